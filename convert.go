@@ -0,0 +1,625 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cadence
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ConvertTagName is the name of the struct tag which maps
+// a field of a Go struct to the name of a composite field.
+//
+// For example, the tag `cadence:"id"` maps the Go field to the composite field `id`.
+// The tag `cadence:"-"` skips the Go field.
+// Fields without a tag use the name of the Go field.
+const ConvertTagName = "cadence"
+
+var valueReflectType = reflect.TypeOf((*Value)(nil)).Elem()
+var bigIntReflectType = reflect.TypeOf((*big.Int)(nil))
+
+// Convert converts a Go value to a Cadence value using reflection.
+//
+// Booleans, strings, integers and big integers are converted to the corresponding Cadence values,
+// pointers are converted to optionals, slices and arrays are converted to arrays,
+// maps are converted to dictionaries, and structs are converted to Cadence structs.
+// Struct fields can be mapped to composite field names using the `cadence` struct tag.
+//
+// Values which already are Cadence values are returned as-is.
+//
+// Unmetered because this function is only used by the client.
+func Convert(goValue any) (Value, error) {
+	if goValue == nil {
+		return NewOptional(nil), nil
+	}
+
+	c := &converter{
+		structTypes: map[reflect.Type]*StructType{},
+	}
+	return c.convertValue(reflect.ValueOf(goValue))
+}
+
+type converter struct {
+	structTypes map[reflect.Type]*StructType
+}
+
+func (c *converter) convertValue(value reflect.Value) (Value, error) {
+	valueType := value.Type()
+
+	if valueType.Implements(valueReflectType) {
+		if valueType.Kind() == reflect.Interface && value.IsNil() {
+			return NewOptional(nil), nil
+		}
+		return value.Interface().(Value), nil
+	}
+
+	if valueType == bigIntReflectType {
+		if value.IsNil() {
+			return NewOptional(nil), nil
+		}
+		return NewIntFromBig(new(big.Int).Set(value.Interface().(*big.Int))), nil
+	}
+
+	switch valueType.Kind() {
+	case reflect.Bool:
+		return NewBool(value.Bool()), nil
+
+	case reflect.String:
+		return NewString(value.String())
+
+	case reflect.Int:
+		return NewInt(int(value.Int())), nil
+	case reflect.Int8:
+		return NewInt8(int8(value.Int())), nil
+	case reflect.Int16:
+		return NewInt16(int16(value.Int())), nil
+	case reflect.Int32:
+		return NewInt32(int32(value.Int())), nil
+	case reflect.Int64:
+		return NewInt64(value.Int()), nil
+
+	case reflect.Uint:
+		return NewUInt(uint(value.Uint())), nil
+	case reflect.Uint8:
+		return NewUInt8(uint8(value.Uint())), nil
+	case reflect.Uint16:
+		return NewUInt16(uint16(value.Uint())), nil
+	case reflect.Uint32:
+		return NewUInt32(uint32(value.Uint())), nil
+	case reflect.Uint64:
+		return NewUInt64(value.Uint()), nil
+
+	case reflect.Pointer:
+		if value.IsNil() {
+			return NewOptional(nil), nil
+		}
+		inner, err := c.convertValue(value.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return NewOptional(inner), nil
+
+	case reflect.Interface:
+		if value.IsNil() {
+			return NewOptional(nil), nil
+		}
+		return c.convertValue(value.Elem())
+
+	case reflect.Slice, reflect.Array:
+		return c.convertArray(value)
+
+	case reflect.Map:
+		return c.convertDictionary(value)
+
+	case reflect.Struct:
+		return c.convertStruct(value)
+	}
+
+	return nil, fmt.Errorf("cannot convert Go value of type %s to Cadence value", valueType)
+}
+
+func (c *converter) convertArray(value reflect.Value) (Value, error) {
+	arrayType, err := c.convertType(value.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	length := value.Len()
+	values := make([]Value, length)
+
+	for i := 0; i < length; i++ {
+		element, err := c.convertValue(value.Index(i))
+		if err != nil {
+			return nil, err
+		}
+		values[i] = element
+	}
+
+	return NewArray(values).WithType(arrayType.(ArrayType)), nil
+}
+
+func (c *converter) convertDictionary(value reflect.Value) (Value, error) {
+	dictionaryType, err := c.convertType(value.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	pairs := make([]KeyValuePair, 0, value.Len())
+
+	iterator := value.MapRange()
+	for iterator.Next() {
+		key, err := c.convertValue(iterator.Key())
+		if err != nil {
+			return nil, err
+		}
+
+		element, err := c.convertValue(iterator.Value())
+		if err != nil {
+			return nil, err
+		}
+
+		pairs = append(pairs, KeyValuePair{
+			Key:   key,
+			Value: element,
+		})
+	}
+
+	// Go maps are unordered, so sort the pairs to produce a deterministic result
+
+	sort.Slice(pairs, func(i, j int) bool {
+		return pairs[i].Key.String() < pairs[j].Key.String()
+	})
+
+	return NewDictionary(pairs).WithType(dictionaryType.(DictionaryType)), nil
+}
+
+func (c *converter) convertStruct(value reflect.Value) (Value, error) {
+	structType, err := c.convertType(value.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	goFields := convertedStructFields(value.Type())
+	fields := make([]Value, len(goFields))
+
+	for i, goField := range goFields {
+		field, err := c.convertValue(value.FieldByIndex(goField.index))
+		if err != nil {
+			return nil, fmt.Errorf("cannot convert field %s: %w", goField.name, err)
+		}
+		fields[i] = field
+	}
+
+	return NewStruct(fields).WithType(structType.(*StructType)), nil
+}
+
+func (c *converter) convertType(goType reflect.Type) (Type, error) {
+
+	if goType.Implements(valueReflectType) {
+		if goType.Kind() == reflect.Interface {
+			return NewAnyStructType(), nil
+		}
+		return reflect.Zero(goType).Interface().(Value).Type(), nil
+	}
+
+	if goType == bigIntReflectType {
+		return NewIntType(), nil
+	}
+
+	switch goType.Kind() {
+	case reflect.Bool:
+		return NewBoolType(), nil
+
+	case reflect.String:
+		return NewStringType(), nil
+
+	case reflect.Int:
+		return NewIntType(), nil
+	case reflect.Int8:
+		return NewInt8Type(), nil
+	case reflect.Int16:
+		return NewInt16Type(), nil
+	case reflect.Int32:
+		return NewInt32Type(), nil
+	case reflect.Int64:
+		return NewInt64Type(), nil
+
+	case reflect.Uint:
+		return NewUIntType(), nil
+	case reflect.Uint8:
+		return NewUInt8Type(), nil
+	case reflect.Uint16:
+		return NewUInt16Type(), nil
+	case reflect.Uint32:
+		return NewUInt32Type(), nil
+	case reflect.Uint64:
+		return NewUInt64Type(), nil
+
+	case reflect.Pointer:
+		innerType, err := c.convertType(goType.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return NewOptionalType(innerType), nil
+
+	case reflect.Interface:
+		return NewAnyStructType(), nil
+
+	case reflect.Slice:
+		elementType, err := c.convertType(goType.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return NewVariableSizedArrayType(elementType), nil
+
+	case reflect.Array:
+		elementType, err := c.convertType(goType.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return NewConstantSizedArrayType(uint(goType.Len()), elementType), nil
+
+	case reflect.Map:
+		keyType, err := c.convertType(goType.Key())
+		if err != nil {
+			return nil, err
+		}
+		elementType, err := c.convertType(goType.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return NewDictionaryType(keyType, elementType), nil
+
+	case reflect.Struct:
+		if structType, ok := c.structTypes[goType]; ok {
+			return structType, nil
+		}
+
+		// Register the struct type before converting the field types,
+		// so recursive struct types refer to the same struct type
+
+		structType := &StructType{
+			QualifiedIdentifier: goType.Name(),
+		}
+		c.structTypes[goType] = structType
+
+		goFields := convertedStructFields(goType)
+		fields := make([]Field, len(goFields))
+
+		for i, goField := range goFields {
+			fieldType, err := c.convertType(goType.FieldByIndex(goField.index).Type)
+			if err != nil {
+				return nil, fmt.Errorf("cannot convert type of field %s: %w", goField.name, err)
+			}
+			fields[i] = Field{
+				Identifier: goField.name,
+				Type:       fieldType,
+			}
+		}
+
+		structType.Fields = fields
+
+		return structType, nil
+	}
+
+	return nil, fmt.Errorf("cannot convert Go type %s to Cadence type", goType)
+}
+
+type convertedStructField struct {
+	name  string
+	index []int
+}
+
+// convertedStructFields returns the exported fields of the given Go struct type,
+// with their composite field names determined by the `cadence` struct tag.
+func convertedStructFields(goType reflect.Type) []convertedStructField {
+	var fields []convertedStructField
+
+	for _, field := range reflect.VisibleFields(goType) {
+		if !field.IsExported() || field.Anonymous {
+			continue
+		}
+
+		name := field.Name
+
+		if tag, ok := field.Tag.Lookup(ConvertTagName); ok {
+			tagName, _, _ := strings.Cut(tag, ",")
+			if tagName == "-" {
+				continue
+			}
+			if tagName != "" {
+				name = tagName
+			}
+		}
+
+		fields = append(fields, convertedStructField{
+			name:  name,
+			index: field.Index,
+		})
+	}
+
+	return fields
+}
+
+// DecodeInto decodes the given Cadence value into the Go value pointed to by goPtr,
+// using reflection. It is the inverse of Convert.
+//
+// Composite fields are matched to the fields of a Go struct by their name,
+// which can be set using the `cadence` struct tag.
+// If no field has the exact name, a case-insensitive match is used.
+// Composite fields without a matching Go field are ignored.
+//
+// Optionals are decoded into pointers, where nil is decoded as a nil pointer.
+// If the target is not a pointer, nil is decoded as the zero value.
+func DecodeInto(value Value, goPtr any) error {
+	target := reflect.ValueOf(goPtr)
+	if target.Kind() != reflect.Pointer || target.IsNil() {
+		return fmt.Errorf("cannot decode into non-pointer or nil value of type %T", goPtr)
+	}
+
+	return decodeValue(value, target.Elem())
+}
+
+func decodeValue(value Value, target reflect.Value) error {
+	targetType := target.Type()
+
+	if value != nil && reflect.TypeOf(value).AssignableTo(targetType) &&
+		// An empty interface target receives the Go representation below
+		!(targetType.Kind() == reflect.Interface && targetType.NumMethod() == 0) {
+
+		target.Set(reflect.ValueOf(value))
+		return nil
+	}
+
+	if optional, ok := value.(Optional); ok {
+		if optional.Value == nil {
+			target.Set(reflect.Zero(targetType))
+			return nil
+		}
+		value = optional.Value
+	}
+
+	if value == nil {
+		target.Set(reflect.Zero(targetType))
+		return nil
+	}
+
+	if targetType == bigIntReflectType {
+		integer, ok := integerValueToBig(value)
+		if !ok {
+			return decodeTypeMismatchError(value, targetType)
+		}
+		target.Set(reflect.ValueOf(integer))
+		return nil
+	}
+
+	switch targetType.Kind() {
+	case reflect.Pointer:
+		element := reflect.New(targetType.Elem())
+		err := decodeValue(value, element.Elem())
+		if err != nil {
+			return err
+		}
+		target.Set(element)
+		return nil
+
+	case reflect.Interface:
+		if targetType.NumMethod() != 0 {
+			return decodeTypeMismatchError(value, targetType)
+		}
+		target.Set(reflect.ValueOf(value.ToGoValue()))
+		return nil
+
+	case reflect.Bool:
+		boolean, ok := value.(Bool)
+		if !ok {
+			return decodeTypeMismatchError(value, targetType)
+		}
+		target.SetBool(bool(boolean))
+		return nil
+
+	case reflect.String:
+		switch value := value.(type) {
+		case String:
+			target.SetString(string(value))
+			return nil
+		case Character:
+			target.SetString(string(value))
+			return nil
+		}
+		return decodeTypeMismatchError(value, targetType)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		integer, ok := integerValueToBig(value)
+		if !ok {
+			return decodeTypeMismatchError(value, targetType)
+		}
+		if !integer.IsInt64() || target.OverflowInt(integer.Int64()) {
+			return fmt.Errorf("cannot decode %s into Go value of type %s: overflow", value, targetType)
+		}
+		target.SetInt(integer.Int64())
+		return nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		integer, ok := integerValueToBig(value)
+		if !ok {
+			return decodeTypeMismatchError(value, targetType)
+		}
+		if !integer.IsUint64() || target.OverflowUint(integer.Uint64()) {
+			return fmt.Errorf("cannot decode %s into Go value of type %s: overflow", value, targetType)
+		}
+		target.SetUint(integer.Uint64())
+		return nil
+
+	case reflect.Slice:
+		array, ok := value.(Array)
+		if !ok {
+			return decodeTypeMismatchError(value, targetType)
+		}
+		slice := reflect.MakeSlice(targetType, len(array.Values), len(array.Values))
+		for i, element := range array.Values {
+			err := decodeValue(element, slice.Index(i))
+			if err != nil {
+				return err
+			}
+		}
+		target.Set(slice)
+		return nil
+
+	case reflect.Array:
+		array, ok := value.(Array)
+		if !ok {
+			return decodeTypeMismatchError(value, targetType)
+		}
+		if len(array.Values) != targetType.Len() {
+			return fmt.Errorf(
+				"cannot decode array with %d elements into Go value of type %s",
+				len(array.Values),
+				targetType,
+			)
+		}
+		for i, element := range array.Values {
+			err := decodeValue(element, target.Index(i))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case reflect.Map:
+		dictionary, ok := value.(Dictionary)
+		if !ok {
+			return decodeTypeMismatchError(value, targetType)
+		}
+		result := reflect.MakeMapWithSize(targetType, len(dictionary.Pairs))
+		for _, pair := range dictionary.Pairs {
+			key := reflect.New(targetType.Key()).Elem()
+			err := decodeValue(pair.Key, key)
+			if err != nil {
+				return err
+			}
+
+			element := reflect.New(targetType.Elem()).Elem()
+			err = decodeValue(pair.Value, element)
+			if err != nil {
+				return err
+			}
+
+			result.SetMapIndex(key, element)
+		}
+		target.Set(result)
+		return nil
+
+	case reflect.Struct:
+		composite, ok := value.(Composite)
+		// The field names are only available through the composite type
+		if !ok || composite.CompositeFields() == nil {
+			return decodeTypeMismatchError(value, targetType)
+		}
+		return decodeStruct(composite, target)
+	}
+
+	return decodeTypeMismatchError(value, targetType)
+}
+
+func decodeStruct(composite Composite, target reflect.Value) error {
+	fields := composite.CompositeFields()
+	fieldValues := composite.FieldValues()
+
+	if len(fields) != len(fieldValues) {
+		return fmt.Errorf(
+			"cannot decode composite value into Go value of type %s: field count mismatch",
+			target.Type(),
+		)
+	}
+
+	for _, goField := range convertedStructFields(target.Type()) {
+
+		index := -1
+		for i, field := range fields {
+			if field.Identifier == goField.name {
+				index = i
+				break
+			}
+		}
+
+		if index < 0 {
+			for i, field := range fields {
+				if strings.EqualFold(field.Identifier, goField.name) {
+					index = i
+					break
+				}
+			}
+		}
+
+		if index < 0 {
+			continue
+		}
+
+		err := decodeValue(fieldValues[index], target.FieldByIndex(goField.index))
+		if err != nil {
+			return fmt.Errorf("cannot decode field %s: %w", fields[index].Identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// integerValueToBig returns the given integer value as a big integer.
+// Fixed-point values are not integers and are rejected.
+func integerValueToBig(value Value) (*big.Int, bool) {
+	switch value.(type) {
+	case Fix64, UFix64:
+		return nil, false
+	}
+
+	switch goValue := value.ToGoValue().(type) {
+	case *big.Int:
+		return new(big.Int).Set(goValue), true
+	case int:
+		return big.NewInt(int64(goValue)), true
+	case int8:
+		return big.NewInt(int64(goValue)), true
+	case int16:
+		return big.NewInt(int64(goValue)), true
+	case int32:
+		return big.NewInt(int64(goValue)), true
+	case int64:
+		return big.NewInt(goValue), true
+	case uint:
+		return new(big.Int).SetUint64(uint64(goValue)), true
+	case uint8:
+		return new(big.Int).SetUint64(uint64(goValue)), true
+	case uint16:
+		return new(big.Int).SetUint64(uint64(goValue)), true
+	case uint32:
+		return new(big.Int).SetUint64(uint64(goValue)), true
+	case uint64:
+		return new(big.Int).SetUint64(goValue), true
+	}
+
+	return nil, false
+}
+
+func decodeTypeMismatchError(value Value, targetType reflect.Type) error {
+	return fmt.Errorf("cannot decode Cadence value of type %T into Go value of type %s", value, targetType)
+}
@@ -0,0 +1,340 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cadence
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type convertTestItem struct {
+	ID    uint64 `cadence:"id"`
+	Name  string `cadence:"name"`
+	Price *big.Int
+	Owner *string `cadence:"owner"`
+	Tags  []string
+	Meta  map[string]int8 `cadence:"metadata"`
+
+	Ignored bool `cadence:"-"`
+	private int
+}
+
+func TestConvert(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("simple values", func(t *testing.T) {
+
+		t.Parallel()
+
+		for goValue, expected := range map[any]Value{
+			true:                   NewBool(true),
+			"test":                 String("test"),
+			42:                     NewInt(42),
+			int8(-1):               NewInt8(-1),
+			uint16(2):              NewUInt16(2),
+			uint64(3):              NewUInt64(3),
+			NewWord8(4):            NewWord8(4),
+			NewAddress([8]byte{1}): NewAddress([8]byte{1}),
+		} {
+			actual, err := Convert(goValue)
+			require.NoError(t, err)
+			assert.Equal(t, expected, actual)
+		}
+	})
+
+	t.Run("nil", func(t *testing.T) {
+
+		t.Parallel()
+
+		actual, err := Convert(nil)
+		require.NoError(t, err)
+		assert.Equal(t, NewOptional(nil), actual)
+	})
+
+	t.Run("pointer", func(t *testing.T) {
+
+		t.Parallel()
+
+		value := int16(7)
+
+		actual, err := Convert(&value)
+		require.NoError(t, err)
+		assert.Equal(t, NewOptional(NewInt16(7)), actual)
+
+		actual, err = Convert((*int16)(nil))
+		require.NoError(t, err)
+		assert.Equal(t, NewOptional(nil), actual)
+	})
+
+	t.Run("slice", func(t *testing.T) {
+
+		t.Parallel()
+
+		actual, err := Convert([]uint8{1, 2})
+		require.NoError(t, err)
+		assert.Equal(t,
+			NewArray([]Value{NewUInt8(1), NewUInt8(2)}).
+				WithType(NewVariableSizedArrayType(NewUInt8Type())),
+			actual,
+		)
+	})
+
+	t.Run("array", func(t *testing.T) {
+
+		t.Parallel()
+
+		actual, err := Convert([2]bool{true, false})
+		require.NoError(t, err)
+		assert.Equal(t,
+			NewArray([]Value{NewBool(true), NewBool(false)}).
+				WithType(NewConstantSizedArrayType(2, NewBoolType())),
+			actual,
+		)
+	})
+
+	t.Run("map", func(t *testing.T) {
+
+		t.Parallel()
+
+		actual, err := Convert(map[string]int32{"b": 2, "a": 1})
+		require.NoError(t, err)
+		assert.Equal(t,
+			NewDictionary([]KeyValuePair{
+				{Key: String("a"), Value: NewInt32(1)},
+				{Key: String("b"), Value: NewInt32(2)},
+			}).WithType(NewDictionaryType(NewStringType(), NewInt32Type())),
+			actual,
+		)
+	})
+
+	t.Run("struct", func(t *testing.T) {
+
+		t.Parallel()
+
+		actual, err := Convert(convertTestItem{
+			ID:      1,
+			Name:    "foo",
+			Price:   big.NewInt(100),
+			Tags:    []string{"a"},
+			Ignored: true,
+			private: 2,
+		})
+		require.NoError(t, err)
+
+		expectedType := &StructType{
+			QualifiedIdentifier: "convertTestItem",
+			Fields: []Field{
+				{Identifier: "id", Type: NewUInt64Type()},
+				{Identifier: "name", Type: NewStringType()},
+				{Identifier: "Price", Type: NewIntType()},
+				{Identifier: "owner", Type: NewOptionalType(NewStringType())},
+				{Identifier: "Tags", Type: NewVariableSizedArrayType(NewStringType())},
+				{Identifier: "metadata", Type: NewDictionaryType(NewStringType(), NewInt8Type())},
+			},
+		}
+
+		assert.Equal(t,
+			NewStruct([]Value{
+				NewUInt64(1),
+				String("foo"),
+				NewInt(100),
+				NewOptional(nil),
+				NewArray([]Value{String("a")}).
+					WithType(NewVariableSizedArrayType(NewStringType())),
+				NewDictionary([]KeyValuePair{}).
+					WithType(NewDictionaryType(NewStringType(), NewInt8Type())),
+			}).WithType(expectedType),
+			actual,
+		)
+	})
+
+	t.Run("recursive struct", func(t *testing.T) {
+
+		t.Parallel()
+
+		type node struct {
+			Next *node `cadence:"next"`
+		}
+
+		actual, err := Convert(node{Next: &node{}})
+		require.NoError(t, err)
+
+		structType := actual.(Struct).StructType
+		require.Len(t, structType.Fields, 1)
+		assert.Equal(t, NewOptionalType(structType), structType.Fields[0].Type)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := Convert(1.5)
+		require.Error(t, err)
+
+		_, err = Convert(struct{ F func() }{})
+		require.Error(t, err)
+	})
+}
+
+func TestDecodeInto(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("round trip", func(t *testing.T) {
+
+		t.Parallel()
+
+		owner := "bar"
+
+		item := convertTestItem{
+			ID:    1,
+			Name:  "foo",
+			Price: big.NewInt(100),
+			Owner: &owner,
+			Tags:  []string{"a", "b"},
+			Meta:  map[string]int8{"x": 1},
+		}
+
+		value, err := Convert(item)
+		require.NoError(t, err)
+
+		var decoded convertTestItem
+		err = DecodeInto(value, &decoded)
+		require.NoError(t, err)
+
+		assert.Equal(t, item, decoded)
+	})
+
+	t.Run("composite field names", func(t *testing.T) {
+
+		t.Parallel()
+
+		value := NewResource([]Value{
+			NewUInt64(3),
+			String("vault"),
+			NewInt(5),
+		}).WithType(&ResourceType{
+			QualifiedIdentifier: "Vault",
+			Fields: []Field{
+				{Identifier: "id", Type: NewUInt64Type()},
+				{Identifier: "tags", Type: NewStringType()},
+				{Identifier: "price", Type: NewIntType()},
+			},
+		})
+
+		var decoded struct {
+			ID    uint64 `cadence:"id"`
+			Price int
+			Other string
+		}
+		err := DecodeInto(value, &decoded)
+		require.NoError(t, err)
+
+		assert.Equal(t, uint64(3), decoded.ID)
+		assert.Equal(t, 5, decoded.Price)
+		assert.Equal(t, "", decoded.Other)
+	})
+
+	t.Run("optional", func(t *testing.T) {
+
+		t.Parallel()
+
+		var pointer *int32
+		err := DecodeInto(NewOptional(NewInt32(2)), &pointer)
+		require.NoError(t, err)
+		require.NotNil(t, pointer)
+		assert.Equal(t, int32(2), *pointer)
+
+		err = DecodeInto(NewOptional(nil), &pointer)
+		require.NoError(t, err)
+		assert.Nil(t, pointer)
+
+		value := int32(4)
+		err = DecodeInto(NewOptional(nil), &value)
+		require.NoError(t, err)
+		assert.Equal(t, int32(0), value)
+	})
+
+	t.Run("cadence value", func(t *testing.T) {
+
+		t.Parallel()
+
+		var address Address
+		err := DecodeInto(NewAddress([8]byte{1}), &address)
+		require.NoError(t, err)
+		assert.Equal(t, NewAddress([8]byte{1}), address)
+
+		var value Value
+		err = DecodeInto(NewUInt8(1), &value)
+		require.NoError(t, err)
+		assert.Equal(t, NewUInt8(1), value)
+	})
+
+	t.Run("interface", func(t *testing.T) {
+
+		t.Parallel()
+
+		var value any
+		err := DecodeInto(String("foo"), &value)
+		require.NoError(t, err)
+		assert.Equal(t, "foo", value)
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+
+		t.Parallel()
+
+		var value int8
+		err := DecodeInto(NewInt(1000), &value)
+		require.Error(t, err)
+
+		var unsigned uint
+		err = DecodeInto(NewInt(-1), &unsigned)
+		require.Error(t, err)
+	})
+
+	t.Run("type mismatch", func(t *testing.T) {
+
+		t.Parallel()
+
+		var value string
+		err := DecodeInto(NewInt(1), &value)
+		require.Error(t, err)
+
+		var integer int64
+		err = DecodeInto(Fix64(1), &integer)
+		require.Error(t, err)
+	})
+
+	t.Run("non-pointer", func(t *testing.T) {
+
+		t.Parallel()
+
+		var value int
+		err := DecodeInto(NewInt(1), value)
+		require.Error(t, err)
+
+		err = DecodeInto(NewInt(1), nil)
+		require.Error(t, err)
+	})
+}
@@ -135,6 +135,8 @@ const (
 	labelKey        = "label"
 	parametersKey   = "parameters"
 	returnKey       = "return"
+	typesKey        = "types"
+	indexedKey      = "indexed"
 )
 
 var ErrInvalidJSONCadence = errors.NewDefaultUserError("invalid JSON Cadence structure")
@@ -207,10 +209,16 @@ func (d *Decoder) decodeJSON(v any) cadence.Value {
 		return d.decodeFix64(valueJSON)
 	case ufix64TypeStr:
 		return d.decodeUFix64(valueJSON)
+	case fix128TypeStr:
+		return d.decodeFix128(valueJSON)
+	case ufix128TypeStr:
+		return d.decodeUFix128(valueJSON)
 	case arrayTypeStr:
 		return d.decodeArray(valueJSON)
 	case dictionaryTypeStr:
 		return d.decodeDictionary(valueJSON)
+	case tupleTypeStr:
+		return d.decodeTuple(valueJSON)
 	case resourceTypeStr:
 		return d.decodeResource(valueJSON)
 	case structTypeStr:
@@ -572,6 +580,28 @@ func (d *Decoder) decodeUFix64(valueJSON any) cadence.UFix64 {
 	return v
 }
 
+func (d *Decoder) decodeFix128(valueJSON any) cadence.Fix128 {
+	v, err := cadence.NewMeteredFix128(d.gauge, func() (string, error) {
+		return toString(valueJSON), nil
+	})
+	if err != nil {
+		// TODO: improve error message
+		panic(ErrInvalidJSONCadence)
+	}
+	return v
+}
+
+func (d *Decoder) decodeUFix128(valueJSON any) cadence.UFix128 {
+	v, err := cadence.NewMeteredUFix128(d.gauge, func() (string, error) {
+		return toString(valueJSON), nil
+	})
+	if err != nil {
+		// TODO: improve error message
+		panic(ErrInvalidJSONCadence)
+	}
+	return v
+}
+
 func (d *Decoder) decodeArray(valueJSON any) cadence.Array {
 	v := toSlice(valueJSON)
 
@@ -594,6 +624,27 @@ func (d *Decoder) decodeArray(valueJSON any) cadence.Array {
 	return value
 }
 
+func (d *Decoder) decodeTuple(valueJSON any) cadence.Tuple {
+	v := toSlice(valueJSON)
+
+	value, err := cadence.NewMeteredTuple(
+		d.gauge,
+		func() ([]cadence.Value, error) {
+			values := make([]cadence.Value, len(v))
+			for i, val := range v {
+				values[i] = d.decodeJSON(val)
+			}
+			return values, nil
+		},
+	)
+
+	if err != nil {
+		// TODO: improve error message
+		panic(ErrInvalidJSONCadence)
+	}
+	return value
+}
+
 func (d *Decoder) decodeDictionary(valueJSON any) cadence.Dictionary {
 	v := toSlice(valueJSON)
 
@@ -846,6 +897,10 @@ func (d *Decoder) decodePath(valueJSON any) cadence.Path {
 		Amount: uint64(len(domain)),
 	})
 
+	if common.PathDomainFromIdentifier(domain) == common.PathDomainUnknown {
+		panic(errors.NewDefaultUserError("invalid path domain: %s", domain))
+	}
+
 	identifier := obj.GetString(identifierKey)
 	common.UseMemory(d.gauge, common.MemoryUsage{
 		Kind: common.MemoryKindRawString,
@@ -853,6 +908,10 @@ func (d *Decoder) decodePath(valueJSON any) cadence.Path {
 		Amount: uint64(len(identifier)),
 	})
 
+	if identifier == "" {
+		panic(errors.NewDefaultUserError("invalid path: missing identifier"))
+	}
+
 	return cadence.NewMeteredPath(
 		d.gauge,
 		domain,
@@ -902,10 +961,17 @@ func (d *Decoder) decodeFieldTypes(fs []any, results typeDecodingResults) []cade
 func (d *Decoder) decodeFieldType(valueJSON any, results typeDecodingResults) cadence.Field {
 	obj := toObject(valueJSON)
 	// Unmetered because decodeFieldType is metered in decodeFieldTypes and called nowhere else
-	return cadence.NewField(
+	field := cadence.NewField(
 		toString(obj.Get(idKey)),
 		d.decodeType(obj.Get(typeKey), results),
 	)
+
+	// The indexed flag is optional, and only present for indexed event fields
+	if indexed, ok := obj[indexedKey]; ok {
+		field.Indexed = toBool(indexed)
+	}
+
+	return field
 }
 
 func (d *Decoder) decodeFunctionType(returnValue, parametersValue, id any, results typeDecodingResults) cadence.Type {
@@ -1116,6 +1182,13 @@ func (d *Decoder) decodeType(valueJSON any, results typeDecodingResults) cadence
 			d.decodeType(obj.Get(keyKey), results),
 			d.decodeType(obj.Get(valueKey), results),
 		)
+	case "Tuple":
+		typesValue := toSlice(obj.Get(typesKey))
+		elementTypes := make([]cadence.Type, len(typesValue))
+		for i, typeValue := range typesValue {
+			elementTypes[i] = d.decodeType(typeValue, results)
+		}
+		return cadence.NewMeteredTupleType(d.gauge, elementTypes)
 	case "ConstantSizedArray":
 		size := toUInt(obj.Get(sizeKey))
 		return cadence.NewMeteredConstantSizedArrayType(
@@ -1204,6 +1277,10 @@ func (d *Decoder) decodeType(valueJSON any, results typeDecodingResults) cadence
 		return cadence.NewMeteredFix64Type(d.gauge)
 	case "UFix64":
 		return cadence.NewMeteredUFix64Type(d.gauge)
+	case "Fix128":
+		return cadence.NewMeteredFix128Type(d.gauge)
+	case "UFix128":
+		return cadence.NewMeteredUFix128Type(d.gauge)
 	case "Path":
 		return cadence.NewMeteredPathType(d.gauge)
 	case "CapabilityPath":
@@ -1230,6 +1307,14 @@ func (d *Decoder) decodeType(valueJSON any, results typeDecodingResults) cadence
 		return cadence.NewMeteredDeployedContractType(d.gauge)
 	case "AccountKey":
 		return cadence.NewMeteredAccountKeyType(d.gauge)
+	case "AuthAccount.Capabilities":
+		return cadence.NewMeteredAuthAccountCapabilitiesType(d.gauge)
+	case "AuthAccount.Inbox":
+		return cadence.NewMeteredAuthAccountInboxType(d.gauge)
+	case "AuthAccount.StorageCapabilities":
+		return cadence.NewMeteredAuthAccountStorageCapabilitiesType(d.gauge)
+	case "StorageCapabilityController":
+		return cadence.NewMeteredStorageCapabilityControllerType(d.gauge)
 	case "Block":
 		return cadence.NewMeteredBlockType(d.gauge)
 	default:
@@ -1259,6 +1344,17 @@ func (d *Decoder) decodeTypeValue(valueJSON any) cadence.TypeValue {
 func (d *Decoder) decodeCapability(valueJSON any) cadence.Capability {
 	obj := toObject(valueJSON)
 
+	// ID capabilities have an ID instead of a path
+
+	if id, ok := obj[idKey]; ok {
+		return cadence.NewMeteredIDCapability(
+			d.gauge,
+			d.decodeUInt64(id),
+			d.decodeAddress(obj.Get(addressKey)),
+			d.decodeType(obj.Get(borrowTypeKey), typeDecodingResults{}),
+		)
+	}
+
 	path, ok := d.decodeJSON(obj.Get(pathKey)).(cadence.Path)
 	if !ok {
 		// TODO: improve error message
@@ -137,8 +137,9 @@ type jsonPathValue struct {
 }
 
 type jsonFieldType struct {
-	Id   string    `json:"id"`
-	Type jsonValue `json:"type"`
+	Id      string    `json:"id"`
+	Type    jsonValue `json:"type"`
+	Indexed bool      `json:"indexed,omitempty"`
 }
 
 type jsonNominalType struct {
@@ -170,6 +171,11 @@ type jsonDictionaryType struct {
 	ValueType jsonValue `json:"value"`
 }
 
+type jsonTupleType struct {
+	Kind  string      `json:"kind"`
+	Types []jsonValue `json:"types"`
+}
+
 type jsonReferenceType struct {
 	Kind       string    `json:"kind"`
 	Type       jsonValue `json:"type"`
@@ -201,7 +207,8 @@ type jsonTypeValue struct {
 }
 
 type jsonCapabilityValue struct {
-	Path       jsonValue `json:"path"`
+	Path       jsonValue `json:"path,omitempty"`
+	ID         string    `json:"id,omitempty"`
 	Address    string    `json:"address"`
 	BorrowType jsonValue `json:"borrowType"`
 }
@@ -233,8 +240,11 @@ const (
 	word64TypeStr     = "Word64"
 	fix64TypeStr      = "Fix64"
 	ufix64TypeStr     = "UFix64"
+	fix128TypeStr     = "Fix128"
+	ufix128TypeStr    = "UFix128"
 	arrayTypeStr      = "Array"
 	dictionaryTypeStr = "Dictionary"
+	tupleTypeStr      = "Tuple"
 	structTypeStr     = "Struct"
 	resourceTypeStr   = "Resource"
 	eventTypeStr      = "Event"
@@ -302,10 +312,16 @@ func Prepare(v cadence.Value) jsonValue {
 		return prepareFix64(x)
 	case cadence.UFix64:
 		return prepareUFix64(x)
+	case cadence.Fix128:
+		return prepareFix128(x)
+	case cadence.UFix128:
+		return prepareUFix128(x)
 	case cadence.Array:
 		return prepareArray(x)
 	case cadence.Dictionary:
 		return prepareDictionary(x)
+	case cadence.Tuple:
+		return prepareTuple(x)
 	case cadence.Struct:
 		return prepareStruct(x)
 	case cadence.Resource:
@@ -514,6 +530,20 @@ func prepareUFix64(v cadence.UFix64) jsonValue {
 	}
 }
 
+func prepareFix128(v cadence.Fix128) jsonValue {
+	return jsonValueObject{
+		Type:  fix128TypeStr,
+		Value: encodeFix128(v.Value),
+	}
+}
+
+func prepareUFix128(v cadence.UFix128) jsonValue {
+	return jsonValueObject{
+		Type:  ufix128TypeStr,
+		Value: encodeUFix128(v.Value),
+	}
+}
+
 func prepareArray(v cadence.Array) jsonValue {
 	values := make([]jsonValue, len(v.Values))
 
@@ -527,6 +557,19 @@ func prepareArray(v cadence.Array) jsonValue {
 	}
 }
 
+func prepareTuple(v cadence.Tuple) jsonValue {
+	values := make([]jsonValue, len(v.Values))
+
+	for i, value := range v.Values {
+		values[i] = Prepare(value)
+	}
+
+	return jsonValueObject{
+		Type:  tupleTypeStr,
+		Value: values,
+	}
+}
+
 func prepareDictionary(v cadence.Dictionary) jsonValue {
 	items := make([]jsonDictionaryItem, len(v.Pairs))
 
@@ -631,8 +674,9 @@ func prepareParameterType(parameterType cadence.Parameter, results typePreparati
 
 func prepareFieldType(fieldType cadence.Field, results typePreparationResults) jsonFieldType {
 	return jsonFieldType{
-		Id:   fieldType.Identifier,
-		Type: prepareType(fieldType.Type, results),
+		Id:      fieldType.Identifier,
+		Type:    prepareType(fieldType.Type, results),
+		Indexed: fieldType.Indexed,
 	}
 }
 
@@ -718,6 +762,8 @@ func prepareType(typ cadence.Type, results typePreparationResults) jsonValue {
 		cadence.Word64Type,
 		cadence.Fix64Type,
 		cadence.UFix64Type,
+		cadence.Fix128Type,
+		cadence.UFix128Type,
 		cadence.BlockType,
 		cadence.PathType,
 		cadence.CapabilityPathType,
@@ -727,11 +773,15 @@ func prepareType(typ cadence.Type, results typePreparationResults) jsonValue {
 		cadence.AccountKeyType,
 		cadence.AuthAccountContractsType,
 		cadence.AuthAccountKeysType,
+		cadence.AuthAccountCapabilitiesType,
+		cadence.AuthAccountInboxType,
+		cadence.AuthAccountStorageCapabilitiesType,
 		cadence.AuthAccountType,
 		cadence.PublicAccountContractsType,
 		cadence.PublicAccountKeysType,
 		cadence.PublicAccountType,
-		cadence.DeployedContractType:
+		cadence.DeployedContractType,
+		cadence.StorageCapabilityControllerType:
 		return jsonSimpleType{
 			Kind: typ.ID(),
 		}
@@ -757,6 +807,15 @@ func prepareType(typ cadence.Type, results typePreparationResults) jsonValue {
 			KeyType:   prepareType(typ.KeyType, results),
 			ValueType: prepareType(typ.ElementType, results),
 		}
+	case *cadence.TupleType:
+		types := make([]jsonValue, len(typ.ElementTypes))
+		for i, elementType := range typ.ElementTypes {
+			types[i] = prepareType(elementType, results)
+		}
+		return jsonTupleType{
+			Kind:  "Tuple",
+			Types: types,
+		}
 	case *cadence.StructType:
 		return jsonNominalType{
 			Kind:         "Struct",
@@ -869,13 +928,21 @@ func prepareTypeValue(typeValue cadence.TypeValue) jsonValue {
 }
 
 func prepareCapability(capability cadence.Capability) jsonValue {
+	value := jsonCapabilityValue{
+		Address:    encodeBytes(capability.Address.Bytes()),
+		BorrowType: prepareType(capability.BorrowType, typePreparationResults{}),
+	}
+
+	// ID capabilities have an ID instead of a path
+	if capability.ID != 0 {
+		value.ID = encodeUInt(uint64(capability.ID))
+	} else {
+		value.Path = preparePath(capability.Path)
+	}
+
 	return jsonValueObject{
-		Type: capabilityTypeStr,
-		Value: jsonCapabilityValue{
-			Path:       preparePath(capability.Path),
-			Address:    encodeBytes(capability.Address.Bytes()),
-			BorrowType: prepareType(capability.BorrowType, typePreparationResults{}),
-		},
+		Type:  capabilityTypeStr,
+		Value: value,
 	}
 }
 
@@ -930,6 +997,39 @@ func encodeUFix64(v uint64) string {
 	)
 }
 
+func encodeFix128(v *big.Int) string {
+	integer, fraction := new(big.Int).QuoRem(v, sema.Fix128FactorBig, new(big.Int))
+
+	negative := fraction.Sign() < 0
+
+	var builder strings.Builder
+
+	if negative {
+		fraction.Neg(fraction)
+		if integer.Sign() == 0 {
+			builder.WriteRune('-')
+		}
+	}
+
+	builder.WriteString(fmt.Sprintf(
+		"%s.%024s",
+		integer,
+		fraction,
+	))
+
+	return builder.String()
+}
+
+func encodeUFix128(v *big.Int) string {
+	integer, fraction := new(big.Int).QuoRem(v, sema.Fix128FactorBig, new(big.Int))
+
+	return fmt.Sprintf(
+		"%s.%024s",
+		integer,
+		fraction,
+	)
+}
+
 func typeId(location common.Location, identifier string) string {
 	if location == nil {
 		return identifier
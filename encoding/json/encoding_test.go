@@ -595,6 +595,69 @@ func TestEncodeUFix64(t *testing.T) {
 	}...)
 }
 
+func TestEncodeFix128(t *testing.T) {
+
+	t.Parallel()
+
+	newFix128 := func(s string) cadence.Fix128 {
+		value, err := cadence.NewFix128(s)
+		require.NoError(t, err)
+		return value
+	}
+
+	testAllEncodeAndDecode(t, []encodeTest{
+		{
+			"Zero",
+			newFix128("0.0"),
+			`{"type":"Fix128","value":"0.000000000000000000000000"}`,
+		},
+		{
+			"789.001230100000000000000001",
+			newFix128("789.001230100000000000000001"),
+			`{"type":"Fix128","value":"789.001230100000000000000001"}`,
+		},
+		{
+			"-0.5",
+			newFix128("-0.5"),
+			`{"type":"Fix128","value":"-0.500000000000000000000000"}`,
+		},
+		{
+			"-12345.006789",
+			newFix128("-12345.006789"),
+			`{"type":"Fix128","value":"-12345.006789000000000000000000"}`,
+		},
+	}...)
+}
+
+func TestEncodeUFix128(t *testing.T) {
+
+	t.Parallel()
+
+	newUFix128 := func(s string) cadence.UFix128 {
+		value, err := cadence.NewUFix128(s)
+		require.NoError(t, err)
+		return value
+	}
+
+	testAllEncodeAndDecode(t, []encodeTest{
+		{
+			"Zero",
+			newUFix128("0.0"),
+			`{"type":"UFix128","value":"0.000000000000000000000000"}`,
+		},
+		{
+			"1234.056",
+			newUFix128("1234.056"),
+			`{"type":"UFix128","value":"1234.056000000000000000000000"}`,
+		},
+		{
+			"Max",
+			newUFix128("340282366920938.463463374607431768211455"),
+			`{"type":"UFix128","value":"340282366920938.463463374607431768211455"}`,
+		},
+	}...)
+}
+
 func TestEncodeArray(t *testing.T) {
 
 	t.Parallel()
@@ -762,6 +825,36 @@ func exportFromScript(t *testing.T, code string) cadence.Value {
 	return exported
 }
 
+func TestEncodeTuple(t *testing.T) {
+
+	t.Parallel()
+
+	simpleTuple := encodeTest{
+		"Simple",
+		cadence.NewTuple([]cadence.Value{
+			cadence.NewInt(1),
+			cadence.String("two"),
+		}),
+		`{"type":"Tuple","value":[{"type":"Int","value":"1"},{"type":"String","value":"two"}]}`,
+	}
+
+	nestedTuple := encodeTest{
+		"Nested",
+		cadence.NewTuple([]cadence.Value{
+			cadence.NewTuple([]cadence.Value{
+				cadence.NewInt(1),
+				cadence.NewBool(true),
+			}),
+			cadence.NewArray([]cadence.Value{
+				cadence.NewInt(2),
+			}),
+		}),
+		`{"type":"Tuple","value":[{"type":"Tuple","value":[{"type":"Int","value":"1"},{"type":"Bool","value":true}]},{"type":"Array","value":[{"type":"Int","value":"2"}]}]}`,
+	}
+
+	testAllEncodeAndDecode(t, simpleTuple, nestedTuple)
+}
+
 func TestEncodeResource(t *testing.T) {
 
 	t.Parallel()
@@ -786,7 +879,9 @@ func TestEncodeResource(t *testing.T) {
 
 		expectedJSON := `{"type":"Resource","value":{"id":"S.test.Foo","fields":[{"name":"uuid","value":{"type":"UInt64","value":"1"}},{"name":"bar","value":{"type":"Int","value":"42"}}]}}`
 
-		testEncodeAndDecode(t, actual, expectedJSON)
+		// The JSON encoding of values does not include the initializers of the type
+		actualJSON := testEncode(t, actual, expectedJSON)
+		testDecode(t, actualJSON, simpleFooResource)
 	})
 
 	t.Run("With function member", func(t *testing.T) {
@@ -814,7 +909,9 @@ func TestEncodeResource(t *testing.T) {
 		// function "foo" should be omitted from resulting JSON
 		expectedJSON := `{"type":"Resource","value":{"id":"S.test.Foo","fields":[{"name":"uuid","value":{"type":"UInt64","value":"1"}},{"name":"bar","value":{"type":"Int","value":"42"}}]}}`
 
-		testEncodeAndDecode(t, actual, expectedJSON)
+		// The JSON encoding of values does not include the initializers of the type
+		actualJSON := testEncode(t, actual, expectedJSON)
+		testDecode(t, actualJSON, simpleFooResource)
 	})
 
 	t.Run("Nested resource", func(t *testing.T) {
@@ -849,10 +946,72 @@ func TestEncodeResource(t *testing.T) {
 
 		expectedJSON := `{"type":"Resource","value":{"id":"S.test.Foo","fields":[{"name":"uuid","value":{"type":"UInt64","value":"2"}},{"name":"bar","value":{"type":"Resource","value":{"id":"S.test.Bar","fields":[{"name":"uuid","value":{"type":"UInt64","value":"1"}},{"name":"x","value":{"type":"Int","value":"42"}}]}}}]}}`
 
-		testEncodeAndDecode(t, actual, expectedJSON)
+		barResourceType := &cadence.ResourceType{
+			Location:            utils.TestLocation,
+			QualifiedIdentifier: "Bar",
+			Fields: []cadence.Field{
+				{
+					Identifier: "uuid",
+					Type:       cadence.UInt64Type{},
+				},
+				{
+					Identifier: "x",
+					Type:       cadence.IntType{},
+				},
+			},
+		}
+
+		expected := cadence.NewResource(
+			[]cadence.Value{
+				cadence.NewUInt64(2),
+				cadence.NewResource(
+					[]cadence.Value{
+						cadence.NewUInt64(1),
+						cadence.NewInt(42),
+					},
+				).WithType(barResourceType),
+			},
+		).WithType(&cadence.ResourceType{
+			Location:            utils.TestLocation,
+			QualifiedIdentifier: "Foo",
+			Fields: []cadence.Field{
+				{
+					Identifier: "uuid",
+					Type:       cadence.UInt64Type{},
+				},
+				{
+					Identifier: "bar",
+					Type:       barResourceType,
+				},
+			},
+		})
+
+		// The JSON encoding of values does not include the initializers of the types
+		actualJSON := testEncode(t, actual, expectedJSON)
+		testDecode(t, actualJSON, expected)
 	})
 }
 
+var simpleFooResource = cadence.NewResource(
+	[]cadence.Value{
+		cadence.NewUInt64(1),
+		cadence.NewInt(42),
+	},
+).WithType(&cadence.ResourceType{
+	Location:            utils.TestLocation,
+	QualifiedIdentifier: "Foo",
+	Fields: []cadence.Field{
+		{
+			Identifier: "uuid",
+			Type:       cadence.UInt64Type{},
+		},
+		{
+			Identifier: "bar",
+			Type:       cadence.IntType{},
+		},
+	},
+})
+
 func TestEncodeStruct(t *testing.T) {
 
 	t.Parallel()
@@ -1098,6 +1257,8 @@ func TestEncodeSimpleTypes(t *testing.T) {
 		cadence.Word64Type{},
 		cadence.Fix64Type{},
 		cadence.UFix64Type{},
+		cadence.Fix128Type{},
+		cadence.UFix128Type{},
 		cadence.BlockType{},
 		cadence.PathType{},
 		cadence.CapabilityPathType{},
@@ -1185,6 +1346,22 @@ func TestEncodeType(t *testing.T) {
 
 	})
 
+	t.Run("with static (int, string)", func(t *testing.T) {
+
+		testEncodeAndDecode(
+			t,
+			cadence.TypeValue{
+				StaticType: cadence.NewTupleType([]cadence.Type{
+					cadence.IntType{},
+					cadence.StringType{},
+				}),
+			},
+			`{"type":"Type","value":{"staticType":{"kind":"Tuple", 
+			"types" : [{"kind" : "Int"}, {"kind" : "String"}]}}}`,
+		)
+
+	})
+
 	t.Run("with static struct", func(t *testing.T) {
 
 		testEncodeAndDecode(
@@ -1422,6 +1599,41 @@ func TestEncodeType(t *testing.T) {
 		)
 	})
 
+	t.Run("with static event, indexed fields", func(t *testing.T) {
+
+		testEncodeAndDecode(
+			t,
+			cadence.TypeValue{
+				StaticType: &cadence.EventType{
+					Location:            utils.TestLocation,
+					QualifiedIdentifier: "E",
+					Fields: []cadence.Field{
+						{Identifier: "foo", Type: cadence.IntType{}, Indexed: true},
+						{Identifier: "bar", Type: cadence.StringType{}},
+					},
+					Initializer: []cadence.Parameter{
+						{Identifier: "foo", Type: cadence.IntType{}},
+						{Identifier: "bar", Type: cadence.StringType{}},
+					},
+				},
+			},
+			`{"type":"Type", "value": {"staticType":
+					{"kind": "Event",
+					 "type" : "",
+					 "typeID" : "S.test.E",
+					 "fields" : [
+						  {"id" : "foo", "type": {"kind" : "Int"}, "indexed": true },
+						  {"id" : "bar", "type": {"kind" : "String"} }
+					    ],
+					 "initializers" :
+						  [[{"label" : "", "id" : "foo", "type": {"kind" : "Int"}},
+						  {"label" : "", "id" : "bar", "type": {"kind" : "String"}}]]
+					}
+				}
+			}`,
+		)
+	})
+
 	t.Run("with static enum", func(t *testing.T) {
 
 		testEncodeAndDecode(
@@ -1566,6 +1778,21 @@ func TestEncodeCapability(t *testing.T) {
 	)
 }
 
+func TestEncodeIDCapability(t *testing.T) {
+
+	t.Parallel()
+
+	testEncodeAndDecode(
+		t,
+		cadence.NewIDCapability(
+			42,
+			cadence.BytesToAddress([]byte{1, 2, 3, 4, 5}),
+			cadence.IntType{},
+		),
+		`{"type":"Capability","value":{"borrowType":{"kind":"Int"},"address":"0x0000000102030405","id":"42"}}`,
+	)
+}
+
 func TestDecodeFixedPoints(t *testing.T) {
 
 	t.Parallel()
@@ -1971,6 +2198,71 @@ func TestDecodeInvalidType(t *testing.T) {
 	})
 }
 
+func TestDecodeInvalidPath(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("invalid domain", func(t *testing.T) {
+		t.Parallel()
+
+		encodedValue := `
+		{
+			"type":"Path",
+			"value":{
+				"domain":"foo",
+				"identifier":"bar"
+			}
+		}
+	`
+		_, err := json.Decode(nil, []byte(encodedValue))
+		require.Error(t, err)
+		assert.Equal(t, "failed to decode value: invalid path domain: foo", err.Error())
+	})
+
+	t.Run("missing identifier", func(t *testing.T) {
+		t.Parallel()
+
+		encodedValue := `
+		{
+			"type":"Path",
+			"value":{
+				"domain":"public",
+				"identifier":""
+			}
+		}
+	`
+		_, err := json.Decode(nil, []byte(encodedValue))
+		require.Error(t, err)
+		assert.Equal(t, "failed to decode value: invalid path: missing identifier", err.Error())
+	})
+
+	t.Run("capability with invalid path domain", func(t *testing.T) {
+		t.Parallel()
+
+		encodedValue := `
+		{
+			"type":"Capability",
+			"value":{
+				"path":{
+					"type":"Path",
+					"value":{
+						"domain":"foo",
+						"identifier":"bar"
+					}
+				},
+				"address":"0x0000000102030405",
+				"borrowType":{
+					"kind":"Int"
+				}
+			}
+		}
+	`
+		_, err := json.Decode(nil, []byte(encodedValue))
+		require.Error(t, err)
+		assert.Equal(t, "failed to decode value: invalid path domain: foo", err.Error())
+	})
+}
+
 func testEncodeAndDecode(t *testing.T, val cadence.Value, expectedJSON string) {
 	actualJSON := testEncode(t, val, expectedJSON)
 	testDecode(t, actualJSON, val)
@@ -0,0 +1,301 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cadence
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+)
+
+// Equal returns true if the given values are structurally equal.
+//
+// Arrays are equal if their elements are equal, in order.
+// Dictionaries are equal if they have the same keys with equal values,
+// independent of the order of their pairs.
+// Composites are equal if they have the same type ID and their fields are equal.
+// Types are compared by their IDs.
+func Equal(a, b Value) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	switch a := a.(type) {
+	case Optional:
+		b, ok := b.(Optional)
+		return ok && Equal(a.Value, b.Value)
+
+	case Array:
+		b, ok := b.(Array)
+		if !ok || len(a.Values) != len(b.Values) {
+			return false
+		}
+		for i, element := range a.Values {
+			if !Equal(element, b.Values[i]) {
+				return false
+			}
+		}
+		return true
+
+	case Dictionary:
+		b, ok := b.(Dictionary)
+		if !ok || len(a.Pairs) != len(b.Pairs) {
+			return false
+		}
+		for _, pair := range a.Pairs {
+			otherValue, ok := dictionaryValue(b, pair.Key)
+			if !ok || !Equal(pair.Value, otherValue) {
+				return false
+			}
+		}
+		return true
+
+	case Composite:
+		b, ok := b.(Composite)
+		if !ok ||
+			reflect.TypeOf(a) != reflect.TypeOf(b) ||
+			typeID(a.Type()) != typeID(b.Type()) {
+
+			return false
+		}
+
+		aFields := a.CompositeFields()
+		bFields := b.CompositeFields()
+
+		// Without type information, fields can only be compared by position
+
+		if aFields == nil || bFields == nil {
+			return valuesEqual(a.FieldValues(), b.FieldValues())
+		}
+
+		aValues := a.FieldsMappedByName()
+		bValues := b.FieldsMappedByName()
+		if len(aValues) != len(bValues) {
+			return false
+		}
+		for name, value := range aValues {
+			otherValue, ok := bValues[name]
+			if !ok || !Equal(value, otherValue) {
+				return false
+			}
+		}
+		return true
+
+	case TypeValue:
+		b, ok := b.(TypeValue)
+		return ok && typeID(a.StaticType) == typeID(b.StaticType)
+
+	case Capability:
+		b, ok := b.(Capability)
+		return ok &&
+			Equal(a.Path, b.Path) &&
+			a.Address == b.Address &&
+			a.ID == b.ID &&
+			typeID(a.BorrowType) == typeID(b.BorrowType)
+	}
+
+	if reflect.TypeOf(a) != reflect.TypeOf(b) {
+		return false
+	}
+
+	// Big integer based values may have different, but equal representations
+
+	if aBig, ok := a.ToGoValue().(*big.Int); ok {
+		bBig, ok := b.ToGoValue().(*big.Int)
+		if !ok || aBig == nil || bBig == nil {
+			return aBig == nil && bBig == nil
+		}
+		return aBig.Cmp(bBig) == 0
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
+func valuesEqual(a, b []Value) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i, value := range a {
+		if !Equal(value, b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func dictionaryValue(dictionary Dictionary, key Value) (Value, bool) {
+	for _, pair := range dictionary.Pairs {
+		if Equal(pair.Key, key) {
+			return pair.Value, true
+		}
+	}
+	return nil, false
+}
+
+func typeID(t Type) string {
+	if t == nil {
+		return ""
+	}
+	value := reflect.ValueOf(t)
+	if value.Kind() == reflect.Pointer && value.IsNil() {
+		return ""
+	}
+	return t.ID()
+}
+
+// FieldDelta is a difference between two values, reported by Diff.
+type FieldDelta struct {
+	// Path is the path to the differing value, relative to the compared values,
+	// e.g. `owner.balances["FLOW"]` or `items[2]`.
+	// The path is empty if the compared values themselves differ
+	Path string
+	// Old is the value in the first compared value,
+	// or nil if the value only exists in the second compared value
+	Old Value
+	// New is the value in the second compared value,
+	// or nil if the value only exists in the first compared value
+	New Value
+}
+
+// Diff returns the differences between the given values.
+//
+// Optionals, arrays, dictionaries, and composites of the same type are compared element-wise,
+// all other values are compared using Equal.
+// The result is empty if the values are equal.
+func Diff(a, b Value) []FieldDelta {
+	var deltas []FieldDelta
+	diff(a, b, "", &deltas)
+	return deltas
+}
+
+func diff(a, b Value, path string, deltas *[]FieldDelta) {
+	if Equal(a, b) {
+		return
+	}
+
+	report := func() {
+		*deltas = append(*deltas, FieldDelta{
+			Path: path,
+			Old:  a,
+			New:  b,
+		})
+	}
+
+	switch a := a.(type) {
+	case Optional:
+		b, ok := b.(Optional)
+		if !ok || a.Value == nil || b.Value == nil {
+			report()
+			return
+		}
+		diff(a.Value, b.Value, path, deltas)
+
+	case Array:
+		b, ok := b.(Array)
+		if !ok {
+			report()
+			return
+		}
+		diffArrays(a, b, path, deltas)
+
+	case Dictionary:
+		b, ok := b.(Dictionary)
+		if !ok {
+			report()
+			return
+		}
+		diffDictionaries(a, b, path, deltas)
+
+	case Composite:
+		b, ok := b.(Composite)
+		if !ok ||
+			reflect.TypeOf(a) != reflect.TypeOf(b) ||
+			typeID(a.Type()) != typeID(b.Type()) ||
+			a.CompositeFields() == nil ||
+			b.CompositeFields() == nil {
+
+			report()
+			return
+		}
+		diffComposites(a, b, path, deltas)
+
+	default:
+		report()
+	}
+}
+
+func diffArrays(a, b Array, path string, deltas *[]FieldDelta) {
+	length := len(a.Values)
+	if len(b.Values) > length {
+		length = len(b.Values)
+	}
+
+	for i := 0; i < length; i++ {
+		var aElement, bElement Value
+		if i < len(a.Values) {
+			aElement = a.Values[i]
+		}
+		if i < len(b.Values) {
+			bElement = b.Values[i]
+		}
+
+		diff(aElement, bElement, fmt.Sprintf("%s[%d]", path, i), deltas)
+	}
+}
+
+func diffDictionaries(a, b Dictionary, path string, deltas *[]FieldDelta) {
+	for _, pair := range a.Pairs {
+		bValue, _ := dictionaryValue(b, pair.Key)
+		diff(pair.Value, bValue, fmt.Sprintf("%s[%s]", path, pair.Key), deltas)
+	}
+
+	for _, pair := range b.Pairs {
+		if _, ok := dictionaryValue(a, pair.Key); ok {
+			continue
+		}
+		diff(nil, pair.Value, fmt.Sprintf("%s[%s]", path, pair.Key), deltas)
+	}
+}
+
+func diffComposites(a, b Composite, path string, deltas *[]FieldDelta) {
+	fieldPath := func(name string) string {
+		if path == "" {
+			return name
+		}
+		return path + "." + name
+	}
+
+	aValues := a.FieldsMappedByName()
+	bValues := b.FieldsMappedByName()
+
+	// Report the fields in declaration order
+
+	for _, field := range a.CompositeFields() {
+		name := field.Identifier
+		diff(aValues[name], bValues[name], fieldPath(name), deltas)
+	}
+
+	for _, field := range b.CompositeFields() {
+		name := field.Identifier
+		if _, ok := aValues[name]; ok {
+			continue
+		}
+		diff(nil, bValues[name], fieldPath(name), deltas)
+	}
+}
@@ -0,0 +1,266 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cadence
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEqual(t *testing.T) {
+
+	t.Parallel()
+
+	fooType := &StructType{
+		QualifiedIdentifier: "Foo",
+		Fields: []Field{
+			{Identifier: "a", Type: IntType{}},
+			{Identifier: "b", Type: NewOptionalType(StringType{})},
+		},
+	}
+
+	barType := &StructType{
+		QualifiedIdentifier: "Bar",
+		Fields:              fooType.Fields,
+	}
+
+	type testCase struct {
+		a, b  Value
+		equal bool
+	}
+
+	for name, test := range map[string]testCase{
+		"nil": {
+			a:     nil,
+			b:     nil,
+			equal: true,
+		},
+		"nil and value": {
+			a:     nil,
+			b:     NewInt(1),
+			equal: false,
+		},
+		"same integer": {
+			a:     NewInt(1),
+			b:     NewIntFromBig(big.NewInt(1)),
+			equal: true,
+		},
+		"different integer": {
+			a:     NewInt(1),
+			b:     NewInt(2),
+			equal: false,
+		},
+		"different kinds": {
+			a:     NewInt8(1),
+			b:     NewInt16(1),
+			equal: false,
+		},
+		"optional": {
+			a:     NewOptional(String("a")),
+			b:     NewOptional(String("a")),
+			equal: true,
+		},
+		"optional and nil optional": {
+			a:     NewOptional(String("a")),
+			b:     NewOptional(nil),
+			equal: false,
+		},
+		"array": {
+			a:     NewArray([]Value{NewInt(1), NewInt(2)}),
+			b:     NewArray([]Value{NewInt(1), NewInt(2)}),
+			equal: true,
+		},
+		"array with different order": {
+			a:     NewArray([]Value{NewInt(1), NewInt(2)}),
+			b:     NewArray([]Value{NewInt(2), NewInt(1)}),
+			equal: false,
+		},
+		"dictionary with different order": {
+			a: NewDictionary([]KeyValuePair{
+				{Key: String("a"), Value: NewInt(1)},
+				{Key: String("b"), Value: NewInt(2)},
+			}),
+			b: NewDictionary([]KeyValuePair{
+				{Key: String("b"), Value: NewInt(2)},
+				{Key: String("a"), Value: NewInt(1)},
+			}),
+			equal: true,
+		},
+		"dictionary with different value": {
+			a: NewDictionary([]KeyValuePair{
+				{Key: String("a"), Value: NewInt(1)},
+			}),
+			b: NewDictionary([]KeyValuePair{
+				{Key: String("a"), Value: NewInt(2)},
+			}),
+			equal: false,
+		},
+		"struct": {
+			a:     NewStruct([]Value{NewInt(1), NewOptional(nil)}).WithType(fooType),
+			b:     NewStruct([]Value{NewInt(1), NewOptional(nil)}).WithType(fooType),
+			equal: true,
+		},
+		"struct with different field": {
+			a:     NewStruct([]Value{NewInt(1), NewOptional(nil)}).WithType(fooType),
+			b:     NewStruct([]Value{NewInt(1), NewOptional(String("b"))}).WithType(fooType),
+			equal: false,
+		},
+		"struct with different type": {
+			a:     NewStruct([]Value{NewInt(1), NewOptional(nil)}).WithType(fooType),
+			b:     NewStruct([]Value{NewInt(1), NewOptional(nil)}).WithType(barType),
+			equal: false,
+		},
+		"struct and resource": {
+			a:     NewStruct([]Value{NewInt(1), NewOptional(nil)}).WithType(fooType),
+			b:     NewResource([]Value{NewInt(1), NewOptional(nil)}),
+			equal: false,
+		},
+		"type value": {
+			a:     NewTypeValue(&StructType{QualifiedIdentifier: "Foo"}),
+			b:     NewTypeValue(&StructType{QualifiedIdentifier: "Foo"}),
+			equal: true,
+		},
+		"path": {
+			a:     Path{Domain: "storage", Identifier: "foo"},
+			b:     Path{Domain: "public", Identifier: "foo"},
+			equal: false,
+		},
+	} {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+
+			t.Parallel()
+
+			assert.Equal(t, test.equal, Equal(test.a, test.b))
+			assert.Equal(t, test.equal, Equal(test.b, test.a))
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+
+	t.Parallel()
+
+	ownerType := &StructType{
+		QualifiedIdentifier: "Owner",
+		Fields: []Field{
+			{Identifier: "name", Type: StringType{}},
+			{Identifier: "balances", Type: NewDictionaryType(StringType{}, IntType{})},
+		},
+	}
+
+	eventType := &EventType{
+		QualifiedIdentifier: "Transferred",
+		Fields: []Field{
+			{Identifier: "owner", Type: ownerType},
+			{Identifier: "items", Type: NewVariableSizedArrayType(IntType{})},
+		},
+	}
+
+	newEvent := func(name string, balances []KeyValuePair, items ...Value) Event {
+		return NewEvent([]Value{
+			NewStruct([]Value{
+				String(name),
+				NewDictionary(balances),
+			}).WithType(ownerType),
+			NewArray(items),
+		}).WithType(eventType)
+	}
+
+	t.Run("equal", func(t *testing.T) {
+
+		t.Parallel()
+
+		a := newEvent("a", []KeyValuePair{{Key: String("FLOW"), Value: NewInt(1)}}, NewInt(1))
+		b := newEvent("a", []KeyValuePair{{Key: String("FLOW"), Value: NewInt(1)}}, NewInt(1))
+
+		assert.Empty(t, Diff(a, b))
+	})
+
+	t.Run("nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		a := newEvent(
+			"a",
+			[]KeyValuePair{
+				{Key: String("FLOW"), Value: NewInt(1)},
+				{Key: String("USDC"), Value: NewInt(2)},
+			},
+			NewInt(1),
+			NewInt(2),
+		)
+		b := newEvent(
+			"b",
+			[]KeyValuePair{
+				{Key: String("FLOW"), Value: NewInt(3)},
+				{Key: String("FUSD"), Value: NewInt(4)},
+			},
+			NewInt(1),
+		)
+
+		assert.Equal(t,
+			[]FieldDelta{
+				{Path: "owner.name", Old: String("a"), New: String("b")},
+				{Path: `owner.balances["FLOW"]`, Old: NewInt(1), New: NewInt(3)},
+				{Path: `owner.balances["USDC"]`, Old: NewInt(2), New: nil},
+				{Path: `owner.balances["FUSD"]`, Old: nil, New: NewInt(4)},
+				{Path: "items[1]", Old: NewInt(2), New: nil},
+			},
+			Diff(a, b),
+		)
+	})
+
+	t.Run("different kinds", func(t *testing.T) {
+
+		t.Parallel()
+
+		assert.Equal(t,
+			[]FieldDelta{
+				{Path: "", Old: NewInt(1), New: String("1")},
+			},
+			Diff(NewInt(1), String("1")),
+		)
+	})
+
+	t.Run("optional", func(t *testing.T) {
+
+		t.Parallel()
+
+		assert.Equal(t,
+			[]FieldDelta{
+				{Path: "[0]", Old: NewInt(1), New: NewInt(2)},
+			},
+			Diff(
+				NewOptional(NewArray([]Value{NewInt(1)})),
+				NewOptional(NewArray([]Value{NewInt(2)})),
+			),
+		)
+
+		assert.Equal(t,
+			[]FieldDelta{
+				{Path: "", Old: NewOptional(NewInt(1)), New: NewOptional(nil)},
+			},
+			Diff(NewOptional(NewInt(1)), NewOptional(nil)),
+		)
+	})
+}
@@ -0,0 +1,131 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package fuzz provides fuzz targets and corpus generators
+// for the parser, the checker, and the JSON-CDC encoding.
+//
+// The targets have the signature expected by go-fuzz,
+// and are also used by the native Go fuzz tests of this package, e.g.:
+//
+//	go test ./fuzz -fuzz=FuzzParseAndCheck
+//
+// The targets return 1 if the input is interesting, i.e. it is valid,
+// and 0 otherwise. Bugs, e.g. a failed round-trip, are reported by panicking.
+//
+package fuzz
+
+import (
+	"bytes"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/parser"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+// Location is the location of the programs which are checked by the fuzz targets
+//
+const Location = common.StringLocation("fuzz")
+
+// Parse is a fuzz target for the parser.
+//
+func Parse(data []byte) int {
+
+	if !utf8.Valid(data) {
+		return 0
+	}
+
+	_, err := parser.ParseProgram(string(data), nil)
+	if err != nil {
+		return 0
+	}
+
+	return 1
+}
+
+// ParseAndCheck is a fuzz target for the parser and the checker.
+//
+func ParseAndCheck(data []byte) int {
+
+	if !utf8.Valid(data) {
+		return 0
+	}
+
+	program, err := parser.ParseProgram(string(data), nil)
+	if err != nil {
+		return 0
+	}
+
+	checker, err := sema.NewChecker(
+		program,
+		Location,
+		nil,
+		false,
+		sema.WithAccessCheckMode(sema.AccessCheckModeNotSpecifiedUnrestricted),
+	)
+	if err != nil {
+		return 0
+	}
+
+	err = checker.Check()
+	if err != nil {
+		return 0
+	}
+
+	return 1
+}
+
+// JSONRoundTrip is a fuzz target for the JSON-CDC encoding.
+//
+// If the input can be decoded, the decoded value is encoded again,
+// and the result must decode and encode to exactly the same data.
+//
+func JSONRoundTrip(data []byte) int {
+
+	value, err := json.Decode(nil, data)
+	if err != nil {
+		return 0
+	}
+
+	encoded, err := json.Encode(value)
+	if err != nil {
+		return 0
+	}
+
+	decoded, err := json.Decode(nil, encoded)
+	if err != nil {
+		panic(fmt.Errorf("failed to decode encoded value %s: %w", encoded, err))
+	}
+
+	reencoded, err := json.Encode(decoded)
+	if err != nil {
+		panic(fmt.Errorf("failed to re-encode decoded value %s: %w", encoded, err))
+	}
+
+	if !bytes.Equal(encoded, reencoded) {
+		panic(fmt.Errorf(
+			"JSON-CDC round-trip mismatch:\n%s\n%s",
+			encoded,
+			reencoded,
+		))
+	}
+
+	return 1
+}
@@ -0,0 +1,117 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fuzz
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/parser"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+const corpusSeed = 42
+const corpusSize = 50
+
+func addProgramCorpus(f *testing.F) {
+	for _, program := range ProgramCorpus(corpusSeed, corpusSize) {
+		f.Add([]byte(program))
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	addProgramCorpus(f)
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		Parse(data)
+	})
+}
+
+func FuzzParseAndCheck(f *testing.F) {
+	addProgramCorpus(f)
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		ParseAndCheck(data)
+	})
+}
+
+func FuzzJSONRoundTrip(f *testing.F) {
+	corpus, err := JSONCorpus(corpusSeed, corpusSize)
+	require.NoError(f, err)
+
+	for _, data := range corpus {
+		f.Add(data)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		JSONRoundTrip(data)
+	})
+}
+
+func TestProgramGenerator(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("deterministic", func(t *testing.T) {
+
+		t.Parallel()
+
+		assert.Equal(t,
+			ProgramCorpus(corpusSeed, corpusSize),
+			ProgramCorpus(corpusSeed, corpusSize),
+		)
+	})
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		for _, code := range ProgramCorpus(corpusSeed, corpusSize) {
+
+			program, err := parser.ParseProgram(code, nil)
+			require.NoError(t, err, code)
+
+			checker, err := sema.NewChecker(
+				program,
+				Location,
+				nil,
+				false,
+				sema.WithAccessCheckMode(sema.AccessCheckModeNotSpecifiedUnrestricted),
+			)
+			require.NoError(t, err)
+
+			err = checker.Check()
+			require.NoError(t, err, code)
+		}
+	})
+}
+
+func TestJSONCorpus(t *testing.T) {
+
+	t.Parallel()
+
+	corpus, err := JSONCorpus(corpusSeed, corpusSize)
+	require.NoError(t, err)
+
+	for _, data := range corpus {
+		assert.Equal(t, 1, JSONRoundTrip(data), string(data))
+	}
+}
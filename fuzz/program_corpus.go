@@ -0,0 +1,37 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fuzz
+
+import (
+	"github.com/onflow/cadence/tools/fuzz/gen"
+)
+
+// ProgramCorpus returns the given number of random, well-typed programs,
+// generated from the given seed, which can be used as a seed corpus for the fuzz targets.
+//
+// See gen.Generator.
+//
+func ProgramCorpus(seed int64, count int) []string {
+	generator := gen.NewGenerator(seed, gen.DefaultConfig)
+	programs := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		programs = append(programs, generator.Program().Code)
+	}
+	return programs
+}
@@ -0,0 +1,159 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fuzz
+
+import (
+	"math/big"
+	"math/rand"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/encoding/json"
+)
+
+// ValueGenerator generates random Cadence values,
+// which can be encoded as a seed corpus for the JSON-CDC fuzz target.
+// Given the same seed, the generator produces the same values.
+//
+type ValueGenerator struct {
+	random *rand.Rand
+	// MaxDepth is the maximum nesting depth of container values
+	MaxDepth int
+	// MaxElements is the maximum number of elements of container values
+	MaxElements int
+}
+
+func NewValueGenerator(seed int64) *ValueGenerator {
+	return &ValueGenerator{
+		random:      rand.New(rand.NewSource(seed)),
+		MaxDepth:    3,
+		MaxElements: 4,
+	}
+}
+
+// Value generates a new random value
+//
+func (g *ValueGenerator) Value() cadence.Value {
+	return g.value(0)
+}
+
+// JSONCorpus returns the JSON-CDC encodings of the given number of random values,
+// generated from the given seed
+//
+func JSONCorpus(seed int64, count int) ([][]byte, error) {
+	generator := NewValueGenerator(seed)
+	corpus := make([][]byte, 0, count)
+	for i := 0; i < count; i++ {
+		encoded, err := json.Encode(generator.Value())
+		if err != nil {
+			return nil, err
+		}
+		corpus = append(corpus, encoded)
+	}
+	return corpus, nil
+}
+
+func (g *ValueGenerator) value(depth int) cadence.Value {
+	if depth < g.MaxDepth && g.random.Intn(3) == 0 {
+		return g.containerValue(depth + 1)
+	}
+	return g.simpleValue()
+}
+
+func (g *ValueGenerator) simpleValue() cadence.Value {
+	switch g.random.Intn(12) {
+	case 0:
+		return cadence.NewVoid()
+	case 1:
+		return cadence.NewBool(g.random.Intn(2) == 0)
+	case 2:
+		return cadence.String(g.string())
+	case 3:
+		return cadence.NewIntFromBig(g.bigInt())
+	case 4:
+		// The integer is non-negative, so the construction cannot fail
+		value, _ := cadence.NewUIntFromBig(new(big.Int).Abs(g.bigInt()))
+		return value
+	case 5:
+		return cadence.NewInt8(int8(g.random.Uint32()))
+	case 6:
+		return cadence.NewInt64(g.random.Int63() - g.random.Int63())
+	case 7:
+		return cadence.NewUInt64(g.random.Uint64())
+	case 8:
+		return cadence.Fix64(g.random.Int63() - g.random.Int63())
+	case 9:
+		return cadence.UFix64(g.random.Uint64())
+	case 10:
+		var address cadence.Address
+		g.random.Read(address[:])
+		return address
+	default:
+		return cadence.NewOptional(nil)
+	}
+}
+
+func (g *ValueGenerator) containerValue(depth int) cadence.Value {
+	count := g.random.Intn(g.MaxElements + 1)
+
+	switch g.random.Intn(3) {
+	case 0:
+		return cadence.NewOptional(g.value(depth))
+
+	case 1:
+		values := make([]cadence.Value, 0, count)
+		for i := 0; i < count; i++ {
+			values = append(values, g.value(depth))
+		}
+		return cadence.NewArray(values)
+
+	default:
+		pairs := make([]cadence.KeyValuePair, 0, count)
+		for i := 0; i < count; i++ {
+			pairs = append(pairs, cadence.KeyValuePair{
+				Key:   cadence.String(g.string()),
+				Value: g.value(depth),
+			})
+		}
+		return cadence.NewDictionary(pairs)
+	}
+}
+
+func (g *ValueGenerator) string() string {
+	length := g.random.Intn(8)
+	runes := make([]rune, 0, length)
+	for i := 0; i < length; i++ {
+		if g.random.Intn(4) == 0 {
+			// Non-ASCII, but valid (non-surrogate) code point
+			runes = append(runes, rune(0x80+g.random.Intn(0xD800-0x80)))
+		} else {
+			runes = append(runes, rune('a'+g.random.Intn(26)))
+		}
+	}
+	return string(runes)
+}
+
+func (g *ValueGenerator) bigInt() *big.Int {
+	bytes := make([]byte, g.random.Intn(24))
+	g.random.Read(bytes)
+	result := new(big.Int).SetBytes(bytes)
+	if g.random.Intn(2) == 0 {
+		result.Neg(result)
+	}
+	return result
+}
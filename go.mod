@@ -9,6 +9,7 @@ require (
 	github.com/cheekybits/genny v1.0.0
 	github.com/fxamacker/cbor/v2 v2.4.1-0.20220515183430-ad2eae63303f
 	github.com/go-test/deep v1.0.5
+	github.com/google/pprof v0.0.0-20211214055906-6f57359322fd
 	github.com/leanovate/gopter v0.2.9
 	github.com/logrusorgru/aurora v0.0.0-20200102142835-e9ef32dff381
 	github.com/onflow/atree v0.4.0
@@ -689,6 +689,54 @@ func TestRuntimeHashAlgorithm(t *testing.T) {
 	require.Nil(t, optionalValue.Value)
 }
 
+func TestRuntimeHashAlgorithmAllCases(t *testing.T) {
+
+	t.Parallel()
+
+	rt := newTestInterpreterRuntime()
+
+	script := []byte(`
+        pub fun main(): [HashAlgorithm] {
+            assert(HashAlgorithm.lookup(rawValue: 3) == HashAlgorithm.SHA3_256)
+            assert(HashAlgorithm.lookup(rawValue: 100) == nil)
+            return HashAlgorithm.allCases
+        }
+    `)
+
+	runtimeInterface := &testRuntimeInterface{
+		storage: newTestLedger(nil, nil),
+	}
+
+	nextTransactionLocation := newTransactionLocationGenerator()
+
+	result, err := rt.ExecuteScript(
+		Script{
+			Source: script,
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	require.IsType(t, cadence.Array{}, result)
+	array := result.(cadence.Array)
+
+	require.Len(t, array.Values, len(sema.HashAlgorithms))
+
+	for i, algo := range sema.HashAlgorithms {
+		require.IsType(t, cadence.Enum{}, array.Values[i])
+		enumValue := array.Values[i].(cadence.Enum)
+
+		require.Len(t, enumValue.Fields, 1)
+		assert.Equal(t,
+			cadence.NewUInt8(algo.RawValue()),
+			enumValue.Fields[0],
+		)
+	}
+}
+
 func TestRuntimeSignatureAlgorithm(t *testing.T) {
 
 	t.Parallel()
@@ -1887,6 +1935,304 @@ func TestGetAuthAccount(t *testing.T) {
 	})
 }
 
+func TestRuntimeAuthAccountInbox(t *testing.T) {
+
+	t.Parallel()
+
+	providerAddress := common.MustBytesToAddress([]byte{0x1})
+	recipientAddress := common.MustBytesToAddress([]byte{0x2})
+
+	const publishTx = `
+      transaction {
+          prepare(signer: AuthAccount) {
+              signer.save(42, to: /storage/answer)
+              let cap = signer.link<&Int>(/private/answer, target: /storage/answer)!
+              signer.inbox.publish(cap, name: "answer", recipient: 0x2)
+          }
+      }
+    `
+
+	type testAccountInbox struct {
+		events             []cadence.Event
+		executeTransaction func(signer common.Address, code string) error
+	}
+
+	newTestAccountInbox := func() *testAccountInbox {
+		inbox := &testAccountInbox{}
+
+		rt := newTestInterpreterRuntime()
+
+		var signerAddress common.Address
+
+		runtimeInterface := &testRuntimeInterface{
+			storage: newTestLedger(nil, nil),
+			getSigningAccounts: func() ([]Address, error) {
+				return []Address{signerAddress}, nil
+			},
+			emitEvent: func(event cadence.Event) error {
+				inbox.events = append(inbox.events, event)
+				return nil
+			},
+		}
+
+		nextTransactionLocation := newTransactionLocationGenerator()
+
+		inbox.executeTransaction = func(signer common.Address, code string) error {
+			signerAddress = signer
+			return rt.ExecuteTransaction(
+				Script{
+					Source: []byte(code),
+				},
+				Context{
+					Interface: runtimeInterface,
+					Location:  nextTransactionLocation(),
+				},
+			)
+		}
+
+		return inbox
+	}
+
+	eventTypeIDs := func(events []cadence.Event) []string {
+		typeIDs := make([]string, 0, len(events))
+		for _, event := range events {
+			typeIDs = append(typeIDs, event.EventType.ID())
+		}
+		return typeIDs
+	}
+
+	t.Run("publish and claim", func(t *testing.T) {
+
+		t.Parallel()
+
+		inbox := newTestAccountInbox()
+
+		err := inbox.executeTransaction(providerAddress, publishTx)
+		require.NoError(t, err)
+
+		err = inbox.executeTransaction(
+			recipientAddress,
+			`
+              transaction {
+                  prepare(signer: AuthAccount) {
+                      let cap = signer.inbox.claim<&Int>("answer", provider: 0x1)!
+                      assert(cap.check())
+                      assert(cap.address == 0x1)
+
+                      // The capability can only be claimed once
+                      assert(signer.inbox.claim<&Int>("answer", provider: 0x1) == nil)
+                  }
+              }
+            `,
+		)
+		require.NoError(t, err)
+
+		require.Equal(t,
+			[]string{
+				"flow.InboxValuePublished",
+				"flow.InboxValueClaimed",
+			},
+			eventTypeIDs(inbox.events),
+		)
+
+		publishedEvent := inbox.events[0]
+		require.Equal(t,
+			[]cadence.Value{
+				cadence.Address(providerAddress),
+				cadence.Address(recipientAddress),
+				cadence.String("answer"),
+				cadence.TypeValue{
+					StaticType: cadence.CapabilityType{
+						BorrowType: cadence.ReferenceType{
+							Type: cadence.IntType{},
+						},
+					},
+				},
+			},
+			publishedEvent.Fields,
+		)
+
+		claimedEvent := inbox.events[1]
+		require.Equal(t,
+			[]cadence.Value{
+				cadence.Address(providerAddress),
+				cadence.Address(recipientAddress),
+				cadence.String("answer"),
+			},
+			claimedEvent.Fields,
+		)
+	})
+
+	t.Run("claim by other account", func(t *testing.T) {
+
+		t.Parallel()
+
+		inbox := newTestAccountInbox()
+
+		err := inbox.executeTransaction(providerAddress, publishTx)
+		require.NoError(t, err)
+
+		err = inbox.executeTransaction(
+			common.MustBytesToAddress([]byte{0x3}),
+			`
+              transaction {
+                  prepare(signer: AuthAccount) {
+                      assert(signer.inbox.claim<&Int>("answer", provider: 0x1) == nil)
+                  }
+              }
+            `,
+		)
+		require.NoError(t, err)
+
+		// The capability is still available to the recipient
+
+		err = inbox.executeTransaction(
+			recipientAddress,
+			`
+              transaction {
+                  prepare(signer: AuthAccount) {
+                      assert(signer.inbox.claim<&Int>("answer", provider: 0x1) != nil)
+                  }
+              }
+            `,
+		)
+		require.NoError(t, err)
+
+		require.Equal(t,
+			[]string{
+				"flow.InboxValuePublished",
+				"flow.InboxValueClaimed",
+			},
+			eventTypeIDs(inbox.events),
+		)
+	})
+
+	t.Run("unpublish", func(t *testing.T) {
+
+		t.Parallel()
+
+		inbox := newTestAccountInbox()
+
+		err := inbox.executeTransaction(providerAddress, publishTx)
+		require.NoError(t, err)
+
+		err = inbox.executeTransaction(
+			providerAddress,
+			`
+              transaction {
+                  prepare(signer: AuthAccount) {
+                      let cap = signer.inbox.unpublish<&Int>("answer")!
+                      assert(cap.check())
+
+                      assert(signer.inbox.unpublish<&Int>("answer") == nil)
+                  }
+              }
+            `,
+		)
+		require.NoError(t, err)
+
+		err = inbox.executeTransaction(
+			recipientAddress,
+			`
+              transaction {
+                  prepare(signer: AuthAccount) {
+                      assert(signer.inbox.claim<&Int>("answer", provider: 0x1) == nil)
+                  }
+              }
+            `,
+		)
+		require.NoError(t, err)
+
+		require.Equal(t,
+			[]string{
+				"flow.InboxValuePublished",
+				"flow.InboxValueUnpublished",
+			},
+			eventTypeIDs(inbox.events),
+		)
+
+		unpublishedEvent := inbox.events[1]
+		require.Equal(t,
+			[]cadence.Value{
+				cadence.Address(providerAddress),
+				cadence.String("answer"),
+			},
+			unpublishedEvent.Fields,
+		)
+	})
+
+	t.Run("claim with invalid type", func(t *testing.T) {
+
+		t.Parallel()
+
+		inbox := newTestAccountInbox()
+
+		err := inbox.executeTransaction(providerAddress, publishTx)
+		require.NoError(t, err)
+
+		err = inbox.executeTransaction(
+			recipientAddress,
+			`
+              transaction {
+                  prepare(signer: AuthAccount) {
+                      signer.inbox.claim<&String>("answer", provider: 0x1)
+                  }
+              }
+            `,
+		)
+		require.ErrorAs(t, err, &interpreter.ForceCastTypeMismatchError{})
+
+		// The failed claim leaves the capability in the inbox
+
+		err = inbox.executeTransaction(
+			recipientAddress,
+			`
+              transaction {
+                  prepare(signer: AuthAccount) {
+                      assert(signer.inbox.claim<&Int>("answer", provider: 0x1) != nil)
+                  }
+              }
+            `,
+		)
+		require.NoError(t, err)
+	})
+
+	t.Run("publish overwrites", func(t *testing.T) {
+
+		t.Parallel()
+
+		inbox := newTestAccountInbox()
+
+		err := inbox.executeTransaction(providerAddress, publishTx)
+		require.NoError(t, err)
+
+		err = inbox.executeTransaction(
+			providerAddress,
+			`
+              transaction {
+                  prepare(signer: AuthAccount) {
+                      let cap = signer.getCapability<&Int>(/private/answer)
+                      signer.inbox.publish(cap, name: "answer", recipient: 0x3)
+                  }
+              }
+            `,
+		)
+		require.NoError(t, err)
+
+		err = inbox.executeTransaction(
+			recipientAddress,
+			`
+              transaction {
+                  prepare(signer: AuthAccount) {
+                      assert(signer.inbox.claim<&Int>("answer", provider: 0x1) == nil)
+                  }
+              }
+            `,
+		)
+		require.NoError(t, err)
+	})
+}
+
 type fakeError struct{}
 
 func (fakeError) Error() string {
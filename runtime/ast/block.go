@@ -29,6 +29,7 @@ import (
 type Block struct {
 	Statements []Statement
 	Range
+	Node
 }
 
 var _ Element = &Block{}
@@ -112,6 +113,7 @@ type FunctionBlock struct {
 	Block          *Block
 	PreConditions  *Conditions `json:",omitempty"`
 	PostConditions *Conditions `json:",omitempty"`
+	Node
 }
 
 var _ Element = &FunctionBlock{}
@@ -30,15 +30,22 @@ import (
 // CompositeDeclaration
 
 // NOTE: For events, only an empty initializer is declared
+//
+// NOTE: For attachments, the base type is the type the attachment is declared for
 
 type CompositeDeclaration struct {
 	Access        Access
 	CompositeKind common.CompositeKind
 	Identifier    Identifier
-	Conformances  []*NominalType
-	Members       *Members
-	DocString     string
+	// TypeParameters are the type parameters of a generic structure or resource,
+	// e.g. `T` in `struct Box<T: AnyStruct>`
+	TypeParameters []*TypeParameter `json:",omitempty"`
+	Conformances   []*NominalType
+	BaseType       *NominalType `json:",omitempty"`
+	Members        *Members
+	DocString      string
 	Range
+	Node
 }
 
 var _ Element = &CompositeDeclaration{}
@@ -124,11 +131,16 @@ func (d *CompositeDeclaration) Doc() prettier.Doc {
 		return d.EventDoc()
 	}
 
+	if d.CompositeKind == common.CompositeKindAttachment {
+		return d.AttachmentDoc()
+	}
+
 	return CompositeDocument(
 		d.Access,
 		d.CompositeKind,
 		false,
 		d.Identifier.Identifier,
+		d.TypeParameters,
 		d.Conformances,
 		d.Members,
 	)
@@ -163,6 +175,41 @@ func (d *CompositeDeclaration) EventDoc() prettier.Doc {
 	return append(doc, paramsDoc)
 }
 
+var attachmentForKeywordDoc = prettier.Text(" for ")
+
+func (d *CompositeDeclaration) AttachmentDoc() prettier.Doc {
+	var doc prettier.Concat
+
+	if d.Access != AccessNotSpecified {
+		doc = append(
+			doc,
+			prettier.Text(d.Access.Keyword()),
+			prettier.Space,
+		)
+	}
+
+	doc = append(
+		doc,
+		prettier.Text(d.CompositeKind.Keyword()),
+		prettier.Space,
+		prettier.Text(d.Identifier.Identifier),
+	)
+
+	if d.BaseType != nil {
+		doc = append(
+			doc,
+			attachmentForKeywordDoc,
+			d.BaseType.Doc(),
+		)
+	}
+
+	return append(
+		doc,
+		prettier.Space,
+		d.Members.Doc(),
+	)
+}
+
 func (d *CompositeDeclaration) String() string {
 	return Prettier(d)
 }
@@ -179,6 +226,7 @@ func CompositeDocument(
 	kind common.CompositeKind,
 	isInterface bool,
 	identifier string,
+	typeParameters []*TypeParameter,
 	conformances []*NominalType,
 	members *Members,
 ) prettier.Doc {
@@ -211,6 +259,13 @@ func CompositeDocument(
 		prettier.Text(identifier),
 	)
 
+	if len(typeParameters) > 0 {
+		doc = append(
+			doc,
+			TypeParametersDoc(typeParameters),
+		)
+	}
+
 	if len(conformances) > 0 {
 
 		conformancesDoc := prettier.Concat{
@@ -271,6 +326,7 @@ type FieldDeclaration struct {
 	TypeAnnotation *TypeAnnotation
 	DocString      string
 	Range
+	Node
 }
 
 var _ Element = &FieldDeclaration{}
@@ -418,6 +474,7 @@ type EnumCaseDeclaration struct {
 	Identifier Identifier
 	DocString  string
 	StartPos   Position `json:"-"`
+	Node
 }
 
 var _ Element = &EnumCaseDeclaration{}
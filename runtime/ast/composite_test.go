@@ -719,6 +719,31 @@ func TestCompositeDeclaration_String(t *testing.T) {
 
 	t.Parallel()
 
+	t.Run("attachment", func(t *testing.T) {
+
+		t.Parallel()
+
+		decl := &CompositeDeclaration{
+			Access:        AccessPublic,
+			CompositeKind: common.CompositeKindAttachment,
+			Identifier: Identifier{
+				Identifier: "AB",
+			},
+			BaseType: &NominalType{
+				Identifier: Identifier{
+					Identifier: "CD",
+				},
+			},
+			Members: NewMembers(nil, []Declaration{}),
+		}
+
+		require.Equal(
+			t,
+			"pub attachment AB for CD {}",
+			decl.String(),
+		)
+	})
+
 	t.Run("no members, conformances", func(t *testing.T) {
 
 		t.Parallel()
@@ -836,6 +861,55 @@ func TestCompositeDeclaration_String(t *testing.T) {
 		)
 	})
 
+	t.Run("event, indexed", func(t *testing.T) {
+
+		t.Parallel()
+
+		decl := &CompositeDeclaration{
+			Access:        AccessPublic,
+			CompositeKind: common.CompositeKindEvent,
+			Identifier: Identifier{
+				Identifier: "AB",
+			},
+			Members: NewMembers(nil, []Declaration{
+				&SpecialFunctionDeclaration{
+					Kind: common.DeclarationKindInitializer,
+					FunctionDeclaration: &FunctionDeclaration{
+						ParameterList: &ParameterList{
+							Parameters: []*Parameter{
+								{
+									Indexed:    true,
+									Identifier: Identifier{Identifier: "e"},
+									TypeAnnotation: &TypeAnnotation{
+										Type: &NominalType{
+											Identifier: Identifier{Identifier: "E"},
+										},
+									},
+								},
+								{
+									Indexed:    true,
+									Label:      "f",
+									Identifier: Identifier{Identifier: "g"},
+									TypeAnnotation: &TypeAnnotation{
+										Type: &NominalType{
+											Identifier: Identifier{Identifier: "G"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}),
+		}
+
+		require.Equal(
+			t,
+			"pub event AB(indexed e: E, indexed f g: G)",
+			decl.String(),
+		)
+	})
+
 	t.Run("enum", func(t *testing.T) {
 
 		t.Parallel()
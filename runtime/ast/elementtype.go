@@ -40,6 +40,7 @@ const (
 	ElementTypePragmaDeclaration
 	ElementTypeImportDeclaration
 	ElementTypeTransactionDeclaration
+	ElementTypeTypeAliasDeclaration
 
 	// Statements
 
@@ -51,7 +52,9 @@ const (
 	ElementTypeWhileStatement
 	ElementTypeForStatement
 	ElementTypeEmitStatement
+	ElementTypeRemoveStatement
 	ElementTypeVariableDeclaration
+	ElementTypeTupleVariableDeclaration
 	ElementTypeAssignmentStatement
 	ElementTypeSwapStatement
 	ElementTypeExpressionStatement
@@ -79,4 +82,8 @@ const (
 	ElementTypeReferenceExpression
 	ElementTypeForceExpression
 	ElementTypePathExpression
+	ElementTypeAttachExpression
+	ElementTypeTryExpression
+	ElementTypeStringTemplateExpression
+	ElementTypeTupleExpression
 )
@@ -21,44 +21,51 @@ func _() {
 	_ = x[ElementTypePragmaDeclaration-10]
 	_ = x[ElementTypeImportDeclaration-11]
 	_ = x[ElementTypeTransactionDeclaration-12]
-	_ = x[ElementTypeReturnStatement-13]
-	_ = x[ElementTypeBreakStatement-14]
-	_ = x[ElementTypeContinueStatement-15]
-	_ = x[ElementTypeIfStatement-16]
-	_ = x[ElementTypeSwitchStatement-17]
-	_ = x[ElementTypeWhileStatement-18]
-	_ = x[ElementTypeForStatement-19]
-	_ = x[ElementTypeEmitStatement-20]
-	_ = x[ElementTypeVariableDeclaration-21]
-	_ = x[ElementTypeAssignmentStatement-22]
-	_ = x[ElementTypeSwapStatement-23]
-	_ = x[ElementTypeExpressionStatement-24]
-	_ = x[ElementTypeBoolExpression-25]
-	_ = x[ElementTypeNilExpression-26]
-	_ = x[ElementTypeIntegerExpression-27]
-	_ = x[ElementTypeFixedPointExpression-28]
-	_ = x[ElementTypeArrayExpression-29]
-	_ = x[ElementTypeDictionaryExpression-30]
-	_ = x[ElementTypeIdentifierExpression-31]
-	_ = x[ElementTypeInvocationExpression-32]
-	_ = x[ElementTypeMemberExpression-33]
-	_ = x[ElementTypeIndexExpression-34]
-	_ = x[ElementTypeConditionalExpression-35]
-	_ = x[ElementTypeUnaryExpression-36]
-	_ = x[ElementTypeBinaryExpression-37]
-	_ = x[ElementTypeFunctionExpression-38]
-	_ = x[ElementTypeStringExpression-39]
-	_ = x[ElementTypeCastingExpression-40]
-	_ = x[ElementTypeCreateExpression-41]
-	_ = x[ElementTypeDestroyExpression-42]
-	_ = x[ElementTypeReferenceExpression-43]
-	_ = x[ElementTypeForceExpression-44]
-	_ = x[ElementTypePathExpression-45]
+	_ = x[ElementTypeTypeAliasDeclaration-13]
+	_ = x[ElementTypeReturnStatement-14]
+	_ = x[ElementTypeBreakStatement-15]
+	_ = x[ElementTypeContinueStatement-16]
+	_ = x[ElementTypeIfStatement-17]
+	_ = x[ElementTypeSwitchStatement-18]
+	_ = x[ElementTypeWhileStatement-19]
+	_ = x[ElementTypeForStatement-20]
+	_ = x[ElementTypeEmitStatement-21]
+	_ = x[ElementTypeRemoveStatement-22]
+	_ = x[ElementTypeVariableDeclaration-23]
+	_ = x[ElementTypeTupleVariableDeclaration-24]
+	_ = x[ElementTypeAssignmentStatement-25]
+	_ = x[ElementTypeSwapStatement-26]
+	_ = x[ElementTypeExpressionStatement-27]
+	_ = x[ElementTypeBoolExpression-28]
+	_ = x[ElementTypeNilExpression-29]
+	_ = x[ElementTypeIntegerExpression-30]
+	_ = x[ElementTypeFixedPointExpression-31]
+	_ = x[ElementTypeArrayExpression-32]
+	_ = x[ElementTypeDictionaryExpression-33]
+	_ = x[ElementTypeIdentifierExpression-34]
+	_ = x[ElementTypeInvocationExpression-35]
+	_ = x[ElementTypeMemberExpression-36]
+	_ = x[ElementTypeIndexExpression-37]
+	_ = x[ElementTypeConditionalExpression-38]
+	_ = x[ElementTypeUnaryExpression-39]
+	_ = x[ElementTypeBinaryExpression-40]
+	_ = x[ElementTypeFunctionExpression-41]
+	_ = x[ElementTypeStringExpression-42]
+	_ = x[ElementTypeCastingExpression-43]
+	_ = x[ElementTypeCreateExpression-44]
+	_ = x[ElementTypeDestroyExpression-45]
+	_ = x[ElementTypeReferenceExpression-46]
+	_ = x[ElementTypeForceExpression-47]
+	_ = x[ElementTypePathExpression-48]
+	_ = x[ElementTypeAttachExpression-49]
+	_ = x[ElementTypeTryExpression-50]
+	_ = x[ElementTypeStringTemplateExpression-51]
+	_ = x[ElementTypeTupleExpression-52]
 }
 
-const _ElementType_name = "ElementTypeUnknownElementTypeProgramElementTypeBlockElementTypeFunctionBlockElementTypeFunctionDeclarationElementTypeSpecialFunctionDeclarationElementTypeCompositeDeclarationElementTypeInterfaceDeclarationElementTypeFieldDeclarationElementTypeEnumCaseDeclarationElementTypePragmaDeclarationElementTypeImportDeclarationElementTypeTransactionDeclarationElementTypeReturnStatementElementTypeBreakStatementElementTypeContinueStatementElementTypeIfStatementElementTypeSwitchStatementElementTypeWhileStatementElementTypeForStatementElementTypeEmitStatementElementTypeVariableDeclarationElementTypeAssignmentStatementElementTypeSwapStatementElementTypeExpressionStatementElementTypeBoolExpressionElementTypeNilExpressionElementTypeIntegerExpressionElementTypeFixedPointExpressionElementTypeArrayExpressionElementTypeDictionaryExpressionElementTypeIdentifierExpressionElementTypeInvocationExpressionElementTypeMemberExpressionElementTypeIndexExpressionElementTypeConditionalExpressionElementTypeUnaryExpressionElementTypeBinaryExpressionElementTypeFunctionExpressionElementTypeStringExpressionElementTypeCastingExpressionElementTypeCreateExpressionElementTypeDestroyExpressionElementTypeReferenceExpressionElementTypeForceExpressionElementTypePathExpression"
+const _ElementType_name = "ElementTypeUnknownElementTypeProgramElementTypeBlockElementTypeFunctionBlockElementTypeFunctionDeclarationElementTypeSpecialFunctionDeclarationElementTypeCompositeDeclarationElementTypeInterfaceDeclarationElementTypeFieldDeclarationElementTypeEnumCaseDeclarationElementTypePragmaDeclarationElementTypeImportDeclarationElementTypeTransactionDeclarationElementTypeTypeAliasDeclarationElementTypeReturnStatementElementTypeBreakStatementElementTypeContinueStatementElementTypeIfStatementElementTypeSwitchStatementElementTypeWhileStatementElementTypeForStatementElementTypeEmitStatementElementTypeRemoveStatementElementTypeVariableDeclarationElementTypeTupleVariableDeclarationElementTypeAssignmentStatementElementTypeSwapStatementElementTypeExpressionStatementElementTypeBoolExpressionElementTypeNilExpressionElementTypeIntegerExpressionElementTypeFixedPointExpressionElementTypeArrayExpressionElementTypeDictionaryExpressionElementTypeIdentifierExpressionElementTypeInvocationExpressionElementTypeMemberExpressionElementTypeIndexExpressionElementTypeConditionalExpressionElementTypeUnaryExpressionElementTypeBinaryExpressionElementTypeFunctionExpressionElementTypeStringExpressionElementTypeCastingExpressionElementTypeCreateExpressionElementTypeDestroyExpressionElementTypeReferenceExpressionElementTypeForceExpressionElementTypePathExpressionElementTypeAttachExpressionElementTypeTryExpressionElementTypeStringTemplateExpressionElementTypeTupleExpression"
 
-var _ElementType_index = [...]uint16{0, 18, 36, 52, 76, 106, 143, 174, 205, 232, 262, 290, 318, 351, 377, 402, 430, 452, 478, 503, 526, 550, 580, 610, 634, 664, 689, 713, 741, 772, 798, 829, 860, 891, 918, 944, 976, 1002, 1029, 1058, 1085, 1113, 1140, 1168, 1198, 1224, 1249}
+var _ElementType_index = [...]uint16{0, 18, 36, 52, 76, 106, 143, 174, 205, 232, 262, 290, 318, 351, 382, 408, 433, 461, 483, 509, 534, 557, 581, 607, 637, 672, 702, 726, 756, 781, 805, 833, 864, 890, 921, 952, 983, 1010, 1036, 1068, 1094, 1121, 1150, 1177, 1205, 1232, 1260, 1290, 1316, 1341, 1368, 1392, 1427, 1453}
 
 func (i ElementType) String() string {
 	if i >= ElementType(len(_ElementType_index)-1) {
@@ -48,6 +48,7 @@ type Expression interface {
 type BoolExpression struct {
 	Value bool
 	Range
+	Node
 }
 
 var _ Element = &BoolExpression{}
@@ -115,6 +116,7 @@ func (*BoolExpression) precedence() precedence {
 
 type NilExpression struct {
 	Pos Position `json:"-"`
+	Node
 }
 
 var _ Element = &NilExpression{}
@@ -187,6 +189,7 @@ func (*NilExpression) precedence() precedence {
 type StringExpression struct {
 	Value string
 	Range
+	Node
 }
 
 var _ Expression = &StringExpression{}
@@ -245,6 +248,120 @@ func (*StringExpression) precedence() precedence {
 	return precedenceLiteral
 }
 
+// StringTemplateExpression
+
+// StringTemplateExpression is a string literal with interpolated expressions,
+// e.g. `"balance: \(vault.balance)"`.
+//
+// Values are the literal parts of the template, in order,
+// and are interleaved with the interpolated expressions,
+// i.e. there is always one more value than there are expressions.
+//
+type StringTemplateExpression struct {
+	Values      []string
+	Expressions []Expression
+	Range
+	Node
+}
+
+var _ Element = &StringTemplateExpression{}
+var _ Expression = &StringTemplateExpression{}
+
+func NewStringTemplateExpression(
+	gauge common.MemoryGauge,
+	values []string,
+	expressions []Expression,
+	exprRange Range,
+) *StringTemplateExpression {
+	common.UseMemory(gauge, common.StringTemplateExpressionMemoryUsage)
+	return &StringTemplateExpression{
+		Values:      values,
+		Expressions: expressions,
+		Range:       exprRange,
+	}
+}
+
+func (*StringTemplateExpression) ElementType() ElementType {
+	return ElementTypeStringTemplateExpression
+}
+
+func (*StringTemplateExpression) isExpression() {}
+
+func (*StringTemplateExpression) isIfStatementTest() {}
+
+func (e *StringTemplateExpression) Accept(visitor Visitor) Repr {
+	return e.AcceptExp(visitor)
+}
+
+func (e *StringTemplateExpression) Walk(walkChild func(Element)) {
+	walkExpressions(walkChild, e.Expressions)
+}
+
+func (e *StringTemplateExpression) AcceptExp(visitor ExpressionVisitor) Repr {
+	return visitor.VisitStringTemplateExpression(e)
+}
+
+func (e *StringTemplateExpression) String() string {
+	return Prettier(e)
+}
+
+const stringTemplateExpressionInterpolationStart = `\(`
+const stringTemplateExpressionInterpolationEnd = `)`
+
+func (e *StringTemplateExpression) Doc() prettier.Doc {
+
+	// Quote each literal part, without the surrounding quotes,
+	// and interleave the parts with the interpolated expressions
+
+	quoteValue := func(value string) string {
+		quoted := QuoteString(value)
+		return quoted[1 : len(quoted)-1]
+	}
+
+	doc := make(prettier.Concat, 0, len(e.Values)+len(e.Expressions))
+
+	for i, value := range e.Values {
+		var literal strings.Builder
+
+		if i == 0 {
+			literal.WriteByte('"')
+		} else {
+			literal.WriteString(stringTemplateExpressionInterpolationEnd)
+		}
+
+		literal.WriteString(quoteValue(value))
+
+		if i < len(e.Expressions) {
+			literal.WriteString(stringTemplateExpressionInterpolationStart)
+		} else {
+			literal.WriteByte('"')
+		}
+
+		doc = append(doc, prettier.Text(literal.String()))
+
+		if i < len(e.Expressions) {
+			doc = append(doc, e.Expressions[i].Doc())
+		}
+	}
+
+	return doc
+}
+
+func (e *StringTemplateExpression) MarshalJSON() ([]byte, error) {
+	type Alias StringTemplateExpression
+	return json.Marshal(&struct {
+		Type string
+		*Alias
+	}{
+		Type:  "StringTemplateExpression",
+		Alias: (*Alias)(e),
+	})
+}
+
+func (*StringTemplateExpression) precedence() precedence {
+	return precedenceLiteral
+}
+
 // IntegerExpression
 
 type IntegerExpression struct {
@@ -252,6 +369,7 @@ type IntegerExpression struct {
 	Value           *big.Int `json:"-"`
 	Base            int
 	Range
+	Node
 }
 
 var _ Element = &IntegerExpression{}
@@ -332,6 +450,7 @@ type FixedPointExpression struct {
 	Fractional      *big.Int `json:"-"`
 	Scale           uint
 	Range
+	Node
 }
 
 var _ Element = &FixedPointExpression{}
@@ -429,6 +548,7 @@ func (*FixedPointExpression) precedence() precedence {
 type ArrayExpression struct {
 	Values []Expression
 	Range
+	Node
 }
 
 var _ Element = &ArrayExpression{}
@@ -507,11 +627,94 @@ func (*ArrayExpression) precedence() precedence {
 	return precedenceLiteral
 }
 
+// TupleExpression
+
+// TupleExpression is a parenthesized, comma-separated list
+// of at least two expressions, e.g. `(1, "two")`
+//
+type TupleExpression struct {
+	Elements []Expression
+	Range
+	Node
+}
+
+var _ Element = &TupleExpression{}
+var _ Expression = &TupleExpression{}
+
+func NewTupleExpression(
+	gauge common.MemoryGauge,
+	elements []Expression,
+	tokenRange Range,
+) *TupleExpression {
+	common.UseMemory(gauge, common.TupleExpressionMemoryUsage)
+
+	return &TupleExpression{
+		Elements: elements,
+		Range:    tokenRange,
+	}
+}
+
+func (*TupleExpression) ElementType() ElementType {
+	return ElementTypeTupleExpression
+}
+
+func (*TupleExpression) isExpression() {}
+
+func (*TupleExpression) isIfStatementTest() {}
+
+func (e *TupleExpression) Accept(visitor Visitor) Repr {
+	return e.AcceptExp(visitor)
+}
+
+func (e *TupleExpression) Walk(walkChild func(Element)) {
+	walkExpressions(walkChild, e.Elements)
+}
+
+func (e *TupleExpression) AcceptExp(visitor ExpressionVisitor) Repr {
+	return visitor.VisitTupleExpression(e)
+}
+
+func (e *TupleExpression) String() string {
+	return Prettier(e)
+}
+
+var tupleExpressionSeparatorDoc prettier.Doc = prettier.Concat{
+	prettier.Text(","),
+	prettier.Line{},
+}
+
+func (e *TupleExpression) Doc() prettier.Doc {
+	elementDocs := make([]prettier.Doc, len(e.Elements))
+	for i, element := range e.Elements {
+		elementDocs[i] = element.Doc()
+	}
+	return prettier.WrapParentheses(
+		prettier.Join(tupleExpressionSeparatorDoc, elementDocs...),
+		prettier.SoftLine{},
+	)
+}
+
+func (e *TupleExpression) MarshalJSON() ([]byte, error) {
+	type Alias TupleExpression
+	return json.Marshal(&struct {
+		Type string
+		*Alias
+	}{
+		Type:  "TupleExpression",
+		Alias: (*Alias)(e),
+	})
+}
+
+func (*TupleExpression) precedence() precedence {
+	return precedenceLiteral
+}
+
 // DictionaryExpression
 
 type DictionaryExpression struct {
 	Entries []DictionaryEntry
 	Range
+	Node
 }
 
 var _ Element = &DictionaryExpression{}
@@ -644,6 +847,7 @@ func (e DictionaryEntry) Doc() prettier.Doc {
 
 type IdentifierExpression struct {
 	Identifier Identifier
+	Node
 }
 
 var _ Element = &IdentifierExpression{}
@@ -752,6 +956,7 @@ type InvocationExpression struct {
 	Arguments         Arguments
 	ArgumentsStartPos Position
 	EndPos            Position `json:"-"`
+	Node
 }
 
 var _ Element = &InvocationExpression{}
@@ -875,6 +1080,7 @@ type MemberExpression struct {
 	// and the identifier of the member
 	AccessPos  Position
 	Identifier Identifier
+	Node
 }
 
 var _ Element = &MemberExpression{}
@@ -990,6 +1196,7 @@ type IndexExpression struct {
 	TargetExpression   Expression
 	IndexingExpression Expression
 	Range
+	Node
 }
 
 var _ Element = &IndexExpression{}
@@ -1074,6 +1281,7 @@ type ConditionalExpression struct {
 	Test Expression
 	Then Expression
 	Else Expression
+	Node
 }
 
 var _ Element = &ConditionalExpression{}
@@ -1206,6 +1414,7 @@ type UnaryExpression struct {
 	Operation  Operation
 	Expression Expression
 	StartPos   Position `json:"-"`
+	Node
 }
 
 var _ Element = &UnaryExpression{}
@@ -1303,6 +1512,7 @@ type BinaryExpression struct {
 	Operation Operation
 	Left      Expression
 	Right     Expression
+	Node
 }
 
 var _ Element = &BinaryExpression{}
@@ -1421,6 +1631,8 @@ func (e *BinaryExpression) precedence() precedence {
 		OperationGreater,
 		OperationGreaterEqual:
 		return precedenceComparison
+	case OperationRange, OperationInclusiveRange:
+		return precedenceRange
 	case OperationNilCoalesce:
 		return precedenceNilCoalescing
 	case OperationBitwiseOr:
@@ -1447,10 +1659,12 @@ func (e *BinaryExpression) IsLeftAssociative() bool {
 // FunctionExpression
 
 type FunctionExpression struct {
+	Purity               FunctionPurity `json:",omitempty"`
 	ParameterList        *ParameterList
 	ReturnTypeAnnotation *TypeAnnotation
 	FunctionBlock        *FunctionBlock
 	StartPos             Position `json:"-"`
+	Node
 }
 
 var _ Element = &FunctionExpression{}
@@ -1505,8 +1719,10 @@ var functionExpressionEmptyBlockDoc prettier.Doc = prettier.Text(" {}")
 
 func FunctionDocument(
 	access Access,
+	purity FunctionPurity,
 	includeKeyword bool,
 	identifier string,
+	typeParameters []*TypeParameter,
 	parameterList *ParameterList,
 	returnTypeAnnotation *TypeAnnotation,
 	block *FunctionBlock,
@@ -1540,6 +1756,14 @@ func FunctionDocument(
 		)
 	}
 
+	if purity != FunctionPurityUnspecified {
+		doc = append(
+			doc,
+			prettier.Text(purity.Keyword()),
+			prettier.Space,
+		)
+	}
+
 	if includeKeyword {
 		doc = append(
 			doc,
@@ -1554,6 +1778,13 @@ func FunctionDocument(
 		)
 	}
 
+	if len(typeParameters) > 0 {
+		doc = append(
+			doc,
+			TypeParametersDoc(typeParameters),
+		)
+	}
+
 	if signatureDoc != nil {
 		doc = append(
 			doc,
@@ -1579,8 +1810,10 @@ func FunctionDocument(
 func (e *FunctionExpression) Doc() prettier.Doc {
 	return FunctionDocument(
 		AccessNotSpecified,
+		e.Purity,
 		true,
 		"",
+		nil,
 		e.ParameterList,
 		e.ReturnTypeAnnotation,
 		e.FunctionBlock,
@@ -1619,6 +1852,7 @@ type CastingExpression struct {
 	Operation                 Operation
 	TypeAnnotation            *TypeAnnotation
 	ParentVariableDeclaration *VariableDeclaration `json:"-"`
+	Node
 }
 
 var _ Element = &CastingExpression{}
@@ -1714,6 +1948,7 @@ func (*CastingExpression) precedence() precedence {
 type CreateExpression struct {
 	InvocationExpression *InvocationExpression
 	StartPos             Position `json:"-"`
+	Node
 }
 
 var _ Element = &CreateExpression{}
@@ -1795,6 +2030,7 @@ func (*CreateExpression) precedence() precedence {
 type DestroyExpression struct {
 	Expression Expression
 	StartPos   Position `json:"-"`
+	Node
 }
 
 var _ Element = &DestroyExpression{}
@@ -1879,6 +2115,7 @@ func (*DestroyExpression) precedence() precedence {
 type ReferenceExpression struct {
 	Expression Expression
 	StartPos   Position `json:"-"`
+	Node
 }
 
 var _ Element = &ReferenceExpression{}
@@ -1969,6 +2206,7 @@ func (*ReferenceExpression) precedence() precedence {
 type ForceExpression struct {
 	Expression Expression
 	EndPos     Position `json:"-"`
+	Node
 }
 
 var _ Element = &ForceExpression{}
@@ -2054,6 +2292,7 @@ type PathExpression struct {
 	StartPos   Position `json:"-"`
 	Domain     Identifier
 	Identifier Identifier
+	Node
 }
 
 var _ Element = &PathExpression{}
@@ -2133,3 +2372,180 @@ func (e *PathExpression) MarshalJSON() ([]byte, error) {
 func (*PathExpression) precedence() precedence {
 	return precedenceLiteral
 }
+
+// AttachExpression
+
+type AttachExpression struct {
+	Base       Expression
+	Attachment *InvocationExpression
+	StartPos   Position `json:"-"`
+	Node
+}
+
+var _ Element = &AttachExpression{}
+var _ Expression = &AttachExpression{}
+
+func NewAttachExpression(
+	gauge common.MemoryGauge,
+	base Expression,
+	attachment *InvocationExpression,
+	startPos Position,
+) *AttachExpression {
+	common.UseMemory(gauge, common.AttachExpressionMemoryUsage)
+
+	return &AttachExpression{
+		Base:       base,
+		Attachment: attachment,
+		StartPos:   startPos,
+	}
+}
+
+func (*AttachExpression) ElementType() ElementType {
+	return ElementTypeAttachExpression
+}
+
+func (*AttachExpression) isExpression() {}
+
+func (*AttachExpression) isIfStatementTest() {}
+
+func (e *AttachExpression) Accept(visitor Visitor) Repr {
+	return e.AcceptExp(visitor)
+}
+
+func (e *AttachExpression) Walk(walkChild func(Element)) {
+	walkChild(e.Attachment)
+	walkChild(e.Base)
+}
+
+func (e *AttachExpression) AcceptExp(visitor ExpressionVisitor) Repr {
+	return visitor.VisitAttachExpression(e)
+}
+
+func (e *AttachExpression) String() string {
+	return Prettier(e)
+}
+
+const attachExpressionKeywordDoc = prettier.Text("attach ")
+const attachExpressionToKeywordDoc = prettier.Text(" to ")
+
+func (e *AttachExpression) Doc() prettier.Doc {
+	return prettier.Concat{
+		attachExpressionKeywordDoc,
+		e.Attachment.Doc(),
+		attachExpressionToKeywordDoc,
+		parenthesizedExpressionDoc(
+			e.Base,
+			e.precedence(),
+		),
+	}
+}
+
+func (e *AttachExpression) StartPosition() Position {
+	return e.StartPos
+}
+
+func (e *AttachExpression) EndPosition(memoryGauge common.MemoryGauge) Position {
+	return e.Base.EndPosition(memoryGauge)
+}
+
+func (e *AttachExpression) MarshalJSON() ([]byte, error) {
+	type Alias AttachExpression
+	return json.Marshal(&struct {
+		Type string
+		Range
+		*Alias
+	}{
+		Type:  "AttachExpression",
+		Range: NewUnmeteredRangeFromPositioned(e),
+		Alias: (*Alias)(e),
+	})
+}
+
+func (*AttachExpression) precedence() precedence {
+	return precedenceUnaryPrefix
+}
+
+// TryExpression
+
+type TryExpression struct {
+	Expression Expression
+	StartPos   Position `json:"-"`
+	Node
+}
+
+var _ Element = &TryExpression{}
+var _ Expression = &TryExpression{}
+
+func NewTryExpression(
+	gauge common.MemoryGauge,
+	expression Expression,
+	startPos Position,
+) *TryExpression {
+	common.UseMemory(gauge, common.TryExpressionMemoryUsage)
+
+	return &TryExpression{
+		Expression: expression,
+		StartPos:   startPos,
+	}
+}
+
+func (*TryExpression) ElementType() ElementType {
+	return ElementTypeTryExpression
+}
+
+func (*TryExpression) isExpression() {}
+
+func (*TryExpression) isIfStatementTest() {}
+
+func (e *TryExpression) Accept(visitor Visitor) Repr {
+	return e.AcceptExp(visitor)
+}
+
+func (e *TryExpression) Walk(walkChild func(Element)) {
+	walkChild(e.Expression)
+}
+
+func (e *TryExpression) AcceptExp(visitor ExpressionVisitor) Repr {
+	return visitor.VisitTryExpression(e)
+}
+
+func (e *TryExpression) String() string {
+	return Prettier(e)
+}
+
+const tryExpressionKeywordDoc = prettier.Text("try? ")
+
+func (e *TryExpression) Doc() prettier.Doc {
+	return prettier.Concat{
+		tryExpressionKeywordDoc,
+		parenthesizedExpressionDoc(
+			e.Expression,
+			e.precedence(),
+		),
+	}
+}
+
+func (e *TryExpression) StartPosition() Position {
+	return e.StartPos
+}
+
+func (e *TryExpression) EndPosition(memoryGauge common.MemoryGauge) Position {
+	return e.Expression.EndPosition(memoryGauge)
+}
+
+func (e *TryExpression) MarshalJSON() ([]byte, error) {
+	type Alias TryExpression
+	return json.Marshal(&struct {
+		Type string
+		Range
+		*Alias
+	}{
+		Type:  "TryExpression",
+		Range: NewUnmeteredRangeFromPositioned(e),
+		Alias: (*Alias)(e),
+	})
+}
+
+func (*TryExpression) precedence() precedence {
+	return precedenceUnaryPrefix
+}
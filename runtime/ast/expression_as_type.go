@@ -83,6 +83,24 @@ func ExpressionAsType(expression Expression) Type {
 			},
 		}
 
+	case *TupleExpression:
+		elementTypes := make([]Type, 0, len(expression.Elements))
+		for _, element := range expression.Elements {
+			elementType := ExpressionAsType(element)
+			if elementType == nil {
+				return nil
+			}
+			elementTypes = append(elementTypes, elementType)
+		}
+
+		return &TupleType{
+			Types: elementTypes,
+			Range: Range{
+				StartPos: expression.StartPos,
+				EndPos:   expression.EndPos,
+			},
+		}
+
 	default:
 		return nil
 	}
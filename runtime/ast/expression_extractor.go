@@ -109,30 +109,50 @@ type PathExtractor interface {
 	ExtractPath(extractor *ExpressionExtractor, expression *PathExpression) ExpressionExtraction
 }
 
+type AttachExtractor interface {
+	ExtractAttach(extractor *ExpressionExtractor, expression *AttachExpression) ExpressionExtraction
+}
+
+type TryExtractor interface {
+	ExtractTry(extractor *ExpressionExtractor, expression *TryExpression) ExpressionExtraction
+}
+
+type StringTemplateExtractor interface {
+	ExtractStringTemplate(extractor *ExpressionExtractor, expression *StringTemplateExpression) ExpressionExtraction
+}
+
+type TupleExtractor interface {
+	ExtractTuple(extractor *ExpressionExtractor, expression *TupleExpression) ExpressionExtraction
+}
+
 type ExpressionExtractor struct {
-	nextIdentifier       int
-	BoolExtractor        BoolExtractor
-	NilExtractor         NilExtractor
-	IntExtractor         IntExtractor
-	FixedPointExtractor  FixedPointExtractor
-	StringExtractor      StringExtractor
-	ArrayExtractor       ArrayExtractor
-	DictionaryExtractor  DictionaryExtractor
-	IdentifierExtractor  IdentifierExtractor
-	InvocationExtractor  InvocationExtractor
-	MemberExtractor      MemberExtractor
-	IndexExtractor       IndexExtractor
-	ConditionalExtractor ConditionalExtractor
-	UnaryExtractor       UnaryExtractor
-	BinaryExtractor      BinaryExtractor
-	FunctionExtractor    FunctionExtractor
-	CastingExtractor     CastingExtractor
-	CreateExtractor      CreateExtractor
-	DestroyExtractor     DestroyExtractor
-	ReferenceExtractor   ReferenceExtractor
-	ForceExtractor       ForceExtractor
-	PathExtractor        PathExtractor
-	MemoryGauge          common.MemoryGauge
+	nextIdentifier          int
+	BoolExtractor           BoolExtractor
+	NilExtractor            NilExtractor
+	IntExtractor            IntExtractor
+	FixedPointExtractor     FixedPointExtractor
+	StringExtractor         StringExtractor
+	ArrayExtractor          ArrayExtractor
+	DictionaryExtractor     DictionaryExtractor
+	IdentifierExtractor     IdentifierExtractor
+	InvocationExtractor     InvocationExtractor
+	MemberExtractor         MemberExtractor
+	IndexExtractor          IndexExtractor
+	ConditionalExtractor    ConditionalExtractor
+	UnaryExtractor          UnaryExtractor
+	BinaryExtractor         BinaryExtractor
+	FunctionExtractor       FunctionExtractor
+	CastingExtractor        CastingExtractor
+	CreateExtractor         CreateExtractor
+	DestroyExtractor        DestroyExtractor
+	ReferenceExtractor      ReferenceExtractor
+	ForceExtractor          ForceExtractor
+	PathExtractor           PathExtractor
+	AttachExtractor         AttachExtractor
+	TryExtractor            TryExtractor
+	StringTemplateExtractor StringTemplateExtractor
+	TupleExtractor          TupleExtractor
+	MemoryGauge             common.MemoryGauge
 }
 
 func (extractor *ExpressionExtractor) Extract(expression Expression) ExpressionExtraction {
@@ -796,3 +816,139 @@ func (extractor *ExpressionExtractor) ExtractPath(expression *PathExpression) Ex
 		ExtractedExpressions: nil,
 	}
 }
+
+func (extractor *ExpressionExtractor) VisitAttachExpression(expression *AttachExpression) Repr {
+
+	// delegate to child extractor, if any,
+	// or call default implementation
+
+	if extractor.AttachExtractor != nil {
+		return extractor.AttachExtractor.ExtractAttach(extractor, expression)
+	}
+	return extractor.ExtractAttach(expression)
+}
+
+func (extractor *ExpressionExtractor) ExtractAttach(expression *AttachExpression) ExpressionExtraction {
+
+	// copy the expression
+	newExpression := *expression
+
+	// rewrite the sub-expressions
+
+	attachmentResult := extractor.Extract(newExpression.Attachment)
+
+	attachment, ok := attachmentResult.RewrittenExpression.(*InvocationExpression)
+	if !ok {
+		// Edge-case:
+		// The rewritten expression returned from the extractor may not be an InvocationExpression,
+		// but an expression of another type.
+		//
+		// Wrap the rewritten expression in an InvocationExpression.
+
+		attachment = &InvocationExpression{
+			InvokedExpression: attachmentResult.RewrittenExpression,
+			EndPos:            attachmentResult.RewrittenExpression.EndPosition(extractor.MemoryGauge),
+		}
+	}
+
+	newExpression.Attachment = attachment
+
+	baseResult := extractor.Extract(newExpression.Base)
+
+	newExpression.Base = baseResult.RewrittenExpression
+
+	var extractedExpressions []ExtractedExpression
+	extractedExpressions = append(extractedExpressions, attachmentResult.ExtractedExpressions...)
+	extractedExpressions = append(extractedExpressions, baseResult.ExtractedExpressions...)
+
+	return ExpressionExtraction{
+		RewrittenExpression:  &newExpression,
+		ExtractedExpressions: extractedExpressions,
+	}
+}
+
+func (extractor *ExpressionExtractor) VisitTryExpression(expression *TryExpression) Repr {
+
+	// delegate to child extractor, if any,
+	// or call default implementation
+
+	if extractor.TryExtractor != nil {
+		return extractor.TryExtractor.ExtractTry(extractor, expression)
+	}
+	return extractor.ExtractTry(expression)
+}
+
+func (extractor *ExpressionExtractor) ExtractTry(expression *TryExpression) ExpressionExtraction {
+
+	// copy the expression
+	newExpression := *expression
+
+	// rewrite the sub-expression
+
+	result := extractor.Extract(newExpression.Expression)
+
+	newExpression.Expression = result.RewrittenExpression
+
+	return ExpressionExtraction{
+		RewrittenExpression:  &newExpression,
+		ExtractedExpressions: result.ExtractedExpressions,
+	}
+}
+
+func (extractor *ExpressionExtractor) VisitStringTemplateExpression(expression *StringTemplateExpression) Repr {
+
+	// delegate to child extractor, if any,
+	// or call default implementation
+
+	if extractor.StringTemplateExtractor != nil {
+		return extractor.StringTemplateExtractor.ExtractStringTemplate(extractor, expression)
+	}
+	return extractor.ExtractStringTemplate(expression)
+}
+
+func (extractor *ExpressionExtractor) ExtractStringTemplate(expression *StringTemplateExpression) ExpressionExtraction {
+
+	// copy the expression
+	newExpression := *expression
+
+	// rewrite all interpolated expressions
+
+	rewrittenExpressions, extractedExpressions :=
+		extractor.VisitExpressions(expression.Expressions)
+
+	newExpression.Expressions = rewrittenExpressions
+
+	return ExpressionExtraction{
+		RewrittenExpression:  &newExpression,
+		ExtractedExpressions: extractedExpressions,
+	}
+}
+
+func (extractor *ExpressionExtractor) VisitTupleExpression(expression *TupleExpression) Repr {
+
+	// delegate to child extractor, if any,
+	// or call default implementation
+
+	if extractor.TupleExtractor != nil {
+		return extractor.TupleExtractor.ExtractTuple(extractor, expression)
+	}
+	return extractor.ExtractTuple(expression)
+}
+
+func (extractor *ExpressionExtractor) ExtractTuple(expression *TupleExpression) ExpressionExtraction {
+
+	// copy the expression
+	newExpression := *expression
+
+	// rewrite all elements
+
+	rewrittenExpressions, extractedExpressions :=
+		extractor.VisitExpressions(expression.Elements)
+
+	newExpression.Elements = rewrittenExpressions
+
+	return ExpressionExtraction{
+		RewrittenExpression:  &newExpression,
+		ExtractedExpressions: extractedExpressions,
+	}
+}
@@ -4877,3 +4877,396 @@ func TestFunctionExpression_String(t *testing.T) {
 		)
 	})
 }
+
+func TestAttachExpression_MarshalJSON(t *testing.T) {
+
+	t.Parallel()
+
+	expr := &AttachExpression{
+		Base: &IdentifierExpression{
+			Identifier: Identifier{
+				Identifier: "foo",
+				Pos:        Position{Offset: 1, Line: 2, Column: 3},
+			},
+		},
+		Attachment: &InvocationExpression{
+			InvokedExpression: &IdentifierExpression{
+				Identifier: Identifier{
+					Identifier: "A",
+					Pos:        Position{Offset: 4, Line: 5, Column: 6},
+				},
+			},
+			ArgumentsStartPos: Position{Offset: 7, Line: 8, Column: 9},
+			EndPos:            Position{Offset: 10, Line: 11, Column: 12},
+		},
+		StartPos: Position{Offset: 13, Line: 14, Column: 15},
+	}
+
+	actual, err := json.Marshal(expr)
+	require.NoError(t, err)
+
+	assert.JSONEq(t,
+		`
+        {
+            "Type": "AttachExpression",
+            "Base": {
+                "Type": "IdentifierExpression",
+                "Identifier": {
+                    "Identifier": "foo",
+                    "StartPos": {"Offset": 1, "Line": 2, "Column": 3},
+                    "EndPos": {"Offset": 3, "Line": 2, "Column": 5}
+                },
+                "StartPos": {"Offset": 1, "Line": 2, "Column": 3},
+                "EndPos": {"Offset": 3, "Line": 2, "Column": 5}
+            },
+            "Attachment": {
+                "Type": "InvocationExpression",
+                "InvokedExpression": {
+                    "Type": "IdentifierExpression",
+                    "Identifier": {
+                        "Identifier": "A",
+                        "StartPos": {"Offset": 4, "Line": 5, "Column": 6},
+                        "EndPos": {"Offset": 4, "Line": 5, "Column": 6}
+                    },
+                    "StartPos": {"Offset": 4, "Line": 5, "Column": 6},
+                    "EndPos": {"Offset": 4, "Line": 5, "Column": 6}
+                },
+                "TypeArguments": null,
+                "Arguments": null,
+                "ArgumentsStartPos": {"Offset": 7, "Line": 8, "Column": 9},
+                "StartPos": {"Offset": 4, "Line": 5, "Column": 6},
+                "EndPos": {"Offset": 10, "Line": 11, "Column": 12}
+            },
+            "StartPos": {"Offset": 13, "Line": 14, "Column": 15},
+            "EndPos": {"Offset": 3, "Line": 2, "Column": 5}
+        }
+        `,
+		string(actual),
+	)
+}
+
+func TestAttachExpression_Doc(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("simple", func(t *testing.T) {
+
+		t.Parallel()
+
+		expr := &AttachExpression{
+			Base: &IdentifierExpression{
+				Identifier: Identifier{
+					Identifier: "foo",
+				},
+			},
+			Attachment: &InvocationExpression{
+				InvokedExpression: &IdentifierExpression{
+					Identifier: Identifier{
+						Identifier: "A",
+					},
+				},
+			},
+		}
+
+		assert.Equal(t,
+			prettier.Concat{
+				prettier.Text("attach "),
+				prettier.Concat{
+					prettier.Text("A"),
+					prettier.Text("()"),
+				},
+				prettier.Text(" to "),
+				prettier.Text("foo"),
+			},
+			expr.Doc(),
+		)
+	})
+
+	t.Run("move", func(t *testing.T) {
+
+		t.Parallel()
+
+		expr := &AttachExpression{
+			Base: &UnaryExpression{
+				Operation: OperationMove,
+				Expression: &IdentifierExpression{
+					Identifier: Identifier{
+						Identifier: "foo",
+					},
+				},
+			},
+			Attachment: &InvocationExpression{
+				InvokedExpression: &IdentifierExpression{
+					Identifier: Identifier{
+						Identifier: "A",
+					},
+				},
+			},
+		}
+
+		assert.Equal(t,
+			prettier.Concat{
+				prettier.Text("attach "),
+				prettier.Concat{
+					prettier.Text("A"),
+					prettier.Text("()"),
+				},
+				prettier.Text(" to "),
+				prettier.Concat{
+					prettier.Text("<-"),
+					prettier.Text("foo"),
+				},
+			},
+			expr.Doc(),
+		)
+	})
+}
+
+func TestAttachExpression_String(t *testing.T) {
+
+	t.Parallel()
+
+	expr := &AttachExpression{
+		Base: &UnaryExpression{
+			Operation: OperationMove,
+			Expression: &IdentifierExpression{
+				Identifier: Identifier{
+					Identifier: "foo",
+				},
+			},
+		},
+		Attachment: &InvocationExpression{
+			InvokedExpression: &IdentifierExpression{
+				Identifier: Identifier{
+					Identifier: "A",
+				},
+			},
+		},
+	}
+
+	assert.Equal(t,
+		"attach A() to <-foo",
+		expr.String(),
+	)
+}
+
+func TestTryExpression_MarshalJSON(t *testing.T) {
+
+	t.Parallel()
+
+	expr := &TryExpression{
+		Expression: &InvocationExpression{
+			InvokedExpression: &IdentifierExpression{
+				Identifier: Identifier{
+					Identifier: "f",
+					Pos:        Position{Offset: 1, Line: 2, Column: 3},
+				},
+			},
+			ArgumentsStartPos: Position{Offset: 4, Line: 5, Column: 6},
+			EndPos:            Position{Offset: 7, Line: 8, Column: 9},
+		},
+		StartPos: Position{Offset: 10, Line: 11, Column: 12},
+	}
+
+	actual, err := json.Marshal(expr)
+	require.NoError(t, err)
+
+	assert.JSONEq(t,
+		`
+        {
+            "Type": "TryExpression",
+            "Expression": {
+                "Type": "InvocationExpression",
+                "InvokedExpression": {
+                    "Type": "IdentifierExpression",
+                    "Identifier": {
+                        "Identifier": "f",
+                        "StartPos": {"Offset": 1, "Line": 2, "Column": 3},
+                        "EndPos": {"Offset": 1, "Line": 2, "Column": 3}
+                    },
+                    "StartPos": {"Offset": 1, "Line": 2, "Column": 3},
+                    "EndPos": {"Offset": 1, "Line": 2, "Column": 3}
+                },
+                "TypeArguments": null,
+                "Arguments": null,
+                "ArgumentsStartPos": {"Offset": 4, "Line": 5, "Column": 6},
+                "StartPos": {"Offset": 1, "Line": 2, "Column": 3},
+                "EndPos": {"Offset": 7, "Line": 8, "Column": 9}
+            },
+            "StartPos": {"Offset": 10, "Line": 11, "Column": 12},
+            "EndPos": {"Offset": 7, "Line": 8, "Column": 9}
+        }
+        `,
+		string(actual),
+	)
+}
+
+func TestTryExpression_Doc(t *testing.T) {
+
+	t.Parallel()
+
+	expr := &TryExpression{
+		Expression: &InvocationExpression{
+			InvokedExpression: &IdentifierExpression{
+				Identifier: Identifier{
+					Identifier: "f",
+				},
+			},
+		},
+	}
+
+	assert.Equal(t,
+		prettier.Concat{
+			prettier.Text("try? "),
+			prettier.Concat{
+				prettier.Text("f"),
+				prettier.Text("()"),
+			},
+		},
+		expr.Doc(),
+	)
+}
+
+func TestTryExpression_String(t *testing.T) {
+
+	t.Parallel()
+
+	expr := &TryExpression{
+		Expression: &InvocationExpression{
+			InvokedExpression: &IdentifierExpression{
+				Identifier: Identifier{
+					Identifier: "f",
+				},
+			},
+		},
+	}
+
+	assert.Equal(t,
+		"try? f()",
+		expr.String(),
+	)
+}
+
+func TestStringTemplateExpression_MarshalJSON(t *testing.T) {
+
+	t.Parallel()
+
+	expr := &StringTemplateExpression{
+		Values: []string{"a ", ""},
+		Expressions: []Expression{
+			&IdentifierExpression{
+				Identifier: Identifier{
+					Identifier: "x",
+					Pos:        Position{Offset: 1, Line: 2, Column: 3},
+				},
+			},
+		},
+		Range: Range{
+			StartPos: Position{Offset: 4, Line: 5, Column: 6},
+			EndPos:   Position{Offset: 7, Line: 8, Column: 9},
+		},
+	}
+
+	actual, err := json.Marshal(expr)
+	require.NoError(t, err)
+
+	assert.JSONEq(t,
+		`
+        {
+            "Type": "StringTemplateExpression",
+            "Values": ["a ", ""],
+            "Expressions": [
+                {
+                    "Type": "IdentifierExpression",
+                    "Identifier": {
+                        "Identifier": "x",
+                        "StartPos": {"Offset": 1, "Line": 2, "Column": 3},
+                        "EndPos": {"Offset": 1, "Line": 2, "Column": 3}
+                    },
+                    "StartPos": {"Offset": 1, "Line": 2, "Column": 3},
+                    "EndPos": {"Offset": 1, "Line": 2, "Column": 3}
+                }
+            ],
+            "StartPos": {"Offset": 4, "Line": 5, "Column": 6},
+            "EndPos": {"Offset": 7, "Line": 8, "Column": 9}
+        }
+        `,
+		string(actual),
+	)
+}
+
+func TestStringTemplateExpression_Doc(t *testing.T) {
+
+	t.Parallel()
+
+	expr := &StringTemplateExpression{
+		Values: []string{"a\n", " \"b\" ", ""},
+		Expressions: []Expression{
+			&IdentifierExpression{
+				Identifier: Identifier{
+					Identifier: "x",
+				},
+			},
+			&BinaryExpression{
+				Operation: OperationPlus,
+				Left: &IdentifierExpression{
+					Identifier: Identifier{
+						Identifier: "y",
+					},
+				},
+				Right: &IntegerExpression{
+					PositiveLiteral: "1",
+					Value:           big.NewInt(1),
+					Base:            10,
+				},
+			},
+		},
+	}
+
+	assert.Equal(t,
+		prettier.Concat{
+			prettier.Text(`"a\n\(`),
+			prettier.Text("x"),
+			prettier.Text(`) \"b\" \(`),
+			prettier.Group{
+				Doc: prettier.Concat{
+					prettier.Group{
+						Doc: prettier.Text("y"),
+					},
+					prettier.Line{},
+					prettier.Text("+"),
+					prettier.Text(" "),
+					prettier.Group{
+						Doc: prettier.Text("1"),
+					},
+				},
+			},
+			prettier.Text(`)"`),
+		},
+		expr.Doc(),
+	)
+}
+
+func TestStringTemplateExpression_String(t *testing.T) {
+
+	t.Parallel()
+
+	expr := &StringTemplateExpression{
+		Values: []string{"a\n", " \"b\" ", ""},
+		Expressions: []Expression{
+			&IdentifierExpression{
+				Identifier: Identifier{
+					Identifier: "x",
+				},
+			},
+			&StringExpression{
+				Value: "y",
+			},
+		},
+	}
+
+	assert.Equal(t,
+		`"a\n\(x) \"b\" \("y")"`,
+		expr.String(),
+	)
+}
@@ -28,12 +28,15 @@ import (
 
 type FunctionDeclaration struct {
 	Access               Access
+	Purity               FunctionPurity `json:",omitempty"`
 	Identifier           Identifier
+	TypeParameters       []*TypeParameter `json:",omitempty"`
 	ParameterList        *ParameterList
 	ReturnTypeAnnotation *TypeAnnotation
 	FunctionBlock        *FunctionBlock
 	DocString            string
 	StartPos             Position `json:"-"`
+	Node
 }
 
 var _ Element = &FunctionDeclaration{}
@@ -110,13 +113,15 @@ func (d *FunctionDeclaration) DeclarationAccess() Access {
 }
 
 func (d *FunctionDeclaration) ToExpression(memoryGauge common.MemoryGauge) *FunctionExpression {
-	return NewFunctionExpression(
+	expression := NewFunctionExpression(
 		memoryGauge,
 		d.ParameterList,
 		d.ReturnTypeAnnotation,
 		d.FunctionBlock,
 		d.StartPos,
 	)
+	expression.Purity = d.Purity
+	return expression
 }
 
 func (d *FunctionDeclaration) DeclarationMembers() *Members {
@@ -130,8 +135,10 @@ func (d *FunctionDeclaration) DeclarationDocString() string {
 func (d *FunctionDeclaration) Doc() prettier.Doc {
 	return FunctionDocument(
 		d.Access,
+		d.Purity,
 		true,
 		d.Identifier.Identifier,
+		d.TypeParameters,
 		d.ParameterList,
 		d.ReturnTypeAnnotation,
 		d.FunctionBlock,
@@ -160,6 +167,7 @@ func (d *FunctionDeclaration) String() string {
 type SpecialFunctionDeclaration struct {
 	Kind                common.DeclarationKind
 	FunctionDeclaration *FunctionDeclaration
+	Node
 }
 
 var _ Element = &SpecialFunctionDeclaration{}
@@ -226,8 +234,10 @@ func (d *SpecialFunctionDeclaration) DeclarationDocString() string {
 func (d *SpecialFunctionDeclaration) Doc() prettier.Doc {
 	return FunctionDocument(
 		d.FunctionDeclaration.Access,
+		d.FunctionDeclaration.Purity,
 		false,
 		d.Kind.Keywords(),
+		nil,
 		d.FunctionDeclaration.ParameterList,
 		d.FunctionDeclaration.ReturnTypeAnnotation,
 		d.FunctionDeclaration.FunctionBlock,
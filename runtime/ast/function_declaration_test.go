@@ -297,6 +297,142 @@ func TestFunctionDeclaration_String(t *testing.T) {
 	)
 }
 
+func TestFunctionDeclaration_Purity(t *testing.T) {
+
+	t.Parallel()
+
+	decl := &FunctionDeclaration{
+		Access: AccessPublic,
+		Purity: FunctionPurityView,
+		Identifier: Identifier{
+			Identifier: "xyz",
+		},
+		ParameterList: &ParameterList{},
+		FunctionBlock: &FunctionBlock{
+			Block: &Block{
+				Statements: []Statement{},
+			},
+		},
+	}
+
+	require.Equal(t,
+		"pub view fun xyz() {}",
+		decl.String(),
+	)
+
+	actual, err := json.Marshal(decl)
+	require.NoError(t, err)
+
+	require.Contains(t, string(actual), `"Purity":"FunctionPurityView"`)
+}
+
+func TestFunctionDeclaration_TypeParameters(t *testing.T) {
+
+	t.Parallel()
+
+	decl := &FunctionDeclaration{
+		Identifier: Identifier{
+			Identifier: "xyz",
+		},
+		TypeParameters: []*TypeParameter{
+			{
+				Identifier: Identifier{
+					Identifier: "T",
+					Pos:        Position{Offset: 1, Line: 2, Column: 3},
+				},
+				TypeBounds: []Type{
+					&NominalType{
+						Identifier: Identifier{
+							Identifier: "AB",
+							Pos:        Position{Offset: 4, Line: 5, Column: 6},
+						},
+					},
+					&NominalType{
+						Identifier: Identifier{
+							Identifier: "CD",
+							Pos:        Position{Offset: 7, Line: 8, Column: 9},
+						},
+					},
+				},
+			},
+			{
+				Identifier: Identifier{
+					Identifier: "U",
+					Pos:        Position{Offset: 10, Line: 11, Column: 12},
+				},
+			},
+		},
+		ParameterList: &ParameterList{},
+		FunctionBlock: &FunctionBlock{
+			Block: &Block{
+				Statements: []Statement{},
+			},
+		},
+	}
+
+	t.Run("String", func(t *testing.T) {
+
+		t.Parallel()
+
+		require.Equal(t,
+			"fun xyz<T: AB & CD, U>() {}",
+			decl.String(),
+		)
+	})
+
+	t.Run("MarshalJSON", func(t *testing.T) {
+
+		t.Parallel()
+
+		actual, err := json.Marshal(decl.TypeParameters)
+		require.NoError(t, err)
+
+		assert.JSONEq(t,
+			`
+            [
+                {
+                    "Identifier": {
+                        "Identifier": "T",
+                        "StartPos": {"Offset": 1, "Line": 2, "Column": 3},
+                        "EndPos": {"Offset": 1, "Line": 2, "Column": 3}
+                    },
+                    "TypeBounds": [
+                        {
+                            "Type": "NominalType",
+                            "Identifier": {
+                                "Identifier": "AB",
+                                "StartPos": {"Offset": 4, "Line": 5, "Column": 6},
+                                "EndPos": {"Offset": 5, "Line": 5, "Column": 7}
+                            },
+                            "StartPos": {"Offset": 4, "Line": 5, "Column": 6},
+                            "EndPos": {"Offset": 5, "Line": 5, "Column": 7}
+                        },
+                        {
+                            "Type": "NominalType",
+                            "Identifier": {
+                                "Identifier": "CD",
+                                "StartPos": {"Offset": 7, "Line": 8, "Column": 9},
+                                "EndPos": {"Offset": 8, "Line": 8, "Column": 10}
+                            },
+                            "StartPos": {"Offset": 7, "Line": 8, "Column": 9},
+                            "EndPos": {"Offset": 8, "Line": 8, "Column": 10}
+                        }
+                    ]
+                },
+                {
+                    "Identifier": {
+                        "Identifier": "U",
+                        "StartPos": {"Offset": 10, "Line": 11, "Column": 12},
+                        "EndPos": {"Offset": 10, "Line": 11, "Column": 12}
+                    }
+                }
+            ]
+            `,
+			string(actual),
+		)
+	})
+}
+
 func TestSpecialFunctionDeclaration_MarshalJSON(t *testing.T) {
 
 	t.Parallel()
@@ -0,0 +1,56 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ast
+
+import (
+	"encoding/json"
+
+	"github.com/onflow/cadence/runtime/errors"
+)
+
+//go:generate go run golang.org/x/tools/cmd/stringer -type=FunctionPurity
+
+// FunctionPurity indicates if a function may have side effects (unspecified),
+// or is guaranteed to have none (view).
+//
+type FunctionPurity uint
+
+const (
+	FunctionPurityUnspecified FunctionPurity = iota
+	FunctionPurityView
+)
+
+func FunctionPurityCount() int {
+	return len(_FunctionPurity_index) - 1
+}
+
+func (p FunctionPurity) Keyword() string {
+	switch p {
+	case FunctionPurityUnspecified:
+		return ""
+	case FunctionPurityView:
+		return "view"
+	}
+
+	panic(errors.NewUnreachableError())
+}
+
+func (p FunctionPurity) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.String())
+}
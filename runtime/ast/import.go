@@ -30,9 +30,13 @@ import (
 
 type ImportDeclaration struct {
 	Identifiers []Identifier
+	// Aliases maps imported identifiers to the names they are declared as,
+	// e.g. `import FungibleToken as FT from 0x1` declares `FungibleToken` as `FT`
+	Aliases     map[string]string `json:",omitempty"`
 	Location    common.Location
 	LocationPos Position
 	Range
+	Node
 }
 
 var _ Element = &ImportDeclaration{}
@@ -41,6 +45,7 @@ var _ Declaration = &ImportDeclaration{}
 func NewImportDeclaration(
 	gauge common.MemoryGauge,
 	identifiers []Identifier,
+	aliases map[string]string,
 	location common.Location,
 	declRange Range,
 	locationPos Position,
@@ -49,6 +54,7 @@ func NewImportDeclaration(
 
 	return &ImportDeclaration{
 		Identifiers: identifiers,
+		Aliases:     aliases,
 		Location:    location,
 		Range:       declRange,
 		LocationPos: locationPos,
@@ -91,6 +97,16 @@ func (d *ImportDeclaration) DeclarationDocString() string {
 	return ""
 }
 
+// DeclaredName returns the name the given imported identifier is declared as,
+// i.e. its alias, if any, or the identifier itself
+//
+func (d *ImportDeclaration) DeclaredName(identifier string) string {
+	if alias, ok := d.Aliases[identifier]; ok {
+		return alias
+	}
+	return identifier
+}
+
 func (d *ImportDeclaration) MarshalJSON() ([]byte, error) {
 	type Alias ImportDeclaration
 	return json.Marshal(&struct {
@@ -104,6 +120,7 @@ func (d *ImportDeclaration) MarshalJSON() ([]byte, error) {
 
 const importDeclarationImportKeywordDoc = prettier.Text("import")
 const importDeclarationFromKeywordDoc = prettier.Text("from ")
+const importDeclarationAsKeywordDoc = prettier.Text(" as ")
 
 var importDeclarationSeparatorDoc prettier.Doc = prettier.Concat{
 	prettier.Text(","),
@@ -133,6 +150,14 @@ func (d *ImportDeclaration) Doc() prettier.Doc {
 				identifiersDoc,
 				prettier.Text(identifier.Identifier),
 			)
+
+			if alias, ok := d.Aliases[identifier.Identifier]; ok {
+				identifiersDoc = append(
+					identifiersDoc,
+					importDeclarationAsKeywordDoc,
+					prettier.Text(alias),
+				)
+			}
 		}
 
 		identifiersDoc = append(
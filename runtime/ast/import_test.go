@@ -238,4 +238,32 @@ func TestImportDeclaration_String(t *testing.T) {
 			decl.String(),
 		)
 	})
+
+	t.Run("aliases", func(t *testing.T) {
+
+		t.Parallel()
+
+		decl := &ImportDeclaration{
+			Identifiers: []Identifier{
+				{
+					Identifier: "foo",
+				},
+				{
+					Identifier: "bar",
+				},
+			},
+			Aliases: map[string]string{
+				"foo": "baz",
+			},
+			Location: common.AddressLocation{
+				Address: common.MustBytesToAddress([]byte{0x1}),
+			},
+		}
+
+		require.Equal(
+			t,
+			`import foo as baz, bar from 0x1`,
+			decl.String(),
+		)
+	})
 }
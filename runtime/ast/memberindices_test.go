@@ -87,9 +87,17 @@ func TestMemberIndices(t *testing.T) {
 		Identifier: Identifier{Identifier: "C"},
 	}
 
+	typeAliasA := &TypeAliasDeclaration{
+		Identifier: Identifier{Identifier: "A"},
+	}
+	typeAliasB := &TypeAliasDeclaration{
+		Identifier: Identifier{Identifier: "B"},
+	}
+
 	members := NewUnmeteredMembers(
 		[]Declaration{
 			specialFunctionB,
+			typeAliasB,
 			enumCaseA,
 			compositeC,
 			fieldC,
@@ -106,6 +114,7 @@ func TestMemberIndices(t *testing.T) {
 			fieldB,
 			interfaceC,
 			enumCaseC,
+			typeAliasA,
 			functionA,
 		},
 	)
@@ -171,6 +180,14 @@ func TestMemberIndices(t *testing.T) {
 				},
 				members.EnumCases(),
 			)
+
+			require.Equal(t,
+				[]*TypeAliasDeclaration{
+					typeAliasB,
+					typeAliasA,
+				},
+				members.TypeAliases(),
+			)
 		}()
 	}
 
@@ -48,6 +48,41 @@ func (m *Members) Declarations() []Declaration {
 	return m.declarations
 }
 
+// Deprecations returns the deprecation messages of the member declarations
+// which are preceded by a `#deprecated` pragma.
+// The message of a member is empty if the pragma has no message argument.
+//
+func (m *Members) Deprecations() map[Declaration]string {
+	var deprecations map[Declaration]string
+
+	var message string
+	var deprecated bool
+
+	for _, declaration := range m.declarations {
+		if pragma, ok := declaration.(*PragmaDeclaration); ok {
+			if pragmaMessage, ok := pragma.DeprecationMessage(); ok {
+				message = pragmaMessage
+				deprecated = true
+			}
+			continue
+		}
+
+		if !deprecated {
+			continue
+		}
+
+		if deprecations == nil {
+			deprecations = map[Declaration]string{}
+		}
+		deprecations[declaration] = message
+
+		message = ""
+		deprecated = false
+	}
+
+	return deprecations
+}
+
 func (m *Members) Fields() []*FieldDeclaration {
 	return m.indices.Fields(m.declarations)
 }
@@ -72,6 +107,10 @@ func (m *Members) EnumCases() []*EnumCaseDeclaration {
 	return m.indices.EnumCases(m.declarations)
 }
 
+func (m *Members) TypeAliases() []*TypeAliasDeclaration {
+	return m.indices.TypeAliases(m.declarations)
+}
+
 func (m *Members) FieldsByIdentifier() map[string]*FieldDeclaration {
 	return m.indices.FieldsByIdentifier(m.declarations)
 }
@@ -44,3 +44,52 @@ func TestMembers_MarshalJSON(t *testing.T) {
 		string(actual),
 	)
 }
+
+func TestMembers_Deprecations(t *testing.T) {
+
+	t.Parallel()
+
+	deprecatedPragma := &PragmaDeclaration{
+		Expression: &InvocationExpression{
+			InvokedExpression: &IdentifierExpression{
+				Identifier: Identifier{
+					Identifier: "deprecated",
+				},
+			},
+			Arguments: Arguments{
+				{
+					Expression: &StringExpression{
+						Value: "use bar",
+					},
+				},
+			},
+		},
+	}
+
+	foo := &FunctionDeclaration{
+		Identifier: Identifier{
+			Identifier: "foo",
+		},
+	}
+
+	bar := &FunctionDeclaration{
+		Identifier: Identifier{
+			Identifier: "bar",
+		},
+	}
+
+	members := NewUnmeteredMembers([]Declaration{
+		deprecatedPragma,
+		foo,
+		bar,
+	})
+
+	assert.Equal(t,
+		map[Declaration]string{
+			foo: "use bar",
+		},
+		members.Deprecations(),
+	)
+
+	assert.Nil(t, NewUnmeteredMembers([]Declaration{bar}).Deprecations())
+}
@@ -0,0 +1,221 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ast
+
+import (
+	"hash/fnv"
+)
+
+// NodeID is the identifier of an AST node.
+//
+// Node IDs are assigned by the parser, and are unique within a program.
+// The lower 32 bits are the index of the node in the program.
+// Indices are assigned in depth-first order, so they only depend on the structure of the program,
+// and not on the identity of the nodes.
+// This allows information about nodes, e.g. the elaboration of a checked program,
+// to be keyed by node ID, serialized, compared across passes, and correlated by external tools.
+//
+// The upper 32 bits are the tag of the program, which is derived from the program's code.
+// This ensures that the IDs of nodes of different programs do not collide,
+// so looking up information about a node of another program does not return wrong data.
+//
+type NodeID uint64
+
+// NodeIDUnassigned is the ID of a node which has not been assigned an ID yet
+//
+const NodeIDUnassigned NodeID = 0
+
+// FirstSynthesizedNodeIndex is the first node index
+// which is assigned to nodes synthesized after parsing, e.g. by the checker.
+//
+// Indices of parsed nodes are always lower,
+// so synthesized nodes never collide with parsed nodes.
+//
+const FirstSynthesizedNodeIndex uint32 = 1 << 31
+
+// NewNodeID returns the ID of the node with the given index,
+// in the program with the given tag.
+//
+func NewNodeID(programTag uint32, index uint32) NodeID {
+	return NodeID(programTag)<<32 | NodeID(index)
+}
+
+// ProgramTag returns the tag of the program the node belongs to.
+//
+func (id NodeID) ProgramTag() uint32 {
+	return uint32(id >> 32)
+}
+
+// Index returns the index of the node in its program.
+//
+func (id NodeID) Index() uint32 {
+	return uint32(id)
+}
+
+// ProgramTag returns the tag for the program with the given code.
+//
+func ProgramTag(code string) uint32 {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(code))
+	return hash.Sum32()
+}
+
+// Node is embedded in all elements and holds the ID of the element.
+//
+type Node struct {
+	NodeID NodeID `json:"ID,omitempty"`
+}
+
+// ID returns the ID of the node,
+// or NodeIDUnassigned if the node has not been assigned an ID yet.
+//
+func (n *Node) ID() NodeID {
+	return n.NodeID
+}
+
+// NodeIDGenerator assigns node IDs.
+//
+// The zero value is ready to use, and assigns IDs with program tag 0.
+//
+type NodeIDGenerator struct {
+	programTag uint32
+	lastIndex  uint32
+}
+
+// NewNodeIDGenerator returns a generator which assigns IDs
+// with the given program tag, and indices greater than the given index.
+//
+func NewNodeIDGenerator(programTag uint32, lastIndex uint32) *NodeIDGenerator {
+	return &NodeIDGenerator{
+		programTag: programTag,
+		lastIndex:  lastIndex,
+	}
+}
+
+// AssignIDs assigns IDs to the given element and all its nested elements,
+// which have not been assigned an ID yet.
+//
+// IDs are assigned in depth-first order, and have indices greater than all indices
+// which were previously assigned by the generator or which are already assigned
+// to the given element and its nested elements with the generator's program tag.
+//
+// Only elements which have not been assigned an ID yet are modified.
+//
+func (g *NodeIDGenerator) AssignIDs(element Element) {
+
+	// Determine the greatest index which is already assigned,
+	// so that newly assigned IDs are unique
+
+	walkNodes(element, func(node *Node) {
+		id := node.NodeID
+		if id.ProgramTag() == g.programTag && id.Index() > g.lastIndex {
+			g.lastIndex = id.Index()
+		}
+	})
+
+	walkNodes(element, func(node *Node) {
+		if node.NodeID != NodeIDUnassigned {
+			return
+		}
+		g.lastIndex++
+		node.NodeID = NewNodeID(g.programTag, g.lastIndex)
+	})
+}
+
+// AssignNodeIDs assigns IDs to the given element and all its nested elements,
+// which have not been assigned an ID yet, using program tag 0.
+//
+// The parser already assigns IDs to parsed programs.
+// This function only needs to be called for elements which were constructed directly.
+//
+// See NodeIDGenerator.AssignIDs.
+//
+func AssignNodeIDs(element Element) {
+	var generator NodeIDGenerator
+	generator.AssignIDs(element)
+}
+
+// AssignProgramNodeIDs assigns IDs to the given program, which has the given code,
+// and all its nested elements.
+//
+func AssignProgramNodeIDs(program *Program, code string) {
+	NewNodeIDGenerator(ProgramTag(code), 0).AssignIDs(program)
+}
+
+// walkNodes calls the given function for the node of the given element,
+// and the nodes of all its nested elements, in depth-first order.
+//
+// In addition to the child elements walked by Element.Walk,
+// the expressions of pre-conditions and post-conditions are walked.
+//
+func walkNodes(element Element, f func(*Node)) {
+	Inspect(element, func(element Element) bool {
+		if element == nil {
+			return true
+		}
+
+		node := elementNode(element)
+		if node == nil {
+			return true
+		}
+
+		f(node)
+
+		switch element := element.(type) {
+		case *FunctionBlock:
+			walkConditionNodes(element.PreConditions, f)
+			walkConditionNodes(element.PostConditions, f)
+
+		case *TransactionDeclaration:
+			walkConditionNodes(element.PreConditions, f)
+			walkConditionNodes(element.PostConditions, f)
+		}
+
+		return true
+	})
+}
+
+func walkConditionNodes(conditions *Conditions, f func(*Node)) {
+	if conditions == nil {
+		return
+	}
+
+	for _, condition := range *conditions {
+		walkNodes(condition.Test, f)
+		if condition.Message != nil {
+			walkNodes(condition.Message, f)
+		}
+	}
+}
+
+type hasNode interface {
+	node() *Node
+}
+
+func (n *Node) node() *Node {
+	return n
+}
+
+func elementNode(element Element) *Node {
+	hasNode, ok := element.(hasNode)
+	if !ok {
+		return nil
+	}
+	return hasNode.node()
+}
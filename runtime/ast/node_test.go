@@ -0,0 +1,186 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ast_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/parser"
+)
+
+func TestAssignNodeIDs(t *testing.T) {
+
+	t.Parallel()
+
+	const code = `
+      fun test(x: Int): Int {
+          pre {
+              x > 0: "x must be positive"
+          }
+          post {
+              result > x
+          }
+          let y = x + 1
+          return y * 2
+      }
+    `
+
+	parse := func(t *testing.T) *ast.Program {
+		program, err := parser.ParseProgram(code, nil)
+		require.NoError(t, err)
+		return program
+	}
+
+	elementIDs := func(program *ast.Program) []ast.NodeID {
+		var ids []ast.NodeID
+		ast.Inspect(program, func(element ast.Element) bool {
+			if element != nil {
+				ids = append(ids, element.ID())
+			}
+			return true
+		})
+		return ids
+	}
+
+	t.Run("assigned by parser", func(t *testing.T) {
+
+		t.Parallel()
+
+		program := parse(t)
+
+		assert.Equal(t,
+			ast.NewNodeID(ast.ProgramTag(code), 1),
+			program.ID(),
+		)
+
+		ids := elementIDs(program)
+
+		for i, id := range ids {
+			require.NotEqual(t, ast.NodeIDUnassigned, id)
+			assert.Equal(t, ast.ProgramTag(code), id.ProgramTag())
+			if i > 0 {
+				assert.Greater(t, id.Index(), ids[i-1].Index())
+			}
+		}
+
+		// Conditions are assigned IDs, too
+
+		functionBlock := program.FunctionDeclarations()[0].FunctionBlock
+
+		preCondition := (*functionBlock.PreConditions)[0]
+		assert.NotEqual(t, ast.NodeIDUnassigned, preCondition.Test.ID())
+		assert.NotEqual(t, ast.NodeIDUnassigned, preCondition.Message.ID())
+
+		postCondition := (*functionBlock.PostConditions)[0]
+		assert.NotEqual(t, ast.NodeIDUnassigned, postCondition.Test.ID())
+	})
+
+	t.Run("stable", func(t *testing.T) {
+
+		t.Parallel()
+
+		program1 := parse(t)
+		program2 := parse(t)
+
+		assert.Equal(t,
+			elementIDs(program1),
+			elementIDs(program2),
+		)
+	})
+
+	t.Run("different programs", func(t *testing.T) {
+
+		t.Parallel()
+
+		program1 := parse(t)
+
+		program2, err := parser.ParseProgram(code+"\n", nil)
+		require.NoError(t, err)
+
+		// The programs have the same structure, so the indices are the same,
+		// but the IDs differ, as the code differs
+
+		ids1 := elementIDs(program1)
+		ids2 := elementIDs(program2)
+		require.Equal(t, len(ids1), len(ids2))
+
+		for i, id1 := range ids1 {
+			id2 := ids2[i]
+			assert.Equal(t, id1.Index(), id2.Index())
+			assert.NotEqual(t, id1, id2)
+		}
+	})
+
+	t.Run("constructed", func(t *testing.T) {
+
+		t.Parallel()
+
+		expression := &ast.BinaryExpression{
+			Operation: ast.OperationPlus,
+			Left: &ast.IdentifierExpression{
+				Identifier: ast.Identifier{
+					Identifier: "x",
+				},
+			},
+			Right: &ast.IdentifierExpression{
+				Identifier: ast.Identifier{
+					Identifier: "y",
+				},
+			},
+		}
+
+		ast.AssignNodeIDs(expression)
+
+		assert.Equal(t, ast.NewNodeID(0, 1), expression.ID())
+		assert.Equal(t, ast.NewNodeID(0, 2), expression.Left.ID())
+		assert.Equal(t, ast.NewNodeID(0, 3), expression.Right.ID())
+	})
+
+	t.Run("already assigned", func(t *testing.T) {
+
+		t.Parallel()
+
+		program := parse(t)
+
+		ids := elementIDs(program)
+
+		generator := ast.NewNodeIDGenerator(ast.ProgramTag(code), 0)
+		generator.AssignIDs(program)
+
+		assert.Equal(t, ids, elementIDs(program))
+
+		// IDs assigned to new elements are greater
+		// than all previously assigned IDs
+
+		expression := &ast.IdentifierExpression{
+			Identifier: ast.Identifier{
+				Identifier: "z",
+			},
+		}
+
+		generator.AssignIDs(expression)
+
+		assert.Equal(t, ast.ProgramTag(code), expression.ID().ProgramTag())
+		assert.Greater(t, expression.ID().Index(), ids[len(ids)-1].Index())
+	})
+}
@@ -54,6 +54,8 @@ const (
 	OperationBitwiseAnd
 	OperationBitwiseLeftShift
 	OperationBitwiseRightShift
+	OperationRange
+	OperationInclusiveRange
 )
 
 func OperationCount() int {
@@ -110,6 +112,10 @@ func (s Operation) Symbol() string {
 		return "<<"
 	case OperationBitwiseRightShift:
 		return ">>"
+	case OperationRange:
+		return "..<"
+	case OperationInclusiveRange:
+		return "..."
 	}
 
 	panic(errors.NewUnreachableError())
@@ -154,6 +160,10 @@ func (s Operation) Category() string {
 		OperationBitwiseLeftShift,
 		OperationBitwiseRightShift:
 		return "bitwise"
+
+	case OperationRange,
+		OperationInclusiveRange:
+		return "range"
 	}
 
 	panic(errors.NewUnreachableError())
@@ -24,6 +24,12 @@ type Parameter struct {
 	Label          string
 	Identifier     Identifier
 	TypeAnnotation *TypeAnnotation
+	// DefaultArgument is the expression which is evaluated
+	// if no argument is provided for the parameter, if any
+	DefaultArgument Expression `json:",omitempty"`
+	// Indexed indicates if the parameter is an event parameter
+	// which is marked with the `indexed` modifier
+	Indexed bool `json:",omitempty"`
 	Range
 }
 
@@ -32,14 +38,16 @@ func NewParameter(
 	label string,
 	identifier Identifier,
 	typeAnnotation *TypeAnnotation,
+	defaultArgument Expression,
 	astRange Range,
 ) *Parameter {
 	common.UseMemory(gauge, common.ParameterMemoryUsage)
 	return &Parameter{
-		Label:          label,
-		Identifier:     identifier,
-		TypeAnnotation: typeAnnotation,
-		Range:          astRange,
+		Label:           label,
+		Identifier:      identifier,
+		TypeAnnotation:  typeAnnotation,
+		DefaultArgument: defaultArgument,
+		Range:           astRange,
 	}
 }
 
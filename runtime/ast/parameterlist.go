@@ -78,6 +78,7 @@ func (l *ParameterList) IsEmpty() bool {
 }
 
 const parameterListEmptyDoc = prettier.Text("()")
+const parameterIndexedKeywordDoc = prettier.Text("indexed")
 
 var parameterSeparatorDoc prettier.Doc = prettier.Concat{
 	prettier.Text(","),
@@ -95,6 +96,14 @@ func (l *ParameterList) Doc() prettier.Doc {
 	for _, parameter := range l.Parameters {
 		var parameterDoc prettier.Concat
 
+		if parameter.Indexed {
+			parameterDoc = append(
+				parameterDoc,
+				parameterIndexedKeywordDoc,
+				prettier.Space,
+			)
+		}
+
 		if parameter.Label != "" {
 			parameterDoc = append(
 				parameterDoc,
@@ -110,6 +119,14 @@ func (l *ParameterList) Doc() prettier.Doc {
 			parameter.TypeAnnotation.Doc(),
 		)
 
+		if parameter.DefaultArgument != nil {
+			parameterDoc = append(
+				parameterDoc,
+				prettier.Text(" = "),
+				parameter.DefaultArgument.Doc(),
+			)
+		}
+
 		parameterDocs = append(parameterDocs, parameterDoc)
 	}
 
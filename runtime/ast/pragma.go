@@ -31,6 +31,7 @@ import (
 type PragmaDeclaration struct {
 	Expression Expression
 	Range
+	Node
 }
 
 var _ Element = &PragmaDeclaration{}
@@ -81,6 +82,87 @@ func (d *PragmaDeclaration) DeclarationDocString() string {
 	return ""
 }
 
+// DeprecatedPragmaName is the name of the pragma which marks the member declaration following it as deprecated,
+// e.g. `#deprecated` or `#deprecated("use bar instead")`
+//
+const DeprecatedPragmaName = "deprecated"
+
+// Name returns the name of the pragma,
+// i.e. the identifier of an identifier pragma or the invoked identifier of an invocation pragma,
+// or an empty string if the pragma is neither.
+//
+func (d *PragmaDeclaration) Name() string {
+	expression := d.Expression
+	if invocation, ok := expression.(*InvocationExpression); ok {
+		expression = invocation.InvokedExpression
+	}
+
+	identifierExpression, ok := expression.(*IdentifierExpression)
+	if !ok {
+		return ""
+	}
+
+	return identifierExpression.Identifier.Identifier
+}
+
+// DeprecationMessage returns the message of the pragma, if it is a deprecated pragma.
+// The message is empty if the pragma has no message argument.
+// The second result is false if the pragma is not a deprecated pragma.
+//
+func (d *PragmaDeclaration) DeprecationMessage() (string, bool) {
+	if d.Name() != DeprecatedPragmaName {
+		return "", false
+	}
+
+	invocation, ok := d.Expression.(*InvocationExpression)
+	if !ok || len(invocation.Arguments) == 0 {
+		return "", true
+	}
+
+	stringExpression, ok := invocation.Arguments[0].Expression.(*StringExpression)
+	if !ok {
+		return "", true
+	}
+
+	return stringExpression.Value, true
+}
+
+// CadenceVersionPragmaName is the name of the pragma which declares the language version of the program,
+// e.g. `#cadence(version: "0.25")`
+//
+const CadenceVersionPragmaName = "cadence"
+
+// CadenceVersionPragmaVersionLabel is the label of the version argument of the cadence version pragma
+//
+const CadenceVersionPragmaVersionLabel = "version"
+
+// CadenceVersion returns the version of the pragma, if it is a cadence version pragma,
+// i.e. an invocation with a single string argument labeled `version`.
+// The second result is false if the pragma is not a well-formed cadence version pragma.
+//
+func (d *PragmaDeclaration) CadenceVersion() (string, bool) {
+	if d.Name() != CadenceVersionPragmaName {
+		return "", false
+	}
+
+	invocation, ok := d.Expression.(*InvocationExpression)
+	if !ok || len(invocation.Arguments) != 1 {
+		return "", false
+	}
+
+	argument := invocation.Arguments[0]
+	if argument.Label != CadenceVersionPragmaVersionLabel {
+		return "", false
+	}
+
+	stringExpression, ok := argument.Expression.(*StringExpression)
+	if !ok {
+		return "", false
+	}
+
+	return stringExpression.Value, true
+}
+
 func (d *PragmaDeclaration) MarshalJSON() ([]byte, error) {
 	type Alias PragmaDeclaration
 	return json.Marshal(&struct {
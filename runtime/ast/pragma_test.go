@@ -102,3 +102,178 @@ func TestPragmaDeclaration_String(t *testing.T) {
 		decl.String(),
 	)
 }
+
+func TestPragmaDeclaration_DeprecationMessage(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("identifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		decl := &PragmaDeclaration{
+			Expression: &IdentifierExpression{
+				Identifier: Identifier{
+					Identifier: "deprecated",
+				},
+			},
+		}
+
+		assert.Equal(t, "deprecated", decl.Name())
+
+		message, ok := decl.DeprecationMessage()
+		assert.True(t, ok)
+		assert.Equal(t, "", message)
+	})
+
+	t.Run("invocation", func(t *testing.T) {
+
+		t.Parallel()
+
+		decl := &PragmaDeclaration{
+			Expression: &InvocationExpression{
+				InvokedExpression: &IdentifierExpression{
+					Identifier: Identifier{
+						Identifier: "deprecated",
+					},
+				},
+				Arguments: Arguments{
+					{
+						Expression: &StringExpression{
+							Value: "use bar",
+						},
+					},
+				},
+			},
+		}
+
+		assert.Equal(t, "deprecated", decl.Name())
+
+		message, ok := decl.DeprecationMessage()
+		assert.True(t, ok)
+		assert.Equal(t, "use bar", message)
+	})
+
+	t.Run("other pragma", func(t *testing.T) {
+
+		t.Parallel()
+
+		decl := &PragmaDeclaration{
+			Expression: &IdentifierExpression{
+				Identifier: Identifier{
+					Identifier: "pedantic",
+				},
+			},
+		}
+
+		assert.Equal(t, "pedantic", decl.Name())
+
+		_, ok := decl.DeprecationMessage()
+		assert.False(t, ok)
+	})
+}
+
+func TestPragmaDeclaration_CadenceVersion(t *testing.T) {
+
+	t.Parallel()
+
+	newVersionPragma := func(label string, argument Expression) *PragmaDeclaration {
+		return &PragmaDeclaration{
+			Expression: &InvocationExpression{
+				InvokedExpression: &IdentifierExpression{
+					Identifier: Identifier{
+						Identifier: "cadence",
+					},
+				},
+				Arguments: Arguments{
+					{
+						Label:      label,
+						Expression: argument,
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		decl := newVersionPragma(
+			"version",
+			&StringExpression{
+				Value: "0.25",
+			},
+		)
+
+		assert.Equal(t, "cadence", decl.Name())
+
+		version, ok := decl.CadenceVersion()
+		assert.True(t, ok)
+		assert.Equal(t, "0.25", version)
+	})
+
+	t.Run("missing label", func(t *testing.T) {
+
+		t.Parallel()
+
+		decl := newVersionPragma(
+			"",
+			&StringExpression{
+				Value: "0.25",
+			},
+		)
+
+		_, ok := decl.CadenceVersion()
+		assert.False(t, ok)
+	})
+
+	t.Run("non-string argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		decl := newVersionPragma(
+			"version",
+			&IdentifierExpression{
+				Identifier: Identifier{
+					Identifier: "x",
+				},
+			},
+		)
+
+		_, ok := decl.CadenceVersion()
+		assert.False(t, ok)
+	})
+
+	t.Run("identifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		decl := &PragmaDeclaration{
+			Expression: &IdentifierExpression{
+				Identifier: Identifier{
+					Identifier: "cadence",
+				},
+			},
+		}
+
+		_, ok := decl.CadenceVersion()
+		assert.False(t, ok)
+	})
+
+	t.Run("other pragma", func(t *testing.T) {
+
+		t.Parallel()
+
+		decl := &PragmaDeclaration{
+			Expression: &IdentifierExpression{
+				Identifier: Identifier{
+					Identifier: "pedantic",
+				},
+			},
+		}
+
+		_, ok := decl.CadenceVersion()
+		assert.False(t, ok)
+	})
+}
@@ -42,6 +42,9 @@ const (
 	//   OperationLessEqual, OperationLess,
 	//   OperationGreater, or OperationGreaterEqual
 	precedenceComparison
+	// precedenceRange is the precedence of
+	// - BinaryExpression, with OperationRange or OperationInclusiveRange
+	precedenceRange
 	// precedenceNilCoalescing is the precedence of
 	// - BinaryExpression, with OperationNilCoalesce. right associative!
 	precedenceNilCoalescing
@@ -88,6 +91,7 @@ const (
 	// - FixedPointExpression
 	// - ArrayExpression
 	// - DictionaryExpression
+	// - TupleExpression
 	// - IdentifierExpression
 	// - FunctionExpression
 	// - PathExpression
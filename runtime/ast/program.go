@@ -30,6 +30,7 @@ type Program struct {
 	// all declarations, in the order they are defined
 	declarations []Declaration
 	indices      programIndices
+	Node
 }
 
 var _ Element = &Program{}
@@ -102,6 +103,10 @@ func (p *Program) VariableDeclarations() []*VariableDeclaration {
 	return p.indices.variableDeclarations(p.declarations)
 }
 
+func (p *Program) TypeAliasDeclarations() []*TypeAliasDeclaration {
+	return p.indices.typeAliasDeclarations(p.declarations)
+}
+
 // SoleContractDeclaration returns the sole contract declaration, if any,
 // and if there are no other actionable declarations.
 //
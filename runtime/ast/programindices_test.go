@@ -113,6 +113,13 @@ func TestProgramIndices(t *testing.T) {
 		},
 	}
 
+	typeAliasA := &TypeAliasDeclaration{
+		Identifier: Identifier{Identifier: "A"},
+	}
+	typeAliasB := &TypeAliasDeclaration{
+		Identifier: Identifier{Identifier: "B"},
+	}
+
 	program := NewProgram(
 		nil,
 		[]Declaration{
@@ -121,6 +128,7 @@ func TestProgramIndices(t *testing.T) {
 			transactionC,
 			functionC,
 			interfaceB,
+			typeAliasB,
 			transactionA,
 			compositeB,
 			importC,
@@ -133,6 +141,7 @@ func TestProgramIndices(t *testing.T) {
 			functionB,
 			interfaceC,
 			pragmaC,
+			typeAliasA,
 			compositeA,
 		},
 	)
@@ -198,6 +207,14 @@ func TestProgramIndices(t *testing.T) {
 				},
 				program.PragmaDeclarations(),
 			)
+
+			require.Equal(t,
+				[]*TypeAliasDeclaration{
+					typeAliasB,
+					typeAliasA,
+				},
+				program.TypeAliasDeclarations(),
+			)
 		}()
 	}
 
@@ -39,6 +39,7 @@ type Statement interface {
 type ReturnStatement struct {
 	Expression Expression
 	Range
+	Node
 }
 
 var _ Element = &ReturnStatement{}
@@ -100,15 +101,22 @@ func (s *ReturnStatement) MarshalJSON() ([]byte, error) {
 // BreakStatement
 
 type BreakStatement struct {
+	Label *Identifier
 	Range
+	Node
 }
 
 var _ Element = &BreakStatement{}
 var _ Statement = &BreakStatement{}
 
-func NewBreakStatement(gauge common.MemoryGauge, tokenRange Range) *BreakStatement {
+func NewBreakStatement(
+	gauge common.MemoryGauge,
+	label *Identifier,
+	tokenRange Range,
+) *BreakStatement {
 	common.UseMemory(gauge, common.BreakStatementMemoryUsage)
 	return &BreakStatement{
+		Label: label,
 		Range: tokenRange,
 	}
 }
@@ -129,8 +137,16 @@ func (*BreakStatement) Walk(_ func(Element)) {
 
 const breakStatementKeywordDoc = prettier.Text("break")
 
-func (*BreakStatement) Doc() prettier.Doc {
-	return breakStatementKeywordDoc
+func (s *BreakStatement) Doc() prettier.Doc {
+	if s.Label == nil {
+		return breakStatementKeywordDoc
+	}
+
+	return prettier.Concat{
+		breakStatementKeywordDoc,
+		prettier.Space,
+		prettier.Text(s.Label.Identifier),
+	}
 }
 
 func (s *BreakStatement) String() string {
@@ -151,15 +167,22 @@ func (s *BreakStatement) MarshalJSON() ([]byte, error) {
 // ContinueStatement
 
 type ContinueStatement struct {
+	Label *Identifier
 	Range
+	Node
 }
 
 var _ Element = &ContinueStatement{}
 var _ Statement = &ContinueStatement{}
 
-func NewContinueStatement(gauge common.MemoryGauge, tokenRange Range) *ContinueStatement {
+func NewContinueStatement(
+	gauge common.MemoryGauge,
+	label *Identifier,
+	tokenRange Range,
+) *ContinueStatement {
 	common.UseMemory(gauge, common.ContinueStatementMemoryUsage)
 	return &ContinueStatement{
+		Label: label,
 		Range: tokenRange,
 	}
 }
@@ -180,8 +203,16 @@ func (*ContinueStatement) Walk(_ func(Element)) {
 
 const continueStatementKeywordDoc = prettier.Text("continue")
 
-func (*ContinueStatement) Doc() prettier.Doc {
-	return continueStatementKeywordDoc
+func (s *ContinueStatement) Doc() prettier.Doc {
+	if s.Label == nil {
+		return continueStatementKeywordDoc
+	}
+
+	return prettier.Concat{
+		continueStatementKeywordDoc,
+		prettier.Space,
+		prettier.Text(s.Label.Identifier),
+	}
 }
 
 func (s *ContinueStatement) String() string {
@@ -214,6 +245,7 @@ type IfStatement struct {
 	Then     *Block
 	Else     *Block
 	StartPos Position `json:"-"`
+	Node
 }
 
 var _ Element = &IfStatement{}
@@ -322,9 +354,11 @@ func (s *IfStatement) MarshalJSON() ([]byte, error) {
 // WhileStatement
 
 type WhileStatement struct {
+	Label    *Identifier
 	Test     Expression
 	Block    *Block
 	StartPos Position `json:"-"`
+	Node
 }
 
 var _ Element = &WhileStatement{}
@@ -332,12 +366,14 @@ var _ Statement = &WhileStatement{}
 
 func NewWhileStatement(
 	gauge common.MemoryGauge,
+	label *Identifier,
 	expression Expression,
 	block *Block,
 	startPos Position,
 ) *WhileStatement {
 	common.UseMemory(gauge, common.WhileStatementMemoryUsage)
 	return &WhileStatement{
+		Label:    label,
 		Test:     expression,
 		Block:    block,
 		StartPos: startPos,
@@ -369,14 +405,29 @@ func (s *WhileStatement) EndPosition(memoryGauge common.MemoryGauge) Position {
 
 const whileStatementKeywordSpaceDoc = prettier.Text("while ")
 
+const loopLabelSeparatorDoc = prettier.Text(": ")
+
 func (s *WhileStatement) Doc() prettier.Doc {
+	var doc prettier.Concat
+
+	if s.Label != nil {
+		doc = append(
+			doc,
+			prettier.Text(s.Label.Identifier),
+			loopLabelSeparatorDoc,
+		)
+	}
+
+	doc = append(
+		doc,
+		whileStatementKeywordSpaceDoc,
+		s.Test.Doc(),
+		prettier.Space,
+		s.Block.Doc(),
+	)
+
 	return prettier.Group{
-		Doc: prettier.Concat{
-			whileStatementKeywordSpaceDoc,
-			s.Test.Doc(),
-			prettier.Space,
-			s.Block.Doc(),
-		},
+		Doc: doc,
 	}
 }
 
@@ -400,11 +451,13 @@ func (s *WhileStatement) MarshalJSON() ([]byte, error) {
 // ForStatement
 
 type ForStatement struct {
+	Label      *Identifier
 	Identifier Identifier
 	Index      *Identifier
 	Value      Expression
 	Block      *Block
 	StartPos   Position `json:"-"`
+	Node
 }
 
 var _ Element = &ForStatement{}
@@ -412,6 +465,7 @@ var _ Statement = &ForStatement{}
 
 func NewForStatement(
 	gauge common.MemoryGauge,
+	label *Identifier,
 	identifier Identifier,
 	index *Identifier,
 	block *Block,
@@ -421,6 +475,7 @@ func NewForStatement(
 	common.UseMemory(gauge, common.ForStatementMemoryUsage)
 
 	return &ForStatement{
+		Label:      label,
 		Identifier: identifier,
 		Index:      index,
 		Block:      block,
@@ -456,10 +511,21 @@ const forStatementForKeywordSpaceDoc = prettier.Text("for ")
 const forStatementSpaceInKeywordSpaceDoc = prettier.Text(" in ")
 
 func (s *ForStatement) Doc() prettier.Doc {
-	doc := prettier.Concat{
-		forStatementForKeywordSpaceDoc,
+	var doc prettier.Concat
+
+	if s.Label != nil {
+		doc = append(
+			doc,
+			prettier.Text(s.Label.Identifier),
+			loopLabelSeparatorDoc,
+		)
 	}
 
+	doc = append(
+		doc,
+		forStatementForKeywordSpaceDoc,
+	)
+
 	if s.Index != nil {
 		doc = append(
 			doc,
@@ -504,6 +570,7 @@ func (s *ForStatement) MarshalJSON() ([]byte, error) {
 type EmitStatement struct {
 	InvocationExpression *InvocationExpression
 	StartPos             Position `json:"-"`
+	Node
 }
 
 var _ Element = &EmitStatement{}
@@ -569,12 +636,90 @@ func (s *EmitStatement) MarshalJSON() ([]byte, error) {
 	})
 }
 
+// RemoveStatement
+
+type RemoveStatement struct {
+	Attachment *NominalType
+	Value      Expression
+	StartPos   Position `json:"-"`
+	Node
+}
+
+var _ Element = &RemoveStatement{}
+var _ Statement = &RemoveStatement{}
+
+func NewRemoveStatement(
+	gauge common.MemoryGauge,
+	attachment *NominalType,
+	value Expression,
+	startPos Position,
+) *RemoveStatement {
+	common.UseMemory(gauge, common.RemoveStatementMemoryUsage)
+	return &RemoveStatement{
+		Attachment: attachment,
+		Value:      value,
+		StartPos:   startPos,
+	}
+}
+
+func (*RemoveStatement) ElementType() ElementType {
+	return ElementTypeRemoveStatement
+}
+
+func (*RemoveStatement) isStatement() {}
+
+func (s *RemoveStatement) StartPosition() Position {
+	return s.StartPos
+}
+
+func (s *RemoveStatement) EndPosition(memoryGauge common.MemoryGauge) Position {
+	return s.Value.EndPosition(memoryGauge)
+}
+
+func (s *RemoveStatement) Accept(visitor Visitor) Repr {
+	return visitor.VisitRemoveStatement(s)
+}
+
+func (s *RemoveStatement) Walk(walkChild func(Element)) {
+	walkChild(s.Value)
+}
+
+const removeStatementRemoveKeywordSpaceDoc = prettier.Text("remove ")
+const removeStatementFromKeywordSpaceDoc = prettier.Text(" from ")
+
+func (s *RemoveStatement) Doc() prettier.Doc {
+	return prettier.Concat{
+		removeStatementRemoveKeywordSpaceDoc,
+		s.Attachment.Doc(),
+		removeStatementFromKeywordSpaceDoc,
+		s.Value.Doc(),
+	}
+}
+
+func (s *RemoveStatement) String() string {
+	return Prettier(s)
+}
+
+func (s *RemoveStatement) MarshalJSON() ([]byte, error) {
+	type Alias RemoveStatement
+	return json.Marshal(&struct {
+		Type string
+		Range
+		*Alias
+	}{
+		Type:  "RemoveStatement",
+		Range: NewUnmeteredRangeFromPositioned(s),
+		Alias: (*Alias)(s),
+	})
+}
+
 // AssignmentStatement
 
 type AssignmentStatement struct {
 	Target   Expression
 	Transfer *Transfer
 	Value    Expression
+	Node
 }
 
 var _ Element = &AssignmentStatement{}
@@ -656,6 +801,7 @@ func (s *AssignmentStatement) MarshalJSON() ([]byte, error) {
 type SwapStatement struct {
 	Left  Expression
 	Right Expression
+	Node
 }
 
 var _ Element = &SwapStatement{}
@@ -725,6 +871,7 @@ func (s *SwapStatement) MarshalJSON() ([]byte, error) {
 
 type ExpressionStatement struct {
 	Expression Expression
+	Node
 }
 
 var _ Element = &ExpressionStatement{}
@@ -786,6 +933,7 @@ type SwitchStatement struct {
 	Expression Expression
 	Cases      []*SwitchCase
 	Range
+	Node
 }
 
 var _ Element = &SwitchStatement{}
@@ -882,10 +1030,20 @@ func (s *SwitchStatement) MarshalJSON() ([]byte, error) {
 
 type SwitchCase struct {
 	Expression Expression
+	// Pattern is the pattern of the case, if any.
+	// A case has either an expression or a pattern,
+	// and the default case has neither
+	Pattern    *OptionalBindingPattern `json:",omitempty"`
 	Statements []Statement
 	Range
 }
 
+// IsDefault returns true if the case is the default case
+//
+func (s *SwitchCase) IsDefault() bool {
+	return s.Expression == nil && s.Pattern == nil
+}
+
 func (s *SwitchCase) MarshalJSON() ([]byte, error) {
 	type Alias SwitchCase
 	return json.Marshal(&struct {
@@ -906,17 +1064,67 @@ func (s *SwitchCase) Doc() prettier.Doc {
 		Doc: StatementsDoc(s.Statements),
 	}
 
-	if s.Expression == nil {
+	if s.IsDefault() {
 		return prettier.Concat{
 			switchCaseDefaultKeywordSpaceDoc,
 			statementsDoc,
 		}
 	}
 
+	var caseDoc prettier.Doc
+	if s.Pattern != nil {
+		caseDoc = s.Pattern.Doc()
+	} else {
+		caseDoc = s.Expression.Doc()
+	}
+
 	return prettier.Concat{
 		switchCaseKeywordSpaceDoc,
-		s.Expression.Doc(),
+		caseDoc,
 		switchCaseColonSymbolDoc,
 		statementsDoc,
 	}
 }
+
+// OptionalBindingPattern is a switch case pattern,
+// which matches non-nil optional values,
+// and binds the unwrapped value to a new constant, e.g. `let x?`
+//
+type OptionalBindingPattern struct {
+	Identifier Identifier
+	Range
+}
+
+func NewOptionalBindingPattern(
+	gauge common.MemoryGauge,
+	identifier Identifier,
+	patternRange Range,
+) *OptionalBindingPattern {
+	common.UseMemory(gauge, common.OptionalBindingPatternMemoryUsage)
+	return &OptionalBindingPattern{
+		Identifier: identifier,
+		Range:      patternRange,
+	}
+}
+
+const optionalBindingPatternKeywordSpaceDoc = prettier.Text("let ")
+const optionalBindingPatternQuestionMarkDoc = prettier.Text("?")
+
+func (p *OptionalBindingPattern) Doc() prettier.Doc {
+	return prettier.Concat{
+		optionalBindingPatternKeywordSpaceDoc,
+		prettier.Text(p.Identifier.Identifier),
+		optionalBindingPatternQuestionMarkDoc,
+	}
+}
+
+func (p *OptionalBindingPattern) MarshalJSON() ([]byte, error) {
+	type Alias OptionalBindingPattern
+	return json.Marshal(&struct {
+		Type string
+		*Alias
+	}{
+		Type:  "OptionalBindingPattern",
+		Alias: (*Alias)(p),
+	})
+}
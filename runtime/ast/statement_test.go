@@ -220,6 +220,7 @@ func TestBreakStatement_MarshalJSON(t *testing.T) {
 		`
         {
             "Type": "BreakStatement",
+            "Label": null,
             "StartPos": {"Offset": 1, "Line": 2, "Column": 3},
             "EndPos": {"Offset": 4, "Line": 5, "Column": 6}
         }
@@ -248,6 +249,22 @@ func TestBreakStatement_String(t *testing.T) {
 	)
 }
 
+func TestBreakStatement_WithLabel_String(t *testing.T) {
+
+	t.Parallel()
+
+	stmt := &BreakStatement{
+		Label: &Identifier{
+			Identifier: "outer",
+		},
+	}
+
+	assert.Equal(t,
+		"break outer",
+		stmt.String(),
+	)
+}
+
 func TestContinueStatement_MarshalJSON(t *testing.T) {
 
 	t.Parallel()
@@ -266,6 +283,7 @@ func TestContinueStatement_MarshalJSON(t *testing.T) {
 		`
         {
             "Type": "ContinueStatement",
+            "Label": null,
             "StartPos": {"Offset": 1, "Line": 2, "Column": 3},
             "EndPos": {"Offset": 4, "Line": 5, "Column": 6}
         }
@@ -294,6 +312,22 @@ func TestContinueStatement_String(t *testing.T) {
 	)
 }
 
+func TestContinueStatement_WithLabel_String(t *testing.T) {
+
+	t.Parallel()
+
+	stmt := &ContinueStatement{
+		Label: &Identifier{
+			Identifier: "outer",
+		},
+	}
+
+	assert.Equal(t,
+		"continue outer",
+		stmt.String(),
+	)
+}
+
 func TestIfStatement_MarshalJSON(t *testing.T) {
 
 	t.Parallel()
@@ -526,6 +560,7 @@ func TestWhileStatement_MarshalJSON(t *testing.T) {
 		`
         {
             "Type": "WhileStatement",
+            "Label": null,
             "Test": {
                 "Type": "BoolExpression",
                 "Value": false,
@@ -591,6 +626,28 @@ func TestWhileStatement_String(t *testing.T) {
 	)
 }
 
+func TestWhileStatement_WithLabel_String(t *testing.T) {
+
+	t.Parallel()
+
+	stmt := &WhileStatement{
+		Label: &Identifier{
+			Identifier: "outer",
+		},
+		Test: &BoolExpression{
+			Value: false,
+		},
+		Block: &Block{
+			Statements: []Statement{},
+		},
+	}
+
+	assert.Equal(t,
+		"outer: while false {}",
+		stmt.String(),
+	)
+}
+
 func TestForStatement_MarshalJSON(t *testing.T) {
 
 	t.Parallel()
@@ -628,6 +685,7 @@ func TestForStatement_MarshalJSON(t *testing.T) {
 			`
             {
                 "Type": "ForStatement",
+                "Label": null,
                 "Identifier": {
                     "Identifier": "foobar",
                     "StartPos": {"Offset": 1, "Line": 2, "Column": 3},
@@ -691,6 +749,7 @@ func TestForStatement_MarshalJSON(t *testing.T) {
 			`
             {
                 "Type": "ForStatement",
+                "Label": null,
                 "Index": {
                     "Identifier": "i",
                     "StartPos": {"Offset": 1, "Line": 2, "Column": 3},
@@ -1494,3 +1553,196 @@ func TestSwitchStatement_Doc(t *testing.T) {
 		stmt.String(),
 	)
 }
+
+func TestOptionalBindingPattern_MarshalJSON(t *testing.T) {
+
+	t.Parallel()
+
+	pattern := &OptionalBindingPattern{
+		Identifier: Identifier{
+			Identifier: "foo",
+			Pos:        Position{Offset: 5, Line: 2, Column: 7},
+		},
+		Range: Range{
+			StartPos: Position{Offset: 1, Line: 2, Column: 3},
+			EndPos:   Position{Offset: 8, Line: 2, Column: 10},
+		},
+	}
+
+	actual, err := json.Marshal(pattern)
+	require.NoError(t, err)
+
+	assert.JSONEq(t,
+		`
+        {
+            "Type": "OptionalBindingPattern",
+            "Identifier": {
+                "Identifier": "foo",
+                "StartPos": {"Offset": 5, "Line": 2, "Column": 7},
+                "EndPos": {"Offset": 7, "Line": 2, "Column": 9}
+            },
+            "StartPos": {"Offset": 1, "Line": 2, "Column": 3},
+            "EndPos": {"Offset": 8, "Line": 2, "Column": 10}
+        }
+        `,
+		string(actual),
+	)
+}
+
+func TestSwitchStatement_OptionalBindingPattern_String(t *testing.T) {
+
+	t.Parallel()
+
+	stmt := &SwitchStatement{
+		Expression: &IdentifierExpression{
+			Identifier: Identifier{
+				Identifier: "foo",
+			},
+		},
+		Cases: []*SwitchCase{
+			{
+				Pattern: &OptionalBindingPattern{
+					Identifier: Identifier{
+						Identifier: "bar",
+					},
+				},
+				Statements: []Statement{
+					&ExpressionStatement{
+						Expression: &IdentifierExpression{
+							Identifier: Identifier{
+								Identifier: "bar",
+							},
+						},
+					},
+				},
+			},
+			{
+				Statements: []Statement{
+					&ExpressionStatement{
+						Expression: &IdentifierExpression{
+							Identifier: Identifier{
+								Identifier: "baz",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t,
+		"switch foo {\n"+
+			"    case let bar?:\n"+
+			"        bar\n"+
+			"    default:\n"+
+			"        baz\n"+
+			"}",
+		stmt.String(),
+	)
+}
+
+func TestRemoveStatement_MarshalJSON(t *testing.T) {
+
+	t.Parallel()
+
+	stmt := &RemoveStatement{
+		Attachment: &NominalType{
+			Identifier: Identifier{
+				Identifier: "A",
+				Pos:        Position{Offset: 1, Line: 2, Column: 3},
+			},
+		},
+		Value: &IdentifierExpression{
+			Identifier: Identifier{
+				Identifier: "foo",
+				Pos:        Position{Offset: 4, Line: 5, Column: 6},
+			},
+		},
+		StartPos: Position{Offset: 7, Line: 8, Column: 9},
+	}
+
+	actual, err := json.Marshal(stmt)
+	require.NoError(t, err)
+
+	assert.JSONEq(t,
+		`
+        {
+            "Type": "RemoveStatement",
+            "Attachment": {
+                "Type": "NominalType",
+                "Identifier": {
+                    "Identifier": "A",
+                    "StartPos": {"Offset": 1, "Line": 2, "Column": 3},
+                    "EndPos": {"Offset": 1, "Line": 2, "Column": 3}
+                },
+                "StartPos": {"Offset": 1, "Line": 2, "Column": 3},
+                "EndPos": {"Offset": 1, "Line": 2, "Column": 3}
+            },
+            "Value": {
+                "Type": "IdentifierExpression",
+                "Identifier": {
+                    "Identifier": "foo",
+                    "StartPos": {"Offset": 4, "Line": 5, "Column": 6},
+                    "EndPos": {"Offset": 6, "Line": 5, "Column": 8}
+                },
+                "StartPos": {"Offset": 4, "Line": 5, "Column": 6},
+                "EndPos": {"Offset": 6, "Line": 5, "Column": 8}
+            },
+            "StartPos": {"Offset": 7, "Line": 8, "Column": 9},
+            "EndPos": {"Offset": 6, "Line": 5, "Column": 8}
+        }
+        `,
+		string(actual),
+	)
+}
+
+func TestRemoveStatement_Doc(t *testing.T) {
+
+	t.Parallel()
+
+	stmt := &RemoveStatement{
+		Attachment: &NominalType{
+			Identifier: Identifier{
+				Identifier: "A",
+			},
+		},
+		Value: &IdentifierExpression{
+			Identifier: Identifier{
+				Identifier: "foo",
+			},
+		},
+	}
+
+	require.Equal(t,
+		prettier.Concat{
+			prettier.Text("remove "),
+			prettier.Text("A"),
+			prettier.Text(" from "),
+			prettier.Text("foo"),
+		},
+		stmt.Doc(),
+	)
+}
+
+func TestRemoveStatement_String(t *testing.T) {
+
+	t.Parallel()
+
+	stmt := &RemoveStatement{
+		Attachment: &NominalType{
+			Identifier: Identifier{
+				Identifier: "A",
+			},
+		},
+		Value: &IdentifierExpression{
+			Identifier: Identifier{
+				Identifier: "foo",
+			},
+		},
+	}
+
+	require.Equal(t,
+		"remove A from foo",
+		stmt.String(),
+	)
+}
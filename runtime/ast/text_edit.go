@@ -0,0 +1,46 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ast
+
+// TextEdit is an edit of source code.
+//
+// If the replacement is non-empty, the text in the range is replaced with it.
+// Otherwise, the insertion is inserted before the start of the range.
+//
+type TextEdit struct {
+	Replacement string
+	Insertion   string
+	Range
+}
+
+// ApplyTo applies the text edit to the given code,
+// and returns the resulting code
+//
+func (edit TextEdit) ApplyTo(code string) string {
+	if len(edit.Insertion) > 0 {
+		offset := edit.StartPos.Offset
+		return code[:offset] +
+			edit.Insertion +
+			code[offset:]
+	}
+
+	return code[:edit.StartPos.Offset] +
+		edit.Replacement +
+		code[edit.EndPos.Offset+1:]
+}
@@ -0,0 +1,83 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ast
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTextEdit_ApplyTo(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("insertion", func(t *testing.T) {
+
+		t.Parallel()
+
+		edit := TextEdit{
+			Insertion: "<-",
+			Range: Range{
+				StartPos: Position{Offset: 4, Line: 1, Column: 4},
+				EndPos:   Position{Offset: 4, Line: 1, Column: 4},
+			},
+		}
+
+		assert.Equal(t,
+			"foo(<-r)",
+			edit.ApplyTo("foo(r)"),
+		)
+	})
+
+	t.Run("replacement", func(t *testing.T) {
+
+		t.Parallel()
+
+		edit := TextEdit{
+			Replacement: "<-",
+			Range: Range{
+				StartPos: Position{Offset: 6, Line: 1, Column: 6},
+				EndPos:   Position{Offset: 6, Line: 1, Column: 6},
+			},
+		}
+
+		assert.Equal(t,
+			"let r <- create R()",
+			edit.ApplyTo("let r = create R()"),
+		)
+	})
+
+	t.Run("removal", func(t *testing.T) {
+
+		t.Parallel()
+
+		edit := TextEdit{
+			Range: Range{
+				StartPos: Position{Offset: 3, Line: 1, Column: 3},
+				EndPos:   Position{Offset: 4, Line: 1, Column: 4},
+			},
+		}
+
+		assert.Equal(t,
+			"foobar",
+			edit.ApplyTo("foo  bar"),
+		)
+	})
+}
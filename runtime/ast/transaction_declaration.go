@@ -35,6 +35,7 @@ type TransactionDeclaration struct {
 	PostConditions *Conditions
 	DocString      string
 	Range
+	Node
 }
 
 var _ Element = &TransactionDeclaration{}
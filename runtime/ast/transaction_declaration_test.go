@@ -156,7 +156,7 @@ func TestTransactionDeclaration_Doc(t *testing.T) {
 					Block: &Block{
 						Statements: []Statement{
 							&ExpressionStatement{
-								&StringExpression{
+								Expression: &StringExpression{
 									Value: "xyz",
 								},
 							},
@@ -418,7 +418,7 @@ func TestTransactionDeclaration_String(t *testing.T) {
 					Block: &Block{
 						Statements: []Statement{
 							&ExpressionStatement{
-								&StringExpression{
+								Expression: &StringExpression{
 									Value: "xyz",
 								},
 							},
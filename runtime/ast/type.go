@@ -770,6 +770,83 @@ func (t *InstantiationType) CheckEqual(other Type, checker TypeEqualityChecker)
 	return checker.CheckInstantiationTypeEquality(t, other)
 }
 
+// TupleType is a fixed-size, heterogeneous sequence of types
+
+type TupleType struct {
+	Types []Type
+	Range
+}
+
+var _ Type = &TupleType{}
+
+func NewTupleType(
+	memoryGauge common.MemoryGauge,
+	types []Type,
+	astRange Range,
+) *TupleType {
+	common.UseMemory(memoryGauge, common.TupleTypeMemoryUsage)
+	return &TupleType{
+		Types: types,
+		Range: astRange,
+	}
+}
+
+func (*TupleType) isType() {}
+
+func (t *TupleType) String() string {
+	return Prettier(t)
+}
+
+const tupleTypeStartDoc = prettier.Text("(")
+const tupleTypeEndDoc = prettier.Text(")")
+const tupleTypeSeparatorDoc = prettier.Text(",")
+
+func (t *TupleType) Doc() prettier.Doc {
+	typesDoc := prettier.Concat{
+		prettier.SoftLine{},
+	}
+
+	for i, elementType := range t.Types {
+		if i > 0 {
+			typesDoc = append(
+				typesDoc,
+				tupleTypeSeparatorDoc,
+				prettier.Line{},
+			)
+		}
+		typesDoc = append(
+			typesDoc,
+			elementType.Doc(),
+		)
+	}
+
+	return prettier.Group{
+		Doc: prettier.Concat{
+			tupleTypeStartDoc,
+			prettier.Indent{
+				Doc: typesDoc,
+			},
+			prettier.SoftLine{},
+			tupleTypeEndDoc,
+		},
+	}
+}
+
+func (t *TupleType) MarshalJSON() ([]byte, error) {
+	type Alias TupleType
+	return json.Marshal(&struct {
+		Type string
+		*Alias
+	}{
+		Type:  "TupleType",
+		Alias: (*Alias)(t),
+	})
+}
+
+func (t *TupleType) CheckEqual(other Type, checker TypeEqualityChecker) error {
+	return checker.CheckTupleTypeEquality(t, other)
+}
+
 type TypeEqualityChecker interface {
 	CheckNominalTypeEquality(*NominalType, Type) error
 	CheckOptionalTypeEquality(*OptionalType, Type) error
@@ -780,4 +857,5 @@ type TypeEqualityChecker interface {
 	CheckReferenceTypeEquality(*ReferenceType, Type) error
 	CheckRestrictedTypeEquality(*RestrictedType, Type) error
 	CheckInstantiationTypeEquality(*InstantiationType, Type) error
+	CheckTupleTypeEquality(*TupleType, Type) error
 }
@@ -0,0 +1,140 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ast
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/turbolent/prettier"
+)
+
+func TestTypeAliasDeclaration_MarshalJSON(t *testing.T) {
+
+	t.Parallel()
+
+	decl := &TypeAliasDeclaration{
+		Access: AccessPublic,
+		Identifier: Identifier{
+			Identifier: "A",
+			Pos:        Position{Offset: 1, Line: 2, Column: 3},
+		},
+		Type: &NominalType{
+			Identifier: Identifier{
+				Identifier: "B",
+				Pos:        Position{Offset: 4, Line: 5, Column: 6},
+			},
+		},
+		DocString: "test",
+		Range: Range{
+			StartPos: Position{Offset: 7, Line: 8, Column: 9},
+			EndPos:   Position{Offset: 10, Line: 11, Column: 12},
+		},
+	}
+
+	actual, err := json.Marshal(decl)
+	require.NoError(t, err)
+
+	assert.JSONEq(t,
+		`
+        {
+            "Type": "TypeAliasDeclaration",
+            "Access": "AccessPublic",
+            "Identifier": {
+                "Identifier": "A",
+                "StartPos": {"Offset": 1, "Line": 2, "Column": 3},
+                "EndPos": {"Offset": 1, "Line": 2, "Column": 3}
+            },
+            "AliasedType": {
+                "Type": "NominalType",
+                "Identifier": {
+                    "Identifier": "B",
+                    "StartPos": {"Offset": 4, "Line": 5, "Column": 6},
+                    "EndPos": {"Offset": 4, "Line": 5, "Column": 6}
+                },
+                "StartPos": {"Offset": 4, "Line": 5, "Column": 6},
+                "EndPos": {"Offset": 4, "Line": 5, "Column": 6}
+            },
+            "DocString": "test",
+            "StartPos": {"Offset": 7, "Line": 8, "Column": 9},
+            "EndPos": {"Offset": 10, "Line": 11, "Column": 12}
+        }
+        `,
+		string(actual),
+	)
+}
+
+func TestTypeAliasDeclaration_Doc(t *testing.T) {
+
+	t.Parallel()
+
+	decl := &TypeAliasDeclaration{
+		Access: AccessPublic,
+		Identifier: Identifier{
+			Identifier: "A",
+		},
+		Type: &NominalType{
+			Identifier: Identifier{
+				Identifier: "B",
+			},
+		},
+	}
+
+	require.Equal(
+		t,
+		prettier.Concat{
+			prettier.Text("pub"),
+			prettier.Space,
+			prettier.Text("typealias"),
+			prettier.Space,
+			prettier.Text("A"),
+			prettier.Space,
+			prettier.Text("="),
+			prettier.Space,
+			prettier.Text("B"),
+		},
+		decl.Doc(),
+	)
+}
+
+func TestTypeAliasDeclaration_String(t *testing.T) {
+
+	t.Parallel()
+
+	decl := &TypeAliasDeclaration{
+		Identifier: Identifier{
+			Identifier: "A",
+		},
+		Type: &VariableSizedType{
+			Type: &NominalType{
+				Identifier: Identifier{
+					Identifier: "B",
+				},
+			},
+		},
+	}
+
+	require.Equal(
+		t,
+		"typealias A = [B]",
+		decl.String(),
+	)
+}
@@ -0,0 +1,108 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ast
+
+import (
+	"github.com/turbolent/prettier"
+
+	"github.com/onflow/cadence/runtime/common"
+)
+
+// TypeParameter is a type parameter of a function declaration,
+// e.g. `T: AnyStruct & Comparable`.
+//
+// A type argument for the type parameter must be a subtype of all type bounds
+//
+type TypeParameter struct {
+	Identifier Identifier
+	TypeBounds []Type `json:",omitempty"`
+}
+
+func NewTypeParameter(
+	gauge common.MemoryGauge,
+	identifier Identifier,
+	typeBounds []Type,
+) *TypeParameter {
+	common.UseMemory(gauge, common.TypeParameterMemoryUsage)
+	return &TypeParameter{
+		Identifier: identifier,
+		TypeBounds: typeBounds,
+	}
+}
+
+var typeParameterBoundSeparatorDoc = prettier.Text(" & ")
+
+func (p *TypeParameter) Doc() prettier.Doc {
+	doc := prettier.Concat{
+		prettier.Text(p.Identifier.Identifier),
+	}
+
+	if len(p.TypeBounds) == 0 {
+		return doc
+	}
+
+	typeBoundDocs := make([]prettier.Doc, len(p.TypeBounds))
+	for i, typeBound := range p.TypeBounds {
+		typeBoundDocs[i] = typeBound.Doc()
+	}
+
+	return append(
+		doc,
+		typeSeparatorSpaceDoc,
+		prettier.Join(
+			typeParameterBoundSeparatorDoc,
+			typeBoundDocs...,
+		),
+	)
+}
+
+func (p *TypeParameter) String() string {
+	return Prettier(p)
+}
+
+var typeParametersStartDoc prettier.Doc = prettier.Text("<")
+var typeParametersEndDoc prettier.Doc = prettier.Text(">")
+var typeParameterSeparatorDoc prettier.Doc = prettier.Concat{
+	prettier.Text(","),
+	prettier.Line{},
+}
+
+// TypeParametersDoc returns the document for the given type parameters,
+// e.g. `<T: AnyStruct, U>`, or nil if there are no type parameters
+//
+func TypeParametersDoc(typeParameters []*TypeParameter) prettier.Doc {
+	if len(typeParameters) == 0 {
+		return nil
+	}
+
+	typeParameterDocs := make([]prettier.Doc, len(typeParameters))
+	for i, typeParameter := range typeParameters {
+		typeParameterDocs[i] = typeParameter.Doc()
+	}
+
+	return prettier.Wrap(
+		typeParametersStartDoc,
+		prettier.Join(
+			typeParameterSeparatorDoc,
+			typeParameterDocs...,
+		),
+		typeParametersEndDoc,
+		prettier.SoftLine{},
+	)
+}
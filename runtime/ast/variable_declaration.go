@@ -38,6 +38,7 @@ type VariableDeclaration struct {
 	SecondValue       Expression
 	ParentIfStatement *IfStatement `json:"-"`
 	DocString         string
+	Node
 }
 
 var _ Element = &VariableDeclaration{}
@@ -244,3 +245,139 @@ func (d *VariableDeclaration) MarshalJSON() ([]byte, error) {
 func (d *VariableDeclaration) String() string {
 	return Prettier(d)
 }
+
+// TupleVariableDeclaration declares multiple variables at once,
+// by destructuring a tuple value, e.g. `let (a, b) = (1, 2)`
+
+type TupleVariableDeclaration struct {
+	IsConstant     bool
+	Identifiers    []Identifier
+	TypeAnnotation *TypeAnnotation
+	Value          Expression
+	Transfer       *Transfer
+	StartPos       Position `json:"-"`
+	Node
+}
+
+var _ Element = &TupleVariableDeclaration{}
+var _ Statement = &TupleVariableDeclaration{}
+
+func NewTupleVariableDeclaration(
+	gauge common.MemoryGauge,
+	isLet bool,
+	identifiers []Identifier,
+	typeAnnotation *TypeAnnotation,
+	value Expression,
+	transfer *Transfer,
+	startPos Position,
+) *TupleVariableDeclaration {
+	common.UseMemory(gauge, common.TupleVariableDeclarationMemoryUsage)
+
+	return &TupleVariableDeclaration{
+		IsConstant:     isLet,
+		Identifiers:    identifiers,
+		TypeAnnotation: typeAnnotation,
+		Value:          value,
+		Transfer:       transfer,
+		StartPos:       startPos,
+	}
+}
+
+func (*TupleVariableDeclaration) isStatement() {}
+
+func (*TupleVariableDeclaration) ElementType() ElementType {
+	return ElementTypeTupleVariableDeclaration
+}
+
+func (d *TupleVariableDeclaration) StartPosition() Position {
+	return d.StartPos
+}
+
+func (d *TupleVariableDeclaration) EndPosition(memoryGauge common.MemoryGauge) Position {
+	return d.Value.EndPosition(memoryGauge)
+}
+
+func (d *TupleVariableDeclaration) Accept(visitor Visitor) Repr {
+	return visitor.VisitTupleVariableDeclaration(d)
+}
+
+func (d *TupleVariableDeclaration) Walk(walkChild func(Element)) {
+	// TODO: walk type
+	walkChild(d.Value)
+}
+
+func (d *TupleVariableDeclaration) DeclarationKind() common.DeclarationKind {
+	if d.IsConstant {
+		return common.DeclarationKindConstant
+	}
+	return common.DeclarationKindVariable
+}
+
+var tupleVariableDeclarationSeparatorDoc prettier.Doc = prettier.Concat{
+	prettier.Text(","),
+	prettier.Line{},
+}
+
+func (d *TupleVariableDeclaration) Doc() prettier.Doc {
+	keywordDoc := varKeywordDoc
+	if d.IsConstant {
+		keywordDoc = letKeywordDoc
+	}
+
+	identifierDocs := make([]prettier.Doc, len(d.Identifiers))
+	for i, identifier := range d.Identifiers {
+		identifierDocs[i] = prettier.Text(identifier.Identifier)
+	}
+
+	identifiersTypeDoc := prettier.Concat{
+		prettier.WrapParentheses(
+			prettier.Join(tupleVariableDeclarationSeparatorDoc, identifierDocs...),
+			prettier.SoftLine{},
+		),
+	}
+
+	if d.TypeAnnotation != nil {
+		identifiersTypeDoc = append(
+			identifiersTypeDoc,
+			typeSeparatorSpaceDoc,
+			d.TypeAnnotation.Doc(),
+		)
+	}
+
+	return prettier.Group{
+		Doc: prettier.Concat{
+			keywordDoc,
+			prettier.Space,
+			prettier.Group{
+				Doc: identifiersTypeDoc,
+			},
+			prettier.Space,
+			d.Transfer.Doc(),
+			prettier.Group{
+				Doc: prettier.Indent{
+					Doc: prettier.Concat{
+						prettier.Line{},
+						d.Value.Doc(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TupleVariableDeclaration) MarshalJSON() ([]byte, error) {
+	type Alias TupleVariableDeclaration
+	return json.Marshal(&struct {
+		Type string
+		Range
+		*Alias
+	}{
+		Type:  "TupleVariableDeclaration",
+		Range: NewUnmeteredRangeFromPositioned(d),
+		Alias: (*Alias)(d),
+	})
+}
+
+func (d *TupleVariableDeclaration) String() string {
+	return Prettier(d)
+}
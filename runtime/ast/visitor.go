@@ -25,6 +25,7 @@ type Repr any
 type Element interface {
 	HasPosition
 	ElementType() ElementType
+	ID() NodeID
 	Accept(Visitor) Repr
 	Walk(walkChild func(Element))
 }
@@ -37,6 +38,10 @@ func (NotAnElement) ElementType() ElementType {
 	return ElementTypeUnknown
 }
 
+func (NotAnElement) ID() NodeID {
+	return NodeIDUnassigned
+}
+
 func (NotAnElement) Accept(Visitor) Repr {
 	// NO-OP
 	return nil
@@ -69,6 +74,7 @@ type DeclarationVisitor interface {
 	VisitEnumCaseDeclaration(*EnumCaseDeclaration) Repr
 	VisitPragmaDeclaration(*PragmaDeclaration) Repr
 	VisitImportDeclaration(*ImportDeclaration) Repr
+	VisitTypeAliasDeclaration(*TypeAliasDeclaration) Repr
 }
 
 type StatementVisitor interface {
@@ -81,8 +87,10 @@ type StatementVisitor interface {
 	VisitWhileStatement(*WhileStatement) Repr
 	VisitForStatement(*ForStatement) Repr
 	VisitEmitStatement(*EmitStatement) Repr
+	VisitRemoveStatement(*RemoveStatement) Repr
 	VisitAssignmentStatement(*AssignmentStatement) Repr
 	VisitSwapStatement(*SwapStatement) Repr
+	VisitTupleVariableDeclaration(*TupleVariableDeclaration) Repr
 	VisitExpressionStatement(*ExpressionStatement) Repr
 }
 
@@ -108,6 +116,10 @@ type ExpressionVisitor interface {
 	VisitReferenceExpression(*ReferenceExpression) Repr
 	VisitForceExpression(*ForceExpression) Repr
 	VisitPathExpression(*PathExpression) Repr
+	VisitAttachExpression(*AttachExpression) Repr
+	VisitTryExpression(*TryExpression) Repr
+	VisitStringTemplateExpression(*StringTemplateExpression) Repr
+	VisitTupleExpression(*TupleExpression) Repr
 }
 
 type Visitor interface {
@@ -0,0 +1,138 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+)
+
+func TestRuntimeStorageAttachments(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := newTestInterpreterRuntime()
+
+	container := []byte(`
+      pub resource R {
+        pub let id: Int
+
+        init(id: Int) {
+          self.id = id
+        }
+      }
+
+      pub attachment Metadata for R {
+        pub let name: String
+
+        init(name: String) {
+          self.name = name
+        }
+
+        pub fun describe(): String {
+          return self.name.concat(" #").concat(base.id.toString())
+        }
+      }
+
+      pub fun createR(id: Int, name: String): @R {
+        return <-attach Metadata(name: name) to <-create R(id: id)
+      }
+    `)
+
+	tx1 := []byte(`
+      import "container"
+
+      transaction {
+        prepare(signer: AuthAccount) {
+          signer.save(<-createR(id: 1, name: "one"), to: /storage/r)
+        }
+      }
+    `)
+
+	tx2 := []byte(`
+      import "container"
+
+      transaction {
+        prepare(signer: AuthAccount) {
+          let ref = signer.borrow<&R>(from: /storage/r)!
+          log(ref[Metadata]?.describe())
+        }
+      }
+    `)
+
+	tx3 := []byte(`
+      import "container"
+
+      transaction {
+        prepare(signer: AuthAccount) {
+          let r <- signer.load<@R>(from: /storage/r)!
+          remove Metadata from r
+          signer.save(<-r, to: /storage/r)
+        }
+      }
+    `)
+
+	var loggedMessages []string
+
+	runtimeInterface := &testRuntimeInterface{
+		getCode: func(location Location) (bytes []byte, err error) {
+			switch location {
+			case common.StringLocation("container"):
+				return container, nil
+			default:
+				return nil, fmt.Errorf("unknown import location: %s", location)
+			}
+		},
+		storage: newTestLedger(nil, nil),
+		getSigningAccounts: func() ([]Address, error) {
+			return []Address{{42}}, nil
+		},
+		log: func(message string) {
+			loggedMessages = append(loggedMessages, message)
+		},
+	}
+
+	nextTransactionLocation := newTransactionLocationGenerator()
+
+	for _, tx := range [][]byte{tx1, tx2, tx3, tx2} {
+		err := runtime.ExecuteTransaction(
+			Script{
+				Source: tx,
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  nextTransactionLocation(),
+			},
+		)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t,
+		[]string{
+			`"one #1"`,
+			"nil",
+		},
+		loggedMessages,
+	)
+}
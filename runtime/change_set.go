@@ -0,0 +1,180 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+// ChangeSet is the state changed by the execution of a transaction,
+// recorded by Runtime.ExecuteTransactionWithChangeSet.
+//
+type ChangeSet struct {
+	// Paths are the storage paths whose values changed, ordered by their first access
+	Paths []PathChange
+	// CreatedResources are the resources which were created, in order of creation
+	CreatedResources []ResourceChange
+	// DestroyedResources are the resources which were destroyed, in order of destruction.
+	// Resources which were created and destroyed in the same transaction
+	// are also listed in CreatedResources
+	DestroyedResources []ResourceChange
+}
+
+// PathChange is a change of the value stored at a path in the storage of an account.
+//
+// The old value is the value before the transaction, the new value is the value after the transaction.
+// A nil value indicates that no value was stored, or that the value could not be exported.
+//
+type PathChange struct {
+	Address    common.Address
+	Domain     string
+	Identifier string
+	OldValue   cadence.Value
+	NewValue   cadence.Value
+}
+
+// ResourceChange is the creation or destruction of a resource.
+//
+type ResourceChange struct {
+	TypeID common.TypeID
+	UUID   uint64
+}
+
+type changeSetRecorder struct {
+	changeSet ChangeSet
+	// paths are the accessed paths, ordered by their first access
+	paths []PathRead
+	// oldValues are the exported values of the accessed paths, at the time of their first access
+	oldValues map[PathRead]cadence.Value
+}
+
+func newChangeSetRecorder() *changeSetRecorder {
+	return &changeSetRecorder{
+		oldValues: map[PathRead]cadence.Value{},
+	}
+}
+
+// recordPathAccess records the value stored at the given path, if the path was not accessed before.
+//
+// Values may only be mutated after they were read, or be overwritten,
+// so the value at the time of the first access is the value before the transaction.
+func (r *changeSetRecorder) recordPathAccess(
+	inter *interpreter.Interpreter,
+	address common.Address,
+	domain string,
+	identifier string,
+) {
+	// Only record paths, and ignore internal storage domains and iteration
+	if identifier == "" ||
+		common.PathDomainFromIdentifier(domain) == common.PathDomainUnknown {
+
+		return
+	}
+
+	path := PathRead{
+		Address:    address,
+		Domain:     domain,
+		Identifier: identifier,
+	}
+	if _, ok := r.oldValues[path]; ok {
+		return
+	}
+
+	// NOTE: mark the path as accessed before reading it,
+	// as the read below triggers this function again
+	r.oldValues[path] = nil
+	r.paths = append(r.paths, path)
+
+	r.oldValues[path] = exportStoredValue(inter, path)
+}
+
+func (r *changeSetRecorder) recordResourceCreated(
+	inter *interpreter.Interpreter,
+	resource *interpreter.CompositeValue,
+) {
+	r.changeSet.CreatedResources = append(
+		r.changeSet.CreatedResources,
+		newResourceChange(inter, resource),
+	)
+}
+
+func (r *changeSetRecorder) recordResourceDestroyed(
+	inter *interpreter.Interpreter,
+	resource *interpreter.CompositeValue,
+) {
+	r.changeSet.DestroyedResources = append(
+		r.changeSet.DestroyedResources,
+		newResourceChange(inter, resource),
+	)
+}
+
+func newResourceChange(inter *interpreter.Interpreter, resource *interpreter.CompositeValue) ResourceChange {
+	var uuid uint64
+	uuidValue := resource.ResourceUUID(inter, interpreter.ReturnEmptyLocationRange)
+	if uuidValue != nil {
+		uuid = uint64(*uuidValue)
+	}
+
+	return ResourceChange{
+		TypeID: resource.TypeID(),
+		UUID:   uuid,
+	}
+}
+
+// finish determines the new values of all accessed paths,
+// and records the paths whose values changed.
+func (r *changeSetRecorder) finish(inter *interpreter.Interpreter) {
+	for _, path := range r.paths {
+		oldValue := r.oldValues[path]
+		newValue := exportStoredValue(inter, path)
+
+		if cadence.Equal(oldValue, newValue) {
+			continue
+		}
+
+		r.changeSet.Paths = append(r.changeSet.Paths, PathChange{
+			Address:    path.Address,
+			Domain:     path.Domain,
+			Identifier: path.Identifier,
+			OldValue:   oldValue,
+			NewValue:   newValue,
+		})
+	}
+}
+
+func exportStoredValue(inter *interpreter.Interpreter, path PathRead) cadence.Value {
+	value := inter.ReadStored(path.Address, path.Domain, path.Identifier)
+	if value == nil {
+		return nil
+	}
+
+	exportedValue, err := exportValueWithInterpreter(
+		value,
+		inter,
+		interpreter.ReturnEmptyLocationRange,
+		seenReferences{},
+	)
+	if err != nil {
+		return nil
+	}
+
+	return exportedValue
+}
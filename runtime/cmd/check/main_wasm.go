@@ -0,0 +1,145 @@
+//go:build wasm
+// +build wasm
+
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"runtime/debug"
+	"syscall/js"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/cmd"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/parser"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/stdlib"
+)
+
+const globalFunctionNamePrefix = "CADENCE_CHECKER"
+
+func globalFunctionName(name string) string {
+	return fmt.Sprintf("__%s_%s__", globalFunctionNamePrefix, name)
+}
+
+func main() {
+
+	log.Println("Cadence Checker")
+
+	done := make(chan struct{}, 0)
+
+	js.Global().Set(
+		globalFunctionName("parse"),
+		js.FuncOf(func(this js.Value, args []js.Value) any {
+			code := args[0].String()
+			return parse(code)
+		}),
+	)
+
+	js.Global().Set(
+		globalFunctionName("check"),
+		js.FuncOf(func(this js.Value, args []js.Value) any {
+			code := args[0].String()
+			return check(code)
+		}),
+	)
+	<-done
+}
+
+type result struct {
+	Program     *ast.Program     `json:"program,omitempty"`
+	Diagnostics []cmd.Diagnostic `json:"diagnostics"`
+	Error       string           `json:"error,omitempty"`
+}
+
+var location = common.StringLocation("playground")
+
+// parse parses the given code and returns the program and the syntax errors, if any,
+// serialized as JSON
+//
+func parse(code string) string {
+	return run(func(res *result) {
+		program, err := parser.ParseProgram(code, nil)
+		res.Program = program
+		res.Diagnostics = cmd.Diagnostics(err)
+	})
+}
+
+// check parses and checks the given code and returns the program
+// and the syntax and semantic errors, if any, serialized as JSON
+//
+func check(code string) string {
+	return run(func(res *result) {
+		program, err := parser.ParseProgram(code, nil)
+		res.Program = program
+		if err != nil {
+			res.Diagnostics = cmd.Diagnostics(err)
+			return
+		}
+
+		checkerOptions, _ := cmd.DefaultCheckerInterpreterOptions(
+			map[common.Location]*sema.Checker{},
+			map[common.Location]string{
+				location: code,
+			},
+			stdlib.FlowBuiltinImpls{},
+		)
+
+		checker, err := sema.NewChecker(
+			program,
+			location,
+			nil,
+			false,
+			checkerOptions...,
+		)
+		if err != nil {
+			panic(err)
+		}
+
+		res.Diagnostics = cmd.Diagnostics(checker.Check())
+	})
+}
+
+func run(f func(res *result)) string {
+
+	res := result{
+		Diagnostics: []cmd.Diagnostic{},
+	}
+
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				res.Error = fmt.Sprintf("%s\n%s", r, debug.Stack())
+			}
+		}()
+
+		f(&res)
+	}()
+
+	serialized, err := json.Marshal(res)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(serialized)
+}
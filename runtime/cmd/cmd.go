@@ -25,6 +25,7 @@ import (
 
 	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
 	"github.com/onflow/cadence/runtime/interpreter"
 	"github.com/onflow/cadence/runtime/parser"
 	"github.com/onflow/cadence/runtime/pretty"
@@ -108,9 +109,23 @@ func DefaultCheckerInterpreterOptions(
 
 					importedChecker, ok := checkers[importedLocation]
 					if !ok {
-						importedProgram, _ := PrepareProgramFromFile(stringLocation, codes)
-						importedChecker, _ = checker.SubChecker(importedProgram, importedLocation)
+						importedProgram, err := parseProgramFromFile(stringLocation, codes)
+						if err != nil {
+							return nil, err
+						}
+
+						importedChecker, err = checker.SubChecker(importedProgram, importedLocation)
+						if err != nil {
+							return nil, err
+						}
+
 						checkers[importedLocation] = importedChecker
+
+						err = importedChecker.Check()
+						if err != nil {
+							delete(checkers, importedLocation)
+							return nil, err
+						}
 					}
 
 					return sema.ElaborationImport{
@@ -121,9 +136,72 @@ func DefaultCheckerInterpreterOptions(
 		},
 		[]interpreter.Option{
 			interpreter.WithPredeclaredValues(interpreterPredeclaredValues),
+			interpreter.WithImportLocationHandler(
+				func(inter *interpreter.Interpreter, location common.Location) interpreter.Import {
+					importedChecker, ok := checkers[location]
+					if !ok {
+						if location != stdlib.CryptoChecker.Location {
+							panic(errors.NewUnexpectedError("cannot import unchecked location `%s`", location))
+						}
+						importedChecker = stdlib.CryptoChecker
+					}
+
+					program := interpreter.ProgramFromChecker(importedChecker)
+					subInterpreter, err := inter.NewSubInterpreter(program, location)
+					if err != nil {
+						panic(err)
+					}
+
+					return interpreter.InterpreterImport{
+						Interpreter: subInterpreter,
+					}
+				},
+			),
+			interpreter.WithContractValueHandler(
+				func(
+					inter *interpreter.Interpreter,
+					compositeType *sema.CompositeType,
+					constructorGenerator func(common.Address) *interpreter.HostFunctionValue,
+					invocationRange ast.Range,
+				) *interpreter.CompositeValue {
+
+					// Contracts are not deployed to an account,
+					// so instantiate them using the initializer without arguments
+
+					constructor := constructorGenerator(common.Address{})
+
+					value, err := inter.InvokeFunctionValue(
+						constructor,
+						nil,
+						nil,
+						nil,
+						invocationRange,
+					)
+					if err != nil {
+						panic(err)
+					}
+
+					return value.(*interpreter.CompositeValue)
+				},
+			),
 		}
 }
 
+// parseProgramFromFile reads and parses the program in the file with the given location.
+// Unlike PrepareProgramFromFile, errors are returned instead of exiting
+//
+func parseProgramFromFile(location common.StringLocation, codes map[common.Location]string) (*ast.Program, error) {
+	codeBytes, err := ioutil.ReadFile(string(location))
+	if err != nil {
+		return nil, err
+	}
+
+	code := string(codeBytes)
+	codes[location] = code
+
+	return parser.ParseProgram(code, nil)
+}
+
 // PrepareChecker prepares and initializes a checker with a given code as a string,
 // and a filename which is used for pretty-printing errors, if any
 func PrepareChecker(
@@ -204,11 +282,6 @@ func PrepareInterpreter(filename string, debugger *interpreter.Debugger) (*inter
 			return uuid, nil
 		}),
 		interpreter.WithDebugger(debugger),
-		interpreter.WithImportLocationHandler(
-			func(inter *interpreter.Interpreter, location common.Location) interpreter.Import {
-				panic("Importing programs is not supported yet")
-			},
-		),
 	}
 
 	interpreterOptions = append(
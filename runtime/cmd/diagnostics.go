@@ -0,0 +1,77 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/errors"
+)
+
+// Diagnostic is a serializable description of an error,
+// e.g. a syntax error or a semantic error
+//
+type Diagnostic struct {
+	Message          string        `json:"message"`
+	SecondaryMessage string        `json:"secondaryMessage,omitempty"`
+	StartPos         *ast.Position `json:"startPos,omitempty"`
+	EndPos           *ast.Position `json:"endPos,omitempty"`
+}
+
+// Diagnostics flattens the given error, which might be a parent error
+// like a parser or checker error, into a list of diagnostics
+//
+func Diagnostics(err error) []Diagnostic {
+	result := []Diagnostic{}
+
+	var add func(err error)
+	add = func(err error) {
+		if err == nil {
+			return
+		}
+
+		if parentErr, ok := err.(errors.ParentError); ok {
+			for _, childErr := range parentErr.ChildErrors() {
+				add(childErr)
+			}
+			return
+		}
+
+		diagnostic := Diagnostic{
+			Message: err.Error(),
+		}
+
+		if secondaryError, ok := err.(errors.SecondaryError); ok {
+			diagnostic.SecondaryMessage = secondaryError.SecondaryError()
+		}
+
+		if positioned, ok := err.(ast.HasPosition); ok {
+			startPos := positioned.StartPosition()
+			diagnostic.StartPos = &startPos
+
+			endPos := positioned.EndPosition(nil)
+			diagnostic.EndPos = &endPos
+		}
+
+		result = append(result, diagnostic)
+	}
+
+	add(err)
+
+	return result
+}
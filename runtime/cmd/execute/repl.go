@@ -1,3 +1,6 @@
+//go:build !wasm
+// +build !wasm
+
 /*
  * Cadence - The resource-oriented smart contract programming language
  *
@@ -64,16 +67,17 @@ func RunREPL() {
 		}()
 
 		if code == "" && strings.HasPrefix(line, ".") {
-			handleCommand(line)
-			code = ""
+			handleCommand(repl, line)
 			return
 		}
 
 		// Prefix the code with empty lines,
-		// so that error messages match current line number
+		// so that error messages match current line number.
+		// Only prefix the first line of the input,
+		// the following lines of incomplete input are appended
 
-		for i := 1; i < lineNumber; i++ {
-			code = "\n" + code
+		if code == "" {
+			code = strings.Repeat("\n", lineNumber-1)
 		}
 
 		code += line + "\n"
@@ -122,21 +126,42 @@ func RunREPL() {
 
 const replHelpMessage = `
 Enter declarations and statements to evaluate them.
+Declarations may span multiple lines, until all braces are closed.
+Files can be imported, e.g. 'import C from "./C.cdc"'.
+
 Commands are prefixed with a dot. Valid commands are:
 
-.exit     Exit the interpreter
-.help     Print this help message
+.exit               Exit the interpreter
+.help               Print this help message
+.inspect            Print the declared variables and constants, and their values
+.type <expression>  Print the type of the expression, without evaluating it
 
 Press ^C to abort current expression, ^D to exit`
 
 const replAssistanceMessage = `Type '.help' for assistance.`
 
-func handleCommand(command string) {
+func handleCommand(repl *runtime.REPL, line string) {
+	command, argument, _ := strings.Cut(line, " ")
+	argument = strings.TrimSpace(argument)
+
 	switch command {
 	case ".exit":
 		os.Exit(0)
 	case ".help":
 		fmt.Println(replHelpMessage)
+	case ".inspect":
+		for _, value := range repl.Values() {
+			fmt.Printf("%s: %s = %s\n", value.Name, value.Type.QualifiedString(), colorizeResult(value.Value))
+		}
+	case ".type":
+		if argument == "" {
+			fmt.Println(colorizeError("Missing expression. Usage: .type <expression>"))
+			return
+		}
+		ty := repl.ExpressionType(argument)
+		if ty != nil {
+			fmt.Println(ty.QualifiedString())
+		}
 	default:
 		fmt.Println(colorizeError(fmt.Sprintf("Unknown command. %s", replAssistanceMessage)))
 	}
@@ -0,0 +1,298 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package main builds a C shared library which exposes the parser, checker,
+// and interpreter to non-Go environments:
+//
+//	go build -buildmode=c-shared -o libcadence.so ./runtime/cmd/libcadence
+//
+// Each exported function takes a JSON-encoded request as a NUL-terminated string,
+// and returns a JSON-encoded result, which must be released using `cadence_free`.
+//
+// A request has the form `{"code": "...", "arguments": [...]}`,
+// where the optional arguments are JSON-CDC encoded values.
+//
+package main
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+	"encoding/json"
+	"fmt"
+	"runtime/debug"
+	"unsafe"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/cmd"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/parser"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/stdlib"
+)
+
+func main() {
+	// required for building a C shared library, never called
+}
+
+var location = common.StringLocation("libcadence")
+
+type request struct {
+	Code      string            `json:"code"`
+	Arguments []json.RawMessage `json:"arguments,omitempty"`
+}
+
+type result struct {
+	Program     *ast.Program     `json:"program,omitempty"`
+	Diagnostics []cmd.Diagnostic `json:"diagnostics"`
+	Value       json.RawMessage  `json:"value,omitempty"`
+	Logs        []string         `json:"logs,omitempty"`
+	Error       string           `json:"error,omitempty"`
+}
+
+//export cadence_parse
+func cadence_parse(input *C.char) *C.char {
+	return handle(input, func(req request, res *result) {
+		program, err := parser.ParseProgram(req.Code, nil)
+		res.Program = program
+		res.Diagnostics = cmd.Diagnostics(err)
+	})
+}
+
+//export cadence_check
+func cadence_check(input *C.char) *C.char {
+	return handle(input, func(req request, res *result) {
+		_, err := check(req.Code, stdlib.DefaultFlowBuiltinImpls())
+		res.Diagnostics = cmd.Diagnostics(err)
+	})
+}
+
+//export cadence_execute_script
+func cadence_execute_script(input *C.char) *C.char {
+	return handle(input, func(req request, res *result) {
+		value, err := executeScript(req, res)
+		if err != nil {
+			res.Diagnostics = cmd.Diagnostics(err)
+			return
+		}
+
+		encoded, err := jsoncdc.Encode(value)
+		if err != nil {
+			panic(err)
+		}
+		res.Value = encoded
+	})
+}
+
+//export cadence_free
+func cadence_free(result *C.char) {
+	C.free(unsafe.Pointer(result))
+}
+
+// handle decodes the request, calls the given function,
+// and returns the JSON-encoded result as a C string, which must be freed by the caller.
+// Panics are recovered and reported as an error in the result
+//
+func handle(input *C.char, f func(req request, res *result)) *C.char {
+
+	res := result{
+		Diagnostics: []cmd.Diagnostic{},
+	}
+
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				res.Error = fmt.Sprintf("%s\n%s", r, debug.Stack())
+			}
+		}()
+
+		var req request
+		err := json.Unmarshal([]byte(C.GoString(input)), &req)
+		if err != nil {
+			res.Error = fmt.Sprintf("invalid request: %s", err)
+			return
+		}
+
+		f(req, &res)
+	}()
+
+	serialized, err := json.Marshal(res)
+	if err != nil {
+		panic(err)
+	}
+
+	return C.CString(string(serialized))
+}
+
+// check parses and checks the given code.
+// Only the standard library can be imported
+//
+func check(code string, impls stdlib.FlowBuiltinImpls) (*sema.Checker, error) {
+	program, err := parser.ParseProgram(code, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	checkerOptions, _ := cmd.DefaultCheckerInterpreterOptions(
+		map[common.Location]*sema.Checker{},
+		map[common.Location]string{
+			location: code,
+		},
+		impls,
+	)
+
+	checker, err := sema.NewChecker(
+		program,
+		location,
+		nil,
+		false,
+		checkerOptions...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checker.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	return checker, nil
+}
+
+// executeScript checks the script in the request and invokes its `main` function
+// with the arguments in the request. The logs of the script are recorded in the result
+//
+func executeScript(req request, res *result) (cadence.Value, error) {
+
+	impls := stdlib.DefaultFlowBuiltinImpls()
+	impls.Log = func(invocation interpreter.Invocation) interpreter.Value {
+		message := invocation.Arguments[0].MeteredString(
+			invocation.Interpreter,
+			interpreter.SeenReferences{},
+		)
+		res.Logs = append(res.Logs, message)
+		return interpreter.VoidValue{}
+	}
+
+	checker, err := check(req.Code, impls)
+	if err != nil {
+		return nil, err
+	}
+
+	functionEntryPointType, err := checker.Elaboration.FunctionEntryPointType()
+	if err != nil {
+		return nil, err
+	}
+
+	_, interpreterOptions := cmd.DefaultCheckerInterpreterOptions(
+		map[common.Location]*sema.Checker{
+			location: checker,
+		},
+		map[common.Location]string{
+			location: req.Code,
+		},
+		impls,
+	)
+
+	var uuid uint64
+
+	// NOTE: storage option must be provided *before* the predeclared values option,
+	// as predeclared values may rely on storage
+
+	interpreterOptions = append(
+		[]interpreter.Option{
+			interpreter.WithStorage(interpreter.NewInMemoryStorage(nil)),
+			interpreter.WithUUIDHandler(func() (uint64, error) {
+				defer func() { uuid++ }()
+				return uuid, nil
+			}),
+		},
+		interpreterOptions...,
+	)
+
+	inter, err := interpreter.NewInterpreter(
+		interpreter.ProgramFromChecker(checker),
+		location,
+		interpreterOptions...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = inter.Interpret()
+	if err != nil {
+		return nil, err
+	}
+
+	arguments := make([][]byte, len(req.Arguments))
+	for i, argument := range req.Arguments {
+		arguments[i] = argument
+	}
+
+	value, err := invokeMain(inter, arguments, functionEntryPointType.Parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	return runtime.ExportValue(value, inter, interpreter.ReturnEmptyLocationRange)
+}
+
+func invokeMain(
+	inter *interpreter.Interpreter,
+	arguments [][]byte,
+	parameters []*sema.Parameter,
+) (
+	value interpreter.Value,
+	err error,
+) {
+	// Recover internal panics and return them as an error.
+	// For example, the argument validation might fail
+
+	defer inter.RecoverErrors(func(internalErr error) {
+		err = internalErr
+	})
+
+	values, err := runtime.ValidateArguments(
+		inter,
+		jsonArgumentDecoder{},
+		arguments,
+		parameters,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return inter.Invoke("main", values...)
+}
+
+// jsonArgumentDecoder decodes JSON-CDC encoded arguments
+//
+type jsonArgumentDecoder struct{}
+
+var _ runtime.ArgumentDecoder = jsonArgumentDecoder{}
+
+func (jsonArgumentDecoder) DecodeArgument(argument []byte, _ cadence.Type) (cadence.Value, error) {
+	return jsoncdc.Decode(nil, argument)
+}
@@ -110,6 +110,12 @@ func (a Address) HexWithPrefix() string {
 	return fmt.Sprintf("0x%x", [AddressLength]byte(a))
 }
 
+// AddressValidator validates an address,
+// for example that it is a valid address of a certain network.
+// It returns an error if the address is invalid.
+//
+type AddressValidator func(address Address) error
+
 // HexToAddress converts a hex string to an Address.
 func HexToAddress(h string) (Address, error) {
 	trimmed := strings.TrimPrefix(h, "0x")
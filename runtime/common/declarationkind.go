@@ -56,6 +56,10 @@ const (
 	DeclarationKindPragma
 	DeclarationKindEnum
 	DeclarationKindEnumCase
+	DeclarationKindAttachment
+	DeclarationKindBase
+	DeclarationKindTypeAlias
+	DeclarationKindLabel
 )
 
 func DeclarationKindCount() int {
@@ -72,7 +76,9 @@ func (k DeclarationKind) IsTypeDeclaration() bool {
 		DeclarationKindResourceInterface,
 		DeclarationKindContractInterface,
 		DeclarationKindTypeParameter,
-		DeclarationKindEnum:
+		DeclarationKindEnum,
+		DeclarationKindAttachment,
+		DeclarationKindTypeAlias:
 
 		return true
 
@@ -135,6 +141,14 @@ func (k DeclarationKind) Name() string {
 		return "enum"
 	case DeclarationKindEnumCase:
 		return "enum case"
+	case DeclarationKindAttachment:
+		return "attachment"
+	case DeclarationKindBase:
+		return "base"
+	case DeclarationKindTypeAlias:
+		return "type alias"
+	case DeclarationKindLabel:
+		return "label"
 	case DeclarationKindUnknown:
 		return "unknown"
 	}
@@ -182,6 +196,12 @@ func (k DeclarationKind) Keywords() string {
 		return "enum"
 	case DeclarationKindEnumCase:
 		return "case"
+	case DeclarationKindAttachment:
+		return "attachment"
+	case DeclarationKindBase:
+		return "base"
+	case DeclarationKindTypeAlias:
+		return "typealias"
 	default:
 		return ""
 	}
@@ -38,6 +38,7 @@ const (
 	MemoryKindDictionaryValueBase
 	MemoryKindCompositeValueBase
 	MemoryKindSimpleCompositeValueBase
+	MemoryKindTupleValueBase
 	MemoryKindOptionalValue
 	MemoryKindNilValue
 	MemoryKindVoidValue
@@ -52,6 +53,7 @@ const (
 	MemoryKindBoundFunctionValue
 	MemoryKindBigInt
 	MemoryKindSimpleCompositeValue
+	MemoryKindPublishedValue
 
 	// Atree Nodes
 	MemoryKindAtreeArrayDataSlab
@@ -75,6 +77,8 @@ const (
 	MemoryKindReferenceStaticType
 	MemoryKindCapabilityStaticType
 	MemoryKindFunctionStaticType
+	MemoryKindRangeStaticType
+	MemoryKindTupleStaticType
 
 	// Cadence Values
 	MemoryKindCadenceVoidValue
@@ -103,6 +107,7 @@ const (
 	MemoryKindCadencePathValue
 	MemoryKindCadenceTypeValue
 	MemoryKindCadenceCapabilityValue
+	MemoryKindCadenceTupleValueBase
 
 	// Cadence Types
 	MemoryKindCadenceSimpleType
@@ -124,6 +129,7 @@ const (
 	MemoryKindCadenceRestrictedType
 	MemoryKindCadenceCapabilityType
 	MemoryKindCadenceEnumType
+	MemoryKindCadenceTupleType
 
 	// Misc
 
@@ -152,6 +158,7 @@ const (
 	MemoryKindFunctionBlock
 	MemoryKindParameter
 	MemoryKindParameterList
+	MemoryKindTypeParameter
 	MemoryKindTransfer
 	MemoryKindMembers
 	MemoryKindTypeAnnotation
@@ -165,8 +172,10 @@ const (
 	MemoryKindTransactionDeclaration
 	MemoryKindImportDeclaration
 	MemoryKindVariableDeclaration
+	MemoryKindTupleVariableDeclaration
 	MemoryKindSpecialFunctionDeclaration
 	MemoryKindPragmaDeclaration
+	MemoryKindTypeAliasDeclaration
 
 	MemoryKindAssignmentStatement
 	MemoryKindBreakStatement
@@ -175,6 +184,7 @@ const (
 	MemoryKindExpressionStatement
 	MemoryKindForStatement
 	MemoryKindIfStatement
+	MemoryKindRemoveStatement
 	MemoryKindReturnStatement
 	MemoryKindSwapStatement
 	MemoryKindSwitchStatement
@@ -201,6 +211,11 @@ const (
 	MemoryKindReferenceExpression
 	MemoryKindForceExpression
 	MemoryKindPathExpression
+	MemoryKindAttachExpression
+	MemoryKindTryExpression
+	MemoryKindStringTemplateExpression
+	MemoryKindTupleExpression
+	MemoryKindOptionalBindingPattern
 
 	MemoryKindConstantSizedType
 	MemoryKindDictionaryType
@@ -211,6 +226,7 @@ const (
 	MemoryKindReferenceType
 	MemoryKindRestrictedType
 	MemoryKindVariableSizedType
+	MemoryKindTupleType
 
 	MemoryKindPosition
 	MemoryKindRange
@@ -227,6 +243,8 @@ const (
 	MemoryKindRestrictedSemaType
 	MemoryKindReferenceSemaType
 	MemoryKindCapabilitySemaType
+	MemoryKindRangeSemaType
+	MemoryKindTupleSemaType
 
 	// ordered-map
 	MemoryKindOrderedMap
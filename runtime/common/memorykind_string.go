@@ -18,179 +18,197 @@ func _() {
 	_ = x[MemoryKindDictionaryValueBase-7]
 	_ = x[MemoryKindCompositeValueBase-8]
 	_ = x[MemoryKindSimpleCompositeValueBase-9]
-	_ = x[MemoryKindOptionalValue-10]
-	_ = x[MemoryKindNilValue-11]
-	_ = x[MemoryKindVoidValue-12]
-	_ = x[MemoryKindTypeValue-13]
-	_ = x[MemoryKindPathValue-14]
-	_ = x[MemoryKindCapabilityValue-15]
-	_ = x[MemoryKindLinkValue-16]
-	_ = x[MemoryKindStorageReferenceValue-17]
-	_ = x[MemoryKindEphemeralReferenceValue-18]
-	_ = x[MemoryKindInterpretedFunctionValue-19]
-	_ = x[MemoryKindHostFunctionValue-20]
-	_ = x[MemoryKindBoundFunctionValue-21]
-	_ = x[MemoryKindBigInt-22]
-	_ = x[MemoryKindSimpleCompositeValue-23]
-	_ = x[MemoryKindAtreeArrayDataSlab-24]
-	_ = x[MemoryKindAtreeArrayMetaDataSlab-25]
-	_ = x[MemoryKindAtreeArrayElementOverhead-26]
-	_ = x[MemoryKindAtreeMapDataSlab-27]
-	_ = x[MemoryKindAtreeMapMetaDataSlab-28]
-	_ = x[MemoryKindAtreeMapElementOverhead-29]
-	_ = x[MemoryKindAtreeMapPreAllocatedElement-30]
-	_ = x[MemoryKindAtreeEncodedSlab-31]
-	_ = x[MemoryKindPrimitiveStaticType-32]
-	_ = x[MemoryKindCompositeStaticType-33]
-	_ = x[MemoryKindInterfaceStaticType-34]
-	_ = x[MemoryKindVariableSizedStaticType-35]
-	_ = x[MemoryKindConstantSizedStaticType-36]
-	_ = x[MemoryKindDictionaryStaticType-37]
-	_ = x[MemoryKindOptionalStaticType-38]
-	_ = x[MemoryKindRestrictedStaticType-39]
-	_ = x[MemoryKindReferenceStaticType-40]
-	_ = x[MemoryKindCapabilityStaticType-41]
-	_ = x[MemoryKindFunctionStaticType-42]
-	_ = x[MemoryKindCadenceVoidValue-43]
-	_ = x[MemoryKindCadenceOptionalValue-44]
-	_ = x[MemoryKindCadenceBoolValue-45]
-	_ = x[MemoryKindCadenceStringValue-46]
-	_ = x[MemoryKindCadenceCharacterValue-47]
-	_ = x[MemoryKindCadenceAddressValue-48]
-	_ = x[MemoryKindCadenceIntValue-49]
-	_ = x[MemoryKindCadenceNumberValue-50]
-	_ = x[MemoryKindCadenceArrayValueBase-51]
-	_ = x[MemoryKindCadenceArrayValueLength-52]
-	_ = x[MemoryKindCadenceDictionaryValue-53]
-	_ = x[MemoryKindCadenceKeyValuePair-54]
-	_ = x[MemoryKindCadenceStructValueBase-55]
-	_ = x[MemoryKindCadenceStructValueSize-56]
-	_ = x[MemoryKindCadenceResourceValueBase-57]
-	_ = x[MemoryKindCadenceResourceValueSize-58]
-	_ = x[MemoryKindCadenceEventValueBase-59]
-	_ = x[MemoryKindCadenceEventValueSize-60]
-	_ = x[MemoryKindCadenceContractValueBase-61]
-	_ = x[MemoryKindCadenceContractValueSize-62]
-	_ = x[MemoryKindCadenceEnumValueBase-63]
-	_ = x[MemoryKindCadenceEnumValueSize-64]
-	_ = x[MemoryKindCadenceLinkValue-65]
-	_ = x[MemoryKindCadencePathValue-66]
-	_ = x[MemoryKindCadenceTypeValue-67]
-	_ = x[MemoryKindCadenceCapabilityValue-68]
-	_ = x[MemoryKindCadenceSimpleType-69]
-	_ = x[MemoryKindCadenceOptionalType-70]
-	_ = x[MemoryKindCadenceVariableSizedArrayType-71]
-	_ = x[MemoryKindCadenceConstantSizedArrayType-72]
-	_ = x[MemoryKindCadenceDictionaryType-73]
-	_ = x[MemoryKindCadenceField-74]
-	_ = x[MemoryKindCadenceParameter-75]
-	_ = x[MemoryKindCadenceStructType-76]
-	_ = x[MemoryKindCadenceResourceType-77]
-	_ = x[MemoryKindCadenceEventType-78]
-	_ = x[MemoryKindCadenceContractType-79]
-	_ = x[MemoryKindCadenceStructInterfaceType-80]
-	_ = x[MemoryKindCadenceResourceInterfaceType-81]
-	_ = x[MemoryKindCadenceContractInterfaceType-82]
-	_ = x[MemoryKindCadenceFunctionType-83]
-	_ = x[MemoryKindCadenceReferenceType-84]
-	_ = x[MemoryKindCadenceRestrictedType-85]
-	_ = x[MemoryKindCadenceCapabilityType-86]
-	_ = x[MemoryKindCadenceEnumType-87]
-	_ = x[MemoryKindRawString-88]
-	_ = x[MemoryKindAddressLocation-89]
-	_ = x[MemoryKindBytes-90]
-	_ = x[MemoryKindVariable-91]
-	_ = x[MemoryKindCompositeTypeInfo-92]
-	_ = x[MemoryKindCompositeField-93]
-	_ = x[MemoryKindInvocation-94]
-	_ = x[MemoryKindStorageMap-95]
-	_ = x[MemoryKindStorageKey-96]
-	_ = x[MemoryKindValueToken-97]
-	_ = x[MemoryKindSyntaxToken-98]
-	_ = x[MemoryKindSpaceToken-99]
-	_ = x[MemoryKindProgram-100]
-	_ = x[MemoryKindIdentifier-101]
-	_ = x[MemoryKindArgument-102]
-	_ = x[MemoryKindBlock-103]
-	_ = x[MemoryKindFunctionBlock-104]
-	_ = x[MemoryKindParameter-105]
-	_ = x[MemoryKindParameterList-106]
-	_ = x[MemoryKindTransfer-107]
-	_ = x[MemoryKindMembers-108]
-	_ = x[MemoryKindTypeAnnotation-109]
-	_ = x[MemoryKindDictionaryEntry-110]
-	_ = x[MemoryKindFunctionDeclaration-111]
-	_ = x[MemoryKindCompositeDeclaration-112]
-	_ = x[MemoryKindInterfaceDeclaration-113]
-	_ = x[MemoryKindEnumCaseDeclaration-114]
-	_ = x[MemoryKindFieldDeclaration-115]
-	_ = x[MemoryKindTransactionDeclaration-116]
-	_ = x[MemoryKindImportDeclaration-117]
-	_ = x[MemoryKindVariableDeclaration-118]
-	_ = x[MemoryKindSpecialFunctionDeclaration-119]
-	_ = x[MemoryKindPragmaDeclaration-120]
-	_ = x[MemoryKindAssignmentStatement-121]
-	_ = x[MemoryKindBreakStatement-122]
-	_ = x[MemoryKindContinueStatement-123]
-	_ = x[MemoryKindEmitStatement-124]
-	_ = x[MemoryKindExpressionStatement-125]
-	_ = x[MemoryKindForStatement-126]
-	_ = x[MemoryKindIfStatement-127]
-	_ = x[MemoryKindReturnStatement-128]
-	_ = x[MemoryKindSwapStatement-129]
-	_ = x[MemoryKindSwitchStatement-130]
-	_ = x[MemoryKindWhileStatement-131]
-	_ = x[MemoryKindBooleanExpression-132]
-	_ = x[MemoryKindNilExpression-133]
-	_ = x[MemoryKindStringExpression-134]
-	_ = x[MemoryKindIntegerExpression-135]
-	_ = x[MemoryKindFixedPointExpression-136]
-	_ = x[MemoryKindArrayExpression-137]
-	_ = x[MemoryKindDictionaryExpression-138]
-	_ = x[MemoryKindIdentifierExpression-139]
-	_ = x[MemoryKindInvocationExpression-140]
-	_ = x[MemoryKindMemberExpression-141]
-	_ = x[MemoryKindIndexExpression-142]
-	_ = x[MemoryKindConditionalExpression-143]
-	_ = x[MemoryKindUnaryExpression-144]
-	_ = x[MemoryKindBinaryExpression-145]
-	_ = x[MemoryKindFunctionExpression-146]
-	_ = x[MemoryKindCastingExpression-147]
-	_ = x[MemoryKindCreateExpression-148]
-	_ = x[MemoryKindDestroyExpression-149]
-	_ = x[MemoryKindReferenceExpression-150]
-	_ = x[MemoryKindForceExpression-151]
-	_ = x[MemoryKindPathExpression-152]
-	_ = x[MemoryKindConstantSizedType-153]
-	_ = x[MemoryKindDictionaryType-154]
-	_ = x[MemoryKindFunctionType-155]
-	_ = x[MemoryKindInstantiationType-156]
-	_ = x[MemoryKindNominalType-157]
-	_ = x[MemoryKindOptionalType-158]
-	_ = x[MemoryKindReferenceType-159]
-	_ = x[MemoryKindRestrictedType-160]
-	_ = x[MemoryKindVariableSizedType-161]
-	_ = x[MemoryKindPosition-162]
-	_ = x[MemoryKindRange-163]
-	_ = x[MemoryKindElaboration-164]
-	_ = x[MemoryKindActivation-165]
-	_ = x[MemoryKindActivationEntries-166]
-	_ = x[MemoryKindVariableSizedSemaType-167]
-	_ = x[MemoryKindConstantSizedSemaType-168]
-	_ = x[MemoryKindDictionarySemaType-169]
-	_ = x[MemoryKindOptionalSemaType-170]
-	_ = x[MemoryKindRestrictedSemaType-171]
-	_ = x[MemoryKindReferenceSemaType-172]
-	_ = x[MemoryKindCapabilitySemaType-173]
-	_ = x[MemoryKindOrderedMap-174]
-	_ = x[MemoryKindOrderedMapEntryList-175]
-	_ = x[MemoryKindOrderedMapEntry-176]
-	_ = x[MemoryKindLast-177]
+	_ = x[MemoryKindTupleValueBase-10]
+	_ = x[MemoryKindOptionalValue-11]
+	_ = x[MemoryKindNilValue-12]
+	_ = x[MemoryKindVoidValue-13]
+	_ = x[MemoryKindTypeValue-14]
+	_ = x[MemoryKindPathValue-15]
+	_ = x[MemoryKindCapabilityValue-16]
+	_ = x[MemoryKindLinkValue-17]
+	_ = x[MemoryKindStorageReferenceValue-18]
+	_ = x[MemoryKindEphemeralReferenceValue-19]
+	_ = x[MemoryKindInterpretedFunctionValue-20]
+	_ = x[MemoryKindHostFunctionValue-21]
+	_ = x[MemoryKindBoundFunctionValue-22]
+	_ = x[MemoryKindBigInt-23]
+	_ = x[MemoryKindSimpleCompositeValue-24]
+	_ = x[MemoryKindPublishedValue-25]
+	_ = x[MemoryKindAtreeArrayDataSlab-26]
+	_ = x[MemoryKindAtreeArrayMetaDataSlab-27]
+	_ = x[MemoryKindAtreeArrayElementOverhead-28]
+	_ = x[MemoryKindAtreeMapDataSlab-29]
+	_ = x[MemoryKindAtreeMapMetaDataSlab-30]
+	_ = x[MemoryKindAtreeMapElementOverhead-31]
+	_ = x[MemoryKindAtreeMapPreAllocatedElement-32]
+	_ = x[MemoryKindAtreeEncodedSlab-33]
+	_ = x[MemoryKindPrimitiveStaticType-34]
+	_ = x[MemoryKindCompositeStaticType-35]
+	_ = x[MemoryKindInterfaceStaticType-36]
+	_ = x[MemoryKindVariableSizedStaticType-37]
+	_ = x[MemoryKindConstantSizedStaticType-38]
+	_ = x[MemoryKindDictionaryStaticType-39]
+	_ = x[MemoryKindOptionalStaticType-40]
+	_ = x[MemoryKindRestrictedStaticType-41]
+	_ = x[MemoryKindReferenceStaticType-42]
+	_ = x[MemoryKindCapabilityStaticType-43]
+	_ = x[MemoryKindFunctionStaticType-44]
+	_ = x[MemoryKindRangeStaticType-45]
+	_ = x[MemoryKindTupleStaticType-46]
+	_ = x[MemoryKindCadenceVoidValue-47]
+	_ = x[MemoryKindCadenceOptionalValue-48]
+	_ = x[MemoryKindCadenceBoolValue-49]
+	_ = x[MemoryKindCadenceStringValue-50]
+	_ = x[MemoryKindCadenceCharacterValue-51]
+	_ = x[MemoryKindCadenceAddressValue-52]
+	_ = x[MemoryKindCadenceIntValue-53]
+	_ = x[MemoryKindCadenceNumberValue-54]
+	_ = x[MemoryKindCadenceArrayValueBase-55]
+	_ = x[MemoryKindCadenceArrayValueLength-56]
+	_ = x[MemoryKindCadenceDictionaryValue-57]
+	_ = x[MemoryKindCadenceKeyValuePair-58]
+	_ = x[MemoryKindCadenceStructValueBase-59]
+	_ = x[MemoryKindCadenceStructValueSize-60]
+	_ = x[MemoryKindCadenceResourceValueBase-61]
+	_ = x[MemoryKindCadenceResourceValueSize-62]
+	_ = x[MemoryKindCadenceEventValueBase-63]
+	_ = x[MemoryKindCadenceEventValueSize-64]
+	_ = x[MemoryKindCadenceContractValueBase-65]
+	_ = x[MemoryKindCadenceContractValueSize-66]
+	_ = x[MemoryKindCadenceEnumValueBase-67]
+	_ = x[MemoryKindCadenceEnumValueSize-68]
+	_ = x[MemoryKindCadenceLinkValue-69]
+	_ = x[MemoryKindCadencePathValue-70]
+	_ = x[MemoryKindCadenceTypeValue-71]
+	_ = x[MemoryKindCadenceCapabilityValue-72]
+	_ = x[MemoryKindCadenceTupleValueBase-73]
+	_ = x[MemoryKindCadenceSimpleType-74]
+	_ = x[MemoryKindCadenceOptionalType-75]
+	_ = x[MemoryKindCadenceVariableSizedArrayType-76]
+	_ = x[MemoryKindCadenceConstantSizedArrayType-77]
+	_ = x[MemoryKindCadenceDictionaryType-78]
+	_ = x[MemoryKindCadenceField-79]
+	_ = x[MemoryKindCadenceParameter-80]
+	_ = x[MemoryKindCadenceStructType-81]
+	_ = x[MemoryKindCadenceResourceType-82]
+	_ = x[MemoryKindCadenceEventType-83]
+	_ = x[MemoryKindCadenceContractType-84]
+	_ = x[MemoryKindCadenceStructInterfaceType-85]
+	_ = x[MemoryKindCadenceResourceInterfaceType-86]
+	_ = x[MemoryKindCadenceContractInterfaceType-87]
+	_ = x[MemoryKindCadenceFunctionType-88]
+	_ = x[MemoryKindCadenceReferenceType-89]
+	_ = x[MemoryKindCadenceRestrictedType-90]
+	_ = x[MemoryKindCadenceCapabilityType-91]
+	_ = x[MemoryKindCadenceEnumType-92]
+	_ = x[MemoryKindCadenceTupleType-93]
+	_ = x[MemoryKindRawString-94]
+	_ = x[MemoryKindAddressLocation-95]
+	_ = x[MemoryKindBytes-96]
+	_ = x[MemoryKindVariable-97]
+	_ = x[MemoryKindCompositeTypeInfo-98]
+	_ = x[MemoryKindCompositeField-99]
+	_ = x[MemoryKindInvocation-100]
+	_ = x[MemoryKindStorageMap-101]
+	_ = x[MemoryKindStorageKey-102]
+	_ = x[MemoryKindValueToken-103]
+	_ = x[MemoryKindSyntaxToken-104]
+	_ = x[MemoryKindSpaceToken-105]
+	_ = x[MemoryKindProgram-106]
+	_ = x[MemoryKindIdentifier-107]
+	_ = x[MemoryKindArgument-108]
+	_ = x[MemoryKindBlock-109]
+	_ = x[MemoryKindFunctionBlock-110]
+	_ = x[MemoryKindParameter-111]
+	_ = x[MemoryKindParameterList-112]
+	_ = x[MemoryKindTypeParameter-113]
+	_ = x[MemoryKindTransfer-114]
+	_ = x[MemoryKindMembers-115]
+	_ = x[MemoryKindTypeAnnotation-116]
+	_ = x[MemoryKindDictionaryEntry-117]
+	_ = x[MemoryKindFunctionDeclaration-118]
+	_ = x[MemoryKindCompositeDeclaration-119]
+	_ = x[MemoryKindInterfaceDeclaration-120]
+	_ = x[MemoryKindEnumCaseDeclaration-121]
+	_ = x[MemoryKindFieldDeclaration-122]
+	_ = x[MemoryKindTransactionDeclaration-123]
+	_ = x[MemoryKindImportDeclaration-124]
+	_ = x[MemoryKindVariableDeclaration-125]
+	_ = x[MemoryKindTupleVariableDeclaration-126]
+	_ = x[MemoryKindSpecialFunctionDeclaration-127]
+	_ = x[MemoryKindPragmaDeclaration-128]
+	_ = x[MemoryKindTypeAliasDeclaration-129]
+	_ = x[MemoryKindAssignmentStatement-130]
+	_ = x[MemoryKindBreakStatement-131]
+	_ = x[MemoryKindContinueStatement-132]
+	_ = x[MemoryKindEmitStatement-133]
+	_ = x[MemoryKindExpressionStatement-134]
+	_ = x[MemoryKindForStatement-135]
+	_ = x[MemoryKindIfStatement-136]
+	_ = x[MemoryKindRemoveStatement-137]
+	_ = x[MemoryKindReturnStatement-138]
+	_ = x[MemoryKindSwapStatement-139]
+	_ = x[MemoryKindSwitchStatement-140]
+	_ = x[MemoryKindWhileStatement-141]
+	_ = x[MemoryKindBooleanExpression-142]
+	_ = x[MemoryKindNilExpression-143]
+	_ = x[MemoryKindStringExpression-144]
+	_ = x[MemoryKindIntegerExpression-145]
+	_ = x[MemoryKindFixedPointExpression-146]
+	_ = x[MemoryKindArrayExpression-147]
+	_ = x[MemoryKindDictionaryExpression-148]
+	_ = x[MemoryKindIdentifierExpression-149]
+	_ = x[MemoryKindInvocationExpression-150]
+	_ = x[MemoryKindMemberExpression-151]
+	_ = x[MemoryKindIndexExpression-152]
+	_ = x[MemoryKindConditionalExpression-153]
+	_ = x[MemoryKindUnaryExpression-154]
+	_ = x[MemoryKindBinaryExpression-155]
+	_ = x[MemoryKindFunctionExpression-156]
+	_ = x[MemoryKindCastingExpression-157]
+	_ = x[MemoryKindCreateExpression-158]
+	_ = x[MemoryKindDestroyExpression-159]
+	_ = x[MemoryKindReferenceExpression-160]
+	_ = x[MemoryKindForceExpression-161]
+	_ = x[MemoryKindPathExpression-162]
+	_ = x[MemoryKindAttachExpression-163]
+	_ = x[MemoryKindTryExpression-164]
+	_ = x[MemoryKindStringTemplateExpression-165]
+	_ = x[MemoryKindTupleExpression-166]
+	_ = x[MemoryKindOptionalBindingPattern-167]
+	_ = x[MemoryKindConstantSizedType-168]
+	_ = x[MemoryKindDictionaryType-169]
+	_ = x[MemoryKindFunctionType-170]
+	_ = x[MemoryKindInstantiationType-171]
+	_ = x[MemoryKindNominalType-172]
+	_ = x[MemoryKindOptionalType-173]
+	_ = x[MemoryKindReferenceType-174]
+	_ = x[MemoryKindRestrictedType-175]
+	_ = x[MemoryKindVariableSizedType-176]
+	_ = x[MemoryKindTupleType-177]
+	_ = x[MemoryKindPosition-178]
+	_ = x[MemoryKindRange-179]
+	_ = x[MemoryKindElaboration-180]
+	_ = x[MemoryKindActivation-181]
+	_ = x[MemoryKindActivationEntries-182]
+	_ = x[MemoryKindVariableSizedSemaType-183]
+	_ = x[MemoryKindConstantSizedSemaType-184]
+	_ = x[MemoryKindDictionarySemaType-185]
+	_ = x[MemoryKindOptionalSemaType-186]
+	_ = x[MemoryKindRestrictedSemaType-187]
+	_ = x[MemoryKindReferenceSemaType-188]
+	_ = x[MemoryKindCapabilitySemaType-189]
+	_ = x[MemoryKindRangeSemaType-190]
+	_ = x[MemoryKindTupleSemaType-191]
+	_ = x[MemoryKindOrderedMap-192]
+	_ = x[MemoryKindOrderedMapEntryList-193]
+	_ = x[MemoryKindOrderedMapEntry-194]
+	_ = x[MemoryKindLast-195]
 }
 
-const _MemoryKind_name = "UnknownBoolValueAddressValueStringValueCharacterValueNumberValueArrayValueBaseDictionaryValueBaseCompositeValueBaseSimpleCompositeValueBaseOptionalValueNilValueVoidValueTypeValuePathValueCapabilityValueLinkValueStorageReferenceValueEphemeralReferenceValueInterpretedFunctionValueHostFunctionValueBoundFunctionValueBigIntSimpleCompositeValueAtreeArrayDataSlabAtreeArrayMetaDataSlabAtreeArrayElementOverheadAtreeMapDataSlabAtreeMapMetaDataSlabAtreeMapElementOverheadAtreeMapPreAllocatedElementAtreeEncodedSlabPrimitiveStaticTypeCompositeStaticTypeInterfaceStaticTypeVariableSizedStaticTypeConstantSizedStaticTypeDictionaryStaticTypeOptionalStaticTypeRestrictedStaticTypeReferenceStaticTypeCapabilityStaticTypeFunctionStaticTypeCadenceVoidValueCadenceOptionalValueCadenceBoolValueCadenceStringValueCadenceCharacterValueCadenceAddressValueCadenceIntValueCadenceNumberValueCadenceArrayValueBaseCadenceArrayValueLengthCadenceDictionaryValueCadenceKeyValuePairCadenceStructValueBaseCadenceStructValueSizeCadenceResourceValueBaseCadenceResourceValueSizeCadenceEventValueBaseCadenceEventValueSizeCadenceContractValueBaseCadenceContractValueSizeCadenceEnumValueBaseCadenceEnumValueSizeCadenceLinkValueCadencePathValueCadenceTypeValueCadenceCapabilityValueCadenceSimpleTypeCadenceOptionalTypeCadenceVariableSizedArrayTypeCadenceConstantSizedArrayTypeCadenceDictionaryTypeCadenceFieldCadenceParameterCadenceStructTypeCadenceResourceTypeCadenceEventTypeCadenceContractTypeCadenceStructInterfaceTypeCadenceResourceInterfaceTypeCadenceContractInterfaceTypeCadenceFunctionTypeCadenceReferenceTypeCadenceRestrictedTypeCadenceCapabilityTypeCadenceEnumTypeRawStringAddressLocationBytesVariableCompositeTypeInfoCompositeFieldInvocationStorageMapStorageKeyValueTokenSyntaxTokenSpaceTokenProgramIdentifierArgumentBlockFunctionBlockParameterParameterListTransferMembersTypeAnnotationDictionaryEntryFunctionDeclarationCompositeDeclarationInterfaceDeclarationEnumCaseDeclarationFieldDeclarationTransactionDeclarationImportDeclarationVariableDeclarationSpecialFunctionDeclarationPragmaDeclarationAssignmentStatementBreakStatementContinueStatementEmitStatementExpressionStatementForStatementIfStatementReturnStatementSwapStatementSwitchStatementWhileStatementBooleanExpressionNilExpressionStringExpressionIntegerExpressionFixedPointExpressionArrayExpressionDictionaryExpressionIdentifierExpressionInvocationExpressionMemberExpressionIndexExpressionConditionalExpressionUnaryExpressionBinaryExpressionFunctionExpressionCastingExpressionCreateExpressionDestroyExpressionReferenceExpressionForceExpressionPathExpressionConstantSizedTypeDictionaryTypeFunctionTypeInstantiationTypeNominalTypeOptionalTypeReferenceTypeRestrictedTypeVariableSizedTypePositionRangeElaborationActivationActivationEntriesVariableSizedSemaTypeConstantSizedSemaTypeDictionarySemaTypeOptionalSemaTypeRestrictedSemaTypeReferenceSemaTypeCapabilitySemaTypeOrderedMapOrderedMapEntryListOrderedMapEntryLast"
+const _MemoryKind_name = "UnknownBoolValueAddressValueStringValueCharacterValueNumberValueArrayValueBaseDictionaryValueBaseCompositeValueBaseSimpleCompositeValueBaseTupleValueBaseOptionalValueNilValueVoidValueTypeValuePathValueCapabilityValueLinkValueStorageReferenceValueEphemeralReferenceValueInterpretedFunctionValueHostFunctionValueBoundFunctionValueBigIntSimpleCompositeValuePublishedValueAtreeArrayDataSlabAtreeArrayMetaDataSlabAtreeArrayElementOverheadAtreeMapDataSlabAtreeMapMetaDataSlabAtreeMapElementOverheadAtreeMapPreAllocatedElementAtreeEncodedSlabPrimitiveStaticTypeCompositeStaticTypeInterfaceStaticTypeVariableSizedStaticTypeConstantSizedStaticTypeDictionaryStaticTypeOptionalStaticTypeRestrictedStaticTypeReferenceStaticTypeCapabilityStaticTypeFunctionStaticTypeRangeStaticTypeTupleStaticTypeCadenceVoidValueCadenceOptionalValueCadenceBoolValueCadenceStringValueCadenceCharacterValueCadenceAddressValueCadenceIntValueCadenceNumberValueCadenceArrayValueBaseCadenceArrayValueLengthCadenceDictionaryValueCadenceKeyValuePairCadenceStructValueBaseCadenceStructValueSizeCadenceResourceValueBaseCadenceResourceValueSizeCadenceEventValueBaseCadenceEventValueSizeCadenceContractValueBaseCadenceContractValueSizeCadenceEnumValueBaseCadenceEnumValueSizeCadenceLinkValueCadencePathValueCadenceTypeValueCadenceCapabilityValueCadenceTupleValueBaseCadenceSimpleTypeCadenceOptionalTypeCadenceVariableSizedArrayTypeCadenceConstantSizedArrayTypeCadenceDictionaryTypeCadenceFieldCadenceParameterCadenceStructTypeCadenceResourceTypeCadenceEventTypeCadenceContractTypeCadenceStructInterfaceTypeCadenceResourceInterfaceTypeCadenceContractInterfaceTypeCadenceFunctionTypeCadenceReferenceTypeCadenceRestrictedTypeCadenceCapabilityTypeCadenceEnumTypeCadenceTupleTypeRawStringAddressLocationBytesVariableCompositeTypeInfoCompositeFieldInvocationStorageMapStorageKeyValueTokenSyntaxTokenSpaceTokenProgramIdentifierArgumentBlockFunctionBlockParameterParameterListTypeParameterTransferMembersTypeAnnotationDictionaryEntryFunctionDeclarationCompositeDeclarationInterfaceDeclarationEnumCaseDeclarationFieldDeclarationTransactionDeclarationImportDeclarationVariableDeclarationTupleVariableDeclarationSpecialFunctionDeclarationPragmaDeclarationTypeAliasDeclarationAssignmentStatementBreakStatementContinueStatementEmitStatementExpressionStatementForStatementIfStatementRemoveStatementReturnStatementSwapStatementSwitchStatementWhileStatementBooleanExpressionNilExpressionStringExpressionIntegerExpressionFixedPointExpressionArrayExpressionDictionaryExpressionIdentifierExpressionInvocationExpressionMemberExpressionIndexExpressionConditionalExpressionUnaryExpressionBinaryExpressionFunctionExpressionCastingExpressionCreateExpressionDestroyExpressionReferenceExpressionForceExpressionPathExpressionAttachExpressionTryExpressionStringTemplateExpressionTupleExpressionOptionalBindingPatternConstantSizedTypeDictionaryTypeFunctionTypeInstantiationTypeNominalTypeOptionalTypeReferenceTypeRestrictedTypeVariableSizedTypeTupleTypePositionRangeElaborationActivationActivationEntriesVariableSizedSemaTypeConstantSizedSemaTypeDictionarySemaTypeOptionalSemaTypeRestrictedSemaTypeReferenceSemaTypeCapabilitySemaTypeRangeSemaTypeTupleSemaTypeOrderedMapOrderedMapEntryListOrderedMapEntryLast"
 
-var _MemoryKind_index = [...]uint16{0, 7, 16, 28, 39, 53, 64, 78, 97, 115, 139, 152, 160, 169, 178, 187, 202, 211, 232, 255, 279, 296, 314, 320, 340, 358, 380, 405, 421, 441, 464, 491, 507, 526, 545, 564, 587, 610, 630, 648, 668, 687, 707, 725, 741, 761, 777, 795, 816, 835, 850, 868, 889, 912, 934, 953, 975, 997, 1021, 1045, 1066, 1087, 1111, 1135, 1155, 1175, 1191, 1207, 1223, 1245, 1262, 1281, 1310, 1339, 1360, 1372, 1388, 1405, 1424, 1440, 1459, 1485, 1513, 1541, 1560, 1580, 1601, 1622, 1637, 1646, 1661, 1666, 1674, 1691, 1705, 1715, 1725, 1735, 1745, 1756, 1766, 1773, 1783, 1791, 1796, 1809, 1818, 1831, 1839, 1846, 1860, 1875, 1894, 1914, 1934, 1953, 1969, 1991, 2008, 2027, 2053, 2070, 2089, 2103, 2120, 2133, 2152, 2164, 2175, 2190, 2203, 2218, 2232, 2249, 2262, 2278, 2295, 2315, 2330, 2350, 2370, 2390, 2406, 2421, 2442, 2457, 2473, 2491, 2508, 2524, 2541, 2560, 2575, 2589, 2606, 2620, 2632, 2649, 2660, 2672, 2685, 2699, 2716, 2724, 2729, 2740, 2750, 2767, 2788, 2809, 2827, 2843, 2861, 2878, 2896, 2906, 2925, 2940, 2944}
+var _MemoryKind_index = [...]uint16{0, 7, 16, 28, 39, 53, 64, 78, 97, 115, 139, 153, 166, 174, 183, 192, 201, 216, 225, 246, 269, 293, 310, 328, 334, 354, 368, 386, 408, 433, 449, 469, 492, 519, 535, 554, 573, 592, 615, 638, 658, 676, 696, 715, 735, 753, 768, 783, 799, 819, 835, 853, 874, 893, 908, 926, 947, 970, 992, 1011, 1033, 1055, 1079, 1103, 1124, 1145, 1169, 1193, 1213, 1233, 1249, 1265, 1281, 1303, 1324, 1341, 1360, 1389, 1418, 1439, 1451, 1467, 1484, 1503, 1519, 1538, 1564, 1592, 1620, 1639, 1659, 1680, 1701, 1716, 1732, 1741, 1756, 1761, 1769, 1786, 1800, 1810, 1820, 1830, 1840, 1851, 1861, 1868, 1878, 1886, 1891, 1904, 1913, 1926, 1939, 1947, 1954, 1968, 1983, 2002, 2022, 2042, 2061, 2077, 2099, 2116, 2135, 2159, 2185, 2202, 2222, 2241, 2255, 2272, 2285, 2304, 2316, 2327, 2342, 2357, 2370, 2385, 2399, 2416, 2429, 2445, 2462, 2482, 2497, 2517, 2537, 2557, 2573, 2588, 2609, 2624, 2640, 2658, 2675, 2691, 2708, 2727, 2742, 2756, 2772, 2785, 2809, 2824, 2846, 2863, 2877, 2889, 2906, 2917, 2929, 2942, 2956, 2973, 2982, 2990, 2995, 3006, 3016, 3033, 3054, 3075, 3093, 3109, 3127, 3144, 3162, 3175, 3188, 3198, 3217, 3232, 3236}
 
 func (i MemoryKind) String() string {
 	if i >= MemoryKind(len(_MemoryKind_index)-1) {
@@ -51,6 +51,7 @@ var (
 	FunctionBlockMemoryUsage   = NewConstantMemoryUsage(MemoryKindFunctionBlock)
 	ParameterMemoryUsage       = NewConstantMemoryUsage(MemoryKindParameter)
 	ParameterListMemoryUsage   = NewConstantMemoryUsage(MemoryKindParameterList)
+	TypeParameterMemoryUsage   = NewConstantMemoryUsage(MemoryKindTypeParameter)
 	TransferMemoryUsage        = NewConstantMemoryUsage(MemoryKindTransfer)
 	TypeAnnotationMemoryUsage  = NewConstantMemoryUsage(MemoryKindTypeAnnotation)
 	DictionaryEntryMemoryUsage = NewConstantMemoryUsage(MemoryKindDictionaryEntry)
@@ -65,8 +66,10 @@ var (
 	FieldDeclarationMemoryUsage           = NewConstantMemoryUsage(MemoryKindFieldDeclaration)
 	EnumCaseDeclarationMemoryUsage        = NewConstantMemoryUsage(MemoryKindEnumCaseDeclaration)
 	VariableDeclarationMemoryUsage        = NewConstantMemoryUsage(MemoryKindVariableDeclaration)
+	TupleVariableDeclarationMemoryUsage   = NewConstantMemoryUsage(MemoryKindTupleVariableDeclaration)
 	SpecialFunctionDeclarationMemoryUsage = NewConstantMemoryUsage(MemoryKindSpecialFunctionDeclaration)
 	PragmaDeclarationMemoryUsage          = NewConstantMemoryUsage(MemoryKindPragmaDeclaration)
+	TypeAliasDeclarationMemoryUsage       = NewConstantMemoryUsage(MemoryKindTypeAliasDeclaration)
 
 	// AST Statements
 
@@ -77,6 +80,7 @@ var (
 	ExpressionStatementMemoryUsage = NewConstantMemoryUsage(MemoryKindExpressionStatement)
 	ForStatementMemoryUsage        = NewConstantMemoryUsage(MemoryKindForStatement)
 	IfStatementMemoryUsage         = NewConstantMemoryUsage(MemoryKindIfStatement)
+	RemoveStatementMemoryUsage     = NewConstantMemoryUsage(MemoryKindRemoveStatement)
 	ReturnStatementMemoryUsage     = NewConstantMemoryUsage(MemoryKindReturnStatement)
 	SwapStatementMemoryUsage       = NewConstantMemoryUsage(MemoryKindSwapStatement)
 	SwitchStatementMemoryUsage     = NewConstantMemoryUsage(MemoryKindSwitchStatement)
@@ -84,25 +88,30 @@ var (
 
 	// AST Expressions
 
-	BooleanExpressionMemoryUsage     = NewConstantMemoryUsage(MemoryKindBooleanExpression)
-	NilExpressionMemoryUsage         = NewConstantMemoryUsage(MemoryKindNilExpression)
-	StringExpressionMemoryUsage      = NewConstantMemoryUsage(MemoryKindStringExpression)
-	IntegerExpressionMemoryUsage     = NewConstantMemoryUsage(MemoryKindIntegerExpression)
-	FixedPointExpressionMemoryUsage  = NewConstantMemoryUsage(MemoryKindFixedPointExpression)
-	IdentifierExpressionMemoryUsage  = NewConstantMemoryUsage(MemoryKindIdentifierExpression)
-	InvocationExpressionMemoryUsage  = NewConstantMemoryUsage(MemoryKindInvocationExpression)
-	MemberExpressionMemoryUsage      = NewConstantMemoryUsage(MemoryKindMemberExpression)
-	IndexExpressionMemoryUsage       = NewConstantMemoryUsage(MemoryKindIndexExpression)
-	ConditionalExpressionMemoryUsage = NewConstantMemoryUsage(MemoryKindConditionalExpression)
-	UnaryExpressionMemoryUsage       = NewConstantMemoryUsage(MemoryKindUnaryExpression)
-	BinaryExpressionMemoryUsage      = NewConstantMemoryUsage(MemoryKindBinaryExpression)
-	FunctionExpressionMemoryUsage    = NewConstantMemoryUsage(MemoryKindFunctionExpression)
-	CastingExpressionMemoryUsage     = NewConstantMemoryUsage(MemoryKindCastingExpression)
-	CreateExpressionMemoryUsage      = NewConstantMemoryUsage(MemoryKindCreateExpression)
-	DestroyExpressionMemoryUsage     = NewConstantMemoryUsage(MemoryKindDestroyExpression)
-	ReferenceExpressionMemoryUsage   = NewConstantMemoryUsage(MemoryKindReferenceExpression)
-	ForceExpressionMemoryUsage       = NewConstantMemoryUsage(MemoryKindForceExpression)
-	PathExpressionMemoryUsage        = NewConstantMemoryUsage(MemoryKindPathExpression)
+	BooleanExpressionMemoryUsage        = NewConstantMemoryUsage(MemoryKindBooleanExpression)
+	NilExpressionMemoryUsage            = NewConstantMemoryUsage(MemoryKindNilExpression)
+	StringExpressionMemoryUsage         = NewConstantMemoryUsage(MemoryKindStringExpression)
+	IntegerExpressionMemoryUsage        = NewConstantMemoryUsage(MemoryKindIntegerExpression)
+	FixedPointExpressionMemoryUsage     = NewConstantMemoryUsage(MemoryKindFixedPointExpression)
+	IdentifierExpressionMemoryUsage     = NewConstantMemoryUsage(MemoryKindIdentifierExpression)
+	InvocationExpressionMemoryUsage     = NewConstantMemoryUsage(MemoryKindInvocationExpression)
+	MemberExpressionMemoryUsage         = NewConstantMemoryUsage(MemoryKindMemberExpression)
+	IndexExpressionMemoryUsage          = NewConstantMemoryUsage(MemoryKindIndexExpression)
+	ConditionalExpressionMemoryUsage    = NewConstantMemoryUsage(MemoryKindConditionalExpression)
+	UnaryExpressionMemoryUsage          = NewConstantMemoryUsage(MemoryKindUnaryExpression)
+	BinaryExpressionMemoryUsage         = NewConstantMemoryUsage(MemoryKindBinaryExpression)
+	FunctionExpressionMemoryUsage       = NewConstantMemoryUsage(MemoryKindFunctionExpression)
+	CastingExpressionMemoryUsage        = NewConstantMemoryUsage(MemoryKindCastingExpression)
+	CreateExpressionMemoryUsage         = NewConstantMemoryUsage(MemoryKindCreateExpression)
+	DestroyExpressionMemoryUsage        = NewConstantMemoryUsage(MemoryKindDestroyExpression)
+	ReferenceExpressionMemoryUsage      = NewConstantMemoryUsage(MemoryKindReferenceExpression)
+	ForceExpressionMemoryUsage          = NewConstantMemoryUsage(MemoryKindForceExpression)
+	PathExpressionMemoryUsage           = NewConstantMemoryUsage(MemoryKindPathExpression)
+	AttachExpressionMemoryUsage         = NewConstantMemoryUsage(MemoryKindAttachExpression)
+	TryExpressionMemoryUsage            = NewConstantMemoryUsage(MemoryKindTryExpression)
+	StringTemplateExpressionMemoryUsage = NewConstantMemoryUsage(MemoryKindStringTemplateExpression)
+	TupleExpressionMemoryUsage          = NewConstantMemoryUsage(MemoryKindTupleExpression)
+	OptionalBindingPatternMemoryUsage   = NewConstantMemoryUsage(MemoryKindOptionalBindingPattern)
 
 	// AST Types
 
@@ -115,6 +124,7 @@ var (
 	ReferenceTypeMemoryUsage     = NewConstantMemoryUsage(MemoryKindReferenceType)
 	RestrictedTypeMemoryUsage    = NewConstantMemoryUsage(MemoryKindRestrictedType)
 	VariableSizedTypeMemoryUsage = NewConstantMemoryUsage(MemoryKindVariableSizedType)
+	TupleTypeMemoryUsage         = NewConstantMemoryUsage(MemoryKindTupleType)
 
 	PositionMemoryUsage = NewConstantMemoryUsage(MemoryKindPosition)
 	RangeMemoryUsage    = NewConstantMemoryUsage(MemoryKindRange)
@@ -133,6 +143,7 @@ var (
 	DictionaryValueBaseMemoryUsage      = NewConstantMemoryUsage(MemoryKindDictionaryValueBase)
 	ArrayValueBaseMemoryUsage           = NewConstantMemoryUsage(MemoryKindArrayValueBase)
 	CompositeValueBaseMemoryUsage       = NewConstantMemoryUsage(MemoryKindCompositeValueBase)
+	TupleValueBaseMemoryUsage           = NewConstantMemoryUsage(MemoryKindTupleValueBase)
 	AddressValueMemoryUsage             = NewConstantMemoryUsage(MemoryKindAddressValue)
 	BoolValueMemoryUsage                = NewConstantMemoryUsage(MemoryKindBoolValue)
 	NilValueMemoryUsage                 = NewConstantMemoryUsage(MemoryKindNilValue)
@@ -144,6 +155,7 @@ var (
 	EphemeralReferenceValueMemoryUsage  = NewConstantMemoryUsage(MemoryKindEphemeralReferenceValue)
 	StorageReferenceValueMemoryUsage    = NewConstantMemoryUsage(MemoryKindStorageReferenceValue)
 	LinkValueMemoryUsage                = NewConstantMemoryUsage(MemoryKindLinkValue)
+	PublishedValueMemoryUsage           = NewConstantMemoryUsage(MemoryKindPublishedValue)
 	PathValueMemoryUsage                = NewConstantMemoryUsage(MemoryKindPathValue)
 	OptionalValueMemoryUsage            = NewConstantMemoryUsage(MemoryKindOptionalValue)
 	TypeValueMemoryUsage                = NewConstantMemoryUsage(MemoryKindTypeValue)
@@ -161,6 +173,8 @@ var (
 	ReferenceStaticTypeMemoryUsage     = NewConstantMemoryUsage(MemoryKindReferenceStaticType)
 	CapabilityStaticTypeMemoryUsage    = NewConstantMemoryUsage(MemoryKindCapabilityStaticType)
 	FunctionStaticTypeMemoryUsage      = NewConstantMemoryUsage(MemoryKindFunctionStaticType)
+	RangeStaticTypeMemoryUsage         = NewConstantMemoryUsage(MemoryKindRangeStaticType)
+	TupleStaticTypeMemoryUsage         = NewConstantMemoryUsage(MemoryKindTupleStaticType)
 
 	// Sema types
 
@@ -171,6 +185,8 @@ var (
 	RestrictedSemaTypeMemoryUsage    = NewConstantMemoryUsage(MemoryKindRestrictedSemaType)
 	ReferenceSemaTypeMemoryUsage     = NewConstantMemoryUsage(MemoryKindReferenceSemaType)
 	CapabilitySemaTypeMemoryUsage    = NewConstantMemoryUsage(MemoryKindCapabilitySemaType)
+	RangeSemaTypeMemoryUsage         = NewConstantMemoryUsage(MemoryKindRangeSemaType)
+	TupleSemaTypeMemoryUsage         = NewConstantMemoryUsage(MemoryKindTupleSemaType)
 
 	// Storage related memory usages
 
@@ -197,6 +213,7 @@ var (
 	CadencePathValueMemoryUsage         = NewConstantMemoryUsage(MemoryKindCadencePathValue)
 	CadenceVoidValueMemoryUsage         = NewConstantMemoryUsage(MemoryKindCadenceVoidValue)
 	CadenceTypeValueMemoryUsage         = NewConstantMemoryUsage(MemoryKindCadenceTypeValue)
+	CadenceTupleValueBaseMemoryUsage    = NewConstantMemoryUsage(MemoryKindCadenceTupleValueBase)
 
 	// Cadence external types
 
@@ -208,6 +225,7 @@ var (
 	CadenceContractTypeMemoryUsage           = NewConstantMemoryUsage(MemoryKindCadenceContractType)
 	CadenceDictionaryTypeMemoryUsage         = NewConstantMemoryUsage(MemoryKindCadenceDictionaryType)
 	CadenceEnumTypeMemoryUsage               = NewConstantMemoryUsage(MemoryKindCadenceEnumType)
+	CadenceTupleTypeMemoryUsage              = NewConstantMemoryUsage(MemoryKindCadenceTupleType)
 	CadenceEventTypeMemoryUsage              = NewConstantMemoryUsage(MemoryKindCadenceEventType)
 	CadenceFunctionTypeMemoryUsage           = NewConstantMemoryUsage(MemoryKindCadenceFunctionType)
 	CadenceOptionalTypeMemoryUsage           = NewConstantMemoryUsage(MemoryKindCadenceOptionalType)
@@ -239,6 +257,13 @@ var (
 	CapabilityValueStringMemoryUsage        = NewRawStringMemoryUsage(len("Capability<>(address: , path: )"))
 	LinkValueStringMemoryUsage              = NewRawStringMemoryUsage(len("Link<>()"))
 
+	AuthAccountCapabilitiesStringMemoryUsage          = NewRawStringMemoryUsage(len("AuthAccount.Capabilities()"))
+	AuthAccountStorageCapabilitiesStringMemoryUsage   = NewRawStringMemoryUsage(len("AuthAccount.StorageCapabilities()"))
+	AuthAccountInboxStringMemoryUsage                 = NewRawStringMemoryUsage(len("AuthAccount.Inbox()"))
+	PublishedValueStringMemoryUsage                   = NewRawStringMemoryUsage(len("PublishedValue<>()"))
+	StorageCapabilityControllerValueStringMemoryUsage = NewRawStringMemoryUsage(len("StorageCapabilityController(borrowType: , capabilityID: )"))
+	RangeValueStringMemoryUsage                       = NewRawStringMemoryUsage(len("..<"))
+
 	// Static types string representations
 
 	VariableSizedStaticTypeStringMemoryUsage = NewRawStringMemoryUsage(2)  // []
@@ -247,6 +272,8 @@ var (
 	AuthReferenceStaticTypeStringMemoryUsage = NewRawStringMemoryUsage(5)  // auth&
 	ReferenceStaticTypeStringMemoryUsage     = NewRawStringMemoryUsage(1)  // &
 	CapabilityStaticTypeStringMemoryUsage    = NewRawStringMemoryUsage(12) // Capability<>
+	RangeStaticTypeStringMemoryUsage         = NewRawStringMemoryUsage(16) // InclusiveRange<>
+	TupleStaticTypeStringMemoryUsage         = NewRawStringMemoryUsage(2)  // ()
 )
 
 func UseMemory(gauge MemoryGauge, usage MemoryUsage) {
@@ -301,20 +328,20 @@ func newAtreeMemoryUsage(count uint64, elementSize uint, array bool) (MemoryUsag
 	newLeafNodes, newBranchNodes := atreeNodes(count, elementSize)
 	if array {
 		return MemoryUsage{
-				Kind:   MemoryKindAtreeArrayDataSlab,
-				Amount: newLeafNodes,
-			}, MemoryUsage{
-				Kind:   MemoryKindAtreeArrayMetaDataSlab,
-				Amount: newBranchNodes,
-			}
+			Kind:   MemoryKindAtreeArrayDataSlab,
+			Amount: newLeafNodes,
+		}, MemoryUsage{
+			Kind:   MemoryKindAtreeArrayMetaDataSlab,
+			Amount: newBranchNodes,
+		}
 	} else {
 		return MemoryUsage{
-				Kind:   MemoryKindAtreeMapDataSlab,
-				Amount: newLeafNodes,
-			}, MemoryUsage{
-				Kind:   MemoryKindAtreeMapMetaDataSlab,
-				Amount: newBranchNodes,
-			}
+			Kind:   MemoryKindAtreeMapDataSlab,
+			Amount: newLeafNodes,
+		}, MemoryUsage{
+			Kind:   MemoryKindAtreeMapMetaDataSlab,
+			Amount: newBranchNodes,
+		}
 	}
 }
 
@@ -330,20 +357,20 @@ func AdditionalAtreeMemoryUsage(originalCount uint64, elementSize uint, array bo
 	newLeafNodes, newBranchNodes := atreeNodes(originalCount+1, elementSize)
 	if array {
 		return MemoryUsage{
-				Kind:   MemoryKindAtreeArrayDataSlab,
-				Amount: newLeafNodes - originalLeafNodes,
-			}, MemoryUsage{
-				Kind:   MemoryKindAtreeArrayMetaDataSlab,
-				Amount: newBranchNodes - originalBranchNodes,
-			}
+			Kind:   MemoryKindAtreeArrayDataSlab,
+			Amount: newLeafNodes - originalLeafNodes,
+		}, MemoryUsage{
+			Kind:   MemoryKindAtreeArrayMetaDataSlab,
+			Amount: newBranchNodes - originalBranchNodes,
+		}
 	} else {
 		return MemoryUsage{
-				Kind:   MemoryKindAtreeMapDataSlab,
-				Amount: newLeafNodes - originalLeafNodes,
-			}, MemoryUsage{
-				Kind:   MemoryKindAtreeMapMetaDataSlab,
-				Amount: newBranchNodes - originalBranchNodes,
-			}
+			Kind:   MemoryKindAtreeMapDataSlab,
+			Amount: newLeafNodes - originalLeafNodes,
+		}, MemoryUsage{
+			Kind:   MemoryKindAtreeMapMetaDataSlab,
+			Amount: newBranchNodes - originalBranchNodes,
+		}
 	}
 }
 
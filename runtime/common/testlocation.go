@@ -0,0 +1,141 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/onflow/cadence/runtime/errors"
+)
+
+const TestLocationPrefix = "T"
+
+// TestLocation is the location of a test program,
+// identified by the name of the test (e.g. the test file).
+//
+// Types declared in test programs are qualified with the test name,
+// so that e.g. events emitted by different tests have distinct type IDs.
+//
+type TestLocation string
+
+var _ Location = TestLocation("")
+
+func NewTestLocation(gauge MemoryGauge, name string) TestLocation {
+	UseMemory(gauge, NewRawStringMemoryUsage(len(name)))
+	return TestLocation(name)
+}
+
+func (l TestLocation) ID() LocationID {
+	return l.MeteredID(nil)
+}
+
+func (l TestLocation) MeteredID(memoryGauge MemoryGauge) LocationID {
+	return NewMeteredLocationID(
+		memoryGauge,
+		TestLocationPrefix,
+		string(l),
+	)
+}
+
+func (l TestLocation) TypeID(memoryGauge MemoryGauge, qualifiedIdentifier string) TypeID {
+	return NewMeteredTypeID(
+		memoryGauge,
+		TestLocationPrefix,
+		string(l),
+		qualifiedIdentifier,
+	)
+}
+
+func (l TestLocation) QualifiedIdentifier(typeID TypeID) string {
+	pieces := strings.SplitN(string(typeID), ".", 3)
+
+	if len(pieces) < 3 {
+		return ""
+	}
+
+	return pieces[2]
+}
+
+func (l TestLocation) String() string {
+	return string(l)
+}
+
+func (l TestLocation) Description() string {
+	return fmt.Sprintf("test %s", string(l))
+}
+
+func (l TestLocation) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		Type string
+		Name string
+	}{
+		Type: "TestLocation",
+		Name: string(l),
+	})
+}
+
+func init() {
+	RegisterTypeIDDecoder(
+		TestLocationPrefix,
+		func(gauge MemoryGauge, typeID string) (location Location, qualifiedIdentifier string, err error) {
+			return decodeTestLocationTypeID(gauge, typeID)
+		},
+	)
+}
+
+func decodeTestLocationTypeID(gauge MemoryGauge, typeID string) (TestLocation, string, error) {
+
+	const errorMessagePrefix = "invalid test location type ID"
+
+	newError := func(message string) (TestLocation, string, error) {
+		return "", "", errors.NewDefaultUserError("%s: %s", errorMessagePrefix, message)
+	}
+
+	if typeID == "" {
+		return newError("missing prefix")
+	}
+
+	parts := strings.SplitN(typeID, ".", 3)
+
+	pieceCount := len(parts)
+	switch pieceCount {
+	case 1:
+		return newError("missing location")
+	case 2:
+		return newError("missing qualified identifier")
+	}
+
+	prefix := parts[0]
+
+	if prefix != TestLocationPrefix {
+		return "", "", errors.NewDefaultUserError(
+			"%s: invalid prefix: expected %q, got %q",
+			errorMessagePrefix,
+			TestLocationPrefix,
+			prefix,
+		)
+	}
+
+	location := NewTestLocation(gauge, parts[1])
+	qualifiedIdentifier := parts[2]
+
+	return location, qualifiedIdentifier, nil
+}
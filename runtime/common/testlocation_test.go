@@ -0,0 +1,139 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTestLocation_MarshalJSON(t *testing.T) {
+
+	t.Parallel()
+
+	loc := TestLocation("test")
+
+	actual, err := json.Marshal(loc)
+	require.NoError(t, err)
+
+	assert.JSONEq(t,
+		`
+        {
+            "Type": "TestLocation",
+            "Name": "test"
+        }
+        `,
+		string(actual),
+	)
+}
+
+func TestTestLocation_TypeID(t *testing.T) {
+
+	t.Parallel()
+
+	location := TestLocation("test")
+
+	typeID := location.TypeID(nil, "E")
+	assert.Equal(t, TypeID("T.test.E"), typeID)
+	assert.Equal(t, "E", location.QualifiedIdentifier(typeID))
+
+	// The type ID is distinct from the type ID of the same type
+	// declared in a string location with the same name
+
+	assert.NotEqual(t, StringLocation("test").TypeID(nil, "E"), typeID)
+}
+
+func TestDecodeTestLocationTypeID(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("missing prefix", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, _, err := decodeTestLocationTypeID(nil, "")
+		require.EqualError(t, err, "invalid test location type ID: missing prefix")
+	})
+
+	t.Run("missing location", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, _, err := decodeTestLocationTypeID(nil, "T")
+		require.EqualError(t, err, "invalid test location type ID: missing location")
+	})
+
+	t.Run("missing qualified identifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, _, err := decodeTestLocationTypeID(nil, "T.test")
+		require.EqualError(t, err, "invalid test location type ID: missing qualified identifier")
+	})
+
+	t.Run("invalid prefix", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, _, err := decodeTestLocationTypeID(nil, "X.test.T")
+		require.EqualError(t, err, "invalid test location type ID: invalid prefix: expected \"T\", got \"X\"")
+	})
+
+	t.Run("qualified identifier with one part", func(t *testing.T) {
+
+		t.Parallel()
+
+		location, qualifiedIdentifier, err := decodeTestLocationTypeID(nil, "T.test.E")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			TestLocation("test"),
+			location,
+		)
+		assert.Equal(t, "E", qualifiedIdentifier)
+	})
+
+	t.Run("qualified identifier with two parts", func(t *testing.T) {
+
+		t.Parallel()
+
+		location, qualifiedIdentifier, err := decodeTestLocationTypeID(nil, "T.test.R.E")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			TestLocation("test"),
+			location,
+		)
+		assert.Equal(t, "R.E", qualifiedIdentifier)
+	})
+}
+
+func TestDecodeTypeIDTestLocation(t *testing.T) {
+
+	t.Parallel()
+
+	location, qualifiedIdentifier, err := DecodeTypeID(nil, "T.test.E")
+	require.NoError(t, err)
+
+	assert.Equal(t, TestLocation("test"), location)
+	assert.Equal(t, "E", qualifiedIdentifier)
+}
@@ -0,0 +1,59 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"encoding/hex"
+	"hash/fnv"
+)
+
+const TypeIDHashLength = 4
+
+// TypeIDHash is a short, stable hash of a type ID.
+//
+// It is the big-endian 32-bit FNV-1a hash of the type ID,
+// so it does not depend on the process or platform,
+// and can be used in persisted data, e.g. storage keys.
+//
+// NOTE: the hash is not collision-resistant.
+// It must not be used where an adversary can choose type IDs
+// to cause a collision with another type ID.
+//
+type TypeIDHash [TypeIDHashLength]byte
+
+func (h TypeIDHash) String() string {
+	return hex.EncodeToString(h[:])
+}
+
+// Hash returns the stable four-byte hash of the type ID.
+//
+func (id TypeID) Hash() (result TypeIDHash) {
+	hasher := fnv.New32a()
+	// NOTE: Write of a hash.Hash never returns an error
+	_, _ = hasher.Write([]byte(id))
+	hasher.Sum(result[:0])
+	return
+}
+
+// TypeIDHashFromQualifiedName returns the hash of the type ID
+// of the type with the given qualified identifier in the given location.
+//
+func TypeIDHashFromQualifiedName(location Location, qualifiedIdentifier string) TypeIDHash {
+	return NewTypeIDFromQualifiedName(nil, location, qualifiedIdentifier).Hash()
+}
@@ -0,0 +1,68 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypeIDHash(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(typeID TypeID, expected string) {
+
+		t.Run(string(typeID), func(t *testing.T) {
+
+			t.Parallel()
+
+			hash := typeID.Hash()
+			assert.Equal(t, expected, hash.String())
+			// Hashing is stable
+			assert.Equal(t, hash, typeID.Hash())
+		})
+	}
+
+	test("", "811c9dc5")
+	test("S.test.Foo", "f42a9d7a")
+	test("A.0000000000000001.Test", "01330675")
+	test("A.0000000000000001.Test.R", "2faab0e9")
+}
+
+func TestTypeIDHashFromQualifiedName(t *testing.T) {
+
+	t.Parallel()
+
+	location := AddressLocation{
+		Address: Address{0, 0, 0, 0, 0, 0, 0, 1},
+		Name:    "Test",
+	}
+
+	assert.Equal(t,
+		TypeID("A.0000000000000001.Test.R").Hash(),
+		TypeIDHashFromQualifiedName(location, "Test.R"),
+	)
+
+	assert.Equal(t,
+		TypeID("R").Hash(),
+		TypeIDHashFromQualifiedName(nil, "R"),
+	)
+}
@@ -93,6 +93,11 @@ func (compiler *Compiler) VisitEmitStatement(_ *ast.EmitStatement) ast.Repr {
 	panic(errors.NewUnreachableError())
 }
 
+func (compiler *Compiler) VisitRemoveStatement(_ *ast.RemoveStatement) ast.Repr {
+	// TODO
+	panic(errors.NewUnreachableError())
+}
+
 func (compiler *Compiler) VisitSwitchStatement(_ *ast.SwitchStatement) ast.Repr {
 	// TODO
 	panic(errors.NewUnreachableError())
@@ -105,7 +110,7 @@ func (compiler *Compiler) VisitVariableDeclaration(declaration *ast.VariableDecl
 	// TODO: second value
 
 	identifier := declaration.Identifier.Identifier
-	targetType := compiler.Checker.Elaboration.VariableDeclarationTargetTypes[declaration]
+	targetType := compiler.Checker.Elaboration.VariableDeclarationTargetTypes[declaration.ID()]
 	valType := compileValueType(targetType)
 	local := compiler.declareLocal(identifier, valType)
 	exp := declaration.Value.Accept(compiler).(ir.Expr)
@@ -126,6 +131,11 @@ func (compiler *Compiler) VisitSwapStatement(_ *ast.SwapStatement) ast.Repr {
 	panic(errors.NewUnreachableError())
 }
 
+func (compiler *Compiler) VisitTupleVariableDeclaration(_ *ast.TupleVariableDeclaration) ast.Repr {
+	// TODO
+	panic(errors.NewUnreachableError())
+}
+
 func (compiler *Compiler) VisitExpressionStatement(_ *ast.ExpressionStatement) ast.Repr {
 	// TODO
 	panic(errors.NewUnreachableError())
@@ -265,6 +275,26 @@ func (compiler *Compiler) VisitPathExpression(_ *ast.PathExpression) ast.Repr {
 	panic(errors.NewUnreachableError())
 }
 
+func (compiler *Compiler) VisitAttachExpression(_ *ast.AttachExpression) ast.Repr {
+	// TODO
+	panic(errors.NewUnreachableError())
+}
+
+func (compiler *Compiler) VisitTryExpression(_ *ast.TryExpression) ast.Repr {
+	// TODO
+	panic(errors.NewUnreachableError())
+}
+
+func (compiler *Compiler) VisitStringTemplateExpression(_ *ast.StringTemplateExpression) ast.Repr {
+	// TODO
+	panic(errors.NewUnreachableError())
+}
+
+func (compiler *Compiler) VisitTupleExpression(_ *ast.TupleExpression) ast.Repr {
+	// TODO
+	panic(errors.NewUnreachableError())
+}
+
 func (compiler *Compiler) VisitProgram(_ *ast.Program) ast.Repr {
 	// TODO
 	panic(errors.NewUnreachableError())
@@ -285,7 +315,7 @@ func (compiler *Compiler) VisitFunctionDeclaration(declaration *ast.FunctionDecl
 
 	// Declare a local for each parameter
 
-	functionType := compiler.Checker.Elaboration.FunctionDeclarationFunctionTypes[declaration]
+	functionType := compiler.Checker.Elaboration.FunctionDeclarationFunctionTypes[declaration.ID()]
 
 	parameters := declaration.ParameterList.Parameters
 
@@ -366,6 +396,11 @@ func (compiler *Compiler) VisitPragmaDeclaration(_ *ast.PragmaDeclaration) ast.R
 	panic(errors.NewUnreachableError())
 }
 
+func (compiler *Compiler) VisitTypeAliasDeclaration(_ *ast.TypeAliasDeclaration) ast.Repr {
+	// TODO
+	panic(errors.NewUnreachableError())
+}
+
 func (compiler *Compiler) VisitImportDeclaration(_ *ast.ImportDeclaration) ast.Repr {
 	// TODO
 	panic(errors.NewUnreachableError())
@@ -27,8 +27,15 @@ type Context struct {
 	Interface         Interface
 	Location          Location
 	PredeclaredValues []ValueDeclaration
+	PredeclaredTypes  []TypeDeclaration
+	// CollectEvents configures if the events emitted by a script executed with
+	// Runtime.ExecuteScriptWithEvents are collected and returned,
+	// instead of being emitted to the interface
+	CollectEvents     bool
 	codes             map[common.Location][]byte
 	programs          map[common.Location]*ast.Program
+	readSetRecorder   *readSetRecorder
+	changeSetRecorder *changeSetRecorder
 }
 
 func (c Context) SetCode(location common.Location, code []byte) {
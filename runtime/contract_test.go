@@ -806,3 +806,272 @@ func TestRuntimeImportMultipleContracts(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestRuntimeImportSameAccountContracts(t *testing.T) {
+
+	t.Parallel()
+
+	address := common.MustBytesToAddress([]byte{0x1})
+
+	contractA := `
+      pub contract A {
+
+          pub struct S {}
+
+          pub fun a(): Int {
+              return 1
+          }
+      }
+    `
+
+	contractB := `
+      import A
+
+      pub contract B {
+
+          pub fun b(): Int {
+              return A.a() + 1
+          }
+
+          pub fun s(): A.S {
+              return A.S()
+          }
+      }
+    `
+
+	contractC := `
+      import Crypto
+      import A
+      import B
+
+      pub contract C {
+
+          pub fun c(): Int {
+              return A.a() + B.b()
+          }
+
+          pub fun keyList(): Crypto.KeyList {
+              return Crypto.KeyList()
+          }
+      }
+    `
+
+	newRuntimeInterface := func() (*testRuntimeInterface, map[common.Location][]byte) {
+
+		accountCodes := map[common.Location][]byte{}
+
+		runtimeInterface := &testRuntimeInterface{
+			storage: newTestLedger(nil, nil),
+			getSigningAccounts: func() ([]Address, error) {
+				return []Address{address}, nil
+			},
+			updateAccountContractCode: func(address Address, name string, code []byte) error {
+				location := common.AddressLocation{
+					Address: address,
+					Name:    name,
+				}
+				accountCodes[location] = code
+				return nil
+			},
+			getAccountContractCode: func(address Address, name string) (code []byte, err error) {
+				location := common.AddressLocation{
+					Address: address,
+					Name:    name,
+				}
+				code = accountCodes[location]
+				return code, nil
+			},
+			resolveLocation: func(identifiers []ast.Identifier, location common.Location) ([]sema.ResolvedLocation, error) {
+				addressLocation, ok := location.(common.AddressLocation)
+				if !ok {
+					return []sema.ResolvedLocation{
+						{
+							Location:    location,
+							Identifiers: identifiers,
+						},
+					}, nil
+				}
+
+				// Resolve each identifier as an address location
+
+				result := make([]sema.ResolvedLocation, 0, len(identifiers))
+				for _, identifier := range identifiers {
+					result = append(result, sema.ResolvedLocation{
+						Location: common.AddressLocation{
+							Address: addressLocation.Address,
+							Name:    identifier.Identifier,
+						},
+						Identifiers: []ast.Identifier{
+							identifier,
+						},
+					})
+				}
+				return result, nil
+			},
+			emitEvent: func(event cadence.Event) error {
+				return nil
+			},
+		}
+
+		return runtimeInterface, accountCodes
+	}
+
+	addTx := func(contracts ...[2]string) []byte {
+		var adds string
+		for _, contract := range contracts {
+			adds += fmt.Sprintf(
+				"signer.contracts.add(name: %q, code: \"%s\".decodeHex())\n",
+				contract[0],
+				hex.EncodeToString([]byte(contract[1])),
+			)
+		}
+
+		return []byte(fmt.Sprintf(
+			`
+              transaction {
+                  prepare(signer: AuthAccount) {
+                      %s
+                  }
+              }
+            `,
+			adds,
+		))
+	}
+
+	t.Run("deploy in one transaction", func(t *testing.T) {
+
+		t.Parallel()
+
+		runtimeInterface, accountCodes := newRuntimeInterface()
+
+		runtime := newTestInterpreterRuntime()
+
+		nextTransactionLocation := newTransactionLocationGenerator()
+
+		err := runtime.ExecuteTransaction(
+			Script{
+				Source: addTx(
+					[2]string{"A", contractA},
+					[2]string{"B", contractB},
+					[2]string{"C", contractC},
+				),
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  nextTransactionLocation(),
+			},
+		)
+		require.NoError(t, err)
+
+		require.Len(t, accountCodes, 3)
+
+		result, err := runtime.ExecuteScript(
+			Script{
+				Source: []byte(`
+                  import C from 0x1
+
+                  pub fun main(): Int {
+                      return C.c()
+                  }
+                `),
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  common.ScriptLocation{},
+			},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, cadence.NewInt(3), result)
+	})
+
+	t.Run("qualified type IDs", func(t *testing.T) {
+
+		t.Parallel()
+
+		runtimeInterface, _ := newRuntimeInterface()
+
+		runtime := newTestInterpreterRuntime()
+
+		nextTransactionLocation := newTransactionLocationGenerator()
+
+		for _, contract := range [][2]string{
+			{"A", contractA},
+			{"B", contractB},
+		} {
+			err := runtime.ExecuteTransaction(
+				Script{
+					Source: addTx(contract),
+				},
+				Context{
+					Interface: runtimeInterface,
+					Location:  nextTransactionLocation(),
+				},
+			)
+			require.NoError(t, err)
+		}
+
+		result, err := runtime.ExecuteScript(
+			Script{
+				Source: []byte(`
+                  import A, B from 0x1
+
+                  pub fun main(): A.S {
+                      return B.s()
+                  }
+                `),
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  common.ScriptLocation{},
+			},
+		)
+		require.NoError(t, err)
+
+		require.IsType(t, cadence.Struct{}, result)
+		assert.Equal(t,
+			"A.0000000000000001.A.S",
+			result.(cadence.Struct).StructType.ID(),
+		)
+	})
+
+	t.Run("missing contract", func(t *testing.T) {
+
+		t.Parallel()
+
+		runtimeInterface, accountCodes := newRuntimeInterface()
+
+		runtime := newTestInterpreterRuntime()
+
+		err := runtime.ExecuteTransaction(
+			Script{
+				Source: addTx([2]string{"B", contractB}),
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  newTransactionLocationGenerator()(),
+			},
+		)
+		require.Error(t, err)
+
+		var checkerErr *sema.CheckerError
+		require.ErrorAs(t, err, &checkerErr)
+		errs := checkerErr.Errors
+		require.Len(t, errs, 1)
+
+		require.IsType(t, &sema.NotExportedError{}, errs[0])
+		notExportedErr := errs[0].(*sema.NotExportedError)
+
+		assert.Equal(t, "A", notExportedErr.Name)
+		assert.Equal(t,
+			common.AddressLocation{
+				Address: address,
+				Name:    "A",
+			},
+			notExportedErr.ImportLocation,
+		)
+		assert.Equal(t, 2, notExportedErr.Pos.Line)
+
+		assert.Empty(t, accountCodes)
+	})
+}
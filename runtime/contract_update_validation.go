@@ -138,6 +138,8 @@ func (validator *ContractUpdateValidator) checkDeclarationUpdatability(
 
 	validator.checkFields(oldDeclaration, newDeclaration)
 
+	validator.checkTypeAliases(oldDeclaration, newDeclaration)
+
 	validator.checkNestedDeclarations(oldDeclaration, newDeclaration)
 
 	if newDecl, ok := newDeclaration.(*ast.CompositeDeclaration); ok {
@@ -185,6 +187,38 @@ func (validator *ContractUpdateValidator) checkField(oldField *ast.FieldDeclarat
 	}
 }
 
+// checkTypeAliases validates updating type aliases.
+// Type aliases are transparent, so fields may have types which refer to type aliases.
+// Changing the aliased type of an existing type alias would change the type of such fields,
+// so the aliased type of an existing type alias must not change.
+// Adding and removing type aliases is allowed.
+func (validator *ContractUpdateValidator) checkTypeAliases(
+	oldDeclaration ast.Declaration,
+	newDeclaration ast.Declaration,
+) {
+	oldTypeAliases := map[string]*ast.TypeAliasDeclaration{}
+	for _, oldTypeAlias := range oldDeclaration.DeclarationMembers().TypeAliases() {
+		oldTypeAliases[oldTypeAlias.Identifier.Identifier] = oldTypeAlias
+	}
+
+	for _, newTypeAlias := range newDeclaration.DeclarationMembers().TypeAliases() {
+		oldTypeAlias := oldTypeAliases[newTypeAlias.Identifier.Identifier]
+		if oldTypeAlias == nil {
+			continue
+		}
+
+		err := oldTypeAlias.Type.CheckEqual(newTypeAlias.Type, validator)
+		if err != nil {
+			validator.report(&TypeAliasMismatchError{
+				DeclName:      newDeclaration.DeclarationIdentifier().Identifier,
+				TypeAliasName: newTypeAlias.Identifier.Identifier,
+				Err:           err,
+				Range:         ast.NewUnmeteredRangeFromPositioned(newTypeAlias.Type),
+			})
+		}
+	}
+}
+
 func (validator *ContractUpdateValidator) checkNestedDeclarations(
 	oldDeclaration ast.Declaration,
 	newDeclaration ast.Declaration,
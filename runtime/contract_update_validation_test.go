@@ -1831,6 +1831,66 @@ func TestRuntimeContractUpdateValidation(t *testing.T) {
 			assertMissingDeclarationError(t, childErrors[1], "B")
 		}
 	})
+
+	t.Run("change type alias", func(t *testing.T) {
+
+		t.Parallel()
+
+		const oldCode = `
+            pub contract Test {
+                pub typealias A = String
+
+                pub var a: A
+
+                init() {
+                    self.a = "hello"
+                }
+            }
+        `
+
+		const newCode = `
+            pub contract Test {
+                pub typealias A = Int
+
+                pub var a: A
+
+                init() {
+                    self.a = 0
+                }
+            }
+        `
+
+		err := testDeployAndUpdate(t, contractValidationEnabled, "Test", oldCode, newCode)
+		require.Error(t, err)
+
+		cause := getSingleContractUpdateErrorCause(t, err, "Test")
+
+		var typeAliasMismatchError *TypeAliasMismatchError
+		require.ErrorAs(t, cause, &typeAliasMismatchError)
+
+		assert.Equal(t, "A", typeAliasMismatchError.TypeAliasName)
+		assert.Equal(t, "Test", typeAliasMismatchError.DeclName)
+	})
+
+	t.Run("add and remove type aliases", func(t *testing.T) {
+
+		t.Parallel()
+
+		const oldCode = `
+            pub contract Test {
+                pub typealias A = String
+            }
+        `
+
+		const newCode = `
+            pub contract Test {
+                pub typealias B = Int
+            }
+        `
+
+		err := testDeployAndUpdate(t, contractValidationEnabled, "Test", oldCode, newCode)
+		require.NoError(t, err)
+	})
 }
 
 func assertContractRemovalError(t *testing.T, err error, name string) {
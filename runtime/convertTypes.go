@@ -58,6 +58,8 @@ func ExportType(
 			return exportInterfaceType(gauge, t, results)
 		case *sema.DictionaryType:
 			return exportDictionaryType(gauge, t, results)
+		case *sema.TupleType:
+			return exportTupleType(gauge, t, results)
 		case *sema.FunctionType:
 			return exportFunctionType(gauge, t, results)
 		case *sema.AddressType:
@@ -123,6 +125,10 @@ func ExportType(
 			return cadence.NewMeteredFix64Type(gauge)
 		case sema.UFix64Type:
 			return cadence.NewMeteredUFix64Type(gauge)
+		case sema.Fix128Type:
+			return cadence.NewMeteredFix128Type(gauge)
+		case sema.UFix128Type:
+			return cadence.NewMeteredUFix128Type(gauge)
 		case sema.PathType:
 			return cadence.NewMeteredPathType(gauge)
 		case sema.StoragePathType:
@@ -165,6 +171,14 @@ func ExportType(
 			return cadence.NewMeteredPublicAccountKeysType(gauge)
 		case sema.AuthAccountKeysType:
 			return cadence.NewMeteredAuthAccountKeysType(gauge)
+		case sema.AuthAccountCapabilitiesType:
+			return cadence.NewMeteredAuthAccountCapabilitiesType(gauge)
+		case sema.AuthAccountInboxType:
+			return cadence.NewMeteredAuthAccountInboxType(gauge)
+		case sema.AuthAccountStorageCapabilitiesType:
+			return cadence.NewMeteredAuthAccountStorageCapabilitiesType(gauge)
+		case sema.StorageCapabilityControllerType:
+			return cadence.NewMeteredStorageCapabilityControllerType(gauge)
 		case sema.PublicAccountType:
 			return cadence.NewMeteredPublicAccountType(gauge)
 		case sema.AuthAccountType:
@@ -275,6 +289,10 @@ func ExportMeteredType(
 			return cadence.NewMeteredFix64Type(gauge)
 		case sema.UFix64Type:
 			return cadence.NewMeteredUFix64Type(gauge)
+		case sema.Fix128Type:
+			return cadence.NewMeteredFix128Type(gauge)
+		case sema.UFix128Type:
+			return cadence.NewMeteredUFix128Type(gauge)
 		case sema.PathType:
 			return cadence.NewMeteredPathType(gauge)
 		case sema.StoragePathType:
@@ -317,6 +335,14 @@ func ExportMeteredType(
 			return cadence.NewMeteredPublicAccountKeysType(gauge)
 		case sema.AuthAccountKeysType:
 			return cadence.NewMeteredAuthAccountKeysType(gauge)
+		case sema.AuthAccountCapabilitiesType:
+			return cadence.NewMeteredAuthAccountCapabilitiesType(gauge)
+		case sema.AuthAccountInboxType:
+			return cadence.NewMeteredAuthAccountInboxType(gauge)
+		case sema.AuthAccountStorageCapabilitiesType:
+			return cadence.NewMeteredAuthAccountStorageCapabilitiesType(gauge)
+		case sema.StorageCapabilityControllerType:
+			return cadence.NewMeteredStorageCapabilityControllerType(gauge)
 		case sema.PublicAccountType:
 			return cadence.NewMeteredPublicAccountType(gauge)
 		case sema.AuthAccountType:
@@ -382,6 +408,22 @@ func exportCompositeType(
 
 	fields := make([]cadence.Field, len(fieldMembers))
 
+	// The initializer parameters expose the argument labels of the constructor.
+	// Like the fields, they are only filled in after the result was set,
+	// as the parameter types may refer to the composite type itself
+
+	var initializers [][]cadence.Parameter
+	var initializerParameters []cadence.Parameter
+
+	if t.ConstructorParameters != nil {
+		common.UseMemory(gauge, common.MemoryUsage{
+			Kind:   common.MemoryKindCadenceParameter,
+			Amount: uint64(len(t.ConstructorParameters)),
+		})
+		initializerParameters = make([]cadence.Parameter, len(t.ConstructorParameters))
+		initializers = [][]cadence.Parameter{initializerParameters}
+	}
+
 	switch t.Kind {
 	case common.CompositeKindStructure:
 		result = cadence.NewMeteredStructType(
@@ -389,7 +431,7 @@ func exportCompositeType(
 			t.Location,
 			t.QualifiedIdentifier(),
 			fields,
-			nil,
+			initializers,
 		)
 
 	case common.CompositeKindResource:
@@ -398,7 +440,7 @@ func exportCompositeType(
 			t.Location,
 			t.QualifiedIdentifier(),
 			fields,
-			nil,
+			initializers,
 		)
 
 	case common.CompositeKindEvent:
@@ -407,7 +449,7 @@ func exportCompositeType(
 			t.Location,
 			t.QualifiedIdentifier(),
 			fields,
-			nil,
+			initializerParameters,
 		)
 
 	case common.CompositeKindContract:
@@ -416,7 +458,7 @@ func exportCompositeType(
 			t.Location,
 			t.QualifiedIdentifier(),
 			fields,
-			nil,
+			initializers,
 		)
 
 	case common.CompositeKindEnum:
@@ -426,7 +468,7 @@ func exportCompositeType(
 			t.QualifiedIdentifier(),
 			ExportMeteredType(gauge, t.EnumRawType, results),
 			fields,
-			nil,
+			initializers,
 		)
 
 	default:
@@ -443,9 +485,21 @@ func exportCompositeType(
 		fields[i] = cadence.Field{
 			Identifier: member.Identifier.Identifier,
 			Type:       convertedFieldType,
+			Indexed:    member.Indexed,
 		}
 	}
 
+	for i, parameter := range t.ConstructorParameters {
+		convertedParameterType := ExportMeteredType(gauge, parameter.TypeAnnotation.Type, results)
+
+		// Metered above
+		initializerParameters[i] = cadence.NewParameter(
+			parameter.Label,
+			parameter.Identifier,
+			convertedParameterType,
+		)
+	}
+
 	return
 }
 
@@ -536,6 +590,23 @@ func exportDictionaryType(
 	)
 }
 
+func exportTupleType(
+	gauge common.MemoryGauge,
+	t *sema.TupleType,
+	results map[sema.TypeID]cadence.Type,
+) cadence.Type {
+	convertedElementTypes := make([]cadence.Type, len(t.ElementTypes))
+
+	for i, elementType := range t.ElementTypes {
+		convertedElementTypes[i] = ExportMeteredType(gauge, elementType, results)
+	}
+
+	return cadence.NewMeteredTupleType(
+		gauge,
+		convertedElementTypes,
+	)
+}
+
 func exportFunctionType(
 	gauge common.MemoryGauge,
 	t *sema.FunctionType,
@@ -714,6 +785,10 @@ func ImportType(memoryGauge common.MemoryGauge, t cadence.Type) interpreter.Stat
 		return interpreter.NewPrimitiveStaticType(memoryGauge, interpreter.PrimitiveStaticTypeFix64)
 	case cadence.UFix64Type:
 		return interpreter.NewPrimitiveStaticType(memoryGauge, interpreter.PrimitiveStaticTypeUFix64)
+	case cadence.Fix128Type:
+		return interpreter.NewPrimitiveStaticType(memoryGauge, interpreter.PrimitiveStaticTypeFix128)
+	case cadence.UFix128Type:
+		return interpreter.NewPrimitiveStaticType(memoryGauge, interpreter.PrimitiveStaticTypeUFix128)
 	case cadence.VariableSizedArrayType:
 		return interpreter.NewVariableSizedStaticType(memoryGauge, ImportType(memoryGauge, t.ElementType))
 	case cadence.ConstantSizedArrayType:
@@ -728,6 +803,12 @@ func ImportType(memoryGauge common.MemoryGauge, t cadence.Type) interpreter.Stat
 			ImportType(memoryGauge, t.KeyType),
 			ImportType(memoryGauge, t.ElementType),
 		)
+	case *cadence.TupleType:
+		elementTypes := make([]interpreter.StaticType, len(t.ElementTypes))
+		for i, elementType := range t.ElementTypes {
+			elementTypes[i] = ImportType(memoryGauge, elementType)
+		}
+		return interpreter.NewTupleStaticType(memoryGauge, elementTypes)
 	case *cadence.StructType,
 		*cadence.ResourceType,
 		*cadence.EventType,
@@ -787,6 +868,14 @@ func ImportType(memoryGauge common.MemoryGauge, t cadence.Type) interpreter.Stat
 		return interpreter.NewPrimitiveStaticType(memoryGauge, interpreter.PrimitiveStaticTypePublicAccount)
 	case cadence.DeployedContractType:
 		return interpreter.NewPrimitiveStaticType(memoryGauge, interpreter.PrimitiveStaticTypeDeployedContract)
+	case cadence.AuthAccountCapabilitiesType:
+		return interpreter.NewPrimitiveStaticType(memoryGauge, interpreter.PrimitiveStaticTypeAuthAccountCapabilities)
+	case cadence.AuthAccountInboxType:
+		return interpreter.NewPrimitiveStaticType(memoryGauge, interpreter.PrimitiveStaticTypeAuthAccountInbox)
+	case cadence.AuthAccountStorageCapabilitiesType:
+		return interpreter.NewPrimitiveStaticType(memoryGauge, interpreter.PrimitiveStaticTypeAuthAccountStorageCapabilities)
+	case cadence.StorageCapabilityControllerType:
+		return interpreter.NewPrimitiveStaticType(memoryGauge, interpreter.PrimitiveStaticTypeStorageCapabilityController)
 	default:
 		panic(fmt.Sprintf("cannot export type of type %T", t))
 	}
@@ -59,33 +59,83 @@ func ExportValue(
 	)
 }
 
+// exportValueWithinLimits converts a runtime value to its native Go representation,
+// like exportValue, but fails if the exported value exceeds the given limits.
+func exportValueWithinLimits(
+	value exportableValue,
+	getLocationRange func() interpreter.LocationRange,
+	limits ExportLimits,
+) (
+	cadence.Value,
+	error,
+) {
+	return exportValueWithLimits(
+		value.Value,
+		value.Interpreter(),
+		getLocationRange,
+		seenReferences{},
+		newExportLimitTracker(limits),
+	)
+}
+
 // NOTE: Do not generalize to map[interpreter.Value],
 // as not all values are Go hashable, i.e. this might lead to run-time panics
 type seenReferences map[*interpreter.EphemeralReferenceValue]struct{}
 
-// exportValueWithInterpreter exports the given internal (interpreter) value to an external value.
+// exportValueWithInterpreter exports the given internal (interpreter) value to an external value,
+// without enforcing any export limits.
+//
+func exportValueWithInterpreter(
+	value interpreter.Value,
+	inter *interpreter.Interpreter,
+	getLocationRange func() interpreter.LocationRange,
+	seenReferences seenReferences,
+) (
+	cadence.Value,
+	error,
+) {
+	return exportValueWithLimits(
+		value,
+		inter,
+		getLocationRange,
+		seenReferences,
+		nil,
+	)
+}
+
+// exportValueWithLimits exports the given internal (interpreter) value to an external value.
 //
 // The export is recursive, the results parameter prevents cycles:
 // it is checked at the start of the recursively called function,
 // and pre-set before a recursive call.
 //
-func exportValueWithInterpreter(
+// If limits are given, the export fails with an ExportTooLargeError
+// as soon as the exported value exceeds them.
+//
+func exportValueWithLimits(
 	value interpreter.Value,
 	inter *interpreter.Interpreter,
 	getLocationRange func() interpreter.LocationRange,
 	seenReferences seenReferences,
+	limits *exportLimitTracker,
 ) (
 	cadence.Value,
 	error,
 ) {
 
+	err := limits.enterValue()
+	if err != nil {
+		return nil, err
+	}
+	defer limits.leaveValue()
+
 	switch v := value.(type) {
 	case interpreter.VoidValue:
 		return cadence.NewMeteredVoid(inter), nil
 	case interpreter.NilValue:
 		return cadence.NewMeteredOptional(inter, nil), nil
 	case *interpreter.SomeValue:
-		return exportSomeValue(v, inter, getLocationRange, seenReferences)
+		return exportSomeValue(v, inter, getLocationRange, seenReferences, limits)
 	case interpreter.BoolValue:
 		return cadence.NewMeteredBool(inter, bool(v)), nil
 	case *interpreter.StringValue:
@@ -110,6 +160,7 @@ func exportValueWithInterpreter(
 			inter,
 			getLocationRange,
 			seenReferences,
+			limits,
 		)
 	case interpreter.IntValue:
 		bigInt := v.ToBigInt(inter)
@@ -189,12 +240,27 @@ func exportValueWithInterpreter(
 		return cadence.Fix64(v), nil
 	case interpreter.UFix64Value:
 		return cadence.UFix64(v), nil
+	case interpreter.Fix128Value:
+		return cadence.NewMeteredFix128FromBig(
+			inter,
+			func() *big.Int {
+				return new(big.Int).Set(v.BigInt)
+			},
+		)
+	case interpreter.UFix128Value:
+		return cadence.NewMeteredUFix128FromBig(
+			inter,
+			func() *big.Int {
+				return new(big.Int).Set(v.BigInt)
+			},
+		)
 	case *interpreter.CompositeValue:
 		return exportCompositeValue(
 			v,
 			inter,
 			getLocationRange,
 			seenReferences,
+			limits,
 		)
 	case *interpreter.SimpleCompositeValue:
 		return exportSimpleCompositeValue(
@@ -202,6 +268,7 @@ func exportValueWithInterpreter(
 			inter,
 			getLocationRange,
 			seenReferences,
+			limits,
 		)
 	case *interpreter.DictionaryValue:
 		return exportDictionaryValue(
@@ -209,6 +276,15 @@ func exportValueWithInterpreter(
 			inter,
 			getLocationRange,
 			seenReferences,
+			limits,
+		)
+	case *interpreter.TupleValue:
+		return exportTupleValue(
+			v,
+			inter,
+			getLocationRange,
+			seenReferences,
+			limits,
 		)
 	case interpreter.AddressValue:
 		return cadence.NewMeteredAddress(inter, v), nil
@@ -227,22 +303,24 @@ func exportValueWithInterpreter(
 		}
 		defer delete(seenReferences, v)
 		seenReferences[v] = struct{}{}
-		return exportValueWithInterpreter(
+		return exportValueWithLimits(
 			v.Value,
 			inter,
 			getLocationRange,
 			seenReferences,
+			limits,
 		)
 	case *interpreter.StorageReferenceValue:
 		referencedValue := v.ReferencedValue(inter)
 		if referencedValue == nil {
 			return nil, nil
 		}
-		return exportValueWithInterpreter(
+		return exportValueWithLimits(
 			*referencedValue,
 			inter,
 			getLocationRange,
 			seenReferences,
+			limits,
 		)
 	default:
 		return nil, errors.NewUnexpectedError("cannot export value of type %T", value)
@@ -254,6 +332,7 @@ func exportSomeValue(
 	inter *interpreter.Interpreter,
 	getLocationRange func() interpreter.LocationRange,
 	seenReferences seenReferences,
+	limits *exportLimitTracker,
 ) (
 	cadence.Optional,
 	error,
@@ -264,11 +343,12 @@ func exportSomeValue(
 		return cadence.NewMeteredOptional(inter, nil), nil
 	}
 
-	value, err := exportValueWithInterpreter(
+	value, err := exportValueWithLimits(
 		innerValue,
 		inter,
 		getLocationRange,
 		seenReferences,
+		limits,
 	)
 	if err != nil {
 		return cadence.Optional{}, err
@@ -282,10 +362,18 @@ func exportArrayValue(
 	inter *interpreter.Interpreter,
 	getLocationRange func() interpreter.LocationRange,
 	seenReferences seenReferences,
+	limits *exportLimitTracker,
 ) (
 	cadence.Array,
 	error,
 ) {
+	// Fail early if the elements would exceed the size limit,
+	// before any of them are exported
+	err := limits.checkNestedValueCount(v.Count())
+	if err != nil {
+		return cadence.Array{}, err
+	}
+
 	array, err := cadence.NewMeteredArray(
 		inter,
 		v.Count(),
@@ -295,11 +383,12 @@ func exportArrayValue(
 			var err error
 			v.Iterate(inter, func(value interpreter.Value) (resume bool) {
 				var exportedValue cadence.Value
-				exportedValue, err = exportValueWithInterpreter(
+				exportedValue, err = exportValueWithLimits(
 					value,
 					inter,
 					getLocationRange,
 					seenReferences,
+					limits,
 				)
 				if err != nil {
 					return false
@@ -326,11 +415,58 @@ func exportArrayValue(
 	return array.WithType(exportType), err
 }
 
+func exportTupleValue(
+	v *interpreter.TupleValue,
+	inter *interpreter.Interpreter,
+	getLocationRange func() interpreter.LocationRange,
+	seenReferences seenReferences,
+	limits *exportLimitTracker,
+) (
+	cadence.Tuple,
+	error,
+) {
+	tuple, err := cadence.NewMeteredTuple(
+		inter,
+		func() ([]cadence.Value, error) {
+			values := make([]cadence.Value, len(v.Elements))
+
+			for i, element := range v.Elements {
+				exportedValue, err := exportValueWithLimits(
+					element,
+					inter,
+					getLocationRange,
+					seenReferences,
+					limits,
+				)
+				if err != nil {
+					return nil, err
+				}
+				values[i] = exportedValue
+			}
+
+			return values, nil
+		},
+	)
+	if err != nil {
+		return cadence.Tuple{}, err
+	}
+
+	semaType, err := inter.ConvertStaticToSemaType(v.StaticType(inter))
+	if err != nil {
+		return cadence.Tuple{}, err
+	}
+
+	exportType := ExportType(semaType, map[sema.TypeID]cadence.Type{}).(*cadence.TupleType)
+
+	return tuple.WithType(exportType), nil
+}
+
 func exportCompositeValue(
 	v *interpreter.CompositeValue,
 	inter *interpreter.Interpreter,
 	getLocationRange func() interpreter.LocationRange,
 	seenReferences seenReferences,
+	limits *exportLimitTracker,
 ) (
 	cadence.Value,
 	error,
@@ -346,11 +482,20 @@ func exportCompositeValue(
 		panic(errors.NewUnreachableError())
 	}
 
+	// Attachments are only accessible through the value they are attached to
+
+	if compositeType.Kind == common.CompositeKindAttachment {
+		return nil, errors.NewDefaultUserError(
+			"cannot export attachment `%s`",
+			compositeType.QualifiedString(),
+		)
+	}
+
 	// TODO: consider making the results map "global", by moving it up to exportValueWithInterpreter
 	t := exportCompositeType(inter, compositeType, map[sema.TypeID]cadence.Type{})
 
 	// NOTE: use the exported type's fields to ensure fields in type
-	// and value are in sync
+	// and value are in sync, and in declaration order (see cadence.Composite)
 
 	fieldNames := t.CompositeFields()
 
@@ -367,11 +512,12 @@ func exportCompositeValue(
 				}
 			}
 
-			exportedFieldValue, err := exportValueWithInterpreter(
+			exportedFieldValue, err := exportValueWithLimits(
 				fieldValue,
 				inter,
 				getLocationRange,
 				seenReferences,
+				limits,
 			)
 			if err != nil {
 				return nil, err
@@ -469,6 +615,7 @@ func exportSimpleCompositeValue(
 	inter *interpreter.Interpreter,
 	getLocationRange func() interpreter.LocationRange,
 	seenReferences seenReferences,
+	limits *exportLimitTracker,
 ) (
 	cadence.Value,
 	error,
@@ -507,11 +654,12 @@ func exportSimpleCompositeValue(
 				}
 			}
 
-			exportedFieldValue, err := exportValueWithInterpreter(
+			exportedFieldValue, err := exportValueWithLimits(
 				fieldValue,
 				inter,
 				getLocationRange,
 				seenReferences,
+				limits,
 			)
 			if err != nil {
 				return nil, err
@@ -609,10 +757,18 @@ func exportDictionaryValue(
 	inter *interpreter.Interpreter,
 	getLocationRange func() interpreter.LocationRange,
 	seenReferences seenReferences,
+	limits *exportLimitTracker,
 ) (
 	cadence.Dictionary,
 	error,
 ) {
+	// Fail early if the keys and values would exceed the size limit,
+	// before any of them are exported
+	err := limits.checkNestedValueCount(v.Count() * 2)
+	if err != nil {
+		return cadence.Dictionary{}, err
+	}
+
 	dictionary, err := cadence.NewMeteredDictionary(
 		inter,
 		v.Count(),
@@ -623,22 +779,24 @@ func exportDictionaryValue(
 			v.Iterate(inter, func(key, value interpreter.Value) (resume bool) {
 
 				var convertedKey cadence.Value
-				convertedKey, err = exportValueWithInterpreter(
+				convertedKey, err = exportValueWithLimits(
 					key,
 					inter,
 					getLocationRange,
 					seenReferences,
+					limits,
 				)
 				if err != nil {
 					return false
 				}
 
 				var convertedValue cadence.Value
-				convertedValue, err = exportValueWithInterpreter(
+				convertedValue, err = exportValueWithLimits(
 					value,
 					inter,
 					getLocationRange,
 					seenReferences,
+					limits,
 				)
 				if err != nil {
 					return false
@@ -709,6 +867,15 @@ func exportCapabilityValue(v *interpreter.CapabilityValue, inter *interpreter.In
 		borrowType = inter.MustConvertStaticToSemaType(v.BorrowType)
 	}
 
+	if v.IsIDCapability() {
+		return cadence.NewMeteredIDCapability(
+			inter,
+			cadence.NewMeteredUInt64(inter, uint64(v.ID)),
+			cadence.NewMeteredAddress(inter, v.Address),
+			ExportMeteredType(inter, borrowType, map[sema.TypeID]cadence.Type{}),
+		)
+	}
+
 	return cadence.NewMeteredCapability(
 		inter,
 		exportPathValue(inter, v.Path),
@@ -723,6 +890,7 @@ func exportEvent(
 	event exportableEvent,
 	getLocationRange func() interpreter.LocationRange,
 	seenReferences seenReferences,
+	limits *exportLimitTracker,
 ) (
 	cadence.Event,
 	error,
@@ -734,11 +902,12 @@ func exportEvent(
 			fields := make([]cadence.Value, len(event.Fields))
 
 			for i, field := range event.Fields {
-				value, err := exportValueWithInterpreter(
+				value, err := exportValueWithLimits(
 					field.Value,
 					field.Interpreter(),
 					getLocationRange,
 					seenReferences,
+					limits,
 				)
 				if err != nil {
 					return nil, err
@@ -785,7 +954,7 @@ func importValue(
 	case cadence.Bytes:
 		return interpreter.ByteSliceToByteArrayValue(inter, v), nil
 	case cadence.Address:
-		return importAddress(inter, v), nil
+		return importAddress(inter, v)
 	case cadence.Int:
 		return importInt(inter, v), nil
 	case cadence.Int8:
@@ -826,8 +995,12 @@ func importValue(
 		return importFix64(inter, v), nil
 	case cadence.UFix64:
 		return importUFix64(inter, v), nil
+	case cadence.Fix128:
+		return importFix128(inter, v), nil
+	case cadence.UFix128:
+		return importUFix128(inter, v), nil
 	case cadence.Path:
-		return importPathValue(inter, v), nil
+		return importPathValue(inter, v)
 	case cadence.Array:
 		return importArrayValue(
 			inter,
@@ -888,6 +1061,12 @@ func importValue(
 			v.StaticType,
 		)
 	case cadence.Capability:
+		// Capabilities issued through capability controllers cannot be forged
+		if v.ID != 0 {
+			return nil, errors.NewDefaultUserError(
+				"cannot import capability: ID capabilities are not importable",
+			)
+		}
 		return importCapability(
 			inter,
 			v.Path,
@@ -1088,6 +1267,24 @@ func importUFix64(inter *interpreter.Interpreter, v cadence.UFix64) interpreter.
 	)
 }
 
+func importFix128(inter *interpreter.Interpreter, v cadence.Fix128) interpreter.Fix128Value {
+	return interpreter.NewFix128ValueFromBigInt(
+		inter,
+		func() *big.Int {
+			return v.Value
+		},
+	)
+}
+
+func importUFix128(inter *interpreter.Interpreter, v cadence.UFix128) interpreter.UFix128Value {
+	return interpreter.NewUFix128ValueFromBigInt(
+		inter,
+		func() *big.Int {
+			return v.Value
+		},
+	)
+}
+
 func importString(inter *interpreter.Interpreter, v cadence.String) *interpreter.StringValue {
 	memoryUsage := common.NewStringMemoryUsage(len(v))
 	return interpreter.NewStringValue(
@@ -1111,22 +1308,49 @@ func importCharacter(inter *interpreter.Interpreter, v cadence.Character) interp
 	)
 }
 
-func importAddress(inter *interpreter.Interpreter, v cadence.Address) interpreter.AddressValue {
-	return interpreter.NewAddressValue(
-		inter,
-		common.Address(v),
-	)
+func importAddress(inter *interpreter.Interpreter, v cadence.Address) (interpreter.AddressValue, error) {
+	address := common.Address(v)
+
+	err := inter.ValidateAddress(address)
+	if err != nil {
+		return interpreter.AddressValue{}, interpreter.InvalidAddressError{
+			Address: address,
+			Err:     err,
+		}
+	}
+
+	return interpreter.NewAddressValue(inter, address), nil
 }
 
-func importPathValue(inter *interpreter.Interpreter, v cadence.Path) interpreter.PathValue {
+func importPathValue(
+	inter *interpreter.Interpreter,
+	v cadence.Path,
+) (
+	interpreter.PathValue,
+	error,
+) {
+	domain := common.PathDomainFromIdentifier(v.Domain)
+	if domain == common.PathDomainUnknown {
+		return interpreter.EmptyPathValue, errors.NewDefaultUserError(
+			"cannot import path: invalid domain '%s'",
+			v.Domain,
+		)
+	}
+
+	if v.Identifier == "" {
+		return interpreter.EmptyPathValue, errors.NewDefaultUserError(
+			"cannot import path: missing identifier",
+		)
+	}
+
 	// meter the Path's Identifier since path is just a container
 	common.UseMemory(inter, common.NewRawStringMemoryUsage(len(v.Identifier)))
 
 	return interpreter.NewPathValue(
 		inter,
-		common.PathDomainFromIdentifier(v.Domain),
+		domain,
 		v.Identifier,
-	)
+	), nil
 }
 
 func importTypeValue(
@@ -1160,6 +1384,12 @@ func importCapability(
 	error,
 ) {
 
+	if borrowType == nil {
+		return nil, errors.NewDefaultUserError(
+			"cannot import capability: missing borrow type",
+		)
+	}
+
 	_, ok := borrowType.(cadence.ReferenceType)
 	if !ok {
 		return nil, errors.NewDefaultUserError(
@@ -1168,13 +1398,18 @@ func importCapability(
 		)
 	}
 
+	pathValue, err := importPathValue(inter, path)
+	if err != nil {
+		return nil, err
+	}
+
 	return interpreter.NewCapabilityValue(
 		inter,
 		interpreter.NewAddressValue(
 			inter,
 			common.Address(address),
 		),
-		importPathValue(inter, path),
+		pathValue,
 		ImportType(inter, borrowType),
 	), nil
 
@@ -21,6 +21,7 @@ package runtime
 import (
 	_ "embed"
 	"fmt"
+	"math/big"
 	"testing"
 	"unicode/utf8"
 
@@ -360,6 +361,16 @@ func TestExportValue(t *testing.T) {
 			value:    interpreter.NewUnmeteredUFix64Value(123000000),
 			expected: cadence.UFix64(123000000),
 		},
+		{
+			label:    "Fix128",
+			value:    interpreter.NewUnmeteredFix128ValueFromBigInt(big.NewInt(-123000000)),
+			expected: cadence.Fix128{Value: big.NewInt(-123000000)},
+		},
+		{
+			label:    "UFix128",
+			value:    interpreter.NewUnmeteredUFix128ValueFromBigInt(big.NewInt(123000000)),
+			expected: cadence.UFix128{Value: big.NewInt(123000000)},
+		},
 		{
 			label: "Path",
 			value: interpreter.PathValue{
@@ -762,6 +773,16 @@ func TestImportValue(t *testing.T) {
 			value:    cadence.UFix64(123000000),
 			expected: interpreter.NewUnmeteredUFix64Value(123000000),
 		},
+		{
+			label:    "Fix128",
+			value:    cadence.Fix128{Value: big.NewInt(-123000000)},
+			expected: interpreter.NewUnmeteredFix128ValueFromBigInt(big.NewInt(-123000000)),
+		},
+		{
+			label:    "UFix128",
+			value:    cadence.UFix128{Value: big.NewInt(123000000)},
+			expected: interpreter.NewUnmeteredUFix128ValueFromBigInt(big.NewInt(123000000)),
+		},
 		{
 			label: "Path",
 			value: cadence.Path{
@@ -1020,6 +1041,16 @@ func TestImportRuntimeType(t *testing.T) {
 			actual:   cadence.UFix64Type{},
 			expected: interpreter.PrimitiveStaticTypeUFix64,
 		},
+		{
+			label:    "Fix128",
+			actual:   cadence.Fix128Type{},
+			expected: interpreter.PrimitiveStaticTypeFix128,
+		},
+		{
+			label:    "UFix128",
+			actual:   cadence.UFix128Type{},
+			expected: interpreter.PrimitiveStaticTypeUFix128,
+		},
 		{
 			label:    "Block",
 			actual:   cadence.BlockType{},
@@ -1375,6 +1406,99 @@ func TestExportStructValue(t *testing.T) {
 	assert.Equal(t, expected, actual)
 }
 
+func TestExportStructValueInitializerLabels(t *testing.T) {
+
+	t.Parallel()
+
+	script := `
+        pub struct Foo {
+            pub let bar: Int
+            pub let baz: String
+
+            init(with bar: Int, _ baz: String) {
+                self.bar = bar
+                self.baz = baz
+            }
+        }
+
+        pub fun main(): Foo {
+            return Foo(with: 42, "baz")
+        }
+    `
+
+	actual := exportValueFromScript(t, script)
+
+	require.IsType(t, cadence.Struct{}, actual)
+
+	assert.Equal(t,
+		[][]cadence.Parameter{
+			{
+				{
+					Label:      "with",
+					Identifier: "bar",
+					Type:       cadence.IntType{},
+				},
+				{
+					Label:      "_",
+					Identifier: "baz",
+					Type:       cadence.StringType{},
+				},
+			},
+		},
+		actual.(cadence.Struct).StructType.Initializers,
+	)
+}
+
+func TestExportGenericStructValue(t *testing.T) {
+
+	t.Parallel()
+
+	script := `
+        pub struct Box<T> {
+            pub let value: T
+
+            init(value: T) {
+                self.value = value
+            }
+        }
+
+        pub fun main(): Box<String> {
+            return Box(value: "hello")
+        }
+    `
+
+	actual := exportValueFromScript(t, script)
+
+	require.IsType(t, cadence.Struct{}, actual)
+
+	structType := actual.(cadence.Struct).StructType
+
+	assert.Equal(t, "S.test.Box<String>", structType.ID())
+	assert.Equal(t,
+		[]cadence.Field{
+			{
+				Identifier: "value",
+				Type:       cadence.StringType{},
+			},
+		},
+		structType.Fields,
+	)
+	assert.Equal(t,
+		[]cadence.Value{
+			cadence.String("hello"),
+		},
+		actual.(cadence.Struct).Fields,
+	)
+
+	encoded, err := json.Encode(actual)
+	require.NoError(t, err)
+
+	assert.JSONEq(t,
+		`{"type":"Struct","value":{"id":"S.test.Box<String>","fields":[{"name":"value","value":{"type":"String","value":"hello"}}]}}`,
+		string(encoded),
+	)
+}
+
 func TestExportResourceValue(t *testing.T) {
 
 	t.Parallel()
@@ -1403,6 +1527,103 @@ func TestExportResourceValue(t *testing.T) {
 	assert.Equal(t, expected, actual)
 }
 
+func TestExportTupleValue(t *testing.T) {
+
+	t.Parallel()
+
+	script := `
+        pub fun main(): (Int, String?) {
+            return (1, "two")
+        }
+    `
+
+	actual := exportValueFromScript(t, script)
+	expected := cadence.NewTuple([]cadence.Value{
+		cadence.NewInt(1),
+		cadence.NewOptional(cadence.String("two")),
+	}).WithType(cadence.NewTupleType([]cadence.Type{
+		cadence.IntType{},
+		cadence.OptionalType{Type: cadence.StringType{}},
+	}))
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestExportCompositeValueFieldOrder(t *testing.T) {
+
+	t.Parallel()
+
+	script := `
+        pub resource Foo {
+            pub let c: Int
+            pub let a: String
+            pub let b: Bool
+
+            init() {
+                self.b = true
+                self.a = "a"
+                self.c = 3
+            }
+        }
+
+        pub fun main(): @Foo {
+            return <- create Foo()
+        }
+    `
+
+	actual := exportValueFromScript(t, script)
+
+	require.IsType(t, cadence.Resource{}, actual)
+	composite := actual.(cadence.Composite)
+
+	// Fields are exported in declaration order,
+	// independent of the order in which they are initialized
+
+	fieldNames := make([]string, 0, len(composite.CompositeFields()))
+	for _, field := range composite.CompositeFields() {
+		fieldNames = append(fieldNames, field.Identifier)
+	}
+
+	assert.Equal(t,
+		[]string{"uuid", "c", "a", "b"},
+		fieldNames,
+	)
+	assert.Equal(t,
+		[]cadence.Value{
+			cadence.NewUInt64(0),
+			cadence.NewInt(3),
+			cadence.String("a"),
+			cadence.NewBool(true),
+		},
+		composite.FieldValues(),
+	)
+
+	assert.Equal(t, cadence.String("a"), composite.FieldByName("a"))
+	assert.Equal(t, cadence.NewBool(true), composite.FieldByName("b"))
+	assert.Nil(t, composite.FieldByName("d"))
+
+	// JSON-CDC encodes the fields in declaration order
+
+	encoded, err := json.Encode(actual)
+	require.NoError(t, err)
+
+	assert.JSONEq(t,
+		`{
+          "type": "Resource",
+          "value": {
+            "id": "S.test.Foo",
+            "fields": [
+              {"name": "uuid", "value": {"type": "UInt64", "value": "0"}},
+              {"name": "c", "value": {"type": "Int", "value": "3"}},
+              {"name": "a", "value": {"type": "String", "value": "a"}},
+              {"name": "b", "value": {"type": "Bool", "value": true}}
+            ]
+          }
+        }`,
+		string(encoded),
+	)
+}
+
 func TestExportResourceArrayValue(t *testing.T) {
 
 	t.Parallel()
@@ -1432,20 +1653,7 @@ func TestExportResourceArrayValue(t *testing.T) {
 			cadence.NewInt(2),
 		}).WithType(fooResourceType),
 	}).WithType(cadence.VariableSizedArrayType{
-		ElementType: &cadence.ResourceType{
-			Location:            common.StringLocation("test"),
-			QualifiedIdentifier: "Foo",
-			Fields: []cadence.Field{
-				{
-					Identifier: "uuid",
-					Type:       cadence.UInt64Type{},
-				},
-				{
-					Identifier: "bar",
-					Type:       cadence.IntType{},
-				},
-			},
-		},
+		ElementType: fooResourceType,
 	})
 
 	assert.Equal(t, expected, actual)
@@ -1489,21 +1697,8 @@ func TestExportResourceDictionaryValue(t *testing.T) {
 			}).WithType(fooResourceType),
 		},
 	}).WithType(cadence.DictionaryType{
-		KeyType: cadence.StringType{},
-		ElementType: &cadence.ResourceType{
-			Location:            common.StringLocation("test"),
-			QualifiedIdentifier: "Foo",
-			Fields: []cadence.Field{
-				{
-					Identifier: "uuid",
-					Type:       cadence.UInt64Type{},
-				},
-				{
-					Identifier: "bar",
-					Type:       cadence.IntType{},
-				},
-			},
-		},
+		KeyType:     cadence.StringType{},
+		ElementType: fooResourceType,
 	})
 
 	assert.Equal(t, expected, actual)
@@ -1526,6 +1721,14 @@ func TestExportNestedResourceValueFromScript(t *testing.T) {
 				Type:       cadence.IntType{},
 			},
 		},
+		Initializers: [][]cadence.Parameter{
+			{
+				{
+					Identifier: "x",
+					Type:       cadence.IntType{},
+				},
+			},
+		},
 	}
 
 	fooResourceType := &cadence.ResourceType{
@@ -1541,6 +1744,14 @@ func TestExportNestedResourceValueFromScript(t *testing.T) {
 				Type:       barResourceType,
 			},
 		},
+		Initializers: [][]cadence.Parameter{
+			{
+				{
+					Identifier: "bar",
+					Type:       barResourceType,
+				},
+			},
+		},
 	}
 
 	script := `
@@ -1599,6 +1810,39 @@ func TestExportEventValue(t *testing.T) {
 	assert.Equal(t, expected, actual)
 }
 
+func TestExportIndexedEventValue(t *testing.T) {
+
+	t.Parallel()
+
+	script := `
+        pub event Transfer(indexed from: Address, to: Address)
+
+        pub fun main() {
+            emit Transfer(from: 0x1, to: 0x2)
+        }
+    `
+
+	actual := exportEventFromScript(t, script)
+
+	eventType, ok := actual.Type().(*cadence.EventType)
+	require.True(t, ok)
+
+	assert.Equal(t,
+		[]cadence.Field{
+			{
+				Identifier: "from",
+				Type:       cadence.AddressType{},
+				Indexed:    true,
+			},
+			{
+				Identifier: "to",
+				Type:       cadence.AddressType{},
+			},
+		},
+		eventType.Fields,
+	)
+}
+
 func exportEventFromScript(t *testing.T, script string) cadence.Event {
 	rt := newTestInterpreterRuntime()
 
@@ -1647,6 +1891,182 @@ func exportValueFromScript(t *testing.T, script string) cadence.Value {
 	return value
 }
 
+func TestExportValueLimits(t *testing.T) {
+
+	t.Parallel()
+
+	executeScript := func(limits ExportLimits, script string, events *[]cadence.Event) (cadence.Value, error) {
+		rt := newTestInterpreterRuntime(WithExportLimits(limits))
+
+		return rt.ExecuteScript(
+			Script{
+				Source: []byte(script),
+			},
+			Context{
+				Interface: &testRuntimeInterface{
+					emitEvent: func(event cadence.Event) error {
+						*events = append(*events, event)
+						return nil
+					},
+				},
+				Location: TestLocation,
+			},
+		)
+	}
+
+	t.Run("within limits", func(t *testing.T) {
+
+		t.Parallel()
+
+		var events []cadence.Event
+
+		value, err := executeScript(
+			ExportLimits{
+				MaxSize:  4,
+				MaxDepth: 2,
+			},
+			`
+              pub fun main(): [Int] {
+                  return [1, 2, 3]
+              }
+            `,
+			&events,
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			cadence.NewArray([]cadence.Value{
+				cadence.NewInt(1),
+				cadence.NewInt(2),
+				cadence.NewInt(3),
+			}).WithType(cadence.VariableSizedArrayType{
+				ElementType: cadence.IntType{},
+			}),
+			value,
+		)
+	})
+
+	t.Run("array exceeds size", func(t *testing.T) {
+
+		t.Parallel()
+
+		var events []cadence.Event
+
+		_, err := executeScript(
+			ExportLimits{
+				MaxSize: 3,
+			},
+			`
+              pub fun main(): [Int] {
+                  return [1, 2, 3]
+              }
+            `,
+			&events,
+		)
+		require.Error(t, err)
+
+		var exportErr *ExportTooLargeError
+		require.ErrorAs(t, err, &exportErr)
+
+		assert.Equal(t,
+			&ExportTooLargeError{
+				Size:    4,
+				MaxSize: 3,
+			},
+			exportErr,
+		)
+	})
+
+	t.Run("dictionary exceeds size", func(t *testing.T) {
+
+		t.Parallel()
+
+		var events []cadence.Event
+
+		_, err := executeScript(
+			ExportLimits{
+				MaxSize: 4,
+			},
+			`
+              pub fun main(): {String: Int} {
+                  return {"a": 1, "b": 2}
+              }
+            `,
+			&events,
+		)
+		require.Error(t, err)
+
+		var exportErr *ExportTooLargeError
+		require.ErrorAs(t, err, &exportErr)
+
+		assert.Equal(t,
+			&ExportTooLargeError{
+				Size:    5,
+				MaxSize: 4,
+			},
+			exportErr,
+		)
+	})
+
+	t.Run("nested array exceeds depth", func(t *testing.T) {
+
+		t.Parallel()
+
+		var events []cadence.Event
+
+		_, err := executeScript(
+			ExportLimits{
+				MaxDepth: 2,
+			},
+			`
+              pub fun main(): [[Int]] {
+                  return [[1]]
+              }
+            `,
+			&events,
+		)
+		require.Error(t, err)
+
+		var exportErr *ExportTooLargeError
+		require.ErrorAs(t, err, &exportErr)
+
+		assert.Equal(t,
+			&ExportTooLargeError{
+				Depth:    3,
+				MaxDepth: 2,
+			},
+			exportErr,
+		)
+	})
+
+	t.Run("event exceeds size", func(t *testing.T) {
+
+		t.Parallel()
+
+		var events []cadence.Event
+
+		_, err := executeScript(
+			ExportLimits{
+				MaxSize: 3,
+			},
+			`
+              pub event Foo(bar: [Int])
+
+              pub fun main() {
+                  emit Foo(bar: [1, 2, 3])
+              }
+            `,
+			&events,
+		)
+		require.Error(t, err)
+
+		var exportErr *ExportTooLargeError
+		require.ErrorAs(t, err, &exportErr)
+
+		assert.Empty(t, events)
+	})
+}
+
 func TestExportReferenceValue(t *testing.T) {
 
 	t.Parallel()
@@ -2172,22 +2592,32 @@ var fooResourceFields = []cadence.Field{
 	},
 }
 
+var fooInitializer = []cadence.Parameter{
+	{
+		Identifier: "bar",
+		Type:       cadence.IntType{},
+	},
+}
+
 var fooStructType = &cadence.StructType{
 	Location:            TestLocation,
 	QualifiedIdentifier: "Foo",
 	Fields:              fooFields,
+	Initializers:        [][]cadence.Parameter{fooInitializer},
 }
 
 var fooResourceType = &cadence.ResourceType{
 	Location:            TestLocation,
 	QualifiedIdentifier: "Foo",
 	Fields:              fooResourceFields,
+	Initializers:        [][]cadence.Parameter{fooInitializer},
 }
 
 var fooEventType = &cadence.EventType{
 	Location:            TestLocation,
 	QualifiedIdentifier: "Foo",
 	Fields:              fooFields,
+	Initializer:         fooInitializer,
 }
 
 func TestRuntimeEnumValue(t *testing.T) {
@@ -2285,6 +2715,33 @@ func executeTestScript(t *testing.T, script string, arg cadence.Value) (cadence.
 	)
 }
 
+// executeTestScriptWithArgumentValue executes the given script with the given argument,
+// without encoding and decoding the argument using JSON-CDC
+func executeTestScriptWithArgumentValue(t *testing.T, script string, arg cadence.Value) (cadence.Value, error) {
+	rt := newTestInterpreterRuntime()
+
+	runtimeInterface := &testRuntimeInterface{
+		storage: newTestLedger(nil, nil),
+		meterMemory: func(_ common.MemoryUsage) error {
+			return nil
+		},
+		decodeArgument: func(_ []byte, _ cadence.Type) (cadence.Value, error) {
+			return arg, nil
+		},
+	}
+
+	return rt.ExecuteScript(
+		Script{
+			Source:    []byte(script),
+			Arguments: [][]byte{nil},
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  TestLocation,
+		},
+	)
+}
+
 func TestRuntimeArgumentPassing(t *testing.T) {
 
 	t.Parallel()
@@ -2590,6 +3047,7 @@ func TestRuntimeComplexStructArgumentPassing(t *testing.T) {
 					Type:       cadence.AnyStructType{},
 				},
 			},
+			Initializers: [][]cadence.Parameter{{}},
 		},
 
 		Fields: []cadence.Value{
@@ -2723,6 +3181,7 @@ func TestRuntimeComplexStructWithAnyStructFields(t *testing.T) {
 					Type:       cadence.AnyStructType{},
 				},
 			},
+			Initializers: [][]cadence.Parameter{{}},
 		},
 
 		Fields: []cadence.Value{
@@ -3718,6 +4177,32 @@ func TestTypeValueImport(t *testing.T) {
 		require.True(t, ok)
 	})
 
+	t.Run("[Type]", func(t *testing.T) {
+
+		t.Parallel()
+
+		types := cadence.NewArray([]cadence.Value{
+			cadence.NewTypeValue(cadence.IntType{}),
+			cadence.NewTypeValue(cadence.NewOptionalType(cadence.StringType{})),
+		}).WithType(cadence.NewVariableSizedArrayType(cadence.NewMetaType()))
+
+		script := `
+            pub fun main(types: [Type]): [String] {
+                return [types[0].identifier, types[1].identifier]
+            }
+        `
+
+		result, err := executeTestScript(t, script, types)
+		require.NoError(t, err)
+		assert.Equal(t,
+			cadence.NewArray([]cadence.Value{
+				cadence.String("Int"),
+				cadence.String("String?"),
+			}).WithType(cadence.NewVariableSizedArrayType(cadence.NewStringType())),
+			result,
+		)
+	})
+
 	t.Run("missing struct", func(t *testing.T) {
 
 		t.Parallel()
@@ -3765,6 +4250,200 @@ func TestTypeValueImport(t *testing.T) {
 	})
 }
 
+func TestRuntimeAddressValueImport(t *testing.T) {
+
+	t.Parallel()
+
+	rt := newTestInterpreterRuntime(
+		WithAddressValidator(func(address common.Address) error {
+			if address[0] != 0 {
+				return fmt.Errorf("address not in network")
+			}
+			return nil
+		}),
+	)
+
+	runtimeInterface := &testRuntimeInterface{
+		storage: newTestLedger(nil, nil),
+	}
+	runtimeInterface.decodeArgument = func(b []byte, t cadence.Type) (value cadence.Value, err error) {
+		return json.Decode(runtimeInterface, b)
+	}
+
+	executeScript := func(address cadence.Address) (cadence.Value, error) {
+		encodedArg, err := json.Encode(address)
+		require.NoError(t, err)
+
+		return rt.ExecuteScript(
+			Script{
+				Source: []byte(`
+                    pub fun main(address: Address): String {
+                        return address.toString()
+                    }
+                `),
+				Arguments: [][]byte{encodedArg},
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  TestLocation,
+			},
+		)
+	}
+
+	t.Run("valid", func(t *testing.T) {
+
+		result, err := executeScript(cadence.Address{0, 0, 0, 0, 0, 0, 0, 1})
+		require.NoError(t, err)
+		assert.Equal(t, cadence.String("0x0000000000000001"), result)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+
+		_, err := executeScript(cadence.Address{1, 0, 0, 0, 0, 0, 0, 1})
+		require.Error(t, err)
+		assertUserError(t, err)
+
+		var argErr *InvalidEntryPointArgumentError
+		require.ErrorAs(t, err, &argErr)
+
+		var addressErr interpreter.InvalidAddressError
+		require.ErrorAs(t, err, &addressErr)
+		assert.Equal(t, common.Address{1, 0, 0, 0, 0, 0, 0, 1}, addressErr.Address)
+	})
+}
+
+func TestPathValueImport(t *testing.T) {
+
+	t.Parallel()
+
+	storagePath := cadence.Path{
+		Domain:     common.PathDomainStorage.Identifier(),
+		Identifier: "foo",
+	}
+
+	publicPath := cadence.Path{
+		Domain:     common.PathDomainPublic.Identifier(),
+		Identifier: "bar",
+	}
+
+	t.Run("StoragePath", func(t *testing.T) {
+
+		t.Parallel()
+
+		script := `
+            pub fun main(path: StoragePath): StoragePath {
+                return path
+            }
+        `
+
+		result, err := executeTestScript(t, script, storagePath)
+		require.NoError(t, err)
+		assert.Equal(t, storagePath, result)
+	})
+
+	t.Run("PublicPath", func(t *testing.T) {
+
+		t.Parallel()
+
+		script := `
+            pub fun main(path: PublicPath): PublicPath {
+                return path
+            }
+        `
+
+		result, err := executeTestScript(t, script, publicPath)
+		require.NoError(t, err)
+		assert.Equal(t, publicPath, result)
+	})
+
+	t.Run("Path array", func(t *testing.T) {
+
+		t.Parallel()
+
+		script := `
+            pub fun main(paths: [Path]): [Path] {
+                return paths
+            }
+        `
+
+		paths := cadence.NewArray([]cadence.Value{
+			storagePath,
+			publicPath,
+		}).WithType(cadence.NewVariableSizedArrayType(cadence.NewPathType()))
+
+		result, err := executeTestScript(t, script, paths)
+		require.NoError(t, err)
+		assert.Equal(t, paths, result)
+	})
+
+	t.Run("StoragePath as PublicPath", func(t *testing.T) {
+
+		t.Parallel()
+
+		script := `
+            pub fun main(path: PublicPath) {
+            }
+        `
+
+		_, err := executeTestScript(t, script, storagePath)
+		require.Error(t, err)
+		assertUserError(t, err)
+
+		var argErr *InvalidEntryPointArgumentError
+		require.ErrorAs(t, err, &argErr)
+
+		var typeErr *InvalidValueTypeError
+		require.ErrorAs(t, err, &typeErr)
+	})
+
+	t.Run("invalid domain", func(t *testing.T) {
+
+		t.Parallel()
+
+		script := `
+            pub fun main(path: Path) {
+            }
+        `
+
+		_, err := executeTestScriptWithArgumentValue(
+			t,
+			script,
+			cadence.Path{
+				Domain:     "foo",
+				Identifier: "bar",
+			},
+		)
+		require.Error(t, err)
+		assertUserError(t, err)
+
+		var argErr *InvalidEntryPointArgumentError
+		require.ErrorAs(t, err, &argErr)
+	})
+
+	t.Run("missing identifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		script := `
+            pub fun main(path: Path) {
+            }
+        `
+
+		_, err := executeTestScriptWithArgumentValue(
+			t,
+			script,
+			cadence.Path{
+				Domain: common.PathDomainStorage.Identifier(),
+			},
+		)
+		require.Error(t, err)
+		assertUserError(t, err)
+
+		var argErr *InvalidEntryPointArgumentError
+		require.ErrorAs(t, err, &argErr)
+	})
+}
+
 func TestCapabilityValueImport(t *testing.T) {
 
 	t.Parallel()
@@ -4021,6 +4700,81 @@ func TestCapabilityValueImport(t *testing.T) {
 		require.Error(t, err)
 		assertUserError(t, err)
 	})
+
+	t.Run("borrow type mismatch", func(t *testing.T) {
+
+		t.Parallel()
+
+		capabilityValue := cadence.Capability{
+			BorrowType: cadence.ReferenceType{Type: cadence.StringType{}},
+			Address:    cadence.Address{0x1},
+			Path: cadence.Path{
+				Domain:     common.PathDomainPublic.Identifier(),
+				Identifier: "foo",
+			},
+		}
+
+		script := `
+            pub fun main(s: Capability<&Int>) {
+            }
+        `
+
+		_, err := executeTestScript(t, script, capabilityValue)
+		require.Error(t, err)
+		assertUserError(t, err)
+
+		var typeErr *InvalidValueTypeError
+		require.ErrorAs(t, err, &typeErr)
+	})
+
+	t.Run("optional", func(t *testing.T) {
+
+		t.Parallel()
+
+		capabilityValue := cadence.Capability{
+			BorrowType: cadence.ReferenceType{Type: cadence.IntType{}},
+			Address:    cadence.Address{0x1},
+			Path: cadence.Path{
+				Domain:     common.PathDomainPublic.Identifier(),
+				Identifier: "foo",
+			},
+		}
+
+		script := `
+            pub fun main(s: Capability<&Int>?): Address? {
+                return s?.address
+            }
+        `
+
+		result, err := executeTestScript(t, script, cadence.NewOptional(capabilityValue))
+		require.NoError(t, err)
+		assert.Equal(t, cadence.NewOptional(cadence.Address{0x1}), result)
+	})
+
+	t.Run("missing borrow type", func(t *testing.T) {
+
+		t.Parallel()
+
+		capabilityValue := cadence.Capability{
+			Address: cadence.Address{0x1},
+			Path: cadence.Path{
+				Domain:     common.PathDomainPublic.Identifier(),
+				Identifier: "foo",
+			},
+		}
+
+		script := `
+            pub fun main(s: Capability) {
+            }
+        `
+
+		_, err := executeTestScriptWithArgumentValue(t, script, capabilityValue)
+		require.Error(t, err)
+		assertUserError(t, err)
+
+		var argErr *InvalidEntryPointArgumentError
+		require.ErrorAs(t, err, &argErr)
+	})
 }
 
 func TestRuntimePublicKeyImport(t *testing.T) {
@@ -4572,7 +5326,8 @@ func TestRuntimePublicKeyImport(t *testing.T) {
 		)
 
 		assert.Contains(t, err.Error(),
-			"invalid argument at index 0: cannot import value of type 'PublicKey'. missing field 'publicKey'")
+			"invalid argument for parameter `key` at index 0 of type `PublicKey`: "+
+				"cannot import value of type 'PublicKey'. missing field 'publicKey'")
 		assert.False(t, publicKeyValidated)
 		assert.Nil(t, value)
 	})
@@ -4645,7 +5400,8 @@ func TestRuntimePublicKeyImport(t *testing.T) {
 		)
 
 		assert.Contains(t, err.Error(),
-			"invalid argument at index 0: cannot import value of type 'PublicKey'. missing field 'signatureAlgorithm'")
+			"invalid argument for parameter `key` at index 0 of type `PublicKey`: "+
+				"cannot import value of type 'PublicKey'. missing field 'signatureAlgorithm'")
 		assert.False(t, publicKeyValidated)
 		assert.Nil(t, value)
 	})
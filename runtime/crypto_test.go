@@ -19,6 +19,7 @@
 package runtime
 
 import (
+	"bytes"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -681,6 +682,163 @@ func getCadenceValueArrayFromHexStr(t *testing.T, inp string) cadence.Value {
 // and should not be used as a sample code for Merkle Proof Verification,
 // for proper verification you need extra steps such as checking if the leaf content matches
 // what you're expecting and etc...
+func TestRuntimeAccountProof_verify(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := newTestInterpreterRuntime()
+
+	address := common.MustBytesToAddress([]byte{0x1})
+
+	accountKeys := []*AccountKey{
+		{
+			KeyIndex: 0,
+			PublicKey: &PublicKey{
+				PublicKey: []byte{0},
+				SignAlgo:  SignatureAlgorithmECDSA_P256,
+			},
+			HashAlgo: HashAlgorithmSHA3_256,
+			Weight:   500,
+		},
+		{
+			KeyIndex: 1,
+			PublicKey: &PublicKey{
+				PublicKey: []byte{1},
+				SignAlgo:  SignatureAlgorithmECDSA_secp256k1,
+			},
+			HashAlgo: HashAlgorithmSHA2_256,
+			Weight:   500,
+		},
+		{
+			KeyIndex: 2,
+			PublicKey: &PublicKey{
+				PublicKey: []byte{2},
+				SignAlgo:  SignatureAlgorithmECDSA_P256,
+			},
+			HashAlgo:  HashAlgorithmSHA3_256,
+			Weight:    1000,
+			IsRevoked: true,
+		},
+		{
+			KeyIndex: 3,
+			PublicKey: &PublicKey{
+				PublicKey: []byte{3},
+				SignAlgo:  SignatureAlgorithmBLS_BLS12_381,
+			},
+			HashAlgo: HashAlgorithmKMAC128_BLS_BLS12_381,
+			Weight:   1000,
+		},
+	}
+
+	// A signature is valid if it is the public key
+
+	runtimeInterface := &testRuntimeInterface{
+		storage: newTestLedger(nil, nil),
+		getAccountKey: func(a Address, index int) (*AccountKey, error) {
+			if a != address || index >= len(accountKeys) {
+				return nil, nil
+			}
+			return accountKeys[index], nil
+		},
+		verifySignature: func(
+			signature []byte,
+			tag string,
+			signedData []byte,
+			publicKey []byte,
+			signatureAlgorithm SignatureAlgorithm,
+			hashAlgorithm HashAlgorithm,
+		) (bool, error) {
+			assert.Equal(t, "FLOW-V0.0-user", tag)
+			assert.Equal(t, []byte{5, 6}, signedData)
+			return bytes.Equal(signature, publicKey), nil
+		},
+	}
+
+	verify := func(t *testing.T, signatures string, keyIndices string) bool {
+		script := fmt.Sprintf(
+			`
+              pub fun main(): Bool {
+                  return AccountProof.verify(
+                      0x1,
+                      "0506".decodeHex(),
+                      %s,
+                      %s
+                  )
+              }
+            `,
+			signatures,
+			keyIndices,
+		)
+
+		result, err := runtime.ExecuteScript(
+			Script{
+				Source: []byte(script),
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  utils.TestLocation,
+			},
+		)
+		require.NoError(t, err)
+
+		return bool(result.(cadence.Bool))
+	}
+
+	t.Run("full weight", func(t *testing.T) {
+		t.Parallel()
+
+		assert.True(t, verify(t, `[[0], [1]]`, `[0, 1]`))
+	})
+
+	t.Run("insufficient weight", func(t *testing.T) {
+		t.Parallel()
+
+		assert.False(t, verify(t, `[[0]]`, `[0]`))
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		t.Parallel()
+
+		assert.False(t, verify(t, `[[0], [0]]`, `[0, 1]`))
+	})
+
+	t.Run("duplicate key index", func(t *testing.T) {
+		t.Parallel()
+
+		assert.False(t, verify(t, `[[0], [0]]`, `[0, 0]`))
+	})
+
+	t.Run("revoked key", func(t *testing.T) {
+		t.Parallel()
+
+		assert.False(t, verify(t, `[[2]]`, `[2]`))
+	})
+
+	t.Run("unsupported signature algorithm", func(t *testing.T) {
+		t.Parallel()
+
+		assert.False(t, verify(t, `[[3]]`, `[3]`))
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		t.Parallel()
+
+		assert.False(t, verify(t, `[[4]]`, `[4]`))
+	})
+
+	t.Run("count mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		assert.False(t, verify(t, `[[0], [1]]`, `[0]`))
+	})
+
+	t.Run("no signatures", func(t *testing.T) {
+		t.Parallel()
+
+		assert.False(t, verify(t, `[]`, `[]`))
+	})
+}
+
 func TestTraversingMerkleProof(t *testing.T) {
 
 	t.Parallel()
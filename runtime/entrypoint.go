@@ -0,0 +1,140 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+// EntryPoint is the entry point of a transaction or script,
+// i.e. its parameters, and its pre-conditions and post-conditions,
+// which are the guarantees declared by the program,
+// and can be displayed, e.g. to the signers of a transaction.
+//
+type EntryPoint struct {
+	Parameters     []cadence.Parameter
+	PreConditions  []EntryPointCondition
+	PostConditions []EntryPointCondition
+}
+
+// EntryPointCondition is a pre-condition or post-condition of a transaction or script.
+//
+type EntryPointCondition struct {
+	// Source is the source text of the condition, as declared in the program
+	Source string
+	// Test is the normalized test expression of the condition
+	Test string
+	// Message is the normalized message expression of the condition, if any
+	Message string
+}
+
+// NewEntryPoint returns the entry point of the transaction or script
+// declared in the given checked program, if any.
+//
+// The given code is the source code of the program.
+//
+func NewEntryPoint(
+	memoryGauge common.MemoryGauge,
+	code []byte,
+	program *interpreter.Program,
+) *EntryPoint {
+	entryPoint := sema.ProgramEntryPoint(program.Program, program.Elaboration)
+	if entryPoint == nil {
+		return nil
+	}
+
+	exportedTypes := map[sema.TypeID]cadence.Type{}
+
+	common.UseMemory(memoryGauge, common.MemoryUsage{
+		Kind:   common.MemoryKindCadenceParameter,
+		Amount: uint64(len(entryPoint.Parameters)),
+	})
+	parameters := make([]cadence.Parameter, len(entryPoint.Parameters))
+
+	for i, parameter := range entryPoint.Parameters {
+		parameterType := ExportMeteredType(memoryGauge, parameter.TypeAnnotation.Type, exportedTypes)
+
+		// Metered above
+		parameters[i] = cadence.NewParameter(
+			parameter.Label,
+			parameter.Identifier,
+			parameterType,
+		)
+	}
+
+	return &EntryPoint{
+		Parameters:     parameters,
+		PreConditions:  newEntryPointConditionList(memoryGauge, code, entryPoint.PreConditions),
+		PostConditions: newEntryPointConditionList(memoryGauge, code, entryPoint.PostConditions),
+	}
+}
+
+func newEntryPointConditionList(
+	memoryGauge common.MemoryGauge,
+	code []byte,
+	conditions *ast.Conditions,
+) []EntryPointCondition {
+	if conditions.IsEmpty() {
+		return nil
+	}
+
+	result := make([]EntryPointCondition, 0, len(*conditions))
+
+	for _, condition := range *conditions {
+
+		var message string
+		if condition.Message != nil {
+			message = condition.Message.String()
+		}
+
+		result = append(
+			result,
+			EntryPointCondition{
+				Source:  conditionSource(memoryGauge, code, condition),
+				Test:    condition.Test.String(),
+				Message: message,
+			},
+		)
+	}
+
+	return result
+}
+
+// conditionSource returns the source text of the given condition,
+// i.e. the text from the start of its test to the end of its message, if any.
+//
+func conditionSource(memoryGauge common.MemoryGauge, code []byte, condition *ast.Condition) string {
+	startOffset := condition.Test.StartPosition().Offset
+
+	end := condition.Test.EndPosition(memoryGauge)
+	if condition.Message != nil {
+		end = condition.Message.EndPosition(memoryGauge)
+	}
+	endOffset := end.Offset + 1
+
+	if startOffset < 0 || endOffset > len(code) || startOffset > endOffset {
+		return condition.Test.String()
+	}
+
+	return string(code[startOffset:endOffset])
+}
@@ -19,6 +19,8 @@
 package runtime
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -148,8 +150,12 @@ func (e InvalidTransactionAuthorizerCountError) Error() string {
 // InvalidEntryPointArgumentError
 //
 type InvalidEntryPointArgumentError struct {
-	Index int
-	Err   error
+	Index         int
+	ParameterName string
+	ExpectedType  sema.Type
+	// Argument is the encoded argument, e.g. JSON-CDC
+	Argument []byte
+	Err      error
 }
 
 var _ errors.UserError = &InvalidEntryPointArgumentError{}
@@ -160,12 +166,47 @@ func (e *InvalidEntryPointArgumentError) Unwrap() error {
 	return e.Err
 }
 
+// maxArgumentSnippetLength is the maximum number of bytes of the encoded argument
+// which are included in the error message
+//
+const maxArgumentSnippetLength = 256
+
+// ArgumentSnippet returns the encoded argument, shortened if it is too long.
+// JSON arguments are compacted first
+//
+func (e *InvalidEntryPointArgumentError) ArgumentSnippet() string {
+	var compacted bytes.Buffer
+	var snippet string
+	if json.Compact(&compacted, e.Argument) == nil {
+		snippet = compacted.String()
+	} else {
+		snippet = strings.TrimSpace(string(e.Argument))
+	}
+	if len(snippet) > maxArgumentSnippetLength {
+		snippet = snippet[:maxArgumentSnippetLength] + "..."
+	}
+	return snippet
+}
+
 func (e *InvalidEntryPointArgumentError) Error() string {
-	return fmt.Sprintf(
-		"invalid argument at index %d: %s",
-		e.Index,
-		e.Err.Error(),
-	)
+	var sb strings.Builder
+
+	sb.WriteString("invalid argument")
+	if e.ParameterName != "" {
+		_, _ = fmt.Fprintf(&sb, " for parameter `%s`", e.ParameterName)
+	}
+	_, _ = fmt.Fprintf(&sb, " at index %d", e.Index)
+	if e.ExpectedType != nil {
+		_, _ = fmt.Fprintf(&sb, " of type `%s`", e.ExpectedType.QualifiedString())
+	}
+	_, _ = fmt.Fprintf(&sb, ": %s", e.Err.Error())
+
+	snippet := e.ArgumentSnippet()
+	if snippet != "" {
+		_, _ = fmt.Fprintf(&sb, ". argument: %s", snippet)
+	}
+
+	return sb.String()
 }
 
 // MalformedValueError
@@ -423,6 +464,31 @@ func (e *FieldMismatchError) SecondaryError() string {
 	return e.Err.Error()
 }
 
+// TypeAliasMismatchError is reported during a contract update, when the aliased type
+// of a type alias does not match the existing aliased type of the same type alias.
+type TypeAliasMismatchError struct {
+	DeclName      string
+	TypeAliasName string
+	Err           error
+	ast.Range
+}
+
+var _ errors.UserError = &TypeAliasMismatchError{}
+var _ errors.SecondaryError = &TypeAliasMismatchError{}
+
+func (*TypeAliasMismatchError) IsUserError() {}
+
+func (e *TypeAliasMismatchError) Error() string {
+	return fmt.Sprintf("mismatching type alias `%s` in `%s`",
+		e.TypeAliasName,
+		e.DeclName,
+	)
+}
+
+func (e *TypeAliasMismatchError) SecondaryError() string {
+	return e.Err.Error()
+}
+
 // TypeMismatchError is reported during a contract update, when a type of the new program
 // does not match the existing type.
 type TypeMismatchError struct {
@@ -567,3 +633,58 @@ func (e *MissingDeclarationError) Error() string {
 		e.Name,
 	)
 }
+
+// ContractStorageKeyCollisionError is reported when a contract value is stored,
+// and the hashed storage key of the contract already stores the value of another contract.
+//
+type ContractStorageKeyCollisionError struct {
+	Address        common.Address
+	Name           string
+	ExistingTypeID common.TypeID
+}
+
+var _ errors.UserError = &ContractStorageKeyCollisionError{}
+
+func (*ContractStorageKeyCollisionError) IsUserError() {}
+
+func (e *ContractStorageKeyCollisionError) Error() string {
+	return fmt.Sprintf(
+		"cannot store contract `%s` in account %s: storage key collides with `%s`",
+		e.Name,
+		e.Address.ShortHexWithPrefix(),
+		e.ExistingTypeID,
+	)
+}
+
+// ExportTooLargeError is returned when an exported value,
+// e.g. a script return value or an event payload, exceeds the export limits.
+//
+// Either the size or the depth limit is reported.
+//
+type ExportTooLargeError struct {
+	// Size is the size of the value when the size limit was exceeded
+	Size    int
+	MaxSize int
+	// Depth is the depth of the value when the depth limit was exceeded
+	Depth    int
+	MaxDepth int
+}
+
+var _ errors.UserError = &ExportTooLargeError{}
+
+func (*ExportTooLargeError) IsUserError() {}
+
+func (e *ExportTooLargeError) Error() string {
+	if e.MaxDepth > 0 {
+		return fmt.Sprintf(
+			"exported value too large: depth exceeds limit of %d",
+			e.MaxDepth,
+		)
+	}
+
+	return fmt.Sprintf(
+		"exported value too large: size of at least %d exceeds limit of %d",
+		e.Size,
+		e.MaxSize,
+	)
+}
@@ -82,6 +82,25 @@ type ErrorNote interface {
 	Message() string
 }
 
+// HasSuggestedFixes is an interface for errors that provide suggested fixes,
+// e.g. for quick-fixes in editors.
+//
+// The type parameter is the type of the text edits of the suggested fixes,
+// e.g. ast.TextEdit.
+//
+type HasSuggestedFixes[T any] interface {
+	SuggestedFixes(code string) []SuggestedFix[T]
+}
+
+// SuggestedFix is a fix for an error,
+// consisting of a message describing the fix,
+// and the text edits which apply the fix.
+//
+type SuggestedFix[T any] struct {
+	Message   string
+	TextEdits []T
+}
+
 // ParentError is an error that contains one or more child errors.
 type ParentError interface {
 	error
@@ -0,0 +1,78 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/runtime/common"
+)
+
+// eventCollectingInterface is a runtime interface which collects emitted events,
+// instead of emitting them to the wrapped interface.
+//
+// Memory metering and metrics reporting are delegated to the wrapped interface,
+// if it supports them.
+//
+type eventCollectingInterface struct {
+	Interface
+	events []cadence.Event
+}
+
+var _ Interface = &eventCollectingInterface{}
+var _ common.MemoryGauge = &eventCollectingInterface{}
+var _ Metrics = &eventCollectingInterface{}
+
+func newEventCollectingInterface(runtimeInterface Interface) *eventCollectingInterface {
+	return &eventCollectingInterface{
+		Interface: runtimeInterface,
+	}
+}
+
+func (i *eventCollectingInterface) EmitEvent(event cadence.Event) error {
+	i.events = append(i.events, event)
+	return nil
+}
+
+func (i *eventCollectingInterface) MeterMemory(usage common.MemoryUsage) error {
+	memoryGauge, ok := i.Interface.(common.MemoryGauge)
+	if !ok {
+		return nil
+	}
+	return memoryGauge.MeterMemory(usage)
+}
+
+func (i *eventCollectingInterface) ProgramParsed(location common.Location, duration time.Duration) {
+	if metrics, ok := i.Interface.(Metrics); ok {
+		metrics.ProgramParsed(location, duration)
+	}
+}
+
+func (i *eventCollectingInterface) ProgramChecked(location common.Location, duration time.Duration) {
+	if metrics, ok := i.Interface.(Metrics); ok {
+		metrics.ProgramChecked(location, duration)
+	}
+}
+
+func (i *eventCollectingInterface) ProgramInterpreted(location common.Location, duration time.Duration) {
+	if metrics, ok := i.Interface.(Metrics); ok {
+		metrics.ProgramInterpreted(location, duration)
+	}
+}
@@ -0,0 +1,107 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+// ExportLimits are the limits for values which are exported,
+// i.e. script return values and event payloads.
+// A limit of 0 means the limit is not enforced.
+//
+type ExportLimits struct {
+	// MaxSize is the maximum number of values in an exported value,
+	// including the value itself and all nested values
+	MaxSize int
+	// MaxDepth is the maximum nesting depth of an exported value,
+	// e.g. the array `[[1]]` has a depth of 3
+	MaxDepth int
+}
+
+// exportLimitTracker tracks the size and depth of a value during its export.
+// A nil tracker enforces no limits.
+//
+type exportLimitTracker struct {
+	limits ExportLimits
+	size   int
+	depth  int
+}
+
+func newExportLimitTracker(limits ExportLimits) *exportLimitTracker {
+	if limits.MaxSize <= 0 && limits.MaxDepth <= 0 {
+		return nil
+	}
+	return &exportLimitTracker{
+		limits: limits,
+	}
+}
+
+// enterValue records the export of a value, nested in the values currently being exported.
+// It must be followed by a call of leaveValue, if it succeeds
+//
+func (t *exportLimitTracker) enterValue() error {
+	if t == nil {
+		return nil
+	}
+
+	t.size++
+	t.depth++
+
+	if t.limits.MaxSize > 0 && t.size > t.limits.MaxSize {
+		t.depth--
+		return &ExportTooLargeError{
+			Size:    t.size,
+			MaxSize: t.limits.MaxSize,
+		}
+	}
+
+	if t.limits.MaxDepth > 0 && t.depth > t.limits.MaxDepth {
+		t.depth--
+		return &ExportTooLargeError{
+			Depth:    t.depth + 1,
+			MaxDepth: t.limits.MaxDepth,
+		}
+	}
+
+	return nil
+}
+
+func (t *exportLimitTracker) leaveValue() {
+	if t == nil {
+		return
+	}
+
+	t.depth--
+}
+
+// checkNestedValueCount checks if the given number of nested values,
+// e.g. the elements of an array, can still be exported without exceeding the size limit
+//
+func (t *exportLimitTracker) checkNestedValueCount(count int) error {
+	if t == nil || t.limits.MaxSize <= 0 {
+		return nil
+	}
+
+	size := t.size + count
+	if size > t.limits.MaxSize {
+		return &ExportTooLargeError{
+			Size:    size,
+			MaxSize: t.limits.MaxSize,
+		}
+	}
+
+	return nil
+}
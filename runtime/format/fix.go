@@ -20,6 +20,7 @@ package format
 
 import (
 	"fmt"
+	"math/big"
 	"strconv"
 	"strings"
 
@@ -53,3 +54,28 @@ func UFix64(v uint64) string {
 		PadLeft(strconv.Itoa(int(fraction)), '0', fixedpoint.Fix64Scale),
 	)
 }
+
+func Fix128(v *big.Int) string {
+	integer, fraction := new(big.Int).QuoRem(v, fixedpoint.Fix128FactorBig, new(big.Int))
+	negative := fraction.Sign() < 0
+	var builder strings.Builder
+	if negative {
+		fraction.Neg(fraction)
+		if integer.Sign() == 0 {
+			builder.WriteRune('-')
+		}
+	}
+	builder.WriteString(integer.String())
+	builder.WriteRune('.')
+	builder.WriteString(PadLeft(fraction.String(), '0', fixedpoint.Fix128Scale))
+	return builder.String()
+}
+
+func UFix128(v *big.Int) string {
+	integer, fraction := new(big.Int).QuoRem(v, fixedpoint.Fix128FactorBig, new(big.Int))
+	return fmt.Sprintf(
+		"%s.%s",
+		integer,
+		PadLeft(fraction.String(), '0', fixedpoint.Fix128Scale),
+	)
+}
@@ -23,6 +23,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/cadence/runtime/common"
@@ -82,7 +83,7 @@ func TestRuntimeCyclicImport(t *testing.T) {
 	)
 	require.Error(t, err)
 
-	require.Contains(t, err.Error(), "cyclic import of `p1`")
+	require.Contains(t, err.Error(), "cyclic import of `p1`: `p1` -> `p2` -> `p1`")
 
 	// Script
 
@@ -112,6 +113,15 @@ func TestRuntimeCyclicImport(t *testing.T) {
 	errs = checker.ExpectCheckerErrors(t, checkerErr3, 1)
 
 	require.IsType(t, &sema.CyclicImportsError{}, errs[0])
+
+	assert.Equal(t,
+		[]common.Location{
+			common.IdentifierLocation("p1"),
+			common.IdentifierLocation("p2"),
+			common.IdentifierLocation("p1"),
+		},
+		errs[0].(*sema.CyclicImportsError).Cycle,
+	)
 }
 
 func TestRuntimeExport(t *testing.T) {
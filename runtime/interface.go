@@ -108,9 +108,14 @@ type Interface interface {
 	GetCurrentBlockHeight() (uint64, error)
 	// GetBlockAtHeight returns the block at the given height.
 	GetBlockAtHeight(height uint64) (block Block, exists bool, err error)
+	// GetBlockHeaderAtHeight returns the header of the block at the given height.
+	GetBlockHeaderAtHeight(height uint64) (header BlockHeader, exists bool, err error)
 	// UnsafeRandom returns a random uint64, where the process of random number derivation is not cryptographically
 	// secure.
 	UnsafeRandom() (uint64, error)
+	// ReadRandom fills the given buffer with random bytes,
+	// which are produced by a cryptographically secure source of randomness.
+	ReadRandom(buffer []byte) error
 	// VerifySignature returns true if the given signature was produced by signing the given tag + data
 	// using the given public key, signature algorithm, and hash algorithm.
 	VerifySignature(
@@ -161,3 +166,43 @@ type Metrics interface {
 	ProgramChecked(location common.Location, duration time.Duration)
 	ProgramInterpreted(location common.Location, duration time.Duration)
 }
+
+const (
+	TraceOperationParse            = "cadence.parse"
+	TraceOperationCheck            = "cadence.check"
+	TraceOperationInterpret        = "cadence.interpret"
+	TraceOperationImport           = "cadence.import"
+	TraceOperationHostFunctionCall = "cadence.hostFunctionCall"
+)
+
+// UUIDBatchAllocator is an optional interface which can be implemented by the runtime interface
+// to allocate resource UUIDs in batches, see Runtime.SetUUIDBatchSize.
+//
+// If the runtime interface does not implement it,
+// each UUID is generated individually using Interface.GenerateUUID.
+//
+type UUIDBatchAllocator interface {
+	// AllocateUUIDs is called to allocate the given number of UUIDs at once.
+	// The UUIDs are used in the order they are returned.
+	AllocateUUIDs(count uint64) ([]uint64, error)
+}
+
+// Tracer is an optional interface which can be implemented by the runtime interface
+// to trace where the execution of transactions and scripts spends its time,
+// e.g. by creating OpenTelemetry spans.
+//
+// Spans are strictly nested: a span started while another span is active
+// is a child of the active span, and ends before the active span ends.
+//
+type Tracer interface {
+	// StartSpan starts a span for the given operation, one of the TraceOperation constants.
+	// The name is the name of the invoked function for host function calls, and empty otherwise.
+	StartSpan(operation string, location common.Location, name string) Span
+}
+
+// Span is a span started by a Tracer.
+//
+type Span interface {
+	// End ends the span. It is also called when the traced operation failed.
+	End()
+}
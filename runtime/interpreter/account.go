@@ -47,6 +47,7 @@ func NewAuthAccountValue(
 	removePublicKeyFunction FunctionValue,
 	contractsConstructor func() Value,
 	keysConstructor func() Value,
+	inboxConstructor func() Value,
 ) Value {
 
 	fields := map[string]Value{
@@ -63,6 +64,8 @@ func NewAuthAccountValue(
 
 	var contracts Value
 	var keys Value
+	var capabilities Value
+	var inbox Value
 
 	computedFields := map[string]ComputedField{
 		sema.AuthAccountContractsField: func(_ *Interpreter, _ func() LocationRange) Value {
@@ -77,6 +80,18 @@ func NewAuthAccountValue(
 			}
 			return keys
 		},
+		sema.AuthAccountCapabilitiesField: func(inter *Interpreter, _ func() LocationRange) Value {
+			if capabilities == nil {
+				capabilities = NewAuthAccountCapabilitiesValue(inter, address)
+			}
+			return capabilities
+		},
+		sema.AuthAccountInboxField: func(_ *Interpreter, _ func() LocationRange) Value {
+			if inbox == nil {
+				inbox = inboxConstructor()
+			}
+			return inbox
+		},
 		sema.AuthAccountBalanceField: func(_ *Interpreter, _ func() LocationRange) Value {
 			return accountBalanceGet()
 		},
@@ -113,6 +128,27 @@ func NewAuthAccountValue(
 		sema.AuthAccountGetLinkTargetField: func(inter *Interpreter, _ func() LocationRange) Value {
 			return inter.accountGetLinkTargetFunction(address)
 		},
+		sema.AuthAccountForEachStoredField: func(inter *Interpreter, _ func() LocationRange) Value {
+			return inter.accountForEachFunction(
+				address,
+				common.PathDomainStorage,
+				sema.AuthAccountForEachStoredFunctionType,
+			)
+		},
+		sema.AuthAccountForEachPublicField: func(inter *Interpreter, _ func() LocationRange) Value {
+			return inter.accountForEachFunction(
+				address,
+				common.PathDomainPublic,
+				sema.AccountForEachPublicFunctionType,
+			)
+		},
+		sema.AuthAccountForEachPrivateField: func(inter *Interpreter, _ func() LocationRange) Value {
+			return inter.accountForEachFunction(
+				address,
+				common.PathDomainPrivate,
+				sema.AuthAccountForEachPrivateFunctionType,
+			)
+		},
 	}
 
 	var str string
@@ -200,6 +236,13 @@ func NewPublicAccountValue(
 		sema.PublicAccountGetTargetLinkField: func(inter *Interpreter, _ func() LocationRange) Value {
 			return inter.accountGetLinkTargetFunction(address)
 		},
+		sema.PublicAccountForEachPublicField: func(inter *Interpreter, _ func() LocationRange) Value {
+			return inter.accountForEachFunction(
+				address,
+				common.PathDomainPublic,
+				sema.AccountForEachPublicFunctionType,
+			)
+		},
 	}
 
 	var str string
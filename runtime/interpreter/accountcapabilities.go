@@ -0,0 +1,594 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/onflow/atree"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+// AuthAccountCapabilities
+
+var authAccountCapabilitiesTypeID = sema.AuthAccountCapabilitiesType.ID()
+var authAccountCapabilitiesStaticType StaticType = PrimitiveStaticTypeAuthAccountCapabilities // unmetered
+var authAccountCapabilitiesFieldNames = []string{
+	sema.AuthAccountCapabilitiesTypeStorageFieldName,
+}
+
+// NewAuthAccountCapabilitiesValue constructs an AuthAccount.Capabilities value.
+func NewAuthAccountCapabilitiesValue(
+	inter *Interpreter,
+	address AddressValue,
+) Value {
+
+	var storage Value
+
+	computedFields := map[string]ComputedField{
+		sema.AuthAccountCapabilitiesTypeStorageFieldName: func(inter *Interpreter, _ func() LocationRange) Value {
+			if storage == nil {
+				storage = NewAuthAccountStorageCapabilitiesValue(inter, address)
+			}
+			return storage
+		},
+		sema.AuthAccountCapabilitiesTypePublishFunctionName: func(inter *Interpreter, _ func() LocationRange) Value {
+			return inter.authAccountCapabilitiesPublishFunction(address)
+		},
+		sema.AuthAccountCapabilitiesTypeUnpublishFunctionName: func(inter *Interpreter, _ func() LocationRange) Value {
+			return inter.authAccountCapabilitiesUnpublishFunction(address)
+		},
+		sema.AuthAccountCapabilitiesTypeMigrateLinkFunctionName: func(inter *Interpreter, _ func() LocationRange) Value {
+			return inter.authAccountCapabilitiesMigrateLinkFunction(address)
+		},
+	}
+
+	var str string
+	stringer := func(memoryGauge common.MemoryGauge, _ SeenReferences) string {
+		if str == "" {
+			common.UseMemory(memoryGauge, common.AuthAccountCapabilitiesStringMemoryUsage)
+			addressStr := address.MeteredString(memoryGauge, SeenReferences{})
+			str = fmt.Sprintf("AuthAccount.Capabilities(%s)", addressStr)
+		}
+		return str
+	}
+
+	return NewSimpleCompositeValue(
+		inter,
+		authAccountCapabilitiesTypeID,
+		authAccountCapabilitiesStaticType,
+		authAccountCapabilitiesFieldNames,
+		nil,
+		computedFields,
+		nil,
+		stringer,
+	)
+}
+
+func (interpreter *Interpreter) authAccountCapabilitiesPublishFunction(addressValue AddressValue) *HostFunctionValue {
+
+	// Converted addresses can be cached and don't have to be recomputed on each function invocation
+	address := addressValue.ToAddress()
+
+	return NewHostFunctionValue(
+		interpreter,
+		func(invocation Invocation) Value {
+
+			capability, ok := invocation.Arguments[0].(*CapabilityValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			path, ok := invocation.Arguments[1].(PathValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			inter := invocation.Interpreter
+			getLocationRange := invocation.GetLocationRange
+
+			// Only capabilities issued by the account itself can be published
+
+			if !capability.IsIDCapability() {
+				panic(PathCapabilityPublishingError{
+					Path:          capability.Path,
+					LocationRange: getLocationRange(),
+				})
+			}
+
+			if capability.Address != addressValue {
+				panic(CapabilityAddressPublishingError{
+					CapabilityAddress: capability.Address,
+					AccountAddress:    addressValue,
+					LocationRange:     getLocationRange(),
+				})
+			}
+
+			domain := path.Domain.Identifier()
+			identifier := path.Identifier
+
+			if interpreter.storedValueExists(address, domain, identifier) {
+				panic(CapabilityPublishingOverwriteError{
+					Address:       addressValue,
+					Path:          path,
+					LocationRange: getLocationRange(),
+				})
+			}
+
+			value := capability.Transfer(
+				inter,
+				getLocationRange,
+				atree.Address(address),
+				true,
+				nil,
+			)
+
+			interpreter.WriteStored(address, domain, identifier, value, getLocationRange)
+
+			return NewVoidValue(inter)
+		},
+		sema.AuthAccountCapabilitiesTypePublishFunctionType,
+	)
+}
+
+func (interpreter *Interpreter) authAccountCapabilitiesUnpublishFunction(addressValue AddressValue) *HostFunctionValue {
+
+	// Converted addresses can be cached and don't have to be recomputed on each function invocation
+	address := addressValue.ToAddress()
+
+	return NewHostFunctionValue(
+		interpreter,
+		func(invocation Invocation) Value {
+
+			path, ok := invocation.Arguments[0].(PathValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			inter := invocation.Interpreter
+			getLocationRange := invocation.GetLocationRange
+
+			domain := path.Domain.Identifier()
+			identifier := path.Identifier
+
+			// Links are not published capabilities, and are not removed
+
+			capability, ok := interpreter.ReadStored(address, domain, identifier).(*CapabilityValue)
+			if !ok {
+				return NewNilValue(inter)
+			}
+
+			value := capability.Transfer(
+				inter,
+				getLocationRange,
+				atree.Address{},
+				false,
+				nil,
+			)
+
+			interpreter.WriteStored(address, domain, identifier, nil, getLocationRange)
+
+			return NewSomeValueNonCopying(inter, value)
+		},
+		sema.AuthAccountCapabilitiesTypeUnpublishFunctionType,
+	)
+}
+
+func (interpreter *Interpreter) authAccountCapabilitiesMigrateLinkFunction(addressValue AddressValue) *HostFunctionValue {
+
+	// Converted addresses can be cached and don't have to be recomputed on each function invocation
+	address := addressValue.ToAddress()
+
+	return NewHostFunctionValue(
+		interpreter,
+		func(invocation Invocation) Value {
+
+			capabilityPath, ok := invocation.Arguments[0].(PathValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			inter := invocation.Interpreter
+			getLocationRange := invocation.GetLocationRange
+
+			domain := capabilityPath.Domain.Identifier()
+			identifier := capabilityPath.Identifier
+
+			link, ok := interpreter.ReadStored(address, domain, identifier).(LinkValue)
+			if !ok {
+				return NewNilValue(inter)
+			}
+
+			targetPath, ok := interpreter.migrationLinkTargetPath(address, link)
+			if !ok {
+				return NewNilValue(inter)
+			}
+
+			capability := interpreter.issueStorageCapability(
+				addressValue,
+				targetPath,
+				link.Type,
+				getLocationRange,
+			)
+
+			// Replace the link with the issued capability,
+			// so existing path capabilities for the path are resolved through the new controller
+
+			interpreter.WriteStored(address, domain, identifier, capability, getLocationRange)
+
+			return NewSomeValueNonCopying(inter, capability.ID)
+		},
+		sema.AuthAccountCapabilitiesTypeMigrateLinkFunctionType,
+	)
+}
+
+// migrationLinkTargetPath follows the given link and returns the storage path it finally targets.
+//
+// Returns false if the link does not lead to a storage path,
+// e.g. because the link chain is broken or cyclic,
+// or if the type of the link is not allowed by all links in the chain.
+//
+func (interpreter *Interpreter) migrationLinkTargetPath(
+	address common.Address,
+	link LinkValue,
+) (
+	PathValue,
+	bool,
+) {
+	borrowType := interpreter.MustConvertStaticToSemaType(link.Type)
+
+	seenPaths := map[PathValue]struct{}{}
+
+	for {
+		path := link.TargetPath
+
+		if path.Domain == common.PathDomainStorage {
+			return path, true
+		}
+
+		if _, ok := seenPaths[path]; ok {
+			return EmptyPathValue, false
+		}
+		seenPaths[path] = struct{}{}
+
+		var ok bool
+		link, ok = interpreter.ReadStored(
+			address,
+			path.Domain.Identifier(),
+			path.Identifier,
+		).(LinkValue)
+		if !ok {
+			return EmptyPathValue, false
+		}
+
+		allowedType := interpreter.MustConvertStaticToSemaType(link.Type)
+		if !sema.IsSubType(borrowType, allowedType) {
+			return EmptyPathValue, false
+		}
+	}
+}
+
+// AuthAccountStorageCapabilities
+
+var authAccountStorageCapabilitiesTypeID = sema.AuthAccountStorageCapabilitiesType.ID()
+var authAccountStorageCapabilitiesStaticType StaticType = PrimitiveStaticTypeAuthAccountStorageCapabilities // unmetered
+
+// NewAuthAccountStorageCapabilitiesValue constructs an AuthAccount.StorageCapabilities value.
+func NewAuthAccountStorageCapabilitiesValue(
+	inter *Interpreter,
+	address AddressValue,
+) Value {
+
+	computedFields := map[string]ComputedField{
+		sema.AuthAccountStorageCapabilitiesTypeGetControllerFunctionName: func(inter *Interpreter, _ func() LocationRange) Value {
+			return inter.authAccountStorageCapabilitiesGetControllerFunction(address)
+		},
+		sema.AuthAccountStorageCapabilitiesTypeGetControllersFunctionName: func(inter *Interpreter, _ func() LocationRange) Value {
+			return inter.authAccountStorageCapabilitiesGetControllersFunction(address)
+		},
+		sema.AuthAccountStorageCapabilitiesTypeForEachControllerFunctionName: func(inter *Interpreter, _ func() LocationRange) Value {
+			return inter.authAccountStorageCapabilitiesForEachControllerFunction(address)
+		},
+		sema.AuthAccountStorageCapabilitiesTypeIssueFunctionName: func(inter *Interpreter, _ func() LocationRange) Value {
+			return inter.authAccountStorageCapabilitiesIssueFunction(address)
+		},
+	}
+
+	var str string
+	stringer := func(memoryGauge common.MemoryGauge, _ SeenReferences) string {
+		if str == "" {
+			common.UseMemory(memoryGauge, common.AuthAccountStorageCapabilitiesStringMemoryUsage)
+			addressStr := address.MeteredString(memoryGauge, SeenReferences{})
+			str = fmt.Sprintf("AuthAccount.StorageCapabilities(%s)", addressStr)
+		}
+		return str
+	}
+
+	return NewSimpleCompositeValue(
+		inter,
+		authAccountStorageCapabilitiesTypeID,
+		authAccountStorageCapabilitiesStaticType,
+		nil,
+		nil,
+		computedFields,
+		nil,
+		stringer,
+	)
+}
+
+func (interpreter *Interpreter) authAccountStorageCapabilitiesIssueFunction(addressValue AddressValue) *HostFunctionValue {
+	return NewHostFunctionValue(
+		interpreter,
+		func(invocation Invocation) Value {
+
+			typeParameterPair := invocation.TypeParameterTypes.Oldest()
+			if typeParameterPair == nil {
+				panic(errors.NewUnreachableError())
+			}
+
+			borrowType, ok := typeParameterPair.Value.(*sema.ReferenceType)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			targetPath, ok := invocation.Arguments[0].(PathValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			borrowStaticType := ConvertSemaToStaticType(invocation.Interpreter, borrowType)
+
+			return interpreter.issueStorageCapability(
+				addressValue,
+				targetPath,
+				borrowStaticType,
+				invocation.GetLocationRange,
+			)
+		},
+		sema.AuthAccountStorageCapabilitiesTypeIssueFunctionType,
+	)
+}
+
+func (interpreter *Interpreter) authAccountStorageCapabilitiesGetControllerFunction(addressValue AddressValue) *HostFunctionValue {
+
+	// Converted addresses can be cached and don't have to be recomputed on each function invocation
+	address := addressValue.ToAddress()
+
+	return NewHostFunctionValue(
+		interpreter,
+		func(invocation Invocation) Value {
+
+			capabilityID, ok := invocation.Arguments[0].(UInt64Value)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			inter := invocation.Interpreter
+
+			controller, ok := interpreter.readStorageCapabilityController(address, capabilityID)
+			if !ok {
+				return NewNilValue(inter)
+			}
+
+			return NewSomeValueNonCopying(
+				inter,
+				NewStorageCapabilityControllerValue(
+					inter,
+					addressValue,
+					capabilityID,
+					controller.Type,
+				),
+			)
+		},
+		sema.AuthAccountStorageCapabilitiesTypeGetControllerFunctionType,
+	)
+}
+
+var storageCapabilityControllersStaticType = VariableSizedStaticType{
+	Type: PrimitiveStaticTypeStorageCapabilityController,
+}
+
+func (interpreter *Interpreter) authAccountStorageCapabilitiesGetControllersFunction(addressValue AddressValue) *HostFunctionValue {
+
+	// Converted addresses can be cached and don't have to be recomputed on each function invocation
+	address := addressValue.ToAddress()
+
+	return NewHostFunctionValue(
+		interpreter,
+		func(invocation Invocation) Value {
+
+			targetPath, ok := invocation.Arguments[0].(PathValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			inter := invocation.Interpreter
+
+			capabilityIDs := interpreter.storageCapabilityIDs(address, targetPath)
+
+			controllers := make([]Value, 0, len(capabilityIDs))
+
+			for _, capabilityID := range capabilityIDs {
+				inter.ReportComputation(common.ComputationKindLoop, 1)
+
+				controller, ok := interpreter.readStorageCapabilityController(address, capabilityID)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				controllers = append(
+					controllers,
+					NewStorageCapabilityControllerValue(
+						inter,
+						addressValue,
+						capabilityID,
+						controller.Type,
+					),
+				)
+			}
+
+			return NewArrayValue(
+				inter,
+				invocation.GetLocationRange,
+				storageCapabilityControllersStaticType,
+				common.Address{},
+				controllers...,
+			)
+		},
+		sema.AuthAccountStorageCapabilitiesTypeGetControllersFunctionType,
+	)
+}
+
+// authAccountStorageCapabilitiesForEachControllerFunction returns a function which calls the given iteration function
+// for each controller of a capability that targets the given storage path, ordered by capability ID.
+//
+// The account's capability controllers must not be mutated while they are being iterated.
+//
+func (interpreter *Interpreter) authAccountStorageCapabilitiesForEachControllerFunction(addressValue AddressValue) *HostFunctionValue {
+
+	// Converted addresses can be cached and don't have to be recomputed on each function invocation
+	address := addressValue.ToAddress()
+
+	return NewHostFunctionValue(
+		interpreter,
+		func(invocation Invocation) Value {
+
+			targetPath, ok := invocation.Arguments[0].(PathValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			function, ok := invocation.Arguments[1].(FunctionValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			inter := invocation.Interpreter
+			getLocationRange := invocation.GetLocationRange
+
+			controllerStorage := inter.Storage.GetStorageMap(address, CapabilityControllerStorageDomain, false)
+			if controllerStorage == nil {
+				return NewVoidValue(inter)
+			}
+
+			capabilityIDs := interpreter.storageCapabilityIDs(address, targetPath)
+
+			inter.withMutationPrevention(controllerStorage.StorageID(), func() {
+				for _, capabilityID := range capabilityIDs {
+					inter.ReportComputation(common.ComputationKindLoop, 1)
+
+					controller, ok := interpreter.readStorageCapabilityController(address, capabilityID)
+					if !ok {
+						panic(errors.NewUnreachableError())
+					}
+
+					iterationInvocation := NewInvocation(
+						inter,
+						nil,
+						[]Value{
+							NewStorageCapabilityControllerValue(
+								inter,
+								addressValue,
+								capabilityID,
+								controller.Type,
+							),
+						},
+						[]sema.Type{
+							sema.StorageCapabilityControllerType,
+						},
+						nil,
+						getLocationRange,
+					)
+
+					result, ok := function.invoke(iterationInvocation).(BoolValue)
+					if !ok {
+						panic(errors.NewUnreachableError())
+					}
+
+					if !result {
+						break
+					}
+				}
+			})
+
+			return NewVoidValue(inter)
+		},
+		sema.AuthAccountStorageCapabilitiesTypeForEachControllerFunctionType,
+	)
+}
+
+// storageCapabilityIDs returns the IDs of all capabilities of the account
+// which target the given storage path, in ascending order
+//
+func (interpreter *Interpreter) storageCapabilityIDs(
+	address common.Address,
+	targetPath PathValue,
+) []UInt64Value {
+
+	controllerStorage := interpreter.Storage.GetStorageMap(address, CapabilityControllerStorageDomain, false)
+	if controllerStorage == nil {
+		return nil
+	}
+
+	var capabilityIDs []UInt64Value
+
+	iterator := controllerStorage.Iterator(interpreter)
+
+	for {
+		key, value := iterator.Next()
+		if value == nil {
+			break
+		}
+
+		controller, ok := value.(LinkValue)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		if controller.TargetPath != targetPath {
+			continue
+		}
+
+		capabilityID, err := strconv.ParseUint(key, 10, 64)
+		if err != nil {
+			panic(errors.NewUnexpectedErrorFromCause(err))
+		}
+
+		capabilityIDs = append(
+			capabilityIDs,
+			NewUInt64Value(
+				interpreter,
+				func() uint64 {
+					return capabilityID
+				},
+			),
+		)
+	}
+
+	sort.Slice(capabilityIDs, func(i, j int) bool {
+		return capabilityIDs[i] < capabilityIDs[j]
+	})
+
+	return capabilityIDs
+}
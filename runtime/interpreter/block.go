@@ -33,18 +33,23 @@ var blockFieldNames = []string{
 	sema.BlockTypeViewFieldName,
 	sema.BlockTypeIDFieldName,
 	sema.BlockTypeTimestampFieldName,
+	sema.BlockTypeParentIDFieldName,
+	sema.BlockTypeCollectionIndexFieldName,
 }
 var blockFieldFormatters = func(inter *Interpreter) map[string]func(common.MemoryGauge, Value, SeenReferences) string {
-	return map[string]func(common.MemoryGauge, Value, SeenReferences) string{
-		sema.BlockTypeIDFieldName: func(memoryGauge common.MemoryGauge, value Value, references SeenReferences) string {
-			bytes, err := ByteArrayValueToByteSlice(inter, value)
-			if err != nil {
-				panic(err)
-			}
+	formatID := func(memoryGauge common.MemoryGauge, value Value, references SeenReferences) string {
+		bytes, err := ByteArrayValueToByteSlice(inter, value)
+		if err != nil {
+			panic(err)
+		}
 
-			common.UseMemory(memoryGauge, common.NewRawStringMemoryUsage(len(bytes)*2+2))
-			return fmt.Sprintf("0x%x", bytes)
-		},
+		common.UseMemory(memoryGauge, common.NewRawStringMemoryUsage(len(bytes)*2+2))
+		return fmt.Sprintf("0x%x", bytes)
+	}
+
+	return map[string]func(common.MemoryGauge, Value, SeenReferences) string{
+		sema.BlockTypeIDFieldName:       formatID,
+		sema.BlockTypeParentIDFieldName: formatID,
 	}
 }
 
@@ -54,6 +59,8 @@ func NewBlockValue(
 	view UInt64Value,
 	id *ArrayValue,
 	timestamp UFix64Value,
+	parentID *ArrayValue,
+	collectionIndex UInt64Value,
 ) *SimpleCompositeValue {
 	return NewSimpleCompositeValue(
 		inter,
@@ -61,10 +68,12 @@ func NewBlockValue(
 		blockStaticType,
 		blockFieldNames,
 		map[string]Value{
-			sema.BlockTypeHeightFieldName:    height,
-			sema.BlockTypeViewFieldName:      view,
-			sema.BlockTypeIDFieldName:        id,
-			sema.BlockTypeTimestampFieldName: timestamp,
+			sema.BlockTypeHeightFieldName:          height,
+			sema.BlockTypeViewFieldName:            view,
+			sema.BlockTypeIDFieldName:              id,
+			sema.BlockTypeTimestampFieldName:       timestamp,
+			sema.BlockTypeParentIDFieldName:        parentID,
+			sema.BlockTypeCollectionIndexFieldName: collectionIndex,
 		},
 		nil,
 		blockFieldFormatters(inter),
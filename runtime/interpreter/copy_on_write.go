@@ -0,0 +1,406 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"github.com/onflow/atree"
+
+	"github.com/onflow/cadence/runtime/errors"
+)
+
+// copyOnWriteValue is a container value which may share its backing storage
+// with other values of the same container.
+//
+// Value-semantics containers are copied on assignment and when passed as an argument.
+// Instead of eagerly copying the whole container,
+// the copy shares the backing storage with the original value,
+// and the storage is only copied once either of the values is mutated or removed.
+//
+// Sharing is not observable: the copy is metered like an eager transfer,
+// and the storage IDs of the eager copy are already generated when the value is shared.
+// The seeds of new dictionaries are derived from storage IDs,
+// so generating a different sequence of storage IDs would e.g. change the iteration order of dictionaries.
+//
+type copyOnWriteValue interface {
+	Value
+	isSharable(interpreter *Interpreter) bool
+	share(interpreter *Interpreter, getLocationRange func() LocationRange) Value
+	unshare(interpreter *Interpreter, getLocationRange func() LocationRange)
+}
+
+// copyOnWriteState is the state of a backing storage which is shared by multiple values.
+//
+// The state is only referenced by the values which share the backing storage,
+// so it is discarded together with these values.
+//
+type copyOnWriteState struct {
+	// valueCount is the number of values which share the backing storage
+	valueCount int
+	// storageIDs are, for each copy, the storage IDs which were generated for the eager copy when it was shared,
+	// and which are used when the backing storage is copied
+	storageIDs [][]atree.StorageID
+	// storageIDCount is the number of storage IDs which an eager copy of the backing storage generates,
+	// or 0 if it is not known yet
+	storageIDCount int
+}
+
+func newCopyOnWriteState() *copyOnWriteState {
+	return &copyOnWriteState{
+		valueCount: 1,
+	}
+}
+
+// generateStorageIDs generates the storage IDs for a new copy of the backing storage,
+// like an eager copy.
+//
+// The storage IDs for the first copy are generated by the given function,
+// which determines the storage IDs without copying the backing storage.
+// The backing storage is not mutated while it is shared,
+// so all copies generate the same number of storage IDs,
+// and the storage IDs for further copies are generated directly.
+//
+func (s *copyOnWriteState) generateStorageIDs(
+	interpreter *Interpreter,
+	generateCopyStorageIDs func() []atree.StorageID,
+) []atree.StorageID {
+
+	if s.storageIDCount == 0 {
+		storageIDs := generateCopyStorageIDs()
+		s.storageIDCount = len(storageIDs)
+		return storageIDs
+	}
+
+	storageIDs := make([]atree.StorageID, 0, s.storageIDCount)
+	for i := 0; i < s.storageIDCount; i++ {
+		storageID, err := interpreter.Storage.GenerateStorageID(atree.Address{})
+		if err != nil {
+			panic(errors.NewExternalError(err))
+		}
+		storageIDs = append(storageIDs, storageID)
+	}
+
+	return storageIDs
+}
+
+// add adds a copy, which got the given storage IDs generated, to the values sharing the backing storage.
+//
+func (s *copyOnWriteState) add(storageIDs []atree.StorageID) {
+	s.valueCount++
+	s.storageIDs = append(s.storageIDs, storageIDs)
+}
+
+// remove removes a value from the values sharing the backing storage,
+// and returns the storage IDs for the copy of the backing storage.
+//
+// If the value is the last one sharing the backing storage,
+// it keeps the backing storage and no storage IDs are returned.
+//
+func (s *copyOnWriteState) remove() ([]atree.StorageID, bool) {
+	s.valueCount--
+	if s.valueCount == 0 {
+		return nil, false
+	}
+
+	lastIndex := len(s.storageIDs) - 1
+	storageIDs := s.storageIDs[lastIndex]
+	s.storageIDs[lastIndex] = nil
+	s.storageIDs = s.storageIDs[:lastIndex]
+
+	return storageIDs, true
+}
+
+// reservedSlabStorage is a slab storage which provides the given, previously generated storage IDs,
+// in order, for the slabs that are created, instead of generating new storage IDs.
+//
+// The copy of a backing storage must create exactly the slabs that the eager copy would have created,
+// so all reserved storage IDs must be used, and no other storage IDs may be generated.
+//
+type reservedSlabStorage struct {
+	atree.SlabStorage
+	storageIDs []atree.StorageID
+}
+
+var _ atree.SlabStorage = &reservedSlabStorage{}
+
+func (s *reservedSlabStorage) GenerateStorageID(_ atree.Address) (atree.StorageID, error) {
+	if len(s.storageIDs) == 0 {
+		panic(errors.NewUnexpectedError("missing reserved storage ID"))
+	}
+
+	storageID := s.storageIDs[0]
+	s.storageIDs = s.storageIDs[1:]
+
+	return storageID, nil
+}
+
+// checkUsed checks that all reserved storage IDs were used
+//
+func (s *reservedSlabStorage) checkUsed() {
+	if len(s.storageIDs) > 0 {
+		panic(errors.NewUnexpectedError("unused reserved storage IDs: %d", len(s.storageIDs)))
+	}
+}
+
+// storageIDRecordingStorage is a slab storage which is used to generate the storage IDs of an eager copy,
+// without performing the copy.
+//
+// Storage IDs are generated by the given storage, and are recorded.
+// The slabs that are created are only stored temporarily, and are discarded together with this storage.
+//
+type storageIDRecordingStorage struct {
+	atree.SlabStorage
+	storageIDs []atree.StorageID
+	slabs      map[atree.StorageID]atree.Slab
+}
+
+var _ atree.SlabStorage = &storageIDRecordingStorage{}
+
+func (s *storageIDRecordingStorage) GenerateStorageID(address atree.Address) (atree.StorageID, error) {
+	storageID, err := s.SlabStorage.GenerateStorageID(address)
+	if err != nil {
+		return atree.StorageIDUndefined, err
+	}
+
+	s.storageIDs = append(s.storageIDs, storageID)
+
+	return storageID, nil
+}
+
+func (s *storageIDRecordingStorage) Store(storageID atree.StorageID, slab atree.Slab) error {
+	if s.slabs == nil {
+		s.slabs = map[atree.StorageID]atree.Slab{}
+	}
+	s.slabs[storageID] = slab
+	return nil
+}
+
+func (s *storageIDRecordingStorage) Retrieve(storageID atree.StorageID) (atree.Slab, bool, error) {
+	if slab, ok := s.slabs[storageID]; ok {
+		return slab, true, nil
+	}
+	return s.SlabStorage.Retrieve(storageID)
+}
+
+func (s *storageIDRecordingStorage) Remove(storageID atree.StorageID) error {
+	delete(s.slabs, storageID)
+	return nil
+}
+
+// copiedElementStorable is the storable of an element of an array which is copied
+// to determine the storage IDs of the copy, see arrayCopyStorageIDs.
+//
+// Elements of sharable arrays are immutable, so the storable of the copied element
+// is the storable of the original element.
+// Elements which are not inlined are stored in a separate slab, see maybeLargeImmutableStorable,
+// so a storage ID is generated for the copied element.
+//
+type copiedElementStorable struct {
+	storable atree.Storable
+}
+
+var _ atree.Value = copiedElementStorable{}
+
+func (s copiedElementStorable) Storable(storage atree.SlabStorage, address atree.Address, _ uint64) (atree.Storable, error) {
+	if _, ok := s.storable.(atree.StorageIDStorable); ok {
+		_, err := storage.GenerateStorageID(address)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s.storable, nil
+}
+
+// copyValue returns a copy of the given value with value semantics.
+//
+// Arrays and dictionaries which can be shared are not copied,
+// but share the backing storage of the given value until either value gets mutated.
+//
+// Values are not shared while the effects of a recoverable call are tracked.
+// The eager copies of values shared before the call are not new for the call,
+// just like the storage IDs generated for them, see EffectTracker.
+//
+func (interpreter *Interpreter) copyValue(value Value, getLocationRange func() LocationRange) Value {
+	if sharable, ok := value.(copyOnWriteValue); ok &&
+		!interpreter.isTrackingEffects() &&
+		sharable.isSharable(interpreter) {
+
+		return sharable.share(interpreter, getLocationRange)
+	}
+
+	return value.Transfer(
+		interpreter,
+		getLocationRange,
+		atree.Address{},
+		false,
+		nil,
+	)
+}
+
+func (interpreter *Interpreter) isTrackingEffects() bool {
+	storage, ok := interpreter.Storage.(EffectTrackingStorage)
+	return ok && storage.EffectTracker().Tracking()
+}
+
+// arrayCopyStorageIDs generates the storage IDs of an eager copy of the given array, in the same order,
+// without copying the array.
+//
+// The copy is built from the storables of the elements, which are neither decoded nor transferred,
+// and the slabs of the copy are discarded.
+//
+func (interpreter *Interpreter) arrayCopyStorageIDs(array *atree.Array) []atree.StorageID {
+	storables := interpreter.arrayElementStorables(array.StorageID(), nil)
+
+	storage := &storageIDRecordingStorage{
+		SlabStorage: interpreter.Storage,
+	}
+
+	index := 0
+
+	_, err := atree.NewArrayFromBatchData(
+		storage,
+		atree.Address{},
+		array.Type(),
+		func() (atree.Value, error) {
+			if index >= len(storables) {
+				return nil, nil
+			}
+
+			storable := storables[index]
+			index++
+
+			return copiedElementStorable{storable: storable}, nil
+		},
+	)
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	return storage.storageIDs
+}
+
+// arrayElementStorables appends the storables of the elements of the array slab with the given storage ID,
+// and its child slabs, in order
+//
+func (interpreter *Interpreter) arrayElementStorables(
+	storageID atree.StorageID,
+	storables []atree.Storable,
+) []atree.Storable {
+
+	slab, found, err := interpreter.Storage.Retrieve(storageID)
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+	if !found {
+		panic(errors.NewUnexpectedError("missing slab %s", storageID))
+	}
+
+	switch slab := slab.(type) {
+	case *atree.ArrayDataSlab:
+		return append(storables, slab.ChildStorables()...)
+
+	case *atree.ArrayMetaDataSlab:
+		for _, childStorable := range slab.ChildStorables() {
+			childStorageID := atree.StorageID(childStorable.(atree.StorageIDStorable))
+			storables = interpreter.arrayElementStorables(childStorageID, storables)
+		}
+		return storables
+
+	default:
+		panic(errors.NewUnexpectedError("invalid array slab: %T", slab))
+	}
+}
+
+// dictionaryCopyStorageIDs generates the storage IDs of an eager copy of the given dictionary, in the same order,
+// without copying the dictionary.
+//
+// The copy is built from the keys and values of the dictionary, which are not transferred,
+// and the slabs of the copy are discarded.
+//
+func (interpreter *Interpreter) dictionaryCopyStorageIDs(
+	dictionary *atree.OrderedMap,
+	getLocationRange func() LocationRange,
+) []atree.StorageID {
+
+	iterator, err := dictionary.Iterator()
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	storage := &storageIDRecordingStorage{
+		SlabStorage: interpreter.Storage,
+	}
+
+	_, err = atree.NewMapFromBatchData(
+		storage,
+		atree.Address{},
+		atree.NewDefaultDigesterBuilder(),
+		dictionary.Type(),
+		newValueComparator(interpreter, getLocationRange),
+		newHashInputProvider(interpreter, getLocationRange),
+		dictionary.Seed(),
+		func() (atree.Value, atree.Value, error) {
+
+			atreeKey, atreeValue, err := iterator.Next()
+			if err != nil {
+				return nil, nil, err
+			}
+			if atreeKey == nil || atreeValue == nil {
+				return nil, nil, nil
+			}
+
+			key := MustConvertStoredValue(interpreter, atreeKey)
+			value := MustConvertStoredValue(interpreter, atreeValue)
+
+			return key, value, nil
+		},
+	)
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	return storage.storageIDs
+}
+
+// isImmutableStaticType returns true if values of the given type can never be mutated.
+// The elements of containers with such an element type can be safely shared.
+//
+func isImmutableStaticType(staticType StaticType) bool {
+	primitiveStaticType, ok := staticType.(PrimitiveStaticType)
+	if !ok {
+		return false
+	}
+
+	switch primitiveStaticType {
+	case PrimitiveStaticTypeBool,
+		PrimitiveStaticTypeAddress,
+		PrimitiveStaticTypeString,
+		PrimitiveStaticTypeCharacter,
+		PrimitiveStaticTypePath,
+		PrimitiveStaticTypeStoragePath,
+		PrimitiveStaticTypeCapabilityPath,
+		PrimitiveStaticTypePublicPath,
+		PrimitiveStaticTypePrivatePath:
+
+		return true
+	}
+
+	return primitiveStaticType >= PrimitiveStaticTypeNumber &&
+		primitiveStaticType <= PrimitiveStaticTypeUFix128
+}
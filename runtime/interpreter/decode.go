@@ -126,6 +126,23 @@ func DecodeStorable(
 	return NewStorableDecoder(decoder, slabStorageID, memoryGauge).decodeStorable()
 }
 
+// DecodeStorableLazily decodes a storable like DecodeStorable,
+// but defers decoding values which are expensive to decode until they are accessed,
+// see lazyStorable.
+//
+func DecodeStorableLazily(
+	decoder *cbor.StreamDecoder,
+	slabStorageID atree.StorageID,
+	memoryGauge common.MemoryGauge,
+) (
+	atree.Storable,
+	error,
+) {
+	storableDecoder := NewStorableDecoder(decoder, slabStorageID, memoryGauge)
+	storableDecoder.lazy = true
+	return storableDecoder.decodeStorable()
+}
+
 func NewStorableDecoder(
 	decoder *cbor.StreamDecoder,
 	slabStorageID atree.StorageID,
@@ -146,6 +163,7 @@ type StorableDecoder struct {
 	memoryGauge   common.MemoryGauge
 	decoder       *cbor.StreamDecoder
 	slabStorageID atree.StorageID
+	lazy          bool
 	TypeDecoder
 }
 
@@ -193,6 +211,15 @@ func (d StorableDecoder) decodeStorable() (atree.Storable, error) {
 			return nil, err
 		}
 
+		if d.lazy && isLazilyDecodedTag(num) {
+			var content []byte
+			content, err = d.decoder.DecodeRawBytes()
+			if err != nil {
+				return nil, err
+			}
+			return newLazyStorable(d.memoryGauge, num, content, d.slabStorageID), nil
+		}
+
 		switch num {
 
 		case atree.CBORTagStorageID:
@@ -289,11 +316,17 @@ func (d StorableDecoder) decodeStorable() (atree.Storable, error) {
 		case CBORTagFix64Value:
 			storable, err = d.decodeFix64()
 
+		case CBORTagFix128Value:
+			storable, err = d.decodeFix128()
+
 		// UFix*
 
 		case CBORTagUFix64Value:
 			storable, err = d.decodeUFix64()
 
+		case CBORTagUFix128Value:
+			storable, err = d.decodeUFix128()
+
 		// Storage
 
 		case CBORTagPathValue:
@@ -305,6 +338,9 @@ func (d StorableDecoder) decodeStorable() (atree.Storable, error) {
 		case CBORTagLinkValue:
 			storable, err = d.decodeLink()
 
+		case CBORTagPublishedValue:
+			storable, err = d.decodePublishedValue()
+
 		case CBORTagTypeValue:
 			storable, err = d.decodeType()
 
@@ -741,6 +777,51 @@ func (d StorableDecoder) decodeUFix64() (UFix64Value, error) {
 	return NewUnmeteredUFix64Value(value), nil
 }
 
+func (d StorableDecoder) decodeFix128() (Fix128Value, error) {
+	bigInt, err := d.decodeBigInt()
+	if err != nil {
+		if e, ok := err.(*cbor.WrongTypeError); ok {
+			return Fix128Value{}, errors.NewUnexpectedError("invalid Fix128 encoding: %s", e.ActualType.String())
+		}
+		return Fix128Value{}, err
+	}
+
+	min := sema.Fix128TypeMinBig
+	if bigInt.Cmp(min) < 0 {
+		return Fix128Value{}, errors.NewUnexpectedError("invalid Fix128: got %s, expected min %s", bigInt, min)
+	}
+
+	max := sema.Fix128TypeMaxBig
+	if bigInt.Cmp(max) > 0 {
+		return Fix128Value{}, errors.NewUnexpectedError("invalid Fix128: got %s, expected max %s", bigInt, max)
+	}
+
+	// NOTE: already metered by `decodeBigInt`
+	return NewUnmeteredFix128ValueFromBigInt(bigInt), nil
+}
+
+func (d StorableDecoder) decodeUFix128() (UFix128Value, error) {
+	bigInt, err := d.decodeBigInt()
+	if err != nil {
+		if e, ok := err.(*cbor.WrongTypeError); ok {
+			return UFix128Value{}, errors.NewUnexpectedError("invalid UFix128 encoding: %s", e.ActualType.String())
+		}
+		return UFix128Value{}, err
+	}
+
+	if bigInt.Sign() < 0 {
+		return UFix128Value{}, errors.NewUnexpectedError("invalid UFix128: got %s, expected positive", bigInt)
+	}
+
+	max := sema.UFix128TypeMaxBig
+	if bigInt.Cmp(max) > 0 {
+		return UFix128Value{}, errors.NewUnexpectedError("invalid UFix128: got %s, expected max %s", bigInt, max)
+	}
+
+	// NOTE: already metered by `decodeBigInt`
+	return NewUnmeteredUFix128ValueFromBigInt(bigInt), nil
+}
+
 func (d StorableDecoder) decodeSome() (SomeStorable, error) {
 	storable, err := d.decodeStorable()
 	if err != nil {
@@ -873,7 +954,9 @@ func (d StorableDecoder) decodeCapability() (*CapabilityValue, error) {
 		return nil, err
 	}
 
-	if size != expectedLength {
+	// Path capabilities are encoded without an ID
+
+	if size != expectedLength && size != encodedPathCapabilityValueLength {
 		return nil, errors.NewUnexpectedError(
 			"invalid capability encoding: expected [%d]any, got [%d]any",
 			expectedLength,
@@ -940,7 +1023,18 @@ func (d StorableDecoder) decodeCapability() (*CapabilityValue, error) {
 		return nil, errors.NewUnexpectedError("invalid capability borrow type encoding: %w", err)
 	}
 
-	return NewCapabilityValue(d.memoryGauge, address, pathValue, borrowType), nil
+	if size == encodedPathCapabilityValueLength {
+		return NewCapabilityValue(d.memoryGauge, address, pathValue, borrowType), nil
+	}
+
+	// Decode ID at array index encodedCapabilityValueIDFieldKey
+
+	id, err := decodeUint64(d.decoder, d.memoryGauge)
+	if err != nil {
+		return nil, errors.NewUnexpectedError("invalid capability ID encoding: %w", err)
+	}
+
+	return NewIDCapabilityValue(d.memoryGauge, UInt64Value(id), address, borrowType), nil
 }
 
 func (d StorableDecoder) decodeLink() (LinkValue, error) {
@@ -989,6 +1083,67 @@ func (d StorableDecoder) decodeLink() (LinkValue, error) {
 	return NewLinkValue(d.memoryGauge, pathValue, staticType), nil
 }
 
+func (d StorableDecoder) decodePublishedValue() (*PublishedValue, error) {
+
+	const expectedLength = encodedPublishedValueLength
+
+	size, err := d.decoder.DecodeArrayHead()
+	if err != nil {
+		if e, ok := err.(*cbor.WrongTypeError); ok {
+			return nil, errors.NewUnexpectedError(
+				"invalid published value encoding: expected [%d]any, got %s",
+				expectedLength,
+				e.ActualType.String(),
+			)
+		}
+		return nil, err
+	}
+
+	if size != expectedLength {
+		return nil, errors.NewUnexpectedError(
+			"invalid published value encoding: expected [%d]any, got [%d]any",
+			expectedLength,
+			size,
+		)
+	}
+
+	// Decode recipient at array index encodedPublishedValueRecipientFieldKey
+	num, err := d.decoder.DecodeTagNumber()
+	if err != nil {
+		return nil, errors.NewUnexpectedError("invalid published value recipient encoding: %w", err)
+	}
+	if num != CBORTagAddressValue {
+		return nil, errors.NewUnexpectedError(
+			"invalid published value recipient encoding: expected CBOR tag %d, got %d",
+			CBORTagAddressValue,
+			num,
+		)
+	}
+	recipient, err := d.decodeAddress()
+	if err != nil {
+		return nil, errors.NewUnexpectedError("invalid published value recipient encoding: %w", err)
+	}
+
+	// Decode value at array index encodedPublishedValueValueFieldKey
+	num, err = d.decoder.DecodeTagNumber()
+	if err != nil {
+		return nil, errors.NewUnexpectedError("invalid published value value encoding: %w", err)
+	}
+	if num != CBORTagCapabilityValue {
+		return nil, errors.NewUnexpectedError(
+			"invalid published value value encoding: expected CBOR tag %d, got %d",
+			CBORTagCapabilityValue,
+			num,
+		)
+	}
+	value, err := d.decodeCapability()
+	if err != nil {
+		return nil, errors.NewUnexpectedError("invalid published value value encoding: %w", err)
+	}
+
+	return NewPublishedValue(d.memoryGauge, recipient, value), nil
+}
+
 func (d StorableDecoder) decodeType() (TypeValue, error) {
 	const expectedLength = encodedTypeValueTypeLength
 
@@ -1139,7 +1294,9 @@ func (d TypeDecoder) decodeCompositeStaticType() (StaticType, error) {
 		return nil, err
 	}
 
-	if size != expectedLength {
+	if size != expectedLength &&
+		size != encodedCompositeStaticTypeWithTypeArgumentsLength {
+
 		return nil, errors.NewUnexpectedError(
 			"invalid composite static type encoding: expected [%d]any, got [%d]any",
 			expectedLength,
@@ -1165,7 +1322,39 @@ func (d TypeDecoder) decodeCompositeStaticType() (StaticType, error) {
 		return nil, err
 	}
 
-	return NewCompositeStaticTypeComputeTypeID(d.memoryGauge, location, qualifiedIdentifier), nil
+	staticType := NewCompositeStaticTypeComputeTypeID(d.memoryGauge, location, qualifiedIdentifier)
+
+	if size == encodedCompositeStaticTypeWithTypeArgumentsLength {
+		// Decode type arguments at array index encodedCompositeStaticTypeTypeArgumentsFieldKey
+		typeArguments, err := d.decodeStaticTypes()
+		if err != nil {
+			return nil, errors.NewUnexpectedError(
+				"invalid composite static type type arguments encoding: %w",
+				err,
+			)
+		}
+
+		staticType = staticType.WithTypeArguments(typeArguments)
+	}
+
+	return staticType, nil
+}
+
+func (d TypeDecoder) decodeStaticTypes() ([]StaticType, error) {
+	size, err := d.decoder.DecodeArrayHead()
+	if err != nil {
+		return nil, err
+	}
+
+	staticTypes := make([]StaticType, size)
+	for i := 0; i < int(size); i++ {
+		staticTypes[i], err = d.DecodeStaticType()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return staticTypes, nil
 }
 
 func (d TypeDecoder) decodeInterfaceStaticType() (InterfaceStaticType, error) {
@@ -1506,7 +1695,9 @@ func (d TypeDecoder) decodeCompositeTypeInfo() (atree.TypeInfo, error) {
 		return nil, err
 	}
 
-	if length != encodedCompositeTypeInfoLength {
+	if length != encodedCompositeTypeInfoLength &&
+		length != encodedCompositeTypeInfoWithTypeArgumentsLength {
+
 		return nil, errors.NewUnexpectedError(
 			"invalid composite type info: expected %d elements, got %d",
 			encodedCompositeTypeInfoLength, length,
@@ -1535,12 +1726,24 @@ func (d TypeDecoder) decodeCompositeTypeInfo() (atree.TypeInfo, error) {
 		)
 	}
 
-	return NewCompositeTypeInfo(
+	typeInfo := NewCompositeTypeInfo(
 		d.memoryGauge,
 		location,
 		qualifiedIdentifier,
 		common.CompositeKind(kind),
-	), nil
+	)
+
+	if length == encodedCompositeTypeInfoWithTypeArgumentsLength {
+		typeInfo.typeArguments, err = d.decodeStaticTypes()
+		if err != nil {
+			return nil, errors.NewUnexpectedError(
+				"invalid composite ordered map type info: invalid type arguments: %w",
+				err,
+			)
+		}
+	}
+
+	return typeInfo, nil
 }
 
 func DecodeTypeInfo(decoder *cbor.StreamDecoder, memoryGauge common.MemoryGauge) (atree.TypeInfo, error) {
@@ -1637,6 +1840,9 @@ func (d LocationDecoder) DecodeLocation() (common.Location, error) {
 	case CBORTagScriptLocation:
 		return d.decodeScriptLocation()
 
+	case CBORTagTestLocation:
+		return d.decodeTestLocation()
+
 	default:
 		return nil, errors.NewUnexpectedError("invalid location encoding tag: %d", number)
 	}
@@ -1657,6 +1863,21 @@ func (d LocationDecoder) decodeStringLocation() (common.Location, error) {
 	return common.NewStringLocation(d.memoryGauge, s), nil
 }
 
+func (d LocationDecoder) decodeTestLocation() (common.Location, error) {
+	s, err := decodeString(d.decoder, d.memoryGauge, common.MemoryKindRawString)
+	if err != nil {
+		if e, ok := err.(*cbor.WrongTypeError); ok {
+			return nil, errors.NewUnexpectedError(
+				"invalid test location encoding: %s",
+				e.ActualType.String(),
+			)
+		}
+		return nil, err
+	}
+
+	return common.NewTestLocation(d.memoryGauge, s), nil
+}
+
 func (d LocationDecoder) decodeIdentifierLocation() (common.Location, error) {
 	s, err := decodeString(d.decoder, d.memoryGauge, common.MemoryKindRawString)
 	if err != nil {
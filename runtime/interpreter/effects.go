@@ -0,0 +1,186 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"github.com/onflow/atree"
+)
+
+// EffectTracker tracks the effects of calls which are recovered from,
+// i.e. calls in try expressions.
+//
+// When a recoverable call fails, the effects it had so far must be rolled back.
+// Slabs which were allocated during the call are unreachable after the call failed,
+// and can be discarded.
+// Assignments to variables are recorded, and are undone when the call fails.
+// However, writes to slabs which already existed before the call started,
+// e.g. the mutation of a value in account storage, the emission of events,
+// or changes to accounts, cannot be undone,
+// so a failed call which had such effects cannot be recovered from.
+//
+// Recoverable calls may be nested, so the tracker maintains a stack of frames,
+// one for each active recoverable call.
+//
+type EffectTracker struct {
+	frames []*effectFrame
+}
+
+type effectFrame struct {
+	newStorageIDs  map[atree.StorageID]struct{}
+	variableWrites []variableWrite
+	hasEffects     bool
+}
+
+// variableWrite is the state of a variable before it was assigned to
+//
+type variableWrite struct {
+	variable *Variable
+	value    Value
+	getter   func() Value
+}
+
+// Start starts tracking the effects of a new recoverable call.
+//
+func (t *EffectTracker) Start() {
+	t.frames = append(
+		t.frames,
+		&effectFrame{
+			newStorageIDs: map[atree.StorageID]struct{}{},
+		},
+	)
+}
+
+// HasEffects returns true if the current recoverable call had effects which cannot be undone.
+//
+func (t *EffectTracker) HasEffects() bool {
+	return t.frames[len(t.frames)-1].hasEffects
+}
+
+// Stop stops tracking the effects of the current recoverable call.
+//
+// If rollback is true, the call failed and is recovered from,
+// so the assignments to variables in the call are undone.
+// Otherwise, the assignments become part of the enclosing call, if any,
+// so they are undone if the enclosing call fails.
+//
+func (t *EffectTracker) Stop(rollback bool) {
+	depth := len(t.frames)
+	frame := t.frames[depth-1]
+	t.frames[depth-1] = nil
+	t.frames = t.frames[:depth-1]
+
+	if rollback {
+		// Restore the variables in reverse order,
+		// so variables which were assigned multiple times get their original value
+
+		for i := len(frame.variableWrites) - 1; i >= 0; i-- {
+			write := frame.variableWrites[i]
+			write.variable.value = write.value
+			write.variable.getter = write.getter
+		}
+	}
+
+	if depth == 1 {
+		return
+	}
+
+	parent := t.frames[depth-2]
+
+	if !rollback {
+		parent.variableWrites = append(parent.variableWrites, frame.variableWrites...)
+	}
+
+	// The slabs allocated in the stopped call are also new for the enclosing call
+
+	for storageID := range frame.newStorageIDs { //nolint:maprangecheck
+		parent.newStorageIDs[storageID] = struct{}{}
+	}
+}
+
+// Tracking returns true if effects are currently tracked,
+// i.e. if a recoverable call is active.
+//
+func (t *EffectTracker) Tracking() bool {
+	return len(t.frames) > 0
+}
+
+// RecordStorageIDGeneration records that a slab with the given storage ID was allocated.
+//
+func (t *EffectTracker) RecordStorageIDGeneration(storageID atree.StorageID) {
+	depth := len(t.frames)
+	if depth == 0 {
+		return
+	}
+
+	t.frames[depth-1].newStorageIDs[storageID] = struct{}{}
+}
+
+// RecordVariableWrite records that the given variable is about to be assigned,
+// so the assignment can be undone if the current recoverable call fails.
+//
+func (t *EffectTracker) RecordVariableWrite(variable *Variable) {
+	depth := len(t.frames)
+	if depth == 0 {
+		return
+	}
+
+	frame := t.frames[depth-1]
+	frame.variableWrites = append(
+		frame.variableWrites,
+		variableWrite{
+			variable: variable,
+			value:    variable.value,
+			getter:   variable.getter,
+		},
+	)
+}
+
+// RecordStorageWrite records that the slab with the given storage ID was written or removed.
+//
+// The write is an effect for all active recoverable calls
+// which were started before the slab was allocated.
+//
+func (t *EffectTracker) RecordStorageWrite(storageID atree.StorageID) {
+	for i := len(t.frames) - 1; i >= 0; i-- {
+		frame := t.frames[i]
+		if _, ok := frame.newStorageIDs[storageID]; ok {
+			return
+		}
+		frame.hasEffects = true
+	}
+}
+
+// RecordEffect records an effect which cannot be undone,
+// e.g. the emission of an event.
+//
+func (t *EffectTracker) RecordEffect() {
+	for _, frame := range t.frames {
+		frame.hasEffects = true
+	}
+}
+
+// EffectTrackingStorage is a Storage which tracks the effects of recoverable calls.
+//
+// Failed calls in try expressions can only be recovered from
+// if the storage of the interpreter tracks effects.
+//
+type EffectTrackingStorage interface {
+	Storage
+	EffectTracker() *EffectTracker
+}
@@ -168,7 +168,7 @@ const (
 	_ // future: Fix16
 	_ // future: Fix32
 	CBORTagFix64Value
-	_ // future: Fix128
+	CBORTagFix128Value
 	_ // future: Fix256
 	_
 
@@ -178,7 +178,7 @@ const (
 	_ // future: UFix16
 	_ // future: UFix32
 	CBORTagUFix64Value
-	_ // future: UFix128
+	CBORTagUFix128Value
 	_ // future: UFix256
 	_
 
@@ -188,7 +188,7 @@ const (
 	CBORTagIdentifierLocation
 	CBORTagTransactionLocation
 	CBORTagScriptLocation
-	_
+	CBORTagTestLocation
 	_
 	_
 
@@ -198,7 +198,7 @@ const (
 	CBORTagCapabilityValue
 	_ // DO NOT REPLACE! used to be used for storage references
 	CBORTagLinkValue
-	_
+	CBORTagPublishedValue
 	_
 	_
 	_
@@ -630,6 +630,38 @@ func (v UFix64Value) Encode(e *atree.Encoder) error {
 	return e.CBOR.EncodeUint64(uint64(v))
 }
 
+// Encode encodes Fix128Value as
+// cbor.Tag{
+//		Number:  CBORTagFix128Value,
+//		Content: *big.Int(v.BigInt),
+// }
+func (v Fix128Value) Encode(e *atree.Encoder) error {
+	err := e.CBOR.EncodeRawBytes([]byte{
+		// tag number
+		0xd8, CBORTagFix128Value,
+	})
+	if err != nil {
+		return err
+	}
+	return e.CBOR.EncodeBigInt(v.BigInt)
+}
+
+// Encode encodes UFix128Value as
+// cbor.Tag{
+//		Number:  CBORTagUFix128Value,
+//		Content: *big.Int(v.BigInt),
+// }
+func (v UFix128Value) Encode(e *atree.Encoder) error {
+	err := e.CBOR.EncodeRawBytes([]byte{
+		// tag number
+		0xd8, CBORTagUFix128Value,
+	})
+	if err != nil {
+		return err
+	}
+	return e.CBOR.EncodeBigInt(v.BigInt)
+}
+
 // Encode encodes SomeStorable as
 // cbor.Tag{
 //		Number: CBORTagSomeValue,
@@ -710,12 +742,18 @@ const (
 	// encodedCapabilityValueAddressFieldKey    uint64 = 0
 	// encodedCapabilityValuePathFieldKey       uint64 = 1
 	// encodedCapabilityValueBorrowTypeFieldKey uint64 = 2
+	// encodedCapabilityValueIDFieldKey         uint64 = 3
 
 	// !!! *WARNING* !!!
 	//
 	// encodedCapabilityValueLength MUST be updated when new element is added.
 	// It is used to verify encoded capability length during decoding.
-	encodedCapabilityValueLength = 3
+	encodedCapabilityValueLength = 4
+
+	// encodedPathCapabilityValueLength is the length of encoded path capabilities,
+	// which have no ID. Path capabilities are encoded without the ID element,
+	// so their encoding stays unchanged.
+	encodedPathCapabilityValueLength = 3
 )
 
 // Encode encodes CapabilityStorable as
@@ -725,15 +763,23 @@ const (
 //					encodedCapabilityValueAddressFieldKey:    AddressValue(v.Address),
 // 					encodedCapabilityValuePathFieldKey:       PathValue(v.Path),
 // 					encodedCapabilityValueBorrowTypeFieldKey: StaticType(v.BorrowType),
+// 					encodedCapabilityValueIDFieldKey:         uint64(v.ID), // only for ID capabilities
 // 				},
 // }
 func (v *CapabilityValue) Encode(e *atree.Encoder) error {
 	// Encode tag number and array head
+	var arrayHead byte
+	if v.IsIDCapability() {
+		// array, 4 items follow
+		arrayHead = 0x84
+	} else {
+		// array, 3 items follow
+		arrayHead = 0x83
+	}
 	err := e.CBOR.EncodeRawBytes([]byte{
 		// tag number
 		0xd8, CBORTagCapabilityValue,
-		// array, 3 items follow
-		0x83,
+		arrayHead,
 	})
 	if err != nil {
 		return err
@@ -752,7 +798,17 @@ func (v *CapabilityValue) Encode(e *atree.Encoder) error {
 	}
 
 	// Encode borrow type at array index encodedCapabilityValueBorrowTypeFieldKey
-	return EncodeStaticType(e.CBOR, v.BorrowType)
+	err = EncodeStaticType(e.CBOR, v.BorrowType)
+	if err != nil {
+		return err
+	}
+
+	if !v.IsIDCapability() {
+		return nil
+	}
+
+	// Encode ID at array index encodedCapabilityValueIDFieldKey
+	return e.CBOR.EncodeUint64(uint64(v.ID))
 }
 
 // NOTE: NEVER change, only add/increment; ensure uint64
@@ -869,6 +925,22 @@ func encodeLocation(e *cbor.StreamEncoder, l common.Location) error {
 
 		return e.EncodeBytes(l[:])
 
+	case common.TestLocation:
+		// common.TestLocation is encoded as
+		// cbor.Tag{
+		//		Number:  CBORTagTestLocation,
+		//		Content: string(l),
+		// }
+		err := e.EncodeRawBytes([]byte{
+			// tag number
+			0xd8, CBORTagTestLocation,
+		})
+		if err != nil {
+			return err
+		}
+
+		return e.EncodeString(string(l))
+
 	default:
 		return errors.NewUnexpectedError("unsupported location: %T", l)
 	}
@@ -914,6 +986,46 @@ func (v LinkValue) Encode(e *atree.Encoder) error {
 	return EncodeStaticType(e.CBOR, v.Type)
 }
 
+// NOTE: NEVER change, only add/increment; ensure uint64
+const (
+	// encodedPublishedValueRecipientFieldKey uint64 = 0
+	// encodedPublishedValueValueFieldKey     uint64 = 1
+
+	// !!! *WARNING* !!!
+	//
+	// encodedPublishedValueLength MUST be updated when new element is added.
+	// It is used to verify encoded published value length during decoding.
+	encodedPublishedValueLength = 2
+)
+
+// Encode encodes PublishedValue as
+// cbor.Tag{
+//			Number: CBORTagPublishedValue,
+//			Content: []any{
+//				encodedPublishedValueRecipientFieldKey: AddressValue(v.Recipient),
+//				encodedPublishedValueValueFieldKey:     CapabilityValue(v.Value),
+//			},
+// }
+func (v *PublishedValue) Encode(e *atree.Encoder) error {
+	// Encode tag number and array head
+	err := e.CBOR.EncodeRawBytes([]byte{
+		// tag number
+		0xd8, CBORTagPublishedValue,
+		// array, 2 items follow
+		0x82,
+	})
+	if err != nil {
+		return err
+	}
+	// Encode recipient at array index encodedPublishedValueRecipientFieldKey
+	err = v.Recipient.Encode(e)
+	if err != nil {
+		return err
+	}
+	// Encode value at array index encodedPublishedValueValueFieldKey
+	return v.Value.Encode(e)
+}
+
 // NOTE: NEVER change, only add/increment; ensure uint64
 const (
 	// encodedTypeValueTypeFieldKey uint64 = 0
@@ -1009,12 +1121,18 @@ func (t OptionalStaticType) Encode(e *cbor.StreamEncoder) error {
 const (
 	// encodedCompositeStaticTypeLocationFieldKey            uint64 = 0
 	// encodedCompositeStaticTypeQualifiedIdentifierFieldKey uint64 = 1
+	// encodedCompositeStaticTypeTypeArgumentsFieldKey       uint64 = 2
 
 	// !!! *WARNING* !!!
 	//
 	// encodedCompositeStaticTypeLength MUST be updated when new element is added.
 	// It is used to verify encoded composite static type length during decoding.
 	encodedCompositeStaticTypeLength = 2
+
+	// encodedCompositeStaticTypeWithTypeArgumentsLength is the length
+	// of the encoding of an instantiation of a generic composite type,
+	// which has the additional type arguments element
+	encodedCompositeStaticTypeWithTypeArgumentsLength = 3
 )
 
 // Encode encodes CompositeStaticType as
@@ -1023,15 +1141,27 @@ const (
 // 			Content: cborArray{
 //				encodedCompositeStaticTypeLocationFieldKey:            Location(v.Location),
 //				encodedCompositeStaticTypeQualifiedIdentifierFieldKey: string(v.QualifiedIdentifier),
+//				encodedCompositeStaticTypeTypeArgumentsFieldKey:       []StaticType(v.TypeArguments()),
 //		},
 // }
+//
+// The type arguments are only encoded for instantiations of generic composite types.
+//
 func (t CompositeStaticType) Encode(e *cbor.StreamEncoder) error {
+	typeArguments := t.TypeArguments()
+
+	// array, 2 items follow
+	arrayHead := byte(0x82)
+	if len(typeArguments) > 0 {
+		// array, 3 items follow
+		arrayHead = 0x83
+	}
+
 	// Encode tag number and array head
 	err := e.EncodeRawBytes([]byte{
 		// tag number
 		0xd8, CBORTagCompositeStaticType,
-		// array, 2 items follow
-		0x82,
+		arrayHead,
 	})
 	if err != nil {
 		return err
@@ -1044,7 +1174,31 @@ func (t CompositeStaticType) Encode(e *cbor.StreamEncoder) error {
 	}
 
 	// Encode qualified identifier at array index encodedCompositeStaticTypeQualifiedIdentifierFieldKey
-	return e.EncodeString(t.QualifiedIdentifier)
+	err = e.EncodeString(t.QualifiedIdentifier)
+	if err != nil {
+		return err
+	}
+
+	if len(typeArguments) == 0 {
+		return nil
+	}
+
+	// Encode type arguments (as array) at array index encodedCompositeStaticTypeTypeArgumentsFieldKey
+	return encodeStaticTypes(e, typeArguments)
+}
+
+func encodeStaticTypes(e *cbor.StreamEncoder, staticTypes []StaticType) error {
+	err := e.EncodeArrayHead(uint64(len(staticTypes)))
+	if err != nil {
+		return err
+	}
+	for _, staticType := range staticTypes {
+		err = EncodeStaticType(e, staticType)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // NOTE: NEVER change, only add/increment; ensure uint64
@@ -1298,12 +1452,26 @@ func (t FunctionStaticType) Encode(_ *cbor.StreamEncoder) error {
 	}
 }
 
+func (t RangeStaticType) Encode(_ *cbor.StreamEncoder) error {
+	return NonStorableStaticTypeError{
+		Type: t,
+	}
+}
+
+func (t *TupleStaticType) Encode(_ *cbor.StreamEncoder) error {
+	return NonStorableStaticTypeError{
+		Type: t,
+	}
+}
+
 // compositeTypeInfo
 //
 type compositeTypeInfo struct {
 	location            common.Location
 	qualifiedIdentifier string
 	kind                common.CompositeKind
+	// typeArguments are only set for instantiations of generic composite types
+	typeArguments []StaticType
 }
 
 func NewCompositeTypeInfo(
@@ -1325,12 +1493,23 @@ var _ atree.TypeInfo = compositeTypeInfo{}
 
 const encodedCompositeTypeInfoLength = 3
 
+// encodedCompositeTypeInfoWithTypeArgumentsLength is the length of the encoding
+// of the type info of an instantiation of a generic composite type,
+// which has the additional type arguments element
+const encodedCompositeTypeInfoWithTypeArgumentsLength = 4
+
 func (c compositeTypeInfo) Encode(e *cbor.StreamEncoder) error {
+	// array, 3 items follow
+	arrayHead := byte(0x83)
+	if len(c.typeArguments) > 0 {
+		// array, 4 items follow
+		arrayHead = 0x84
+	}
+
 	err := e.EncodeRawBytes([]byte{
 		// tag number
 		0xd8, CBORTagCompositeValue,
-		// array, 3 items follow
-		0x83,
+		arrayHead,
 	})
 	if err != nil {
 		return err
@@ -1351,15 +1530,31 @@ func (c compositeTypeInfo) Encode(e *cbor.StreamEncoder) error {
 		return err
 	}
 
-	return nil
+	if len(c.typeArguments) == 0 {
+		return nil
+	}
+
+	return encodeStaticTypes(e, c.typeArguments)
 }
 
 func (c compositeTypeInfo) Equal(o atree.TypeInfo) bool {
 	other, ok := o.(compositeTypeInfo)
-	return ok &&
-		c.location == other.location &&
-		c.qualifiedIdentifier == other.qualifiedIdentifier &&
-		c.kind == other.kind
+	if !ok ||
+		c.location != other.location ||
+		c.qualifiedIdentifier != other.qualifiedIdentifier ||
+		c.kind != other.kind ||
+		len(c.typeArguments) != len(other.typeArguments) {
+
+		return false
+	}
+
+	for i, typeArgument := range c.typeArguments {
+		if !typeArgument.Equal(other.typeArguments[i]) {
+			return false
+		}
+	}
+
+	return true
 }
 
 // EmptyTypeInfo
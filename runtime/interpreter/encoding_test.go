@@ -2966,6 +2966,51 @@ func TestEncodeDecodeCapabilityValue(t *testing.T) {
 		)
 	})
 
+	t.Run("ID capability", func(t *testing.T) {
+
+		t.Parallel()
+
+		value := &CapabilityValue{
+			Address:    NewUnmeteredAddressValueFromBytes([]byte{0x2}),
+			BorrowType: PrimitiveStaticTypeBool,
+			ID:         4,
+		}
+
+		encoded := []byte{
+			// tag
+			0xd8, CBORTagCapabilityValue,
+			// array, 4 items follow
+			0x84,
+			// tag for address
+			0xd8, CBORTagAddressValue,
+			// byte sequence, length 1
+			0x41,
+			// address
+			0x02,
+			// tag for path
+			0xd8, CBORTagPathValue,
+			// array, 2 items follow
+			0x82,
+			// positive integer 0
+			0x0,
+			// UTF-8 string, length 0
+			0x60,
+			// tag
+			0xd8, CBORTagPrimitiveStaticType,
+			// bool
+			0x6,
+			// positive integer 4
+			0x4,
+		}
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value:   value,
+				encoded: encoded,
+			},
+		)
+	})
+
 	t.Run("public path, untyped capability, new format", func(t *testing.T) {
 
 		t.Parallel()
@@ -3346,6 +3391,45 @@ func TestEncodeDecodeLinkValue(t *testing.T) {
 		)
 	})
 
+	t.Run("interface, struct, test location", func(t *testing.T) {
+
+		t.Parallel()
+
+		value := LinkValue{
+			TargetPath: publicPathValue,
+			Type: InterfaceStaticType{
+				Location:            common.TestLocation("test"),
+				QualifiedIdentifier: "SimpleInterface",
+			},
+		}
+
+		//nolint:gocritic
+		encoded := append(
+			expectedLinkEncodingPrefix[:],
+			// tag
+			0xd8, CBORTagInterfaceStaticType,
+			// array, 2 items follow
+			0x82,
+			// tag
+			0xd8, CBORTagTestLocation,
+			// UTF-8 string, length 4
+			0x64,
+			// t, e, s, t
+			0x74, 0x65, 0x73, 0x74,
+			// UTF-8 string, length 15
+			0x6F,
+			// SimpleInterface
+			0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+		)
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value:   value,
+				encoded: encoded,
+			},
+		)
+	})
+
 	t.Run("variable-sized, bool", func(t *testing.T) {
 
 		t.Parallel()
@@ -3686,6 +3770,99 @@ func TestEncodeDecodeLinkValue(t *testing.T) {
 	})
 }
 
+func TestEncodeDecodePublishedValue(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("private path, typed capability", func(t *testing.T) {
+
+		t.Parallel()
+
+		value := &PublishedValue{
+			Recipient: NewUnmeteredAddressValueFromBytes([]byte{0x3}),
+			Value: &CapabilityValue{
+				Address:    NewUnmeteredAddressValueFromBytes([]byte{0x2}),
+				Path:       privatePathValue,
+				BorrowType: PrimitiveStaticTypeBool,
+			},
+		}
+
+		encoded := []byte{
+			// tag
+			0xd8, CBORTagPublishedValue,
+			// array, 2 items follow
+			0x82,
+			// tag for address
+			0xd8, CBORTagAddressValue,
+			// byte sequence, length 1
+			0x41,
+			// address
+			0x03,
+			// tag
+			0xd8, CBORTagCapabilityValue,
+			// array, 3 items follow
+			0x83,
+			// tag for address
+			0xd8, CBORTagAddressValue,
+			// byte sequence, length 1
+			0x41,
+			// address
+			0x02,
+			// tag for path
+			0xd8, CBORTagPathValue,
+			// array, 2 items follow
+			0x82,
+			// positive integer 2
+			0x2,
+			// UTF-8 string, length 3
+			0x63,
+			// f, o, o
+			0x66, 0x6f, 0x6f,
+			// tag for borrow type
+			0xd8, CBORTagPrimitiveStaticType,
+			// bool
+			0x6,
+		}
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value:   value,
+				encoded: encoded,
+			},
+		)
+	})
+
+	t.Run("invalid recipient", func(t *testing.T) {
+
+		t.Parallel()
+
+		encoded := []byte{
+			// tag
+			0xd8, CBORTagPublishedValue,
+			// array, 2 items follow
+			0x82,
+			// tag for path, instead of address
+			0xd8, CBORTagPathValue,
+			// array, 2 items follow
+			0x82,
+			// positive integer 2
+			0x2,
+			// UTF-8 string, length 3
+			0x63,
+			// f, o, o
+			0x66, 0x6f, 0x6f,
+		}
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				encoded:    encoded,
+				invalid:    true,
+				decodeOnly: true,
+			},
+		)
+	})
+}
+
 func TestEncodeDecodeTypeValue(t *testing.T) {
 
 	t.Parallel()
@@ -3841,6 +4018,111 @@ func TestEncodeDecodeStaticType(t *testing.T) {
 
 		require.Equal(t, ty, actualType)
 	})
+
+	t.Run("composite, struct, type arguments", func(t *testing.T) {
+
+		t.Parallel()
+
+		ty := NewCompositeStaticTypeComputeTypeID(nil, utils.TestLocation, "Box").
+			WithTypeArguments([]StaticType{
+				PrimitiveStaticTypeBool,
+			})
+
+		encoded := cbor.RawMessage{
+			// tag
+			0xd8, CBORTagCompositeStaticType,
+			// array, 3 items follow
+			0x83,
+			// tag
+			0xd8, CBORTagStringLocation,
+			// UTF-8 string, length 4
+			0x64,
+			// t, e, s, t
+			0x74, 0x65, 0x73, 0x74,
+			// UTF-8 string, length 3
+			0x63,
+			// Box
+			0x42, 0x6f, 0x78,
+			// array, 1 item follows
+			0x81,
+			// tag
+			0xd8, CBORTagPrimitiveStaticType,
+			// bool
+			0x6,
+		}
+
+		actualEncoded, err := StaticTypeToBytes(ty)
+		require.NoError(t, err)
+
+		AssertEqualWithDiff(t, encoded, actualEncoded)
+
+		actualType, err := staticTypeFromBytes(encoded)
+		require.NoError(t, err)
+
+		require.Equal(t, ty, actualType)
+	})
+}
+
+func TestDecodeStorableLazily(t *testing.T) {
+
+	t.Parallel()
+
+	decode := func(t *testing.T, value Value) (storable atree.Storable, encoded []byte) {
+		storage := newUnmeteredInMemoryStorage()
+
+		eagerStorable, err := value.Storable(storage, atree.Address(testOwner), math.MaxUint64)
+		require.NoError(t, err)
+
+		encoded, err = atree.Encode(eagerStorable, CBOREncMode)
+		require.NoError(t, err)
+
+		decoder := CBORDecMode.NewByteStreamDecoder(encoded)
+		storable, err = DecodeStorableLazily(decoder, atree.StorageIDUndefined, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, eagerStorable.ByteSize(), storable.ByteSize())
+
+		inter, err := NewInterpreter(nil, TestLocation, WithStorage(storage))
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, value, StoredValue(inter, storable, storage))
+
+		return storable, encoded
+	}
+
+	t.Run("type value", func(t *testing.T) {
+
+		t.Parallel()
+
+		value := TypeValue{
+			Type: NewCompositeStaticTypeComputeTypeID(nil, utils.TestLocation, "S"),
+		}
+
+		storable, encoded := decode(t, value)
+
+		// The type value is decoded lazily,
+		// and is encoded again without being decoded
+
+		assert.NotEqual(t, value, storable)
+
+		reencoded, err := atree.Encode(storable, CBOREncMode)
+		require.NoError(t, err)
+
+		AssertEqualWithDiff(t, encoded, reencoded)
+	})
+
+	t.Run("Int value", func(t *testing.T) {
+
+		t.Parallel()
+
+		value := NewUnmeteredIntValueFromInt64(42)
+
+		storable, _ := decode(t, value)
+
+		// Integers are cheap to decode, so they are decoded eagerly
+
+		assert.Equal(t, value, storable)
+	})
 }
 
 func TestCBORTagValue(t *testing.T) {
@@ -87,6 +87,41 @@ func (e Error) ImportLocation() common.Location {
 	return e.Location
 }
 
+// CallStack returns the Cadence stack trace of the error,
+// i.e. the frames of the interpreted functions which were being executed
+// when the error occurred, starting with the innermost frame.
+//
+func (e Error) CallStack() []StackFrame {
+	var line int
+	if positioned, ok := e.Err.(ast.HasPosition); ok {
+		line = positioned.StartPosition().Line
+	}
+
+	return StackFrames(e.StackTrace, line)
+}
+
+// StackFrame is a frame of a Cadence stack trace, see StackFrames
+//
+type StackFrame struct {
+	// FunctionName is the name of the function.
+	// It is empty for anonymous functions
+	FunctionName string
+	// Location is the location of the program the function is declared in
+	Location common.Location
+	// Line is the line in the function which was being executed,
+	// or 0 if it is unknown
+	Line int
+}
+
+func (f StackFrame) String() string {
+	functionName := f.FunctionName
+	if functionName == "" {
+		functionName = "<anonymous>"
+	}
+
+	return fmt.Sprintf("%s (%s:%d)", functionName, f.Location, f.Line)
+}
+
 type StackTraceError struct {
 	LocationRange
 }
@@ -308,6 +343,61 @@ func (e DestroyedResourceError) Error() string {
 	return "resource was destroyed and cannot be used anymore"
 }
 
+// ResourceLossError is the error which is reported if resource loss diagnostics are enabled,
+// and an error aborts the evaluation of an expression while a resource is held in a temporary,
+// e.g. when the evaluation of an argument fails after a preceding argument evaluated to a resource.
+//
+// The location range is the range of the expression which evaluated to the lost resource.
+// Err is the error which caused the loss.
+//
+type ResourceLossError struct {
+	Err     error
+	TypeID  common.TypeID
+	UUID    uint64
+	HasUUID bool
+	LocationRange
+}
+
+var _ errors.UserError = ResourceLossError{}
+
+func (ResourceLossError) IsUserError() {}
+
+func (e ResourceLossError) Unwrap() error {
+	return e.Err
+}
+
+func (e ResourceLossError) Error() string {
+	if !e.HasUUID {
+		return fmt.Sprintf(
+			"loss of resource of type `%s`: %s",
+			e.TypeID,
+			e.Err.Error(),
+		)
+	}
+
+	return fmt.Sprintf(
+		"loss of resource of type `%s` with UUID %d: %s",
+		e.TypeID,
+		e.UUID,
+		e.Err.Error(),
+	)
+}
+
+// InvalidatedReferenceError is the error which is reported
+// when a user uses a reference to a resource that was moved
+//
+type InvalidatedReferenceError struct {
+	LocationRange
+}
+
+var _ errors.UserError = InvalidatedReferenceError{}
+
+func (InvalidatedReferenceError) IsUserError() {}
+
+func (e InvalidatedReferenceError) Error() string {
+	return "reference is invalidated: the referenced resource was moved"
+}
+
 // ForceAssignmentToNonNilResourceError
 //
 type ForceAssignmentToNonNilResourceError struct {
@@ -322,6 +412,24 @@ func (e ForceAssignmentToNonNilResourceError) Error() string {
 	return "force assignment to non-nil resource-typed value"
 }
 
+// CallStackDepthLimitExceededError
+//
+type CallStackDepthLimitExceededError struct {
+	Limit uint64
+	LocationRange
+}
+
+var _ errors.UserError = CallStackDepthLimitExceededError{}
+
+func (CallStackDepthLimitExceededError) IsUserError() {}
+
+func (e CallStackDepthLimitExceededError) Error() string {
+	return fmt.Sprintf(
+		"call stack depth limit exceeded: %d",
+		e.Limit,
+	)
+}
+
 // ForceNilError
 //
 type ForceNilError struct {
@@ -340,6 +448,7 @@ func (e ForceNilError) Error() string {
 //
 type ForceCastTypeMismatchError struct {
 	ExpectedType sema.Type
+	ActualType   sema.Type
 	LocationRange
 }
 
@@ -348,9 +457,17 @@ var _ errors.UserError = ForceCastTypeMismatchError{}
 func (ForceCastTypeMismatchError) IsUserError() {}
 
 func (e ForceCastTypeMismatchError) Error() string {
+	if e.ActualType == nil {
+		return fmt.Sprintf(
+			"unexpectedly found non-`%s` while force-casting value",
+			e.ExpectedType.QualifiedString(),
+		)
+	}
+
 	return fmt.Sprintf(
-		"unexpectedly found non-`%s` while force-casting value",
+		"unexpectedly found non-`%s` while force-casting value: got `%s`",
 		e.ExpectedType.QualifiedString(),
+		e.ActualType.QualifiedString(),
 	)
 }
 
@@ -372,6 +489,29 @@ func (e TypeMismatchError) Error() string {
 	)
 }
 
+// InvalidAddressError
+
+type InvalidAddressError struct {
+	Address common.Address
+	Err     error
+}
+
+var _ errors.UserError = InvalidAddressError{}
+
+func (InvalidAddressError) IsUserError() {}
+
+func (e InvalidAddressError) Error() string {
+	return fmt.Sprintf(
+		"invalid address `%s`: %s",
+		e.Address.HexWithPrefix(),
+		e.Err,
+	)
+}
+
+func (e InvalidAddressError) Unwrap() error {
+	return e.Err
+}
+
 // InvalidPathDomainError
 //
 type InvalidPathDomainError struct {
@@ -424,6 +564,84 @@ func (e OverwriteError) Error() string {
 	)
 }
 
+// CapabilityPublishingOverwriteError
+//
+type CapabilityPublishingOverwriteError struct {
+	Address AddressValue
+	Path    PathValue
+	LocationRange
+}
+
+var _ errors.UserError = CapabilityPublishingOverwriteError{}
+
+func (CapabilityPublishingOverwriteError) IsUserError() {}
+
+func (e CapabilityPublishingOverwriteError) Error() string {
+	return fmt.Sprintf(
+		"failed to publish capability: path %s in account %s is already in use",
+		e.Path,
+		e.Address,
+	)
+}
+
+// CapabilityAddressPublishingError
+//
+type CapabilityAddressPublishingError struct {
+	CapabilityAddress AddressValue
+	AccountAddress    AddressValue
+	LocationRange
+}
+
+var _ errors.UserError = CapabilityAddressPublishingError{}
+
+func (CapabilityAddressPublishingError) IsUserError() {}
+
+func (e CapabilityAddressPublishingError) Error() string {
+	return fmt.Sprintf(
+		"failed to publish capability: capability of account %s cannot be published in account %s",
+		e.CapabilityAddress,
+		e.AccountAddress,
+	)
+}
+
+// PathCapabilityPublishingError
+//
+type PathCapabilityPublishingError struct {
+	Path PathValue
+	LocationRange
+}
+
+var _ errors.UserError = PathCapabilityPublishingError{}
+
+func (PathCapabilityPublishingError) IsUserError() {}
+
+func (e PathCapabilityPublishingError) Error() string {
+	return fmt.Sprintf(
+		"failed to publish capability: capability for path %s was not issued by a capability controller",
+		e.Path,
+	)
+}
+
+// DeletedCapabilityControllerError
+//
+type DeletedCapabilityControllerError struct {
+	Address      AddressValue
+	CapabilityID UInt64Value
+	LocationRange
+}
+
+var _ errors.UserError = DeletedCapabilityControllerError{}
+
+func (DeletedCapabilityControllerError) IsUserError() {}
+
+func (e DeletedCapabilityControllerError) Error() string {
+	return fmt.Sprintf(
+		"capability controller for capability %s in account %s was deleted",
+		e.CapabilityID,
+		e.Address,
+	)
+}
+
 // CyclicLinkError
 //
 type CyclicLinkError struct {
@@ -701,6 +919,20 @@ func (e ContainerMutationError) Error() string {
 	)
 }
 
+// ContainerMutatedDuringIterationError
+//
+type ContainerMutatedDuringIterationError struct {
+	LocationRange
+}
+
+var _ errors.UserError = ContainerMutatedDuringIterationError{}
+
+func (ContainerMutatedDuringIterationError) IsUserError() {}
+
+func (ContainerMutatedDuringIterationError) Error() string {
+	return "invalid container update: container is mutated while being iterated"
+}
+
 // NonStorableValueError
 //
 type NonStorableValueError struct {
@@ -825,3 +1057,23 @@ func (DuplicateKeyInResourceDictionaryError) IsUserError() {}
 func (e DuplicateKeyInResourceDictionaryError) Error() string {
 	return "duplicate key in resource dictionary"
 }
+
+// DuplicateAttachmentError
+//
+type DuplicateAttachmentError struct {
+	AttachmentType *sema.CompositeType
+	Value          *CompositeValue
+	LocationRange
+}
+
+var _ errors.UserError = DuplicateAttachmentError{}
+
+func (DuplicateAttachmentError) IsUserError() {}
+
+func (e DuplicateAttachmentError) Error() string {
+	return fmt.Sprintf(
+		"cannot attach %s to %s: value already has an attachment of this type",
+		e.AttachmentType.QualifiedString(),
+		e.Value.QualifiedIdentifier,
+	)
+}
@@ -52,6 +52,11 @@ type InterpretedFunctionValue struct {
 	PreConditions    ast.Conditions
 	Statements       []ast.Statement
 	PostConditions   ast.Conditions
+	// Name is the name of the function, used in stack traces.
+	// It is empty for anonymous functions, i.e. function expressions
+	Name string
+	// CompositeType is the type of the composite the function is declared in, if any
+	CompositeType *sema.CompositeType
 }
 
 func NewInterpretedFunctionValue(
@@ -415,3 +420,17 @@ func (f BoundFunctionValue) Clone(_ *Interpreter) Value {
 func (BoundFunctionValue) DeepRemove(_ *Interpreter) {
 	// NO-OP
 }
+
+// isHostFunction returns true if the given function is a host function,
+// or a host function bound to a value
+//
+func isHostFunction(function FunctionValue) bool {
+	switch function := function.(type) {
+	case *HostFunctionValue:
+		return true
+	case BoundFunctionValue:
+		return isHostFunction(function.Function)
+	default:
+		return false
+	}
+}
@@ -19,16 +19,20 @@
 package interpreter
 
 import (
-	"encoding/hex"
+	"encoding/base64"
 	goErrors "errors"
 	"fmt"
 	"math"
+	"math/big"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/fxamacker/cbor/v2"
 	"github.com/onflow/atree"
 	"github.com/opentracing/opentracing-go"
 
+	"github.com/onflow/cadence/fixedpoint"
 	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/errors"
@@ -39,14 +43,38 @@ type controlReturn interface {
 	isControlReturn()
 }
 
-type controlBreak struct{}
+// controlBreak is the result of a break statement.
+// If the label is empty, the break applies to the innermost loop or switch,
+// otherwise it applies to the loop with the given label
+//
+type controlBreak struct {
+	Label string
+}
 
 func (controlBreak) isControlReturn() {}
 
-type controlContinue struct{}
+// appliesToLoop returns true if the break applies to the loop with the given label
+//
+func (b controlBreak) appliesToLoop(label string) bool {
+	return b.Label == "" || b.Label == label
+}
+
+// controlContinue is the result of a continue statement.
+// If the label is empty, the continue applies to the innermost loop,
+// otherwise it applies to the loop with the given label
+//
+type controlContinue struct {
+	Label string
+}
 
 func (controlContinue) isControlReturn() {}
 
+// appliesToLoop returns true if the continue applies to the loop with the given label
+//
+func (c controlContinue) appliesToLoop(label string) bool {
+	return c.Label == "" || c.Label == label
+}
+
 type functionReturn struct {
 	Value Value
 }
@@ -116,6 +144,52 @@ type OnInvokedFunctionReturnFunc func(
 	line int,
 )
 
+// OnHostFunctionInvocationFunc is a function that is triggered when a host function is about to be invoked.
+// The returned function, if any, is triggered when the invoked host function returned,
+// also if the invocation failed.
+//
+type OnHostFunctionInvocationFunc func(
+	inter *Interpreter,
+	functionName string,
+) (
+	onReturn func(),
+)
+
+// OnStorageReadFunc is a function that is triggered when a value is read from storage,
+// or when the existence of a value in storage is checked.
+// The identifier is empty if the whole storage domain is read, e.g. when iterating over it.
+//
+type OnStorageReadFunc func(
+	inter *Interpreter,
+	address common.Address,
+	domain string,
+	identifier string,
+)
+
+// OnStorageWriteFunc is a function that is triggered when a value is about to be written to storage,
+// including when a value is removed from storage.
+//
+type OnStorageWriteFunc func(
+	inter *Interpreter,
+	address common.Address,
+	domain string,
+	identifier string,
+)
+
+// OnResourceCreatedFunc is a function that is triggered when a resource is created.
+//
+type OnResourceCreatedFunc func(
+	inter *Interpreter,
+	resource *CompositeValue,
+)
+
+// OnResourceDestroyedFunc is a function that is triggered when a resource is about to be destroyed.
+//
+type OnResourceDestroyedFunc func(
+	inter *Interpreter,
+	resource *CompositeValue,
+)
+
 // OnRecordTraceFunc is a function thats records a trace.
 type OnRecordTraceFunc func(
 	inter *Interpreter,
@@ -256,6 +330,23 @@ type HashHandlerFunc func(
 	hashAlgorithm MemberAccessibleValue,
 ) *ArrayValue
 
+// AccountProofHandlerFunc is a function that verifies an account proof.
+// Parameter types:
+// - address: Address
+// - message: [UInt8]
+// - signatures: [[UInt8]]
+// - keyIndices: [Int]
+// Expected result type: Bool
+//
+type AccountProofHandlerFunc func(
+	inter *Interpreter,
+	getLocationRange func() LocationRange,
+	address AddressValue,
+	message *ArrayValue,
+	signatures *ArrayValue,
+	keyIndices *ArrayValue,
+) BoolValue
+
 // ExitHandlerFunc is a function that is called at the end of execution
 type ExitHandlerFunc func() error
 
@@ -318,8 +409,25 @@ type Storage interface {
 	CheckHealth() error
 }
 
+// StorageMapPreloadingStorage is a Storage which can read
+// the registers of the storage maps of several domains of an account at once.
+//
+type StorageMapPreloadingStorage interface {
+	Storage
+	PreloadStorageMaps(address common.Address, domains []string)
+}
+
 type ReferencedResourceKindedValues map[atree.StorageID]map[ReferenceTrackedResourceKindedValue]struct{}
 
+// ResourceReferences is the set of ephemeral references to resources,
+// grouped by the storage ID of the referenced resource.
+// The references are invalidated when the resource is moved
+type ResourceReferences map[atree.StorageID]map[*EphemeralReferenceValue]struct{}
+
+// IteratedContainers is the set of containers which are currently being iterated,
+// and which therefore must not be mutated
+type IteratedContainers map[atree.StorageID]struct{}
+
 type Interpreter struct {
 	Program                        *Program
 	Location                       common.Location
@@ -336,6 +444,11 @@ type Interpreter struct {
 	onLoopIteration                OnLoopIterationFunc
 	onFunctionInvocation           OnFunctionInvocationFunc
 	onInvokedFunctionReturn        OnInvokedFunctionReturnFunc
+	onHostFunctionInvocation       OnHostFunctionInvocationFunc
+	onStorageRead                  OnStorageReadFunc
+	onStorageWrite                 OnStorageWriteFunc
+	onResourceCreated              OnResourceCreatedFunc
+	onResourceDestroyed            OnResourceDestroyedFunc
 	onRecordTrace                  OnRecordTraceFunc
 	onResourceOwnerChange          OnResourceOwnerChangeFunc
 	onMeterComputation             OnMeterComputationFunc
@@ -350,6 +463,8 @@ type Interpreter struct {
 	BLSAggregateSignaturesHandler  BLSAggregateSignaturesHandlerFunc
 	BLSAggregatePublicKeysHandler  BLSAggregatePublicKeysHandlerFunc
 	HashHandler                    HashHandlerFunc
+	AccountProofHandler            AccountProofHandlerFunc
+	addressValidator               common.AddressValidator
 	ExitHandler                    ExitHandlerFunc
 	interpreted                    bool
 	statement                      ast.Statement
@@ -359,10 +474,17 @@ type Interpreter struct {
 	tracingEnabled                 bool
 	// TODO: ideally this would be a weak map, but Go has no weak references
 	referencedResourceKindedValues       ReferencedResourceKindedValues
+	resourceReferences                   ResourceReferences
+	iteratedContainers                   IteratedContainers
 	invalidatedResourceValidationEnabled bool
 	resourceVariables                    map[ResourceKindedValue]*Variable
+	resourceLossDiagnosticsEnabled       bool
 	memoryGauge                          common.MemoryGauge
 	CallStack                            *CallStack
+	callStackDepthLimit                  uint64
+	// memberInlineCaches maps member expressions to the composite type
+	// for which the accessed member was last resolved to a function
+	memberInlineCaches map[ast.NodeID]common.TypeID
 }
 
 var _ common.MemoryGauge = &Interpreter{}
@@ -419,6 +541,56 @@ func WithOnInvokedFunctionReturnHandler(handler OnInvokedFunctionReturnFunc) Opt
 	}
 }
 
+// WithOnHostFunctionInvocationHandler returns an interpreter option which sets
+// the given function as the host function invocation handler.
+//
+func WithOnHostFunctionInvocationHandler(handler OnHostFunctionInvocationFunc) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.SetOnHostFunctionInvocationHandler(handler)
+		return nil
+	}
+}
+
+// WithOnStorageReadHandler returns an interpreter option which sets
+// the given function as the storage read handler.
+//
+func WithOnStorageReadHandler(handler OnStorageReadFunc) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.SetOnStorageReadHandler(handler)
+		return nil
+	}
+}
+
+// WithOnStorageWriteHandler returns an interpreter option which sets
+// the given function as the storage write handler.
+//
+func WithOnStorageWriteHandler(handler OnStorageWriteFunc) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.SetOnStorageWriteHandler(handler)
+		return nil
+	}
+}
+
+// WithOnResourceCreatedHandler returns an interpreter option which sets
+// the given function as the resource creation handler.
+//
+func WithOnResourceCreatedHandler(handler OnResourceCreatedFunc) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.SetOnResourceCreatedHandler(handler)
+		return nil
+	}
+}
+
+// WithOnResourceDestroyedHandler returns an interpreter option which sets
+// the given function as the resource destruction handler.
+//
+func WithOnResourceDestroyedHandler(handler OnResourceDestroyedFunc) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.SetOnResourceDestroyedHandler(handler)
+		return nil
+	}
+}
+
 // WithMemoryGauge returns an interpreter option which sets
 // the given object as the memory gauge.
 //
@@ -588,6 +760,26 @@ func WithHashHandler(handler HashHandlerFunc) Option {
 	}
 }
 
+// WithAccountProofHandler returns an interpreter option which sets the given
+// function as the function that is used to verify account proofs.
+//
+func WithAccountProofHandler(handler AccountProofHandlerFunc) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.SetAccountProofHandler(handler)
+		return nil
+	}
+}
+
+// WithAddressValidator returns an interpreter option which sets the given
+// function as the function that is used to validate parsed and imported addresses.
+//
+func WithAddressValidator(validator common.AddressValidator) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.addressValidator = validator
+		return nil
+	}
+}
+
 // WithExitHandler returns an interpreter option which sets the given
 // function as the function that is used when execution is complete.
 //
@@ -618,6 +810,29 @@ func WithCallStack(callStack *CallStack) Option {
 	}
 }
 
+// DefaultCallStackDepthLimit is the default maximum depth of the call stack.
+//
+// Invocations of interpreted functions are evaluated recursively, on the Go stack.
+// Exhausting the Go stack is fatal and cannot be recovered from,
+// so the call stack depth is limited by default, well below the depth
+// at which the Go stack would be exhausted.
+// Deeply recursive programs then fail with a CallStackDepthLimitExceededError instead.
+//
+const DefaultCallStackDepthLimit = 10_000
+
+// WithCallStackDepthLimit returns an interpreter option which sets
+// the maximum depth of the call stack, i.e. how deeply nested
+// invocations of interpreted functions may be.
+// The default is DefaultCallStackDepthLimit.
+// A limit of 0 means the call stack depth is unlimited.
+//
+func WithCallStackDepthLimit(limit uint64) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.callStackDepthLimit = limit
+		return nil
+	}
+}
+
 // WithAtreeValueValidationEnabled returns an interpreter option which sets
 // the atree validation option.
 //
@@ -658,6 +873,16 @@ func WithInvalidatedResourceValidationEnabled(enabled bool) Option {
 	}
 }
 
+// WithResourceLossDiagnosticsEnabled returns an interpreter option which sets
+// the resource loss diagnostics option.
+//
+func WithResourceLossDiagnosticsEnabled(enabled bool) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.SetResourceLossDiagnosticsEnabled(enabled)
+		return nil
+	}
+}
+
 // withTypeCodes returns an interpreter option which sets the type codes.
 //
 func withTypeCodes(typeCodes TypeCodes) Option {
@@ -676,6 +901,24 @@ func withReferencedResourceKindedValues(referencedResourceKindedValues Reference
 	}
 }
 
+// withResourceReferences returns an interpreter option which sets the tracked resource references.
+//
+func withResourceReferences(resourceReferences ResourceReferences) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.resourceReferences = resourceReferences
+		return nil
+	}
+}
+
+// withIteratedContainers returns an interpreter option which sets the iterated containers.
+//
+func withIteratedContainers(iteratedContainers IteratedContainers) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.iteratedContainers = iteratedContainers
+		return nil
+	}
+}
+
 // WithDebugger returns an interpreter option which sets the given debugger
 //
 func WithDebugger(debugger *Debugger) Option {
@@ -703,6 +946,7 @@ func NewInterpreter(program *Program, location common.Location, options ...Optio
 		Globals:                    map[string]*Variable{},
 		effectivePredeclaredValues: map[string]ValueDeclaration{},
 		resourceVariables:          map[ResourceKindedValue]*Variable{},
+		memberInlineCaches:         map[ast.NodeID]common.TypeID{},
 	}
 
 	interpreter.activations = NewVariableActivations(interpreter)
@@ -714,12 +958,15 @@ func NewInterpreter(program *Program, location common.Location, options ...Optio
 	defaultOptions := []Option{
 		WithAllInterpreters(map[common.Location]*Interpreter{}),
 		WithCallStack(&CallStack{}),
+		WithCallStackDepthLimit(DefaultCallStackDepthLimit),
 		withTypeCodes(TypeCodes{
 			CompositeCodes:       map[sema.TypeID]CompositeTypeCode{},
 			InterfaceCodes:       map[sema.TypeID]WrapperCode{},
 			TypeRequirementCodes: map[sema.TypeID]WrapperCode{},
 		}),
 		withReferencedResourceKindedValues(map[atree.StorageID]map[ReferenceTrackedResourceKindedValue]struct{}{}),
+		withResourceReferences(ResourceReferences{}),
+		withIteratedContainers(IteratedContainers{}),
 		WithInvalidatedResourceValidationEnabled(true),
 	}
 
@@ -770,6 +1017,36 @@ func (interpreter *Interpreter) SetOnInvokedFunctionReturnHandler(function OnInv
 	interpreter.onInvokedFunctionReturn = function
 }
 
+// SetOnHostFunctionInvocationHandler sets the function that is triggered when a host function is about to be invoked.
+//
+func (interpreter *Interpreter) SetOnHostFunctionInvocationHandler(function OnHostFunctionInvocationFunc) {
+	interpreter.onHostFunctionInvocation = function
+}
+
+// SetOnStorageReadHandler sets the function that is triggered when a value is read from storage.
+//
+func (interpreter *Interpreter) SetOnStorageReadHandler(function OnStorageReadFunc) {
+	interpreter.onStorageRead = function
+}
+
+// SetOnStorageWriteHandler sets the function that is triggered when a value is about to be written to storage.
+//
+func (interpreter *Interpreter) SetOnStorageWriteHandler(function OnStorageWriteFunc) {
+	interpreter.onStorageWrite = function
+}
+
+// SetOnResourceCreatedHandler sets the function that is triggered when a resource is created.
+//
+func (interpreter *Interpreter) SetOnResourceCreatedHandler(function OnResourceCreatedFunc) {
+	interpreter.onResourceCreated = function
+}
+
+// SetOnResourceDestroyedHandler sets the function that is triggered when a resource is about to be destroyed.
+//
+func (interpreter *Interpreter) SetOnResourceDestroyedHandler(function OnResourceDestroyedFunc) {
+	interpreter.onResourceDestroyed = function
+}
+
 // SetMemoryGauge sets the object as the memory gauge.
 //
 func (interpreter *Interpreter) SetMemoryGauge(memoryGauge common.MemoryGauge) {
@@ -860,6 +1137,21 @@ func (interpreter *Interpreter) SetHashHandler(function HashHandlerFunc) {
 	interpreter.HashHandler = function
 }
 
+// SetAccountProofHandler sets the function that is used to verify account proofs.
+//
+func (interpreter *Interpreter) SetAccountProofHandler(function AccountProofHandlerFunc) {
+	interpreter.AccountProofHandler = function
+}
+
+// ValidateAddress validates the given address using the address validator, if any.
+//
+func (interpreter *Interpreter) ValidateAddress(address common.Address) error {
+	if interpreter.addressValidator == nil {
+		return nil
+	}
+	return interpreter.addressValidator(address)
+}
+
 // SetExitHandler sets the function that is used to handle end of execution.
 //
 func (interpreter *Interpreter) SetExitHandler(function ExitHandlerFunc) {
@@ -901,6 +1193,14 @@ func (interpreter *Interpreter) SetInvalidatedResourceValidationEnabled(enabled
 	interpreter.invalidatedResourceValidationEnabled = enabled
 }
 
+// SetResourceLossDiagnosticsEnabled sets the resource loss diagnostics option.
+// If enabled, an error which aborts the program while a resource is held in a temporary
+// is reported as a resource loss error, which includes the type and the UUID of the lost resource.
+//
+func (interpreter *Interpreter) SetResourceLossDiagnosticsEnabled(enabled bool) {
+	interpreter.resourceLossDiagnosticsEnabled = enabled
+}
+
 // setTypeCodes sets the type codes.
 //
 func (interpreter *Interpreter) setTypeCodes(typeCodes TypeCodes) {
@@ -933,6 +1233,12 @@ func (interpreter *Interpreter) findVariable(name string) *Variable {
 	return interpreter.activations.Find(name)
 }
 
+// FindVariable returns the variable with the given name in the current scope, if any
+//
+func (interpreter *Interpreter) FindVariable(name string) *Variable {
+	return interpreter.findVariable(name)
+}
+
 func (interpreter *Interpreter) findOrDeclareVariable(name string) *Variable {
 	variable := interpreter.findVariable(name)
 	if variable == nil {
@@ -1163,8 +1469,21 @@ func (interpreter *Interpreter) RecoverErrors(onError func(error)) {
 			}
 		}
 
+		// NOTE: copy the invocations, as the call stack might still be unwound,
+		// e.g. when the error is recovered from.
+		//
+		// Errors are recovered from and re-thrown for each statement,
+		// so only capture the call stack where the error occurred,
+		// i.e. when the error is recovered from for the first time.
+		// Capturing the call stack again for each statement while unwinding
+		// would be quadratic in the depth of the call stack
+
 		interpreterErr := err.(Error)
-		interpreterErr.StackTrace = interpreter.CallStack.Invocations[:]
+		invocations := interpreter.CallStack.Invocations
+		if interpreterErr.StackTrace == nil && len(invocations) > 0 {
+			interpreterErr.StackTrace = make([]Invocation, len(invocations))
+			copy(interpreterErr.StackTrace, invocations)
+		}
 
 		onError(interpreterErr)
 	}
@@ -1278,7 +1597,7 @@ func (interpreter *Interpreter) VisitFunctionDeclaration(declaration *ast.Functi
 
 	identifier := declaration.Identifier.Identifier
 
-	functionType := interpreter.Program.Elaboration.FunctionDeclarationFunctionTypes[declaration]
+	functionType := interpreter.Program.Elaboration.FunctionDeclarationFunctionTypes[declaration.ID()]
 
 	// NOTE: find *or* declare, as the function might have not been pre-declared (e.g. in the REPL)
 	variable := interpreter.findOrDeclareVariable(identifier)
@@ -1322,7 +1641,7 @@ func (interpreter *Interpreter) functionDeclarationValue(
 		beforeStatements = postConditionsRewrite.BeforeStatements
 	}
 
-	return NewInterpretedFunctionValue(
+	function := NewInterpretedFunctionValue(
 		interpreter,
 		declaration.ParameterList,
 		functionType,
@@ -1332,6 +1651,9 @@ func (interpreter *Interpreter) functionDeclarationValue(
 		declaration.FunctionBlock.Block.Statements,
 		rewrittenPostConditions,
 	)
+	function.Name = declaration.Identifier.Identifier
+
+	return function
 }
 
 func (interpreter *Interpreter) VisitBlock(block *ast.Block) ast.Repr {
@@ -1603,10 +1925,11 @@ func (interpreter *Interpreter) declareNonEnumCompositeValue(
 		}
 	})()
 
-	compositeType := interpreter.Program.Elaboration.CompositeDeclarationTypes[declaration]
+	compositeType := interpreter.Program.Elaboration.CompositeDeclarationTypes[declaration.ID()]
 
 	constructorType := &sema.FunctionType{
 		IsConstructor: true,
+		Purity:        compositeType.ConstructorPurity,
 		Parameters:    compositeType.ConstructorParameters,
 		ReturnTypeAnnotation: &sema.TypeAnnotation{
 			Type: compositeType,
@@ -1766,12 +2089,30 @@ func (interpreter *Interpreter) declareNonEnumCompositeValue(
 					)
 				}
 
-				value := NewCompositeValue(
+				// The constructor of a generic composite type is generic,
+				// and its type arguments are the type arguments of the constructed value
+
+				var typeArguments []StaticType
+
+				typeParameters := compositeType.TypeParameters()
+				if len(typeParameters) > 0 {
+					typeArguments = make([]StaticType, len(typeParameters))
+					for i, typeParameter := range typeParameters {
+						typeArgument, ok := invocation.TypeParameterTypes.Get(typeParameter)
+						if !ok {
+							panic(errors.NewUnreachableError())
+						}
+						typeArguments[i] = ConvertSemaToStaticType(interpreter, typeArgument)
+					}
+				}
+
+				value := NewCompositeValueWithTypeArguments(
 					interpreter,
 					invocation.GetLocationRange,
 					location,
 					qualifiedIdentifier,
 					declaration.CompositeKind,
+					typeArguments,
 					fields,
 					address,
 				)
@@ -1780,8 +2121,18 @@ func (interpreter *Interpreter) declareNonEnumCompositeValue(
 				value.Functions = functions
 				value.Destructor = destructorFunction
 
+				if declaration.CompositeKind == common.CompositeKindAttachment {
+					value.base = invocation.Base
+				}
+
 				invocation.Self = value
 
+				if declaration.CompositeKind == common.CompositeKindResource &&
+					interpreter.onResourceCreated != nil {
+
+					interpreter.onResourceCreated(interpreter, value)
+				}
+
 				if declaration.CompositeKind == common.CompositeKindContract {
 					// NOTE: set the variable value immediately, as the contract value
 					// needs to be available for nested declarations
@@ -1842,7 +2193,7 @@ func (interpreter *Interpreter) declareEnumConstructor(
 
 	lexicalScope.Set(identifier, variable)
 
-	compositeType := interpreter.Program.Elaboration.CompositeDeclarationTypes[declaration]
+	compositeType := interpreter.Program.Elaboration.CompositeDeclarationTypes[declaration.ID()]
 	qualifiedIdentifier := compositeType.QualifiedIdentifier()
 
 	location := interpreter.Location
@@ -1921,26 +2272,77 @@ func EnumConstructorFunction(
 
 	// Prepare the constructor function which performs a lookup in the lookup table
 
-	constructor := NewHostFunctionValue(
-		inter,
-		func(invocation Invocation) Value {
-			rawValue, ok := invocation.Arguments[0].(IntegerValue)
-			if !ok {
-				panic(errors.NewUnreachableError())
-			}
+	lookup := func(invocation Invocation) Value {
+		rawValue, ok := invocation.Arguments[0].(IntegerValue)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
 
-			rawValueArgumentBigEndianBytes := rawValue.ToBigEndianBytes()
+		rawValueArgumentBigEndianBytes := rawValue.ToBigEndianBytes()
 
-			caseValue, ok := lookupTable[string(rawValueArgumentBigEndianBytes)]
-			if !ok {
-				return NewNilValue(inter)
-			}
+		caseValue, ok := lookupTable[string(rawValueArgumentBigEndianBytes)]
+		if !ok {
+			return NewNilValue(inter)
+		}
 
-			return NewSomeValueNonCopying(invocation.Interpreter, caseValue)
-		},
+		return NewSomeValueNonCopying(invocation.Interpreter, caseValue)
+	}
+
+	constructor := NewHostFunctionValue(
+		inter,
+		lookup,
 		sema.EnumConstructorType(enumType),
 	)
 
+	// Declare the `allCases` field and the `lookup` function,
+	// unless the enum has cases with the same names.
+	// Both are only constructed when they are accessed
+
+	if _, ok := nestedVariables[sema.EnumAllCasesFieldName]; !ok {
+		nestedVariables[sema.EnumAllCasesFieldName] = NewVariableWithGetter(
+			inter,
+			func() Value {
+				values := make([]Value, len(caseValues))
+				for i, caseValue := range caseValues {
+					// NOTE: copy the case value, as the array takes ownership of its elements
+					values[i] = caseValue.Transfer(
+						inter,
+						getLocationRange,
+						atree.Address{},
+						false,
+						nil,
+					)
+				}
+
+				return NewArrayValue(
+					inter,
+					getLocationRange,
+					ConvertSemaArrayTypeToStaticArrayType(
+						inter,
+						&sema.VariableSizedType{
+							Type: enumType,
+						},
+					),
+					common.Address{},
+					values...,
+				)
+			},
+		)
+	}
+
+	if _, ok := nestedVariables[sema.EnumLookupFunctionName]; !ok {
+		nestedVariables[sema.EnumLookupFunctionName] = NewVariableWithGetter(
+			inter,
+			func() Value {
+				return NewHostFunctionValue(
+					inter,
+					lookup,
+					sema.EnumLookupFunctionType(enumType),
+				)
+			},
+		)
+	}
+
 	constructor.NestedVariables = nestedVariables
 
 	return constructor
@@ -1960,7 +2362,7 @@ func (interpreter *Interpreter) compositeInitializerFunction(
 	}
 
 	initializer = initializers[0]
-	functionType := interpreter.Program.Elaboration.ConstructorFunctionTypes[initializer]
+	functionType := interpreter.Program.Elaboration.ConstructorFunctionTypes[initializer.ID()]
 
 	parameterList := initializer.FunctionDeclaration.ParameterList
 
@@ -1983,7 +2385,7 @@ func (interpreter *Interpreter) compositeInitializerFunction(
 		rewrittenPostConditions = postConditionsRewrite.RewrittenPostConditions
 	}
 
-	return NewInterpretedFunctionValue(
+	function := NewInterpretedFunctionValue(
 		interpreter,
 		parameterList,
 		functionType,
@@ -1993,6 +2395,10 @@ func (interpreter *Interpreter) compositeInitializerFunction(
 		statements,
 		rewrittenPostConditions,
 	)
+	function.Name = initializer.FunctionDeclaration.Identifier.Identifier
+	function.CompositeType = interpreter.Program.Elaboration.CompositeDeclarationTypes[compositeDeclaration.ID()]
+
+	return function
 }
 
 func (interpreter *Interpreter) compositeDestructorFunction(
@@ -2026,7 +2432,7 @@ func (interpreter *Interpreter) compositeDestructorFunction(
 		rewrittenPostConditions = postConditionsRewrite.RewrittenPostConditions
 	}
 
-	return NewInterpretedFunctionValue(
+	function := NewInterpretedFunctionValue(
 		interpreter,
 		nil,
 		emptyFunctionType,
@@ -2036,6 +2442,10 @@ func (interpreter *Interpreter) compositeDestructorFunction(
 		statements,
 		rewrittenPostConditions,
 	)
+	function.Name = destructor.FunctionDeclaration.Identifier.Identifier
+	function.CompositeType = interpreter.Program.Elaboration.CompositeDeclarationTypes[compositeDeclaration.ID()]
+
+	return function
 }
 
 func (interpreter *Interpreter) compositeFunctions(
@@ -2045,13 +2455,17 @@ func (interpreter *Interpreter) compositeFunctions(
 
 	functions := map[string]FunctionValue{}
 
+	compositeType := interpreter.Program.Elaboration.CompositeDeclarationTypes[compositeDeclaration.ID()]
+
 	for _, functionDeclaration := range compositeDeclaration.Members.Functions() {
 		name := functionDeclaration.Identifier.Identifier
-		functions[name] =
-			interpreter.compositeFunction(
-				functionDeclaration,
-				lexicalScope,
-			)
+		function := interpreter.compositeFunction(
+			functionDeclaration,
+			lexicalScope,
+		)
+		function.Name = name
+		function.CompositeType = compositeType
+		functions[name] = function
 	}
 
 	return functions
@@ -2066,7 +2480,7 @@ func (interpreter *Interpreter) functionWrappers(
 
 	for _, functionDeclaration := range members.Functions() {
 
-		functionType := interpreter.Program.Elaboration.FunctionDeclarationFunctionTypes[functionDeclaration]
+		functionType := interpreter.Program.Elaboration.FunctionDeclarationFunctionTypes[functionDeclaration.ID()]
 
 		name := functionDeclaration.Identifier.Identifier
 		functionWrapper := interpreter.functionConditionsWrapper(
@@ -2088,7 +2502,7 @@ func (interpreter *Interpreter) compositeFunction(
 	lexicalScope *VariableActivation,
 ) *InterpretedFunctionValue {
 
-	functionType := interpreter.Program.Elaboration.FunctionDeclarationFunctionTypes[functionDeclaration]
+	functionType := interpreter.Program.Elaboration.FunctionDeclarationFunctionTypes[functionDeclaration.ID()]
 
 	var preConditions ast.Conditions
 
@@ -2134,7 +2548,22 @@ func (interpreter *Interpreter) VisitEnumCaseDeclaration(_ *ast.EnumCaseDeclarat
 }
 
 func (interpreter *Interpreter) ValueIsSubtypeOfSemaType(value Value, targetType sema.Type) bool {
-	return interpreter.IsSubTypeOfSemaType(value.StaticType(interpreter), targetType)
+	staticType := value.StaticType(interpreter)
+
+	if interpreter.IsSubTypeOfSemaType(staticType, targetType) {
+		return true
+	}
+
+	// The target type might be generic, e.g. the parameter type or the return type
+	// in the body of a generic function, so resolve it and check again.
+	// This is done lazily, as it requires inspecting the call stack
+
+	resolvedTargetType := interpreter.resolveGenericType(targetType)
+	if resolvedTargetType == targetType {
+		return false
+	}
+
+	return interpreter.IsSubTypeOfSemaType(staticType, resolvedTargetType)
 }
 
 func (interpreter *Interpreter) transferAndConvert(
@@ -2143,13 +2572,7 @@ func (interpreter *Interpreter) transferAndConvert(
 	getLocationRange func() LocationRange,
 ) Value {
 
-	transferredValue := value.Transfer(
-		interpreter,
-		getLocationRange,
-		atree.Address{},
-		false,
-		nil,
-	)
+	transferredValue := interpreter.copyValue(value, getLocationRange)
 
 	result := interpreter.ConvertAndBox(
 		getLocationRange,
@@ -2297,6 +2720,16 @@ func (interpreter *Interpreter) convert(value Value, valueType, targetType sema.
 		if !valueType.Equal(unwrappedTargetType) {
 			return ConvertUFix64(interpreter, value)
 		}
+
+	case sema.Fix128Type:
+		if !valueType.Equal(unwrappedTargetType) {
+			return ConvertFix128(interpreter, value)
+		}
+
+	case sema.UFix128Type:
+		if !valueType.Equal(unwrappedTargetType) {
+			return ConvertUFix128(interpreter, value)
+		}
 	}
 
 	switch unwrappedTargetType.(type) {
@@ -2383,7 +2816,7 @@ func (interpreter *Interpreter) declareInterface(
 		}
 	})()
 
-	interfaceType := interpreter.Program.Elaboration.InterfaceDeclarationTypes[declaration]
+	interfaceType := interpreter.Program.Elaboration.InterfaceDeclarationTypes[declaration.ID()]
 	typeID := interfaceType.ID()
 
 	initializerFunctionWrapper := interpreter.initializerFunctionWrapper(declaration.Members, lexicalScope)
@@ -2417,7 +2850,7 @@ func (interpreter *Interpreter) declareTypeRequirement(
 		}
 	})()
 
-	compositeType := interpreter.Program.Elaboration.CompositeDeclarationTypes[declaration]
+	compositeType := interpreter.Program.Elaboration.CompositeDeclarationTypes[declaration.ID()]
 	typeID := compositeType.ID()
 
 	initializerFunctionWrapper := interpreter.initializerFunctionWrapper(declaration.Members, lexicalScope)
@@ -2524,6 +2957,7 @@ func (interpreter *Interpreter) functionConditionsWrapper(
 
 				if invocation.Self != nil {
 					interpreter.declareVariable(sema.SelfIdentifier, invocation.Self)
+					interpreter.declareBaseValue(invocation.Self)
 				}
 
 				// NOTE: The `inner` function might be nil.
@@ -2699,20 +3133,31 @@ func (interpreter *Interpreter) NewSubInterpreter(
 		WithOnLoopIterationHandler(interpreter.onLoopIteration),
 		WithOnFunctionInvocationHandler(interpreter.onFunctionInvocation),
 		WithOnInvokedFunctionReturnHandler(interpreter.onInvokedFunctionReturn),
+		WithOnHostFunctionInvocationHandler(interpreter.onHostFunctionInvocation),
+		WithOnStorageReadHandler(interpreter.onStorageRead),
+		WithOnStorageWriteHandler(interpreter.onStorageWrite),
+		WithOnResourceCreatedHandler(interpreter.onResourceCreated),
+		WithOnResourceDestroyedHandler(interpreter.onResourceDestroyed),
 		WithInjectedCompositeFieldsHandler(interpreter.injectedCompositeFieldsHandler),
 		WithContractValueHandler(interpreter.contractValueHandler),
 		WithImportLocationHandler(interpreter.importLocationHandler),
 		WithUUIDHandler(interpreter.uuidHandler),
 		WithAllInterpreters(interpreter.allInterpreters),
 		WithCallStack(interpreter.CallStack),
+		WithCallStackDepthLimit(interpreter.callStackDepthLimit),
 		WithAtreeValueValidationEnabled(interpreter.atreeValueValidationEnabled),
 		WithAtreeStorageValidationEnabled(interpreter.atreeStorageValidationEnabled),
+		WithResourceLossDiagnosticsEnabled(interpreter.resourceLossDiagnosticsEnabled),
 		withTypeCodes(interpreter.typeCodes),
 		withReferencedResourceKindedValues(interpreter.referencedResourceKindedValues),
+		withResourceReferences(interpreter.resourceReferences),
+		withIteratedContainers(interpreter.iteratedContainers),
 		WithPublicAccountHandler(interpreter.publicAccountHandler),
 		WithPublicKeyValidationHandler(interpreter.PublicKeyValidationHandler),
 		WithSignatureVerificationHandler(interpreter.SignatureVerificationHandler),
 		WithHashHandler(interpreter.HashHandler),
+		WithAccountProofHandler(interpreter.AccountProofHandler),
+		WithAddressValidator(interpreter.addressValidator),
 		WithBLSCryptoFunctions(
 			interpreter.BLSVerifyPoPHandler,
 			interpreter.BLSAggregateSignaturesHandler,
@@ -2742,6 +3187,8 @@ func (interpreter *Interpreter) storedValueExists(
 	domain string,
 	identifier string,
 ) bool {
+	interpreter.reportStorageRead(storageAddress, domain, identifier)
+
 	accountStorage := interpreter.Storage.GetStorageMap(storageAddress, domain, false)
 	if accountStorage == nil {
 		return false
@@ -2749,11 +3196,25 @@ func (interpreter *Interpreter) storedValueExists(
 	return accountStorage.ValueExists(identifier)
 }
 
+// preloadStorageMaps loads the storage maps of the given domains of the account at once,
+// if the storage supports it
+//
+func (interpreter *Interpreter) preloadStorageMaps(address common.Address, domains ...string) {
+	storage, ok := interpreter.Storage.(StorageMapPreloadingStorage)
+	if !ok {
+		return
+	}
+
+	storage.PreloadStorageMaps(address, domains)
+}
+
 func (interpreter *Interpreter) ReadStored(
 	storageAddress common.Address,
 	domain string,
 	identifier string,
 ) Value {
+	interpreter.reportStorageRead(storageAddress, domain, identifier)
+
 	accountStorage := interpreter.Storage.GetStorageMap(storageAddress, domain, false)
 	if accountStorage == nil {
 		return nil
@@ -2761,13 +3222,30 @@ func (interpreter *Interpreter) ReadStored(
 	return accountStorage.ReadValue(interpreter, identifier)
 }
 
-func (interpreter *Interpreter) writeStored(
+func (interpreter *Interpreter) reportStorageRead(
+	storageAddress common.Address,
+	domain string,
+	identifier string,
+) {
+	if interpreter.onStorageRead == nil {
+		return
+	}
+	interpreter.onStorageRead(interpreter, storageAddress, domain, identifier)
+}
+
+func (interpreter *Interpreter) WriteStored(
 	storageAddress common.Address,
 	domain string,
 	identifier string,
 	value Value,
+	getLocationRange func() LocationRange,
 ) {
+	if interpreter.onStorageWrite != nil {
+		interpreter.onStorageWrite(interpreter, storageAddress, domain, identifier)
+	}
+
 	accountStorage := interpreter.Storage.GetStorageMap(storageAddress, domain, true)
+	interpreter.checkContainerNotIterated(accountStorage.StorageID(), getLocationRange)
 	accountStorage.WriteValue(interpreter, identifier, value)
 }
 
@@ -2960,10 +3438,28 @@ var ConverterDeclarations = []ValueConverterDeclaration{
 		max: NewUnmeteredUFix64Value(math.MaxUint64),
 	},
 	{
-		name:         sema.AddressTypeName,
-		functionType: sema.AddressConversionFunctionType,
+		name:         sema.Fix128TypeName,
+		functionType: sema.NumberConversionFunctionType(sema.Fix128Type),
 		convert: func(interpreter *Interpreter, value Value) Value {
-			return ConvertAddress(interpreter, value)
+			return ConvertFix128(interpreter, value)
+		},
+		min: NewUnmeteredFix128ValueFromBigInt(sema.Fix128TypeMinBig),
+		max: NewUnmeteredFix128ValueFromBigInt(sema.Fix128TypeMaxBig),
+	},
+	{
+		name:         sema.UFix128TypeName,
+		functionType: sema.NumberConversionFunctionType(sema.UFix128Type),
+		convert: func(interpreter *Interpreter, value Value) Value {
+			return ConvertUFix128(interpreter, value)
+		},
+		min: NewUnmeteredUFix128ValueFromBigInt(sema.UFix128TypeMinBig),
+		max: NewUnmeteredUFix128ValueFromBigInt(sema.UFix128TypeMaxBig),
+	},
+	{
+		name:         sema.AddressTypeName,
+		functionType: sema.AddressConversionFunctionType,
+		convert: func(interpreter *Interpreter, value Value) Value {
+			return ConvertAddress(interpreter, value)
 		},
 	},
 	{
@@ -3314,6 +3810,15 @@ var converterFunctionValues = func() []converterFunction {
 			addMember(sema.NumberTypeMaxFieldName, declaration.max)
 		}
 
+		switch numberType := declaration.functionType.ReturnTypeAnnotation.Type.(type) {
+		case *sema.NumericType, *sema.FixedPointNumericType:
+			addMember(sema.NumberTypeFromStringFunctionName, newFromStringFunction(numberType))
+		}
+
+		if declaration.name == sema.AddressTypeName {
+			addMember(sema.AddressTypeParseFunctionName, addressParseFunction)
+		}
+
 		converterFuncValues[index] = converterFunction{
 			name:      declaration.name,
 			converter: converterFunctionValue,
@@ -3323,6 +3828,194 @@ var converterFunctionValues = func() []converterFunction {
 	return converterFuncValues
 }()
 
+var addressParseFunction = NewUnmeteredHostFunctionValue(
+	func(invocation Invocation) Value {
+		argument, ok := invocation.Arguments[0].(*StringValue)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		inter := invocation.Interpreter
+
+		hexString := strings.TrimPrefix(argument.Str, "0x")
+		if len(hexString) == 0 {
+			return NewNilValue(inter)
+		}
+
+		address, err := common.HexToAddress(hexString)
+		if err != nil {
+			return NewNilValue(inter)
+		}
+
+		err = inter.ValidateAddress(address)
+		if err != nil {
+			return NewNilValue(inter)
+		}
+
+		return NewSomeValueNonCopying(
+			inter,
+			NewAddressValue(inter, address),
+		)
+	},
+	sema.AddressTypeParseFunctionType,
+)
+
+func newFromStringFunction(numberType sema.Type) *HostFunctionValue {
+	return NewUnmeteredHostFunctionValue(
+		func(invocation Invocation) Value {
+			argument, ok := invocation.Arguments[0].(*StringValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			inter := invocation.Interpreter
+
+			var value Value
+			switch numberType := numberType.(type) {
+			case *sema.NumericType:
+				value = inter.parseInteger(argument.Str, numberType)
+			case *sema.FixedPointNumericType:
+				value = inter.parseFixedPoint(argument.Str, numberType)
+			default:
+				panic(errors.NewUnreachableError())
+			}
+
+			if value == nil {
+				return NewNilValue(inter)
+			}
+
+			return NewSomeValueNonCopying(inter, value)
+		},
+		sema.NumberTypeFromStringFunctionType(numberType),
+	)
+}
+
+// parseCanonicalDecimal parses an optionally negative decimal number.
+// Only the canonical form is accepted: no plus sign, no whitespace, no underscores,
+// and no leading zeros.
+//
+func parseCanonicalDecimal(s string) (negative bool, unsigned *big.Int, ok bool) {
+	if strings.HasPrefix(s, "-") {
+		negative = true
+		s = s[1:]
+	}
+
+	if !isDecimalDigits(s) ||
+		(len(s) > 1 && s[0] == '0') {
+
+		return false, nil, false
+	}
+
+	unsigned, ok = new(big.Int).SetString(s, 10)
+	if !ok {
+		return false, nil, false
+	}
+
+	return negative, unsigned, true
+}
+
+func isDecimalDigits(s string) bool {
+	if len(s) == 0 {
+		return false
+	}
+
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// parseInteger parses the given string into a value of the given integer type.
+// Returns nil if the string is not canonical or the number is out of range.
+//
+func (interpreter *Interpreter) parseInteger(s string, integerType *sema.NumericType) Value {
+	negative, value, ok := parseCanonicalDecimal(s)
+	if !ok {
+		return nil
+	}
+
+	if negative {
+		// Negative zero is not canonical
+		if value.Sign() == 0 {
+			return nil
+		}
+
+		value.Neg(value)
+	}
+
+	minInt := integerType.MinInt()
+	if minInt != nil && value.Cmp(minInt) < 0 {
+		return nil
+	}
+
+	maxInt := integerType.MaxInt()
+	if maxInt != nil && value.Cmp(maxInt) > 0 {
+		return nil
+	}
+
+	return interpreter.NewIntegerValueFromBigInt(value, integerType)
+}
+
+// parseFixedPoint parses the given string into a value of the given fixed-point type.
+// The string must contain a decimal point, and at most as many fractional digits as the type's scale.
+// Returns nil if the string is not canonical or the number is out of range.
+//
+func (interpreter *Interpreter) parseFixedPoint(s string, fixedPointType *sema.FixedPointNumericType) Value {
+	integerString, fractionalString, found := strings.Cut(s, ".")
+	if !found {
+		return nil
+	}
+
+	negative, unsignedInteger, ok := parseCanonicalDecimal(integerString)
+	if !ok {
+		return nil
+	}
+
+	if !isDecimalDigits(fractionalString) {
+		return nil
+	}
+
+	scale := uint(len(fractionalString))
+	if scale > fixedPointType.Scale() {
+		return nil
+	}
+
+	fractional, ok := new(big.Int).SetString(fractionalString, 10)
+	if !ok {
+		return nil
+	}
+
+	// Negative zero is not canonical
+	if negative && unsignedInteger.Sign() == 0 && fractional.Sign() == 0 {
+		return nil
+	}
+
+	if !fixedpoint.CheckRange(
+		negative,
+		unsignedInteger,
+		fractional,
+		fixedPointType.MinInt(),
+		fixedPointType.MinFractional(),
+		fixedPointType.MaxInt(),
+		fixedPointType.MaxFractional(),
+	) {
+		return nil
+	}
+
+	value := fixedpoint.ConvertToFixedPointBigInt(
+		negative,
+		unsignedInteger,
+		fractional,
+		scale,
+		fixedPointType.Scale(),
+	)
+
+	return interpreter.NewFixedPointValueFromBigInt(value, fixedPointType)
+}
+
 func defineConverterFunctions(activation *VariableActivation) {
 	for _, converterFunc := range converterFunctionValues {
 		defineBaseValue(activation, converterFunc.name, converterFunc.converter)
@@ -3509,6 +4202,7 @@ var stringFunction = func() Value {
 			return emptyString
 		},
 		&sema.FunctionType{
+			Purity: sema.FunctionPurityView,
 			ReturnTypeAnnotation: sema.NewTypeAnnotation(
 				sema.StringType,
 			),
@@ -3533,20 +4227,78 @@ var stringFunction = func() Value {
 					panic(errors.NewUnreachableError())
 				}
 
+				return argument.ToHexString(invocation.Interpreter)
+			},
+			sema.StringTypeEncodeHexFunctionType,
+		),
+	)
+
+	addMember(
+		sema.StringTypeEncodeBase64FunctionName,
+		NewUnmeteredHostFunctionValue(
+			func(invocation Invocation) Value {
+				argument, ok := invocation.Arguments[0].(*ArrayValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
 				inter := invocation.Interpreter
 				memoryUsage := common.NewStringMemoryUsage(
-					safeMul(argument.Count(), 2),
+					base64.StdEncoding.EncodedLen(argument.Count()),
 				)
 				return NewStringValue(
 					inter,
 					memoryUsage,
 					func() string {
-						bytes, _ := ByteArrayValueToByteSlice(inter, argument)
-						return hex.EncodeToString(bytes)
+						bytes, err := ByteArrayValueToByteSlice(inter, argument)
+						if err != nil {
+							panic(errors.NewUnexpectedErrorFromCause(err))
+						}
+						return base64.StdEncoding.EncodeToString(bytes)
 					},
 				)
 			},
-			sema.StringTypeEncodeHexFunctionType,
+			sema.StringTypeEncodeBase64FunctionType,
+		),
+	)
+
+	addMember(
+		sema.StringTypeFromUTF8FunctionName,
+		NewUnmeteredHostFunctionValue(
+			func(invocation Invocation) Value {
+				argument, ok := invocation.Arguments[0].(*ArrayValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				inter := invocation.Interpreter
+
+				// Meter the intermediate byte slice
+
+				common.UseMemory(inter, common.NewBytesMemoryUsage(argument.Count()))
+
+				bytes, err := ByteArrayValueToByteSlice(inter, argument)
+				if err != nil {
+					panic(errors.NewUnexpectedErrorFromCause(err))
+				}
+
+				if !utf8.Valid(bytes) {
+					return NewNilValue(inter)
+				}
+
+				memoryUsage := common.NewStringMemoryUsage(len(bytes))
+				return NewSomeValueNonCopying(
+					inter,
+					NewStringValue(
+						inter,
+						memoryUsage,
+						func() string {
+							return string(bytes)
+						},
+					),
+				)
+			},
+			sema.StringTypeFromUTF8FunctionType,
 		),
 	)
 
@@ -3680,7 +4432,7 @@ func (interpreter *Interpreter) authAccountSaveFunction(addressValue AddressValu
 
 			// Write new value
 
-			interpreter.writeStored(address, domain, identifier, value)
+			interpreter.WriteStored(address, domain, identifier, value, getLocationRange)
 
 			return NewVoidValue(invocation.Interpreter)
 		},
@@ -3723,6 +4475,93 @@ func (interpreter *Interpreter) authAccountTypeFunction(addressValue AddressValu
 	)
 }
 
+// accountForEachFunction returns a function which calls the given iteration function
+// for each path in the given domain of the account, together with the type of the stored value.
+// For the public and private domains, the type is the type of the linked capability.
+//
+// The account's storage in the domain must not be mutated while it is being iterated.
+//
+func (interpreter *Interpreter) accountForEachFunction(
+	addressValue AddressValue,
+	domain common.PathDomain,
+	functionType *sema.FunctionType,
+) *HostFunctionValue {
+
+	// Converted addresses can be cached and don't have to be recomputed on each function invocation
+	address := addressValue.ToAddress()
+
+	iterationFunctionType := functionType.Parameters[0].TypeAnnotation.Type.(*sema.FunctionType)
+	pathType := iterationFunctionType.Parameters[0].TypeAnnotation.Type
+
+	return NewHostFunctionValue(
+		interpreter,
+		func(invocation Invocation) Value {
+			inter := invocation.Interpreter
+
+			function, ok := invocation.Arguments[0].(FunctionValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			inter.reportStorageRead(address, domain.Identifier(), "")
+
+			accountStorage := inter.Storage.GetStorageMap(address, domain.Identifier(), false)
+			if accountStorage == nil {
+				return NewVoidValue(inter)
+			}
+
+			getLocationRange := invocation.GetLocationRange
+
+			inter.withMutationPrevention(accountStorage.StorageID(), func() {
+				iterator := accountStorage.Iterator(inter)
+
+				for {
+					identifier, value := iterator.Next()
+					if value == nil {
+						break
+					}
+
+					inter.ReportComputation(common.ComputationKindLoop, 1)
+
+					var staticType StaticType
+					if link, ok := value.(LinkValue); ok {
+						staticType = NewCapabilityStaticType(inter, link.Type)
+					} else {
+						staticType = value.StaticType(inter)
+					}
+
+					iterationInvocation := NewInvocation(
+						inter,
+						nil,
+						[]Value{
+							NewPathValue(inter, domain, identifier),
+							NewTypeValue(inter, staticType),
+						},
+						[]sema.Type{
+							pathType,
+							sema.MetaType,
+						},
+						nil,
+						getLocationRange,
+					)
+
+					result, ok := function.invoke(iterationInvocation).(BoolValue)
+					if !ok {
+						panic(errors.NewUnreachableError())
+					}
+
+					if !result {
+						break
+					}
+				}
+			})
+
+			return NewVoidValue(inter)
+		},
+		functionType,
+	)
+}
+
 func (interpreter *Interpreter) authAccountLoadFunction(addressValue AddressValue) *HostFunctionValue {
 	return interpreter.authAccountReadFunction(addressValue, true)
 }
@@ -3763,9 +4602,12 @@ func (interpreter *Interpreter) authAccountReadFunction(addressValue AddressValu
 
 			ty := typeParameterPair.Value
 
-			if !interpreter.IsSubTypeOfSemaType(value.StaticType(invocation.Interpreter), ty) {
+			valueStaticType := value.StaticType(invocation.Interpreter)
+
+			if !interpreter.IsSubTypeOfSemaType(valueStaticType, ty) {
 				panic(ForceCastTypeMismatchError{
 					ExpectedType:  ty,
+					ActualType:    interpreter.MustConvertStaticToSemaType(valueStaticType),
 					LocationRange: invocation.GetLocationRange(),
 				})
 			}
@@ -3787,7 +4629,7 @@ func (interpreter *Interpreter) authAccountReadFunction(addressValue AddressValu
 			// Remove the value from storage,
 			// but only if the type check succeeded.
 			if clear {
-				interpreter.writeStored(address, domain, identifier, nil)
+				interpreter.WriteStored(address, domain, identifier, nil, getLocationRange)
 			}
 
 			return NewSomeValueNonCopying(invocation.Interpreter, transferredValue)
@@ -3896,11 +4738,12 @@ func (interpreter *Interpreter) authAccountLinkFunction(addressValue AddressValu
 			// Note that this will be metered twice if Atree validation is enabled.
 			linkValue := NewLinkValue(interpreter, targetPath, borrowStaticType)
 
-			interpreter.writeStored(
+			interpreter.WriteStored(
 				address,
 				newCapabilityDomain,
 				newCapabilityIdentifier,
 				linkValue,
+				invocation.GetLocationRange,
 			)
 
 			return NewSomeValueNonCopying(
@@ -3971,7 +4814,7 @@ func (interpreter *Interpreter) authAccountUnlinkFunction(addressValue AddressVa
 
 			// Write new value
 
-			interpreter.writeStored(address, domain, identifier, nil)
+			interpreter.WriteStored(address, domain, identifier, nil, invocation.GetLocationRange)
 
 			return NewVoidValue(invocation.Interpreter)
 		},
@@ -3982,6 +4825,7 @@ func (interpreter *Interpreter) authAccountUnlinkFunction(addressValue AddressVa
 func (interpreter *Interpreter) capabilityBorrowFunction(
 	addressValue AddressValue,
 	pathValue PathValue,
+	capabilityID UInt64Value,
 	borrowType *sema.ReferenceType,
 ) *HostFunctionValue {
 
@@ -4010,9 +4854,10 @@ func (interpreter *Interpreter) capabilityBorrowFunction(
 			}
 
 			targetPath, authorized, err :=
-				interpreter.GetCapabilityFinalTargetPath(
+				interpreter.getCapabilityFinalTargetPath(
 					address,
 					pathValue,
+					capabilityID,
 					borrowType,
 					invocation.GetLocationRange,
 				)
@@ -4053,6 +4898,7 @@ func (interpreter *Interpreter) capabilityBorrowFunction(
 func (interpreter *Interpreter) capabilityCheckFunction(
 	addressValue AddressValue,
 	pathValue PathValue,
+	capabilityID UInt64Value,
 	borrowType *sema.ReferenceType,
 ) *HostFunctionValue {
 
@@ -4081,9 +4927,10 @@ func (interpreter *Interpreter) capabilityCheckFunction(
 			}
 
 			targetPath, authorized, err :=
-				interpreter.GetCapabilityFinalTargetPath(
+				interpreter.getCapabilityFinalTargetPath(
 					address,
 					pathValue,
+					capabilityID,
 					borrowType,
 					invocation.GetLocationRange,
 				)
@@ -4117,6 +4964,41 @@ func (interpreter *Interpreter) capabilityCheckFunction(
 	)
 }
 
+// getCapabilityFinalTargetPath returns the final target path of the capability with the given path or ID.
+// ID capabilities are resolved through their capability controller,
+// path capabilities are resolved by following links.
+//
+func (interpreter *Interpreter) getCapabilityFinalTargetPath(
+	address common.Address,
+	path PathValue,
+	capabilityID UInt64Value,
+	wantedBorrowType *sema.ReferenceType,
+	getLocationRange func() LocationRange,
+) (
+	finalPath PathValue,
+	authorized bool,
+	err error,
+) {
+	if capabilityID != 0 {
+		finalPath, authorized = interpreter.GetStorageCapabilityFinalTargetPath(
+			address,
+			capabilityID,
+			wantedBorrowType,
+		)
+		return finalPath, authorized, nil
+	}
+
+	return interpreter.GetCapabilityFinalTargetPath(
+		address,
+		path,
+		wantedBorrowType,
+		getLocationRange,
+	)
+}
+
+// GetCapabilityFinalTargetPath returns the final target path of the given capability path,
+// by following links and published capabilities.
+//
 func (interpreter *Interpreter) GetCapabilityFinalTargetPath(
 	address common.Address,
 	path PathValue,
@@ -4167,6 +5049,18 @@ func (interpreter *Interpreter) GetCapabilityFinalTargetPath(
 			paths = append(paths, targetPath)
 			path = targetPath
 
+		} else if capability, ok := value.(*CapabilityValue); ok && capability.IsIDCapability() {
+
+			// Published capabilities are resolved through their capability controller.
+			// Only capabilities issued by the account itself can be published
+
+			finalPath, authorized = interpreter.GetStorageCapabilityFinalTargetPath(
+				address,
+				capability.ID,
+				wantedBorrowType,
+			)
+			return finalPath, authorized, nil
+
 		} else {
 			return path, wantedReferenceType.Authorized, nil
 		}
@@ -4324,6 +5218,58 @@ func (interpreter *Interpreter) reportInvokedFunctionReturn(line int) {
 	interpreter.onInvokedFunctionReturn(interpreter, line)
 }
 
+// RecordEffect records an effect which cannot be undone,
+// e.g. the emission of an event, or a change to an account.
+// A failed call which had such an effect cannot be recovered from, see EffectTracker.
+//
+func (interpreter *Interpreter) RecordEffect() {
+	storage, ok := interpreter.Storage.(EffectTrackingStorage)
+	if !ok {
+		return
+	}
+
+	storage.EffectTracker().RecordEffect()
+}
+
+// recordVariableWrite records that the given variable is about to be assigned,
+// so the assignment can be undone if a recoverable call fails, see EffectTracker.
+//
+func (interpreter *Interpreter) recordVariableWrite(variable *Variable) {
+	storage, ok := interpreter.Storage.(EffectTrackingStorage)
+	if !ok {
+		return
+	}
+
+	storage.EffectTracker().RecordVariableWrite(variable)
+}
+
+// withMutationPrevention calls the given function,
+// and prevents the container with the given storage ID from being mutated while the function runs
+//
+func (interpreter *Interpreter) withMutationPrevention(storageID atree.StorageID, f func()) {
+	_, wasIterated := interpreter.iteratedContainers[storageID]
+	interpreter.iteratedContainers[storageID] = struct{}{}
+
+	defer func() {
+		if !wasIterated {
+			delete(interpreter.iteratedContainers, storageID)
+		}
+	}()
+
+	f()
+}
+
+func (interpreter *Interpreter) checkContainerNotIterated(
+	storageID atree.StorageID,
+	getLocationRange func() LocationRange,
+) {
+	if _, ok := interpreter.iteratedContainers[storageID]; ok {
+		panic(ContainerMutatedDuringIterationError{
+			LocationRange: getLocationRange(),
+		})
+	}
+}
+
 func (interpreter *Interpreter) ReportComputation(compKind common.ComputationKind, intensity uint) {
 	if interpreter.onMeterComputation != nil {
 		interpreter.onMeterComputation(compKind, intensity)
@@ -4357,6 +5303,43 @@ func (interpreter *Interpreter) getMember(self Value, getLocationRange func() Lo
 	return result
 }
 
+// getMemberWithInlineCache gets the member of the given value, like getMember,
+// using the inline cache of the given member expression.
+//
+// Member names are unique within a composite type.
+// If the member was resolved to a function for a value of the same type before,
+// the lookup of fields, which is more expensive than the lookup of functions, is skipped.
+//
+func (interpreter *Interpreter) getMemberWithInlineCache(
+	memberExpression *ast.MemberExpression,
+	self Value,
+	getLocationRange func() LocationRange,
+	identifier string,
+) Value {
+	compositeValue, ok := self.(*CompositeValue)
+	if !ok {
+		return interpreter.getMember(self, getLocationRange, identifier)
+	}
+
+	expressionID := memberExpression.ID()
+	typeID := compositeValue.TypeID()
+
+	if cachedTypeID, ok := interpreter.memberInlineCaches[expressionID]; ok && cachedTypeID == typeID {
+		function := compositeValue.getFunctionMember(interpreter, getLocationRange, identifier)
+		if function != nil {
+			return function
+		}
+	}
+
+	result := interpreter.getMember(self, getLocationRange, identifier)
+
+	if _, ok := result.(BoundFunctionValue); ok {
+		interpreter.memberInlineCaches[expressionID] = typeID
+	}
+
+	return result
+}
+
 func (interpreter *Interpreter) isInstanceFunction(self Value) *HostFunctionValue {
 	return NewHostFunctionValue(
 		interpreter,
@@ -4607,6 +5590,36 @@ func (interpreter *Interpreter) updateReferencedResource(
 	}
 }
 
+// trackResourceReference tracks the given reference if it references a resource,
+// so it can be invalidated when the resource is moved.
+func (interpreter *Interpreter) trackResourceReference(reference *EphemeralReferenceValue) {
+	referencedValue, ok := reference.Value.(ReferenceTrackedResourceKindedValue)
+	if !ok {
+		return
+	}
+
+	id := referencedValue.StorageID()
+	references := interpreter.resourceReferences[id]
+	if references == nil {
+		references = map[*EphemeralReferenceValue]struct{}{}
+		interpreter.resourceReferences[id] = references
+	}
+	references[reference] = struct{}{}
+}
+
+// invalidateResourceReferences invalidates all references
+// to the resource with the given storage ID.
+func (interpreter *Interpreter) invalidateResourceReferences(id atree.StorageID) {
+	references := interpreter.resourceReferences[id]
+	if references == nil {
+		return
+	}
+	for reference := range references { //nolint:maprangecheck
+		reference.invalidated = true
+	}
+	delete(interpreter.resourceReferences, id)
+}
+
 // startResourceTracking starts tracking the life-span of a resource.
 // A resource can only be associated with one variable at most, at a given time.
 func (interpreter *Interpreter) startResourceTracking(
@@ -4698,6 +5711,48 @@ func (interpreter *Interpreter) resourceForValidation(value Value) ResourceKinde
 	return nil
 }
 
+// resourceTemporaryLoss returns the value to re-panic with,
+// when the given recovered value aborted the evaluation of an expression,
+// while the given value, which the given expression evaluated to, was held in a temporary.
+//
+// If the value is a resource and the recovered value is an error of the user program,
+// the resource is lost, and a resource loss error which wraps the error is returned.
+// Otherwise, the recovered value is returned unchanged.
+//
+func (interpreter *Interpreter) resourceTemporaryLoss(recovered any, value Value, expression ast.Expression) any {
+	if value == nil ||
+		!value.IsResourceKinded(interpreter) ||
+		!isRecoverableError(recovered) {
+
+		return recovered
+	}
+
+	return interpreter.newResourceLossError(recovered.(error), value, expression)
+}
+
+// newResourceLossError returns an error for the given resource,
+// which was held in a temporary for the given expression, and was lost due to the given error.
+//
+func (interpreter *Interpreter) newResourceLossError(err error, value Value, expression ast.Expression) ResourceLossError {
+	getLocationRange := locationRangeGetter(interpreter, interpreter.Location, expression)
+
+	lossErr := ResourceLossError{
+		Err:           err,
+		TypeID:        interpreter.MustConvertStaticToSemaType(value.StaticType(interpreter)).ID(),
+		LocationRange: getLocationRange(),
+	}
+
+	if compositeValue, ok := value.(*CompositeValue); ok {
+		uuid := compositeValue.ResourceUUID(interpreter, getLocationRange)
+		if uuid != nil {
+			lossErr.UUID = uint64(*uuid)
+			lossErr.HasUUID = true
+		}
+	}
+
+	return lossErr
+}
+
 func (interpreter *Interpreter) invalidateResource(value Value) {
 	if !interpreter.invalidatedResourceValidationEnabled {
 		return
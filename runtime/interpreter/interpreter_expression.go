@@ -19,9 +19,13 @@
 package interpreter
 
 import (
+	goErrors "errors"
 	"math/big"
+	"strings"
 	"time"
 
+	"github.com/onflow/atree"
+
 	"github.com/onflow/cadence/fixedpoint"
 	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/common"
@@ -70,6 +74,7 @@ func (interpreter *Interpreter) identifierExpressionGetterSetter(identifierExpre
 		},
 		set: func(value Value) {
 			interpreter.startResourceTracking(value, variable, identifier, identifierExpression)
+			interpreter.recordVariableWrite(variable)
 			variable.SetValue(value)
 		},
 	}
@@ -79,6 +84,18 @@ func (interpreter *Interpreter) identifierExpressionGetterSetter(identifierExpre
 // for the target index expression
 //
 func (interpreter *Interpreter) indexExpressionGetterSetter(indexExpression *ast.IndexExpression) getterSetter {
+	if index, ok := interpreter.Program.Elaboration.TupleElementAccessIndices[indexExpression.ID()]; ok {
+		// Tuple elements cannot be assigned to, only read
+		return getterSetter{
+			get: func(_ bool) Value {
+				return interpreter.visitTupleElementAccess(indexExpression, index)
+			},
+			set: func(_ Value) {
+				panic(errors.NewUnreachableError())
+			},
+		}
+	}
+
 	target, ok := interpreter.evalExpression(indexExpression.TargetExpression).(ValueIndexableValue)
 	if !ok {
 		panic(errors.NewUnreachableError())
@@ -91,8 +108,8 @@ func (interpreter *Interpreter) indexExpressionGetterSetter(indexExpression *ast
 
 	elaboration := interpreter.Program.Elaboration
 
-	indexedType := elaboration.IndexExpressionIndexedTypes[indexExpression]
-	indexingType := elaboration.IndexExpressionIndexingTypes[indexExpression]
+	indexedType := elaboration.IndexExpressionIndexedTypes[indexExpression.ID()]
+	indexingType := elaboration.IndexExpressionIndexingTypes[indexExpression.ID()]
 
 	transferredIndexingValue := interpreter.transferAndConvert(
 		interpreter.evalExpression(indexExpression.IndexingExpression),
@@ -105,7 +122,7 @@ func (interpreter *Interpreter) indexExpressionGetterSetter(indexExpression *ast
 		),
 	)
 
-	_, isNestedResourceMove := elaboration.IsNestedResourceMoveExpression[indexExpression]
+	_, isNestedResourceMove := elaboration.IsNestedResourceMoveExpression[indexExpression.ID()]
 
 	return getterSetter{
 		target: target,
@@ -134,7 +151,7 @@ func (interpreter *Interpreter) memberExpressionGetterSetter(memberExpression *a
 	identifier := memberExpression.Identifier.Identifier
 	getLocationRange := locationRangeGetter(interpreter, interpreter.Location, memberExpression)
 
-	_, isNestedResourceMove := interpreter.Program.Elaboration.IsNestedResourceMoveExpression[memberExpression]
+	_, isNestedResourceMove := interpreter.Program.Elaboration.IsNestedResourceMoveExpression[memberExpression.ID()]
 
 	return getterSetter{
 		target: target,
@@ -161,7 +178,12 @@ func (interpreter *Interpreter) memberExpressionGetterSetter(memberExpression *a
 			if isNestedResourceMove {
 				resultValue = target.(MemberAccessibleValue).RemoveMember(interpreter, getLocationRange, identifier)
 			} else {
-				resultValue = interpreter.getMember(target, getLocationRange, identifier)
+				resultValue = interpreter.getMemberWithInlineCache(
+					memberExpression,
+					target,
+					getLocationRange,
+					identifier,
+				)
 			}
 			if resultValue == nil && !allowMissing {
 				panic(MissingMemberValueError{
@@ -194,7 +216,7 @@ func (interpreter *Interpreter) checkMemberAccess(
 	target Value,
 	getLocationRange func() LocationRange,
 ) {
-	memberInfo := interpreter.Program.Elaboration.MemberExpressionMemberInfos[memberExpression]
+	memberInfo := interpreter.Program.Elaboration.MemberExpressionMemberInfos[memberExpression.ID()]
 	expectedType := memberInfo.AccessedType
 
 	switch expectedType := expectedType.(type) {
@@ -372,6 +394,21 @@ func (interpreter *Interpreter) VisitBinaryExpression(expression *ast.BinaryExpr
 		}
 		return left.GreaterEqual(interpreter, right)
 
+	case ast.OperationRange,
+		ast.OperationInclusiveRange:
+		left, leftOk := leftValue.(IntegerValue)
+		right, rightOk := rightValue().(IntegerValue)
+		if !leftOk || !rightOk {
+			error(right)
+		}
+
+		rangeType, ok := interpreter.Program.Elaboration.BinaryExpressionResultTypes[expression.ID()].(*sema.RangeType)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		return NewRangeValue(interpreter, left, right, rangeType)
+
 	case ast.OperationEqual:
 		return interpreter.testEqual(leftValue, rightValue(), expression)
 
@@ -428,8 +465,8 @@ func (interpreter *Interpreter) VisitBinaryExpression(expression *ast.BinaryExpr
 
 		value := rightValue()
 
-		rightType := interpreter.Program.Elaboration.BinaryExpressionRightTypes[expression]
-		resultType := interpreter.Program.Elaboration.BinaryExpressionResultTypes[expression]
+		rightType := interpreter.Program.Elaboration.BinaryExpressionRightTypes[expression.ID()]
+		resultType := interpreter.Program.Elaboration.BinaryExpressionResultTypes[expression.ID()]
 
 		// NOTE: important to convert both any and optional
 		return interpreter.ConvertAndBox(getLocationRange, value, rightType, resultType)
@@ -490,6 +527,10 @@ func (interpreter *Interpreter) VisitUnaryExpression(expression *ast.UnaryExpres
 	case ast.OperationMove:
 		interpreter.invalidateResource(value)
 		return value
+
+	case ast.OperationMul:
+		getLocationRange := locationRangeGetter(interpreter, interpreter.Location, expression)
+		return interpreter.dereference(value, getLocationRange)
 	}
 
 	panic(&unsupportedOperation{
@@ -499,6 +540,43 @@ func (interpreter *Interpreter) VisitUnaryExpression(expression *ast.UnaryExpres
 	})
 }
 
+// dereference returns a copy of the value referenced by the given reference.
+// The checker ensures that the referenced value is not a resource
+//
+func (interpreter *Interpreter) dereference(value Value, getLocationRange func() LocationRange) Value {
+	var referencedValue *Value
+
+	switch reference := value.(type) {
+	case *EphemeralReferenceValue:
+		referencedValue = reference.ReferencedValue(interpreter, getLocationRange)
+
+	case *StorageReferenceValue:
+		var err error
+		referencedValue, err = reference.dereference(interpreter, getLocationRange)
+		if err != nil {
+			panic(err)
+		}
+
+	default:
+		panic(errors.NewUnreachableError())
+	}
+
+	// The referenced value may have been moved out of storage in the meantime
+	if referencedValue == nil {
+		panic(DereferenceError{
+			LocationRange: getLocationRange(),
+		})
+	}
+
+	return (*referencedValue).Transfer(
+		interpreter,
+		getLocationRange,
+		atree.Address{},
+		false,
+		nil,
+	)
+}
+
 func (interpreter *Interpreter) VisitBoolExpression(expression *ast.BoolExpression) ast.Repr {
 	return NewBoolValue(interpreter, expression.Value)
 }
@@ -508,7 +586,7 @@ func (interpreter *Interpreter) VisitNilExpression(_ *ast.NilExpression) ast.Rep
 }
 
 func (interpreter *Interpreter) VisitIntegerExpression(expression *ast.IntegerExpression) ast.Repr {
-	typ := interpreter.Program.Elaboration.IntegerExpressionType[expression]
+	typ := interpreter.Program.Elaboration.IntegerExpressionType[expression.ID()]
 
 	value := expression.Value
 
@@ -610,22 +688,52 @@ func (interpreter *Interpreter) NewIntegerValueFromBigInt(value *big.Int, intege
 func (interpreter *Interpreter) VisitFixedPointExpression(expression *ast.FixedPointExpression) ast.Repr {
 	// TODO: adjust once/if we support more fixed point types
 
-	fixedPointSubType := interpreter.Program.Elaboration.FixedPointExpression[expression]
+	fixedPointSubType := interpreter.Program.Elaboration.FixedPointExpression[expression.ID()]
+
+	scale := uint(sema.Fix64Scale)
+	switch fixedPointSubType {
+	case sema.Fix128Type, sema.UFix128Type:
+		scale = sema.Fix128Scale
+	}
 
 	value := fixedpoint.ConvertToFixedPointBigInt(
 		expression.Negative,
 		expression.UnsignedInteger,
 		expression.Fractional,
 		expression.Scale,
-		sema.Fix64Scale,
+		scale,
 	)
+
+	// The ranges are checked at the checker level.
+	// Hence, it is safe to create the value without validation.
+	return interpreter.NewFixedPointValueFromBigInt(value, fixedPointSubType)
+}
+
+// NewFixedPointValueFromBigInt returns a fixed-point value of the given type
+// for the given value, which must already be scaled to the type's scale.
+//
+func (interpreter *Interpreter) NewFixedPointValueFromBigInt(value *big.Int, fixedPointSubType sema.Type) Value {
 	switch fixedPointSubType {
 	case sema.Fix64Type, sema.SignedFixedPointType:
 		return NewFix64Value(interpreter, value.Int64)
 	case sema.UFix64Type:
 		return NewUFix64Value(interpreter, value.Uint64)
+	case sema.Fix128Type:
+		return NewFix128ValueFromBigInt(
+			interpreter,
+			func() *big.Int {
+				return value
+			},
+		)
+	case sema.UFix128Type:
+		return NewUFix128ValueFromBigInt(
+			interpreter,
+			func() *big.Int {
+				return value
+			},
+		)
 	case sema.FixedPointType:
-		if expression.Negative {
+		if value.Sign() < 0 {
 			return NewFix64Value(interpreter, value.Int64)
 		} else {
 			return NewUFix64Value(interpreter, value.Uint64)
@@ -636,7 +744,7 @@ func (interpreter *Interpreter) VisitFixedPointExpression(expression *ast.FixedP
 }
 
 func (interpreter *Interpreter) VisitStringExpression(expression *ast.StringExpression) ast.Repr {
-	stringType := interpreter.Program.Elaboration.StringExpressionType[expression]
+	stringType := interpreter.Program.Elaboration.StringExpressionType[expression.ID()]
 
 	switch stringType {
 	case sema.CharacterType:
@@ -647,11 +755,74 @@ func (interpreter *Interpreter) VisitStringExpression(expression *ast.StringExpr
 	return NewUnmeteredStringValue(expression.Value)
 }
 
+func (interpreter *Interpreter) VisitStringTemplateExpression(expression *ast.StringTemplateExpression) ast.Repr {
+
+	// Convert all interpolated values to strings
+
+	operands := make([]string, len(expression.Expressions))
+
+	length := 0
+	for _, value := range expression.Values {
+		length = safeAdd(length, len(value))
+	}
+
+	for i, operandExpression := range expression.Expressions {
+		value := interpreter.evalExpression(operandExpression)
+		operand := stringTemplateOperandString(value)
+		operands[i] = operand
+		length = safeAdd(length, len(operand))
+	}
+
+	memoryUsage := common.NewStringMemoryUsage(length)
+
+	return NewStringValue(
+		interpreter,
+		memoryUsage,
+		func() string {
+			var sb strings.Builder
+
+			for i, value := range expression.Values {
+				sb.WriteString(value)
+				if i < len(operands) {
+					sb.WriteString(operands[i])
+				}
+			}
+
+			return sb.String()
+		},
+	)
+}
+
+// stringTemplateOperandString returns the string representation
+// of an interpolated value of a string template.
+//
+// The checker ensures the value has a string-convertible type,
+// see sema.IsStringConvertibleType.
+//
+func stringTemplateOperandString(value Value) string {
+	switch value := value.(type) {
+	case *StringValue:
+		return value.Str
+	case CharacterValue:
+		return string(value)
+	case BoolValue:
+		return value.String()
+	case NumberValue:
+		return value.String()
+	case AddressValue:
+		return value.String()
+	case PathValue:
+		return value.String()
+	default:
+		panic(errors.NewUnreachableError())
+	}
+}
+
 func (interpreter *Interpreter) VisitArrayExpression(expression *ast.ArrayExpression) ast.Repr {
 	values := interpreter.visitExpressionsNonCopying(expression.Values)
 
-	argumentTypes := interpreter.Program.Elaboration.ArrayExpressionArgumentTypes[expression]
-	arrayType := interpreter.Program.Elaboration.ArrayExpressionArrayType[expression]
+	argumentTypes := interpreter.Program.Elaboration.ArrayExpressionArgumentTypes[expression.ID()]
+	arrayType := interpreter.Program.Elaboration.ArrayExpressionArrayType[expression.ID()]
 	elementType := arrayType.ElementType(false)
 
 	copies := make([]Value, len(values))
@@ -663,7 +834,10 @@ func (interpreter *Interpreter) VisitArrayExpression(expression *ast.ArrayExpres
 	}
 
 	// TODO: cache
-	arrayStaticType := ConvertSemaArrayTypeToStaticArrayType(interpreter, arrayType)
+	arrayStaticType := ConvertSemaArrayTypeToStaticArrayType(
+		interpreter,
+		interpreter.resolveGenericType(arrayType).(sema.ArrayType),
+	)
 
 	getLocationRange := locationRangeGetter(interpreter, interpreter.Location, expression)
 
@@ -676,11 +850,34 @@ func (interpreter *Interpreter) VisitArrayExpression(expression *ast.ArrayExpres
 	)
 }
 
+func (interpreter *Interpreter) VisitTupleExpression(expression *ast.TupleExpression) ast.Repr {
+	values := interpreter.visitExpressionsNonCopying(expression.Elements)
+
+	argumentTypes := interpreter.Program.Elaboration.TupleExpressionArgumentTypes[expression.ID()]
+	tupleType := interpreter.Program.Elaboration.TupleExpressionTupleType[expression.ID()]
+
+	elements := make([]Value, len(values))
+	for i, argument := range values {
+		argumentType := argumentTypes[i]
+		elementExpression := expression.Elements[i]
+		getLocationRange := locationRangeGetter(interpreter, interpreter.Location, elementExpression)
+		elements[i] = interpreter.transferAndConvert(argument, argumentType, tupleType.ElementTypes[i], getLocationRange)
+	}
+
+	// TODO: cache
+	tupleStaticType := ConvertSemaToStaticType(
+		interpreter,
+		interpreter.resolveGenericType(tupleType),
+	).(*TupleStaticType)
+
+	return NewTupleValue(interpreter, tupleStaticType, elements)
+}
+
 func (interpreter *Interpreter) VisitDictionaryExpression(expression *ast.DictionaryExpression) ast.Repr {
 	values := interpreter.visitEntries(expression.Entries)
 
-	entryTypes := interpreter.Program.Elaboration.DictionaryExpressionEntryTypes[expression]
-	dictionaryType := interpreter.Program.Elaboration.DictionaryExpressionType[expression]
+	entryTypes := interpreter.Program.Elaboration.DictionaryExpressionEntryTypes[expression.ID()]
+	dictionaryType := interpreter.Program.Elaboration.DictionaryExpressionType[expression.ID()]
 
 	var keyValuePairs []Value
 
@@ -709,7 +906,10 @@ func (interpreter *Interpreter) VisitDictionaryExpression(expression *ast.Dictio
 		)
 	}
 
-	dictionaryStaticType := ConvertSemaDictionaryTypeToStaticDictionaryType(interpreter, dictionaryType)
+	dictionaryStaticType := ConvertSemaDictionaryTypeToStaticDictionaryType(
+		interpreter,
+		interpreter.resolveGenericType(dictionaryType).(*sema.DictionaryType),
+	)
 
 	getLocationRange := locationRangeGetter(interpreter, interpreter.Location, expression)
 
@@ -727,6 +927,14 @@ func (interpreter *Interpreter) VisitMemberExpression(expression *ast.MemberExpr
 }
 
 func (interpreter *Interpreter) VisitIndexExpression(expression *ast.IndexExpression) ast.Repr {
+	if attachmentType, ok := interpreter.Program.Elaboration.AttachmentAccessTypes[expression.ID()]; ok {
+		return interpreter.visitAttachmentAccess(expression, attachmentType)
+	}
+
+	if index, ok := interpreter.Program.Elaboration.TupleElementAccessIndices[expression.ID()]; ok {
+		return interpreter.visitTupleElementAccess(expression, index)
+	}
+
 	typedResult, ok := interpreter.evalExpression(expression.TargetExpression).(ValueIndexableValue)
 	if !ok {
 		panic(errors.NewUnreachableError())
@@ -736,6 +944,119 @@ func (interpreter *Interpreter) VisitIndexExpression(expression *ast.IndexExpres
 	return typedResult.GetKey(interpreter, getLocationRange, indexingValue)
 }
 
+// visitTupleElementAccess evaluates an index expression which accesses an element of a tuple,
+// e.g. `t[0]`. The index was already checked statically
+//
+func (interpreter *Interpreter) visitTupleElementAccess(
+	expression *ast.IndexExpression,
+	index int,
+) Value {
+	tuple, ok := interpreter.evalExpression(expression.TargetExpression).(*TupleValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	return tuple.Get(index)
+}
+
+// visitAttachmentAccess evaluates an index expression which accesses an attachment,
+// e.g. `r[A]`, and returns an optional reference to the attachment
+//
+func (interpreter *Interpreter) visitAttachmentAccess(
+	expression *ast.IndexExpression,
+	attachmentType *sema.CompositeType,
+) Value {
+	getLocationRange := locationRangeGetter(interpreter, interpreter.Location, expression)
+
+	target := interpreter.evalExpression(expression.TargetExpression)
+	base := interpreter.attachmentBase(target, getLocationRange)
+
+	attachment := base.GetAttachment(interpreter, getLocationRange, attachmentType.ID())
+	if attachment == nil {
+		return NewNilValue(interpreter)
+	}
+
+	return NewSomeValueNonCopying(
+		interpreter,
+		NewEphemeralReferenceValue(interpreter, false, attachment, attachmentType),
+	)
+}
+
+// attachmentBase returns the composite value which has the attachments,
+// i.e. the given value itself, or the referenced value
+//
+func (interpreter *Interpreter) attachmentBase(value Value, getLocationRange func() LocationRange) *CompositeValue {
+	var referencedValue *Value
+
+	switch value := value.(type) {
+	case *CompositeValue:
+		return value
+
+	case *EphemeralReferenceValue:
+		referencedValue = value.ReferencedValue(interpreter, getLocationRange)
+
+	case *StorageReferenceValue:
+		referencedValue = value.ReferencedValue(interpreter)
+
+	default:
+		panic(errors.NewUnreachableError())
+	}
+
+	if referencedValue == nil {
+		panic(DereferenceError{
+			LocationRange: getLocationRange(),
+		})
+	}
+
+	interpreter.checkReferencedResourceNotDestroyed(*referencedValue, getLocationRange)
+
+	base, ok := (*referencedValue).(*CompositeValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	return base
+}
+
+func (interpreter *Interpreter) VisitAttachExpression(attachExpression *ast.AttachExpression) ast.Repr {
+
+	attachmentType := interpreter.Program.Elaboration.AttachExpressionAttachmentTypes[attachExpression.ID()]
+
+	getLocationRange := locationRangeGetter(interpreter, interpreter.Location, attachExpression)
+
+	// NOTE: the base is transferred, i.e. copied if it is a structure,
+	// or moved if it is a resource, as it is the result of the attach expression
+
+	base, ok := interpreter.evalExpression(attachExpression.Base).
+		Transfer(
+			interpreter,
+			getLocationRange,
+			atree.Address{},
+			false,
+			nil,
+		).(*CompositeValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	if base.GetAttachment(interpreter, getLocationRange, attachmentType.ID()) != nil {
+		panic(DuplicateAttachmentError{
+			AttachmentType: attachmentType,
+			Value:          base,
+			LocationRange:  getLocationRange(),
+		})
+	}
+
+	attachment, ok := interpreter.visitInvocationExpressionWithBase(attachExpression.Attachment, base).(*CompositeValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	base.SetAttachment(interpreter, getLocationRange, attachment)
+
+	return base
+}
+
 func (interpreter *Interpreter) VisitConditionalExpression(expression *ast.ConditionalExpression) ast.Repr {
 	value, ok := interpreter.evalExpression(expression.Test).(BoolValue)
 	if !ok {
@@ -749,6 +1070,17 @@ func (interpreter *Interpreter) VisitConditionalExpression(expression *ast.Condi
 }
 
 func (interpreter *Interpreter) VisitInvocationExpression(invocationExpression *ast.InvocationExpression) ast.Repr {
+	return interpreter.visitInvocationExpressionWithBase(invocationExpression, nil)
+}
+
+// visitInvocationExpressionWithBase evaluates the invocation expression.
+// The base is the value an attachment is attached to,
+// and is only given when an attachment is constructed.
+//
+func (interpreter *Interpreter) visitInvocationExpressionWithBase(
+	invocationExpression *ast.InvocationExpression,
+	base *CompositeValue,
+) Value {
 
 	// tracing
 	if interpreter.tracingEnabled {
@@ -806,14 +1138,28 @@ func (interpreter *Interpreter) VisitInvocationExpression(invocationExpression *
 
 	elaboration := interpreter.Program.Elaboration
 
-	typeParameterTypes := elaboration.InvocationExpressionTypeArguments[invocationExpression]
-	argumentTypes := elaboration.InvocationExpressionArgumentTypes[invocationExpression]
-	parameterTypes := elaboration.InvocationExpressionParameterTypes[invocationExpression]
+	typeParameterTypes := interpreter.resolveGenericTypeArguments(
+		elaboration.InvocationExpressionTypeArguments[invocationExpression.ID()],
+	)
+	argumentTypes := elaboration.InvocationExpressionArgumentTypes[invocationExpression.ID()]
+	parameterTypes := elaboration.InvocationExpressionParameterTypes[invocationExpression.ID()]
 
 	line := invocationExpression.StartPosition().Line
 
 	interpreter.reportFunctionInvocation(line)
 
+	// NOTE: also report the return if the invocation fails,
+	// as the failure might be recovered from, e.g. in a try expression
+	defer interpreter.reportInvokedFunctionReturn(line)
+
+	if interpreter.onHostFunctionInvocation != nil && isHostFunction(function) {
+		functionName := invocationExpression.InvokedExpression.String()
+		onReturn := interpreter.onHostFunctionInvocation(interpreter, functionName)
+		if onReturn != nil {
+			defer onReturn()
+		}
+	}
+
 	resultValue := interpreter.invokeFunctionValue(
 		function,
 		arguments,
@@ -822,10 +1168,9 @@ func (interpreter *Interpreter) VisitInvocationExpression(invocationExpression *
 		parameterTypes,
 		typeParameterTypes,
 		invocationExpression,
+		base,
 	)
 
-	interpreter.reportInvokedFunctionReturn(line)
-
 	// If this is invocation is optional chaining, wrap the result
 	// as an optional, as the result is expected to be an optional
 	if isOptionalChaining {
@@ -838,6 +1183,24 @@ func (interpreter *Interpreter) VisitInvocationExpression(invocationExpression *
 func (interpreter *Interpreter) visitExpressionsNonCopying(expressions []ast.Expression) []Value {
 	values := make([]Value, 0, len(expressions))
 
+	// The values of the already evaluated expressions are held in temporaries,
+	// so they are lost if the evaluation of a following expression fails
+
+	if interpreter.resourceLossDiagnosticsEnabled {
+		defer func() {
+			r := recover()
+			if r == nil {
+				return
+			}
+
+			for i, value := range values {
+				r = interpreter.resourceTemporaryLoss(r, value, expressions[i])
+			}
+
+			panic(r)
+		}()
+	}
+
 	for _, expression := range expressions {
 		value := interpreter.evalExpression(expression)
 		values = append(values, value)
@@ -849,6 +1212,24 @@ func (interpreter *Interpreter) visitExpressionsNonCopying(expressions []ast.Exp
 func (interpreter *Interpreter) visitEntries(entries []ast.DictionaryEntry) []DictionaryEntryValues {
 	values := make([]DictionaryEntryValues, 0, len(entries))
 
+	// The values of the already evaluated entries are held in temporaries,
+	// so they are lost if the evaluation of a following entry fails
+
+	if interpreter.resourceLossDiagnosticsEnabled {
+		defer func() {
+			r := recover()
+			if r == nil {
+				return
+			}
+
+			for i, entryValues := range values {
+				r = interpreter.resourceTemporaryLoss(r, entryValues.Value, entries[i].Value)
+			}
+
+			panic(r)
+		}()
+	}
+
 	for _, entry := range entries {
 		key := interpreter.evalExpression(entry.Key)
 		value := interpreter.evalExpression(entry.Value)
@@ -870,7 +1251,7 @@ func (interpreter *Interpreter) VisitFunctionExpression(expression *ast.Function
 	// lexical scope: variables in functions are bound to what is visible at declaration time
 	lexicalScope := interpreter.activations.CurrentOrNew()
 
-	functionType := interpreter.Program.Elaboration.FunctionExpressionFunctionType[expression]
+	functionType := interpreter.Program.Elaboration.FunctionExpressionFunctionType[expression.ID()]
 
 	var preConditions ast.Conditions
 	if expression.FunctionBlock.PreConditions != nil {
@@ -907,11 +1288,14 @@ func (interpreter *Interpreter) VisitCastingExpression(expression *ast.CastingEx
 
 	getLocationRange := locationRangeGetter(interpreter, interpreter.Location, expression.Expression)
 
-	expectedType := interpreter.Program.Elaboration.CastingTargetTypes[expression]
+	expectedType := interpreter.resolveGenericType(
+		interpreter.Program.Elaboration.CastingTargetTypes[expression.ID()],
+	)
 
 	switch expression.Operation {
 	case ast.OperationFailableCast, ast.OperationForceCast:
-		isSubType := interpreter.IsSubTypeOfSemaType(value.StaticType(interpreter), expectedType)
+		valueStaticType := value.StaticType(interpreter)
+		isSubType := interpreter.IsSubTypeOfSemaType(valueStaticType, expectedType)
 
 		switch expression.Operation {
 		case ast.OperationFailableCast:
@@ -929,6 +1313,7 @@ func (interpreter *Interpreter) VisitCastingExpression(expression *ast.CastingEx
 				getLocationRange := locationRangeGetter(interpreter, interpreter.Location, expression.Expression)
 				panic(ForceCastTypeMismatchError{
 					ExpectedType:  expectedType,
+					ActualType:    interpreter.MustConvertStaticToSemaType(valueStaticType),
 					LocationRange: getLocationRange(),
 				})
 			}
@@ -941,7 +1326,7 @@ func (interpreter *Interpreter) VisitCastingExpression(expression *ast.CastingEx
 		}
 
 	case ast.OperationCast:
-		staticValueType := interpreter.Program.Elaboration.CastingStaticValueTypes[expression]
+		staticValueType := interpreter.Program.Elaboration.CastingStaticValueTypes[expression.ID()]
 		// The cast may upcast to an optional type, e.g. `1 as Int?`, so box
 		return interpreter.ConvertAndBox(getLocationRange, value, staticValueType, expectedType)
 
@@ -968,7 +1353,9 @@ func (interpreter *Interpreter) VisitDestroyExpression(expression *ast.DestroyEx
 
 func (interpreter *Interpreter) VisitReferenceExpression(referenceExpression *ast.ReferenceExpression) ast.Repr {
 
-	borrowType := interpreter.Program.Elaboration.ReferenceExpressionBorrowTypes[referenceExpression]
+	borrowType := interpreter.resolveGenericType(
+		interpreter.Program.Elaboration.ReferenceExpressionBorrowTypes[referenceExpression.ID()],
+	)
 
 	result := interpreter.evalExpression(referenceExpression.Expression)
 
@@ -996,15 +1383,15 @@ func (interpreter *Interpreter) VisitReferenceExpression(referenceExpression *as
 				interpreter.trackReferencedResourceKindedValue(result.StorageID(), result)
 			}
 
-			return NewSomeValueNonCopying(
+			reference := NewEphemeralReferenceValue(
 				interpreter,
-				NewEphemeralReferenceValue(
-					interpreter,
-					innerBorrowType.Authorized,
-					innerValue,
-					innerBorrowType.Type,
-				),
+				innerBorrowType.Authorized,
+				innerValue,
+				innerBorrowType.Type,
 			)
+			interpreter.trackResourceReference(reference)
+
+			return NewSomeValueNonCopying(interpreter, reference)
 
 		case NilValue:
 			return NewNilValue(interpreter)
@@ -1016,20 +1403,26 @@ func (interpreter *Interpreter) VisitReferenceExpression(referenceExpression *as
 
 			getLocationRange := locationRangeGetter(interpreter, interpreter.Location, referenceExpression)
 
+			reference := NewEphemeralReferenceValue(
+				interpreter,
+				innerBorrowType.Authorized,
+				result,
+				innerBorrowType.Type,
+			)
+			interpreter.trackResourceReference(reference)
+
 			return interpreter.BoxOptional(
 				getLocationRange,
-				NewEphemeralReferenceValue(
-					interpreter,
-					innerBorrowType.Authorized,
-					result,
-					innerBorrowType.Type,
-				),
+				reference,
 				borrowType,
 			)
 		}
 
 	case *sema.ReferenceType:
-		return NewEphemeralReferenceValue(interpreter, typ.Authorized, result, typ.Type)
+		reference := NewEphemeralReferenceValue(interpreter, typ.Authorized, result, typ.Type)
+		interpreter.trackResourceReference(reference)
+
+		return reference
 	}
 	panic(errors.NewUnreachableError())
 }
@@ -1072,3 +1465,96 @@ func (interpreter *Interpreter) VisitPathExpression(expression *ast.PathExpressi
 		expression.Identifier.Identifier,
 	)
 }
+
+func (interpreter *Interpreter) VisitTryExpression(expression *ast.TryExpression) ast.Repr {
+	return interpreter.recoverableCall(func() Value {
+		return interpreter.evalExpression(expression.Expression)
+	})
+}
+
+// recoverableCall calls the given function and returns its result as an optional.
+// If the function fails with a user error, nil is returned instead of aborting,
+// and the state of the interpreter is unwound to the state before the call.
+//
+// Only failures of calls which had no effects that cannot be undone are recovered from,
+// see EffectTracker.
+// All other errors, e.g. internal errors, external errors, and memory errors,
+// are propagated, i.e. they abort the program.
+//
+func (interpreter *Interpreter) recoverableCall(f func() Value) (result OptionalValue) {
+
+	var effectTracker *EffectTracker
+	if storage, ok := interpreter.Storage.(EffectTrackingStorage); ok {
+		effectTracker = storage.EffectTracker()
+	}
+
+	callStackDepth := len(interpreter.CallStack.Invocations)
+	activationsDepth := interpreter.activations.Depth()
+	statement := interpreter.statement
+
+	if effectTracker != nil {
+		effectTracker.Start()
+	}
+
+	defer func() {
+		r := recover()
+
+		recovering := r != nil &&
+			effectTracker != nil &&
+			!effectTracker.HasEffects() &&
+			isRecoverableError(r)
+
+		if effectTracker != nil {
+			effectTracker.Stop(recovering)
+		}
+
+		if r == nil {
+			return
+		}
+
+		if !recovering {
+			panic(r)
+		}
+
+		// Unwind to the state before the call
+
+		for len(interpreter.CallStack.Invocations) > callStackDepth {
+			interpreter.CallStack.Pop()
+		}
+
+		for interpreter.activations.Depth() > activationsDepth {
+			interpreter.activations.Pop()
+		}
+
+		interpreter.statement = statement
+
+		result = NewNilValue(interpreter)
+	}()
+
+	return NewSomeValueNonCopying(interpreter, f())
+}
+
+// isRecoverableError returns true if the given recovered value
+// is an error of the user program that a recoverable call may recover from.
+//
+func isRecoverableError(r any) bool {
+	err, ok := r.(error)
+	if !ok {
+		return false
+	}
+
+	if errors.IsInternalError(err) {
+		return false
+	}
+
+	if _, ok := errors.GetExternalError(err); ok {
+		return false
+	}
+
+	var memoryError errors.MemoryError
+	if goErrors.As(err, &memoryError) {
+		return false
+	}
+
+	return errors.IsUserError(err)
+}
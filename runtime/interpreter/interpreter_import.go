@@ -28,16 +28,19 @@ import (
 
 func (interpreter *Interpreter) VisitImportDeclaration(declaration *ast.ImportDeclaration) ast.Repr {
 
-	resolvedLocations := interpreter.Program.Elaboration.ImportDeclarationsResolvedLocations[declaration]
+	resolvedLocations := interpreter.Program.Elaboration.ImportDeclarationsResolvedLocations[declaration.ID()]
 
 	for _, resolvedLocation := range resolvedLocations {
-		interpreter.importResolvedLocation(resolvedLocation)
+		interpreter.importResolvedLocation(declaration, resolvedLocation)
 	}
 
 	return nil
 }
 
-func (interpreter *Interpreter) importResolvedLocation(resolvedLocation sema.ResolvedLocation) {
+func (interpreter *Interpreter) importResolvedLocation(
+	declaration *ast.ImportDeclaration,
+	resolvedLocation sema.ResolvedLocation,
+) {
 
 	// tracing
 	if interpreter.tracingEnabled {
@@ -90,8 +93,12 @@ func (interpreter *Interpreter) importResolvedLocation(resolvedLocation sema.Res
 			}
 		}
 
-		interpreter.setVariable(name, variable)
-		interpreter.Globals.Set(name, variable)
+		// imported values may be declared under an alias
+
+		declaredName := declaration.DeclaredName(name)
+
+		interpreter.setVariable(declaredName, variable)
+		interpreter.Globals.Set(declaredName, variable)
 	}
 
 }
@@ -22,6 +22,8 @@ import (
 	"github.com/onflow/atree"
 
 	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
 	"github.com/onflow/cadence/runtime/sema"
 )
 
@@ -49,6 +51,7 @@ func (interpreter *Interpreter) InvokeFunctionValue(
 		parameterTypes,
 		nil,
 		invocationPosition,
+		nil,
 	), nil
 }
 
@@ -60,6 +63,7 @@ func (interpreter *Interpreter) invokeFunctionValue(
 	parameterTypes []sema.Type,
 	typeParameterTypes *sema.TypeParameterTypeOrderedMap,
 	invocationPosition ast.HasPosition,
+	base *CompositeValue,
 ) Value {
 
 	parameterTypeCount := len(parameterTypes)
@@ -106,6 +110,7 @@ func (interpreter *Interpreter) invokeFunctionValue(
 		typeParameterTypes,
 		getLocationRange,
 	)
+	invocation.Base = base
 
 	return function.invoke(invocation)
 }
@@ -115,22 +120,156 @@ func (interpreter *Interpreter) invokeInterpretedFunction(
 	invocation Invocation,
 ) Value {
 
+	callStackDepth := uint64(len(interpreter.CallStack.Invocations))
+	if interpreter.callStackDepthLimit > 0 && callStackDepth >= interpreter.callStackDepthLimit {
+		panic(CallStackDepthLimitExceededError{
+			Limit:         interpreter.callStackDepthLimit,
+			LocationRange: invocation.GetLocationRange(),
+		})
+	}
+
 	// Start a new activation record.
 	// Lexical scope: use the function declaration's activation record,
 	// not the current one (which would be dynamic scope)
 	interpreter.activations.PushNewWithParent(function.Activation)
 	interpreter.activations.Current().isFunction = true
 
+	invocation.Function = function
 	interpreter.CallStack.Push(invocation)
 
 	// Make `self` available, if any
 	if invocation.Self != nil {
 		interpreter.declareVariable(sema.SelfIdentifier, invocation.Self)
+		interpreter.declareBaseValue(invocation.Self)
 	}
 
 	return interpreter.invokeInterpretedFunctionActivated(function, invocation.Arguments)
 }
 
+// declareBaseValue declares the `base` value in functions of attachments,
+// a reference to the value the attachment is attached to
+//
+func (interpreter *Interpreter) declareBaseValue(self MemberAccessibleValue) {
+	attachment, ok := self.(*CompositeValue)
+	if !ok ||
+		attachment.Kind != common.CompositeKindAttachment ||
+		attachment.base == nil {
+
+		return
+	}
+
+	base := attachment.base
+	baseType := interpreter.MustConvertStaticToSemaType(base.StaticType(interpreter))
+
+	interpreter.declareVariable(
+		sema.BaseIdentifier,
+		NewEphemeralReferenceValue(interpreter, false, base, baseType),
+	)
+}
+
+// genericTypeArguments returns the type arguments of the invocations on the call stack,
+// or nil if none of the invoked functions is generic.
+// Type arguments of more recent invocations take precedence.
+//
+// The functions of a generic composite type are invoked with the type arguments
+// of the value they are invoked on, e.g. `T` is `Int` in the functions of a `Box<Int>`
+//
+func (interpreter *Interpreter) genericTypeArguments() *sema.TypeParameterTypeOrderedMap {
+	var typeArguments *sema.TypeParameterTypeOrderedMap
+
+	addTypeArgument := func(typeParameter *sema.TypeParameter, ty sema.Type) {
+		if typeArguments == nil {
+			typeArguments = &sema.TypeParameterTypeOrderedMap{}
+		}
+		if _, ok := typeArguments.Get(typeParameter); ok {
+			return
+		}
+		typeArguments.Set(typeParameter, ty)
+	}
+
+	invocations := interpreter.CallStack.Invocations
+	for i := len(invocations) - 1; i >= 0; i-- {
+		invocation := invocations[i]
+
+		invocationTypeArguments := invocation.TypeParameterTypes
+		if invocationTypeArguments != nil {
+			invocationTypeArguments.Foreach(addTypeArgument)
+		}
+
+		self, ok := invocation.Self.(*CompositeValue)
+		if !ok || len(self.TypeArguments) == 0 {
+			continue
+		}
+
+		selfType, ok := interpreter.MustConvertStaticToSemaType(self.StaticType(interpreter)).(*sema.CompositeType)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		genericType, ok := selfType.BaseType().(*sema.CompositeType)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		selfTypeArguments := selfType.TypeArguments()
+		for j, typeParameter := range genericType.TypeParameters() {
+			addTypeArgument(typeParameter, selfTypeArguments[j])
+		}
+	}
+
+	return typeArguments
+}
+
+// resolveGenericType resolves the generic types in the given type
+// to the type arguments of the invocations on the call stack.
+//
+// If a type argument is not available, e.g. because a function escaped
+// the invocation of the generic function it was declared in,
+// the type is returned as-is, and generic types are treated as their type bounds.
+//
+func (interpreter *Interpreter) resolveGenericType(ty sema.Type) sema.Type {
+	typeArguments := interpreter.genericTypeArguments()
+	if typeArguments == nil {
+		return ty
+	}
+
+	resolvedType := ty.Resolve(typeArguments)
+	if resolvedType == nil {
+		return ty
+	}
+
+	return resolvedType
+}
+
+// resolveGenericTypeArguments resolves the generic types in the given type arguments
+// of an invocation, see resolveGenericType
+//
+func (interpreter *Interpreter) resolveGenericTypeArguments(
+	typeArguments *sema.TypeParameterTypeOrderedMap,
+) *sema.TypeParameterTypeOrderedMap {
+
+	if typeArguments == nil || typeArguments.Len() == 0 {
+		return typeArguments
+	}
+
+	genericTypeArguments := interpreter.genericTypeArguments()
+	if genericTypeArguments == nil {
+		return typeArguments
+	}
+
+	resolvedTypeArguments := &sema.TypeParameterTypeOrderedMap{}
+
+	typeArguments.Foreach(func(typeParameter *sema.TypeParameter, ty sema.Type) {
+		resolvedType := ty.Resolve(genericTypeArguments)
+		if resolvedType == nil {
+			resolvedType = ty
+		}
+		resolvedTypeArguments.Set(typeParameter, resolvedType)
+	})
+
+	return resolvedTypeArguments
+}
+
 // NOTE: assumes the function's activation (or an extension of it) is pushed!
 //
 func (interpreter *Interpreter) invokeInterpretedFunctionActivated(
@@ -161,14 +300,59 @@ func (interpreter *Interpreter) invokeInterpretedFunctionActivated(
 	)
 }
 
-// bindParameterArguments binds the argument values to the given parameters
+// bindParameterArguments binds the argument values to the given parameters.
+//
+// If fewer arguments than parameters are given,
+// the default arguments of the remaining parameters are used
 //
 func (interpreter *Interpreter) bindParameterArguments(
 	parameterList *ast.ParameterList,
 	arguments []Value,
 ) {
-	for parameterIndex, parameter := range parameterList.Parameters {
+	parameters := parameterList.Parameters
+
+	argumentCount := len(arguments)
+	if argumentCount < len(parameters) {
+
+		// NOTE: evaluate all default arguments before declaring any parameters,
+		// so the default arguments cannot refer to the parameters
+
+		defaultArguments := make([]Value, 0, len(parameters)-argumentCount)
+		for _, parameter := range parameters[argumentCount:] {
+			defaultArguments = append(
+				defaultArguments,
+				interpreter.evalParameterDefaultArgument(parameter),
+			)
+		}
+
+		arguments = append(arguments[:argumentCount:argumentCount], defaultArguments...)
+	}
+
+	for parameterIndex, parameter := range parameters {
 		argument := arguments[parameterIndex]
 		interpreter.declareVariable(parameter.Identifier.Identifier, argument)
 	}
 }
+
+// evalParameterDefaultArgument evaluates the default argument of the given parameter,
+// and converts it to the type of the parameter
+//
+func (interpreter *Interpreter) evalParameterDefaultArgument(parameter *ast.Parameter) Value {
+	defaultArgument := parameter.DefaultArgument
+	if defaultArgument == nil {
+		panic(errors.NewUnreachableError())
+	}
+
+	value := interpreter.evalExpression(defaultArgument)
+
+	defaultArgumentTypes := interpreter.Program.Elaboration.ParameterDefaultArgumentTypes[defaultArgument.ID()]
+
+	getLocationRange := locationRangeGetter(interpreter, interpreter.Location, defaultArgument)
+
+	return interpreter.transferAndConvert(
+		value,
+		defaultArgumentTypes.ValueType,
+		defaultArgumentTypes.ParameterType,
+		getLocationRange,
+	)
+}
@@ -73,8 +73,8 @@ func (interpreter *Interpreter) VisitReturnStatement(statement *ast.ReturnStatem
 	} else {
 		value = interpreter.evalExpression(statement.Expression)
 
-		valueType := interpreter.Program.Elaboration.ReturnStatementValueTypes[statement]
-		returnType := interpreter.Program.Elaboration.ReturnStatementReturnTypes[statement]
+		valueType := interpreter.Program.Elaboration.ReturnStatementValueTypes[statement.ID()]
+		returnType := interpreter.Program.Elaboration.ReturnStatementReturnTypes[statement.ID()]
 
 		getLocationRange := locationRangeGetter(interpreter, interpreter.Location, statement.Expression)
 
@@ -85,12 +85,26 @@ func (interpreter *Interpreter) VisitReturnStatement(statement *ast.ReturnStatem
 	return functionReturn{value}
 }
 
-func (interpreter *Interpreter) VisitBreakStatement(_ *ast.BreakStatement) ast.Repr {
-	return controlBreak{}
+func (interpreter *Interpreter) VisitBreakStatement(statement *ast.BreakStatement) ast.Repr {
+	return controlBreak{
+		Label: loopLabel(statement.Label),
+	}
+}
+
+func (interpreter *Interpreter) VisitContinueStatement(statement *ast.ContinueStatement) ast.Repr {
+	return controlContinue{
+		Label: loopLabel(statement.Label),
+	}
 }
 
-func (interpreter *Interpreter) VisitContinueStatement(_ *ast.ContinueStatement) ast.Repr {
-	return controlContinue{}
+// loopLabel returns the name of the given optional label,
+// or the empty string if there is no label
+//
+func loopLabel(label *ast.Identifier) string {
+	if label == nil {
+		return ""
+	}
+	return label.Identifier
 }
 
 func (interpreter *Interpreter) VisitIfStatement(statement *ast.IfStatement) ast.Repr {
@@ -149,10 +163,10 @@ func (interpreter *Interpreter) visitIfStatementWithVariableDeclaration(
 		panic(errors.NewUnreachableError())
 	}
 
-	valueType := interpreter.Program.Elaboration.VariableDeclarationValueTypes[declaration]
+	valueType := interpreter.Program.Elaboration.VariableDeclarationValueTypes[declaration.ID()]
 
 	if declaration.SecondValue != nil {
-		secondValueType := interpreter.Program.Elaboration.VariableDeclarationSecondValueTypes[declaration]
+		secondValueType := interpreter.Program.Elaboration.VariableDeclarationSecondValueTypes[declaration.ID()]
 
 		interpreter.visitAssignment(
 			declaration.Transfer.Operation,
@@ -167,7 +181,7 @@ func (interpreter *Interpreter) visitIfStatementWithVariableDeclaration(
 	var result any
 	if someValue, ok := value.(*SomeValue); ok {
 
-		targetType := interpreter.Program.Elaboration.VariableDeclarationTargetTypes[declaration]
+		targetType := interpreter.Program.Elaboration.VariableDeclarationTargetTypes[declaration.ID()]
 		getLocationRange := locationRangeGetter(interpreter, interpreter.Location, declaration.Value)
 		innerValue := someValue.InnerValue(interpreter, getLocationRange)
 		transferredUnwrappedValue := interpreter.transferAndConvert(
@@ -201,10 +215,11 @@ func (interpreter *Interpreter) visitIfStatementWithVariableDeclaration(
 
 func (interpreter *Interpreter) VisitSwitchStatement(switchStatement *ast.SwitchStatement) ast.Repr {
 
-	testValue, ok := interpreter.evalExpression(switchStatement.Expression).(EquatableValue)
-	if !ok {
-		panic(errors.NewUnreachableError())
-	}
+	value := interpreter.evalExpression(switchStatement.Expression)
+
+	// NOTE: the test value is only required to be equatable
+	// if it is compared against case expressions
+	testValue, _ := value.(EquatableValue)
 
 	for _, switchCase := range switchStatement.Cases {
 
@@ -219,20 +234,60 @@ func (interpreter *Interpreter) VisitSwitchStatement(switchStatement *ast.Switch
 
 			result := block.Accept(interpreter)
 
-			if _, ok := result.(controlBreak); ok {
+			// An unlabeled break applies to the switch statement,
+			// a labeled break applies to an enclosing loop
+
+			if breakResult, ok := result.(controlBreak); ok && breakResult.Label == "" {
 				return nil
 			}
 
 			return result
 		}
 
-		// If the case has no expression it is the default case.
+		// If the case has no expression and no pattern, it is the default case.
 		// Evaluate it, i.e. all statements
 
-		if switchCase.Expression == nil {
+		if switchCase.IsDefault() {
 			return runStatements()
 		}
 
+		// If the case has an optional binding pattern,
+		// and the test value is not nil, bind the unwrapped value
+		// in a new scope, and evaluate the case's statements
+
+		if pattern := switchCase.Pattern; pattern != nil {
+			someValue, ok := value.(*SomeValue)
+			if !ok {
+				continue
+			}
+
+			getLocationRange := locationRangeGetter(interpreter, interpreter.Location, switchStatement.Expression)
+			innerValue := someValue.InnerValue(interpreter, getLocationRange)
+
+			// The unwrapped value is never a resource, so it can be copied
+			transferredValue := innerValue.Transfer(
+				interpreter,
+				getLocationRange,
+				atree.Address{},
+				false,
+				nil,
+			)
+
+			interpreter.activations.PushNewWithCurrent()
+			defer interpreter.activations.Pop()
+
+			interpreter.declareVariable(
+				pattern.Identifier.Identifier,
+				transferredValue,
+			)
+
+			return runStatements()
+		}
+
+		if testValue == nil {
+			panic(errors.NewUnreachableError())
+		}
+
 		// The case has an expression.
 		// Evaluate it and compare it to the test value
 
@@ -262,6 +317,8 @@ func (interpreter *Interpreter) VisitSwitchStatement(switchStatement *ast.Switch
 
 func (interpreter *Interpreter) VisitWhileStatement(statement *ast.WhileStatement) ast.Repr {
 
+	label := loopLabel(statement.Label)
+
 	for {
 
 		value, ok := interpreter.evalExpression(statement.Test).(BoolValue)
@@ -273,12 +330,17 @@ func (interpreter *Interpreter) VisitWhileStatement(statement *ast.WhileStatemen
 
 		result := statement.Block.Accept(interpreter)
 
-		switch result.(type) {
+		switch result := result.(type) {
 		case controlBreak:
+			if !result.appliesToLoop(label) {
+				return result
+			}
 			return nil
 
 		case controlContinue:
-			// NO-OP
+			if !result.appliesToLoop(label) {
+				return result
+			}
 
 		case functionReturn:
 			return result
@@ -301,6 +363,68 @@ func (interpreter *Interpreter) VisitForStatement(statement *ast.ForStatement) a
 	getLocationRange := locationRangeGetter(interpreter, interpreter.Location, statement)
 
 	value := interpreter.evalExpression(statement.Value)
+
+	var indexVariable *Variable
+	if statement.Index != nil {
+		indexVariable = interpreter.declareVariable(
+			statement.Index.Identifier,
+			NewIntValueFromInt64(interpreter, 0),
+		)
+	}
+
+	label := loopLabel(statement.Label)
+
+	// executeBody executes the loop body for the given element.
+	// It returns the result of the loop, and false if the loop is done
+
+	executeBody := func(element Value) (result ast.Repr, resume bool) {
+
+		interpreter.reportLoopIteration(statement)
+
+		variable.SetValue(element)
+
+		result = statement.Block.Accept(interpreter)
+
+		switch result := result.(type) {
+		case controlBreak:
+			if !result.appliesToLoop(label) {
+				return result, false
+			}
+			return nil, false
+
+		case controlContinue:
+			if !result.appliesToLoop(label) {
+				return result, false
+			}
+
+		case functionReturn:
+			return result, false
+		}
+
+		if indexVariable != nil {
+			currentIndex := indexVariable.GetValue().(IntValue)
+			nextIndex := currentIndex.Plus(interpreter, intOne)
+			indexVariable.SetValue(nextIndex)
+		}
+
+		return nil, true
+	}
+
+	// Ranges are iterated without materializing their elements
+
+	if rangeValue, ok := value.(*SimpleCompositeValue); ok {
+		var result ast.Repr
+		interpreter.forEachRangeElement(
+			rangeValue,
+			func(element IntegerValue) bool {
+				var resume bool
+				result, resume = executeBody(element)
+				return resume
+			},
+		)
+		return result
+	}
+
 	transferredValue := value.Transfer(
 		interpreter,
 		getLocationRange,
@@ -314,14 +438,6 @@ func (interpreter *Interpreter) VisitForStatement(statement *ast.ForStatement) a
 		panic(errors.NewExternalError(err))
 	}
 
-	var indexVariable *Variable
-	if statement.Index != nil {
-		indexVariable = interpreter.declareVariable(
-			statement.Index.Identifier,
-			NewIntValueFromInt64(interpreter, 0),
-		)
-	}
-
 	for {
 		var atreeValue atree.Value
 		atreeValue, err = iterator.Next()
@@ -333,32 +449,14 @@ func (interpreter *Interpreter) VisitForStatement(statement *ast.ForStatement) a
 			return nil
 		}
 
-		interpreter.reportLoopIteration(statement)
-
 		// atree.Array iterator returns low-level atree.Value,
 		// convert to high-level interpreter.Value
 		value := MustConvertStoredValue(interpreter, atreeValue)
 
-		variable.SetValue(value)
-
-		result := statement.Block.Accept(interpreter)
-
-		switch result.(type) {
-		case controlBreak:
-			return nil
-
-		case controlContinue:
-			// NO-OP
-
-		case functionReturn:
+		result, resume := executeBody(value)
+		if !resume {
 			return result
 		}
-
-		if indexVariable != nil {
-			currentIndex := indexVariable.GetValue().(IntValue)
-			nextIndex := currentIndex.Plus(interpreter, intOne)
-			indexVariable.SetValue(nextIndex)
-		}
 	}
 }
 
@@ -368,7 +466,7 @@ func (interpreter *Interpreter) VisitEmitStatement(statement *ast.EmitStatement)
 		panic(errors.NewUnreachableError())
 	}
 
-	eventType := interpreter.Program.Elaboration.EmitStatementEventTypes[statement]
+	eventType := interpreter.Program.Elaboration.EmitStatementEventTypes[statement.ID()]
 
 	getLocationRange := locationRangeGetter(interpreter, interpreter.Location, statement)
 
@@ -378,6 +476,8 @@ func (interpreter *Interpreter) VisitEmitStatement(statement *ast.EmitStatement)
 		})
 	}
 
+	interpreter.RecordEffect()
+
 	err := interpreter.onEventEmitted(interpreter, getLocationRange, event, eventType)
 	if err != nil {
 		panic(err)
@@ -386,10 +486,30 @@ func (interpreter *Interpreter) VisitEmitStatement(statement *ast.EmitStatement)
 	return nil
 }
 
+func (interpreter *Interpreter) VisitRemoveStatement(statement *ast.RemoveStatement) ast.Repr {
+	base, ok := interpreter.evalExpression(statement.Value).(*CompositeValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	attachmentType := interpreter.Program.Elaboration.AttachmentRemoveTypes[statement.ID()]
+
+	getLocationRange := locationRangeGetter(interpreter, interpreter.Location, statement)
+
+	base.RemoveAttachment(interpreter, getLocationRange, attachmentType.ID())
+
+	return nil
+}
+
 func (interpreter *Interpreter) VisitPragmaDeclaration(_ *ast.PragmaDeclaration) ast.Repr {
 	return nil
 }
 
+func (interpreter *Interpreter) VisitTypeAliasDeclaration(_ *ast.TypeAliasDeclaration) ast.Repr {
+	// Type aliases are resolved statically by the checker
+	return nil
+}
+
 // VisitVariableDeclaration first visits the declaration's value,
 // then declares the variable with the name bound to the value
 func (interpreter *Interpreter) VisitVariableDeclaration(declaration *ast.VariableDeclaration) ast.Repr {
@@ -416,9 +536,9 @@ func (interpreter *Interpreter) visitVariableDeclaration(
 	valueCallback func(identifier string, value Value),
 ) {
 
-	targetType := interpreter.Program.Elaboration.VariableDeclarationTargetTypes[declaration]
-	valueType := interpreter.Program.Elaboration.VariableDeclarationValueTypes[declaration]
-	secondValueType := interpreter.Program.Elaboration.VariableDeclarationSecondValueTypes[declaration]
+	targetType := interpreter.Program.Elaboration.VariableDeclarationTargetTypes[declaration.ID()]
+	valueType := interpreter.Program.Elaboration.VariableDeclarationValueTypes[declaration.ID()]
+	secondValueType := interpreter.Program.Elaboration.VariableDeclarationSecondValueTypes[declaration.ID()]
 
 	// NOTE: It is *REQUIRED* that the getter for the value is used
 	// instead of just evaluating value expression,
@@ -464,9 +584,41 @@ func (interpreter *Interpreter) visitVariableDeclaration(
 	)
 }
 
+func (interpreter *Interpreter) VisitTupleVariableDeclaration(declaration *ast.TupleVariableDeclaration) ast.Repr {
+
+	targetType := interpreter.Program.Elaboration.TupleVariableDeclarationTargetTypes[declaration.ID()]
+	valueType := interpreter.Program.Elaboration.TupleVariableDeclarationValueTypes[declaration.ID()]
+
+	result := interpreter.evalExpression(declaration.Value)
+
+	// Destructuring is a potential resource move.
+	interpreter.invalidateResource(result)
+
+	getLocationRange := locationRangeGetter(interpreter, interpreter.Location, declaration.Value)
+
+	transferredValue := interpreter.transferAndConvert(result, valueType, targetType, getLocationRange)
+
+	tuple, ok := transferredValue.(*TupleValue)
+	if !ok || len(tuple.Elements) != len(declaration.Identifiers) {
+		panic(errors.NewUnreachableError())
+	}
+
+	for i, identifier := range declaration.Identifiers {
+		// NOTE: lexical scope, always declare a new variable.
+		// Do not find an existing variable and assign the value!
+
+		_ = interpreter.declareVariable(
+			identifier.Identifier,
+			tuple.Elements[i],
+		)
+	}
+
+	return nil
+}
+
 func (interpreter *Interpreter) VisitAssignmentStatement(assignment *ast.AssignmentStatement) ast.Repr {
-	targetType := interpreter.Program.Elaboration.AssignmentStatementTargetTypes[assignment]
-	valueType := interpreter.Program.Elaboration.AssignmentStatementValueTypes[assignment]
+	targetType := interpreter.Program.Elaboration.AssignmentStatementTargetTypes[assignment.ID()]
+	valueType := interpreter.Program.Elaboration.AssignmentStatementValueTypes[assignment.ID()]
 
 	target := assignment.Target
 	value := assignment.Value
@@ -483,8 +635,8 @@ func (interpreter *Interpreter) VisitAssignmentStatement(assignment *ast.Assignm
 
 func (interpreter *Interpreter) VisitSwapStatement(swap *ast.SwapStatement) ast.Repr {
 
-	leftType := interpreter.Program.Elaboration.SwapStatementLeftTypes[swap]
-	rightType := interpreter.Program.Elaboration.SwapStatementRightTypes[swap]
+	leftType := interpreter.Program.Elaboration.SwapStatementLeftTypes[swap.ID()]
+	rightType := interpreter.Program.Elaboration.SwapStatementRightTypes[swap.ID()]
 
 	const allowMissing = false
 
@@ -32,6 +32,12 @@ type Invocation struct {
 	TypeParameterTypes *sema.TypeParameterTypeOrderedMap
 	GetLocationRange   func() LocationRange
 	Interpreter        *Interpreter
+	// Base is the value an attachment is attached to.
+	// It is only set for the invocation of an attachment constructor
+	Base *CompositeValue
+	// Function is the invoked interpreted function.
+	// It is only set for invocations on the call stack
+	Function *InterpretedFunctionValue
 }
 
 func NewInvocation(
@@ -69,3 +75,47 @@ func (i *CallStack) Pop() {
 	i.Invocations[depth-1] = Invocation{}
 	i.Invocations = i.Invocations[:depth-1]
 }
+
+// StackFrames returns the frames of the interpreted functions
+// of the given invocations on a call stack, starting with the innermost frame.
+//
+// The given line is the line being executed in the innermost function.
+// The line being executed in all other functions is the line
+// of the invocation of the next inner function.
+//
+func StackFrames(invocations []Invocation, line int) []StackFrame {
+	count := len(invocations)
+	frames := make([]StackFrame, 0, count)
+
+	for i := count - 1; i >= 0; i-- {
+		function := invocations[i].Function
+		if function == nil {
+			continue
+		}
+
+		frame := StackFrame{
+			FunctionName: function.Name,
+		}
+
+		if function.CompositeType != nil {
+			frame.FunctionName = function.CompositeType.QualifiedIdentifier() + "." + function.Name
+		}
+
+		if function.Interpreter != nil {
+			frame.Location = function.Interpreter.Location
+		}
+
+		if i == count-1 {
+			frame.Line = line
+		} else {
+			getLocationRange := invocations[i+1].GetLocationRange
+			if getLocationRange != nil {
+				frame.Line = getLocationRange().StartPos.Line
+			}
+		}
+
+		frames = append(frames, frame)
+	}
+
+	return frames
+}
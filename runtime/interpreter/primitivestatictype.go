@@ -161,7 +161,7 @@ const (
 	_ // future: Fix16
 	_ // future: Fix32
 	PrimitiveStaticTypeFix64
-	_ // future: Fix128
+	PrimitiveStaticTypeFix128
 	_ // future: Fix256
 	_
 
@@ -171,7 +171,7 @@ const (
 	_ // future: UFix16
 	_ // future: UFix32
 	PrimitiveStaticTypeUFix64
-	_ // future: UFix128
+	PrimitiveStaticTypeUFix128
 	_ // future: UFix256
 	_
 
@@ -199,6 +199,10 @@ const (
 	PrimitiveStaticTypeAuthAccountKeys
 	PrimitiveStaticTypePublicAccountKeys
 	PrimitiveStaticTypeAccountKey
+	PrimitiveStaticTypeAuthAccountCapabilities
+	PrimitiveStaticTypeAuthAccountStorageCapabilities
+	PrimitiveStaticTypeStorageCapabilityController
+	PrimitiveStaticTypeAuthAccountInbox
 
 	// !!! *WARNING* !!!
 	// ADD NEW TYPES *BEFORE* THIS WARNING.
@@ -240,6 +244,8 @@ func (t PrimitiveStaticType) elementSize() uint {
 		PrimitiveStaticTypeUInt256,
 		PrimitiveStaticTypeInt128,
 		PrimitiveStaticTypeInt256,
+		PrimitiveStaticTypeFix128,
+		PrimitiveStaticTypeUFix128,
 		PrimitiveStaticTypeInteger,
 		PrimitiveStaticTypeSignedInteger,
 		PrimitiveStaticTypeNumber,
@@ -278,7 +284,11 @@ func (t PrimitiveStaticType) elementSize() uint {
 		PrimitiveStaticTypePublicAccountContracts,
 		PrimitiveStaticTypeAuthAccountKeys,
 		PrimitiveStaticTypePublicAccountKeys,
-		PrimitiveStaticTypeAccountKey:
+		PrimitiveStaticTypeAccountKey,
+		PrimitiveStaticTypeAuthAccountCapabilities,
+		PrimitiveStaticTypeAuthAccountStorageCapabilities,
+		PrimitiveStaticTypeStorageCapabilityController,
+		PrimitiveStaticTypeAuthAccountInbox:
 		return UnknownElementSize
 	}
 	return UnknownElementSize
@@ -384,10 +394,14 @@ func (i PrimitiveStaticType) SemaType() sema.Type {
 	// Fix*
 	case PrimitiveStaticTypeFix64:
 		return sema.Fix64Type
+	case PrimitiveStaticTypeFix128:
+		return sema.Fix128Type
 
 	// UFix*
 	case PrimitiveStaticTypeUFix64:
 		return sema.UFix64Type
+	case PrimitiveStaticTypeUFix128:
+		return sema.UFix128Type
 
 	// Storage
 
@@ -419,6 +433,14 @@ func (i PrimitiveStaticType) SemaType() sema.Type {
 		return sema.PublicAccountKeysType
 	case PrimitiveStaticTypeAccountKey:
 		return sema.AccountKeyType
+	case PrimitiveStaticTypeAuthAccountCapabilities:
+		return sema.AuthAccountCapabilitiesType
+	case PrimitiveStaticTypeAuthAccountStorageCapabilities:
+		return sema.AuthAccountStorageCapabilitiesType
+	case PrimitiveStaticTypeStorageCapabilityController:
+		return sema.StorageCapabilityControllerType
+	case PrimitiveStaticTypeAuthAccountInbox:
+		return sema.AuthAccountInboxType
 	default:
 		panic(errors.NewUnreachableError())
 	}
@@ -498,10 +520,14 @@ func ConvertSemaToPrimitiveStaticType(
 	// Fix*
 	case sema.Fix64Type:
 		typ = PrimitiveStaticTypeFix64
+	case sema.Fix128Type:
+		typ = PrimitiveStaticTypeFix128
 
 	// UFix*
 	case sema.UFix64Type:
 		typ = PrimitiveStaticTypeUFix64
+	case sema.UFix128Type:
+		typ = PrimitiveStaticTypeUFix128
 
 	case sema.PathType:
 		typ = PrimitiveStaticTypePath
@@ -547,6 +573,14 @@ func ConvertSemaToPrimitiveStaticType(
 		typ = PrimitiveStaticTypePublicAccountKeys
 	case sema.AccountKeyType:
 		typ = PrimitiveStaticTypeAccountKey
+	case sema.AuthAccountCapabilitiesType:
+		typ = PrimitiveStaticTypeAuthAccountCapabilities
+	case sema.AuthAccountStorageCapabilitiesType:
+		typ = PrimitiveStaticTypeAuthAccountStorageCapabilities
+	case sema.StorageCapabilityControllerType:
+		typ = PrimitiveStaticTypeStorageCapabilityController
+	case sema.AuthAccountInboxType:
+		typ = PrimitiveStaticTypeAuthAccountInbox
 	case sema.StringType:
 		typ = PrimitiveStaticTypeString
 	}
@@ -0,0 +1,161 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+// Range
+
+var rangeFieldNames = []string{
+	sema.RangeTypeStartFieldName,
+	sema.RangeTypeEndFieldName,
+}
+
+// NewRangeValue constructs a range value, e.g. `0..<10` or `0...10`.
+// Ranges are represented as simple composite values,
+// with the start and the end of the range as fields.
+//
+func NewRangeValue(
+	inter *Interpreter,
+	start IntegerValue,
+	end IntegerValue,
+	rangeType *sema.RangeType,
+) *SimpleCompositeValue {
+
+	staticType := ConvertSemaToStaticType(inter, rangeType)
+
+	containsFunction := NewHostFunctionValue(
+		inter,
+		func(invocation Invocation) Value {
+			element, ok := invocation.Arguments[0].(IntegerValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			return rangeContains(invocation.Interpreter, start, end, rangeType.Inclusive, element)
+		},
+		sema.RangeTypeContainsFunctionType(rangeType.ElementType),
+	)
+
+	fields := map[string]Value{
+		sema.RangeTypeStartFieldName:       start,
+		sema.RangeTypeEndFieldName:         end,
+		sema.RangeTypeContainsFunctionName: containsFunction,
+	}
+
+	operation := "..<"
+	if rangeType.Inclusive {
+		operation = "..."
+	}
+
+	var str string
+	stringer := func(memoryGauge common.MemoryGauge, seenReferences SeenReferences) string {
+		if str == "" {
+			common.UseMemory(memoryGauge, common.RangeValueStringMemoryUsage)
+			startStr := start.MeteredString(memoryGauge, seenReferences)
+			endStr := end.MeteredString(memoryGauge, seenReferences)
+			str = startStr + operation + endStr
+		}
+		return str
+	}
+
+	return NewSimpleCompositeValue(
+		inter,
+		rangeType.ID(),
+		staticType,
+		rangeFieldNames,
+		fields,
+		nil,
+		nil,
+		stringer,
+	)
+}
+
+func rangeContains(
+	inter *Interpreter,
+	start IntegerValue,
+	end IntegerValue,
+	inclusive bool,
+	element IntegerValue,
+) BoolValue {
+	if !start.LessEqual(inter, element) {
+		return false
+	}
+
+	if inclusive {
+		return element.LessEqual(inter, end)
+	}
+
+	return element.Less(inter, end)
+}
+
+// forEachRangeElement calls the given function for each element of the given range value, in order,
+// until the function returns false.
+//
+func (interpreter *Interpreter) forEachRangeElement(
+	rangeValue *SimpleCompositeValue,
+	f func(element IntegerValue) (resume bool),
+) {
+	staticType, ok := rangeValue.StaticType(interpreter).(RangeStaticType)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	start, ok := rangeValue.Fields[sema.RangeTypeStartFieldName].(IntegerValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	end, ok := rangeValue.Fields[sema.RangeTypeEndFieldName].(IntegerValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	elementType := interpreter.MustConvertStaticToSemaType(staticType.ElementType)
+
+	one, ok := interpreter.convert(intOne, sema.IntType, elementType).(IntegerValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	element := start
+
+	for rangeContains(interpreter, start, end, staticType.Inclusive, element) {
+
+		if !f(element) {
+			return
+		}
+
+		// Stop before incrementing past the end of an inclusive range,
+		// as the end might be the maximum value of the element type
+
+		if staticType.Inclusive && element.Equal(interpreter, ReturnEmptyLocationRange, end) {
+			return
+		}
+
+		element, ok = element.Plus(interpreter, one).(IntegerValue)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+	}
+}
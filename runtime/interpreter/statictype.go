@@ -56,6 +56,11 @@ type CompositeStaticType struct {
 	Location            common.Location
 	QualifiedIdentifier string
 	TypeID              common.TypeID
+	// typeArguments are the type arguments of an instantiation of a generic composite type.
+	// The qualified identifier and type ID are the ones of the generic type.
+	// NOTE: the type arguments are stored behind a pointer,
+	// so that the static type stays comparable, e.g. when used as a map key
+	typeArguments *[]StaticType
 }
 
 var _ StaticType = CompositeStaticType{}
@@ -85,6 +90,27 @@ func NewCompositeStaticTypeComputeTypeID(
 	return NewCompositeStaticType(memoryGauge, location, qualifiedIdentifier, typeID)
 }
 
+// TypeArguments returns the type arguments of the static type,
+// if it is an instantiation of a generic composite type
+//
+func (t CompositeStaticType) TypeArguments() []StaticType {
+	if t.typeArguments == nil {
+		return nil
+	}
+	return *t.typeArguments
+}
+
+// WithTypeArguments returns a copy of the static type with the given type arguments
+//
+func (t CompositeStaticType) WithTypeArguments(typeArguments []StaticType) CompositeStaticType {
+	if len(typeArguments) == 0 {
+		t.typeArguments = nil
+	} else {
+		t.typeArguments = &typeArguments
+	}
+	return t
+}
+
 func (CompositeStaticType) isStaticType() {}
 
 func (CompositeStaticType) elementSize() uint {
@@ -92,10 +118,7 @@ func (CompositeStaticType) elementSize() uint {
 }
 
 func (t CompositeStaticType) String() string {
-	if t.Location == nil {
-		return t.QualifiedIdentifier
-	}
-	return string(t.TypeID)
+	return t.string(StaticType.String)
 }
 
 func (t CompositeStaticType) MeteredString(memoryGauge common.MemoryGauge) string {
@@ -107,7 +130,30 @@ func (t CompositeStaticType) MeteredString(memoryGauge common.MemoryGauge) strin
 	}
 
 	common.UseMemory(memoryGauge, common.NewRawStringMemoryUsage(amount))
-	return t.String()
+	return t.string(func(typeArgument StaticType) string {
+		return typeArgument.MeteredString(memoryGauge)
+	})
+}
+
+func (t CompositeStaticType) string(typeArgumentFormatter func(StaticType) string) string {
+	var identifier string
+	if t.Location == nil {
+		identifier = t.QualifiedIdentifier
+	} else {
+		identifier = string(t.TypeID)
+	}
+
+	staticTypeArguments := t.TypeArguments()
+	if len(staticTypeArguments) == 0 {
+		return identifier
+	}
+
+	typeArguments := make([]string, len(staticTypeArguments))
+	for i, typeArgument := range staticTypeArguments {
+		typeArguments[i] = typeArgumentFormatter(typeArgument)
+	}
+
+	return fmt.Sprintf("%s<%s>", identifier, strings.Join(typeArguments, ", "))
 }
 
 func (t CompositeStaticType) Equal(other StaticType) bool {
@@ -116,7 +162,22 @@ func (t CompositeStaticType) Equal(other StaticType) bool {
 		return false
 	}
 
-	return otherCompositeType.TypeID == t.TypeID
+	typeArguments := t.TypeArguments()
+	otherTypeArguments := otherCompositeType.TypeArguments()
+
+	if otherCompositeType.TypeID != t.TypeID ||
+		len(otherTypeArguments) != len(typeArguments) {
+
+		return false
+	}
+
+	for i, typeArgument := range typeArguments {
+		if !typeArgument.Equal(otherTypeArguments[i]) {
+			return false
+		}
+	}
+
+	return true
 }
 
 // InterfaceStaticType
@@ -596,11 +657,154 @@ func (t CapabilityStaticType) Equal(other StaticType) bool {
 	return t.BorrowType.Equal(otherCapabilityType.BorrowType)
 }
 
+// RangeStaticType
+
+type RangeStaticType struct {
+	ElementType StaticType
+	Inclusive   bool
+}
+
+var _ StaticType = RangeStaticType{}
+
+func NewRangeStaticType(
+	memoryGauge common.MemoryGauge,
+	elementType StaticType,
+	inclusive bool,
+) RangeStaticType {
+	common.UseMemory(memoryGauge, common.RangeStaticTypeMemoryUsage)
+
+	return RangeStaticType{
+		ElementType: elementType,
+		Inclusive:   inclusive,
+	}
+}
+
+func (RangeStaticType) isStaticType() {}
+
+func (RangeStaticType) elementSize() uint {
+	return UnknownElementSize
+}
+
+func (t RangeStaticType) name() string {
+	if t.Inclusive {
+		return sema.InclusiveRangeTypeName
+	}
+	return sema.RangeTypeName
+}
+
+func (t RangeStaticType) String() string {
+	if t.ElementType != nil {
+		return fmt.Sprintf("%s<%s>", t.name(), t.ElementType)
+	}
+	return t.name()
+}
+
+func (t RangeStaticType) MeteredString(memoryGauge common.MemoryGauge) string {
+	common.UseMemory(memoryGauge, common.RangeStaticTypeStringMemoryUsage)
+
+	if t.ElementType != nil {
+		typeStr := t.ElementType.MeteredString(memoryGauge)
+		return fmt.Sprintf("%s<%s>", t.name(), typeStr)
+	}
+
+	return t.name()
+}
+
+func (t RangeStaticType) Equal(other StaticType) bool {
+	otherRangeType, ok := other.(RangeStaticType)
+	if !ok || t.Inclusive != otherRangeType.Inclusive {
+		return false
+	}
+
+	// The element types must either be both nil,
+	// or they must be equal
+
+	if t.ElementType == nil {
+		return otherRangeType.ElementType == nil
+	}
+
+	return t.ElementType.Equal(otherRangeType.ElementType)
+}
+
+// TupleStaticType
+
+type TupleStaticType struct {
+	ElementTypes []StaticType
+}
+
+var _ StaticType = &TupleStaticType{}
+
+func NewTupleStaticType(
+	memoryGauge common.MemoryGauge,
+	elementTypes []StaticType,
+) *TupleStaticType {
+	common.UseMemory(memoryGauge, common.TupleStaticTypeMemoryUsage)
+
+	return &TupleStaticType{
+		ElementTypes: elementTypes,
+	}
+}
+
+// NOTE: must be pointer receiver, as static types get used in type values,
+// which are used as keys in maps when exporting.
+// Key types in Go maps must be (transitively) hashable types,
+// and slices are not, but `ElementTypes` is one.
+//
+func (*TupleStaticType) isStaticType() {}
+
+func (*TupleStaticType) elementSize() uint {
+	return UnknownElementSize
+}
+
+func (t *TupleStaticType) String() string {
+	elementTypes := make([]string, len(t.ElementTypes))
+
+	for i, elementType := range t.ElementTypes {
+		elementTypes[i] = elementType.String()
+	}
+
+	return fmt.Sprintf("(%s)", strings.Join(elementTypes, ", "))
+}
+
+func (t *TupleStaticType) MeteredString(memoryGauge common.MemoryGauge) string {
+	common.UseMemory(memoryGauge, common.TupleStaticTypeStringMemoryUsage)
+
+	elementTypes := make([]string, len(t.ElementTypes))
+
+	for i, elementType := range t.ElementTypes {
+		elementTypes[i] = elementType.MeteredString(memoryGauge)
+	}
+
+	// len = (comma + space) x (n - 1)
+	common.UseMemory(memoryGauge, common.NewRawStringMemoryUsage(len(elementTypes)*2))
+
+	return fmt.Sprintf("(%s)", strings.Join(elementTypes, ", "))
+}
+
+func (t *TupleStaticType) Equal(other StaticType) bool {
+	otherTupleType, ok := other.(*TupleStaticType)
+	if !ok || len(t.ElementTypes) != len(otherTupleType.ElementTypes) {
+		return false
+	}
+
+	for i, elementType := range t.ElementTypes {
+		if !elementType.Equal(otherTupleType.ElementTypes[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Conversion
 
 func ConvertSemaToStaticType(memoryGauge common.MemoryGauge, t sema.Type) StaticType {
 	switch t := t.(type) {
 	case *sema.CompositeType:
+		if genericType, ok := t.BaseType().(*sema.CompositeType); ok {
+			return ConvertSemaCompositeTypeInstanceToStaticType(memoryGauge, genericType, t.TypeArguments())
+		}
+
 		return NewCompositeStaticType(memoryGauge, t.Location, t.QualifiedIdentifier(), t.ID())
 
 	case *sema.InterfaceType:
@@ -641,8 +845,34 @@ func ConvertSemaToStaticType(memoryGauge common.MemoryGauge, t sema.Type) Static
 		}
 		return NewCapabilityStaticType(memoryGauge, borrowType)
 
+	case *sema.RangeType:
+		var elementType StaticType
+		if t.ElementType != nil {
+			elementType = ConvertSemaToStaticType(memoryGauge, t.ElementType)
+		}
+		return NewRangeStaticType(memoryGauge, elementType, t.Inclusive)
+
+	case *sema.TupleType:
+		elementTypes := make([]StaticType, len(t.ElementTypes))
+
+		for i, elementType := range t.ElementTypes {
+			elementTypes[i] = ConvertSemaToStaticType(memoryGauge, elementType)
+		}
+
+		return NewTupleStaticType(memoryGauge, elementTypes)
+
 	case *sema.FunctionType:
 		return NewFunctionStaticType(memoryGauge, t)
+
+	case *sema.GenericType:
+		// Generic types are usually resolved to the type arguments before conversion,
+		// see `Interpreter.resolveGenericType`.
+		// If the type argument is not available, fall back to the type bound
+
+		if t.TypeParameter.TypeBound == nil {
+			return nil
+		}
+		return ConvertSemaToStaticType(memoryGauge, t.TypeParameter.TypeBound)
 	}
 
 	primitiveStaticType := ConvertSemaToPrimitiveStaticType(memoryGauge, t)
@@ -703,6 +933,29 @@ func ConvertSemaInterfaceTypeToStaticInterfaceType(
 	return NewInterfaceStaticType(memoryGauge, t.Location, t.QualifiedIdentifier())
 }
 
+// ConvertSemaCompositeTypeInstanceToStaticType converts the instantiation
+// of the given generic composite type with the given type arguments to a static type
+//
+func ConvertSemaCompositeTypeInstanceToStaticType(
+	memoryGauge common.MemoryGauge,
+	genericType *sema.CompositeType,
+	typeArguments []sema.Type,
+) CompositeStaticType {
+	staticType := NewCompositeStaticType(
+		memoryGauge,
+		genericType.Location,
+		genericType.QualifiedIdentifier(),
+		genericType.ID(),
+	)
+
+	staticTypeArguments := make([]StaticType, len(typeArguments))
+	for i, typeArgument := range typeArguments {
+		staticTypeArguments[i] = ConvertSemaToStaticType(memoryGauge, typeArgument)
+	}
+
+	return staticType.WithTypeArguments(staticTypeArguments)
+}
+
 func ConvertStaticToSemaType(
 	memoryGauge common.MemoryGauge,
 	typ StaticType,
@@ -711,7 +964,28 @@ func ConvertStaticToSemaType(
 ) (_ sema.Type, err error) {
 	switch t := typ.(type) {
 	case CompositeStaticType:
-		return getComposite(t.Location, t.QualifiedIdentifier, t.TypeID)
+		compositeType, err := getComposite(t.Location, t.QualifiedIdentifier, t.TypeID)
+		staticTypeArguments := t.TypeArguments()
+		if err != nil || len(staticTypeArguments) == 0 {
+			return compositeType, err
+		}
+
+		if len(compositeType.TypeParameters()) != len(staticTypeArguments) {
+			return nil, errors.NewUnexpectedError(
+				"invalid type arguments for composite type %s",
+				t.TypeID,
+			)
+		}
+
+		typeArguments := make([]sema.Type, len(staticTypeArguments))
+		for i, typeArgument := range staticTypeArguments {
+			typeArguments[i], err = ConvertStaticToSemaType(memoryGauge, typeArgument, getInterface, getComposite)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return compositeType.Instantiate(typeArguments, nil), nil
 
 	case InterfaceStaticType:
 		return getInterface(t.Location, t.QualifiedIdentifier)
@@ -780,6 +1054,29 @@ func ConvertStaticToSemaType(
 
 		return sema.NewCapabilityType(memoryGauge, borrowType), nil
 
+	case RangeStaticType:
+		var elementType sema.Type
+		if t.ElementType != nil {
+			elementType, err = ConvertStaticToSemaType(memoryGauge, t.ElementType, getInterface, getComposite)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return sema.NewRangeType(memoryGauge, elementType, t.Inclusive), nil
+
+	case *TupleStaticType:
+		elementTypes := make([]sema.Type, len(t.ElementTypes))
+
+		for i, elementType := range t.ElementTypes {
+			elementTypes[i], err = ConvertStaticToSemaType(memoryGauge, elementType, getInterface, getComposite)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return sema.NewTupleType(memoryGauge, elementTypes), nil
+
 	case FunctionStaticType:
 		return t.Type, nil
 
@@ -813,10 +1110,19 @@ func NewFunctionStaticType(
 func (t FunctionStaticType) TypeParameters(interpreter *Interpreter) []*TypeParameter {
 	typeParameters := make([]*TypeParameter, len(t.Type.TypeParameters))
 	for i, typeParameter := range t.Type.TypeParameters {
+		var constraints []StaticType
+		if len(typeParameter.Constraints) > 0 {
+			constraints = make([]StaticType, len(typeParameter.Constraints))
+			for j, constraint := range typeParameter.Constraints {
+				constraints[j] = ConvertSemaToStaticType(interpreter, constraint)
+			}
+		}
+
 		typeParameters[i] = &TypeParameter{
-			Name:      typeParameter.Name,
-			TypeBound: ConvertSemaToStaticType(interpreter, typeParameter.TypeBound),
-			Optional:  typeParameter.Optional,
+			Name:        typeParameter.Name,
+			TypeBound:   ConvertSemaToStaticType(interpreter, typeParameter.TypeBound),
+			Constraints: constraints,
+			Optional:    typeParameter.Optional,
 		}
 	}
 
@@ -868,9 +1174,10 @@ func (t FunctionStaticType) Equal(other StaticType) bool {
 }
 
 type TypeParameter struct {
-	Name      string
-	TypeBound StaticType
-	Optional  bool
+	Name        string
+	TypeBound   StaticType
+	Constraints []StaticType
+	Optional    bool
 }
 
 func (p TypeParameter) Equal(other *TypeParameter) bool {
@@ -886,6 +1193,16 @@ func (p TypeParameter) Equal(other *TypeParameter) bool {
 		}
 	}
 
+	if len(p.Constraints) != len(other.Constraints) {
+		return false
+	}
+
+	for i, constraint := range p.Constraints {
+		if !constraint.Equal(other.Constraints[i]) {
+			return false
+		}
+	}
+
 	return p.Optional == other.Optional
 }
 
@@ -896,5 +1213,9 @@ func (p TypeParameter) String() string {
 		builder.WriteString(": ")
 		builder.WriteString(p.TypeBound.String())
 	}
+	for _, constraint := range p.Constraints {
+		builder.WriteString(" & ")
+		builder.WriteString(constraint.String())
+	}
 	return builder.String()
 }
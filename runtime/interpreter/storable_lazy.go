@@ -0,0 +1,154 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"github.com/onflow/atree"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+)
+
+// lazyStorable is the storable of a stored value which is only decoded when it is first accessed.
+//
+// All values stored inline in a slab are decoded when the slab is loaded,
+// e.g. all fields of a stored composite value.
+// Some values, e.g. type values, capabilities, and links,
+// require decoding static types, which is expensive,
+// but most programs only access a few fields of a loaded composite.
+//
+// The encoded content of the value is kept as-is,
+// so the storable can be encoded again without decoding it.
+//
+type lazyStorable struct {
+	tagNumber     uint64
+	content       []byte
+	slabStorageID atree.StorageID
+	memoryGauge   common.MemoryGauge
+	storable      atree.Storable
+}
+
+var _ atree.Storable = &lazyStorable{}
+
+func newLazyStorable(
+	memoryGauge common.MemoryGauge,
+	tagNumber uint64,
+	content []byte,
+	slabStorageID atree.StorageID,
+) *lazyStorable {
+	common.UseMemory(memoryGauge, common.NewBytesMemoryUsage(len(content)))
+	return &lazyStorable{
+		tagNumber:     tagNumber,
+		content:       content,
+		slabStorageID: slabStorageID,
+		memoryGauge:   memoryGauge,
+	}
+}
+
+// isLazilyDecodedTag returns true if values with the given CBOR tag
+// are decoded lazily by DecodeStorableLazily.
+//
+func isLazilyDecodedTag(tagNumber uint64) bool {
+	switch tagNumber {
+	case CBORTagTypeValue,
+		CBORTagCapabilityValue,
+		CBORTagLinkValue,
+		CBORTagPublishedValue:
+
+		return true
+	}
+
+	return false
+}
+
+func (s *lazyStorable) decode() (atree.Storable, error) {
+	if s.storable != nil {
+		return s.storable, nil
+	}
+
+	decoder := NewStorableDecoder(
+		CBORDecMode.NewByteStreamDecoder(s.content),
+		s.slabStorageID,
+		s.memoryGauge,
+	)
+
+	var storable atree.Storable
+	var err error
+
+	switch s.tagNumber {
+	case CBORTagTypeValue:
+		storable, err = decoder.decodeType()
+
+	case CBORTagCapabilityValue:
+		storable, err = decoder.decodeCapability()
+
+	case CBORTagLinkValue:
+		storable, err = decoder.decodeLink()
+
+	case CBORTagPublishedValue:
+		storable, err = decoder.decodePublishedValue()
+
+	default:
+		return nil, UnsupportedTagDecodingError{
+			Tag: s.tagNumber,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.storable = storable
+
+	return storable, nil
+}
+
+func (s *lazyStorable) Encode(e *atree.Encoder) error {
+	err := e.CBOR.EncodeRawBytes([]byte{
+		// tag number
+		0xd8, byte(s.tagNumber),
+	})
+	if err != nil {
+		return err
+	}
+
+	return e.CBOR.EncodeRawBytes(s.content)
+}
+
+func (s *lazyStorable) ByteSize() uint32 {
+	return cborTagSize + uint32(len(s.content))
+}
+
+func (s *lazyStorable) StoredValue(storage atree.SlabStorage) (atree.Value, error) {
+	storable, err := s.decode()
+	if err != nil {
+		return nil, err
+	}
+
+	return storable.StoredValue(storage)
+}
+
+func (s *lazyStorable) ChildStorables() []atree.Storable {
+	storable, err := s.decode()
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	return storable.ChildStorables()
+}
@@ -64,12 +64,16 @@ func ConvertStoredValue(gauge common.MemoryGauge, value atree.Value) (Value, err
 		if !ok {
 			panic(errors.NewUnreachableError())
 		}
-		return newArrayValueFromConstructor(gauge, staticType, value.Count(), func() *atree.Array { return value }), nil
+		array := newArrayValueFromConstructor(gauge, staticType, value.Count(), func() *atree.Array { return value })
+		array.isLoaded = true
+		return array, nil
 	case *atree.OrderedMap:
 		typeInfo := value.Type()
 		switch typeInfo := typeInfo.(type) {
 		case DictionaryStaticType:
-			return newDictionaryValueFromConstructor(gauge, typeInfo, value.Count(), func() *atree.OrderedMap { return value }), nil
+			dictionary := newDictionaryValueFromConstructor(gauge, typeInfo, value.Count(), func() *atree.OrderedMap { return value })
+			dictionary.isLoaded = true
+			return dictionary, nil
 		case compositeTypeInfo:
 			return newCompositeValueFromConstructor(gauge, value.Count(), typeInfo, func() *atree.OrderedMap { return value }), nil
 		default:
@@ -110,15 +114,45 @@ func (k StorageKey) IsLess(o StorageKey) bool {
 	}
 }
 
+// hashedStorageKeyPrefix is the prefix of storage keys which are type ID hashes.
+// Identifiers never contain the prefix,
+// so hashed keys cannot clash with keys which are identifiers.
+//
+const hashedStorageKeyPrefix = "#"
+
+// HashedStorageKeyLength is the length of the storage keys produced by HashedStorageKey
+//
+const HashedStorageKeyLength = len(hashedStorageKeyPrefix) + common.TypeIDHashLength*2
+
+// HashedStorageKey returns the shortened storage key for the given type ID,
+// i.e. the hex-encoded four-byte hash of the type ID, prefixed with a marker.
+//
+// NOTE: storage keys are encoded as CBOR text strings,
+// so the hash is hex-encoded to produce valid UTF-8
+//
+func HashedStorageKey(typeID common.TypeID) string {
+	return hashedStorageKeyPrefix + typeID.Hash().String()
+}
+
+// IsHashedStorageKey returns true if the given storage key
+// was produced by HashedStorageKey.
+//
+func IsHashedStorageKey(key string) bool {
+	return len(key) == HashedStorageKeyLength &&
+		strings.HasPrefix(key, hashedStorageKeyPrefix)
+}
+
 // InMemoryStorage
 //
 type InMemoryStorage struct {
 	*atree.BasicSlabStorage
-	StorageMaps map[StorageKey]*StorageMap
-	memoryGauge common.MemoryGauge
+	StorageMaps   map[StorageKey]*StorageMap
+	memoryGauge   common.MemoryGauge
+	effectTracker *EffectTracker
 }
 
 var _ Storage = InMemoryStorage{}
+var _ EffectTrackingStorage = InMemoryStorage{}
 
 func NewInMemoryStorage(memoryGauge common.MemoryGauge) InMemoryStorage {
 	decodeStorable := func(decoder *cbor.StreamDecoder, storableSlabStorageID atree.StorageID) (atree.Storable, error) {
@@ -140,6 +174,7 @@ func NewInMemoryStorage(memoryGauge common.MemoryGauge) InMemoryStorage {
 		BasicSlabStorage: slabStorage,
 		StorageMaps:      make(map[StorageKey]*StorageMap),
 		memoryGauge:      memoryGauge,
+		effectTracker:    &EffectTracker{},
 	}
 }
 
@@ -155,6 +190,9 @@ func (i InMemoryStorage) GetStorageMap(
 	if storageMap == nil && createIfNotExists {
 		storageMap = NewStorageMap(i.memoryGauge, i, atree.Address(address))
 		i.StorageMaps[key] = storageMap
+
+		// The new storage map is reachable even if the current recoverable call fails
+		i.effectTracker.RecordEffect()
 	}
 	return storageMap
 }
@@ -164,6 +202,31 @@ func (i InMemoryStorage) CheckHealth() error {
 	return err
 }
 
+func (i InMemoryStorage) EffectTracker() *EffectTracker {
+	return i.effectTracker
+}
+
+func (i InMemoryStorage) GenerateStorageID(address atree.Address) (atree.StorageID, error) {
+	storageID, err := i.BasicSlabStorage.GenerateStorageID(address)
+	if err != nil {
+		return atree.StorageID{}, err
+	}
+
+	i.effectTracker.RecordStorageIDGeneration(storageID)
+
+	return storageID, nil
+}
+
+func (i InMemoryStorage) Store(storageID atree.StorageID, slab atree.Slab) error {
+	i.effectTracker.RecordStorageWrite(storageID)
+	return i.BasicSlabStorage.Store(storageID, slab)
+}
+
+func (i InMemoryStorage) Remove(storageID atree.StorageID) error {
+	i.effectTracker.RecordStorageWrite(storageID)
+	return i.BasicSlabStorage.Remove(storageID)
+}
+
 // writeCounter is an io.Writer which counts the amount of written data.
 //
 type writeCounter struct {
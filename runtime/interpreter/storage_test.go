@@ -486,3 +486,18 @@ func TestStorageOverwriteAndRemove(t *testing.T) {
 	// - storage map (atree ordered map)
 	assert.Len(t, storage.Slabs, 1)
 }
+
+func TestHashedStorageKey(t *testing.T) {
+
+	t.Parallel()
+
+	typeID := common.TypeID("A.0000000000000001.Test")
+
+	key := HashedStorageKey(typeID)
+	assert.Equal(t, "#01330675", key)
+	assert.True(t, IsHashedStorageKey(key))
+
+	assert.False(t, IsHashedStorageKey("Test"))
+	assert.False(t, IsHashedStorageKey("012345678"))
+	assert.False(t, IsHashedStorageKey("#0133067"))
+}
@@ -0,0 +1,308 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+// CapabilityControllerStorageDomain is the storage domain which stores the storage capability controllers of an account.
+//
+// A controller is stored as a link to the target storage path of the controlled capability,
+// with the borrow type of the capability, keyed by the capability ID.
+//
+const CapabilityControllerStorageDomain = "cap_con"
+
+// CapabilityIDStorageDomain is the storage domain which stores the ID of the last capability issued by an account.
+//
+const CapabilityIDStorageDomain = "cap_id"
+
+const capabilityIDStorageKey = "id"
+
+func capabilityControllerStorageKey(capabilityID UInt64Value) string {
+	return strconv.FormatUint(uint64(capabilityID), 10)
+}
+
+// generateCapabilityID returns a new capability ID for the given account.
+// Capability IDs are unique per account and start at 1
+//
+func (interpreter *Interpreter) generateCapabilityID(address common.Address) UInt64Value {
+	storageMap := interpreter.Storage.GetStorageMap(address, CapabilityIDStorageDomain, true)
+
+	var lastID UInt64Value
+	value := storageMap.ReadValue(interpreter, capabilityIDStorageKey)
+	if value != nil {
+		var ok bool
+		lastID, ok = value.(UInt64Value)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+	}
+
+	id := NewUInt64Value(
+		interpreter,
+		func() uint64 {
+			return uint64(lastID) + 1
+		},
+	)
+
+	storageMap.WriteValue(interpreter, capabilityIDStorageKey, id)
+
+	return id
+}
+
+// readStorageCapabilityController returns the controller of the capability with the given ID,
+// or false if the account has no such controller
+//
+func (interpreter *Interpreter) readStorageCapabilityController(
+	address common.Address,
+	capabilityID UInt64Value,
+) (
+	LinkValue,
+	bool,
+) {
+	value := interpreter.ReadStored(
+		address,
+		CapabilityControllerStorageDomain,
+		capabilityControllerStorageKey(capabilityID),
+	)
+	if value == nil {
+		return EmptyLinkValue, false
+	}
+
+	controller, ok := value.(LinkValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	return controller, true
+}
+
+// writeStorageCapabilityController sets or removes the controller of the capability with the given ID.
+// If the given controller is nil, the controller is removed
+//
+func (interpreter *Interpreter) writeStorageCapabilityController(
+	address common.Address,
+	capabilityID UInt64Value,
+	controller Value,
+	getLocationRange func() LocationRange,
+) {
+	interpreter.WriteStored(
+		address,
+		CapabilityControllerStorageDomain,
+		capabilityControllerStorageKey(capabilityID),
+		controller,
+		getLocationRange,
+	)
+}
+
+// GetStorageCapabilityFinalTargetPath returns the target storage path of the capability with the given ID,
+// if the capability has a controller and the capability's borrow type is a subtype of the wanted borrow type.
+//
+// Returns EmptyPathValue if the capability was revoked or can not be borrowed with the wanted type.
+//
+func (interpreter *Interpreter) GetStorageCapabilityFinalTargetPath(
+	address common.Address,
+	capabilityID UInt64Value,
+	wantedBorrowType *sema.ReferenceType,
+) (
+	finalPath PathValue,
+	authorized bool,
+) {
+	controller, ok := interpreter.readStorageCapabilityController(address, capabilityID)
+	if !ok {
+		return EmptyPathValue, false
+	}
+
+	allowedType := interpreter.MustConvertStaticToSemaType(controller.Type)
+
+	if !sema.IsSubType(allowedType, wantedBorrowType) {
+		return EmptyPathValue, false
+	}
+
+	return controller.TargetPath, wantedBorrowType.Authorized
+}
+
+// issueStorageCapability issues a new capability for the given target storage path and borrow type,
+// and stores its controller
+//
+func (interpreter *Interpreter) issueStorageCapability(
+	addressValue AddressValue,
+	targetPath PathValue,
+	borrowStaticType StaticType,
+	getLocationRange func() LocationRange,
+) *CapabilityValue {
+
+	address := addressValue.ToAddress()
+
+	// Issuing a capability reads both the last capability ID and the controllers
+
+	interpreter.preloadStorageMaps(
+		address,
+		CapabilityIDStorageDomain,
+		CapabilityControllerStorageDomain,
+	)
+
+	capabilityID := interpreter.generateCapabilityID(address)
+
+	controller := NewLinkValue(interpreter, targetPath, borrowStaticType)
+
+	interpreter.writeStorageCapabilityController(
+		address,
+		capabilityID,
+		controller,
+		getLocationRange,
+	)
+
+	return NewIDCapabilityValue(
+		interpreter,
+		capabilityID,
+		addressValue,
+		borrowStaticType,
+	)
+}
+
+// StorageCapabilityController
+
+var storageCapabilityControllerTypeID = sema.StorageCapabilityControllerType.ID()
+var storageCapabilityControllerStaticType StaticType = PrimitiveStaticTypeStorageCapabilityController // unmetered
+var storageCapabilityControllerFieldNames = []string{
+	sema.StorageCapabilityControllerTypeBorrowTypeFieldName,
+	sema.StorageCapabilityControllerTypeCapabilityIDFieldName,
+}
+
+// NewStorageCapabilityControllerValue constructs a storage capability controller value
+// for the capability with the given ID and borrow type.
+//
+// The controller's functions operate on the account's storage,
+// so the stored controller can be retargeted and deleted.
+//
+func NewStorageCapabilityControllerValue(
+	inter *Interpreter,
+	addressValue AddressValue,
+	capabilityID UInt64Value,
+	borrowType StaticType,
+) Value {
+
+	// Converted addresses can be cached and don't have to be recomputed on each function invocation
+	address := addressValue.ToAddress()
+
+	readController := func(getLocationRange func() LocationRange) LinkValue {
+		controller, ok := inter.readStorageCapabilityController(address, capabilityID)
+		if !ok {
+			panic(DeletedCapabilityControllerError{
+				Address:       addressValue,
+				CapabilityID:  capabilityID,
+				LocationRange: getLocationRange(),
+			})
+		}
+		return controller
+	}
+
+	fields := map[string]Value{
+		sema.StorageCapabilityControllerTypeBorrowTypeFieldName:   NewTypeValue(inter, borrowType),
+		sema.StorageCapabilityControllerTypeCapabilityIDFieldName: capabilityID,
+	}
+
+	computedFields := map[string]ComputedField{
+		sema.StorageCapabilityControllerTypeTargetFunctionName: func(inter *Interpreter, _ func() LocationRange) Value {
+			return NewHostFunctionValue(
+				inter,
+				func(invocation Invocation) Value {
+					controller := readController(invocation.GetLocationRange)
+					return controller.TargetPath
+				},
+				sema.StorageCapabilityControllerTypeTargetFunctionType,
+			)
+		},
+		sema.StorageCapabilityControllerTypeRetargetFunctionName: func(inter *Interpreter, _ func() LocationRange) Value {
+			return NewHostFunctionValue(
+				inter,
+				func(invocation Invocation) Value {
+					targetPath, ok := invocation.Arguments[0].(PathValue)
+					if !ok {
+						panic(errors.NewUnreachableError())
+					}
+
+					controller := readController(invocation.GetLocationRange)
+
+					inter.writeStorageCapabilityController(
+						address,
+						capabilityID,
+						NewLinkValue(invocation.Interpreter, targetPath, controller.Type),
+						invocation.GetLocationRange,
+					)
+
+					return NewVoidValue(invocation.Interpreter)
+				},
+				sema.StorageCapabilityControllerTypeRetargetFunctionType,
+			)
+		},
+		sema.StorageCapabilityControllerTypeDeleteFunctionName: func(inter *Interpreter, _ func() LocationRange) Value {
+			return NewHostFunctionValue(
+				inter,
+				func(invocation Invocation) Value {
+					// Ensure the controller was not already deleted
+					readController(invocation.GetLocationRange)
+
+					inter.writeStorageCapabilityController(
+						address,
+						capabilityID,
+						nil,
+						invocation.GetLocationRange,
+					)
+
+					return NewVoidValue(invocation.Interpreter)
+				},
+				sema.StorageCapabilityControllerTypeDeleteFunctionType,
+			)
+		},
+	}
+
+	var str string
+	stringer := func(memoryGauge common.MemoryGauge, seenReferences SeenReferences) string {
+		if str == "" {
+			common.UseMemory(memoryGauge, common.StorageCapabilityControllerValueStringMemoryUsage)
+			borrowTypeStr := borrowType.MeteredString(memoryGauge)
+			capabilityIDStr := capabilityID.MeteredString(memoryGauge, seenReferences)
+			str = fmt.Sprintf(
+				"StorageCapabilityController(borrowType: %s, capabilityID: %s)",
+				borrowTypeStr,
+				capabilityIDStr,
+			)
+		}
+		return str
+	}
+
+	return NewSimpleCompositeValue(
+		inter,
+		storageCapabilityControllerTypeID,
+		storageCapabilityControllerStaticType,
+		storageCapabilityControllerFieldNames,
+		fields,
+		computedFields,
+		nil,
+		stringer,
+	)
+}
@@ -135,6 +135,36 @@ func (s StorageMap) SetValue(interpreter *Interpreter, key string, value atree.V
 	}
 }
 
+// MoveValue moves the value stored under the old key to the new key.
+// If the new key already stores a value, it is overwritten.
+// Returns false if the old key does not exist.
+//
+func (s StorageMap) MoveValue(interpreter *Interpreter, oldKey string, newKey string) bool {
+	existingKeyStorable, existingValueStorable, err := s.orderedMap.Remove(
+		StringAtreeComparator,
+		StringAtreeHashInput,
+		StringAtreeValue(oldKey),
+	)
+	if err != nil {
+		if _, ok := err.(*atree.KeyNotFoundError); ok {
+			return false
+		}
+		panic(errors.NewExternalError(err))
+	}
+	interpreter.maybeValidateAtreeValue(s.orderedMap)
+
+	// NOTE: key / field name is stringAtreeValue,
+	// and not a Value, so no need to deep remove
+	interpreter.RemoveReferencedSlab(existingKeyStorable)
+
+	// NOTE: the value is not removed, only re-inserted under the new key
+
+	value := StoredValue(interpreter, existingValueStorable, s.orderedMap.Storage)
+	s.SetValue(interpreter, newKey, value)
+
+	return true
+}
+
 // removeValue removes a value in the storage map, if it exists.
 //
 func (s StorageMap) removeValue(interpreter *Interpreter, key string) {
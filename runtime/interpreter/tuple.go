@@ -0,0 +1,256 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"github.com/onflow/atree"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/format"
+)
+
+// TupleValue is a fixed-size, heterogeneous sequence of values, e.g. `(1, "two")`.
+//
+// Tuples are not storable, so they are backed by a plain slice.
+// A tuple is resource-kinded if any of its elements is.
+//
+type TupleValue struct {
+	Type        *TupleStaticType
+	Elements    []Value
+	isDestroyed bool
+}
+
+var _ Value = &TupleValue{}
+var _ EquatableValue = &TupleValue{}
+var _ ResourceKindedValue = &TupleValue{}
+
+func NewTupleValue(
+	memoryGauge common.MemoryGauge,
+	tupleType *TupleStaticType,
+	elements []Value,
+) *TupleValue {
+	common.UseMemory(memoryGauge, common.TupleValueBaseMemoryUsage)
+
+	return &TupleValue{
+		Type:     tupleType,
+		Elements: elements,
+	}
+}
+
+func (*TupleValue) IsValue() {}
+
+func (v *TupleValue) Accept(interpreter *Interpreter, visitor Visitor) {
+	descend := visitor.VisitTupleValue(interpreter, v)
+	if !descend {
+		return
+	}
+
+	v.Walk(interpreter, func(element Value) {
+		element.Accept(interpreter, visitor)
+	})
+}
+
+func (v *TupleValue) Walk(_ *Interpreter, walkChild func(Value)) {
+	for _, element := range v.Elements {
+		walkChild(element)
+	}
+}
+
+func (v *TupleValue) StaticType(_ *Interpreter) StaticType {
+	return v.Type
+}
+
+func (*TupleValue) IsImportable(_ *Interpreter) bool {
+	return false
+}
+
+// Get returns the element at the given index.
+// The index is checked statically, so it is always in bounds.
+//
+func (v *TupleValue) Get(index int) Value {
+	if index < 0 || index >= len(v.Elements) {
+		panic(errors.NewUnreachableError())
+	}
+
+	return v.Elements[index]
+}
+
+func (v *TupleValue) String() string {
+	return v.RecursiveString(SeenReferences{})
+}
+
+func (v *TupleValue) RecursiveString(seenReferences SeenReferences) string {
+	return v.MeteredString(nil, seenReferences)
+}
+
+func (v *TupleValue) MeteredString(memoryGauge common.MemoryGauge, seenReferences SeenReferences) string {
+	// len = open-paren + close-paren + ((n-1) comma+space)
+	//     = 2n
+	// Each elements' string value is metered individually.
+	common.UseMemory(memoryGauge, common.NewRawStringMemoryUsage(len(v.Elements)*2))
+
+	values := make([]string, len(v.Elements))
+
+	for i, element := range v.Elements {
+		values[i] = element.MeteredString(memoryGauge, seenReferences)
+	}
+
+	return format.Tuple(values)
+}
+
+func (v *TupleValue) ConformsToStaticType(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	results TypeConformanceResults,
+) bool {
+
+	if len(v.Elements) != len(v.Type.ElementTypes) {
+		return false
+	}
+
+	for i, element := range v.Elements {
+
+		if !interpreter.IsSubType(element.StaticType(interpreter), v.Type.ElementTypes[i]) {
+			return false
+		}
+
+		if !element.ConformsToStaticType(
+			interpreter,
+			getLocationRange,
+			results,
+		) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v *TupleValue) Equal(interpreter *Interpreter, getLocationRange func() LocationRange, other Value) bool {
+	otherTuple, ok := other.(*TupleValue)
+	if !ok || len(v.Elements) != len(otherTuple.Elements) {
+		return false
+	}
+
+	for i, element := range v.Elements {
+		equatableElement, ok := element.(EquatableValue)
+		if !ok || !equatableElement.Equal(interpreter, getLocationRange, otherTuple.Elements[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v *TupleValue) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+	return NonStorable{Value: v}, nil
+}
+
+func (*TupleValue) NeedsStoreTo(_ atree.Address) bool {
+	return false
+}
+
+func (v *TupleValue) IsResourceKinded(interpreter *Interpreter) bool {
+	for _, element := range v.Elements {
+		if element.IsResourceKinded(interpreter) {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *TupleValue) checkInvalidatedResourceUse(getLocationRange func() LocationRange) {
+	if v.isDestroyed {
+		panic(InvalidatedResourceError{
+			LocationRange: getLocationRange(),
+		})
+	}
+}
+
+func (v *TupleValue) Destroy(interpreter *Interpreter, getLocationRange func() LocationRange) {
+
+	if interpreter.invalidatedResourceValidationEnabled {
+		v.checkInvalidatedResourceUse(getLocationRange)
+	}
+
+	v.Walk(interpreter, func(element Value) {
+		maybeDestroy(interpreter, getLocationRange, element)
+	})
+
+	v.isDestroyed = true
+}
+
+func (v *TupleValue) IsDestroyed() bool {
+	return v.isDestroyed
+}
+
+func (v *TupleValue) Transfer(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	address atree.Address,
+	remove bool,
+	storable atree.Storable,
+) Value {
+
+	if interpreter.invalidatedResourceValidationEnabled {
+		v.checkInvalidatedResourceUse(getLocationRange)
+	}
+
+	// TODO: actually not needed, value is not storable
+	if remove {
+		interpreter.RemoveReferencedSlab(storable)
+	}
+
+	// Tuples are not storable, so the elements are never stored in a slab:
+	// Transfer them like the values of local variables, i.e. move resources, and copy other values
+
+	elements := make([]Value, len(v.Elements))
+
+	for i, element := range v.Elements {
+		elements[i] = element.Transfer(
+			interpreter,
+			getLocationRange,
+			address,
+			false,
+			nil,
+		)
+	}
+
+	return NewTupleValue(interpreter, v.Type, elements)
+}
+
+func (v *TupleValue) Clone(interpreter *Interpreter) Value {
+
+	elements := make([]Value, len(v.Elements))
+
+	for i, element := range v.Elements {
+		elements[i] = element.Clone(interpreter)
+	}
+
+	return &TupleValue{
+		Type:        v.Type,
+		Elements:    elements,
+		isDestroyed: v.isDestroyed,
+	}
+}
+
+func (v *TupleValue) DeepRemove(_ *Interpreter) {
+	// NO-OP: tuples are not storable
+}
@@ -19,11 +19,13 @@
 package interpreter
 
 import (
+	"encoding/base64"
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
 	"math"
 	"math/big"
+	"sort"
 	"strings"
 	"time"
 	"unicode"
@@ -353,10 +355,20 @@ func (v TypeValue) GetMember(interpreter *Interpreter, _ func() LocationRange, n
 
 				inter := invocation.Interpreter
 
-				result := sema.IsSubType(
-					inter.MustConvertStaticToSemaType(staticType),
-					inter.MustConvertStaticToSemaType(otherStaticType),
-				)
+				// if either type cannot be loaded anymore, e.g. because its contract was removed,
+				// the subtype relation is false, like for unknown types
+
+				semaType, err := inter.ConvertStaticToSemaType(staticType)
+				if err != nil {
+					return NewBoolValue(interpreter, false)
+				}
+
+				otherSemaType, err := inter.ConvertStaticToSemaType(otherStaticType)
+				if err != nil {
+					return NewBoolValue(interpreter, false)
+				}
+
+				result := sema.IsSubType(semaType, otherSemaType)
 				return NewBoolValue(interpreter, result)
 			},
 			sema.MetaTypeIsSubtypeFunctionType,
@@ -1146,6 +1158,24 @@ func (v *StringValue) GetMember(interpreter *Interpreter, _ func() LocationRange
 			sema.StringTypeDecodeHexFunctionType,
 		)
 
+	case "tryDecodeHex":
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				return v.TryDecodeHex(invocation.Interpreter)
+			},
+			sema.StringTypeTryDecodeHexFunctionType,
+		)
+
+	case "decodeBase64":
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				return v.DecodeBase64(invocation.Interpreter)
+			},
+			sema.StringTypeDecodeBase64FunctionType,
+		)
+
 	case "toLower":
 		return NewHostFunctionValue(
 			interpreter,
@@ -1291,6 +1321,37 @@ func (v *StringValue) DecodeHex(interpreter *Interpreter) *ArrayValue {
 	)
 }
 
+// TryDecodeHex hex-decodes this string and returns an optional array of UInt8 values.
+// Returns nil if the string is not a valid hexadecimal string
+//
+func (v *StringValue) TryDecodeHex(interpreter *Interpreter) OptionalValue {
+	bs, err := hex.DecodeString(v.Str)
+	if err != nil {
+		return NewNilValue(interpreter)
+	}
+
+	return NewSomeValueNonCopying(
+		interpreter,
+		ByteSliceToByteArrayValue(interpreter, bs),
+	)
+}
+
+// DecodeBase64 decodes this standard base64 encoded string,
+// and returns an optional array of UInt8 values.
+// Returns nil if the string is not a valid, canonical base64 string
+//
+func (v *StringValue) DecodeBase64(interpreter *Interpreter) OptionalValue {
+	bs, err := base64.StdEncoding.Strict().DecodeString(v.Str)
+	if err != nil {
+		return NewNilValue(interpreter)
+	}
+
+	return NewSomeValueNonCopying(
+		interpreter,
+		ByteSliceToByteArrayValue(interpreter, bs),
+	)
+}
+
 func (v *StringValue) ConformsToStaticType(
 	_ *Interpreter,
 	_ func() LocationRange,
@@ -1308,6 +1369,13 @@ type ArrayValue struct {
 	isDestroyed      bool
 	isResourceKinded *bool
 	elementSize      uint
+	// isLoaded is true if the value was loaded from storage, e.g. as the element of a container.
+	// Multiple values may be loaded for the same backing array,
+	// so the backing array of such a value is not shared, see copyOnWriteValue
+	isLoaded bool
+	// copyOnWrite is the state of the backing array
+	// if it is shared with other array values, see copyOnWriteValue
+	copyOnWrite *copyOnWriteState
 }
 
 func NewArrayValue(
@@ -1689,6 +1757,10 @@ func (v *ArrayValue) Set(interpreter *Interpreter, getLocationRange func() Locat
 		})
 	}
 
+	v.unshare(interpreter, getLocationRange)
+
+	interpreter.checkContainerNotIterated(v.StorageID(), getLocationRange)
+
 	interpreter.checkContainerMutation(v.Type.ElementType(), element, getLocationRange)
 
 	common.UseMemory(interpreter, common.AtreeArrayElementOverhead)
@@ -1760,6 +1832,9 @@ func (v *ArrayValue) Append(interpreter *Interpreter, getLocationRange func() Lo
 	common.UseMemory(interpreter, metaDataSlabs)
 	common.UseMemory(interpreter, common.AtreeArrayElementOverhead)
 
+	v.unshare(interpreter, getLocationRange)
+
+	interpreter.checkContainerNotIterated(v.StorageID(), getLocationRange)
 	interpreter.checkContainerMutation(v.Type.ElementType(), element, getLocationRange)
 
 	element = element.Transfer(
@@ -1816,6 +1891,9 @@ func (v *ArrayValue) Insert(interpreter *Interpreter, getLocationRange func() Lo
 	common.UseMemory(interpreter, metaDataSlabs)
 	common.UseMemory(interpreter, common.AtreeArrayElementOverhead)
 
+	v.unshare(interpreter, getLocationRange)
+
+	interpreter.checkContainerNotIterated(v.StorageID(), getLocationRange)
 	interpreter.checkContainerMutation(v.Type.ElementType(), element, getLocationRange)
 
 	element = element.Transfer(
@@ -1858,6 +1936,10 @@ func (v *ArrayValue) Remove(interpreter *Interpreter, getLocationRange func() Lo
 		})
 	}
 
+	v.unshare(interpreter, getLocationRange)
+
+	interpreter.checkContainerNotIterated(v.StorageID(), getLocationRange)
+
 	storable, err := v.array.Remove(uint64(index))
 	if err != nil {
 		v.handleIndexOutOfBoundsError(err, index, getLocationRange)
@@ -1895,6 +1977,8 @@ func (v *ArrayValue) FirstIndex(interpreter *Interpreter, getLocationRange func(
 	var counter int64
 	var result bool
 	v.Iterate(interpreter, func(element Value) (resume bool) {
+		interpreter.ReportComputation(common.ComputationKindLoop, 1)
+
 		if needleEquatable.Equal(interpreter, getLocationRange, element) {
 			result = true
 			// stop iteration
@@ -1912,6 +1996,167 @@ func (v *ArrayValue) FirstIndex(interpreter *Interpreter, getLocationRange func(
 	return NilValue{}
 }
 
+// Sort sorts the array in place,
+// using the given function to determine if an element should be ordered before another element.
+//
+// The sort is stable, i.e. equal elements keep their original order.
+//
+func (v *ArrayValue) Sort(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	lessFunction FunctionValue,
+) {
+	v.unshare(interpreter, getLocationRange)
+
+	count := v.Count()
+
+	storables := make([]atree.Storable, count)
+	elements := make([]Value, count)
+	for index := range storables {
+		storable, err := v.array.Get(uint64(index))
+		if err != nil {
+			panic(errors.NewExternalError(err))
+		}
+		storables[index] = storable
+		elements[index] = StoredValue(interpreter, storable, interpreter.Storage)
+	}
+
+	order := make([]int, count)
+	for i := range order {
+		order[i] = i
+	}
+
+	elementType := v.SemaType(interpreter).ElementType(false)
+	argumentTypes := []sema.Type{elementType, elementType}
+
+	// The comparison function must not mutate the array while it is being sorted
+
+	interpreter.withMutationPrevention(v.StorageID(), func() {
+		sort.SliceStable(order, func(i, j int) bool {
+			interpreter.ReportComputation(common.ComputationKindLoop, 1)
+
+			invocation := NewInvocation(
+				interpreter,
+				nil,
+				[]Value{
+					elements[order[i]],
+					elements[order[j]],
+				},
+				argumentTypes,
+				nil,
+				getLocationRange,
+			)
+
+			result, ok := lessFunction.invoke(invocation).(BoolValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			return bool(result)
+		})
+	})
+
+	interpreter.checkContainerNotIterated(v.StorageID(), getLocationRange)
+
+	// The elements are only moved within the array,
+	// so their storables can be swapped in place, without transferring the elements.
+	// The storables replaced are not removed, as they are stored at another index
+
+	for index, originalIndex := range order {
+		if index == originalIndex {
+			continue
+		}
+
+		common.UseMemory(interpreter, common.AtreeArrayElementOverhead)
+
+		_, err := v.array.Set(
+			uint64(index),
+			movedStorable{
+				storable: storables[originalIndex],
+			},
+		)
+		if err != nil {
+			panic(errors.NewExternalError(err))
+		}
+	}
+
+	interpreter.maybeValidateAtreeValue(v.array)
+}
+
+// movedStorable is an atree.Value for an element which is moved within its container.
+// The element is stored as its existing storable.
+//
+type movedStorable struct {
+	storable atree.Storable
+}
+
+var _ atree.Value = movedStorable{}
+
+func (s movedStorable) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+	return s.storable, nil
+}
+
+// BinarySearch returns the index of the given number in the array,
+// which must be sorted in ascending order.
+//
+func (v *ArrayValue) BinarySearch(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	needleValue Value,
+) OptionalValue {
+
+	needleNumber, ok := needleValue.(NumberValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	low := 0
+	high := v.Count() - 1
+
+	for low <= high {
+		interpreter.ReportComputation(common.ComputationKindLoop, 1)
+
+		middle := low + (high-low)/2
+
+		element, ok := v.Get(interpreter, getLocationRange, middle).(NumberValue)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		if element.Less(interpreter, needleNumber) {
+			low = middle + 1
+		} else if needleNumber.Less(interpreter, element) {
+			high = middle - 1
+		} else {
+			value := NewIntValueFromInt64(interpreter, int64(middle))
+			return NewSomeValueNonCopying(interpreter, value)
+		}
+	}
+
+	return NewNilValue(interpreter)
+}
+
+// ToHexString returns a hexadecimal string for the bytes in this array.
+// The array must be a byte array
+//
+func (v *ArrayValue) ToHexString(interpreter *Interpreter) *StringValue {
+	memoryUsage := common.NewStringMemoryUsage(
+		safeMul(v.Count(), 2),
+	)
+
+	return NewStringValue(
+		interpreter,
+		memoryUsage,
+		func() string {
+			bytes, err := ByteArrayValueToByteSlice(interpreter, v)
+			if err != nil {
+				panic(errors.NewUnexpectedErrorFromCause(err))
+			}
+			return hex.EncodeToString(bytes)
+		},
+	)
+}
+
 func (v *ArrayValue) Contains(
 	interpreter *Interpreter,
 	getLocationRange func() LocationRange,
@@ -2094,6 +2339,52 @@ func (v *ArrayValue) GetMember(interpreter *Interpreter, getLocationRange func()
 			),
 		)
 
+	case "sort":
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				lessFunction, ok := invocation.Arguments[0].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				v.Sort(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					lessFunction,
+				)
+
+				return NewVoidValue(invocation.Interpreter)
+			},
+			sema.ArraySortFunctionType(
+				v.SemaType(interpreter).ElementType(false),
+			),
+		)
+
+	case "binarySearch":
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				return v.BinarySearch(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					invocation.Arguments[0],
+				)
+			},
+			sema.ArrayBinarySearchFunctionType(
+				v.SemaType(interpreter).ElementType(false),
+			),
+		)
+
+	case sema.ArrayTypeToHexStringFunctionName:
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				return v.ToHexString(invocation.Interpreter)
+			},
+			sema.ArrayToHexStringFunctionType,
+		)
+
 	case "contains":
 		return NewHostFunctionValue(
 			interpreter,
@@ -2300,6 +2591,11 @@ func (v *ArrayValue) Transfer(
 		}()
 	}
 
+	if remove {
+		// The backing array must not be removed while it is shared
+		v.unshare(interpreter, getLocationRange)
+	}
+
 	currentStorageID := v.StorageID()
 	currentAddress := currentStorageID.Address
 
@@ -2372,6 +2668,8 @@ func (v *ArrayValue) Transfer(
 
 		newStorageID := array.StorageID()
 
+		interpreter.invalidateResourceReferences(currentStorageID)
+
 		interpreter.updateReferencedResource(
 			currentStorageID,
 			newStorageID,
@@ -2396,12 +2694,17 @@ func (v *ArrayValue) Transfer(
 	return res
 }
 
-func (v *ArrayValue) Clone(interpreter *Interpreter) Value {
+func (v *ArrayValue) isSharable(interpreter *Interpreter) bool {
+	return !v.isLoaded &&
+		v.StorageID().Address == (atree.Address{}) &&
+		!v.IsResourceKinded(interpreter) &&
+		isImmutableStaticType(v.Type.ElementType())
+}
 
-	iterator, err := v.array.Iterator()
-	if err != nil {
-		panic(errors.NewExternalError(err))
-	}
+func (v *ArrayValue) share(interpreter *Interpreter, _ func() LocationRange) Value {
+
+	// The copy is metered like an eager transfer,
+	// as it may have to be performed later on
 
 	baseUsage, elementUsage, dataSlabs, metaDataSlabs := common.NewArrayMemoryUsages(v.array.Count(), v.elementSize)
 	common.UseMemory(interpreter, baseUsage)
@@ -2409,38 +2712,7 @@ func (v *ArrayValue) Clone(interpreter *Interpreter) Value {
 	common.UseMemory(interpreter, dataSlabs)
 	common.UseMemory(interpreter, metaDataSlabs)
 
-	array, err := atree.NewArrayFromBatchData(
-		interpreter.Storage,
-		v.StorageID().Address,
-		v.array.Type(),
-		func() (atree.Value, error) {
-			value, err := iterator.Next()
-			if err != nil {
-				return nil, err
-			}
-			if value == nil {
-				return nil, nil
-			}
-
-			element := MustConvertStoredValue(interpreter, value).
-				Clone(interpreter)
-
-			return element, nil
-		},
-	)
-	if err != nil {
-		panic(errors.NewExternalError(err))
-	}
-	return &ArrayValue{
-		Type:             v.Type,
-		semaType:         v.semaType,
-		isResourceKinded: v.isResourceKinded,
-		array:            array,
-		isDestroyed:      v.isDestroyed,
-	}
-}
-
-func (v *ArrayValue) DeepRemove(interpreter *Interpreter) {
+	interpreter.ReportComputation(common.ComputationKindTransferArrayValue, uint(v.Count()))
 
 	if interpreter.tracingEnabled {
 		startTime := time.Now()
@@ -2449,7 +2721,7 @@ func (v *ArrayValue) DeepRemove(interpreter *Interpreter) {
 		count := v.Count()
 
 		defer func() {
-			interpreter.reportArrayValueDeepRemoveTrace(
+			interpreter.reportArrayValueTransferTrace(
 				typeInfo,
 				count,
 				time.Since(startTime),
@@ -2457,45 +2729,192 @@ func (v *ArrayValue) DeepRemove(interpreter *Interpreter) {
 		}()
 	}
 
-	// Remove nested values and storables
-
-	storage := v.array.Storage
+	// Generate the storage IDs of the copy like an eager transfer,
+	// they are used once the backing array gets copied
 
-	err := v.array.PopIterate(func(storable atree.Storable) {
-		value := StoredValue(interpreter, storable, storage)
-		value.DeepRemove(interpreter)
-		interpreter.RemoveReferencedSlab(storable)
-	})
-	if err != nil {
-		panic(errors.NewExternalError(err))
+	if v.copyOnWrite == nil {
+		v.copyOnWrite = newCopyOnWriteState()
 	}
-	interpreter.maybeValidateAtreeValue(v.array)
-}
 
-func (v *ArrayValue) StorageID() atree.StorageID {
-	return v.array.StorageID()
-}
+	storageIDs := v.copyOnWrite.generateStorageIDs(
+		interpreter,
+		func() []atree.StorageID {
+			return interpreter.arrayCopyStorageIDs(v.array)
+		},
+	)
 
-func (v *ArrayValue) GetOwner() common.Address {
-	return common.Address(v.StorageID().Address)
-}
+	v.copyOnWrite.add(storageIDs)
 
-func (v *ArrayValue) SemaType(interpreter *Interpreter) sema.ArrayType {
-	if v.semaType == nil {
-		// this function will panic already if this conversion fails
-		v.semaType, _ = interpreter.MustConvertStaticToSemaType(v.Type).(sema.ArrayType)
-	}
-	return v.semaType
-}
+	res := newArrayValueFromAtreeValue(v.array, v.Type)
+	res.elementSize = v.elementSize
+	res.semaType = v.semaType
+	res.isResourceKinded = v.isResourceKinded
+	res.isDestroyed = v.isDestroyed
+	res.copyOnWrite = v.copyOnWrite
 
-func (v *ArrayValue) NeedsStoreTo(address atree.Address) bool {
-	return address != v.StorageID().Address
+	return res
 }
 
-func (v *ArrayValue) IsResourceKinded(interpreter *Interpreter) bool {
-	if v.isResourceKinded == nil {
-		isResourceKinded := v.SemaType(interpreter).IsResourceType()
-		v.isResourceKinded = &isResourceKinded
+// unshare ensures that the backing array is not shared with any other array value.
+// It must be called before the backing array gets mutated or removed.
+//
+func (v *ArrayValue) unshare(interpreter *Interpreter, getLocationRange func() LocationRange) {
+	if v.copyOnWrite == nil {
+		return
+	}
+
+	storageIDs, ok := v.copyOnWrite.remove()
+	v.copyOnWrite = nil
+	if !ok {
+		return
+	}
+
+	iterator, err := v.array.Iterator()
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	storage := &reservedSlabStorage{
+		SlabStorage: interpreter.Storage,
+		storageIDs:  storageIDs,
+	}
+
+	address := atree.Address{}
+
+	array, err := atree.NewArrayFromBatchData(
+		storage,
+		address,
+		v.array.Type(),
+		func() (atree.Value, error) {
+			value, err := iterator.Next()
+			if err != nil {
+				return nil, err
+			}
+			if value == nil {
+				return nil, nil
+			}
+
+			element := MustConvertStoredValue(interpreter, value).
+				Transfer(interpreter, getLocationRange, address, false, nil)
+
+			return element, nil
+		},
+	)
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	storage.checkUsed()
+
+	array.Storage = interpreter.Storage
+
+	v.array = array
+}
+
+func (v *ArrayValue) Clone(interpreter *Interpreter) Value {
+
+	iterator, err := v.array.Iterator()
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	baseUsage, elementUsage, dataSlabs, metaDataSlabs := common.NewArrayMemoryUsages(v.array.Count(), v.elementSize)
+	common.UseMemory(interpreter, baseUsage)
+	common.UseMemory(interpreter, elementUsage)
+	common.UseMemory(interpreter, dataSlabs)
+	common.UseMemory(interpreter, metaDataSlabs)
+
+	array, err := atree.NewArrayFromBatchData(
+		interpreter.Storage,
+		v.StorageID().Address,
+		v.array.Type(),
+		func() (atree.Value, error) {
+			value, err := iterator.Next()
+			if err != nil {
+				return nil, err
+			}
+			if value == nil {
+				return nil, nil
+			}
+
+			element := MustConvertStoredValue(interpreter, value).
+				Clone(interpreter)
+
+			return element, nil
+		},
+	)
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+	return &ArrayValue{
+		Type:             v.Type,
+		semaType:         v.semaType,
+		isResourceKinded: v.isResourceKinded,
+		array:            array,
+		isDestroyed:      v.isDestroyed,
+	}
+}
+
+func (v *ArrayValue) DeepRemove(interpreter *Interpreter) {
+
+	if interpreter.tracingEnabled {
+		startTime := time.Now()
+
+		typeInfo := v.Type.String()
+		count := v.Count()
+
+		defer func() {
+			interpreter.reportArrayValueDeepRemoveTrace(
+				typeInfo,
+				count,
+				time.Since(startTime),
+			)
+		}()
+	}
+
+	// The backing array must not be removed while it is shared
+
+	v.unshare(interpreter, ReturnEmptyLocationRange)
+
+	// Remove nested values and storables
+
+	storage := v.array.Storage
+
+	err := v.array.PopIterate(func(storable atree.Storable) {
+		value := StoredValue(interpreter, storable, storage)
+		value.DeepRemove(interpreter)
+		interpreter.RemoveReferencedSlab(storable)
+	})
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+	interpreter.maybeValidateAtreeValue(v.array)
+}
+
+func (v *ArrayValue) StorageID() atree.StorageID {
+	return v.array.StorageID()
+}
+
+func (v *ArrayValue) GetOwner() common.Address {
+	return common.Address(v.StorageID().Address)
+}
+
+func (v *ArrayValue) SemaType(interpreter *Interpreter) sema.ArrayType {
+	if v.semaType == nil {
+		// this function will panic already if this conversion fails
+		v.semaType, _ = interpreter.MustConvertStaticToSemaType(v.Type).(sema.ArrayType)
+	}
+	return v.semaType
+}
+
+func (v *ArrayValue) NeedsStoreTo(address atree.Address) bool {
+	return address != v.StorageID().Address
+}
+
+func (v *ArrayValue) IsResourceKinded(interpreter *Interpreter) bool {
+	if v.isResourceKinded == nil {
+		isResourceKinded := v.SemaType(interpreter).IsResourceType()
+		v.isResourceKinded = &isResourceKinded
 	}
 	return *v.isResourceKinded
 }
@@ -2631,6 +3050,7 @@ func getNumberValueMember(interpreter *Interpreter, v NumberValue, name string,
 				)
 			},
 			&sema.FunctionType{
+				Purity: sema.FunctionPurityView,
 				ReturnTypeAnnotation: sema.NewTypeAnnotation(
 					sema.ByteArrayType,
 				),
@@ -2651,6 +3071,7 @@ func getNumberValueMember(interpreter *Interpreter, v NumberValue, name string,
 				)
 			},
 			&sema.FunctionType{
+				Purity: sema.FunctionPurityView,
 				ReturnTypeAnnotation: sema.NewTypeAnnotation(
 					typ,
 				),
@@ -2671,6 +3092,7 @@ func getNumberValueMember(interpreter *Interpreter, v NumberValue, name string,
 				)
 			},
 			&sema.FunctionType{
+				Purity: sema.FunctionPurityView,
 				ReturnTypeAnnotation: sema.NewTypeAnnotation(
 					typ,
 				),
@@ -2691,6 +3113,7 @@ func getNumberValueMember(interpreter *Interpreter, v NumberValue, name string,
 				)
 			},
 			&sema.FunctionType{
+				Purity: sema.FunctionPurityView,
 				ReturnTypeAnnotation: sema.NewTypeAnnotation(
 					typ,
 				),
@@ -2711,16 +3134,140 @@ func getNumberValueMember(interpreter *Interpreter, v NumberValue, name string,
 				)
 			},
 			&sema.FunctionType{
+				Purity: sema.FunctionPurityView,
 				ReturnTypeAnnotation: sema.NewTypeAnnotation(
 					typ,
 				),
 			},
 		)
+
+	case sema.NumericTypeCheckedAddFunctionName:
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				other, ok := invocation.Arguments[0].(NumberValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+				interpreter := invocation.Interpreter
+				return checkedArithmetic(
+					interpreter,
+					func() NumberValue {
+						return v.Plus(interpreter, other)
+					},
+				)
+			},
+			&sema.FunctionType{
+				Purity: sema.FunctionPurityView,
+				ReturnTypeAnnotation: sema.NewTypeAnnotation(
+					&sema.OptionalType{
+						Type: typ,
+					},
+				),
+			},
+		)
+
+	case sema.NumericTypeCheckedSubtractFunctionName:
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				other, ok := invocation.Arguments[0].(NumberValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+				interpreter := invocation.Interpreter
+				return checkedArithmetic(
+					interpreter,
+					func() NumberValue {
+						return v.Minus(interpreter, other)
+					},
+				)
+			},
+			&sema.FunctionType{
+				Purity: sema.FunctionPurityView,
+				ReturnTypeAnnotation: sema.NewTypeAnnotation(
+					&sema.OptionalType{
+						Type: typ,
+					},
+				),
+			},
+		)
+
+	case sema.NumericTypeCheckedMultiplyFunctionName:
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				other, ok := invocation.Arguments[0].(NumberValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+				interpreter := invocation.Interpreter
+				return checkedArithmetic(
+					interpreter,
+					func() NumberValue {
+						return v.Mul(interpreter, other)
+					},
+				)
+			},
+			&sema.FunctionType{
+				Purity: sema.FunctionPurityView,
+				ReturnTypeAnnotation: sema.NewTypeAnnotation(
+					&sema.OptionalType{
+						Type: typ,
+					},
+				),
+			},
+		)
+
+	case sema.NumericTypeCheckedDivideFunctionName:
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				other, ok := invocation.Arguments[0].(NumberValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+				interpreter := invocation.Interpreter
+				return checkedArithmetic(
+					interpreter,
+					func() NumberValue {
+						return v.Div(interpreter, other)
+					},
+				)
+			},
+			&sema.FunctionType{
+				Purity: sema.FunctionPurityView,
+				ReturnTypeAnnotation: sema.NewTypeAnnotation(
+					&sema.OptionalType{
+						Type: typ,
+					},
+				),
+			},
+		)
 	}
 
 	return nil
 }
 
+// checkedArithmetic performs the given arithmetic operation and returns the result as an optional.
+// If the operation overflows, underflows, or divides by zero, nil is returned instead of aborting.
+//
+func checkedArithmetic(interpreter *Interpreter, operation func() NumberValue) (result OptionalValue) {
+	defer func() {
+		r := recover()
+		switch r.(type) {
+		case nil:
+			return
+		case OverflowError, UnderflowError, DivisionByZeroError:
+			result = NewNilValue(interpreter)
+		default:
+			panic(r)
+		}
+	}()
+
+	return NewSomeValueNonCopying(interpreter, operation())
+}
+
 type IntegerValue interface {
 	NumberValue
 	BitwiseOr(interpreter *Interpreter, other IntegerValue) IntegerValue
@@ -13489,6 +14036,32 @@ func ConvertFix64(memoryGauge common.MemoryGauge, value Value) Fix64Value {
 			},
 		)
 
+	case Fix128Value:
+		return NewFix64Value(
+			memoryGauge,
+			func() int64 {
+				v := scaleFix128ToFix64(value.BigInt)
+				if v.Cmp(minInt64Big) < 0 {
+					panic(UnderflowError{})
+				} else if v.Cmp(maxInt64Big) > 0 {
+					panic(OverflowError{})
+				}
+				return v.Int64()
+			},
+		)
+
+	case UFix128Value:
+		return NewFix64Value(
+			memoryGauge,
+			func() int64 {
+				v := scaleFix128ToFix64(value.BigInt)
+				if v.Cmp(maxInt64Big) > 0 {
+					panic(OverflowError{})
+				}
+				return v.Int64()
+			},
+		)
+
 	case BigNumberValue:
 		converter := func() int64 {
 			v := value.ToBigInt(memoryGauge)
@@ -14005,9 +14578,36 @@ func ConvertUFix64(memoryGauge common.MemoryGauge, value Value) UFix64Value {
 			},
 		)
 
-	case BigNumberValue:
-		converter := func() uint64 {
-			v := value.ToBigInt(memoryGauge)
+	case Fix128Value:
+		if value.BigInt.Sign() < 0 {
+			panic(UnderflowError{})
+		}
+		return NewUFix64Value(
+			memoryGauge,
+			func() uint64 {
+				v := scaleFix128ToFix64(value.BigInt)
+				if !v.IsUint64() {
+					panic(OverflowError{})
+				}
+				return v.Uint64()
+			},
+		)
+
+	case UFix128Value:
+		return NewUFix64Value(
+			memoryGauge,
+			func() uint64 {
+				v := scaleFix128ToFix64(value.BigInt)
+				if !v.IsUint64() {
+					panic(OverflowError{})
+				}
+				return v.Uint64()
+			},
+		)
+
+	case BigNumberValue:
+		converter := func() uint64 {
+			v := value.ToBigInt(memoryGauge)
 
 			if v.Sign() < 0 {
 				panic(UnderflowError{})
@@ -14059,13 +14659,1134 @@ func (UFix64Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _
 	panic(errors.NewUnreachableError())
 }
 
-func (v UFix64Value) ToBigEndianBytes() []byte {
-	b := make([]byte, 8)
-	binary.BigEndian.PutUint64(b, uint64(v))
-	return b
+func (v UFix64Value) ToBigEndianBytes() []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(v))
+	return b
+}
+
+func (v UFix64Value) ConformsToStaticType(
+	_ *Interpreter,
+	_ func() LocationRange,
+	_ TypeConformanceResults,
+) bool {
+	return true
+}
+
+func (UFix64Value) IsStorable() bool {
+	return true
+}
+
+func (v UFix64Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+	return v, nil
+}
+
+func (UFix64Value) NeedsStoreTo(_ atree.Address) bool {
+	return false
+}
+
+func (UFix64Value) IsResourceKinded(_ *Interpreter) bool {
+	return false
+}
+
+func (v UFix64Value) Transfer(
+	interpreter *Interpreter,
+	_ func() LocationRange,
+	_ atree.Address,
+	remove bool,
+	storable atree.Storable,
+) Value {
+	if remove {
+		interpreter.RemoveReferencedSlab(storable)
+	}
+	return v
+}
+
+func (v UFix64Value) Clone(_ *Interpreter) Value {
+	return v
+}
+
+func (UFix64Value) DeepRemove(_ *Interpreter) {
+	// NO-OP
+}
+
+func (v UFix64Value) ByteSize() uint32 {
+	return cborTagSize + getUintCBORSize(uint64(v))
+}
+
+func (v UFix64Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+	return v, nil
+}
+
+func (UFix64Value) ChildStorables() []atree.Storable {
+	return nil
+}
+
+func (v UFix64Value) IntegerPart() NumberValue {
+	return UInt64Value(v / sema.Fix64Factor)
+}
+
+func (UFix64Value) Scale() int {
+	return sema.Fix64Scale
+}
+
+// Fix128Value
+//
+type Fix128Value struct {
+	BigInt *big.Int
+}
+
+var Fix128MemoryUsage = common.NewBigIntMemoryUsage(16)
+
+func NewFix128ValueWithInteger(memoryGauge common.MemoryGauge, integerConstructor func() *big.Int) Fix128Value {
+	common.UseMemory(memoryGauge, Fix128MemoryUsage)
+	return NewUnmeteredFix128ValueWithInteger(integerConstructor())
+}
+
+func NewUnmeteredFix128ValueWithInteger(integer *big.Int) Fix128Value {
+
+	if integer.Cmp(sema.Fix128TypeMinIntBig) < 0 {
+		panic(UnderflowError{})
+	}
+
+	if integer.Cmp(sema.Fix128TypeMaxIntBig) > 0 {
+		panic(OverflowError{})
+	}
+
+	return NewUnmeteredFix128ValueFromBigInt(
+		new(big.Int).Mul(integer, sema.Fix128FactorBig),
+	)
+}
+
+func NewFix128ValueFromBigInt(memoryGauge common.MemoryGauge, bigIntConstructor func() *big.Int) Fix128Value {
+	common.UseMemory(memoryGauge, Fix128MemoryUsage)
+	value := bigIntConstructor()
+	return NewUnmeteredFix128ValueFromBigInt(value)
+}
+
+func NewUnmeteredFix128ValueFromBigInt(value *big.Int) Fix128Value {
+	return Fix128Value{
+		BigInt: value,
+	}
+}
+
+var _ Value = Fix128Value{}
+var _ atree.Storable = Fix128Value{}
+var _ NumberValue = Fix128Value{}
+var _ FixedPointValue = Fix128Value{}
+var _ EquatableValue = Fix128Value{}
+var _ HashableValue = Fix128Value{}
+var _ MemberAccessibleValue = Fix128Value{}
+
+func (Fix128Value) IsValue() {}
+
+func (v Fix128Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitFix128Value(interpreter, v)
+}
+
+func (Fix128Value) Walk(_ *Interpreter, _ func(Value)) {
+	// NO-OP
+}
+
+func (Fix128Value) StaticType(interpreter *Interpreter) StaticType {
+	return NewPrimitiveStaticType(interpreter, PrimitiveStaticTypeFix128)
+}
+
+func (Fix128Value) IsImportable(_ *Interpreter) bool {
+	return true
+}
+
+func (v Fix128Value) String() string {
+	return format.Fix128(v.BigInt)
+}
+
+func (v Fix128Value) RecursiveString(_ SeenReferences) string {
+	return v.String()
+}
+
+func (v Fix128Value) MeteredString(memoryGauge common.MemoryGauge, _ SeenReferences) string {
+	common.UseMemory(
+		memoryGauge,
+		common.NewRawStringMemoryUsage(
+			OverEstimateNumberStringLength(memoryGauge, v),
+		),
+	)
+	return v.String()
+}
+
+func (v Fix128Value) ToInt() int {
+	integer := new(big.Int).Quo(v.BigInt, sema.Fix128FactorBig)
+	if !integer.IsInt64() {
+		panic(OverflowError{})
+	}
+	return int(integer.Int64())
+}
+
+func (v Fix128Value) Negate(interpreter *Interpreter) NumberValue {
+	// INT32-C
+	if v.BigInt.Cmp(sema.Fix128TypeMinBig) == 0 {
+		panic(OverflowError{})
+	}
+
+	valueGetter := func() *big.Int {
+		return new(big.Int).Neg(v.BigInt)
+	}
+
+	return NewFix128ValueFromBigInt(interpreter, valueGetter)
+}
+
+// checkFix128Range checks that the given result is in the range of Fix128.
+// If saturate is true, the result is clamped to the range,
+// otherwise an overflow or underflow error is reported.
+//
+func checkFix128Range(result *big.Int, saturate bool) *big.Int {
+	if result.Cmp(sema.Fix128TypeMinBig) < 0 {
+		if saturate {
+			return sema.Fix128TypeMinBig
+		}
+		panic(UnderflowError{})
+	} else if result.Cmp(sema.Fix128TypeMaxBig) > 0 {
+		if saturate {
+			return sema.Fix128TypeMaxBig
+		}
+		panic(OverflowError{})
+	}
+
+	return result
+}
+
+func (v Fix128Value) Plus(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationPlus,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		// Given that this value is backed by an arbitrary size integer,
+		// we can just add and check the range of the result.
+		res := new(big.Int).Add(v.BigInt, o.BigInt)
+		return checkFix128Range(res, false)
+	}
+
+	return NewFix128ValueFromBigInt(interpreter, valueGetter)
+}
+
+func (v Fix128Value) SaturatingPlus(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingAddFunctionName,
+			LeftType:     v.StaticType(interpreter),
+			RightType:    other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		res := new(big.Int).Add(v.BigInt, o.BigInt)
+		return checkFix128Range(res, true)
+	}
+
+	return NewFix128ValueFromBigInt(interpreter, valueGetter)
+}
+
+func (v Fix128Value) Minus(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMinus,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		// Given that this value is backed by an arbitrary size integer,
+		// we can just subtract and check the range of the result.
+		res := new(big.Int).Sub(v.BigInt, o.BigInt)
+		return checkFix128Range(res, false)
+	}
+
+	return NewFix128ValueFromBigInt(interpreter, valueGetter)
+}
+
+func (v Fix128Value) SaturatingMinus(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingSubtractFunctionName,
+			LeftType:     v.StaticType(interpreter),
+			RightType:    other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		res := new(big.Int).Sub(v.BigInt, o.BigInt)
+		return checkFix128Range(res, true)
+	}
+
+	return NewFix128ValueFromBigInt(interpreter, valueGetter)
+}
+
+func (v Fix128Value) Mul(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMul,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		res := new(big.Int).Mul(v.BigInt, o.BigInt)
+		res.Div(res, sema.Fix128FactorBig)
+		return checkFix128Range(res, false)
+	}
+
+	return NewFix128ValueFromBigInt(interpreter, valueGetter)
+}
+
+func (v Fix128Value) SaturatingMul(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingMultiplyFunctionName,
+			LeftType:     v.StaticType(interpreter),
+			RightType:    other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		res := new(big.Int).Mul(v.BigInt, o.BigInt)
+		res.Div(res, sema.Fix128FactorBig)
+		return checkFix128Range(res, true)
+	}
+
+	return NewFix128ValueFromBigInt(interpreter, valueGetter)
+}
+
+func (v Fix128Value) Div(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationDiv,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		if o.BigInt.Sign() == 0 {
+			panic(DivisionByZeroError{})
+		}
+		res := new(big.Int).Mul(v.BigInt, sema.Fix128FactorBig)
+		res.Div(res, o.BigInt)
+		return checkFix128Range(res, false)
+	}
+
+	return NewFix128ValueFromBigInt(interpreter, valueGetter)
+}
+
+func (v Fix128Value) SaturatingDiv(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingDivideFunctionName,
+			LeftType:     v.StaticType(interpreter),
+			RightType:    other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		if o.BigInt.Sign() == 0 {
+			panic(DivisionByZeroError{})
+		}
+		res := new(big.Int).Mul(v.BigInt, sema.Fix128FactorBig)
+		res.Div(res, o.BigInt)
+		return checkFix128Range(res, true)
+	}
+
+	return NewFix128ValueFromBigInt(interpreter, valueGetter)
+}
+
+func (v Fix128Value) Mod(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMod,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	// v - int(v/o) * o
+	quotient, ok := v.Div(interpreter, o).(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMod,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	truncatedQuotient := NewFix128ValueFromBigInt(
+		interpreter,
+		func() *big.Int {
+			res := new(big.Int).Quo(quotient.BigInt, sema.Fix128FactorBig)
+			return res.Mul(res, sema.Fix128FactorBig)
+		},
+	)
+
+	return v.Minus(
+		interpreter,
+		truncatedQuotient.Mul(interpreter, o),
+	)
+}
+
+func (v Fix128Value) Less(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationLess,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	return NewBoolValueFromConstructor(
+		interpreter,
+		func() bool {
+			cmp := v.BigInt.Cmp(o.BigInt)
+			return cmp == -1
+		},
+	)
+}
+
+func (v Fix128Value) LessEqual(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationLessEqual,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	return NewBoolValueFromConstructor(
+		interpreter,
+		func() bool {
+			cmp := v.BigInt.Cmp(o.BigInt)
+			return cmp <= 0
+		},
+	)
+}
+
+func (v Fix128Value) Greater(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationGreater,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	return NewBoolValueFromConstructor(
+		interpreter,
+		func() bool {
+			cmp := v.BigInt.Cmp(o.BigInt)
+			return cmp == 1
+		},
+	)
+}
+
+func (v Fix128Value) GreaterEqual(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationGreaterEqual,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	return NewBoolValueFromConstructor(
+		interpreter,
+		func() bool {
+			cmp := v.BigInt.Cmp(o.BigInt)
+			return cmp >= 0
+		},
+	)
+}
+
+func (v Fix128Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherFix128, ok := other.(Fix128Value)
+	if !ok {
+		return false
+	}
+	cmp := v.BigInt.Cmp(otherFix128.BigInt)
+	return cmp == 0
+}
+
+// HashInput returns a byte slice containing:
+// - HashInputTypeFix128 (1 byte)
+// - big int value encoded in big-endian (n bytes)
+func (v Fix128Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	b := SignedBigIntToBigEndianBytes(v.BigInt)
+
+	length := 1 + len(b)
+	var buffer []byte
+	if length <= len(scratch) {
+		buffer = scratch[:length]
+	} else {
+		buffer = make([]byte, length)
+	}
+
+	buffer[0] = byte(HashInputTypeFix128)
+	copy(buffer[1:], b)
+	return buffer
+}
+
+func ConvertFix128(memoryGauge common.MemoryGauge, value Value) Fix128Value {
+	switch value := value.(type) {
+	case Fix128Value:
+		return value
+
+	case UFix128Value:
+		return NewFix128ValueFromBigInt(
+			memoryGauge,
+			func() *big.Int {
+				return checkFix128Range(new(big.Int).Set(value.BigInt), false)
+			},
+		)
+
+	case Fix64Value:
+		return NewFix128ValueFromBigInt(
+			memoryGauge,
+			func() *big.Int {
+				return scaleFix64ToFix128(new(big.Int).SetInt64(int64(value)))
+			},
+		)
+
+	case UFix64Value:
+		return NewFix128ValueFromBigInt(
+			memoryGauge,
+			func() *big.Int {
+				return scaleFix64ToFix128(new(big.Int).SetUint64(uint64(value)))
+			},
+		)
+
+	case BigNumberValue:
+		// Check that the integer value fits the range of Fix128
+		return NewFix128ValueWithInteger(
+			memoryGauge,
+			func() *big.Int {
+				return value.ToBigInt(memoryGauge)
+			},
+		)
+
+	case NumberValue:
+		// Check that the integer value fits the range of Fix128
+		return NewFix128ValueWithInteger(
+			memoryGauge,
+			func() *big.Int {
+				return big.NewInt(int64(value.ToInt()))
+			},
+		)
+
+	default:
+		panic(fmt.Sprintf("can't convert Fix128: %s", value))
+	}
+}
+
+var fix64ToFix128FactorBig = new(big.Int).Quo(sema.Fix128FactorBig, sema.Fix64FactorBig)
+
+// scaleFix64ToFix128 converts the given Fix64/UFix64 representation
+// to a Fix128/UFix128 representation. No precision is lost.
+//
+func scaleFix64ToFix128(value *big.Int) *big.Int {
+	return value.Mul(value, fix64ToFix128FactorBig)
+}
+
+// scaleFix128ToFix64 converts the given Fix128/UFix128 representation
+// to a Fix64/UFix64 representation. The additional fractional digits are truncated.
+//
+func scaleFix128ToFix64(value *big.Int) *big.Int {
+	return new(big.Int).Quo(value, fix64ToFix128FactorBig)
+}
+
+func (v Fix128Value) GetMember(interpreter *Interpreter, _ func() LocationRange, name string) Value {
+	return getNumberValueMember(interpreter, v, name, sema.Fix128Type)
+}
+
+func (Fix128Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+	// Numbers have no removable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (Fix128Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+	// Numbers have no settable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (v Fix128Value) ToBigEndianBytes() []byte {
+	return SignedBigIntToBigEndianBytes(v.BigInt)
+}
+
+func (v Fix128Value) ConformsToStaticType(
+	_ *Interpreter,
+	_ func() LocationRange,
+	_ TypeConformanceResults,
+) bool {
+	return true
+}
+
+func (Fix128Value) IsStorable() bool {
+	return true
+}
+
+func (v Fix128Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+	return v, nil
+}
+
+func (Fix128Value) NeedsStoreTo(_ atree.Address) bool {
+	return false
+}
+
+func (Fix128Value) IsResourceKinded(_ *Interpreter) bool {
+	return false
+}
+
+func (v Fix128Value) Transfer(
+	interpreter *Interpreter,
+	_ func() LocationRange,
+	_ atree.Address,
+	remove bool,
+	storable atree.Storable,
+) Value {
+	if remove {
+		interpreter.RemoveReferencedSlab(storable)
+	}
+	return v
+}
+
+func (v Fix128Value) Clone(_ *Interpreter) Value {
+	return NewUnmeteredFix128ValueFromBigInt(v.BigInt)
+}
+
+func (Fix128Value) DeepRemove(_ *Interpreter) {
+	// NO-OP
+}
+
+func (v Fix128Value) ByteSize() uint32 {
+	return cborTagSize + getBigIntCBORSize(v.BigInt)
+}
+
+func (v Fix128Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+	return v, nil
+}
+
+func (Fix128Value) ChildStorables() []atree.Storable {
+	return nil
+}
+
+func (v Fix128Value) IntegerPart() NumberValue {
+	return NewUnmeteredIntValueFromBigInt(
+		new(big.Int).Quo(v.BigInt, sema.Fix128FactorBig),
+	)
+}
+
+func (Fix128Value) Scale() int {
+	return sema.Fix128Scale
+}
+
+// UFix128Value
+//
+type UFix128Value struct {
+	BigInt *big.Int
+}
+
+var UFix128MemoryUsage = common.NewBigIntMemoryUsage(16)
+
+func NewUFix128ValueWithInteger(memoryGauge common.MemoryGauge, integerConstructor func() *big.Int) UFix128Value {
+	common.UseMemory(memoryGauge, UFix128MemoryUsage)
+	return NewUnmeteredUFix128ValueWithInteger(integerConstructor())
+}
+
+func NewUnmeteredUFix128ValueWithInteger(integer *big.Int) UFix128Value {
+
+	if integer.Sign() < 0 {
+		panic(UnderflowError{})
+	}
+
+	if integer.Cmp(sema.UFix128TypeMaxIntBig) > 0 {
+		panic(OverflowError{})
+	}
+
+	return NewUnmeteredUFix128ValueFromBigInt(
+		new(big.Int).Mul(integer, sema.Fix128FactorBig),
+	)
+}
+
+func NewUFix128ValueFromBigInt(memoryGauge common.MemoryGauge, bigIntConstructor func() *big.Int) UFix128Value {
+	common.UseMemory(memoryGauge, UFix128MemoryUsage)
+	value := bigIntConstructor()
+	return NewUnmeteredUFix128ValueFromBigInt(value)
+}
+
+func NewUnmeteredUFix128ValueFromBigInt(value *big.Int) UFix128Value {
+	return UFix128Value{
+		BigInt: value,
+	}
+}
+
+var _ Value = UFix128Value{}
+var _ atree.Storable = UFix128Value{}
+var _ NumberValue = UFix128Value{}
+var _ FixedPointValue = UFix128Value{}
+var _ EquatableValue = UFix128Value{}
+var _ HashableValue = UFix128Value{}
+var _ MemberAccessibleValue = UFix128Value{}
+
+func (UFix128Value) IsValue() {}
+
+func (v UFix128Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitUFix128Value(interpreter, v)
+}
+
+func (UFix128Value) Walk(_ *Interpreter, _ func(Value)) {
+	// NO-OP
+}
+
+func (UFix128Value) StaticType(interpreter *Interpreter) StaticType {
+	return NewPrimitiveStaticType(interpreter, PrimitiveStaticTypeUFix128)
+}
+
+func (UFix128Value) IsImportable(_ *Interpreter) bool {
+	return true
+}
+
+func (v UFix128Value) String() string {
+	return format.UFix128(v.BigInt)
+}
+
+func (v UFix128Value) RecursiveString(_ SeenReferences) string {
+	return v.String()
+}
+
+func (v UFix128Value) MeteredString(memoryGauge common.MemoryGauge, _ SeenReferences) string {
+	common.UseMemory(
+		memoryGauge,
+		common.NewRawStringMemoryUsage(
+			OverEstimateNumberStringLength(memoryGauge, v),
+		),
+	)
+	return v.String()
+}
+
+func (v UFix128Value) ToInt() int {
+	integer := new(big.Int).Quo(v.BigInt, sema.Fix128FactorBig)
+	if !integer.IsInt64() {
+		panic(OverflowError{})
+	}
+	return int(integer.Int64())
+}
+
+func (v UFix128Value) Negate(*Interpreter) NumberValue {
+	panic(errors.NewUnreachableError())
+}
+
+// checkUFix128Range checks that the given result is in the range of UFix128.
+// If saturate is true, the result is clamped to the range,
+// otherwise an overflow or underflow error is reported.
+//
+func checkUFix128Range(result *big.Int, saturate bool) *big.Int {
+	if result.Sign() < 0 {
+		if saturate {
+			return new(big.Int)
+		}
+		panic(UnderflowError{})
+	} else if result.Cmp(sema.UFix128TypeMaxBig) > 0 {
+		if saturate {
+			return sema.UFix128TypeMaxBig
+		}
+		panic(OverflowError{})
+	}
+
+	return result
+}
+
+func (v UFix128Value) Plus(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationPlus,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		// Given that this value is backed by an arbitrary size integer,
+		// we can just add and check the range of the result.
+		res := new(big.Int).Add(v.BigInt, o.BigInt)
+		return checkUFix128Range(res, false)
+	}
+
+	return NewUFix128ValueFromBigInt(interpreter, valueGetter)
+}
+
+func (v UFix128Value) SaturatingPlus(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingAddFunctionName,
+			LeftType:     v.StaticType(interpreter),
+			RightType:    other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		res := new(big.Int).Add(v.BigInt, o.BigInt)
+		return checkUFix128Range(res, true)
+	}
+
+	return NewUFix128ValueFromBigInt(interpreter, valueGetter)
+}
+
+func (v UFix128Value) Minus(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMinus,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		// Given that this value is backed by an arbitrary size integer,
+		// we can just subtract and check the range of the result.
+		res := new(big.Int).Sub(v.BigInt, o.BigInt)
+		return checkUFix128Range(res, false)
+	}
+
+	return NewUFix128ValueFromBigInt(interpreter, valueGetter)
+}
+
+func (v UFix128Value) SaturatingMinus(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingSubtractFunctionName,
+			LeftType:     v.StaticType(interpreter),
+			RightType:    other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		res := new(big.Int).Sub(v.BigInt, o.BigInt)
+		return checkUFix128Range(res, true)
+	}
+
+	return NewUFix128ValueFromBigInt(interpreter, valueGetter)
+}
+
+func (v UFix128Value) Mul(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMul,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		res := new(big.Int).Mul(v.BigInt, o.BigInt)
+		res.Div(res, sema.Fix128FactorBig)
+		return checkUFix128Range(res, false)
+	}
+
+	return NewUFix128ValueFromBigInt(interpreter, valueGetter)
+}
+
+func (v UFix128Value) SaturatingMul(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingMultiplyFunctionName,
+			LeftType:     v.StaticType(interpreter),
+			RightType:    other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		res := new(big.Int).Mul(v.BigInt, o.BigInt)
+		res.Div(res, sema.Fix128FactorBig)
+		return checkUFix128Range(res, true)
+	}
+
+	return NewUFix128ValueFromBigInt(interpreter, valueGetter)
+}
+
+func (v UFix128Value) Div(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationDiv,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		if o.BigInt.Sign() == 0 {
+			panic(DivisionByZeroError{})
+		}
+		res := new(big.Int).Mul(v.BigInt, sema.Fix128FactorBig)
+		res.Div(res, o.BigInt)
+		return checkUFix128Range(res, false)
+	}
+
+	return NewUFix128ValueFromBigInt(interpreter, valueGetter)
+}
+
+func (v UFix128Value) SaturatingDiv(interpreter *Interpreter, other NumberValue) NumberValue {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+		if _, ok := r.(InvalidOperandsError); ok {
+			panic(InvalidOperandsError{
+				FunctionName: sema.NumericTypeSaturatingDivideFunctionName,
+				LeftType:     v.StaticType(interpreter),
+				RightType:    other.StaticType(interpreter),
+			})
+		}
+		panic(r)
+	}()
+
+	return v.Div(interpreter, other)
+}
+
+func (v UFix128Value) Mod(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMod,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	// v - int(v/o) * o
+	quotient, ok := v.Div(interpreter, o).(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMod,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	truncatedQuotient := NewUFix128ValueFromBigInt(
+		interpreter,
+		func() *big.Int {
+			res := new(big.Int).Quo(quotient.BigInt, sema.Fix128FactorBig)
+			return res.Mul(res, sema.Fix128FactorBig)
+		},
+	)
+
+	return v.Minus(
+		interpreter,
+		truncatedQuotient.Mul(interpreter, o),
+	)
+}
+
+func (v UFix128Value) Less(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationLess,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	return NewBoolValueFromConstructor(
+		interpreter,
+		func() bool {
+			cmp := v.BigInt.Cmp(o.BigInt)
+			return cmp == -1
+		},
+	)
+}
+
+func (v UFix128Value) LessEqual(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationLessEqual,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	return NewBoolValueFromConstructor(
+		interpreter,
+		func() bool {
+			cmp := v.BigInt.Cmp(o.BigInt)
+			return cmp <= 0
+		},
+	)
+}
+
+func (v UFix128Value) Greater(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationGreater,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	return NewBoolValueFromConstructor(
+		interpreter,
+		func() bool {
+			cmp := v.BigInt.Cmp(o.BigInt)
+			return cmp == 1
+		},
+	)
+}
+
+func (v UFix128Value) GreaterEqual(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationGreaterEqual,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	return NewBoolValueFromConstructor(
+		interpreter,
+		func() bool {
+			cmp := v.BigInt.Cmp(o.BigInt)
+			return cmp >= 0
+		},
+	)
+}
+
+func (v UFix128Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherUFix128, ok := other.(UFix128Value)
+	if !ok {
+		return false
+	}
+	cmp := v.BigInt.Cmp(otherUFix128.BigInt)
+	return cmp == 0
+}
+
+// HashInput returns a byte slice containing:
+// - HashInputTypeUFix128 (1 byte)
+// - big int value encoded in big-endian (n bytes)
+func (v UFix128Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	b := UnsignedBigIntToBigEndianBytes(v.BigInt)
+
+	length := 1 + len(b)
+	var buffer []byte
+	if length <= len(scratch) {
+		buffer = scratch[:length]
+	} else {
+		buffer = make([]byte, length)
+	}
+
+	buffer[0] = byte(HashInputTypeUFix128)
+	copy(buffer[1:], b)
+	return buffer
+}
+
+func ConvertUFix128(memoryGauge common.MemoryGauge, value Value) UFix128Value {
+	switch value := value.(type) {
+	case UFix128Value:
+		return value
+
+	case Fix128Value:
+		return NewUFix128ValueFromBigInt(
+			memoryGauge,
+			func() *big.Int {
+				return checkUFix128Range(new(big.Int).Set(value.BigInt), false)
+			},
+		)
+
+	case Fix64Value:
+		if value < 0 {
+			panic(UnderflowError{})
+		}
+		return NewUFix128ValueFromBigInt(
+			memoryGauge,
+			func() *big.Int {
+				return scaleFix64ToFix128(new(big.Int).SetInt64(int64(value)))
+			},
+		)
+
+	case UFix64Value:
+		return NewUFix128ValueFromBigInt(
+			memoryGauge,
+			func() *big.Int {
+				return scaleFix64ToFix128(new(big.Int).SetUint64(uint64(value)))
+			},
+		)
+
+	case BigNumberValue:
+		// Check that the integer value fits the range of UFix128
+		return NewUFix128ValueWithInteger(
+			memoryGauge,
+			func() *big.Int {
+				return value.ToBigInt(memoryGauge)
+			},
+		)
+
+	case NumberValue:
+		// Check that the integer value fits the range of UFix128
+		return NewUFix128ValueWithInteger(
+			memoryGauge,
+			func() *big.Int {
+				return big.NewInt(int64(value.ToInt()))
+			},
+		)
+
+	default:
+		panic(fmt.Sprintf("can't convert to UFix128: %s", value))
+	}
+}
+
+func (v UFix128Value) GetMember(interpreter *Interpreter, _ func() LocationRange, name string) Value {
+	return getNumberValueMember(interpreter, v, name, sema.UFix128Type)
+}
+
+func (UFix128Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+	// Numbers have no removable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (UFix128Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+	// Numbers have no settable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (v UFix128Value) ToBigEndianBytes() []byte {
+	return UnsignedBigIntToBigEndianBytes(v.BigInt)
 }
 
-func (v UFix64Value) ConformsToStaticType(
+func (v UFix128Value) ConformsToStaticType(
 	_ *Interpreter,
 	_ func() LocationRange,
 	_ TypeConformanceResults,
@@ -14073,23 +15794,23 @@ func (v UFix64Value) ConformsToStaticType(
 	return true
 }
 
-func (UFix64Value) IsStorable() bool {
+func (UFix128Value) IsStorable() bool {
 	return true
 }
 
-func (v UFix64Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+func (v UFix128Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
 	return v, nil
 }
 
-func (UFix64Value) NeedsStoreTo(_ atree.Address) bool {
+func (UFix128Value) NeedsStoreTo(_ atree.Address) bool {
 	return false
 }
 
-func (UFix64Value) IsResourceKinded(_ *Interpreter) bool {
+func (UFix128Value) IsResourceKinded(_ *Interpreter) bool {
 	return false
 }
 
-func (v UFix64Value) Transfer(
+func (v UFix128Value) Transfer(
 	interpreter *Interpreter,
 	_ func() LocationRange,
 	_ atree.Address,
@@ -14102,32 +15823,34 @@ func (v UFix64Value) Transfer(
 	return v
 }
 
-func (v UFix64Value) Clone(_ *Interpreter) Value {
-	return v
+func (v UFix128Value) Clone(_ *Interpreter) Value {
+	return NewUnmeteredUFix128ValueFromBigInt(v.BigInt)
 }
 
-func (UFix64Value) DeepRemove(_ *Interpreter) {
+func (UFix128Value) DeepRemove(_ *Interpreter) {
 	// NO-OP
 }
 
-func (v UFix64Value) ByteSize() uint32 {
-	return cborTagSize + getUintCBORSize(uint64(v))
+func (v UFix128Value) ByteSize() uint32 {
+	return cborTagSize + getBigIntCBORSize(v.BigInt)
 }
 
-func (v UFix64Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+func (v UFix128Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
 	return v, nil
 }
 
-func (UFix64Value) ChildStorables() []atree.Storable {
+func (UFix128Value) ChildStorables() []atree.Storable {
 	return nil
 }
 
-func (v UFix64Value) IntegerPart() NumberValue {
-	return UInt64Value(v / sema.Fix64Factor)
+func (v UFix128Value) IntegerPart() NumberValue {
+	return NewUnmeteredIntValueFromBigInt(
+		new(big.Int).Quo(v.BigInt, sema.Fix128FactorBig),
+	)
 }
 
-func (UFix64Value) Scale() int {
-	return sema.Fix64Scale
+func (UFix128Value) Scale() int {
+	return sema.Fix128Scale
 }
 
 // CompositeValue
@@ -14146,6 +15869,12 @@ type CompositeValue struct {
 	isDestroyed         bool
 	typeID              common.TypeID
 	staticType          StaticType
+	// base is the value an attachment value is attached to.
+	// It is only set for attachment values
+	base *CompositeValue
+	// TypeArguments are the type arguments of the value's type.
+	// They are only set for values of generic composite types
+	TypeArguments []StaticType
 }
 
 type ComputedField func(*Interpreter, func() LocationRange) Value
@@ -14176,6 +15905,31 @@ func NewCompositeValue(
 	fields []CompositeField,
 	address common.Address,
 ) *CompositeValue {
+	return NewCompositeValueWithTypeArguments(
+		interpreter,
+		getLocationRange,
+		location,
+		qualifiedIdentifier,
+		kind,
+		nil,
+		fields,
+		address,
+	)
+}
+
+// NewCompositeValueWithTypeArguments returns a new composite value
+// of the instantiation of a generic composite type with the given type arguments
+//
+func NewCompositeValueWithTypeArguments(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	location common.Location,
+	qualifiedIdentifier string,
+	kind common.CompositeKind,
+	typeArguments []StaticType,
+	fields []CompositeField,
+	address common.Address,
+) *CompositeValue {
 
 	interpreter.ReportComputation(common.ComputationKindCreateCompositeValue, 1)
 
@@ -14204,16 +15958,19 @@ func NewCompositeValue(
 	}
 
 	constructor := func() *atree.OrderedMap {
+		typeInfo := NewCompositeTypeInfo(
+			interpreter,
+			location,
+			qualifiedIdentifier,
+			kind,
+		)
+		typeInfo.typeArguments = typeArguments
+
 		dictionary, err := atree.NewMap(
 			interpreter.Storage,
 			atree.Address(address),
 			atree.NewDefaultDigesterBuilder(),
-			NewCompositeTypeInfo(
-				interpreter,
-				location,
-				qualifiedIdentifier,
-				kind,
-			),
+			typeInfo,
 		)
 		if err != nil {
 			panic(errors.NewExternalError(err))
@@ -14227,6 +15984,7 @@ func NewCompositeValue(
 		qualifiedIdentifier,
 		kind,
 	)
+	typeInfo.typeArguments = typeArguments
 
 	v = newCompositeValueFromConstructor(interpreter, uint64(len(fields)), typeInfo, constructor)
 
@@ -14251,6 +16009,7 @@ func newCompositeValueFromOrderedMap(
 		Location:            typeInfo.location,
 		QualifiedIdentifier: typeInfo.qualifiedIdentifier,
 		Kind:                typeInfo.kind,
+		TypeArguments:       typeInfo.typeArguments,
 	}
 }
 
@@ -14300,12 +16059,13 @@ func (v *CompositeValue) StaticType(interpreter *Interpreter) StaticType {
 	if v.staticType == nil {
 		// NOTE: Instead of using NewCompositeStaticType, which always generates the type ID,
 		// use the TypeID accessor, which may return an already computed type ID
-		v.staticType = NewCompositeStaticType(
+		staticType := NewCompositeStaticType(
 			interpreter,
 			v.Location,
 			v.QualifiedIdentifier,
 			v.TypeID(), // TODO TypeID metering
 		)
+		v.staticType = staticType.WithTypeArguments(v.TypeArguments)
 	}
 	return v.staticType
 }
@@ -14331,6 +16091,18 @@ func (v *CompositeValue) Destroy(interpreter *Interpreter, getLocationRange func
 		v.checkInvalidatedResourceUse(getLocationRange)
 	}
 
+	if v.Kind == common.CompositeKindResource &&
+		interpreter.onResourceDestroyed != nil {
+
+		interpreter.onResourceDestroyed(interpreter, v)
+	}
+
+	if v.Kind == common.CompositeKindResource &&
+		interpreter.onEventEmitted != nil {
+
+		v.emitResourceDestroyedEvent(interpreter, getLocationRange)
+	}
+
 	storageID := v.StorageID()
 
 	if interpreter.tracingEnabled {
@@ -14397,6 +16169,65 @@ func (v *CompositeValue) Destroy(interpreter *Interpreter, getLocationRange func
 	)
 }
 
+// emitResourceDestroyedEvent emits the event which is implicitly declared for the resource's type,
+// with the current values of the resource's fields.
+//
+func (v *CompositeValue) emitResourceDestroyedEvent(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+) {
+	compositeType, err := interpreter.GetCompositeType(v.Location, v.QualifiedIdentifier, v.TypeID())
+	if err != nil {
+		panic(err)
+	}
+
+	eventType := compositeType.ResourceDestroyedEventType()
+	if eventType == nil {
+		return
+	}
+
+	fields := make([]CompositeField, 0, len(eventType.Fields))
+
+	for _, fieldName := range eventType.Fields {
+		value := v.GetField(interpreter, getLocationRange, fieldName)
+		if value == nil {
+			panic(errors.NewUnreachableError())
+		}
+
+		fields = append(
+			fields,
+			NewCompositeField(
+				interpreter,
+				fieldName,
+				value.Transfer(
+					interpreter,
+					getLocationRange,
+					atree.Address{},
+					false,
+					nil,
+				),
+			),
+		)
+	}
+
+	event := NewCompositeValue(
+		interpreter,
+		getLocationRange,
+		eventType.Location,
+		eventType.QualifiedIdentifier(),
+		eventType.Kind,
+		fields,
+		common.Address{},
+	)
+
+	interpreter.RecordEffect()
+
+	err = interpreter.onEventEmitted(interpreter, getLocationRange, event, eventType)
+	if err != nil {
+		panic(err)
+	}
+}
+
 func (v *CompositeValue) GetMember(interpreter *Interpreter, getLocationRange func() LocationRange, name string) Value {
 
 	if interpreter.invalidatedResourceValidationEnabled {
@@ -14485,6 +16316,32 @@ func (v *CompositeValue) GetMember(interpreter *Interpreter, getLocationRange fu
 	return nil
 }
 
+// getFunctionMember returns the function with the given name bound to the composite value,
+// or nil if the composite value has no such function.
+// Unlike GetMember, it does not look up fields.
+//
+func (v *CompositeValue) getFunctionMember(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	name string,
+) Value {
+
+	if interpreter.invalidatedResourceValidationEnabled {
+		v.checkInvalidatedResourceUse(getLocationRange)
+	}
+
+	interpreter = v.getInterpreter(interpreter)
+
+	v.InitializeFunctions(interpreter)
+
+	function, ok := v.Functions[name]
+	if !ok {
+		return nil
+	}
+
+	return NewBoundFunctionValue(interpreter, function, v)
+}
+
 func (v *CompositeValue) checkInvalidatedResourceUse(getLocationRange func() LocationRange) {
 	if v.isDestroyed || (v.dictionary == nil && v.Kind == common.CompositeKindResource) {
 		panic(InvalidatedResourceError{
@@ -14669,9 +16526,16 @@ func (v *CompositeValue) MeteredString(memoryGauge common.MemoryGauge, seenRefer
 
 	var fields []CompositeField
 	_ = v.dictionary.Iterate(func(key atree.Value, value atree.Value) (resume bool, err error) {
+		name := string(key.(StringAtreeValue))
+
+		// Attachments are not fields of the composite
+		if isAttachmentFieldName(name) {
+			return true, nil
+		}
+
 		field := NewCompositeField(
 			memoryGauge,
-			string(key.(StringAtreeValue)),
+			name,
 			MustConvertStoredValue(memoryGauge, value),
 		)
 
@@ -14868,7 +16732,7 @@ func (v *CompositeValue) ConformsToStaticType(
 		return false
 	}
 
-	fieldsLen := int(v.dictionary.Count())
+	fieldsLen := int(v.dictionary.Count()) - v.attachmentCount()
 	if v.ComputedFields != nil {
 		fieldsLen += len(v.ComputedFields)
 	}
@@ -14925,7 +16789,8 @@ func (v *CompositeValue) IsStorable() bool {
 	case common.CompositeKindStructure,
 		common.CompositeKindResource,
 		common.CompositeKindEnum,
-		common.CompositeKindContract:
+		common.CompositeKindContract,
+		common.CompositeKindAttachment:
 		break
 	default:
 		return false
@@ -15079,6 +16944,8 @@ func (v *CompositeValue) Transfer(
 
 		newStorageID := dictionary.StorageID()
 
+		interpreter.invalidateResourceReferences(currentStorageID)
+
 		interpreter.updateReferencedResource(
 			currentStorageID,
 			newStorageID,
@@ -15099,6 +16966,7 @@ func (v *CompositeValue) Transfer(
 			v.QualifiedIdentifier,
 			v.Kind,
 		)
+		info.typeArguments = v.TypeArguments
 		res = newCompositeValueFromOrderedMap(dictionary, info)
 		res.InjectedFields = v.InjectedFields
 		res.ComputedFields = v.ComputedFields
@@ -15187,6 +17055,7 @@ func (v *CompositeValue) Clone(interpreter *Interpreter) Value {
 		isDestroyed:         v.isDestroyed,
 		typeID:              v.typeID,
 		staticType:          v.staticType,
+		TypeArguments:       v.TypeArguments,
 	}
 }
 
@@ -15287,6 +17156,87 @@ func (v *CompositeValue) RemoveField(
 	interpreter.RemoveReferencedSlab(existingValueStorable)
 }
 
+// attachmentFieldPrefix is the prefix of the field names
+// under which the attachments of a composite value are stored.
+// The prefix is not a valid identifier character,
+// so attachment fields cannot clash with declared fields
+//
+const attachmentFieldPrefix = "$"
+
+func attachmentFieldName(attachmentTypeID common.TypeID) string {
+	return attachmentFieldPrefix + string(attachmentTypeID)
+}
+
+func isAttachmentFieldName(name string) bool {
+	return strings.HasPrefix(name, attachmentFieldPrefix)
+}
+
+func (v *CompositeValue) attachmentCount() int {
+	count := 0
+	err := v.dictionary.Iterate(func(key atree.Value, _ atree.Value) (resume bool, err error) {
+		if isAttachmentFieldName(string(key.(StringAtreeValue))) {
+			count++
+		}
+		return true, nil
+	})
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+	return count
+}
+
+// GetAttachment returns the attachment of the given type,
+// or nil if no such attachment is attached to the composite value.
+//
+func (v *CompositeValue) GetAttachment(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	attachmentTypeID common.TypeID,
+) *CompositeValue {
+	value := v.GetField(interpreter, getLocationRange, attachmentFieldName(attachmentTypeID))
+	if value == nil {
+		return nil
+	}
+
+	attachment, ok := value.(*CompositeValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	attachment.base = v
+
+	return attachment
+}
+
+// SetAttachment attaches the given attachment value to the composite value.
+//
+func (v *CompositeValue) SetAttachment(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	attachment *CompositeValue,
+) {
+	v.SetMember(
+		interpreter,
+		getLocationRange,
+		attachmentFieldName(attachment.TypeID()),
+		attachment,
+	)
+}
+
+// RemoveAttachment removes the attachment of the given type, if any.
+//
+func (v *CompositeValue) RemoveAttachment(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	attachmentTypeID common.TypeID,
+) {
+	v.RemoveField(
+		interpreter,
+		getLocationRange,
+		attachmentFieldName(attachmentTypeID),
+	)
+}
+
 func NewEnumCaseValue(
 	interpreter *Interpreter,
 	getLocationRange func() LocationRange,
@@ -15326,6 +17276,13 @@ type DictionaryValue struct {
 	dictionary       *atree.OrderedMap
 	isDestroyed      bool
 	elementSize      uint
+	// isLoaded is true if the value was loaded from storage, e.g. as the element of a container.
+	// Multiple values may be loaded for the same backing dictionary,
+	// so the backing dictionary of such a value is not shared, see copyOnWriteValue
+	isLoaded bool
+	// copyOnWrite is the state of the backing dictionary
+	// if it is shared with other dictionary values, see copyOnWriteValue
+	copyOnWrite *copyOnWriteState
 }
 
 func NewDictionaryValue(
@@ -15620,6 +17577,104 @@ func (v *DictionaryValue) ContainsKey(
 	return NewBoolValueFromConstructor(interpreter, valueGetter)
 }
 
+// ForEachKey calls the given function for each key of the dictionary,
+// in the same order as the keys are returned by the `keys` field.
+// The iteration stops when the function returns false.
+//
+// The dictionary must not be mutated during the iteration.
+//
+func (v *DictionaryValue) ForEachKey(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	function FunctionValue,
+) {
+	keyType := v.SemaType(interpreter).KeyType
+
+	v.iterateElements(
+		interpreter,
+		getLocationRange,
+		func(key, _ atree.Value) atree.Value {
+			return key
+		},
+		keyType,
+		function,
+	)
+}
+
+// ForEachValue calls the given function for each value of the dictionary,
+// in the same order as the values are returned by the `values` field.
+// The iteration stops when the function returns false.
+//
+// The dictionary must not be mutated during the iteration.
+//
+func (v *DictionaryValue) ForEachValue(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	function FunctionValue,
+) {
+	valueType := v.SemaType(interpreter).ValueType
+
+	v.iterateElements(
+		interpreter,
+		getLocationRange,
+		func(_, value atree.Value) atree.Value {
+			return value
+		},
+		valueType,
+		function,
+	)
+}
+
+func (v *DictionaryValue) iterateElements(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	selectElement func(key, value atree.Value) atree.Value,
+	elementType sema.Type,
+	function FunctionValue,
+) {
+	if interpreter.invalidatedResourceValidationEnabled {
+		v.checkInvalidatedResourceUse(interpreter, getLocationRange)
+	}
+
+	// Mutations of the dictionary while it is iterated are prevented based on its storage ID,
+	// so the backing dictionary, and its storage ID, must not be shared while it is iterated
+
+	v.unshare(interpreter, getLocationRange)
+
+	iterate := func() {
+		err := v.dictionary.Iterate(func(key, value atree.Value) (resume bool, err error) {
+
+			interpreter.ReportComputation(common.ComputationKindLoop, 1)
+
+			element := MustConvertStoredValue(
+				interpreter,
+				selectElement(key, value),
+			)
+
+			invocation := NewInvocation(
+				interpreter,
+				nil,
+				[]Value{element},
+				[]sema.Type{elementType},
+				nil,
+				getLocationRange,
+			)
+
+			result, ok := function.invoke(invocation).(BoolValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			return bool(result), nil
+		})
+		if err != nil {
+			panic(errors.NewExternalError(err))
+		}
+	}
+
+	interpreter.withMutationPrevention(v.StorageID(), iterate)
+}
+
 func (v *DictionaryValue) Get(
 	interpreter *Interpreter,
 	getLocationRange func() LocationRange,
@@ -15846,29 +17901,73 @@ func (v *DictionaryValue) GetMember(
 				keyValue := invocation.Arguments[0]
 				newValue := invocation.Arguments[1]
 
-				return v.Insert(
+				return v.Insert(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					keyValue,
+					newValue,
+				)
+			},
+			sema.DictionaryInsertFunctionType(
+				v.SemaType(interpreter),
+			),
+		)
+
+	case "containsKey":
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				return v.ContainsKey(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					invocation.Arguments[0],
+				)
+			},
+			sema.DictionaryContainsKeyFunctionType(
+				v.SemaType(interpreter),
+			),
+		)
+
+	case "forEachKey":
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				function, ok := invocation.Arguments[0].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				v.ForEachKey(
 					invocation.Interpreter,
 					invocation.GetLocationRange,
-					keyValue,
-					newValue,
+					function,
 				)
+
+				return NewVoidValue(invocation.Interpreter)
 			},
-			sema.DictionaryInsertFunctionType(
+			sema.DictionaryForEachKeyFunctionType(
 				v.SemaType(interpreter),
 			),
 		)
 
-	case "containsKey":
+	case "forEachValue":
 		return NewHostFunctionValue(
 			interpreter,
 			func(invocation Invocation) Value {
-				return v.ContainsKey(
+				function, ok := invocation.Arguments[0].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				v.ForEachValue(
 					invocation.Interpreter,
 					invocation.GetLocationRange,
-					invocation.Arguments[0],
+					function,
 				)
+
+				return NewVoidValue(invocation.Interpreter)
 			},
-			sema.DictionaryContainsKeyFunctionType(
+			sema.DictionaryForEachValueFunctionType(
 				v.SemaType(interpreter),
 			),
 		)
@@ -15921,6 +18020,10 @@ func (v *DictionaryValue) Remove(
 	keyValue Value,
 ) OptionalValue {
 
+	v.unshare(interpreter, getLocationRange)
+
+	interpreter.checkContainerNotIterated(v.StorageID(), getLocationRange)
+
 	valueComparator := newValueComparator(interpreter, getLocationRange)
 	hashInputProvider := newHashInputProvider(interpreter, getLocationRange)
 
@@ -15975,6 +18078,10 @@ func (v *DictionaryValue) Insert(
 	keyValue, value Value,
 ) OptionalValue {
 
+	v.unshare(interpreter, getLocationRange)
+
+	interpreter.checkContainerNotIterated(v.StorageID(), getLocationRange)
+
 	// length increases by 1
 	dataSlabs, metaDataSlabs := common.AdditionalAtreeMemoryUsage(v.dictionary.Count(), v.elementSize, false)
 	common.UseMemory(interpreter, common.AtreeMapElementOverhead)
@@ -16213,6 +18320,11 @@ func (v *DictionaryValue) Transfer(
 		}()
 	}
 
+	if remove {
+		// The backing dictionary must not be removed while it is shared
+		v.unshare(interpreter, getLocationRange)
+	}
+
 	currentStorageID := v.StorageID()
 	currentAddress := currentStorageID.Address
 
@@ -16300,6 +18412,8 @@ func (v *DictionaryValue) Transfer(
 
 		newStorageID := dictionary.StorageID()
 
+		interpreter.invalidateResourceReferences(currentStorageID)
+
 		interpreter.updateReferencedResource(
 			currentStorageID,
 			newStorageID,
@@ -16324,6 +18438,141 @@ func (v *DictionaryValue) Transfer(
 	return res
 }
 
+func (v *DictionaryValue) isSharable(interpreter *Interpreter) bool {
+	return !v.isLoaded &&
+		v.StorageID().Address == (atree.Address{}) &&
+		!v.IsResourceKinded(interpreter) &&
+		isImmutableStaticType(v.Type.KeyType) &&
+		isImmutableStaticType(v.Type.ValueType)
+}
+
+func (v *DictionaryValue) share(interpreter *Interpreter, getLocationRange func() LocationRange) Value {
+
+	// The copy is metered like an eager transfer,
+	// as it may have to be performed later on
+
+	baseUse, elementOverhead, dataUse, metaDataUse := common.NewDictionaryMemoryUsages(
+		v.dictionary.Count(),
+		v.elementSize,
+	)
+	common.UseMemory(interpreter, baseUse)
+	common.UseMemory(interpreter, elementOverhead)
+	common.UseMemory(interpreter, dataUse)
+	common.UseMemory(interpreter, metaDataUse)
+
+	interpreter.ReportComputation(common.ComputationKindTransferDictionaryValue, uint(v.Count()))
+
+	if interpreter.tracingEnabled {
+		startTime := time.Now()
+
+		typeInfo := v.Type.String()
+		count := v.Count()
+
+		defer func() {
+			interpreter.reportDictionaryValueTransferTrace(
+				typeInfo,
+				count,
+				time.Since(startTime),
+			)
+		}()
+	}
+
+	elementMemoryUse := common.NewAtreeMapPreAllocatedElementsMemoryUsage(v.dictionary.Count(), v.elementSize)
+	common.UseMemory(interpreter.memoryGauge, elementMemoryUse)
+
+	// Generate the storage IDs of the copy like an eager transfer,
+	// they are used once the backing dictionary gets copied
+
+	if v.copyOnWrite == nil {
+		v.copyOnWrite = newCopyOnWriteState()
+	}
+
+	storageIDs := v.copyOnWrite.generateStorageIDs(
+		interpreter,
+		func() []atree.StorageID {
+			return interpreter.dictionaryCopyStorageIDs(v.dictionary, getLocationRange)
+		},
+	)
+
+	v.copyOnWrite.add(storageIDs)
+
+	res := newDictionaryValueFromOrderedMap(v.dictionary, v.Type)
+	res.elementSize = v.elementSize
+	res.semaType = v.semaType
+	res.isResourceKinded = v.isResourceKinded
+	res.isDestroyed = v.isDestroyed
+	res.copyOnWrite = v.copyOnWrite
+
+	return res
+}
+
+// unshare ensures that the backing dictionary is not shared with any other dictionary value.
+// It must be called before the backing dictionary gets mutated or removed.
+//
+func (v *DictionaryValue) unshare(interpreter *Interpreter, getLocationRange func() LocationRange) {
+	if v.copyOnWrite == nil {
+		return
+	}
+
+	storageIDs, ok := v.copyOnWrite.remove()
+	v.copyOnWrite = nil
+	if !ok {
+		return
+	}
+
+	valueComparator := newValueComparator(interpreter, getLocationRange)
+	hashInputProvider := newHashInputProvider(interpreter, getLocationRange)
+
+	iterator, err := v.dictionary.Iterator()
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	storage := &reservedSlabStorage{
+		SlabStorage: interpreter.Storage,
+		storageIDs:  storageIDs,
+	}
+
+	address := atree.Address{}
+
+	dictionary, err := atree.NewMapFromBatchData(
+		storage,
+		address,
+		atree.NewDefaultDigesterBuilder(),
+		v.dictionary.Type(),
+		valueComparator,
+		hashInputProvider,
+		v.dictionary.Seed(),
+		func() (atree.Value, atree.Value, error) {
+
+			atreeKey, atreeValue, err := iterator.Next()
+			if err != nil {
+				return nil, nil, err
+			}
+			if atreeKey == nil || atreeValue == nil {
+				return nil, nil, nil
+			}
+
+			key := MustConvertStoredValue(interpreter, atreeKey).
+				Transfer(interpreter, getLocationRange, address, false, nil)
+
+			value := MustConvertStoredValue(interpreter, atreeValue).
+				Transfer(interpreter, getLocationRange, address, false, nil)
+
+			return key, value, nil
+		},
+	)
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	storage.checkUsed()
+
+	dictionary.Storage = interpreter.Storage
+
+	v.dictionary = dictionary
+}
+
 func (v *DictionaryValue) Clone(interpreter *Interpreter) Value {
 
 	valueComparator := newValueComparator(interpreter, ReturnEmptyLocationRange)
@@ -16394,6 +18643,10 @@ func (v *DictionaryValue) DeepRemove(interpreter *Interpreter) {
 		}()
 	}
 
+	// The backing dictionary must not be removed while it is shared
+
+	v.unshare(interpreter, ReturnEmptyLocationRange)
+
 	// Remove nested values and storables
 
 	storage := v.dictionary.Storage
@@ -16862,6 +19115,10 @@ func (v *SomeValue) Transfer(
 			interpreter.RemoveReferencedSlab(v.valueStorable)
 			interpreter.RemoveReferencedSlab(storable)
 		}
+	} else if trackedValue, ok := innerValue.(ReferenceTrackedResourceKindedValue); ok {
+		// The inner resource is moved along with the optional,
+		// even though it is not transferred itself
+		interpreter.invalidateResourceReferences(trackedValue.StorageID())
 	}
 
 	var res *SomeValue
@@ -17043,6 +19300,7 @@ func (v *StorageReferenceValue) dereference(interpreter *Interpreter, getLocatio
 		if !interpreter.IsSubTypeOfSemaType(staticType, v.BorrowedType) {
 			return nil, ForceCastTypeMismatchError{
 				ExpectedType:  v.BorrowedType,
+				ActualType:    interpreter.MustConvertStaticToSemaType(staticType),
 				LocationRange: getLocationRange(),
 			}
 		}
@@ -17199,21 +19457,14 @@ func (v *StorageReferenceValue) RemoveKey(
 		RemoveKey(interpreter, getLocationRange, key)
 }
 
+// Equal returns true if the given value is a storage reference to the same storage location.
+// References are compared by identity, so the authorization and the borrowed type are not relevant.
+//
 func (v *StorageReferenceValue) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
 	otherReference, ok := other.(*StorageReferenceValue)
-	if !ok ||
-		v.TargetStorageAddress != otherReference.TargetStorageAddress ||
-		v.TargetPath != otherReference.TargetPath ||
-		v.Authorized != otherReference.Authorized {
-
-		return false
-	}
-
-	if v.BorrowedType == nil {
-		return otherReference.BorrowedType == nil
-	} else {
-		return v.BorrowedType.Equal(otherReference.BorrowedType)
-	}
+	return ok &&
+		v.TargetStorageAddress == otherReference.TargetStorageAddress &&
+		v.TargetPath == otherReference.TargetPath
 }
 
 func (v *StorageReferenceValue) ConformsToStaticType(
@@ -17287,6 +19538,8 @@ type EphemeralReferenceValue struct {
 	Authorized   bool
 	Value        Value
 	BorrowedType sema.Type
+	// invalidated is true if the referenced resource was moved
+	invalidated bool
 }
 
 var _ Value = &EphemeralReferenceValue{}
@@ -17348,7 +19601,8 @@ func (v *EphemeralReferenceValue) MeteredString(memoryGauge common.MemoryGauge,
 }
 
 func (v *EphemeralReferenceValue) StaticType(inter *Interpreter) StaticType {
-	referencedValue := v.ReferencedValue(inter, ReturnEmptyLocationRange)
+	// NOTE: the static type of an invalidated reference is still available
+	referencedValue := v.referencedValue(inter, ReturnEmptyLocationRange)
 	if referencedValue == nil {
 		panic(DereferenceError{})
 	}
@@ -17368,6 +19622,19 @@ func (*EphemeralReferenceValue) IsImportable(_ *Interpreter) bool {
 func (v *EphemeralReferenceValue) ReferencedValue(
 	interpreter *Interpreter,
 	getLocationRange func() LocationRange,
+) *Value {
+	if v.invalidated {
+		panic(InvalidatedReferenceError{
+			LocationRange: getLocationRange(),
+		})
+	}
+
+	return v.referencedValue(interpreter, getLocationRange)
+}
+
+func (v *EphemeralReferenceValue) referencedValue(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
 ) *Value {
 	// Just like for storage references, references to optionals are unwrapped,
 	// i.e. a reference to `nil` aborts when dereferenced.
@@ -17527,20 +19794,12 @@ func (v *EphemeralReferenceValue) RemoveKey(
 		RemoveKey(interpreter, getLocationRange, key)
 }
 
+// Equal returns true if the given value is an ephemeral reference to the same value.
+// References are compared by identity, so the authorization and the borrowed type are not relevant.
+//
 func (v *EphemeralReferenceValue) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
 	otherReference, ok := other.(*EphemeralReferenceValue)
-	if !ok ||
-		v.Value != otherReference.Value ||
-		v.Authorized != otherReference.Authorized {
-
-		return false
-	}
-
-	if v.BorrowedType == nil {
-		return otherReference.BorrowedType == nil
-	} else {
-		return v.BorrowedType.Equal(otherReference.BorrowedType)
-	}
+	return ok && v.Value == otherReference.Value
 }
 
 func (v *EphemeralReferenceValue) ConformsToStaticType(
@@ -18148,14 +20407,25 @@ func (PathValue) ChildStorables() []atree.Storable {
 
 // CapabilityValue
 
+// CapabilityValue is either a path capability, which was created by linking,
+// or an ID capability, which was issued through a capability controller.
+//
+// Path capabilities have a path and no ID.
+// ID capabilities have an ID and no path.
+//
 type CapabilityValue struct {
 	Address    AddressValue
 	Path       PathValue
 	BorrowType StaticType
+	ID         UInt64Value
 }
 
 func NewUnmeteredCapabilityValue(address AddressValue, path PathValue, borrowType StaticType) *CapabilityValue {
-	return &CapabilityValue{address, path, borrowType}
+	return &CapabilityValue{
+		Address:    address,
+		Path:       path,
+		BorrowType: borrowType,
+	}
 }
 
 func NewCapabilityValue(
@@ -18169,6 +20439,31 @@ func NewCapabilityValue(
 	return NewUnmeteredCapabilityValue(address, path, borrowType)
 }
 
+func NewUnmeteredIDCapabilityValue(id UInt64Value, address AddressValue, borrowType StaticType) *CapabilityValue {
+	return &CapabilityValue{
+		Address:    address,
+		BorrowType: borrowType,
+		ID:         id,
+	}
+}
+
+func NewIDCapabilityValue(
+	memoryGauge common.MemoryGauge,
+	id UInt64Value,
+	address AddressValue,
+	borrowType StaticType,
+) *CapabilityValue {
+	// Constant because its constituents are already metered.
+	common.UseMemory(memoryGauge, common.CapabilityValueMemoryUsage)
+	return NewUnmeteredIDCapabilityValue(id, address, borrowType)
+}
+
+// IsIDCapability returns true if the capability was issued through a capability controller,
+// and false if it is a path capability
+func (v *CapabilityValue) IsIDCapability() bool {
+	return v.ID != 0
+}
+
 var _ Value = &CapabilityValue{}
 var _ atree.Storable = &CapabilityValue{}
 var _ EquatableValue = &CapabilityValue{}
@@ -18182,7 +20477,11 @@ func (v *CapabilityValue) Accept(interpreter *Interpreter, visitor Visitor) {
 
 func (v *CapabilityValue) Walk(_ *Interpreter, walkChild func(Value)) {
 	walkChild(v.Address)
-	walkChild(v.Path)
+	if v.IsIDCapability() {
+		walkChild(v.ID)
+	} else {
+		walkChild(v.Path)
+	}
 }
 
 func (v *CapabilityValue) StaticType(inter *Interpreter) StaticType {
@@ -18205,6 +20504,13 @@ func (v *CapabilityValue) RecursiveString(seenReferences SeenReferences) string
 	if v.BorrowType != nil {
 		borrowType = v.BorrowType.String()
 	}
+	if v.IsIDCapability() {
+		return format.IDCapability(
+			borrowType,
+			v.Address.RecursiveString(seenReferences),
+			v.ID.RecursiveString(seenReferences),
+		)
+	}
 	return format.Capability(
 		borrowType,
 		v.Address.RecursiveString(seenReferences),
@@ -18220,6 +20526,14 @@ func (v *CapabilityValue) MeteredString(memoryGauge common.MemoryGauge, seenRefe
 		borrowType = v.BorrowType.MeteredString(memoryGauge)
 	}
 
+	if v.IsIDCapability() {
+		return format.IDCapability(
+			borrowType,
+			v.Address.MeteredString(memoryGauge, seenReferences),
+			v.ID.MeteredString(memoryGauge, seenReferences),
+		)
+	}
+
 	return format.Capability(
 		borrowType,
 		v.Address.MeteredString(memoryGauge, seenReferences),
@@ -18235,7 +20549,7 @@ func (v *CapabilityValue) GetMember(interpreter *Interpreter, _ func() LocationR
 			// this function will panic already if this conversion fails
 			borrowType, _ = interpreter.MustConvertStaticToSemaType(v.BorrowType).(*sema.ReferenceType)
 		}
-		return interpreter.capabilityBorrowFunction(v.Address, v.Path, borrowType)
+		return interpreter.capabilityBorrowFunction(v.Address, v.Path, v.ID, borrowType)
 
 	case sema.CapabilityTypeCheckField:
 		var borrowType *sema.ReferenceType
@@ -18243,7 +20557,7 @@ func (v *CapabilityValue) GetMember(interpreter *Interpreter, _ func() LocationR
 			// this function will panic already if this conversion fails
 			borrowType, _ = interpreter.MustConvertStaticToSemaType(v.BorrowType).(*sema.ReferenceType)
 		}
-		return interpreter.capabilityCheckFunction(v.Address, v.Path, borrowType)
+		return interpreter.capabilityCheckFunction(v.Address, v.Path, v.ID, borrowType)
 
 	case sema.CapabilityTypeAddressField:
 		return v.Address
@@ -18287,7 +20601,8 @@ func (v *CapabilityValue) Equal(interpreter *Interpreter, getLocationRange func(
 	}
 
 	return otherCapability.Address.Equal(interpreter, getLocationRange, v.Address) &&
-		otherCapability.Path.Equal(interpreter, getLocationRange, v.Path)
+		otherCapability.Path.Equal(interpreter, getLocationRange, v.Path) &&
+		otherCapability.ID == v.ID
 }
 
 func (*CapabilityValue) IsStorable() bool {
@@ -18334,6 +20649,7 @@ func (v *CapabilityValue) Clone(interpreter *Interpreter) Value {
 		Address:    v.Address.Clone(interpreter).(AddressValue),
 		Path:       v.Path.Clone(interpreter).(PathValue),
 		BorrowType: v.BorrowType,
+		ID:         v.ID,
 	}
 }
 
@@ -18490,6 +20806,140 @@ func (v LinkValue) ChildStorables() []atree.Storable {
 	}
 }
 
+// PublishedValue
+
+type PublishedValue struct {
+	Recipient AddressValue
+	Value     *CapabilityValue
+}
+
+func NewPublishedValue(memoryGauge common.MemoryGauge, recipient AddressValue, value *CapabilityValue) *PublishedValue {
+	common.UseMemory(memoryGauge, common.PublishedValueMemoryUsage)
+	return &PublishedValue{
+		Recipient: recipient,
+		Value:     value,
+	}
+}
+
+var _ Value = &PublishedValue{}
+var _ atree.Value = &PublishedValue{}
+var _ EquatableValue = &PublishedValue{}
+
+func (*PublishedValue) IsValue() {}
+
+func (v *PublishedValue) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitPublishedValue(interpreter, v)
+}
+
+func (v *PublishedValue) Walk(_ *Interpreter, walkChild func(Value)) {
+	walkChild(v.Recipient)
+	walkChild(v.Value)
+}
+
+func (*PublishedValue) StaticType(_ *Interpreter) StaticType {
+	return nil
+}
+
+func (*PublishedValue) IsImportable(_ *Interpreter) bool {
+	return false
+}
+
+func (v *PublishedValue) String() string {
+	return v.RecursiveString(SeenReferences{})
+}
+
+func (v *PublishedValue) RecursiveString(seenReferences SeenReferences) string {
+	return fmt.Sprintf(
+		"PublishedValue<%s>(%s)",
+		v.Recipient.RecursiveString(seenReferences),
+		v.Value.RecursiveString(seenReferences),
+	)
+}
+
+func (v *PublishedValue) MeteredString(memoryGauge common.MemoryGauge, seenReferences SeenReferences) string {
+	common.UseMemory(memoryGauge, common.PublishedValueStringMemoryUsage)
+
+	return fmt.Sprintf(
+		"PublishedValue<%s>(%s)",
+		v.Recipient.MeteredString(memoryGauge, seenReferences),
+		v.Value.MeteredString(memoryGauge, seenReferences),
+	)
+}
+
+func (v *PublishedValue) ConformsToStaticType(
+	_ *Interpreter,
+	_ func() LocationRange,
+	_ TypeConformanceResults,
+) bool {
+	return true
+}
+
+func (v *PublishedValue) Equal(interpreter *Interpreter, getLocationRange func() LocationRange, other Value) bool {
+	otherValue, ok := other.(*PublishedValue)
+	if !ok {
+		return false
+	}
+
+	return otherValue.Recipient.Equal(interpreter, getLocationRange, v.Recipient) &&
+		otherValue.Value.Equal(interpreter, getLocationRange, v.Value)
+}
+
+func (*PublishedValue) IsStorable() bool {
+	return true
+}
+
+func (v *PublishedValue) Storable(storage atree.SlabStorage, address atree.Address, maxInlineSize uint64) (atree.Storable, error) {
+	return maybeLargeImmutableStorable(v, storage, address, maxInlineSize)
+}
+
+func (*PublishedValue) NeedsStoreTo(_ atree.Address) bool {
+	return false
+}
+
+func (*PublishedValue) IsResourceKinded(_ *Interpreter) bool {
+	return false
+}
+
+func (v *PublishedValue) Transfer(
+	interpreter *Interpreter,
+	_ func() LocationRange,
+	_ atree.Address,
+	remove bool,
+	storable atree.Storable,
+) Value {
+	if remove {
+		v.DeepRemove(interpreter)
+		interpreter.RemoveReferencedSlab(storable)
+	}
+	return v
+}
+
+func (v *PublishedValue) Clone(interpreter *Interpreter) Value {
+	return &PublishedValue{
+		Recipient: v.Recipient.Clone(interpreter).(AddressValue),
+		Value:     v.Value.Clone(interpreter).(*CapabilityValue),
+	}
+}
+
+func (v *PublishedValue) DeepRemove(interpreter *Interpreter) {
+	v.Value.DeepRemove(interpreter)
+}
+
+func (v *PublishedValue) ByteSize() uint32 {
+	return mustStorableSize(v)
+}
+
+func (v *PublishedValue) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+	return v, nil
+}
+
+func (v *PublishedValue) ChildStorables() []atree.Storable {
+	return []atree.Storable{
+		v.Recipient,
+		v.Value,
+	}
+}
+
 // NewPublicKeyValue constructs a PublicKey value.
 func NewPublicKeyValue(
 	interpreter *Interpreter,
@@ -1586,6 +1586,13 @@ func TestBlockValue(t *testing.T) {
 			common.Address{},
 		),
 		5.0,
+		NewArrayValue(
+			inter,
+			ReturnEmptyLocationRange,
+			ByteArrayStaticType,
+			common.Address{},
+		),
+		6,
 	)
 
 	// static type test
@@ -3888,8 +3895,10 @@ func TestValue_ConformsToStaticType(t *testing.T) {
 		t.Parallel()
 
 		testCases := map[*sema.FixedPointNumericType]NumberValue{
-			sema.UFix64Type: NewUnmeteredUFix64ValueWithInteger(42),
-			sema.Fix64Type:  NewUnmeteredFix64ValueWithInteger(42),
+			sema.UFix64Type:  NewUnmeteredUFix64ValueWithInteger(42),
+			sema.Fix64Type:   NewUnmeteredFix64ValueWithInteger(42),
+			sema.UFix128Type: NewUnmeteredUFix128ValueWithInteger(big.NewInt(42)),
+			sema.Fix128Type:  NewUnmeteredFix128ValueWithInteger(big.NewInt(42)),
 		}
 
 		for _, ty := range sema.AllFixedPointTypes {
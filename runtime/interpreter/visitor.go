@@ -26,6 +26,7 @@ type Visitor interface {
 	VisitStringValue(interpreter *Interpreter, value *StringValue)
 	VisitCharacterValue(interpreter *Interpreter, value CharacterValue)
 	VisitArrayValue(interpreter *Interpreter, value *ArrayValue) bool
+	VisitTupleValue(interpreter *Interpreter, value *TupleValue) bool
 	VisitIntValue(interpreter *Interpreter, value IntValue)
 	VisitInt8Value(interpreter *Interpreter, value Int8Value)
 	VisitInt16Value(interpreter *Interpreter, value Int16Value)
@@ -46,6 +47,8 @@ type Visitor interface {
 	VisitWord64Value(interpreter *Interpreter, value Word64Value)
 	VisitFix64Value(interpreter *Interpreter, value Fix64Value)
 	VisitUFix64Value(interpreter *Interpreter, value UFix64Value)
+	VisitFix128Value(interpreter *Interpreter, value Fix128Value)
+	VisitUFix128Value(interpreter *Interpreter, value UFix128Value)
 	VisitCompositeValue(interpreter *Interpreter, value *CompositeValue) bool
 	VisitDictionaryValue(interpreter *Interpreter, value *DictionaryValue) bool
 	VisitNilValue(interpreter *Interpreter, value NilValue)
@@ -56,6 +59,7 @@ type Visitor interface {
 	VisitPathValue(interpreter *Interpreter, value PathValue)
 	VisitCapabilityValue(interpreter *Interpreter, value *CapabilityValue)
 	VisitLinkValue(interpreter *Interpreter, value LinkValue)
+	VisitPublishedValue(interpreter *Interpreter, value *PublishedValue)
 	VisitInterpretedFunctionValue(interpreter *Interpreter, value *InterpretedFunctionValue)
 	VisitHostFunctionValue(interpreter *Interpreter, value *HostFunctionValue)
 	VisitBoundFunctionValue(interpreter *Interpreter, value BoundFunctionValue)
@@ -69,6 +73,7 @@ type EmptyVisitor struct {
 	CharacterValueVisitor           func(interpreter *Interpreter, value CharacterValue)
 	StringValueVisitor              func(interpreter *Interpreter, value *StringValue)
 	ArrayValueVisitor               func(interpreter *Interpreter, value *ArrayValue) bool
+	TupleValueVisitor               func(interpreter *Interpreter, value *TupleValue) bool
 	IntValueVisitor                 func(interpreter *Interpreter, value IntValue)
 	Int8ValueVisitor                func(interpreter *Interpreter, value Int8Value)
 	Int16ValueVisitor               func(interpreter *Interpreter, value Int16Value)
@@ -89,6 +94,8 @@ type EmptyVisitor struct {
 	Word64ValueVisitor              func(interpreter *Interpreter, value Word64Value)
 	Fix64ValueVisitor               func(interpreter *Interpreter, value Fix64Value)
 	UFix64ValueVisitor              func(interpreter *Interpreter, value UFix64Value)
+	Fix128ValueVisitor              func(interpreter *Interpreter, value Fix128Value)
+	UFix128ValueVisitor             func(interpreter *Interpreter, value UFix128Value)
 	CompositeValueVisitor           func(interpreter *Interpreter, value *CompositeValue) bool
 	DictionaryValueVisitor          func(interpreter *Interpreter, value *DictionaryValue) bool
 	NilValueVisitor                 func(interpreter *Interpreter, value NilValue)
@@ -99,6 +106,7 @@ type EmptyVisitor struct {
 	PathValueVisitor                func(interpreter *Interpreter, value PathValue)
 	CapabilityValueVisitor          func(interpreter *Interpreter, value *CapabilityValue)
 	LinkValueVisitor                func(interpreter *Interpreter, value LinkValue)
+	PublishedValueVisitor           func(interpreter *Interpreter, value *PublishedValue)
 	InterpretedFunctionValueVisitor func(interpreter *Interpreter, value *InterpretedFunctionValue)
 	HostFunctionValueVisitor        func(interpreter *Interpreter, value *HostFunctionValue)
 	BoundFunctionValueVisitor       func(interpreter *Interpreter, value BoundFunctionValue)
@@ -155,6 +163,13 @@ func (v EmptyVisitor) VisitArrayValue(interpreter *Interpreter, value *ArrayValu
 	return v.ArrayValueVisitor(interpreter, value)
 }
 
+func (v EmptyVisitor) VisitTupleValue(interpreter *Interpreter, value *TupleValue) bool {
+	if v.TupleValueVisitor == nil {
+		return true
+	}
+	return v.TupleValueVisitor(interpreter, value)
+}
+
 func (v EmptyVisitor) VisitIntValue(interpreter *Interpreter, value IntValue) {
 	if v.IntValueVisitor == nil {
 		return
@@ -295,6 +310,20 @@ func (v EmptyVisitor) VisitUFix64Value(interpreter *Interpreter, value UFix64Val
 	v.UFix64ValueVisitor(interpreter, value)
 }
 
+func (v EmptyVisitor) VisitFix128Value(interpreter *Interpreter, value Fix128Value) {
+	if v.Fix128ValueVisitor == nil {
+		return
+	}
+	v.Fix128ValueVisitor(interpreter, value)
+}
+
+func (v EmptyVisitor) VisitUFix128Value(interpreter *Interpreter, value UFix128Value) {
+	if v.UFix128ValueVisitor == nil {
+		return
+	}
+	v.UFix128ValueVisitor(interpreter, value)
+}
+
 func (v EmptyVisitor) VisitCompositeValue(interpreter *Interpreter, value *CompositeValue) bool {
 	if v.CompositeValueVisitor == nil {
 		return true
@@ -365,6 +394,13 @@ func (v EmptyVisitor) VisitLinkValue(interpreter *Interpreter, value LinkValue)
 	v.LinkValueVisitor(interpreter, value)
 }
 
+func (v EmptyVisitor) VisitPublishedValue(interpreter *Interpreter, value *PublishedValue) {
+	if v.PublishedValueVisitor == nil {
+		return
+	}
+	v.PublishedValueVisitor(interpreter, value)
+}
+
 func (v EmptyVisitor) VisitInterpretedFunctionValue(interpreter *Interpreter, value *InterpretedFunctionValue) {
 	if v.InterpretedFunctionValueVisitor == nil {
 		return
@@ -262,12 +262,18 @@ func fixedPointLiteralValue(memoryGauge common.MemoryGauge, expression ast.Expre
 
 	// TODO: adjust once/if we support more fixed point types
 
+	scale := uint(sema.Fix64Scale)
+	switch ty {
+	case sema.Fix128Type, sema.UFix128Type:
+		scale = sema.Fix128Scale
+	}
+
 	value := fixedpoint.ConvertToFixedPointBigInt(
 		fixedPointExpression.Negative,
 		fixedPointExpression.UnsignedInteger,
 		fixedPointExpression.Fractional,
 		fixedPointExpression.Scale,
-		sema.Fix64Scale,
+		scale,
 	)
 
 	switch ty {
@@ -275,6 +281,20 @@ func fixedPointLiteralValue(memoryGauge common.MemoryGauge, expression ast.Expre
 		return cadence.Fix64(value.Int64()), nil
 	case sema.UFix64Type:
 		return cadence.UFix64(value.Uint64()), nil
+	case sema.Fix128Type:
+		return cadence.NewMeteredFix128FromBig(
+			memoryGauge,
+			func() *big.Int {
+				return value
+			},
+		)
+	case sema.UFix128Type:
+		return cadence.NewMeteredUFix128FromBig(
+			memoryGauge,
+			func() *big.Int {
+				return value
+			},
+		)
 	}
 
 	return nil, UnsupportedLiteralError
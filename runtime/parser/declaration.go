@@ -66,6 +66,9 @@ func parseDeclaration(p *parser, docString string) (ast.Declaration, error) {
 	access := ast.AccessNotSpecified
 	var accessPos *ast.Position
 
+	purity := ast.FunctionPurityUnspecified
+	var purityPos *ast.Position
+
 	for {
 		p.skipSpaceAndComments(true)
 
@@ -81,7 +84,17 @@ func parseDeclaration(p *parser, docString string) (ast.Declaration, error) {
 				return parseVariableDeclaration(p, access, accessPos, docString)
 
 			case keywordFun:
-				return parseFunctionDeclaration(p, false, access, accessPos, docString)
+				return parseFunctionDeclaration(p, false, access, accessPos, purity, purityPos, docString)
+
+			case keywordView:
+				var err error
+				purity, purityPos, err = parsePurityAnnotation(p, keywordFun)
+				if err != nil {
+					return nil, err
+				}
+				if purity != ast.FunctionPurityUnspecified {
+					continue
+				}
 
 			case keywordImport:
 				return parseImportDeclaration(p)
@@ -92,6 +105,12 @@ func parseDeclaration(p *parser, docString string) (ast.Declaration, error) {
 			case keywordStruct, keywordResource, keywordContract, keywordEnum:
 				return parseCompositeOrInterfaceDeclaration(p, access, accessPos, docString)
 
+			case keywordAttachment:
+				return parseAttachmentDeclaration(p, access, accessPos, docString)
+
+			case keywordTypeAlias:
+				return parseTypeAliasDeclaration(p, access, accessPos, docString)
+
 			case KeywordTransaction:
 				if access != ast.AccessNotSpecified {
 					return nil, p.syntaxError("invalid access modifier for transaction")
@@ -272,6 +291,59 @@ func parseVariableDeclaration(
 	// Skip the `let` or `var` keyword
 	p.next()
 
+	return parseVariableDeclarationRemainder(
+		p,
+		access,
+		isLet,
+		startPos,
+		docString,
+	)
+}
+
+// parseVariableDeclarationStatement parses a variable declaration in a statement position,
+// which is either a plain variable declaration, or a tuple variable declaration.
+//
+func parseVariableDeclarationStatement(p *parser) (ast.Statement, error) {
+
+	startPos := p.current.StartPos
+
+	isLet := p.current.Value == keywordLet
+
+	// Skip the `let` or `var` keyword
+	p.next()
+
+	p.skipSpaceAndComments(true)
+	if p.current.Is(lexer.TokenParenOpen) {
+		tupleVariableDeclaration, err := parseTupleVariableDeclarationRemainder(p, isLet, startPos)
+		if err != nil {
+			return nil, err
+		}
+		return tupleVariableDeclaration, nil
+	}
+
+	variableDeclaration, err := parseVariableDeclarationRemainder(
+		p,
+		ast.AccessNotSpecified,
+		isLet,
+		startPos,
+		"",
+	)
+	if err != nil {
+		return nil, err
+	}
+	return variableDeclaration, nil
+}
+
+// parseVariableDeclarationRemainder parses a variable declaration after the `let` or `var` keyword.
+//
+func parseVariableDeclarationRemainder(
+	p *parser,
+	access ast.Access,
+	isLet bool,
+	startPos ast.Position,
+	docString string,
+) (*ast.VariableDeclaration, error) {
+
 	p.skipSpaceAndComments(true)
 	if !p.current.Is(lexer.TokenIdentifier) {
 		return nil, p.syntaxError(
@@ -344,6 +416,117 @@ func parseVariableDeclaration(
 	return variableDeclaration, nil
 }
 
+// parseTupleVariableDeclarationRemainder parses a variable declaration which destructures a tuple,
+// after the `let` or `var` keyword.
+//
+//     tupleVariableDeclaration :
+//         ( 'let' | 'var' )
+//         '(' identifier ( ',' identifier )+ ')'
+//         ( ':' typeAnnotation )?
+//         transfer expression
+//
+func parseTupleVariableDeclarationRemainder(
+	p *parser,
+	isLet bool,
+	startPos ast.Position,
+) (*ast.TupleVariableDeclaration, error) {
+
+	p.skipSpaceAndComments(true)
+	_, err := p.mustOne(lexer.TokenParenOpen)
+	if err != nil {
+		return nil, err
+	}
+
+	var identifiers []ast.Identifier
+
+	expectIdentifier := true
+
+	atEnd := false
+	for !atEnd {
+		p.skipSpaceAndComments(true)
+
+		switch p.current.Type {
+		case lexer.TokenComma:
+			if expectIdentifier {
+				return nil, p.syntaxError("expected identifier, got %s", p.current.Type)
+			}
+			// Skip the comma
+			p.next()
+			expectIdentifier = true
+
+		case lexer.TokenParenClose:
+			if expectIdentifier {
+				return nil, p.syntaxError("expected identifier, got %s", p.current.Type)
+			}
+			// Skip the closing paren
+			p.next()
+			atEnd = true
+
+		case lexer.TokenIdentifier:
+			if !expectIdentifier {
+				return nil, p.syntaxError(
+					"unexpected token: got %s, expected %s or %s",
+					p.current.Type,
+					lexer.TokenComma,
+					lexer.TokenParenClose,
+				)
+			}
+
+			identifiers = append(identifiers, p.tokenToIdentifier(p.current))
+
+			// Skip the identifier
+			p.next()
+			expectIdentifier = false
+
+		default:
+			return nil, p.syntaxError(
+				"expected identifier in tuple variable declaration, got %s",
+				p.current.Type,
+			)
+		}
+	}
+
+	if len(identifiers) < 2 {
+		return nil, p.syntaxError("tuple variable declarations must declare at least two variables")
+	}
+
+	p.skipSpaceAndComments(true)
+
+	var typeAnnotation *ast.TypeAnnotation
+
+	if p.current.Is(lexer.TokenColon) {
+		// Skip the colon
+		p.next()
+		p.skipSpaceAndComments(true)
+
+		typeAnnotation, err = parseTypeAnnotation(p)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	p.skipSpaceAndComments(true)
+	transfer := parseTransfer(p)
+	if transfer == nil {
+		return nil, p.syntaxError("expected transfer")
+	}
+
+	value, err := parseExpression(p, lowestBindingPower)
+	if err != nil {
+		return nil, err
+	}
+
+	return ast.NewTupleVariableDeclaration(
+		p.memoryGauge,
+		isLet,
+		identifiers,
+		typeAnnotation,
+		value,
+		transfer,
+		startPos,
+	), nil
+}
+
 // parseTransfer parses a transfer.
 //
 //     transfer : '=' | '<-' | '<-!'
@@ -401,14 +584,17 @@ func parsePragmaDeclaration(p *parser) (*ast.PragmaDeclaration, error) {
 //
 //     importDeclaration :
 //         'import'
-//         ( identifier (',' identifier)* 'from' )?
+//         ( importedIdentifier (',' importedIdentifier)* 'from' )?
 //         ( string | hexadecimalLiteral | identifier )
 //
+//     importedIdentifier : identifier ( 'as' identifier )?
+//
 func parseImportDeclaration(p *parser) (*ast.ImportDeclaration, error) {
 
 	startPosition := p.current.StartPos
 
 	var identifiers []ast.Identifier
+	var aliases map[string]string
 
 	var location common.Location
 	var locationPos ast.Position
@@ -460,9 +646,38 @@ func parseImportDeclaration(p *parser) (*ast.ImportDeclaration, error) {
 		return nil
 	}
 
-	parseMoreIdentifiers := func() error {
-		expectCommaOrFrom := false
+	// parseAlias parses the alias for the given imported identifier.
+	// The current token is the `as` keyword
+	parseAlias := func(identifier ast.Identifier) error {
+		// Skip the `as` keyword
+		p.next()
+		p.skipSpaceAndComments(true)
+
+		if !p.current.Is(lexer.TokenIdentifier) {
+			return p.syntaxError(
+				"expected %s for alias of import %q, got %s",
+				lexer.TokenIdentifier,
+				identifier.Identifier,
+				p.current.Type,
+			)
+		}
+
+		if _, ok := aliases[identifier.Identifier]; ok {
+			return p.syntaxError(
+				"duplicate alias for import %q",
+				identifier.Identifier,
+			)
+		}
 
+		if aliases == nil {
+			aliases = map[string]string{}
+		}
+		aliases[identifier.Identifier] = p.current.Value.(string)
+
+		return nil
+	}
+
+	parseMoreIdentifiers := func(expectCommaOrFrom bool) error {
 		atEnd := false
 		for !atEnd {
 			p.next()
@@ -482,6 +697,18 @@ func parseImportDeclaration(p *parser) (*ast.ImportDeclaration, error) {
 
 			case lexer.TokenIdentifier:
 
+				// If an identifier was just imported,
+				// the `as` keyword introduces its alias
+
+				if expectCommaOrFrom && p.current.Value == keywordAs {
+					err := parseAlias(identifiers[len(identifiers)-1])
+					if err != nil {
+						return err
+					}
+
+					break
+				}
+
 				if p.current.Value == keywordFrom {
 					if expectCommaOrFrom {
 						atEnd = true
@@ -584,11 +811,26 @@ func parseImportDeclaration(p *parser) (*ast.ImportDeclaration, error) {
 			// The previous identifier is an imported identifier,
 			// not the import location
 			identifiers = append(identifiers, identifier)
-			err := parseMoreIdentifiers()
+			err := parseMoreIdentifiers(false)
 			if err != nil {
 				return nil, err
 			}
 		case lexer.TokenIdentifier:
+			if p.current.Value == keywordAs {
+				// The previous identifier is an imported identifier
+				// which has an alias, not the import location
+				identifiers = append(identifiers, identifier)
+				err := parseAlias(identifier)
+				if err != nil {
+					return nil, err
+				}
+				err = parseMoreIdentifiers(true)
+				if err != nil {
+					return nil, err
+				}
+				break
+			}
+
 			err := maybeParseFromIdentifier(identifier)
 			if err != nil {
 				return nil, err
@@ -619,6 +861,7 @@ func parseImportDeclaration(p *parser) (*ast.ImportDeclaration, error) {
 	return ast.NewImportDeclaration(
 		p.memoryGauge,
 		identifiers,
+		aliases,
 		location,
 		ast.NewRange(
 			p.memoryGauge,
@@ -709,7 +952,7 @@ func parseEventDeclaration(
 	// Skip the identifier
 	p.next()
 
-	parameterList, err := parseParameterList(p)
+	parameterList, err := parseParameterList(p, true)
 	if err != nil {
 		return nil, err
 	}
@@ -851,7 +1094,7 @@ func parseFieldWithVariableKind(
 //
 //     conformances : ':' nominalType ( ',' nominalType )*
 //
-//     compositeDeclaration : compositeKind identifier conformances?
+//     compositeDeclaration : compositeKind identifier typeParameterList? conformances?
 //                            '{' membersAndNestedDeclarations '}'
 //
 //     interfaceDeclaration : compositeKind 'interface' identifier conformances?
@@ -910,9 +1153,24 @@ func parseCompositeOrInterfaceDeclaration(
 
 	p.skipSpaceAndComments(true)
 
-	var conformances []*ast.NominalType
+	var typeParameters []*ast.TypeParameter
 	var err error
 
+	if p.current.Is(lexer.TokenLess) {
+		if isInterface {
+			return nil, p.syntaxError("unexpected type parameters for interface")
+		}
+
+		typeParameters, err = parseTypeParameterList(p)
+		if err != nil {
+			return nil, err
+		}
+
+		p.skipSpaceAndComments(true)
+	}
+
+	var conformances []*ast.NominalType
+
 	if p.current.Is(lexer.TokenColon) {
 		// Skip the colon
 		p.next()
@@ -972,7 +1230,7 @@ func parseCompositeOrInterfaceDeclaration(
 			declarationRange,
 		), nil
 	} else {
-		return ast.NewCompositeDeclaration(
+		compositeDeclaration := ast.NewCompositeDeclaration(
 			p.memoryGauge,
 			access,
 			compositeKind,
@@ -981,8 +1239,146 @@ func parseCompositeOrInterfaceDeclaration(
 			members,
 			docString,
 			declarationRange,
-		), nil
+		)
+		compositeDeclaration.TypeParameters = typeParameters
+		return compositeDeclaration, nil
+	}
+}
+
+// parseAttachmentDeclaration parses an attachment declaration.
+//
+//     attachmentDeclaration : 'attachment' identifier 'for' nominalType
+//                             '{' membersAndNestedDeclarations '}'
+//
+func parseAttachmentDeclaration(
+	p *parser,
+	access ast.Access,
+	accessPos *ast.Position,
+	docString string,
+) (*ast.CompositeDeclaration, error) {
+
+	startPos := p.current.StartPos
+	if accessPos != nil {
+		startPos = *accessPos
+	}
+
+	// Skip the `attachment` keyword
+	p.next()
+
+	p.skipSpaceAndComments(true)
+	identifier, err := p.mustIdentifier()
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpaceAndComments(true)
+	_, err = p.mustOneString(lexer.TokenIdentifier, keywordFor)
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpaceAndComments(true)
+	baseTypeToken, err := p.mustOne(lexer.TokenIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	baseType, err := parseNominalTypeRemainder(p, baseTypeToken)
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpaceAndComments(true)
+
+	_, err = p.mustOne(lexer.TokenBraceOpen)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := parseMembersAndNestedDeclarations(p, lexer.TokenBraceClose)
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpaceAndComments(true)
+
+	endToken, err := p.mustOne(lexer.TokenBraceClose)
+	if err != nil {
+		return nil, err
+	}
+
+	declarationRange := ast.NewRange(
+		p.memoryGauge,
+		startPos,
+		endToken.EndPos,
+	)
+
+	declaration := ast.NewCompositeDeclaration(
+		p.memoryGauge,
+		access,
+		common.CompositeKindAttachment,
+		identifier,
+		nil,
+		members,
+		docString,
+		declarationRange,
+	)
+	declaration.BaseType = baseType
+
+	return declaration, nil
+}
+
+// parseTypeAliasDeclaration parses a type alias declaration.
+//
+//     typeAliasDeclaration : 'typealias' identifier '=' type
+//
+func parseTypeAliasDeclaration(
+	p *parser,
+	access ast.Access,
+	accessPos *ast.Position,
+	docString string,
+) (*ast.TypeAliasDeclaration, error) {
+
+	startPos := p.current.StartPos
+	if accessPos != nil {
+		startPos = *accessPos
+	}
+
+	// Skip the `typealias` keyword
+	p.next()
+
+	p.skipSpaceAndComments(true)
+	identifier, err := p.mustIdentifier()
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpaceAndComments(true)
+	_, err = p.mustOne(lexer.TokenEqual)
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpaceAndComments(true)
+	ty, err := parseType(p, lowestBindingPower)
+	if err != nil {
+		return nil, err
 	}
+
+	declarationRange := ast.NewRange(
+		p.memoryGauge,
+		startPos,
+		ty.EndPosition(p.memoryGauge),
+	)
+
+	return ast.NewTypeAliasDeclaration(
+		p.memoryGauge,
+		access,
+		identifier,
+		ty,
+		docString,
+		declarationRange,
+	), nil
 }
 
 // parseMembersAndNestedDeclarations parses composite or interface members,
@@ -1032,8 +1428,11 @@ func parseMembersAndNestedDeclarations(p *parser, endTokenType lexer.TokenType)
 //                               | functionDeclaration
 //                               | interfaceDeclaration
 //                               | compositeDeclaration
+//                               | attachmentDeclaration
+//                               | typeAliasDeclaration
 //                               | eventDeclaration
 //                               | enumCase
+//                               | pragmaDeclaration
 //
 func parseMemberOrNestedDeclaration(p *parser, docString string) (ast.Declaration, error) {
 
@@ -1042,12 +1441,21 @@ func parseMemberOrNestedDeclaration(p *parser, docString string) (ast.Declaratio
 	access := ast.AccessNotSpecified
 	var accessPos *ast.Position
 
+	purity := ast.FunctionPurityUnspecified
+	var purityPos *ast.Position
+
 	var previousIdentifierToken *lexer.Token
 
 	for {
 		p.skipSpaceAndComments(true)
 
 		switch p.current.Type {
+		case lexer.TokenPragma:
+			if access != ast.AccessNotSpecified {
+				return nil, p.syntaxError("invalid access modifier for pragma")
+			}
+			return parsePragmaDeclaration(p)
+
 		case lexer.TokenIdentifier:
 			switch p.current.Value {
 			case keywordLet, keywordVar:
@@ -1057,7 +1465,15 @@ func parseMemberOrNestedDeclaration(p *parser, docString string) (ast.Declaratio
 				return parseEnumCase(p, access, accessPos, docString)
 
 			case keywordFun:
-				return parseFunctionDeclaration(p, functionBlockIsOptional, access, accessPos, docString)
+				return parseFunctionDeclaration(
+					p,
+					functionBlockIsOptional,
+					access,
+					accessPos,
+					purity,
+					purityPos,
+					docString,
+				)
 
 			case keywordEvent:
 				return parseEventDeclaration(p, access, accessPos, docString)
@@ -1065,6 +1481,12 @@ func parseMemberOrNestedDeclaration(p *parser, docString string) (ast.Declaratio
 			case keywordStruct, keywordResource, keywordContract, keywordEnum:
 				return parseCompositeOrInterfaceDeclaration(p, access, accessPos, docString)
 
+			case keywordAttachment:
+				return parseAttachmentDeclaration(p, access, accessPos, docString)
+
+			case keywordTypeAlias:
+				return parseTypeAliasDeclaration(p, access, accessPos, docString)
+
 			case keywordPriv, keywordPub, keywordAccess:
 				if access != ast.AccessNotSpecified {
 					return nil, p.syntaxError("unexpected access modifier")
@@ -1084,6 +1506,17 @@ func parseMemberOrNestedDeclaration(p *parser, docString string) (ast.Declaratio
 					return nil, p.syntaxError("unexpected %s", p.current.Type)
 				}
 
+				if purity == ast.FunctionPurityUnspecified {
+					var err error
+					purity, purityPos, err = parsePurityAnnotation(p, keywordFun, keywordInit)
+					if err != nil {
+						return nil, err
+					}
+					if purity != ast.FunctionPurityUnspecified {
+						continue
+					}
+				}
+
 				t := p.current
 				previousIdentifierToken = &t
 				// Skip the identifier
@@ -1105,7 +1538,15 @@ func parseMemberOrNestedDeclaration(p *parser, docString string) (ast.Declaratio
 			}
 
 			identifier := p.tokenToIdentifier(*previousIdentifierToken)
-			return parseSpecialFunctionDeclaration(p, functionBlockIsOptional, access, accessPos, identifier)
+			return parseSpecialFunctionDeclaration(
+				p,
+				functionBlockIsOptional,
+				access,
+				accessPos,
+				purity,
+				purityPos,
+				identifier,
+			)
 		}
 
 		return nil, nil
@@ -1157,18 +1598,22 @@ func parseSpecialFunctionDeclaration(
 	functionBlockIsOptional bool,
 	access ast.Access,
 	accessPos *ast.Position,
+	purity ast.FunctionPurity,
+	purityPos *ast.Position,
 	identifier ast.Identifier,
 ) (*ast.SpecialFunctionDeclaration, error) {
 
 	startPos := identifier.Pos
 	if accessPos != nil {
 		startPos = *accessPos
+	} else if purityPos != nil {
+		startPos = *purityPos
 	}
 
 	// TODO: switch to parseFunctionParameterListAndRest once old parser is deprecated:
 	//   allow a return type annotation while parsing, but reject later.
 
-	parameterList, err := parseParameterList(p)
+	parameterList, err := parseParameterList(p, false)
 	if err != nil {
 		return nil, err
 	}
@@ -1198,19 +1643,22 @@ func parseSpecialFunctionDeclaration(
 		declarationKind = common.DeclarationKindPrepare
 	}
 
+	functionDeclaration := ast.NewFunctionDeclaration(
+		p.memoryGauge,
+		access,
+		identifier,
+		parameterList,
+		nil,
+		functionBlock,
+		startPos,
+		"",
+	)
+	functionDeclaration.Purity = purity
+
 	return ast.NewSpecialFunctionDeclaration(
 		p.memoryGauge,
 		declarationKind,
-		ast.NewFunctionDeclaration(
-			p.memoryGauge,
-			access,
-			identifier,
-			parameterList,
-			nil,
-			functionBlock,
-			startPos,
-			"",
-		),
+		functionDeclaration,
 	), nil
 }
 
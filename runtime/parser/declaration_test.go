@@ -269,7 +269,7 @@ func TestParseParameterList(t *testing.T) {
 		return Parse(
 			input,
 			func(p *parser) (any, error) {
-				return parseParameterList(p)
+				return parseParameterList(p, false)
 			},
 			nil,
 		)
@@ -455,6 +455,77 @@ func TestParseParameterList(t *testing.T) {
 		)
 	})
 
+	t.Run("two, second with default argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := parse("( a : Int , b : Int = 1 )")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.ParameterList{
+				Parameters: []*ast.Parameter{
+					{
+						Label: "",
+						Identifier: ast.Identifier{
+							Identifier: "a",
+							Pos:        ast.Position{Line: 1, Column: 2, Offset: 2},
+						},
+						TypeAnnotation: &ast.TypeAnnotation{
+							IsResource: false,
+							Type: &ast.NominalType{
+								Identifier: ast.Identifier{
+									Identifier: "Int",
+									Pos:        ast.Position{Line: 1, Column: 6, Offset: 6},
+								},
+							},
+							StartPos: ast.Position{Line: 1, Column: 6, Offset: 6},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 2, Offset: 2},
+							EndPos:   ast.Position{Line: 1, Column: 8, Offset: 8},
+						},
+					},
+					{
+						Label: "",
+						Identifier: ast.Identifier{
+							Identifier: "b",
+							Pos:        ast.Position{Line: 1, Column: 12, Offset: 12},
+						},
+						TypeAnnotation: &ast.TypeAnnotation{
+							IsResource: false,
+							Type: &ast.NominalType{
+								Identifier: ast.Identifier{
+									Identifier: "Int",
+									Pos:        ast.Position{Line: 1, Column: 16, Offset: 16},
+								},
+							},
+							StartPos: ast.Position{Line: 1, Column: 16, Offset: 16},
+						},
+						DefaultArgument: &ast.IntegerExpression{
+							PositiveLiteral: "1",
+							Value:           big.NewInt(1),
+							Base:            10,
+							Range: ast.Range{
+								StartPos: ast.Position{Line: 1, Column: 22, Offset: 22},
+								EndPos:   ast.Position{Line: 1, Column: 22, Offset: 22},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 12, Offset: 12},
+							EndPos:   ast.Position{Line: 1, Column: 22, Offset: 22},
+						},
+					},
+				},
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 24, Offset: 24},
+				},
+			},
+			result,
+		)
+	})
+
 	t.Run("two, with and without argument label, missing comma", func(t *testing.T) {
 
 		t.Parallel()
@@ -1521,6 +1592,128 @@ func TestParseImportDeclaration(t *testing.T) {
 			result,
 		)
 	})
+
+	t.Run("one identifier with alias, address location", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations(` import foo as bar from 0x42`, nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.ImportDeclaration{
+					Identifiers: []ast.Identifier{
+						{
+							Identifier: "foo",
+							Pos:        ast.Position{Line: 1, Column: 8, Offset: 8},
+						},
+					},
+					Aliases: map[string]string{
+						"foo": "bar",
+					},
+					Location: common.AddressLocation{
+						Address: common.MustBytesToAddress([]byte{0x42}),
+					},
+					LocationPos: ast.Position{Line: 1, Column: 24, Offset: 24},
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 1, Offset: 1},
+						EndPos:   ast.Position{Line: 1, Column: 27, Offset: 27},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("multiple identifiers, some with aliases, address location", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations(` import foo , bar as baz , as from 0x42`, nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.ImportDeclaration{
+					Identifiers: []ast.Identifier{
+						{
+							Identifier: "foo",
+							Pos:        ast.Position{Line: 1, Column: 8, Offset: 8},
+						},
+						{
+							Identifier: "bar",
+							Pos:        ast.Position{Line: 1, Column: 14, Offset: 14},
+						},
+						{
+							Identifier: "as",
+							Pos:        ast.Position{Line: 1, Column: 27, Offset: 27},
+						},
+					},
+					Aliases: map[string]string{
+						"bar": "baz",
+					},
+					Location: common.AddressLocation{
+						Address: common.MustBytesToAddress([]byte{0x42}),
+					},
+					LocationPos: ast.Position{Line: 1, Column: 35, Offset: 35},
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 1, Offset: 1},
+						EndPos:   ast.Position{Line: 1, Column: 38, Offset: 38},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("alias without location", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseDeclarations(` import foo as bar`, nil)
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: `unexpected end in import declaration: expected identifier or ','`,
+					Pos:     ast.Position{Offset: 18, Line: 1, Column: 18},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("missing alias", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseDeclarations(` import foo as , bar from 0x42`, nil)
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: `expected identifier for alias of import "foo", got ','`,
+					Pos:     ast.Position{Offset: 15, Line: 1, Column: 15},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("duplicate alias", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseDeclarations(` import foo as bar as baz from 0x42`, nil)
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: `duplicate alias for import "foo"`,
+					Pos:     ast.Position{Offset: 22, Line: 1, Column: 22},
+				},
+			},
+			errs,
+		)
+	})
 }
 
 func TestParseEvent(t *testing.T) {
@@ -1995,6 +2188,72 @@ func TestParseCompositeDeclaration(t *testing.T) {
 			result,
 		)
 	})
+
+	t.Run("struct, type parameters", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations(" pub struct S<T: AnyStruct, U> { }", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.CompositeDeclaration{
+					Access:        ast.AccessPublic,
+					CompositeKind: common.CompositeKindStructure,
+					Identifier: ast.Identifier{
+						Identifier: "S",
+						Pos:        ast.Position{Line: 1, Column: 12, Offset: 12},
+					},
+					TypeParameters: []*ast.TypeParameter{
+						{
+							Identifier: ast.Identifier{
+								Identifier: "T",
+								Pos:        ast.Position{Line: 1, Column: 14, Offset: 14},
+							},
+							TypeBounds: []ast.Type{
+								&ast.NominalType{
+									Identifier: ast.Identifier{
+										Identifier: "AnyStruct",
+										Pos:        ast.Position{Line: 1, Column: 17, Offset: 17},
+									},
+								},
+							},
+						},
+						{
+							Identifier: ast.Identifier{
+								Identifier: "U",
+								Pos:        ast.Position{Line: 1, Column: 28, Offset: 28},
+							},
+						},
+					},
+					Members: &ast.Members{},
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 1, Offset: 1},
+						EndPos:   ast.Position{Line: 1, Column: 33, Offset: 33},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("struct interface, type parameters", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseDeclarations(" pub struct interface S<T> { }", nil)
+
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "unexpected type parameters for interface",
+					Pos:     ast.Position{Offset: 23, Line: 1, Column: 23},
+				},
+			},
+			errs,
+		)
+	})
 }
 
 func TestParseInterfaceDeclaration(t *testing.T) {
@@ -2329,7 +2588,7 @@ func TestParseTransactionDeclaration(t *testing.T) {
 		const code = `
 		  transaction {}
 		`
-		result, errs := ParseProgram(code, nil)
+		result, errs := parseProgramWithoutNodeIDs(code)
 		require.Empty(t, errs)
 
 		utils.AssertEqualWithDiff(t,
@@ -2365,7 +2624,7 @@ func TestParseTransactionDeclaration(t *testing.T) {
 			}
 		  }
 		`
-		result, errs := ParseProgram(code, nil)
+		result, errs := parseProgramWithoutNodeIDs(code)
 		require.Empty(t, errs)
 
 		utils.AssertEqualWithDiff(t,
@@ -2561,7 +2820,7 @@ func TestParseTransactionDeclaration(t *testing.T) {
 	        }
 		  }
 		`
-		result, errs := ParseProgram(code, nil)
+		result, errs := parseProgramWithoutNodeIDs(code)
 		require.Empty(t, errs)
 
 		utils.AssertEqualWithDiff(t,
@@ -2801,7 +3060,7 @@ func TestParseTransactionDeclaration(t *testing.T) {
 			}
 		  }
 		`
-		result, errs := ParseProgram(code, nil)
+		result, errs := parseProgramWithoutNodeIDs(code)
 		require.Empty(t, errs)
 
 		utils.AssertEqualWithDiff(t,
@@ -3019,39 +3278,169 @@ func TestParseTransactionDeclaration(t *testing.T) {
 	})
 }
 
-func TestParseFunctionAndBlock(t *testing.T) {
+func TestParseFunctionDeclarationWithTypeParameters(t *testing.T) {
 
 	t.Parallel()
 
-	result, errs := ParseDeclarations(`
-	    fun test() { return }
-	`, nil)
-	require.Empty(t, errs)
+	t.Run("valid", func(t *testing.T) {
 
-	utils.AssertEqualWithDiff(t,
-		[]ast.Declaration{
-			&ast.FunctionDeclaration{
-				Access: ast.AccessNotSpecified,
-				Identifier: ast.Identifier{
-					Identifier: "test",
-					Pos:        ast.Position{Offset: 10, Line: 2, Column: 9},
-				},
-				ParameterList: &ast.ParameterList{
-					Range: ast.Range{
-						StartPos: ast.Position{Offset: 14, Line: 2, Column: 13},
-						EndPos:   ast.Position{Offset: 15, Line: 2, Column: 14},
+		t.Parallel()
+
+		result, errs := ParseDeclarations("fun foo<T: A & B, U>() {}", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.FunctionDeclaration{
+					Identifier: ast.Identifier{
+						Identifier: "foo",
+						Pos:        ast.Position{Line: 1, Column: 4, Offset: 4},
 					},
-				},
-				ReturnTypeAnnotation: &ast.TypeAnnotation{
-					IsResource: false,
-					Type: &ast.NominalType{
-						Identifier: ast.Identifier{
-							Pos: ast.Position{Offset: 15, Line: 2, Column: 14},
+					TypeParameters: []*ast.TypeParameter{
+						{
+							Identifier: ast.Identifier{
+								Identifier: "T",
+								Pos:        ast.Position{Line: 1, Column: 8, Offset: 8},
+							},
+							TypeBounds: []ast.Type{
+								&ast.NominalType{
+									Identifier: ast.Identifier{
+										Identifier: "A",
+										Pos:        ast.Position{Line: 1, Column: 11, Offset: 11},
+									},
+								},
+								&ast.NominalType{
+									Identifier: ast.Identifier{
+										Identifier: "B",
+										Pos:        ast.Position{Line: 1, Column: 15, Offset: 15},
+									},
+								},
+							},
+						},
+						{
+							Identifier: ast.Identifier{
+								Identifier: "U",
+								Pos:        ast.Position{Line: 1, Column: 18, Offset: 18},
+							},
 						},
 					},
-					StartPos: ast.Position{Offset: 15, Line: 2, Column: 14},
-				},
-				FunctionBlock: &ast.FunctionBlock{
+					ParameterList: &ast.ParameterList{
+						Parameters: nil,
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 20, Offset: 20},
+							EndPos:   ast.Position{Line: 1, Column: 21, Offset: 21},
+						},
+					},
+					ReturnTypeAnnotation: &ast.TypeAnnotation{
+						IsResource: false,
+						Type: &ast.NominalType{
+							Identifier: ast.Identifier{
+								Identifier: "",
+								Pos:        ast.Position{Line: 1, Column: 21, Offset: 21},
+							},
+						},
+						StartPos: ast.Position{Line: 1, Column: 21, Offset: 21},
+					},
+					FunctionBlock: &ast.FunctionBlock{
+						Block: &ast.Block{
+							Range: ast.Range{
+								StartPos: ast.Position{Line: 1, Column: 23, Offset: 23},
+								EndPos:   ast.Position{Line: 1, Column: 24, Offset: 24},
+							},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("empty type parameter list", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseDeclarations("fun foo<>() {}", nil)
+
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected at least one type parameter",
+					Pos:     ast.Position{Offset: 8, Line: 1, Column: 8},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("missing comma", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseDeclarations("fun foo<T U>() {}", nil)
+
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected comma or end of type parameter list, got identifier",
+					Pos:     ast.Position{Offset: 10, Line: 1, Column: 10},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("missing type bound", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseDeclarations("fun foo<T: A &>() {}", nil)
+
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "unexpected token in type: '>'",
+					Pos:     ast.Position{Offset: 15, Line: 1, Column: 15},
+				},
+			},
+			errs,
+		)
+	})
+}
+
+func TestParseFunctionAndBlock(t *testing.T) {
+
+	t.Parallel()
+
+	result, errs := ParseDeclarations(`
+	    fun test() { return }
+	`, nil)
+	require.Empty(t, errs)
+
+	utils.AssertEqualWithDiff(t,
+		[]ast.Declaration{
+			&ast.FunctionDeclaration{
+				Access: ast.AccessNotSpecified,
+				Identifier: ast.Identifier{
+					Identifier: "test",
+					Pos:        ast.Position{Offset: 10, Line: 2, Column: 9},
+				},
+				ParameterList: &ast.ParameterList{
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 14, Line: 2, Column: 13},
+						EndPos:   ast.Position{Offset: 15, Line: 2, Column: 14},
+					},
+				},
+				ReturnTypeAnnotation: &ast.TypeAnnotation{
+					IsResource: false,
+					Type: &ast.NominalType{
+						Identifier: ast.Identifier{
+							Pos: ast.Position{Offset: 15, Line: 2, Column: 14},
+						},
+					},
+					StartPos: ast.Position{Offset: 15, Line: 2, Column: 14},
+				},
+				FunctionBlock: &ast.FunctionBlock{
 					Block: &ast.Block{
 						Statements: []ast.Statement{
 							&ast.ReturnStatement{
@@ -3074,6 +3463,157 @@ func TestParseFunctionAndBlock(t *testing.T) {
 	)
 }
 
+func TestParseViewFunctionDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("view", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations("view fun foo() {}", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.FunctionDeclaration{
+					Purity: ast.FunctionPurityView,
+					Identifier: ast.Identifier{
+						Identifier: "foo",
+						Pos:        ast.Position{Line: 1, Column: 9, Offset: 9},
+					},
+					ParameterList: &ast.ParameterList{
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 12, Offset: 12},
+							EndPos:   ast.Position{Line: 1, Column: 13, Offset: 13},
+						},
+					},
+					ReturnTypeAnnotation: &ast.TypeAnnotation{
+						IsResource: false,
+						Type: &ast.NominalType{
+							Identifier: ast.Identifier{
+								Pos: ast.Position{Line: 1, Column: 13, Offset: 13},
+							},
+						},
+						StartPos: ast.Position{Line: 1, Column: 13, Offset: 13},
+					},
+					FunctionBlock: &ast.FunctionBlock{
+						Block: &ast.Block{
+							Range: ast.Range{
+								StartPos: ast.Position{Line: 1, Column: 15, Offset: 15},
+								EndPos:   ast.Position{Line: 1, Column: 16, Offset: 16},
+							},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("pub view", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations("pub view fun foo() {}", nil)
+		require.Empty(t, errs)
+
+		require.Len(t, result, 1)
+		require.IsType(t, &ast.FunctionDeclaration{}, result[0])
+
+		declaration := result[0].(*ast.FunctionDeclaration)
+		require.Equal(t, ast.AccessPublic, declaration.Access)
+		require.Equal(t, ast.FunctionPurityView, declaration.Purity)
+		require.Equal(t,
+			ast.Position{Line: 1, Column: 0, Offset: 0},
+			declaration.StartPos,
+		)
+	})
+
+	t.Run("view pub", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseDeclarations("view pub fun foo() {}", nil)
+		require.NotEmpty(t, errs)
+	})
+
+	t.Run("members", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations(
+			`
+              struct S {
+                  let view: Int
+
+                  view init() {
+                      self.view = 1
+                  }
+
+                  pub view fun foo(): Int {
+                      return self.view
+                  }
+
+                  fun bar() {}
+              }
+            `,
+			nil,
+		)
+		require.Empty(t, errs)
+
+		require.Len(t, result, 1)
+		require.IsType(t, &ast.CompositeDeclaration{}, result[0])
+
+		members := result[0].(*ast.CompositeDeclaration).Members
+
+		fields := members.Fields()
+		require.Len(t, fields, 1)
+		require.Equal(t, "view", fields[0].Identifier.Identifier)
+
+		initializers := members.Initializers()
+		require.Len(t, initializers, 1)
+		require.Equal(t,
+			ast.FunctionPurityView,
+			initializers[0].FunctionDeclaration.Purity,
+		)
+		require.Equal(t,
+			ast.Position{Line: 5, Column: 18, Offset: 77},
+			initializers[0].StartPosition(),
+		)
+
+		functions := members.Functions()
+		require.Len(t, functions, 2)
+		require.Equal(t, ast.FunctionPurityView, functions[0].Purity)
+		require.Equal(t, ast.FunctionPurityUnspecified, functions[1].Purity)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements(
+			`
+              view fun foo() {}
+              let view = 1
+              view
+            `,
+			nil,
+		)
+		require.Empty(t, errs)
+
+		require.Len(t, result, 3)
+		require.IsType(t, &ast.FunctionDeclaration{}, result[0])
+		require.Equal(t,
+			ast.FunctionPurityView,
+			result[0].(*ast.FunctionDeclaration).Purity,
+		)
+		require.IsType(t, &ast.VariableDeclaration{}, result[1])
+		require.IsType(t, &ast.ExpressionStatement{}, result[2])
+	})
+}
+
 func TestParseFunctionParameterWithoutLabel(t *testing.T) {
 
 	t.Parallel()
@@ -3231,7 +3771,7 @@ func TestParseStructure(t *testing.T) {
             }
         }
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -3412,7 +3952,7 @@ func TestParseStructureWithConformances(t *testing.T) {
 	const code = `
         struct Test: Foo, Bar {}
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -3464,7 +4004,7 @@ func TestParsePreAndPostConditions(t *testing.T) {
             return 0
         }
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -3625,7 +4165,7 @@ func TestParseConditionMessage(t *testing.T) {
             return n
         }
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -3748,7 +4288,7 @@ func TestParseInterface(t *testing.T) {
                 fun getFoo(): Int
             }
 	    `, kind.Keyword())
-		actual, err := ParseProgram(code, nil)
+		actual, err := parseProgramWithoutNodeIDs(code)
 
 		require.NoError(t, err)
 
@@ -3873,7 +4413,7 @@ func TestParsePragmaNoArguments(t *testing.T) {
 	t.Parallel()
 
 	const code = `#pedantic`
-	result, err := ParseProgram(code, nil)
+	result, err := parseProgramWithoutNodeIDs(code)
 	require.NoError(t, err)
 
 	utils.AssertEqualWithDiff(t,
@@ -3900,7 +4440,7 @@ func TestParsePragmaArguments(t *testing.T) {
 	t.Parallel()
 
 	const code = `#version("1.0")`
-	actual, err := ParseProgram(code, nil)
+	actual, err := parseProgramWithoutNodeIDs(code)
 	require.NoError(t, err)
 
 	utils.AssertEqualWithDiff(t,
@@ -3938,6 +4478,55 @@ func TestParsePragmaArguments(t *testing.T) {
 	)
 }
 
+func TestParsePragmaInMembers(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+          struct S {
+              #deprecated("use bar")
+              fun foo() {}
+          }
+		`
+		result, err := parseProgramWithoutNodeIDs(code)
+		require.NoError(t, err)
+
+		declarations := result.Declarations()
+		require.Len(t, declarations, 1)
+		require.IsType(t, &ast.CompositeDeclaration{}, declarations[0])
+
+		members := declarations[0].(*ast.CompositeDeclaration).Members.Declarations()
+		require.Len(t, members, 2)
+
+		require.IsType(t, &ast.PragmaDeclaration{}, members[0])
+		require.Equal(t,
+			`deprecated("use bar")`,
+			members[0].(*ast.PragmaDeclaration).Expression.String(),
+		)
+
+		require.IsType(t, &ast.FunctionDeclaration{}, members[1])
+	})
+
+	t.Run("access modifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+          struct S {
+              pub #deprecated
+              fun foo() {}
+          }
+		`
+		_, err := ParseProgram(code, nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid access modifier for pragma")
+	})
+}
+
 func TestParseImportWithString(t *testing.T) {
 
 	t.Parallel()
@@ -3945,7 +4534,7 @@ func TestParseImportWithString(t *testing.T) {
 	const code = `
         import "test.cdc"
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -3971,7 +4560,7 @@ func TestParseImportWithAddress(t *testing.T) {
 	const code = `
         import 0x1234
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -3999,7 +4588,7 @@ func TestParseImportWithIdentifiers(t *testing.T) {
 	const code = `
         import A, b from 0x1
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -4038,7 +4627,7 @@ func TestParseFieldWithFromIdentifier(t *testing.T) {
           let from: String
       }
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -4103,7 +4692,7 @@ func TestParseImportWithFromIdentifier(t *testing.T) {
 	const code = `
         import from from 0x1
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -4148,7 +4737,7 @@ func TestParseResource(t *testing.T) {
 	const code = `
         resource Test {}
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -4177,7 +4766,7 @@ func TestParseEventDeclaration(t *testing.T) {
 	const code = `
         event Transfer(to: Address, from: Address)
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -4258,6 +4847,135 @@ func TestParseEventDeclaration(t *testing.T) {
 	)
 }
 
+func TestParseEventDeclarationIndexed(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("indexed parameters", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+        event Transfer(indexed from: Address, indexed: Int)
+	`
+		result, errs := parseProgramWithoutNodeIDs(code)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.CompositeDeclaration{
+					CompositeKind: common.CompositeKindEvent,
+					Identifier: ast.Identifier{
+						Identifier: "Transfer",
+						Pos:        ast.Position{Offset: 15, Line: 2, Column: 14},
+					},
+					Members: ast.NewUnmeteredMembers(
+						[]ast.Declaration{
+							&ast.SpecialFunctionDeclaration{
+								Kind: common.DeclarationKindInitializer,
+								FunctionDeclaration: &ast.FunctionDeclaration{
+									ParameterList: &ast.ParameterList{
+										Parameters: []*ast.Parameter{
+											{
+												Indexed: true,
+												Identifier: ast.Identifier{
+													Identifier: "from",
+													Pos:        ast.Position{Offset: 32, Line: 2, Column: 31},
+												},
+												TypeAnnotation: &ast.TypeAnnotation{
+													Type: &ast.NominalType{
+														Identifier: ast.Identifier{
+															Identifier: "Address",
+															Pos:        ast.Position{Offset: 38, Line: 2, Column: 37},
+														},
+													},
+													StartPos: ast.Position{Offset: 38, Line: 2, Column: 37},
+												},
+												Range: ast.Range{
+													StartPos: ast.Position{Offset: 24, Line: 2, Column: 23},
+													EndPos:   ast.Position{Offset: 44, Line: 2, Column: 43},
+												},
+											},
+											{
+												Identifier: ast.Identifier{
+													Identifier: "indexed",
+													Pos:        ast.Position{Offset: 47, Line: 2, Column: 46},
+												},
+												TypeAnnotation: &ast.TypeAnnotation{
+													Type: &ast.NominalType{
+														Identifier: ast.Identifier{
+															Identifier: "Int",
+															Pos:        ast.Position{Offset: 56, Line: 2, Column: 55},
+														},
+													},
+													StartPos: ast.Position{Offset: 56, Line: 2, Column: 55},
+												},
+												Range: ast.Range{
+													StartPos: ast.Position{Offset: 47, Line: 2, Column: 46},
+													EndPos:   ast.Position{Offset: 58, Line: 2, Column: 57},
+												},
+											},
+										},
+										Range: ast.Range{
+											StartPos: ast.Position{Offset: 23, Line: 2, Column: 22},
+											EndPos:   ast.Position{Offset: 59, Line: 2, Column: 58},
+										},
+									},
+									StartPos: ast.Position{Offset: 23, Line: 2, Column: 22},
+								},
+							},
+						},
+					),
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 9, Line: 2, Column: 8},
+						EndPos:   ast.Position{Offset: 59, Line: 2, Column: 58},
+					},
+				},
+			},
+			result.Declarations(),
+		)
+	})
+
+	t.Run("indexed parameter with argument label", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := parseProgramWithoutNodeIDs("event E(indexed a b: Int)")
+		require.Empty(t, errs)
+
+		declarations := result.Declarations()
+		require.Len(t, declarations, 1)
+
+		compositeDeclaration := declarations[0].(*ast.CompositeDeclaration)
+		parameters := compositeDeclaration.Members.Initializers()[0].
+			FunctionDeclaration.ParameterList.Parameters
+		require.Len(t, parameters, 1)
+
+		parameter := parameters[0]
+		require.True(t, parameter.Indexed)
+		require.Equal(t, "a", parameter.Label)
+		require.Equal(t, "b", parameter.Identifier.Identifier)
+	})
+
+	t.Run("not an event", func(t *testing.T) {
+
+		t.Parallel()
+
+		// Outside of event declarations, `indexed` is an ordinary argument label
+
+		result, errs := parseProgramWithoutNodeIDs("fun f(indexed a: Int) {}")
+		require.Empty(t, errs)
+
+		declarations := result.Declarations()
+		require.Len(t, declarations, 1)
+
+		parameter := declarations[0].(*ast.FunctionDeclaration).ParameterList.Parameters[0]
+		require.False(t, parameter.Indexed)
+		require.Equal(t, "indexed", parameter.Label)
+		require.Equal(t, "a", parameter.Identifier.Identifier)
+	})
+}
+
 func TestParseEventEmitStatement(t *testing.T) {
 
 	t.Parallel()
@@ -4267,7 +4985,7 @@ func TestParseEventEmitStatement(t *testing.T) {
         emit Transfer(to: 1, from: 2)
       }
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -4363,7 +5081,7 @@ func TestParseResourceReturnType(t *testing.T) {
 	const code = `
         fun test(): @X {}
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -4411,7 +5129,7 @@ func TestParseMovingVariableDeclaration(t *testing.T) {
 	const code = `
         let x <- y
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -4446,7 +5164,7 @@ func TestParseResourceParameterType(t *testing.T) {
 	const code = `
         fun test(x: @X) {}
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -4517,7 +5235,7 @@ func TestParseMovingVariableDeclarationWithTypeAnnotation(t *testing.T) {
 	const code = `
         let x: @R <- y
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -4562,7 +5280,7 @@ func TestParseFieldDeclarationWithMoveTypeAnnotation(t *testing.T) {
 	const code = `
         struct X { x: @R }
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -4618,7 +5336,7 @@ func TestParseDestructor(t *testing.T) {
             destroy() {}
         }
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -4674,7 +5392,7 @@ func TestParseCompositeDeclarationWithSemicolonSeparatedMembers(t *testing.T) {
 	const code = `
         struct Kitty { let id: Int ; init(id: Int) { self.id = id } }
     `
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -4881,7 +5599,7 @@ func TestParsePreconditionWithUnaryNegation(t *testing.T) {
           }
       }
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -5013,3 +5731,258 @@ func TestParseInvalidAccessModifiers(t *testing.T) {
 		)
 	})
 }
+
+func TestParseAttachmentDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("empty", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations("attachment A for R {}", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.CompositeDeclaration{
+					CompositeKind: common.CompositeKindAttachment,
+					Identifier: ast.Identifier{
+						Identifier: "A",
+						Pos:        ast.Position{Offset: 11, Line: 1, Column: 11},
+					},
+					BaseType: &ast.NominalType{
+						Identifier: ast.Identifier{
+							Identifier: "R",
+							Pos:        ast.Position{Offset: 17, Line: 1, Column: 17},
+						},
+					},
+					Members: ast.NewUnmeteredMembers(nil),
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 0, Line: 1, Column: 0},
+						EndPos:   ast.Position{Offset: 20, Line: 1, Column: 20},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("access, nested base type, members", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations("pub attachment A for C.R { let x: Int }", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.CompositeDeclaration{
+					Access:        ast.AccessPublic,
+					CompositeKind: common.CompositeKindAttachment,
+					Identifier: ast.Identifier{
+						Identifier: "A",
+						Pos:        ast.Position{Offset: 15, Line: 1, Column: 15},
+					},
+					BaseType: &ast.NominalType{
+						Identifier: ast.Identifier{
+							Identifier: "C",
+							Pos:        ast.Position{Offset: 21, Line: 1, Column: 21},
+						},
+						NestedIdentifiers: []ast.Identifier{
+							{
+								Identifier: "R",
+								Pos:        ast.Position{Offset: 23, Line: 1, Column: 23},
+							},
+						},
+					},
+					Members: ast.NewUnmeteredMembers(
+						[]ast.Declaration{
+							&ast.FieldDeclaration{
+								Access:       ast.AccessNotSpecified,
+								VariableKind: ast.VariableKindConstant,
+								Identifier: ast.Identifier{
+									Identifier: "x",
+									Pos:        ast.Position{Offset: 31, Line: 1, Column: 31},
+								},
+								TypeAnnotation: &ast.TypeAnnotation{
+									IsResource: false,
+									Type: &ast.NominalType{
+										Identifier: ast.Identifier{
+											Identifier: "Int",
+											Pos:        ast.Position{Offset: 34, Line: 1, Column: 34},
+										},
+									},
+									StartPos: ast.Position{Offset: 34, Line: 1, Column: 34},
+								},
+								Range: ast.Range{
+									StartPos: ast.Position{Offset: 27, Line: 1, Column: 27},
+									EndPos:   ast.Position{Offset: 36, Line: 1, Column: 36},
+								},
+							},
+						},
+					),
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 0, Line: 1, Column: 0},
+						EndPos:   ast.Position{Offset: 38, Line: 1, Column: 38},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("missing base type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseDeclarations("attachment A {}", nil)
+		require.NotEmpty(t, errs)
+	})
+}
+
+func TestParseTypeAliasDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("top-level", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations("pub typealias A = [Int]", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.TypeAliasDeclaration{
+					Access: ast.AccessPublic,
+					Identifier: ast.Identifier{
+						Identifier: "A",
+						Pos:        ast.Position{Offset: 14, Line: 1, Column: 14},
+					},
+					Type: &ast.VariableSizedType{
+						Type: &ast.NominalType{
+							Identifier: ast.Identifier{
+								Identifier: "Int",
+								Pos:        ast.Position{Offset: 19, Line: 1, Column: 19},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Offset: 18, Line: 1, Column: 18},
+							EndPos:   ast.Position{Offset: 22, Line: 1, Column: 22},
+						},
+					},
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 0, Line: 1, Column: 0},
+						EndPos:   ast.Position{Offset: 22, Line: 1, Column: 22},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+          contract C {
+              /// Test
+              pub typealias R = &R{I}
+          }
+        `
+		result, errs := ParseDeclarations(code, nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.CompositeDeclaration{
+					CompositeKind: common.CompositeKindContract,
+					Identifier: ast.Identifier{
+						Identifier: "C",
+						Pos:        ast.Position{Offset: 20, Line: 2, Column: 19},
+					},
+					Members: ast.NewUnmeteredMembers(
+						[]ast.Declaration{
+							&ast.TypeAliasDeclaration{
+								Access: ast.AccessPublic,
+								Identifier: ast.Identifier{
+									Identifier: "R",
+									Pos:        ast.Position{Offset: 75, Line: 4, Column: 28},
+								},
+								Type: &ast.ReferenceType{
+									Type: &ast.RestrictedType{
+										Type: &ast.NominalType{
+											Identifier: ast.Identifier{
+												Identifier: "R",
+												Pos:        ast.Position{Offset: 80, Line: 4, Column: 33},
+											},
+										},
+										Restrictions: []*ast.NominalType{
+											{
+												Identifier: ast.Identifier{
+													Identifier: "I",
+													Pos:        ast.Position{Offset: 82, Line: 4, Column: 35},
+												},
+											},
+										},
+										Range: ast.Range{
+											StartPos: ast.Position{Offset: 80, Line: 4, Column: 33},
+											EndPos:   ast.Position{Offset: 83, Line: 4, Column: 36},
+										},
+									},
+									StartPos: ast.Position{Offset: 79, Line: 4, Column: 32},
+								},
+								DocString: " Test",
+								Range: ast.Range{
+									StartPos: ast.Position{Offset: 61, Line: 4, Column: 14},
+									EndPos:   ast.Position{Offset: 83, Line: 4, Column: 36},
+								},
+							},
+						},
+					),
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 11, Line: 2, Column: 10},
+						EndPos:   ast.Position{Offset: 95, Line: 5, Column: 10},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("missing equal sign", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseDeclarations("typealias A Int", nil)
+
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected token '='",
+					Pos:     ast.Position{Offset: 12, Line: 1, Column: 12},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("missing type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseDeclarations("typealias A =", nil)
+
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "unexpected token in type: EOF",
+					Pos:     ast.Position{Offset: 13, Line: 1, Column: 13},
+				},
+			},
+			errs,
+		)
+	})
+}
@@ -36,6 +36,7 @@ const (
 	exprLeftBindingPowerLogicalOr
 	exprLeftBindingPowerLogicalAnd
 	exprLeftBindingPowerComparison
+	exprLeftBindingPowerRange
 	exprLeftBindingPowerNilCoalescing
 	exprLeftBindingPowerBitwiseOr
 	exprLeftBindingPowerBitwiseXor
@@ -287,6 +288,18 @@ func init() {
 		operation:        ast.OperationNotEqual,
 	})
 
+	defineExpr(binaryExpr{
+		tokenType:        lexer.TokenDotDotLess,
+		leftBindingPower: exprLeftBindingPowerRange,
+		operation:        ast.OperationRange,
+	})
+
+	defineExpr(binaryExpr{
+		tokenType:        lexer.TokenDotDotDot,
+		leftBindingPower: exprLeftBindingPowerRange,
+		operation:        ast.OperationInclusiveRange,
+	})
+
 	defineExpr(binaryExpr{
 		tokenType:        lexer.TokenDoubleQuestionMark,
 		leftBindingPower: exprLeftBindingPowerNilCoalescing,
@@ -516,6 +529,12 @@ func init() {
 		operation:    ast.OperationMove,
 	})
 
+	defineExpr(unaryExpr{
+		tokenType:    lexer.TokenStar,
+		bindingPower: exprLeftBindingPowerUnaryPrefix,
+		operation:    ast.OperationMul,
+	})
+
 	defineExpr(postfixExpr{
 		tokenType:    lexer.TokenExclamationMark,
 		bindingPower: exprLeftBindingPowerUnaryPostfix,
@@ -532,6 +551,7 @@ func init() {
 	defineInvocationExpression()
 	defineArrayExpression()
 	defineDictionaryExpression()
+	defineStringTemplateExpression()
 	defineIndexExpression()
 	definePathExpression()
 	defineConditionalExpression()
@@ -834,6 +854,32 @@ func defineIdentifierExpression() {
 			case keywordCreate:
 				return parseCreateExpressionRemainder(p, token)
 
+			case keywordAttach:
+				// The `attach` keyword is contextual: it only introduces an attach expression
+				// if it is followed by an identifier, i.e. the attachment type
+				if p.current.Is(lexer.TokenIdentifier) {
+					return parseAttachExpressionRemainder(p, token)
+				}
+
+				return ast.NewIdentifierExpression(
+					p.memoryGauge,
+					p.tokenToIdentifier(token),
+				), nil
+
+			case keywordTry:
+				// The `try` keyword is contextual: it only introduces a try expression
+				// if it is immediately followed by a question mark
+				if p.current.Is(lexer.TokenQuestionMark) &&
+					p.current.StartPos.Offset == token.EndPos.Offset+1 {
+
+					return parseTryExpressionRemainder(p, token)
+				}
+
+				return ast.NewIdentifierExpression(
+					p.memoryGauge,
+					p.tokenToIdentifier(token),
+				), nil
+
 			case keywordDestroy:
 				expression, err := parseExpression(p, lowestBindingPower)
 				if err != nil {
@@ -847,7 +893,30 @@ func defineIdentifierExpression() {
 				), nil
 
 			case keywordFun:
-				return parseFunctionExpression(p, token)
+				return parseFunctionExpression(p, token, ast.FunctionPurityUnspecified)
+
+			case keywordView:
+				// The `view` keyword is contextual: it only introduces a view function expression
+				// if it is followed by the `fun` keyword
+
+				isViewFunction, err := isCurrentTokenKeyword(p, keywordFun)
+				if err != nil {
+					return nil, err
+				}
+
+				if !isViewFunction {
+					return ast.NewIdentifierExpression(
+						p.memoryGauge,
+						p.tokenToIdentifier(token),
+					), nil
+				}
+
+				p.skipSpaceAndComments(true)
+
+				// Skip the `fun` keyword
+				p.next()
+
+				return parseFunctionExpression(p, token, ast.FunctionPurityView)
 
 			default:
 				return ast.NewIdentifierExpression(
@@ -859,7 +928,11 @@ func defineIdentifierExpression() {
 	})
 }
 
-func parseFunctionExpression(p *parser, token lexer.Token) (*ast.FunctionExpression, error) {
+func parseFunctionExpression(
+	p *parser,
+	token lexer.Token,
+	purity ast.FunctionPurity,
+) (*ast.FunctionExpression, error) {
 
 	parameterList, returnTypeAnnotation, functionBlock, err :=
 		parseFunctionParameterListAndRest(p, false)
@@ -867,13 +940,16 @@ func parseFunctionExpression(p *parser, token lexer.Token) (*ast.FunctionExpress
 		return nil, err
 	}
 
-	return ast.NewFunctionExpression(
+	expression := ast.NewFunctionExpression(
 		p.memoryGauge,
 		parameterList,
 		returnTypeAnnotation,
 		functionBlock,
 		token.StartPos,
-	), nil
+	)
+	expression.Purity = purity
+
+	return expression, nil
 }
 
 func defineCastingExpression() {
@@ -956,6 +1032,56 @@ func parseCreateExpressionRemainder(p *parser, token lexer.Token) (*ast.CreateEx
 	), nil
 }
 
+// parseAttachExpressionRemainder parses the remainder of an attach expression.
+//
+//     attachExpression : 'attach' nominalType invocation 'to' expression
+//
+func parseAttachExpressionRemainder(p *parser, token lexer.Token) (*ast.AttachExpression, error) {
+	attachment, err := parseNominalTypeInvocationRemainder(p)
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpaceAndComments(true)
+	_, err = p.mustOneString(lexer.TokenIdentifier, keywordTo)
+	if err != nil {
+		return nil, err
+	}
+
+	base, err := parseExpression(p, lowestBindingPower)
+	if err != nil {
+		return nil, err
+	}
+
+	return ast.NewAttachExpression(
+		p.memoryGauge,
+		base,
+		attachment,
+		token.StartPos,
+	), nil
+}
+
+// parseTryExpressionRemainder parses a try expression,
+// assuming the `try` keyword has already been consumed.
+//
+//     tryExpression : 'try' '?' expression
+//
+func parseTryExpressionRemainder(p *parser, token lexer.Token) (*ast.TryExpression, error) {
+	// Skip the question mark
+	p.next()
+
+	expression, err := parseExpression(p, exprLeftBindingPowerUnaryPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	return ast.NewTryExpression(
+		p.memoryGauge,
+		expression,
+		token.StartPos,
+	), nil
+}
+
 // Invocation Expression Grammar:
 //
 //     invocation : '(' ( argument ( ',' argument )* )? ')'
@@ -1092,14 +1218,48 @@ func parseArgument(p *parser) (*ast.Argument, error) {
 func defineNestedExpression() {
 	setExprNullDenotation(
 		lexer.TokenParenOpen,
-		func(p *parser, token lexer.Token) (ast.Expression, error) {
+		func(p *parser, startToken lexer.Token) (ast.Expression, error) {
 			expression, err := parseExpression(p, lowestBindingPower)
 			if err != nil {
 				return nil, err
 			}
 
-			_, err = p.mustOne(lexer.TokenParenClose)
-			return expression, err
+			if !p.current.Is(lexer.TokenComma) {
+				_, err = p.mustOne(lexer.TokenParenClose)
+				return expression, err
+			}
+
+			// A comma after the first expression makes the expression a tuple expression,
+			// e.g. `(1, "two")`
+
+			elements := []ast.Expression{expression}
+
+			for p.current.Is(lexer.TokenComma) {
+				// Skip the comma
+				p.next()
+
+				element, err := parseExpression(p, lowestBindingPower)
+				if err != nil {
+					return nil, err
+				}
+
+				elements = append(elements, element)
+			}
+
+			endToken, err := p.mustOne(lexer.TokenParenClose)
+			if err != nil {
+				return nil, err
+			}
+
+			return ast.NewTupleExpression(
+				p.memoryGauge,
+				elements,
+				ast.NewRange(
+					p.memoryGauge,
+					startToken.StartPos,
+					endToken.EndPos,
+				),
+			), nil
 		},
 	)
 }
@@ -1144,6 +1304,109 @@ func defineArrayExpression() {
 	)
 }
 
+// String Template Expression Grammar:
+//
+//     stringTemplate : stringTemplateHead expression
+//                      ( stringTemplateMiddle expression )*
+//                      stringTemplateTail
+//
+// The literal parts of the template are lexed as separate tokens:
+// The head includes the start quote and the start of the first interpolation (`\(`),
+// a middle part includes the end of the previous interpolation (`)`) and the start of the next one,
+// and the tail includes the end of the last interpolation and the end quote.
+//
+func defineStringTemplateExpression() {
+	setExprNullDenotation(
+		lexer.TokenStringTemplateHead,
+		func(p *parser, startToken lexer.Token) (ast.Expression, error) {
+			head := startToken.Value.(string)
+
+			// Skip the start quote and the start of the interpolation
+			values := []string{
+				parseStringLiteralContent(p, head[1:len(head)-2]),
+			}
+
+			var expressions []ast.Expression
+
+			for {
+				switch p.current.Type {
+				case lexer.TokenStringTemplateMiddle, lexer.TokenStringTemplateTail:
+					return nil, p.syntaxError("expected expression in string template interpolation")
+				}
+
+				expression, err := parseExpression(p, lowestBindingPower)
+				if err != nil {
+					return nil, err
+				}
+
+				expressions = append(expressions, expression)
+
+				token := p.current
+
+				switch token.Type {
+				case lexer.TokenStringTemplateMiddle:
+					p.next()
+
+					// Skip the end of the previous interpolation,
+					// and the start of the next interpolation
+					middle := token.Value.(string)
+					values = append(
+						values,
+						parseStringLiteralContent(p, middle[1:len(middle)-2]),
+					)
+
+				case lexer.TokenStringTemplateTail:
+					p.next()
+
+					values = append(
+						values,
+						parseStringTemplateTail(p, token.Value.(string)),
+					)
+
+					return ast.NewStringTemplateExpression(
+						p.memoryGauge,
+						values,
+						expressions,
+						ast.NewRange(
+							p.memoryGauge,
+							startToken.StartPos,
+							token.EndPos,
+						),
+					), nil
+
+				default:
+					return nil, p.syntaxError(
+						"expected end of string template interpolation, got %s",
+						token.Type,
+					)
+				}
+			}
+		},
+	)
+}
+
+// parseStringTemplateTail parses the last literal part of a string template,
+// including the end of the last interpolation and the end quote
+//
+func parseStringTemplateTail(p *parser, tail string) string {
+	endOffset := len(tail)
+
+	missingEnd := true
+	if endOffset >= 2 && tail[endOffset-1] == '"' {
+		missingEnd = false
+		endOffset--
+	}
+
+	// Skip the end of the interpolation
+	result := parseStringLiteralContent(p, tail[1:endOffset])
+
+	if missingEnd {
+		p.reportSyntaxError("invalid end of string literal: missing '\"'")
+	}
+
+	return result
+}
+
 func defineDictionaryExpression() {
 	setExprNullDenotation(
 		lexer.TokenBraceOpen,
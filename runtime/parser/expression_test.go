@@ -1798,7 +1798,7 @@ func TestParseMemberExpression(t *testing.T) {
 		const code = `
           let a = b.c
         `
-		result, errs := ParseProgram(code, nil)
+		result, errs := parseProgramWithoutNodeIDs(code)
 		require.Empty(t, errs)
 
 		utils.AssertEqualWithDiff(t,
@@ -2490,6 +2490,55 @@ func TestParseFunctionExpression(t *testing.T) {
 
 	t.Parallel()
 
+	t.Run("view", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseExpression("view fun () { }", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.FunctionExpression{
+				Purity: ast.FunctionPurityView,
+				ParameterList: &ast.ParameterList{
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 9, Offset: 9},
+						EndPos:   ast.Position{Line: 1, Column: 10, Offset: 10},
+					},
+				},
+				ReturnTypeAnnotation: &ast.TypeAnnotation{
+					IsResource: false,
+					Type: &ast.NominalType{
+						Identifier: ast.Identifier{
+							Pos: ast.Position{Line: 1, Column: 10, Offset: 10},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 10, Offset: 10},
+				},
+				FunctionBlock: &ast.FunctionBlock{
+					Block: &ast.Block{
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 12, Offset: 12},
+							EndPos:   ast.Position{Line: 1, Column: 14, Offset: 14},
+						},
+					},
+				},
+				StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+			},
+			result,
+		)
+	})
+
+	t.Run("view identifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseExpression("view + 1", nil)
+		require.Empty(t, errs)
+
+		require.IsType(t, &ast.BinaryExpression{}, result)
+	})
+
 	t.Run("without return type", func(t *testing.T) {
 
 		t.Parallel()
@@ -3921,7 +3970,7 @@ func TestParseBoolExpression(t *testing.T) {
 	const code = `
 	    let a = true
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -3957,7 +4006,7 @@ func TestParseIdentifierExpression(t *testing.T) {
 	const code = `
 	    let b = a
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -3992,7 +4041,7 @@ func TestParseArrayExpressionInVariableDeclaration(t *testing.T) {
 	const code = `
 	    let a = [1, 2]
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -4046,7 +4095,7 @@ func TestParseDictionaryExpressionInVariableDeclaration(t *testing.T) {
 	const code = `
 	    let x = {"a": 1, "b": 2}
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -4118,7 +4167,7 @@ func TestParseInvocationExpressionWithoutLabels(t *testing.T) {
 	const code = `
 	    let a = b(1, 2)
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -4185,7 +4234,7 @@ func TestParseInvocationExpressionWithLabels(t *testing.T) {
 	const code = `
 	    let a = b(x: 1, y: 2)
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -4256,7 +4305,7 @@ func TestParseOptionalMemberExpression(t *testing.T) {
 	const code = `
 	    let a = b?.c
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -4299,7 +4348,7 @@ func TestParseIndexExpressionInVariableDeclaration(t *testing.T) {
 	const code = `
 	    let a = b[1]
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -4349,7 +4398,7 @@ func TestParseUnaryExpression(t *testing.T) {
 	const code = `
 	    let foo = -boo
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -4381,6 +4430,68 @@ func TestParseUnaryExpression(t *testing.T) {
 	)
 }
 
+func TestParseDereferenceExpression(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("simple", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseExpression("*ref", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.UnaryExpression{
+				Operation: ast.OperationMul,
+				Expression: &ast.IdentifierExpression{
+					Identifier: ast.Identifier{
+						Identifier: "ref",
+						Pos:        ast.Position{Offset: 1, Line: 1, Column: 1},
+					},
+				},
+				StartPos: ast.Position{Offset: 0, Line: 1, Column: 0},
+			},
+			result,
+		)
+	})
+
+	t.Run("multiplication", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseExpression("*a * *b", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.BinaryExpression{
+				Operation: ast.OperationMul,
+				Left: &ast.UnaryExpression{
+					Operation: ast.OperationMul,
+					Expression: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "a",
+							Pos:        ast.Position{Offset: 1, Line: 1, Column: 1},
+						},
+					},
+					StartPos: ast.Position{Offset: 0, Line: 1, Column: 0},
+				},
+				Right: &ast.UnaryExpression{
+					Operation: ast.OperationMul,
+					Expression: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "b",
+							Pos:        ast.Position{Offset: 6, Line: 1, Column: 6},
+						},
+					},
+					StartPos: ast.Position{Offset: 5, Line: 1, Column: 5},
+				},
+			},
+			result,
+		)
+	})
+}
+
 func TestParseOrExpression(t *testing.T) {
 
 	t.Parallel()
@@ -4388,7 +4499,7 @@ func TestParseOrExpression(t *testing.T) {
 	const code = `
         let a = false || true
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -4434,7 +4545,7 @@ func TestParseAndExpression(t *testing.T) {
 	const code = `
         let a = false && true
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -4480,7 +4591,7 @@ func TestParseEqualityExpression(t *testing.T) {
 	const code = `
         let a = false == true
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -4526,7 +4637,7 @@ func TestParseRelationalExpression(t *testing.T) {
 	const code = `
         let a = 1 < 2
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -4576,7 +4687,7 @@ func TestParseAdditiveExpression(t *testing.T) {
 	const code = `
         let a = 1 + 2
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -4626,7 +4737,7 @@ func TestParseMultiplicativeExpression(t *testing.T) {
 	const code = `
         let a = 1 * 2
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -4676,7 +4787,7 @@ func TestParseFunctionExpressionAndReturn(t *testing.T) {
 	const code = `
 	    let test = fun (): Int { return 1 }
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -4749,7 +4860,7 @@ func TestParseLeftAssociativity(t *testing.T) {
 	const code = `
         let a = 1 + 2 + 3
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -4811,7 +4922,7 @@ func TestParseNegativeInteger(t *testing.T) {
 	const code = `
       let a = -42
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -4849,7 +4960,7 @@ func TestParseNegativeFixedPoint(t *testing.T) {
 	const code = `
       let a = -42.3
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -4891,7 +5002,7 @@ func TestParseTernaryRightAssociativity(t *testing.T) {
           ? 0
           : 3 > 2 ? 1 : 2
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -4994,7 +5105,7 @@ func TestParseMissingReturnType(t *testing.T) {
 		let noop: ((): Void) =
             fun () { return }
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -5206,6 +5317,205 @@ func TestParseStringWithUnicode(t *testing.T) {
 	utils.AssertEqualWithDiff(t, expected, actual)
 }
 
+func TestParseStringTemplate(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("single interpolation", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseExpression(`"a \(x) b"`, nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.StringTemplateExpression{
+				Values: []string{"a ", " b"},
+				Expressions: []ast.Expression{
+					&ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "x",
+							Pos:        ast.Position{Line: 1, Column: 5, Offset: 5},
+						},
+					},
+				},
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 9, Offset: 9},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("multiple interpolations, escapes", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseExpression(`"\(a + 1)\n\(b)"`, nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.StringTemplateExpression{
+				Values: []string{"", "\n", ""},
+				Expressions: []ast.Expression{
+					&ast.BinaryExpression{
+						Operation: ast.OperationPlus,
+						Left: &ast.IdentifierExpression{
+							Identifier: ast.Identifier{
+								Identifier: "a",
+								Pos:        ast.Position{Line: 1, Column: 3, Offset: 3},
+							},
+						},
+						Right: &ast.IntegerExpression{
+							PositiveLiteral: "1",
+							Value:           big.NewInt(1),
+							Base:            10,
+							Range: ast.Range{
+								StartPos: ast.Position{Line: 1, Column: 7, Offset: 7},
+								EndPos:   ast.Position{Line: 1, Column: 7, Offset: 7},
+							},
+						},
+					},
+					&ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "b",
+							Pos:        ast.Position{Line: 1, Column: 13, Offset: 13},
+						},
+					},
+				},
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 15, Offset: 15},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseExpression(`"\("\(x)")"`, nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.StringTemplateExpression{
+				Values: []string{"", ""},
+				Expressions: []ast.Expression{
+					&ast.StringTemplateExpression{
+						Values: []string{"", ""},
+						Expressions: []ast.Expression{
+							&ast.IdentifierExpression{
+								Identifier: ast.Identifier{
+									Identifier: "x",
+									Pos:        ast.Position{Line: 1, Column: 6, Offset: 6},
+								},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 3, Offset: 3},
+							EndPos:   ast.Position{Line: 1, Column: 8, Offset: 8},
+						},
+					},
+				},
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 10, Offset: 10},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("escaped interpolation", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseExpression(`"\\(x)"`, nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.StringExpression{
+				Value: `\(x)`,
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 6, Offset: 6},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("invalid, empty interpolation", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseExpression(`"\()"`, nil)
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected expression in string template interpolation",
+					Pos:     ast.Position{Line: 1, Column: 3, Offset: 3},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("invalid, missing end of interpolation", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseExpression(`"\(x`, nil)
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected end of string template interpolation, got EOF",
+					Pos:     ast.Position{Line: 1, Column: 4, Offset: 4},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("invalid, missing end quote", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseExpression(`"\(x)`, nil)
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "invalid end of string literal: missing '\"'",
+					Pos:     ast.Position{Line: 1, Column: 5, Offset: 5},
+				},
+			},
+			errs,
+		)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.StringTemplateExpression{
+				Values: []string{"", ""},
+				Expressions: []ast.Expression{
+					&ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "x",
+							Pos:        ast.Position{Line: 1, Column: 3, Offset: 3},
+						},
+					},
+				},
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 4, Offset: 4},
+				},
+			},
+			result,
+		)
+	})
+}
+
 func TestParseNilCoalescing(t *testing.T) {
 
 	t.Parallel()
@@ -5213,7 +5523,7 @@ func TestParseNilCoalescing(t *testing.T) {
 	const code = `
        let x = nil ?? 1
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -5250,22 +5560,136 @@ func TestParseNilCoalescing(t *testing.T) {
 	)
 }
 
-func TestParseNilCoalescingRightAssociativity(t *testing.T) {
+func TestParseRange(t *testing.T) {
 
 	t.Parallel()
 
-	// NOTE: only syntactically, not semantically valid
-	const code = `
-       let x = 1 ?? 2 ?? 3
+	t.Run("half-open", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+       let x = 0..<n + 1
 	`
-	result, errs := ParseProgram(code, nil)
-	require.Empty(t, errs)
+		result, errs := parseProgramWithoutNodeIDs(code)
+		require.Empty(t, errs)
 
-	utils.AssertEqualWithDiff(t,
-		[]ast.Declaration{
-			&ast.VariableDeclaration{
-				IsConstant: true,
-				Identifier: ast.Identifier{
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.VariableDeclaration{
+					IsConstant: true,
+					Identifier: ast.Identifier{
+						Identifier: "x",
+						Pos:        ast.Position{Offset: 12, Line: 2, Column: 11},
+					},
+					Transfer: &ast.Transfer{
+						Operation: ast.TransferOperationCopy,
+						Pos:       ast.Position{Offset: 14, Line: 2, Column: 13},
+					},
+					Value: &ast.BinaryExpression{
+						Operation: ast.OperationRange,
+						Left: &ast.IntegerExpression{
+							PositiveLiteral: "0",
+							Value:           big.NewInt(0),
+							Base:            10,
+							Range: ast.Range{
+								StartPos: ast.Position{Offset: 16, Line: 2, Column: 15},
+								EndPos:   ast.Position{Offset: 16, Line: 2, Column: 15},
+							},
+						},
+						Right: &ast.BinaryExpression{
+							Operation: ast.OperationPlus,
+							Left: &ast.IdentifierExpression{
+								Identifier: ast.Identifier{
+									Identifier: "n",
+									Pos:        ast.Position{Offset: 20, Line: 2, Column: 19},
+								},
+							},
+							Right: &ast.IntegerExpression{
+								PositiveLiteral: "1",
+								Value:           big.NewInt(1),
+								Base:            10,
+								Range: ast.Range{
+									StartPos: ast.Position{Offset: 24, Line: 2, Column: 23},
+									EndPos:   ast.Position{Offset: 24, Line: 2, Column: 23},
+								},
+							},
+						},
+					},
+					StartPos: ast.Position{Offset: 8, Line: 2, Column: 7},
+				},
+			},
+			result.Declarations(),
+		)
+	})
+
+	t.Run("inclusive", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+       let x = 1...10
+	`
+		result, errs := parseProgramWithoutNodeIDs(code)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.VariableDeclaration{
+					IsConstant: true,
+					Identifier: ast.Identifier{
+						Identifier: "x",
+						Pos:        ast.Position{Offset: 12, Line: 2, Column: 11},
+					},
+					Transfer: &ast.Transfer{
+						Operation: ast.TransferOperationCopy,
+						Pos:       ast.Position{Offset: 14, Line: 2, Column: 13},
+					},
+					Value: &ast.BinaryExpression{
+						Operation: ast.OperationInclusiveRange,
+						Left: &ast.IntegerExpression{
+							PositiveLiteral: "1",
+							Value:           big.NewInt(1),
+							Base:            10,
+							Range: ast.Range{
+								StartPos: ast.Position{Offset: 16, Line: 2, Column: 15},
+								EndPos:   ast.Position{Offset: 16, Line: 2, Column: 15},
+							},
+						},
+						Right: &ast.IntegerExpression{
+							PositiveLiteral: "10",
+							Value:           big.NewInt(10),
+							Base:            10,
+							Range: ast.Range{
+								StartPos: ast.Position{Offset: 20, Line: 2, Column: 19},
+								EndPos:   ast.Position{Offset: 21, Line: 2, Column: 20},
+							},
+						},
+					},
+					StartPos: ast.Position{Offset: 8, Line: 2, Column: 7},
+				},
+			},
+			result.Declarations(),
+		)
+	})
+}
+
+func TestParseNilCoalescingRightAssociativity(t *testing.T) {
+
+	t.Parallel()
+
+	// NOTE: only syntactically, not semantically valid
+	const code = `
+       let x = 1 ?? 2 ?? 3
+	`
+	result, errs := parseProgramWithoutNodeIDs(code)
+	require.Empty(t, errs)
+
+	utils.AssertEqualWithDiff(t,
+		[]ast.Declaration{
+			&ast.VariableDeclaration{
+				IsConstant: true,
+				Identifier: ast.Identifier{
 					Identifier: "x",
 					Pos:        ast.Position{Offset: 12, Line: 2, Column: 11},
 				},
@@ -5320,7 +5744,7 @@ func TestParseFailableCasting(t *testing.T) {
 	const code = `
        let x = 0 as? Int
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	failableDowncast := &ast.CastingExpression{
@@ -5377,7 +5801,7 @@ func TestParseMoveOperator(t *testing.T) {
 	const code = `
       let x = foo(<-y)
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -5434,7 +5858,7 @@ func TestParseFunctionExpressionWithResourceTypeAnnotation(t *testing.T) {
 	const code = `
         let f = fun (): @R { return X }
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -5505,7 +5929,7 @@ func TestParseFailableCastingResourceTypeAnnotation(t *testing.T) {
 	const code = `
         let y = x as? @R
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	failableDowncast := &ast.CastingExpression{
@@ -5559,7 +5983,7 @@ func TestParseCasting(t *testing.T) {
 	const code = `
         let y = x as Y
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	cast := &ast.CastingExpression{
@@ -5625,7 +6049,7 @@ func TestParseReferenceInVariableDeclaration(t *testing.T) {
 	const code = `
        let x = &account.storage[R] as &R
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	expected := &ast.VariableDeclaration{
@@ -5701,7 +6125,7 @@ func TestParseFixedPointExpression(t *testing.T) {
 	const code = `
 	    let a = -1234_5678_90.0009_8765_4321
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -5740,7 +6164,7 @@ func TestParseFixedPointExpressionZeroInteger(t *testing.T) {
 	const code = `
 	    let a = -0.1
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -5779,7 +6203,7 @@ func TestParsePathLiteral(t *testing.T) {
 	const code = `
 	    let a = /foo/bar
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -5818,7 +6242,7 @@ func TestParseBitwiseExpression(t *testing.T) {
 	const code = `
       let a = 1 | 2 ^ 3 & 4 << 5 >> 6
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -5967,3 +6391,268 @@ func (g *limitingMemoryGauge) MeterMemory(usage common.MemoryUsage) error {
 
 	return nil
 }
+
+func TestParseAttach(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("simple", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseExpression("attach A() to r", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.AttachExpression{
+				Base: &ast.IdentifierExpression{
+					Identifier: ast.Identifier{
+						Identifier: "r",
+						Pos:        ast.Position{Line: 1, Column: 14, Offset: 14},
+					},
+				},
+				Attachment: &ast.InvocationExpression{
+					InvokedExpression: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "A",
+							Pos:        ast.Position{Line: 1, Column: 7, Offset: 7},
+						},
+					},
+					ArgumentsStartPos: ast.Position{Line: 1, Column: 8, Offset: 8},
+					EndPos:            ast.Position{Line: 1, Column: 9, Offset: 9},
+				},
+				StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+			},
+			result,
+		)
+	})
+
+	t.Run("move", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseExpression("attach A() to <-r", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.AttachExpression{
+				Base: &ast.UnaryExpression{
+					Operation: ast.OperationMove,
+					Expression: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "r",
+							Pos:        ast.Position{Line: 1, Column: 16, Offset: 16},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 14, Offset: 14},
+				},
+				Attachment: &ast.InvocationExpression{
+					InvokedExpression: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "A",
+							Pos:        ast.Position{Line: 1, Column: 7, Offset: 7},
+						},
+					},
+					ArgumentsStartPos: ast.Position{Line: 1, Column: 8, Offset: 8},
+					EndPos:            ast.Position{Line: 1, Column: 9, Offset: 9},
+				},
+				StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+			},
+			result,
+		)
+	})
+
+	t.Run("identifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseExpression("attach", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.IdentifierExpression{
+				Identifier: ast.Identifier{
+					Identifier: "attach",
+					Pos:        ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("missing to", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseExpression("attach A() r", nil)
+		require.NotEmpty(t, errs)
+	})
+}
+
+func TestParseTry(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("invocation", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseExpression("try? f(1)", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.TryExpression{
+				Expression: &ast.InvocationExpression{
+					InvokedExpression: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "f",
+							Pos:        ast.Position{Line: 1, Column: 5, Offset: 5},
+						},
+					},
+					Arguments: []*ast.Argument{
+						{
+							Expression: &ast.IntegerExpression{
+								PositiveLiteral: "1",
+								Value:           big.NewInt(1),
+								Base:            10,
+								Range: ast.Range{
+									StartPos: ast.Position{Line: 1, Column: 7, Offset: 7},
+									EndPos:   ast.Position{Line: 1, Column: 7, Offset: 7},
+								},
+							},
+							TrailingSeparatorPos: ast.Position{Line: 1, Column: 8, Offset: 8},
+						},
+					},
+					ArgumentsStartPos: ast.Position{Line: 1, Column: 6, Offset: 6},
+					EndPos:            ast.Position{Line: 1, Column: 8, Offset: 8},
+				},
+				StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+			},
+			result,
+		)
+	})
+
+	t.Run("nil-coalescing", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseExpression("try? f() ?? 2", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.BinaryExpression{
+				Operation: ast.OperationNilCoalesce,
+				Left: &ast.TryExpression{
+					Expression: &ast.InvocationExpression{
+						InvokedExpression: &ast.IdentifierExpression{
+							Identifier: ast.Identifier{
+								Identifier: "f",
+								Pos:        ast.Position{Line: 1, Column: 5, Offset: 5},
+							},
+						},
+						ArgumentsStartPos: ast.Position{Line: 1, Column: 6, Offset: 6},
+						EndPos:            ast.Position{Line: 1, Column: 7, Offset: 7},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+				Right: &ast.IntegerExpression{
+					PositiveLiteral: "2",
+					Value:           big.NewInt(2),
+					Base:            10,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 12, Offset: 12},
+						EndPos:   ast.Position{Line: 1, Column: 12, Offset: 12},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("identifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseExpression("try", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.IdentifierExpression{
+				Identifier: ast.Identifier{
+					Identifier: "try",
+					Pos:        ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("conditional", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseExpression("try ? 1 : 2", nil)
+		require.Empty(t, errs)
+
+		require.IsType(t, &ast.ConditionalExpression{}, result)
+	})
+}
+
+func TestParseTupleExpression(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("two elements", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseExpression(`(1, "two")`, nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.TupleExpression{
+				Elements: []ast.Expression{
+					&ast.IntegerExpression{
+						PositiveLiteral: "1",
+						Value:           big.NewInt(1),
+						Base:            10,
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 1, Offset: 1},
+							EndPos:   ast.Position{Line: 1, Column: 1, Offset: 1},
+						},
+					},
+					&ast.StringExpression{
+						Value: "two",
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 4, Offset: 4},
+							EndPos:   ast.Position{Line: 1, Column: 8, Offset: 8},
+						},
+					},
+				},
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 9, Offset: 9},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("parenthesized expression is not a tuple", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseExpression(`(x)`, nil)
+		require.Empty(t, errs)
+
+		require.IsType(t, &ast.IdentifierExpression{}, result)
+	})
+
+	t.Run("trailing comma", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseExpression(`(1, )`, nil)
+		require.NotEmpty(t, errs)
+	})
+}
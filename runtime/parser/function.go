@@ -23,7 +23,11 @@ import (
 	"github.com/onflow/cadence/runtime/parser/lexer"
 )
 
-func parseParameterList(p *parser) (parameterList *ast.ParameterList, err error) {
+// parseParameterList parses a parenthesized list of parameters.
+// If allowIndexed is true, parameters may be preceded by the `indexed` modifier,
+// which is only valid for event parameters.
+//
+func parseParameterList(p *parser, allowIndexed bool) (parameterList *ast.ParameterList, err error) {
 	var parameters []*ast.Parameter
 
 	p.skipSpaceAndComments(true)
@@ -54,7 +58,7 @@ func parseParameterList(p *parser) (parameterList *ast.ParameterList, err error)
 					Pos: p.current.StartPos,
 				})
 			}
-			parameter, err := parseParameter(p)
+			parameter, err := parseParameter(p, allowIndexed)
 			if err != nil {
 				return nil, err
 			}
@@ -111,7 +115,7 @@ func parseParameterList(p *parser) (parameterList *ast.ParameterList, err error)
 	), err
 }
 
-func parseParameter(p *parser) (*ast.Parameter, error) {
+func parseParameter(p *parser, allowIndexed bool) (*ast.Parameter, error) {
 	p.skipSpaceAndComments(true)
 
 	startPos := p.current.StartPos
@@ -134,10 +138,34 @@ func parseParameter(p *parser) (*ast.Parameter, error) {
 	// Skip the identifier
 	p.next()
 
+	p.skipSpaceAndComments(true)
+
+	// If the `indexed` modifier is allowed and another identifier follows,
+	// then the previous identifier is the modifier,
+	// and the argument label or parameter name follows
+
+	indexed := false
+	if allowIndexed &&
+		parameterName == keywordIndexed &&
+		p.current.Is(lexer.TokenIdentifier) {
+
+		indexed = true
+		parameterName, ok = p.current.Value.(string)
+		if !ok {
+			return nil, p.syntaxError(
+				"expected parameter %s to be a string",
+				p.current,
+			)
+		}
+		parameterPos = p.current.StartPos
+		// Skip the identifier
+		p.next()
+		p.skipSpaceAndComments(true)
+	}
+
 	// If another identifier is provided, then the previous identifier
 	// is the argument label, and this identifier is the parameter name
 
-	p.skipSpaceAndComments(true)
 	if p.current.Is(lexer.TokenIdentifier) {
 		argumentLabel = parameterName
 		parameterName, ok = p.current.Value.(string)
@@ -172,7 +200,26 @@ func parseParameter(p *parser) (*ast.Parameter, error) {
 
 	endPos := typeAnnotation.EndPosition(p.memoryGauge)
 
-	return ast.NewParameter(
+	// If an equal sign follows the type annotation,
+	// then the parameter has a default argument
+
+	var defaultArgument ast.Expression
+
+	p.skipSpaceAndComments(true)
+	if p.current.Is(lexer.TokenEqual) {
+		// Skip the equal sign
+		p.next()
+		p.skipSpaceAndComments(true)
+
+		defaultArgument, err = parseExpression(p, lowestBindingPower)
+		if err != nil {
+			return nil, err
+		}
+
+		endPos = defaultArgument.EndPosition(p.memoryGauge)
+	}
+
+	parameter := ast.NewParameter(
 		p.memoryGauge,
 		argumentLabel,
 		ast.NewIdentifier(
@@ -181,12 +228,16 @@ func parseParameter(p *parser) (*ast.Parameter, error) {
 			parameterPos,
 		),
 		typeAnnotation,
+		defaultArgument,
 		ast.NewRange(
 			p.memoryGauge,
 			startPos,
 			endPos,
 		),
-	), nil
+	)
+	parameter.Indexed = indexed
+
+	return parameter, nil
 }
 
 func parseFunctionDeclaration(
@@ -194,12 +245,16 @@ func parseFunctionDeclaration(
 	functionBlockIsOptional bool,
 	access ast.Access,
 	accessPos *ast.Position,
+	purity ast.FunctionPurity,
+	purityPos *ast.Position,
 	docString string,
 ) (*ast.FunctionDeclaration, error) {
 
 	startPos := p.current.StartPos
 	if accessPos != nil {
 		startPos = *accessPos
+	} else if purityPos != nil {
+		startPos = *purityPos
 	}
 
 	// Skip the `fun` keyword
@@ -218,6 +273,17 @@ func parseFunctionDeclaration(
 	// Skip the identifier
 	p.next()
 
+	var typeParameters []*ast.TypeParameter
+
+	p.skipSpaceAndComments(true)
+	if p.current.Is(lexer.TokenLess) {
+		var err error
+		typeParameters, err = parseTypeParameterList(p)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	parameterList, returnTypeAnnotation, functionBlock, err :=
 		parseFunctionParameterListAndRest(p, functionBlockIsOptional)
 
@@ -225,7 +291,7 @@ func parseFunctionDeclaration(
 		return nil, err
 	}
 
-	return ast.NewFunctionDeclaration(
+	declaration := ast.NewFunctionDeclaration(
 		p.memoryGauge,
 		access,
 		identifier,
@@ -234,6 +300,208 @@ func parseFunctionDeclaration(
 		functionBlock,
 		startPos,
 		docString,
+	)
+
+	declaration.TypeParameters = typeParameters
+	declaration.Purity = purity
+
+	return declaration, nil
+}
+
+// parsePurityAnnotation parses an optional purity annotation of a function,
+// e.g. `view` in `view fun foo() {}`.
+//
+// The `view` keyword is contextual: it is only a purity annotation
+// if it is followed by one of the given keywords, e.g. `fun`.
+// Otherwise, the current token is not consumed.
+//
+//     purity : 'view'?
+//
+func parsePurityAnnotation(p *parser, keywords ...string) (
+	purity ast.FunctionPurity,
+	purityPos *ast.Position,
+	err error,
+) {
+	if !p.current.IsString(lexer.TokenIdentifier, keywordView) {
+		return ast.FunctionPurityUnspecified, nil, nil
+	}
+
+	isPurityAnnotation, err := isNextTokenKeyword(p, keywords...)
+	if err != nil || !isPurityAnnotation {
+		return ast.FunctionPurityUnspecified, nil, err
+	}
+
+	pos := p.current.StartPos
+
+	// Skip the `view` keyword
+	p.next()
+	p.skipSpaceAndComments(true)
+
+	return ast.FunctionPurityView, &pos, nil
+}
+
+// isNextTokenKeyword returns true if the token following the current token
+// is one of the given keywords. No tokens are consumed.
+//
+func isNextTokenKeyword(p *parser, keywords ...string) (b bool, err error) {
+	p.startBuffering()
+	defer func() {
+		err = p.replayBuffered()
+	}()
+
+	// skip the current token
+	p.next()
+
+	return isKeywordAhead(p, keywords), nil
+}
+
+// isCurrentTokenKeyword returns true if the current token,
+// ignoring any whitespace and comments, is one of the given keywords.
+// No tokens are consumed.
+//
+func isCurrentTokenKeyword(p *parser, keywords ...string) (b bool, err error) {
+	p.startBuffering()
+	defer func() {
+		err = p.replayBuffered()
+	}()
+
+	return isKeywordAhead(p, keywords), nil
+}
+
+func isKeywordAhead(p *parser, keywords []string) bool {
+	p.skipSpaceAndComments(true)
+
+	if !p.current.Is(lexer.TokenIdentifier) {
+		return false
+	}
+
+	for _, keyword := range keywords {
+		if p.current.Value == keyword {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseTypeParameterList parses the type parameters of a function or composite declaration,
+// e.g. `<T: AnyStruct & Comparable, U>`
+//
+//     typeParameterList : '<' ( typeParameter ( ',' typeParameter )* )? '>'
+//
+func parseTypeParameterList(p *parser) (typeParameters []*ast.TypeParameter, err error) {
+
+	// Skip the opening angle bracket
+	p.next()
+
+	expectTypeParameter := true
+
+	atEnd := false
+	for !atEnd {
+		p.skipSpaceAndComments(true)
+		switch p.current.Type {
+		case lexer.TokenIdentifier:
+			if !expectTypeParameter {
+				return nil, p.syntaxError(
+					"expected comma or end of type parameter list, got %s",
+					p.current.Type,
+				)
+			}
+
+			typeParameter, err := parseTypeParameter(p)
+			if err != nil {
+				return nil, err
+			}
+
+			typeParameters = append(typeParameters, typeParameter)
+			expectTypeParameter = false
+
+		case lexer.TokenComma:
+			if expectTypeParameter {
+				return nil, p.syntaxError(
+					"expected type parameter or end of type parameter list, got %s",
+					p.current.Type,
+				)
+			}
+			// Skip the comma
+			p.next()
+			expectTypeParameter = true
+
+		case lexer.TokenGreater:
+			if len(typeParameters) == 0 {
+				return nil, p.syntaxError("expected at least one type parameter")
+			}
+			// Skip the closing angle bracket
+			p.next()
+			atEnd = true
+
+		case lexer.TokenEOF:
+			return nil, p.syntaxError(
+				"missing %s at end of type parameter list",
+				lexer.TokenGreater,
+			)
+
+		default:
+			if expectTypeParameter {
+				return nil, p.syntaxError(
+					"expected type parameter or end of type parameter list, got %s",
+					p.current.Type,
+				)
+			} else {
+				return nil, p.syntaxError(
+					"expected comma or end of type parameter list, got %s",
+					p.current.Type,
+				)
+			}
+		}
+	}
+
+	return typeParameters, nil
+}
+
+// parseTypeParameter parses a type parameter and its optional type bounds,
+// e.g. `T: AnyStruct & Comparable`
+//
+//     typeParameter : identifier ( ':' type ( '&' type )* )?
+//
+func parseTypeParameter(p *parser) (*ast.TypeParameter, error) {
+
+	identifier := p.tokenToIdentifier(p.current)
+
+	// Skip the identifier
+	p.next()
+
+	var typeBounds []ast.Type
+
+	p.skipSpaceAndComments(true)
+	if p.current.Is(lexer.TokenColon) {
+		// Skip the colon
+		p.next()
+
+		for {
+			p.skipSpaceAndComments(true)
+
+			typeBound, err := parseType(p, lowestBindingPower)
+			if err != nil {
+				return nil, err
+			}
+
+			typeBounds = append(typeBounds, typeBound)
+
+			p.skipSpaceAndComments(true)
+			if !p.current.Is(lexer.TokenAmpersand) {
+				break
+			}
+
+			// Skip the ampersand
+			p.next()
+		}
+	}
+
+	return ast.NewTypeParameter(
+		p.memoryGauge,
+		identifier,
+		typeBounds,
 	), nil
 }
 
@@ -246,7 +514,7 @@ func parseFunctionParameterListAndRest(
 	functionBlock *ast.FunctionBlock,
 	err error,
 ) {
-	parameterList, err = parseParameterList(p)
+	parameterList, err = parseParameterList(p, false)
 	if err != nil {
 		return
 	}
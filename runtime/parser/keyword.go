@@ -62,4 +62,12 @@ const (
 	keywordSwitch      = "switch"
 	keywordDefault     = "default"
 	keywordEnum        = "enum"
+	keywordAttachment  = "attachment"
+	keywordAttach      = "attach"
+	keywordTo          = "to"
+	keywordRemove      = "remove"
+	keywordTypeAlias   = "typealias"
+	keywordTry         = "try"
+	keywordView        = "view"
+	keywordIndexed     = "indexed"
 )
@@ -20,6 +20,7 @@ package lexer
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 	"unicode/utf8"
 
@@ -72,8 +73,16 @@ type lexer struct {
 	tokens []Token
 	// tokenCount is the number of tokens in the stream
 	tokenCount int
+	// stringTemplateParenDepths is a stack of the parenthesis nesting depths
+	// of the string template interpolations that are currently being scanned
+	stringTemplateParenDepths []int
 	// memoryGauge is used for metering memory usage
 	memoryGauge common.MemoryGauge
+	// identifierValues maps the words of the scanned identifiers
+	// to the values of their tokens
+	identifierValues map[string]any
+	// spaceValues maps the scanned spaces to the values of their tokens
+	spaceValues map[Space]any
 }
 
 var _ TokenStream = &lexer{}
@@ -130,6 +139,13 @@ func (l *lexer) clear() {
 	l.cursor = 0
 	l.tokens = l.tokens[:0]
 	l.tokenCount = 0
+	l.stringTemplateParenDepths = l.stringTemplateParenDepths[:0]
+	for word := range l.identifierValues {
+		delete(l.identifierValues, word)
+	}
+	for space := range l.spaceValues {
+		delete(l.spaceValues, space)
+	}
 }
 
 func (l *lexer) Reclaim() {
@@ -139,7 +155,9 @@ func (l *lexer) Reclaim() {
 var pool = sync.Pool{
 	New: func() any {
 		return &lexer{
-			tokens: make([]Token, 0, 2048),
+			tokens:           make([]Token, 0, 2048),
+			identifierValues: make(map[string]any, 256),
+			spaceValues:      make(map[Space]any, 64),
 		}
 	},
 }
@@ -228,6 +246,14 @@ func (l *lexer) backupOne() {
 	l.current = l.prev
 }
 
+// hasPrefix returns true if the remaining input starts with the given prefix.
+// It does not consume any input.
+//
+func (l *lexer) hasPrefix(prefix string) bool {
+	return l.endOffset <= len(l.input) &&
+		strings.HasPrefix(l.input[l.endOffset:], prefix)
+}
+
 func (l *lexer) wordLength() int {
 	return l.endOffset - l.startOffset
 }
@@ -343,7 +369,61 @@ func (l *lexer) emitValue(ty TokenType) {
 		common.UseMemory(l.memoryGauge, usage)
 	}
 
-	l.emit(ty, l.word(), l.startPosition(), true)
+	l.emit(ty, l.tokenValue(ty), l.startPosition(), true)
+}
+
+// tokenValue returns the value of a token of the given type for the current word.
+//
+// Converting the word to a token value allocates,
+// so the values of identifiers, which includes keywords, are interned:
+// identifiers are repeated frequently, especially in large programs
+//
+func (l *lexer) tokenValue(ty TokenType) any {
+	word := l.word()
+
+	if ty != TokenIdentifier {
+		return word
+	}
+
+	if value, ok := l.identifierValues[word]; ok {
+		return value
+	}
+
+	var value any = word
+	l.identifierValues[word] = value
+	return value
+}
+
+// emitSpace emits a space token for the current word.
+//
+// Like the values of identifiers, the values of spaces are interned,
+// as most spaces are repeated, e.g. indentation
+//
+func (l *lexer) emitSpace(startIsNewline bool) {
+	containsNewline := l.scanSpace()
+	containsNewline = containsNewline || startIsNewline
+
+	if l.memoryGauge != nil {
+		// Meter token wrapper
+		common.UseMemory(l.memoryGauge, common.SpaceTokenMemoryUsage)
+
+		// Meter token content
+		tokenLength := l.wordLength()
+		common.UseMemory(l.memoryGauge, common.NewRawStringMemoryUsage(tokenLength))
+	}
+
+	space := Space{
+		String:          l.word(),
+		ContainsNewline: containsNewline,
+	}
+
+	value, ok := l.spaceValues[space]
+	if !ok {
+		value = space
+		l.spaceValues[space] = value
+	}
+
+	l.emit(TokenSpace, value, l.startPosition(), true)
 }
 
 func (l *lexer) emitError(err error) {
@@ -406,25 +486,67 @@ func (l *lexer) acceptWhile(f func(rune) bool) {
 	}
 }
 
-func (l *lexer) scanString(quote rune) {
+// scanString scans a string until the end quote.
+//
+// It returns true if the scanning stopped at the start
+// of a string template interpolation, i.e. `\(`.
+//
+func (l *lexer) scanString(quote rune) (interpolation bool) {
 	r := l.next()
 	for r != quote {
 		switch r {
 		case '\n', EOF:
 			// NOTE: invalid end of string handled by parser
 			l.backupOne()
-			return
+			return false
 		case '\\':
 			r = l.next()
 			switch r {
 			case '\n', EOF:
 				// NOTE: invalid end of string handled by parser
 				l.backupOne()
-				return
+				return false
+			case '(':
+				return true
 			}
 		}
 		r = l.next()
 	}
+	return false
+}
+
+// startStringTemplateInterpolation is called when the start of
+// a string template interpolation, i.e. `\(`, got scanned.
+func (l *lexer) startStringTemplateInterpolation() {
+	l.stringTemplateParenDepths = append(l.stringTemplateParenDepths, 0)
+}
+
+// openParen is called when an opening parenthesis got scanned.
+func (l *lexer) openParen() {
+	lastIndex := len(l.stringTemplateParenDepths) - 1
+	if lastIndex < 0 {
+		return
+	}
+	l.stringTemplateParenDepths[lastIndex]++
+}
+
+// closeParen is called when a closing parenthesis got scanned.
+//
+// It returns true if the parenthesis ends a string template interpolation.
+//
+func (l *lexer) closeParen() (endsInterpolation bool) {
+	lastIndex := len(l.stringTemplateParenDepths) - 1
+	if lastIndex < 0 {
+		return false
+	}
+
+	if l.stringTemplateParenDepths[lastIndex] == 0 {
+		l.stringTemplateParenDepths = l.stringTemplateParenDepths[:lastIndex]
+		return true
+	}
+
+	l.stringTemplateParenDepths[lastIndex]--
+	return false
 }
 
 func (l *lexer) scanBinaryRemainder() {
@@ -451,7 +573,9 @@ func (l *lexer) scanHexadecimalRemainder() {
 func (l *lexer) scanDecimalOrFixedPointRemainder() TokenType {
 	l.acceptWhile(isDecimalDigitOrUnderscore)
 	r := l.next()
-	if r == '.' {
+	// A second dot starts a range operator, e.g. `1..<10`,
+	// so the number is an integer
+	if r == '.' && !l.hasPrefix(".") {
 		l.scanFixedPointRemainder()
 		return TokenFixedPointNumberLiteral
 	} else {
@@ -478,7 +602,12 @@ func (l *lexer) scanFixedPointRemainder() {
 func (l *lexer) tokenValueMemoryUsage(tokenType TokenType) common.MemoryUsage {
 	tokenLength := l.wordLength()
 
-	if tokenType == TokenString {
+	switch tokenType {
+	case TokenString,
+		TokenStringTemplateHead,
+		TokenStringTemplateMiddle,
+		TokenStringTemplateTail:
+
 		return common.NewStringMemoryUsage(tokenLength)
 	}
 
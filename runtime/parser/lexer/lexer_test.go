@@ -20,6 +20,7 @@ package lexer
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
 
@@ -903,6 +904,236 @@ func TestLexString(t *testing.T) {
 	})
 }
 
+func TestLexStringTemplate(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("single interpolation", func(t *testing.T) {
+		testLex(t,
+			`"a\(x)b"`,
+			[]Token{
+				{
+					Type:  TokenStringTemplateHead,
+					Value: `"a\(`,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 3, Offset: 3},
+					},
+				},
+				{
+					Type:  TokenIdentifier,
+					Value: `x`,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 4, Offset: 4},
+						EndPos:   ast.Position{Line: 1, Column: 4, Offset: 4},
+					},
+				},
+				{
+					Type:  TokenStringTemplateTail,
+					Value: `)b"`,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 5, Offset: 5},
+						EndPos:   ast.Position{Line: 1, Column: 7, Offset: 7},
+					},
+				},
+				{
+					Type: TokenEOF,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 8, Offset: 8},
+						EndPos:   ast.Position{Line: 1, Column: 8, Offset: 8},
+					},
+				},
+			},
+		)
+	})
+
+	t.Run("multiple interpolations", func(t *testing.T) {
+		testLex(t,
+			`"\(x)-\(y)"`,
+			[]Token{
+				{
+					Type:  TokenStringTemplateHead,
+					Value: `"\(`,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 2, Offset: 2},
+					},
+				},
+				{
+					Type:  TokenIdentifier,
+					Value: `x`,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 3, Offset: 3},
+						EndPos:   ast.Position{Line: 1, Column: 3, Offset: 3},
+					},
+				},
+				{
+					Type:  TokenStringTemplateMiddle,
+					Value: `)-\(`,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 4, Offset: 4},
+						EndPos:   ast.Position{Line: 1, Column: 7, Offset: 7},
+					},
+				},
+				{
+					Type:  TokenIdentifier,
+					Value: `y`,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 8, Offset: 8},
+						EndPos:   ast.Position{Line: 1, Column: 8, Offset: 8},
+					},
+				},
+				{
+					Type:  TokenStringTemplateTail,
+					Value: `)"`,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 9, Offset: 9},
+						EndPos:   ast.Position{Line: 1, Column: 10, Offset: 10},
+					},
+				},
+				{
+					Type: TokenEOF,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 11, Offset: 11},
+						EndPos:   ast.Position{Line: 1, Column: 11, Offset: 11},
+					},
+				},
+			},
+		)
+	})
+
+	t.Run("nested parentheses", func(t *testing.T) {
+		testLex(t,
+			`"\((x))"`,
+			[]Token{
+				{
+					Type:  TokenStringTemplateHead,
+					Value: `"\(`,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 2, Offset: 2},
+					},
+				},
+				{
+					Type: TokenParenOpen,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 3, Offset: 3},
+						EndPos:   ast.Position{Line: 1, Column: 3, Offset: 3},
+					},
+				},
+				{
+					Type:  TokenIdentifier,
+					Value: `x`,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 4, Offset: 4},
+						EndPos:   ast.Position{Line: 1, Column: 4, Offset: 4},
+					},
+				},
+				{
+					Type: TokenParenClose,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 5, Offset: 5},
+						EndPos:   ast.Position{Line: 1, Column: 5, Offset: 5},
+					},
+				},
+				{
+					Type:  TokenStringTemplateTail,
+					Value: `)"`,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 6, Offset: 6},
+						EndPos:   ast.Position{Line: 1, Column: 7, Offset: 7},
+					},
+				},
+				{
+					Type: TokenEOF,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 8, Offset: 8},
+						EndPos:   ast.Position{Line: 1, Column: 8, Offset: 8},
+					},
+				},
+			},
+		)
+	})
+
+	t.Run("nested template", func(t *testing.T) {
+		testLex(t,
+			`"\("\(x)")"`,
+			[]Token{
+				{
+					Type:  TokenStringTemplateHead,
+					Value: `"\(`,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 2, Offset: 2},
+					},
+				},
+				{
+					Type:  TokenStringTemplateHead,
+					Value: `"\(`,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 3, Offset: 3},
+						EndPos:   ast.Position{Line: 1, Column: 5, Offset: 5},
+					},
+				},
+				{
+					Type:  TokenIdentifier,
+					Value: `x`,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 6, Offset: 6},
+						EndPos:   ast.Position{Line: 1, Column: 6, Offset: 6},
+					},
+				},
+				{
+					Type:  TokenStringTemplateTail,
+					Value: `)"`,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 7, Offset: 7},
+						EndPos:   ast.Position{Line: 1, Column: 8, Offset: 8},
+					},
+				},
+				{
+					Type:  TokenStringTemplateTail,
+					Value: `)"`,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 9, Offset: 9},
+						EndPos:   ast.Position{Line: 1, Column: 10, Offset: 10},
+					},
+				},
+				{
+					Type: TokenEOF,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 11, Offset: 11},
+						EndPos:   ast.Position{Line: 1, Column: 11, Offset: 11},
+					},
+				},
+			},
+		)
+	})
+
+	t.Run("escaped backslash", func(t *testing.T) {
+		testLex(t,
+			`"\\(x)"`,
+			[]Token{
+				{
+					Type:  TokenString,
+					Value: `"\\(x)"`,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 6, Offset: 6},
+					},
+				},
+				{
+					Type: TokenEOF,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 7, Offset: 7},
+						EndPos:   ast.Position{Line: 1, Column: 7, Offset: 7},
+					},
+				},
+			},
+		)
+	})
+}
+
 func TestLexBlockComment(t *testing.T) {
 
 	t.Parallel()
@@ -1762,6 +1993,125 @@ func TestLexFixedPoint(t *testing.T) {
 	})
 }
 
+func TestLexRange(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("half-open", func(t *testing.T) {
+		testLex(t,
+			"0..<10",
+			[]Token{
+				{
+					Type:  TokenDecimalIntegerLiteral,
+					Value: "0",
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 0, Offset: 0},
+					},
+				},
+				{
+					Type: TokenDotDotLess,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 1, Offset: 1},
+						EndPos:   ast.Position{Line: 1, Column: 3, Offset: 3},
+					},
+				},
+				{
+					Type:  TokenDecimalIntegerLiteral,
+					Value: "10",
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 4, Offset: 4},
+						EndPos:   ast.Position{Line: 1, Column: 5, Offset: 5},
+					},
+				},
+				{
+					Type: TokenEOF,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 6, Offset: 6},
+						EndPos:   ast.Position{Line: 1, Column: 6, Offset: 6},
+					},
+				},
+			},
+		)
+	})
+
+	t.Run("inclusive", func(t *testing.T) {
+		testLex(t,
+			"1...10",
+			[]Token{
+				{
+					Type:  TokenDecimalIntegerLiteral,
+					Value: "1",
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 0, Offset: 0},
+					},
+				},
+				{
+					Type: TokenDotDotDot,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 1, Offset: 1},
+						EndPos:   ast.Position{Line: 1, Column: 3, Offset: 3},
+					},
+				},
+				{
+					Type:  TokenDecimalIntegerLiteral,
+					Value: "10",
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 4, Offset: 4},
+						EndPos:   ast.Position{Line: 1, Column: 5, Offset: 5},
+					},
+				},
+				{
+					Type: TokenEOF,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 6, Offset: 6},
+						EndPos:   ast.Position{Line: 1, Column: 6, Offset: 6},
+					},
+				},
+			},
+		)
+	})
+
+	t.Run("identifiers", func(t *testing.T) {
+		testLex(t,
+			"a..<b",
+			[]Token{
+				{
+					Type:  TokenIdentifier,
+					Value: "a",
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 0, Offset: 0},
+					},
+				},
+				{
+					Type: TokenDotDotLess,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 1, Offset: 1},
+						EndPos:   ast.Position{Line: 1, Column: 3, Offset: 3},
+					},
+				},
+				{
+					Type:  TokenIdentifier,
+					Value: "b",
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 4, Offset: 4},
+						EndPos:   ast.Position{Line: 1, Column: 4, Offset: 4},
+					},
+				},
+				{
+					Type: TokenEOF,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 5, Offset: 5},
+						EndPos:   ast.Position{Line: 1, Column: 5, Offset: 5},
+					},
+				},
+			},
+		)
+	})
+}
+
 func TestLexLineComment(t *testing.T) {
 
 	t.Parallel()
@@ -2163,3 +2513,57 @@ func TestLimit(t *testing.T) {
 		},
 	)
 }
+
+// largeContract returns the code of a contract which is larger than 100KB
+//
+func largeContract() string {
+	var builder strings.Builder
+
+	builder.WriteString("pub contract Large {\n")
+
+	for i := 0; builder.Len() < 128*1024; i++ {
+		_, _ = fmt.Fprintf(&builder,
+			`
+              pub resource Vault%[1]d {
+                  pub var balance: UFix64
+
+                  init(balance: UFix64) {
+                      self.balance = balance
+                  }
+
+                  pub fun withdraw(amount: UFix64): @Vault%[1]d {
+                      pre {
+                          self.balance >= amount: "insufficient balance"
+                      }
+                      self.balance = self.balance - amount
+                      return <-create Vault%[1]d(balance: amount)
+                  }
+
+                  pub fun deposit(from: @Vault%[1]d) {
+                      self.balance = self.balance + from.balance
+                      destroy from
+                  }
+              }
+            `,
+			i,
+		)
+	}
+
+	builder.WriteString("}\n")
+
+	return builder.String()
+}
+
+func BenchmarkLexLargeContract(b *testing.B) {
+
+	code := largeContract()
+
+	b.SetBytes(int64(len(code)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		tokens := Lex(code, nil)
+		tokens.Reclaim()
+	}
+}
@@ -20,8 +20,6 @@ package lexer
 
 import (
 	"fmt"
-
-	"github.com/onflow/cadence/runtime/common"
 )
 
 const keywordAs = "as"
@@ -49,8 +47,12 @@ func rootState(l *lexer) stateFn {
 		case '%':
 			l.emitType(TokenPercent)
 		case '(':
+			l.openParen()
 			l.emitType(TokenParenOpen)
 		case ')':
+			if l.closeParen() {
+				return stringTemplateContinuationState
+			}
 			l.emitType(TokenParenClose)
 		case '{':
 			l.emitType(TokenBraceOpen)
@@ -67,7 +69,18 @@ func rootState(l *lexer) stateFn {
 		case ':':
 			l.emitType(TokenColon)
 		case '.':
-			l.emitType(TokenDot)
+			switch {
+			case l.hasPrefix(".<"):
+				l.next()
+				l.next()
+				l.emitType(TokenDotDotLess)
+			case l.hasPrefix(".."):
+				l.next()
+				l.next()
+				l.emitType(TokenDotDotDot)
+			default:
+				l.emitType(TokenDot)
+			}
 		case '=':
 			if l.acceptOne('=') {
 				l.emitType(TokenEqualEqual)
@@ -104,9 +117,9 @@ func rootState(l *lexer) stateFn {
 		case '_':
 			return identifierState
 		case ' ', '\t', '\r':
-			return spaceState(false)
+			return spaceState
 		case '\n':
-			return spaceState(true)
+			return newlineSpaceState
 		case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 			return numberState
 		case '"':
@@ -216,8 +229,15 @@ func numberState(l *lexer) stateFn {
 			l.emitValue(tokenType)
 
 		case '.':
-			l.scanFixedPointRemainder()
-			l.emitValue(TokenFixedPointNumberLiteral)
+			// A second dot starts a range operator, e.g. `0..<10`,
+			// so the number is an integer
+			if l.hasPrefix(".") {
+				l.backupOne()
+				l.emitValue(TokenDecimalIntegerLiteral)
+			} else {
+				l.scanFixedPointRemainder()
+				l.emitValue(TokenFixedPointNumberLiteral)
+			}
 
 		case EOF:
 			l.backupOne()
@@ -254,31 +274,21 @@ type Space struct {
 	ContainsNewline bool
 }
 
-func spaceState(startIsNewline bool) stateFn {
-	return func(l *lexer) stateFn {
-		containsNewline := l.scanSpace()
-		containsNewline = containsNewline || startIsNewline
-
-		if l.memoryGauge != nil {
-			// Meter token wrapper
-			common.UseMemory(l.memoryGauge, common.SpaceTokenMemoryUsage)
-
-			// Meter token content
-			tokenLength := l.wordLength()
-			common.UseMemory(l.memoryGauge, common.NewRawStringMemoryUsage(tokenLength))
-		}
+// spaceState scans space which does not start with a newline.
+//
+// NOTE: spaceState and newlineSpaceState are separate functions,
+// instead of a function returning a closure, to avoid an allocation for each space token
+//
+func spaceState(l *lexer) stateFn {
+	l.emitSpace(false)
+	return rootState
+}
 
-		l.emit(
-			TokenSpace,
-			Space{
-				String:          l.word(),
-				ContainsNewline: containsNewline,
-			},
-			l.startPosition(),
-			true,
-		)
-		return rootState
-	}
+// newlineSpaceState scans space which starts with a newline
+//
+func newlineSpaceState(l *lexer) stateFn {
+	l.emitSpace(true)
+	return rootState
 }
 
 func identifierState(l *lexer) stateFn {
@@ -301,8 +311,24 @@ func identifierState(l *lexer) stateFn {
 }
 
 func stringState(l *lexer) stateFn {
-	l.scanString('"')
-	l.emitValue(TokenString)
+	if l.scanString('"') {
+		l.emitValue(TokenStringTemplateHead)
+		l.startStringTemplateInterpolation()
+	} else {
+		l.emitValue(TokenString)
+	}
+	return rootState
+}
+
+// stringTemplateContinuationState scans the remainder of a string template
+// after an interpolation, starting with the closing parenthesis of the interpolation
+func stringTemplateContinuationState(l *lexer) stateFn {
+	if l.scanString('"') {
+		l.emitValue(TokenStringTemplateMiddle)
+		l.startStringTemplateInterpolation()
+	} else {
+		l.emitValue(TokenStringTemplateTail)
+	}
 	return rootState
 }
 
@@ -55,6 +55,8 @@ const (
 	TokenComma
 	TokenColon
 	TokenDot
+	TokenDotDotLess
+	TokenDotDotDot
 	TokenSemicolon
 	TokenLeftArrow
 	TokenLeftArrowExclamation
@@ -81,6 +83,9 @@ const (
 	TokenAsExclamationMark
 	TokenAsQuestionMark
 	TokenPragma
+	TokenStringTemplateHead
+	TokenStringTemplateMiddle
+	TokenStringTemplateTail
 	// NOTE: not an actual token, must be last item
 	TokenMax
 )
@@ -150,6 +155,10 @@ func (t TokenType) String() string {
 		return `':'`
 	case TokenDot:
 		return `'.'`
+	case TokenDotDotLess:
+		return `'..<'`
+	case TokenDotDotDot:
+		return `'...'`
 	case TokenSemicolon:
 		return `';'`
 	case TokenLeftArrow:
@@ -202,6 +211,12 @@ func (t TokenType) String() string {
 		return `'as?'`
 	case TokenPragma:
 		return `'#'`
+	case TokenStringTemplateHead:
+		return "start of string template"
+	case TokenStringTemplateMiddle:
+		return "string template continuation"
+	case TokenStringTemplateTail:
+		return "end of string template"
 	default:
 		panic(errors.NewUnreachableError())
 	}
@@ -87,6 +87,35 @@ func ParseTokenStream(
 ) (
 	result any,
 	errs []error,
+) {
+	return parseTokenStream(
+		memoryGauge,
+		tokens,
+		func(p *parser) (any, error) {
+			result, err := parse(p)
+			if err != nil {
+				return nil, err
+			}
+
+			if !p.current.Is(lexer.TokenEOF) {
+				p.reportSyntaxError("unexpected token: %s", p.current.Type)
+			}
+
+			return result, nil
+		},
+	)
+}
+
+// parseTokenStream uses the given `parse` function to parse the given tokens into a result.
+// Unlike ParseTokenStream, it does not require that all tokens are consumed.
+//
+func parseTokenStream(
+	memoryGauge common.MemoryGauge,
+	tokens lexer.TokenStream,
+	parse func(*parser) (any, error),
+) (
+	result any,
+	errs []error,
 ) {
 	p := &parser{
 		tokens:      tokens,
@@ -149,10 +178,6 @@ func ParseTokenStream(
 		return nil, p.errors
 	}
 
-	if !p.current.Is(lexer.TokenEOF) {
-		p.reportSyntaxError("unexpected token: %s", p.current.Type)
-	}
-
 	return result, p.errors
 }
 
@@ -623,6 +648,11 @@ func ParseProgramFromTokenStream(
 
 	program = ast.NewProgram(memoryGauge, declarations)
 
+	// Assign node IDs while the program is still exclusively owned by the parser,
+	// so later passes, e.g. the checker, never need to modify it
+
+	ast.AssignProgramNodeIDs(program, input.Input())
+
 	return
 }
 
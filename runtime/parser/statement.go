@@ -89,11 +89,24 @@ func parseStatement(p *parser) (ast.Statement, error) {
 		case keywordSwitch:
 			return parseSwitchStatement(p)
 		case keywordWhile:
-			return parseWhileStatement(p)
+			return parseWhileStatement(p, nil)
 		case keywordFor:
-			return parseForStatement(p)
+			return parseForStatement(p, nil)
 		case keywordEmit:
 			return parseEmitStatement(p)
+		case keywordRemove:
+			// The `remove` keyword is contextual: it only introduces a remove statement
+			// if it is followed by an identifier, i.e. the attachment type
+			isRemoveStatement, err := isNextTokenIdentifier(p)
+			if err != nil {
+				return nil, err
+			}
+			if isRemoveStatement {
+				return parseRemoveStatement(p)
+			}
+		case keywordLet, keywordVar:
+			// In a statement, a variable declaration may also destructure a tuple
+			return parseVariableDeclarationStatement(p)
 		case keywordFun:
 			// The `fun` keyword is ambiguous: it either introduces a function expression
 			// or a function declaration, depending on if an identifier follows, or not.
@@ -146,6 +159,17 @@ func parseStatement(p *parser) (ast.Statement, error) {
 
 		return ast.NewSwapStatement(p.memoryGauge, expression, right), nil
 
+	case lexer.TokenColon:
+		// If the expression is an identifier followed by a colon,
+		// it is actually the label of a loop statement
+
+		identifierExpression, ok := expression.(*ast.IdentifierExpression)
+		if !ok {
+			return ast.NewExpressionStatement(p.memoryGauge, expression), nil
+		}
+
+		return parseLabeledStatement(p, identifierExpression.Identifier)
+
 	default:
 		return ast.NewExpressionStatement(p.memoryGauge, expression), nil
 	}
@@ -240,14 +264,66 @@ func parseBreakStatement(p *parser) *ast.BreakStatement {
 	tokenRange := p.current.Range
 	p.next()
 
-	return ast.NewBreakStatement(p.memoryGauge, tokenRange)
+	label := parseControlStatementLabel(p)
+	if label != nil {
+		tokenRange.EndPos = label.EndPosition(p.memoryGauge)
+	}
+
+	return ast.NewBreakStatement(p.memoryGauge, label, tokenRange)
 }
 
 func parseContinueStatement(p *parser) *ast.ContinueStatement {
 	tokenRange := p.current.Range
 	p.next()
 
-	return ast.NewContinueStatement(p.memoryGauge, tokenRange)
+	label := parseControlStatementLabel(p)
+	if label != nil {
+		tokenRange.EndPos = label.EndPosition(p.memoryGauge)
+	}
+
+	return ast.NewContinueStatement(p.memoryGauge, label, tokenRange)
+}
+
+// parseControlStatementLabel parses the optional label of a break or continue statement.
+// The label must be on the same line as the keyword.
+//
+func parseControlStatementLabel(p *parser) *ast.Identifier {
+	sawNewLine := p.skipSpaceAndComments(false)
+	if sawNewLine || !p.current.Is(lexer.TokenIdentifier) {
+		return nil
+	}
+
+	label := p.tokenToIdentifier(p.current)
+	p.next()
+
+	return &label
+}
+
+// parseLabeledStatement parses a loop statement with a label.
+//
+//     labeledStatement : identifier ':' ( whileStatement | forStatement )
+//
+func parseLabeledStatement(p *parser, label ast.Identifier) (ast.Statement, error) {
+
+	// Skip the colon
+	p.next()
+
+	p.skipSpaceAndComments(true)
+
+	if p.current.Is(lexer.TokenIdentifier) {
+		switch p.current.Value {
+		case keywordWhile:
+			return parseWhileStatement(p, &label)
+		case keywordFor:
+			return parseForStatement(p, &label)
+		}
+	}
+
+	return nil, p.syntaxError(
+		"expected while-statement or for-statement after label %q, got %s",
+		label.Identifier,
+		p.current.Type,
+	)
 }
 
 func parseIfStatement(p *parser) (*ast.IfStatement, error) {
@@ -353,9 +429,12 @@ func parseIfStatement(p *parser) (*ast.IfStatement, error) {
 	return result, nil
 }
 
-func parseWhileStatement(p *parser) (*ast.WhileStatement, error) {
+func parseWhileStatement(p *parser, label *ast.Identifier) (*ast.WhileStatement, error) {
 
 	startPos := p.current.StartPos
+	if label != nil {
+		startPos = label.Pos
+	}
 	p.next()
 
 	expression, err := parseExpression(p, lowestBindingPower)
@@ -368,12 +447,15 @@ func parseWhileStatement(p *parser) (*ast.WhileStatement, error) {
 		return nil, err
 	}
 
-	return ast.NewWhileStatement(p.memoryGauge, expression, block, startPos), nil
+	return ast.NewWhileStatement(p.memoryGauge, label, expression, block, startPos), nil
 }
 
-func parseForStatement(p *parser) (*ast.ForStatement, error) {
+func parseForStatement(p *parser, label *ast.Identifier) (*ast.ForStatement, error) {
 
 	startPos := p.current.StartPos
+	if label != nil {
+		startPos = label.Pos
+	}
 	p.next()
 
 	p.skipSpaceAndComments(true)
@@ -432,6 +514,7 @@ func parseForStatement(p *parser) (*ast.ForStatement, error) {
 
 	return ast.NewForStatement(
 		p.memoryGauge,
+		label,
 		identifier,
 		index,
 		block,
@@ -610,6 +693,61 @@ func parseEmitStatement(p *parser) (*ast.EmitStatement, error) {
 	return ast.NewEmitStatement(p.memoryGauge, invocation, startPos), nil
 }
 
+// isNextTokenIdentifier checks whether the token following the current token is an identifier.
+func isNextTokenIdentifier(p *parser) (b bool, err error) {
+	p.startBuffering()
+	defer func() {
+		err = p.replayBuffered()
+	}()
+
+	// skip the current token
+	p.next()
+	p.skipSpaceAndComments(true)
+
+	// Lookahead the next token
+	return p.current.Is(lexer.TokenIdentifier), nil
+}
+
+// parseRemoveStatement parses a remove statement.
+//
+//     removeStatement : 'remove' nominalType 'from' expression
+//
+func parseRemoveStatement(p *parser) (*ast.RemoveStatement, error) {
+	startPos := p.current.StartPos
+
+	// Skip the `remove` keyword
+	p.next()
+
+	p.skipSpaceAndComments(true)
+	attachmentToken, err := p.mustOne(lexer.TokenIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment, err := parseNominalTypeRemainder(p, attachmentToken)
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpaceAndComments(true)
+	_, err = p.mustOneString(lexer.TokenIdentifier, keywordFrom)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := parseExpression(p, lowestBindingPower)
+	if err != nil {
+		return nil, err
+	}
+
+	return ast.NewRemoveStatement(
+		p.memoryGauge,
+		attachment,
+		value,
+		startPos,
+	), nil
+}
+
 func parseSwitchStatement(p *parser) (*ast.SwitchStatement, error) {
 
 	startPos := p.current.StartPos
@@ -702,7 +840,7 @@ func parseSwitchCases(p *parser) (cases []*ast.SwitchCase, err error) {
 // parseSwitchCase parses a switch case (hasExpression == true)
 // or default case (hasExpression == false)
 //
-//     switchCase : `case` expression `:` statements
+//     switchCase : `case` ( expression | optionalBindingPattern ) `:` statements
 //                | `default` `:` statements
 //
 func parseSwitchCase(p *parser, hasExpression bool) (*ast.SwitchCase, error) {
@@ -713,17 +851,24 @@ func parseSwitchCase(p *parser, hasExpression bool) (*ast.SwitchCase, error) {
 	p.next()
 
 	var expression ast.Expression
+	var pattern *ast.OptionalBindingPattern
 	var err error
 
 	if hasExpression {
-		expression, err = parseExpression(p, lowestBindingPower)
+		p.skipSpaceAndComments(true)
+
+		if p.current.IsString(lexer.TokenIdentifier, keywordLet) {
+			pattern, err = parseOptionalBindingPattern(p)
+		} else {
+			expression, err = parseExpression(p, lowestBindingPower)
+		}
 		if err != nil {
 			return nil, err
 		}
-	} else {
-		p.skipSpaceAndComments(true)
 	}
 
+	p.skipSpaceAndComments(true)
+
 	colonPos := p.current.StartPos
 
 	if !p.current.Is(lexer.TokenColon) {
@@ -766,6 +911,7 @@ func parseSwitchCase(p *parser, hasExpression bool) (*ast.SwitchCase, error) {
 
 	return &ast.SwitchCase{
 		Expression: expression,
+		Pattern:    pattern,
 		Statements: statements,
 		Range: ast.NewRange(
 			p.memoryGauge,
@@ -774,3 +920,50 @@ func parseSwitchCase(p *parser, hasExpression bool) (*ast.SwitchCase, error) {
 		),
 	}, nil
 }
+
+// parseOptionalBindingPattern parses an optional binding pattern of a switch case.
+//
+//     optionalBindingPattern : `let` identifier `?`
+//
+func parseOptionalBindingPattern(p *parser) (*ast.OptionalBindingPattern, error) {
+
+	startPos := p.current.StartPos
+
+	// Skip the `let` keyword
+	p.next()
+	p.skipSpaceAndComments(true)
+
+	if !p.current.Is(lexer.TokenIdentifier) {
+		return nil, p.syntaxError(
+			"expected identifier after start of optional binding pattern, got %s",
+			p.current.Type,
+		)
+	}
+
+	identifier := p.tokenToIdentifier(p.current)
+
+	p.next()
+	p.skipSpaceAndComments(true)
+
+	if !p.current.Is(lexer.TokenQuestionMark) {
+		return nil, p.syntaxError(
+			"expected %s after identifier of optional binding pattern, got %s",
+			lexer.TokenQuestionMark,
+			p.current.Type,
+		)
+	}
+
+	endPos := p.current.EndPos
+
+	p.next()
+
+	return ast.NewOptionalBindingPattern(
+		p.memoryGauge,
+		identifier,
+		ast.NewRange(
+			p.memoryGauge,
+			startPos,
+			endPos,
+		),
+	), nil
+}
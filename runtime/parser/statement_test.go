@@ -607,6 +607,89 @@ func TestParseWhileStatement(t *testing.T) {
 			result,
 		)
 	})
+
+	t.Run("labeled, with labeled break", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("outer: while true { break outer }", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.WhileStatement{
+					Label: &ast.Identifier{
+						Identifier: "outer",
+						Pos:        ast.Position{Line: 1, Column: 0, Offset: 0},
+					},
+					Test: &ast.BoolExpression{
+						Value: true,
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 13, Offset: 13},
+							EndPos:   ast.Position{Line: 1, Column: 16, Offset: 16},
+						},
+					},
+					Block: &ast.Block{
+						Statements: []ast.Statement{
+							&ast.BreakStatement{
+								Label: &ast.Identifier{
+									Identifier: "outer",
+									Pos:        ast.Position{Line: 1, Column: 26, Offset: 26},
+								},
+								Range: ast.Range{
+									StartPos: ast.Position{Line: 1, Column: 20, Offset: 20},
+									EndPos:   ast.Position{Line: 1, Column: 30, Offset: 30},
+								},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 18, Offset: 18},
+							EndPos:   ast.Position{Line: 1, Column: 32, Offset: 32},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("break label on next line", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("while true { break\n outer }", nil)
+		require.Empty(t, errs)
+
+		require.Len(t, result, 1)
+		whileStatement := result[0].(*ast.WhileStatement)
+		require.Len(t, whileStatement.Block.Statements, 2)
+
+		breakStatement := whileStatement.Block.Statements[0].(*ast.BreakStatement)
+		assert.Nil(t, breakStatement.Label)
+
+		assert.IsType(t,
+			&ast.ExpressionStatement{},
+			whileStatement.Block.Statements[1],
+		)
+	})
+
+	t.Run("label without loop", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseStatements("outer: 1", nil)
+
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected while-statement or for-statement after label \"outer\", got decimal integer",
+					Pos:     ast.Position{Line: 1, Column: 7, Offset: 7},
+				},
+			},
+			errs,
+		)
+	})
 }
 
 func TestParseAssignmentStatement(t *testing.T) {
@@ -824,6 +907,55 @@ func TestParseForStatement(t *testing.T) {
 			result,
 		)
 	})
+
+	t.Run("labeled, with labeled continue", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("outer: for x in xs { continue outer }", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.ForStatement{
+					Label: &ast.Identifier{
+						Identifier: "outer",
+						Pos:        ast.Position{Line: 1, Column: 0, Offset: 0},
+					},
+					Identifier: ast.Identifier{
+						Identifier: "x",
+						Pos:        ast.Position{Line: 1, Column: 11, Offset: 11},
+					},
+					Value: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "xs",
+							Pos:        ast.Position{Line: 1, Column: 16, Offset: 16},
+						},
+					},
+					Block: &ast.Block{
+						Statements: []ast.Statement{
+							&ast.ContinueStatement{
+								Label: &ast.Identifier{
+									Identifier: "outer",
+									Pos:        ast.Position{Line: 1, Column: 30, Offset: 30},
+								},
+								Range: ast.Range{
+									StartPos: ast.Position{Line: 1, Column: 21, Offset: 21},
+									EndPos:   ast.Position{Line: 1, Column: 34, Offset: 34},
+								},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 19, Offset: 19},
+							EndPos:   ast.Position{Line: 1, Column: 36, Offset: 36},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
 }
 
 func TestParseForStatementIndexBinding(t *testing.T) {
@@ -1243,6 +1375,92 @@ func TestParseSwitchStatement(t *testing.T) {
 			result,
 		)
 	})
+
+	t.Run("optional binding pattern", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("switch x { case let y?: y }", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.SwitchStatement{
+					Expression: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "x",
+							Pos:        ast.Position{Line: 1, Column: 7, Offset: 7},
+						},
+					},
+					Cases: []*ast.SwitchCase{
+						{
+							Pattern: &ast.OptionalBindingPattern{
+								Identifier: ast.Identifier{
+									Identifier: "y",
+									Pos:        ast.Position{Line: 1, Column: 20, Offset: 20},
+								},
+								Range: ast.Range{
+									StartPos: ast.Position{Line: 1, Column: 16, Offset: 16},
+									EndPos:   ast.Position{Line: 1, Column: 21, Offset: 21},
+								},
+							},
+							Statements: []ast.Statement{
+								&ast.ExpressionStatement{
+									Expression: &ast.IdentifierExpression{
+										Identifier: ast.Identifier{
+											Identifier: "y",
+											Pos:        ast.Position{Line: 1, Column: 24, Offset: 24},
+										},
+									},
+								},
+							},
+							Range: ast.Range{
+								StartPos: ast.Position{Line: 1, Column: 11, Offset: 11},
+								EndPos:   ast.Position{Line: 1, Column: 24, Offset: 24},
+							},
+						},
+					},
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 26, Offset: 26},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("optional binding pattern, missing identifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseStatements("switch x { case let 1: }", nil)
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected identifier after start of optional binding pattern, got decimal integer",
+					Pos:     ast.Position{Offset: 20, Line: 1, Column: 20},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("optional binding pattern, missing question mark", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseStatements("switch x { case let y: }", nil)
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected '?' after identifier of optional binding pattern, got ':'",
+					Pos:     ast.Position{Offset: 21, Line: 1, Column: 21},
+				},
+			},
+			errs,
+		)
+	})
 }
 
 func TestParseIfStatementInFunctionDeclaration(t *testing.T) {
@@ -1261,7 +1479,7 @@ func TestParseIfStatementInFunctionDeclaration(t *testing.T) {
             }
         }
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -1407,7 +1625,7 @@ func TestParseIfStatementWithVariableDeclaration(t *testing.T) {
             }
         }
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	ifStatement := &ast.IfStatement{
@@ -1526,7 +1744,7 @@ func TestParseIfStatementNoElse(t *testing.T) {
             }
         }
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -1607,7 +1825,7 @@ func TestParseWhileStatementInFunctionDeclaration(t *testing.T) {
             }
         }
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -1696,7 +1914,7 @@ func TestParseForStatementInFunctionDeclaration(t *testing.T) {
             for x in xs {}
         }
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -1767,7 +1985,7 @@ func TestParseAssignment(t *testing.T) {
             a = 1
         }
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -1840,7 +2058,7 @@ func TestParseAccessAssignment(t *testing.T) {
             x.foo.bar[0][1].baz = 1
         }
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -1962,7 +2180,7 @@ func TestParseExpressionStatementWithAccess(t *testing.T) {
 	const code = `
 	    fun test() { x.foo.bar[0][1].baz }
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -2073,7 +2291,7 @@ func TestParseMoveStatement(t *testing.T) {
             x <- y
         }
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -2144,7 +2362,7 @@ func TestParseFunctionExpressionStatementAfterVariableDeclarationWithCreateExpre
           (fun () {})()
       }
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -2267,7 +2485,7 @@ func TestParseExpressionStatementAfterReturnStatement(t *testing.T) {
           destroy x
       }
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -2338,7 +2556,7 @@ func TestParseSwapStatementInFunctionDeclaration(t *testing.T) {
           foo[0] <-> bar.baz
       }
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -2494,3 +2712,125 @@ func TestParseReferenceExpressionStatement(t *testing.T) {
 		result,
 	)
 }
+
+func TestParseRemove(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("simple", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("remove A from r", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.RemoveStatement{
+					Attachment: &ast.NominalType{
+						Identifier: ast.Identifier{
+							Identifier: "A",
+							Pos:        ast.Position{Line: 1, Column: 7, Offset: 7},
+						},
+					},
+					Value: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "r",
+							Pos:        ast.Position{Line: 1, Column: 14, Offset: 14},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("identifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("remove(1)", nil)
+		require.Empty(t, errs)
+
+		require.Len(t, result, 1)
+		require.IsType(t, &ast.ExpressionStatement{}, result[0])
+		require.IsType(t, &ast.InvocationExpression{}, result[0].(*ast.ExpressionStatement).Expression)
+	})
+}
+
+func TestParseTupleVariableDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("let, move", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("let (a, b) <- t", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.TupleVariableDeclaration{
+					IsConstant: true,
+					Identifiers: []ast.Identifier{
+						{
+							Identifier: "a",
+							Pos:        ast.Position{Line: 1, Column: 5, Offset: 5},
+						},
+						{
+							Identifier: "b",
+							Pos:        ast.Position{Line: 1, Column: 8, Offset: 8},
+						},
+					},
+					Value: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "t",
+							Pos:        ast.Position{Line: 1, Column: 14, Offset: 14},
+						},
+					},
+					Transfer: &ast.Transfer{
+						Operation: ast.TransferOperationMove,
+						Pos:       ast.Position{Line: 1, Column: 11, Offset: 11},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("var, type annotation", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("var (a, b): (Int, Bool) = (1, true)", nil)
+		require.Empty(t, errs)
+
+		require.Len(t, result, 1)
+		require.IsType(t, &ast.TupleVariableDeclaration{}, result[0])
+
+		declaration := result[0].(*ast.TupleVariableDeclaration)
+		require.False(t, declaration.IsConstant)
+		require.Len(t, declaration.Identifiers, 2)
+		require.IsType(t, &ast.TupleType{}, declaration.TypeAnnotation.Type)
+		require.IsType(t, &ast.TupleExpression{}, declaration.Value)
+	})
+
+	t.Run("single identifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseStatements("let (a) = t", nil)
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "tuple variable declarations must declare at least two variables",
+					Pos:     ast.Position{Offset: 7, Line: 1, Column: 7},
+				},
+			},
+			errs,
+		)
+	})
+}
@@ -0,0 +1,231 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parser
+
+import (
+	"io"
+	"strings"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/parser/lexer"
+)
+
+// StreamingParser parses a program incrementally, declaration by declaration.
+//
+// The source code of the program is written to the parser in chunks.
+// Each top-level declaration is passed to the declaration handler as soon as it is complete,
+// after which its source code and tokens are released.
+// Close must be called after the last chunk has been written.
+//
+// Only top-level declarations are parsed incrementally,
+// i.e. the source code of a single declaration is held in memory until it is complete.
+//
+type StreamingParser struct {
+	memoryGauge       common.MemoryGauge
+	handleDeclaration func(ast.Declaration) error
+	// buffer is the source code which has not been parsed into declarations yet
+	buffer []byte
+	// position is the position of the start of the buffer in the program
+	position ast.Position
+	// nextParseLength is the length the buffer must reach before parsing is attempted again.
+	// It avoids re-parsing an incomplete declaration for every written chunk
+	nextParseLength int
+	closed          bool
+}
+
+var _ io.WriteCloser = &StreamingParser{}
+
+// NewStreamingParser returns a new streaming parser,
+// which calls the given handler for each parsed top-level declaration
+//
+func NewStreamingParser(
+	memoryGauge common.MemoryGauge,
+	handleDeclaration func(ast.Declaration) error,
+) *StreamingParser {
+	return &StreamingParser{
+		memoryGauge:       memoryGauge,
+		handleDeclaration: handleDeclaration,
+		position: ast.Position{
+			Offset: 0,
+			Line:   1,
+			Column: 0,
+		},
+	}
+}
+
+// Write adds the given chunk of source code,
+// and parses all declarations which are complete.
+//
+// The returned error is a parser Error if the source code is invalid,
+// or the error returned by the declaration handler
+//
+func (p *StreamingParser) Write(chunk []byte) (int, error) {
+	if p.closed {
+		return 0, errors.NewDefaultUserError("cannot write to closed parser")
+	}
+
+	p.buffer = append(p.buffer, chunk...)
+
+	if len(p.buffer) < p.nextParseLength {
+		return len(chunk), nil
+	}
+
+	return len(chunk), p.parse(false)
+}
+
+// Close parses the remaining source code
+//
+func (p *StreamingParser) Close() error {
+	if p.closed {
+		return nil
+	}
+	p.closed = true
+
+	return p.parse(true)
+}
+
+// parse parses the declarations in the buffer.
+//
+// Unless the end of the program has been reached,
+// a declaration is only considered complete if the parser did not reach the end of the buffer,
+// as more source code might continue the declaration, or change how it is parsed
+//
+func (p *StreamingParser) parse(final bool) error {
+
+	code := string(p.buffer)
+
+	lexerTokens := lexer.Lex(code, p.memoryGauge)
+	defer lexerTokens.Reclaim()
+
+	tokens := &streamingTokenStream{
+		TokenStream: lexerTokens,
+		position:    p.position,
+	}
+
+	var consumed int
+	nextPosition := p.position
+	var handlerErr error
+
+	_, errs := parseTokenStream(
+		p.memoryGauge,
+		tokens,
+		func(parser *parser) (any, error) {
+			for {
+				_, docString := parser.parseTrivia(triviaOptions{
+					skipNewlines:    true,
+					parseDocStrings: true,
+				})
+
+				switch parser.current.Type {
+				case lexer.TokenSemicolon:
+					// Skip the semicolon
+					parser.next()
+					continue
+
+				case lexer.TokenEOF:
+					if final {
+						consumed = len(code)
+					}
+					return nil, nil
+				}
+
+				declaration, err := parseDeclaration(parser, docString)
+				if err != nil {
+					return nil, err
+				}
+
+				if declaration == nil ||
+					len(parser.errors) > 0 ||
+					(tokens.reachedEOF && !final) {
+
+					return nil, nil
+				}
+
+				err = p.handleDeclaration(declaration)
+				if err != nil {
+					handlerErr = err
+					return nil, nil
+				}
+
+				endPos := declaration.EndPosition(p.memoryGauge)
+				nextPosition = ast.Position{
+					Offset: endPos.Offset + 1,
+					Line:   endPos.Line,
+					Column: endPos.Column + 1,
+				}
+				consumed = nextPosition.Offset - p.position.Offset
+			}
+		},
+	)
+
+	if handlerErr != nil {
+		return handlerErr
+	}
+
+	if len(errs) > 0 && (final || !tokens.reachedEOF) {
+		return Error{
+			// Indent the remaining code, so the positions of the errors match
+			Code: strings.Repeat("\n", p.position.Line-1) +
+				strings.Repeat(" ", p.position.Column) +
+				code,
+			Errors: errs,
+		}
+	}
+
+	p.buffer = append(p.buffer[:0], p.buffer[consumed:]...)
+	p.position = nextPosition
+	p.nextParseLength = 2 * len(p.buffer)
+
+	return nil
+}
+
+// streamingTokenStream is a token stream of a part of a program.
+// It adjusts the positions of the tokens to be relative to the whole program,
+// and records if the end of the stream was reached
+//
+type streamingTokenStream struct {
+	lexer.TokenStream
+	// position is the position of the start of the stream in the program
+	position   ast.Position
+	reachedEOF bool
+}
+
+func (s *streamingTokenStream) Next() lexer.Token {
+	token := s.TokenStream.Next()
+
+	if token.Is(lexer.TokenEOF) {
+		s.reachedEOF = true
+	}
+
+	token.StartPos = s.adjustPosition(token.StartPos)
+	token.EndPos = s.adjustPosition(token.EndPos)
+
+	return token
+}
+
+func (s *streamingTokenStream) adjustPosition(pos ast.Position) ast.Position {
+	if pos.Line == 1 {
+		pos.Column += s.position.Column
+	}
+	pos.Line += s.position.Line - 1
+	pos.Offset += s.position.Offset
+	return pos
+}
@@ -0,0 +1,174 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parser
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func parseStreaming(code string, chunkSize int) (declarations []ast.Declaration, err error) {
+	parser := NewStreamingParser(
+		nil,
+		func(declaration ast.Declaration) error {
+			declarations = append(declarations, declaration)
+			return nil
+		},
+	)
+
+	for start := 0; start < len(code); start += chunkSize {
+		end := start + chunkSize
+		if end > len(code) {
+			end = len(code)
+		}
+
+		_, err = parser.Write([]byte(code[start:end]))
+		if err != nil {
+			return
+		}
+	}
+
+	err = parser.Close()
+	return
+}
+
+func TestParseStreaming(t *testing.T) {
+
+	t.Parallel()
+
+	const code = `
+      /// The answer
+      pub let answer = 4
+          + 2;
+
+      pub fun test(): String {
+          return "héllo"
+      }
+
+      pub struct S {
+          pub let x: Int
+
+          init() {
+              self.x = 1
+          }
+      }
+
+      pub let a = answer ; pub let b = answer
+
+      pub resource R {}
+      pub fun createR(): @R { return <-create R() }
+    `
+
+	program, err := parseProgramWithoutNodeIDs(code)
+	require.NoError(t, err)
+
+	for _, chunkSize := range []int{1, 2, 3, 7, 16, 64, len(code)} {
+
+		declarations, err := parseStreaming(code, chunkSize)
+		require.NoError(t, err)
+
+		utils.AssertEqualWithDiff(t, program.Declarations(), declarations)
+	}
+}
+
+func TestParseStreamingHandler(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("declarations are handled before the end", func(t *testing.T) {
+
+		t.Parallel()
+
+		var identifiers []string
+
+		parser := NewStreamingParser(
+			nil,
+			func(declaration ast.Declaration) error {
+				identifiers = append(identifiers, declaration.DeclarationIdentifier().Identifier)
+				return nil
+			},
+		)
+
+		_, err := parser.Write([]byte("pub fun a() {}\npub fun b() {}\npub fun c("))
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"a", "b"}, identifiers)
+
+		_, err = parser.Write([]byte(") {}"))
+		require.NoError(t, err)
+
+		err = parser.Close()
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"a", "b", "c"}, identifiers)
+
+		_, err = parser.Write([]byte("pub fun d() {}"))
+		require.Error(t, err)
+	})
+
+	t.Run("handler error", func(t *testing.T) {
+
+		t.Parallel()
+
+		handlerErr := errors.New("test")
+
+		parser := NewStreamingParser(
+			nil,
+			func(declaration ast.Declaration) error {
+				return handlerErr
+			},
+		)
+
+		_, err := parser.Write([]byte("pub fun a() {}\n"))
+		require.ErrorIs(t, err, handlerErr)
+	})
+}
+
+func TestParseStreamingInvalid(t *testing.T) {
+
+	t.Parallel()
+
+	const code = `
+      pub fun a() {}
+
+      pub fun b() {
+          let x = )
+      }
+    `
+
+	declarations, err := parseStreaming(code, 5)
+	require.Error(t, err)
+
+	require.Len(t, declarations, 1)
+
+	var parserErr Error
+	require.ErrorAs(t, err, &parserErr)
+
+	_, expectedErr := ParseProgram(code, nil)
+	require.Error(t, expectedErr)
+
+	assert.Equal(t, expectedErr.(Error).Errors, parserErr.Errors)
+	assert.Equal(t, expectedErr.Error(), err.Error())
+}
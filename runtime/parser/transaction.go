@@ -54,7 +54,7 @@ func parseTransactionDeclaration(p *parser, docString string) (*ast.TransactionD
 	var err error
 
 	if p.current.Is(lexer.TokenParenOpen) {
-		parameterList, err = parseParameterList(p)
+		parameterList, err = parseParameterList(p, false)
 		if err != nil {
 			return nil, err
 		}
@@ -86,7 +86,15 @@ func parseTransactionDeclaration(p *parser, docString string) (*ast.TransactionD
 			identifier := p.tokenToIdentifier(p.current)
 			// Skip the `prepare` keyword
 			p.next()
-			prepare, err = parseSpecialFunctionDeclaration(p, false, ast.AccessNotSpecified, nil, identifier)
+			prepare, err = parseSpecialFunctionDeclaration(
+				p,
+				false,
+				ast.AccessNotSpecified,
+				nil,
+				ast.FunctionPurityUnspecified,
+				nil,
+				identifier,
+			)
 			if err != nil {
 				return nil, err
 			}
@@ -148,7 +148,7 @@ func init() {
 	defineOptionalType()
 	defineReferenceType()
 	defineRestrictedOrDictionaryType()
-	defineFunctionType()
+	defineFunctionTypeAndTupleType()
 	defineInstantiationType()
 
 	setTypeNullDenotation(
@@ -628,22 +628,63 @@ func parseNominalTypes(
 	return
 }
 
-func defineFunctionType() {
+// defineFunctionTypeAndTupleType defines the type null denotation for the open paren token `(`.
+//
+// It is either a function type, e.g. `((Int): String)`,
+// or a tuple type, e.g. `(Int, String)`.
+//
+//     functionType : '(' '(' ( typeAnnotation ( ',' typeAnnotation )* )? ')' ':' typeAnnotation ')'
+//
+//     tupleType : '(' type ( ',' type )+ ')'
+//
+func defineFunctionTypeAndTupleType() {
 	setTypeNullDenotation(
 		lexer.TokenParenOpen,
 		func(p *parser, startToken lexer.Token) (ast.Type, error) {
 
-			parameterTypeAnnotations, err := parseParameterTypeAnnotations(p)
+			p.skipSpaceAndComments(true)
+
+			if !p.current.Is(lexer.TokenParenOpen) {
+				return parseTupleTypeRemainder(p, startToken.StartPos, nil)
+			}
+
+			// The parenthesized list is either the parameter list of a function type,
+			// or, if it is not followed by a colon, a tuple type which is the first element of a tuple type
+
+			listStartPos := p.current.StartPos
+
+			parameterTypeAnnotations, listEndPos, err := parseParameterTypeAnnotations(p)
 			if err != nil {
 				return nil, err
 			}
 
 			p.skipSpaceAndComments(true)
-			_, err = p.mustOne(lexer.TokenColon)
-			if err != nil {
-				return nil, err
+
+			if !p.current.Is(lexer.TokenColon) {
+
+				firstElementType, err := newTupleTypeFromTypeAnnotations(
+					p,
+					parameterTypeAnnotations,
+					ast.NewRange(
+						p.memoryGauge,
+						listStartPos,
+						listEndPos,
+					),
+				)
+				if err != nil {
+					return nil, err
+				}
+
+				return parseTupleTypeRemainder(
+					p,
+					startToken.StartPos,
+					[]ast.Type{firstElementType},
+				)
 			}
 
+			// Skip the colon
+			p.next()
+
 			p.skipSpaceAndComments(true)
 			returnTypeAnnotation, err := parseTypeAnnotation(p)
 			if err != nil {
@@ -670,7 +711,121 @@ func defineFunctionType() {
 	)
 }
 
-func parseParameterTypeAnnotations(p *parser) (typeAnnotations []*ast.TypeAnnotation, err error) {
+// newTupleTypeFromTypeAnnotations returns the tuple type for a parenthesized list of type annotations,
+// which was parsed speculatively as the parameter list of a function type.
+//
+func newTupleTypeFromTypeAnnotations(
+	p *parser,
+	typeAnnotations []*ast.TypeAnnotation,
+	tupleRange ast.Range,
+) (*ast.TupleType, error) {
+
+	// A single parenthesized type is neither a valid tuple type, nor a valid parameter list
+	// (without a following colon): Report the missing colon of the function type
+
+	if len(typeAnnotations) < 2 {
+		return nil, p.syntaxError("expected token %s", lexer.TokenColon)
+	}
+
+	elementTypes := make([]ast.Type, len(typeAnnotations))
+
+	for i, typeAnnotation := range typeAnnotations {
+		if typeAnnotation.IsResource {
+			return nil, NewSyntaxError(
+				typeAnnotation.StartPos,
+				"invalid resource annotation for tuple element type: annotate the tuple type instead",
+			)
+		}
+		elementTypes[i] = typeAnnotation.Type
+	}
+
+	return ast.NewTupleType(p.memoryGauge, elementTypes, tupleRange), nil
+}
+
+// parseTupleTypeRemainder parses the remaining element types of a tuple type,
+// and the closing paren.
+//
+// The given element types are the already parsed leading element types.
+//
+func parseTupleTypeRemainder(
+	p *parser,
+	startPos ast.Position,
+	elementTypes []ast.Type,
+) (*ast.TupleType, error) {
+
+	expectType := len(elementTypes) == 0
+
+	atEnd := false
+	for !atEnd {
+		p.skipSpaceAndComments(true)
+
+		switch p.current.Type {
+		case lexer.TokenComma:
+			if expectType {
+				return nil, p.syntaxError("unexpected comma in tuple type")
+			}
+			// Skip the comma
+			p.next()
+			expectType = true
+
+		case lexer.TokenParenClose:
+			if expectType {
+				return nil, p.syntaxError("expected type, got %s", p.current.Type)
+			}
+			atEnd = true
+
+		case lexer.TokenEOF:
+			return nil, p.syntaxError(
+				"missing %s at end of tuple type",
+				lexer.TokenParenClose,
+			)
+
+		default:
+			if !expectType {
+				return nil, p.syntaxError(
+					"unexpected token: got %s, expected %s or %s",
+					p.current.Type,
+					lexer.TokenComma,
+					lexer.TokenParenClose,
+				)
+			}
+
+			elementType, err := parseType(p, lowestBindingPower)
+			if err != nil {
+				return nil, err
+			}
+
+			elementTypes = append(elementTypes, elementType)
+
+			expectType = false
+		}
+	}
+
+	endPos := p.current.EndPos
+
+	if len(elementTypes) < 2 {
+		return nil, p.syntaxError("tuple types must have at least two element types")
+	}
+
+	// Skip the closing paren
+	p.next()
+
+	return ast.NewTupleType(
+		p.memoryGauge,
+		elementTypes,
+		ast.NewRange(
+			p.memoryGauge,
+			startPos,
+			endPos,
+		),
+	), nil
+}
+
+func parseParameterTypeAnnotations(p *parser) (
+	typeAnnotations []*ast.TypeAnnotation,
+	endPos ast.Position,
+	err error,
+) {
 
 	p.skipSpaceAndComments(true)
 	_, err = p.mustOne(lexer.TokenParenOpen)
@@ -686,7 +841,7 @@ func parseParameterTypeAnnotations(p *parser) (typeAnnotations []*ast.TypeAnnota
 		switch p.current.Type {
 		case lexer.TokenComma:
 			if expectTypeAnnotation {
-				return nil, p.syntaxError(
+				return nil, ast.EmptyPosition, p.syntaxError(
 					"expected type annotation or end of list, got %q",
 					p.current.Type,
 				)
@@ -696,19 +851,20 @@ func parseParameterTypeAnnotations(p *parser) (typeAnnotations []*ast.TypeAnnota
 			expectTypeAnnotation = true
 
 		case lexer.TokenParenClose:
+			endPos = p.current.EndPos
 			// Skip the closing paren
 			p.next()
 			atEnd = true
 
 		case lexer.TokenEOF:
-			return nil, p.syntaxError(
+			return nil, ast.EmptyPosition, p.syntaxError(
 				"missing %q at end of list",
 				lexer.TokenParenClose,
 			)
 
 		default:
 			if !expectTypeAnnotation {
-				return nil, p.syntaxError(
+				return nil, ast.EmptyPosition, p.syntaxError(
 					"expected comma or end of list, got %q",
 					p.current.Type,
 				)
@@ -716,7 +872,7 @@ func parseParameterTypeAnnotations(p *parser) (typeAnnotations []*ast.TypeAnnota
 
 			typeAnnotation, err := parseTypeAnnotation(p)
 			if err != nil {
-				return nil, err
+				return nil, ast.EmptyPosition, err
 			}
 
 			typeAnnotations = append(typeAnnotations, typeAnnotation)
@@ -867,6 +1023,30 @@ func parseNominalTypeInvocationRemainder(p *parser) (*ast.InvocationExpression,
 	}
 
 	p.skipSpaceAndComments(true)
+
+	// Parse the optional type arguments, e.g. `create Box<Int>()`.
+	// Unlike in other invocations, the less token `<` is not ambiguous here
+
+	var typeArguments []*ast.TypeAnnotation
+
+	if p.current.Is(lexer.TokenLess) {
+		// Skip the `<` token
+		p.next()
+		p.skipSpaceAndComments(true)
+
+		typeArguments, err = parseCommaSeparatedTypeAnnotations(p, lexer.TokenGreater)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = p.mustOne(lexer.TokenGreater)
+		if err != nil {
+			return nil, err
+		}
+
+		p.skipSpaceAndComments(true)
+	}
+
 	parenOpenToken, err := p.mustOne(lexer.TokenParenOpen)
 	if err != nil {
 		return nil, err
@@ -896,7 +1076,7 @@ func parseNominalTypeInvocationRemainder(p *parser) (*ast.InvocationExpression,
 	return ast.NewInvocationExpression(
 		p.memoryGauge,
 		invokedExpression,
-		nil,
+		typeArguments,
 		arguments,
 		argumentsStartPos,
 		endPos,
@@ -1327,7 +1327,7 @@ func TestParseParametersAndArrayTypes(t *testing.T) {
 	const code = `
 		pub fun test(a: Int32, b: [Int32; 2], c: [[Int32; 3]]): [[Int64]] {}
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -1485,7 +1485,7 @@ func TestParseDictionaryTypeInVariableDeclaration(t *testing.T) {
 	const code = `
 	    let x: {String: Int} = {}
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -1548,7 +1548,7 @@ func TestParseIntegerTypes(t *testing.T) {
 		let g: UInt32 = 7
 		let h: UInt64 = 8
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	a := &ast.VariableDeclaration{
@@ -1814,7 +1814,7 @@ func TestParseFunctionTypeInVariableDeclaration(t *testing.T) {
 	const code = `
 		let add: ((Int8, Int16): Int32) = nothing
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -1891,7 +1891,7 @@ func TestParseFunctionArrayType(t *testing.T) {
 	const code = `
 		let test: [((Int8): Int16); 2] = []
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -1974,7 +1974,7 @@ func TestParseFunctionTypeWithArrayReturnType(t *testing.T) {
 	const code = `
 		let test: ((Int8): [Int16; 2]) = nothing
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -2056,7 +2056,7 @@ func TestParseFunctionTypeWithFunctionReturnTypeInParentheses(t *testing.T) {
 	const code = `
 		let test: ((Int8): ((Int16): Int32)) = nothing
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -2145,7 +2145,7 @@ func TestParseFunctionTypeWithFunctionReturnType(t *testing.T) {
 	const code = `
 		let test: ((Int8): ((Int16): Int32)) = nothing
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -2234,7 +2234,7 @@ func TestParseOptionalTypeDouble(t *testing.T) {
 	const code = `
        let x: Int?? = 1
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -2288,7 +2288,7 @@ func TestParseFunctionTypeWithResourceTypeAnnotation(t *testing.T) {
 	const code = `
         let f: ((): @R) = g
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -2344,7 +2344,7 @@ func TestParseReferenceTypeInVariableDeclaration(t *testing.T) {
 	const code = `
        let x: &[&R] = 1
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -2404,7 +2404,7 @@ func TestParseOptionalReference(t *testing.T) {
 	const code = `
        let x: &R? = 1
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -2458,7 +2458,7 @@ func TestParseRestrictedReferenceTypeWithBaseType(t *testing.T) {
 	const code = `
        let x: &R{I} = 1
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -2523,7 +2523,7 @@ func TestParseRestrictedReferenceTypeWithoutBaseType(t *testing.T) {
 	const code = `
        let x: &{I} = 1
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -2582,7 +2582,7 @@ func TestParseOptionalRestrictedType(t *testing.T) {
 	const code = `
        let x: @R{I}? = 1
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -2647,7 +2647,7 @@ func TestParseOptionalRestrictedTypeOnlyRestrictions(t *testing.T) {
 	const code = `
        let x: @{I}? = 1
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -2706,7 +2706,7 @@ func TestParseAuthorizedReferenceType(t *testing.T) {
 	const code = `
        let x: auth &R = 1
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -2759,7 +2759,7 @@ func TestParseInstantiationTypeInVariableDeclaration(t *testing.T) {
 	const code = `
       let a: MyContract.MyStruct<Int, @R > = b
 	`
-	result, errs := ParseProgram(code, nil)
+	result, errs := parseProgramWithoutNodeIDs(code)
 	require.Empty(t, errs)
 
 	utils.AssertEqualWithDiff(t,
@@ -2852,3 +2852,131 @@ func TestParseConstantSizedSizedArrayWithTrailingUnderscoreSize(t *testing.T) {
 		errs,
 	)
 }
+
+func TestParseTupleType(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("two elements", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseType("(Int, String)", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.TupleType{
+				Types: []ast.Type{
+					&ast.NominalType{
+						Identifier: ast.Identifier{
+							Identifier: "Int",
+							Pos:        ast.Position{Line: 1, Column: 1, Offset: 1},
+						},
+					},
+					&ast.NominalType{
+						Identifier: ast.Identifier{
+							Identifier: "String",
+							Pos:        ast.Position{Line: 1, Column: 6, Offset: 6},
+						},
+					},
+				},
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 12, Offset: 12},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("nested first element", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseType("((A, B), C)", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.TupleType{
+				Types: []ast.Type{
+					&ast.TupleType{
+						Types: []ast.Type{
+							&ast.NominalType{
+								Identifier: ast.Identifier{
+									Identifier: "A",
+									Pos:        ast.Position{Line: 1, Column: 2, Offset: 2},
+								},
+							},
+							&ast.NominalType{
+								Identifier: ast.Identifier{
+									Identifier: "B",
+									Pos:        ast.Position{Line: 1, Column: 5, Offset: 5},
+								},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 1, Offset: 1},
+							EndPos:   ast.Position{Line: 1, Column: 6, Offset: 6},
+						},
+					},
+					&ast.NominalType{
+						Identifier: ast.Identifier{
+							Identifier: "C",
+							Pos:        ast.Position{Line: 1, Column: 9, Offset: 9},
+						},
+					},
+				},
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 10, Offset: 10},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("function type element", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseType("(Int, ((Int): Bool))", nil)
+		require.Empty(t, errs)
+
+		require.IsType(t, &ast.TupleType{}, result)
+		tupleType := result.(*ast.TupleType)
+		require.Len(t, tupleType.Types, 2)
+		require.IsType(t, &ast.FunctionType{}, tupleType.Types[1])
+	})
+
+	t.Run("single element", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseType("(Int)", nil)
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "tuple types must have at least two element types",
+					Pos:     ast.Position{Offset: 4, Line: 1, Column: 4},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("resource annotation in element", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseType("((@R, Int), Int)", nil)
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "invalid resource annotation for tuple element type: annotate the tuple type instead",
+					Pos:     ast.Position{Offset: 2, Line: 1, Column: 2},
+				},
+			},
+			errs,
+		)
+	})
+}
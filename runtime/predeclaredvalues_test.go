@@ -24,6 +24,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/onflow/cadence"
 	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/sema"
 	"github.com/onflow/cadence/runtime/tests/checker"
@@ -146,3 +147,84 @@ func TestRuntimePredeclaredValues(t *testing.T) {
 
 	require.IsType(t, &sema.NotDeclaredError{}, errs[1])
 }
+
+func TestRuntimePredeclaredTypes(t *testing.T) {
+
+	t.Parallel()
+
+	// Only predeclare a type 'Foo' for scripts
+
+	typeDeclaration := TypeDeclaration{
+		Name: "Foo",
+		Type: sema.IntType,
+		Kind: common.DeclarationKindType,
+		Available: func(location common.Location) bool {
+			_, ok := location.(common.ScriptLocation)
+			return ok
+		},
+	}
+
+	address := common.MustBytesToAddress([]byte{0x1})
+
+	contract := []byte(`pub contract C { pub let x: Foo; init() { self.x = 1 } }`)
+
+	runtime := newTestInterpreterRuntime()
+
+	runtimeInterface := &testRuntimeInterface{
+		getAccountContractCode: func(a Address, name string) (bytes []byte, err error) {
+			if a == address {
+				return contract, nil
+			}
+			return nil, fmt.Errorf("unknown address: %s", a.ShortHexWithPrefix())
+		},
+	}
+
+	t.Run("script", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, err := runtime.ExecuteScript(
+			Script{
+				Source: []byte(`pub fun main(): Foo { return 42 }`),
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  common.ScriptLocation{},
+				PredeclaredTypes: []TypeDeclaration{
+					typeDeclaration,
+				},
+			},
+		)
+		require.NoError(t, err)
+		require.Equal(t, cadence.NewInt(42), result)
+	})
+
+	t.Run("imported contract", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := runtime.ExecuteScript(
+			Script{
+				Source: []byte(`
+                  import C from 0x1
+
+                  pub fun main() {}
+                `),
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  common.ScriptLocation{},
+				PredeclaredTypes: []TypeDeclaration{
+					typeDeclaration,
+				},
+			},
+		)
+
+		errs := checker.ExpectCheckerErrors(t, err, 1)
+
+		var importedProgramError *sema.ImportedProgramError
+		require.ErrorAs(t, errs[0], &importedProgramError)
+		importedErrs := checker.ExpectCheckerErrors(t, importedProgramError.Err, 1)
+		require.IsType(t, &sema.NotDeclaredError{}, importedErrs[0])
+	})
+}
@@ -313,20 +313,31 @@ func (p ErrorPrettyPrinter) writeCodeExcerpts(
 
 			p.writeString(" ")
 			for i := 0; i < indicatorLength; i++ {
-				c := line[i]
-				if c != '\t' {
-					c = ' '
+				// NOTE: the column might be after the end of the line,
+				// e.g. for errors reported at the end of the code
+				c := byte(' ')
+				if i < len(line) && line[i] == '\t' {
+					c = '\t'
 				}
 				p.writeString(string(c))
 			}
 
 			columns := 1
-			if excerpt.endPos != nil && excerpt.endPos.Line == excerpt.startPos.Line {
-				endColumn := excerpt.endPos.Column
+			if excerpt.endPos != nil {
+				var endColumn int
+				if excerpt.endPos.Line == excerpt.startPos.Line {
+					endColumn = excerpt.endPos.Column
+				} else if excerpt.endPos.Line > excerpt.startPos.Line {
+					// The range spans multiple lines:
+					// underline the remainder of the first line
+					endColumn = len(line) - 1
+				}
 				if endColumn >= maxLineLength {
 					endColumn = maxLineLength - 1
 				}
-				columns = endColumn - excerpt.startPos.Column + 1
+				if endColumn >= excerpt.startPos.Column {
+					columns = endColumn - excerpt.startPos.Column + 1
+				}
 			}
 
 			indicator := "-"
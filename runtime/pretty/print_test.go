@@ -26,6 +26,7 @@ import (
 
 	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
 )
 
 type testError struct {
@@ -112,3 +113,168 @@ func TestPrintTabs(t *testing.T) {
 		sb.String(),
 	)
 }
+
+func TestPrintMultiLineRange(t *testing.T) {
+
+	t.Parallel()
+
+	const code = "let x = [\n    1\n]"
+
+	location := common.StringLocation("test")
+
+	var sb strings.Builder
+	printer := NewErrorPrettyPrinter(&sb, false)
+	err := printer.PrettyPrintError(
+		testError{
+			Range: ast.Range{
+				StartPos: ast.Position{
+					Line:   1,
+					Column: 4,
+				},
+				EndPos: ast.Position{
+					Line:   3,
+					Column: 0,
+				},
+			},
+		},
+		location,
+		map[common.Location]string{
+			location: code,
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t,
+		"error: test error\n"+
+			" --> test:1:4\n"+
+			"  |\n"+
+			"1 | let x = [\n"+
+			"  |     ^^^^^\n",
+		sb.String(),
+	)
+}
+
+func TestPrintPositionAfterEndOfLine(t *testing.T) {
+
+	t.Parallel()
+
+	const code = "\tlet x ="
+
+	location := common.StringLocation("test")
+
+	var sb strings.Builder
+	printer := NewErrorPrettyPrinter(&sb, false)
+	err := printer.PrettyPrintError(
+		testError{
+			Range: ast.Range{
+				StartPos: ast.Position{
+					Line:   1,
+					Column: 9,
+				},
+				EndPos: ast.Position{
+					Line:   1,
+					Column: 9,
+				},
+			},
+		},
+		location,
+		map[common.Location]string{
+			location: code,
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t,
+		"error: test error\n"+
+			" --> test:1:9\n"+
+			"  |\n"+
+			"1 | \tlet x =\n"+
+			"  | \t        ^\n",
+		sb.String(),
+	)
+}
+
+type testNote struct {
+	ast.Range
+	message string
+}
+
+func (n testNote) Message() string {
+	return n.message
+}
+
+type testErrorWithNotes struct {
+	ast.Range
+	notes []errors.ErrorNote
+}
+
+func (testErrorWithNotes) Error() string {
+	return "test error"
+}
+
+func (testErrorWithNotes) SecondaryError() string {
+	return "secondary"
+}
+
+func (e testErrorWithNotes) ErrorNotes() []errors.ErrorNote {
+	return e.notes
+}
+
+func TestPrintSecondaryErrorAndNotes(t *testing.T) {
+
+	t.Parallel()
+
+	const code = "let x = 1\n" +
+		"\n" +
+		"\n" +
+		"let x = 2"
+
+	location := common.StringLocation("test")
+
+	var sb strings.Builder
+	printer := NewErrorPrettyPrinter(&sb, false)
+	err := printer.PrettyPrintError(
+		testErrorWithNotes{
+			Range: ast.Range{
+				StartPos: ast.Position{
+					Line:   4,
+					Column: 4,
+				},
+				EndPos: ast.Position{
+					Line:   4,
+					Column: 4,
+				},
+			},
+			notes: []errors.ErrorNote{
+				testNote{
+					Range: ast.Range{
+						StartPos: ast.Position{
+							Line:   1,
+							Column: 4,
+						},
+						EndPos: ast.Position{
+							Line:   1,
+							Column: 4,
+						},
+					},
+					message: "previously declared here",
+				},
+			},
+		},
+		location,
+		map[common.Location]string{
+			location: code,
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t,
+		"error: test error\n"+
+			" --> test:1:4\n"+
+			"  |\n"+
+			"1 | let x = 1\n"+
+			"  |     - previously declared here\n"+
+			" ... \n"+
+			"  |\n"+
+			"4 | let x = 2\n"+
+			"  |     ^ secondary\n",
+		sb.String(),
+	)
+}
@@ -0,0 +1,409 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"compress/gzip"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+// ProfileSample is the aggregated profiling information for a Cadence call stack
+//
+type ProfileSample struct {
+	// Stack is the call stack, starting with the innermost frame
+	Stack []interpreter.StackFrame
+	// Statements is the estimated number of statements executed with this call stack
+	Statements int64
+	// Duration is the estimated time spent executing statements with this call stack
+	Duration time.Duration
+}
+
+// DefaultProfilerSamplingPeriod is the number of statements between two samples
+// of a profiler created using NewProfiler
+//
+const DefaultProfilerSamplingPeriod = 100
+
+// Profiler records which Cadence functions executed how many statements, and how much time was spent in them.
+//
+// The profiler samples the execution: Only every n-th statement is sampled,
+// where n is the sampling period. Executing any other statement only increments a counter.
+// When a statement is sampled, its call stack is determined,
+// and all statements executed and all time spent since the previous sample are attributed to it.
+// A sampling period of 1 records every statement.
+//
+// The profile can be written in the pprof format using WriteProfile,
+// where the functions are keyed by their Cadence source locations,
+// and can then be analyzed using pprof tools, e.g. `go tool pprof`.
+//
+// A profiler is not safe for concurrent use.
+//
+type Profiler struct {
+	samples        map[string]*ProfileSample
+	sampleKeys     []string
+	startTime      time.Time
+	samplingPeriod int64
+	// statements is the number of statements executed since the previous sample
+	statements int64
+	// lastSampleTime is the time of the previous sample,
+	// or the start of the execution, if no sample was taken yet
+	lastSampleTime time.Time
+	// now returns the current time, it is only replaced in tests
+	now func() time.Time
+}
+
+// NewProfiler returns a new profiler
+// which samples every DefaultProfilerSamplingPeriod-th statement.
+//
+func NewProfiler() *Profiler {
+	return NewSamplingProfiler(DefaultProfilerSamplingPeriod)
+}
+
+// NewSamplingProfiler returns a new profiler which samples every n-th statement,
+// where n is the given sampling period.
+//
+func NewSamplingProfiler(samplingPeriod int) *Profiler {
+	if samplingPeriod < 1 {
+		samplingPeriod = 1
+	}
+
+	now := time.Now()
+
+	return &Profiler{
+		samples:        map[string]*ProfileSample{},
+		startTime:      now,
+		samplingPeriod: int64(samplingPeriod),
+		lastSampleTime: now,
+		now:            time.Now,
+	}
+}
+
+// Samples returns the samples recorded so far, in the order they were first recorded.
+//
+func (p *Profiler) Samples() []ProfileSample {
+	samples := make([]ProfileSample, 0, len(p.sampleKeys))
+	for _, key := range p.sampleKeys {
+		samples = append(samples, *p.samples[key])
+	}
+	return samples
+}
+
+// startExecution must be called when the execution of a program starts.
+//
+func (p *Profiler) startExecution() {
+	p.statements = 0
+	p.lastSampleTime = p.now()
+}
+
+// recordStatement records the execution of the given statement by the given interpreter,
+// and samples it, if the sampling period has elapsed.
+//
+func (p *Profiler) recordStatement(inter *interpreter.Interpreter, statement ast.Statement) {
+	p.statements++
+	if p.statements < p.samplingPeriod {
+		return
+	}
+
+	now := p.now()
+
+	line := statement.StartPosition().Line
+
+	stack := interpreter.StackFrames(inter.CallStack.Invocations, line)
+	if len(stack) == 0 {
+		// The statement is not executed in an interpreted function
+		stack = []interpreter.StackFrame{
+			{
+				Location: inter.Location,
+				Line:     line,
+			},
+		}
+	}
+
+	key := profileSampleKey(stack)
+	sample, ok := p.samples[key]
+	if !ok {
+		sample = &ProfileSample{
+			Stack: stack,
+		}
+		p.samples[key] = sample
+		p.sampleKeys = append(p.sampleKeys, key)
+	}
+
+	sample.Statements += p.statements
+	sample.Duration += now.Sub(p.lastSampleTime)
+
+	p.statements = 0
+	p.lastSampleTime = now
+}
+
+func profileSampleKey(stack []interpreter.StackFrame) string {
+	var builder strings.Builder
+	for _, frame := range stack {
+		builder.WriteString(frame.String())
+		builder.WriteByte(';')
+	}
+	return builder.String()
+}
+
+// WriteProfile writes the recorded profile to the given writer,
+// in the gzip-compressed protocol buffer format of pprof.
+//
+// The profile has two sample types:
+// the number of statements executed (`statements/count`),
+// and the time spent executing them (`time/nanoseconds`).
+// The period of the profile is the sampling period.
+//
+func (p *Profiler) WriteProfile(w io.Writer) error {
+	zw := gzip.NewWriter(w)
+
+	_, err := zw.Write(p.encodeProfile())
+	if err != nil {
+		return err
+	}
+
+	return zw.Close()
+}
+
+type profileFunctionKey struct {
+	name     string
+	filename string
+}
+
+type profileLocationKey struct {
+	functionID uint64
+	line       int
+}
+
+// encodeProfile encodes the profile in the protocol buffer format of pprof,
+// see https://github.com/google/pprof/blob/main/proto/profile.proto
+//
+func (p *Profiler) encodeProfile() []byte {
+
+	// NOTE: the first entry of the string table must be the empty string
+
+	stringTable := []string{""}
+	stringIndices := map[string]int64{"": 0}
+
+	stringIndex := func(s string) int64 {
+		index, ok := stringIndices[s]
+		if !ok {
+			index = int64(len(stringTable))
+			stringTable = append(stringTable, s)
+			stringIndices[s] = index
+		}
+		return index
+	}
+
+	// Functions and locations are identified by their index, starting at 1
+
+	var functionKeys []profileFunctionKey
+	functionIDs := map[profileFunctionKey]uint64{}
+
+	var locationKeys []profileLocationKey
+	locationIDs := map[profileLocationKey]uint64{}
+
+	locationID := func(frame interpreter.StackFrame) uint64 {
+		functionName := frame.FunctionName
+		if functionName == "" {
+			functionName = "<anonymous>"
+		}
+
+		// Key functions by the ID of the Cadence location they are declared in
+
+		var filename string
+		if frame.Location != nil {
+			filename = string(frame.Location.ID())
+		}
+
+		functionKey := profileFunctionKey{
+			name:     functionName,
+			filename: filename,
+		}
+
+		functionID, ok := functionIDs[functionKey]
+		if !ok {
+			functionKeys = append(functionKeys, functionKey)
+			functionID = uint64(len(functionKeys))
+			functionIDs[functionKey] = functionID
+		}
+
+		locationKey := profileLocationKey{
+			functionID: functionID,
+			line:       frame.Line,
+		}
+
+		id, ok := locationIDs[locationKey]
+		if !ok {
+			locationKeys = append(locationKeys, locationKey)
+			id = uint64(len(locationKeys))
+			locationIDs[locationKey] = id
+		}
+
+		return id
+	}
+
+	var buffer protobufBuffer
+
+	writeValueType := func(field int, typ string, unit string) {
+		buffer.message(field, func(buffer *protobufBuffer) {
+			buffer.int64(1, stringIndex(typ))
+			buffer.int64(2, stringIndex(unit))
+		})
+	}
+
+	// Sample types
+
+	writeValueType(1, "statements", "count")
+	writeValueType(1, "time", "nanoseconds")
+
+	// Samples
+
+	var totalDuration time.Duration
+
+	for _, key := range p.sampleKeys {
+		sample := p.samples[key]
+		totalDuration += sample.Duration
+
+		sampleLocationIDs := make([]uint64, 0, len(sample.Stack))
+		for _, frame := range sample.Stack {
+			sampleLocationIDs = append(sampleLocationIDs, locationID(frame))
+		}
+
+		buffer.message(2, func(buffer *protobufBuffer) {
+			buffer.packedUint64s(1, sampleLocationIDs)
+			buffer.packedInt64s(2, []int64{
+				sample.Statements,
+				int64(sample.Duration),
+			})
+		})
+	}
+
+	// Locations
+
+	for i, locationKey := range locationKeys {
+		buffer.message(4, func(buffer *protobufBuffer) {
+			buffer.uint64(1, uint64(i+1))
+			buffer.message(4, func(buffer *protobufBuffer) {
+				buffer.uint64(1, locationKey.functionID)
+				buffer.int64(2, int64(locationKey.line))
+			})
+		})
+	}
+
+	// Functions
+
+	for i, functionKey := range functionKeys {
+		buffer.message(5, func(buffer *protobufBuffer) {
+			buffer.uint64(1, uint64(i+1))
+			buffer.int64(2, stringIndex(functionKey.name))
+			buffer.int64(3, stringIndex(functionKey.name))
+			buffer.int64(4, stringIndex(functionKey.filename))
+		})
+	}
+
+	// Time and duration, and period
+
+	buffer.int64(9, p.startTime.UnixNano())
+	buffer.int64(10, int64(totalDuration))
+	writeValueType(11, "statements", "count")
+	buffer.int64(12, p.samplingPeriod)
+
+	// String table.
+	// NOTE: written last, as all strings are only known once everything else was written
+
+	for _, s := range stringTable {
+		buffer.string(6, s)
+	}
+
+	return buffer.data
+}
+
+// protobufBuffer is a minimal encoder for the protocol buffer wire format
+//
+type protobufBuffer struct {
+	data []byte
+}
+
+const (
+	protobufWireTypeVarint          = 0
+	protobufWireTypeLengthDelimited = 2
+)
+
+func (b *protobufBuffer) varint(x uint64) {
+	for x >= 0x80 {
+		b.data = append(b.data, byte(x)|0x80)
+		x >>= 7
+	}
+	b.data = append(b.data, byte(x))
+}
+
+func (b *protobufBuffer) tag(field int, wireType int) {
+	b.varint(uint64(field)<<3 | uint64(wireType))
+}
+
+func (b *protobufBuffer) uint64(field int, x uint64) {
+	// NOTE: zero is the default value, so it can be omitted
+	if x == 0 {
+		return
+	}
+	b.tag(field, protobufWireTypeVarint)
+	b.varint(x)
+}
+
+func (b *protobufBuffer) int64(field int, x int64) {
+	b.uint64(field, uint64(x))
+}
+
+func (b *protobufBuffer) bytes(field int, data []byte) {
+	b.tag(field, protobufWireTypeLengthDelimited)
+	b.varint(uint64(len(data)))
+	b.data = append(b.data, data...)
+}
+
+func (b *protobufBuffer) string(field int, s string) {
+	b.tag(field, protobufWireTypeLengthDelimited)
+	b.varint(uint64(len(s)))
+	b.data = append(b.data, s...)
+}
+
+func (b *protobufBuffer) message(field int, encode func(buffer *protobufBuffer)) {
+	var message protobufBuffer
+	encode(&message)
+	b.bytes(field, message.data)
+}
+
+func (b *protobufBuffer) packedUint64s(field int, xs []uint64) {
+	var packed protobufBuffer
+	for _, x := range xs {
+		packed.varint(x)
+	}
+	b.bytes(field, packed.data)
+}
+
+func (b *protobufBuffer) packedInt64s(field int, xs []int64) {
+	var packed protobufBuffer
+	for _, x := range xs {
+		packed.varint(uint64(x))
+	}
+	b.bytes(field, packed.data)
+}
@@ -0,0 +1,335 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/pprof/profile"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+const profilerTestImportedLocation = common.StringLocation("imported")
+
+var profilerTestScriptLocation = common.ScriptLocation{0x1}
+
+// executeProfiledScript executes a script which calls an imported function,
+// and records the execution using the given profiler
+//
+func executeProfiledScript(t *testing.T, profiler *Profiler) {
+
+	runtime := newTestInterpreterRuntime()
+
+	importedScript := []byte(`
+      pub fun answer(): Int {
+        var i = 0
+        while i < 3 {
+          i = i + 1
+        }
+        return i
+      }
+    `)
+
+	script := []byte(`
+      import "imported"
+
+      pub fun main(): Int {
+          let answer = answer()
+          return answer
+      }
+    `)
+
+	runtimeInterface := &testRuntimeInterface{
+		getCode: func(location Location) (bytes []byte, err error) {
+			switch location {
+			case profilerTestImportedLocation:
+				return importedScript, nil
+			default:
+				return nil, fmt.Errorf("unknown import location: %s", location)
+			}
+		},
+	}
+
+	// Advance the clock by one millisecond each time it is read
+
+	now := time.Unix(0, 0)
+	profiler.now = func() time.Time {
+		now = now.Add(time.Millisecond)
+		return now
+	}
+
+	runtime.SetProfiler(profiler)
+
+	value, err := runtime.ExecuteScript(
+		Script{
+			Source: script,
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  profilerTestScriptLocation,
+		},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, cadence.NewInt(3), value)
+}
+
+func profilerTestMainFrame(line int) interpreter.StackFrame {
+	return interpreter.StackFrame{
+		FunctionName: "main",
+		Location:     profilerTestScriptLocation,
+		Line:         line,
+	}
+}
+
+func profilerTestAnswerFrame(line int) interpreter.StackFrame {
+	return interpreter.StackFrame{
+		FunctionName: "answer",
+		Location:     profilerTestImportedLocation,
+		Line:         line,
+	}
+}
+
+func TestRuntimeProfiler(t *testing.T) {
+
+	t.Parallel()
+
+	profiler := NewSamplingProfiler(1)
+
+	executeProfiledScript(t, profiler)
+
+	mainFrame := profilerTestMainFrame
+	answerFrame := profilerTestAnswerFrame
+
+	assert.Equal(t,
+		[]ProfileSample{
+			{
+				Stack:      []interpreter.StackFrame{mainFrame(5)},
+				Statements: 1,
+				Duration:   time.Millisecond,
+			},
+			{
+				Stack:      []interpreter.StackFrame{answerFrame(3), mainFrame(5)},
+				Statements: 1,
+				Duration:   time.Millisecond,
+			},
+			{
+				Stack:      []interpreter.StackFrame{answerFrame(4), mainFrame(5)},
+				Statements: 1,
+				Duration:   time.Millisecond,
+			},
+			{
+				Stack:      []interpreter.StackFrame{answerFrame(5), mainFrame(5)},
+				Statements: 3,
+				Duration:   3 * time.Millisecond,
+			},
+			{
+				Stack:      []interpreter.StackFrame{answerFrame(7), mainFrame(5)},
+				Statements: 1,
+				Duration:   time.Millisecond,
+			},
+			{
+				Stack:      []interpreter.StackFrame{mainFrame(6)},
+				Statements: 1,
+				Duration:   time.Millisecond,
+			},
+		},
+		profiler.Samples(),
+	)
+
+	var buffer bytes.Buffer
+	err := profiler.WriteProfile(&buffer)
+	require.NoError(t, err)
+
+	reader, err := gzip.NewReader(&buffer)
+	require.NoError(t, err)
+
+	encoded, err := io.ReadAll(reader)
+	require.NoError(t, err)
+
+	assert.Equal(t, profiler.encodeProfile(), encoded)
+	assert.Contains(t, string(encoded), "answer")
+}
+
+func TestRuntimeSamplingProfiler(t *testing.T) {
+
+	t.Parallel()
+
+	// The script executes 8 statements.
+	// Every third statement is sampled,
+	// and the last two statements are not sampled
+
+	profiler := NewSamplingProfiler(3)
+
+	executeProfiledScript(t, profiler)
+
+	mainFrame := profilerTestMainFrame
+	answerFrame := profilerTestAnswerFrame
+
+	assert.Equal(t,
+		[]ProfileSample{
+			{
+				Stack:      []interpreter.StackFrame{answerFrame(4), mainFrame(5)},
+				Statements: 3,
+				Duration:   time.Millisecond,
+			},
+			{
+				Stack:      []interpreter.StackFrame{answerFrame(5), mainFrame(5)},
+				Statements: 3,
+				Duration:   time.Millisecond,
+			},
+		},
+		profiler.Samples(),
+	)
+}
+
+func TestRuntimeProfilerPprofRoundTrip(t *testing.T) {
+
+	t.Parallel()
+
+	profiler := NewSamplingProfiler(1)
+
+	executeProfiledScript(t, profiler)
+
+	var buffer bytes.Buffer
+	err := profiler.WriteProfile(&buffer)
+	require.NoError(t, err)
+
+	parsed, err := profile.Parse(&buffer)
+	require.NoError(t, err)
+
+	require.NoError(t, parsed.CheckValid())
+
+	require.Len(t, parsed.SampleType, 2)
+	assert.Equal(t, "statements", parsed.SampleType[0].Type)
+	assert.Equal(t, "count", parsed.SampleType[0].Unit)
+	assert.Equal(t, "time", parsed.SampleType[1].Type)
+	assert.Equal(t, "nanoseconds", parsed.SampleType[1].Unit)
+
+	require.NotNil(t, parsed.PeriodType)
+	assert.Equal(t, "statements", parsed.PeriodType.Type)
+	assert.Equal(t, int64(1), parsed.Period)
+
+	type parsedFrame struct {
+		function string
+		filename string
+		line     int64
+	}
+
+	type parsedSample struct {
+		stack  []parsedFrame
+		values []int64
+	}
+
+	var samples []parsedSample
+
+	for _, sample := range parsed.Sample {
+		var stack []parsedFrame
+		for _, location := range sample.Location {
+			require.Len(t, location.Line, 1)
+			line := location.Line[0]
+			stack = append(stack, parsedFrame{
+				function: line.Function.Name,
+				filename: line.Function.Filename,
+				line:     line.Line,
+			})
+		}
+
+		samples = append(samples, parsedSample{
+			stack:  stack,
+			values: sample.Value,
+		})
+	}
+
+	scriptFilename := string(profilerTestScriptLocation.ID())
+	importedFilename := string(profilerTestImportedLocation.ID())
+
+	mainFrame := func(line int64) parsedFrame {
+		return parsedFrame{
+			function: "main",
+			filename: scriptFilename,
+			line:     line,
+		}
+	}
+
+	answerFrame := func(line int64) parsedFrame {
+		return parsedFrame{
+			function: "answer",
+			filename: importedFilename,
+			line:     line,
+		}
+	}
+
+	millisecond := int64(time.Millisecond)
+
+	assert.Equal(t,
+		[]parsedSample{
+			{
+				stack:  []parsedFrame{mainFrame(5)},
+				values: []int64{1, millisecond},
+			},
+			{
+				stack:  []parsedFrame{answerFrame(3), mainFrame(5)},
+				values: []int64{1, millisecond},
+			},
+			{
+				stack:  []parsedFrame{answerFrame(4), mainFrame(5)},
+				values: []int64{1, millisecond},
+			},
+			{
+				stack:  []parsedFrame{answerFrame(5), mainFrame(5)},
+				values: []int64{3, 3 * millisecond},
+			},
+			{
+				stack:  []parsedFrame{answerFrame(7), mainFrame(5)},
+				values: []int64{1, millisecond},
+			},
+			{
+				stack:  []parsedFrame{mainFrame(6)},
+				values: []int64{1, millisecond},
+			},
+		},
+		samples,
+	)
+}
+
+func TestProtobufBufferVarint(t *testing.T) {
+
+	t.Parallel()
+
+	var buffer protobufBuffer
+	buffer.uint64(1, 150)
+	buffer.uint64(2, 0)
+	buffer.string(3, "ab")
+
+	assert.Equal(t,
+		[]byte{0x08, 0x96, 0x01, 0x1a, 0x02, 'a', 'b'},
+		buffer.data,
+	)
+}
@@ -0,0 +1,268 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"time"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+// ReadSet is the state read during the execution of a script,
+// recorded by Runtime.ExecuteScriptWithReadSet.
+//
+// All reads are deduplicated and ordered by their first occurrence.
+//
+type ReadSet struct {
+	// Accounts are the addresses of all accounts which were read
+	Accounts []common.Address
+	// Registers are the registers which were read through the runtime interface
+	Registers []RegisterRead
+	// Paths are the storage paths which were read
+	Paths []PathRead
+	// Contracts are the account contracts whose code was read
+	Contracts []common.AddressLocation
+}
+
+// RegisterRead is a read of a register, i.e. a key in the storage owned by an account.
+//
+type RegisterRead struct {
+	Owner []byte
+	Key   []byte
+}
+
+// PathRead is a read of a path in the storage of an account.
+// The identifier is empty if the whole storage domain was read, e.g. when iterating over it.
+//
+type PathRead struct {
+	Address    common.Address
+	Domain     string
+	Identifier string
+}
+
+type registerKey struct {
+	owner string
+	key   string
+}
+
+type readSetRecorder struct {
+	readSet   ReadSet
+	accounts  map[common.Address]struct{}
+	registers map[registerKey]struct{}
+	paths     map[PathRead]struct{}
+	contracts map[common.AddressLocation]struct{}
+}
+
+func newReadSetRecorder() *readSetRecorder {
+	return &readSetRecorder{
+		accounts:  map[common.Address]struct{}{},
+		registers: map[registerKey]struct{}{},
+		paths:     map[PathRead]struct{}{},
+		contracts: map[common.AddressLocation]struct{}{},
+	}
+}
+
+func (r *readSetRecorder) recordAccountRead(address common.Address) {
+	if _, ok := r.accounts[address]; ok {
+		return
+	}
+	r.accounts[address] = struct{}{}
+	r.readSet.Accounts = append(r.readSet.Accounts, address)
+}
+
+func (r *readSetRecorder) recordRegisterRead(owner, key []byte) {
+	if len(owner) == common.AddressLength {
+		r.recordAccountRead(common.MustBytesToAddress(owner))
+	}
+
+	registerKey := registerKey{
+		owner: string(owner),
+		key:   string(key),
+	}
+	if _, ok := r.registers[registerKey]; ok {
+		return
+	}
+	r.registers[registerKey] = struct{}{}
+
+	// Copy the owner and key, as the caller may reuse the underlying arrays
+	r.readSet.Registers = append(r.readSet.Registers, RegisterRead{
+		Owner: []byte(registerKey.owner),
+		Key:   []byte(registerKey.key),
+	})
+}
+
+func (r *readSetRecorder) recordPathRead(
+	_ *interpreter.Interpreter,
+	address common.Address,
+	domain string,
+	identifier string,
+) {
+	r.recordAccountRead(address)
+
+	pathRead := PathRead{
+		Address:    address,
+		Domain:     domain,
+		Identifier: identifier,
+	}
+	if _, ok := r.paths[pathRead]; ok {
+		return
+	}
+	r.paths[pathRead] = struct{}{}
+	r.readSet.Paths = append(r.readSet.Paths, pathRead)
+}
+
+func (r *readSetRecorder) recordContractRead(address common.Address, name string) {
+	r.recordAccountRead(address)
+
+	location := common.AddressLocation{
+		Address: address,
+		Name:    name,
+	}
+	if _, ok := r.contracts[location]; ok {
+		return
+	}
+	r.contracts[location] = struct{}{}
+	r.readSet.Contracts = append(r.readSet.Contracts, location)
+}
+
+// readSetRecordingInterface is a runtime interface which records all reads of state
+// into a read set, and delegates them to the wrapped interface.
+//
+// Memory metering and metrics reporting are delegated to the wrapped interface,
+// if it supports them.
+//
+type readSetRecordingInterface struct {
+	Interface
+	recorder *readSetRecorder
+}
+
+var _ Interface = &readSetRecordingInterface{}
+var _ common.MemoryGauge = &readSetRecordingInterface{}
+var _ Metrics = &readSetRecordingInterface{}
+
+// newReadSetRecordingInterface returns a runtime interface which records all reads of state
+// of the given runtime interface into the given recorder.
+//
+// The returned interface only supports batched reads if the given runtime interface supports them.
+//
+func newReadSetRecordingInterface(runtimeInterface Interface, recorder *readSetRecorder) Interface {
+	recordingInterface := &readSetRecordingInterface{
+		Interface: runtimeInterface,
+		recorder:  recorder,
+	}
+
+	batchLedger, ok := runtimeInterface.(BatchLedger)
+	if !ok {
+		return recordingInterface
+	}
+
+	return &readSetRecordingBatchInterface{
+		readSetRecordingInterface: recordingInterface,
+		batchLedger:               batchLedger,
+	}
+}
+
+func (i *readSetRecordingInterface) GetValue(owner, key []byte) ([]byte, error) {
+	i.recorder.recordRegisterRead(owner, key)
+	return i.Interface.GetValue(owner, key)
+}
+
+func (i *readSetRecordingInterface) ValueExists(owner, key []byte) (bool, error) {
+	i.recorder.recordRegisterRead(owner, key)
+	return i.Interface.ValueExists(owner, key)
+}
+
+func (i *readSetRecordingInterface) GetAccountContractCode(address Address, name string) ([]byte, error) {
+	i.recorder.recordContractRead(address, name)
+	return i.Interface.GetAccountContractCode(address, name)
+}
+
+func (i *readSetRecordingInterface) GetAccountContractNames(address Address) ([]string, error) {
+	i.recorder.recordAccountRead(address)
+	return i.Interface.GetAccountContractNames(address)
+}
+
+func (i *readSetRecordingInterface) GetAccountKey(address Address, index int) (*AccountKey, error) {
+	i.recorder.recordAccountRead(address)
+	return i.Interface.GetAccountKey(address, index)
+}
+
+func (i *readSetRecordingInterface) GetAccountBalance(address common.Address) (uint64, error) {
+	i.recorder.recordAccountRead(address)
+	return i.Interface.GetAccountBalance(address)
+}
+
+func (i *readSetRecordingInterface) GetAccountAvailableBalance(address common.Address) (uint64, error) {
+	i.recorder.recordAccountRead(address)
+	return i.Interface.GetAccountAvailableBalance(address)
+}
+
+func (i *readSetRecordingInterface) GetStorageUsed(address Address) (uint64, error) {
+	i.recorder.recordAccountRead(address)
+	return i.Interface.GetStorageUsed(address)
+}
+
+func (i *readSetRecordingInterface) GetStorageCapacity(address Address) (uint64, error) {
+	i.recorder.recordAccountRead(address)
+	return i.Interface.GetStorageCapacity(address)
+}
+
+func (i *readSetRecordingInterface) MeterMemory(usage common.MemoryUsage) error {
+	memoryGauge, ok := i.Interface.(common.MemoryGauge)
+	if !ok {
+		return nil
+	}
+	return memoryGauge.MeterMemory(usage)
+}
+
+func (i *readSetRecordingInterface) ProgramParsed(location common.Location, duration time.Duration) {
+	if metrics, ok := i.Interface.(Metrics); ok {
+		metrics.ProgramParsed(location, duration)
+	}
+}
+
+func (i *readSetRecordingInterface) ProgramChecked(location common.Location, duration time.Duration) {
+	if metrics, ok := i.Interface.(Metrics); ok {
+		metrics.ProgramChecked(location, duration)
+	}
+}
+
+func (i *readSetRecordingInterface) ProgramInterpreted(location common.Location, duration time.Duration) {
+	if metrics, ok := i.Interface.(Metrics); ok {
+		metrics.ProgramInterpreted(location, duration)
+	}
+}
+
+// readSetRecordingBatchInterface is a read set recording interface
+// for a runtime interface which supports batched reads
+//
+type readSetRecordingBatchInterface struct {
+	*readSetRecordingInterface
+	batchLedger BatchLedger
+}
+
+var _ BatchLedger = &readSetRecordingBatchInterface{}
+
+func (i *readSetRecordingBatchInterface) GetValues(owner []byte, keys [][]byte) ([][]byte, error) {
+	for _, key := range keys {
+		i.recorder.recordRegisterRead(owner, key)
+	}
+	return i.batchLedger.GetValues(owner, keys)
+}
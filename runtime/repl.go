@@ -27,6 +27,7 @@ import (
 	"github.com/onflow/cadence/runtime/errors"
 	"github.com/onflow/cadence/runtime/interpreter"
 	"github.com/onflow/cadence/runtime/parser"
+	"github.com/onflow/cadence/runtime/parser/lexer"
 	"github.com/onflow/cadence/runtime/sema"
 	"github.com/onflow/cadence/runtime/stdlib"
 )
@@ -37,6 +38,9 @@ type REPL struct {
 	onError  func(err error, location common.Location, codes map[common.Location]string)
 	onResult func(interpreter.Value)
 	codes    map[common.Location]string
+	// nodeIDs assigns the IDs of the elements of all inputs,
+	// so they are unique across inputs
+	nodeIDs ast.NodeIDGenerator
 }
 
 func NewREPL(
@@ -139,15 +143,24 @@ func (r *REPL) execute(element ast.Element) {
 }
 
 func (r *REPL) check(element ast.Element, code string) bool {
+	r.nodeIDs.AssignIDs(element)
 	element.Accept(r.checker)
 	r.codes[r.checker.Location] = code
 	return r.handleCheckerError()
 }
 
+// Accept parses, checks, and executes the given code.
+//
+// If the input is incomplete, e.g. a declaration spanning multiple lines
+// is missing its closing brace, the code is not executed, and false is returned.
+// The caller is expected to call Accept again with the complete input.
+//
 func (r *REPL) Accept(code string) (inputIsComplete bool) {
 
-	// TODO: detect if the input is complete
-	inputIsComplete = true
+	inputIsComplete = isInputComplete(code)
+	if !inputIsComplete {
+		return
+	}
 
 	var err error
 	result, errs := parser.ParseStatements(code, nil)
@@ -158,10 +171,6 @@ func (r *REPL) Accept(code string) (inputIsComplete bool) {
 		}
 	}
 
-	if !inputIsComplete {
-		return
-	}
-
 	if err != nil {
 		r.onError(err, r.checker.Location, r.codes)
 		return
@@ -198,6 +207,121 @@ func (r *REPL) Accept(code string) (inputIsComplete bool) {
 	return
 }
 
+// isInputComplete returns true if all parentheses, braces, and brackets
+// in the given code are closed
+//
+func isInputComplete(code string) bool {
+	tokens := lexer.Lex(code, nil)
+	defer tokens.Reclaim()
+
+	depth := 0
+
+	for {
+		token := tokens.Next()
+
+		switch token.Type {
+		case lexer.TokenEOF:
+			return depth <= 0
+
+		case lexer.TokenParenOpen,
+			lexer.TokenBraceOpen,
+			lexer.TokenBracketOpen:
+
+			depth++
+
+		case lexer.TokenParenClose,
+			lexer.TokenBraceClose,
+			lexer.TokenBracketClose:
+
+			depth--
+		}
+	}
+}
+
+// ExpressionType parses and checks the given expression in the current scope,
+// and returns its static type. The expression is not evaluated.
+//
+// If the expression is invalid, the error is reported and nil is returned.
+//
+func (r *REPL) ExpressionType(code string) sema.Type {
+	expression, errs := parser.ParseExpression(code, nil)
+	if len(errs) > 0 {
+		r.onError(
+			parser.Error{
+				Code:   code,
+				Errors: errs,
+			},
+			r.checker.Location,
+			r.codes,
+		)
+		return nil
+	}
+
+	r.checker.ResetErrors()
+	r.checker.Program = nil
+	r.codes[r.checker.Location] = code
+
+	r.nodeIDs.AssignIDs(expression)
+	ty := r.checker.VisitUnevaluatedExpression(expression)
+
+	if !r.handleCheckerError() {
+		return nil
+	}
+
+	return ty
+}
+
+// REPLValue is a variable declared in the REPL
+//
+type REPLValue struct {
+	Name  string
+	Type  sema.Type
+	Value interpreter.Value
+}
+
+// Values returns the variables and constants declared in the REPL,
+// sorted by name
+//
+func (r *REPL) Values() (result []REPLValue) {
+	r.checker.Elaboration.GlobalValues.Foreach(func(name string, variable *sema.Variable) {
+
+		if variable.DeclarationKind != common.DeclarationKindConstant &&
+			variable.DeclarationKind != common.DeclarationKindVariable {
+
+			return
+		}
+
+		if _, ok := r.checker.Elaboration.EffectivePredeclaredValues[name]; ok {
+			return
+		}
+
+		// Resources which were moved or destroyed have no value anymore
+
+		if variable.Type.IsResourceType() &&
+			r.checker.IsInvalidatedResource(variable) {
+
+			return
+		}
+
+		interpreterVariable := r.inter.FindVariable(name)
+		if interpreterVariable == nil {
+			return
+		}
+
+		result = append(result, REPLValue{
+			Name:  name,
+			Type:  variable.Type,
+			Value: interpreterVariable.GetValue(),
+		})
+	})
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return
+}
+
 type REPLSuggestion struct {
 	Name, Description string
 }
@@ -0,0 +1,190 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func newTestREPL(t *testing.T) (repl *REPL, errs *[]error, results *[]interpreter.Value) {
+	errs = &[]error{}
+	results = &[]interpreter.Value{}
+
+	repl, err := NewREPL(
+		func(err error, _ common.Location, _ map[common.Location]string) {
+			*errs = append(*errs, err)
+		},
+		func(value interpreter.Value) {
+			*results = append(*results, value)
+		},
+		nil,
+	)
+	require.NoError(t, err)
+
+	return repl, errs, results
+}
+
+func TestREPLMultiLineDeclarations(t *testing.T) {
+
+	t.Parallel()
+
+	repl, errs, results := newTestREPL(t)
+
+	assert.False(t, repl.Accept("fun double(_ x: Int): Int {\n"))
+	assert.False(t, repl.Accept("fun double(_ x: Int): Int {\n  let ys = [\n"))
+	assert.True(t, repl.Accept("fun double(_ x: Int): Int {\n  let ys = [\n    x * 2\n  ]\n  return ys[0]\n}\n"))
+
+	assert.True(t, repl.Accept("double(21)\n"))
+
+	require.Empty(t, *errs)
+	require.Len(t, *results, 1)
+	assert.Equal(t, "42", (*results)[0].String())
+}
+
+func TestREPLPersistentState(t *testing.T) {
+
+	t.Parallel()
+
+	repl, errs, results := newTestREPL(t)
+
+	for _, code := range []string{
+		"pub struct S {\n  pub let x: Int\n  init() { self.x = 1 }\n}\n",
+		"pub resource R {\n  pub let y: Int\n  init(y: Int) { self.y = y }\n}\n",
+		"let s = S()\n",
+		"let r <- create R(y: 2)\n",
+		"var count = s.x + r.y\n",
+		"count = count * 2\n",
+		"count\n",
+	} {
+		require.True(t, repl.Accept(code))
+	}
+
+	require.Empty(t, *errs)
+	require.Len(t, *results, 1)
+	assert.Equal(t, "6", (*results)[0].String())
+
+	values := repl.Values()
+
+	var names []string
+	for _, value := range values {
+		names = append(names, value.Name)
+	}
+	assert.Equal(t, []string{"count", "r", "s"}, names)
+
+	assert.Equal(t, sema.IntType, values[0].Type)
+	assert.Equal(t, "6", values[0].Value.String())
+	assert.Equal(t, "R", values[1].Type.QualifiedString())
+}
+
+func TestREPLExpressionType(t *testing.T) {
+
+	t.Parallel()
+
+	repl, errs, _ := newTestREPL(t)
+
+	require.True(t, repl.Accept("pub resource R {}\n"))
+	require.True(t, repl.Accept("let r <- create R()\n"))
+	require.True(t, repl.Accept("let xs = [1, 2]\n"))
+
+	ty := repl.ExpressionType("xs")
+	require.NotNil(t, ty)
+	assert.Equal(t, "[Int]", ty.QualifiedString())
+
+	ty = repl.ExpressionType("xs.length > 1")
+	require.NotNil(t, ty)
+	assert.Equal(t, sema.BoolType, ty)
+
+	// Determining the type of a move does not invalidate the resource
+
+	ty = repl.ExpressionType("<-r")
+	require.NotNil(t, ty)
+	assert.Equal(t, "R", ty.QualifiedString())
+
+	require.True(t, repl.Accept("destroy r\n"))
+
+	require.Empty(t, *errs)
+
+	// Destroyed resources are not listed
+
+	var names []string
+	for _, value := range repl.Values() {
+		names = append(names, value.Name)
+	}
+	assert.Equal(t, []string{"xs"}, names)
+
+	// Invalid expressions are reported
+
+	assert.Nil(t, repl.ExpressionType("xs + 1"))
+	assert.Len(t, *errs, 1)
+
+	assert.Nil(t, repl.ExpressionType("xs +"))
+	assert.Len(t, *errs, 2)
+}
+
+func TestREPLImportContract(t *testing.T) {
+
+	t.Parallel()
+
+	contractPath := path.Join(t.TempDir(), "Counter.cdc")
+
+	err := os.WriteFile(
+		contractPath,
+		[]byte(`
+          pub contract Counter {
+              pub var count: Int
+
+              init() {
+                  self.count = 40
+              }
+
+              pub fun increment(): Int {
+                  self.count = self.count + 1
+                  return self.count
+              }
+          }
+        `),
+		0600,
+	)
+	require.NoError(t, err)
+
+	repl, errs, results := newTestREPL(t)
+
+	require.True(t, repl.Accept(fmt.Sprintf("import Counter from %q\n", contractPath)))
+	require.True(t, repl.Accept("Counter.increment()\n"))
+	require.True(t, repl.Accept("Counter.increment()\n"))
+
+	require.Empty(t, *errs)
+	require.Len(t, *results, 2)
+	assert.Equal(t, "42", (*results)[1].String())
+
+	// Importing a missing file is reported
+
+	require.True(t, repl.Accept(fmt.Sprintf("import Missing from %q\n", path.Join(t.TempDir(), "Missing.cdc"))))
+	assert.Len(t, *errs, 1)
+}
@@ -19,10 +19,12 @@
 package runtime
 
 import (
+	"encoding/binary"
 	goRuntime "runtime"
 	"time"
 	"unsafe"
 
+	"github.com/onflow/atree"
 	opentracing "github.com/opentracing/opentracing-go"
 	"golang.org/x/crypto/sha3"
 
@@ -41,7 +43,32 @@ type Script struct {
 	Arguments [][]byte
 }
 
-type importResolutionResults map[common.Location]bool
+// importResolutionResults is the stack of locations which are currently being imported,
+// in the order they were imported
+type importResolutionResults []common.Location
+
+// cycle returns the path of imports which forms a cycle if the given location is imported,
+// starting and ending with the given location, or nil if importing the location is not cyclic.
+func (results importResolutionResults) cycle(location common.Location) []common.Location {
+	for i, importedLocation := range results {
+		if importedLocation != location {
+			continue
+		}
+
+		cycle := make([]common.Location, 0, len(results)-i+1)
+		cycle = append(cycle, results[i:]...)
+		return append(cycle, location)
+	}
+
+	return nil
+}
+
+// with returns a new stack of locations, which additionally contains the given location.
+func (results importResolutionResults) with(location common.Location) importResolutionResults {
+	newResults := make(importResolutionResults, 0, len(results)+1)
+	newResults = append(newResults, results...)
+	return append(newResults, location)
+}
 
 // Runtime is a runtime capable of executing Cadence.
 type Runtime interface {
@@ -51,12 +78,38 @@ type Runtime interface {
 	// or if the execution fails.
 	ExecuteScript(Script, Context) (cadence.Value, error)
 
+	// ExecuteScriptWithEvents executes the given script, like ExecuteScript.
+	//
+	// If the context's CollectEvents flag is set, the events emitted during the execution
+	// are not emitted to the runtime interface, but are collected and returned alongside the result.
+	// Otherwise, the events are emitted to the runtime interface and no events are returned.
+	ExecuteScriptWithEvents(Script, Context) (cadence.Value, []cadence.Event, error)
+
+	// ExecuteScriptWithReadSet executes the given script, like ExecuteScript.
+	//
+	// All state read during the execution, i.e. accounts, storage paths, registers and contract code,
+	// is recorded and returned alongside the result.
+	// The read set is also returned if the execution fails.
+	ExecuteScriptWithReadSet(Script, Context) (cadence.Value, *ReadSet, error)
+
 	// ExecuteTransaction executes the given transaction.
 	//
 	// This function returns an error if the program has errors (e.g syntax errors, type errors),
 	// or if the execution fails.
 	ExecuteTransaction(Script, Context) error
 
+	// ExecuteTransactionWithChangeSet executes the given transaction, like ExecuteTransaction.
+	//
+	// The changes to the state, i.e. the values of storage paths before and after the transaction,
+	// and the created and destroyed resources, are recorded and returned.
+	ExecuteTransactionWithChangeSet(Script, Context) (*ChangeSet, error)
+
+	// ExecuteTransactionWithWriteSet executes the given transaction, like ExecuteTransaction.
+	//
+	// The final writes of registers, which are flushed at the end of the execution,
+	// are recorded and returned.
+	ExecuteTransactionWithWriteSet(Script, Context) (*WriteSet, error)
+
 	// InvokeContractFunction invokes a contract function with the given arguments.
 	//
 	// This function returns an error if the execution fails.
@@ -75,11 +128,24 @@ type Runtime interface {
 	// This function returns an error if the program contains any syntax or semantic errors.
 	ParseAndCheckProgram(source []byte, context Context) (*interpreter.Program, error)
 
+	// GetEntryPoint parses and checks the given code without executing the program,
+	// and returns the entry point of the transaction or script,
+	// i.e. its parameters, and its pre-conditions and post-conditions.
+	//
+	// This function returns nil if the program declares no transaction or script,
+	// and returns an error if the program contains any syntax or semantic errors.
+	GetEntryPoint(source []byte, context Context) (*EntryPoint, error)
+
 	// SetCoverageReport activates reporting coverage in the given report.
 	// Passing nil disables coverage reporting (default).
 	//
 	SetCoverageReport(coverageReport *CoverageReport)
 
+	// SetProfiler activates profiling using the given profiler.
+	// Passing nil disables profiling (default).
+	//
+	SetProfiler(profiler *Profiler)
+
 	// SetContractUpdateValidationEnabled configures if contract update validation is enabled.
 	//
 	SetContractUpdateValidationEnabled(enabled bool)
@@ -97,6 +163,11 @@ type Runtime interface {
 	// SetResourceOwnerChangeHandlerEnabled configures if the resource owner change callback is enabled.
 	SetResourceOwnerChangeHandlerEnabled(enabled bool)
 
+	// SetAddressValidator sets the function that is used to validate
+	// address literals, parsed addresses, and imported addresses.
+	// Passing nil disables address validation (default).
+	SetAddressValidator(validator common.AddressValidator)
+
 	// ReadStored reads the value stored at the given path
 	//
 	ReadStored(address common.Address, path cadence.Path, context Context) (cadence.Value, error)
@@ -108,6 +179,29 @@ type Runtime interface {
 	// SetDebugger configures interpreters with the given debugger.
 	//
 	SetDebugger(debugger *interpreter.Debugger)
+
+	// SetHashedContractStorageKeysEnabled configures if contract values are stored
+	// under the four-byte hash of the contract's type ID, instead of the contract's name.
+	SetHashedContractStorageKeysEnabled(enabled bool)
+
+	// SetBuiltinRegistry sets the registry that configures which standard library
+	// functions and values are available to programs.
+	// Passing nil makes all built-ins available (default).
+	SetBuiltinRegistry(registry *stdlib.BuiltinRegistry)
+
+	// SetExportLimits sets the limits for exported values,
+	// i.e. script return values and event payloads.
+	// By default, exported values are not limited.
+	SetExportLimits(limits ExportLimits)
+
+	// SetUUIDBatchSize sets the number of UUIDs which are allocated at once
+	// from the runtime interface, see UUIDBatchAllocator.
+	// A batch size of 0 or 1 generates each UUID individually (default).
+	SetUUIDBatchSize(size uint64)
+
+	// SetTransactionExecuteAuthAccountCheckEnabled configures if the execute block of a transaction
+	// is rejected to access fields of the transaction which may contain the authorizers' accounts.
+	SetTransactionExecuteAuthAccountCheckEnabled(enabled bool)
 }
 
 type ImportResolver = func(location common.Location) (program *ast.Program, e error)
@@ -154,15 +248,43 @@ func reportMetric(
 	report(metrics, elapsed)
 }
 
+// traceSpan calls the given function in a span for the given operation,
+// if the given runtime interface implements Tracer.
+//
+func traceSpan(
+	f func(),
+	runtimeInterface Interface,
+	operation string,
+	location common.Location,
+) {
+	tracer, ok := runtimeInterface.(Tracer)
+	if !ok {
+		f()
+		return
+	}
+
+	span := tracer.StartSpan(operation, location, "")
+	defer span.End()
+
+	f()
+}
+
 // interpreterRuntime is a interpreter-based version of the Flow runtime.
 type interpreterRuntime struct {
-	coverageReport                       *CoverageReport
-	debugger                             *interpreter.Debugger
-	contractUpdateValidationEnabled      bool
-	atreeValidationEnabled               bool
-	tracingEnabled                       bool
-	resourceOwnerChangeHandlerEnabled    bool
-	invalidatedResourceValidationEnabled bool
+	coverageReport                            *CoverageReport
+	profiler                                  *Profiler
+	debugger                                  *interpreter.Debugger
+	contractUpdateValidationEnabled           bool
+	atreeValidationEnabled                    bool
+	tracingEnabled                            bool
+	resourceOwnerChangeHandlerEnabled         bool
+	invalidatedResourceValidationEnabled      bool
+	addressValidator                          common.AddressValidator
+	hashedContractStorageKeysEnabled          bool
+	builtinRegistry                           *stdlib.BuiltinRegistry
+	exportLimits                              ExportLimits
+	uuidBatchSize                             uint64
+	transactionExecuteAuthAccountCheckEnabled bool
 }
 
 type Option func(Runtime)
@@ -212,6 +334,61 @@ func WithResourceOwnerChangeCallbackEnabled(enabled bool) Option {
 	}
 }
 
+// WithAddressValidator returns a runtime option
+// that configures the address validator.
+//
+func WithAddressValidator(validator common.AddressValidator) Option {
+	return func(runtime Runtime) {
+		runtime.SetAddressValidator(validator)
+	}
+}
+
+// WithHashedContractStorageKeysEnabled returns a runtime option
+// that configures if contract values are stored under hashed keys.
+//
+func WithHashedContractStorageKeysEnabled(enabled bool) Option {
+	return func(runtime Runtime) {
+		runtime.SetHashedContractStorageKeysEnabled(enabled)
+	}
+}
+
+// WithTransactionExecuteAuthAccountCheckEnabled returns a runtime option
+// that configures if the execute block of a transaction is rejected
+// to access fields of the transaction which may contain the authorizers' accounts.
+//
+func WithTransactionExecuteAuthAccountCheckEnabled(enabled bool) Option {
+	return func(runtime Runtime) {
+		runtime.SetTransactionExecuteAuthAccountCheckEnabled(enabled)
+	}
+}
+
+// WithBuiltinRegistry returns a runtime option
+// that configures which standard library functions and values are available.
+//
+func WithBuiltinRegistry(registry *stdlib.BuiltinRegistry) Option {
+	return func(runtime Runtime) {
+		runtime.SetBuiltinRegistry(registry)
+	}
+}
+
+// WithExportLimits returns a runtime option
+// that configures the limits for exported values.
+//
+func WithExportLimits(limits ExportLimits) Option {
+	return func(runtime Runtime) {
+		runtime.SetExportLimits(limits)
+	}
+}
+
+// WithUUIDBatchSize returns a runtime option
+// that configures the number of UUIDs which are allocated at once.
+//
+func WithUUIDBatchSize(size uint64) Option {
+	return func(runtime Runtime) {
+		runtime.SetUUIDBatchSize(size)
+	}
+}
+
 // NewInterpreterRuntime returns a interpreter-based version of the Flow runtime.
 func NewInterpreterRuntime(options ...Option) Runtime {
 	runtime := &interpreterRuntime{}
@@ -265,6 +442,10 @@ func (r *interpreterRuntime) SetCoverageReport(coverageReport *CoverageReport) {
 	r.coverageReport = coverageReport
 }
 
+func (r *interpreterRuntime) SetProfiler(profiler *Profiler) {
+	r.profiler = profiler
+}
+
 func (r *interpreterRuntime) SetContractUpdateValidationEnabled(enabled bool) {
 	r.contractUpdateValidationEnabled = enabled
 }
@@ -285,11 +466,86 @@ func (r *interpreterRuntime) SetResourceOwnerChangeHandlerEnabled(enabled bool)
 	r.resourceOwnerChangeHandlerEnabled = enabled
 }
 
+func (r *interpreterRuntime) SetAddressValidator(validator common.AddressValidator) {
+	r.addressValidator = validator
+}
+
 func (r *interpreterRuntime) SetDebugger(debugger *interpreter.Debugger) {
 	r.debugger = debugger
 }
 
+func (r *interpreterRuntime) SetHashedContractStorageKeysEnabled(enabled bool) {
+	r.hashedContractStorageKeysEnabled = enabled
+}
+
+func (r *interpreterRuntime) SetTransactionExecuteAuthAccountCheckEnabled(enabled bool) {
+	r.transactionExecuteAuthAccountCheckEnabled = enabled
+}
+
+func (r *interpreterRuntime) SetBuiltinRegistry(registry *stdlib.BuiltinRegistry) {
+	r.builtinRegistry = registry
+}
+
+func (r *interpreterRuntime) SetExportLimits(limits ExportLimits) {
+	r.exportLimits = limits
+}
+
+func (r *interpreterRuntime) SetUUIDBatchSize(size uint64) {
+	r.uuidBatchSize = size
+}
+
+func (r *interpreterRuntime) newStorage(ledger atree.Ledger, memoryGauge common.MemoryGauge) *Storage {
+	storage := NewStorage(ledger, memoryGauge)
+	storage.hashedContractKeysEnabled = r.hashedContractStorageKeysEnabled
+	return storage
+}
+
 func (r *interpreterRuntime) ExecuteScript(script Script, context Context) (val cadence.Value, err error) {
+	return r.executeScript(script, context)
+}
+
+func (r *interpreterRuntime) ExecuteScriptWithEvents(
+	script Script,
+	context Context,
+) (
+	val cadence.Value,
+	events []cadence.Event,
+	err error,
+) {
+	if !context.CollectEvents {
+		val, err = r.executeScript(script, context)
+		return val, nil, err
+	}
+
+	eventCollector := newEventCollectingInterface(context.Interface)
+	context.Interface = eventCollector
+
+	val, err = r.executeScript(script, context)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return val, eventCollector.events, nil
+}
+
+func (r *interpreterRuntime) ExecuteScriptWithReadSet(
+	script Script,
+	context Context,
+) (
+	val cadence.Value,
+	readSet *ReadSet,
+	err error,
+) {
+	recorder := newReadSetRecorder()
+	context.readSetRecorder = recorder
+	context.Interface = newReadSetRecordingInterface(context.Interface, recorder)
+
+	val, err = r.executeScript(script, context)
+
+	return val, &recorder.readSet, err
+}
+
+func (r *interpreterRuntime) executeScript(script Script, context Context) (val cadence.Value, err error) {
 	defer r.Recover(
 		func(internalErr Error) {
 			err = internalErr
@@ -301,7 +557,7 @@ func (r *interpreterRuntime) ExecuteScript(script Script, context Context) (val
 
 	memoryGauge, _ := context.Interface.(common.MemoryGauge)
 
-	storage := NewStorage(context.Interface, memoryGauge)
+	storage := r.newStorage(context.Interface, memoryGauge)
 
 	var checkerOptions []sema.Option
 	var interpreterOptions []interpreter.Option
@@ -317,7 +573,7 @@ func (r *interpreterRuntime) ExecuteScript(script Script, context Context) (val
 		script.Source,
 		context,
 		functions,
-		stdlib.BuiltinValues,
+		r.standardLibraryValues(),
 		checkerOptions,
 		true,
 		importResolutionResults{},
@@ -363,7 +619,7 @@ func (r *interpreterRuntime) ExecuteScript(script Script, context Context) (val
 		context,
 		storage,
 		functions,
-		stdlib.BuiltinValues,
+		r.standardLibraryValues(),
 		interpreterOptions,
 		checkerOptions,
 		interpret,
@@ -374,7 +630,11 @@ func (r *interpreterRuntime) ExecuteScript(script Script, context Context) (val
 
 	// Export before committing storage
 
-	result, err := exportValue(value, interpreter.ReturnEmptyLocationRange)
+	result, err := exportValueWithinLimits(
+		value,
+		interpreter.ReturnEmptyLocationRange,
+		r.exportLimits,
+	)
 	if err != nil {
 		return nil, newError(err, context)
 	}
@@ -471,13 +731,24 @@ func (r *interpreterRuntime) interpret(
 
 	var result interpreter.Value
 
+	if r.profiler != nil {
+		r.profiler.startExecution()
+	}
+
 	reportMetric(
 		func() {
-			err = inter.Interpret()
-			if err != nil || f == nil {
-				return
-			}
-			result, err = f(inter)
+			traceSpan(
+				func() {
+					err = inter.Interpret()
+					if err != nil || f == nil {
+						return
+					}
+					result, err = f(inter)
+				},
+				context.Interface,
+				TraceOperationInterpret,
+				context.Location,
+			)
 		},
 		context.Interface,
 		func(metrics Metrics, duration time.Duration) {
@@ -534,6 +805,13 @@ func (r *interpreterRuntime) newAuthAccountValue(
 				context.Interface,
 			)
 		},
+		func() interpreter.Value {
+			return r.newAuthAccountInbox(
+				inter,
+				addressValue,
+				context.Interface,
+			)
+		},
 	)
 }
 
@@ -555,7 +833,7 @@ func (r *interpreterRuntime) InvokeContractFunction(
 
 	memoryGauge, _ := context.Interface.(common.MemoryGauge)
 
-	storage := NewStorage(context.Interface, memoryGauge)
+	storage := r.newStorage(context.Interface, memoryGauge)
 
 	var interpreterOptions []interpreter.Option
 	var checkerOptions []sema.Option
@@ -573,7 +851,7 @@ func (r *interpreterRuntime) InvokeContractFunction(
 		context,
 		storage,
 		functions,
-		stdlib.BuiltinValues,
+		r.standardLibraryValues(),
 		interpreterOptions,
 		checkerOptions,
 		nil,
@@ -690,6 +968,46 @@ func (r *interpreterRuntime) convertArgument(
 }
 
 func (r *interpreterRuntime) ExecuteTransaction(script Script, context Context) (err error) {
+	return r.executeTransaction(script, context)
+}
+
+func (r *interpreterRuntime) ExecuteTransactionWithChangeSet(
+	script Script,
+	context Context,
+) (
+	changeSet *ChangeSet,
+	err error,
+) {
+	recorder := newChangeSetRecorder()
+	context.changeSetRecorder = recorder
+
+	err = r.executeTransaction(script, context)
+	if err != nil {
+		return nil, err
+	}
+
+	return &recorder.changeSet, nil
+}
+
+func (r *interpreterRuntime) ExecuteTransactionWithWriteSet(
+	script Script,
+	context Context,
+) (
+	writeSet *WriteSet,
+	err error,
+) {
+	recorder := newWriteSetRecorder()
+	context.Interface = newWriteSetRecordingInterface(context.Interface, recorder)
+
+	err = r.executeTransaction(script, context)
+	if err != nil {
+		return nil, err
+	}
+
+	return &recorder.writeSet, nil
+}
+
+func (r *interpreterRuntime) executeTransaction(script Script, context Context) (err error) {
 	defer r.Recover(
 		func(internalErr Error) {
 			err = internalErr
@@ -701,7 +1019,7 @@ func (r *interpreterRuntime) ExecuteTransaction(script Script, context Context)
 
 	memoryGauge, _ := context.Interface.(common.MemoryGauge)
 
-	storage := NewStorage(context.Interface, memoryGauge)
+	storage := r.newStorage(context.Interface, memoryGauge)
 
 	var interpreterOptions []interpreter.Option
 	var checkerOptions []sema.Option
@@ -717,7 +1035,7 @@ func (r *interpreterRuntime) ExecuteTransaction(script Script, context Context)
 		script.Source,
 		context,
 		functions,
-		stdlib.BuiltinValues,
+		r.standardLibraryValues(),
 		checkerOptions,
 		true,
 		importResolutionResults{},
@@ -795,7 +1113,7 @@ func (r *interpreterRuntime) ExecuteTransaction(script Script, context Context)
 		context,
 		storage,
 		functions,
-		stdlib.BuiltinValues,
+		r.standardLibraryValues(),
 		interpreterOptions,
 		checkerOptions,
 		r.transactionExecutionFunction(
@@ -809,6 +1127,15 @@ func (r *interpreterRuntime) ExecuteTransaction(script Script, context Context)
 		return newError(err, context)
 	}
 
+	if recorder := context.changeSetRecorder; recorder != nil {
+		err = userPanicToError(func() {
+			recorder.finish(inter)
+		})
+		if err != nil {
+			return newError(err, context)
+		}
+	}
+
 	// Write back all stored values, which were actually just cached, back into storage
 	err = r.commitStorage(storage, inter)
 	if err != nil {
@@ -896,9 +1223,39 @@ func (r *interpreterRuntime) transactionExecutionFunction(
 	}
 }
 
+// ArgumentDecoder decodes encoded entry point arguments against their expected types.
+//
+type ArgumentDecoder interface {
+	DecodeArgument(argument []byte, argumentType cadence.Type) (cadence.Value, error)
+}
+
+// ValidateArguments decodes the given entry point arguments using the given decoder,
+// imports them, and ensures they are valid for the given parameters.
+//
+// Note: This function is not used directly within Cadence, but used by embedders
+// which execute programs without a full runtime interface, e.g. the C library.
+//
+func ValidateArguments(
+	inter *interpreter.Interpreter,
+	decoder ArgumentDecoder,
+	arguments [][]byte,
+	parameters []*sema.Parameter,
+) (
+	[]interpreter.Value,
+	error,
+) {
+	return validateArgumentParams(
+		inter,
+		decoder,
+		interpreter.ReturnEmptyLocationRange,
+		arguments,
+		parameters,
+	)
+}
+
 func validateArgumentParams(
 	inter *interpreter.Interpreter,
-	runtimeInterface Interface,
+	decoder ArgumentDecoder,
 	getLocationRange func() interpreter.LocationRange,
 	arguments [][]byte,
 	parameters []*sema.Parameter,
@@ -928,7 +1285,7 @@ func validateArgumentParams(
 		var err error
 
 		wrapPanic(func() {
-			value, err = runtimeInterface.DecodeArgument(
+			value, err = decoder.DecodeArgument(
 				argument,
 				exportedParameterType,
 			)
@@ -936,8 +1293,11 @@ func validateArgumentParams(
 
 		if err != nil {
 			return nil, &InvalidEntryPointArgumentError{
-				Index: i,
-				Err:   err,
+				Index:         i,
+				ParameterName: parameter.Identifier,
+				ExpectedType:  parameterType,
+				Argument:      argument,
+				Err:           err,
 			}
 		}
 
@@ -954,15 +1314,21 @@ func validateArgumentParams(
 
 		if panicError != nil {
 			return nil, &InvalidEntryPointArgumentError{
-				Index: i,
-				Err:   panicError,
+				Index:         i,
+				ParameterName: parameter.Identifier,
+				ExpectedType:  parameterType,
+				Argument:      argument,
+				Err:           panicError,
 			}
 		}
 
 		if err != nil {
 			return nil, &InvalidEntryPointArgumentError{
-				Index: i,
-				Err:   err,
+				Index:         i,
+				ParameterName: parameter.Identifier,
+				ExpectedType:  parameterType,
+				Argument:      argument,
+				Err:           err,
 			}
 		}
 
@@ -978,7 +1344,10 @@ func validateArgumentParams(
 		// Check that decoded value is a subtype of static parameter type
 		if !inter.IsSubTypeOfSemaType(argType, parameterType) {
 			return nil, &InvalidEntryPointArgumentError{
-				Index: i,
+				Index:         i,
+				ParameterName: parameter.Identifier,
+				ExpectedType:  parameterType,
+				Argument:      argument,
 				Err: &InvalidValueTypeError{
 					ExpectedType: parameterType,
 				},
@@ -992,7 +1361,10 @@ func validateArgumentParams(
 			interpreter.TypeConformanceResults{},
 		) {
 			return nil, &InvalidEntryPointArgumentError{
-				Index: i,
+				Index:         i,
+				ParameterName: parameter.Identifier,
+				ExpectedType:  parameterType,
+				Argument:      argument,
 				Err: &MalformedValueError{
 					ExpectedType: parameterType,
 				},
@@ -1053,7 +1425,7 @@ func (r *interpreterRuntime) ParseAndCheckProgram(
 
 	memoryGauge, _ := context.Interface.(common.MemoryGauge)
 
-	storage := NewStorage(context.Interface, memoryGauge)
+	storage := r.newStorage(context.Interface, memoryGauge)
 
 	var interpreterOptions []interpreter.Option
 	var checkerOptions []sema.Option
@@ -1069,7 +1441,7 @@ func (r *interpreterRuntime) ParseAndCheckProgram(
 		code,
 		context,
 		functions,
-		stdlib.BuiltinValues,
+		r.standardLibraryValues(),
 		checkerOptions,
 		true,
 		importResolutionResults{},
@@ -1081,6 +1453,23 @@ func (r *interpreterRuntime) ParseAndCheckProgram(
 	return program, nil
 }
 
+func (r *interpreterRuntime) GetEntryPoint(
+	code []byte,
+	context Context,
+) (
+	*EntryPoint,
+	error,
+) {
+	program, err := r.ParseAndCheckProgram(code, context)
+	if err != nil {
+		return nil, err
+	}
+
+	memoryGauge, _ := context.Interface.(common.MemoryGauge)
+
+	return NewEntryPoint(memoryGauge, code, program), nil
+}
+
 func (r *interpreterRuntime) parseAndCheckProgram(
 	code []byte,
 	context Context,
@@ -1111,7 +1500,14 @@ func (r *interpreterRuntime) parseAndCheckProgram(
 	var parse *ast.Program
 	reportMetric(
 		func() {
-			parse, err = parser.ParseProgram(string(code), memoryGauge)
+			traceSpan(
+				func() {
+					parse, err = parser.ParseProgram(string(code), memoryGauge)
+				},
+				context.Interface,
+				TraceOperationParse,
+				context.Location,
+			)
 		},
 		context.Interface,
 		func(metrics Metrics, duration time.Duration) {
@@ -1171,6 +1567,17 @@ func (r *interpreterRuntime) check(
 		valueDeclarations = append(valueDeclarations, predeclaredValue)
 	}
 
+	typeDeclarations := make(
+		[]sema.TypeDeclaration,
+		0,
+		len(stdlib.FlowDefaultPredeclaredTypes)+len(startContext.PredeclaredTypes),
+	)
+	typeDeclarations = append(typeDeclarations, stdlib.FlowDefaultPredeclaredTypes...)
+
+	for _, predeclaredType := range startContext.PredeclaredTypes {
+		typeDeclarations = append(typeDeclarations, predeclaredType)
+	}
+
 	memoryGauge, _ := startContext.Interface.(common.MemoryGauge)
 
 	checker, err := sema.NewChecker(
@@ -1181,10 +1588,16 @@ func (r *interpreterRuntime) check(
 		append(
 			[]sema.Option{
 				sema.WithPredeclaredValues(valueDeclarations),
-				sema.WithPredeclaredTypes(stdlib.FlowDefaultPredeclaredTypes),
+				sema.WithPredeclaredTypes(typeDeclarations),
+				sema.WithAddressValidator(r.addressValidator),
 				sema.WithValidTopLevelDeclarationsHandler(validTopLevelDeclarations),
+				sema.WithTransactionExecuteAuthAccountCheckEnabled(r.transactionExecuteAuthAccountCheckEnabled),
 				sema.WithLocationHandler(
 					func(identifiers []Identifier, location Location) (res []ResolvedLocation, err error) {
+						if res, ok := resolveSameAccountLocation(startContext.Location, location); ok {
+							return res, nil
+						}
+
 						wrapPanic(func() {
 							res, err = startContext.Interface.ResolveLocation(identifiers, location)
 						})
@@ -1203,17 +1616,31 @@ func (r *interpreterRuntime) check(
 							context := startContext.WithLocation(importedLocation)
 
 							// Check for cyclic imports
-							if checkedImports[importedLocation] {
+							cycle := checkedImports.cycle(importedLocation)
+							if cycle != nil {
 								return nil, &sema.CyclicImportsError{
 									Location: importedLocation,
+									Cycle:    cycle,
 									Range:    importRange,
 								}
-							} else {
-								checkedImports[importedLocation] = true
-								defer delete(checkedImports, importedLocation)
 							}
 
-							program, err := r.getProgram(context, functions, values, checkerOptions, checkedImports)
+							var program *interpreter.Program
+							var err error
+							traceSpan(
+								func() {
+									program, err = r.getProgram(
+										context,
+										functions,
+										values,
+										checkerOptions,
+										checkedImports.with(importedLocation),
+									)
+								},
+								context.Interface,
+								TraceOperationImport,
+								importedLocation,
+							)
 							if err != nil {
 								return nil, err
 							}
@@ -1228,7 +1655,14 @@ func (r *interpreterRuntime) check(
 				),
 				sema.WithCheckHandler(func(location common.Location, check func()) {
 					reportMetric(
-						check,
+						func() {
+							traceSpan(
+								check,
+								startContext.Interface,
+								TraceOperationCheck,
+								location,
+							)
+						},
 						startContext.Interface,
 						func(metrics Metrics, duration time.Duration) {
 							metrics.ProgramChecked(location, duration)
@@ -1253,6 +1687,39 @@ func (r *interpreterRuntime) check(
 	return elaboration, nil
 }
 
+// resolveSameAccountLocation resolves an import of an identifier location,
+// e.g. `import B`, in a program deployed to an account, e.g. contract `A` in account 0x1,
+// to the contract with the same name in the same account, i.e. `0x1.B`.
+//
+// This allows contracts of an account to import each other by name.
+// Built-in identifier locations, like `Crypto`, take precedence.
+//
+func resolveSameAccountLocation(importingLocation, location Location) ([]ResolvedLocation, bool) {
+	identifierLocation, ok := location.(common.IdentifierLocation)
+	if !ok || identifierLocation == stdlib.CryptoChecker.Location {
+		return nil, false
+	}
+
+	addressLocation, ok := importingLocation.(common.AddressLocation)
+	if !ok {
+		return nil, false
+	}
+
+	name := string(identifierLocation)
+
+	return []ResolvedLocation{
+		{
+			Location: common.AddressLocation{
+				Address: addressLocation.Address,
+				Name:    name,
+			},
+			Identifiers: []ast.Identifier{
+				{Identifier: name},
+			},
+		},
+	}, true
+}
+
 func (r *interpreterRuntime) newInterpreter(
 	program *interpreter.Program,
 	context Context,
@@ -1309,12 +1776,9 @@ func (r *interpreterRuntime) newInterpreter(
 		interpreter.WithInjectedCompositeFieldsHandler(
 			r.injectedCompositeFieldsHandler(context, storage, interpreterOptions, checkerOptions),
 		),
-		interpreter.WithUUIDHandler(func() (uuid uint64, err error) {
-			wrapPanic(func() {
-				uuid, err = context.Interface.GenerateUUID()
-			})
-			return
-		}),
+		interpreter.WithUUIDHandler(
+			newUUIDAllocator(context.Interface, r.uuidBatchSize).nextUUID,
+		),
 		interpreter.WithContractValueHandler(
 			func(
 				inter *interpreter.Interpreter,
@@ -1429,6 +1893,26 @@ func (r *interpreterRuntime) newInterpreter(
 				)
 			},
 		),
+		interpreter.WithAccountProofHandler(
+			func(
+				inter *interpreter.Interpreter,
+				getLocationRange func() interpreter.LocationRange,
+				address interpreter.AddressValue,
+				message *interpreter.ArrayValue,
+				signatures *interpreter.ArrayValue,
+				keyIndices *interpreter.ArrayValue,
+			) interpreter.BoolValue {
+				return verifyAccountProof(
+					inter,
+					getLocationRange,
+					address,
+					message,
+					signatures,
+					keyIndices,
+					context.Interface,
+				)
+			},
+		),
 		interpreter.WithOnRecordTraceHandler(
 			func(
 				interpreter *interpreter.Interpreter,
@@ -1440,6 +1924,7 @@ func (r *interpreterRuntime) newInterpreter(
 			},
 		),
 		interpreter.WithTracingEnabled(r.tracingEnabled),
+		interpreter.WithAddressValidator(r.addressValidator),
 		interpreter.WithAtreeValueValidationEnabled(r.atreeValidationEnabled),
 		// NOTE: ignore r.atreeValidationEnabled here,
 		// and disable storage validation after each value modification.
@@ -1455,6 +1940,32 @@ func (r *interpreterRuntime) newInterpreter(
 		r.meteringInterpreterOptions(context.Interface)...,
 	)
 
+	if recorder := context.readSetRecorder; recorder != nil {
+		defaultOptions = append(defaultOptions,
+			interpreter.WithOnStorageReadHandler(recorder.recordPathRead),
+		)
+	}
+
+	if recorder := context.changeSetRecorder; recorder != nil {
+		defaultOptions = append(defaultOptions,
+			interpreter.WithOnStorageReadHandler(recorder.recordPathAccess),
+			interpreter.WithOnStorageWriteHandler(recorder.recordPathAccess),
+			interpreter.WithOnResourceCreatedHandler(recorder.recordResourceCreated),
+			interpreter.WithOnResourceDestroyedHandler(recorder.recordResourceDestroyed),
+		)
+	}
+
+	if tracer, ok := context.Interface.(Tracer); ok {
+		defaultOptions = append(defaultOptions,
+			interpreter.WithOnHostFunctionInvocationHandler(
+				func(inter *interpreter.Interpreter, functionName string) func() {
+					span := tracer.StartSpan(TraceOperationHostFunctionCall, inter.Location, functionName)
+					return span.End
+				},
+			),
+		)
+	}
+
 	return interpreter.NewInterpreter(
 		program,
 		context.Location,
@@ -1487,7 +1998,16 @@ func (r *interpreterRuntime) importLocationHandler(
 		default:
 			context := startContext.WithLocation(location)
 
-			program, err := r.getProgram(context, functions, values, checkerOptions, importResolutionResults{})
+			var program *interpreter.Program
+			var err error
+			traceSpan(
+				func() {
+					program, err = r.getProgram(context, functions, values, checkerOptions, importResolutionResults{})
+				},
+				context.Interface,
+				TraceOperationImport,
+				location,
+			)
 			if err != nil {
 				panic(err)
 			}
@@ -1665,7 +2185,14 @@ func (r *interpreterRuntime) standardLibraryFunctions(
 		Log:             r.newLogFunction(context.Interface),
 		GetCurrentBlock: r.newGetCurrentBlockFunction(context.Interface),
 		GetBlock:        r.newGetBlockFunction(context.Interface),
+		GetBlockHeader:  r.newGetBlockHeaderFunction(context.Interface),
 		UnsafeRandom:    r.newUnsafeRandomFunction(context.Interface),
+		RevertibleRandom: stdlib.NewRevertibleRandomFunction(
+			newReadRandomUInt64Function(context.Interface),
+		),
+		RevertibleRandomInRange: stdlib.NewRevertibleRandomInRangeFunction(
+			newReadRandomUInt64Function(context.Interface),
+		),
 	})
 
 	switch context.Location.(type) {
@@ -1681,12 +2208,18 @@ func (r *interpreterRuntime) standardLibraryFunctions(
 		)
 	}
 
-	return append(
-		builtins,
-		stdlib.BuiltinFunctions...,
+	return r.builtinRegistry.Functions(
+		append(
+			builtins,
+			stdlib.BuiltinFunctions...,
+		),
 	)
 }
 
+func (r *interpreterRuntime) standardLibraryValues() stdlib.StandardLibraryValues {
+	return r.builtinRegistry.Values(stdlib.BuiltinValues)
+}
+
 func (r *interpreterRuntime) getCode(context Context) (code []byte, err error) {
 	if addressLocation, ok := context.Location.(common.AddressLocation); ok {
 		wrapPanic(func() {
@@ -1732,6 +2265,7 @@ func (r *interpreterRuntime) emitEvent(
 		eventValue,
 		getLocationRange,
 		seenReferences{},
+		newExportLimitTracker(r.exportLimits),
 	)
 	if err != nil {
 		return err
@@ -1771,6 +2305,7 @@ func (r *interpreterRuntime) emitAccountEvent(
 		eventValue,
 		getLocationRange,
 		seenReferences{},
+		nil,
 	)
 	if err != nil {
 		panic(err)
@@ -1822,6 +2357,8 @@ func (r *interpreterRuntime) newCreateAccountFunction(
 		payerAddress := payerAddressValue.(interpreter.AddressValue).ToAddress()
 
 		addressGetter := func() (address common.Address) {
+			invocation.Interpreter.RecordEffect()
+
 			var err error
 			wrapPanic(func() {
 				address, err = context.Interface.CreateAccount(payerAddress)
@@ -2004,6 +2541,8 @@ func (r *interpreterRuntime) newAddPublicKeyFunction(
 				panic("addPublicKey requires the first argument to be a byte array")
 			}
 
+			invocation.Interpreter.RecordEffect()
+
 			wrapPanic(func() {
 				err = runtimeInterface.AddEncodedAccountKey(address, publicKey)
 			})
@@ -2047,6 +2586,8 @@ func (r *interpreterRuntime) newRemovePublicKeyFunction(
 				panic(runtimeErrors.NewUnreachableError())
 			}
 
+			invocation.Interpreter.RecordEffect()
+
 			var publicKey []byte
 			var err error
 			wrapPanic(func() {
@@ -2123,14 +2664,11 @@ func (r *interpreterRuntime) loadContract(
 		switch location := compositeType.Location.(type) {
 
 		case common.AddressLocation:
-			storageMap := storage.GetStorageMap(
+			storedValue = storage.readContractValue(
+				inter,
 				location.Address,
-				StorageDomainContract,
-				false,
+				location.Name,
 			)
-			if storageMap != nil {
-				storedValue = storageMap.ReadValue(inter, location.Name)
-			}
 		}
 
 		if storedValue == nil {
@@ -2446,9 +2984,50 @@ func (r *interpreterRuntime) newGetBlockFunction(runtimeInterface Interface) int
 	}
 }
 
-func (r *interpreterRuntime) newUnsafeRandomFunction(runtimeInterface Interface) interpreter.HostFunction {
+func (r *interpreterRuntime) newGetBlockHeaderFunction(runtimeInterface Interface) interpreter.HostFunction {
 	return func(invocation interpreter.Invocation) interpreter.Value {
-		return interpreter.NewUInt64Value(
+		heightValue, ok := invocation.Arguments[0].(interpreter.UInt64Value)
+		if !ok {
+			panic(runtimeErrors.NewUnreachableError())
+		}
+
+		var header BlockHeader
+		var exists bool
+		var err error
+
+		wrapPanic(func() {
+			header, exists, err = runtimeInterface.GetBlockHeaderAtHeight(uint64(heightValue))
+		})
+		if err != nil {
+			panic(err)
+		}
+
+		if !exists {
+			return interpreter.NewNilValue(invocation.Interpreter)
+		}
+
+		block := Block{
+			Height:     header.Height,
+			View:       header.View,
+			Hash:       header.Hash,
+			Timestamp:  header.Timestamp,
+			ParentHash: header.ParentHash,
+		}
+
+		return interpreter.NewSomeValueNonCopying(
+			invocation.Interpreter,
+			NewBlockValue(
+				invocation.Interpreter,
+				invocation.GetLocationRange,
+				block,
+			),
+		)
+	}
+}
+
+func (r *interpreterRuntime) newUnsafeRandomFunction(runtimeInterface Interface) interpreter.HostFunction {
+	return func(invocation interpreter.Invocation) interpreter.Value {
+		return interpreter.NewUInt64Value(
 			invocation.Interpreter,
 			func() uint64 {
 				var rand uint64
@@ -2465,6 +3044,23 @@ func (r *interpreterRuntime) newUnsafeRandomFunction(runtimeInterface Interface)
 	}
 }
 
+// newReadRandomUInt64Function returns a function which reads
+// a random uint64 from the secure source of randomness of the given runtime interface
+//
+func newReadRandomUInt64Function(runtimeInterface Interface) func() uint64 {
+	return func() uint64 {
+		var buffer [8]byte
+		var err error
+		wrapPanic(func() {
+			err = runtimeInterface.ReadRandom(buffer[:])
+		})
+		if err != nil {
+			panic(err)
+		}
+		return binary.LittleEndian.Uint64(buffer[:])
+	}
+}
+
 func (r *interpreterRuntime) newAuthAccountContracts(
 	inter *interpreter.Interpreter,
 	addressValue interpreter.AddressValue,
@@ -2538,6 +3134,275 @@ func (r *interpreterRuntime) newAuthAccountKeys(
 	)
 }
 
+func (r *interpreterRuntime) newAuthAccountInbox(
+	inter *interpreter.Interpreter,
+	addressValue interpreter.AddressValue,
+	runtimeInterface Interface,
+) interpreter.Value {
+	return interpreter.NewAuthAccountInboxValue(
+		inter,
+		addressValue,
+		r.newAccountInboxPublishFunction(
+			inter,
+			addressValue,
+			runtimeInterface,
+		),
+		r.newAccountInboxUnpublishFunction(
+			inter,
+			addressValue,
+			runtimeInterface,
+		),
+		r.newAccountInboxClaimFunction(
+			inter,
+			addressValue,
+			runtimeInterface,
+		),
+	)
+}
+
+func (r *interpreterRuntime) newAccountInboxPublishFunction(
+	inter *interpreter.Interpreter,
+	providerValue interpreter.AddressValue,
+	runtimeInterface Interface,
+) *interpreter.HostFunctionValue {
+
+	// Converted addresses can be cached and don't have to be recomputed on each function invocation
+	provider := providerValue.ToAddress()
+
+	return interpreter.NewHostFunctionValue(
+		inter,
+		func(invocation interpreter.Invocation) interpreter.Value {
+			capabilityValue, ok := invocation.Arguments[0].(*interpreter.CapabilityValue)
+			if !ok {
+				panic(runtimeErrors.NewUnreachableError())
+			}
+
+			nameValue, ok := invocation.Arguments[1].(*interpreter.StringValue)
+			if !ok {
+				panic(runtimeErrors.NewUnreachableError())
+			}
+
+			recipientValue, ok := invocation.Arguments[2].(interpreter.AddressValue)
+			if !ok {
+				panic(runtimeErrors.NewUnreachableError())
+			}
+
+			inter := invocation.Interpreter
+			getLocationRange := invocation.GetLocationRange
+
+			r.emitAccountEvent(
+				inter,
+				stdlib.AccountInboxPublishedEventType,
+				runtimeInterface,
+				[]exportableValue{
+					newExportableValue(providerValue, inter),
+					newExportableValue(recipientValue, inter),
+					newExportableValue(nameValue, inter),
+					newExportableValue(
+						interpreter.NewTypeValue(inter, capabilityValue.StaticType(inter)),
+						inter,
+					),
+				},
+				getLocationRange,
+			)
+
+			publishedValue := interpreter.NewPublishedValue(
+				inter,
+				recipientValue,
+				capabilityValue,
+			).Transfer(
+				inter,
+				getLocationRange,
+				atree.Address(provider),
+				true,
+				nil,
+			)
+
+			// Publishing under an already used name overwrites the existing value
+			inter.WriteStored(provider, StorageDomainInbox, nameValue.Str, publishedValue, getLocationRange)
+
+			return interpreter.NewVoidValue(inter)
+		},
+		sema.AuthAccountInboxTypePublishFunctionType,
+	)
+}
+
+func (r *interpreterRuntime) newAccountInboxUnpublishFunction(
+	inter *interpreter.Interpreter,
+	providerValue interpreter.AddressValue,
+	runtimeInterface Interface,
+) *interpreter.HostFunctionValue {
+
+	// Converted addresses can be cached and don't have to be recomputed on each function invocation
+	provider := providerValue.ToAddress()
+
+	return interpreter.NewHostFunctionValue(
+		inter,
+		func(invocation interpreter.Invocation) interpreter.Value {
+			nameValue, ok := invocation.Arguments[0].(*interpreter.StringValue)
+			if !ok {
+				panic(runtimeErrors.NewUnreachableError())
+			}
+
+			inter := invocation.Interpreter
+			getLocationRange := invocation.GetLocationRange
+
+			publishedValue := readInboxValue(inter, provider, nameValue.Str)
+			if publishedValue == nil {
+				return interpreter.NewNilValue(inter)
+			}
+
+			capabilityValue := takeInboxValue(
+				inter,
+				provider,
+				nameValue.Str,
+				publishedValue,
+				invocation,
+			)
+
+			r.emitAccountEvent(
+				inter,
+				stdlib.AccountInboxUnpublishedEventType,
+				runtimeInterface,
+				[]exportableValue{
+					newExportableValue(providerValue, inter),
+					newExportableValue(nameValue, inter),
+				},
+				getLocationRange,
+			)
+
+			return interpreter.NewSomeValueNonCopying(inter, capabilityValue)
+		},
+		sema.AuthAccountInboxTypeUnpublishFunctionType,
+	)
+}
+
+func (r *interpreterRuntime) newAccountInboxClaimFunction(
+	inter *interpreter.Interpreter,
+	recipientValue interpreter.AddressValue,
+	runtimeInterface Interface,
+) *interpreter.HostFunctionValue {
+	return interpreter.NewHostFunctionValue(
+		inter,
+		func(invocation interpreter.Invocation) interpreter.Value {
+			nameValue, ok := invocation.Arguments[0].(*interpreter.StringValue)
+			if !ok {
+				panic(runtimeErrors.NewUnreachableError())
+			}
+
+			providerValue, ok := invocation.Arguments[1].(interpreter.AddressValue)
+			if !ok {
+				panic(runtimeErrors.NewUnreachableError())
+			}
+
+			inter := invocation.Interpreter
+			getLocationRange := invocation.GetLocationRange
+
+			provider := providerValue.ToAddress()
+
+			publishedValue := readInboxValue(inter, provider, nameValue.Str)
+
+			// Only the recipient the value was published for can claim it
+
+			if publishedValue == nil ||
+				!publishedValue.Recipient.Equal(inter, getLocationRange, recipientValue) {
+
+				return interpreter.NewNilValue(inter)
+			}
+
+			capabilityValue := takeInboxValue(
+				inter,
+				provider,
+				nameValue.Str,
+				publishedValue,
+				invocation,
+			)
+
+			r.emitAccountEvent(
+				inter,
+				stdlib.AccountInboxClaimedEventType,
+				runtimeInterface,
+				[]exportableValue{
+					newExportableValue(providerValue, inter),
+					newExportableValue(recipientValue, inter),
+					newExportableValue(nameValue, inter),
+				},
+				getLocationRange,
+			)
+
+			return interpreter.NewSomeValueNonCopying(inter, capabilityValue)
+		},
+		sema.AuthAccountInboxTypeClaimFunctionType,
+	)
+}
+
+// readInboxValue returns the value published by the given provider under the given name,
+// or nil if no value was published.
+//
+func readInboxValue(
+	inter *interpreter.Interpreter,
+	provider common.Address,
+	name string,
+) *interpreter.PublishedValue {
+	value := inter.ReadStored(provider, StorageDomainInbox, name)
+	if value == nil {
+		return nil
+	}
+
+	publishedValue, ok := value.(*interpreter.PublishedValue)
+	if !ok {
+		panic(runtimeErrors.NewUnreachableError())
+	}
+
+	return publishedValue
+}
+
+// takeInboxValue removes the given published value from the provider's inbox,
+// and returns the published capability.
+//
+// The capability must be a subtype of the capability type
+// requested through the invocation's type argument,
+// otherwise the value is left in the inbox.
+//
+func takeInboxValue(
+	inter *interpreter.Interpreter,
+	provider common.Address,
+	name string,
+	publishedValue *interpreter.PublishedValue,
+	invocation interpreter.Invocation,
+) interpreter.Value {
+
+	typeParameterPair := invocation.TypeParameterTypes.Oldest()
+	if typeParameterPair == nil {
+		panic(runtimeErrors.NewUnreachableError())
+	}
+
+	getLocationRange := invocation.GetLocationRange
+
+	expectedType := sema.NewCapabilityType(inter, typeParameterPair.Value)
+	capabilityStaticType := publishedValue.Value.StaticType(inter)
+
+	if !inter.IsSubTypeOfSemaType(capabilityStaticType, expectedType) {
+		panic(interpreter.ForceCastTypeMismatchError{
+			ExpectedType:  expectedType,
+			ActualType:    inter.MustConvertStaticToSemaType(capabilityStaticType),
+			LocationRange: getLocationRange(),
+		})
+	}
+
+	capabilityValue := publishedValue.Value.Transfer(
+		inter,
+		getLocationRange,
+		atree.Address{},
+		false,
+		nil,
+	)
+
+	inter.WriteStored(provider, StorageDomainInbox, name, nil, getLocationRange)
+
+	return capabilityValue
+}
+
 // newAuthAccountContractsChangeFunction called when e.g.
 // - adding: `AuthAccount.contracts.add(name: "Foo", code: [...])` (isUpdate = false)
 // - updating: `AuthAccount.contracts.update__experimental(name: "Foo", code: [...])` (isUpdate = true)
@@ -2658,7 +3523,7 @@ func (r *interpreterRuntime) newAuthAccountContractsChangeFunction(
 				code,
 				context,
 				functions,
-				stdlib.BuiltinValues,
+				r.standardLibraryValues(),
 				checkerOptions,
 				storeProgram,
 				importResolutionResults{},
@@ -2897,7 +3762,7 @@ func (r *interpreterRuntime) updateAccountContractCode(
 	if createContract {
 
 		functions := r.standardLibraryFunctions(context, storage, interpreterOptions, checkerOptions)
-		values := stdlib.BuiltinValues
+		values := r.standardLibraryValues()
 
 		contractValue, err = r.instantiateContract(
 			program,
@@ -2919,6 +3784,8 @@ func (r *interpreterRuntime) updateAccountContractCode(
 	}
 
 	// NOTE: only update account code if contract instantiation succeeded
+	inter.RecordEffect()
+
 	wrapPanic(func() {
 		err = context.Interface.UpdateAccountContractCode(address, name, code)
 	})
@@ -3046,6 +3913,8 @@ func (r *interpreterRuntime) newAuthAccountContractsRemoveFunction(
 					}
 				}
 
+				invocation.Interpreter.RecordEffect()
+
 				wrapPanic(func() {
 					err = runtimeInterface.RemoveAccountContractCode(address, name)
 				})
@@ -3152,14 +4021,20 @@ func (r *interpreterRuntime) newAccountContractsGetNamesFunction(
 }
 
 func (r *interpreterRuntime) onStatementHandler() interpreter.OnStatementFunc {
-	if r.coverageReport == nil {
+	if r.coverageReport == nil && r.profiler == nil {
 		return nil
 	}
 
 	return func(inter *interpreter.Interpreter, statement ast.Statement) {
-		location := inter.Location
-		line := statement.StartPosition().Line
-		r.coverageReport.AddLineHit(location, line)
+		if r.coverageReport != nil {
+			location := inter.Location
+			line := statement.StartPosition().Line
+			r.coverageReport.AddLineHit(location, line)
+		}
+
+		if r.profiler != nil {
+			r.profiler.recordStatement(inter, statement)
+		}
 	}
 }
 
@@ -3170,7 +4045,7 @@ func (r *interpreterRuntime) executeNonProgram(interpret interpretFunc, context
 
 	memoryGauge, _ := context.Interface.(common.MemoryGauge)
 
-	storage := NewStorage(context.Interface, memoryGauge)
+	storage := r.newStorage(context.Interface, memoryGauge)
 
 	var functions stdlib.StandardLibraryFunctions
 	var values stdlib.StandardLibraryValues
@@ -3215,7 +4090,10 @@ func (r *interpreterRuntime) ReadStored(
 
 	return r.executeNonProgram(
 		func(inter *interpreter.Interpreter) (interpreter.Value, error) {
-			pathValue := importPathValue(inter, path)
+			pathValue, err := importPathValue(inter, path)
+			if err != nil {
+				return nil, err
+			}
 
 			domain := pathValue.Domain.Identifier()
 			identifier := pathValue.Identifier
@@ -3245,9 +4123,14 @@ func (r *interpreterRuntime) ReadLinked(
 
 	return r.executeNonProgram(
 		func(inter *interpreter.Interpreter) (interpreter.Value, error) {
+			pathValue, err := importPathValue(inter, path)
+			if err != nil {
+				return nil, err
+			}
+
 			targetPath, _, err := inter.GetCapabilityFinalTargetPath(
 				address,
-				importPathValue(inter, path),
+				pathValue,
 				&sema.ReferenceType{
 					Type: sema.AnyType,
 				},
@@ -3304,19 +4187,7 @@ func NewBlockValue(
 	)
 
 	// ID
-	common.UseMemory(inter, blockIDMemoryUsage)
-	var values = make([]interpreter.Value, sema.BlockIDSize)
-	for i, b := range block.Hash {
-		values[i] = interpreter.NewUnmeteredUInt8Value(b)
-	}
-
-	idValue := interpreter.NewArrayValue(
-		inter,
-		getLocationRange,
-		BlockIDStaticType,
-		common.Address{},
-		values...,
-	)
+	idValue := newBlockIDValue(inter, getLocationRange, block.Hash)
 
 	// timestamp
 	// TODO: verify
@@ -3327,12 +4198,46 @@ func NewBlockValue(
 		},
 	)
 
+	// parent ID
+	parentIDValue := newBlockIDValue(inter, getLocationRange, block.ParentHash)
+
+	// collection index
+	collectionIndexValue := interpreter.NewUInt64Value(
+		inter,
+		func() uint64 {
+			return block.CollectionIndex
+		},
+	)
+
 	return interpreter.NewBlockValue(
 		inter,
 		heightValue,
 		viewValue,
 		idValue,
 		timestampValue,
+		parentIDValue,
+		collectionIndexValue,
+	)
+}
+
+func newBlockIDValue(
+	inter *interpreter.Interpreter,
+	getLocationRange func() interpreter.LocationRange,
+	hash BlockHash,
+) *interpreter.ArrayValue {
+
+	common.UseMemory(inter, blockIDMemoryUsage)
+	var values = make([]interpreter.Value, sema.BlockIDSize)
+	for i, b := range hash {
+		values[i] = interpreter.NewUnmeteredUInt8Value(b)
+	}
+
+	return interpreter.NewArrayValue(
+		inter,
+		getLocationRange,
+		BlockIDStaticType,
+		common.Address{},
+		values...,
 	)
 }
 
@@ -3368,6 +4273,8 @@ func (r *interpreterRuntime) newAccountKeysAddFunction(
 			}
 			weight := weightValue.ToInt()
 
+			invocation.Interpreter.RecordEffect()
+
 			var accountKey *AccountKey
 			wrapPanic(func() {
 				accountKey, err = runtimeInterface.AddAccountKey(address, publicKey, hashAlgo, weight)
@@ -3467,6 +4374,8 @@ func (r *interpreterRuntime) newAccountKeysRevokeFunction(
 			}
 			index := indexValue.ToInt()
 
+			invocation.Interpreter.RecordEffect()
+
 			var err error
 			var accountKey *AccountKey
 			wrapPanic(func() {
@@ -3877,6 +4786,109 @@ func verifySignature(
 	return interpreter.BoolValue(valid)
 }
 
+// accountProofDomainSeparationTag is the domain separation tag
+// of signatures produced by user account keys
+//
+const accountProofDomainSeparationTag = "FLOW-V0.0-user"
+
+// accountProofWeightThreshold is the total key weight required to authorize an account
+//
+const accountProofWeightThreshold = 1000
+
+func verifyAccountProof(
+	inter *interpreter.Interpreter,
+	getLocationRange func() interpreter.LocationRange,
+	addressValue interpreter.AddressValue,
+	messageValue *interpreter.ArrayValue,
+	signaturesValue *interpreter.ArrayValue,
+	keyIndicesValue *interpreter.ArrayValue,
+	runtimeInterface Interface,
+) interpreter.BoolValue {
+
+	count := signaturesValue.Count()
+	if count == 0 || keyIndicesValue.Count() != count {
+		return false
+	}
+
+	message, err := interpreter.ByteArrayValueToByteSlice(inter, messageValue)
+	if err != nil {
+		panic(runtimeErrors.NewUnexpectedError("failed to get message. %w", err))
+	}
+
+	address := addressValue.ToAddress()
+
+	usedKeyIndices := make(map[int]struct{}, count)
+	totalWeight := 0
+
+	for i := 0; i < count; i++ {
+
+		keyIndexValue, ok := keyIndicesValue.Get(inter, getLocationRange, i).(interpreter.IntValue)
+		if !ok {
+			panic(runtimeErrors.NewUnreachableError())
+		}
+		keyIndex := keyIndexValue.ToInt()
+
+		// Each key may only contribute its weight once
+
+		if _, ok := usedKeyIndices[keyIndex]; ok || keyIndex < 0 {
+			return false
+		}
+		usedKeyIndices[keyIndex] = struct{}{}
+
+		signatureValue, ok := signaturesValue.Get(inter, getLocationRange, i).(*interpreter.ArrayValue)
+		if !ok {
+			panic(runtimeErrors.NewUnreachableError())
+		}
+
+		signature, err := interpreter.ByteArrayValueToByteSlice(inter, signatureValue)
+		if err != nil {
+			panic(runtimeErrors.NewUnexpectedError("failed to get signature. %w", err))
+		}
+
+		var accountKey *AccountKey
+		wrapPanic(func() {
+			accountKey, err = runtimeInterface.GetAccountKey(address, keyIndex)
+		})
+		if err != nil {
+			panic(err)
+		}
+
+		if accountKey == nil || accountKey.IsRevoked {
+			return false
+		}
+
+		signatureAlgorithm := accountKey.PublicKey.SignAlgo
+		if signatureAlgorithm != SignatureAlgorithmECDSA_P256 &&
+			signatureAlgorithm != SignatureAlgorithmECDSA_secp256k1 {
+
+			return false
+		}
+
+		var valid bool
+		wrapPanic(func() {
+			valid, err = runtimeInterface.VerifySignature(
+				signature,
+				accountProofDomainSeparationTag,
+				message,
+				accountKey.PublicKey.PublicKey,
+				signatureAlgorithm,
+				accountKey.HashAlgo,
+			)
+		})
+		if err != nil {
+			panic(err)
+		}
+
+		if !valid {
+			return false
+		}
+
+		totalWeight += accountKey.Weight
+	}
+
+	return totalWeight >= accountProofWeightThreshold
+}
+
 func hash(
 	inter *interpreter.Interpreter,
 	getLocationRange func() interpreter.LocationRange,
@@ -165,6 +165,7 @@ type testRuntimeInterface struct {
 	programChecked     func(location common.Location, duration time.Duration)
 	programInterpreted func(location common.Location, duration time.Duration)
 	unsafeRandom       func() (uint64, error)
+	readRandom         func(buffer []byte) error
 	verifySignature    func(
 		signature []byte,
 		tag string,
@@ -339,6 +340,9 @@ func (i *testRuntimeInterface) ProgramLog(message string) error {
 }
 
 func (i *testRuntimeInterface) EmitEvent(event cadence.Event) error {
+	if i.emitEvent == nil {
+		return nil
+	}
 	return i.emitEvent(event)
 }
 
@@ -403,25 +407,52 @@ func (i *testRuntimeInterface) GetCurrentBlockHeight() (uint64, error) {
 
 func (i *testRuntimeInterface) GetBlockAtHeight(height uint64) (block Block, exists bool, err error) {
 
-	buf := new(bytes.Buffer)
-	err = binary.Write(buf, binary.BigEndian, height)
-	if err != nil {
-		panic(err)
+	header, exists, err := i.GetBlockHeaderAtHeight(height)
+	if err != nil || !exists {
+		return
 	}
 
-	encoded := buf.Bytes()
-	var hash BlockHash
-	copy(hash[sema.BlockIDSize-len(encoded):], encoded)
-
 	block = Block{
-		Height:    height,
-		View:      height,
-		Hash:      hash,
-		Timestamp: time.Unix(int64(height), 0).UnixNano(),
+		Height:     header.Height,
+		View:       header.View,
+		Hash:       header.Hash,
+		Timestamp:  header.Timestamp,
+		ParentHash: header.ParentHash,
 	}
+
+	// The collection index is only set for the current block
+	currentHeight, _ := i.GetCurrentBlockHeight()
+	if height == currentHeight {
+		block.CollectionIndex = 1
+	}
+
 	return block, true, nil
 }
 
+func (i *testRuntimeInterface) GetBlockHeaderAtHeight(height uint64) (header BlockHeader, exists bool, err error) {
+
+	blockHash := func(height uint64) (hash BlockHash) {
+		buf := new(bytes.Buffer)
+		err = binary.Write(buf, binary.BigEndian, height)
+		if err != nil {
+			panic(err)
+		}
+
+		encoded := buf.Bytes()
+		copy(hash[sema.BlockIDSize-len(encoded):], encoded)
+		return
+	}
+
+	header = BlockHeader{
+		Height:     height,
+		View:       height,
+		Hash:       blockHash(height),
+		Timestamp:  time.Unix(int64(height), 0).UnixNano(),
+		ParentHash: blockHash(height - 1),
+	}
+	return header, true, nil
+}
+
 func (i *testRuntimeInterface) UnsafeRandom() (uint64, error) {
 	if i.unsafeRandom == nil {
 		return 0, nil
@@ -429,6 +460,13 @@ func (i *testRuntimeInterface) UnsafeRandom() (uint64, error) {
 	return i.unsafeRandom()
 }
 
+func (i *testRuntimeInterface) ReadRandom(buffer []byte) error {
+	if i.readRandom == nil {
+		return nil
+	}
+	return i.readRandom(buffer)
+}
+
 func (i *testRuntimeInterface) VerifySignature(
 	signature []byte,
 	tag string,
@@ -919,6 +957,77 @@ func TestRuntimeTransactionWithAccount(t *testing.T) {
 	assert.Equal(t, "0x000000000000002a", loggedMessage)
 }
 
+func TestRuntimeTransactionExecuteAuthAccountCheck(t *testing.T) {
+
+	t.Parallel()
+
+	script := []byte(`
+      transaction {
+
+        let signers: [AnyStruct]
+
+        prepare(signer: AuthAccount) {
+          self.signers = [signer]
+        }
+
+        execute {
+          log((self.signers[0] as! AuthAccount).address)
+        }
+      }
+    `)
+
+	test := func(t *testing.T, enabled bool) error {
+
+		runtime := newTestInterpreterRuntime(
+			WithTransactionExecuteAuthAccountCheckEnabled(enabled),
+		)
+
+		runtimeInterface := &testRuntimeInterface{
+			getSigningAccounts: func() ([]Address, error) {
+				return []Address{
+					common.MustBytesToAddress([]byte{42}),
+				}, nil
+			},
+			log: func(message string) {},
+		}
+
+		nextTransactionLocation := newTransactionLocationGenerator()
+
+		return runtime.ExecuteTransaction(
+			Script{
+				Source: script,
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  nextTransactionLocation(),
+			},
+		)
+	}
+
+	t.Run("enabled", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := test(t, true)
+		require.Error(t, err)
+
+		var checkerErr *sema.CheckerError
+		require.ErrorAs(t, err, &checkerErr)
+		errs := checkerErr.Errors
+		require.Len(t, errs, 1)
+
+		assert.IsType(t, &sema.InvalidTransactionExecuteAuthAccountAccessError{}, errs[0])
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := test(t, false)
+		require.NoError(t, err)
+	})
+}
+
 func TestRuntimeTransactionWithArguments(t *testing.T) {
 
 	t.Parallel()
@@ -1013,6 +1122,36 @@ func TestRuntimeTransactionWithArguments(t *testing.T) {
 				assert.IsType(t, &InvalidValueTypeError{}, errors.Unwrap(errors.Unwrap(err)))
 			},
 		},
+		{
+			label: "Type mismatch, argument details",
+			script: `
+              transaction(x: Int, y: UInt8) {
+                execute {}
+              }
+            `,
+			args: [][]byte{
+				jsoncdc.MustEncode(cadence.NewInt(42)),
+				jsoncdc.MustEncode(cadence.String("foo")),
+			},
+			check: func(t *testing.T, err error) {
+				var argumentErr *InvalidEntryPointArgumentError
+				require.ErrorAs(t, err, &argumentErr)
+
+				assert.Equal(t, 1, argumentErr.Index)
+				assert.Equal(t, "y", argumentErr.ParameterName)
+				assert.Equal(t, sema.UInt8Type, argumentErr.ExpectedType)
+				assert.Equal(t,
+					`{"type":"String","value":"foo"}`,
+					argumentErr.ArgumentSnippet(),
+				)
+				assert.Equal(t,
+					"invalid argument for parameter `y` at index 1 of type `UInt8`: "+
+						"expected value of type `UInt8`. "+
+						`argument: {"type":"String","value":"foo"}`,
+					argumentErr.Error(),
+				)
+			},
+		},
 		{
 			label: "Address",
 			script: `
@@ -2496,6 +2635,167 @@ func TestRuntimeParseAndCheckProgram(t *testing.T) {
 	})
 }
 
+func TestRuntimeGetEntryPoint(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("transaction", func(t *testing.T) {
+		runtime := newTestInterpreterRuntime()
+
+		script := []byte(`
+          transaction(amount: UFix64) {
+            prepare(signer: AuthAccount) {}
+
+            pre {
+              amount > 0.0:
+                "amount must be positive"
+              amount < 100.0
+            }
+
+            execute {}
+
+            post {
+              amount   <=   10.0
+            }
+          }
+        `)
+		runtimeInterface := &testRuntimeInterface{}
+
+		nextTransactionLocation := newTransactionLocationGenerator()
+
+		entryPoint, err := runtime.GetEntryPoint(
+			script,
+			Context{
+				Interface: runtimeInterface,
+				Location:  nextTransactionLocation(),
+			},
+		)
+		require.NoError(t, err)
+		require.NotNil(t, entryPoint)
+
+		assert.Equal(t,
+			[]cadence.Parameter{
+				{
+					Identifier: "amount",
+					Type:       cadence.UFix64Type{},
+				},
+			},
+			entryPoint.Parameters,
+		)
+
+		assert.Equal(t,
+			[]EntryPointCondition{
+				{
+					Source:  "amount > 0.0:\n                \"amount must be positive\"",
+					Test:    "amount > 0.0",
+					Message: `"amount must be positive"`,
+				},
+				{
+					Source: "amount < 100.0",
+					Test:   "amount < 100.0",
+				},
+			},
+			entryPoint.PreConditions,
+		)
+
+		// The source text is preserved, the test is normalized
+
+		assert.Equal(t,
+			[]EntryPointCondition{
+				{
+					Source: "amount   <=   10.0",
+					Test:   "amount <= 10.0",
+				},
+			},
+			entryPoint.PostConditions,
+		)
+	})
+
+	t.Run("script", func(t *testing.T) {
+		runtime := newTestInterpreterRuntime()
+
+		script := []byte(`
+          pub fun main(a: Int): Int {
+            post {
+              result > a
+            }
+            return a + 1
+          }
+        `)
+		runtimeInterface := &testRuntimeInterface{}
+
+		nextTransactionLocation := newTransactionLocationGenerator()
+
+		entryPoint, err := runtime.GetEntryPoint(
+			script,
+			Context{
+				Interface: runtimeInterface,
+				Location:  nextTransactionLocation(),
+			},
+		)
+		require.NoError(t, err)
+		require.NotNil(t, entryPoint)
+
+		assert.Equal(t,
+			[]cadence.Parameter{
+				{
+					Identifier: "a",
+					Type:       cadence.IntType{},
+				},
+			},
+			entryPoint.Parameters,
+		)
+
+		require.Empty(t, entryPoint.PreConditions)
+		assert.Equal(t,
+			[]EntryPointCondition{
+				{
+					Source: "result > a",
+					Test:   "result > a",
+				},
+			},
+			entryPoint.PostConditions,
+		)
+	})
+
+	t.Run("no entry point", func(t *testing.T) {
+		runtime := newTestInterpreterRuntime()
+
+		script := []byte(`pub fun test() {}`)
+		runtimeInterface := &testRuntimeInterface{}
+
+		nextTransactionLocation := newTransactionLocationGenerator()
+
+		entryPoint, err := runtime.GetEntryPoint(
+			script,
+			Context{
+				Interface: runtimeInterface,
+				Location:  nextTransactionLocation(),
+			},
+		)
+		require.NoError(t, err)
+		require.Nil(t, entryPoint)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		runtime := newTestInterpreterRuntime()
+
+		script := []byte(`pub let a: Int = "b"`)
+		runtimeInterface := &testRuntimeInterface{}
+
+		nextTransactionLocation := newTransactionLocationGenerator()
+
+		_, err := runtime.GetEntryPoint(
+			script,
+			Context{
+				Interface: runtimeInterface,
+				Location:  nextTransactionLocation(),
+			},
+		)
+		require.Error(t, err)
+	})
+}
+
 func TestRuntimeScriptReturnTypeNotReturnableError(t *testing.T) {
 
 	t.Parallel()
@@ -3546,7 +3846,7 @@ func TestRuntimeContractNestedResource(t *testing.T) {
 	assert.Equal(t, `"Hello World!"`, loggedMessage)
 }
 
-func TestRuntimeStorageLoadedDestructionConcreteType(t *testing.T) {
+func TestRuntimeCompositeTypeFromIdentifier(t *testing.T) {
 
 	t.Parallel()
 
@@ -3558,58 +3858,51 @@ func TestRuntimeStorageLoadedDestructionConcreteType(t *testing.T) {
 
 	contract := []byte(`
         pub contract Test {
-            pub resource R {
-                // test that the destructor is linked back into the nested resource
-                // after being loaded from storage
-                destroy() {
-                    log("destroyed")
-                }
-            }
+            pub resource interface INFT {}
 
-            init() {
-                // store nested resource in account on deployment
-                self.account.save(<-create R(), to: /storage/r)
-            }
+            pub resource NFT: INFT {}
         }
     `)
 
-	tx := []byte(`
-        import Test from 0x01
-
-        transaction {
+	// NOTE: the script does not import the contract,
+	// the types are resolved dynamically
 
-            prepare(acct: AuthAccount) {
-                let r <- acct.load<@Test.R>(from: /storage/r)
-                destroy r
-            }
+	script := []byte(`
+        pub fun main(): [Bool] {
+            let nftType = CompositeType("A.0000000000000001.Test.NFT")!
+            let interfaceType = InterfaceType("A.0000000000000001.Test.INFT")!
+            return [
+                nftType.isSubtype(of: interfaceType),
+                nftType.isSubtype(of: Type<@AnyResource>()),
+                nftType.isSubtype(of: Type<AnyStruct>()),
+                CompositeType("A.0000000000000001.Test.Missing") == nil,
+                CompositeType("A.0000000000000002.Other.NFT") == nil,
+                CompositeType("invalid") == nil
+            ]
         }
     `)
 
 	deploy := utils.DeploymentTransaction("Test", contract)
 
 	var accountCode []byte
-	var loggedMessage string
 
 	runtimeInterface := &testRuntimeInterface{
-		getCode: func(_ Location) (bytes []byte, err error) {
-			return accountCode, nil
-		},
 		storage: newTestLedger(nil, nil),
 		getSigningAccounts: func() ([]Address, error) {
 			return []Address{addressValue}, nil
 		},
 		resolveLocation: singleIdentifierLocationResolver(t),
-		getAccountContractCode: func(_ Address, _ string) (code []byte, err error) {
+		getAccountContractCode: func(address Address, _ string) (code []byte, err error) {
+			if address != addressValue {
+				return nil, nil
+			}
 			return accountCode, nil
 		},
-		updateAccountContractCode: func(address Address, _ string, code []byte) error {
+		updateAccountContractCode: func(_ Address, _ string, code []byte) error {
 			accountCode = code
 			return nil
 		},
 		emitEvent: func(event cadence.Event) error { return nil },
-		log: func(message string) {
-			loggedMessage = message
-		},
 	}
 
 	nextTransactionLocation := newTransactionLocationGenerator()
@@ -3625,22 +3918,31 @@ func TestRuntimeStorageLoadedDestructionConcreteType(t *testing.T) {
 	)
 	require.NoError(t, err)
 
-	assert.NotNil(t, accountCode)
-
-	err = runtime.ExecuteTransaction(
+	result, err := runtime.ExecuteScript(
 		Script{
-			Source: tx,
+			Source: script,
 		},
 		Context{
 			Interface: runtimeInterface,
-			Location:  nextTransactionLocation(),
-		})
+			Location:  common.ScriptLocation{},
+		},
+	)
 	require.NoError(t, err)
 
-	assert.Equal(t, `"destroyed"`, loggedMessage)
+	assert.Equal(t,
+		cadence.NewArray([]cadence.Value{
+			cadence.NewBool(true),
+			cadence.NewBool(true),
+			cadence.NewBool(false),
+			cadence.NewBool(true),
+			cadence.NewBool(true),
+			cadence.NewBool(true),
+		}).WithType(cadence.NewVariableSizedArrayType(cadence.NewBoolType())),
+		result,
+	)
 }
 
-func TestRuntimeStorageLoadedDestructionAnyResource(t *testing.T) {
+func TestRuntimeStorageLoadedDestructionConcreteType(t *testing.T) {
 
 	t.Parallel()
 
@@ -3668,13 +3970,12 @@ func TestRuntimeStorageLoadedDestructionAnyResource(t *testing.T) {
     `)
 
 	tx := []byte(`
-        // NOTE: *not* importing concrete implementation.
-        //   Should be imported automatically when loading the value from storage
+        import Test from 0x01
 
         transaction {
 
             prepare(acct: AuthAccount) {
-                let r <- acct.load<@AnyResource>(from: /storage/r)
+                let r <- acct.load<@Test.R>(from: /storage/r)
                 destroy r
             }
         }
@@ -3729,14 +4030,13 @@ func TestRuntimeStorageLoadedDestructionAnyResource(t *testing.T) {
 		Context{
 			Interface: runtimeInterface,
 			Location:  nextTransactionLocation(),
-		},
-	)
+		})
 	require.NoError(t, err)
 
 	assert.Equal(t, `"destroyed"`, loggedMessage)
 }
 
-func TestRuntimeStorageLoadedDestructionAfterRemoval(t *testing.T) {
+func TestRuntimeStorageLoadedDestructionAnyResource(t *testing.T) {
 
 	t.Parallel()
 
@@ -3777,17 +4077,113 @@ func TestRuntimeStorageLoadedDestructionAfterRemoval(t *testing.T) {
     `)
 
 	deploy := utils.DeploymentTransaction("Test", contract)
-	removal := utils.RemovalTransaction("Test")
 
 	var accountCode []byte
-
-	ledger := newTestLedger(nil, nil)
+	var loggedMessage string
 
 	runtimeInterface := &testRuntimeInterface{
 		getCode: func(_ Location) (bytes []byte, err error) {
 			return accountCode, nil
 		},
-		storage: ledger,
+		storage: newTestLedger(nil, nil),
+		getSigningAccounts: func() ([]Address, error) {
+			return []Address{addressValue}, nil
+		},
+		resolveLocation: singleIdentifierLocationResolver(t),
+		getAccountContractCode: func(_ Address, _ string) (code []byte, err error) {
+			return accountCode, nil
+		},
+		updateAccountContractCode: func(address Address, _ string, code []byte) error {
+			accountCode = code
+			return nil
+		},
+		emitEvent: func(event cadence.Event) error { return nil },
+		log: func(message string) {
+			loggedMessage = message
+		},
+	}
+
+	nextTransactionLocation := newTransactionLocationGenerator()
+
+	err := runtime.ExecuteTransaction(
+		Script{
+			Source: deploy,
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	assert.NotNil(t, accountCode)
+
+	err = runtime.ExecuteTransaction(
+		Script{
+			Source: tx,
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, `"destroyed"`, loggedMessage)
+}
+
+func TestRuntimeStorageLoadedDestructionAfterRemoval(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := newTestInterpreterRuntime()
+
+	addressValue := Address{
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1,
+	}
+
+	contract := []byte(`
+        pub contract Test {
+            pub resource R {
+                // test that the destructor is linked back into the nested resource
+                // after being loaded from storage
+                destroy() {
+                    log("destroyed")
+                }
+            }
+
+            init() {
+                // store nested resource in account on deployment
+                self.account.save(<-create R(), to: /storage/r)
+            }
+        }
+    `)
+
+	tx := []byte(`
+        // NOTE: *not* importing concrete implementation.
+        //   Should be imported automatically when loading the value from storage
+
+        transaction {
+
+            prepare(acct: AuthAccount) {
+                let r <- acct.load<@AnyResource>(from: /storage/r)
+                destroy r
+            }
+        }
+    `)
+
+	deploy := utils.DeploymentTransaction("Test", contract)
+	removal := utils.RemovalTransaction("Test")
+
+	var accountCode []byte
+
+	ledger := newTestLedger(nil, nil)
+
+	runtimeInterface := &testRuntimeInterface{
+		getCode: func(_ Location) (bytes []byte, err error) {
+			return accountCode, nil
+		},
+		storage: ledger,
 		getSigningAccounts: func() ([]Address, error) {
 			return []Address{addressValue}, nil
 		},
@@ -4444,6 +4840,11 @@ func TestRuntimeBlock(t *testing.T) {
           log(nextBlock?.view)
           log(nextBlock?.id)
           log(nextBlock?.timestamp)
+          log(nextBlock?.parentID)
+          log(nextBlock?.collectionIndex)
+
+          let nextBlockHeader = getBlockHeader(byHeight: block.height + UInt64(1))
+          log(nextBlockHeader)
         }
       }
     `)
@@ -4477,16 +4878,19 @@ func TestRuntimeBlock(t *testing.T) {
 
 	assert.Equal(t,
 		[]string{
-			"Block(height: 1, view: 1, id: 0x0000000000000000000000000000000000000000000000000000000000000001, timestamp: 1.00000000)",
+			"Block(height: 1, view: 1, id: 0x0000000000000000000000000000000000000000000000000000000000000001, timestamp: 1.00000000, parentID: 0x0000000000000000000000000000000000000000000000000000000000000000, collectionIndex: 1)",
 			"1",
 			"1",
 			"[0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1]",
 			"1.00000000",
-			"Block(height: 2, view: 2, id: 0x0000000000000000000000000000000000000000000000000000000000000002, timestamp: 2.00000000)",
+			"Block(height: 2, view: 2, id: 0x0000000000000000000000000000000000000000000000000000000000000002, timestamp: 2.00000000, parentID: 0x0000000000000000000000000000000000000000000000000000000000000001, collectionIndex: 0)",
 			"2",
 			"2",
 			"[0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2]",
 			"2.00000000",
+			"[0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1]",
+			"0",
+			"Block(height: 2, view: 2, id: 0x0000000000000000000000000000000000000000000000000000000000000002, timestamp: 2.00000000, parentID: 0x0000000000000000000000000000000000000000000000000000000000000001, collectionIndex: 0)",
 		},
 		loggedMessages,
 	)
@@ -4539,116 +4943,355 @@ func TestRuntimeUnsafeRandom(t *testing.T) {
 	)
 }
 
-func TestRuntimeTransactionTopLevelDeclarations(t *testing.T) {
+func TestRuntimeBuiltinRegistry(t *testing.T) {
 
 	t.Parallel()
 
-	t.Run("transaction with function", func(t *testing.T) {
-		runtime := newTestInterpreterRuntime()
+	newRuntimeInterface := func(loggedMessages *[]string) *testRuntimeInterface {
+		return &testRuntimeInterface{
+			unsafeRandom: func() (uint64, error) {
+				return 42, nil
+			},
+			log: func(message string) {
+				*loggedMessages = append(*loggedMessages, message)
+			},
+		}
+	}
 
-		script := []byte(`
-          pub fun test() {}
+	t.Run("disable functions", func(t *testing.T) {
 
-          transaction {}
-        `)
+		t.Parallel()
 
-		runtimeInterface := &testRuntimeInterface{
-			getSigningAccounts: func() ([]Address, error) {
-				return nil, nil
-			},
-		}
+		registry := stdlib.NewBuiltinRegistry()
+		registry.Disable("log", "unsafeRandom")
 
-		nextTransactionLocation := newTransactionLocationGenerator()
+		runtime := newTestInterpreterRuntime(WithBuiltinRegistry(registry))
+
+		script := []byte(`
+          transaction {
+            prepare() {
+              log(unsafeRandom())
+            }
+          }
+        `)
+
+		var loggedMessages []string
 
 		err := runtime.ExecuteTransaction(
 			Script{
 				Source: script,
 			},
 			Context{
-				Interface: runtimeInterface,
-				Location:  nextTransactionLocation(),
+				Interface: newRuntimeInterface(&loggedMessages),
+				Location:  newTransactionLocationGenerator()(),
 			},
 		)
-		require.NoError(t, err)
+		require.Error(t, err)
+
+		var checkerErr *sema.CheckerError
+		require.ErrorAs(t, err, &checkerErr)
+		errs := checkerErr.Errors
+		require.Len(t, errs, 2)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[1])
+
+		assert.Empty(t, loggedMessages)
 	})
 
-	t.Run("transaction with resource", func(t *testing.T) {
-		runtime := newTestInterpreterRuntime()
+	t.Run("disable value", func(t *testing.T) {
 
-		script := []byte(`
-          pub resource R {}
+		t.Parallel()
 
-          transaction {}
-        `)
+		registry := stdlib.NewBuiltinRegistry()
+		registry.Disable("RLP")
 
-		runtimeInterface := &testRuntimeInterface{
-			getSigningAccounts: func() ([]Address, error) {
-				return nil, nil
-			},
-		}
+		runtime := newTestInterpreterRuntime(WithBuiltinRegistry(registry))
 
-		nextTransactionLocation := newTransactionLocationGenerator()
+		script := []byte(`
+          pub fun main() {
+              RLP
+          }
+        `)
 
-		err := runtime.ExecuteTransaction(
+		var loggedMessages []string
+
+		_, err := runtime.ExecuteScript(
 			Script{
 				Source: script,
 			},
 			Context{
-				Interface: runtimeInterface,
-				Location:  nextTransactionLocation(),
+				Interface: newRuntimeInterface(&loggedMessages),
+				Location:  common.ScriptLocation{},
 			},
 		)
 		require.Error(t, err)
-		assertRuntimeErrorIsUserError(t, err)
 
 		var checkerErr *sema.CheckerError
 		require.ErrorAs(t, err, &checkerErr)
+		errs := checkerErr.Errors
+		require.Len(t, errs, 1)
 
-		errs := checker.ExpectCheckerErrors(t, checkerErr, 1)
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
 
-		assert.IsType(t, &sema.InvalidTopLevelDeclarationError{}, errs[0])
+	t.Run("replace function", func(t *testing.T) {
+
+		t.Parallel()
+
+		var loggedMessages []string
+
+		registry := stdlib.NewBuiltinRegistry()
+		registry.RegisterFunction(
+			stdlib.NewStandardLibraryFunction(
+				"log",
+				stdlib.LogFunctionType,
+				"",
+				func(invocation interpreter.Invocation) interpreter.Value {
+					loggedMessages = append(loggedMessages, "replaced")
+					return interpreter.VoidValue{}
+				},
+			),
+		)
+
+		runtime := newTestInterpreterRuntime(WithBuiltinRegistry(registry))
+
+		script := []byte(`
+          transaction {
+            prepare() {
+              log(unsafeRandom())
+            }
+          }
+        `)
+
+		err := runtime.ExecuteTransaction(
+			Script{
+				Source: script,
+			},
+			Context{
+				Interface: newRuntimeInterface(&loggedMessages),
+				Location:  newTransactionLocationGenerator()(),
+			},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			[]string{
+				"replaced",
+			},
+			loggedMessages,
+		)
 	})
 }
 
-func TestRuntimeStoreIntegerTypes(t *testing.T) {
+func TestRuntimeRevertibleRandom(t *testing.T) {
 
 	t.Parallel()
 
 	runtime := newTestInterpreterRuntime()
 
-	addressValue := interpreter.AddressValue{
-		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xCA, 0xDE,
+	script := []byte(`
+      transaction {
+        prepare() {
+          log(revertibleRandom())
+          log(revertibleRandomInRange(from: 10, upTo: 20))
+        }
+      }
+    `)
+
+	var loggedMessages []string
+
+	runtimeInterface := &testRuntimeInterface{
+		readRandom: func(buffer []byte) error {
+			binary.LittleEndian.PutUint64(buffer, 7558174677681708339)
+			return nil
+		},
+		log: func(message string) {
+			loggedMessages = append(loggedMessages, message)
+		},
 	}
 
-	for _, integerType := range sema.AllIntegerTypes {
+	nextTransactionLocation := newTransactionLocationGenerator()
 
-		typeName := integerType.String()
+	err := runtime.ExecuteTransaction(
+		Script{
+			Source: script,
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
 
-		t.Run(typeName, func(t *testing.T) {
+	assert.Equal(t,
+		[]string{
+			"7558174677681708339",
+			// 10 + 7558174677681708339 % 10
+			"19",
+		},
+		loggedMessages,
+	)
 
-			contract := []byte(
-				fmt.Sprintf(
-					`
-                      pub contract Test {
+	t.Run("invalid range", func(t *testing.T) {
 
-                          pub let n: %s
+		t.Parallel()
 
-                          init() {
-                              self.n = 42
-                          }
-                      }
-                    `,
-					typeName,
-				),
-			)
+		err := runtime.ExecuteTransaction(
+			Script{
+				Source: []byte(`
+                  transaction {
+                    prepare() {
+                      revertibleRandomInRange(from: 2, upTo: 2)
+                    }
+                  }
+                `),
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  nextTransactionLocation(),
+			},
+		)
+		require.Error(t, err)
 
-			deploy := utils.DeploymentTransaction("Test", contract)
+		var rangeErr stdlib.InvalidRandomRangeError
+		require.ErrorAs(t, err, &rangeErr)
+	})
 
-			var accountCode []byte
-			var events []cadence.Event
+	t.Run("view function", func(t *testing.T) {
 
-			runtimeInterface := &testRuntimeInterface{
-				getCode: func(_ Location) (bytes []byte, err error) {
+		t.Parallel()
+
+		_, err := runtime.ExecuteScript(
+			Script{
+				Source: []byte(`
+                  pub view fun main(): UInt64 {
+                      return revertibleRandom()
+                  }
+                `),
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  common.ScriptLocation{},
+			},
+		)
+
+		errs := checker.ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.PurityError{}, errs[0])
+	})
+}
+
+func TestRuntimeTransactionTopLevelDeclarations(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("transaction with function", func(t *testing.T) {
+		runtime := newTestInterpreterRuntime()
+
+		script := []byte(`
+          pub fun test() {}
+
+          transaction {}
+        `)
+
+		runtimeInterface := &testRuntimeInterface{
+			getSigningAccounts: func() ([]Address, error) {
+				return nil, nil
+			},
+		}
+
+		nextTransactionLocation := newTransactionLocationGenerator()
+
+		err := runtime.ExecuteTransaction(
+			Script{
+				Source: script,
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  nextTransactionLocation(),
+			},
+		)
+		require.NoError(t, err)
+	})
+
+	t.Run("transaction with resource", func(t *testing.T) {
+		runtime := newTestInterpreterRuntime()
+
+		script := []byte(`
+          pub resource R {}
+
+          transaction {}
+        `)
+
+		runtimeInterface := &testRuntimeInterface{
+			getSigningAccounts: func() ([]Address, error) {
+				return nil, nil
+			},
+		}
+
+		nextTransactionLocation := newTransactionLocationGenerator()
+
+		err := runtime.ExecuteTransaction(
+			Script{
+				Source: script,
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  nextTransactionLocation(),
+			},
+		)
+		require.Error(t, err)
+		assertRuntimeErrorIsUserError(t, err)
+
+		var checkerErr *sema.CheckerError
+		require.ErrorAs(t, err, &checkerErr)
+
+		errs := checker.ExpectCheckerErrors(t, checkerErr, 1)
+
+		assert.IsType(t, &sema.InvalidTopLevelDeclarationError{}, errs[0])
+	})
+}
+
+func TestRuntimeStoreIntegerTypes(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := newTestInterpreterRuntime()
+
+	addressValue := interpreter.AddressValue{
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xCA, 0xDE,
+	}
+
+	for _, integerType := range sema.AllIntegerTypes {
+
+		typeName := integerType.String()
+
+		t.Run(typeName, func(t *testing.T) {
+
+			contract := []byte(
+				fmt.Sprintf(
+					`
+                      pub contract Test {
+
+                          pub let n: %s
+
+                          init() {
+                              self.n = 42
+                          }
+                      }
+                    `,
+					typeName,
+				),
+			)
+
+			deploy := utils.DeploymentTransaction("Test", contract)
+
+			var accountCode []byte
+			var events []cadence.Event
+
+			runtimeInterface := &testRuntimeInterface{
+				getCode: func(_ Location) (bytes []byte, err error) {
 					return accountCode, nil
 				},
 				storage: newTestLedger(nil, nil),
@@ -5069,7 +5712,7 @@ func TestRuntimeResourceOwnerFieldUseArray(t *testing.T) {
 	)
 }
 
-func TestRuntimeResourceOwnerFieldUseDictionary(t *testing.T) {
+func TestRuntimeResourceOwnerFieldUseNested(t *testing.T) {
 
 	t.Parallel()
 
@@ -5082,15 +5725,56 @@ func TestRuntimeResourceOwnerFieldUseDictionary(t *testing.T) {
 	contract := []byte(`
       pub contract Test {
 
-          pub resource R {
+          pub resource NFT {
 
               pub fun logOwnerAddress() {
                 log(self.owner?.address)
               }
           }
 
-          pub fun createR(): @R {
-              return <-create R()
+          pub resource Wrapper {
+              pub var nft: @NFT?
+
+              init() {
+                  self.nft <- create NFT()
+              }
+
+              pub fun logOwnerAddresses() {
+                  log(self.owner?.address)
+                  let nft = &self.nft as &NFT?
+                  nft?.logOwnerAddress()
+              }
+
+              pub fun withdraw(): @NFT {
+                  let nft <- self.nft <- nil
+                  return <-nft!
+              }
+
+              destroy() {
+                  destroy self.nft
+              }
+          }
+
+          pub resource Collection {
+              pub let wrappers: @{Int: Wrapper}
+
+              init() {
+                  self.wrappers <- {0: <-create Wrapper()}
+              }
+
+              pub fun logOwnerAddresses() {
+                  log(self.owner?.address)
+                  let wrapper = &self.wrappers[0] as &Wrapper?
+                  wrapper!.logOwnerAddresses()
+              }
+
+              destroy() {
+                  destroy self.wrappers
+              }
+          }
+
+          pub fun createCollection(): @Collection {
+              return <-create Collection()
           }
       }
     `)
@@ -5103,31 +5787,13 @@ func TestRuntimeResourceOwnerFieldUseDictionary(t *testing.T) {
       transaction {
 
           prepare(signer: AuthAccount) {
+              let collection <- Test.createCollection()
+              collection.logOwnerAddresses()
 
-              let rs <- {
-                  "a": <-Test.createR(),
-                  "b": <-Test.createR()
-              }
-              log(rs["a"]?.owner?.address)
-              log(rs["b"]?.owner?.address)
-              rs["a"]?.logOwnerAddress()
-              rs["b"]?.logOwnerAddress()
-
-              signer.save(<-rs, to: /storage/rs)
-              signer.link<&{String: Test.R}>(/public/rs, target: /storage/rs)
-
-              let ref1 = signer.borrow<&{String: Test.R}>(from: /storage/rs)!
-              log(ref1["a"]?.owner?.address)
-              log(ref1["b"]?.owner?.address)
-              ref1["a"]?.logOwnerAddress()
-              ref1["b"]?.logOwnerAddress()
+              signer.save(<-collection, to: /storage/collection)
 
-              let publicAccount = getAccount(0x01)
-              let ref2 = publicAccount.getCapability(/public/rs).borrow<&{String: Test.R}>()!
-              log(ref2["a"]?.owner?.address)
-              log(ref2["b"]?.owner?.address)
-              ref2["a"]?.logOwnerAddress()
-              ref2["b"]?.logOwnerAddress()
+              let ref = signer.borrow<&Test.Collection>(from: /storage/collection)!
+              ref.logOwnerAddresses()
           }
       }
     `)
@@ -5138,24 +5804,22 @@ func TestRuntimeResourceOwnerFieldUseDictionary(t *testing.T) {
       transaction {
 
           prepare(signer: AuthAccount) {
-              let ref1 = signer.borrow<&{String: Test.R}>(from: /storage/rs)!
-              log(ref1["a"]?.owner?.address)
-              log(ref1["b"]?.owner?.address)
-              ref1["a"]?.logOwnerAddress()
-              ref1["b"]?.logOwnerAddress()
+              let ref = signer.borrow<&Test.Collection>(from: /storage/collection)!
+              ref.logOwnerAddresses()
 
-              let publicAccount = getAccount(0x01)
-              let ref2 = publicAccount.getCapability(/public/rs).borrow<&{String: Test.R}>()!
-              log(ref2["a"]?.owner?.address)
-              log(ref2["b"]?.owner?.address)
-              ref2["a"]?.logOwnerAddress()
-              ref2["b"]?.logOwnerAddress()
+              let wrapper = &ref.wrappers[0] as &Test.Wrapper?
+              let nft <- wrapper!.withdraw()
+              nft.logOwnerAddress()
+
+              signer.save(<-nft, to: /storage/nft)
+
+              let nftRef = signer.borrow<&Test.NFT>(from: /storage/nft)!
+              nftRef.logOwnerAddress()
           }
       }
     `)
 
 	accountCodes := map[common.Location][]byte{}
-	var events []cadence.Event
 	var loggedMessages []string
 
 	runtimeInterface := &testRuntimeInterface{
@@ -5166,7 +5830,6 @@ func TestRuntimeResourceOwnerFieldUseDictionary(t *testing.T) {
 		getSigningAccounts: func() ([]Address, error) {
 			return []Address{address}, nil
 		},
-		resolveLocation: singleIdentifierLocationResolver(t),
 		getAccountContractCode: func(address Address, name string) (code []byte, err error) {
 			location := common.AddressLocation{
 				Address: address,
@@ -5174,6 +5837,7 @@ func TestRuntimeResourceOwnerFieldUseDictionary(t *testing.T) {
 			}
 			return accountCodes[location], nil
 		},
+		resolveLocation: singleIdentifierLocationResolver(t),
 		updateAccountContractCode: func(address Address, name string, code []byte) error {
 			location := common.AddressLocation{
 				Address: address,
@@ -5183,7 +5847,6 @@ func TestRuntimeResourceOwnerFieldUseDictionary(t *testing.T) {
 			return nil
 		},
 		emitEvent: func(event cadence.Event) error {
-			events = append(events, event)
 			return nil
 		},
 		log: func(message string) {
@@ -5217,12 +5880,8 @@ func TestRuntimeResourceOwnerFieldUseDictionary(t *testing.T) {
 
 	assert.Equal(t,
 		[]string{
-			"nil", "nil",
-			"nil", "nil",
-			"0x0000000000000001", "0x0000000000000001",
-			"0x0000000000000001", "0x0000000000000001",
-			"0x0000000000000001", "0x0000000000000001",
-			"0x0000000000000001", "0x0000000000000001",
+			"nil", "nil", "nil",
+			"0x0000000000000001", "0x0000000000000001", "0x0000000000000001",
 		},
 		loggedMessages,
 	)
@@ -5241,63 +5900,245 @@ func TestRuntimeResourceOwnerFieldUseDictionary(t *testing.T) {
 
 	assert.Equal(t,
 		[]string{
-			"0x0000000000000001", "0x0000000000000001",
-			"0x0000000000000001", "0x0000000000000001",
-			"0x0000000000000001", "0x0000000000000001",
-			"0x0000000000000001", "0x0000000000000001",
+			"0x0000000000000001", "0x0000000000000001", "0x0000000000000001",
+			// moved out of storage
+			"nil",
+			// moved into storage again
+			"0x0000000000000001",
 		},
 		loggedMessages,
 	)
 }
 
-func TestRuntimeMetrics(t *testing.T) {
+func TestRuntimeResourceOwnerFieldUseDictionary(t *testing.T) {
 
 	t.Parallel()
 
 	runtime := newTestInterpreterRuntime()
 
-	imported1Location := common.StringLocation("imported1")
-
-	importedScript1 := []byte(`
-      pub fun generate(): [Int] {
-        return [1, 2, 3]
-      }
-    `)
+	address := Address{
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1,
+	}
 
-	imported2Location := common.StringLocation("imported2")
+	contract := []byte(`
+      pub contract Test {
 
-	importedScript2 := []byte(`
-      pub fun getPath(): StoragePath {
-        return /storage/foo
-      }
-    `)
+          pub resource R {
 
-	script1 := []byte(`
-      import "imported1"
+              pub fun logOwnerAddress() {
+                log(self.owner?.address)
+              }
+          }
 
-      transaction {
-          prepare(signer: AuthAccount) {
-              signer.save(generate(), to: /storage/foo)
+          pub fun createR(): @R {
+              return <-create R()
           }
-          execute {}
       }
     `)
 
-	script2 := []byte(`
-      import "imported2"
+	deploy := utils.DeploymentTransaction("Test", contract)
+
+	tx := []byte(`
+      import Test from 0x1
 
       transaction {
+
           prepare(signer: AuthAccount) {
-              signer.load<[Int]>(from: getPath())
-          }
-          execute {}
-      }
-    `)
 
-	storage := newTestLedger(nil, nil)
+              let rs <- {
+                  "a": <-Test.createR(),
+                  "b": <-Test.createR()
+              }
+              log(rs["a"]?.owner?.address)
+              log(rs["b"]?.owner?.address)
+              rs["a"]?.logOwnerAddress()
+              rs["b"]?.logOwnerAddress()
 
-	type reports struct {
-		programParsed      map[common.Location]int
+              signer.save(<-rs, to: /storage/rs)
+              signer.link<&{String: Test.R}>(/public/rs, target: /storage/rs)
+
+              let ref1 = signer.borrow<&{String: Test.R}>(from: /storage/rs)!
+              log(ref1["a"]?.owner?.address)
+              log(ref1["b"]?.owner?.address)
+              ref1["a"]?.logOwnerAddress()
+              ref1["b"]?.logOwnerAddress()
+
+              let publicAccount = getAccount(0x01)
+              let ref2 = publicAccount.getCapability(/public/rs).borrow<&{String: Test.R}>()!
+              log(ref2["a"]?.owner?.address)
+              log(ref2["b"]?.owner?.address)
+              ref2["a"]?.logOwnerAddress()
+              ref2["b"]?.logOwnerAddress()
+          }
+      }
+    `)
+
+	tx2 := []byte(`
+      import Test from 0x1
+
+      transaction {
+
+          prepare(signer: AuthAccount) {
+              let ref1 = signer.borrow<&{String: Test.R}>(from: /storage/rs)!
+              log(ref1["a"]?.owner?.address)
+              log(ref1["b"]?.owner?.address)
+              ref1["a"]?.logOwnerAddress()
+              ref1["b"]?.logOwnerAddress()
+
+              let publicAccount = getAccount(0x01)
+              let ref2 = publicAccount.getCapability(/public/rs).borrow<&{String: Test.R}>()!
+              log(ref2["a"]?.owner?.address)
+              log(ref2["b"]?.owner?.address)
+              ref2["a"]?.logOwnerAddress()
+              ref2["b"]?.logOwnerAddress()
+          }
+      }
+    `)
+
+	accountCodes := map[common.Location][]byte{}
+	var events []cadence.Event
+	var loggedMessages []string
+
+	runtimeInterface := &testRuntimeInterface{
+		getCode: func(location Location) (bytes []byte, err error) {
+			return accountCodes[location], nil
+		},
+		storage: newTestLedger(nil, nil),
+		getSigningAccounts: func() ([]Address, error) {
+			return []Address{address}, nil
+		},
+		resolveLocation: singleIdentifierLocationResolver(t),
+		getAccountContractCode: func(address Address, name string) (code []byte, err error) {
+			location := common.AddressLocation{
+				Address: address,
+				Name:    name,
+			}
+			return accountCodes[location], nil
+		},
+		updateAccountContractCode: func(address Address, name string, code []byte) error {
+			location := common.AddressLocation{
+				Address: address,
+				Name:    name,
+			}
+			accountCodes[location] = code
+			return nil
+		},
+		emitEvent: func(event cadence.Event) error {
+			events = append(events, event)
+			return nil
+		},
+		log: func(message string) {
+			loggedMessages = append(loggedMessages, message)
+		},
+	}
+
+	nextTransactionLocation := newTransactionLocationGenerator()
+
+	err := runtime.ExecuteTransaction(
+		Script{
+			Source: deploy,
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	err = runtime.ExecuteTransaction(
+		Script{
+			Source: tx,
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		[]string{
+			"nil", "nil",
+			"nil", "nil",
+			"0x0000000000000001", "0x0000000000000001",
+			"0x0000000000000001", "0x0000000000000001",
+			"0x0000000000000001", "0x0000000000000001",
+			"0x0000000000000001", "0x0000000000000001",
+		},
+		loggedMessages,
+	)
+
+	loggedMessages = nil
+	err = runtime.ExecuteTransaction(
+		Script{
+			Source: tx2,
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		[]string{
+			"0x0000000000000001", "0x0000000000000001",
+			"0x0000000000000001", "0x0000000000000001",
+			"0x0000000000000001", "0x0000000000000001",
+			"0x0000000000000001", "0x0000000000000001",
+		},
+		loggedMessages,
+	)
+}
+
+func TestRuntimeMetrics(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := newTestInterpreterRuntime()
+
+	imported1Location := common.StringLocation("imported1")
+
+	importedScript1 := []byte(`
+      pub fun generate(): [Int] {
+        return [1, 2, 3]
+      }
+    `)
+
+	imported2Location := common.StringLocation("imported2")
+
+	importedScript2 := []byte(`
+      pub fun getPath(): StoragePath {
+        return /storage/foo
+      }
+    `)
+
+	script1 := []byte(`
+      import "imported1"
+
+      transaction {
+          prepare(signer: AuthAccount) {
+              signer.save(generate(), to: /storage/foo)
+          }
+          execute {}
+      }
+    `)
+
+	script2 := []byte(`
+      import "imported2"
+
+      transaction {
+          prepare(signer: AuthAccount) {
+              signer.load<[Int]>(from: getPath())
+          }
+          execute {}
+      }
+    `)
+
+	storage := newTestLedger(nil, nil)
+
+	type reports struct {
+		programParsed      map[common.Location]int
 		programChecked     map[common.Location]int
 		programInterpreted map[common.Location]int
 	}
@@ -5413,6 +6254,106 @@ func TestRuntimeMetrics(t *testing.T) {
 	)
 }
 
+type testTracingRuntimeInterface struct {
+	*testRuntimeInterface
+	spans []string
+}
+
+var _ Tracer = &testTracingRuntimeInterface{}
+
+func (i *testTracingRuntimeInterface) StartSpan(operation string, location common.Location, name string) Span {
+	span := fmt.Sprintf("%s %s", operation, location)
+	if name != "" {
+		span += " " + name
+	}
+	i.spans = append(i.spans, "start "+span)
+	return testSpan(func() {
+		i.spans = append(i.spans, "end "+span)
+	})
+}
+
+type testSpan func()
+
+func (s testSpan) End() {
+	s()
+}
+
+func TestRuntimeTracer(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := newTestInterpreterRuntime()
+
+	importedLocation := common.StringLocation("imported")
+
+	importedScript := []byte(`
+      pub fun generate(): [Int] {
+        return [1, 2, 3]
+      }
+    `)
+
+	script := []byte(`
+      import "imported"
+
+      pub fun main(): Int {
+          let xs = generate()
+          log(xs)
+          return xs.length
+      }
+    `)
+
+	runtimeInterface := &testTracingRuntimeInterface{
+		testRuntimeInterface: &testRuntimeInterface{
+			getCode: func(location Location) (bytes []byte, err error) {
+				switch location {
+				case importedLocation:
+					return importedScript, nil
+				default:
+					return nil, fmt.Errorf("unknown import location: %s", location)
+				}
+			},
+			log: func(_ string) {},
+		},
+	}
+
+	scriptLocation := common.ScriptLocation{0x1}
+
+	_, err := runtime.ExecuteScript(
+		Script{
+			Source: script,
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  scriptLocation,
+		},
+	)
+	require.NoError(t, err)
+
+	scriptID := scriptLocation.String()
+
+	assert.Equal(t,
+		[]string{
+			"start cadence.parse " + scriptID,
+			"end cadence.parse " + scriptID,
+			"start cadence.check " + scriptID,
+			"start cadence.import imported",
+			"start cadence.parse imported",
+			"end cadence.parse imported",
+			"start cadence.check imported",
+			"end cadence.check imported",
+			"end cadence.import imported",
+			"end cadence.check " + scriptID,
+			"start cadence.interpret " + scriptID,
+			"start cadence.import imported",
+			"end cadence.import imported",
+			"start cadence.hostFunctionCall " + scriptID + " log",
+			"end cadence.hostFunctionCall " + scriptID + " log",
+			"end cadence.interpret " + scriptID,
+		},
+		runtimeInterface.spans,
+	)
+}
+
 type testWrite struct {
 	owner, key []byte
 }
@@ -7405,3 +8346,732 @@ func assertRuntimeErrorIsExternalError(t *testing.T, err error) {
 	innerError := runtimeError.Unwrap()
 	require.ErrorAs(t, innerError, &runtimeErrors.ExternalError{})
 }
+
+func TestRuntimeExecuteScriptWithEvents(t *testing.T) {
+
+	t.Parallel()
+
+	script := []byte(`
+      pub event Answer(value: Int)
+
+      pub fun main(): Int {
+          emit Answer(value: 42)
+          return 42
+      }
+    `)
+
+	test := func(t *testing.T, collectEvents bool) {
+
+		runtime := newTestInterpreterRuntime()
+
+		var emittedEvents []cadence.Event
+
+		runtimeInterface := &testRuntimeInterface{
+			storage: newTestLedger(nil, nil),
+			emitEvent: func(event cadence.Event) error {
+				emittedEvents = append(emittedEvents, event)
+				return nil
+			},
+		}
+
+		value, collectedEvents, err := runtime.ExecuteScriptWithEvents(
+			Script{
+				Source: script,
+			},
+			Context{
+				Interface:     runtimeInterface,
+				Location:      common.ScriptLocation{},
+				CollectEvents: collectEvents,
+			},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, cadence.NewInt(42), value)
+
+		var events []cadence.Event
+		if collectEvents {
+			assert.Empty(t, emittedEvents)
+			events = collectedEvents
+		} else {
+			assert.Empty(t, collectedEvents)
+			events = emittedEvents
+		}
+
+		require.Len(t, events, 1)
+		assert.Equal(t,
+			"s.0000000000000000000000000000000000000000000000000000000000000000.Answer",
+			string(events[0].Type().ID()),
+		)
+		assert.Equal(t,
+			[]cadence.Value{cadence.NewInt(42)},
+			events[0].Fields,
+		)
+	}
+
+	t.Run("collect", func(t *testing.T) {
+		t.Parallel()
+
+		test(t, true)
+	})
+
+	t.Run("emit", func(t *testing.T) {
+		t.Parallel()
+
+		test(t, false)
+	})
+}
+
+func TestRuntimeResourceDestroyedEvent(t *testing.T) {
+
+	t.Parallel()
+
+	script := []byte(`
+      pub resource R {
+          pub let id: Int
+          pub let tags: [String]
+
+          init(id: Int, tags: [String]) {
+              self.id = id
+              self.tags = tags
+          }
+      }
+
+      pub fun main() {
+          destroy create R(id: 42, tags: ["a", "b"])
+      }
+    `)
+
+	runtime := newTestInterpreterRuntime()
+
+	var events []cadence.Event
+
+	runtimeInterface := &testRuntimeInterface{
+		storage: newTestLedger(nil, nil),
+		generateUUID: func() (uint64, error) {
+			return 7, nil
+		},
+		emitEvent: func(event cadence.Event) error {
+			events = append(events, event)
+			return nil
+		},
+	}
+
+	_, err := runtime.ExecuteScript(
+		Script{
+			Source: script,
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  common.ScriptLocation{},
+		},
+	)
+	require.NoError(t, err)
+
+	require.Len(t, events, 1)
+
+	eventType := events[0].EventType
+	assert.Equal(t,
+		"s.0000000000000000000000000000000000000000000000000000000000000000.R.ResourceDestroyed",
+		string(eventType.ID()),
+	)
+
+	fieldNames := make([]string, len(eventType.Fields))
+	for i, field := range eventType.Fields {
+		fieldNames[i] = field.Identifier
+	}
+	assert.Equal(t, []string{"uuid", "id", "tags"}, fieldNames)
+
+	assert.Equal(t,
+		[]cadence.Value{
+			cadence.NewUInt64(7),
+			cadence.NewInt(42),
+			cadence.NewArray([]cadence.Value{
+				cadence.String("a"),
+				cadence.String("b"),
+			}).WithType(cadence.NewVariableSizedArrayType(cadence.StringType{})),
+		},
+		events[0].Fields,
+	)
+}
+
+func TestRuntimeExecuteScriptWithReadSet(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := newTestInterpreterRuntime()
+
+	address1 := common.MustBytesToAddress([]byte{0x1})
+	address2 := common.MustBytesToAddress([]byte{0x2})
+
+	runtimeInterface := &testRuntimeInterface{
+		storage: newTestLedger(nil, nil),
+		getSigningAccounts: func() ([]Address, error) {
+			return []Address{address1}, nil
+		},
+		getAccountBalance: func(_ Address) (uint64, error) {
+			return 1, nil
+		},
+	}
+
+	nextTransactionLocation := newTransactionLocationGenerator()
+
+	err := runtime.ExecuteTransaction(
+		Script{
+			Source: []byte(`
+              transaction {
+                  prepare(signer: AuthAccount) {
+                      signer.save(42, to: /storage/foo)
+                  }
+              }
+            `),
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	script := []byte(`
+      pub fun main(): Int {
+          let account = getAuthAccount(0x1)
+          let missing = account.borrow<&Int>(from: /storage/bar)
+          account.forEachPublic(fun (path: PublicPath, type: Type): Bool {
+              return true
+          })
+          let balance = getAccount(0x2).balance
+          return account.copy<Int>(from: /storage/foo)!
+      }
+    `)
+
+	value, readSet, err := runtime.ExecuteScriptWithReadSet(
+		Script{
+			Source: script,
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  common.ScriptLocation{},
+		},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, cadence.NewInt(42), value)
+
+	require.NotNil(t, readSet)
+
+	assert.Equal(t,
+		[]common.Address{address1, address2},
+		readSet.Accounts,
+	)
+
+	assert.Equal(t,
+		[]PathRead{
+			{Address: address1, Domain: "storage", Identifier: "bar"},
+			{Address: address1, Domain: "public", Identifier: ""},
+			{Address: address1, Domain: "storage", Identifier: "foo"},
+		},
+		readSet.Paths,
+	)
+
+	assert.Contains(t,
+		readSet.Registers,
+		RegisterRead{
+			Owner: address1[:],
+			Key:   []byte("storage"),
+		},
+	)
+	assert.Contains(t,
+		readSet.Registers,
+		RegisterRead{
+			Owner: address1[:],
+			Key:   []byte("public"),
+		},
+	)
+
+	assert.Empty(t, readSet.Contracts)
+}
+
+func TestRuntimeExecuteTransactionWithChangeSet(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := newTestInterpreterRuntime()
+
+	address := common.MustBytesToAddress([]byte{0x1})
+
+	contract := []byte(`
+      pub contract Test {
+
+          pub resource R {
+              pub var balance: Int
+
+              init(balance: Int) {
+                  self.balance = balance
+              }
+
+              pub fun deposit(_ amount: Int) {
+                  self.balance = self.balance + amount
+              }
+          }
+
+          pub fun createR(balance: Int): @R {
+              return <- create R(balance: balance)
+          }
+      }
+    `)
+
+	var accountCode []byte
+	var uuid uint64
+
+	runtimeInterface := &testRuntimeInterface{
+		storage: newTestLedger(nil, nil),
+		getSigningAccounts: func() ([]Address, error) {
+			return []Address{address}, nil
+		},
+		resolveLocation: singleIdentifierLocationResolver(t),
+		getAccountContractCode: func(_ Address, _ string) ([]byte, error) {
+			return accountCode, nil
+		},
+		updateAccountContractCode: func(_ Address, _ string, code []byte) error {
+			accountCode = code
+			return nil
+		},
+		emitEvent: func(event cadence.Event) error {
+			return nil
+		},
+		generateUUID: func() (uint64, error) {
+			uuid++
+			return uuid, nil
+		},
+	}
+
+	nextTransactionLocation := newTransactionLocationGenerator()
+
+	for _, source := range [][]byte{
+		utils.DeploymentTransaction("Test", contract),
+		[]byte(`
+          import Test from 0x1
+
+          transaction {
+              prepare(signer: AuthAccount) {
+                  signer.save(<- Test.createR(balance: 1), to: /storage/a)
+                  signer.save(<- Test.createR(balance: 2), to: /storage/b)
+                  signer.save(42, to: /storage/c)
+              }
+          }
+        `),
+	} {
+		err := runtime.ExecuteTransaction(
+			Script{
+				Source: source,
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  nextTransactionLocation(),
+			},
+		)
+		require.NoError(t, err)
+	}
+
+	changeSet, err := runtime.ExecuteTransactionWithChangeSet(
+		Script{
+			Source: []byte(`
+              import Test from 0x1
+
+              transaction {
+                  prepare(signer: AuthAccount) {
+                      // mutate a stored resource through a reference
+                      signer.borrow<&Test.R>(from: /storage/a)!.deposit(10)
+
+                      // destroy a stored resource
+                      destroy signer.load<@Test.R>(from: /storage/b)
+
+                      // store a new resource
+                      signer.save(<- Test.createR(balance: 3), to: /storage/d)
+
+                      // read a value without changing it
+                      let c = signer.copy<Int>(from: /storage/c)
+                  }
+              }
+            `),
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+	require.NotNil(t, changeSet)
+
+	resourceTypeID := common.NewAddressLocation(nil, address, "Test").TypeID(nil, "Test.R")
+
+	require.Len(t, changeSet.Paths, 3)
+
+	pathA := changeSet.Paths[0]
+	assert.Equal(t, address, pathA.Address)
+	assert.Equal(t, "storage", pathA.Domain)
+	assert.Equal(t, "a", pathA.Identifier)
+	assert.Equal(t,
+		cadence.NewInt(1),
+		pathA.OldValue.(cadence.Composite).FieldByName("balance"),
+	)
+	assert.Equal(t,
+		cadence.NewInt(11),
+		pathA.NewValue.(cadence.Composite).FieldByName("balance"),
+	)
+
+	pathB := changeSet.Paths[1]
+	assert.Equal(t, "b", pathB.Identifier)
+	assert.NotNil(t, pathB.OldValue)
+	assert.Nil(t, pathB.NewValue)
+
+	pathD := changeSet.Paths[2]
+	assert.Equal(t, "d", pathD.Identifier)
+	assert.Nil(t, pathD.OldValue)
+	assert.Equal(t,
+		cadence.NewInt(3),
+		pathD.NewValue.(cadence.Composite).FieldByName("balance"),
+	)
+
+	assert.Equal(t,
+		[]ResourceChange{
+			{TypeID: resourceTypeID, UUID: 3},
+		},
+		changeSet.CreatedResources,
+	)
+	assert.Equal(t,
+		[]ResourceChange{
+			{TypeID: resourceTypeID, UUID: 2},
+		},
+		changeSet.DestroyedResources,
+	)
+}
+
+func TestRuntimeWriteSetRecorder(t *testing.T) {
+
+	t.Parallel()
+
+	recorder := newWriteSetRecorder()
+
+	value := []byte{2}
+	recorder.recordRegisterWrite([]byte{0x1}, []byte("a"), []byte{1})
+	recorder.recordRegisterWrite([]byte{0x1}, []byte("b"), value)
+
+	// The recorded value is a copy
+	value[0] = 42
+
+	// A later write of the same register replaces the value,
+	// but keeps the position of the first write
+	recorder.recordRegisterWrite([]byte{0x1}, []byte("a"), nil)
+
+	assert.Equal(t,
+		[]RegisterWrite{
+			{
+				Owner: []byte{0x1},
+				Key:   []byte("a"),
+				Value: []byte{},
+			},
+			{
+				Owner: []byte{0x1},
+				Key:   []byte("b"),
+				Value: []byte{2},
+			},
+		},
+		recorder.writeSet.Registers,
+	)
+}
+
+func TestRuntimeExecuteTransactionWithWriteSet(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := newTestInterpreterRuntime()
+
+	address := common.MustBytesToAddress([]byte{0x1})
+
+	type ledgerWrite struct {
+		owner string
+		key   string
+		value string
+	}
+
+	var ledgerWrites []ledgerWrite
+
+	onWrite := func(owner, key, value []byte) {
+		ledgerWrites = append(ledgerWrites, ledgerWrite{
+			owner: string(owner),
+			key:   string(key),
+			value: string(value),
+		})
+	}
+
+	runtimeInterface := &testRuntimeInterface{
+		storage: newTestLedger(nil, onWrite),
+		getSigningAccounts: func() ([]Address, error) {
+			return []Address{address}, nil
+		},
+	}
+
+	nextTransactionLocation := newTransactionLocationGenerator()
+
+	writeSet, err := runtime.ExecuteTransactionWithWriteSet(
+		Script{
+			Source: []byte(`
+              transaction {
+                  prepare(signer: AuthAccount) {
+                      // intermediate values are overwritten and never written
+
+                      signer.save(1, to: /storage/a)
+                      signer.load<Int>(from: /storage/a)
+                      signer.save(2, to: /storage/a)
+                      signer.load<Int>(from: /storage/a)
+                      signer.save(3, to: /storage/a)
+
+                      signer.save("b", to: /storage/b)
+                  }
+              }
+            `),
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+	require.NotNil(t, writeSet)
+
+	// Each register is written once, with its final value,
+	// in the order of the writes to the ledger
+
+	require.Len(t, writeSet.Registers, len(ledgerWrites))
+
+	for i, ledgerWrite := range ledgerWrites {
+		register := writeSet.Registers[i]
+		assert.Equal(t, ledgerWrite.owner, string(register.Owner))
+		assert.Equal(t, ledgerWrite.key, string(register.Key))
+		assert.Equal(t, ledgerWrite.value, string(register.Value))
+	}
+
+	assert.Equal(t,
+		[]RegisterWrite{
+			// storage index to storage domain storage map
+			{
+				Owner: address[:],
+				Key:   []byte("storage"),
+				Value: []byte{0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1},
+			},
+		},
+		writeSet.Registers[:1],
+	)
+	require.Len(t, writeSet.Registers, 2)
+	assert.Equal(t,
+		[]byte{'$', 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1},
+		writeSet.Registers[1].Key,
+	)
+
+	// Another execution only records its own writes
+
+	ledgerWrites = nil
+
+	writeSet, err = runtime.ExecuteTransactionWithWriteSet(
+		Script{
+			Source: []byte(`
+              transaction {
+                  prepare(signer: AuthAccount) {
+                      let value = signer.copy<Int>(from: /storage/a)
+                  }
+              }
+            `),
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+	require.NotNil(t, writeSet)
+
+	assert.Empty(t, ledgerWrites)
+	assert.Empty(t, writeSet.Registers)
+}
+
+// testUUIDBatchRuntimeInterface is a test runtime interface which supports allocating UUIDs in batches
+//
+type testUUIDBatchRuntimeInterface struct {
+	*testRuntimeInterface
+	allocateUUIDs func(count uint64) ([]uint64, error)
+}
+
+var _ UUIDBatchAllocator = &testUUIDBatchRuntimeInterface{}
+
+func (i *testUUIDBatchRuntimeInterface) AllocateUUIDs(count uint64) ([]uint64, error) {
+	return i.allocateUUIDs(count)
+}
+
+func TestRuntimeUUIDBatchAllocation(t *testing.T) {
+
+	t.Parallel()
+
+	script := []byte(`
+      pub resource R {}
+
+      pub fun main(): [UInt64] {
+          let uuids: [UInt64] = []
+          var i = 0
+          while i < 5 {
+              let r <- create R()
+              uuids.append(r.uuid)
+              destroy r
+              i = i + 1
+          }
+          return uuids
+      }
+    `)
+
+	expectedUUIDs := cadence.NewArray([]cadence.Value{
+		cadence.NewUInt64(1),
+		cadence.NewUInt64(2),
+		cadence.NewUInt64(3),
+		cadence.NewUInt64(4),
+		cadence.NewUInt64(5),
+	}).WithType(cadence.VariableSizedArrayType{
+		ElementType: cadence.UInt64Type{},
+	})
+
+	t.Run("individual", func(t *testing.T) {
+
+		t.Parallel()
+
+		runtime := newTestInterpreterRuntime()
+
+		var uuid uint64
+		var allocations int
+
+		runtimeInterface := &testUUIDBatchRuntimeInterface{
+			testRuntimeInterface: &testRuntimeInterface{
+				generateUUID: func() (uint64, error) {
+					uuid++
+					return uuid, nil
+				},
+			},
+			allocateUUIDs: func(count uint64) ([]uint64, error) {
+				allocations++
+				return nil, nil
+			},
+		}
+
+		result, err := runtime.ExecuteScript(
+			Script{
+				Source: script,
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  common.ScriptLocation{},
+			},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, expectedUUIDs, result)
+		assert.Equal(t, 0, allocations)
+	})
+
+	t.Run("batched", func(t *testing.T) {
+
+		t.Parallel()
+
+		runtime := newTestInterpreterRuntime(WithUUIDBatchSize(2))
+
+		var uuid uint64
+		var allocations []uint64
+
+		runtimeInterface := &testUUIDBatchRuntimeInterface{
+			testRuntimeInterface: &testRuntimeInterface{
+				generateUUID: func() (uint64, error) {
+					require.FailNow(t, "unexpected call of GenerateUUID")
+					return 0, nil
+				},
+			},
+			allocateUUIDs: func(count uint64) ([]uint64, error) {
+				allocations = append(allocations, count)
+
+				uuids := make([]uint64, count)
+				for i := range uuids {
+					uuid++
+					uuids[i] = uuid
+				}
+				return uuids, nil
+			},
+		}
+
+		result, err := runtime.ExecuteScript(
+			Script{
+				Source: script,
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  common.ScriptLocation{},
+			},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, expectedUUIDs, result)
+		assert.Equal(t, []uint64{2, 2, 2}, allocations)
+	})
+
+	t.Run("batched, not supported", func(t *testing.T) {
+
+		t.Parallel()
+
+		runtime := newTestInterpreterRuntime(WithUUIDBatchSize(2))
+
+		var uuid uint64
+
+		runtimeInterface := &testRuntimeInterface{
+			generateUUID: func() (uint64, error) {
+				uuid++
+				return uuid, nil
+			},
+		}
+
+		result, err := runtime.ExecuteScript(
+			Script{
+				Source: script,
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  common.ScriptLocation{},
+			},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, expectedUUIDs, result)
+	})
+
+	t.Run("batched, no UUIDs allocated", func(t *testing.T) {
+
+		t.Parallel()
+
+		runtime := newTestInterpreterRuntime(WithUUIDBatchSize(2))
+
+		runtimeInterface := &testUUIDBatchRuntimeInterface{
+			testRuntimeInterface: &testRuntimeInterface{},
+			allocateUUIDs: func(count uint64) ([]uint64, error) {
+				return nil, nil
+			},
+		}
+
+		_, err := runtime.ExecuteScript(
+			Script{
+				Source: script,
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  common.ScriptLocation{},
+			},
+		)
+		require.Error(t, err)
+
+		var unexpectedErr runtimeErrors.UnexpectedError
+		require.ErrorAs(t, err, &unexpectedErr)
+	})
+}
@@ -0,0 +1,326 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/common"
+)
+
+const AuthAccountCapabilitiesTypeName = "Capabilities"
+const AuthAccountCapabilitiesTypeStorageFieldName = "storage"
+const AuthAccountCapabilitiesTypePublishFunctionName = "publish"
+const AuthAccountCapabilitiesTypeUnpublishFunctionName = "unpublish"
+const AuthAccountCapabilitiesTypeMigrateLinkFunctionName = "migrateLink"
+
+// AuthAccountCapabilitiesType represents the type `AuthAccount.Capabilities`
+//
+var AuthAccountCapabilitiesType = func() *CompositeType {
+
+	authAccountCapabilitiesType := &CompositeType{
+		Identifier: AuthAccountCapabilitiesTypeName,
+		Kind:       common.CompositeKindStructure,
+		importable: false,
+	}
+
+	var members = []*Member{
+		NewUnmeteredPublicConstantFieldMember(
+			authAccountCapabilitiesType,
+			AuthAccountCapabilitiesTypeStorageFieldName,
+			AuthAccountStorageCapabilitiesType,
+			authAccountCapabilitiesTypeStorageFieldDocString,
+		),
+		NewUnmeteredPublicFunctionMember(
+			authAccountCapabilitiesType,
+			AuthAccountCapabilitiesTypePublishFunctionName,
+			AuthAccountCapabilitiesTypePublishFunctionType,
+			authAccountCapabilitiesTypePublishFunctionDocString,
+		),
+		NewUnmeteredPublicFunctionMember(
+			authAccountCapabilitiesType,
+			AuthAccountCapabilitiesTypeUnpublishFunctionName,
+			AuthAccountCapabilitiesTypeUnpublishFunctionType,
+			authAccountCapabilitiesTypeUnpublishFunctionDocString,
+		),
+		NewUnmeteredPublicFunctionMember(
+			authAccountCapabilitiesType,
+			AuthAccountCapabilitiesTypeMigrateLinkFunctionName,
+			AuthAccountCapabilitiesTypeMigrateLinkFunctionType,
+			authAccountCapabilitiesTypeMigrateLinkFunctionDocString,
+		),
+	}
+
+	authAccountCapabilitiesType.Members = GetMembersAsMap(members)
+	authAccountCapabilitiesType.Fields = getFieldNames(members)
+	return authAccountCapabilitiesType
+}()
+
+const authAccountCapabilitiesTypeStorageFieldDocString = `
+The capabilities of the account which target storage paths
+`
+
+var AuthAccountCapabilitiesTypePublishFunctionType = &FunctionType{
+	Effects: FunctionEffectWriteStorage,
+	Parameters: []*Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "capability",
+			TypeAnnotation: NewTypeAnnotation(&CapabilityType{}),
+		},
+		{
+			Identifier:     "at",
+			TypeAnnotation: NewTypeAnnotation(PublicPathType),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(VoidType),
+}
+
+const authAccountCapabilitiesTypePublishFunctionDocString = `
+Publishes the given capability at the given public path.
+
+The capability must have been issued by the account.
+It can then be obtained and borrowed by anyone using ` + "`getCapability`" + `.
+
+The function fails if the path is already in use
+`
+
+var AuthAccountCapabilitiesTypeUnpublishFunctionType = &FunctionType{
+	Effects: FunctionEffectWriteStorage,
+	Parameters: []*Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "path",
+			TypeAnnotation: NewTypeAnnotation(PublicPathType),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		&OptionalType{
+			Type: &CapabilityType{},
+		},
+	),
+}
+
+const authAccountCapabilitiesTypeUnpublishFunctionDocString = `
+Unpublishes the capability published at the given public path.
+
+Returns the capability if one was published at the path.
+Returns nil if no capability was published at the path
+`
+
+var AuthAccountCapabilitiesTypeMigrateLinkFunctionType = &FunctionType{
+	Effects: FunctionEffectWriteStorage,
+	Parameters: []*Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "newCapabilityPath",
+			TypeAnnotation: NewTypeAnnotation(CapabilityPathType),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		&OptionalType{
+			Type: UInt64Type,
+		},
+	),
+}
+
+const authAccountCapabilitiesTypeMigrateLinkFunctionDocString = `
+Migrates the link at the given public or private path to a capability controller.
+
+The link is resolved to its final storage path target,
+a storage capability is issued for the target with the type of the link,
+and the link is replaced with the issued capability.
+Existing capabilities for the path keep working.
+
+Returns the ID of the issued capability.
+Returns nil if there is no link at the given path,
+or if the link does not lead to a storage path
+`
+
+func init() {
+	// Set the container type after initializing the `AuthAccountCapabilitiesType`, to avoid initializing loop.
+	AuthAccountCapabilitiesType.SetContainerType(AuthAccountType)
+}
+
+const AuthAccountStorageCapabilitiesTypeName = "StorageCapabilities"
+const AuthAccountStorageCapabilitiesTypeGetControllerFunctionName = "getController"
+const AuthAccountStorageCapabilitiesTypeGetControllersFunctionName = "getControllers"
+const AuthAccountStorageCapabilitiesTypeForEachControllerFunctionName = "forEachController"
+const AuthAccountStorageCapabilitiesTypeIssueFunctionName = "issue"
+
+// AuthAccountStorageCapabilitiesType represents the type `AuthAccount.StorageCapabilities`
+//
+var AuthAccountStorageCapabilitiesType = func() *CompositeType {
+
+	authAccountStorageCapabilitiesType := &CompositeType{
+		Identifier: AuthAccountStorageCapabilitiesTypeName,
+		Kind:       common.CompositeKindStructure,
+		importable: false,
+	}
+
+	var members = []*Member{
+		NewUnmeteredPublicFunctionMember(
+			authAccountStorageCapabilitiesType,
+			AuthAccountStorageCapabilitiesTypeGetControllerFunctionName,
+			AuthAccountStorageCapabilitiesTypeGetControllerFunctionType,
+			authAccountStorageCapabilitiesTypeGetControllerFunctionDocString,
+		),
+		NewUnmeteredPublicFunctionMember(
+			authAccountStorageCapabilitiesType,
+			AuthAccountStorageCapabilitiesTypeGetControllersFunctionName,
+			AuthAccountStorageCapabilitiesTypeGetControllersFunctionType,
+			authAccountStorageCapabilitiesTypeGetControllersFunctionDocString,
+		),
+		NewUnmeteredPublicFunctionMember(
+			authAccountStorageCapabilitiesType,
+			AuthAccountStorageCapabilitiesTypeForEachControllerFunctionName,
+			AuthAccountStorageCapabilitiesTypeForEachControllerFunctionType,
+			authAccountStorageCapabilitiesTypeForEachControllerFunctionDocString,
+		),
+		NewUnmeteredPublicFunctionMember(
+			authAccountStorageCapabilitiesType,
+			AuthAccountStorageCapabilitiesTypeIssueFunctionName,
+			AuthAccountStorageCapabilitiesTypeIssueFunctionType,
+			authAccountStorageCapabilitiesTypeIssueFunctionDocString,
+		),
+	}
+
+	authAccountStorageCapabilitiesType.Members = GetMembersAsMap(members)
+	authAccountStorageCapabilitiesType.Fields = getFieldNames(members)
+	return authAccountStorageCapabilitiesType
+}()
+
+var AuthAccountStorageCapabilitiesTypeGetControllerFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
+	Parameters: []*Parameter{
+		{
+			Label:          "byCapabilityID",
+			Identifier:     "capabilityID",
+			TypeAnnotation: NewTypeAnnotation(UInt64Type),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		&OptionalType{
+			Type: StorageCapabilityControllerType,
+		},
+	),
+}
+
+const authAccountStorageCapabilitiesTypeGetControllerFunctionDocString = `
+Returns the storage capability controller for the capability with the given ID.
+
+Returns nil if the ID does not reference an existing storage capability
+`
+
+var AuthAccountStorageCapabilitiesTypeGetControllersFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
+	Parameters: []*Parameter{
+		{
+			Label:          "forPath",
+			Identifier:     "path",
+			TypeAnnotation: NewTypeAnnotation(StoragePathType),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		&VariableSizedType{
+			Type: StorageCapabilityControllerType,
+		},
+	),
+}
+
+const authAccountStorageCapabilitiesTypeGetControllersFunctionDocString = `
+Returns all storage capability controllers for capabilities that target the given storage path,
+ordered by capability ID
+`
+
+var AuthAccountStorageCapabilitiesTypeForEachControllerFunctionType = &FunctionType{
+	Parameters: []*Parameter{
+		{
+			Label:          "forPath",
+			Identifier:     "path",
+			TypeAnnotation: NewTypeAnnotation(StoragePathType),
+		},
+		{
+			Label:      ArgumentLabelNotRequired,
+			Identifier: "function",
+			TypeAnnotation: NewTypeAnnotation(
+				&FunctionType{
+					Parameters: []*Parameter{
+						{
+							Identifier:     "controller",
+							TypeAnnotation: NewTypeAnnotation(StorageCapabilityControllerType),
+						},
+					},
+					ReturnTypeAnnotation: NewTypeAnnotation(BoolType),
+				},
+			),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(VoidType),
+}
+
+const authAccountStorageCapabilitiesTypeForEachControllerFunctionDocString = `
+Calls the given function for each storage capability controller
+for capabilities that target the given storage path.
+
+Iteration stops early when the function returns false.
+Capabilities must not be issued and controllers must not be retargeted or deleted while iterating
+`
+
+var AuthAccountStorageCapabilitiesTypeIssueFunctionType = func() *FunctionType {
+
+	typeParameter := &TypeParameter{
+		TypeBound: &ReferenceType{
+			Type: AnyType,
+		},
+		Name: "T",
+	}
+
+	return &FunctionType{
+		Effects: FunctionEffectWriteStorage,
+		TypeParameters: []*TypeParameter{
+			typeParameter,
+		},
+		Parameters: []*Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "path",
+				TypeAnnotation: NewTypeAnnotation(StoragePathType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			&CapabilityType{
+				BorrowType: &GenericType{
+					TypeParameter: typeParameter,
+				},
+			},
+		),
+	}
+}()
+
+const authAccountStorageCapabilitiesTypeIssueFunctionDocString = `
+Issues a new storage capability which targets the given storage path,
+and which can be borrowed as the given type.
+
+Every issued capability has a unique ID and its own controller,
+which can be used to retarget or revoke the capability
+`
+
+func init() {
+	// Set the container type after initializing the `AuthAccountStorageCapabilitiesType`, to avoid initializing loop.
+	AuthAccountStorageCapabilitiesType.SetContainerType(AuthAccountType)
+}
@@ -102,6 +102,7 @@ Returns the deployed contract.
 `
 
 var AuthAccountContractsTypeAddFunctionType = &FunctionType{
+	Effects: FunctionEffectWriteStorage,
 	Parameters: []*Parameter{
 		{
 			Identifier: "name",
@@ -143,6 +144,7 @@ Returns the deployed contract for the updated contract.
 `
 
 var AuthAccountContractsTypeUpdateExperimentalFunctionType = &FunctionType{
+	Effects: FunctionEffectWriteStorage,
 	Parameters: []*Parameter{
 		{
 			Identifier: "name",
@@ -169,6 +171,7 @@ Returns nil if no contract/contract interface with the given name exists in the
 `
 
 var AuthAccountContractsTypeGetFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	Parameters: []*Parameter{
 		{
 			Identifier: "name",
@@ -193,6 +196,7 @@ Returns nil if no contract/contract interface with the given name exists in the
 `
 
 var AuthAccountContractsTypeRemoveFunctionType = &FunctionType{
+	Effects: FunctionEffectWriteStorage,
 	Parameters: []*Parameter{
 		{
 			Identifier:     "name",
@@ -0,0 +1,171 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/common"
+)
+
+const AuthAccountInboxTypeName = "Inbox"
+const AuthAccountInboxTypePublishFunctionName = "publish"
+const AuthAccountInboxTypeUnpublishFunctionName = "unpublish"
+const AuthAccountInboxTypeClaimFunctionName = "claim"
+
+// AuthAccountInboxType represents the type `AuthAccount.Inbox`
+//
+var AuthAccountInboxType = func() *CompositeType {
+
+	authAccountInboxType := &CompositeType{
+		Identifier: AuthAccountInboxTypeName,
+		Kind:       common.CompositeKindStructure,
+		importable: false,
+	}
+
+	var members = []*Member{
+		NewUnmeteredPublicFunctionMember(
+			authAccountInboxType,
+			AuthAccountInboxTypePublishFunctionName,
+			AuthAccountInboxTypePublishFunctionType,
+			authAccountInboxTypePublishFunctionDocString,
+		),
+		NewUnmeteredPublicFunctionMember(
+			authAccountInboxType,
+			AuthAccountInboxTypeUnpublishFunctionName,
+			AuthAccountInboxTypeUnpublishFunctionType,
+			authAccountInboxTypeUnpublishFunctionDocString,
+		),
+		NewUnmeteredPublicFunctionMember(
+			authAccountInboxType,
+			AuthAccountInboxTypeClaimFunctionName,
+			AuthAccountInboxTypeClaimFunctionType,
+			authAccountInboxTypeClaimFunctionDocString,
+		),
+	}
+
+	authAccountInboxType.Members = GetMembersAsMap(members)
+	authAccountInboxType.Fields = getFieldNames(members)
+	return authAccountInboxType
+}()
+
+var AuthAccountInboxTypePublishFunctionType = &FunctionType{
+	Effects: FunctionEffectWriteStorage,
+	Parameters: []*Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "value",
+			TypeAnnotation: NewTypeAnnotation(&CapabilityType{}),
+		},
+		{
+			Identifier:     "name",
+			TypeAnnotation: NewTypeAnnotation(StringType),
+		},
+		{
+			Identifier:     "recipient",
+			TypeAnnotation: NewTypeAnnotation(&AddressType{}),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(VoidType),
+}
+
+const authAccountInboxTypePublishFunctionDocString = `
+Publishes the given capability under the given name, to be claimed by the given recipient.
+
+Only the recipient can claim the capability, using ` + "`claim`" + `.
+The account can unpublish the capability again, using ` + "`unpublish`" + `.
+
+If a capability was already published under the name, it is overwritten.
+Emits an ` + "`InboxValuePublished`" + ` event
+`
+
+// newAuthAccountInboxTakeFunctionType returns the type of a function
+// which takes a capability out of an inbox, i.e. `unpublish` and `claim`.
+//
+func newAuthAccountInboxTakeFunctionType(parameters ...*Parameter) *FunctionType {
+
+	typeParameter := &TypeParameter{
+		TypeBound: &ReferenceType{
+			Type: AnyType,
+		},
+		Name: "T",
+	}
+
+	return &FunctionType{
+		Effects: FunctionEffectWriteStorage,
+		TypeParameters: []*TypeParameter{
+			typeParameter,
+		},
+		Parameters: parameters,
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			&OptionalType{
+				Type: &CapabilityType{
+					BorrowType: &GenericType{
+						TypeParameter: typeParameter,
+					},
+				},
+			},
+		),
+	}
+}
+
+var AuthAccountInboxTypeUnpublishFunctionType = newAuthAccountInboxTakeFunctionType(
+	&Parameter{
+		Label:          ArgumentLabelNotRequired,
+		Identifier:     "name",
+		TypeAnnotation: NewTypeAnnotation(StringType),
+	},
+)
+
+const authAccountInboxTypeUnpublishFunctionDocString = `
+Unpublishes the capability published by the account under the given name.
+
+Returns the capability if one was published under the name.
+Returns nil if no capability was published under the name.
+
+The function fails if the published capability's type is not a subtype of the requested capability type.
+Emits an ` + "`InboxValueUnpublished`" + ` event
+`
+
+var AuthAccountInboxTypeClaimFunctionType = newAuthAccountInboxTakeFunctionType(
+	&Parameter{
+		Label:          ArgumentLabelNotRequired,
+		Identifier:     "name",
+		TypeAnnotation: NewTypeAnnotation(StringType),
+	},
+	&Parameter{
+		Identifier:     "provider",
+		TypeAnnotation: NewTypeAnnotation(&AddressType{}),
+	},
+)
+
+const authAccountInboxTypeClaimFunctionDocString = `
+Claims the capability published under the given name by the given provider for this account.
+
+The capability is removed from the provider's inbox.
+Returns the capability if the provider published one under the name for this account.
+Returns nil if the provider did not publish a capability under the name,
+or if the capability was published for another account.
+
+The function fails if the published capability's type is not a subtype of the requested capability type.
+Emits an ` + "`InboxValueClaimed`" + ` event
+`
+
+func init() {
+	// Set the container type after initializing the `AuthAccountInboxType`, to avoid initializing loop.
+	AuthAccountInboxType.SetContainerType(AuthAccountType)
+}
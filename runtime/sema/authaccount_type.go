@@ -41,6 +41,11 @@ const AuthAccountGetCapabilityField = "getCapability"
 const AuthAccountGetLinkTargetField = "getLinkTarget"
 const AuthAccountContractsField = "contracts"
 const AuthAccountKeysField = "keys"
+const AuthAccountCapabilitiesField = "capabilities"
+const AuthAccountInboxField = "inbox"
+const AuthAccountForEachStoredField = "forEachStored"
+const AuthAccountForEachPublicField = "forEachPublic"
+const AuthAccountForEachPrivateField = "forEachPrivate"
 
 // AuthAccountType represents the authorized access to an account.
 // Access to an AuthAccount means having full access to its storage, public keys, and code.
@@ -57,6 +62,9 @@ var AuthAccountType = func() *CompositeType {
 			nestedTypes := &StringTypeOrderedMap{}
 			nestedTypes.Set(AuthAccountContractsTypeName, AuthAccountContractsType)
 			nestedTypes.Set(AccountKeysTypeName, AuthAccountKeysType)
+			nestedTypes.Set(AuthAccountCapabilitiesTypeName, AuthAccountCapabilitiesType)
+			nestedTypes.Set(AuthAccountStorageCapabilitiesTypeName, AuthAccountStorageCapabilitiesType)
+			nestedTypes.Set(AuthAccountInboxTypeName, AuthAccountInboxType)
 			return nestedTypes
 		}(),
 	}
@@ -170,6 +178,36 @@ var AuthAccountType = func() *CompositeType {
 			AuthAccountKeysType,
 			accountTypeKeysFieldDocString,
 		),
+		NewUnmeteredPublicConstantFieldMember(
+			authAccountType,
+			AuthAccountCapabilitiesField,
+			AuthAccountCapabilitiesType,
+			authAccountTypeCapabilitiesFieldDocString,
+		),
+		NewUnmeteredPublicConstantFieldMember(
+			authAccountType,
+			AuthAccountInboxField,
+			AuthAccountInboxType,
+			authAccountTypeInboxFieldDocString,
+		),
+		NewUnmeteredPublicFunctionMember(
+			authAccountType,
+			AuthAccountForEachStoredField,
+			AuthAccountForEachStoredFunctionType,
+			authAccountForEachStoredFunctionDocString,
+		),
+		NewUnmeteredPublicFunctionMember(
+			authAccountType,
+			AuthAccountForEachPublicField,
+			AccountForEachPublicFunctionType,
+			accountForEachPublicFunctionDocString,
+		),
+		NewUnmeteredPublicFunctionMember(
+			authAccountType,
+			AuthAccountForEachPrivateField,
+			AuthAccountForEachPrivateFunctionType,
+			authAccountForEachPrivateFunctionDocString,
+		),
 	}
 
 	authAccountType.Members = GetMembersAsMap(members)
@@ -178,6 +216,7 @@ var AuthAccountType = func() *CompositeType {
 }()
 
 var AuthAccountTypeAddPublicKeyFunctionType = &FunctionType{
+	Effects: FunctionEffectWriteStorage,
 	Parameters: []*Parameter{
 		{
 			Label:      ArgumentLabelNotRequired,
@@ -193,10 +232,13 @@ var AuthAccountTypeAddPublicKeyFunctionType = &FunctionType{
 }
 
 const authAccountTypeAddPublicKeyFunctionDocString = `
-Adds the given byte representation of a public key to the account's keys
+Adds the given byte representation of a public key to the account's keys.
+
+Deprecated: Use ` + "`keys.add`" + ` instead
 `
 
 var AuthAccountTypeRemovePublicKeyFunctionType = &FunctionType{
+	Effects: FunctionEffectWriteStorage,
 	Parameters: []*Parameter{
 		{
 			Label:      ArgumentLabelNotRequired,
@@ -212,7 +254,9 @@ var AuthAccountTypeRemovePublicKeyFunctionType = &FunctionType{
 }
 
 const authAccountTypeRemovePublicKeyFunctionDocString = `
-Removes the public key at the given index from the account's keys
+Removes the public key at the given index from the account's keys.
+
+Deprecated: Use ` + "`keys.revoke`" + ` instead
 `
 
 var AuthAccountTypeSaveFunctionType = func() *FunctionType {
@@ -223,6 +267,7 @@ var AuthAccountTypeSaveFunctionType = func() *FunctionType {
 	}
 
 	return &FunctionType{
+		Effects: FunctionEffectWriteStorage,
 		TypeParameters: []*TypeParameter{
 			typeParameter,
 		},
@@ -263,6 +308,7 @@ var AuthAccountTypeLoadFunctionType = func() *FunctionType {
 	}
 
 	return &FunctionType{
+		Effects: FunctionEffectWriteStorage,
 		TypeParameters: []*TypeParameter{
 			typeParameter,
 		},
@@ -292,6 +338,7 @@ The path must be a storage path, i.e., only the domain ` + "`storage`" + ` is al
 `
 
 var AuthAccountTypeTypeFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	Parameters: []*Parameter{
 		{
 			Label:          "at",
@@ -328,6 +375,7 @@ var AuthAccountTypeCopyFunctionType = func() *FunctionType {
 	}
 
 	return &FunctionType{
+		Purity: FunctionPurityView,
 		TypeParameters: []*TypeParameter{
 			typeParameter,
 		},
@@ -371,6 +419,7 @@ var AuthAccountTypeBorrowFunctionType = func() *FunctionType {
 	}
 
 	return &FunctionType{
+		Purity: FunctionPurityView,
 		TypeParameters: []*TypeParameter{
 			typeParameter,
 		},
@@ -416,6 +465,7 @@ var AuthAccountTypeLinkFunctionType = func() *FunctionType {
 	}
 
 	return &FunctionType{
+		Effects: FunctionEffectWriteStorage,
 		TypeParameters: []*TypeParameter{
 			typeParameter,
 		},
@@ -456,6 +506,7 @@ The link is latent. The target value might be stored after the link is created,
 `
 
 var AuthAccountTypeUnlinkFunctionType = &FunctionType{
+	Effects: FunctionEffectWriteStorage,
 	Parameters: []*Parameter{
 		{
 			Label:          ArgumentLabelNotRequired,
@@ -481,6 +532,7 @@ var AuthAccountTypeGetCapabilityFunctionType = func() *FunctionType {
 	}
 
 	return &FunctionType{
+		Purity: FunctionPurityView,
 		TypeParameters: []*TypeParameter{
 			typeParameter,
 		},
@@ -506,6 +558,7 @@ Returns the capability at the given private or public path, or nil if it does no
 `
 
 var AccountTypeGetLinkTargetFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	Parameters: []*Parameter{
 		{
 			Label:          ArgumentLabelNotRequired,
@@ -520,6 +573,76 @@ var AccountTypeGetLinkTargetFunctionType = &FunctionType{
 	),
 }
 
+// AccountForEachFunctionType returns the type of a function
+// which calls the given iteration function for each path of the given path type
+// in an account, together with the type of the value stored under the path,
+// i.e. `((P, Type): Bool): Void`.
+//
+// The iteration function returns whether the iteration should continue.
+//
+func AccountForEachFunctionType(pathType Type) *FunctionType {
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:      ArgumentLabelNotRequired,
+				Identifier: "function",
+				TypeAnnotation: NewTypeAnnotation(
+					&FunctionType{
+						Parameters: []*Parameter{
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "path",
+								TypeAnnotation: NewTypeAnnotation(pathType),
+							},
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "type",
+								TypeAnnotation: NewTypeAnnotation(MetaType),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(
+							BoolType,
+						),
+					},
+				),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			VoidType,
+		),
+	}
+}
+
+var AuthAccountForEachStoredFunctionType = AccountForEachFunctionType(StoragePathType)
+
+var AccountForEachPublicFunctionType = AccountForEachFunctionType(PublicPathType)
+
+var AuthAccountForEachPrivateFunctionType = AccountForEachFunctionType(PrivatePathType)
+
+const authAccountForEachStoredFunctionDocString = `
+Calls the given function for each path in the storage domain of the account,
+together with the type of the object stored under the path.
+
+Iteration stops early when the function returns false.
+Objects must not be saved to or loaded from the account's storage while it is being iterated
+`
+
+const accountForEachPublicFunctionDocString = `
+Calls the given function for each path in the public domain of the account,
+together with the type of the capability linked under the path.
+
+Iteration stops early when the function returns false.
+Capabilities must not be linked or unlinked in the account while it is being iterated
+`
+
+const authAccountForEachPrivateFunctionDocString = `
+Calls the given function for each path in the private domain of the account,
+together with the type of the capability linked under the path.
+
+Iteration stops early when the function returns false.
+Capabilities must not be linked or unlinked in the account while it is being iterated
+`
+
 // AuthAccountKeysType represents the keys associated with an auth account.
 var AuthAccountKeysType = func() *CompositeType {
 
@@ -556,6 +679,7 @@ var AuthAccountKeysType = func() *CompositeType {
 }()
 
 var AuthAccountKeysTypeAddFunctionType = &FunctionType{
+	Effects: FunctionEffectWriteStorage,
 	Parameters: []*Parameter{
 		{
 			Identifier:     AccountKeyPublicKeyField,
@@ -575,6 +699,7 @@ var AuthAccountKeysTypeAddFunctionType = &FunctionType{
 }
 
 var AccountKeysTypeGetFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	Parameters: []*Parameter{
 		{
 			Identifier:     AccountKeyKeyIndexField,
@@ -586,6 +711,7 @@ var AccountKeysTypeGetFunctionType = &FunctionType{
 }
 
 var AuthAccountKeysTypeRevokeFunctionType = &FunctionType{
+	Effects: FunctionEffectWriteStorage,
 	Parameters: []*Parameter{
 		{
 			Identifier:     AccountKeyKeyIndexField,
@@ -638,6 +764,15 @@ const accountTypeKeysFieldDocString = `
 The keys associated with the account
 `
 
+const authAccountTypeCapabilitiesFieldDocString = `
+The capabilities of the account
+`
+
+const authAccountTypeInboxFieldDocString = `
+The inbox of the account, which allows publishing capabilities to other accounts,
+and claiming capabilities published by other accounts
+`
+
 const authAccountKeysTypeAddFunctionDocString = `
 Adds the given key to the keys list of the account.
 `
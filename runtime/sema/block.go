@@ -86,6 +86,30 @@ var BlockType = &SimpleType{
 					)
 				},
 			},
+			BlockTypeParentIDFieldName: {
+				Kind: common.DeclarationKindField,
+				Resolve: func(memoryGauge common.MemoryGauge, identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicConstantFieldMember(
+						memoryGauge,
+						t,
+						identifier,
+						blockIDFieldType,
+						blockTypeParentIDFieldDocString,
+					)
+				},
+			},
+			BlockTypeCollectionIndexFieldName: {
+				Kind: common.DeclarationKindField,
+				Resolve: func(memoryGauge common.MemoryGauge, identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicConstantFieldMember(
+						memoryGauge,
+						t,
+						identifier,
+						UInt64Type,
+						blockTypeCollectionIndexFieldDocString,
+					)
+				},
+			},
 		}
 	},
 }
@@ -131,3 +155,17 @@ Unix timestamp of when the proposer claims it constructed the block.
 NOTE: It is included by the proposer, there are no guarantees on how much the time stamp can deviate from the true time the block was published.
 Consider observing blocks’ status changes off-chain yourself to get a more reliable value
 `
+
+const BlockTypeParentIDFieldName = "parentID"
+
+const blockTypeParentIDFieldDocString = `
+The ID of the parent block, i.e. the block at the previous height
+`
+
+const BlockTypeCollectionIndexFieldName = "collectionIndex"
+
+const blockTypeCollectionIndexFieldDocString = `
+The index of the collection in the block which contains the currently executed transaction.
+
+It is only set for the current block, see getCurrentBlock, and zero for all other blocks
+`
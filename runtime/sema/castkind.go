@@ -0,0 +1,74 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+//go:generate go run golang.org/x/tools/cmd/stringer -type=CastKind
+
+// CastKind is the kind of a cast from one type to another,
+// as determined by CheckCastability.
+//
+type CastKind uint8
+
+const (
+	// CastKindUnknown indicates that the castability could not be determined,
+	// because one of the types is missing or invalid
+	CastKindUnknown CastKind = iota
+	// CastKindStatic indicates that the cast always succeeds,
+	// i.e. the source type is a subtype of the target type,
+	// and a static cast (`as`) is allowed
+	CastKindStatic
+	// CastKindDynamic indicates that the cast might succeed at run-time,
+	// depending on the value, i.e. only a failable cast (`as?`) or force cast (`as!`) is allowed
+	CastKindDynamic
+	// CastKindImpossible indicates that the cast always fails
+	CastKindImpossible
+)
+
+// CheckCastability determines how a value of the given source type can be cast to the given target type.
+//
+// The cast is static if the source type is a subtype of the target type (see IsSubType),
+// i.e. if all values of the source type are also values of the target type.
+// The cast is dynamic if only some values of the source type may be values of the target type,
+// which can only be determined at run-time, e.g. a cast from `AnyStruct` to `Int`.
+// The cast is impossible if no value of the source type is a value of the target type,
+// e.g. a cast from a resource type to a non-resource type.
+//
+// This function has the same semantics as the checking of casting expressions by the checker.
+//
+func CheckCastability(from, to Type) CastKind {
+	if from == nil || to == nil ||
+		from.IsInvalidType() || to.IsInvalidType() {
+
+		return CastKindUnknown
+	}
+
+	if IsSubType(from, to) {
+		return CastKindStatic
+	}
+
+	if from.IsResourceType() != to.IsResourceType() {
+		return CastKindImpossible
+	}
+
+	if !FailableCastCanSucceed(from, to) {
+		return CastKindImpossible
+	}
+
+	return CastKindDynamic
+}
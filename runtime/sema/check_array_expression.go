@@ -73,7 +73,7 @@ func (checker *Checker) VisitArrayExpression(expression *ast.ArrayExpression) as
 		checker.checkResourceMoveOperation(value, valueType)
 	}
 
-	checker.Elaboration.ArrayExpressionArgumentTypes[expression] = argumentTypes
+	checker.Elaboration.ArrayExpressionArgumentTypes[expression.ID()] = argumentTypes
 
 	if elementType == nil {
 		// Contextually expected type is not available.
@@ -96,7 +96,7 @@ func (checker *Checker) VisitArrayExpression(expression *ast.ArrayExpression) as
 		}
 	}
 
-	checker.Elaboration.ArrayExpressionArrayType[expression] = resultType
+	checker.Elaboration.ArrayExpressionArrayType[expression.ID()] = resultType
 
 	return resultType
 }
@@ -32,8 +32,8 @@ func (checker *Checker) VisitAssignmentStatement(assignment *ast.AssignmentState
 		false,
 	)
 
-	checker.Elaboration.AssignmentStatementValueTypes[assignment] = valueType
-	checker.Elaboration.AssignmentStatementTargetTypes[assignment] = targetType
+	checker.Elaboration.AssignmentStatementValueTypes[assignment.ID()] = valueType
+	checker.Elaboration.AssignmentStatementTargetTypes[assignment.ID()] = targetType
 
 	return nil
 }
@@ -104,6 +104,13 @@ func (checker *Checker) checkAssignment(
 		ResourceInvalidationKindMoveDefinite,
 	)
 
+	if identifierExpression, ok := target.(*ast.IdentifierExpression); ok {
+		variable := checker.valueActivations.Find(identifierExpression.Identifier.Identifier)
+		if variable != nil {
+			checker.recordReferencedResourceVariables(variable, value)
+		}
+	}
+
 	return
 }
 
@@ -169,17 +176,21 @@ func (checker *Checker) visitAssignmentValueType(
 
 	switch target := targetExpression.(type) {
 	case *ast.IdentifierExpression:
-		return checker.visitIdentifierExpressionAssignment(target)
+		targetType = checker.visitIdentifierExpressionAssignment(target)
 
 	case *ast.IndexExpression:
-		return checker.visitIndexExpressionAssignment(target)
+		targetType = checker.visitIndexExpressionAssignment(target)
 
 	case *ast.MemberExpression:
-		return checker.visitMemberExpressionAssignment(target)
+		targetType = checker.visitMemberExpressionAssignment(target)
 
 	default:
 		panic(errors.NewUnreachableError())
 	}
+
+	checker.checkAssignmentTargetPurity(targetExpression)
+
+	return targetType
 }
 
 func (checker *Checker) visitIdentifierExpressionAssignment(
@@ -289,42 +300,37 @@ func (checker *Checker) visitMemberExpressionAssignment(
 
 		if functionActivation.InitializationInfo != nil {
 
-			// If the function potentially returned before,
-			// then the initialization is not definitive, and it must be ignored
+			// NOTE: an assignment after a potential return is still definitive
+			// for the remaining path, as the return statements are checked separately
 
-			// NOTE: assignment can still be considered definitive if the function maybe halted
+			// If the field is constant,
+			// or it is variable and resource-kinded,
+			// and it has already previously been initialized,
+			// report an error for the repeated assignment / initialization
+			//
+			// Assigning to a variable, resource-kinded field is invalid,
+			// because the initial value would get lost.
 
-			if !functionActivation.ReturnInfo.MaybeReturned {
+			initializedFieldMembers := functionActivation.InitializationInfo.InitializedFieldMembers
 
-				// If the field is constant,
-				// or it is variable and resource-kinded,
-				// and it has already previously been initialized,
-				// report an error for the repeated assignment / initialization
-				//
-				// Assigning to a variable, resource-kinded field is invalid,
-				// because the initial value would get lost.
+			if (targetIsConstant || member.TypeAnnotation.Type.IsResourceType()) &&
+				initializedFieldMembers.Contains(accessedSelfMember) {
 
-				initializedFieldMembers := functionActivation.InitializationInfo.InitializedFieldMembers
-
-				if (targetIsConstant || member.TypeAnnotation.Type.IsResourceType()) &&
-					initializedFieldMembers.Contains(accessedSelfMember) {
-
-					checker.report(
-						&FieldReinitializationError{
-							Name:  target.Identifier.Identifier,
-							Range: ast.NewRangeFromPositioned(checker.memoryGauge, target.Identifier),
-						},
-					)
+				checker.report(
+					&FieldReinitializationError{
+						Name:  target.Identifier.Identifier,
+						Range: ast.NewRangeFromPositioned(checker.memoryGauge, target.Identifier),
+					},
+				)
 
-				} else if _, ok := functionActivation.InitializationInfo.FieldMembers.Get(accessedSelfMember); !ok {
-					// This member is not supposed to be initialized
+			} else if _, ok := functionActivation.InitializationInfo.FieldMembers.Get(accessedSelfMember); !ok {
+				// This member is not supposed to be initialized
 
-					reportAssignmentToConstant()
-				} else {
-					// This is the initial assignment to the field, record it
+				reportAssignmentToConstant()
+			} else {
+				// This is the initial assignment to the field, record it
 
-					initializedFieldMembers.Add(accessedSelfMember)
-				}
+				initializedFieldMembers.Add(accessedSelfMember)
 			}
 
 		} else if targetIsConstant {
@@ -0,0 +1,181 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+)
+
+func (checker *Checker) VisitAttachExpression(expression *ast.AttachExpression) ast.Repr {
+
+	baseExpression := expression.Base
+
+	baseType := checker.VisitExpression(baseExpression, nil)
+
+	checker.checkResourceMoveOperation(baseExpression, baseType)
+
+	// NOTE: the attachment is constructed by checking the invocation directly,
+	// as a normal visit of the invocation would report an invalid attachment usage
+
+	invocation := expression.Attachment
+
+	ty := checker.checkInvocationExpression(invocation)
+
+	if ty.IsInvalidType() || baseType.IsInvalidType() {
+		return baseType
+	}
+
+	// Check that the attached expression is an attachment
+
+	attachmentType, isCompositeType := ty.(*CompositeType)
+	if !isCompositeType || attachmentType.Kind != common.CompositeKindAttachment {
+		checker.report(
+			&AttachNonAttachmentError{
+				Type:  ty,
+				Range: ast.NewRangeFromPositioned(checker.memoryGauge, invocation),
+			},
+		)
+		return baseType
+	}
+
+	// Check that the attachment is declared for the type of the base
+
+	if !checker.isAttachmentBaseType(attachmentType, baseType) {
+		checker.report(
+			&AttachToInvalidTypeError{
+				Type:       baseType,
+				Attachment: attachmentType,
+				Range:      ast.NewRangeFromPositioned(checker.memoryGauge, baseExpression),
+			},
+		)
+		return baseType
+	}
+
+	checker.Elaboration.AttachExpressionAttachmentTypes[expression.ID()] = attachmentType
+
+	return baseType
+}
+
+// isAttachmentBaseType returns true if the given attachment type
+// is declared for the given base type.
+//
+func (checker *Checker) isAttachmentBaseType(attachmentType *CompositeType, baseType Type) bool {
+	attachmentBaseType := attachmentType.baseType
+	if attachmentBaseType == nil || attachmentBaseType.IsInvalidType() {
+		return false
+	}
+
+	return baseType.Equal(attachmentBaseType)
+}
+
+// attachmentBaseCompositeType returns the composite type which may have attachments
+// for the given type, i.e. the type itself or the referenced type,
+// if it is a structure or resource type.
+//
+func attachmentBaseCompositeType(ty Type) (*CompositeType, bool) {
+	if referenceType, ok := ty.(*ReferenceType); ok {
+		ty = referenceType.Type
+	}
+
+	compositeType, ok := ty.(*CompositeType)
+	if !ok {
+		return nil, false
+	}
+
+	switch compositeType.Kind {
+	case common.CompositeKindStructure,
+		common.CompositeKindResource:
+
+		return compositeType, true
+	}
+
+	return nil, false
+}
+
+// checkAttachmentIndexExpression checks an index expression
+// which accesses an attachment of a composite value, e.g. `r[A]`.
+// The result is an optional reference to the attachment.
+//
+func (checker *Checker) checkAttachmentIndexExpression(
+	indexExpression *ast.IndexExpression,
+	targetType Type,
+	baseType *CompositeType,
+	indexingType ast.Type,
+	isAssignment bool,
+) Type {
+
+	targetExpression := indexExpression.TargetExpression
+
+	if isAssignment {
+		checker.report(
+			&NotIndexingAssignableTypeError{
+				Type:  targetType,
+				Range: ast.NewRangeFromPositioned(checker.memoryGauge, targetExpression),
+			},
+		)
+	}
+
+	checker.checkUnusedExpressionResourceLoss(targetType, targetExpression)
+
+	attachmentType := checker.indexingAttachmentType(indexingType, baseType)
+	if attachmentType == nil {
+		return InvalidType
+	}
+
+	checker.Elaboration.AttachmentAccessTypes[indexExpression.ID()] = attachmentType
+
+	return &OptionalType{
+		Type: &ReferenceType{
+			Type: attachmentType,
+		},
+	}
+}
+
+// indexingAttachmentType converts the given indexing type to an attachment type,
+// and checks that the attachment is declared for the given base type.
+// Returns nil if the type is not a valid attachment type for the base type.
+//
+func (checker *Checker) indexingAttachmentType(
+	indexingType ast.Type,
+	baseType *CompositeType,
+) *CompositeType {
+
+	ty := checker.ConvertType(indexingType)
+	if ty.IsInvalidType() {
+		return nil
+	}
+
+	attachmentType, ok := ty.(*CompositeType)
+	if !ok ||
+		attachmentType.Kind != common.CompositeKindAttachment ||
+		!checker.isAttachmentBaseType(attachmentType, baseType) {
+
+		checker.report(
+			&InvalidTypeIndexingError{
+				BaseType:    baseType,
+				IndexedType: ty,
+				Range:       ast.NewRangeFromPositioned(checker.memoryGauge, indexingType),
+			},
+		)
+		return nil
+	}
+
+	return attachmentType
+}
@@ -29,9 +29,9 @@ func (checker *Checker) VisitBinaryExpression(expression *ast.BinaryExpression)
 	var leftType, rightType, resultType Type
 	defer func() {
 		elaboration := checker.Elaboration
-		elaboration.BinaryExpressionLeftTypes[expression] = leftType
-		elaboration.BinaryExpressionRightTypes[expression] = rightType
-		elaboration.BinaryExpressionResultTypes[expression] = resultType
+		elaboration.BinaryExpressionLeftTypes[expression.ID()] = leftType
+		elaboration.BinaryExpressionRightTypes[expression.ID()] = rightType
+		elaboration.BinaryExpressionResultTypes[expression.ID()] = resultType
 	}()
 
 	// The left-hand side is always evaluated.
@@ -48,6 +48,9 @@ func (checker *Checker) VisitBinaryExpression(expression *ast.BinaryExpression)
 	// This is also true, even if the expected type is `Int8?` e.g: `var x: Int8? = a + b`
 	// So here we take the 'optional-type' out of the way.
 	//
+	// Similarly, the operands of a range expression must have the element type of the range.
+	// i.e: `var r: Range<Int8> = a..<b`. Here both `a` and `b` needs to be of the type `Int8`.
+	//
 	// For the rest of the binary-expressions, this is not the case. e.g: logical-expressions.
 	// i.e: `var x: Bool = a > b`. Here `a` and `b` can be anything, and doesn't have to be `Bool`.
 	// For them, the type is inferred from the expressions themselves, and the compatibility check
@@ -58,6 +61,11 @@ func (checker *Checker) VisitBinaryExpression(expression *ast.BinaryExpression)
 	case BinaryOperationKindArithmetic,
 		BinaryOperationKindBitwise:
 		expectedType = UnwrapOptionalType(checker.expectedType)
+
+	case BinaryOperationKindRange:
+		if rangeType, ok := UnwrapOptionalType(checker.expectedType).(*RangeType); ok {
+			expectedType = rangeType.ElementType
+		}
 	}
 
 	// Visit the expression, with contextually expected type. Use the expected type
@@ -79,7 +87,8 @@ func (checker *Checker) VisitBinaryExpression(expression *ast.BinaryExpression)
 	case BinaryOperationKindArithmetic,
 		BinaryOperationKindNonEqualityComparison,
 		BinaryOperationKindEquality,
-		BinaryOperationKindBitwise:
+		BinaryOperationKindBitwise,
+		BinaryOperationKindRange:
 
 		// Right hand side will always be evaluated
 
@@ -128,7 +137,8 @@ func (checker *Checker) VisitBinaryExpression(expression *ast.BinaryExpression)
 		switch operationKind {
 		case BinaryOperationKindArithmetic,
 			BinaryOperationKindNonEqualityComparison,
-			BinaryOperationKindBitwise:
+			BinaryOperationKindBitwise,
+			BinaryOperationKindRange:
 
 			resultType = checker.checkBinaryExpressionArithmeticOrNonEqualityComparisonOrBitwise(
 				expression, operation, operationKind,
@@ -212,7 +222,9 @@ func (checker *Checker) checkBinaryExpressionArithmeticOrNonEqualityComparisonOr
 
 		expectedSuperType = NumberType
 
-	case BinaryOperationKindBitwise:
+	case BinaryOperationKindBitwise,
+		BinaryOperationKindRange:
+
 		expectedSuperType = IntegerType
 
 	default:
@@ -273,7 +285,7 @@ func (checker *Checker) checkBinaryExpressionArithmeticOrNonEqualityComparisonOr
 			return true
 		}
 
-		// Arithmetic, bitwise and non-equality comparison operators
+		// Arithmetic, bitwise, range and non-equality comparison operators
 		// are not supported for numeric supertypes.
 		return isNumericSuperType(leftType)
 	}
@@ -298,6 +310,13 @@ func (checker *Checker) checkBinaryExpressionArithmeticOrNonEqualityComparisonOr
 	case BinaryOperationKindNonEqualityComparison:
 		return BoolType
 
+	case BinaryOperationKindRange:
+		return NewRangeType(
+			checker.memoryGauge,
+			leftType,
+			operation == ast.OperationInclusiveRange,
+		)
+
 	default:
 		panic(errors.NewUnreachableError())
 	}
@@ -62,7 +62,13 @@ func (checker *Checker) visitStatements(statements []ast.Statement) {
 
 		// check statement
 
+		if !checker.enterRecursion(statement) {
+			break
+		}
+
 		statement.Accept(checker)
+
+		checker.leaveRecursion()
 	}
 }
 
@@ -32,7 +32,7 @@ func (checker *Checker) VisitCastingExpression(expression *ast.CastingExpression
 
 	rightHandType := rightHandTypeAnnotation.Type
 
-	checker.Elaboration.CastingTargetTypes[expression] = rightHandType
+	checker.Elaboration.CastingTargetTypes[expression.ID()] = rightHandType
 
 	// visit the expression
 
@@ -50,7 +50,7 @@ func (checker *Checker) VisitCastingExpression(expression *ast.CastingExpression
 
 	hasErrors := len(checker.errors) > beforeErrors
 
-	checker.Elaboration.CastingStaticValueTypes[expression] = leftHandType
+	checker.Elaboration.CastingStaticValueTypes[expression.ID()] = leftHandType
 
 	if leftHandType.IsResourceType() {
 		checker.recordResourceInvalidation(
@@ -125,11 +125,27 @@ func (checker *Checker) VisitCastingExpression(expression *ast.CastingExpression
 					},
 				)
 			} else if checker.extendedElaboration {
-				checker.Elaboration.RuntimeCastTypes[expression] = struct {
+				checker.Elaboration.RuntimeCastTypes[expression.ID()] = struct {
 					Left  Type
 					Right Type
 				}{Left: leftHandType, Right: rightHandType}
 			}
+
+			// If the value is statically known to be of the target type,
+			// the failable or force cast always succeeds.
+			// Don't warn if there are errors in the lhs-expr,
+			// as its type may not be accurate.
+
+			if !hasErrors && IsSubType(leftHandType, rightHandType) {
+				checker.reportWarning(
+					&AlwaysSucceedingCastWarning{
+						Operation:  expression.Operation,
+						ValueType:  leftHandType,
+						TargetType: rightHandType,
+						Range:      ast.NewRangeFromPositioned(checker.memoryGauge, expression),
+					},
+				)
+			}
 		}
 
 		if expression.Operation == ast.OperationFailableCast {
@@ -144,7 +160,7 @@ func (checker *Checker) VisitCastingExpression(expression *ast.CastingExpression
 		// Then, it is not possible to determine whether the target type is redundant.
 		// Therefore, don't check for redundant casts, if there are errors.
 		if checker.extendedElaboration && !hasErrors {
-			checker.Elaboration.StaticCastTypes[expression] = CastType{
+			checker.Elaboration.StaticCastTypes[expression.ID()] = CastType{
 				ExprActualType: exprActualType,
 				TargetType:     rightHandType,
 				ExpectedType:   checker.expectedType,
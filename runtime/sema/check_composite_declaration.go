@@ -42,7 +42,7 @@ func (checker *Checker) VisitCompositeDeclaration(declaration *ast.CompositeDecl
 //
 func (checker *Checker) visitCompositeDeclaration(declaration *ast.CompositeDeclaration, kind ContainerKind) {
 
-	compositeType := checker.Elaboration.CompositeDeclarationTypes[declaration]
+	compositeType := checker.Elaboration.CompositeDeclarationTypes[declaration.ID()]
 	if compositeType == nil {
 		panic(errors.NewUnreachableError())
 	}
@@ -64,6 +64,8 @@ func (checker *Checker) visitCompositeDeclaration(declaration *ast.CompositeDecl
 
 	checker.checkNestedIdentifiers(declaration.Members)
 
+	checker.checkNestedTypeAliases(declaration.Members, declaration.DeclarationKind())
+
 	// Activate new scopes for nested types
 
 	checker.typeActivations.Enter()
@@ -74,6 +76,8 @@ func (checker *Checker) visitCompositeDeclaration(declaration *ast.CompositeDecl
 		defer checker.leaveValueScope(declaration.EndPosition, false)
 	}
 
+	checker.declareCompositeTypeParameters(declaration, compositeType)
+
 	checker.declareCompositeNestedTypes(declaration, kind, true)
 
 	var initializationInfo *InitializationInfo
@@ -140,6 +144,12 @@ func (checker *Checker) visitCompositeDeclaration(declaration *ast.CompositeDecl
 		fieldPositionGetter,
 	)
 
+	if kind == ContainerKindComposite &&
+		compositeType.Kind == common.CompositeKindResource {
+
+		checker.declareResourceDestroyedEvent(compositeType)
+	}
+
 	// Check conformances
 	// NOTE: perform after completing composite type (e.g. setting constructor parameter types)
 
@@ -197,6 +207,10 @@ func (checker *Checker) visitCompositeDeclaration(declaration *ast.CompositeDecl
 	for _, nestedComposite := range declaration.Members.Composites() {
 		nestedComposite.Accept(checker)
 	}
+
+	for _, typeAlias := range declaration.Members.TypeAliases() {
+		typeAlias.Accept(checker)
+	}
 }
 
 // declareCompositeNestedTypes declares the types nested in a composite,
@@ -214,8 +228,8 @@ func (checker *Checker) declareCompositeNestedTypes(
 	kind ContainerKind,
 	declareConstructors bool,
 ) {
-	compositeType := checker.Elaboration.CompositeDeclarationTypes[declaration]
-	nestedDeclarations := checker.Elaboration.CompositeNestedDeclarations[declaration]
+	compositeType := checker.Elaboration.CompositeDeclarationTypes[declaration.ID()]
+	nestedDeclarations := checker.Elaboration.CompositeNestedDeclarations[declaration.ID()]
 
 	compositeType.nestedTypes.Foreach(func(name string, nestedType Type) {
 
@@ -283,6 +297,29 @@ func (checker *Checker) declareCompositeNestedTypes(
 			}
 		}
 	})
+
+	// Declare the type aliases nested in the composite.
+	// They are not yet declared when the type aliases themselves
+	// are being declared in `declareCompositeTypeAliases`
+
+	for _, typeAliasDeclaration := range declaration.Members.TypeAliases() {
+
+		ty, ok := checker.Elaboration.TypeAliasDeclarationTypes[typeAliasDeclaration.ID()]
+		if !ok {
+			continue
+		}
+
+		// NOTE: Errors were already reported when the type alias was declared
+
+		_, _ = checker.typeActivations.DeclareType(typeDeclaration{
+			identifier:               typeAliasDeclaration.Identifier,
+			ty:                       ty,
+			declarationKind:          typeAliasDeclaration.DeclarationKind(),
+			access:                   typeAliasDeclaration.Access,
+			docString:                typeAliasDeclaration.DocString,
+			allowOuterScopeShadowing: true,
+		})
+	}
 }
 
 func (checker *Checker) declareNestedDeclarations(
@@ -348,6 +385,22 @@ func (checker *Checker) declareNestedDeclarations(
 				common.CompositeKindEnum:
 				break
 
+			case common.CompositeKindAttachment:
+				// Attachments may only be nested in contracts,
+				// not in contract interfaces
+
+				if containerDeclarationKind == common.DeclarationKindContract {
+					break
+				}
+
+				checker.report(
+					&InvalidNestedDeclarationError{
+						NestedDeclarationKind:    nestedDeclarationKind,
+						ContainerDeclarationKind: containerDeclarationKind,
+						Range:                    ast.NewRangeFromPositioned(checker.memoryGauge, identifier),
+					},
+				)
+
 			default:
 				checker.report(
 					&InvalidNestedDeclarationError{
@@ -445,14 +498,43 @@ func (checker *Checker) declareCompositeType(declaration *ast.CompositeDeclarati
 
 	if declaration.CompositeKind == common.CompositeKindEnum {
 		compositeType.EnumRawType = checker.enumRawType(declaration)
+
+		enumCases := declaration.Members.EnumCases()
+		compositeType.EnumCases = make([]string, 0, len(enumCases))
+		for _, enumCase := range enumCases {
+			compositeType.EnumCases = append(
+				compositeType.EnumCases,
+				enumCase.Identifier.Identifier,
+			)
+		}
 	} else {
 		compositeType.ExplicitInterfaceConformances =
 			checker.explicitInterfaceConformances(declaration, compositeType)
 	}
 
+	// Convert the type parameters, if any.
+	// Only structures and resources can be generic
+
+	if len(declaration.TypeParameters) > 0 {
+		switch declaration.CompositeKind {
+		case common.CompositeKindStructure,
+			common.CompositeKindResource:
+
+			compositeType.typeParameters = checker.typeParameters(declaration.TypeParameters)
+
+		default:
+			checker.report(
+				&InvalidCompositeTypeParametersError{
+					CompositeKind: declaration.CompositeKind,
+					Range:         ast.NewRangeFromPositioned(checker.memoryGauge, declaration.Identifier),
+				},
+			)
+		}
+	}
+
 	// Register in elaboration
 
-	checker.Elaboration.CompositeDeclarationTypes[declaration] = compositeType
+	checker.Elaboration.CompositeDeclarationTypes[declaration.ID()] = compositeType
 	checker.Elaboration.CompositeTypeDeclarations[compositeType] = declaration
 
 	// Activate new scope for nested declarations
@@ -473,7 +555,7 @@ func (checker *Checker) declareCompositeType(declaration *ast.CompositeDeclarati
 			declaration.Members.Interfaces(),
 		)
 
-	checker.Elaboration.CompositeNestedDeclarations[declaration] = nestedDeclarations
+	checker.Elaboration.CompositeNestedDeclarations[declaration.ID()] = nestedDeclarations
 
 	for _, nestedInterfaceType := range nestedInterfaceTypes {
 		compositeType.nestedTypes.Set(nestedInterfaceType.Identifier, nestedInterfaceType)
@@ -499,7 +581,7 @@ func (checker *Checker) declareCompositeMembersAndValue(
 	declaration *ast.CompositeDeclaration,
 	kind ContainerKind,
 ) {
-	compositeType := checker.Elaboration.CompositeDeclarationTypes[declaration]
+	compositeType := checker.Elaboration.CompositeDeclarationTypes[declaration.ID()]
 	if compositeType == nil {
 		panic(errors.NewUnreachableError())
 	}
@@ -515,13 +597,23 @@ func (checker *Checker) declareCompositeMembersAndValue(
 		checker.enterValueScope()
 		defer checker.leaveValueScope(declaration.EndPosition, false)
 
+		checker.declareCompositeTypeParameters(declaration, compositeType)
+
 		checker.declareCompositeNestedTypes(declaration, kind, false)
 
+		// NOTE: resolve the base type of attachments while nested types are in scope,
+		// as the base type may be a nested type of the containing contract
+
+		if declaration.CompositeKind == common.CompositeKindAttachment {
+			compositeType.baseType = checker.attachmentBaseType(declaration, compositeType)
+		}
+
 		// NOTE: determine initializer parameter types while nested types are in scope,
 		// and after declaring nested types as the initializer may use nested type in parameters
 
 		initializers := declaration.Members.Initializers()
 		compositeType.ConstructorParameters = checker.initializerParameters(initializers)
+		compositeType.ConstructorPurity = initializerPurity(compositeType.Kind, initializers)
 
 		// Declare nested declarations' members
 
@@ -542,6 +634,8 @@ func (checker *Checker) declareCompositeMembersAndValue(
 		//   }
 		// }
 		// ```
+		nestedDeprecations := declaration.Members.Deprecations()
+
 		for _, nestedCompositeDeclaration := range declaration.Members.Composites() {
 			checker.declareCompositeMembersAndValue(nestedCompositeDeclaration, kind)
 
@@ -553,6 +647,8 @@ func (checker *Checker) declareCompositeMembersAndValue(
 			nestedCompositeDeclarationVariable :=
 				checker.valueActivations.Find(identifier.Identifier)
 
+			deprecationMessage, deprecated := nestedDeprecations[nestedCompositeDeclaration]
+
 			declarationMembers.Set(
 				nestedCompositeDeclarationVariable.Identifier,
 				&Member{
@@ -565,6 +661,8 @@ func (checker *Checker) declareCompositeMembersAndValue(
 					ArgumentLabels:        nestedCompositeDeclarationVariable.ArgumentLabels,
 					IgnoreInSerialization: true,
 					DocString:             nestedCompositeDeclaration.DocString,
+					Deprecated:            deprecated,
+					DeprecationMessage:    deprecationMessage,
 				})
 		}
 
@@ -641,6 +739,22 @@ func (checker *Checker) declareCompositeMembersAndValue(
 		if checker.positionInfoEnabled {
 			checker.memberOrigins[compositeType] = origins
 		}
+
+		// Declare the members of the instantiations of a generic type,
+		// now that the members of the generic type are known
+
+		if len(compositeType.typeParameters) > 0 {
+			compositeType.declareInstanceMembers()
+
+			if compositeType.expanding {
+				checker.report(
+					&InfinitelyExpandingTypeError{
+						Type:  compositeType,
+						Range: ast.NewRangeFromPositioned(checker.memoryGauge, declaration.Identifier),
+					},
+				)
+			}
+		}
 	})()
 
 	// Always determine composite constructor type
@@ -682,6 +796,56 @@ func (checker *Checker) declareCompositeMembersAndValue(
 	}
 }
 
+// declareCompositeTypeParameters declares the type parameters of a generic composite type, if any,
+// as generic types in the current type activation
+//
+func (checker *Checker) declareCompositeTypeParameters(
+	declaration *ast.CompositeDeclaration,
+	compositeType *CompositeType,
+) {
+	if len(compositeType.typeParameters) == 0 {
+		return
+	}
+
+	checker.declareTypeParameters(declaration.TypeParameters, compositeType.typeParameters)
+}
+
+// attachmentBaseType resolves the base type of the given attachment declaration.
+// Attachments can only be declared for structures and resources.
+//
+func (checker *Checker) attachmentBaseType(
+	declaration *ast.CompositeDeclaration,
+	attachmentType *CompositeType,
+) Type {
+	if declaration.BaseType == nil {
+		return InvalidType
+	}
+
+	baseType := checker.ConvertType(declaration.BaseType)
+	if baseType.IsInvalidType() {
+		return InvalidType
+	}
+
+	if compositeType, ok := baseType.(*CompositeType); ok {
+		switch compositeType.Kind {
+		case common.CompositeKindStructure,
+			common.CompositeKindResource:
+
+			return compositeType
+		}
+	}
+
+	checker.report(
+		&InvalidBaseTypeError{
+			BaseType:   baseType,
+			Attachment: attachmentType,
+			Range:      ast.NewRangeFromPositioned(checker.memoryGauge, declaration.BaseType),
+		},
+	)
+
+	return InvalidType
+}
+
 func (checker *Checker) declareCompositeConstructor(
 	declaration *ast.CompositeDeclaration,
 	constructorType *FunctionType,
@@ -784,6 +948,8 @@ func (checker *Checker) declareEnumConstructor(
 		}
 	}
 
+	AddEnumConstructorMembers(checker.memoryGauge, constructorType, compositeType)
+
 	if checker.positionInfoEnabled {
 		checker.memberOrigins[constructorType] = constructorOrigins
 	}
@@ -805,6 +971,7 @@ func (checker *Checker) declareEnumConstructor(
 func EnumConstructorType(compositeType *CompositeType) *FunctionType {
 	return &FunctionType{
 		IsConstructor: true,
+		Purity:        FunctionPurityView,
 		Parameters: []*Parameter{
 			{
 				Identifier:     EnumRawValueFieldName,
@@ -820,6 +987,68 @@ func EnumConstructorType(compositeType *CompositeType) *FunctionType {
 	}
 }
 
+// EnumLookupFunctionType returns the type of the `lookup` function
+// of the constructor of the given enum type.
+//
+func EnumLookupFunctionType(compositeType *CompositeType) *FunctionType {
+	return &FunctionType{
+		Purity: FunctionPurityView,
+		Parameters: []*Parameter{
+			{
+				Identifier:     EnumRawValueFieldName,
+				TypeAnnotation: NewTypeAnnotation(compositeType.EnumRawType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			&OptionalType{
+				Type: compositeType,
+			},
+		),
+	}
+}
+
+// AddEnumConstructorMembers declares the `allCases` field
+// and the `lookup` function on the given enum constructor type.
+//
+// Enum cases take precedence, i.e. the members are not declared
+// if the enum already has a case with the same name.
+//
+func AddEnumConstructorMembers(
+	memoryGauge common.MemoryGauge,
+	constructorType *FunctionType,
+	compositeType *CompositeType,
+) {
+	members := constructorType.Members
+
+	if _, ok := members.Get(EnumAllCasesFieldName); !ok {
+		members.Set(
+			EnumAllCasesFieldName,
+			NewPublicConstantFieldMember(
+				memoryGauge,
+				constructorType,
+				EnumAllCasesFieldName,
+				&VariableSizedType{
+					Type: compositeType,
+				},
+				enumAllCasesFieldDocString,
+			),
+		)
+	}
+
+	if _, ok := members.Get(EnumLookupFunctionName); !ok {
+		members.Set(
+			EnumLookupFunctionName,
+			NewPublicFunctionMember(
+				memoryGauge,
+				constructorType,
+				EnumLookupFunctionName,
+				EnumLookupFunctionType(compositeType),
+				enumLookupFunctionDocString,
+			),
+		)
+	}
+}
+
 // checkMemberStorability check that all fields have a type that is storable.
 //
 func (checker *Checker) checkMemberStorability(members *StringMemberOrderedMap) {
@@ -865,6 +1094,23 @@ func (checker *Checker) initializerParameters(initializers []*ast.SpecialFunctio
 	return parameters
 }
 
+// initializerPurity returns the purity of the constructor
+// of a composite with the given kind and initializers.
+//
+// Constructors of composites without an initializer
+// and constructors of events (which have a synthesized initializer)
+// have no side effects, so they are view functions.
+//
+func initializerPurity(compositeKind common.CompositeKind, initializers []*ast.SpecialFunctionDeclaration) FunctionPurity {
+	if compositeKind == common.CompositeKindEvent || len(initializers) == 0 {
+		return FunctionPurityView
+	}
+
+	// TODO: support multiple overloaded initializers
+	firstInitializer := initializers[0]
+	return NewFunctionPurity(firstInitializer.FunctionDeclaration.Purity)
+}
+
 func (checker *Checker) explicitInterfaceConformances(
 	declaration *ast.CompositeDeclaration,
 	compositeType *CompositeType,
@@ -1017,52 +1263,26 @@ func (checker *Checker) checkCompositeConformance(
 
 	// Determine missing members and member conformance
 
-	interfaceType.Members.Foreach(func(name string, interfaceMember *Member) {
+	conformance := checker.memberConformance(compositeType, interfaceType)
 
-		// Conforming types do not provide a concrete member
-		// for the member in the interface if it is predeclared
-
-		if interfaceMember.Predeclared {
-			return
-		}
-
-		compositeMember, ok := compositeType.Members.Get(name)
-		if !ok {
-			if options.checkMissingMembers {
-				missingMembers = append(missingMembers, interfaceMember)
-			}
-			return
-		}
+	if options.checkMissingMembers {
+		missingMembers = conformance.missingMembers
+	}
 
-		if !checker.memberSatisfied(compositeMember, interfaceMember) {
-			memberMismatches = append(memberMismatches,
-				MemberMismatch{
-					CompositeMember: compositeMember,
-					InterfaceMember: interfaceMember,
-				},
-			)
-		}
-	})
+	memberMismatches = conformance.memberMismatches
 
 	// Determine missing nested composite type definitions
 
-	interfaceType.nestedTypes.Foreach(func(name string, typeRequirement Type) {
+	for _, requiredCompositeType := range interfaceType.conformanceRequirements().typeRequirements {
 
-		// Only nested composite declarations are type requirements of the interface
-
-		requiredCompositeType, ok := typeRequirement.(*CompositeType)
-		if !ok {
-			return
-		}
-
-		nestedCompositeType, ok := compositeType.nestedTypes.Get(name)
+		nestedCompositeType, ok := compositeType.nestedTypes.Get(requiredCompositeType.Identifier)
 		if !ok {
 			missingNestedCompositeTypes = append(missingNestedCompositeTypes, requiredCompositeType)
-			return
+			continue
 		}
 
 		checker.checkTypeRequirement(nestedCompositeType, compositeDeclaration, requiredCompositeType)
-	})
+	}
 
 	if len(missingMembers) > 0 ||
 		len(memberMismatches) > 0 ||
@@ -1085,6 +1305,66 @@ func (checker *Checker) checkCompositeConformance(
 	}
 }
 
+// compositeInterfacePair is a pair of a composite type
+// and an interface type the composite type conforms to
+//
+type compositeInterfacePair struct {
+	compositeType *CompositeType
+	interfaceType *InterfaceType
+}
+
+// memberConformance is the result of resolving
+// the members required by an interface in a composite type
+//
+type memberConformance struct {
+	missingMembers   []*Member
+	memberMismatches []MemberMismatch
+}
+
+// memberConformance resolves the members required by the given interface type
+// in the given composite type, and determines which are missing or mismatching.
+//
+// The result is memoized per pair of composite type and interface type
+//
+func (checker *Checker) memberConformance(
+	compositeType *CompositeType,
+	interfaceType *InterfaceType,
+) memberConformance {
+
+	pair := compositeInterfacePair{
+		compositeType: compositeType,
+		interfaceType: interfaceType,
+	}
+
+	if result, ok := checker.memberConformances[pair]; ok {
+		return result
+	}
+
+	var result memberConformance
+
+	for _, interfaceMember := range interfaceType.conformanceRequirements().members {
+
+		compositeMember, ok := compositeType.Members.Get(interfaceMember.Identifier.Identifier)
+		if !ok {
+			result.missingMembers = append(result.missingMembers, interfaceMember)
+			continue
+		}
+
+		if !checker.memberSatisfied(compositeMember, interfaceMember) {
+			result.memberMismatches = append(result.memberMismatches,
+				MemberMismatch{
+					CompositeMember: compositeMember,
+					InterfaceMember: interfaceMember,
+				},
+			)
+		}
+	}
+
+	checker.memberConformances[pair] = result
+
+	return result
+}
+
 // TODO: return proper error
 func (checker *Checker) memberSatisfied(compositeMember, interfaceMember *Member) bool {
 
@@ -1131,6 +1411,14 @@ func (checker *Checker) memberSatisfied(compositeMember, interfaceMember *Member
 				return false
 			}
 
+			// A view function in the interface must be implemented as a view function
+
+			if interfaceMemberFunctionType.Purity == FunctionPurityView &&
+				compositeMemberFunctionType.Purity != FunctionPurityView {
+
+				return false
+			}
+
 			// Functions are invariant in their parameter types
 
 			for i, subParameter := range compositeMemberFunctionType.Parameters {
@@ -1315,9 +1603,29 @@ func (checker *Checker) compositeConstructorType(
 
 	constructorFunctionType = &FunctionType{
 		IsConstructor:        true,
+		Purity:               compositeType.ConstructorPurity,
 		ReturnTypeAnnotation: NewTypeAnnotation(compositeType),
 	}
 
+	// The constructor of a generic composite type is generic:
+	// it has the type parameters of the composite type,
+	// and returns the instantiation with the type arguments,
+	// e.g. the constructor of `struct Box<T>` has the type `fun<T>(...): Box<T>`
+
+	if len(compositeType.typeParameters) > 0 {
+		typeArguments := make([]Type, len(compositeType.typeParameters))
+		for i, typeParameter := range compositeType.typeParameters {
+			typeArguments[i] = &GenericType{
+				TypeParameter: typeParameter,
+			}
+		}
+
+		constructorFunctionType.TypeParameters = compositeType.typeParameters
+		constructorFunctionType.ReturnTypeAnnotation = NewTypeAnnotation(
+			compositeType.instantiate(typeArguments),
+		)
+	}
+
 	// TODO: support multiple overloaded initializers
 
 	initializers := compositeDeclaration.Members.Initializers()
@@ -1330,16 +1638,29 @@ func (checker *Checker) compositeConstructorType(
 			EffectiveArgumentLabels()
 
 		constructorFunctionType.Parameters = compositeType.ConstructorParameters
+		constructorFunctionType.RequiredArgumentCount = requiredArgumentCount(
+			firstInitializer.FunctionDeclaration.ParameterList,
+		)
 
 		// NOTE: Don't use `constructorFunctionType`, as it has a return type.
 		//   The initializer itself has a `Void` return type.
+		//
+		//   The constructor type of nested composites is determined multiple times,
+		//   so reuse the initializer type if it was already determined.
 
-		checker.Elaboration.ConstructorFunctionTypes[firstInitializer] =
-			&FunctionType{
-				IsConstructor:        true,
-				Parameters:           constructorFunctionType.Parameters,
-				ReturnTypeAnnotation: NewTypeAnnotation(VoidType),
+		initializerFunctionType, ok := checker.Elaboration.ConstructorFunctionTypes[firstInitializer.ID()]
+		if !ok {
+			initializerFunctionType = &FunctionType{
+				IsConstructor:         true,
+				Purity:                constructorFunctionType.Purity,
+				Parameters:            constructorFunctionType.Parameters,
+				ReturnTypeAnnotation:  NewTypeAnnotation(VoidType),
+				RequiredArgumentCount: constructorFunctionType.RequiredArgumentCount,
 			}
+			checker.Elaboration.ConstructorFunctionTypes[firstInitializer.ID()] = initializerFunctionType
+		}
+
+		checker.recordFunctionCalleeOf(constructorFunctionType, initializerFunctionType)
 	}
 
 	return constructorFunctionType, argumentLabels
@@ -1358,6 +1679,9 @@ func (checker *Checker) defaultMembersAndOrigins(
 	fields := allMembers.Fields()
 	functions := allMembers.Functions()
 
+	checker.checkMemberPragmas(allMembers)
+	deprecations := allMembers.Deprecations()
+
 	// Enum cases are invalid
 	enumCases := allMembers.EnumCases()
 	if len(enumCases) > 0 && containerDeclarationKind != common.DeclarationKindUnknown {
@@ -1439,16 +1763,20 @@ func (checker *Checker) defaultMembersAndOrigins(
 			)
 		}
 
+		deprecationMessage, deprecated := deprecations[field]
+
 		members.Set(
 			identifier,
 			&Member{
-				ContainerType:   containerType,
-				Access:          field.Access,
-				Identifier:      field.Identifier,
-				DeclarationKind: declarationKind,
-				TypeAnnotation:  fieldTypeAnnotation,
-				VariableKind:    field.VariableKind,
-				DocString:       field.DocString,
+				ContainerType:      containerType,
+				Access:             field.Access,
+				Identifier:         field.Identifier,
+				DeclarationKind:    declarationKind,
+				TypeAnnotation:     fieldTypeAnnotation,
+				VariableKind:       field.VariableKind,
+				DocString:          field.DocString,
+				Deprecated:         deprecated,
+				DeprecationMessage: deprecationMessage,
 			})
 
 		if checker.positionInfoEnabled && origins != nil {
@@ -1480,7 +1808,19 @@ func (checker *Checker) defaultMembersAndOrigins(
 
 		identifier := function.Identifier.Identifier
 
-		functionType := checker.functionType(function.ParameterList, function.ReturnTypeAnnotation)
+		functionType := checker.functionDeclarationType(function)
+
+		// NOTE: Record the function type of generic functions,
+		// so the function body is checked against the same type parameters as the member
+
+		if len(functionType.TypeParameters) > 0 {
+			checker.Elaboration.FunctionDeclarationFunctionTypes[function.ID()] = functionType
+		} else {
+			// The function body is checked against a separately determined function type,
+			// record it as the function invoked by the member, so the effects are known
+
+			checker.memberFunctionTypes[function] = functionType
+		}
 
 		argumentLabels := function.ParameterList.EffectiveArgumentLabels()
 
@@ -1503,17 +1843,21 @@ func (checker *Checker) defaultMembersAndOrigins(
 			)
 		}
 
+		deprecationMessage, deprecated := deprecations[function]
+
 		members.Set(
 			identifier,
 			&Member{
-				ContainerType:   containerType,
-				Access:          function.Access,
-				Identifier:      function.Identifier,
-				DeclarationKind: declarationKind,
-				TypeAnnotation:  fieldTypeAnnotation,
-				VariableKind:    ast.VariableKindConstant,
-				ArgumentLabels:  argumentLabels,
-				DocString:       function.DocString,
+				ContainerType:      containerType,
+				Access:             function.Access,
+				Identifier:         function.Identifier,
+				DeclarationKind:    declarationKind,
+				TypeAnnotation:     fieldTypeAnnotation,
+				VariableKind:       ast.VariableKindConstant,
+				ArgumentLabels:     argumentLabels,
+				DocString:          function.DocString,
+				Deprecated:         deprecated,
+				DeprecationMessage: deprecationMessage,
 			})
 
 		if checker.positionInfoEnabled && origins != nil {
@@ -1556,6 +1900,7 @@ func (checker *Checker) eventMembersAndOrigins(
 				DeclarationKind: common.DeclarationKindField,
 				TypeAnnotation:  typeAnnotation,
 				VariableKind:    ast.VariableKindConstant,
+				Indexed:         parameter.Indexed,
 			})
 
 		if checker.positionInfoEnabled && origins != nil {
@@ -1576,6 +1921,16 @@ const enumRawValueFieldDocString = `
 The raw value of the enum case
 `
 
+const EnumAllCasesFieldName = "allCases"
+const enumAllCasesFieldDocString = `
+All cases of the enum, in declaration order
+`
+
+const EnumLookupFunctionName = "lookup"
+const enumLookupFunctionDocString = `
+Returns the enum case with the given raw value, if any
+`
+
 func (checker *Checker) enumMembersAndOrigins(
 	allMembers *ast.Members,
 	containerType *CompositeType,
@@ -1743,12 +2098,22 @@ func (checker *Checker) checkSpecialFunction(
 	defer checker.leaveValueScope(specialFunction.EndPosition, checkResourceLoss)
 
 	checker.declareSelfValue(containerType, containerDocString)
+	checker.declareBaseValue(containerType)
 
 	functionType := &FunctionType{
+		Purity:               NewFunctionPurity(specialFunction.FunctionDeclaration.Purity),
 		Parameters:           parameters,
 		ReturnTypeAnnotation: NewTypeAnnotation(VoidType),
 	}
 
+	checker.Elaboration.SpecialFunctionTypes[specialFunction.ID()] = functionType
+
+	// The constructor invokes the initializer
+
+	if constructorFunctionType, ok := checker.Elaboration.ConstructorFunctionTypes[specialFunction.ID()]; ok {
+		checker.recordFunctionCalleeOf(constructorFunctionType, functionType)
+	}
+
 	checker.checkFunction(
 		specialFunction.FunctionDeclaration.ParameterList,
 		nil,
@@ -1805,6 +2170,7 @@ func (checker *Checker) checkCompositeFunctions(
 			defer checker.leaveValueScope(function.EndPosition, true)
 
 			checker.declareSelfValue(selfType, selfDocString)
+			checker.declareBaseValue(selfType)
 
 			checker.visitFunctionDeclaration(
 				function,
@@ -1849,6 +2215,38 @@ func (checker *Checker) declareSelfValue(selfType Type, selfDocString string) {
 	}
 }
 
+// declareBaseValue declares the `base` value for functions of attachments.
+// It is a reference to the value the attachment is attached to.
+//
+func (checker *Checker) declareBaseValue(containerType Type) {
+
+	compositeType, ok := containerType.(*CompositeType)
+	if !ok || compositeType.Kind != common.CompositeKindAttachment {
+		return
+	}
+
+	// NOTE: declare `base` one depth lower ("inside" function),
+	// so it can't be re-declared by the function's parameters
+
+	depth := checker.valueActivations.Depth() + 1
+
+	base := &Variable{
+		Identifier:      BaseIdentifier,
+		Access:          ast.AccessPublic,
+		DeclarationKind: common.DeclarationKindBase,
+		Type: &ReferenceType{
+			Type: compositeType.baseType,
+		},
+		IsConstant:      true,
+		ActivationDepth: depth,
+		Pos:             nil,
+	}
+	checker.valueActivations.Set(BaseIdentifier, base)
+	if checker.positionInfoEnabled {
+		checker.recordVariableDeclarationOccurrence(BaseIdentifier, base)
+	}
+}
+
 // checkNestedIdentifiers checks that nested identifiers, i.e. fields, functions,
 // and nested interfaces and composites, are unique and aren't named `init` or `destroy`
 //
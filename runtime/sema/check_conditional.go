@@ -34,7 +34,7 @@ func (checker *Checker) VisitIfStatement(statement *ast.IfStatement) ast.Repr {
 
 	switch test := statement.Test.(type) {
 	case ast.Expression:
-		checker.visitConditional(test, thenElement, elseElement)
+		checker.visitConditional(test, thenElement, elseElement, ifStatementElsePosition(statement))
 
 	case *ast.VariableDeclaration:
 		checker.checkConditionalBranches(
@@ -51,6 +51,8 @@ func (checker *Checker) VisitIfStatement(statement *ast.IfStatement) ast.Repr {
 				elseElement.Accept(checker)
 				return nil
 			},
+			thenElement,
+			ifStatementElsePosition(statement),
 		)
 
 	default:
@@ -60,6 +62,17 @@ func (checker *Checker) VisitIfStatement(statement *ast.IfStatement) ast.Repr {
 	return nil
 }
 
+// ifStatementElsePosition returns the position of the else branch of the given if statement.
+// If the statement has no else branch, the position of the whole statement is returned,
+// as the implicit else branch is taken when the test fails.
+//
+func ifStatementElsePosition(statement *ast.IfStatement) ast.HasPosition {
+	if statement.Else != nil {
+		return statement.Else
+	}
+	return statement
+}
+
 func (checker *Checker) VisitConditionalExpression(expression *ast.ConditionalExpression) ast.Repr {
 
 	expectedType := checker.expectedType
@@ -73,6 +86,8 @@ func (checker *Checker) VisitConditionalExpression(expression *ast.ConditionalEx
 		func() Type {
 			return checker.VisitExpression(expression.Else, expectedType)
 		},
+		expression.Then,
+		expression.Else,
 	)
 
 	if thenType == nil || elseType == nil {
@@ -108,11 +123,14 @@ func (checker *Checker) VisitConditionalExpression(expression *ast.ConditionalEx
 // visitConditional checks a conditional.
 // The test expression must be a boolean.
 // The "then" and "else" elements may be expressions, in which case their types are returned.
+// The else position is the position of the else branch, which may differ from the else element,
+// e.g. if the else branch is implicit.
 //
 func (checker *Checker) visitConditional(
 	test ast.Expression,
 	thenElement ast.Element,
 	elseElement ast.Element,
+	elsePosition ast.HasPosition,
 ) (
 	thenType, elseType Type,
 ) {
@@ -134,6 +152,8 @@ func (checker *Checker) visitConditional(
 			}
 			return elseResult
 		},
+		thenElement,
+		elsePosition,
 	)
 }
 
@@ -142,9 +162,14 @@ func (checker *Checker) visitConditional(
 // resource uses and invalidations, as well as field initializations,
 // are only potential in each branch, but definite if they occur in both branches.
 //
+// The positions of the branches are used to report which branch misses a field initialization.
+// They may be nil, if they are unknown.
+//
 func (checker *Checker) checkConditionalBranches(
 	checkThen TypeCheckFunc,
 	checkElse TypeCheckFunc,
+	thenPosition ast.HasPosition,
+	elsePosition ast.HasPosition,
 ) (
 	thenType, elseType Type,
 ) {
@@ -182,16 +207,31 @@ func (checker *Checker) checkConditionalBranches(
 
 	functionActivation.ReturnInfo.MergeBranches(thenReturnInfo, elseReturnInfo)
 
-	if functionActivation.InitializationInfo != nil {
+	if initializationInfo := functionActivation.InitializationInfo; initializationInfo != nil {
+
+		// If one side definitely returned or halted, execution only continues after the other side,
+		// so the initializations in the other side can be considered definite.
+		//
+		// Field members which are not initialized when returning
+		// are already reported by the return statement
 
-		// If one side definitely halted, the initializations in the other side can be considered definite
+		thenExited := thenReturnInfo.DefinitelyReturned || thenReturnInfo.DefinitelyHalted
+		elseExited := elseReturnInfo.DefinitelyReturned || elseReturnInfo.DefinitelyHalted
 
-		if thenReturnInfo.DefinitelyHalted {
-			functionActivation.InitializationInfo.InitializedFieldMembers = elseInitializedMembers
-		} else if elseReturnInfo.DefinitelyHalted {
-			functionActivation.InitializationInfo.InitializedFieldMembers = thenInitializedMembers
+		if thenExited {
+			initializationInfo.InitializedFieldMembers = elseInitializedMembers
+		} else if elseExited {
+			initializationInfo.InitializedFieldMembers = thenInitializedMembers
 		} else {
-			functionActivation.InitializationInfo.InitializedFieldMembers.
+			initializationInfo.recordUninitializedBranches(
+				checker.memoryGauge,
+				thenInitializedMembers,
+				thenPosition,
+				elseInitializedMembers,
+				elsePosition,
+			)
+
+			initializationInfo.InitializedFieldMembers.
 				AddIntersection(thenInitializedMembers, elseInitializedMembers)
 		}
 	}
@@ -96,8 +96,8 @@ func (checker *Checker) VisitDictionaryExpression(expression *ast.DictionaryExpr
 		ValueType: valueType,
 	}
 
-	checker.Elaboration.DictionaryExpressionEntryTypes[expression] = entryTypes
-	checker.Elaboration.DictionaryExpressionType[expression] = dictionaryType
+	checker.Elaboration.DictionaryExpressionEntryTypes[expression.ID()] = entryTypes
+	checker.Elaboration.DictionaryExpressionType[expression.ID()] = dictionaryType
 
 	return dictionaryType
 }
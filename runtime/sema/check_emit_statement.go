@@ -26,6 +26,9 @@ import (
 func (checker *Checker) VisitEmitStatement(statement *ast.EmitStatement) ast.Repr {
 	invocation := statement.InvocationExpression
 
+	checker.reportImpureOperation(ImpureOperationEmit, statement)
+	checker.recordFunctionEffects(FunctionEffectEmitEvent)
+
 	ty := checker.checkInvocationExpression(invocation)
 
 	if ty.IsInvalidType() {
@@ -45,7 +48,7 @@ func (checker *Checker) VisitEmitStatement(statement *ast.EmitStatement) ast.Rep
 		return nil
 	}
 
-	checker.Elaboration.EmitStatementEventTypes[statement] = compositeType
+	checker.Elaboration.EmitStatementEventTypes[statement.ID()] = compositeType
 
 	// Check that the emitted event is declared in the same location
 
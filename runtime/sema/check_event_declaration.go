@@ -101,3 +101,69 @@ func IsValidEventParameterType(t Type, results map[*Member]bool) bool {
 			IsSameTypeKind(t, PathType)
 	}
 }
+
+// ResourceDestroyedEventIdentifier is the identifier of the event
+// which is implicitly declared in each resource type,
+// and which is emitted when a resource of the type is destroyed.
+//
+const ResourceDestroyedEventIdentifier = "ResourceDestroyed"
+
+// declareResourceDestroyedEvent declares the event which is emitted
+// when a resource of the given type is destroyed.
+//
+// The event has one parameter for each stored field of the resource
+// which has a valid event parameter type, in declaration order,
+// starting with the predeclared `uuid` field.
+// Other fields, e.g. nested resources or references, are not part of the event.
+//
+// NOTE: Composite declarations may not be nested in resource declarations,
+// so the identifier of the event cannot clash with a declared nested type.
+//
+func (checker *Checker) declareResourceDestroyedEvent(resourceType *CompositeType) {
+	eventType := &CompositeType{
+		Location:    resourceType.Location,
+		Kind:        common.CompositeKindEvent,
+		Identifier:  ResourceDestroyedEventIdentifier,
+		nestedTypes: &StringTypeOrderedMap{},
+		Members:     &StringMemberOrderedMap{},
+	}
+	eventType.SetContainerType(resourceType)
+
+	parameterTypeValidationResults := map[*Member]bool{}
+
+	for _, fieldName := range resourceType.Fields {
+		field, ok := resourceType.Members.Get(fieldName)
+		if !ok ||
+			field.IgnoreInSerialization ||
+			!field.IsValidEventParameterType(parameterTypeValidationResults) {
+
+			continue
+		}
+
+		eventType.Fields = append(eventType.Fields, fieldName)
+
+		eventType.ConstructorParameters = append(
+			eventType.ConstructorParameters,
+			&Parameter{
+				Identifier:     fieldName,
+				TypeAnnotation: field.TypeAnnotation,
+			},
+		)
+
+		eventType.Members.Set(
+			fieldName,
+			&Member{
+				ContainerType:   eventType,
+				Access:          ast.AccessPublic,
+				Identifier:      field.Identifier,
+				DeclarationKind: common.DeclarationKindField,
+				TypeAnnotation:  field.TypeAnnotation,
+				VariableKind:    ast.VariableKindConstant,
+			},
+		)
+	}
+
+	resourceType.resourceDestroyedEventType = eventType
+
+	checker.Elaboration.CompositeTypes[eventType.ID()] = eventType
+}
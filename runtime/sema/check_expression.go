@@ -39,10 +39,12 @@ func (checker *Checker) VisitIdentifierExpression(expression *ast.IdentifierExpr
 		checker.resources.AddUse(res, identifier.Pos)
 	}
 
+	checker.checkReferenceValidity(variable, expression)
+
 	checker.checkSelfVariableUseInInitializer(variable, identifier.Pos)
 
 	if checker.inInvocation {
-		checker.Elaboration.IdentifierInInvocationTypes[expression] = valueType
+		checker.Elaboration.IdentifierInInvocationTypes[expression.ID()] = valueType
 	}
 
 	return valueType
@@ -147,11 +149,35 @@ func (checker *Checker) VisitExpressionStatement(statement *ast.ExpressionStatem
 				Range: ast.NewRangeFromPositioned(checker.memoryGauge, expression),
 			},
 		)
+	} else if _, ok := expression.(*ast.InvocationExpression); ok {
+		checker.checkUnusedInvocationResult(ty, expression)
 	}
 
 	return nil
 }
 
+// checkUnusedInvocationResult reports a warning if the result of an invocation
+// is not used, and the invoked function returns a value, i.e. not `Void`.
+//
+func (checker *Checker) checkUnusedInvocationResult(resultType Type, expression ast.Expression) {
+	// Optional chaining of a function returning `Void` results in `Void?`
+	unwrappedResultType := UnwrapOptionalType(resultType)
+
+	if unwrappedResultType.IsInvalidType() ||
+		unwrappedResultType.Equal(VoidType) ||
+		unwrappedResultType.Equal(NeverType) {
+
+		return
+	}
+
+	checker.reportWarning(
+		&UnusedResultWarning{
+			Type:  resultType,
+			Range: ast.NewRangeFromPositioned(checker.memoryGauge, expression),
+		},
+	)
+}
+
 func (checker *Checker) VisitBoolExpression(_ *ast.BoolExpression) ast.Repr {
 	return BoolType
 }
@@ -175,7 +201,7 @@ func (checker *Checker) VisitIntegerExpression(expression *ast.IntegerExpression
 		actualType = expectedType
 	} else if IsSameTypeKind(expectedType, &AddressType{}) {
 		isAddress = true
-		CheckAddressLiteral(checker.memoryGauge, expression, checker.report)
+		checker.checkAddressLiteral(expression)
 		actualType = expectedType
 	} else {
 		// Otherwise infer the type as `Int` which can represent any integer.
@@ -186,7 +212,7 @@ func (checker *Checker) VisitIntegerExpression(expression *ast.IntegerExpression
 		CheckIntegerLiteral(checker.memoryGauge, expression, actualType, checker.report)
 	}
 
-	checker.Elaboration.IntegerExpressionType[expression] = actualType
+	checker.Elaboration.IntegerExpressionType[expression.ID()] = actualType
 
 	return actualType
 }
@@ -211,7 +237,7 @@ func (checker *Checker) VisitFixedPointExpression(expression *ast.FixedPointExpr
 
 	CheckFixedPointLiteral(checker.memoryGauge, expression, actualType, checker.report)
 
-	checker.Elaboration.FixedPointExpression[expression] = actualType
+	checker.Elaboration.FixedPointExpression[expression.ID()] = actualType
 
 	return actualType
 }
@@ -226,7 +252,7 @@ func (checker *Checker) VisitStringExpression(expression *ast.StringExpression)
 		actualType = expectedType
 	}
 
-	checker.Elaboration.StringExpressionType[expression] = actualType
+	checker.Elaboration.StringExpressionType[expression.ID()] = actualType
 
 	return actualType
 }
@@ -256,6 +282,28 @@ func (checker *Checker) visitIndexExpression(
 		return InvalidType
 	}
 
+	// Structures and resources, and references to them,
+	// can be indexed with attachment types
+
+	if baseType, ok := attachmentBaseCompositeType(targetType); ok {
+		indexingType := ast.ExpressionAsType(indexExpression.IndexingExpression)
+		if indexingType != nil {
+			return checker.checkAttachmentIndexExpression(
+				indexExpression,
+				targetType,
+				baseType,
+				indexingType,
+				isAssignment,
+			)
+		}
+	}
+
+	// Tuples can be indexed with integer literals
+
+	if tupleType, ok := targetType.(*TupleType); ok {
+		return checker.checkTupleElementAccess(indexExpression, tupleType, isAssignment)
+	}
+
 	// Check if the type instance is actually indexable. For most types (e.g. arrays and dictionaries)
 	// this is known statically (in the sense of this host language (Go), not the implemented language),
 	// i.e. a Go type switch would be sufficient.
@@ -291,8 +339,8 @@ func (checker *Checker) visitIndexExpression(
 
 	checker.checkUnusedExpressionResourceLoss(elementType, targetExpression)
 
-	checker.Elaboration.IndexExpressionIndexedTypes[indexExpression] = indexedType
-	checker.Elaboration.IndexExpressionIndexingTypes[indexExpression] = indexingType
+	checker.Elaboration.IndexExpressionIndexedTypes[indexExpression.ID()] = indexedType
+	checker.Elaboration.IndexExpressionIndexingTypes[indexExpression.ID()] = indexingType
 
 	return elementType
 }
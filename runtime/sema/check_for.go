@@ -30,7 +30,7 @@ func (checker *Checker) VisitForStatement(statement *ast.ForStatement) ast.Repr
 
 	valueExpression := statement.Value
 
-	// iterations are only supported for non-resource arrays.
+	// iterations are only supported for non-resource arrays and ranges.
 	// Hence, if the array is empty and no context type is available,
 	// then default it to [AnyStruct].
 	var expectedType Type
@@ -60,10 +60,12 @@ func (checker *Checker) VisitForStatement(statement *ast.ForStatement) ast.Repr
 			)
 		} else if arrayType, ok := valueType.(ArrayType); ok {
 			elementType = arrayType.ElementType(false)
+		} else if rangeType, ok := valueType.(*RangeType); ok {
+			elementType = rangeType.elementType()
 		} else {
 			checker.report(
 				&TypeMismatchWithDescriptionError{
-					ExpectedTypeDescription: "array",
+					ExpectedTypeDescription: "array or range",
 					ActualType:              valueType,
 					Range:                   ast.NewRangeFromPositioned(checker.memoryGauge, valueExpression),
 				},
@@ -109,7 +111,7 @@ func (checker *Checker) VisitForStatement(statement *ast.ForStatement) ast.Repr
 	// returns are not definite, but only potential.
 
 	_ = checker.checkPotentiallyUnevaluated(func() Type {
-		checker.functionActivations.WithLoop(func() {
+		checker.withLoop(statement.Label, func() {
 			statement.Block.Accept(checker)
 		})
 
@@ -41,7 +41,7 @@ func (checker *Checker) VisitForceExpression(expression *ast.ForceExpression) as
 	)
 
 	if checker.extendedElaboration {
-		checker.Elaboration.ForceExpressionTypes[expression] = valueType
+		checker.Elaboration.ForceExpressionTypes[expression.ID()] = valueType
 	}
 
 	optionalType, ok := valueType.(*OptionalType)
@@ -67,16 +67,31 @@ func (checker *Checker) visitFunctionDeclaration(
 
 	// global functions were previously declared, see `declareFunctionDeclaration`
 
-	functionType := checker.Elaboration.FunctionDeclarationFunctionTypes[declaration]
+	functionType := checker.Elaboration.FunctionDeclarationFunctionTypes[declaration.ID()]
 	if functionType == nil {
-		functionType = checker.functionType(declaration.ParameterList, declaration.ReturnTypeAnnotation)
+		functionType = checker.functionDeclarationType(declaration)
 
 		if options.declareFunction {
 			checker.declareFunctionDeclaration(declaration, functionType)
 		}
 	}
 
-	checker.Elaboration.FunctionDeclarationFunctionTypes[declaration] = functionType
+	checker.Elaboration.FunctionDeclarationFunctionTypes[declaration.ID()] = functionType
+
+	// The member function type of a composite function invokes the checked function type
+
+	if memberFunctionType, ok := checker.memberFunctionTypes[declaration]; ok {
+		checker.recordFunctionCalleeOf(memberFunctionType, functionType)
+	}
+
+	// The type parameters of a generic function are only available in the function
+
+	if len(functionType.TypeParameters) > 0 {
+		checker.typeActivations.Enter()
+		defer checker.typeActivations.Leave(declaration.EndPosition)
+
+		checker.declareTypeParameters(declaration.TypeParameters, functionType.TypeParameters)
+	}
 
 	checker.checkFunction(
 		declaration.ParameterList,
@@ -91,6 +106,125 @@ func (checker *Checker) visitFunctionDeclaration(
 	return nil
 }
 
+// functionDeclarationType returns the function type for the given function declaration.
+//
+// If the function is generic, the type parameters are declared
+// while the parameter types and the return type are converted,
+// so they may refer to the type parameters
+//
+func (checker *Checker) functionDeclarationType(declaration *ast.FunctionDeclaration) *FunctionType {
+	if len(declaration.TypeParameters) == 0 {
+		return checker.functionType(declaration.Purity, declaration.ParameterList, declaration.ReturnTypeAnnotation)
+	}
+
+	typeParameters := checker.typeParameters(declaration.TypeParameters)
+
+	checker.typeActivations.Enter()
+	defer checker.typeActivations.Leave(declaration.EndPosition)
+
+	checker.declareTypeParameters(declaration.TypeParameters, typeParameters)
+
+	functionType := checker.functionType(declaration.Purity, declaration.ParameterList, declaration.ReturnTypeAnnotation)
+	functionType.TypeParameters = typeParameters
+
+	return functionType
+}
+
+// typeParameters converts the given type parameters of a function declaration.
+//
+// The first type bound is the type bound of the type parameter,
+// all further type bounds are constraints.
+// Type parameters without a type bound are bound by `AnyStruct`
+//
+func (checker *Checker) typeParameters(typeParameters []*ast.TypeParameter) []*TypeParameter {
+
+	convertedTypeParameters := make([]*TypeParameter, len(typeParameters))
+
+	for i, typeParameter := range typeParameters {
+
+		var typeBound Type = AnyStructType
+		var constraints []Type
+
+		for j, rawTypeBound := range typeParameter.TypeBounds {
+			convertedTypeBound := checker.ConvertType(rawTypeBound)
+
+			if j == 0 {
+				typeBound = convertedTypeBound
+			} else {
+				constraints = append(constraints, convertedTypeBound)
+			}
+		}
+
+		convertedTypeParameter := &TypeParameter{
+			Name:        typeParameter.Identifier.Identifier,
+			TypeBound:   typeBound,
+			Constraints: constraints,
+		}
+
+		checker.checkTypeParameterBounds(typeParameter, convertedTypeParameter)
+
+		convertedTypeParameters[i] = convertedTypeParameter
+	}
+
+	return convertedTypeParameters
+}
+
+// checkTypeParameterBounds checks that the type bounds of the given type parameter
+// are either all resource types, or all non-resource types.
+// No type argument could satisfy bounds of mixed kinds
+//
+func (checker *Checker) checkTypeParameterBounds(
+	typeParameter *ast.TypeParameter,
+	convertedTypeParameter *TypeParameter,
+) {
+	typeBounds := convertedTypeParameter.TypeBounds()
+	if len(typeBounds) < 2 {
+		return
+	}
+
+	isResource := typeBounds[0].IsResourceType()
+
+	for i, typeBound := range typeBounds[1:] {
+		if typeBound.IsInvalidType() ||
+			typeBound.IsResourceType() == isResource {
+
+			continue
+		}
+
+		checker.report(
+			&InvalidTypeParameterBoundError{
+				TypeParameter: convertedTypeParameter,
+				TypeBound:     typeBound,
+				Range: ast.NewRangeFromPositioned(
+					checker.memoryGauge,
+					typeParameter.TypeBounds[i+1],
+				),
+			},
+		)
+	}
+}
+
+// declareTypeParameters declares the given type parameters
+// as generic types in the current type activation
+//
+func (checker *Checker) declareTypeParameters(
+	typeParameters []*ast.TypeParameter,
+	convertedTypeParameters []*TypeParameter,
+) {
+	for i, typeParameter := range typeParameters {
+		_, err := checker.typeActivations.DeclareType(typeDeclaration{
+			identifier: typeParameter.Identifier,
+			ty: &GenericType{
+				TypeParameter: convertedTypeParameters[i],
+			},
+			declarationKind:          common.DeclarationKindTypeParameter,
+			access:                   ast.AccessNotSpecified,
+			allowOuterScopeShadowing: true,
+		})
+		checker.report(err)
+	}
+}
+
 func (checker *Checker) declareFunctionDeclaration(
 	declaration *ast.FunctionDeclaration,
 	functionType *FunctionType,
@@ -186,7 +320,11 @@ func (checker *Checker) checkFunction(
 			}
 
 			if initializationInfo != nil {
-				checker.checkFieldMembersInitialized(initializationInfo)
+				// If the end of the function is unreachable, e.g. because all paths return,
+				// the fields only need to be initialized at the return statements
+				endReachable := !functionActivation.ReturnInfo.IsUnreachable()
+
+				checker.checkFieldMembersInitialized(initializationInfo, endReachable)
 			}
 		},
 	)
@@ -237,6 +375,8 @@ func (checker *Checker) checkFunctionExits(functionBlock *ast.FunctionBlock, ret
 }
 
 func (checker *Checker) checkParameters(parameterList *ast.ParameterList, parameters []*Parameter) {
+	hasDefaultArgument := false
+
 	for i, parameter := range parameterList.Parameters {
 		parameterTypeAnnotation := parameters[i].TypeAnnotation
 
@@ -244,7 +384,59 @@ func (checker *Checker) checkParameters(parameterList *ast.ParameterList, parame
 			parameterTypeAnnotation,
 			parameter.TypeAnnotation,
 		)
+
+		if parameter.DefaultArgument == nil {
+			if hasDefaultArgument {
+				checker.report(
+					&MissingDefaultArgumentError{
+						Name:  parameter.Identifier.Identifier,
+						Range: ast.NewRangeFromPositioned(checker.memoryGauge, parameter),
+					},
+				)
+			}
+			continue
+		}
+
+		hasDefaultArgument = true
+
+		checker.checkParameterDefaultArgument(parameter, parameterTypeAnnotation)
+	}
+}
+
+// checkParameterDefaultArgument checks the default argument of the given parameter.
+//
+// The default argument is checked in the scope enclosing the function,
+// i.e. it may not refer to other parameters of the function
+//
+func (checker *Checker) checkParameterDefaultArgument(
+	parameter *ast.Parameter,
+	parameterTypeAnnotation *TypeAnnotation,
+) {
+	defaultArgument := parameter.DefaultArgument
+
+	// Resources would be created every time the default argument is used,
+	// so resource-typed parameters may not have a default argument
+
+	if parameterTypeAnnotation.Type.IsResourceType() {
+		checker.report(
+			&InvalidDefaultArgumentError{
+				Name:   parameter.Identifier.Identifier,
+				Reason: "parameters with a resource type cannot have a default argument",
+				Range:  ast.NewRangeFromPositioned(checker.memoryGauge, defaultArgument),
+			},
+		)
+		return
 	}
+
+	parameterType := parameterTypeAnnotation.Type
+
+	defaultArgumentType := checker.VisitExpression(defaultArgument, parameterType)
+
+	checker.Elaboration.ParameterDefaultArgumentTypes[defaultArgument.ID()] =
+		DefaultArgumentTypes{
+			ValueType:     defaultArgumentType,
+			ParameterType: parameterType,
+		}
 }
 
 // checkArgumentLabels checks that all argument labels (if any) are unique
@@ -423,9 +615,9 @@ func (checker *Checker) declareBefore() {
 func (checker *Checker) VisitFunctionExpression(expression *ast.FunctionExpression) ast.Repr {
 
 	// TODO: infer
-	functionType := checker.functionType(expression.ParameterList, expression.ReturnTypeAnnotation)
+	functionType := checker.functionType(expression.Purity, expression.ParameterList, expression.ReturnTypeAnnotation)
 
-	checker.Elaboration.FunctionExpressionFunctionType[expression] = functionType
+	checker.Elaboration.FunctionExpressionFunctionType[expression.ID()] = functionType
 
 	checker.checkFunction(
 		expression.ParameterList,
@@ -451,23 +643,30 @@ func (checker *Checker) VisitFunctionExpression(expression *ast.FunctionExpressi
 }
 
 // checkFieldMembersInitialized checks that all fields that were required
-// to be initialized (as stated in the initialization info) have been initialized.
+// to be initialized (as stated in the initialization info) have been initialized,
+// at the end of the function, if it is reachable, and at all return statements.
 //
-func (checker *Checker) checkFieldMembersInitialized(info *InitializationInfo) {
+func (checker *Checker) checkFieldMembersInitialized(info *InitializationInfo, endReachable bool) {
 	for pair := info.FieldMembers.Oldest(); pair != nil; pair = pair.Next() {
 		member := pair.Key
 		field := pair.Value
 
-		isInitialized := info.InitializedFieldMembers.Contains(member)
-		if isInitialized {
+		uninitializedReturns := info.UninitializedReturns[member]
+
+		isInitialized := !endReachable ||
+			info.InitializedFieldMembers.Contains(member)
+
+		if isInitialized && len(uninitializedReturns) == 0 {
 			continue
 		}
 
 		checker.report(
 			&FieldUninitializedError{
-				Name:          field.Identifier.Identifier,
-				Pos:           field.Identifier.Pos,
-				ContainerType: info.ContainerType,
+				Name:                  field.Identifier.Identifier,
+				Pos:                   field.Identifier.Pos,
+				ContainerType:         info.ContainerType,
+				UninitializedBranches: info.UninitializedBranches[member],
+				UninitializedReturns:  uninitializedReturns,
 			},
 		)
 	}
@@ -0,0 +1,83 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+)
+
+// recordFunctionEffects records the given side effects
+// for the function which is currently being checked
+//
+func (checker *Checker) recordFunctionEffects(effects FunctionEffects) {
+	if effects == FunctionEffectsNone {
+		return
+	}
+
+	functionType := checker.functionActivations.Current().FunctionType
+	checker.Elaboration.FunctionEffects[functionType] |= effects
+}
+
+// recordFunctionCallee records that the function which is currently being checked
+// invokes the function with the given type
+//
+func (checker *Checker) recordFunctionCallee(callee *FunctionType) {
+	functionType := checker.functionActivations.Current().FunctionType
+	checker.recordFunctionCalleeOf(functionType, callee)
+}
+
+func (checker *Checker) recordFunctionCalleeOf(caller *FunctionType, callee *FunctionType) {
+	callees := checker.Elaboration.FunctionCallees
+	callees[caller] = append(callees[caller], callee)
+}
+
+// recordInvocationEffects records the invocation of the function with the given type,
+// and the side effects of the invocation itself,
+// i.e. if a function of an imported contract is invoked
+//
+func (checker *Checker) recordInvocationEffects(
+	invokedExpression ast.Expression,
+	functionType *FunctionType,
+) {
+	checker.recordFunctionCallee(functionType)
+
+	memberExpression, ok := invokedExpression.(*ast.MemberExpression)
+	if !ok {
+		return
+	}
+
+	memberInfo, ok := checker.Elaboration.MemberExpressionMemberInfos[memberExpression.ID()]
+	if !ok || memberInfo.Member == nil {
+		return
+	}
+
+	locatedType, ok := memberInfo.Member.ContainerType.(LocatedType)
+	if !ok {
+		return
+	}
+
+	location := locatedType.GetLocation()
+	if location == nil || checker.Location == nil ||
+		location.ID() == checker.Location.ID() {
+
+		return
+	}
+
+	checker.recordFunctionEffects(FunctionEffectCallImportedContract)
+}
@@ -57,10 +57,21 @@ func (checker *Checker) declareImportDeclaration(declaration *ast.ImportDeclarat
 		return nil
 	}
 
-	checker.Elaboration.ImportDeclarationsResolvedLocations[declaration] = resolvedLocations
+	checker.Elaboration.ImportDeclarationsResolvedLocations[declaration.ID()] = resolvedLocations
 
 	for _, resolvedLocation := range resolvedLocations {
-		checker.importResolvedLocation(resolvedLocation, locationRange)
+
+		// The location handler may resolve to identifiers which do not occur in the program,
+		// e.g. when it resolves an identifier location to a contract of the same account.
+		// Such identifiers have no position, so use the position of the imported location.
+
+		for i, identifier := range resolvedLocation.Identifiers {
+			if identifier.Pos == (ast.Position{}) {
+				resolvedLocation.Identifiers[i].Pos = declaration.LocationPos
+			}
+		}
+
+		checker.importResolvedLocation(declaration, resolvedLocation, locationRange)
 	}
 
 	return nil
@@ -85,7 +96,11 @@ func (checker *Checker) resolveLocation(identifiers []ast.Identifier, location c
 	return checker.locationHandler(identifiers, location)
 }
 
-func (checker *Checker) importResolvedLocation(resolvedLocation ResolvedLocation, locationRange ast.Range) {
+func (checker *Checker) importResolvedLocation(
+	declaration *ast.ImportDeclaration,
+	resolvedLocation ResolvedLocation,
+	locationRange ast.Range,
+) {
 
 	// First, get the Import for the resolved location
 
@@ -136,30 +151,54 @@ func (checker *Checker) importResolvedLocation(resolvedLocation ResolvedLocation
 		checker.report(
 			&CyclicImportsError{
 				Location: location,
+				Cycle:    checker.importCycle(location),
 				Range:    locationRange,
 			},
 		)
 		return
 	}
 
+	if checker.positionInfoEnabled {
+		checker.importedLocations = append(
+			checker.importedLocations,
+			importedLocation{
+				location: location,
+				imp:      imp,
+			},
+		)
+	}
+
+	// Collisions of imported declarations with existing declarations
+	// are only reported once per name, even if both a value and a type collide
+
+	collisions := map[string]struct{}{}
+
 	// Attempt to import the requested value declarations
 
 	allValueElements := imp.AllValueElements()
 	foundValues, invalidAccessedValues := checker.importElements(
+		declaration,
+		location,
+		locationRange,
 		checker.valueActivations,
 		resolvedLocation.Identifiers,
 		allValueElements,
 		imp.IsImportableValue,
+		collisions,
 	)
 
 	// Attempt to import the requested type declarations
 
 	allTypeElements := imp.AllTypeElements()
 	foundTypes, invalidAccessedTypes := checker.importElements(
+		declaration,
+		location,
+		locationRange,
 		checker.typeActivations,
 		resolvedLocation.Identifiers,
 		allTypeElements,
 		imp.IsImportableType,
+		collisions,
 	)
 
 	// For each identifier, report if the import is invalid due to
@@ -234,11 +273,16 @@ func (checker *Checker) importResolvedLocation(resolvedLocation ResolvedLocation
 			available = append(available, identifier)
 		})
 
-		checker.handleMissingImports(missing, available, location)
+		checker.handleMissingImports(declaration, missing, available, location)
 	}
 }
 
-func (checker *Checker) handleMissingImports(missing []ast.Identifier, available []string, importLocation common.Location) {
+func (checker *Checker) handleMissingImports(
+	declaration *ast.ImportDeclaration,
+	missing []ast.Identifier,
+	available []string,
+	importLocation common.Location,
+) {
 	for _, identifier := range missing {
 		checker.report(
 			&NotExportedError{
@@ -252,8 +296,11 @@ func (checker *Checker) handleMissingImports(missing []ast.Identifier, available
 		// NOTE: declare constant variable with invalid type to silence rest of program
 		const access = ast.AccessPrivate
 
+		declaredIdentifier := identifier
+		declaredIdentifier.Identifier = declaration.DeclaredName(identifier.Identifier)
+
 		_, err := checker.valueActivations.Declare(variableDeclaration{
-			identifier:               identifier.Identifier,
+			identifier:               declaredIdentifier.Identifier,
 			ty:                       InvalidType,
 			access:                   access,
 			kind:                     common.DeclarationKindValue,
@@ -265,7 +312,7 @@ func (checker *Checker) handleMissingImports(missing []ast.Identifier, available
 
 		// NOTE: declare type with invalid type to silence rest of program
 		_, err = checker.typeActivations.DeclareType(typeDeclaration{
-			identifier:               identifier,
+			identifier:               declaredIdentifier,
 			ty:                       InvalidType,
 			declarationKind:          common.DeclarationKindType,
 			access:                   access,
@@ -276,10 +323,14 @@ func (checker *Checker) handleMissingImports(missing []ast.Identifier, available
 }
 
 func (checker *Checker) importElements(
+	declaration *ast.ImportDeclaration,
+	importLocation common.Location,
+	locationRange ast.Range,
 	valueActivations *VariableActivations,
 	requestedIdentifiers []ast.Identifier,
 	availableElements *StringImportElementOrderedMap,
 	filter func(name string) bool,
+	collisions map[string]struct{},
 ) (
 	found map[ast.Identifier]bool,
 	invalidAccessed map[ast.Identifier]ImportElement,
@@ -335,21 +386,81 @@ func (checker *Checker) importElements(
 				}
 			}
 
+			// Explicitly imported declarations are declared at the position
+			// of the imported identifier, and may be declared under an alias
+
+			declaredName := declaration.DeclaredName(name)
+
+			pos := ast.EmptyPosition
+			collisionRange := locationRange
+			if identifier, ok := explicitlyImported[name]; ok {
+				pos = identifier.Pos
+				collisionRange = ast.NewRangeFromPositioned(checker.memoryGauge, identifier)
+			}
+
+			existingVariable := valueActivations.Find(declaredName)
+
 			_, err := valueActivations.Declare(variableDeclaration{
-				identifier: name,
+				identifier: declaredName,
 				ty:         element.Type,
 				// TODO: implies that type is "re-exported"
-				access: access,
-				kind:   element.DeclarationKind,
-				// TODO:
-				pos:                      ast.EmptyPosition,
+				access:                   access,
+				kind:                     element.DeclarationKind,
+				pos:                      pos,
 				isConstant:               true,
 				argumentLabels:           element.ArgumentLabels,
 				allowOuterScopeShadowing: false,
 			})
+
+			if _, ok := err.(*RedeclarationError); ok {
+				if _, ok := collisions[declaredName]; ok {
+					return
+				}
+				collisions[declaredName] = struct{}{}
+
+				var previousPos *ast.Position
+				if existingVariable != nil {
+					previousPos = existingVariable.Pos
+				}
+
+				checker.report(
+					&ImportCollisionError{
+						Name:           declaredName,
+						ImportedName:   name,
+						ImportLocation: importLocation,
+						PreviousPos:    previousPos,
+						Range:          collisionRange,
+					},
+				)
+				return
+			}
+
 			checker.report(err)
 		})
 	}
 
 	return
 }
+
+// importCycle returns the path of imports which leads from the given location,
+// which is currently being checked, to the checker's location, and back to the given location.
+// The path is determined by following the chain of importing checkers.
+// Returns nil if the given location is not found in the chain.
+//
+func (checker *Checker) importCycle(location common.Location) []common.Location {
+	var locations []common.Location
+
+	for current := checker; current != nil; current = current.importingChecker {
+		locations = append(locations, current.Location)
+
+		if current.Location.ID() == location.ID() {
+			cycle := make([]common.Location, 0, len(locations)+1)
+			for i := len(locations) - 1; i >= 0; i-- {
+				cycle = append(cycle, locations[i])
+			}
+			return append(cycle, location)
+		}
+	}
+
+	return nil
+}
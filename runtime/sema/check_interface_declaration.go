@@ -35,7 +35,7 @@ func (checker *Checker) VisitInterfaceDeclaration(declaration *ast.InterfaceDecl
 
 	const kind = ContainerKindInterface
 
-	interfaceType := checker.Elaboration.InterfaceDeclarationTypes[declaration]
+	interfaceType := checker.Elaboration.InterfaceDeclarationTypes[declaration.ID()]
 	if interfaceType == nil {
 		panic(errors.NewUnreachableError())
 	}
@@ -57,6 +57,8 @@ func (checker *Checker) VisitInterfaceDeclaration(declaration *ast.InterfaceDecl
 
 	checker.checkNestedIdentifiers(declaration.Members)
 
+	checker.checkNestedTypeAliases(declaration.Members, declaration.DeclarationKind())
+
 	// Activate new scope for nested types
 
 	checker.typeActivations.Enter()
@@ -134,8 +136,8 @@ func (checker *Checker) declareInterfaceNestedTypes(
 	declaration *ast.InterfaceDeclaration,
 ) {
 
-	interfaceType := checker.Elaboration.InterfaceDeclarationTypes[declaration]
-	nestedDeclarations := checker.Elaboration.InterfaceNestedDeclarations[declaration]
+	interfaceType := checker.Elaboration.InterfaceDeclarationTypes[declaration.ID()]
+	nestedDeclarations := checker.Elaboration.InterfaceNestedDeclarations[declaration.ID()]
 
 	interfaceType.nestedTypes.Foreach(func(name string, nestedType Type) {
 		nestedDeclaration := nestedDeclarations[name]
@@ -232,7 +234,7 @@ func (checker *Checker) declareInterfaceType(declaration *ast.InterfaceDeclarati
 		variable,
 	)
 
-	checker.Elaboration.InterfaceDeclarationTypes[declaration] = interfaceType
+	checker.Elaboration.InterfaceDeclarationTypes[declaration.ID()] = interfaceType
 	checker.Elaboration.InterfaceTypeDeclarations[interfaceType] = declaration
 
 	if !declaration.CompositeKind.SupportsInterfaces() {
@@ -262,7 +264,7 @@ func (checker *Checker) declareInterfaceType(declaration *ast.InterfaceDeclarati
 			declaration.Members.Interfaces(),
 		)
 
-	checker.Elaboration.InterfaceNestedDeclarations[declaration] = nestedDeclarations
+	checker.Elaboration.InterfaceNestedDeclarations[declaration.ID()] = nestedDeclarations
 
 	for _, nestedInterfaceType := range nestedInterfaceTypes {
 		interfaceType.nestedTypes.Set(nestedInterfaceType.Identifier, nestedInterfaceType)
@@ -286,7 +288,7 @@ func (checker *Checker) declareInterfaceType(declaration *ast.InterfaceDeclarati
 //
 func (checker *Checker) declareInterfaceMembers(declaration *ast.InterfaceDeclaration) {
 
-	interfaceType := checker.Elaboration.InterfaceDeclarationTypes[declaration]
+	interfaceType := checker.Elaboration.InterfaceDeclarationTypes[declaration.ID()]
 	if interfaceType == nil {
 		panic(errors.NewUnreachableError())
 	}
@@ -39,6 +39,19 @@ func (checker *Checker) VisitInvocationExpression(invocationExpression *ast.Invo
 		return InvalidType
 	}
 
+	// Attachments cannot be invoked without an attach expression
+
+	if compositeType, ok := ty.(*CompositeType); ok &&
+		compositeType.Kind == common.CompositeKindAttachment {
+
+		checker.report(
+			&InvalidAttachmentUsageError{
+				Range: ast.NewRangeFromPositioned(checker.memoryGauge, invocationExpression),
+			},
+		)
+		return InvalidType
+	}
+
 	return ty
 }
 
@@ -81,7 +94,7 @@ func (checker *Checker) checkInvocationExpression(invocationExpression *ast.Invo
 
 	var argumentTypes []Type
 	defer func() {
-		checker.Elaboration.InvocationExpressionArgumentTypes[invocationExpression] = argumentTypes
+		checker.Elaboration.InvocationExpressionArgumentTypes[invocationExpression.ID()] = argumentTypes
 	}()
 
 	functionType, ok := expressionType.(*FunctionType)
@@ -102,7 +115,7 @@ func (checker *Checker) checkInvocationExpression(invocationExpression *ast.Invo
 			argumentTypes = append(argumentTypes, argumentType)
 		}
 
-		checker.Elaboration.InvocationExpressionReturnTypes[invocationExpression] = checker.expectedType
+		checker.Elaboration.InvocationExpressionReturnTypes[invocationExpression.ID()] = checker.expectedType
 
 		return InvalidType
 	}
@@ -131,6 +144,8 @@ func (checker *Checker) checkInvocationExpression(invocationExpression *ast.Invo
 		checkInvocation()
 	}
 
+	checker.recordInvocationEffects(invokedExpression, functionType)
+
 	arguments := invocationExpression.Arguments
 
 	if checker.positionInfoEnabled && len(arguments) > 0 {
@@ -219,7 +234,7 @@ func (checker *Checker) checkMemberInvocationResourceInvalidation(invokedExpress
 	// Check that an entry for `IdentifierInInvocationTypes` exists,
 	// because the entry might be missing if the invocation was on a non-existent variable
 
-	valueType, ok := checker.Elaboration.IdentifierInInvocationTypes[invocationIdentifierExpression]
+	valueType, ok := checker.Elaboration.IdentifierInInvocationTypes[invocationIdentifierExpression.ID()]
 	if !ok {
 		return
 	}
@@ -371,6 +386,10 @@ func (checker *Checker) checkInvocation(
 	argumentTypes []Type,
 	returnType Type,
 ) {
+	if functionType.Purity != FunctionPurityView {
+		checker.reportImpureOperation(ImpureOperationInvocation, invocationExpression)
+	}
+
 	parameterCount := len(functionType.Parameters)
 	requiredArgumentCount := functionType.RequiredArgumentCount
 	typeParameterCount := len(functionType.TypeParameters)
@@ -482,9 +501,9 @@ func (checker *Checker) checkInvocation(
 
 	// Save types in the elaboration
 
-	checker.Elaboration.InvocationExpressionTypeArguments[invocationExpression] = typeArguments
-	checker.Elaboration.InvocationExpressionParameterTypes[invocationExpression] = parameterTypes
-	checker.Elaboration.InvocationExpressionReturnTypes[invocationExpression] = returnType
+	checker.Elaboration.InvocationExpressionTypeArguments[invocationExpression.ID()] = typeArguments
+	checker.Elaboration.InvocationExpressionParameterTypes[invocationExpression.ID()] = parameterTypes
+	checker.Elaboration.InvocationExpressionReturnTypes[invocationExpression.ID()] = returnType
 
 	return argumentTypes, returnType
 }
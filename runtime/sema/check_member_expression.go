@@ -97,13 +97,13 @@ func (checker *Checker) VisitMemberExpression(expression *ast.MemberExpression)
 }
 
 func (checker *Checker) visitMember(expression *ast.MemberExpression) (accessedType Type, member *Member, isOptional bool) {
-	memberInfo, ok := checker.Elaboration.MemberExpressionMemberInfos[expression]
+	memberInfo, ok := checker.Elaboration.MemberExpressionMemberInfos[expression.ID()]
 	if ok {
 		return memberInfo.AccessedType, memberInfo.Member, memberInfo.IsOptional
 	}
 
 	defer func() {
-		checker.Elaboration.MemberExpressionMemberInfos[expression] =
+		checker.Elaboration.MemberExpressionMemberInfos[expression.ID()] =
 			MemberInfo{
 				AccessedType: accessedType,
 				Member:       member,
@@ -227,7 +227,7 @@ func (checker *Checker) visitMember(expression *ast.MemberExpression) (accessedT
 	if member == nil {
 		if !accessedType.IsInvalidType() {
 
-			checker.Elaboration.MemberExpressionExpectedTypes[expression] = checker.expectedType
+			checker.Elaboration.MemberExpressionExpectedTypes[expression.ID()] = checker.expectedType
 
 			checker.report(
 				&NotDeclaredMemberError{
@@ -247,7 +247,7 @@ func (checker *Checker) visitMember(expression *ast.MemberExpression) (accessedT
 		if checker.positionInfoEnabled {
 			origins := checker.memberOrigins[accessedType]
 			origin := origins[identifier]
-			checker.Occurrences.Put(
+			checker.recordOccurrence(
 				identifierStartPosition,
 				identifierEndPosition,
 				origin,
@@ -284,10 +284,118 @@ func (checker *Checker) visitMember(expression *ast.MemberExpression) (accessedT
 				},
 			)
 		}
+
+		// Check that the execute block of a transaction does not access
+		// the authorizers' accounts, e.g. by storing them in a field in the prepare block
+
+		if checker.transactionExecuteAuthAccountCheckEnabled &&
+			checker.inTransactionExecute &&
+			member.DeclarationKind == common.DeclarationKindField {
+
+			if _, ok := accessedType.(*TransactionType); ok &&
+				mayContainAuthAccountType(member.TypeAnnotation.Type) {
+
+				checker.report(
+					&InvalidTransactionExecuteAuthAccountAccessError{
+						Name:  identifier,
+						Type:  member.TypeAnnotation.Type,
+						Range: ast.NewRangeFromPositioned(checker.memoryGauge, expression),
+					},
+				)
+			}
+		}
+
+		// Check that the member is not a state mutating function,
+		// if the program is checked to be read-only
+
+		if checker.readOnlyProgramCheckEnabled &&
+			isStateMutatingMember(member) {
+
+			checker.report(
+				&ReadOnlyProgramMutationError{
+					Name:          identifier,
+					ContainerType: member.ContainerType,
+					Range:         ast.NewRangeFromPositioned(checker.memoryGauge, expression),
+				},
+			)
+		}
+
+		// Warn about the use of deprecated members,
+		// i.e. members declared with the `#deprecated` pragma,
+		// or members with a deprecation notice in their documentation
+
+		notes, deprecated := member.DeprecationMessage, member.Deprecated
+		if !deprecated {
+			notes, deprecated = deprecationNotes(member.DocString)
+		}
+
+		if deprecated {
+			checker.reportWarning(
+				&DeprecatedMemberWarning{
+					Name:             identifier,
+					DeclarationKind:  member.DeclarationKind,
+					DeprecationNotes: notes,
+					Range: ast.NewRange(
+						checker.memoryGauge,
+						identifierStartPosition,
+						identifierEndPosition,
+					),
+				},
+			)
+		}
 	}
 	return accessedType, member, isOptional
 }
 
+// stateMutatingMembers are the members of built-in types
+// which modify the state of an account when they are invoked
+//
+var stateMutatingMembers = map[Type]map[string]struct{}{
+	AuthAccountType: {
+		AuthAccountSaveField:            {},
+		AuthAccountLoadField:            {},
+		AuthAccountLinkField:            {},
+		AuthAccountUnlinkField:          {},
+		AuthAccountAddPublicKeyField:    {},
+		AuthAccountRemovePublicKeyField: {},
+	},
+	AuthAccountContractsType: {
+		AuthAccountContractsTypeAddFunctionName:                {},
+		AuthAccountContractsTypeUpdateExperimentalFunctionName: {},
+		AuthAccountContractsTypeRemoveFunctionName:             {},
+	},
+	AuthAccountKeysType: {
+		AccountKeysAddFunctionName:    {},
+		AccountKeysRevokeFunctionName: {},
+	},
+	AuthAccountInboxType: {
+		AuthAccountInboxTypePublishFunctionName:   {},
+		AuthAccountInboxTypeUnpublishFunctionName: {},
+		AuthAccountInboxTypeClaimFunctionName:     {},
+	},
+	AuthAccountCapabilitiesType: {
+		AuthAccountCapabilitiesTypePublishFunctionName:     {},
+		AuthAccountCapabilitiesTypeUnpublishFunctionName:   {},
+		AuthAccountCapabilitiesTypeMigrateLinkFunctionName: {},
+	},
+	AuthAccountStorageCapabilitiesType: {
+		AuthAccountStorageCapabilitiesTypeIssueFunctionName: {},
+	},
+	StorageCapabilityControllerType: {
+		StorageCapabilityControllerTypeRetargetFunctionName: {},
+		StorageCapabilityControllerTypeDeleteFunctionName:   {},
+	},
+}
+
+func isStateMutatingMember(member *Member) bool {
+	names, ok := stateMutatingMembers[member.ContainerType]
+	if !ok {
+		return false
+	}
+	_, ok = names[member.Identifier.Identifier]
+	return ok
+}
+
 // isReadableMember returns true if the given member can be read from
 // in the current location of the checker
 //
@@ -0,0 +1,136 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/parser"
+)
+
+func TestCheckReadOnlyProgramStateMutatingMembers(t *testing.T) {
+
+	t.Parallel()
+
+	// accesses contains an access expression for each state mutating member.
+	// Every state mutating member must have an access expression,
+	// so the rejection of each member is tested
+
+	accesses := map[Type]map[string]string{
+		AuthAccountType: {
+			AuthAccountSaveField:            `signer.save`,
+			AuthAccountLoadField:            `signer.load`,
+			AuthAccountLinkField:            `signer.link`,
+			AuthAccountUnlinkField:          `signer.unlink`,
+			AuthAccountAddPublicKeyField:    `signer.addPublicKey`,
+			AuthAccountRemovePublicKeyField: `signer.removePublicKey`,
+		},
+		AuthAccountContractsType: {
+			AuthAccountContractsTypeAddFunctionName:                `signer.contracts.add`,
+			AuthAccountContractsTypeUpdateExperimentalFunctionName: `signer.contracts.update__experimental`,
+			AuthAccountContractsTypeRemoveFunctionName:             `signer.contracts.remove`,
+		},
+		AuthAccountKeysType: {
+			AccountKeysAddFunctionName:    `signer.keys.add`,
+			AccountKeysRevokeFunctionName: `signer.keys.revoke`,
+		},
+		AuthAccountInboxType: {
+			AuthAccountInboxTypePublishFunctionName:   `signer.inbox.publish`,
+			AuthAccountInboxTypeUnpublishFunctionName: `signer.inbox.unpublish`,
+			AuthAccountInboxTypeClaimFunctionName:     `signer.inbox.claim`,
+		},
+		AuthAccountCapabilitiesType: {
+			AuthAccountCapabilitiesTypePublishFunctionName:     `signer.capabilities.publish`,
+			AuthAccountCapabilitiesTypeUnpublishFunctionName:   `signer.capabilities.unpublish`,
+			AuthAccountCapabilitiesTypeMigrateLinkFunctionName: `signer.capabilities.migrateLink`,
+		},
+		AuthAccountStorageCapabilitiesType: {
+			AuthAccountStorageCapabilitiesTypeIssueFunctionName: `signer.capabilities.storage.issue`,
+		},
+		StorageCapabilityControllerType: {
+			StorageCapabilityControllerTypeRetargetFunctionName: `signer.capabilities.storage.getController(byCapabilityID: 1)!.retarget`,
+			StorageCapabilityControllerTypeDeleteFunctionName:   `signer.capabilities.storage.getController(byCapabilityID: 1)!.delete`,
+		},
+	}
+
+	check := func(t *testing.T, access string, readOnly bool) error {
+
+		code := fmt.Sprintf(
+			`
+              transaction {
+                  prepare(signer: AuthAccount) {
+                      let member = %s
+                  }
+              }
+            `,
+			access,
+		)
+
+		program, err := parser.ParseProgram(code, nil)
+		require.NoError(t, err)
+
+		checker, err := NewChecker(
+			program,
+			common.StringLocation("test"),
+			nil,
+			false,
+			WithReadOnlyProgramCheckEnabled(readOnly),
+		)
+		require.NoError(t, err)
+
+		return checker.Check()
+	}
+
+	for containerType, names := range stateMutatingMembers {
+		for name := range names {
+
+			containerType := containerType
+			name := name
+
+			testName := fmt.Sprintf("%s.%s", containerType.QualifiedString(), name)
+
+			t.Run(testName, func(t *testing.T) {
+
+				t.Parallel()
+
+				access, ok := accesses[containerType][name]
+				require.True(t, ok, "missing test for state mutating member %s", testName)
+
+				err := check(t, access, true)
+
+				var checkerErr *CheckerError
+				require.ErrorAs(t, err, &checkerErr)
+				require.Len(t, checkerErr.Errors, 1)
+
+				var mutationErr *ReadOnlyProgramMutationError
+				require.ErrorAs(t, checkerErr.Errors[0], &mutationErr)
+				assert.Equal(t, name, mutationErr.Name)
+				assert.Equal(t, containerType, mutationErr.ContainerType)
+
+				err = check(t, access, false)
+				require.NoError(t, err)
+			})
+		}
+	}
+}
@@ -37,6 +37,14 @@ func (checker *Checker) VisitPragmaDeclaration(p *ast.PragmaDeclaration) ast.Rep
 	}
 
 	if isInvocPragma {
+		// The deprecated pragma has at most one argument, the message
+		if p.Name() == ast.DeprecatedPragmaName && len(invocPragma.Arguments) > 1 {
+			checker.report(&InvalidPragmaError{
+				Message: "deprecated pragma must have at most one argument",
+				Range:   ast.NewRangeFromPositioned(checker.memoryGauge, invocPragma),
+			})
+		}
+
 		// Type arguments are not supported for pragmas
 		if len(invocPragma.TypeArguments) > 0 {
 			checker.report(&InvalidPragmaError{
@@ -58,3 +66,110 @@ func (checker *Checker) VisitPragmaDeclaration(p *ast.PragmaDeclaration) ast.Rep
 
 	return nil
 }
+
+// checkMemberPragmas checks the pragmas declared in the given members.
+// Only the `#deprecated` pragma is supported for members,
+// and it must precede a member declaration.
+//
+func (checker *Checker) checkMemberPragmas(members *ast.Members) {
+	var pendingDeprecatedPragma *ast.PragmaDeclaration
+
+	for _, declaration := range members.Declarations() {
+		pragma, ok := declaration.(*ast.PragmaDeclaration)
+		if !ok {
+			pendingDeprecatedPragma = nil
+			continue
+		}
+
+		checker.VisitPragmaDeclaration(pragma)
+
+		if _, ok := pragma.DeprecationMessage(); !ok {
+			checker.report(&InvalidPragmaError{
+				Message: "only deprecated pragma is supported for members",
+				Range:   ast.NewRangeFromPositioned(checker.memoryGauge, pragma),
+			})
+			continue
+		}
+
+		pendingDeprecatedPragma = pragma
+	}
+
+	if pendingDeprecatedPragma != nil {
+		checker.report(&InvalidPragmaError{
+			Message: "deprecated pragma must precede a member declaration",
+			Range:   ast.NewRangeFromPositioned(checker.memoryGauge, pendingDeprecatedPragma),
+		})
+	}
+}
+
+// declareLanguageVersion determines the language version of the program
+// from the given top-level pragmas.
+// At most one `#cadence(version: "...")` pragma may be declared,
+// and the version must not exceed the maximum allowed language version.
+// If no version is declared, the program has the default language version.
+//
+func (checker *Checker) declareLanguageVersion(pragmas []*ast.PragmaDeclaration) {
+	var versionPragma *ast.PragmaDeclaration
+
+	for _, pragma := range pragmas {
+		if pragma.Name() != ast.CadenceVersionPragmaName {
+			continue
+		}
+
+		if versionPragma != nil {
+			checker.report(&InvalidPragmaError{
+				Message: "language version must only be declared once",
+				Range:   ast.NewRangeFromPositioned(checker.memoryGauge, pragma),
+			})
+			continue
+		}
+		versionPragma = pragma
+
+		versionString, ok := pragma.CadenceVersion()
+		if !ok {
+			checker.report(&InvalidPragmaError{
+				Message: "cadence pragma must have a single string argument labeled `version`",
+				Range:   ast.NewRangeFromPositioned(checker.memoryGauge, pragma),
+			})
+			continue
+		}
+
+		version, err := ParseLanguageVersion(versionString)
+		if err != nil {
+			checker.report(&InvalidPragmaError{
+				Message: err.Error(),
+				Range:   ast.NewRangeFromPositioned(checker.memoryGauge, pragma),
+			})
+			continue
+		}
+
+		maxVersion := checker.maxLanguageVersion
+		if maxVersion == (LanguageVersion{}) {
+			maxVersion = LatestLanguageVersion
+		}
+
+		if !maxVersion.IsAtLeast(version) {
+			checker.report(&UnsupportedLanguageVersionError{
+				Version:    version,
+				MaxVersion: maxVersion,
+				Range:      ast.NewRangeFromPositioned(checker.memoryGauge, pragma),
+			})
+			continue
+		}
+
+		checker.languageVersion = version
+	}
+}
+
+// LanguageVersion returns the language version of the checked program.
+//
+func (checker *Checker) LanguageVersion() LanguageVersion {
+	return checker.languageVersion
+}
+
+// languageFeatureEnabled returns true if the given language feature
+// is enabled by the language version of the checked program.
+//
+func (checker *Checker) languageFeatureEnabled(feature LanguageFeature) bool {
+	return checker.languageVersion.IsAtLeast(feature.Version)
+}
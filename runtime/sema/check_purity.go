@@ -0,0 +1,123 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+)
+
+// inViewFunction returns true if the checker is currently checking
+// the body of a view function
+//
+func (checker *Checker) inViewFunction() bool {
+	functionActivation := checker.functionActivations.Current()
+	return functionActivation != nil && functionActivation.IsView()
+}
+
+// reportImpureOperation reports the given impure operation
+// if the checker is currently checking the body of a view function
+//
+func (checker *Checker) reportImpureOperation(operation ImpureOperation, hasPosition ast.HasPosition) {
+	if !checker.inViewFunction() {
+		return
+	}
+
+	checker.report(
+		&PurityError{
+			Operation: operation,
+			Range:     ast.NewRangeFromPositioned(checker.memoryGauge, hasPosition),
+		},
+	)
+}
+
+// checkAssignmentTargetPurity reports an error if the given assignment target
+// refers to state outside of the current view function.
+//
+// Local variables, and values reachable from them without going through a reference,
+// may be written to. Fields of `self` may only be written to in an initializer.
+//
+func (checker *Checker) checkAssignmentTargetPurity(target ast.Expression) {
+	if !checker.inViewFunction() {
+		return
+	}
+
+	if checker.isLocalAssignmentTarget(target) {
+		return
+	}
+
+	checker.reportImpureOperation(ImpureOperationWrite, target)
+}
+
+func (checker *Checker) isLocalAssignmentTarget(target ast.Expression) bool {
+	functionActivation := checker.functionActivations.Current()
+
+	switch target := target.(type) {
+	case *ast.IdentifierExpression:
+		variable := checker.valueActivations.Find(target.Identifier.Identifier)
+		if variable == nil {
+			// An error is already reported for the undeclared variable
+			return true
+		}
+
+		// NOTE: `self` is declared "inside" the function,
+		// but refers to the enclosing composite
+
+		if variable.DeclarationKind == common.DeclarationKindSelf {
+			return false
+		}
+
+		return variable.ActivationDepth > functionActivation.ValueActivationDepth
+
+	case *ast.MemberExpression:
+		// Fields of the composite being initialized may be written to
+
+		if functionActivation.InitializationInfo != nil &&
+			checker.accessedSelfMember(target) != nil {
+
+			return true
+		}
+
+		memberInfo, ok := checker.Elaboration.MemberExpressionMemberInfos[target.ID()]
+		if ok && isReferenceOrOptionalReferenceType(memberInfo.AccessedType) {
+			return false
+		}
+
+		return checker.isLocalAssignmentTarget(target.Expression)
+
+	case *ast.IndexExpression:
+		indexedType, ok := checker.Elaboration.IndexExpressionIndexedTypes[target.ID()]
+		if ok && isReferenceOrOptionalReferenceType(indexedType) {
+			return false
+		}
+
+		return checker.isLocalAssignmentTarget(target.TargetExpression)
+
+	default:
+		return false
+	}
+}
+
+func isReferenceOrOptionalReferenceType(ty Type) bool {
+	if optionalType, ok := ty.(*OptionalType); ok {
+		ty = optionalType.Type
+	}
+	_, ok := ty.(*ReferenceType)
+	return ok
+}
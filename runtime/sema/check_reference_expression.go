@@ -87,7 +87,118 @@ func (checker *Checker) VisitReferenceExpression(referenceExpression *ast.Refere
 		return InvalidType
 	}
 
-	checker.Elaboration.ReferenceExpressionBorrowTypes[referenceExpression] = returnType
+	checker.Elaboration.ReferenceExpressionBorrowTypes[referenceExpression.ID()] = returnType
 
 	return returnType
 }
+
+// recordReferencedResourceVariables records which resource variables
+// are referenced by the given variable, which is assigned the value of the given expression.
+// If the variable does not have a reference type, no resource variables are referenced.
+//
+func (checker *Checker) recordReferencedResourceVariables(variable *Variable, valueExpression ast.Expression) {
+	if _, ok := UnwrapOptionalType(variable.Type).(*ReferenceType); !ok {
+		variable.referencedResourceVariables = nil
+		return
+	}
+
+	variable.referencedResourceVariables = checker.referencedResourceVariables(valueExpression)
+}
+
+// referencedResourceVariables returns the resource variables
+// which are referenced by the result of the given expression
+//
+func (checker *Checker) referencedResourceVariables(expression ast.Expression) []*Variable {
+	switch expression := expression.(type) {
+	case *ast.ReferenceExpression:
+		variable := checker.rootVariable(expression.Expression)
+		if variable == nil || !variable.Type.IsResourceType() {
+			return nil
+		}
+		return []*Variable{variable}
+
+	case *ast.IdentifierExpression:
+		variable := checker.valueActivations.Find(expression.Identifier.Identifier)
+		if variable == nil {
+			return nil
+		}
+		return variable.referencedResourceVariables
+
+	case *ast.ForceExpression:
+		return checker.referencedResourceVariables(expression.Expression)
+
+	case *ast.CastingExpression:
+		return checker.referencedResourceVariables(expression.Expression)
+
+	case *ast.ConditionalExpression:
+		return append(
+			checker.referencedResourceVariables(expression.Then),
+			checker.referencedResourceVariables(expression.Else)...,
+		)
+
+	case *ast.BinaryExpression:
+		if expression.Operation != ast.OperationNilCoalesce {
+			return nil
+		}
+		return append(
+			checker.referencedResourceVariables(expression.Left),
+			checker.referencedResourceVariables(expression.Right)...,
+		)
+	}
+
+	return nil
+}
+
+// rootVariable returns the variable at the root of the given
+// identifier, member access, or index expression, if any
+//
+func (checker *Checker) rootVariable(expression ast.Expression) *Variable {
+	for {
+		switch typedExpression := expression.(type) {
+		case *ast.IdentifierExpression:
+			return checker.valueActivations.Find(typedExpression.Identifier.Identifier)
+
+		case *ast.MemberExpression:
+			expression = typedExpression.Expression
+
+		case *ast.IndexExpression:
+			expression = typedExpression.TargetExpression
+
+		case *ast.ForceExpression:
+			expression = typedExpression.Expression
+
+		default:
+			return nil
+		}
+	}
+}
+
+// checkReferenceValidity checks that the resources referenced by the given variable
+// have not been moved or destroyed
+//
+func (checker *Checker) checkReferenceValidity(variable *Variable, usePosition ast.HasPosition) {
+	for _, referencedVariable := range variable.referencedResourceVariables {
+		resource := Resource{Variable: referencedVariable}
+		resourceInfo := checker.resources.Get(resource)
+		if resourceInfo.Invalidations.Size() == 0 {
+			continue
+		}
+
+		// The same use might be checked multiple times, e.g. in swap statements
+
+		usePos := usePosition.StartPosition()
+		if checker.resources.IsUseAfterInvalidationReported(resource, usePos) {
+			return
+		}
+		checker.resources.MarkUseAfterInvalidationReported(resource, usePos)
+
+		checker.report(
+			&InvalidatedReferenceError{
+				Invalidations: resourceInfo.Invalidations.All(),
+				Range:         ast.NewRangeFromPositioned(checker.memoryGauge, usePosition),
+			},
+		)
+
+		return
+	}
+}
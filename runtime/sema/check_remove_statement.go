@@ -0,0 +1,65 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+)
+
+func (checker *Checker) VisitRemoveStatement(statement *ast.RemoveStatement) ast.Repr {
+
+	valueExpression := statement.Value
+
+	// Removing an attachment destroys it
+
+	checker.reportImpureOperation(ImpureOperationDestroy, statement)
+
+	valueType := checker.VisitExpression(valueExpression, nil)
+
+	checker.checkUnusedExpressionResourceLoss(valueType, valueExpression)
+
+	ty := checker.ConvertType(statement.Attachment)
+
+	if ty.IsInvalidType() || valueType.IsInvalidType() {
+		return nil
+	}
+
+	// Check that the removed type is an attachment declared for the type of the value.
+	// NOTE: attachments cannot be removed through references
+
+	attachmentType, isCompositeType := ty.(*CompositeType)
+	if !isCompositeType ||
+		attachmentType.Kind != common.CompositeKindAttachment ||
+		!checker.isAttachmentBaseType(attachmentType, valueType) {
+
+		checker.report(
+			&InvalidAttachmentRemoveError{
+				Attachment: ty,
+				BaseType:   valueType,
+				Range:      ast.NewRangeFromPositioned(checker.memoryGauge, statement),
+			},
+		)
+		return nil
+	}
+
+	checker.Elaboration.AttachmentRemoveTypes[statement.ID()] = attachmentType
+
+	return nil
+}
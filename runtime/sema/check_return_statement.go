@@ -25,6 +25,7 @@ func (checker *Checker) VisitReturnStatement(statement *ast.ReturnStatement) ast
 
 	defer func() {
 		checker.checkResourceLossForFunction()
+		checker.checkFieldMembersInitializedAtReturn(statement)
 		checker.resources.JumpsOrReturns = true
 		functionActivation.ReturnInfo.MaybeReturned = true
 		functionActivation.ReturnInfo.DefinitelyReturned = true
@@ -55,8 +56,8 @@ func (checker *Checker) VisitReturnStatement(statement *ast.ReturnStatement) ast
 
 	valueType := checker.VisitExpression(statement.Expression, returnType)
 
-	checker.Elaboration.ReturnStatementValueTypes[statement] = valueType
-	checker.Elaboration.ReturnStatementReturnTypes[statement] = returnType
+	checker.Elaboration.ReturnStatementValueTypes[statement.ID()] = valueType
+	checker.Elaboration.ReturnStatementReturnTypes[statement.ID()] = returnType
 
 	if returnType == VoidType {
 		return nil
@@ -73,3 +74,18 @@ func (checker *Checker) checkResourceLossForFunction() {
 		checker.functionActivations.Current().ValueActivationDepth
 	checker.checkResourceLoss(functionValueActivationDepth)
 }
+
+// checkFieldMembersInitializedAtReturn records the fields
+// which are not initialized at the given return statement of an initializer.
+// The uninitialized fields are reported at the end of the initializer.
+//
+func (checker *Checker) checkFieldMembersInitializedAtReturn(statement *ast.ReturnStatement) {
+	initializationInfo := checker.functionActivations.Current().InitializationInfo
+	if initializationInfo == nil {
+		return
+	}
+
+	initializationInfo.recordUninitializedReturn(
+		ast.NewRangeFromPositioned(checker.memoryGauge, statement),
+	)
+}
@@ -0,0 +1,69 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+)
+
+const stringTemplateOperandTypeDescription = "a string-convertible type " +
+	"(string, character, boolean, number, address, or path)"
+
+// VisitStringTemplateExpression checks a string template expression,
+// e.g. `"balance: \(vault.balance)"`.
+//
+// Each interpolated expression must have a string-convertible type,
+// and the result is always a string.
+//
+func (checker *Checker) VisitStringTemplateExpression(expression *ast.StringTemplateExpression) ast.Repr {
+
+	for _, operand := range expression.Expressions {
+		operandType := checker.VisitExpression(operand, nil)
+
+		if operandType.IsInvalidType() || IsStringConvertibleType(operandType) {
+			continue
+		}
+
+		checker.report(
+			&TypeMismatchWithDescriptionError{
+				ExpectedTypeDescription: stringTemplateOperandTypeDescription,
+				ActualType:              operandType,
+				Range:                   ast.NewRangeFromPositioned(checker.memoryGauge, operand),
+			},
+		)
+	}
+
+	return StringType
+}
+
+// IsStringConvertibleType returns true if values of the given type
+// can be converted to a string, e.g. when interpolated in a string template.
+//
+// Strings, characters, and booleans are string-convertible,
+// as well as all types which have a `toString` function,
+// i.e. all number types, addresses, and path types.
+//
+func IsStringConvertibleType(ty Type) bool {
+	return IsSubType(ty, StringType) ||
+		IsSubType(ty, CharacterType) ||
+		IsSubType(ty, BoolType) ||
+		IsSubType(ty, NumberType) ||
+		IsSubType(ty, &AddressType{}) ||
+		IsSubType(ty, PathType)
+}
@@ -20,6 +20,7 @@ package sema
 
 import (
 	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
 )
 
 func (checker *Checker) VisitSwitchStatement(statement *ast.SwitchStatement) ast.Repr {
@@ -28,9 +29,24 @@ func (checker *Checker) VisitSwitchStatement(statement *ast.SwitchStatement) ast
 
 	testTypeIsValid := !testType.IsInvalidType()
 
-	// The test expression must be equatable
+	// The test expression must be equatable,
+	// unless it is only matched against patterns
+
+	hasExpressionCases := false
+	hasPatternCases := false
+	for _, switchCase := range statement.Cases {
+		if switchCase.Expression != nil {
+			hasExpressionCases = true
+		}
+		if switchCase.Pattern != nil {
+			hasPatternCases = true
+		}
+	}
+
+	if testTypeIsValid &&
+		(hasExpressionCases || !hasPatternCases) &&
+		!testType.IsEquatable() {
 
-	if testTypeIsValid && !testType.IsEquatable() {
 		checker.report(
 			&NotEquatableTypeError{
 				Type:  testType,
@@ -49,8 +65,11 @@ func (checker *Checker) VisitSwitchStatement(statement *ast.SwitchStatement) ast
 		checker.visitSwitchCase(switchCase, defaultAllowed, testType, testTypeIsValid)
 	}
 
+	exhaustive := testTypeIsValid &&
+		checker.checkSwitchExhaustiveness(statement, testType)
+
 	checker.functionActivations.WithSwitch(func() {
-		checker.checkSwitchCasesStatements(statement.Cases)
+		checker.checkSwitchCasesStatements(statement, statement.Cases, testType, exhaustive)
 	})
 
 	return nil
@@ -62,11 +81,8 @@ func (checker *Checker) visitSwitchCase(
 	testType Type,
 	testTypeIsValid bool,
 ) {
-	caseExpression := switchCase.Expression
-
-	// If the case has no expression, it is a default case
-
-	if caseExpression == nil {
+	switch {
+	case switchCase.IsDefault():
 
 		// Only one default case is allowed, as the last case
 		if !defaultAllowed {
@@ -76,9 +92,124 @@ func (checker *Checker) visitSwitchCase(
 				},
 			)
 		}
-	} else {
-		checker.checkSwitchCaseExpression(caseExpression, testType, testTypeIsValid)
+
+	case switchCase.Pattern != nil:
+		checker.checkSwitchCasePattern(switchCase.Pattern, testType, testTypeIsValid)
+
+	default:
+		checker.checkSwitchCaseExpression(switchCase.Expression, testType, testTypeIsValid)
+	}
+}
+
+// checkSwitchCasePattern checks an optional binding pattern, e.g. `case let x?:`.
+// The test expression must be a non-resource optional
+//
+func (checker *Checker) checkSwitchCasePattern(
+	pattern *ast.OptionalBindingPattern,
+	testType Type,
+	testTypeIsValid bool,
+) {
+	if !testTypeIsValid {
+		return
+	}
+
+	_, ok := testType.(*OptionalType)
+	if !ok || testType.IsResourceType() {
+		checker.report(
+			&TypeMismatchWithDescriptionError{
+				ExpectedTypeDescription: "non-resource optional type",
+				ActualType:              testType,
+				Range:                   pattern.Range,
+			},
+		)
+	}
+}
+
+// checkSwitchExhaustiveness checks that a switch over an enum value
+// without a default case has a case for each enum case.
+//
+// It returns true if the switch is known to be exhaustive,
+// i.e. if it has a default case or covers all enum cases.
+//
+func (checker *Checker) checkSwitchExhaustiveness(statement *ast.SwitchStatement, testType Type) bool {
+
+	for _, switchCase := range statement.Cases {
+		if switchCase.IsDefault() {
+			return true
+		}
+	}
+
+	enumType, ok := testType.(*CompositeType)
+	if !ok ||
+		enumType.Kind != common.CompositeKindEnum ||
+		len(enumType.EnumCases) == 0 {
+
+		return false
+	}
+
+	coveredCases := map[string]struct{}{}
+
+	for _, switchCase := range statement.Cases {
+		if switchCase.Expression == nil {
+			continue
+		}
+
+		caseName, ok := checker.switchCaseEnumCase(switchCase.Expression, enumType)
+		if ok {
+			coveredCases[caseName] = struct{}{}
+		}
 	}
+
+	var missingCases []string
+
+	for _, caseName := range enumType.EnumCases {
+		if _, ok := coveredCases[caseName]; !ok {
+			missingCases = append(missingCases, caseName)
+		}
+	}
+
+	if len(missingCases) > 0 {
+		checker.report(
+			&MissingSwitchCasesError{
+				Type:         enumType,
+				MissingCases: missingCases,
+				Range:        ast.NewRangeFromPositioned(checker.memoryGauge, statement.Expression),
+			},
+		)
+		return false
+	}
+
+	return true
+}
+
+// switchCaseEnumCase returns the name of the enum case the given case expression refers to,
+// if the expression is an access of an enum case of the given enum type, e.g. `E.a`.
+//
+func (checker *Checker) switchCaseEnumCase(expression ast.Expression, enumType *CompositeType) (string, bool) {
+	memberExpression, ok := expression.(*ast.MemberExpression)
+	if !ok {
+		return "", false
+	}
+
+	memberInfo, ok := checker.Elaboration.MemberExpressionMemberInfos[memberExpression.ID()]
+	if !ok || memberInfo.Member == nil || memberInfo.IsOptional {
+		return "", false
+	}
+
+	member := memberInfo.Member
+
+	// Enum cases are members of the enum's constructor
+
+	constructorType, ok := member.ContainerType.(*FunctionType)
+	if !ok || !constructorType.IsConstructor {
+		return "", false
+	}
+
+	if !member.TypeAnnotation.Type.Equal(enumType) {
+		return "", false
+	}
+
+	return member.Identifier.Identifier, true
 }
 
 func (checker *Checker) checkSwitchCaseExpression(
@@ -125,37 +256,58 @@ func (checker *Checker) checkSwitchCaseExpression(
 	}
 }
 
-func (checker *Checker) checkSwitchCasesStatements(cases []*ast.SwitchCase) {
+func (checker *Checker) checkSwitchCasesStatements(
+	statement *ast.SwitchStatement,
+	cases []*ast.SwitchCase,
+	testType Type,
+	exhaustive bool,
+) {
 	caseCount := len(cases)
 	if caseCount == 0 {
 		return
 	}
 
 	// NOTE: always check blocks as if they're only *potentially* evaluated.
-	// However, the default case's block must be checked directly as the "else",
-	// because if a default case exists, the whole switch statement
+	// However, the last case's block must be checked directly as the "else",
+	// if it is the default case, or if the switch is exhaustive,
+	// because then the whole switch statement
 	// will definitely have one case which will be taken.
 
 	switchCase := cases[0]
 
-	if caseCount == 1 && switchCase.Expression == nil {
-		checker.checkSwitchCaseStatements(switchCase)
+	if caseCount == 1 && (switchCase.IsDefault() || exhaustive) {
+		checker.checkSwitchCaseStatements(switchCase, testType)
 		return
 	}
 
+	// The "else" branch consists of the remaining cases.
+	// If there are none, it is the implicit branch
+	// which is taken when no case matches, i.e. the whole switch statement
+
+	var remainingCasesPosition ast.HasPosition = statement
+	if caseCount > 1 {
+		remainingCasesPosition = ast.NewRange(
+			checker.memoryGauge,
+			cases[1].StartPosition(),
+			cases[caseCount-1].EndPosition(checker.memoryGauge),
+		)
+	}
+
 	_, _ = checker.checkConditionalBranches(
 		func() Type {
-			checker.checkSwitchCaseStatements(switchCase)
+			checker.checkSwitchCaseStatements(switchCase, testType)
 			return nil
 		},
 		func() Type {
-			checker.checkSwitchCasesStatements(cases[1:])
+			checker.checkSwitchCasesStatements(statement, cases[1:], testType, exhaustive)
 			return nil
 		},
+		switchCase,
+		remainingCasesPosition,
 	)
 }
 
-func (checker *Checker) checkSwitchCaseStatements(switchCase *ast.SwitchCase) {
+func (checker *Checker) checkSwitchCaseStatements(switchCase *ast.SwitchCase, testType Type) {
 
 	// Switch-cases must have at least one statement.
 	// This avoids cases that look like implicit fallthrough is assumed.
@@ -169,6 +321,35 @@ func (checker *Checker) checkSwitchCaseStatements(switchCase *ast.SwitchCase) {
 		return
 	}
 
+	// If the case has an optional binding pattern,
+	// declare the unwrapped value in a new scope for the statements
+
+	if pattern := switchCase.Pattern; pattern != nil {
+		checker.enterValueScope()
+		defer checker.leaveValueScope(switchCase.EndPosition, true)
+
+		var valueType Type = InvalidType
+		if optionalType, ok := testType.(*OptionalType); ok && !optionalType.IsResourceType() {
+			valueType = optionalType.Type
+		}
+
+		identifier := pattern.Identifier.Identifier
+
+		variable, err := checker.valueActivations.Declare(variableDeclaration{
+			identifier:               identifier,
+			ty:                       valueType,
+			kind:                     common.DeclarationKindConstant,
+			pos:                      pattern.Identifier.Pos,
+			isConstant:               true,
+			argumentLabels:           nil,
+			allowOuterScopeShadowing: true,
+		})
+		checker.report(err)
+		if checker.positionInfoEnabled {
+			checker.recordVariableDeclarationOccurrence(identifier, variable)
+		}
+	}
+
 	// NOTE: the block ensures that the statements are checked in a new scope
 
 	block := ast.NewBlock(
@@ -25,7 +25,7 @@ import (
 )
 
 func (checker *Checker) VisitTransactionDeclaration(declaration *ast.TransactionDeclaration) ast.Repr {
-	transactionType := checker.Elaboration.TransactionDeclarationTypes[declaration]
+	transactionType := checker.Elaboration.TransactionDeclarationTypes[declaration.ID()]
 	if transactionType == nil {
 		panic(errors.NewUnreachableError())
 	}
@@ -85,6 +85,7 @@ func (checker *Checker) VisitTransactionDeclaration(declaration *ast.Transaction
 func (checker *Checker) checkTransactionParameters(declaration *ast.TransactionDeclaration, parameters []*Parameter) {
 	checker.checkArgumentLabels(declaration.ParameterList)
 	checker.checkParameters(declaration.ParameterList, parameters)
+	checker.checkTransactionDefaultArguments(declaration.ParameterList)
 	checker.declareParameters(declaration.ParameterList, parameters)
 
 	// Check parameter types
@@ -196,6 +197,30 @@ func (checker *Checker) visitTransactionPrepareFunction(
 		prepareFunction.FunctionDeclaration.ParameterList,
 		prepareFunctionType.Parameters,
 	)
+
+	checker.checkTransactionDefaultArguments(prepareFunction.FunctionDeclaration.ParameterList)
+}
+
+// checkTransactionDefaultArguments reports an error for each parameter which has a default argument.
+//
+// The arguments of a transaction and the signing accounts are always provided by the transaction,
+// so default arguments are not supported
+//
+func (checker *Checker) checkTransactionDefaultArguments(parameterList *ast.ParameterList) {
+	for _, parameter := range parameterList.Parameters {
+		defaultArgument := parameter.DefaultArgument
+		if defaultArgument == nil {
+			continue
+		}
+
+		checker.report(
+			&InvalidDefaultArgumentError{
+				Name:   parameter.Identifier.Identifier,
+				Reason: "transaction parameters cannot have a default argument",
+				Range:  ast.NewRangeFromPositioned(checker.memoryGauge, defaultArgument),
+			},
+		)
+	}
 }
 
 // checkTransactionPrepareFunctionParameters checks that the parameters are each of type Account.
@@ -232,6 +257,12 @@ func (checker *Checker) visitTransactionExecuteFunction(
 
 	executeFunctionType := transactionType.ExecuteFunctionType()
 
+	wasInTransactionExecute := checker.inTransactionExecute
+	checker.inTransactionExecute = true
+	defer func() {
+		checker.inTransactionExecute = wasInTransactionExecute
+	}()
+
 	checker.checkFunction(
 		&ast.ParameterList{},
 		nil,
@@ -243,6 +274,75 @@ func (checker *Checker) visitTransactionExecuteFunction(
 	)
 }
 
+// mayContainAuthAccountType returns true if a value of the given type
+// may be or may contain an `AuthAccount`, or a value of one of its nested types,
+// e.g. `AuthAccount.Contracts`.
+//
+// The check is conservative: Values of the types `AnyStruct`, `AnyResource`, and `Any`,
+// of restricted types with such a base type, and of interface types may be accounts,
+// structures and resources may contain accounts in their fields,
+// and functions may capture accounts.
+//
+func mayContainAuthAccountType(ty Type) bool {
+	return mayContainAuthAccountTypeVisited(ty, map[*CompositeType]struct{}{})
+}
+
+func mayContainAuthAccountTypeVisited(ty Type, visited map[*CompositeType]struct{}) bool {
+	switch ty := ty.(type) {
+	case *SimpleType:
+		return ty == AnyStructType ||
+			ty == AnyResourceType ||
+			ty == AnyType
+
+	case *OptionalType:
+		return mayContainAuthAccountTypeVisited(ty.Type, visited)
+
+	case *ReferenceType:
+		return mayContainAuthAccountTypeVisited(ty.Type, visited)
+
+	case ArrayType:
+		return mayContainAuthAccountTypeVisited(ty.ElementType(false), visited)
+
+	case *DictionaryType:
+		return mayContainAuthAccountTypeVisited(ty.ValueType, visited)
+
+	case *RestrictedType:
+		return mayContainAuthAccountTypeVisited(ty.Type, visited)
+
+	case *InterfaceType, *FunctionType:
+		return true
+
+	case *CompositeType:
+		for containerType := ty; containerType != nil; {
+			if containerType == AuthAccountType {
+				return true
+			}
+			containerType, _ = containerType.GetContainerType().(*CompositeType)
+		}
+
+		// Check the fields of the composite, e.g. of a structure wrapping an account.
+		// Recursive composite types do not contain an account through the recursion
+
+		if _, ok := visited[ty]; ok {
+			return false
+		}
+		visited[ty] = struct{}{}
+
+		for _, fieldName := range ty.Fields {
+			field, ok := ty.Members.Get(fieldName)
+			if !ok {
+				continue
+			}
+
+			if mayContainAuthAccountTypeVisited(field.TypeAnnotation.Type, visited) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 func (checker *Checker) declareTransactionDeclaration(declaration *ast.TransactionDeclaration) {
 	transactionType := &TransactionType{}
 
@@ -275,6 +375,6 @@ func (checker *Checker) declareTransactionDeclaration(declaration *ast.Transacti
 		transactionType.PrepareParameters = checker.parameters(parameterList)
 	}
 
-	checker.Elaboration.TransactionDeclarationTypes[declaration] = transactionType
+	checker.Elaboration.TransactionDeclarationTypes[declaration.ID()] = transactionType
 	checker.Elaboration.TransactionTypes = append(checker.Elaboration.TransactionTypes, transactionType)
 }
@@ -0,0 +1,79 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+)
+
+// VisitTryExpression checks a try expression, e.g. `try? f()`.
+//
+// The expression must be an invocation, and the result is the optional of the invocation's result type.
+// Resources cannot be passed to or returned from the invoked function,
+// as a resource moved into a failed call could not be recovered.
+//
+func (checker *Checker) VisitTryExpression(expression *ast.TryExpression) ast.Repr {
+
+	// Expected type of the `expression.Expression` is the type wrapped in the expected optional type, if any.
+	// i.e: if `try? f()` is `String?`, then `f()` is expected to be `String`.
+	var expectedType Type
+	if optionalType, ok := checker.expectedType.(*OptionalType); ok {
+		expectedType = optionalType.Type
+	}
+
+	invocationExpression, ok := expression.Expression.(*ast.InvocationExpression)
+	if !ok {
+		checker.report(
+			&InvalidTryExpressionError{
+				Range: ast.NewRangeFromPositioned(checker.memoryGauge, expression.Expression),
+			},
+		)
+
+		checker.VisitExpression(expression.Expression, expectedType)
+
+		return InvalidType
+	}
+
+	valueType := checker.VisitExpression(invocationExpression, expectedType)
+
+	if valueType.IsInvalidType() {
+		return valueType
+	}
+
+	involvesResource := valueType.IsResourceType()
+
+	for _, argumentType := range checker.Elaboration.InvocationExpressionArgumentTypes[invocationExpression.ID()] {
+		if argumentType.IsResourceType() {
+			involvesResource = true
+			break
+		}
+	}
+
+	if involvesResource {
+		checker.report(
+			&InvalidTryResourceError{
+				Range: ast.NewRangeFromPositioned(checker.memoryGauge, invocationExpression),
+			},
+		)
+	}
+
+	return &OptionalType{
+		Type: valueType,
+	}
+}
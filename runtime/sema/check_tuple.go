@@ -0,0 +1,226 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+)
+
+func (checker *Checker) VisitTupleExpression(expression *ast.TupleExpression) ast.Repr {
+
+	// If a tuple type with the same number of elements is expected,
+	// then expect the elements to have the corresponding element types.
+	// Otherwise, infer the type from the elements.
+
+	var expectedElementTypes []Type
+
+	expectedType := UnwrapOptionalType(checker.expectedType)
+	if expectedTupleType, ok := expectedType.(*TupleType); ok &&
+		len(expectedTupleType.ElementTypes) == len(expression.Elements) {
+
+		expectedElementTypes = expectedTupleType.ElementTypes
+	}
+
+	argumentTypes := make([]Type, len(expression.Elements))
+
+	for i, element := range expression.Elements {
+		var expectedElementType Type
+		if expectedElementTypes != nil {
+			expectedElementType = expectedElementTypes[i]
+		}
+
+		elementType := checker.VisitExpression(element, expectedElementType)
+
+		argumentTypes[i] = elementType
+
+		checker.checkVariableMove(element)
+		checker.checkResourceMoveOperation(element, elementType)
+	}
+
+	checker.Elaboration.TupleExpressionArgumentTypes[expression.ID()] = argumentTypes
+
+	var tupleType *TupleType
+	if expectedElementTypes != nil {
+		tupleType = NewTupleType(checker.memoryGauge, expectedElementTypes)
+	} else {
+		tupleType = NewTupleType(checker.memoryGauge, argumentTypes)
+	}
+
+	checker.Elaboration.TupleExpressionTupleType[expression.ID()] = tupleType
+
+	return tupleType
+}
+
+// checkTupleElementAccess checks an index expression which accesses an element of a tuple, e.g. `t[0]`.
+//
+// Tuples are heterogeneous, so the index must be known statically,
+// i.e. it must be an integer literal, and it must be in bounds.
+//
+func (checker *Checker) checkTupleElementAccess(
+	indexExpression *ast.IndexExpression,
+	tupleType *TupleType,
+	isAssignment bool,
+) Type {
+
+	targetExpression := indexExpression.TargetExpression
+
+	if isAssignment {
+		checker.report(
+			&NotIndexingAssignableTypeError{
+				Type:  tupleType,
+				Range: ast.NewRangeFromPositioned(checker.memoryGauge, targetExpression),
+			},
+		)
+	}
+
+	integerExpression, ok := indexExpression.IndexingExpression.(*ast.IntegerExpression)
+	if !ok {
+		checker.VisitExpression(indexExpression.IndexingExpression, nil)
+
+		checker.report(
+			&InvalidTupleIndexError{
+				Range: ast.NewRangeFromPositioned(checker.memoryGauge, indexExpression.IndexingExpression),
+			},
+		)
+
+		return InvalidType
+	}
+
+	index := integerExpression.Value
+	length := len(tupleType.ElementTypes)
+
+	if !index.IsInt64() || index.Sign() < 0 || index.Int64() >= int64(length) {
+		checker.report(
+			&TupleIndexOutOfBoundsError{
+				Index:  index,
+				Length: length,
+				Range:  ast.NewRangeFromPositioned(checker.memoryGauge, integerExpression),
+			},
+		)
+
+		return InvalidType
+	}
+
+	elementType := tupleType.ElementTypes[index.Int64()]
+
+	checker.checkUnusedExpressionResourceLoss(elementType, targetExpression)
+
+	checker.Elaboration.TupleElementAccessIndices[indexExpression.ID()] = int(index.Int64())
+
+	return elementType
+}
+
+func (checker *Checker) VisitTupleVariableDeclaration(declaration *ast.TupleVariableDeclaration) ast.Repr {
+
+	// Determine the type of the value of the declaration
+	// and save it in the elaboration
+
+	var declarationType Type
+
+	if declaration.TypeAnnotation != nil {
+		typeAnnotation := checker.ConvertTypeAnnotation(declaration.TypeAnnotation)
+		checker.checkTypeAnnotation(typeAnnotation, declaration.TypeAnnotation)
+		declarationType = typeAnnotation.Type
+	}
+
+	valueType := checker.VisitExpression(declaration.Value, declarationType)
+
+	checker.Elaboration.TupleVariableDeclarationValueTypes[declaration.ID()] = valueType
+
+	if declarationType == nil {
+		declarationType = valueType
+	}
+
+	checker.checkTransfer(declaration.Transfer, declarationType)
+
+	checker.checkVariableMove(declaration.Value)
+
+	// The value is destructured, so it is invalidated (if it has a resource type)
+
+	checker.recordResourceInvalidation(
+		declaration.Value,
+		declarationType,
+		ResourceInvalidationKindMoveDefinite,
+	)
+
+	// Determine the types of the declared variables:
+	// The value must be a tuple with exactly one element per declared variable
+
+	identifierCount := len(declaration.Identifiers)
+
+	elementTypes := make([]Type, identifierCount)
+	for i := range elementTypes {
+		elementTypes[i] = InvalidType
+	}
+
+	switch tupleType := declarationType.(type) {
+	case *TupleType:
+		elementCount := len(tupleType.ElementTypes)
+		if elementCount == identifierCount {
+			copy(elementTypes, tupleType.ElementTypes)
+			checker.Elaboration.TupleVariableDeclarationTargetTypes[declaration.ID()] = tupleType
+		} else {
+			checker.report(
+				&TupleDestructuringCountMismatchError{
+					ExpectedCount: elementCount,
+					ActualCount:   identifierCount,
+					Range: ast.NewRange(
+						checker.memoryGauge,
+						declaration.Identifiers[0].StartPosition(),
+						declaration.Identifiers[identifierCount-1].EndPosition(checker.memoryGauge),
+					),
+				},
+			)
+		}
+
+	default:
+		if !declarationType.IsInvalidType() {
+			checker.report(
+				&TypeMismatchWithDescriptionError{
+					ExpectedTypeDescription: "tuple type",
+					ActualType:              declarationType,
+					Range:                   ast.NewRangeFromPositioned(checker.memoryGauge, declaration.Value),
+				},
+			)
+		}
+	}
+
+	// Finally, declare the variables in the current value activation
+
+	for i, identifier := range declaration.Identifiers {
+
+		variable, err := checker.valueActivations.Declare(variableDeclaration{
+			identifier:               identifier.Identifier,
+			ty:                       elementTypes[i],
+			access:                   ast.AccessNotSpecified,
+			kind:                     declaration.DeclarationKind(),
+			pos:                      identifier.Pos,
+			isConstant:               declaration.IsConstant,
+			argumentLabels:           nil,
+			allowOuterScopeShadowing: true,
+		})
+		checker.report(err)
+
+		if checker.positionInfoEnabled {
+			checker.recordVariableDeclarationOccurrence(identifier.Identifier, variable)
+		}
+	}
+
+	return nil
+}
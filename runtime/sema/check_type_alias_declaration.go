@@ -0,0 +1,331 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+)
+
+// VisitTypeAliasDeclaration checks a previously declared type alias declaration.
+//
+// NOTE: This function assumes that the type alias was previously declared using
+// `declareTypeAliasDeclaration`, i.e. the aliased type was already resolved.
+//
+func (checker *Checker) VisitTypeAliasDeclaration(declaration *ast.TypeAliasDeclaration) ast.Repr {
+
+	checker.checkDeclarationAccessModifier(
+		declaration.Access,
+		declaration.DeclarationKind(),
+		declaration.StartPos,
+		true,
+	)
+
+	return nil
+}
+
+// declareTypeAliasDeclaration resolves the type aliased by the given type alias declaration,
+// records it in the elaboration, and declares the type alias in the current type activation.
+//
+// The type alias is transparent: it is declared as the aliased type itself,
+// so the type alias and the aliased type are indistinguishable, e.g. have the same type ID.
+//
+func (checker *Checker) declareTypeAliasDeclaration(
+	declaration *ast.TypeAliasDeclaration,
+	allowOuterScopeShadowing bool,
+) Type {
+
+	// NOTE: convert the aliased type before declaring the type alias,
+	// so the type alias cannot refer to itself
+
+	ty := checker.ConvertType(declaration.Type)
+
+	checker.Elaboration.TypeAliasDeclarationTypes[declaration.ID()] = ty
+
+	identifier := declaration.Identifier
+
+	variable, err := checker.typeActivations.DeclareType(typeDeclaration{
+		identifier:               identifier,
+		ty:                       ty,
+		declarationKind:          declaration.DeclarationKind(),
+		access:                   declaration.Access,
+		docString:                declaration.DocString,
+		allowOuterScopeShadowing: allowOuterScopeShadowing,
+	})
+	checker.report(err)
+
+	if checker.positionInfoEnabled {
+		checker.recordVariableDeclarationOccurrence(
+			identifier.Identifier,
+			variable,
+		)
+	}
+
+	return ty
+}
+
+// orderTypeAliasDeclarations returns the order in which the given type alias declarations,
+// which are all declared in the same scope, should be declared.
+//
+// Lazy type alias resolution is enabled by the checker option,
+// or by declaring a language version which supports the feature.
+//
+// If lazy type alias resolution is disabled (the default), the declarations are returned as-is,
+// i.e. type aliases are declared in source order and may only refer to type aliases declared before them.
+//
+// If lazy type alias resolution is enabled, the declarations are ordered by their dependencies,
+// so a type alias may also refer to a type alias declared after it.
+// Cyclic type alias declarations are reported.
+//
+func (checker *Checker) orderTypeAliasDeclarations(
+	declarations []*ast.TypeAliasDeclaration,
+) []*ast.TypeAliasDeclaration {
+
+	if !checker.lazyTypeAliasResolutionEnabled &&
+		!checker.languageFeatureEnabled(LanguageFeatureLazyTypeAliasResolution) {
+
+		return declarations
+	}
+
+	// Duplicate declarations are reported when declaring the type aliases,
+	// only consider the first declaration for each identifier
+
+	declarationsByIdentifier := make(map[string]*ast.TypeAliasDeclaration, len(declarations))
+	for _, declaration := range declarations {
+		identifier := declaration.Identifier.Identifier
+		if _, ok := declarationsByIdentifier[identifier]; ok {
+			continue
+		}
+		declarationsByIdentifier[identifier] = declaration
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	states := make(map[*ast.TypeAliasDeclaration]int, len(declarations))
+	orderedDeclarations := make([]*ast.TypeAliasDeclaration, 0, len(declarations))
+	var path []*ast.TypeAliasDeclaration
+
+	var visit func(declaration *ast.TypeAliasDeclaration)
+	visit = func(declaration *ast.TypeAliasDeclaration) {
+		states[declaration] = visiting
+		path = append(path, declaration)
+
+		for _, identifier := range typeAliasReferencedIdentifiers(declaration.Type) {
+			dependency, ok := declarationsByIdentifier[identifier.Identifier]
+			if !ok {
+				continue
+			}
+
+			switch states[dependency] {
+			case unvisited:
+				visit(dependency)
+
+			case visiting:
+				checker.reportCyclicTypeAlias(path, dependency, identifier)
+			}
+		}
+
+		path = path[:len(path)-1]
+		states[declaration] = visited
+		orderedDeclarations = append(orderedDeclarations, declaration)
+	}
+
+	for _, declaration := range declarations {
+		if states[declaration] != unvisited {
+			continue
+		}
+		visit(declaration)
+	}
+
+	return orderedDeclarations
+}
+
+// reportCyclicTypeAlias reports a cyclic type alias error for the cycle
+// formed by the given path of type alias declarations, which ends in a reference to the given dependency.
+//
+func (checker *Checker) reportCyclicTypeAlias(
+	path []*ast.TypeAliasDeclaration,
+	dependency *ast.TypeAliasDeclaration,
+	reference ast.Identifier,
+) {
+	start := 0
+	for i, declaration := range path {
+		if declaration == dependency {
+			start = i
+			break
+		}
+	}
+
+	cycle := make([]string, 0, len(path)-start+1)
+	for _, declaration := range path[start:] {
+		cycle = append(cycle, declaration.Identifier.Identifier)
+	}
+	cycle = append(cycle, dependency.Identifier.Identifier)
+
+	checker.report(
+		&CyclicTypeAliasError{
+			Cycle: cycle,
+			Range: ast.NewRangeFromPositioned(checker.memoryGauge, reference),
+		},
+	)
+}
+
+// typeAliasReferencedIdentifiers returns the identifiers of the nominal types
+// the given type refers to, e.g. `A` for the type `{String: A.B}`.
+//
+func typeAliasReferencedIdentifiers(ty ast.Type) (identifiers []ast.Identifier) {
+
+	var visit func(ty ast.Type)
+	visitAnnotation := func(annotation *ast.TypeAnnotation) {
+		if annotation == nil {
+			return
+		}
+		visit(annotation.Type)
+	}
+
+	visit = func(ty ast.Type) {
+		switch ty := ty.(type) {
+		case *ast.NominalType:
+			identifiers = append(identifiers, ty.Identifier)
+
+		case *ast.OptionalType:
+			visit(ty.Type)
+
+		case *ast.VariableSizedType:
+			visit(ty.Type)
+
+		case *ast.ConstantSizedType:
+			visit(ty.Type)
+
+		case *ast.DictionaryType:
+			visit(ty.KeyType)
+			visit(ty.ValueType)
+
+		case *ast.FunctionType:
+			for _, parameterTypeAnnotation := range ty.ParameterTypeAnnotations {
+				visitAnnotation(parameterTypeAnnotation)
+			}
+			visitAnnotation(ty.ReturnTypeAnnotation)
+
+		case *ast.ReferenceType:
+			visit(ty.Type)
+
+		case *ast.RestrictedType:
+			if ty.Type != nil {
+				visit(ty.Type)
+			}
+			for _, restriction := range ty.Restrictions {
+				visit(restriction)
+			}
+
+		case *ast.InstantiationType:
+			visit(ty.Type)
+			for _, typeArgument := range ty.TypeArguments {
+				visitAnnotation(typeArgument)
+			}
+		}
+	}
+
+	visit(ty)
+
+	return
+}
+
+// declareCompositeTypeAliases declares the type aliases nested in the given composite declaration,
+// and records them in the composite type, so they can be referred to from outside of the composite,
+// e.g. `C.A` for a type alias `A` declared in the contract `C`.
+//
+// Only contracts support nested type aliases, see `checkNestedTypeAliases`.
+//
+// NOTE: This function assumes that the composite type was previously declared using
+// `declareCompositeType` and exists in `checker.Elaboration.CompositeDeclarationTypes`.
+//
+func (checker *Checker) declareCompositeTypeAliases(declaration *ast.CompositeDeclaration) {
+
+	if declaration.CompositeKind != common.CompositeKindContract {
+		return
+	}
+
+	compositeType := checker.Elaboration.CompositeDeclarationTypes[declaration.ID()]
+	compositeType.typeAliases = &StringTypeOrderedMap{}
+
+	// Activate new scope for nested types
+
+	checker.typeActivations.Enter()
+	defer checker.typeActivations.Leave(declaration.EndPosition)
+
+	checker.declareCompositeNestedTypes(declaration, ContainerKindComposite, false)
+
+	typeAliasDeclarations := checker.orderTypeAliasDeclarations(declaration.Members.TypeAliases())
+
+	for _, typeAliasDeclaration := range typeAliasDeclarations {
+
+		name := typeAliasDeclaration.Identifier.Identifier
+
+		// Duplicate nested declarations are reported in `checkNestedIdentifiers`
+
+		if _, ok := compositeType.nestedTypes.Get(name); ok {
+			continue
+		}
+
+		if _, ok := compositeType.typeAliases.Get(name); ok {
+			continue
+		}
+
+		// NOTE: We allow the shadowing of types here, like for other nested types
+
+		ty := checker.declareTypeAliasDeclaration(typeAliasDeclaration, true)
+
+		compositeType.typeAliases.Set(name, ty)
+	}
+}
+
+// checkNestedTypeAliases reports an error if the given members of a declaration
+// contain type alias declarations, which are only supported in contracts.
+//
+func (checker *Checker) checkNestedTypeAliases(
+	members *ast.Members,
+	containerDeclarationKind common.DeclarationKind,
+) {
+	if containerDeclarationKind == common.DeclarationKindContract {
+		return
+	}
+
+	typeAliasDeclarations := members.TypeAliases()
+	if len(typeAliasDeclarations) == 0 {
+		return
+	}
+
+	firstTypeAliasDeclaration := typeAliasDeclarations[0]
+
+	checker.report(
+		&InvalidNestedDeclarationError{
+			NestedDeclarationKind:    firstTypeAliasDeclaration.DeclarationKind(),
+			ContainerDeclarationKind: containerDeclarationKind,
+			Range: ast.NewRangeFromPositioned(
+				checker.memoryGauge,
+				firstTypeAliasDeclaration.Identifier,
+			),
+		},
+	)
+}
@@ -83,6 +83,9 @@ func (checker *Checker) VisitUnaryExpression(expression *ast.UnaryExpression) as
 		)
 
 		return valueType
+
+	case ast.OperationMul:
+		return checker.checkDereference(expression, valueType)
 	}
 
 	panic(&unsupportedOperation{
@@ -91,3 +94,38 @@ func (checker *Checker) VisitUnaryExpression(expression *ast.UnaryExpression) as
 		Range:     ast.NewRangeFromPositioned(checker.memoryGauge, expression),
 	})
 }
+
+// checkDereference checks a dereference of the given type,
+// which must be a reference to a non-resource type,
+// and returns the type of the referenced value, which is copied
+//
+func (checker *Checker) checkDereference(expression *ast.UnaryExpression, valueType Type) Type {
+	if valueType.IsInvalidType() {
+		return InvalidType
+	}
+
+	referenceType, ok := valueType.(*ReferenceType)
+	if !ok {
+		checker.report(
+			&NonReferenceTypeDereferenceError{
+				ActualType: valueType,
+				Range:      ast.NewRangeFromPositioned(checker.memoryGauge, expression.Expression),
+			},
+		)
+		return InvalidType
+	}
+
+	referencedType := referenceType.Type
+
+	if referencedType.IsResourceType() {
+		checker.report(
+			&ResourceDereferenceError{
+				ReferencedType: referencedType,
+				Range:          ast.NewRangeFromPositioned(checker.memoryGauge, expression),
+			},
+		)
+		return InvalidType
+	}
+
+	return referencedType
+}
@@ -61,7 +61,7 @@ func (checker *Checker) visitVariableDeclaration(declaration *ast.VariableDeclar
 
 	valueType := checker.VisitExpression(declaration.Value, expectedValueType)
 
-	checker.Elaboration.VariableDeclarationValueTypes[declaration] = valueType
+	checker.Elaboration.VariableDeclarationValueTypes[declaration.ID()] = valueType
 
 	if isOptionalBinding {
 		optionalType, isOptional := valueType.(*OptionalType)
@@ -85,7 +85,7 @@ func (checker *Checker) visitVariableDeclaration(declaration *ast.VariableDeclar
 		declarationType = valueType
 	}
 
-	checker.Elaboration.VariableDeclarationTargetTypes[declaration] = declarationType
+	checker.Elaboration.VariableDeclarationTargetTypes[declaration.ID()] = declarationType
 
 	checker.checkTransfer(declaration.Transfer, declarationType)
 
@@ -165,7 +165,7 @@ func (checker *Checker) visitVariableDeclaration(declaration *ast.VariableDeclar
 				true,
 			)
 
-			checker.Elaboration.VariableDeclarationSecondValueTypes[declaration] = secondValueType
+			checker.Elaboration.VariableDeclarationSecondValueTypes[declaration.ID()] = secondValueType
 
 			if valueIsResource {
 				checker.elaborateNestedResourceMoveExpression(declaration.Value)
@@ -190,6 +190,10 @@ func (checker *Checker) visitVariableDeclaration(declaration *ast.VariableDeclar
 	})
 	checker.report(err)
 
+	if variable != nil {
+		checker.recordReferencedResourceVariables(variable, declaration.Value)
+	}
+
 	if checker.positionInfoEnabled {
 		checker.recordVariableDeclarationOccurrence(identifier, variable)
 		checker.recordVariableDeclarationRange(declaration, identifier, declarationType)
@@ -236,6 +240,6 @@ func (checker *Checker) recordVariableDeclarationRange(
 func (checker *Checker) elaborateNestedResourceMoveExpression(expression ast.Expression) {
 	switch expression.(type) {
 	case *ast.IndexExpression, *ast.MemberExpression:
-		checker.Elaboration.IsNestedResourceMoveExpression[expression] = struct{}{}
+		checker.Elaboration.IsNestedResourceMoveExpression[expression.ID()] = struct{}{}
 	}
 }
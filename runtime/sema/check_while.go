@@ -32,7 +32,7 @@ func (checker *Checker) VisitWhileStatement(statement *ast.WhileStatement) ast.R
 	// returns are not definite, but only potential.
 
 	_ = checker.checkPotentiallyUnevaluated(func() Type {
-		checker.functionActivations.WithLoop(func() {
+		checker.withLoop(statement.Label, func() {
 			statement.Block.Accept(checker)
 		})
 
@@ -45,6 +45,53 @@ func (checker *Checker) VisitWhileStatement(statement *ast.WhileStatement) ast.R
 	return nil
 }
 
+// withLoop checks the body of a loop statement, given as a function,
+// and makes the optional label of the loop available to the body.
+// It reports an error if an enclosing loop already has the same label.
+//
+func (checker *Checker) withLoop(label *ast.Identifier, f func()) {
+	if label != nil {
+		functionActivation := checker.functionActivations.Current()
+		previousLabel := functionActivation.LoopLabel(label.Identifier)
+		if previousLabel != nil {
+			checker.report(
+				&RedeclarationError{
+					Kind:        common.DeclarationKindLabel,
+					Name:        label.Identifier,
+					Pos:         label.Pos,
+					PreviousPos: &previousLabel.Pos,
+				},
+			)
+		}
+	}
+
+	checker.functionActivations.WithLoop(label, f)
+}
+
+// checkControlStatementLabel checks that the optional label of a break or continue statement
+// refers to an enclosing loop.
+// It returns false if the label is not declared.
+//
+func (checker *Checker) checkControlStatementLabel(label *ast.Identifier) bool {
+	if label == nil {
+		return true
+	}
+
+	if checker.functionActivations.Current().LoopLabel(label.Identifier) != nil {
+		return true
+	}
+
+	checker.report(
+		&NotDeclaredError{
+			ExpectedKind: common.DeclarationKindLabel,
+			Name:         label.Identifier,
+			Pos:          label.Pos,
+		},
+	)
+
+	return false
+}
+
 func (checker *Checker) reportResourceUsesInLoop(startPos, endPos ast.Position) {
 
 	checker.resources.ForEach(func(resource Resource, info ResourceInfo) {
@@ -101,7 +148,12 @@ func (checker *Checker) reportResourceUsesInLoop(startPos, endPos ast.Position)
 
 func (checker *Checker) VisitBreakStatement(statement *ast.BreakStatement) ast.Repr {
 
-	// Ensure that the `break` statement is inside a loop or switch statement
+	// Ensure that the `break` statement is inside a loop or switch statement,
+	// and that its label, if any, refers to an enclosing loop
+
+	if !checker.checkControlStatementLabel(statement.Label) {
+		return nil
+	}
 
 	if !(checker.inLoop() || checker.inSwitch()) {
 		checker.report(
@@ -122,7 +174,12 @@ func (checker *Checker) VisitBreakStatement(statement *ast.BreakStatement) ast.R
 
 func (checker *Checker) VisitContinueStatement(statement *ast.ContinueStatement) ast.Repr {
 
-	// Ensure that the `continue` statement is inside a loop statement
+	// Ensure that the `continue` statement is inside a loop statement,
+	// and that its label, if any, refers to an enclosing loop
+
+	if !checker.checkControlStatementLabel(statement.Label) {
+		return nil
+	}
 
 	if !checker.inLoop() {
 		checker.report(
@@ -32,6 +32,7 @@ import (
 
 const ArgumentLabelNotRequired = "_"
 const SelfIdentifier = "self"
+const BaseIdentifier = "base"
 const BeforeIdentifier = "before"
 const ResultIdentifier = "result"
 
@@ -49,6 +50,7 @@ var beforeType = func() *FunctionType {
 	)
 
 	return &FunctionType{
+		Purity: FunctionPurityView,
 		TypeParameters: []*TypeParameter{
 			typeParameter,
 		},
@@ -87,6 +89,7 @@ type Checker struct {
 	PredeclaredTypes                   []TypeDeclaration
 	accessCheckMode                    AccessCheckMode
 	errors                             []error
+	warnings                           []Warning
 	valueActivations                   *VariableActivations
 	resources                          *Resources
 	typeActivations                    *VariableActivations
@@ -97,18 +100,23 @@ type Checker struct {
 	Occurrences                        *Occurrences
 	variableOrigins                    map[*Variable]*Origin
 	memberOrigins                      map[Type]map[string]*Origin
+	memberFunctionTypes                map[*ast.FunctionDeclaration]*FunctionType
+	memberConformances                 map[compositeInterfacePair]memberConformance
 	MemberAccesses                     *MemberAccesses
 	Ranges                             *Ranges
+	importedLocations                  []importedLocation
 	FunctionInvocations                *FunctionInvocations
 	isChecked                          bool
 	inCreate                           bool
 	inInvocation                       bool
 	inAssignment                       bool
+	inTransactionExecute               bool
 	allowSelfResourceFieldInvalidation bool
 	Elaboration                        *Elaboration
 	currentMemberExpression            *ast.MemberExpression
 	validTopLevelDeclarationsHandler   ValidTopLevelDeclarationsHandlerFunc
 	beforeExtractor                    *BeforeExtractor
+	nodeIDs                            *ast.NodeIDGenerator
 	locationHandler                    LocationHandlerFunc
 	importHandler                      ImportHandlerFunc
 	checkHandler                       CheckHandlerFunc
@@ -116,8 +124,33 @@ type Checker struct {
 	memberAccountAccessHandler         MemberAccountAccessHandlerFunc
 	extendedElaboration                bool
 	errorShortCircuitingEnabled        bool
+	warningsEnabled                    bool
+	lazyTypeAliasResolutionEnabled     bool
+	maxTypeNestingDepth                int
+	maxRestrictionCount                int
+	maxParameterCount                  int
+	maxRecursionDepth                  int
+	typeNestingDepth                   int
+	recursionDepth                     int
+	recursionDepthLimitReached         bool
+	// maxLanguageVersion is the maximum language version programs may declare.
+	// The zero value means the latest language version
+	maxLanguageVersion LanguageVersion
+	// languageVersion is the language version of the checked program
+	languageVersion LanguageVersion
+	// importingChecker is the checker which created this checker
+	// as a sub-checker to check an imported program, if any
+	importingChecker *Checker
 	// memoryGauge is used for metering memory usage
 	memoryGauge common.MemoryGauge
+	// addressValidator is used to validate address literals, if any
+	addressValidator common.AddressValidator
+	// readOnlyProgramCheckEnabled determines if statically detectable
+	// state mutations, e.g. saving to storage, are rejected
+	readOnlyProgramCheckEnabled bool
+	// transactionExecuteAuthAccountCheckEnabled determines if the execute block of a transaction
+	// is rejected to access fields of the transaction which may contain the authorizers' accounts
+	transactionExecuteAuthAccountCheckEnabled bool
 }
 
 type Option func(*Checker) error
@@ -144,7 +177,9 @@ func WithPredeclaredTypes(predeclaredTypes []TypeDeclaration) Option {
 		checker.PredeclaredTypes = predeclaredTypes
 
 		for _, declaration := range predeclaredTypes {
-			checker.declareTypeDeclaration(declaration)
+			if !checker.declareTypeDeclaration(declaration) {
+				continue
+			}
 
 			name := declaration.TypeDeclarationName()
 			checker.Elaboration.EffectivePredeclaredTypes[name] = declaration
@@ -154,6 +189,16 @@ func WithPredeclaredTypes(predeclaredTypes []TypeDeclaration) Option {
 	}
 }
 
+// WithAddressValidator returns a checker option which sets
+// the given function as the function used to validate address literals.
+//
+func WithAddressValidator(validator common.AddressValidator) Option {
+	return func(checker *Checker) error {
+		checker.addressValidator = validator
+		return nil
+	}
+}
+
 // WithAccessCheckMode returns a checker option which sets
 // the given mode for access control checks.
 //
@@ -220,7 +265,8 @@ func WithMemberAccountAccessHandler(handler MemberAccountAccessHandlerFunc) Opti
 // WithPositionInfoEnabled returns a checker option which enables/disables
 // if position info recoding is enabled.
 //
-// Position info includes origins, occurrences, member accesses, and ranges.
+// Position info includes origins, occurrences, member accesses, ranges,
+// and the types of expressions and identifiers (see Elaboration.TypeAtPosition).
 //
 func WithPositionInfoEnabled(enabled bool) Option {
 	return func(checker *Checker) error {
@@ -232,6 +278,7 @@ func WithPositionInfoEnabled(enabled bool) Option {
 			checker.MemberAccesses = NewMemberAccesses()
 			checker.Ranges = NewRanges()
 			checker.FunctionInvocations = NewFunctionInvocations()
+			checker.Elaboration.PositionTypes = NewPositionTypes()
 		}
 
 		return nil
@@ -250,6 +297,115 @@ func WithErrorShortCircuitingEnabled(enabled bool) Option {
 	}
 }
 
+// WithWarningsEnabled returns a checker option which enables/disables
+// the reporting of warnings in the checker.
+// When enabled, soft issues which do not cause checking to fail,
+// e.g. the use of deprecated members, are reported as warnings,
+// which can be retrieved using Checker.Warnings.
+// When disabled (the default), these issues are not reported.
+//
+func WithWarningsEnabled(enabled bool) Option {
+	return func(checker *Checker) error {
+		checker.warningsEnabled = enabled
+		return nil
+	}
+}
+
+// WithLazyTypeAliasResolutionEnabled returns a checker option which enables/disables
+// lazy resolution of type alias declarations.
+// When enabled, type aliases may refer to type aliases declared later in the same scope,
+// and cyclic type alias declarations are reported.
+// When disabled (the default), type aliases may only refer to type aliases declared before them.
+//
+func WithLazyTypeAliasResolutionEnabled(enabled bool) Option {
+	return func(checker *Checker) error {
+		checker.lazyTypeAliasResolutionEnabled = enabled
+		return nil
+	}
+}
+
+// WithMaxTypeNestingDepth returns a checker option which limits
+// how deeply nested a type may be, e.g. `[[Int]]` has a nesting depth of 3.
+// A limit of 0 (the default) means the nesting depth is unlimited.
+//
+func WithMaxTypeNestingDepth(limit int) Option {
+	return func(checker *Checker) error {
+		checker.maxTypeNestingDepth = limit
+		return nil
+	}
+}
+
+// WithMaxRestrictionCount returns a checker option which limits
+// the number of restrictions of a restricted type.
+// A limit of 0 (the default) means the number of restrictions is unlimited.
+//
+func WithMaxRestrictionCount(limit int) Option {
+	return func(checker *Checker) error {
+		checker.maxRestrictionCount = limit
+		return nil
+	}
+}
+
+// WithMaxParameterCount returns a checker option which limits
+// the number of parameters of a function type or function declaration.
+// A limit of 0 (the default) means the number of parameters is unlimited.
+//
+func WithMaxParameterCount(limit int) Option {
+	return func(checker *Checker) error {
+		checker.maxParameterCount = limit
+		return nil
+	}
+}
+
+// WithMaxRecursionDepth returns a checker option which limits
+// how deeply nested the statements and expressions of a program may be
+// when they are checked.
+// A limit of 0 (the default) means the recursion depth is unlimited.
+//
+func WithMaxRecursionDepth(limit int) Option {
+	return func(checker *Checker) error {
+		checker.maxRecursionDepth = limit
+		return nil
+	}
+}
+
+// WithMaxLanguageVersion returns a checker option which restricts
+// the language version programs may declare using the `#cadence(version: "...")` pragma.
+// By default, programs may declare any version up to the latest language version.
+//
+func WithMaxLanguageVersion(version LanguageVersion) Option {
+	return func(checker *Checker) error {
+		checker.maxLanguageVersion = version
+		return nil
+	}
+}
+
+// WithReadOnlyProgramCheckEnabled returns a checker option which enables/disables
+// the rejection of statically detectable state mutations,
+// e.g. calls of the storage functions `save`, `load`, `link`, and `unlink` of `AuthAccount`,
+// of the contract functions `add`, `update__experimental`, and `remove` of `AuthAccount.Contracts`,
+// or of the key, inbox, and capability functions which modify the account.
+// This is intended for programs which must not modify state, like scripts.
+// Only the checked program itself is affected, imported programs are checked as usual.
+//
+func WithReadOnlyProgramCheckEnabled(enabled bool) Option {
+	return func(checker *Checker) error {
+		checker.readOnlyProgramCheckEnabled = enabled
+		return nil
+	}
+}
+
+// WithTransactionExecuteAuthAccountCheckEnabled returns a checker option which enables/disables
+// the rejection of accesses to fields of a transaction in its execute block,
+// if the fields may contain the authorizers' accounts, e.g. if they were stored in the prepare block.
+//
+func WithTransactionExecuteAuthAccountCheckEnabled(enabled bool) Option {
+	return func(checker *Checker) error {
+		checker.transactionExecuteAuthAccountCheckEnabled = enabled
+		return nil
+	}
+}
+
 func NewChecker(program *ast.Program, location common.Location, memoryGauge common.MemoryGauge, extendedElaboration bool, options ...Option) (*Checker, error) {
 
 	if location == nil {
@@ -272,9 +428,12 @@ func NewChecker(program *ast.Program, location common.Location, memoryGauge comm
 		typeActivations:     typeActivations,
 		functionActivations: functionActivations,
 		containerTypes:      map[Type]bool{},
+		memberFunctionTypes: map[*ast.FunctionDeclaration]*FunctionType{},
+		memberConformances:  map[compositeInterfacePair]memberConformance{},
 		Elaboration:         NewElaboration(memoryGauge, extendedElaboration),
 		extendedElaboration: extendedElaboration,
 		memoryGauge:         memoryGauge,
+		languageVersion:     DefaultLanguageVersion,
 	}
 
 	for _, option := range options {
@@ -296,7 +455,7 @@ func NewChecker(program *ast.Program, location common.Location, memoryGauge comm
 }
 
 func (checker *Checker) SubChecker(program *ast.Program, location common.Location) (*Checker, error) {
-	return NewChecker(
+	subChecker, err := NewChecker(
 		program,
 		location,
 		checker.memoryGauge,
@@ -310,7 +469,21 @@ func (checker *Checker) SubChecker(program *ast.Program, location common.Locatio
 		WithImportHandler(checker.importHandler),
 		WithPositionInfoEnabled(checker.positionInfoEnabled),
 		WithErrorShortCircuitingEnabled(checker.errorShortCircuitingEnabled),
+		WithLazyTypeAliasResolutionEnabled(checker.lazyTypeAliasResolutionEnabled),
+		WithMaxTypeNestingDepth(checker.maxTypeNestingDepth),
+		WithMaxRestrictionCount(checker.maxRestrictionCount),
+		WithMaxParameterCount(checker.maxParameterCount),
+		WithMaxRecursionDepth(checker.maxRecursionDepth),
+		WithMaxLanguageVersion(checker.maxLanguageVersion),
+		WithAddressValidator(checker.addressValidator),
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	subChecker.importingChecker = checker
+
+	return subChecker, nil
 }
 
 func (checker *Checker) SetMemoryGauge(gauge common.MemoryGauge) {
@@ -343,7 +516,15 @@ func (checker *Checker) declareValue(declaration ValueDeclaration) *Variable {
 	return variable
 }
 
-func (checker *Checker) declareTypeDeclaration(declaration TypeDeclaration) {
+// declareTypeDeclaration declares the given predeclared type,
+// and returns false if it is not available in the checked location.
+//
+func (checker *Checker) declareTypeDeclaration(declaration TypeDeclaration) bool {
+
+	if !declaration.TypeDeclarationAvailable(checker.Location) {
+		return false
+	}
+
 	identifier := ast.NewIdentifier(
 		checker.memoryGauge,
 		declaration.TypeDeclarationName(),
@@ -375,6 +556,8 @@ func (checker *Checker) declareTypeDeclaration(declaration TypeDeclaration) {
 	if checker.positionInfoEnabled {
 		checker.recordVariableDeclarationOccurrence(identifier.Identifier, variable)
 	}
+
+	return true
 }
 
 func (checker *Checker) IsChecked() bool {
@@ -387,6 +570,15 @@ func (checker *Checker) Check() error {
 	if !checker.IsChecked() {
 		checker.Elaboration.setIsChecking(true)
 		checker.errors = nil
+		if checker.Program != nil &&
+			len(checker.Program.Declarations()) > 0 &&
+			checker.Program.ID() == ast.NodeIDUnassigned {
+
+			// The elaboration is keyed by node ID,
+			// so checking a program without node IDs would result in wrong information
+
+			panic(errors.NewUnexpectedError("program has no node IDs"))
+		}
 		check := func() {
 			if checker.errorShortCircuitingEnabled {
 				defer func() {
@@ -444,6 +636,19 @@ func (checker *Checker) report(err error) {
 	}
 }
 
+// Warnings returns the warnings reported by the checker, if warnings are enabled.
+//
+func (checker *Checker) Warnings() []Warning {
+	return checker.warnings
+}
+
+func (checker *Checker) reportWarning(warning Warning) {
+	if !checker.warningsEnabled {
+		return
+	}
+	checker.warnings = append(checker.warnings, warning)
+}
+
 func (checker *Checker) UserDefinedValues() map[string]*Variable {
 	variables := map[string]*Variable{}
 
@@ -474,6 +679,11 @@ func (checker *Checker) UserDefinedValues() map[string]*Variable {
 
 func (checker *Checker) VisitProgram(program *ast.Program) ast.Repr {
 
+	// NOTE: declare the language version first,
+	// as it determines which language features are enabled
+
+	checker.declareLanguageVersion(program.PragmaDeclarations())
+
 	for _, declaration := range program.ImportDeclarations() {
 		checker.declareImportDeclaration(declaration)
 	}
@@ -509,6 +719,19 @@ func (checker *Checker) VisitProgram(program *ast.Program) ast.Repr {
 		VisitThisAndNested(compositeType, registerInElaboration)
 	}
 
+	// Declare type aliases.
+	// NOTE: *After* declaring interface and composite types,
+	// as type aliases may refer to them, and *before* declaring members,
+	// as members may refer to type aliases
+
+	for _, declaration := range checker.orderTypeAliasDeclarations(program.TypeAliasDeclarations()) {
+		checker.declareTypeAliasDeclaration(declaration, false)
+	}
+
+	for _, declaration := range program.CompositeDeclarations() {
+		checker.declareCompositeTypeAliases(declaration)
+	}
+
 	// Declare interfaces' and composites' members
 
 	for _, declaration := range program.InterfaceDeclarations() {
@@ -595,8 +818,8 @@ func (checker *Checker) checkTopLevelDeclarationValidity(declarations []ast.Decl
 }
 
 func (checker *Checker) declareGlobalFunctionDeclaration(declaration *ast.FunctionDeclaration) {
-	functionType := checker.functionType(declaration.ParameterList, declaration.ReturnTypeAnnotation)
-	checker.Elaboration.FunctionDeclarationFunctionTypes[declaration] = functionType
+	functionType := checker.functionDeclarationType(declaration)
+	checker.Elaboration.FunctionDeclarationFunctionTypes[declaration.ID()] = functionType
 	checker.declareFunctionDeclaration(declaration, functionType)
 }
 
@@ -638,7 +861,7 @@ func (checker *Checker) checkTypeCompatibility(expression ast.Expression, valueT
 			return true
 
 		} else if IsSameTypeKind(unwrappedTargetType, &AddressType{}) {
-			CheckAddressLiteral(checker.memoryGauge, typedExpression, checker.report)
+			checker.checkAddressLiteral(typedExpression)
 
 			return true
 		}
@@ -850,6 +1073,37 @@ func CheckAddressLiteral(memoryGauge common.MemoryGauge, expression *ast.Integer
 	return valid
 }
 
+// checkAddressLiteral checks that the value of the integer literal
+// fits into the range of an address, and that it is valid according to
+// the address validator, if any.
+//
+func (checker *Checker) checkAddressLiteral(expression *ast.IntegerExpression) {
+	if !CheckAddressLiteral(checker.memoryGauge, expression, checker.report) {
+		return
+	}
+
+	if checker.addressValidator == nil {
+		return
+	}
+
+	address, err := common.BytesToAddress(expression.Value.Bytes())
+	if err != nil {
+		// The literal was checked to fit into an address
+		panic(errors.NewUnexpectedErrorFromCause(err))
+	}
+
+	err = checker.addressValidator(address)
+	if err != nil {
+		checker.report(
+			&InvalidAddressError{
+				Address: address,
+				Err:     err,
+				Range:   ast.NewRangeFromPositioned(checker.memoryGauge, expression),
+			},
+		)
+	}
+}
+
 func checkIntegerRange(value, min, max *big.Int) bool {
 	return (min == nil || value.Cmp(min) >= 0) &&
 		(max == nil || value.Cmp(max) <= 0)
@@ -939,6 +1193,28 @@ func (checker *Checker) findAndCheckValueVariable(identifierExpression *ast.Iden
 
 // ConvertType converts an AST type representation to a sema type
 func (checker *Checker) ConvertType(t ast.Type) Type {
+
+	if checker.maxTypeNestingDepth > 0 && t != nil {
+		checker.typeNestingDepth++
+		defer func() {
+			checker.typeNestingDepth--
+		}()
+
+		if checker.typeNestingDepth > checker.maxTypeNestingDepth {
+			checker.report(
+				&TypeNestingDepthLimitReachedError{
+					Limit: checker.maxTypeNestingDepth,
+					Range: ast.NewRangeFromPositioned(checker.memoryGauge, t),
+				},
+			)
+			return InvalidType
+		}
+	}
+
+	return checker.convertType(t)
+}
+
+func (checker *Checker) convertType(t ast.Type) Type {
 	switch t := t.(type) {
 	case *ast.NominalType:
 		return checker.convertNominalType(t)
@@ -967,6 +1243,9 @@ func (checker *Checker) ConvertType(t ast.Type) Type {
 	case *ast.InstantiationType:
 		return checker.convertInstantiationType(t)
 
+	case *ast.TupleType:
+		return checker.convertTupleType(t)
+
 	case nil:
 		// The AST might contain "holes" if parsing failed
 		return InvalidType
@@ -1152,6 +1431,19 @@ func CheckRestrictedType(
 }
 
 func (checker *Checker) convertRestrictedType(t *ast.RestrictedType) Type {
+
+	restrictionCount := len(t.Restrictions)
+	if checker.maxRestrictionCount > 0 && restrictionCount > checker.maxRestrictionCount {
+		checker.report(
+			&RestrictionCountLimitReachedError{
+				Count: restrictionCount,
+				Limit: checker.maxRestrictionCount,
+				Range: ast.NewRangeFromPositioned(checker.memoryGauge, t),
+			},
+		)
+		return InvalidType
+	}
+
 	var restrictedType Type
 
 	// Convert the restricted type, if any
@@ -1247,6 +1539,12 @@ func (checker *Checker) convertOptionalType(t *ast.OptionalType) Type {
 // NOTE: type annotations ar *NOT* checked!
 //
 func (checker *Checker) convertFunctionType(t *ast.FunctionType) Type {
+
+	checker.checkParameterCount(
+		len(t.ParameterTypeAnnotations),
+		ast.NewRangeFromPositioned(checker.memoryGauge, t),
+	)
+
 	var parameters []*Parameter
 
 	for _, parameterTypeAnnotation := range t.ParameterTypeAnnotations {
@@ -1266,6 +1564,16 @@ func (checker *Checker) convertFunctionType(t *ast.FunctionType) Type {
 	}
 }
 
+func (checker *Checker) convertTupleType(t *ast.TupleType) Type {
+	elementTypes := make([]Type, 0, len(t.Types))
+
+	for _, elementType := range t.Types {
+		elementTypes = append(elementTypes, checker.ConvertType(elementType))
+	}
+
+	return NewTupleType(checker.memoryGauge, elementTypes)
+}
+
 func (checker *Checker) convertConstantSizedType(t *ast.ConstantSizedType) Type {
 	elementType := checker.ConvertType(t.Type)
 
@@ -1357,6 +1665,13 @@ func (checker *Checker) convertNominalType(t *ast.NominalType) Type {
 	for _, identifier := range t.NestedIdentifiers {
 		if containerType, ok := ty.(ContainerType); ok && containerType.IsContainerType() {
 			ty, _ = containerType.GetNestedTypes().Get(identifier.Identifier)
+
+			// The nested identifier might refer to a type alias declared in a composite
+			if ty == nil {
+				if compositeType, ok := containerType.(*CompositeType); ok {
+					ty, _ = compositeType.TypeAlias(identifier.Identifier)
+				}
+			}
 		} else {
 			if !ty.IsInvalidType() {
 				checker.report(
@@ -1409,6 +1724,7 @@ func (checker *Checker) ConvertTypeAnnotation(typeAnnotation *ast.TypeAnnotation
 }
 
 func (checker *Checker) functionType(
+	purity ast.FunctionPurity,
 	parameterList *ast.ParameterList,
 	returnTypeAnnotation *ast.TypeAnnotation,
 ) *FunctionType {
@@ -1418,13 +1734,35 @@ func (checker *Checker) functionType(
 		checker.ConvertTypeAnnotation(returnTypeAnnotation)
 
 	return &FunctionType{
-		Parameters:           convertedParameters,
-		ReturnTypeAnnotation: convertedReturnTypeAnnotation,
+		Purity:                NewFunctionPurity(purity),
+		Parameters:            convertedParameters,
+		ReturnTypeAnnotation:  convertedReturnTypeAnnotation,
+		RequiredArgumentCount: requiredArgumentCount(parameterList),
+	}
+}
+
+// requiredArgumentCount returns the number of arguments
+// which must be provided in an invocation of a function with the given parameters.
+//
+// Returns nil if no parameter has a default argument, i.e. all arguments are required
+//
+func requiredArgumentCount(parameterList *ast.ParameterList) *int {
+	for i, parameter := range parameterList.Parameters {
+		if parameter.DefaultArgument != nil {
+			return RequiredArgumentCount(i)
+		}
 	}
+
+	return nil
 }
 
 func (checker *Checker) parameters(parameterList *ast.ParameterList) []*Parameter {
 
+	checker.checkParameterCount(
+		len(parameterList.Parameters),
+		parameterList.Range,
+	)
+
 	parameters := make([]*Parameter, len(parameterList.Parameters))
 
 	for i, parameter := range parameterList.Parameters {
@@ -1446,6 +1784,61 @@ func (checker *Checker) parameters(parameterList *ast.ParameterList) []*Paramete
 	return parameters
 }
 
+// checkParameterCount reports an error if the given number of parameters
+// exceeds the configured parameter count limit, if any.
+//
+func (checker *Checker) checkParameterCount(count int, errorRange ast.Range) {
+	if checker.maxParameterCount <= 0 || count <= checker.maxParameterCount {
+		return
+	}
+
+	checker.report(
+		&ParameterCountLimitReachedError{
+			Count: count,
+			Limit: checker.maxParameterCount,
+			Range: errorRange,
+		},
+	)
+}
+
+// enterRecursion increases the recursion depth of the checker,
+// before checking the given nested statement or expression.
+//
+// Returns false if the recursion depth limit was reached,
+// in which case the statement or expression must not be checked,
+// and `leaveRecursion` must not be called.
+// The error is only reported once, to avoid noise.
+//
+func (checker *Checker) enterRecursion(element ast.HasPosition) bool {
+	checker.recursionDepth++
+
+	if checker.maxRecursionDepth <= 0 || checker.recursionDepth <= checker.maxRecursionDepth {
+		return true
+	}
+
+	checker.recursionDepth--
+
+	if !checker.recursionDepthLimitReached {
+		checker.recursionDepthLimitReached = true
+
+		checker.report(
+			&RecursionDepthLimitReachedError{
+				Limit: checker.maxRecursionDepth,
+				Range: ast.NewRangeFromPositioned(checker.memoryGauge, element),
+			},
+		)
+	}
+
+	return false
+}
+
+// leaveRecursion decreases the recursion depth of the checker,
+// after checking a nested statement or expression.
+//
+func (checker *Checker) leaveRecursion() {
+	checker.recursionDepth--
+}
+
 func (checker *Checker) recordVariableReferenceOccurrence(startPos, endPos ast.Position, variable *Variable) {
 	if !checker.positionInfoEnabled {
 		return
@@ -1468,7 +1861,26 @@ func (checker *Checker) recordVariableReferenceOccurrence(startPos, endPos ast.P
 		}
 		checker.variableOrigins[variable] = origin
 	}
+	checker.recordOccurrence(startPos, endPos, origin)
+}
+
+// recordOccurrence records the occurrence of the given origin,
+// and the type information of the origin for the range of the occurrence
+//
+func (checker *Checker) recordOccurrence(startPos, endPos ast.Position, origin *Origin) {
 	checker.Occurrences.Put(startPos, endPos, origin)
+
+	if origin == nil {
+		return
+	}
+
+	checker.Elaboration.PositionTypes.Put(
+		startPos,
+		endPos,
+		origin.Type,
+		origin.DeclarationKind,
+		origin.DocString,
+	)
 }
 
 func (checker *Checker) recordVariableDeclarationOccurrence(name string, variable *Variable) {
@@ -1500,11 +1912,7 @@ func (checker *Checker) recordFieldDeclarationOrigin(
 		DocString:       docString,
 	}
 
-	checker.Occurrences.Put(
-		startPosition,
-		endPosition,
-		origin,
-	)
+	checker.recordOccurrence(startPosition, endPosition, origin)
 
 	return origin
 }
@@ -1528,11 +1936,7 @@ func (checker *Checker) recordFunctionDeclarationOrigin(
 		DocString:       function.DocString,
 	}
 
-	checker.Occurrences.Put(
-		startPosition,
-		endPosition,
-		origin,
-	)
+	checker.recordOccurrence(startPosition, endPosition, origin)
 	return origin
 }
 
@@ -1667,6 +2071,14 @@ func (checker *Checker) recordResourceInvalidation(
 	}
 }
 
+// IsInvalidatedResource returns true if the given resource variable
+// was potentially or definitely moved or destroyed in the current scope
+//
+func (checker *Checker) IsInvalidatedResource(variable *Variable) bool {
+	info := checker.resources.Get(Resource{Variable: variable})
+	return !info.Invalidations.IsEmpty()
+}
+
 func (checker *Checker) checkWithResources(
 	check TypeCheckFunc,
 	temporaryResources *Resources,
@@ -2239,12 +2651,46 @@ func (checker *Checker) rewritePostConditions(postConditions []*ast.Condition) P
 		rewrittenPostConditions[i] = &newPostCondition
 	}
 
+	// The before statements and the rewritten post-conditions
+	// may contain new elements, which must be assigned IDs.
+	//
+	// NOTE: Only the new elements are modified, the elements of the program are shared
+	// and already have IDs assigned by the parser
+
+	nodeIDs := checker.synthesizedNodeIDs()
+
+	for _, beforeStatement := range beforeStatements {
+		nodeIDs.AssignIDs(beforeStatement)
+	}
+
+	for _, rewrittenPostCondition := range rewrittenPostConditions {
+		nodeIDs.AssignIDs(rewrittenPostCondition.Test)
+		if rewrittenPostCondition.Message != nil {
+			nodeIDs.AssignIDs(rewrittenPostCondition.Message)
+		}
+	}
+
 	return PostConditionsRewrite{
 		BeforeStatements:        beforeStatements,
 		RewrittenPostConditions: rewrittenPostConditions,
 	}
 }
 
+// synthesizedNodeIDs returns the generator for the IDs of elements synthesized by the checker.
+//
+// The IDs have the tag of the checked program, and indices which never collide with parsed elements.
+//
+func (checker *Checker) synthesizedNodeIDs() *ast.NodeIDGenerator {
+	if checker.nodeIDs == nil {
+		var programTag uint32
+		if checker.Program != nil {
+			programTag = checker.Program.ID().ProgramTag()
+		}
+		checker.nodeIDs = ast.NewNodeIDGenerator(programTag, ast.FirstSynthesizedNodeIndex)
+	}
+	return checker.nodeIDs
+}
+
 func (checker *Checker) checkTypeAnnotation(typeAnnotation *TypeAnnotation, pos ast.HasPosition) {
 
 	switch typeAnnotation.TypeAnnotationState() {
@@ -2343,8 +2789,11 @@ func (checker *Checker) convertInstantiationType(t *ast.InstantiationType) Type
 		typeArgumentAnnotations[i] = typeArgument
 	}
 
+	// NOTE: composite types are parameterized types,
+	// but only generic composite types have type parameters
+
 	parameterizedType, ok := ty.(ParameterizedType)
-	if !ok {
+	if !ok || isUnparameterizedCompositeType(ty) {
 
 		// The type is not parameterized,
 		// report an error for all type arguments
@@ -2412,11 +2861,29 @@ func (checker *Checker) convertInstantiationType(t *ast.InstantiationType) Type
 	return parameterizedType.Instantiate(typeArguments, checker.report)
 }
 
+func isUnparameterizedCompositeType(ty Type) bool {
+	compositeType, ok := ty.(*CompositeType)
+	return ok && len(compositeType.typeParameters) == 0
+}
+
 func (checker *Checker) VisitExpression(expr ast.Expression, expectedType Type) Type {
 	actualType, _ := checker.visitExpression(expr, expectedType)
 	return actualType
 }
 
+// VisitUnevaluatedExpression checks the given expression in the current scope,
+// under the assumption that it is not evaluated, e.g. to determine its type.
+// Resources which are moved or destroyed in the expression are not invalidated.
+//
+func (checker *Checker) VisitUnevaluatedExpression(expr ast.Expression) Type {
+	return checker.checkWithResources(
+		func() Type {
+			return checker.VisitExpression(expr, nil)
+		},
+		checker.resources.Clone(),
+	)
+}
+
 func (checker *Checker) visitExpression(expr ast.Expression, expectedType Type) (visibleType Type, actualType Type) {
 	return checker.visitExpressionWithForceType(expr, expectedType, true)
 }
@@ -2445,6 +2912,11 @@ func (checker *Checker) visitExpressionWithForceType(
 	forceType bool,
 ) (visibleType Type, actualType Type) {
 
+	if !checker.enterRecursion(expr) {
+		return InvalidType, InvalidType
+	}
+	defer checker.leaveRecursion()
+
 	// Cache the current contextually expected type, and set the `expectedType`
 	// as the new contextually expected type.
 	prevExpectedType := checker.expectedType
@@ -2461,6 +2933,10 @@ func (checker *Checker) visitExpressionWithForceType(
 		panic(errors.NewUnreachableError())
 	}
 
+	if checker.positionInfoEnabled {
+		checker.recordExpressionType(expr, actualType)
+	}
+
 	if forceType &&
 		expectedType != nil &&
 		!expectedType.IsInvalidType() &&
@@ -2496,6 +2972,34 @@ func (checker *Checker) expressionRange(expression ast.Expression) ast.Range {
 	}
 }
 
+// recordExpressionType records the type information of the given expression.
+// For member expressions, the declaration kind and doc string of the member are recorded
+//
+func (checker *Checker) recordExpressionType(expr ast.Expression, ty Type) {
+	if ty.IsInvalidType() {
+		return
+	}
+
+	declarationKind := common.DeclarationKindUnknown
+	var docString string
+
+	if memberExpression, ok := expr.(*ast.MemberExpression); ok {
+		memberInfo := checker.Elaboration.MemberExpressionMemberInfos[memberExpression.ID()]
+		if memberInfo.Member != nil {
+			declarationKind = memberInfo.Member.DeclarationKind
+			docString = memberInfo.Member.DocString
+		}
+	}
+
+	checker.Elaboration.PositionTypes.Put(
+		expr.StartPosition(),
+		expr.EndPosition(checker.memoryGauge),
+		ty,
+		declarationKind,
+		docString,
+	)
+}
+
 func (checker *Checker) declareGlobalRanges() {
 	if !checker.positionInfoEnabled {
 		return
@@ -0,0 +1,304 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"sort"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+)
+
+//go:generate go run golang.org/x/tools/cmd/stringer -type=CompletionKind
+
+// CompletionKind is the kind of a completion
+//
+type CompletionKind uint
+
+const (
+	CompletionKindUnknown CompletionKind = iota
+	// CompletionKindVariable indicates the completion is a value or type
+	// which is in scope at the position
+	CompletionKindVariable
+	// CompletionKindMember indicates the completion is a member
+	// of the type of the accessed expression
+	CompletionKindMember
+	// CompletionKindImportable indicates the completion is a value or type
+	// which is declared in an imported location, but which is not imported (yet)
+	CompletionKindImportable
+)
+
+// Completion is a declaration which is valid at a position in the program
+//
+type Completion struct {
+	Kind            CompletionKind
+	Identifier      string
+	DeclarationKind common.DeclarationKind
+	Type            Type
+	DocString       string
+	// Location is the location the declaration is imported from.
+	// It is only set for importable declarations
+	Location common.Location
+}
+
+type importedLocation struct {
+	location common.Location
+	imp      Import
+}
+
+// CompletionsAtPosition returns the completions which are valid at the given position.
+//
+// If the position is inside a member access, i.e. in between the dot and the end of the member name,
+// the accessible members of the type of the accessed expression are returned.
+//
+// Otherwise, the values and types in scope at the position are returned,
+// and the declarations of imported locations which are not imported.
+//
+// The completions are sorted by identifier.
+// Position info must be enabled, see WithPositionInfoEnabled.
+//
+func (checker *Checker) CompletionsAtPosition(pos Position) []Completion {
+	if !checker.positionInfoEnabled {
+		return nil
+	}
+
+	// Members are only accessible depending on the enclosing containers,
+	// so declare the containers at the position for the duration of the lookup
+
+	for _, containerType := range checker.containerTypesAtPosition(pos) {
+		if checker.containerTypes[containerType] {
+			continue
+		}
+		checker.containerTypes[containerType] = true
+		defer delete(checker.containerTypes, containerType)
+	}
+
+	var completions []Completion
+
+	memberAccess := checker.MemberAccesses.Find(pos)
+	if memberAccess != nil {
+		completions = checker.memberCompletions(memberAccess.AccessedType)
+	} else {
+		completions = checker.variableCompletions(pos)
+		completions = append(completions, checker.importableCompletions(completions)...)
+	}
+
+	sort.SliceStable(completions, func(i, j int) bool {
+		return completions[i].Identifier < completions[j].Identifier
+	})
+
+	return completions
+}
+
+func (checker *Checker) memberCompletions(accessedType Type) []Completion {
+	memberResolvers := accessedType.GetMembers()
+
+	completions := make([]Completion, 0, len(memberResolvers))
+
+	for name, resolver := range memberResolvers {
+		member := resolver.Resolve(
+			checker.memoryGauge,
+			name,
+			ast.Range{},
+			func(error) {
+				// NO-OP
+			},
+		)
+		if member == nil || !checker.isReadableMember(member) {
+			continue
+		}
+
+		completions = append(completions, Completion{
+			Kind:            CompletionKindMember,
+			Identifier:      name,
+			DeclarationKind: member.DeclarationKind,
+			Type:            member.TypeAnnotation.Type,
+			DocString:       member.DocString,
+		})
+	}
+
+	return completions
+}
+
+func (checker *Checker) variableCompletions(pos Position) []Completion {
+
+	// Inner declarations shadow outer declarations with the same name,
+	// so only keep the range which starts last, i.e. the innermost one
+
+	innermostRanges := map[string]Range{}
+	innermostStartPositions := map[string]Position{}
+	var identifiers []string
+
+	for _, entry := range checker.Ranges.tree.SearchAll(pos) {
+		r, ok := entry.Value.(Range)
+		if !ok {
+			continue
+		}
+
+		startPos, ok := entry.Interval.Min.(Position)
+		if !ok {
+			continue
+		}
+
+		identifier := r.Identifier
+
+		existingStartPos, ok := innermostStartPositions[identifier]
+		if !ok {
+			identifiers = append(identifiers, identifier)
+		} else if startPos.Compare(existingStartPos) <= 0 {
+			continue
+		}
+
+		innermostRanges[identifier] = r
+		innermostStartPositions[identifier] = startPos
+	}
+
+	completions := make([]Completion, 0, len(identifiers))
+
+	for _, identifier := range identifiers {
+		r := innermostRanges[identifier]
+
+		completions = append(completions, Completion{
+			Kind:            CompletionKindVariable,
+			Identifier:      identifier,
+			DeclarationKind: r.DeclarationKind,
+			Type:            r.Type,
+			DocString:       r.DocString,
+		})
+	}
+
+	return completions
+}
+
+func (checker *Checker) importableCompletions(variableCompletions []Completion) []Completion {
+
+	declared := make(map[string]struct{}, len(variableCompletions))
+	for _, completion := range variableCompletions {
+		declared[completion.Identifier] = struct{}{}
+	}
+
+	var completions []Completion
+
+	addElements := func(
+		location common.Location,
+		elements *StringImportElementOrderedMap,
+		isImportable func(name string) bool,
+	) {
+		if elements == nil {
+			return
+		}
+
+		elements.Foreach(func(name string, element ImportElement) {
+			if _, ok := declared[name]; ok {
+				return
+			}
+
+			if !isImportable(name) || !checker.isReadableAccess(element.Access) {
+				return
+			}
+
+			declared[name] = struct{}{}
+
+			completions = append(completions, Completion{
+				Kind:            CompletionKindImportable,
+				Identifier:      name,
+				DeclarationKind: element.DeclarationKind,
+				Type:            element.Type,
+				Location:        location,
+			})
+		})
+	}
+
+	for _, importedLocation := range checker.importedLocations {
+		imp := importedLocation.imp
+		location := importedLocation.location
+
+		addElements(location, imp.AllValueElements(), imp.IsImportableValue)
+		addElements(location, imp.AllTypeElements(), imp.IsImportableType)
+	}
+
+	return completions
+}
+
+// containerTypesAtPosition returns the types of the composites, interfaces, and transactions
+// which contain the given position
+//
+func (checker *Checker) containerTypesAtPosition(pos Position) []Type {
+	var containerTypes []Type
+
+	var addDeclarations func(members *ast.Members)
+
+	addComposites := func(declarations []*ast.CompositeDeclaration) {
+		for _, declaration := range declarations {
+			if !containsPosition(declaration, pos) {
+				continue
+			}
+			compositeType := checker.Elaboration.CompositeDeclarationTypes[declaration.ID()]
+			if compositeType != nil {
+				containerTypes = append(containerTypes, compositeType)
+			}
+			addDeclarations(declaration.Members)
+		}
+	}
+
+	addInterfaces := func(declarations []*ast.InterfaceDeclaration) {
+		for _, declaration := range declarations {
+			if !containsPosition(declaration, pos) {
+				continue
+			}
+			interfaceType := checker.Elaboration.InterfaceDeclarationTypes[declaration.ID()]
+			if interfaceType != nil {
+				containerTypes = append(containerTypes, interfaceType)
+			}
+			addDeclarations(declaration.Members)
+		}
+	}
+
+	addDeclarations = func(members *ast.Members) {
+		if members == nil {
+			return
+		}
+		addComposites(members.Composites())
+		addInterfaces(members.Interfaces())
+	}
+
+	program := checker.Program
+
+	addComposites(program.CompositeDeclarations())
+	addInterfaces(program.InterfaceDeclarations())
+
+	for _, declaration := range program.TransactionDeclarations() {
+		if !containsPosition(declaration, pos) {
+			continue
+		}
+		transactionType := checker.Elaboration.TransactionDeclarationTypes[declaration.ID()]
+		if transactionType != nil {
+			containerTypes = append(containerTypes, transactionType)
+		}
+	}
+
+	return containerTypes
+}
+
+func containsPosition(positioned ast.HasPosition, pos Position) bool {
+	startPos := ASTToSemaPosition(positioned.StartPosition())
+	endPos := ASTToSemaPosition(positioned.EndPosition(nil))
+	return startPos.Compare(pos) <= 0 &&
+		pos.Compare(endPos) <= 0
+}
@@ -19,6 +19,7 @@
 package sema
 
 import (
+	"sort"
 	"sync"
 
 	"github.com/onflow/cadence/runtime/ast"
@@ -37,139 +38,178 @@ type CastType struct {
 	ExpectedType   Type
 }
 
+type DefaultArgumentTypes struct {
+	ValueType     Type
+	ParameterType Type
+}
+
+// Elaboration is the information about a program which is gathered when checking it.
+//
+// Information about AST nodes is keyed by node ID, see ast.NodeID.
+//
 type Elaboration struct {
 	lock                                *sync.RWMutex
-	FunctionDeclarationFunctionTypes    map[*ast.FunctionDeclaration]*FunctionType
-	VariableDeclarationValueTypes       map[*ast.VariableDeclaration]Type
-	VariableDeclarationSecondValueTypes map[*ast.VariableDeclaration]Type
-	VariableDeclarationTargetTypes      map[*ast.VariableDeclaration]Type
-	AssignmentStatementValueTypes       map[*ast.AssignmentStatement]Type
-	AssignmentStatementTargetTypes      map[*ast.AssignmentStatement]Type
-	CompositeDeclarationTypes           map[*ast.CompositeDeclaration]*CompositeType
+	FunctionDeclarationFunctionTypes    map[ast.NodeID]*FunctionType
+	ParameterDefaultArgumentTypes       map[ast.NodeID]DefaultArgumentTypes
+	VariableDeclarationValueTypes       map[ast.NodeID]Type
+	VariableDeclarationSecondValueTypes map[ast.NodeID]Type
+	VariableDeclarationTargetTypes      map[ast.NodeID]Type
+	TupleVariableDeclarationValueTypes  map[ast.NodeID]Type
+	TupleVariableDeclarationTargetTypes map[ast.NodeID]*TupleType
+	AssignmentStatementValueTypes       map[ast.NodeID]Type
+	AssignmentStatementTargetTypes      map[ast.NodeID]Type
+	CompositeDeclarationTypes           map[ast.NodeID]*CompositeType
 	CompositeTypeDeclarations           map[*CompositeType]*ast.CompositeDeclaration
-	InterfaceDeclarationTypes           map[*ast.InterfaceDeclaration]*InterfaceType
+	InterfaceDeclarationTypes           map[ast.NodeID]*InterfaceType
 	InterfaceTypeDeclarations           map[*InterfaceType]*ast.InterfaceDeclaration
-	ConstructorFunctionTypes            map[*ast.SpecialFunctionDeclaration]*FunctionType
-	FunctionExpressionFunctionType      map[*ast.FunctionExpression]*FunctionType
-	InvocationExpressionArgumentTypes   map[*ast.InvocationExpression][]Type
-	InvocationExpressionParameterTypes  map[*ast.InvocationExpression][]Type
-	InvocationExpressionReturnTypes     map[*ast.InvocationExpression]Type
-	InvocationExpressionTypeArguments   map[*ast.InvocationExpression]*TypeParameterTypeOrderedMap
-	CastingStaticValueTypes             map[*ast.CastingExpression]Type
-	CastingTargetTypes                  map[*ast.CastingExpression]Type
-	ReturnStatementValueTypes           map[*ast.ReturnStatement]Type
-	ReturnStatementReturnTypes          map[*ast.ReturnStatement]Type
-	BinaryExpressionResultTypes         map[*ast.BinaryExpression]Type
-	BinaryExpressionLeftTypes           map[*ast.BinaryExpression]Type
-	BinaryExpressionRightTypes          map[*ast.BinaryExpression]Type
-	MemberExpressionMemberInfos         map[*ast.MemberExpression]MemberInfo
-	MemberExpressionExpectedTypes       map[*ast.MemberExpression]Type
-	ArrayExpressionArgumentTypes        map[*ast.ArrayExpression][]Type
-	ArrayExpressionArrayType            map[*ast.ArrayExpression]ArrayType
-	DictionaryExpressionType            map[*ast.DictionaryExpression]*DictionaryType
-	DictionaryExpressionEntryTypes      map[*ast.DictionaryExpression][]DictionaryEntryType
-	IntegerExpressionType               map[*ast.IntegerExpression]Type
-	StringExpressionType                map[*ast.StringExpression]Type
-	FixedPointExpression                map[*ast.FixedPointExpression]Type
-	TransactionDeclarationTypes         map[*ast.TransactionDeclaration]*TransactionType
-	SwapStatementLeftTypes              map[*ast.SwapStatement]Type
-	SwapStatementRightTypes             map[*ast.SwapStatement]Type
+	ConstructorFunctionTypes            map[ast.NodeID]*FunctionType
+	SpecialFunctionTypes                map[ast.NodeID]*FunctionType
+	FunctionExpressionFunctionType      map[ast.NodeID]*FunctionType
+	InvocationExpressionArgumentTypes   map[ast.NodeID][]Type
+	InvocationExpressionParameterTypes  map[ast.NodeID][]Type
+	InvocationExpressionReturnTypes     map[ast.NodeID]Type
+	InvocationExpressionTypeArguments   map[ast.NodeID]*TypeParameterTypeOrderedMap
+	CastingStaticValueTypes             map[ast.NodeID]Type
+	CastingTargetTypes                  map[ast.NodeID]Type
+	ReturnStatementValueTypes           map[ast.NodeID]Type
+	ReturnStatementReturnTypes          map[ast.NodeID]Type
+	BinaryExpressionResultTypes         map[ast.NodeID]Type
+	BinaryExpressionLeftTypes           map[ast.NodeID]Type
+	BinaryExpressionRightTypes          map[ast.NodeID]Type
+	MemberExpressionMemberInfos         map[ast.NodeID]MemberInfo
+	MemberExpressionExpectedTypes       map[ast.NodeID]Type
+	ArrayExpressionArgumentTypes        map[ast.NodeID][]Type
+	ArrayExpressionArrayType            map[ast.NodeID]ArrayType
+	TupleExpressionArgumentTypes        map[ast.NodeID][]Type
+	TupleExpressionTupleType            map[ast.NodeID]*TupleType
+	DictionaryExpressionType            map[ast.NodeID]*DictionaryType
+	DictionaryExpressionEntryTypes      map[ast.NodeID][]DictionaryEntryType
+	IntegerExpressionType               map[ast.NodeID]Type
+	StringExpressionType                map[ast.NodeID]Type
+	FixedPointExpression                map[ast.NodeID]Type
+	TransactionDeclarationTypes         map[ast.NodeID]*TransactionType
+	SwapStatementLeftTypes              map[ast.NodeID]Type
+	SwapStatementRightTypes             map[ast.NodeID]Type
 	// IsNestedResourceMoveExpression indicates if the access the index or member expression
 	// is implicitly moving a resource out of the container, e.g. in a shift or swap statement.
-	IsNestedResourceMoveExpression      map[ast.Expression]struct{}
-	CompositeNestedDeclarations         map[*ast.CompositeDeclaration]map[string]ast.Declaration
-	InterfaceNestedDeclarations         map[*ast.InterfaceDeclaration]map[string]ast.Declaration
+	IsNestedResourceMoveExpression      map[ast.NodeID]struct{}
+	CompositeNestedDeclarations         map[ast.NodeID]map[string]ast.Declaration
+	InterfaceNestedDeclarations         map[ast.NodeID]map[string]ast.Declaration
 	PostConditionsRewrite               map[*ast.Conditions]PostConditionsRewrite
-	EmitStatementEventTypes             map[*ast.EmitStatement]*CompositeType
+	EmitStatementEventTypes             map[ast.NodeID]*CompositeType
+	AttachExpressionAttachmentTypes     map[ast.NodeID]*CompositeType
+	AttachmentAccessTypes               map[ast.NodeID]*CompositeType
+	TupleElementAccessIndices           map[ast.NodeID]int
+	AttachmentRemoveTypes               map[ast.NodeID]*CompositeType
+	TypeAliasDeclarationTypes           map[ast.NodeID]Type
 	CompositeTypes                      map[TypeID]*CompositeType
 	InterfaceTypes                      map[TypeID]*InterfaceType
-	IdentifierInInvocationTypes         map[*ast.IdentifierExpression]Type
-	ImportDeclarationsResolvedLocations map[*ast.ImportDeclaration][]ResolvedLocation
+	IdentifierInInvocationTypes         map[ast.NodeID]Type
+	ImportDeclarationsResolvedLocations map[ast.NodeID][]ResolvedLocation
 	GlobalValues                        *StringVariableOrderedMap
 	GlobalTypes                         *StringVariableOrderedMap
 	TransactionTypes                    []*TransactionType
 	EffectivePredeclaredValues          map[string]ValueDeclaration
 	EffectivePredeclaredTypes           map[string]TypeDeclaration
 	isChecking                          bool
-	ReferenceExpressionBorrowTypes      map[*ast.ReferenceExpression]Type
-	IndexExpressionIndexedTypes         map[*ast.IndexExpression]ValueIndexableType
-	IndexExpressionIndexingTypes        map[*ast.IndexExpression]Type
-	ForceExpressionTypes                map[*ast.ForceExpression]Type
-	StaticCastTypes                     map[*ast.CastingExpression]CastType
-	NumberConversionArgumentTypes       map[ast.Expression]struct {
+	ReferenceExpressionBorrowTypes      map[ast.NodeID]Type
+	IndexExpressionIndexedTypes         map[ast.NodeID]ValueIndexableType
+	IndexExpressionIndexingTypes        map[ast.NodeID]Type
+	ForceExpressionTypes                map[ast.NodeID]Type
+	StaticCastTypes                     map[ast.NodeID]CastType
+	NumberConversionArgumentTypes       map[ast.NodeID]struct {
 		Type  Type
 		Range ast.Range
 	}
-	RuntimeCastTypes map[*ast.CastingExpression]struct {
+	RuntimeCastTypes map[ast.NodeID]struct {
 		Left  Type
 		Right Type
 	}
+	// FunctionEffects are the side effects which the checked functions directly have
+	FunctionEffects map[*FunctionType]FunctionEffects
+	// FunctionCallees are the functions which the checked functions directly invoke
+	FunctionCallees map[*FunctionType][]*FunctionType
+	// PositionTypes is only recorded if position info is enabled, see WithPositionInfoEnabled
+	PositionTypes *PositionTypes
 }
 
 func NewElaboration(gauge common.MemoryGauge, extendedElaboration bool) *Elaboration {
 	common.UseMemory(gauge, common.ElaborationMemoryUsage)
 	elaboration := &Elaboration{
 		lock:                                new(sync.RWMutex),
-		FunctionDeclarationFunctionTypes:    map[*ast.FunctionDeclaration]*FunctionType{},
-		VariableDeclarationValueTypes:       map[*ast.VariableDeclaration]Type{},
-		VariableDeclarationSecondValueTypes: map[*ast.VariableDeclaration]Type{},
-		VariableDeclarationTargetTypes:      map[*ast.VariableDeclaration]Type{},
-		AssignmentStatementValueTypes:       map[*ast.AssignmentStatement]Type{},
-		AssignmentStatementTargetTypes:      map[*ast.AssignmentStatement]Type{},
-		CompositeDeclarationTypes:           map[*ast.CompositeDeclaration]*CompositeType{},
+		FunctionDeclarationFunctionTypes:    map[ast.NodeID]*FunctionType{},
+		ParameterDefaultArgumentTypes:       map[ast.NodeID]DefaultArgumentTypes{},
+		VariableDeclarationValueTypes:       map[ast.NodeID]Type{},
+		VariableDeclarationSecondValueTypes: map[ast.NodeID]Type{},
+		VariableDeclarationTargetTypes:      map[ast.NodeID]Type{},
+		TupleVariableDeclarationValueTypes:  map[ast.NodeID]Type{},
+		TupleVariableDeclarationTargetTypes: map[ast.NodeID]*TupleType{},
+		AssignmentStatementValueTypes:       map[ast.NodeID]Type{},
+		AssignmentStatementTargetTypes:      map[ast.NodeID]Type{},
+		CompositeDeclarationTypes:           map[ast.NodeID]*CompositeType{},
 		CompositeTypeDeclarations:           map[*CompositeType]*ast.CompositeDeclaration{},
-		InterfaceDeclarationTypes:           map[*ast.InterfaceDeclaration]*InterfaceType{},
+		InterfaceDeclarationTypes:           map[ast.NodeID]*InterfaceType{},
 		InterfaceTypeDeclarations:           map[*InterfaceType]*ast.InterfaceDeclaration{},
-		ConstructorFunctionTypes:            map[*ast.SpecialFunctionDeclaration]*FunctionType{},
-		FunctionExpressionFunctionType:      map[*ast.FunctionExpression]*FunctionType{},
-		InvocationExpressionArgumentTypes:   map[*ast.InvocationExpression][]Type{},
-		InvocationExpressionParameterTypes:  map[*ast.InvocationExpression][]Type{},
-		InvocationExpressionReturnTypes:     map[*ast.InvocationExpression]Type{},
-		InvocationExpressionTypeArguments:   map[*ast.InvocationExpression]*TypeParameterTypeOrderedMap{},
-		CastingStaticValueTypes:             map[*ast.CastingExpression]Type{},
-		CastingTargetTypes:                  map[*ast.CastingExpression]Type{},
-		ReturnStatementValueTypes:           map[*ast.ReturnStatement]Type{},
-		ReturnStatementReturnTypes:          map[*ast.ReturnStatement]Type{},
-		BinaryExpressionResultTypes:         map[*ast.BinaryExpression]Type{},
-		BinaryExpressionLeftTypes:           map[*ast.BinaryExpression]Type{},
-		BinaryExpressionRightTypes:          map[*ast.BinaryExpression]Type{},
-		MemberExpressionMemberInfos:         map[*ast.MemberExpression]MemberInfo{},
-		MemberExpressionExpectedTypes:       map[*ast.MemberExpression]Type{},
-		ArrayExpressionArgumentTypes:        map[*ast.ArrayExpression][]Type{},
-		ArrayExpressionArrayType:            map[*ast.ArrayExpression]ArrayType{},
-		DictionaryExpressionType:            map[*ast.DictionaryExpression]*DictionaryType{},
-		DictionaryExpressionEntryTypes:      map[*ast.DictionaryExpression][]DictionaryEntryType{},
-		IntegerExpressionType:               map[*ast.IntegerExpression]Type{},
-		StringExpressionType:                map[*ast.StringExpression]Type{},
-		FixedPointExpression:                map[*ast.FixedPointExpression]Type{},
-		TransactionDeclarationTypes:         map[*ast.TransactionDeclaration]*TransactionType{},
-		SwapStatementLeftTypes:              map[*ast.SwapStatement]Type{},
-		SwapStatementRightTypes:             map[*ast.SwapStatement]Type{},
-		IsNestedResourceMoveExpression:      map[ast.Expression]struct{}{},
-		CompositeNestedDeclarations:         map[*ast.CompositeDeclaration]map[string]ast.Declaration{},
-		InterfaceNestedDeclarations:         map[*ast.InterfaceDeclaration]map[string]ast.Declaration{},
+		ConstructorFunctionTypes:            map[ast.NodeID]*FunctionType{},
+		SpecialFunctionTypes:                map[ast.NodeID]*FunctionType{},
+		FunctionEffects:                     map[*FunctionType]FunctionEffects{},
+		FunctionCallees:                     map[*FunctionType][]*FunctionType{},
+		FunctionExpressionFunctionType:      map[ast.NodeID]*FunctionType{},
+		InvocationExpressionArgumentTypes:   map[ast.NodeID][]Type{},
+		InvocationExpressionParameterTypes:  map[ast.NodeID][]Type{},
+		InvocationExpressionReturnTypes:     map[ast.NodeID]Type{},
+		InvocationExpressionTypeArguments:   map[ast.NodeID]*TypeParameterTypeOrderedMap{},
+		CastingStaticValueTypes:             map[ast.NodeID]Type{},
+		CastingTargetTypes:                  map[ast.NodeID]Type{},
+		ReturnStatementValueTypes:           map[ast.NodeID]Type{},
+		ReturnStatementReturnTypes:          map[ast.NodeID]Type{},
+		BinaryExpressionResultTypes:         map[ast.NodeID]Type{},
+		BinaryExpressionLeftTypes:           map[ast.NodeID]Type{},
+		BinaryExpressionRightTypes:          map[ast.NodeID]Type{},
+		MemberExpressionMemberInfos:         map[ast.NodeID]MemberInfo{},
+		MemberExpressionExpectedTypes:       map[ast.NodeID]Type{},
+		ArrayExpressionArgumentTypes:        map[ast.NodeID][]Type{},
+		ArrayExpressionArrayType:            map[ast.NodeID]ArrayType{},
+		TupleExpressionArgumentTypes:        map[ast.NodeID][]Type{},
+		TupleExpressionTupleType:            map[ast.NodeID]*TupleType{},
+		DictionaryExpressionType:            map[ast.NodeID]*DictionaryType{},
+		DictionaryExpressionEntryTypes:      map[ast.NodeID][]DictionaryEntryType{},
+		IntegerExpressionType:               map[ast.NodeID]Type{},
+		StringExpressionType:                map[ast.NodeID]Type{},
+		FixedPointExpression:                map[ast.NodeID]Type{},
+		TransactionDeclarationTypes:         map[ast.NodeID]*TransactionType{},
+		SwapStatementLeftTypes:              map[ast.NodeID]Type{},
+		SwapStatementRightTypes:             map[ast.NodeID]Type{},
+		IsNestedResourceMoveExpression:      map[ast.NodeID]struct{}{},
+		CompositeNestedDeclarations:         map[ast.NodeID]map[string]ast.Declaration{},
+		InterfaceNestedDeclarations:         map[ast.NodeID]map[string]ast.Declaration{},
 		PostConditionsRewrite:               map[*ast.Conditions]PostConditionsRewrite{},
-		EmitStatementEventTypes:             map[*ast.EmitStatement]*CompositeType{},
+		EmitStatementEventTypes:             map[ast.NodeID]*CompositeType{},
+		AttachExpressionAttachmentTypes:     map[ast.NodeID]*CompositeType{},
+		AttachmentAccessTypes:               map[ast.NodeID]*CompositeType{},
+		TupleElementAccessIndices:           map[ast.NodeID]int{},
+		AttachmentRemoveTypes:               map[ast.NodeID]*CompositeType{},
+		TypeAliasDeclarationTypes:           map[ast.NodeID]Type{},
 		CompositeTypes:                      map[TypeID]*CompositeType{},
 		InterfaceTypes:                      map[TypeID]*InterfaceType{},
-		IdentifierInInvocationTypes:         map[*ast.IdentifierExpression]Type{},
-		ImportDeclarationsResolvedLocations: map[*ast.ImportDeclaration][]ResolvedLocation{},
+		IdentifierInInvocationTypes:         map[ast.NodeID]Type{},
+		ImportDeclarationsResolvedLocations: map[ast.NodeID][]ResolvedLocation{},
 		GlobalValues:                        &StringVariableOrderedMap{},
 		GlobalTypes:                         &StringVariableOrderedMap{},
 		EffectivePredeclaredValues:          map[string]ValueDeclaration{},
 		EffectivePredeclaredTypes:           map[string]TypeDeclaration{},
-		ReferenceExpressionBorrowTypes:      map[*ast.ReferenceExpression]Type{},
-		IndexExpressionIndexedTypes:         map[*ast.IndexExpression]ValueIndexableType{},
-		IndexExpressionIndexingTypes:        map[*ast.IndexExpression]Type{},
+		ReferenceExpressionBorrowTypes:      map[ast.NodeID]Type{},
+		IndexExpressionIndexedTypes:         map[ast.NodeID]ValueIndexableType{},
+		IndexExpressionIndexingTypes:        map[ast.NodeID]Type{},
 	}
 	if extendedElaboration {
-		elaboration.ForceExpressionTypes = map[*ast.ForceExpression]Type{}
-		elaboration.StaticCastTypes = map[*ast.CastingExpression]CastType{}
-		elaboration.RuntimeCastTypes = map[*ast.CastingExpression]struct {
+		elaboration.ForceExpressionTypes = map[ast.NodeID]Type{}
+		elaboration.StaticCastTypes = map[ast.NodeID]CastType{}
+		elaboration.RuntimeCastTypes = map[ast.NodeID]struct {
 			Left  Type
 			Right Type
 		}{}
-		elaboration.NumberConversionArgumentTypes = map[ast.Expression]struct {
+		elaboration.NumberConversionArgumentTypes = map[ast.NodeID]struct {
 			Type  Type
 			Range ast.Range
 		}{}
@@ -190,6 +230,18 @@ func (e *Elaboration) setIsChecking(isChecking bool) {
 	e.isChecking = isChecking
 }
 
+// TypeAtPosition returns the static type, declaration kind, and doc string
+// of the innermost expression or identifier which contains the given position, if any.
+//
+// Returns nil if position info was not enabled when checking the program.
+//
+func (e *Elaboration) TypeAtPosition(pos Position) *PositionType {
+	if e.PositionTypes == nil {
+		return nil
+	}
+	return e.PositionTypes.FindInnermost(pos)
+}
+
 // FunctionEntryPointType returns the type of the entry point function declaration, if any.
 //
 // Returns an error if no valid entry point function declaration exists.
@@ -212,3 +264,108 @@ func (e *Elaboration) FunctionEntryPointType() (*FunctionType, error) {
 
 	return functionType, nil
 }
+
+// IsViewFunctionEntryPoint returns true if the entry point function declaration
+// is a view function, i.e. the program is guaranteed to be free of side effects.
+//
+// Returns an error if no valid entry point function declaration exists.
+//
+func (e *Elaboration) IsViewFunctionEntryPoint() (bool, error) {
+	functionType, err := e.FunctionEntryPointType()
+	if err != nil {
+		return false, err
+	}
+
+	return functionType.Purity == FunctionPurityView, nil
+}
+
+// FunctionTypeEffects returns the side effects which the function with the given type may have.
+//
+// The effects include the intrinsic effects of the function type,
+// the effects the function directly has, and the effects of all functions
+// which are (transitively) invoked by the function.
+//
+// NOTE: Invocations of function values (e.g. parameters) are not followed,
+// only invocations of functions which have a known type.
+//
+func (e *Elaboration) FunctionTypeEffects(functionType *FunctionType) FunctionEffects {
+	effects := FunctionEffectsNone
+
+	visited := map[*FunctionType]struct{}{}
+
+	var visit func(functionType *FunctionType)
+	visit = func(functionType *FunctionType) {
+		if _, ok := visited[functionType]; ok {
+			return
+		}
+		visited[functionType] = struct{}{}
+
+		effects |= functionType.Effects
+		effects |= e.FunctionEffects[functionType]
+
+		for _, callee := range e.FunctionCallees[functionType] {
+			visit(callee)
+		}
+	}
+
+	visit(functionType)
+
+	return effects
+}
+
+// FunctionDeclarationEffects returns the side effects which the given function may have.
+//
+// See FunctionTypeEffects.
+//
+func (e *Elaboration) FunctionDeclarationEffects(declaration *ast.FunctionDeclaration) FunctionEffects {
+	functionType, ok := e.FunctionDeclarationFunctionTypes[declaration.ID()]
+	if !ok {
+		return FunctionEffectsNone
+	}
+	return e.FunctionTypeEffects(functionType)
+}
+
+// SpecialFunctionDeclarationEffects returns the side effects which the given special function,
+// e.g. an initializer or destructor, may have.
+//
+// See FunctionTypeEffects.
+//
+func (e *Elaboration) SpecialFunctionDeclarationEffects(declaration *ast.SpecialFunctionDeclaration) FunctionEffects {
+	functionType, ok := e.SpecialFunctionTypes[declaration.ID()]
+	if !ok {
+		return FunctionEffectsNone
+	}
+	return e.FunctionTypeEffects(functionType)
+}
+
+// EventTypes returns the types of all events which are declared or emitted in the program,
+// ordered by type ID.
+//
+// The fields of an event are the parameters of its constructor,
+// i.e. the type's constructor parameters provide the type and index of each field.
+//
+func (e *Elaboration) EventTypes() []*CompositeType {
+	eventTypes := map[TypeID]*CompositeType{}
+
+	for _, compositeType := range e.CompositeTypes {
+		if compositeType.Kind != common.CompositeKindEvent {
+			continue
+		}
+		eventTypes[compositeType.ID()] = compositeType
+	}
+
+	for _, eventType := range e.EmitStatementEventTypes {
+		eventTypes[eventType.ID()] = eventType
+	}
+
+	result := make([]*CompositeType, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		result = append(result, eventType)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID() < result[j].ID()
+	})
+
+	return result
+}
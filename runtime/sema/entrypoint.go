@@ -55,22 +55,66 @@ func FunctionEntryPointDeclaration(program *ast.Program) *ast.FunctionDeclaratio
 	return entryPointFunctionDeclaration
 }
 
-// EntryPointParameters returns the parameters of the transaction or script, if any.
+// EntryPoint is the entry point of a transaction or script,
+// i.e. the transaction declaration or the entry point function declaration.
+//
+type EntryPoint struct {
+	Parameters     []*Parameter
+	PreConditions  *ast.Conditions
+	PostConditions *ast.Conditions
+}
+
+// EntryPoint returns the entry point of the transaction or script, if any.
 //
 // Returns nil if the program specifies both a valid transaction and entry point function declaration.
 //
-func (checker *Checker) EntryPointParameters() []*Parameter {
-	transactionDeclaration := checker.Program.SoleTransactionDeclaration()
+func (checker *Checker) EntryPoint() *EntryPoint {
+	return ProgramEntryPoint(checker.Program, checker.Elaboration)
+}
+
+// ProgramEntryPoint returns the entry point of the given checked program, if any.
+//
+// Returns nil if the program specifies both a valid transaction and entry point function declaration.
+//
+func ProgramEntryPoint(program *ast.Program, elaboration *Elaboration) *EntryPoint {
+	transactionDeclaration := program.SoleTransactionDeclaration()
 	if transactionDeclaration != nil {
-		transactionType := checker.Elaboration.TransactionDeclarationTypes[transactionDeclaration]
-		return transactionType.Parameters
+		transactionType := elaboration.TransactionDeclarationTypes[transactionDeclaration.ID()]
+		return &EntryPoint{
+			Parameters:     transactionType.Parameters,
+			PreConditions:  transactionDeclaration.PreConditions,
+			PostConditions: transactionDeclaration.PostConditions,
+		}
 	}
 
-	functionDeclaration := FunctionEntryPointDeclaration(checker.Program)
+	functionDeclaration := FunctionEntryPointDeclaration(program)
 	if functionDeclaration != nil {
-		functionType := checker.Elaboration.FunctionDeclarationFunctionTypes[functionDeclaration]
-		return functionType.Parameters
+		functionType := elaboration.FunctionDeclarationFunctionTypes[functionDeclaration.ID()]
+		entryPoint := &EntryPoint{
+			Parameters: functionType.Parameters,
+		}
+
+		functionBlock := functionDeclaration.FunctionBlock
+		if functionBlock != nil {
+			entryPoint.PreConditions = functionBlock.PreConditions
+			entryPoint.PostConditions = functionBlock.PostConditions
+		}
+
+		return entryPoint
 	}
 
 	return nil
 }
+
+// EntryPointParameters returns the parameters of the transaction or script, if any.
+//
+// Returns nil if the program specifies both a valid transaction and entry point function declaration.
+//
+func (checker *Checker) EntryPointParameters() []*Parameter {
+	entryPoint := checker.EntryPoint()
+	if entryPoint == nil {
+		return nil
+	}
+
+	return entryPoint.Parameters
+}
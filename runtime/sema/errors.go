@@ -363,6 +363,27 @@ func (e *NotEquatableTypeError) Error() string {
 	)
 }
 
+// NotComparableTypeError
+
+type NotComparableTypeError struct {
+	Type Type
+	ast.Range
+}
+
+var _ SemanticError = &NotComparableTypeError{}
+var _ errors.UserError = &NotComparableTypeError{}
+
+func (*NotComparableTypeError) isSemanticError() {}
+
+func (*NotComparableTypeError) IsUserError() {}
+
+func (e *NotComparableTypeError) Error() string {
+	return fmt.Sprintf(
+		"cannot order values which have type: `%s`",
+		e.Type.QualifiedString(),
+	)
+}
+
 // NotCallableError
 
 type NotCallableError struct {
@@ -466,6 +487,61 @@ func (e *IncorrectArgumentLabelError) SecondaryError() string {
 	)
 }
 
+// MissingDefaultArgumentError is reported when a parameter without a default argument
+// is declared after a parameter with a default argument
+
+type MissingDefaultArgumentError struct {
+	Name string
+	ast.Range
+}
+
+var _ SemanticError = &MissingDefaultArgumentError{}
+var _ errors.UserError = &MissingDefaultArgumentError{}
+var _ errors.SecondaryError = &MissingDefaultArgumentError{}
+
+func (*MissingDefaultArgumentError) isSemanticError() {}
+
+func (*MissingDefaultArgumentError) IsUserError() {}
+
+func (e *MissingDefaultArgumentError) Error() string {
+	return fmt.Sprintf(
+		"missing default argument for parameter `%s`",
+		e.Name,
+	)
+}
+
+func (e *MissingDefaultArgumentError) SecondaryError() string {
+	return "parameters following a parameter with a default argument must also have a default argument"
+}
+
+// InvalidDefaultArgumentError is reported when a default argument
+// is declared for a parameter which may not have one
+
+type InvalidDefaultArgumentError struct {
+	Name   string
+	Reason string
+	ast.Range
+}
+
+var _ SemanticError = &InvalidDefaultArgumentError{}
+var _ errors.UserError = &InvalidDefaultArgumentError{}
+var _ errors.SecondaryError = &InvalidDefaultArgumentError{}
+
+func (*InvalidDefaultArgumentError) isSemanticError() {}
+
+func (*InvalidDefaultArgumentError) IsUserError() {}
+
+func (e *InvalidDefaultArgumentError) Error() string {
+	return fmt.Sprintf(
+		"invalid default argument for parameter `%s`",
+		e.Name,
+	)
+}
+
+func (e *InvalidDefaultArgumentError) SecondaryError() string {
+	return e.Reason
+}
+
 // InvalidUnaryOperandError
 
 type InvalidUnaryOperandError struct {
@@ -829,6 +905,7 @@ type NotDeclaredMemberError struct {
 var _ SemanticError = &NotDeclaredMemberError{}
 var _ errors.UserError = &NotDeclaredMemberError{}
 var _ errors.SecondaryError = &NotDeclaredMemberError{}
+var _ errors.HasSuggestedFixes[ast.TextEdit] = &NotDeclaredMemberError{}
 
 func (*NotDeclaredMemberError) isSemanticError() {}
 
@@ -843,16 +920,102 @@ func (e *NotDeclaredMemberError) Error() string {
 }
 
 func (e *NotDeclaredMemberError) SecondaryError() string {
-	if optionalType, ok := e.Type.(*OptionalType); ok {
-		members := optionalType.Type.GetMembers()
-		name := e.Name
-		if _, ok := members[name]; ok {
-			return fmt.Sprintf("type is optional, consider optional-chaining: ?.%s", name)
-		}
+	if e.isOptionalMember() {
+		return fmt.Sprintf("type is optional, consider optional-chaining: ?.%s", e.Name)
+	}
+	if closestMember := e.findClosestMember(); closestMember != "" {
+		return fmt.Sprintf("unknown member, did you mean `%s`?", closestMember)
 	}
 	return "unknown member"
 }
 
+// isOptionalMember returns true if the accessed type is optional,
+// and the type it wraps has a member with the name
+//
+func (e *NotDeclaredMemberError) isOptionalMember() bool {
+	optionalType, ok := e.Type.(*OptionalType)
+	if !ok {
+		return false
+	}
+	_, ok = optionalType.Type.GetMembers()[e.Name]
+	return ok
+}
+
+// findClosestMember returns the name of the member of the accessed type
+// which is closest to the name of the undeclared member,
+// or an empty string, if no member is close enough
+//
+func (e *NotDeclaredMemberError) findClosestMember() (closestMember string) {
+	name := e.Name
+
+	// Only suggest members which are reasonably close,
+	// i.e. require at most one edit per three characters
+
+	closestDistance := len(name) / 3
+
+	for memberName := range e.Type.GetMembers() {
+		distance := levenshteinDistance(name, memberName)
+		if distance > closestDistance {
+			continue
+		}
+
+		// Prefer the lexicographically smaller name on ties,
+		// so the result is deterministic
+
+		if distance < closestDistance ||
+			closestMember == "" ||
+			memberName < closestMember {
+
+			closestMember = memberName
+			closestDistance = distance
+		}
+	}
+
+	return
+}
+
+func (e *NotDeclaredMemberError) SuggestedFixes(_ string) []errors.SuggestedFix[ast.TextEdit] {
+	if e.isOptionalMember() {
+		if e.Expression == nil || e.Expression.Optional {
+			return nil
+		}
+
+		accessPos := e.Expression.AccessPos
+
+		return []errors.SuggestedFix[ast.TextEdit]{
+			{
+				Message: "use optional chaining",
+				TextEdits: []ast.TextEdit{
+					{
+						Replacement: "?.",
+						Range: ast.Range{
+							StartPos: accessPos,
+							EndPos:   accessPos,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	closestMember := e.findClosestMember()
+	if closestMember == "" {
+		return nil
+	}
+
+	return []errors.SuggestedFix[ast.TextEdit]{
+		{
+			Message: fmt.Sprintf("rename to `%s`", closestMember),
+			TextEdits: []ast.TextEdit{
+				{
+					Replacement: closestMember,
+					Range:       e.Range,
+				},
+			},
+		},
+	}
+}
+
 // AssignmentToConstantMemberError
 
 // TODO: maybe split up into two errors:
@@ -899,11 +1062,16 @@ type FieldUninitializedError struct {
 	Name          string
 	ContainerType Type
 	Pos           ast.Position
+	// UninitializedBranches are the branches of conditionals which do not initialize the field
+	UninitializedBranches []ast.Range
+	// UninitializedReturns are the return statements at which the field is not initialized
+	UninitializedReturns []ast.Range
 }
 
 var _ SemanticError = &FieldUninitializedError{}
 var _ errors.UserError = &FieldUninitializedError{}
 var _ errors.SecondaryError = &FieldUninitializedError{}
+var _ errors.ErrorNotes = &FieldUninitializedError{}
 
 func (*FieldUninitializedError) isSemanticError() {}
 
@@ -930,6 +1098,40 @@ func (e *FieldUninitializedError) EndPosition(memoryGauge common.MemoryGauge) as
 	return e.Pos.Shifted(memoryGauge, length-1)
 }
 
+func (e *FieldUninitializedError) ErrorNotes() (notes []errors.ErrorNote) {
+	for _, branchRange := range e.UninitializedBranches {
+		notes = append(notes, &UninitializedBranchNote{
+			Range: branchRange,
+		})
+	}
+	for _, returnRange := range e.UninitializedReturns {
+		notes = append(notes, &UninitializedReturnNote{
+			Range: returnRange,
+		})
+	}
+	return
+}
+
+// UninitializedBranchNote
+
+type UninitializedBranchNote struct {
+	ast.Range
+}
+
+func (n UninitializedBranchNote) Message() string {
+	return "not initialized in this branch"
+}
+
+// UninitializedReturnNote
+
+type UninitializedReturnNote struct {
+	ast.Range
+}
+
+func (n UninitializedReturnNote) Message() string {
+	return "not initialized before this return"
+}
+
 // FieldTypeNotStorableError is an error that is reported for
 // fields of composite types that are not storable.
 //
@@ -1334,6 +1536,70 @@ func (e *NotExportedError) EndPosition(memoryGauge common.MemoryGauge) ast.Posit
 	return e.Pos.Shifted(memoryGauge, length-1)
 }
 
+// ImportCollisionError
+
+type ImportCollisionError struct {
+	Name           string
+	ImportedName   string
+	ImportLocation common.Location
+	PreviousPos    *ast.Position
+	ast.Range
+}
+
+var _ SemanticError = &ImportCollisionError{}
+var _ errors.UserError = &ImportCollisionError{}
+var _ errors.SecondaryError = &ImportCollisionError{}
+
+func (*ImportCollisionError) isSemanticError() {}
+
+func (*ImportCollisionError) IsUserError() {}
+
+func (e *ImportCollisionError) Error() string {
+	if e.Name != e.ImportedName {
+		return fmt.Sprintf(
+			"cannot import `%s` as `%s` from `%s`: `%s` is already declared",
+			e.ImportedName,
+			e.Name,
+			e.ImportLocation,
+			e.Name,
+		)
+	}
+
+	return fmt.Sprintf(
+		"cannot import `%s` from `%s`: `%s` is already declared",
+		e.ImportedName,
+		e.ImportLocation,
+		e.Name,
+	)
+}
+
+func (e *ImportCollisionError) SecondaryError() string {
+	return fmt.Sprintf(
+		"consider importing `%s` under a different name using an alias, e.g. `import %s as ... from ...`",
+		e.ImportedName,
+		e.ImportedName,
+	)
+}
+
+func (e *ImportCollisionError) ErrorNotes() []errors.ErrorNote {
+	if e.PreviousPos == nil || e.PreviousPos.Line < 1 {
+		return nil
+	}
+
+	previousStartPos := *e.PreviousPos
+	length := len(e.Name)
+	previousEndPos := previousStartPos.Shifted(nil, length-1)
+
+	return []errors.ErrorNote{
+		&RedeclarationNote{
+			Range: ast.NewUnmeteredRange(
+				previousStartPos,
+				previousEndPos,
+			),
+		},
+	}
+}
+
 // ImportedProgramError
 
 type ImportedProgramError struct {
@@ -1507,6 +1773,33 @@ func (e *InvalidAddressLiteralError) Error() string {
 	return "invalid address"
 }
 
+// InvalidAddressError
+
+type InvalidAddressError struct {
+	Address common.Address
+	Err     error
+	ast.Range
+}
+
+var _ SemanticError = &InvalidAddressError{}
+var _ errors.UserError = &InvalidAddressError{}
+
+func (*InvalidAddressError) isSemanticError() {}
+
+func (*InvalidAddressError) IsUserError() {}
+
+func (e *InvalidAddressError) Error() string {
+	return fmt.Sprintf(
+		"invalid address `%s`: %s",
+		e.Address.HexWithPrefix(),
+		e.Err,
+	)
+}
+
+func (e *InvalidAddressError) Unwrap() error {
+	return e.Err
+}
+
 // InvalidFixedPointLiteralRangeError
 
 type InvalidFixedPointLiteralRangeError struct {
@@ -1729,6 +2022,7 @@ type IncorrectTransferOperationError struct {
 var _ SemanticError = &IncorrectTransferOperationError{}
 var _ errors.UserError = &IncorrectTransferOperationError{}
 var _ errors.SecondaryError = &IncorrectTransferOperationError{}
+var _ errors.HasSuggestedFixes[ast.TextEdit] = &IncorrectTransferOperationError{}
 
 func (*IncorrectTransferOperationError) isSemanticError() {}
 
@@ -1745,6 +2039,22 @@ func (e *IncorrectTransferOperationError) SecondaryError() string {
 	)
 }
 
+func (e *IncorrectTransferOperationError) SuggestedFixes(_ string) []errors.SuggestedFix[ast.TextEdit] {
+	expectedOperator := e.ExpectedOperation.Operator()
+
+	return []errors.SuggestedFix[ast.TextEdit]{
+		{
+			Message: fmt.Sprintf("replace with `%s`", expectedOperator),
+			TextEdits: []ast.TextEdit{
+				{
+					Replacement: expectedOperator,
+					Range:       e.Range,
+				},
+			},
+		},
+	}
+}
+
 // InvalidConstructionError
 
 type InvalidConstructionError struct {
@@ -1924,6 +2234,42 @@ func (e *ResourceUseAfterInvalidationError) EndPosition(common.MemoryGauge) ast.
 	return e.EndPos
 }
 
+// InvalidatedReferenceError
+
+type InvalidatedReferenceError struct {
+	Invalidations []ResourceInvalidation
+	ast.Range
+}
+
+var _ SemanticError = &InvalidatedReferenceError{}
+var _ errors.UserError = &InvalidatedReferenceError{}
+var _ errors.SecondaryError = &InvalidatedReferenceError{}
+
+func (*InvalidatedReferenceError) isSemanticError() {}
+
+func (*InvalidatedReferenceError) IsUserError() {}
+
+func (e *InvalidatedReferenceError) Error() string {
+	return "invalidated reference: referenced resource may have been moved or destroyed"
+}
+
+func (e *InvalidatedReferenceError) SecondaryError() string {
+	return "reference used here after the referenced resource was invalidated"
+}
+
+func (e *InvalidatedReferenceError) ErrorNotes() (notes []errors.ErrorNote) {
+	for _, invalidation := range e.Invalidations {
+		notes = append(notes, &ResourceInvalidationNote{
+			ResourceInvalidation: invalidation,
+			Range: ast.NewUnmeteredRange(
+				invalidation.StartPos,
+				invalidation.EndPos,
+			),
+		})
+	}
+	return
+}
+
 // ResourceInvalidationNote
 
 type ResourceInvalidationNote struct {
@@ -1975,6 +2321,7 @@ type MissingMoveOperationError struct {
 
 var _ SemanticError = &MissingMoveOperationError{}
 var _ errors.UserError = &MissingMoveOperationError{}
+var _ errors.HasSuggestedFixes[ast.TextEdit] = &MissingMoveOperationError{}
 
 func (*MissingMoveOperationError) isSemanticError() {}
 
@@ -1992,6 +2339,23 @@ func (e *MissingMoveOperationError) EndPosition(common.MemoryGauge) ast.Position
 	return e.Pos
 }
 
+func (e *MissingMoveOperationError) SuggestedFixes(_ string) []errors.SuggestedFix[ast.TextEdit] {
+	return []errors.SuggestedFix[ast.TextEdit]{
+		{
+			Message: "insert move operation",
+			TextEdits: []ast.TextEdit{
+				{
+					Insertion: "<-",
+					Range: ast.Range{
+						StartPos: e.Pos,
+						EndPos:   e.Pos,
+					},
+				},
+			},
+		},
+	}
+}
+
 // InvalidMoveOperationError
 
 type InvalidMoveOperationError struct {
@@ -2014,6 +2378,58 @@ func (e *InvalidMoveOperationError) SecondaryError() string {
 	return "unexpected `<-`"
 }
 
+// NonReferenceTypeDereferenceError
+
+type NonReferenceTypeDereferenceError struct {
+	ActualType Type
+	ast.Range
+}
+
+var _ SemanticError = &NonReferenceTypeDereferenceError{}
+var _ errors.UserError = &NonReferenceTypeDereferenceError{}
+var _ errors.SecondaryError = &NonReferenceTypeDereferenceError{}
+
+func (*NonReferenceTypeDereferenceError) isSemanticError() {}
+
+func (*NonReferenceTypeDereferenceError) IsUserError() {}
+
+func (e *NonReferenceTypeDereferenceError) Error() string {
+	return fmt.Sprintf(
+		"cannot dereference non-reference type `%s`",
+		e.ActualType.QualifiedString(),
+	)
+}
+
+func (e *NonReferenceTypeDereferenceError) SecondaryError() string {
+	return "only references can be dereferenced"
+}
+
+// ResourceDereferenceError
+
+type ResourceDereferenceError struct {
+	ReferencedType Type
+	ast.Range
+}
+
+var _ SemanticError = &ResourceDereferenceError{}
+var _ errors.UserError = &ResourceDereferenceError{}
+var _ errors.SecondaryError = &ResourceDereferenceError{}
+
+func (*ResourceDereferenceError) isSemanticError() {}
+
+func (*ResourceDereferenceError) IsUserError() {}
+
+func (e *ResourceDereferenceError) Error() string {
+	return fmt.Sprintf(
+		"cannot dereference reference to resource type `%s`",
+		e.ReferencedType.QualifiedString(),
+	)
+}
+
+func (e *ResourceDereferenceError) SecondaryError() string {
+	return "dereferencing copies the referenced value, and resources cannot be copied"
+}
+
 // ResourceCapturingError
 
 type ResourceCapturingError struct {
@@ -2949,6 +3365,38 @@ func (e *InvalidTransactionPrepareParameterTypeError) Error() string {
 	)
 }
 
+// InvalidTransactionExecuteAuthAccountAccessError
+
+type InvalidTransactionExecuteAuthAccountAccessError struct {
+	Name string
+	Type Type
+	ast.Range
+}
+
+var _ SemanticError = &InvalidTransactionExecuteAuthAccountAccessError{}
+var _ errors.UserError = &InvalidTransactionExecuteAuthAccountAccessError{}
+var _ errors.SecondaryError = &InvalidTransactionExecuteAuthAccountAccessError{}
+
+func (*InvalidTransactionExecuteAuthAccountAccessError) isSemanticError() {}
+
+func (*InvalidTransactionExecuteAuthAccountAccessError) IsUserError() {}
+
+func (e *InvalidTransactionExecuteAuthAccountAccessError) Error() string {
+	return fmt.Sprintf(
+		"cannot access field `%s` of type `%s` in execute block",
+		e.Name,
+		e.Type.QualifiedString(),
+	)
+}
+
+func (e *InvalidTransactionExecuteAuthAccountAccessError) SecondaryError() string {
+	return fmt.Sprintf(
+		"authorizer accounts may only be accessed in the prepare block; "+
+			"consider storing only the values needed from `%s`",
+		AuthAccountType,
+	)
+}
+
 // InvalidNestedDeclarationError
 
 type InvalidNestedDeclarationError struct {
@@ -3188,50 +3636,130 @@ func (e *ConstantSizedArrayLiteralSizeError) SecondaryError() string {
 	)
 }
 
-// InvalidRestrictedTypeError
+// InvalidTupleIndexError
 
-type InvalidRestrictedTypeError struct {
-	Type Type
+type InvalidTupleIndexError struct {
 	ast.Range
 }
 
-var _ SemanticError = &InvalidRestrictedTypeError{}
-var _ errors.UserError = &InvalidRestrictedTypeError{}
+var _ SemanticError = &InvalidTupleIndexError{}
+var _ errors.UserError = &InvalidTupleIndexError{}
+var _ errors.SecondaryError = &InvalidTupleIndexError{}
 
-func (*InvalidRestrictedTypeError) isSemanticError() {}
+func (*InvalidTupleIndexError) isSemanticError() {}
 
-func (*InvalidRestrictedTypeError) IsUserError() {}
+func (*InvalidTupleIndexError) IsUserError() {}
 
-func (e *InvalidRestrictedTypeError) Error() string {
-	return fmt.Sprintf(
-		"cannot restrict type: %s",
-		e.Type.QualifiedString(),
-	)
+func (e *InvalidTupleIndexError) Error() string {
+	return "invalid tuple index"
 }
 
-// InvalidRestrictionTypeError
+func (e *InvalidTupleIndexError) SecondaryError() string {
+	return "tuples can only be indexed with an integer literal"
+}
 
-type InvalidRestrictionTypeError struct {
-	Type Type
+// TupleIndexOutOfBoundsError
+
+type TupleIndexOutOfBoundsError struct {
+	Index  *big.Int
+	Length int
 	ast.Range
 }
 
-var _ SemanticError = &InvalidRestrictionTypeError{}
-var _ errors.UserError = &InvalidRestrictionTypeError{}
+var _ SemanticError = &TupleIndexOutOfBoundsError{}
+var _ errors.UserError = &TupleIndexOutOfBoundsError{}
+var _ errors.SecondaryError = &TupleIndexOutOfBoundsError{}
 
-func (*InvalidRestrictionTypeError) isSemanticError() {}
+func (*TupleIndexOutOfBoundsError) isSemanticError() {}
 
-func (*InvalidRestrictionTypeError) IsUserError() {}
+func (*TupleIndexOutOfBoundsError) IsUserError() {}
 
-func (e *InvalidRestrictionTypeError) Error() string {
+func (e *TupleIndexOutOfBoundsError) Error() string {
 	return fmt.Sprintf(
-		"cannot restrict using non-resource/structure interface type: %s",
-		e.Type.QualifiedString(),
+		"tuple index out of bounds: %s",
+		e.Index,
 	)
 }
 
-// RestrictionCompositeKindMismatchError
-
+func (e *TupleIndexOutOfBoundsError) SecondaryError() string {
+	return fmt.Sprintf(
+		"expected index in range 0..<%d",
+		e.Length,
+	)
+}
+
+// TupleDestructuringCountMismatchError
+
+type TupleDestructuringCountMismatchError struct {
+	ExpectedCount int
+	ActualCount   int
+	ast.Range
+}
+
+var _ SemanticError = &TupleDestructuringCountMismatchError{}
+var _ errors.UserError = &TupleDestructuringCountMismatchError{}
+var _ errors.SecondaryError = &TupleDestructuringCountMismatchError{}
+
+func (*TupleDestructuringCountMismatchError) isSemanticError() {}
+
+func (*TupleDestructuringCountMismatchError) IsUserError() {}
+
+func (e *TupleDestructuringCountMismatchError) Error() string {
+	return "incorrect number of variables in tuple destructuring"
+}
+
+func (e *TupleDestructuringCountMismatchError) SecondaryError() string {
+	return fmt.Sprintf(
+		"expected %d, got %d",
+		e.ExpectedCount,
+		e.ActualCount,
+	)
+}
+
+// InvalidRestrictedTypeError
+
+type InvalidRestrictedTypeError struct {
+	Type Type
+	ast.Range
+}
+
+var _ SemanticError = &InvalidRestrictedTypeError{}
+var _ errors.UserError = &InvalidRestrictedTypeError{}
+
+func (*InvalidRestrictedTypeError) isSemanticError() {}
+
+func (*InvalidRestrictedTypeError) IsUserError() {}
+
+func (e *InvalidRestrictedTypeError) Error() string {
+	return fmt.Sprintf(
+		"cannot restrict type: %s",
+		e.Type.QualifiedString(),
+	)
+}
+
+// InvalidRestrictionTypeError
+
+type InvalidRestrictionTypeError struct {
+	Type Type
+	ast.Range
+}
+
+var _ SemanticError = &InvalidRestrictionTypeError{}
+var _ errors.UserError = &InvalidRestrictionTypeError{}
+
+func (*InvalidRestrictionTypeError) isSemanticError() {}
+
+func (*InvalidRestrictionTypeError) IsUserError() {}
+
+func (e *InvalidRestrictionTypeError) Error() string {
+	return fmt.Sprintf(
+		"cannot restrict using non-resource/structure interface type: %s",
+		e.Type.QualifiedString(),
+	)
+}
+
+// RestrictionCompositeKindMismatchError
+
 type RestrictionCompositeKindMismatchError struct {
 	CompositeKind         common.CompositeKind
 	PreviousCompositeKind common.CompositeKind
@@ -3552,6 +4080,86 @@ func (e *TypeParameterTypeMismatchError) SecondaryError() string {
 	)
 }
 
+// InvalidTypeParameterBoundError
+
+type InvalidTypeParameterBoundError struct {
+	TypeParameter *TypeParameter
+	TypeBound     Type
+	ast.Range
+}
+
+var _ SemanticError = &InvalidTypeParameterBoundError{}
+var _ errors.UserError = &InvalidTypeParameterBoundError{}
+var _ errors.SecondaryError = &InvalidTypeParameterBoundError{}
+
+func (*InvalidTypeParameterBoundError) isSemanticError() {}
+
+func (*InvalidTypeParameterBoundError) IsUserError() {}
+
+func (e *InvalidTypeParameterBoundError) Error() string {
+	return fmt.Sprintf(
+		"invalid type bound for type parameter %s: `%s`",
+		e.TypeParameter.Name,
+		e.TypeBound.QualifiedString(),
+	)
+}
+
+func (e *InvalidTypeParameterBoundError) SecondaryError() string {
+	return "type bounds of a type parameter must either all be resource types, or all be non-resource types"
+}
+
+// InvalidCompositeTypeParametersError
+
+type InvalidCompositeTypeParametersError struct {
+	CompositeKind common.CompositeKind
+	ast.Range
+}
+
+var _ SemanticError = &InvalidCompositeTypeParametersError{}
+var _ errors.UserError = &InvalidCompositeTypeParametersError{}
+var _ errors.SecondaryError = &InvalidCompositeTypeParametersError{}
+
+func (*InvalidCompositeTypeParametersError) isSemanticError() {}
+
+func (*InvalidCompositeTypeParametersError) IsUserError() {}
+
+func (e *InvalidCompositeTypeParametersError) Error() string {
+	return fmt.Sprintf(
+		"%s declarations cannot have type parameters",
+		e.CompositeKind.Name(),
+	)
+}
+
+func (e *InvalidCompositeTypeParametersError) SecondaryError() string {
+	return "only structures and resources can be generic"
+}
+
+// InfinitelyExpandingTypeError
+
+type InfinitelyExpandingTypeError struct {
+	Type *CompositeType
+	ast.Range
+}
+
+var _ SemanticError = &InfinitelyExpandingTypeError{}
+var _ errors.UserError = &InfinitelyExpandingTypeError{}
+var _ errors.SecondaryError = &InfinitelyExpandingTypeError{}
+
+func (*InfinitelyExpandingTypeError) isSemanticError() {}
+
+func (*InfinitelyExpandingTypeError) IsUserError() {}
+
+func (e *InfinitelyExpandingTypeError) Error() string {
+	return fmt.Sprintf(
+		"generic type `%s` expands infinitely",
+		e.Type.QualifiedString(),
+	)
+}
+
+func (e *InfinitelyExpandingTypeError) SecondaryError() string {
+	return "the type refers to itself with ever-growing type arguments"
+}
+
 // TypeMismatchWithDescriptionError
 
 type UnparameterizedTypeInstantiationError struct {
@@ -3614,6 +4222,9 @@ func (e *TypeAnnotationRequiredError) EndPosition(common.MemoryGauge) ast.Positi
 
 type CyclicImportsError struct {
 	Location common.Location
+	// Cycle is the path of imports which forms the cycle, if known,
+	// starting and ending with the cyclically imported location
+	Cycle []common.Location
 	ast.Range
 }
 
@@ -3625,7 +4236,20 @@ func (*CyclicImportsError) isSemanticError() {}
 func (*CyclicImportsError) IsUserError() {}
 
 func (e *CyclicImportsError) Error() string {
-	return fmt.Sprintf("cyclic import of `%s`", e.Location)
+	if len(e.Cycle) == 0 {
+		return fmt.Sprintf("cyclic import of `%s`", e.Location)
+	}
+
+	cycle := make([]string, 0, len(e.Cycle))
+	for _, location := range e.Cycle {
+		cycle = append(cycle, fmt.Sprintf("`%s`", location))
+	}
+
+	return fmt.Sprintf(
+		"cyclic import of `%s`: %s",
+		e.Location,
+		strings.Join(cycle, " -> "),
+	)
 }
 
 // SwitchDefaultPositionError
@@ -3645,6 +4269,41 @@ func (e *SwitchDefaultPositionError) Error() string {
 	return "the 'default' case must appear at the end of a 'switch' statement"
 }
 
+// MissingSwitchCasesError
+
+type MissingSwitchCasesError struct {
+	Type         *CompositeType
+	MissingCases []string
+	ast.Range
+}
+
+var _ SemanticError = &MissingSwitchCasesError{}
+var _ errors.UserError = &MissingSwitchCasesError{}
+var _ errors.SecondaryError = &MissingSwitchCasesError{}
+
+func (*MissingSwitchCasesError) isSemanticError() {}
+
+func (*MissingSwitchCasesError) IsUserError() {}
+
+func (e *MissingSwitchCasesError) Error() string {
+	return fmt.Sprintf(
+		"switch over `%s` must be exhaustive",
+		e.Type.QualifiedString(),
+	)
+}
+
+func (e *MissingSwitchCasesError) SecondaryError() string {
+	missingCases := make([]string, len(e.MissingCases))
+	for i, caseName := range e.MissingCases {
+		missingCases[i] = fmt.Sprintf("`%s`", caseName)
+	}
+
+	return fmt.Sprintf(
+		"missing %s; add the missing cases or a `default` case",
+		common.EnumerateWords(missingCases, "and"),
+	)
+}
+
 // MissingSwitchCaseStatementsError
 
 type MissingSwitchCaseStatementsError struct {
@@ -3733,3 +4392,376 @@ func (e *ExternalMutationError) SecondaryError() string {
 		e.ContainerType.QualifiedString(),
 	)
 }
+
+// InvalidBaseTypeError
+
+type InvalidBaseTypeError struct {
+	BaseType   Type
+	Attachment *CompositeType
+	ast.Range
+}
+
+var _ SemanticError = &InvalidBaseTypeError{}
+var _ errors.UserError = &InvalidBaseTypeError{}
+
+func (*InvalidBaseTypeError) isSemanticError() {}
+
+func (*InvalidBaseTypeError) IsUserError() {}
+
+func (e *InvalidBaseTypeError) Error() string {
+	return fmt.Sprintf(
+		"cannot declare attachment `%s` for `%s`: base type must be a structure or resource",
+		e.Attachment.QualifiedString(),
+		e.BaseType.QualifiedString(),
+	)
+}
+
+// InvalidAttachmentUsageError
+
+type InvalidAttachmentUsageError struct {
+	ast.Range
+}
+
+var _ SemanticError = &InvalidAttachmentUsageError{}
+var _ errors.UserError = &InvalidAttachmentUsageError{}
+
+func (*InvalidAttachmentUsageError) isSemanticError() {}
+
+func (*InvalidAttachmentUsageError) IsUserError() {}
+
+func (e *InvalidAttachmentUsageError) Error() string {
+	return "attachments can only be created in an `attach` expression"
+}
+
+// AttachNonAttachmentError
+
+type AttachNonAttachmentError struct {
+	Type Type
+	ast.Range
+}
+
+var _ SemanticError = &AttachNonAttachmentError{}
+var _ errors.UserError = &AttachNonAttachmentError{}
+
+func (*AttachNonAttachmentError) isSemanticError() {}
+
+func (*AttachNonAttachmentError) IsUserError() {}
+
+func (e *AttachNonAttachmentError) Error() string {
+	return fmt.Sprintf(
+		"cannot attach non-attachment type: `%s`",
+		e.Type.QualifiedString(),
+	)
+}
+
+// AttachToInvalidTypeError
+
+type AttachToInvalidTypeError struct {
+	Type       Type
+	Attachment *CompositeType
+	ast.Range
+}
+
+var _ SemanticError = &AttachToInvalidTypeError{}
+var _ errors.UserError = &AttachToInvalidTypeError{}
+
+func (*AttachToInvalidTypeError) isSemanticError() {}
+
+func (*AttachToInvalidTypeError) IsUserError() {}
+
+func (e *AttachToInvalidTypeError) Error() string {
+	return fmt.Sprintf(
+		"cannot attach `%s` to value of type `%s`",
+		e.Attachment.QualifiedString(),
+		e.Type.QualifiedString(),
+	)
+}
+
+func (e *AttachToInvalidTypeError) SecondaryError() string {
+	return fmt.Sprintf(
+		"attachment `%s` can only be attached to `%s`",
+		e.Attachment.QualifiedString(),
+		e.Attachment.baseType.QualifiedString(),
+	)
+}
+
+// InvalidTypeIndexingError
+
+type InvalidTypeIndexingError struct {
+	BaseType    Type
+	IndexedType Type
+	ast.Range
+}
+
+var _ SemanticError = &InvalidTypeIndexingError{}
+var _ errors.UserError = &InvalidTypeIndexingError{}
+
+func (*InvalidTypeIndexingError) isSemanticError() {}
+
+func (*InvalidTypeIndexingError) IsUserError() {}
+
+func (e *InvalidTypeIndexingError) Error() string {
+	return fmt.Sprintf(
+		"cannot index `%s` with type `%s`: only attachments for `%s` are valid indices",
+		e.BaseType.QualifiedString(),
+		e.IndexedType.QualifiedString(),
+		e.BaseType.QualifiedString(),
+	)
+}
+
+// InvalidAttachmentRemoveError
+
+type InvalidAttachmentRemoveError struct {
+	Attachment Type
+	BaseType   Type
+	ast.Range
+}
+
+var _ SemanticError = &InvalidAttachmentRemoveError{}
+var _ errors.UserError = &InvalidAttachmentRemoveError{}
+
+func (*InvalidAttachmentRemoveError) isSemanticError() {}
+
+func (*InvalidAttachmentRemoveError) IsUserError() {}
+
+func (e *InvalidAttachmentRemoveError) Error() string {
+	return fmt.Sprintf(
+		"cannot remove `%s` from value of type `%s`",
+		e.Attachment.QualifiedString(),
+		e.BaseType.QualifiedString(),
+	)
+}
+
+// InvalidTryExpressionError
+
+type InvalidTryExpressionError struct {
+	ast.Range
+}
+
+var _ SemanticError = &InvalidTryExpressionError{}
+var _ errors.UserError = &InvalidTryExpressionError{}
+var _ errors.SecondaryError = &InvalidTryExpressionError{}
+
+func (*InvalidTryExpressionError) isSemanticError() {}
+
+func (*InvalidTryExpressionError) IsUserError() {}
+
+func (e *InvalidTryExpressionError) Error() string {
+	return "cannot use `try` with non-invocation expression"
+}
+
+func (e *InvalidTryExpressionError) SecondaryError() string {
+	return "only function calls can be recovered from"
+}
+
+// InvalidTryResourceError
+
+type InvalidTryResourceError struct {
+	ast.Range
+}
+
+var _ SemanticError = &InvalidTryResourceError{}
+var _ errors.UserError = &InvalidTryResourceError{}
+var _ errors.SecondaryError = &InvalidTryResourceError{}
+
+func (*InvalidTryResourceError) isSemanticError() {}
+
+func (*InvalidTryResourceError) IsUserError() {}
+
+func (e *InvalidTryResourceError) Error() string {
+	return "cannot use `try` with invocation involving resources"
+}
+
+func (e *InvalidTryResourceError) SecondaryError() string {
+	return "resources cannot be passed to or returned from a recoverable call"
+}
+
+// PurityError
+
+type PurityError struct {
+	Operation ImpureOperation
+	ast.Range
+}
+
+var _ SemanticError = &PurityError{}
+var _ errors.UserError = &PurityError{}
+
+func (*PurityError) isSemanticError() {}
+
+func (*PurityError) IsUserError() {}
+
+func (e *PurityError) Error() string {
+	return fmt.Sprintf(
+		"impure operation performed in view function: %s",
+		e.Operation.Description(),
+	)
+}
+
+// CyclicTypeAliasError
+
+type CyclicTypeAliasError struct {
+	// Cycle is the path of type aliases which forms the cycle,
+	// starting and ending with the same type alias
+	Cycle []string
+	ast.Range
+}
+
+var _ SemanticError = &CyclicTypeAliasError{}
+var _ errors.UserError = &CyclicTypeAliasError{}
+
+func (*CyclicTypeAliasError) isSemanticError() {}
+
+func (*CyclicTypeAliasError) IsUserError() {}
+
+func (e *CyclicTypeAliasError) Error() string {
+	cycle := make([]string, 0, len(e.Cycle))
+	for _, identifier := range e.Cycle {
+		cycle = append(cycle, fmt.Sprintf("`%s`", identifier))
+	}
+
+	return fmt.Sprintf(
+		"cyclic type alias declarations: %s",
+		strings.Join(cycle, " -> "),
+	)
+}
+
+// TypeNestingDepthLimitReachedError is reported when the type nesting depth limit was reached
+//
+type TypeNestingDepthLimitReachedError struct {
+	Limit int
+	ast.Range
+}
+
+var _ SemanticError = &TypeNestingDepthLimitReachedError{}
+var _ errors.UserError = &TypeNestingDepthLimitReachedError{}
+
+func (*TypeNestingDepthLimitReachedError) isSemanticError() {}
+
+func (*TypeNestingDepthLimitReachedError) IsUserError() {}
+
+func (e *TypeNestingDepthLimitReachedError) Error() string {
+	return fmt.Sprintf(
+		"program too complex, reached max type nesting depth limit %d",
+		e.Limit,
+	)
+}
+
+// RestrictionCountLimitReachedError is reported when the restriction count limit was reached
+//
+type RestrictionCountLimitReachedError struct {
+	Count int
+	Limit int
+	ast.Range
+}
+
+var _ SemanticError = &RestrictionCountLimitReachedError{}
+var _ errors.UserError = &RestrictionCountLimitReachedError{}
+
+func (*RestrictionCountLimitReachedError) isSemanticError() {}
+
+func (*RestrictionCountLimitReachedError) IsUserError() {}
+
+func (e *RestrictionCountLimitReachedError) Error() string {
+	return fmt.Sprintf(
+		"program too complex, reached max restriction count limit %d: got %d",
+		e.Limit,
+		e.Count,
+	)
+}
+
+// ParameterCountLimitReachedError is reported when the function parameter count limit was reached
+//
+type ParameterCountLimitReachedError struct {
+	Count int
+	Limit int
+	ast.Range
+}
+
+var _ SemanticError = &ParameterCountLimitReachedError{}
+var _ errors.UserError = &ParameterCountLimitReachedError{}
+
+func (*ParameterCountLimitReachedError) isSemanticError() {}
+
+func (*ParameterCountLimitReachedError) IsUserError() {}
+
+func (e *ParameterCountLimitReachedError) Error() string {
+	return fmt.Sprintf(
+		"program too complex, reached max function parameter count limit %d: got %d",
+		e.Limit,
+		e.Count,
+	)
+}
+
+// RecursionDepthLimitReachedError is reported when the checker recursion depth limit was reached
+//
+type RecursionDepthLimitReachedError struct {
+	Limit int
+	ast.Range
+}
+
+var _ SemanticError = &RecursionDepthLimitReachedError{}
+var _ errors.UserError = &RecursionDepthLimitReachedError{}
+
+func (*RecursionDepthLimitReachedError) isSemanticError() {}
+
+func (*RecursionDepthLimitReachedError) IsUserError() {}
+
+func (e *RecursionDepthLimitReachedError) Error() string {
+	return fmt.Sprintf(
+		"program too complex, reached max checking recursion depth limit %d",
+		e.Limit,
+	)
+}
+
+// UnsupportedLanguageVersionError is reported when a program declares a language version
+// which is newer than the maximum allowed language version
+//
+type UnsupportedLanguageVersionError struct {
+	Version    LanguageVersion
+	MaxVersion LanguageVersion
+	ast.Range
+}
+
+var _ SemanticError = &UnsupportedLanguageVersionError{}
+var _ errors.UserError = &UnsupportedLanguageVersionError{}
+
+func (*UnsupportedLanguageVersionError) isSemanticError() {}
+
+func (*UnsupportedLanguageVersionError) IsUserError() {}
+
+func (e *UnsupportedLanguageVersionError) Error() string {
+	return fmt.Sprintf(
+		"unsupported language version %s: maximum allowed version is %s",
+		e.Version,
+		e.MaxVersion,
+	)
+}
+
+// ReadOnlyProgramMutationError is reported when a program which is checked to be read-only,
+// e.g. a script, accesses a function which modifies state
+//
+type ReadOnlyProgramMutationError struct {
+	Name          string
+	ContainerType Type
+	ast.Range
+}
+
+var _ SemanticError = &ReadOnlyProgramMutationError{}
+var _ errors.UserError = &ReadOnlyProgramMutationError{}
+var _ errors.SecondaryError = &ReadOnlyProgramMutationError{}
+
+func (*ReadOnlyProgramMutationError) isSemanticError() {}
+
+func (*ReadOnlyProgramMutationError) IsUserError() {}
+
+func (e *ReadOnlyProgramMutationError) Error() string {
+	return fmt.Sprintf(
+		"cannot modify state in read-only program: `%s` of `%s` modifies state",
+		e.Name,
+		e.ContainerType.QualifiedString(),
+	)
+}
+
+func (e *ReadOnlyProgramMutationError) SecondaryError() string {
+	return "Consider using a transaction instead"
+}
@@ -18,9 +18,16 @@
 
 package sema
 
+import (
+	"github.com/onflow/cadence/runtime/ast"
+)
+
 type FunctionActivation struct {
+	FunctionType         *FunctionType
 	ReturnType           Type
+	Purity               FunctionPurity
 	Loops                int
+	LoopLabels           []ast.Identifier
 	Switches             int
 	ValueActivationDepth int
 	ReturnInfo           *ReturnInfo
@@ -31,10 +38,26 @@ func (a FunctionActivation) InLoop() bool {
 	return a.Loops > 0
 }
 
+// LoopLabel returns the label of the enclosing loop with the given name, if any.
+//
+func (a FunctionActivation) LoopLabel(name string) *ast.Identifier {
+	for i := len(a.LoopLabels) - 1; i >= 0; i-- {
+		label := a.LoopLabels[i]
+		if label.Identifier == name {
+			return &label
+		}
+	}
+	return nil
+}
+
 func (a FunctionActivation) InSwitch() bool {
 	return a.Switches > 0
 }
 
+func (a FunctionActivation) IsView() bool {
+	return a.Purity == FunctionPurityView
+}
+
 type FunctionActivations struct {
 	activations []*FunctionActivation
 }
@@ -52,7 +75,9 @@ func (a *FunctionActivations) IsLocal() bool {
 
 func (a *FunctionActivations) EnterFunction(functionType *FunctionType, valueActivationDepth int) *FunctionActivation {
 	activation := &FunctionActivation{
+		FunctionType:         functionType,
 		ReturnType:           functionType.ReturnTypeAnnotation.Type,
+		Purity:               functionType.Purity,
 		ValueActivationDepth: valueActivationDepth,
 		ReturnInfo:           &ReturnInfo{},
 	}
@@ -84,10 +109,17 @@ func (a *FunctionActivations) Current() *FunctionActivation {
 	return a.activations[lastIndex]
 }
 
-func (a *FunctionActivations) WithLoop(f func()) {
-	a.Current().Loops++
+func (a *FunctionActivations) WithLoop(label *ast.Identifier, f func()) {
+	current := a.Current()
+	current.Loops++
+	if label != nil {
+		current.LoopLabels = append(current.LoopLabels, *label)
+	}
 	defer func() {
-		a.Current().Loops--
+		current.Loops--
+		if label != nil {
+			current.LoopLabels = current.LoopLabels[:len(current.LoopLabels)-1]
+		}
 	}()
 	f()
 }
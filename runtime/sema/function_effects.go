@@ -0,0 +1,47 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+// FunctionEffects is a set of side effects which a function may have.
+//
+// The effects of a function are determined by the checker
+// independently of the function's purity (see FunctionPurity).
+//
+type FunctionEffects uint
+
+const (
+	// FunctionEffectWriteStorage indicates that the function may write to account storage,
+	// e.g. save or load values, link or issue capabilities, or update contracts or keys
+	FunctionEffectWriteStorage FunctionEffects = 1 << iota
+	// FunctionEffectEmitEvent indicates that the function may emit events
+	FunctionEffectEmitEvent
+	// FunctionEffectLog indicates that the function may log
+	FunctionEffectLog
+	// FunctionEffectCallImportedContract indicates that the function may call
+	// a function of a contract declared in another location
+	FunctionEffectCallImportedContract
+)
+
+const FunctionEffectsNone FunctionEffects = 0
+
+// Has returns true if the set contains all of the given effects
+//
+func (e FunctionEffects) Has(effects FunctionEffects) bool {
+	return e&effects == effects
+}
@@ -0,0 +1,86 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/errors"
+)
+
+// FunctionPurity indicates if a function may have side effects (impure),
+// or is guaranteed to have none (view).
+//
+// View functions may not write to state outside of the function,
+// emit events, destroy resources, or invoke impure functions.
+//
+type FunctionPurity uint
+
+const (
+	FunctionPurityImpure FunctionPurity = iota
+	FunctionPurityView
+)
+
+func NewFunctionPurity(purity ast.FunctionPurity) FunctionPurity {
+	switch purity {
+	case ast.FunctionPurityUnspecified:
+		return FunctionPurityImpure
+	case ast.FunctionPurityView:
+		return FunctionPurityView
+	}
+
+	panic(errors.NewUnreachableError())
+}
+
+func (p FunctionPurity) Keyword() string {
+	switch p {
+	case FunctionPurityImpure:
+		return ""
+	case FunctionPurityView:
+		return "view"
+	}
+
+	panic(errors.NewUnreachableError())
+}
+
+// ImpureOperation is an operation which is not allowed in a view function
+//
+type ImpureOperation uint
+
+const (
+	ImpureOperationUnknown ImpureOperation = iota
+	ImpureOperationInvocation
+	ImpureOperationWrite
+	ImpureOperationEmit
+	ImpureOperationDestroy
+)
+
+func (o ImpureOperation) Description() string {
+	switch o {
+	case ImpureOperationInvocation:
+		return "invocation of non-view function"
+	case ImpureOperationWrite:
+		return "write to non-local state"
+	case ImpureOperationEmit:
+		return "emission of event"
+	case ImpureOperationDestroy:
+		return "destruction of resource"
+	}
+
+	panic(errors.NewUnreachableError())
+}
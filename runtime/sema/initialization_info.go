@@ -18,10 +18,21 @@
 
 package sema
 
+import (
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+)
+
 type InitializationInfo struct {
 	ContainerType           Type
 	FieldMembers            *MemberFieldDeclarationOrderedMap
 	InitializedFieldMembers *MemberSet
+	// UninitializedBranches are the branches which do not initialize a field member,
+	// even though the other branch of the conditional does
+	UninitializedBranches map[*Member][]ast.Range
+	// UninitializedReturns are the return statements
+	// at which a field member is not initialized yet
+	UninitializedReturns map[*Member][]ast.Range
 }
 
 func NewInitializationInfo(
@@ -32,6 +43,8 @@ func NewInitializationInfo(
 		ContainerType:           containerType,
 		FieldMembers:            fieldMembers,
 		InitializedFieldMembers: NewMemberSet(nil),
+		UninitializedBranches:   map[*Member][]ast.Range{},
+		UninitializedReturns:    map[*Member][]ast.Range{},
 	}
 }
 
@@ -49,3 +62,56 @@ func (info *InitializationInfo) InitializationComplete() bool {
 
 	return true
 }
+
+// recordUninitializedReturn records the given return statement
+// for all field members which are not initialized yet
+//
+func (info *InitializationInfo) recordUninitializedReturn(returnRange ast.Range) {
+	for pair := info.FieldMembers.Oldest(); pair != nil; pair = pair.Next() {
+		member := pair.Key
+
+		if info.InitializedFieldMembers.Contains(member) {
+			continue
+		}
+
+		info.UninitializedReturns[member] = append(
+			info.UninitializedReturns[member],
+			returnRange,
+		)
+	}
+}
+
+// recordUninitializedBranches records the branch which does not initialize a field member,
+// for all field members which are only initialized in one of the two given branches.
+//
+// The position of a branch may be nil, if it is unknown
+//
+func (info *InitializationInfo) recordUninitializedBranches(
+	memoryGauge common.MemoryGauge,
+	thenInitializedMembers *MemberSet,
+	thenElement ast.HasPosition,
+	elseInitializedMembers *MemberSet,
+	elseElement ast.HasPosition,
+) {
+	record := func(initializedMembers, uninitializedMembers *MemberSet, uninitializedElement ast.HasPosition) {
+		if uninitializedElement == nil {
+			return
+		}
+
+		_ = initializedMembers.ForEach(func(member *Member) error {
+			if uninitializedMembers.Contains(member) {
+				return nil
+			}
+
+			info.UninitializedBranches[member] = append(
+				info.UninitializedBranches[member],
+				ast.NewRangeFromPositioned(memoryGauge, uninitializedElement),
+			)
+
+			return nil
+		})
+	}
+
+	record(thenInitializedMembers, elseInitializedMembers, elseElement)
+	record(elseInitializedMembers, thenInitializedMembers, thenElement)
+}
@@ -0,0 +1,100 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// LanguageVersion is a version of the Cadence language, e.g. 0.25.
+//
+// A program may declare the language version it is written in,
+// using the `#cadence(version: "0.25")` pragma.
+// The version determines which language features are enabled for the program.
+//
+type LanguageVersion struct {
+	Major uint64
+	Minor uint64
+}
+
+// DefaultLanguageVersion is the language version of programs
+// which do not declare a language version.
+//
+var DefaultLanguageVersion = LanguageVersion{Major: 0, Minor: 24}
+
+// LatestLanguageVersion is the latest language version supported by the checker.
+// It is the maximum allowed language version, unless the embedder restricts it further.
+//
+var LatestLanguageVersion = LanguageVersion{Major: 0, Minor: 25}
+
+// ParseLanguageVersion parses a language version of the form `<major>.<minor>`.
+//
+func ParseLanguageVersion(s string) (LanguageVersion, error) {
+	parts := strings.Split(s, ".")
+	if len(parts) != 2 {
+		return LanguageVersion{}, fmt.Errorf("invalid language version %q: expected <major>.<minor>", s)
+	}
+
+	major, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return LanguageVersion{}, fmt.Errorf("invalid major version %q", parts[0])
+	}
+
+	minor, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return LanguageVersion{}, fmt.Errorf("invalid minor version %q", parts[1])
+	}
+
+	return LanguageVersion{
+		Major: major,
+		Minor: minor,
+	}, nil
+}
+
+func (v LanguageVersion) String() string {
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
+
+// IsAtLeast returns true if the version is equal to or newer than the given version.
+//
+func (v LanguageVersion) IsAtLeast(other LanguageVersion) bool {
+	if v.Major != other.Major {
+		return v.Major > other.Major
+	}
+	return v.Minor >= other.Minor
+}
+
+// LanguageFeature is a language feature which is gated by the language version,
+// i.e. it is only enabled for programs which declare at least the feature's version.
+//
+type LanguageFeature struct {
+	Name    string
+	Version LanguageVersion
+}
+
+// LanguageFeatureLazyTypeAliasResolution allows type aliases
+// to refer to type aliases declared later in the same scope.
+// See WithLazyTypeAliasResolutionEnabled.
+//
+var LanguageFeatureLazyTypeAliasResolution = LanguageFeature{
+	Name:    "lazy type alias resolution",
+	Version: LanguageVersion{Major: 0, Minor: 25},
+}
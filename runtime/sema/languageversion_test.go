@@ -0,0 +1,56 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLanguageVersion(t *testing.T) {
+
+	t.Parallel()
+
+	version, err := ParseLanguageVersion("0.25")
+	require.NoError(t, err)
+	assert.Equal(t, LanguageVersion{Major: 0, Minor: 25}, version)
+	assert.Equal(t, "0.25", version.String())
+
+	for _, invalid := range []string{"", "1", "0.x", "x.1", "0.25.1", "-1.0"} {
+		_, err := ParseLanguageVersion(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestLanguageVersionIsAtLeast(t *testing.T) {
+
+	t.Parallel()
+
+	v0_24 := LanguageVersion{Major: 0, Minor: 24}
+	v0_25 := LanguageVersion{Major: 0, Minor: 25}
+	v1_0 := LanguageVersion{Major: 1, Minor: 0}
+
+	assert.True(t, v0_25.IsAtLeast(v0_24))
+	assert.True(t, v0_25.IsAtLeast(v0_25))
+	assert.False(t, v0_24.IsAtLeast(v0_25))
+	assert.True(t, v1_0.IsAtLeast(v0_25))
+	assert.False(t, v0_25.IsAtLeast(v1_0))
+}
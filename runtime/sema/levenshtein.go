@@ -0,0 +1,62 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+// levenshteinDistance returns the edit distance between the two strings,
+// i.e. the minimum number of single-byte insertions, deletions, and substitutions
+// required to turn one string into the other
+//
+func levenshteinDistance(a, b string) int {
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+
+	// Only keep the previous and current row of the distance matrix
+
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+
+		for j := 1; j <= len(b); j++ {
+			substitutionCost := 1
+			if a[i-1] == b[j-1] {
+				substitutionCost = 0
+			}
+
+			distance := previous[j] + 1
+			if insertion := current[j-1] + 1; insertion < distance {
+				distance = insertion
+			}
+			if substitution := previous[j-1] + substitutionCost; substitution < distance {
+				distance = substitution
+			}
+			current[j] = distance
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
@@ -0,0 +1,51 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLevenshteinDistance(t *testing.T) {
+
+	t.Parallel()
+
+	for _, testCase := range []struct {
+		a, b     string
+		distance int
+	}{
+		{"", "", 0},
+		{"", "abc", 3},
+		{"abc", "", 3},
+		{"balance", "balance", 0},
+		{"balanse", "balance", 1},
+		{"balnce", "balance", 1},
+		{"balances", "balance", 1},
+		{"kitten", "sitting", 3},
+		{"abc", "xyz", 3},
+	} {
+		assert.Equal(t,
+			testCase.distance,
+			levenshteinDistance(testCase.a, testCase.b),
+			"%s -> %s", testCase.a, testCase.b,
+		)
+	}
+}
@@ -0,0 +1,104 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/common/intervalst"
+)
+
+// PositionType is the static type information of a range in the program,
+// e.g. of an expression, or of an identifier in a declaration
+//
+type PositionType struct {
+	StartPos        Position
+	EndPos          Position
+	Type            Type
+	DeclarationKind common.DeclarationKind
+	DocString       string
+}
+
+type PositionTypes struct {
+	tree *intervalst.IntervalST
+}
+
+func NewPositionTypes() *PositionTypes {
+	return &PositionTypes{
+		tree: &intervalst.IntervalST{},
+	}
+}
+
+func (p *PositionTypes) Put(
+	startPos, endPos ast.Position,
+	ty Type,
+	declarationKind common.DeclarationKind,
+	docString string,
+) {
+	positionType := PositionType{
+		StartPos:        ASTToSemaPosition(startPos),
+		EndPos:          ASTToSemaPosition(endPos),
+		Type:            ty,
+		DeclarationKind: declarationKind,
+		DocString:       docString,
+	}
+	interval := intervalst.NewInterval(
+		positionType.StartPos,
+		positionType.EndPos,
+	)
+	p.tree.Put(interval, positionType)
+}
+
+// FindInnermost returns the type information of the innermost range
+// which contains the given position, if any.
+//
+// If multiple ranges are equal, the one with a known declaration kind is preferred,
+// e.g. the occurrence of an identifier over the identifier expression.
+//
+func (p *PositionTypes) FindInnermost(pos Position) *PositionType {
+	var result *PositionType
+
+	for _, entry := range p.tree.SearchAll(pos) {
+		positionType, ok := entry.Value.(PositionType)
+		if !ok {
+			continue
+		}
+
+		if result != nil && !isInnerPositionType(positionType, *result) {
+			continue
+		}
+
+		result = &positionType
+	}
+
+	return result
+}
+
+func isInnerPositionType(a, b PositionType) bool {
+	if startComparison := a.StartPos.Compare(b.StartPos); startComparison != 0 {
+		return startComparison > 0
+	}
+
+	if endComparison := a.EndPos.Compare(b.EndPos); endComparison != 0 {
+		return endComparison < 0
+	}
+
+	return a.DeclarationKind != common.DeclarationKindUnknown &&
+		b.DeclarationKind == common.DeclarationKindUnknown
+}
@@ -32,6 +32,7 @@ const PublicAccountGetCapabilityField = "getCapability"
 const PublicAccountGetTargetLinkField = "getLinkTarget"
 const PublicAccountKeysField = "keys"
 const PublicAccountContractsField = "contracts"
+const PublicAccountForEachPublicField = "forEachPublic"
 
 // PublicAccountType represents the publicly accessible portion of an account.
 //
@@ -105,6 +106,12 @@ var PublicAccountType = func() *CompositeType {
 			PublicAccountContractsType,
 			accountTypeContractsFieldDocString,
 		),
+		NewUnmeteredPublicFunctionMember(
+			publicAccountType,
+			PublicAccountForEachPublicField,
+			AccountForEachPublicFunctionType,
+			accountForEachPublicFunctionDocString,
+		),
 	}
 
 	publicAccountType.Members = GetMembersAsMap(members)
@@ -151,6 +158,7 @@ var PublicAccountTypeGetCapabilityFunctionType = func() *FunctionType {
 	}
 
 	return &FunctionType{
+		Purity: FunctionPurityView,
 		TypeParameters: []*TypeParameter{
 			typeParameter,
 		},
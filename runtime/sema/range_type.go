@@ -0,0 +1,301 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+)
+
+const RangeTypeName = "Range"
+const InclusiveRangeTypeName = "InclusiveRange"
+
+// RangeType is the type of range values, e.g. `0..<10` and `0...10`.
+//
+// A range is half-open (`Range<T>`) or closed (`InclusiveRange<T>`),
+// and its element type is an integer type.
+//
+type RangeType struct {
+	ElementType         Type
+	Inclusive           bool
+	memberResolvers     map[string]MemberResolver
+	memberResolversOnce sync.Once
+}
+
+var _ ParameterizedType = &RangeType{}
+
+func NewRangeType(memoryGauge common.MemoryGauge, elementType Type, inclusive bool) *RangeType {
+	common.UseMemory(memoryGauge, common.RangeSemaTypeMemoryUsage)
+	return &RangeType{
+		ElementType: elementType,
+		Inclusive:   inclusive,
+	}
+}
+
+func (*RangeType) IsType() {}
+
+func (t *RangeType) Tag() TypeTag {
+	return RangeTypeTag
+}
+
+func (t *RangeType) name() string {
+	if t.Inclusive {
+		return InclusiveRangeTypeName
+	}
+	return RangeTypeName
+}
+
+func (t *RangeType) string(typeFormatter func(Type) string) string {
+	var builder strings.Builder
+	builder.WriteString(t.name())
+	if t.ElementType != nil {
+		builder.WriteRune('<')
+		builder.WriteString(typeFormatter(t.ElementType))
+		builder.WriteRune('>')
+	}
+	return builder.String()
+}
+
+func (t *RangeType) String() string {
+	return t.string(func(t Type) string {
+		return t.String()
+	})
+}
+
+func (t *RangeType) QualifiedString() string {
+	return t.string(func(t Type) string {
+		return t.QualifiedString()
+	})
+}
+
+func (t *RangeType) ID() TypeID {
+	return TypeID(t.string(func(t Type) string {
+		return string(t.ID())
+	}))
+}
+
+func (t *RangeType) Equal(other Type) bool {
+	otherRange, ok := other.(*RangeType)
+	if !ok || otherRange.Inclusive != t.Inclusive {
+		return false
+	}
+	if otherRange.ElementType == nil {
+		return t.ElementType == nil
+	}
+	return otherRange.ElementType.Equal(t.ElementType)
+}
+
+func (*RangeType) IsResourceType() bool {
+	return false
+}
+
+func (t *RangeType) IsInvalidType() bool {
+	if t.ElementType == nil {
+		return false
+	}
+	return t.ElementType.IsInvalidType()
+}
+
+func (t *RangeType) TypeAnnotationState() TypeAnnotationState {
+	if t.ElementType == nil {
+		return TypeAnnotationStateValid
+	}
+	return t.ElementType.TypeAnnotationState()
+}
+
+func (*RangeType) IsStorable(_ map[*Member]bool) bool {
+	return false
+}
+
+func (*RangeType) IsExternallyReturnable(_ map[*Member]bool) bool {
+	return false
+}
+
+func (*RangeType) IsImportable(_ map[*Member]bool) bool {
+	return false
+}
+
+func (*RangeType) IsEquatable() bool {
+	return false
+}
+
+func (t *RangeType) RewriteWithRestrictedTypes() (Type, bool) {
+	return t, false
+}
+
+func (t *RangeType) Unify(
+	other Type,
+	typeParameters *TypeParameterTypeOrderedMap,
+	report func(err error),
+	outerRange ast.Range,
+) bool {
+	otherRange, ok := other.(*RangeType)
+	if !ok || otherRange.Inclusive != t.Inclusive {
+		return false
+	}
+
+	if t.ElementType == nil {
+		return false
+	}
+
+	return t.ElementType.Unify(otherRange.ElementType, typeParameters, report, outerRange)
+}
+
+func (t *RangeType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type {
+	var resolvedElementType Type
+	if t.ElementType != nil {
+		resolvedElementType = t.ElementType.Resolve(typeArguments)
+		if resolvedElementType == nil {
+			return nil
+		}
+	}
+
+	return &RangeType{
+		ElementType: resolvedElementType,
+		Inclusive:   t.Inclusive,
+	}
+}
+
+var rangeTypeParameter = &TypeParameter{
+	Name:      "T",
+	TypeBound: IntegerType,
+}
+
+func (t *RangeType) TypeParameters() []*TypeParameter {
+	return []*TypeParameter{
+		rangeTypeParameter,
+	}
+}
+
+func (t *RangeType) Instantiate(typeArguments []Type, _ func(err error)) Type {
+	return &RangeType{
+		ElementType: typeArguments[0],
+		Inclusive:   t.Inclusive,
+	}
+}
+
+func (t *RangeType) BaseType() Type {
+	if t.ElementType == nil {
+		return nil
+	}
+	return &RangeType{
+		Inclusive: t.Inclusive,
+	}
+}
+
+func (t *RangeType) TypeArguments() []Type {
+	return []Type{
+		t.elementType(),
+	}
+}
+
+// elementType returns the element type of the range,
+// or the type bound of the type parameter if the range type is not instantiated
+//
+func (t *RangeType) elementType() Type {
+	if t.ElementType == nil {
+		return rangeTypeParameter.TypeBound
+	}
+	return t.ElementType
+}
+
+const RangeTypeStartFieldName = "start"
+
+const rangeTypeStartFieldDocString = `
+The start of the range. The start is always included in the range
+`
+
+const RangeTypeEndFieldName = "end"
+
+const rangeTypeEndFieldDocString = `
+The end of the range. The end is only included in an inclusive range
+`
+
+const RangeTypeContainsFunctionName = "contains"
+
+const rangeTypeContainsFunctionDocString = `
+Returns true if the given integer is in the range
+`
+
+func RangeTypeContainsFunctionType(elementType Type) *FunctionType {
+	return &FunctionType{
+		Purity: FunctionPurityView,
+		Parameters: []*Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "element",
+				TypeAnnotation: NewTypeAnnotation(elementType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(BoolType),
+	}
+}
+
+func (t *RangeType) GetMembers() map[string]MemberResolver {
+	t.initializeMemberResolvers()
+	return t.memberResolvers
+}
+
+func (t *RangeType) initializeMemberResolvers() {
+	t.memberResolversOnce.Do(func() {
+		elementType := t.elementType()
+
+		t.memberResolvers = withBuiltinMembers(t, map[string]MemberResolver{
+			RangeTypeStartFieldName: {
+				Kind: common.DeclarationKindField,
+				Resolve: func(memoryGauge common.MemoryGauge, identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicConstantFieldMember(
+						memoryGauge,
+						t,
+						identifier,
+						elementType,
+						rangeTypeStartFieldDocString,
+					)
+				},
+			},
+			RangeTypeEndFieldName: {
+				Kind: common.DeclarationKindField,
+				Resolve: func(memoryGauge common.MemoryGauge, identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicConstantFieldMember(
+						memoryGauge,
+						t,
+						identifier,
+						elementType,
+						rangeTypeEndFieldDocString,
+					)
+				},
+			},
+			RangeTypeContainsFunctionName: {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(memoryGauge common.MemoryGauge, identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						memoryGauge,
+						t,
+						identifier,
+						RangeTypeContainsFunctionType(elementType),
+						rangeTypeContainsFunctionDocString,
+					)
+				},
+			},
+		})
+	})
+}
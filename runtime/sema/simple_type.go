@@ -58,6 +58,14 @@ func (t *SimpleType) Tag() TypeTag {
 	return t.tag
 }
 
+// SetTag sets the type tag of the type.
+// It may only be used for types which are not defined by Cadence itself,
+// with a type tag allocated using NewCustomTypeTag.
+//
+func (t *SimpleType) SetTag(tag TypeTag) {
+	t.tag = tag
+}
+
 func (t *SimpleType) String() string {
 	return t.Name
 }
@@ -0,0 +1,126 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/common"
+)
+
+const StorageCapabilityControllerTypeName = "StorageCapabilityController"
+const StorageCapabilityControllerTypeBorrowTypeFieldName = "borrowType"
+const StorageCapabilityControllerTypeCapabilityIDFieldName = "capabilityID"
+const StorageCapabilityControllerTypeTargetFunctionName = "target"
+const StorageCapabilityControllerTypeRetargetFunctionName = "retarget"
+const StorageCapabilityControllerTypeDeleteFunctionName = "delete"
+
+// StorageCapabilityControllerType represents the type `StorageCapabilityController`,
+// which controls a capability issued for a storage path
+//
+var StorageCapabilityControllerType = func() *CompositeType {
+
+	storageCapabilityControllerType := &CompositeType{
+		Identifier: StorageCapabilityControllerTypeName,
+		Kind:       common.CompositeKindStructure,
+		importable: false,
+	}
+
+	var members = []*Member{
+		NewUnmeteredPublicConstantFieldMember(
+			storageCapabilityControllerType,
+			StorageCapabilityControllerTypeBorrowTypeFieldName,
+			MetaType,
+			storageCapabilityControllerTypeBorrowTypeFieldDocString,
+		),
+		NewUnmeteredPublicConstantFieldMember(
+			storageCapabilityControllerType,
+			StorageCapabilityControllerTypeCapabilityIDFieldName,
+			UInt64Type,
+			storageCapabilityControllerTypeCapabilityIDFieldDocString,
+		),
+		NewUnmeteredPublicFunctionMember(
+			storageCapabilityControllerType,
+			StorageCapabilityControllerTypeTargetFunctionName,
+			StorageCapabilityControllerTypeTargetFunctionType,
+			storageCapabilityControllerTypeTargetFunctionDocString,
+		),
+		NewUnmeteredPublicFunctionMember(
+			storageCapabilityControllerType,
+			StorageCapabilityControllerTypeRetargetFunctionName,
+			StorageCapabilityControllerTypeRetargetFunctionType,
+			storageCapabilityControllerTypeRetargetFunctionDocString,
+		),
+		NewUnmeteredPublicFunctionMember(
+			storageCapabilityControllerType,
+			StorageCapabilityControllerTypeDeleteFunctionName,
+			StorageCapabilityControllerTypeDeleteFunctionType,
+			storageCapabilityControllerTypeDeleteFunctionDocString,
+		),
+	}
+
+	storageCapabilityControllerType.Members = GetMembersAsMap(members)
+	storageCapabilityControllerType.Fields = getFieldNames(members)
+	return storageCapabilityControllerType
+}()
+
+const storageCapabilityControllerTypeBorrowTypeFieldDocString = `
+The type of the controlled capability, i.e. the T in ` + "`Capability<T>`" + `
+`
+
+const storageCapabilityControllerTypeCapabilityIDFieldDocString = `
+The identifier of the controlled capability.
+All copies of a capability have the same ID
+`
+
+var StorageCapabilityControllerTypeTargetFunctionType = &FunctionType{
+	Purity:               FunctionPurityView,
+	ReturnTypeAnnotation: NewTypeAnnotation(StoragePathType),
+}
+
+const storageCapabilityControllerTypeTargetFunctionDocString = `
+Returns the targeted storage path of the controlled capability
+`
+
+var StorageCapabilityControllerTypeRetargetFunctionType = &FunctionType{
+	Effects: FunctionEffectWriteStorage,
+	Parameters: []*Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "target",
+			TypeAnnotation: NewTypeAnnotation(StoragePathType),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(VoidType),
+}
+
+const storageCapabilityControllerTypeRetargetFunctionDocString = `
+Retargets the controlled capability to the given storage path.
+The path may be different or the same as the current path
+`
+
+var StorageCapabilityControllerTypeDeleteFunctionType = &FunctionType{
+	Effects:              FunctionEffectWriteStorage,
+	ReturnTypeAnnotation: NewTypeAnnotation(VoidType),
+}
+
+const storageCapabilityControllerTypeDeleteFunctionDocString = `
+Deletes the controller and revokes the controlled capability.
+
+Borrowing the capability or checking it fails after the controller is deleted.
+The controller must not be used after it was deleted
+`
@@ -28,6 +28,18 @@ const StringTypeEncodeHexFunctionDocString = `
 Returns a hexadecimal string for the given byte array
 `
 
+const StringTypeEncodeBase64FunctionName = "encodeBase64"
+const StringTypeEncodeBase64FunctionDocString = `
+Returns a standard base64 encoded string, with padding, for the given byte array
+`
+
+const StringTypeFromUTF8FunctionName = "fromUTF8"
+const StringTypeFromUTF8FunctionDocString = `
+Returns the string for the given UTF-8 encoded byte array.
+
+Returns nil if the byte array is not valid UTF-8
+`
+
 // StringType represents the string type
 //
 var StringType = &SimpleType{
@@ -90,6 +102,30 @@ func init() {
 					)
 				},
 			},
+			"tryDecodeHex": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(memoryGauge common.MemoryGauge, identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						memoryGauge,
+						t,
+						identifier,
+						StringTypeTryDecodeHexFunctionType,
+						stringTypeTryDecodeHexFunctionDocString,
+					)
+				},
+			},
+			"decodeBase64": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(memoryGauge common.MemoryGauge, identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						memoryGauge,
+						t,
+						identifier,
+						StringTypeDecodeBase64FunctionType,
+						stringTypeDecodeBase64FunctionDocString,
+					)
+				},
+			},
 			"utf8": {
 				Kind: common.DeclarationKindField,
 				Resolve: func(memoryGauge common.MemoryGauge, identifier string, _ ast.Range, _ func(error)) *Member {
@@ -131,6 +167,7 @@ func init() {
 }
 
 var StringTypeConcatFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	Parameters: []*Parameter{
 		{
 			Label:          ArgumentLabelNotRequired,
@@ -148,6 +185,7 @@ Returns a new string which contains the given string concatenated to the end of
 `
 
 var StringTypeSliceFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	Parameters: []*Parameter{
 		{
 			Identifier:     "from",
@@ -182,6 +220,7 @@ var ByteArrayArrayType = &VariableSizedType{
 }
 
 var StringTypeDecodeHexFunctionType = &FunctionType{
+	Purity:               FunctionPurityView,
 	ReturnTypeAnnotation: NewTypeAnnotation(ByteArrayType),
 }
 
@@ -192,6 +231,36 @@ The given string must only contain hexadecimal characters and must have an even
 If the string is malformed, the program aborts
 `
 
+var StringTypeTryDecodeHexFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		&OptionalType{
+			Type: ByteArrayType,
+		},
+	),
+}
+
+const stringTypeTryDecodeHexFunctionDocString = `
+Returns an array containing the bytes represented by the given hexadecimal string.
+
+Returns nil if the string does not only contain hexadecimal characters or does not have an even length
+`
+
+var StringTypeDecodeBase64FunctionType = &FunctionType{
+	Purity: FunctionPurityView,
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		&OptionalType{
+			Type: ByteArrayType,
+		},
+	),
+}
+
+const stringTypeDecodeBase64FunctionDocString = `
+Returns an array containing the bytes represented by the given standard base64 encoded string, with padding.
+
+Returns nil if the string is not valid base64
+`
+
 const stringTypeLengthFieldDocString = `
 The number of characters in the string
 `
@@ -201,6 +270,7 @@ The byte array of the UTF-8 encoding
 `
 
 var StringTypeToLowerFunctionType = &FunctionType{
+	Purity:               FunctionPurityView,
 	ReturnTypeAnnotation: NewTypeAnnotation(StringType),
 }
 
@@ -0,0 +1,225 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+)
+
+// TupleType is the type of tuple values, e.g. `(Int, String)`.
+//
+// A tuple is a fixed-size, heterogeneous sequence of values.
+// A tuple type is a resource type if any of its element types is a resource type.
+//
+type TupleType struct {
+	ElementTypes        []Type
+	memberResolvers     map[string]MemberResolver
+	memberResolversOnce sync.Once
+}
+
+func NewTupleType(memoryGauge common.MemoryGauge, elementTypes []Type) *TupleType {
+	common.UseMemory(memoryGauge, common.TupleSemaTypeMemoryUsage)
+	return &TupleType{
+		ElementTypes: elementTypes,
+	}
+}
+
+func (*TupleType) IsType() {}
+
+func (t *TupleType) Tag() TypeTag {
+	return TupleTypeTag
+}
+
+func (t *TupleType) string(separator string, typeFormatter func(Type) string) string {
+	var builder strings.Builder
+	builder.WriteRune('(')
+	for i, elementType := range t.ElementTypes {
+		if i > 0 {
+			builder.WriteString(separator)
+		}
+		builder.WriteString(typeFormatter(elementType))
+	}
+	builder.WriteRune(')')
+	return builder.String()
+}
+
+func (t *TupleType) String() string {
+	return t.string(", ", func(t Type) string {
+		return t.String()
+	})
+}
+
+func (t *TupleType) QualifiedString() string {
+	return t.string(", ", func(t Type) string {
+		return t.QualifiedString()
+	})
+}
+
+func (t *TupleType) ID() TypeID {
+	return TypeID(t.string(",", func(t Type) string {
+		return string(t.ID())
+	}))
+}
+
+func (t *TupleType) Equal(other Type) bool {
+	otherTuple, ok := other.(*TupleType)
+	if !ok || len(otherTuple.ElementTypes) != len(t.ElementTypes) {
+		return false
+	}
+
+	for i, elementType := range t.ElementTypes {
+		if !elementType.Equal(otherTuple.ElementTypes[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (t *TupleType) IsResourceType() bool {
+	for _, elementType := range t.ElementTypes {
+		if elementType.IsResourceType() {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *TupleType) IsInvalidType() bool {
+	for _, elementType := range t.ElementTypes {
+		if elementType.IsInvalidType() {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *TupleType) TypeAnnotationState() TypeAnnotationState {
+	for _, elementType := range t.ElementTypes {
+		state := elementType.TypeAnnotationState()
+		if state != TypeAnnotationStateValid {
+			return state
+		}
+	}
+	return TypeAnnotationStateValid
+}
+
+func (*TupleType) IsStorable(_ map[*Member]bool) bool {
+	return false
+}
+
+func (t *TupleType) IsExternallyReturnable(results map[*Member]bool) bool {
+	for _, elementType := range t.ElementTypes {
+		if !elementType.IsExternallyReturnable(results) {
+			return false
+		}
+	}
+	return true
+}
+
+func (*TupleType) IsImportable(_ map[*Member]bool) bool {
+	return false
+}
+
+func (t *TupleType) IsEquatable() bool {
+	for _, elementType := range t.ElementTypes {
+		if !elementType.IsEquatable() {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *TupleType) RewriteWithRestrictedTypes() (Type, bool) {
+	var rewrittenElementTypes []Type
+
+	for i, elementType := range t.ElementTypes {
+		rewrittenType, rewritten := elementType.RewriteWithRestrictedTypes()
+		if !rewritten {
+			continue
+		}
+
+		if rewrittenElementTypes == nil {
+			rewrittenElementTypes = make([]Type, len(t.ElementTypes))
+			copy(rewrittenElementTypes, t.ElementTypes)
+		}
+		rewrittenElementTypes[i] = rewrittenType
+	}
+
+	if rewrittenElementTypes == nil {
+		return t, false
+	}
+
+	return &TupleType{
+		ElementTypes: rewrittenElementTypes,
+	}, true
+}
+
+func (t *TupleType) Unify(
+	other Type,
+	typeParameters *TypeParameterTypeOrderedMap,
+	report func(err error),
+	outerRange ast.Range,
+) bool {
+	otherTuple, ok := other.(*TupleType)
+	if !ok || len(otherTuple.ElementTypes) != len(t.ElementTypes) {
+		return false
+	}
+
+	result := false
+
+	for i, elementType := range t.ElementTypes {
+		if elementType.Unify(otherTuple.ElementTypes[i], typeParameters, report, outerRange) {
+			result = true
+		}
+	}
+
+	return result
+}
+
+func (t *TupleType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type {
+	resolvedElementTypes := make([]Type, len(t.ElementTypes))
+
+	for i, elementType := range t.ElementTypes {
+		resolvedElementType := elementType.Resolve(typeArguments)
+		if resolvedElementType == nil {
+			return nil
+		}
+		resolvedElementTypes[i] = resolvedElementType
+	}
+
+	return &TupleType{
+		ElementTypes: resolvedElementTypes,
+	}
+}
+
+func (t *TupleType) GetMembers() map[string]MemberResolver {
+	t.initializeMemberResolvers()
+	return t.memberResolvers
+}
+
+func (t *TupleType) initializeMemberResolvers() {
+	t.memberResolversOnce.Do(func() {
+		t.memberResolvers = withBuiltinMembers(t, nil)
+	})
+}
@@ -190,6 +190,16 @@ type MemberResolver struct {
 		targetRange ast.Range,
 		report func(error),
 	) *Member
+	declaredBy *InterfaceType
+}
+
+// DeclaredBy returns the restriction which grants access to the member,
+// if the resolver is a member of a restricted type.
+// It returns nil for all other members,
+// including the members of the restricted type itself.
+//
+func (r MemberResolver) DeclaredBy() *InterfaceType {
+	return r.declaredBy
 }
 
 // ContainedType is a type which might have a container type
@@ -315,6 +325,7 @@ func NewTypeAnnotation(ty Type) *TypeAnnotation {
 const IsInstanceFunctionName = "isInstance"
 
 var IsInstanceFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	Parameters: []*Parameter{
 		{
 			Label:      ArgumentLabelNotRequired,
@@ -338,6 +349,7 @@ Returns true if the object conforms to the given type at runtime
 const GetTypeFunctionName = "getType"
 
 var GetTypeFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	ReturnTypeAnnotation: NewTypeAnnotation(
 		MetaType,
 	),
@@ -352,6 +364,7 @@ Returns the type of the value
 const ToStringFunctionName = "toString"
 
 var ToStringFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	ReturnTypeAnnotation: NewTypeAnnotation(
 		StringType,
 	),
@@ -366,6 +379,7 @@ A textual representation of this object
 const ToBigEndianBytesFunctionName = "toBigEndianBytes"
 
 var toBigEndianBytesFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	ReturnTypeAnnotation: NewTypeAnnotation(
 		ByteArrayType,
 	),
@@ -456,9 +470,17 @@ type OptionalType struct {
 
 func NewOptionalType(memoryGauge common.MemoryGauge, typ Type) *OptionalType {
 	common.UseMemory(memoryGauge, common.OptionalSemaTypeMemoryUsage)
-	return &OptionalType{
-		Type: typ,
-	}
+	return globalTypePool.intern(
+		typePoolKey{
+			kind:  typePoolKindOptional,
+			first: typ,
+		},
+		func() Type {
+			return &OptionalType{
+				Type: typ,
+			}
+		},
+	).(*OptionalType)
 }
 
 func (*OptionalType) IsType() {}
@@ -498,6 +520,10 @@ func (t *OptionalType) Equal(other Type) bool {
 	if !ok {
 		return false
 	}
+	// Interned types are equal if they are identical
+	if otherOptional == t {
+		return true
+	}
 	return t.Type.Equal(otherOptional.Type)
 }
 
@@ -680,7 +706,15 @@ func (t *GenericType) Equal(other Type) bool {
 	return t.TypeParameter == otherType.TypeParameter
 }
 
-func (*GenericType) IsResourceType() bool {
+// IsResourceType returns true if any of the type bounds of the type parameter is a resource type,
+// i.e. if the type arguments for the type parameter are resource types
+//
+func (t *GenericType) IsResourceType() bool {
+	for _, typeBound := range t.TypeParameter.TypeBounds() {
+		if typeBound.IsResourceType() {
+			return true
+		}
+	}
 	return false
 }
 
@@ -761,8 +795,22 @@ func (t *GenericType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type {
 	return ty
 }
 
+// GetMembers returns the members of all type bounds of the type parameter,
+// as the type arguments for the type parameter have all of them
+//
 func (t *GenericType) GetMembers() map[string]MemberResolver {
-	return withBuiltinMembers(t, nil)
+	members := map[string]MemberResolver{}
+
+	for _, typeBound := range t.TypeParameter.TypeBounds() {
+		for name, resolver := range typeBound.GetMembers() {
+			if _, ok := members[name]; ok {
+				continue
+			}
+			members[name] = resolver
+		}
+	}
+
+	return withBuiltinMembers(t, members)
 }
 
 // IntegerRangedType
@@ -810,9 +858,30 @@ const numericTypeSaturatingDivideFunctionDocString = `
 self / other, saturating at the numeric bounds instead of overflowing.
 `
 
+const NumericTypeCheckedAddFunctionName = "checkedAdd"
+const numericTypeCheckedAddFunctionDocString = `
+self + other, or nil if the result overflows or underflows.
+`
+
+const NumericTypeCheckedSubtractFunctionName = "checkedSubtract"
+const numericTypeCheckedSubtractFunctionDocString = `
+self - other, or nil if the result overflows or underflows.
+`
+
+const NumericTypeCheckedMultiplyFunctionName = "checkedMultiply"
+const numericTypeCheckedMultiplyFunctionDocString = `
+self * other, or nil if the result overflows or underflows.
+`
+
+const NumericTypeCheckedDivideFunctionName = "checkedDivide"
+const numericTypeCheckedDivideFunctionDocString = `
+self / other, or nil if the result overflows or underflows, or other is zero.
+`
+
 func addSaturatingArithmeticFunctions(t SaturatingArithmeticType, members map[string]MemberResolver) {
 
 	arithmeticFunctionType := &FunctionType{
+		Purity: FunctionPurityView,
 		Parameters: []*Parameter{
 			{
 				Label:          ArgumentLabelNotRequired,
@@ -862,6 +931,79 @@ func addSaturatingArithmeticFunctions(t SaturatingArithmeticType, members map[st
 	}
 }
 
+// CheckedArithmeticType is a numeric type which may provide checked arithmetic functions.
+//
+type CheckedArithmeticType interface {
+	SaturatingArithmeticType
+	IsSuperType() bool
+}
+
+// addCheckedArithmeticFunctions adds the checked arithmetic functions,
+// which return nil instead of aborting if the result cannot be represented,
+// or if the divisor is zero.
+//
+// A checked function is only available if the operation may fail for the type:
+// Addition, subtraction, and multiplication may fail if the result overflows or underflows,
+// which is exactly the case when the saturating function is available.
+// Division may fail for all concrete types, as the divisor may be zero.
+//
+func addCheckedArithmeticFunctions(t CheckedArithmeticType, members map[string]MemberResolver) {
+
+	arithmeticFunctionType := &FunctionType{
+		Purity: FunctionPurityView,
+		Parameters: []*Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "other",
+				TypeAnnotation: NewTypeAnnotation(t),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			&OptionalType{
+				Type: t,
+			},
+		),
+	}
+
+	addArithmeticFunction := func(name string, docString string) {
+		members[name] = MemberResolver{
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(memoryGauge common.MemoryGauge, identifier string, targetRange ast.Range, report func(error)) *Member {
+				return NewPublicFunctionMember(
+					memoryGauge, t, name, arithmeticFunctionType, docString)
+			},
+		}
+	}
+
+	if t.SupportsSaturatingAdd() {
+		addArithmeticFunction(
+			NumericTypeCheckedAddFunctionName,
+			numericTypeCheckedAddFunctionDocString,
+		)
+	}
+
+	if t.SupportsSaturatingSubtract() {
+		addArithmeticFunction(
+			NumericTypeCheckedSubtractFunctionName,
+			numericTypeCheckedSubtractFunctionDocString,
+		)
+	}
+
+	if t.SupportsSaturatingMultiply() {
+		addArithmeticFunction(
+			NumericTypeCheckedMultiplyFunctionName,
+			numericTypeCheckedMultiplyFunctionDocString,
+		)
+	}
+
+	if !t.IsSuperType() {
+		addArithmeticFunction(
+			NumericTypeCheckedDivideFunctionName,
+			numericTypeCheckedDivideFunctionDocString,
+		)
+	}
+}
+
 // NumericType represent all the types in the integer range
 // and non-fractional ranged types.
 //
@@ -1019,6 +1161,7 @@ func (t *NumericType) initializeMemberResolvers() {
 		members := map[string]MemberResolver{}
 
 		addSaturatingArithmeticFunctions(t, members)
+		addCheckedArithmeticFunctions(t, members)
 
 		t.memberResolvers = withBuiltinMembers(t, members)
 	})
@@ -1221,6 +1364,7 @@ func (t *FixedPointNumericType) initializeMemberResolvers() {
 		members := map[string]MemberResolver{}
 
 		addSaturatingArithmeticFunctions(t, members)
+		addCheckedArithmeticFunctions(t, members)
 
 		t.memberResolvers = withBuiltinMembers(t, members)
 	})
@@ -1433,6 +1577,29 @@ var (
 			WithSaturatingAdd().
 			WithSaturatingSubtract().
 			WithSaturatingMultiply()
+
+	// Fix128Type represents the 128-bit signed decimal fixed-point type `Fix128`
+	// which has a scale of Fix128Scale, and checks for overflow and underflow
+	Fix128Type = NewFixedPointNumericType(Fix128TypeName).
+			WithTag(Fix128TypeTag).
+			WithIntRange(Fix128TypeMinIntBig, Fix128TypeMaxIntBig).
+			WithFractionalRange(Fix128TypeMinFractionalBig, Fix128TypeMaxFractionalBig).
+			WithScale(Fix128Scale).
+			WithSaturatingAdd().
+			WithSaturatingSubtract().
+			WithSaturatingMultiply().
+			WithSaturatingDivide()
+
+	// UFix128Type represents the 128-bit unsigned decimal fixed-point type `UFix128`
+	// which has a scale of Fix128Scale, and checks for overflow and underflow
+	UFix128Type = NewFixedPointNumericType(UFix128TypeName).
+			WithTag(UFix128TypeTag).
+			WithIntRange(UFix128TypeMinIntBig, UFix128TypeMaxIntBig).
+			WithFractionalRange(UFix128TypeMinFractionalBig, UFix128TypeMaxFractionalBig).
+			WithScale(Fix128Scale).
+			WithSaturatingAdd().
+			WithSaturatingSubtract().
+			WithSaturatingMultiply()
 )
 
 // Numeric type ranges
@@ -1533,6 +1700,26 @@ var (
 
 	UFix64TypeMinFractionalBig = fixedpoint.UFix64TypeMinFractionalBig
 	UFix64TypeMaxFractionalBig = fixedpoint.UFix64TypeMaxFractionalBig
+
+	Fix128FactorBig = fixedpoint.Fix128FactorBig
+
+	Fix128TypeMinBig = fixedpoint.Fix128TypeMinBig
+	Fix128TypeMaxBig = fixedpoint.Fix128TypeMaxBig
+
+	Fix128TypeMinIntBig = fixedpoint.Fix128TypeMinIntBig
+	Fix128TypeMaxIntBig = fixedpoint.Fix128TypeMaxIntBig
+
+	Fix128TypeMinFractionalBig = fixedpoint.Fix128TypeMinFractionalBig
+	Fix128TypeMaxFractionalBig = fixedpoint.Fix128TypeMaxFractionalBig
+
+	UFix128TypeMinBig = fixedpoint.UFix128TypeMinBig
+	UFix128TypeMaxBig = fixedpoint.UFix128TypeMaxBig
+
+	UFix128TypeMinIntBig = fixedpoint.UFix128TypeMinIntBig
+	UFix128TypeMaxIntBig = fixedpoint.UFix128TypeMaxIntBig
+
+	UFix128TypeMinFractionalBig = fixedpoint.UFix128TypeMinFractionalBig
+	UFix128TypeMaxFractionalBig = fixedpoint.UFix128TypeMaxFractionalBig
 )
 
 const Fix64Scale = fixedpoint.Fix64Scale
@@ -1550,6 +1737,8 @@ const UFix64TypeMaxInt = fixedpoint.UFix64TypeMaxInt
 const UFix64TypeMinFractional = fixedpoint.UFix64TypeMinFractional
 const UFix64TypeMaxFractional = fixedpoint.UFix64TypeMaxFractional
 
+const Fix128Scale = fixedpoint.Fix128Scale
+
 // ArrayType
 
 type ArrayType interface {
@@ -1562,6 +1751,20 @@ Returns the index of the first element matching the given object in the array, n
 Available if the array element type is not resource-kinded and equatable.
 `
 
+const arrayTypeSortFunctionDocString = `
+Sorts the array in place, using the given function as the comparison between two elements.
+
+The function must return true if the first element should be ordered before the second element.
+The sort is stable, i.e. the order of equal elements is preserved
+`
+
+const arrayTypeBinarySearchFunctionDocString = `
+Returns the index of the given element in the array, nil if the array does not contain the element.
+
+The array must be sorted in ascending order, e.g. by calling sort.
+Available if the array element type is comparable, i.e. a fixed-point or integer type.
+`
+
 const arrayTypeContainsFunctionDocString = `
 Returns true if the given object is in the array
 `
@@ -1713,6 +1916,59 @@ func getArrayMembers(arrayType ArrayType) map[string]MemberResolver {
 				)
 			},
 		},
+		"sort": {
+			Kind:     common.DeclarationKindFunction,
+			Mutating: true,
+			Resolve: func(memoryGauge common.MemoryGauge, identifier string, targetRange ast.Range, report func(error)) *Member {
+
+				elementType := arrayType.ElementType(false)
+
+				// Resources cannot be passed to the comparison function
+				// without moving them out of the array
+
+				if elementType.IsResourceType() {
+					report(
+						&InvalidResourceArrayMemberError{
+							Name:            identifier,
+							DeclarationKind: common.DeclarationKindFunction,
+							Range:           targetRange,
+						},
+					)
+				}
+
+				return NewPublicFunctionMember(
+					memoryGauge,
+					arrayType,
+					identifier,
+					ArraySortFunctionType(elementType),
+					arrayTypeSortFunctionDocString,
+				)
+			},
+		},
+		"binarySearch": {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(memoryGauge common.MemoryGauge, identifier string, targetRange ast.Range, report func(error)) *Member {
+
+				elementType := arrayType.ElementType(false)
+
+				if !IsComparableType(elementType) {
+					report(
+						&NotComparableTypeError{
+							Type:  elementType,
+							Range: targetRange,
+						},
+					)
+				}
+
+				return NewPublicFunctionMember(
+					memoryGauge,
+					arrayType,
+					identifier,
+					ArrayBinarySearchFunctionType(elementType),
+					arrayTypeBinarySearchFunctionDocString,
+				)
+			},
+		},
 	}
 
 	// TODO: maybe still return members but report a helpful error?
@@ -1885,9 +2141,38 @@ func getArrayMembers(arrayType ArrayType) map[string]MemberResolver {
 		}
 	}
 
+	// Byte arrays can be encoded
+
+	if arrayType.ElementType(false).Equal(UInt8Type) {
+
+		members[ArrayTypeToHexStringFunctionName] = MemberResolver{
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(memoryGauge common.MemoryGauge, identifier string, _ ast.Range, _ func(error)) *Member {
+				return NewPublicFunctionMember(
+					memoryGauge,
+					arrayType,
+					identifier,
+					ArrayToHexStringFunctionType,
+					arrayTypeToHexStringFunctionDocString,
+				)
+			},
+		}
+	}
+
 	return withBuiltinMembers(arrayType, members)
 }
 
+const ArrayTypeToHexStringFunctionName = "toHexString"
+
+const arrayTypeToHexStringFunctionDocString = `
+Returns a hexadecimal string for the bytes in this array
+`
+
+var ArrayToHexStringFunctionType = &FunctionType{
+	Purity:               FunctionPurityView,
+	ReturnTypeAnnotation: NewTypeAnnotation(StringType),
+}
+
 func ArrayRemoveLastFunctionType(elementType Type) *FunctionType {
 	return &FunctionType{
 		ReturnTypeAnnotation: NewTypeAnnotation(
@@ -1940,6 +2225,7 @@ func ArrayInsertFunctionType(elementType Type) *FunctionType {
 func ArrayConcatFunctionType(arrayType Type) *FunctionType {
 	typeAnnotation := NewTypeAnnotation(arrayType)
 	return &FunctionType{
+		Purity: FunctionPurityView,
 		Parameters: []*Parameter{
 			{
 				Label:          ArgumentLabelNotRequired,
@@ -1953,6 +2239,7 @@ func ArrayConcatFunctionType(arrayType Type) *FunctionType {
 
 func ArrayFirstIndexFunctionType(elementType Type) *FunctionType {
 	return &FunctionType{
+		Purity: FunctionPurityView,
 		Parameters: []*Parameter{
 			{
 				Identifier:     "of",
@@ -1964,8 +2251,58 @@ func ArrayFirstIndexFunctionType(elementType Type) *FunctionType {
 		),
 	}
 }
+
+func ArraySortFunctionType(elementType Type) *FunctionType {
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Identifier: "by",
+				TypeAnnotation: NewTypeAnnotation(
+					&FunctionType{
+						Parameters: []*Parameter{
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "a",
+								TypeAnnotation: NewTypeAnnotation(elementType),
+							},
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "b",
+								TypeAnnotation: NewTypeAnnotation(elementType),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(
+							BoolType,
+						),
+					},
+				),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			VoidType,
+		),
+	}
+}
+
+func ArrayBinarySearchFunctionType(elementType Type) *FunctionType {
+	return &FunctionType{
+		Purity: FunctionPurityView,
+		Parameters: []*Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "element",
+				TypeAnnotation: NewTypeAnnotation(elementType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			&OptionalType{Type: IntType},
+		),
+	}
+}
+
 func ArrayContainsFunctionType(elementType Type) *FunctionType {
 	return &FunctionType{
+		Purity: FunctionPurityView,
 		Parameters: []*Parameter{
 			{
 				Label:          ArgumentLabelNotRequired,
@@ -2009,6 +2346,7 @@ func ArrayAppendFunctionType(elementType Type) *FunctionType {
 
 func ArraySliceFunctionType(elementType Type) *FunctionType {
 	return &FunctionType{
+		Purity: FunctionPurityView,
 		Parameters: []*Parameter{
 			{
 				Identifier:     "from",
@@ -2034,9 +2372,17 @@ type VariableSizedType struct {
 
 func NewVariableSizedType(memoryGauge common.MemoryGauge, typ Type) *VariableSizedType {
 	common.UseMemory(memoryGauge, common.VariableSizedSemaTypeMemoryUsage)
-	return &VariableSizedType{
-		Type: typ,
-	}
+	return globalTypePool.intern(
+		typePoolKey{
+			kind:  typePoolKindVariableSized,
+			first: typ,
+		},
+		func() Type {
+			return &VariableSizedType{
+				Type: typ,
+			}
+		},
+	).(*VariableSizedType)
 }
 
 func (*VariableSizedType) IsType() {}
@@ -2065,6 +2411,10 @@ func (t *VariableSizedType) Equal(other Type) bool {
 		return false
 	}
 
+	if otherArray == t {
+		return true
+	}
+
 	return t.Type.Equal(otherArray.Type)
 }
 
@@ -2171,10 +2521,19 @@ type ConstantSizedType struct {
 
 func NewConstantSizedType(memoryGauge common.MemoryGauge, typ Type, size int64) *ConstantSizedType {
 	common.UseMemory(memoryGauge, common.ConstantSizedSemaTypeMemoryUsage)
-	return &ConstantSizedType{
-		Type: typ,
-		Size: size,
-	}
+	return globalTypePool.intern(
+		typePoolKey{
+			kind:  typePoolKindConstantSized,
+			first: typ,
+			size:  size,
+		},
+		func() Type {
+			return &ConstantSizedType{
+				Type: typ,
+				Size: size,
+			}
+		},
+	).(*ConstantSizedType)
 }
 
 func (*ConstantSizedType) IsType() {}
@@ -2203,6 +2562,10 @@ func (t *ConstantSizedType) Equal(other Type) bool {
 		return false
 	}
 
+	if otherArray == t {
+		return true
+	}
+
 	return t.Type.Equal(otherArray.Type) &&
 		t.Size == otherArray.Size
 }
@@ -2372,15 +2735,40 @@ func (p *Parameter) EffectiveArgumentLabel() string {
 type TypeParameter struct {
 	Name      string
 	TypeBound Type
-	Optional  bool
+	// Constraints are additional type bounds.
+	// A type argument must be a subtype of the type bound and of all constraints,
+	// e.g. `T: AnyStruct & Comparable` requires `T` to conform to the interface `Comparable`
+	Constraints []Type
+	Optional    bool
+}
+
+// TypeBounds returns the type bound and the constraints of the type parameter
+//
+func (p TypeParameter) TypeBounds() []Type {
+	if len(p.Constraints) == 0 {
+		if p.TypeBound == nil {
+			return nil
+		}
+		return []Type{p.TypeBound}
+	}
+
+	typeBounds := make([]Type, 0, len(p.Constraints)+1)
+	if p.TypeBound != nil {
+		typeBounds = append(typeBounds, p.TypeBound)
+	}
+	return append(typeBounds, p.Constraints...)
 }
 
 func (p TypeParameter) string(typeFormatter func(Type) string) string {
 	var builder strings.Builder
 	builder.WriteString(p.Name)
-	if p.TypeBound != nil {
-		builder.WriteString(": ")
-		builder.WriteString(typeFormatter(p.TypeBound))
+	for i, typeBound := range p.TypeBounds() {
+		if i == 0 {
+			builder.WriteString(": ")
+		} else {
+			builder.WriteString(" & ")
+		}
+		builder.WriteString(typeFormatter(typeBound))
 	}
 	return builder.String()
 }
@@ -2414,22 +2802,38 @@ func (p TypeParameter) Equal(other *TypeParameter) bool {
 		}
 	}
 
+	if len(p.Constraints) != len(other.Constraints) {
+		return false
+	}
+
+	for i, constraint := range p.Constraints {
+		if !constraint.Equal(other.Constraints[i]) {
+			return false
+		}
+	}
+
 	return p.Optional == other.Optional
 }
 
 func (p TypeParameter) checkTypeBound(ty Type, typeRange ast.Range) error {
-	if p.TypeBound == nil ||
-		p.TypeBound.IsInvalidType() ||
-		ty.IsInvalidType() {
-
+	if ty.IsInvalidType() {
 		return nil
 	}
 
-	if !IsSubType(ty, p.TypeBound) {
-		return &TypeMismatchError{
-			ExpectedType: p.TypeBound,
-			ActualType:   ty,
-			Range:        typeRange,
+	// Check that the type is a subtype of the type bound and all constraints.
+	// Report the first unsatisfied bound
+
+	for _, typeBound := range p.TypeBounds() {
+		if typeBound.IsInvalidType() {
+			continue
+		}
+
+		if !IsSubType(ty, typeBound) {
+			return &TypeMismatchError{
+				ExpectedType: typeBound,
+				ActualType:   ty,
+				Range:        typeRange,
+			}
 		}
 	}
 
@@ -2440,12 +2844,19 @@ func (p TypeParameter) checkTypeBound(ty Type, typeRange ast.Range) error {
 
 func formatFunctionType(
 	spaces bool,
+	purity FunctionPurity,
 	typeParameters []string,
 	parameters []string,
 	returnTypeAnnotation string,
 ) string {
 
 	var builder strings.Builder
+
+	if purity != FunctionPurityImpure {
+		builder.WriteString(purity.Keyword())
+		builder.WriteRune(' ')
+	}
+
 	builder.WriteRune('(')
 
 	if len(typeParameters) > 0 {
@@ -2483,7 +2894,11 @@ func formatFunctionType(
 // FunctionType
 //
 type FunctionType struct {
-	IsConstructor            bool
+	IsConstructor bool
+	Purity        FunctionPurity
+	// Effects are the side effects which the function intrinsically has,
+	// e.g. for built-in functions. See Elaboration.FunctionTypeEffects
+	Effects                  FunctionEffects
 	TypeParameters           []*TypeParameter
 	Parameters               []*Parameter
 	ReturnTypeAnnotation     *TypeAnnotation
@@ -2531,6 +2946,7 @@ func (t *FunctionType) String() string {
 
 	return formatFunctionType(
 		true,
+		t.Purity,
 		typeParameters,
 		parameters,
 		returnTypeAnnotation,
@@ -2555,6 +2971,7 @@ func (t *FunctionType) QualifiedString() string {
 
 	return formatFunctionType(
 		true,
+		t.Purity,
 		typeParameters,
 		parameters,
 		returnTypeAnnotation,
@@ -2567,7 +2984,12 @@ func (t *FunctionType) ID() TypeID {
 	typeParameters := make([]string, len(t.TypeParameters))
 
 	for i, typeParameter := range t.TypeParameters {
-		typeParameters[i] = string(typeParameter.TypeBound.ID())
+		typeBounds := typeParameter.TypeBounds()
+		typeBoundIDs := make([]string, len(typeBounds))
+		for j, typeBound := range typeBounds {
+			typeBoundIDs[j] = string(typeBound.ID())
+		}
+		typeParameters[i] = strings.Join(typeBoundIDs, "&")
 	}
 
 	parameters := make([]string, len(t.Parameters))
@@ -2581,6 +3003,7 @@ func (t *FunctionType) ID() TypeID {
 	return TypeID(
 		formatFunctionType(
 			false,
+			t.Purity,
 			typeParameters,
 			parameters,
 			returnTypeAnnotation,
@@ -2628,6 +3051,12 @@ func (t *FunctionType) Equal(other Type) bool {
 		return false
 	}
 
+	// purity
+
+	if t.Purity != otherFunction.Purity {
+		return false
+	}
+
 	// return type
 
 	if !t.ReturnTypeAnnotation.Type.
@@ -2667,10 +3096,10 @@ func (t *FunctionType) IsInvalidType() bool {
 
 	for _, typeParameter := range t.TypeParameters {
 
-		if typeParameter.TypeBound != nil &&
-			typeParameter.TypeBound.IsInvalidType() {
-
-			return true
+		for _, typeBound := range typeParameter.TypeBounds() {
+			if typeBound.IsInvalidType() {
+				return true
+			}
 		}
 	}
 
@@ -2704,9 +3133,11 @@ func (*FunctionType) IsEquatable() bool {
 func (t *FunctionType) TypeAnnotationState() TypeAnnotationState {
 
 	for _, typeParameter := range t.TypeParameters {
-		TypeParameterTypeAnnotationState := typeParameter.TypeBound.TypeAnnotationState()
-		if TypeParameterTypeAnnotationState != TypeAnnotationStateValid {
-			return TypeParameterTypeAnnotationState
+		for _, typeBound := range typeParameter.TypeBounds() {
+			TypeParameterTypeAnnotationState := typeBound.TypeAnnotationState()
+			if TypeParameterTypeAnnotationState != TypeAnnotationStateValid {
+				return TypeParameterTypeAnnotationState
+			}
 		}
 	}
 
@@ -2765,9 +3196,10 @@ func (t *FunctionType) RewriteWithRestrictedTypes() (Type, bool) {
 				rewrittenTypeBound, ok := rewrittenTypeParameterTypeBounds[typeParameter]
 				if ok {
 					rewrittenTypeParameters[i] = &TypeParameter{
-						Name:      typeParameter.Name,
-						TypeBound: rewrittenTypeBound,
-						Optional:  typeParameter.Optional,
+						Name:        typeParameter.Name,
+						TypeBound:   rewrittenTypeBound,
+						Constraints: typeParameter.Constraints,
+						Optional:    typeParameter.Optional,
 					}
 				} else {
 					rewrittenTypeParameters[i] = typeParameter
@@ -2793,6 +3225,8 @@ func (t *FunctionType) RewriteWithRestrictedTypes() (Type, bool) {
 		}
 
 		return &FunctionType{
+			Purity:                t.Purity,
+			Effects:               t.Effects,
 			TypeParameters:        rewrittenTypeParameters,
 			Parameters:            rewrittenParameters,
 			ReturnTypeAnnotation:  NewTypeAnnotation(rewrittenReturnType),
@@ -2904,6 +3338,8 @@ func (t *FunctionType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type
 	}
 
 	return &FunctionType{
+		Purity:                t.Purity,
+		Effects:               t.Effects,
 		Parameters:            newParameters,
 		ReturnTypeAnnotation:  NewTypeAnnotation(newReturnType),
 		RequiredArgumentCount: t.RequiredArgumentCount,
@@ -2964,12 +3400,15 @@ func init() {
 		PrivatePathType,
 		PublicPathType,
 		&CapabilityType{},
+		&RangeType{},
+		&RangeType{Inclusive: true},
 		DeployedContractType,
 		BlockType,
 		AccountKeyType,
 		PublicKeyType,
 		SignatureAlgorithmType,
 		HashAlgorithmType,
+		StorageCapabilityControllerType,
 	)
 
 	for _, ty := range types {
@@ -3013,10 +3452,12 @@ var BaseValueActivation = NewVariableActivation(nil)
 
 var AllSignedFixedPointTypes = []Type{
 	Fix64Type,
+	Fix128Type,
 }
 
 var AllUnsignedFixedPointTypes = []Type{
 	UFix64Type,
+	UFix128Type,
 }
 
 var AllFixedPointTypes = append(
@@ -3081,6 +3522,14 @@ const numberTypeMaxFieldDocString = `The maximum integer of this type`
 const fixedPointNumberTypeMinFieldDocString = `The minimum fixed-point value of this type`
 const fixedPointNumberTypeMaxFieldDocString = `The maximum fixed-point value of this type`
 
+const NumberTypeFromStringFunctionName = "fromString"
+
+const numberTypeFromStringFunctionDocString = `
+Parses the given decimal string into a number of this type.
+
+Returns nil if the string is not in canonical form or if the number is outside the bounds of this type
+`
+
 const numberConversionFunctionDocStringSuffix = `
 The value must be within the bounds of this type.
 If a value is passed that is outside the bounds, the program aborts.`
@@ -3120,6 +3569,13 @@ func init() {
 				functionType.Members.Set(name, member)
 			}
 
+			addMember(NewUnmeteredPublicFunctionMember(
+				functionType,
+				NumberTypeFromStringFunctionName,
+				NumberTypeFromStringFunctionType(numberType),
+				numberTypeFromStringFunctionDocString,
+			))
+
 			switch numberType := numberType.(type) {
 			case *NumericType:
 				if numberType.minInt != nil {
@@ -3186,6 +3642,7 @@ func init() {
 
 func NumberConversionFunctionType(numberType Type) *FunctionType {
 	return &FunctionType{
+		Purity: FunctionPurityView,
 		Parameters: []*Parameter{
 			{
 				Label:          ArgumentLabelNotRequired,
@@ -3198,6 +3655,24 @@ func NumberConversionFunctionType(numberType Type) *FunctionType {
 	}
 }
 
+func NumberTypeFromStringFunctionType(numberType Type) *FunctionType {
+	return &FunctionType{
+		Purity: FunctionPurityView,
+		Parameters: []*Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "input",
+				TypeAnnotation: NewTypeAnnotation(StringType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			&OptionalType{
+				Type: numberType,
+			},
+		),
+	}
+}
+
 func numberConversionDocString(targetDescription string) string {
 	return fmt.Sprintf(
 		"Converts the given number to %s. %s",
@@ -3220,6 +3695,7 @@ func baseFunctionVariable(name string, ty *FunctionType, docString string) *Vari
 }
 
 var AddressConversionFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	Parameters: []*Parameter{
 		{
 			Label:          ArgumentLabelNotRequired,
@@ -3238,11 +3714,35 @@ var AddressConversionFunctionType = &FunctionType{
 			return
 		}
 
-		// No need to meter. This is only checked once.
-		CheckAddressLiteral(nil, intExpression, checker.report)
+		checker.checkAddressLiteral(intExpression)
+	},
+}
+
+const AddressTypeParseFunctionName = "parse"
+
+var AddressTypeParseFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
+	Parameters: []*Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "string",
+			TypeAnnotation: NewTypeAnnotation(StringType),
+		},
 	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		&OptionalType{
+			Type: &AddressType{},
+		},
+	),
 }
 
+const addressTypeParseFunctionDocString = `
+Parses the given hexadecimal string, optionally prefixed with 0x, into an address.
+
+Returns nil if the string is not a valid hexadecimal number, if it does not fit into an address,
+or if the address is not valid for the network
+`
+
 func init() {
 	// Declare a conversion function for the address type
 
@@ -3254,6 +3754,17 @@ func init() {
 		panic(errors.NewUnreachableError())
 	}
 
+	AddressConversionFunctionType.Members = &StringMemberOrderedMap{}
+	AddressConversionFunctionType.Members.Set(
+		AddressTypeParseFunctionName,
+		NewUnmeteredPublicFunctionMember(
+			AddressConversionFunctionType,
+			AddressTypeParseFunctionName,
+			AddressTypeParseFunctionType,
+			addressTypeParseFunctionDocString,
+		),
+	)
+
 	BaseValueActivation.Set(
 		typeName,
 		baseFunctionVariable(
@@ -3276,7 +3787,7 @@ func numberFunctionArgumentExpressionsChecker(targetType Type) ArgumentExpressio
 		case *ast.IntegerExpression:
 			if CheckIntegerLiteral(nil, argument, targetType, checker.report) {
 				if checker.extendedElaboration {
-					checker.Elaboration.NumberConversionArgumentTypes[argument] = struct {
+					checker.Elaboration.NumberConversionArgumentTypes[argument.ID()] = struct {
 						Type  Type
 						Range ast.Range
 					}{Type: targetType, Range: invocationRange}
@@ -3286,7 +3797,7 @@ func numberFunctionArgumentExpressionsChecker(targetType Type) ArgumentExpressio
 		case *ast.FixedPointExpression:
 			if CheckFixedPointLiteral(nil, argument, targetType, checker.report) {
 				if checker.extendedElaboration {
-					checker.Elaboration.NumberConversionArgumentTypes[argument] = struct {
+					checker.Elaboration.NumberConversionArgumentTypes[argument.ID()] = struct {
 						Type  Type
 						Range ast.Range
 					}{Type: targetType, Range: invocationRange}
@@ -3310,6 +3821,7 @@ func init() {
 	}
 
 	functionType := &FunctionType{
+		Purity:               FunctionPurityView,
 		ReturnTypeAnnotation: NewTypeAnnotation(StringType),
 	}
 
@@ -3332,6 +3844,20 @@ func init() {
 		StringTypeEncodeHexFunctionDocString,
 	))
 
+	addMember(NewUnmeteredPublicFunctionMember(
+		functionType,
+		StringTypeEncodeBase64FunctionName,
+		StringTypeEncodeBase64FunctionType,
+		StringTypeEncodeBase64FunctionDocString,
+	))
+
+	addMember(NewUnmeteredPublicFunctionMember(
+		functionType,
+		StringTypeFromUTF8FunctionName,
+		StringTypeFromUTF8FunctionType,
+		StringTypeFromUTF8FunctionDocString,
+	))
+
 	BaseValueActivation.Set(
 		typeName,
 		baseFunctionVariable(
@@ -3343,6 +3869,23 @@ func init() {
 }
 
 var StringTypeEncodeHexFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
+	Parameters: []*Parameter{
+		{
+			Label:      ArgumentLabelNotRequired,
+			Identifier: "data",
+			TypeAnnotation: NewTypeAnnotation(
+				ByteArrayType,
+			),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		StringType,
+	),
+}
+
+var StringTypeEncodeBase64FunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	Parameters: []*Parameter{
 		{
 			Label:      ArgumentLabelNotRequired,
@@ -3357,8 +3900,27 @@ var StringTypeEncodeHexFunctionType = &FunctionType{
 	),
 }
 
+var StringTypeFromUTF8FunctionType = &FunctionType{
+	Purity: FunctionPurityView,
+	Parameters: []*Parameter{
+		{
+			Label:      ArgumentLabelNotRequired,
+			Identifier: "bytes",
+			TypeAnnotation: NewTypeAnnotation(
+				ByteArrayType,
+			),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		&OptionalType{
+			Type: StringType,
+		},
+	),
+}
+
 func pathConversionFunctionType(pathType Type) *FunctionType {
 	return &FunctionType{
+		Purity: FunctionPurityView,
 		Parameters: []*Parameter{
 			{
 				Identifier:     "identifier",
@@ -3461,14 +4023,40 @@ type CompositeType struct {
 	Fields                              []string
 	// TODO: add support for overloaded initializers
 	ConstructorParameters []*Parameter
+	ConstructorPurity     FunctionPurity
 	nestedTypes           *StringTypeOrderedMap
 	containerType         Type
 	EnumRawType           Type
-	hasComputedMembers    bool
+	// Only applicable for enum types:
+	// the names of the enum cases, in declaration order.
+	// Empty if unknown, e.g. for native enum types
+	EnumCases          []string
+	hasComputedMembers bool
+	// Only applicable for attachment types:
+	// the composite type the attachment is declared for
+	baseType Type
+	// Only applicable for contract types:
+	// the types of the type aliases declared in the contract
+	typeAliases *StringTypeOrderedMap
+	// Only applicable for resource types:
+	// the implicitly declared event which is emitted when the resource is destroyed
+	resourceDestroyedEventType *CompositeType
 
 	// Only applicable for native composite types.
 	importable bool
 
+	// Only applicable for generic structure and resource types:
+	// the type parameters of the type, and its instantiations, by type ID
+	typeParameters  []*TypeParameter
+	instances       map[TypeID][]*CompositeType
+	instancesLock   sync.Mutex
+	membersDeclared bool
+	expanding       bool
+	// Only applicable for instantiations of generic composite types:
+	// the generic type, and the type arguments it was instantiated with
+	genericType   *CompositeType
+	typeArguments []Type
+
 	cachedIdentifiers *struct {
 		TypeID              TypeID
 		QualifiedIdentifier string
@@ -3480,6 +4068,13 @@ func (t *CompositeType) Tag() TypeTag {
 	return CompositeTypeTag
 }
 
+// GetBaseType returns the type an attachment type is declared for.
+// It is nil for all other kinds of composite types.
+//
+func (t *CompositeType) GetBaseType() Type {
+	return t.baseType
+}
+
 func (t *CompositeType) ExplicitInterfaceConformanceSet() *InterfaceSet {
 	t.initializeExplicitInterfaceConformanceSet()
 	return t.explicitInterfaceConformanceSet
@@ -3505,13 +4100,43 @@ func (t *CompositeType) addImplicitTypeRequirementConformance(typeRequirement *C
 func (*CompositeType) IsType() {}
 
 func (t *CompositeType) String() string {
+	if t.genericType != nil {
+		return instantiatedTypeString(t.Identifier, t.typeArguments, ", ", Type.String)
+	}
 	return t.Identifier
 }
 
 func (t *CompositeType) QualifiedString() string {
+	if t.genericType != nil {
+		return instantiatedTypeString(
+			t.genericType.QualifiedIdentifier(),
+			t.typeArguments,
+			", ",
+			Type.QualifiedString,
+		)
+	}
 	return t.QualifiedIdentifier()
 }
 
+func instantiatedTypeString(
+	identifier string,
+	typeArguments []Type,
+	separator string,
+	typeFormatter func(Type) string,
+) string {
+	var builder strings.Builder
+	builder.WriteString(identifier)
+	builder.WriteRune('<')
+	for i, typeArgument := range typeArguments {
+		if i > 0 {
+			builder.WriteString(separator)
+		}
+		builder.WriteString(typeFormatter(typeArgument))
+	}
+	builder.WriteRune('>')
+	return builder.String()
+}
+
 func (t *CompositeType) GetContainerType() Type {
 	return t.containerType
 }
@@ -3571,6 +4196,21 @@ func (t *CompositeType) initializeIdentifiers() {
 
 	identifier := qualifiedIdentifier(t.Identifier, t.containerType)
 
+	// The qualified identifier of an instantiation of a generic type
+	// includes the IDs of the type arguments, e.g. `Box<Int>`,
+	// so the instantiation has a monomorphic type ID, e.g. `S.test.Box<Int>`
+
+	if t.genericType != nil {
+		identifier = instantiatedTypeString(
+			identifier,
+			t.typeArguments,
+			",",
+			func(typeArgument Type) string {
+				return string(typeArgument.ID())
+			},
+		)
+	}
+
 	var typeID TypeID
 	if t.Location == nil {
 		typeID = TypeID(identifier)
@@ -3593,10 +4233,30 @@ func (t *CompositeType) Equal(other Type) bool {
 		return false
 	}
 
+	if t.genericType != nil {
+		return otherStructure.genericType != nil &&
+			otherStructure.genericType.Equal(t.genericType) &&
+			typesEqual(otherStructure.typeArguments, t.typeArguments)
+	}
+
 	return otherStructure.Kind == t.Kind &&
 		otherStructure.ID() == t.ID()
 }
 
+func typesEqual(types []Type, otherTypes []Type) bool {
+	if len(types) != len(otherTypes) {
+		return false
+	}
+
+	for i, ty := range types {
+		if !ty.Equal(otherTypes[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (t *CompositeType) GetMembers() map[string]MemberResolver {
 	t.initializeMemberResolvers()
 	return t.memberResolvers
@@ -3649,6 +4309,11 @@ func (t *CompositeType) IsImportable(results map[*Member]bool) bool {
 		return t.importable
 	}
 
+	// Generic composite types and their instantiations cannot be imported (yet)
+	if t.genericType != nil || len(t.typeParameters) > 0 {
+		return false
+	}
+
 	// Only structures and enums can be imported
 
 	switch t.Kind {
@@ -3668,89 +4333,287 @@ func (t *CompositeType) IsImportable(results map[*Member]bool) bool {
 		}
 	}
 
-	return true
+	return true
+}
+
+func (t *CompositeType) IsExternallyReturnable(results map[*Member]bool) bool {
+	// Only structures, resources, and enums can be stored
+
+	switch t.Kind {
+	case common.CompositeKindStructure,
+		common.CompositeKindResource,
+		common.CompositeKindEnum:
+		break
+	default:
+		return false
+	}
+
+	// If this composite type has a member which is not externally returnable,
+	// then the composite type is not externally returnable.
+
+	for p := t.Members.Oldest(); p != nil; p = p.Next() {
+		if !p.Value.IsExternallyReturnable(results) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (t *CompositeType) IsEquatable() bool {
+	// TODO: add support for more composite kinds
+	return t.Kind == common.CompositeKindEnum
+}
+
+func (*CompositeType) TypeAnnotationState() TypeAnnotationState {
+	return TypeAnnotationStateValid
+}
+
+func (t *CompositeType) RewriteWithRestrictedTypes() (result Type, rewritten bool) {
+	return t, false
+}
+
+func (t *CompositeType) InterfaceType() *InterfaceType {
+	return &InterfaceType{
+		Location:              t.Location,
+		Identifier:            t.Identifier,
+		CompositeKind:         t.Kind,
+		Members:               t.Members,
+		Fields:                t.Fields,
+		InitializerParameters: t.ConstructorParameters,
+		containerType:         t.containerType,
+		nestedTypes:           t.nestedTypes,
+	}
+}
+
+func (t *CompositeType) TypeRequirements() []*CompositeType {
+
+	var typeRequirements []*CompositeType
+
+	if containerComposite, ok := t.containerType.(*CompositeType); ok {
+		for _, conformance := range containerComposite.ExplicitInterfaceConformances {
+			ty, ok := conformance.nestedTypes.Get(t.Identifier)
+			if !ok {
+				continue
+			}
+
+			typeRequirement, ok := ty.(*CompositeType)
+			if !ok {
+				continue
+			}
+
+			typeRequirements = append(typeRequirements, typeRequirement)
+		}
+	}
+
+	return typeRequirements
+}
+
+func (t *CompositeType) Unify(
+	other Type,
+	typeParameters *TypeParameterTypeOrderedMap,
+	report func(err error),
+	outerRange ast.Range,
+) bool {
+
+	if t.genericType == nil {
+		// TODO:
+		return false
+	}
+
+	otherComposite, ok := other.(*CompositeType)
+	if !ok || otherComposite.genericType != t.genericType {
+		return false
+	}
+
+	result := false
+	for i, typeArgument := range t.typeArguments {
+		if typeArgument.Unify(otherComposite.typeArguments[i], typeParameters, report, outerRange) {
+			result = true
+		}
+	}
+	return result
+}
+
+func (t *CompositeType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type {
+	if t.genericType == nil {
+		return t
+	}
+
+	newTypeArguments := make([]Type, len(t.typeArguments))
+	for i, typeArgument := range t.typeArguments {
+		newTypeArgument := typeArgument.Resolve(typeArguments)
+		if newTypeArgument == nil {
+			return nil
+		}
+		newTypeArguments[i] = newTypeArgument
+	}
+
+	return t.genericType.instantiate(newTypeArguments)
+}
+
+var _ ParameterizedType = &CompositeType{}
+
+// TypeParameters returns the type parameters of a generic structure or resource type.
+// It is empty for all other composite types, including instantiations of generic types.
+//
+func (t *CompositeType) TypeParameters() []*TypeParameter {
+	return t.typeParameters
+}
+
+func (t *CompositeType) Instantiate(typeArguments []Type, _ func(err error)) Type {
+	return t.instantiate(typeArguments)
+}
+
+// BaseType returns the generic type of an instantiation of a generic composite type,
+// e.g. `Box` for `Box<Int>`. It is nil for all other composite types.
+//
+func (t *CompositeType) BaseType() Type {
+	if t.genericType == nil {
+		return nil
+	}
+	return t.genericType
+}
+
+func (t *CompositeType) TypeArguments() []Type {
+	return t.typeArguments
 }
 
-func (t *CompositeType) IsExternallyReturnable(results map[*Member]bool) bool {
-	// Only structures, resources, and enums can be stored
+// maxInstanceTypeIDLength is the maximum length of the type ID of an instantiation
+// of a generic composite type for which members are declared.
+// Generic types which refer to themselves with growing type arguments,
+// e.g. `struct Box<T> { let next: Box<[T]>? }`, infinitely expand,
+// and the expansion is stopped once the type IDs exceed this length.
+//
+const maxInstanceTypeIDLength = 1024
 
-	switch t.Kind {
-	case common.CompositeKindStructure,
-		common.CompositeKindResource,
-		common.CompositeKindEnum:
-		break
-	default:
-		return false
+// instantiate returns the instantiation of the generic composite type
+// with the given type arguments.
+//
+// Instantiations are cached, so there is only one instance for each type ID,
+// and the members of an instantiation are derived from the generic type's members,
+// once they are declared.
+//
+func (t *CompositeType) instantiate(typeArguments []Type) *CompositeType {
+
+	instance := &CompositeType{
+		Location:                            t.Location,
+		Identifier:                          t.Identifier,
+		Kind:                                t.Kind,
+		ExplicitInterfaceConformances:       t.ExplicitInterfaceConformances,
+		ImplicitTypeRequirementConformances: t.ImplicitTypeRequirementConformances,
+		ConstructorPurity:                   t.ConstructorPurity,
+		Members:                             &StringMemberOrderedMap{},
+		containerType:                       t.containerType,
+		genericType:                         t,
+		typeArguments:                       typeArguments,
 	}
 
-	// If this composite type has a member which is not externally returnable,
-	// then the composite type is not externally returnable.
+	typeID := instance.ID()
 
-	for p := t.Members.Oldest(); p != nil; p = p.Next() {
-		if !p.Value.IsExternallyReturnable(results) {
-			return false
+	t.instancesLock.Lock()
+
+	// NOTE: the type ID of an instantiation is not unique
+	// if the type arguments are or contain generic types,
+	// as the type ID of a generic type is just the name of the type parameter
+
+	for _, existing := range t.instances[typeID] {
+		if existing.Equal(instance) {
+			t.instancesLock.Unlock()
+			return existing
 		}
 	}
 
-	return true
-}
+	if t.instances == nil {
+		t.instances = map[TypeID][]*CompositeType{}
+	}
+	t.instances[typeID] = append(t.instances[typeID], instance)
 
-func (t *CompositeType) IsEquatable() bool {
-	// TODO: add support for more composite kinds
-	return t.Kind == common.CompositeKindEnum
-}
+	declareMembers := t.membersDeclared
+	if len(typeID) > maxInstanceTypeIDLength {
+		t.expanding = true
+		declareMembers = false
+	}
 
-func (*CompositeType) TypeAnnotationState() TypeAnnotationState {
-	return TypeAnnotationStateValid
-}
+	t.instancesLock.Unlock()
 
-func (t *CompositeType) RewriteWithRestrictedTypes() (result Type, rewritten bool) {
-	return t, false
+	if declareMembers {
+		instance.declareInstanceMembers()
+	}
+
+	return instance
 }
 
-func (t *CompositeType) InterfaceType() *InterfaceType {
-	return &InterfaceType{
-		Location:              t.Location,
-		Identifier:            t.Identifier,
-		CompositeKind:         t.Kind,
-		Members:               t.Members,
-		Fields:                t.Fields,
-		InitializerParameters: t.ConstructorParameters,
-		containerType:         t.containerType,
-		nestedTypes:           t.nestedTypes,
+func (t *CompositeType) isInstantiatedWithOwnTypeParameters() bool {
+	for i, typeArgument := range t.typeArguments {
+		genericType, ok := typeArgument.(*GenericType)
+		if !ok || genericType.TypeParameter != t.genericType.typeParameters[i] {
+			return false
+		}
 	}
+	return true
 }
 
-func (t *CompositeType) TypeRequirements() []*CompositeType {
-
-	var typeRequirements []*CompositeType
-
-	if containerComposite, ok := t.containerType.(*CompositeType); ok {
-		for _, conformance := range containerComposite.ExplicitInterfaceConformances {
-			ty, ok := conformance.nestedTypes.Get(t.Identifier)
-			if !ok {
+// declareInstanceMembers marks the members of the generic composite type as declared,
+// and declares the members of all instantiations of the generic type created so far.
+//
+func (t *CompositeType) declareInstanceMembers() {
+	genericType := t.genericType
+
+	if genericType == nil {
+		t.instancesLock.Lock()
+		t.membersDeclared = true
+		var instances []*CompositeType
+		for typeID, typeIDInstances := range t.instances { //nolint:maprangecheck
+			if len(typeID) > maxInstanceTypeIDLength {
 				continue
 			}
+			instances = append(instances, typeIDInstances...)
+		}
+		t.instancesLock.Unlock()
 
-			typeRequirement, ok := ty.(*CompositeType)
-			if !ok {
-				continue
-			}
+		// NOTE: the order in which the instances' members are declared is irrelevant
 
-			typeRequirements = append(typeRequirements, typeRequirement)
+		for _, instance := range instances {
+			instance.declareInstanceMembers()
 		}
+
+		return
 	}
 
-	return typeRequirements
-}
+	typeArguments := &TypeParameterTypeOrderedMap{}
+	for i, typeParameter := range genericType.typeParameters {
+		typeArguments.Set(typeParameter, t.typeArguments[i])
+	}
 
-func (*CompositeType) Unify(_ Type, _ *TypeParameterTypeOrderedMap, _ func(err error), _ ast.Range) bool {
-	// TODO:
-	return false
-}
+	resolve := func(ty Type) Type {
+		resolvedType := ty.Resolve(typeArguments)
+		if resolvedType == nil {
+			return ty
+		}
+		return resolvedType
+	}
 
-func (t *CompositeType) Resolve(_ *TypeParameterTypeOrderedMap) Type {
-	return t
+	members := &StringMemberOrderedMap{}
+
+	genericType.Members.Foreach(func(name string, member *Member) {
+		// NOTE: the container type of the members stays the generic type,
+		// so access control treats the members like the generic type's members
+		instanceMember := *member
+		instanceMember.TypeAnnotation = NewTypeAnnotation(resolve(member.TypeAnnotation.Type))
+		members.Set(name, &instanceMember)
+	})
+
+	constructorParameters := make([]*Parameter, len(genericType.ConstructorParameters))
+	for i, parameter := range genericType.ConstructorParameters {
+		instanceParameter := *parameter
+		instanceParameter.TypeAnnotation = NewTypeAnnotation(resolve(parameter.TypeAnnotation.Type))
+		constructorParameters[i] = &instanceParameter
+	}
+
+	t.Members = members
+	t.Fields = genericType.Fields
+	t.ConstructorParameters = constructorParameters
 }
 
 func (t *CompositeType) IsContainerType() bool {
@@ -3761,6 +4624,24 @@ func (t *CompositeType) GetNestedTypes() *StringTypeOrderedMap {
 	return t.nestedTypes
 }
 
+// ResourceDestroyedEventType returns the implicitly declared event type
+// which is emitted when a resource of this type is destroyed.
+// It is nil for all other kinds of composite types.
+//
+func (t *CompositeType) ResourceDestroyedEventType() *CompositeType {
+	return t.resourceDestroyedEventType
+}
+
+// TypeAlias returns the type aliased by the type alias with the given name
+// which is declared in the composite type, if any
+//
+func (t *CompositeType) TypeAlias(name string) (Type, bool) {
+	if t.typeAliases == nil {
+		return nil, false
+	}
+	return t.typeAliases.Get(name)
+}
+
 func (t *CompositeType) initializeMemberResolvers() {
 	t.memberResolversOnce.Do(func() {
 		members := make(map[string]MemberResolver, t.Members.Len())
@@ -3825,6 +4706,14 @@ type Member struct {
 	// IgnoreInSerialization fields are ignored in serialization
 	IgnoreInSerialization bool
 	DocString             string
+	// Deprecated indicates if the member is deprecated,
+	// i.e. its declaration is preceded by a `#deprecated` pragma
+	Deprecated bool
+	// DeprecationMessage is the optional message of the `#deprecated` pragma
+	DeprecationMessage string
+	// Indexed indicates if the member is an event field
+	// which is marked with the `indexed` modifier
+	Indexed bool
 }
 
 func NewUnmeteredPublicFunctionMember(
@@ -3994,7 +4883,9 @@ type InterfaceType struct {
 		TypeID              TypeID
 		QualifiedIdentifier string
 	}
-	cachedIdentifiersLock sync.RWMutex
+	cachedIdentifiersLock         sync.RWMutex
+	cachedConformanceRequirements interfaceConformanceRequirements
+	conformanceRequirementsOnce   sync.Once
 }
 
 func (*InterfaceType) IsType() {}
@@ -4110,6 +5001,55 @@ func (t *InterfaceType) initializeMemberResolvers() {
 	})
 }
 
+// interfaceConformanceRequirements are the members and the nested type requirements
+// of an interface, which conforming composite types must provide
+//
+type interfaceConformanceRequirements struct {
+	members          []*Member
+	typeRequirements []*CompositeType
+}
+
+// conformanceRequirements returns the members and the nested type requirements
+// of the interface, which conforming composite types must provide.
+//
+// The requirements are only resolved once,
+// and are shared by the conformance checks of all composite types
+// which conform to the interface, e.g. imported standard interfaces
+//
+func (t *InterfaceType) conformanceRequirements() interfaceConformanceRequirements {
+	t.conformanceRequirementsOnce.Do(func() {
+		var requirements interfaceConformanceRequirements
+
+		t.Members.Foreach(func(_ string, member *Member) {
+
+			// Conforming types do not provide a concrete member
+			// for the member in the interface if it is predeclared
+
+			if member.Predeclared {
+				return
+			}
+
+			requirements.members = append(requirements.members, member)
+		})
+
+		t.nestedTypes.Foreach(func(_ string, nestedType Type) {
+
+			// Only nested composite declarations are type requirements of the interface
+
+			typeRequirement, ok := nestedType.(*CompositeType)
+			if !ok {
+				return
+			}
+
+			requirements.typeRequirements = append(requirements.typeRequirements, typeRequirement)
+		})
+
+		t.cachedConformanceRequirements = requirements
+	})
+
+	return t.cachedConformanceRequirements
+}
+
 func (t *InterfaceType) IsResourceType() bool {
 	return t.CompositeKind == common.CompositeKindResource
 }
@@ -4230,10 +5170,19 @@ type DictionaryType struct {
 
 func NewDictionaryType(memoryGauge common.MemoryGauge, keyType, valueType Type) *DictionaryType {
 	common.UseMemory(memoryGauge, common.DictionarySemaTypeMemoryUsage)
-	return &DictionaryType{
-		KeyType:   keyType,
-		ValueType: valueType,
-	}
+	return globalTypePool.intern(
+		typePoolKey{
+			kind:   typePoolKindDictionary,
+			first:  keyType,
+			second: valueType,
+		},
+		func() Type {
+			return &DictionaryType{
+				KeyType:   keyType,
+				ValueType: valueType,
+			}
+		},
+	).(*DictionaryType)
 }
 
 func (*DictionaryType) IsType() {}
@@ -4272,6 +5221,10 @@ func (t *DictionaryType) Equal(other Type) bool {
 		return false
 	}
 
+	if otherDictionary == t {
+		return true
+	}
+
 	return otherDictionary.KeyType.Equal(t.KeyType) &&
 		otherDictionary.ValueType.Equal(t.ValueType)
 }
@@ -4350,6 +5303,20 @@ const dictionaryTypeValuesFieldDocString = `
 An array containing all values of the dictionary
 `
 
+const dictionaryTypeForEachKeyFunctionDocString = `
+Calls the given function for each key of the dictionary, in the same deterministic order as the keys field.
+
+Iteration stops early when the function returns false.
+The dictionary must not be mutated while it is being iterated
+`
+
+const dictionaryTypeForEachValueFunctionDocString = `
+Calls the given function for each value of the dictionary, in the same deterministic order as the values field.
+
+Iteration stops early when the function returns false.
+The dictionary must not be mutated while it is being iterated
+`
+
 const dictionaryTypeInsertFunctionDocString = `
 Inserts the given value into the dictionary under the given key.
 
@@ -4444,6 +5411,52 @@ func (t *DictionaryType) initializeMemberResolvers() {
 					)
 				},
 			},
+			"forEachKey": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(memoryGauge common.MemoryGauge, identifier string, targetRange ast.Range, report func(error)) *Member {
+
+					if t.KeyType.IsResourceType() {
+						report(
+							&InvalidResourceDictionaryMemberError{
+								Name:            identifier,
+								DeclarationKind: common.DeclarationKindFunction,
+								Range:           targetRange,
+							},
+						)
+					}
+
+					return NewPublicFunctionMember(
+						memoryGauge,
+						t,
+						identifier,
+						DictionaryForEachKeyFunctionType(t),
+						dictionaryTypeForEachKeyFunctionDocString,
+					)
+				},
+			},
+			"forEachValue": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(memoryGauge common.MemoryGauge, identifier string, targetRange ast.Range, report func(error)) *Member {
+
+					if t.ValueType.IsResourceType() {
+						report(
+							&InvalidResourceDictionaryMemberError{
+								Name:            identifier,
+								DeclarationKind: common.DeclarationKindFunction,
+								Range:           targetRange,
+							},
+						)
+					}
+
+					return NewPublicFunctionMember(
+						memoryGauge,
+						t,
+						identifier,
+						DictionaryForEachValueFunctionType(t),
+						dictionaryTypeForEachValueFunctionDocString,
+					)
+				},
+			},
 			"insert": {
 				Kind:     common.DeclarationKindFunction,
 				Mutating: true,
@@ -4476,6 +5489,7 @@ func (t *DictionaryType) initializeMemberResolvers() {
 
 func DictionaryContainsKeyFunctionType(t *DictionaryType) *FunctionType {
 	return &FunctionType{
+		Purity: FunctionPurityView,
 		Parameters: []*Parameter{
 			{
 				Label:          ArgumentLabelNotRequired,
@@ -4489,6 +5503,48 @@ func DictionaryContainsKeyFunctionType(t *DictionaryType) *FunctionType {
 	}
 }
 
+func DictionaryForEachKeyFunctionType(t *DictionaryType) *FunctionType {
+	return dictionaryForEachFunctionType("key", t.KeyType)
+}
+
+func DictionaryForEachValueFunctionType(t *DictionaryType) *FunctionType {
+	return dictionaryForEachFunctionType("value", t.ValueType)
+}
+
+// dictionaryForEachFunctionType returns the type of a function
+// which calls the given iteration function for each element
+// of the given element type, i.e. `((T): Bool): Void`.
+//
+// The iteration function returns whether the iteration should continue.
+//
+func dictionaryForEachFunctionType(elementIdentifier string, elementType Type) *FunctionType {
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:      ArgumentLabelNotRequired,
+				Identifier: "function",
+				TypeAnnotation: NewTypeAnnotation(
+					&FunctionType{
+						Parameters: []*Parameter{
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     elementIdentifier,
+								TypeAnnotation: NewTypeAnnotation(elementType),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(
+							BoolType,
+						),
+					},
+				),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			VoidType,
+		),
+	}
+}
+
 func DictionaryInsertFunctionType(t *DictionaryType) *FunctionType {
 	return &FunctionType{
 		Parameters: []*Parameter{
@@ -4589,10 +5645,19 @@ type ReferenceType struct {
 
 func NewReferenceType(memoryGauge common.MemoryGauge, typ Type, authorized bool) *ReferenceType {
 	common.UseMemory(memoryGauge, common.ReferenceSemaTypeMemoryUsage)
-	return &ReferenceType{
-		Type:       typ,
-		Authorized: authorized,
-	}
+	return globalTypePool.intern(
+		typePoolKey{
+			kind:       typePoolKindReference,
+			first:      typ,
+			authorized: authorized,
+		},
+		func() Type {
+			return &ReferenceType{
+				Type:       typ,
+				Authorized: authorized,
+			}
+		},
+	).(*ReferenceType)
 }
 
 func (*ReferenceType) IsType() {}
@@ -4640,6 +5705,10 @@ func (t *ReferenceType) Equal(other Type) bool {
 		return false
 	}
 
+	if otherReference == t {
+		return true
+	}
+
 	if t.Authorized != otherReference.Authorized {
 		return false
 	}
@@ -4821,6 +5890,7 @@ func (t *AddressType) Resolve(_ *TypeParameterTypeOrderedMap) Type {
 const AddressTypeToBytesFunctionName = `toBytes`
 
 var AddressTypeToBytesFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	ReturnTypeAnnotation: NewTypeAnnotation(
 		ByteArrayType,
 	),
@@ -4851,6 +5921,11 @@ func (t *AddressType) GetMembers() map[string]MemberResolver {
 //
 // Types are subtypes of themselves.
 //
+// Subtyping is static, i.e. it holds for all values of the subtype,
+// independent of their run-time types.
+// To determine if a value of a type might be cast to another type at run-time,
+// use CheckCastability.
+//
 // NOTE: This method can be used to check the assignability of `subType` to `superType`.
 // However, to check if a type *strictly* belongs to a certain category, then consider
 // using `IsSameTypeKind` method. e.g: "Is type `T` an Integer type?". Using this method
@@ -4884,12 +5959,19 @@ func IsSubType(subType Type, superType Type) bool {
 // same kind as 'Integer'. Whereas, 'Int8' is both a subtype
 // and also of same kind as 'Integer'.
 //
+// Likewise, a generic type 'T: Integer' is a subtype of 'Integer',
+// but not of the same kind, as the type argument is not known.
+//
 func IsSameTypeKind(subType Type, superType Type) bool {
 
 	if subType == NeverType {
 		return false
 	}
 
+	if _, ok := subType.(*GenericType); ok {
+		return false
+	}
+
 	return IsSubType(subType, superType)
 }
 
@@ -4920,6 +6002,17 @@ func checkSubTypeWithoutEquality(subType Type, superType Type) bool {
 		return true
 	}
 
+	// A generic type `T` is a subtype of a type `V`:
+	// if any of the type bounds of `T` is a subtype of `V`
+
+	if genericSubType, ok := subType.(*GenericType); ok {
+		for _, typeBound := range genericSubType.TypeParameter.TypeBounds() {
+			if IsSubType(typeBound, superType) {
+				return true
+			}
+		}
+	}
+
 	switch superType {
 	case AnyType:
 		return true
@@ -4978,7 +6071,7 @@ func checkSubTypeWithoutEquality(subType Type, superType Type) bool {
 	case FixedPointType:
 		switch subType {
 		case FixedPointType, SignedFixedPointType,
-			UFix64Type:
+			UFix64Type, UFix128Type:
 
 			return true
 
@@ -4988,7 +6081,7 @@ func checkSubTypeWithoutEquality(subType Type, superType Type) bool {
 
 	case SignedFixedPointType:
 		switch subType {
-		case SignedFixedPointType, Fix64Type:
+		case SignedFixedPointType, Fix64Type, Fix128Type:
 			return true
 
 		default:
@@ -5041,6 +6134,27 @@ func checkSubTypeWithoutEquality(subType Type, superType Type) bool {
 			typedSuperType.ElementType(false),
 		)
 
+	case *TupleType:
+		// Tuples are covariant in their element types:
+		// (T1, ..., Tn) <: (U1, ..., Un) if Ti <: Ui for all i
+
+		typedSubType, ok := subType.(*TupleType)
+		if !ok {
+			return false
+		}
+
+		if len(typedSubType.ElementTypes) != len(typedSuperType.ElementTypes) {
+			return false
+		}
+
+		for i, elementType := range typedSubType.ElementTypes {
+			if !IsSubType(elementType, typedSuperType.ElementTypes[i]) {
+				return false
+			}
+		}
+
+		return true
+
 	case *ReferenceType:
 		// References types are only subtypes of reference types
 
@@ -5271,6 +6385,15 @@ func checkSubTypeWithoutEquality(subType Type, superType Type) bool {
 			return false
 		}
 
+		// View functions are subtypes of impure functions,
+		// but impure functions are not subtypes of view functions
+
+		if typedSuperType.Purity == FunctionPurityView &&
+			typedSubType.Purity != FunctionPurityView {
+
+			return false
+		}
+
 		return true
 
 	case *RestrictedType:
@@ -5425,6 +6548,15 @@ func checkSubTypeWithoutEquality(subType Type, superType Type) bool {
 					return true
 				}
 			}
+
+			// Inside of a generic composite type, e.g. `struct Box<T>`,
+			// the generic type is the instantiation with its own type parameters, e.g. `Box<T>`
+
+			if typedSuperType.genericType == typedSubType &&
+				typedSuperType.isInstantiatedWithOwnTypeParameters() {
+
+				return true
+			}
 		}
 
 	case *InterfaceType:
@@ -5527,6 +6659,17 @@ func AreCompatibleEquatableTypes(leftType, rightType Type) bool {
 		return true
 	}
 
+	// References are compared by identity.
+	// They are equatable if the type of one is a subtype of the other,
+	// as only then they may reference the same value
+
+	if leftReferenceType, ok := unwrappedLeftType.(*ReferenceType); ok {
+		if rightReferenceType, ok := unwrappedRightType.(*ReferenceType); ok {
+			return IsSubType(leftReferenceType.Type, rightReferenceType.Type) ||
+				IsSubType(rightReferenceType.Type, leftReferenceType.Type)
+		}
+	}
+
 	// The types are equatable if this is a comparison with `nil`,
 	// which has type `Never?`
 
@@ -5856,11 +6999,7 @@ func (t *RestrictedType) GetMembers() map[string]MemberResolver {
 	// but implicitly when the resource declaration's conformances are checked.
 
 	for _, restriction := range t.Restrictions {
-		for name, resolver := range restriction.GetMembers() { //nolint:maprangecheck
-			if _, ok := members[name]; !ok {
-				members[name] = resolver
-			}
-		}
+		t.addRestrictionMembers(members, restriction)
 	}
 
 	// Also include members of the restricted type for convenience,
@@ -5898,6 +7037,25 @@ func (t *RestrictedType) GetMembers() map[string]MemberResolver {
 	return members
 }
 
+// addRestrictionMembers adds the members of the given restriction,
+// recording the restriction as the declaring type of each member.
+//
+// NOTE: Interfaces cannot inherit from other interfaces yet,
+// so the members granted by a restriction are exactly the members it declares.
+// Once interface inheritance is supported, the members of inherited interfaces
+// should also be added here, declared by the restriction through which they are inherited.
+//
+func (*RestrictedType) addRestrictionMembers(members map[string]MemberResolver, restriction *InterfaceType) {
+	for name, resolver := range restriction.GetMembers() { //nolint:maprangecheck
+		if _, ok := members[name]; ok {
+			continue
+		}
+
+		resolver.declaredBy = restriction
+		members[name] = resolver
+	}
+}
+
 func (*RestrictedType) Unify(_ Type, _ *TypeParameterTypeOrderedMap, _ func(err error), _ ast.Range) bool {
 	// TODO: how do we unify the restriction sets?
 	return false
@@ -6103,6 +7261,7 @@ func CapabilityTypeBorrowFunctionType(borrowType Type) *FunctionType {
 	}
 
 	return &FunctionType{
+		Purity:         FunctionPurityView,
 		TypeParameters: typeParameters,
 		ReturnTypeAnnotation: NewTypeAnnotation(
 			&OptionalType{
@@ -6123,17 +7282,23 @@ func CapabilityTypeCheckFunctionType(borrowType Type) *FunctionType {
 	}
 
 	return &FunctionType{
+		Purity:               FunctionPurityView,
 		TypeParameters:       typeParameters,
 		ReturnTypeAnnotation: NewTypeAnnotation(BoolType),
 	}
 }
 
 const capabilityTypeBorrowFunctionDocString = `
-Returns a reference to the object targeted by the capability, provided it can be borrowed using the given type
+Returns a reference to the object targeted by the capability, provided it can be borrowed using the given type.
+
+Returns nil if the capability is broken, i.e. the target does not exist, or a link does not allow the given type.
+Aborts if the target exists, but the stored object does not have the given type
 `
 
 const capabilityTypeCheckFunctionDocString = `
-Returns true if the capability currently targets an object that satisfies the given type, i.e. could be borrowed using the given type
+Returns true if the capability currently targets an object that satisfies the given type, i.e. could be borrowed using the given type.
+
+The object is not borrowed
 `
 
 const addressTypeCheckFunctionDocString = `
@@ -6203,6 +7368,10 @@ func init() {
 		AuthAccountType,
 		AuthAccountKeysType,
 		AuthAccountContractsType,
+		AuthAccountCapabilitiesType,
+		AuthAccountStorageCapabilitiesType,
+		AuthAccountInboxType,
+		StorageCapabilityControllerType,
 		PublicAccountType,
 		PublicAccountKeysType,
 		PublicAccountContractsType,
@@ -6347,6 +7516,7 @@ var PublicKeyArrayType = &VariableSizedType{
 }
 
 var PublicKeyVerifyFunctionType = &FunctionType{
+	Purity:         FunctionPurityView,
 	TypeParameters: []*TypeParameter{},
 	Parameters: []*Parameter{
 		{
@@ -6374,6 +7544,7 @@ var PublicKeyVerifyFunctionType = &FunctionType{
 }
 
 var PublicKeyVerifyPoPFunctionType = &FunctionType{
+	Purity:         FunctionPurityView,
 	TypeParameters: []*TypeParameter{},
 	Parameters: []*Parameter{
 		{
@@ -6418,6 +7589,15 @@ func getFieldNames(members []*Member) []string {
 	return fields
 }
 
+// IsComparableType returns true if values of the given type
+// can be ordered using the non-equality comparison operators,
+// i.e. if the type is a concrete number type.
+//
+func IsComparableType(typ Type) bool {
+	return IsSubType(typ, NumberType) &&
+		!isNumericSuperType(typ)
+}
+
 func isNumericSuperType(typ Type) bool {
 	if numberType, ok := typ.(IntegerRangedType); ok {
 		return numberType.IsSuperType()
@@ -0,0 +1,152 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"strings"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+)
+
+// TypeDescriptor is the structured representation of a type ID,
+// as returned by DecodeTypeID.
+//
+type TypeDescriptor struct {
+	// Location is the location of the type,
+	// or nil if the type is a built-in type, e.g. `Int` or `AnyStruct`
+	Location common.Location
+	// QualifiedIdentifier is the qualified identifier of the type, e.g. `Foo.Bar`.
+	// It is empty for a restricted type without a restricted type, e.g. `{I}`
+	QualifiedIdentifier string
+	// Restrictions are the restrictions of a restricted type,
+	// or nil if the type is not a restricted type
+	Restrictions []TypeDescriptor
+}
+
+// TypeID returns the canonical type ID for the type described by the descriptor.
+//
+func (d TypeDescriptor) TypeID() TypeID {
+	var result strings.Builder
+
+	if d.Location != nil {
+		result.WriteString(string(d.Location.TypeID(nil, d.QualifiedIdentifier)))
+	} else {
+		result.WriteString(d.QualifiedIdentifier)
+	}
+
+	if d.Restrictions != nil {
+		result.WriteRune('{')
+		for i, restriction := range d.Restrictions {
+			if i > 0 {
+				result.WriteRune(',')
+			}
+			result.WriteString(string(restriction.TypeID()))
+		}
+		result.WriteRune('}')
+	}
+
+	return TypeID(result.String())
+}
+
+// DecodeTypeID decodes the given type ID of a nominal type, e.g. `A.0000000000000001.Foo.Bar`,
+// or a restricted type, e.g. `A.0000000000000001.Foo.Bar{A.0000000000000002.I}`,
+// into its location, qualified identifier, and restrictions.
+//
+// Addresses of address locations may be given in short form and with a `0x` prefix,
+// e.g. `A.0x1.Foo.Bar`.
+//
+// Type IDs of other types, e.g. optional types (`Int?`) or reference types (`&Int`), are not supported.
+//
+func DecodeTypeID(typeID TypeID) (TypeDescriptor, error) {
+	id := string(typeID)
+
+	if strings.HasSuffix(id, "}") {
+		openingBraceIndex := strings.IndexRune(id, '{')
+		if openingBraceIndex < 0 {
+			return TypeDescriptor{}, newInvalidTypeIDError(typeID, "missing opening brace")
+		}
+
+		var descriptor TypeDescriptor
+
+		restrictedTypeID := id[:openingBraceIndex]
+		if restrictedTypeID != "" {
+			var err error
+			descriptor, err = decodeNominalTypeID(typeID, restrictedTypeID)
+			if err != nil {
+				return TypeDescriptor{}, err
+			}
+		}
+
+		restrictionTypeIDs := strings.Split(id[openingBraceIndex+1:len(id)-1], ",")
+		descriptor.Restrictions = make([]TypeDescriptor, 0, len(restrictionTypeIDs))
+
+		for _, restrictionTypeID := range restrictionTypeIDs {
+			restriction, err := decodeNominalTypeID(typeID, restrictionTypeID)
+			if err != nil {
+				return TypeDescriptor{}, err
+			}
+			descriptor.Restrictions = append(descriptor.Restrictions, restriction)
+		}
+
+		return descriptor, nil
+	}
+
+	return decodeNominalTypeID(typeID, id)
+}
+
+func decodeNominalTypeID(typeID TypeID, id string) (TypeDescriptor, error) {
+	if id == "" {
+		return TypeDescriptor{}, newInvalidTypeIDError(typeID, "missing type")
+	}
+
+	if strings.ContainsAny(id, "{}[]()<>?&:; ") {
+		return TypeDescriptor{}, newInvalidTypeIDError(typeID, "unsupported type")
+	}
+
+	// Normalize the address of address locations,
+	// so the address may be given in short form and with a `0x` prefix
+
+	parts := strings.SplitN(id, ".", 3)
+	if len(parts) == 3 && parts[0] == common.AddressLocationPrefix {
+		address, err := common.HexToAddress(parts[1])
+		if err != nil {
+			return TypeDescriptor{}, newInvalidTypeIDError(typeID, "invalid address")
+		}
+		id = strings.Join([]string{parts[0], address.Hex(), parts[2]}, ".")
+	}
+
+	location, qualifiedIdentifier, err := common.DecodeTypeID(nil, id)
+	if err != nil {
+		return TypeDescriptor{}, err
+	}
+
+	if qualifiedIdentifier == "" {
+		return TypeDescriptor{}, newInvalidTypeIDError(typeID, "missing qualified identifier")
+	}
+
+	return TypeDescriptor{
+		Location:            location,
+		QualifiedIdentifier: qualifiedIdentifier,
+	}, nil
+}
+
+func newInvalidTypeIDError(typeID TypeID, message string) error {
+	return errors.NewDefaultUserError("invalid type ID `%s`: %s", typeID, message)
+}
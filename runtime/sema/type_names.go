@@ -47,6 +47,8 @@ const (
 	Word32TypeName = "Word32"
 	Word64TypeName = "Word64"
 
-	Fix64TypeName  = "Fix64"
-	UFix64TypeName = "UFix64"
+	Fix64TypeName   = "Fix64"
+	Fix128TypeName  = "Fix128"
+	UFix64TypeName  = "UFix64"
+	UFix128TypeName = "UFix128"
 )
@@ -0,0 +1,159 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// typePool interns structurally-equal types,
+// so that equal types share a single instance.
+//
+// Only types which are constructed from built-in types,
+// or from types which are themselves interned, are interned.
+// Types of user-defined declarations, e.g. composite types, are specific to a program,
+// so interning types constructed from them would grow the pool indefinitely.
+//
+// The pool is global and shared by all checkers, so it must be safe for concurrent use.
+// It is bounded, see typePoolSizeLimit.
+//
+type typePool struct {
+	// types maps the key of a type to the interned instance
+	types sync.Map
+	// size is the number of interned types
+	size int64
+}
+
+// typePoolSizeLimit is the maximum number of types in the type pool.
+// Once the limit is reached, types are no longer interned,
+// but still constructed as new instances.
+//
+const typePoolSizeLimit = 1 << 16
+
+type typePoolKind uint8
+
+const (
+	typePoolKindUnknown typePoolKind = iota
+	typePoolKindOptional
+	typePoolKindVariableSized
+	typePoolKindConstantSized
+	typePoolKindDictionary
+	typePoolKindReference
+)
+
+// typePoolKey is the key of a type in the type pool.
+// The component types are compared by identity,
+// which is sufficient, as they are either built-in or interned
+//
+type typePoolKey struct {
+	kind       typePoolKind
+	first      Type
+	second     Type
+	size       int64
+	authorized bool
+}
+
+var globalTypePool = &typePool{}
+
+// isInternable returns true if types constructed from the given type may be interned,
+// i.e. if the given type is a built-in type or an interned type
+//
+func (p *typePool) isInternable(ty Type) bool {
+	switch ty := ty.(type) {
+	case *SimpleType, *NumericType, *FixedPointNumericType:
+		return true
+
+	case *OptionalType:
+		return p.isInterned(typePoolKey{kind: typePoolKindOptional, first: ty.Type}, ty)
+
+	case *VariableSizedType:
+		return p.isInterned(typePoolKey{kind: typePoolKindVariableSized, first: ty.Type}, ty)
+
+	case *ConstantSizedType:
+		return p.isInterned(
+			typePoolKey{
+				kind:  typePoolKindConstantSized,
+				first: ty.Type,
+				size:  ty.Size,
+			},
+			ty,
+		)
+
+	case *DictionaryType:
+		return p.isInterned(
+			typePoolKey{
+				kind:   typePoolKindDictionary,
+				first:  ty.KeyType,
+				second: ty.ValueType,
+			},
+			ty,
+		)
+
+	case *ReferenceType:
+		return p.isInterned(
+			typePoolKey{
+				kind:       typePoolKindReference,
+				first:      ty.Type,
+				authorized: ty.Authorized,
+			},
+			ty,
+		)
+	}
+
+	return false
+}
+
+// isInterned returns true if the given type is the interned type for the given key.
+// Only keys with internable component types are stored,
+// so the component types do not have to be checked again
+//
+func (p *typePool) isInterned(key typePoolKey, ty Type) bool {
+	interned, ok := p.types.Load(key)
+	return ok && interned == ty
+}
+
+// intern returns the interned type for the given key.
+// If there is no interned type for the key yet, the type is constructed using the given function,
+// and it is interned, if possible
+//
+func (p *typePool) intern(key typePoolKey, construct func() Type) Type {
+	if !p.isInternable(key.first) ||
+		(key.second != nil && !p.isInternable(key.second)) {
+
+		return construct()
+	}
+
+	if interned, ok := p.types.Load(key); ok {
+		return interned.(Type)
+	}
+
+	ty := construct()
+
+	if atomic.LoadInt64(&p.size) >= typePoolSizeLimit {
+		return ty
+	}
+
+	interned, loaded := p.types.LoadOrStore(key, ty)
+	if !loaded {
+		atomic.AddInt64(&p.size, 1)
+	}
+
+	return interned.(Type)
+}
@@ -0,0 +1,181 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+)
+
+type typePoolTestMemoryGauge struct {
+	optionalTypes uint64
+}
+
+func (g *typePoolTestMemoryGauge) MeterMemory(usage common.MemoryUsage) error {
+	if usage.Kind == common.MemoryKindOptionalSemaType {
+		g.optionalTypes += usage.Amount
+	}
+	return nil
+}
+
+func TestTypePool(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("optional", func(t *testing.T) {
+
+		t.Parallel()
+
+		assert.Same(t,
+			NewOptionalType(nil, IntType),
+			NewOptionalType(nil, IntType),
+		)
+		assert.NotSame(t,
+			NewOptionalType(nil, IntType),
+			NewOptionalType(nil, StringType),
+		)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		assert.Same(t,
+			NewVariableSizedType(nil, NewOptionalType(nil, IntType)),
+			NewVariableSizedType(nil, NewOptionalType(nil, IntType)),
+		)
+	})
+
+	t.Run("constant sized", func(t *testing.T) {
+
+		t.Parallel()
+
+		assert.Same(t,
+			NewConstantSizedType(nil, IntType, 2),
+			NewConstantSizedType(nil, IntType, 2),
+		)
+		assert.NotSame(t,
+			NewConstantSizedType(nil, IntType, 2),
+			NewConstantSizedType(nil, IntType, 3),
+		)
+	})
+
+	t.Run("dictionary", func(t *testing.T) {
+
+		t.Parallel()
+
+		assert.Same(t,
+			NewDictionaryType(nil, StringType, IntType),
+			NewDictionaryType(nil, StringType, IntType),
+		)
+		assert.NotSame(t,
+			NewDictionaryType(nil, StringType, IntType),
+			NewDictionaryType(nil, IntType, StringType),
+		)
+	})
+
+	t.Run("reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		assert.Same(t,
+			NewReferenceType(nil, IntType, true),
+			NewReferenceType(nil, IntType, true),
+		)
+		assert.NotSame(t,
+			NewReferenceType(nil, IntType, true),
+			NewReferenceType(nil, IntType, false),
+		)
+	})
+
+	t.Run("composite element type", func(t *testing.T) {
+
+		t.Parallel()
+
+		compositeType := &CompositeType{
+			Location:   common.StringLocation("test"),
+			Identifier: "S",
+			Kind:       common.CompositeKindStructure,
+		}
+
+		first := NewOptionalType(nil, compositeType)
+		second := NewOptionalType(nil, compositeType)
+
+		assert.NotSame(t, first, second)
+		assert.True(t, first.Equal(second))
+	})
+
+	t.Run("uninterned element type", func(t *testing.T) {
+
+		t.Parallel()
+
+		elementType := &OptionalType{
+			Type: IntType,
+		}
+
+		assert.NotSame(t,
+			NewVariableSizedType(nil, elementType),
+			NewVariableSizedType(nil, elementType),
+		)
+	})
+
+	t.Run("memory is metered for interned types", func(t *testing.T) {
+
+		t.Parallel()
+
+		// Memory must be metered independent of the state of the pool
+
+		meter := &typePoolTestMemoryGauge{}
+
+		NewOptionalType(meter, IntType)
+		NewOptionalType(meter, IntType)
+
+		assert.Equal(t, uint64(2), meter.optionalTypes)
+	})
+
+	t.Run("concurrent", func(t *testing.T) {
+
+		t.Parallel()
+
+		const count = 8
+
+		types := make([]*DictionaryType, count)
+
+		var wg sync.WaitGroup
+		wg.Add(count)
+
+		for i := 0; i < count; i++ {
+			go func(i int) {
+				defer wg.Done()
+				types[i] = NewDictionaryType(nil, UInt8Type, NewOptionalType(nil, Int128Type))
+			}(i)
+		}
+
+		wg.Wait()
+
+		for _, ty := range types {
+			require.Same(t, types[0], ty)
+		}
+	})
+}
@@ -19,6 +19,8 @@
 package sema
 
 import (
+	"sync"
+
 	"github.com/onflow/cadence/runtime/errors"
 )
 
@@ -170,14 +172,14 @@ const (
 	_ // future: Fix16
 	_ // future: Fix32
 	fix64TypeMask
-	_ // future: Fix128
+	fix128TypeMask
 	_ // future: Fix256
 
 	_ // future: UFix8
 	_ // future: UFix16
 	_ // future: UFix32
 	ufix64TypeMask
-	_ // future: UFix128
+	ufix128TypeMask
 	_ // future: UFix256
 
 	stringTypeMask
@@ -217,8 +219,13 @@ const (
 	capabilityTypeMask uint64 = 1 << iota
 	restrictedTypeMask
 	transactionTypeMask
+	rangeTypeMask
+	tupleTypeMask
 
 	invalidTypeMask
+
+	// ~~ NOTE: All following bits of the upper mask are reserved for custom types. See NewCustomTypeTag. ~~
+	firstCustomTypeMask
 )
 
 var (
@@ -252,10 +259,12 @@ var (
 			Or(UnsignedIntegerTypeTag)
 
 	SignedFixedPointTypeTag = newTypeTagFromLowerMask(signedFixedPointTypeMask).
-				Or(Fix64TypeTag)
+				Or(Fix64TypeTag).
+				Or(Fix128TypeTag)
 
 	UnsignedFixedPointTypeTag = newTypeTagFromLowerMask(unsignedFixedPointTypeMask).
-					Or(UFix64TypeTag)
+					Or(UFix64TypeTag).
+					Or(UFix128TypeTag)
 
 	FixedPointTypeTag = newTypeTagFromLowerMask(fixedPointTypeMask).
 				Or(SignedFixedPointTypeTag).
@@ -291,8 +300,10 @@ var (
 	Word32TypeTag = newTypeTagFromLowerMask(word32TypeMask)
 	Word64TypeTag = newTypeTagFromLowerMask(word64TypeMask)
 
-	Fix64TypeTag  = newTypeTagFromLowerMask(fix64TypeMask)
-	UFix64TypeTag = newTypeTagFromLowerMask(ufix64TypeMask)
+	Fix64TypeTag   = newTypeTagFromLowerMask(fix64TypeMask)
+	Fix128TypeTag  = newTypeTagFromLowerMask(fix128TypeMask)
+	UFix64TypeTag  = newTypeTagFromLowerMask(ufix64TypeMask)
+	UFix128TypeTag = newTypeTagFromLowerMask(ufix128TypeMask)
 
 	StringTypeTag           = newTypeTagFromLowerMask(stringTypeMask)
 	CharacterTypeTag        = newTypeTagFromLowerMask(characterTypeMask)
@@ -330,6 +341,8 @@ var (
 	CapabilityTypeTag  = newTypeTagFromUpperMask(capabilityTypeMask)
 	InvalidTypeTag     = newTypeTagFromUpperMask(invalidTypeMask)
 	TransactionTypeTag = newTypeTagFromUpperMask(transactionTypeMask)
+	RangeTypeTag       = newTypeTagFromUpperMask(rangeTypeMask)
+	TupleTypeTag       = newTypeTagFromUpperMask(tupleTypeMask)
 
 	// AnyStructTypeTag only includes the types that are pre-known
 	// to belong to AnyStruct type. This is more of an optimization.
@@ -351,6 +364,7 @@ var (
 				Or(BlockTypeTag).
 				Or(DeployedContractTypeTag).
 				Or(CapabilityTypeTag).
+				Or(RangeTypeTag).
 				Or(FunctionTypeTag)
 
 	AnyResourceTypeTag = newTypeTagFromLowerMask(anyResourceTypeMask)
@@ -364,9 +378,53 @@ var (
 			Or(GenericTypeTag).
 			Or(InterfaceTypeTag).
 			Or(TransactionTypeTag).
-			Or(RestrictedTypeTag)
+			Or(RestrictedTypeTag).
+			Or(TupleTypeTag)
 )
 
+// Custom type tags
+
+// CustomTypeTagSuperTypeFunc is a function which returns the common supertype of the given types,
+// which all have the same custom type tag, or are the 'Never' type.
+//
+type CustomTypeTagSuperTypeFunc func(types []Type) Type
+
+var customTypeTagsLock sync.Mutex
+var nextCustomTypeMask = firstCustomTypeMask
+var customTypeTagSuperTypeFuncs = map[uint64]CustomTypeTagSuperTypeFunc{}
+
+// NewCustomTypeTag allocates a new type tag for a type which is not defined by Cadence itself,
+// e.g. a chain-specific built-in type provided by an embedder,
+// so the type can participate in the common supertype computation.
+//
+// The given function is used to find the common supertype of types which all have the new tag.
+// If the function is nil, the common supertype is the type itself if all types are equal,
+// and `AnyStruct` / `AnyResource` otherwise.
+//
+// Custom type tags are global and should be allocated once, e.g. in an `init` function,
+// before any program is checked.
+//
+func NewCustomTypeTag(superTypeFunc CustomTypeTagSuperTypeFunc) (TypeTag, error) {
+	customTypeTagsLock.Lock()
+	defer customTypeTagsLock.Unlock()
+
+	mask := nextCustomTypeMask
+	if mask == 0 {
+		return NoTypeTag, errors.NewDefaultUserError("cannot allocate custom type tag: all type tags are in use")
+	}
+
+	if superTypeFunc == nil {
+		superTypeFunc = getSuperTypeOfDerivedTypes
+	}
+
+	typeTag := newTypeTagFromUpperMask(mask)
+	customTypeTagSuperTypeFuncs[mask] = superTypeFunc
+
+	nextCustomTypeMask = mask << 1
+
+	return typeTag, nil
+}
+
 // Methods
 
 func LeastCommonSuperType(types ...Type) Type {
@@ -528,8 +586,12 @@ func findSuperTypeFromLowerMask(joinedTypeTag TypeTag, types []Type) Type {
 
 	case fix64TypeMask:
 		return Fix64Type
+	case fix128TypeMask:
+		return Fix128Type
 	case ufix64TypeMask:
 		return UFix64Type
+	case ufix128TypeMask:
+		return UFix128Type
 
 	case stringTypeMask:
 		return StringType
@@ -602,9 +664,11 @@ func findSuperTypeFromLowerMask(joinedTypeTag TypeTag, types []Type) Type {
 		return commonSuperTypeOfVariableSizedArrays(types)
 	case dictionaryTypeMask:
 		return commonSuperTypeOfDictionaries(types)
-	case referenceTypeMask,
-		genericTypeMask,
-		functionTypeMask,
+	case referenceTypeMask:
+		return commonSuperTypeOfReferences(types)
+	case functionTypeMask:
+		return commonSuperTypeOfFunctions(types)
+	case genericTypeMask,
 		interfaceTypeMask:
 
 		return getSuperTypeOfDerivedTypes(types)
@@ -621,11 +685,41 @@ func findSuperTypeFromUpperMask(joinedTypeTag TypeTag, types []Type) Type {
 		return InvalidType
 
 	// All derived types goes here.
-	case capabilityTypeMask,
-		restrictedTypeMask,
+	case capabilityTypeMask:
+		// The types may also include lower-masked types, e.g. optionals.
+		// If so, they are not homogenous. Return nil and continue on advanced checks.
+		if joinedTypeTag.lowerMask != 0 {
+			return nil
+		}
+		return commonSuperTypeOfCapabilities(types)
+	case rangeTypeMask:
+		// The types may also include lower-masked types, e.g. optionals.
+		// If so, they are not homogenous. Return nil and continue on advanced checks.
+		if joinedTypeTag.lowerMask != 0 {
+			return nil
+		}
+		return getSuperTypeOfDerivedTypes(types)
+	case tupleTypeMask:
+		// The types may also include lower-masked types, e.g. optionals.
+		// If so, they are not homogenous. Return nil and continue on advanced checks.
+		if joinedTypeTag.lowerMask != 0 {
+			return nil
+		}
+		return commonSuperTypeOfTuples(types)
+	case restrictedTypeMask,
 		transactionTypeMask:
 		return getSuperTypeOfDerivedTypes(types)
 	default:
+		// The types may also include lower-masked types, e.g. optionals.
+		// If so, they are not homogenous. Return nil and continue on advanced checks.
+		if joinedTypeTag.lowerMask != 0 {
+			return nil
+		}
+
+		if superTypeFunc, ok := customTypeTagSuperTypeFuncs[joinedTypeTag.upperMask]; ok {
+			return superTypeFunc(types)
+		}
+
 		return nil
 	}
 }
@@ -777,6 +871,357 @@ func commonSuperTypeOfDictionaries(types []Type) Type {
 	}
 }
 
+// commonTypeOfEqualTypes returns the type of all given types,
+// ignoring 'Never' types, if they are all equal.
+// It returns nil if the types are not all equal.
+//
+func commonTypeOfEqualTypes(types []Type) Type {
+	var prevType Type
+	for _, typ := range types {
+		// 'Never' type doesn't affect the supertype.
+		// Hence, ignore them
+		if typ == NeverType {
+			continue
+		}
+
+		if prevType == nil {
+			prevType = typ
+			continue
+		}
+
+		if !typ.Equal(prevType) {
+			return nil
+		}
+	}
+
+	return prevType
+}
+
+func commonSuperTypeOfReferences(types []Type) Type {
+	// We reach here if all types are reference types.
+	// Therefore, decide the common supertype based on the referenced types.
+
+	if commonType := commonTypeOfEqualTypes(types); commonType != nil {
+		return commonType
+	}
+
+	referencedTypes := make([]Type, 0, len(types))
+
+	// The supertype is only authorized if all references are authorized,
+	// as the holder of the reference may not gain more permissions.
+	authorized := true
+
+	for _, typ := range types {
+		// 'Never' type doesn't affect the supertype.
+		// Hence, ignore them
+		if typ == NeverType {
+			continue
+		}
+
+		referenceType, ok := typ.(*ReferenceType)
+		if !ok {
+			panic(errors.NewUnexpectedError("expected reference type, found %s", typ))
+		}
+
+		referencedTypes = append(referencedTypes, referenceType.Type)
+		authorized = authorized && referenceType.Authorized
+	}
+
+	if len(referencedTypes) == 0 {
+		return InvalidType
+	}
+
+	referencedSuperType := LeastCommonSuperType(referencedTypes...)
+	if referencedSuperType == InvalidType {
+		return commonSuperTypeOfHeterogeneousTypes(types)
+	}
+
+	// References are covariant in their referenced types,
+	// but unauthorized references may not be upcast to all supertypes
+	// of the referenced type, e.g. `&Int8` is not a subtype of `&Integer`.
+	// Hence, ensure the inferred reference type is a supertype of all references,
+	// and fall back to a reference to `AnyStruct` / `AnyResource` otherwise.
+
+	candidateReferencedTypes := []Type{
+		referencedSuperType,
+		commonSuperTypeOfHeterogeneousTypes(referencedTypes),
+	}
+
+	for _, candidateReferencedType := range candidateReferencedTypes {
+		superType := &ReferenceType{
+			Authorized: authorized,
+			Type:       candidateReferencedType,
+		}
+
+		if isCommonSuperType(superType, types) {
+			return superType
+		}
+	}
+
+	return commonSuperTypeOfHeterogeneousTypes(types)
+}
+
+// isCommonSuperType returns true if the given type is a supertype of all the given types.
+//
+func isCommonSuperType(superType Type, types []Type) bool {
+	for _, typ := range types {
+		if !IsSubType(typ, superType) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func commonSuperTypeOfCapabilities(types []Type) Type {
+	// We reach here if all types are capability types.
+	// Therefore, decide the common supertype based on the borrow types.
+
+	if commonType := commonTypeOfEqualTypes(types); commonType != nil {
+		return commonType
+	}
+
+	borrowTypes := make([]Type, 0, len(types))
+
+	for _, typ := range types {
+		// 'Never' type doesn't affect the supertype.
+		// Hence, ignore them
+		if typ == NeverType {
+			continue
+		}
+
+		capabilityType, ok := typ.(*CapabilityType)
+		if !ok {
+			panic(errors.NewUnexpectedError("expected capability type, found %s", typ))
+		}
+
+		// The untyped capability type is the supertype of all capability types
+		if capabilityType.BorrowType == nil {
+			return &CapabilityType{}
+		}
+
+		borrowTypes = append(borrowTypes, capabilityType.BorrowType)
+	}
+
+	// Capabilities are covariant in their borrow types.
+	// If the borrow types have no common reference supertype,
+	// then the common supertype is the untyped capability type.
+
+	borrowSuperType := LeastCommonSuperType(borrowTypes...)
+	if _, ok := borrowSuperType.(*ReferenceType); !ok {
+		return &CapabilityType{}
+	}
+
+	return &CapabilityType{
+		BorrowType: borrowSuperType,
+	}
+}
+
+func commonSuperTypeOfFunctions(types []Type) Type {
+	// We reach here if all types are function types.
+	// Functions are contravariant in their parameter types,
+	// and covariant in their return type.
+	// Therefore, the common supertype has the common subtypes of the parameter types,
+	// and the common supertype of the return types.
+
+	if commonType := commonTypeOfEqualTypes(types); commonType != nil {
+		return commonType
+	}
+
+	functionTypes := make([]*FunctionType, 0, len(types))
+
+	for _, typ := range types {
+		// 'Never' type doesn't affect the supertype.
+		// Hence, ignore them
+		if typ == NeverType {
+			continue
+		}
+
+		functionType, ok := typ.(*FunctionType)
+		if !ok {
+			panic(errors.NewUnexpectedError("expected function type, found %s", typ))
+		}
+
+		// Generic functions and functions with custom argument checks
+		// (e.g. built-in functions) are not unified
+
+		if len(functionType.TypeParameters) > 0 ||
+			functionType.RequiredArgumentCount != nil ||
+			functionType.ArgumentExpressionsCheck != nil {
+
+			return commonSuperTypeOfHeterogeneousTypes(types)
+		}
+
+		functionTypes = append(functionTypes, functionType)
+	}
+
+	if len(functionTypes) == 0 {
+		return InvalidType
+	}
+
+	firstFunctionType := functionTypes[0]
+
+	purity := FunctionPurityView
+
+	for _, functionType := range functionTypes {
+		if len(functionType.Parameters) != len(firstFunctionType.Parameters) ||
+			functionType.IsConstructor != firstFunctionType.IsConstructor ||
+			(functionType.ReturnTypeAnnotation == nil) != (firstFunctionType.ReturnTypeAnnotation == nil) {
+
+			return commonSuperTypeOfHeterogeneousTypes(types)
+		}
+
+		// Impure functions are not subtypes of view functions
+		if functionType.Purity != FunctionPurityView {
+			purity = FunctionPurityImpure
+		}
+	}
+
+	parameters := make([]*Parameter, 0, len(firstFunctionType.Parameters))
+
+	for i, firstParameter := range firstFunctionType.Parameters {
+
+		parameterTypes := make([]Type, 0, len(functionTypes))
+
+		label := firstParameter.Label
+		argumentLabel := firstParameter.EffectiveArgumentLabel()
+
+		for _, functionType := range functionTypes {
+			parameter := functionType.Parameters[i]
+			parameterTypes = append(parameterTypes, parameter.TypeAnnotation.Type)
+
+			// If the argument labels differ, do not require an argument label
+			if parameter.EffectiveArgumentLabel() != argumentLabel {
+				label = ArgumentLabelNotRequired
+			}
+		}
+
+		parameterSubType := commonSubTypeOfTypes(parameterTypes)
+		if parameterSubType == nil {
+			return commonSuperTypeOfHeterogeneousTypes(types)
+		}
+
+		parameters = append(
+			parameters,
+			&Parameter{
+				Label:          label,
+				Identifier:     firstParameter.Identifier,
+				TypeAnnotation: NewTypeAnnotation(parameterSubType),
+			},
+		)
+	}
+
+	var returnTypeAnnotation *TypeAnnotation
+
+	if firstFunctionType.ReturnTypeAnnotation != nil {
+		returnTypes := make([]Type, 0, len(functionTypes))
+
+		for _, functionType := range functionTypes {
+			returnTypes = append(returnTypes, functionType.ReturnTypeAnnotation.Type)
+		}
+
+		returnSuperType := LeastCommonSuperType(returnTypes...)
+		if returnSuperType == InvalidType {
+			return commonSuperTypeOfHeterogeneousTypes(types)
+		}
+
+		returnTypeAnnotation = NewTypeAnnotation(returnSuperType)
+	}
+
+	return &FunctionType{
+		IsConstructor:        firstFunctionType.IsConstructor,
+		Purity:               purity,
+		Parameters:           parameters,
+		ReturnTypeAnnotation: returnTypeAnnotation,
+	}
+}
+
+func commonSuperTypeOfTuples(types []Type) Type {
+	// We reach here if all types are tuple types.
+	// Tuples are covariant in their element types.
+	// Therefore, the common supertype has the common supertypes of the element types.
+
+	if commonType := commonTypeOfEqualTypes(types); commonType != nil {
+		return commonType
+	}
+
+	tupleTypes := make([]*TupleType, 0, len(types))
+
+	for _, typ := range types {
+		// 'Never' type doesn't affect the supertype.
+		// Hence, ignore them
+		if typ == NeverType {
+			continue
+		}
+
+		tupleType, ok := typ.(*TupleType)
+		if !ok {
+			panic(errors.NewUnexpectedError("expected tuple type, found %s", typ))
+		}
+
+		tupleTypes = append(tupleTypes, tupleType)
+	}
+
+	if len(tupleTypes) == 0 {
+		return InvalidType
+	}
+
+	firstTupleType := tupleTypes[0]
+
+	// Tuples with different lengths are not covariant
+
+	for _, tupleType := range tupleTypes {
+		if len(tupleType.ElementTypes) != len(firstTupleType.ElementTypes) {
+			return commonSuperTypeOfHeterogeneousTypes(types)
+		}
+	}
+
+	elementSuperTypes := make([]Type, 0, len(firstTupleType.ElementTypes))
+
+	for i := range firstTupleType.ElementTypes {
+
+		elementTypes := make([]Type, 0, len(tupleTypes))
+
+		for _, tupleType := range tupleTypes {
+			elementTypes = append(elementTypes, tupleType.ElementTypes[i])
+		}
+
+		elementSuperType := LeastCommonSuperType(elementTypes...)
+		if elementSuperType == InvalidType {
+			return commonSuperTypeOfHeterogeneousTypes(types)
+		}
+
+		elementSuperTypes = append(elementSuperTypes, elementSuperType)
+	}
+
+	return &TupleType{
+		ElementTypes: elementSuperTypes,
+	}
+}
+
+// commonSubTypeOfTypes returns the type among the given types
+// which is a subtype of all the given types.
+// It returns nil if there is no such type.
+//
+func commonSubTypeOfTypes(types []Type) Type {
+	for _, candidate := range types {
+		isCommonSubType := true
+
+		for _, typ := range types {
+			if !IsSubType(candidate, typ) {
+				isCommonSubType = false
+				break
+			}
+		}
+
+		if isCommonSubType {
+			return candidate
+		}
+	}
+
+	return nil
+}
+
 func commonSuperTypeOfHeterogeneousTypes(types []Type) Type {
 	var hasStructs, hasResources bool
 	for _, typ := range types {
@@ -524,7 +524,7 @@ func TestBeforeType_Strings(t *testing.T) {
 
 	t.Parallel()
 
-	expected := "(<T: AnyStruct>(_ value: T): T)"
+	expected := "view (<T: AnyStruct>(_ value: T): T)"
 
 	assert.Equal(t,
 		expected,
@@ -1266,6 +1266,28 @@ func TestCommonSuperType(t *testing.T) {
 	t.Run("References types", func(t *testing.T) {
 		t.Parallel()
 
+		testLocation := common.StringLocation("test")
+
+		resourceInterface := &InterfaceType{
+			Location:      testLocation,
+			Identifier:    "I",
+			CompositeKind: common.CompositeKindResource,
+			Members:       &StringMemberOrderedMap{},
+		}
+
+		newResourceWithInterfaces := func(name string, interfaces ...*InterfaceType) *CompositeType {
+			return &CompositeType{
+				Location:                      testLocation,
+				Identifier:                    name,
+				Kind:                          common.CompositeKindResource,
+				ExplicitInterfaceConformances: interfaces,
+				Members:                       &StringMemberOrderedMap{},
+			}
+		}
+
+		resource1 := newResourceWithInterfaces("R1", resourceInterface)
+		resource2 := newResourceWithInterfaces("R2", resourceInterface)
+
 		tests := []testCase{
 			{
 				name: "homogenous references",
@@ -1294,7 +1316,118 @@ func TestCommonSuperType(t *testing.T) {
 						Type: StringType,
 					},
 				},
-				expectedSuperType: AnyStructType,
+				expectedSuperType: &ReferenceType{
+					Type: AnyStructType,
+				},
+			},
+			{
+				name: "numeric references",
+				types: []Type{
+					&ReferenceType{
+						Type: Int8Type,
+					},
+					&ReferenceType{
+						Type: Int16Type,
+					},
+				},
+				// `&Int8` is not a subtype of `&SignedInteger`
+				expectedSuperType: &ReferenceType{
+					Type: AnyStructType,
+				},
+			},
+			{
+				name: "authorized numeric references",
+				types: []Type{
+					&ReferenceType{
+						Authorized: true,
+						Type:       Int8Type,
+					},
+					&ReferenceType{
+						Authorized: true,
+						Type:       Int16Type,
+					},
+				},
+				expectedSuperType: &ReferenceType{
+					Authorized: true,
+					Type:       SignedIntegerType,
+				},
+			},
+			{
+				name: "authorized & unauthorized references",
+				types: []Type{
+					&ReferenceType{
+						Authorized: true,
+						Type:       Int8Type,
+					},
+					&ReferenceType{
+						Type: StringType,
+					},
+				},
+				expectedSuperType: &ReferenceType{
+					Type: AnyStructType,
+				},
+			},
+			{
+				name: "authorized & unauthorized references of same type",
+				types: []Type{
+					&ReferenceType{
+						Authorized: true,
+						Type:       Int8Type,
+					},
+					&ReferenceType{
+						Type: Int8Type,
+					},
+				},
+				expectedSuperType: &ReferenceType{
+					Type: Int8Type,
+				},
+			},
+			{
+				name: "resource references without common interface",
+				types: []Type{
+					&ReferenceType{
+						Type: resource1,
+					},
+					&ReferenceType{
+						Type: resourceType,
+					},
+				},
+				expectedSuperType: &ReferenceType{
+					Type: AnyResourceType,
+				},
+			},
+			{
+				name: "references & never",
+				types: []Type{
+					&ReferenceType{
+						Type: Int8Type,
+					},
+					NeverType,
+					&ReferenceType{
+						Type: StringType,
+					},
+				},
+				expectedSuperType: &ReferenceType{
+					Type: AnyStructType,
+				},
+			},
+			{
+				name: "optional references",
+				types: []Type{
+					&OptionalType{
+						Type: &ReferenceType{
+							Type: Int8Type,
+						},
+					},
+					&ReferenceType{
+						Type: StringType,
+					},
+				},
+				expectedSuperType: &OptionalType{
+					Type: &ReferenceType{
+						Type: AnyStructType,
+					},
+				},
 			},
 			{
 				name: "references & non-references",
@@ -1321,6 +1454,36 @@ func TestCommonSuperType(t *testing.T) {
 		}
 
 		testLeastCommonSuperType(t, tests)
+
+		t.Run("resource references with common interface", func(t *testing.T) {
+
+			superType := LeastCommonSuperType(
+				&ReferenceType{
+					Type: resource1,
+				},
+				&ReferenceType{
+					Type: resource2,
+				},
+			)
+
+			// NOTE: compare using type equality,
+			// as the restriction set of the inferred restricted type is already initialized
+
+			expectedSuperType := &ReferenceType{
+				Type: &RestrictedType{
+					Type:         AnyResourceType,
+					Restrictions: []*InterfaceType{resourceInterface},
+				},
+			}
+
+			assert.Truef(
+				t,
+				expectedSuperType.Equal(superType),
+				"expected %s, got %s",
+				expectedSuperType,
+				superType,
+			)
+		})
 	})
 
 	t.Run("Path types", func(t *testing.T) {
@@ -1406,6 +1569,107 @@ func TestCommonSuperType(t *testing.T) {
 				},
 				expectedSuperType: InvalidType,
 			},
+			{
+				name: "same borrow types",
+				types: []Type{
+					&CapabilityType{
+						BorrowType: &ReferenceType{Type: IntType},
+					},
+					&CapabilityType{
+						BorrowType: &ReferenceType{Type: IntType},
+					},
+				},
+				expectedSuperType: &CapabilityType{
+					BorrowType: &ReferenceType{Type: IntType},
+				},
+			},
+			{
+				name: "heterogeneous borrow types",
+				types: []Type{
+					&CapabilityType{
+						BorrowType: &ReferenceType{Type: IntType},
+					},
+					&CapabilityType{
+						BorrowType: &ReferenceType{Type: StringType},
+					},
+				},
+				expectedSuperType: &CapabilityType{
+					BorrowType: &ReferenceType{Type: AnyStructType},
+				},
+			},
+			{
+				name: "authorized borrow types",
+				types: []Type{
+					&CapabilityType{
+						BorrowType: &ReferenceType{
+							Authorized: true,
+							Type:       IntType,
+						},
+					},
+					&CapabilityType{
+						BorrowType: &ReferenceType{
+							Authorized: true,
+							Type:       Int8Type,
+						},
+					},
+				},
+				expectedSuperType: &CapabilityType{
+					BorrowType: &ReferenceType{
+						Authorized: true,
+						Type:       SignedIntegerType,
+					},
+				},
+			},
+			{
+				name: "struct and resource borrow types",
+				types: []Type{
+					&CapabilityType{
+						BorrowType: &ReferenceType{Type: IntType},
+					},
+					&CapabilityType{
+						BorrowType: &ReferenceType{Type: AnyResourceType},
+					},
+				},
+				expectedSuperType: &CapabilityType{},
+			},
+			{
+				name: "typed and untyped",
+				types: []Type{
+					&CapabilityType{
+						BorrowType: &ReferenceType{Type: IntType},
+					},
+					&CapabilityType{},
+				},
+				expectedSuperType: &CapabilityType{},
+			},
+			{
+				name: "optional capabilities",
+				types: []Type{
+					&OptionalType{
+						Type: &CapabilityType{
+							BorrowType: &ReferenceType{Type: IntType},
+						},
+					},
+					&CapabilityType{
+						BorrowType: &ReferenceType{Type: StringType},
+					},
+				},
+				expectedSuperType: &OptionalType{
+					Type: &CapabilityType{
+						BorrowType: &ReferenceType{Type: AnyStructType},
+					},
+				},
+			},
+			{
+				name: "capability and non-capability",
+				types: []Type{
+					&CapabilityType{
+						BorrowType: &ReferenceType{Type: IntType},
+					},
+					IntType,
+				},
+				expectedSuperType: AnyStructType,
+			},
 		}
 
 		testLeastCommonSuperType(t, tests)
@@ -1495,6 +1759,159 @@ func TestCommonSuperType(t *testing.T) {
 				},
 				expectedSuperType: AnyStructType,
 			},
+			{
+				name: "contravariant parameters",
+				types: []Type{
+					&FunctionType{
+						Parameters: []*Parameter{
+							{
+								Identifier:     "x",
+								TypeAnnotation: NewTypeAnnotation(IntType),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(Int8Type),
+					},
+					&FunctionType{
+						Parameters: []*Parameter{
+							{
+								Identifier:     "x",
+								TypeAnnotation: NewTypeAnnotation(IntegerType),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(Int8Type),
+					},
+				},
+				expectedSuperType: &FunctionType{
+					Parameters: []*Parameter{
+						{
+							Identifier:     "x",
+							TypeAnnotation: NewTypeAnnotation(IntType),
+						},
+					},
+					ReturnTypeAnnotation: NewTypeAnnotation(Int8Type),
+				},
+			},
+			{
+				name: "covariant return types",
+				types: []Type{
+					&FunctionType{
+						ReturnTypeAnnotation: NewTypeAnnotation(Int8Type),
+					},
+					&FunctionType{
+						ReturnTypeAnnotation: NewTypeAnnotation(StringType),
+					},
+				},
+				expectedSuperType: &FunctionType{
+					Parameters:           []*Parameter{},
+					ReturnTypeAnnotation: NewTypeAnnotation(AnyStructType),
+				},
+			},
+			{
+				name: "view and impure",
+				types: []Type{
+					&FunctionType{
+						Purity:               FunctionPurityView,
+						ReturnTypeAnnotation: NewTypeAnnotation(Int8Type),
+					},
+					&FunctionType{
+						ReturnTypeAnnotation: NewTypeAnnotation(Int16Type),
+					},
+				},
+				expectedSuperType: &FunctionType{
+					Purity:               FunctionPurityImpure,
+					Parameters:           []*Parameter{},
+					ReturnTypeAnnotation: NewTypeAnnotation(SignedIntegerType),
+				},
+			},
+			{
+				name: "all view",
+				types: []Type{
+					&FunctionType{
+						Purity:               FunctionPurityView,
+						ReturnTypeAnnotation: NewTypeAnnotation(Int8Type),
+					},
+					&FunctionType{
+						Purity:               FunctionPurityView,
+						ReturnTypeAnnotation: NewTypeAnnotation(Int16Type),
+					},
+				},
+				expectedSuperType: &FunctionType{
+					Purity:               FunctionPurityView,
+					Parameters:           []*Parameter{},
+					ReturnTypeAnnotation: NewTypeAnnotation(SignedIntegerType),
+				},
+			},
+			{
+				name: "different argument labels",
+				types: []Type{
+					&FunctionType{
+						Parameters: []*Parameter{
+							{
+								Identifier:     "x",
+								TypeAnnotation: NewTypeAnnotation(IntType),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(VoidType),
+					},
+					&FunctionType{
+						Parameters: []*Parameter{
+							{
+								Label:          "y",
+								Identifier:     "x",
+								TypeAnnotation: NewTypeAnnotation(IntegerType),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(VoidType),
+					},
+				},
+				expectedSuperType: &FunctionType{
+					Parameters: []*Parameter{
+						{
+							Label:          ArgumentLabelNotRequired,
+							Identifier:     "x",
+							TypeAnnotation: NewTypeAnnotation(IntType),
+						},
+					},
+					ReturnTypeAnnotation: NewTypeAnnotation(VoidType),
+				},
+			},
+			{
+				name: "different parameter counts",
+				types: []Type{
+					funcType1,
+					&FunctionType{
+						ReturnTypeAnnotation: NewTypeAnnotation(Int8Type),
+					},
+				},
+				expectedSuperType: AnyStructType,
+			},
+			{
+				name: "struct and resource return types",
+				types: []Type{
+					&FunctionType{
+						ReturnTypeAnnotation: NewTypeAnnotation(Int8Type),
+					},
+					&FunctionType{
+						ReturnTypeAnnotation: NewTypeAnnotation(resourceType),
+					},
+				},
+				expectedSuperType: AnyStructType,
+			},
+			{
+				name: "generic functions",
+				types: []Type{
+					&FunctionType{
+						TypeParameters: []*TypeParameter{
+							{Name: "T"},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(Int8Type),
+					},
+					&FunctionType{
+						ReturnTypeAnnotation: NewTypeAnnotation(Int8Type),
+					},
+				},
+				expectedSuperType: AnyStructType,
+			},
 		}
 
 		testLeastCommonSuperType(t, tests)
@@ -1644,12 +2061,188 @@ func TestCommonSuperType(t *testing.T) {
 
 				expectedSuperType: AnyStructType,
 			},
+			{
+				name: "multi-level numeric optional types",
+				types: []Type{
+					&OptionalType{
+						Type: Int8Type,
+					},
+					&OptionalType{
+						Type: &OptionalType{
+							Type: Int16Type,
+						},
+					},
+				},
+
+				expectedSuperType: &OptionalType{
+					Type: &OptionalType{
+						Type: SignedIntegerType,
+					},
+				},
+			},
+			{
+				name: "nil with multi-level optional type",
+				types: []Type{
+					nilType,
+					doubleOptionalStructType,
+				},
+
+				expectedSuperType: doubleOptionalStructType,
+			},
+			{
+				name: "multi-level optional arrays",
+				types: []Type{
+					&VariableSizedType{
+						Type: Int8Type,
+					},
+					&OptionalType{
+						Type: &OptionalType{
+							Type: &VariableSizedType{
+								Type: Int16Type,
+							},
+						},
+					},
+				},
+
+				expectedSuperType: &OptionalType{
+					Type: &OptionalType{
+						Type: &VariableSizedType{
+							Type: SignedIntegerType,
+						},
+					},
+				},
+			},
+			{
+				name: "multi-level optional struct and resource",
+				types: []Type{
+					optionalStructType,
+					&OptionalType{
+						Type: &OptionalType{
+							Type: resourceType,
+						},
+					},
+				},
+
+				expectedSuperType: InvalidType,
+			},
 		}
 
 		testLeastCommonSuperType(t, tests)
 	})
 }
 
+func TestCustomTypeTag(t *testing.T) {
+
+	// NOTE: not parallel, as custom type tags are global
+
+	newCustomType := func(name string, tag TypeTag) *SimpleType {
+		ty := &SimpleType{
+			Name:          name,
+			QualifiedName: name,
+			TypeID:        TypeID(name),
+			Storable:      true,
+			Equatable:     true,
+			Importable:    true,
+		}
+		ty.SetTag(tag)
+		return ty
+	}
+
+	var familyType *SimpleType
+
+	familyTypeTag, err := NewCustomTypeTag(func(types []Type) Type {
+		var superType Type
+		for _, typ := range types {
+			if typ == NeverType {
+				continue
+			}
+			if superType == nil {
+				superType = typ
+			} else if !typ.Equal(superType) {
+				return familyType
+			}
+		}
+		if superType == nil {
+			return InvalidType
+		}
+		return superType
+	})
+	require.NoError(t, err)
+
+	defaultTypeTag, err := NewCustomTypeTag(nil)
+	require.NoError(t, err)
+
+	assert.NotEqual(t, familyTypeTag, defaultTypeTag)
+
+	familyType = newCustomType("Family", familyTypeTag)
+	familyMember1 := newCustomType("FamilyMember1", familyTypeTag)
+	familyMember2 := newCustomType("FamilyMember2", familyTypeTag)
+
+	defaultType1 := newCustomType("Default1", defaultTypeTag)
+	defaultType2 := newCustomType("Default2", defaultTypeTag)
+
+	type testCase struct {
+		name              string
+		types             []Type
+		expectedSuperType Type
+	}
+
+	tests := []testCase{
+		{
+			name:              "same family types",
+			types:             []Type{familyMember1, familyMember1},
+			expectedSuperType: familyMember1,
+		},
+		{
+			name:              "different family types",
+			types:             []Type{familyMember1, NeverType, familyMember2},
+			expectedSuperType: familyType,
+		},
+		{
+			name: "optional family types",
+			types: []Type{
+				&OptionalType{
+					Type: familyMember1,
+				},
+				familyMember2,
+			},
+			expectedSuperType: &OptionalType{
+				Type: familyType,
+			},
+		},
+		{
+			name:              "same default types",
+			types:             []Type{defaultType1, defaultType1},
+			expectedSuperType: defaultType1,
+		},
+		{
+			name:              "different default types",
+			types:             []Type{defaultType1, defaultType2},
+			expectedSuperType: AnyStructType,
+		},
+		{
+			name:              "family and default types",
+			types:             []Type{familyMember1, defaultType1},
+			expectedSuperType: AnyStructType,
+		},
+		{
+			name:              "custom and built-in types",
+			types:             []Type{familyMember1, IntType},
+			expectedSuperType: AnyStructType,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(
+				t,
+				test.expectedSuperType,
+				LeastCommonSuperType(test.types...),
+			)
+		})
+	}
+}
+
 func TestTypeInclusions(t *testing.T) {
 
 	t.Parallel()
@@ -1777,3 +2370,310 @@ func TestTypeInclusions(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestCheckCastability(t *testing.T) {
+
+	t.Parallel()
+
+	resourceType := &CompositeType{
+		Location:   common.StringLocation("test"),
+		Identifier: "R",
+		Kind:       common.CompositeKindResource,
+	}
+
+	structType := &CompositeType{
+		Location:   common.StringLocation("test"),
+		Identifier: "S",
+		Kind:       common.CompositeKindStructure,
+	}
+
+	type testCase struct {
+		name     string
+		from     Type
+		to       Type
+		expected CastKind
+	}
+
+	tests := []testCase{
+		{
+			name:     "same type",
+			from:     IntType,
+			to:       IntType,
+			expected: CastKindStatic,
+		},
+		{
+			name:     "upcast",
+			from:     IntType,
+			to:       AnyStructType,
+			expected: CastKindStatic,
+		},
+		{
+			name:     "optional upcast",
+			from:     IntType,
+			to:       &OptionalType{Type: IntType},
+			expected: CastKindStatic,
+		},
+		{
+			name:     "downcast",
+			from:     AnyStructType,
+			to:       IntType,
+			expected: CastKindDynamic,
+		},
+		{
+			name:     "resource downcast",
+			from:     AnyResourceType,
+			to:       resourceType,
+			expected: CastKindDynamic,
+		},
+		{
+			name:     "resource to struct",
+			from:     resourceType,
+			to:       structType,
+			expected: CastKindImpossible,
+		},
+		{
+			name:     "struct to resource",
+			from:     AnyStructType,
+			to:       resourceType,
+			expected: CastKindImpossible,
+		},
+		{
+			name:     "unauthorized reference to authorized reference",
+			from:     &ReferenceType{Type: AnyStructType},
+			to:       &ReferenceType{Type: IntType, Authorized: true},
+			expected: CastKindImpossible,
+		},
+		{
+			name:     "authorized reference downcast",
+			from:     &ReferenceType{Type: AnyStructType, Authorized: true},
+			to:       &ReferenceType{Type: IntType},
+			expected: CastKindDynamic,
+		},
+		{
+			name:     "invalid",
+			from:     InvalidType,
+			to:       IntType,
+			expected: CastKindUnknown,
+		},
+		{
+			name:     "missing",
+			from:     nil,
+			to:       IntType,
+			expected: CastKindUnknown,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+
+			t.Parallel()
+
+			assert.Equal(t, test.expected, CheckCastability(test.from, test.to))
+		})
+	}
+}
+
+func TestDecodeTypeID(t *testing.T) {
+
+	t.Parallel()
+
+	address1 := common.MustBytesToAddress([]byte{0x1})
+	address2 := common.MustBytesToAddress([]byte{0x2})
+
+	t.Run("built-in", func(t *testing.T) {
+
+		t.Parallel()
+
+		descriptor, err := DecodeTypeID("Int")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			TypeDescriptor{
+				QualifiedIdentifier: "Int",
+			},
+			descriptor,
+		)
+		assert.Equal(t, TypeID("Int"), descriptor.TypeID())
+	})
+
+	t.Run("composite", func(t *testing.T) {
+
+		t.Parallel()
+
+		descriptor, err := DecodeTypeID("A.0000000000000001.Foo.Bar")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			TypeDescriptor{
+				Location:            common.NewAddressLocation(nil, address1, "Foo"),
+				QualifiedIdentifier: "Foo.Bar",
+			},
+			descriptor,
+		)
+		assert.Equal(t, TypeID("A.0000000000000001.Foo.Bar"), descriptor.TypeID())
+	})
+
+	t.Run("short address", func(t *testing.T) {
+
+		t.Parallel()
+
+		descriptor, err := DecodeTypeID("A.0x1.Foo.Bar")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			TypeDescriptor{
+				Location:            common.NewAddressLocation(nil, address1, "Foo"),
+				QualifiedIdentifier: "Foo.Bar",
+			},
+			descriptor,
+		)
+		assert.Equal(t, TypeID("A.0000000000000001.Foo.Bar"), descriptor.TypeID())
+	})
+
+	t.Run("restricted", func(t *testing.T) {
+
+		t.Parallel()
+
+		descriptor, err := DecodeTypeID("A.0x1.Foo.Bar{A.0x2.I,S.test.J}")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			TypeDescriptor{
+				Location:            common.NewAddressLocation(nil, address1, "Foo"),
+				QualifiedIdentifier: "Foo.Bar",
+				Restrictions: []TypeDescriptor{
+					{
+						Location:            common.NewAddressLocation(nil, address2, "I"),
+						QualifiedIdentifier: "I",
+					},
+					{
+						Location:            common.StringLocation("test"),
+						QualifiedIdentifier: "J",
+					},
+				},
+			},
+			descriptor,
+		)
+		assert.Equal(t,
+			TypeID("A.0000000000000001.Foo.Bar{A.0000000000000002.I,S.test.J}"),
+			descriptor.TypeID(),
+		)
+	})
+
+	t.Run("restricted type round trip", func(t *testing.T) {
+
+		t.Parallel()
+
+		ty := &RestrictedType{
+			Type: AnyResourceType,
+			Restrictions: []*InterfaceType{
+				{
+					Location:      common.NewAddressLocation(nil, address2, "I"),
+					Identifier:    "I",
+					CompositeKind: common.CompositeKindResource,
+				},
+			},
+		}
+
+		descriptor, err := DecodeTypeID(ty.ID())
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			TypeDescriptor{
+				QualifiedIdentifier: "AnyResource",
+				Restrictions: []TypeDescriptor{
+					{
+						Location:            common.NewAddressLocation(nil, address2, "I"),
+						QualifiedIdentifier: "I",
+					},
+				},
+			},
+			descriptor,
+		)
+		assert.Equal(t, ty.ID(), descriptor.TypeID())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+
+		t.Parallel()
+
+		for _, typeID := range []TypeID{
+			"",
+			"Int?",
+			"&Int",
+			"[Int]",
+			"A.0x1.Foo{",
+			"Foo}",
+			"A.0x1.Foo{}",
+			"A.xyz.Foo",
+			"A.0x1",
+		} {
+			_, err := DecodeTypeID(typeID)
+			assert.Error(t, err, typeID)
+		}
+	})
+}
+
+func TestRestrictedTypeMemberDeclaredBy(t *testing.T) {
+
+	t.Parallel()
+
+	code := `
+      pub resource interface I1 {
+          pub fun foo()
+      }
+
+      pub resource interface I2 {
+          pub let bar: Int
+      }
+
+      pub resource R: I1, I2 {
+          pub let bar: Int
+          pub let baz: Int
+
+          init() {
+              self.bar = 1
+              self.baz = 2
+          }
+
+          pub fun foo() {}
+      }
+
+      pub let r: @R{I1, I2} <- create R()
+	`
+
+	program, err := parser.ParseProgram(code, nil)
+	require.NoError(t, err)
+
+	checker, err := NewChecker(
+		program,
+		common.StringLocation("test"),
+		nil,
+		false,
+	)
+	require.NoError(t, err)
+
+	err = checker.Check()
+	require.NoError(t, err)
+
+	variable := checker.valueActivations.Find("r")
+	require.NotNil(t, variable)
+
+	require.IsType(t, &RestrictedType{}, variable.Type)
+	restrictedType := variable.Type.(*RestrictedType)
+
+	i1 := checker.typeActivations.Find("I1").Type
+	i2 := checker.typeActivations.Find("I2").Type
+
+	members := restrictedType.GetMembers()
+
+	assert.Equal(t, i1, members["foo"].DeclaredBy())
+	assert.Equal(t, i2, members["bar"].DeclaredBy())
+
+	// Members of the restricted type are not granted by any restriction
+
+	require.Contains(t, members, "baz")
+	assert.Nil(t, members["baz"].DeclaredBy())
+}
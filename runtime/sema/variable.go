@@ -43,4 +43,7 @@ type Variable struct {
 	Pos *ast.Position
 	// DocString is the optional docstring
 	DocString string
+	// referencedResourceVariables are the resource variables
+	// which are referenced by the value of the variable, if it has a reference type
+	referencedResourceVariables []*Variable
 }
@@ -0,0 +1,122 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+)
+
+// Warning is a soft issue found by the checker.
+// Unlike errors, warnings do not cause checking to fail.
+//
+type Warning interface {
+	error
+	ast.HasPosition
+	isWarning()
+}
+
+// UnusedResultWarning
+
+type UnusedResultWarning struct {
+	Type Type
+	ast.Range
+}
+
+var _ Warning = &UnusedResultWarning{}
+
+func (*UnusedResultWarning) isWarning() {}
+
+func (e *UnusedResultWarning) Error() string {
+	return fmt.Sprintf(
+		"unused result of type `%s`",
+		e.Type.QualifiedString(),
+	)
+}
+
+// DeprecatedMemberWarning
+
+type DeprecatedMemberWarning struct {
+	Name             string
+	DeclarationKind  common.DeclarationKind
+	DeprecationNotes string
+	ast.Range
+}
+
+var _ Warning = &DeprecatedMemberWarning{}
+
+func (*DeprecatedMemberWarning) isWarning() {}
+
+func (e *DeprecatedMemberWarning) Error() string {
+	message := fmt.Sprintf(
+		"%s `%s` is deprecated",
+		e.DeclarationKind.Name(),
+		e.Name,
+	)
+
+	if e.DeprecationNotes == "" {
+		return message
+	}
+
+	return fmt.Sprintf("%s: %s", message, e.DeprecationNotes)
+}
+
+// AlwaysSucceedingCastWarning
+
+type AlwaysSucceedingCastWarning struct {
+	Operation  ast.Operation
+	ValueType  Type
+	TargetType Type
+	ast.Range
+}
+
+var _ Warning = &AlwaysSucceedingCastWarning{}
+
+func (*AlwaysSucceedingCastWarning) isWarning() {}
+
+func (e *AlwaysSucceedingCastWarning) Error() string {
+	return fmt.Sprintf(
+		"cast of value of type `%s` to type `%s` using `%s` always succeeds, use `as` instead",
+		e.ValueType.QualifiedString(),
+		e.TargetType.QualifiedString(),
+		e.Operation.Symbol(),
+	)
+}
+
+const deprecationNoticePrefix = "Deprecated:"
+
+// deprecationNotes returns the deprecation notes of the given documentation string,
+// i.e. the text following a line starting with `Deprecated:`, if any.
+// The second result is false if the documentation string does not contain a deprecation notice.
+//
+func deprecationNotes(docString string) (string, bool) {
+	for _, line := range strings.Split(docString, "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, deprecationNoticePrefix) {
+			continue
+		}
+
+		return strings.TrimSpace(line[len(deprecationNoticePrefix):]), true
+	}
+
+	return "", false
+}
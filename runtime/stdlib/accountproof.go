@@ -0,0 +1,161 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stdlib
+
+import (
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+var accountProofContractType = func() *sema.CompositeType {
+	ty := &sema.CompositeType{
+		Identifier: "AccountProof",
+		Kind:       common.CompositeKindContract,
+	}
+
+	ty.Members = sema.GetMembersAsMap([]*sema.Member{
+		sema.NewUnmeteredPublicFunctionMember(
+			ty,
+			accountProofVerifyFunctionName,
+			accountProofVerifyFunctionType,
+			accountProofVerifyFunctionDocString,
+		),
+	})
+	return ty
+}()
+
+var accountProofContractTypeID = accountProofContractType.ID()
+var accountProofContractStaticType interpreter.StaticType = interpreter.CompositeStaticType{
+	QualifiedIdentifier: accountProofContractType.Identifier,
+	TypeID:              accountProofContractTypeID,
+}
+
+const accountProofVerifyFunctionDocString = `
+Verifies that the given message was signed by the account at the given address.
+
+Each signature is verified against the account key with the corresponding key index,
+using the user domain separation tag and the key's hash algorithm.
+
+The function returns true if all signatures are valid,
+and the weights of the keys add up to at least the full weight of 1000.
+It returns false if the number of signatures and key indices differ,
+if a key index is used more than once, if a key does not exist or is revoked,
+or if a key's signature algorithm is not an ECDSA algorithm.
+`
+
+const accountProofVerifyFunctionName = "verify"
+
+var accountProofVerifyFunctionType = &sema.FunctionType{
+	Purity: sema.FunctionPurityView,
+	Parameters: []*sema.Parameter{
+		{
+			Label:      sema.ArgumentLabelNotRequired,
+			Identifier: "address",
+			TypeAnnotation: sema.NewTypeAnnotation(
+				&sema.AddressType{},
+			),
+		},
+		{
+			Label:      sema.ArgumentLabelNotRequired,
+			Identifier: "message",
+			TypeAnnotation: sema.NewTypeAnnotation(
+				sema.ByteArrayType,
+			),
+		},
+		{
+			Label:      sema.ArgumentLabelNotRequired,
+			Identifier: "signatures",
+			TypeAnnotation: sema.NewTypeAnnotation(
+				sema.ByteArrayArrayType,
+			),
+		},
+		{
+			Label:      sema.ArgumentLabelNotRequired,
+			Identifier: "keyIndices",
+			TypeAnnotation: sema.NewTypeAnnotation(
+				&sema.VariableSizedType{
+					Type: sema.IntType,
+				},
+			),
+		},
+	},
+	ReturnTypeAnnotation: sema.NewTypeAnnotation(
+		sema.BoolType,
+	),
+}
+
+var accountProofVerifyFunction = interpreter.NewUnmeteredHostFunctionValue(
+	func(invocation interpreter.Invocation) interpreter.Value {
+		address, ok := invocation.Arguments[0].(interpreter.AddressValue)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		message, ok := invocation.Arguments[1].(*interpreter.ArrayValue)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		signatures, ok := invocation.Arguments[2].(*interpreter.ArrayValue)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		keyIndices, ok := invocation.Arguments[3].(*interpreter.ArrayValue)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		inter := invocation.Interpreter
+
+		return inter.AccountProofHandler(
+			inter,
+			invocation.GetLocationRange,
+			address,
+			message,
+			signatures,
+			keyIndices,
+		)
+	},
+	accountProofVerifyFunctionType,
+)
+
+var accountProofContractFields = map[string]interpreter.Value{
+	accountProofVerifyFunctionName: accountProofVerifyFunction,
+}
+
+var accountProofContract = StandardLibraryValue{
+	Name: "AccountProof",
+	Type: accountProofContractType,
+	ValueFactory: func(inter *interpreter.Interpreter) interpreter.Value {
+		return interpreter.NewSimpleCompositeValue(
+			inter,
+			accountProofContractTypeID,
+			accountProofContractStaticType,
+			nil,
+			accountProofContractFields,
+			nil,
+			nil,
+			nil,
+		)
+	},
+	Kind: common.DeclarationKindContract,
+}
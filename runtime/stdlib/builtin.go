@@ -33,4 +33,5 @@ var BuiltinValues = StandardLibraryValues{
 	hashAlgorithmConstructor,
 	blsContract,
 	rlpContract,
+	accountProofContract,
 }
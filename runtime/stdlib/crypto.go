@@ -114,6 +114,7 @@ func cryptoAlgorithmEnumConstructorType(
 	}
 
 	constructorType := &sema.FunctionType{
+		Purity:        sema.FunctionPurityView,
 		IsConstructor: true,
 		Parameters: []*sema.Parameter{
 			{
@@ -129,6 +130,8 @@ func cryptoAlgorithmEnumConstructorType(
 		Members: sema.GetMembersAsMap(members),
 	}
 
+	sema.AddEnumConstructorMembers(nil, constructorType, enumType)
+
 	return constructorType
 }
 
@@ -20,6 +20,7 @@ package stdlib
 
 import (
 	"encoding/json"
+	"fmt"
 	"math/rand"
 	"strings"
 
@@ -54,6 +55,7 @@ Returns the public account for the given address
 `
 
 var getAccountFunctionType = &sema.FunctionType{
+	Purity: sema.FunctionPurityView,
 	Parameters: []*sema.Parameter{
 		{
 			Label:      sema.ArgumentLabelNotRequired,
@@ -69,6 +71,8 @@ var getAccountFunctionType = &sema.FunctionType{
 }
 
 var LogFunctionType = &sema.FunctionType{
+	Purity:  sema.FunctionPurityView,
+	Effects: sema.FunctionEffectLog,
 	Parameters: []*sema.Parameter{
 		{
 			Label:      sema.ArgumentLabelNotRequired,
@@ -88,6 +92,7 @@ Returns the current block, i.e. the block which contains the currently executed
 `
 
 var getCurrentBlockFunctionType = &sema.FunctionType{
+	Purity: sema.FunctionPurityView,
 	ReturnTypeAnnotation: sema.NewTypeAnnotation(
 		sema.BlockType,
 	),
@@ -98,6 +103,7 @@ Returns the block at the given height. If the given block does not exist the fun
 `
 
 var getBlockFunctionType = &sema.FunctionType{
+	Purity: sema.FunctionPurityView,
 	Parameters: []*sema.Parameter{
 		{
 			Label:      "at",
@@ -114,12 +120,38 @@ var getBlockFunctionType = &sema.FunctionType{
 	),
 }
 
+const getBlockHeaderFunctionDocString = `
+Returns the header of the block at the given height. If the given block does not exist the function returns nil.
+
+The header is returned as a block with a collection index of zero.
+Unlike getBlock, only the header of the block must be available
+`
+
+var getBlockHeaderFunctionType = &sema.FunctionType{
+	Purity: sema.FunctionPurityView,
+	Parameters: []*sema.Parameter{
+		{
+			Label:      "byHeight",
+			Identifier: "height",
+			TypeAnnotation: sema.NewTypeAnnotation(
+				sema.UInt64Type,
+			),
+		},
+	},
+	ReturnTypeAnnotation: sema.NewTypeAnnotation(
+		&sema.OptionalType{
+			Type: sema.BlockType,
+		},
+	),
+}
+
 const unsafeRandomFunctionDocString = `
 Returns a pseudo-random number.
 
 NOTE: The use of this function is unsafe if not used correctly.
 
-Follow best practices to prevent security issues when using this function
+Follow best practices to prevent security issues when using this function.
+Prefer revertibleRandom, which is backed by a secure source of randomness
 `
 
 var unsafeRandomFunctionType = &sema.FunctionType{
@@ -128,15 +160,66 @@ var unsafeRandomFunctionType = &sema.FunctionType{
 	),
 }
 
+const revertibleRandomFunctionDocString = `
+Returns a random number.
+
+The number is produced by the secure source of randomness of the environment.
+
+NOTE: The function is not a view function, so it cannot be called in view functions or conditions.
+The transaction calling it may still abort and revert after observing the result,
+so the result must not be used where reverting an unfavourable outcome benefits the caller
+`
+
+var revertibleRandomFunctionType = &sema.FunctionType{
+	ReturnTypeAnnotation: sema.NewTypeAnnotation(
+		sema.UInt64Type,
+	),
+}
+
+const revertibleRandomInRangeFunctionDocString = `
+Returns a random number which is greater than or equal to the given lower bound,
+and less than the given upper bound.
+
+The number is uniformly distributed and produced by the secure source of randomness of the environment.
+The program aborts if the lower bound is not less than the upper bound.
+
+NOTE: The function is not a view function, so it cannot be called in view functions or conditions.
+The transaction calling it may still abort and revert after observing the result,
+so the result must not be used where reverting an unfavourable outcome benefits the caller
+`
+
+var revertibleRandomInRangeFunctionType = &sema.FunctionType{
+	Parameters: []*sema.Parameter{
+		{
+			Identifier: "from",
+			TypeAnnotation: sema.NewTypeAnnotation(
+				sema.UInt64Type,
+			),
+		},
+		{
+			Identifier: "upTo",
+			TypeAnnotation: sema.NewTypeAnnotation(
+				sema.UInt64Type,
+			),
+		},
+	},
+	ReturnTypeAnnotation: sema.NewTypeAnnotation(
+		sema.UInt64Type,
+	),
+}
+
 // FlowBuiltinImpls defines the set of functions needed to implement the Flow
 // built-in functions.
 type FlowBuiltinImpls struct {
-	CreateAccount   interpreter.HostFunction
-	GetAccount      interpreter.HostFunction
-	Log             interpreter.HostFunction
-	GetCurrentBlock interpreter.HostFunction
-	GetBlock        interpreter.HostFunction
-	UnsafeRandom    interpreter.HostFunction
+	CreateAccount           interpreter.HostFunction
+	GetAccount              interpreter.HostFunction
+	Log                     interpreter.HostFunction
+	GetCurrentBlock         interpreter.HostFunction
+	GetBlock                interpreter.HostFunction
+	GetBlockHeader          interpreter.HostFunction
+	UnsafeRandom            interpreter.HostFunction
+	RevertibleRandom        interpreter.HostFunction
+	RevertibleRandomInRange interpreter.HostFunction
 }
 
 // FlowBuiltInFunctions returns a list of standard library functions, bound to
@@ -173,15 +256,113 @@ func FlowBuiltInFunctions(impls FlowBuiltinImpls) StandardLibraryFunctions {
 			getBlockFunctionDocString,
 			impls.GetBlock,
 		),
+		NewStandardLibraryFunction(
+			"getBlockHeader",
+			getBlockHeaderFunctionType,
+			getBlockHeaderFunctionDocString,
+			impls.GetBlockHeader,
+		),
 		NewStandardLibraryFunction(
 			"unsafeRandom",
 			unsafeRandomFunctionType,
 			unsafeRandomFunctionDocString,
 			impls.UnsafeRandom,
 		),
+		NewStandardLibraryFunction(
+			"revertibleRandom",
+			revertibleRandomFunctionType,
+			revertibleRandomFunctionDocString,
+			impls.RevertibleRandom,
+		),
+		NewStandardLibraryFunction(
+			"revertibleRandomInRange",
+			revertibleRandomInRangeFunctionType,
+			revertibleRandomInRangeFunctionDocString,
+			impls.RevertibleRandomInRange,
+		),
 	}
 }
 
+// NewRevertibleRandomFunction returns the implementation of revertibleRandom
+// for the given source of random numbers.
+//
+func NewRevertibleRandomFunction(random func() uint64) interpreter.HostFunction {
+	return func(invocation interpreter.Invocation) interpreter.Value {
+		return interpreter.NewUInt64Value(
+			invocation.Interpreter,
+			random,
+		)
+	}
+}
+
+// NewRevertibleRandomInRangeFunction returns the implementation of revertibleRandomInRange
+// for the given source of random numbers.
+//
+func NewRevertibleRandomInRangeFunction(random func() uint64) interpreter.HostFunction {
+	return func(invocation interpreter.Invocation) interpreter.Value {
+		from, ok := invocation.Arguments[0].(interpreter.UInt64Value)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		upTo, ok := invocation.Arguments[1].(interpreter.UInt64Value)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		if from >= upTo {
+			panic(InvalidRandomRangeError{
+				From:          uint64(from),
+				UpTo:          uint64(upTo),
+				LocationRange: invocation.GetLocationRange(),
+			})
+		}
+
+		return interpreter.NewUInt64Value(
+			invocation.Interpreter,
+			func() uint64 {
+				return uint64(from) + randomBelow(random, uint64(upTo-from))
+			},
+		)
+	}
+}
+
+// randomBelow returns a uniformly distributed random number in [0, n).
+//
+// Random numbers below 2^64 % n are rejected,
+// so that the remaining numbers are a multiple of n, and the modulo is not biased.
+//
+func randomBelow(random func() uint64, n uint64) uint64 {
+	threshold := -n % n
+	for {
+		r := random()
+		if r >= threshold {
+			return r % n
+		}
+	}
+}
+
+// InvalidRandomRangeError is reported when revertibleRandomInRange
+// is called with a lower bound which is not less than the upper bound.
+//
+type InvalidRandomRangeError struct {
+	From uint64
+	UpTo uint64
+	interpreter.LocationRange
+}
+
+var _ errors.UserError = InvalidRandomRangeError{}
+
+func (InvalidRandomRangeError) IsUserError() {}
+
+func (e InvalidRandomRangeError) Error() string {
+	return fmt.Sprintf(
+		"invalid random range: lower bound %d must be less than upper bound %d",
+		e.From,
+		e.UpTo,
+	)
+}
+
 func DefaultFlowBuiltinImpls() FlowBuiltinImpls {
 	return FlowBuiltinImpls{
 		CreateAccount: func(invocation interpreter.Invocation) interpreter.Value {
@@ -197,12 +378,17 @@ func DefaultFlowBuiltinImpls() FlowBuiltinImpls {
 		GetBlock: func(invocation interpreter.Invocation) interpreter.Value {
 			panic(errors.NewUnexpectedError("cannot get blocks"))
 		},
+		GetBlockHeader: func(invocation interpreter.Invocation) interpreter.Value {
+			panic(errors.NewUnexpectedError("cannot get block headers"))
+		},
 		UnsafeRandom: func(invocation interpreter.Invocation) interpreter.Value {
 			return interpreter.NewUInt64Value(
 				invocation.Interpreter,
 				rand.Uint64,
 			)
 		},
+		RevertibleRandom:        NewRevertibleRandomFunction(rand.Uint64),
+		RevertibleRandomInRange: NewRevertibleRandomInRangeFunction(rand.Uint64),
 	}
 }
 
@@ -437,4 +623,45 @@ var AccountContractRemovedEventType = newFlowEventType(
 	AccountEventContractParameter,
 )
 
+var AccountEventProviderParameter = &sema.Parameter{
+	Identifier:     "provider",
+	TypeAnnotation: sema.NewTypeAnnotation(&sema.AddressType{}),
+}
+
+var AccountEventRecipientParameter = &sema.Parameter{
+	Identifier:     "recipient",
+	TypeAnnotation: sema.NewTypeAnnotation(&sema.AddressType{}),
+}
+
+var AccountEventNameParameter = &sema.Parameter{
+	Identifier:     "name",
+	TypeAnnotation: sema.NewTypeAnnotation(sema.StringType),
+}
+
+var AccountEventTypeParameter = &sema.Parameter{
+	Identifier:     "type",
+	TypeAnnotation: sema.NewTypeAnnotation(sema.MetaType),
+}
+
+var AccountInboxPublishedEventType = newFlowEventType(
+	"InboxValuePublished",
+	AccountEventProviderParameter,
+	AccountEventRecipientParameter,
+	AccountEventNameParameter,
+	AccountEventTypeParameter,
+)
+
+var AccountInboxUnpublishedEventType = newFlowEventType(
+	"InboxValueUnpublished",
+	AccountEventProviderParameter,
+	AccountEventNameParameter,
+)
+
+var AccountInboxClaimedEventType = newFlowEventType(
+	"InboxValueClaimed",
+	AccountEventProviderParameter,
+	AccountEventRecipientParameter,
+	AccountEventNameParameter,
+)
+
 var FlowBuiltInTypes StandardLibraryTypes
@@ -117,3 +117,22 @@ func TestDecodeFlowLocationTypeID(t *testing.T) {
 		assert.Equal(t, "T.U", qualifiedIdentifier)
 	})
 }
+
+func TestRandomBelow(t *testing.T) {
+
+	t.Parallel()
+
+	// 2^64 % 10 = 6, so the random numbers 0 to 5 must be rejected
+
+	randoms := []uint64{0, 5, 6, 17}
+
+	random := func() uint64 {
+		r := randoms[0]
+		randoms = randoms[1:]
+		return r
+	}
+
+	assert.Equal(t, uint64(6), randomBelow(random, 10))
+	assert.Equal(t, uint64(7), randomBelow(random, 10))
+	assert.Empty(t, randoms)
+}
@@ -0,0 +1,172 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stdlib
+
+// BuiltinRegistry configures which standard library functions and values are available.
+//
+// Individual built-ins can be disabled, e.g. `log` and `unsafeRandom`,
+// and built-ins can be replaced or added by registering a function or value with the same name.
+//
+// The registry is applied to the built-ins before they are declared,
+// so the checker's predeclared values and the interpreter's activations always agree.
+//
+// A registry must be fully configured before it is used,
+// it is not safe to modify it concurrently.
+//
+type BuiltinRegistry struct {
+	disabled  map[string]struct{}
+	functions map[string]StandardLibraryFunction
+	values    map[string]StandardLibraryValue
+	// names of the registered functions and values, in registration order
+	added []string
+}
+
+func NewBuiltinRegistry() *BuiltinRegistry {
+	return &BuiltinRegistry{
+		disabled:  map[string]struct{}{},
+		functions: map[string]StandardLibraryFunction{},
+		values:    map[string]StandardLibraryValue{},
+	}
+}
+
+// Disable disables the built-ins with the given names.
+//
+func (r *BuiltinRegistry) Disable(names ...string) {
+	for _, name := range names {
+		r.disabled[name] = struct{}{}
+	}
+}
+
+// Enable re-enables the built-ins with the given names.
+//
+func (r *BuiltinRegistry) Enable(names ...string) {
+	for _, name := range names {
+		delete(r.disabled, name)
+	}
+}
+
+// IsEnabled returns true if the built-in with the given name is not disabled.
+//
+func (r *BuiltinRegistry) IsEnabled(name string) bool {
+	if r == nil {
+		return true
+	}
+	_, disabled := r.disabled[name]
+	return !disabled
+}
+
+// RegisterFunction registers the given function.
+// It replaces the built-in function or value with the same name, if any,
+// otherwise the function is added.
+//
+func (r *BuiltinRegistry) RegisterFunction(function StandardLibraryFunction) {
+	name := function.Name
+	r.register(name)
+	delete(r.values, name)
+	r.functions[name] = function
+}
+
+// RegisterValue registers the given value.
+// It replaces the built-in function or value with the same name, if any,
+// otherwise the value is added.
+//
+func (r *BuiltinRegistry) RegisterValue(value StandardLibraryValue) {
+	name := value.Name
+	r.register(name)
+	delete(r.functions, name)
+	r.values[name] = value
+}
+
+// register records the given name in registration order,
+// unless a function or value with the name was already registered.
+//
+func (r *BuiltinRegistry) register(name string) {
+	if r.isRegistered(name) {
+		return
+	}
+	r.added = append(r.added, name)
+}
+
+func (r *BuiltinRegistry) isRegistered(name string) bool {
+	_, ok := r.functions[name]
+	if !ok {
+		_, ok = r.values[name]
+	}
+	return ok
+}
+
+// Functions returns the given built-in functions,
+// without the disabled or replaced ones,
+// followed by the enabled registered functions.
+//
+func (r *BuiltinRegistry) Functions(builtins StandardLibraryFunctions) StandardLibraryFunctions {
+	if r == nil {
+		return builtins
+	}
+
+	result := make(StandardLibraryFunctions, 0, len(builtins)+len(r.functions))
+
+	for _, function := range builtins {
+		name := function.Name
+		if !r.IsEnabled(name) || r.isRegistered(name) {
+			continue
+		}
+		result = append(result, function)
+	}
+
+	for _, name := range r.added {
+		function, ok := r.functions[name]
+		if !ok || !r.IsEnabled(name) {
+			continue
+		}
+		result = append(result, function)
+	}
+
+	return result
+}
+
+// Values returns the given built-in values,
+// without the disabled or replaced ones,
+// followed by the enabled registered values.
+//
+func (r *BuiltinRegistry) Values(builtins StandardLibraryValues) StandardLibraryValues {
+	if r == nil {
+		return builtins
+	}
+
+	result := make(StandardLibraryValues, 0, len(builtins)+len(r.values))
+
+	for _, value := range builtins {
+		name := value.Name
+		if !r.IsEnabled(name) || r.isRegistered(name) {
+			continue
+		}
+		result = append(result, value)
+	}
+
+	for _, name := range r.added {
+		value, ok := r.values[name]
+		if !ok || !r.IsEnabled(name) {
+			continue
+		}
+		result = append(result, value)
+	}
+
+	return result
+}
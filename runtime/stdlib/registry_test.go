@@ -0,0 +1,148 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stdlib
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func TestBuiltinRegistry(t *testing.T) {
+
+	t.Parallel()
+
+	newFunction := func(name string) StandardLibraryFunction {
+		return NewStandardLibraryFunction(
+			name,
+			&sema.FunctionType{
+				ReturnTypeAnnotation: sema.NewTypeAnnotation(sema.VoidType),
+			},
+			"",
+			func(_ interpreter.Invocation) interpreter.Value {
+				return interpreter.VoidValue{}
+			},
+		)
+	}
+
+	newValue := func(name string) StandardLibraryValue {
+		return StandardLibraryValue{
+			Name: name,
+			Type: sema.IntType,
+			Kind: common.DeclarationKindConstant,
+		}
+	}
+
+	functionNames := func(functions StandardLibraryFunctions) []string {
+		names := make([]string, 0, len(functions))
+		for _, function := range functions {
+			names = append(names, function.Name)
+		}
+		return names
+	}
+
+	valueNames := func(values StandardLibraryValues) []string {
+		names := make([]string, 0, len(values))
+		for _, value := range values {
+			names = append(names, value.Name)
+		}
+		return names
+	}
+
+	functions := StandardLibraryFunctions{
+		newFunction("a"),
+		newFunction("b"),
+		newFunction("c"),
+	}
+
+	values := StandardLibraryValues{
+		newValue("x"),
+		newValue("y"),
+	}
+
+	t.Run("nil", func(t *testing.T) {
+
+		t.Parallel()
+
+		var registry *BuiltinRegistry
+
+		assert.True(t, registry.IsEnabled("a"))
+		assert.Equal(t, functions, registry.Functions(functions))
+		assert.Equal(t, values, registry.Values(values))
+	})
+
+	t.Run("disable", func(t *testing.T) {
+
+		t.Parallel()
+
+		registry := NewBuiltinRegistry()
+		registry.Disable("b", "x")
+
+		assert.False(t, registry.IsEnabled("b"))
+		assert.Equal(t, []string{"a", "c"}, functionNames(registry.Functions(functions)))
+		assert.Equal(t, []string{"y"}, valueNames(registry.Values(values)))
+
+		registry.Enable("b")
+
+		assert.True(t, registry.IsEnabled("b"))
+		assert.Equal(t, []string{"a", "b", "c"}, functionNames(registry.Functions(functions)))
+	})
+
+	t.Run("register", func(t *testing.T) {
+
+		t.Parallel()
+
+		registry := NewBuiltinRegistry()
+
+		// replace a function
+		replacement := newFunction("b")
+		replacement.DocString = "replacement"
+		registry.RegisterFunction(replacement)
+
+		// add a function
+		registry.RegisterFunction(newFunction("d"))
+
+		// replace a value with a function
+		registry.RegisterFunction(newFunction("x"))
+
+		// replace a function with a value
+		registry.RegisterValue(newValue("c"))
+
+		result := registry.Functions(functions)
+		assert.Equal(t, []string{"a", "b", "d", "x"}, functionNames(result))
+		assert.Equal(t, "replacement", result[1].DocString)
+
+		assert.Equal(t, []string{"y", "c"}, valueNames(registry.Values(values)))
+	})
+
+	t.Run("register disabled", func(t *testing.T) {
+
+		t.Parallel()
+
+		registry := NewBuiltinRegistry()
+		registry.RegisterFunction(newFunction("d"))
+		registry.Disable("d")
+
+		assert.Equal(t, []string{"a", "b", "c"}, functionNames(registry.Functions(functions)))
+	})
+}
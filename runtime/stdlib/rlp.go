@@ -69,6 +69,7 @@ If any error is encountered while decoding, the program aborts.
 const rlpDecodeStringFunctionName = "decodeString"
 
 var rlpDecodeStringFunctionType = &sema.FunctionType{
+	Purity: sema.FunctionPurityView,
 	Parameters: []*sema.Parameter{
 		{
 			Label:      sema.ArgumentLabelNotRequired,
@@ -143,6 +144,7 @@ If any error is encountered while decoding, the program aborts.
 const rlpDecodeListFunctionName = "decodeList"
 
 var rlpDecodeListFunctionType = &sema.FunctionType{
+	Purity: sema.FunctionPurityView,
 	Parameters: []*sema.Parameter{
 		{
 			Label:      sema.ArgumentLabelNotRequired,
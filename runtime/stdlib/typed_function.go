@@ -0,0 +1,406 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stdlib
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+var invocationGoType = reflect.TypeOf(interpreter.Invocation{})
+var errorGoType = reflect.TypeOf((*error)(nil)).Elem()
+var valueGoType = reflect.TypeOf((*interpreter.Value)(nil)).Elem()
+var bigIntGoType = reflect.TypeOf((*big.Int)(nil))
+var addressGoType = reflect.TypeOf(common.Address{})
+
+// NewTypedStandardLibraryFunction returns a standard library function
+// for the given Go function.
+//
+// The function type is derived from the Go function's signature,
+// arguments are converted from interpreter values to Go values,
+// and the result is converted from a Go value to an interpreter value.
+//
+// The supported Go types and their Cadence equivalents are:
+//
+//   - bool: Bool
+//   - string: String
+//   - *big.Int: Int
+//   - int8, int16, int32, int64: Int8, Int16, Int32, Int64
+//   - uint8, uint16, uint32, uint64: UInt8, UInt16, UInt32, UInt64
+//   - common.Address: Address
+//   - interpreter.Value: AnyStruct
+//   - []T: [T], for any supported T
+//
+// If the first parameter has the type interpreter.Invocation,
+// the invocation is passed to the function and the parameter is not part of the function type.
+//
+// The function may have no result, a single result, or a result followed by an error.
+// A non-nil error aborts the program with a user error.
+//
+// The parameter names are used as the parameters' identifiers,
+// and their number must match the number of parameters.
+//
+func NewTypedStandardLibraryFunction(
+	name string,
+	docString string,
+	parameterNames []string,
+	function any,
+) (
+	StandardLibraryFunction,
+	error,
+) {
+	functionValue := reflect.ValueOf(function)
+	functionGoType := functionValue.Type()
+
+	if functionGoType.Kind() != reflect.Func {
+		return StandardLibraryFunction{},
+			fmt.Errorf("%s: expected Go function, got %s", name, functionGoType)
+	}
+
+	if functionGoType.IsVariadic() {
+		return StandardLibraryFunction{},
+			fmt.Errorf("%s: variadic Go functions are not supported", name)
+	}
+
+	// Parameters
+
+	parameterGoTypes := make([]reflect.Type, 0, functionGoType.NumIn())
+	for i := 0; i < functionGoType.NumIn(); i++ {
+		parameterGoTypes = append(parameterGoTypes, functionGoType.In(i))
+	}
+
+	passInvocation := len(parameterGoTypes) > 0 &&
+		parameterGoTypes[0] == invocationGoType
+	if passInvocation {
+		parameterGoTypes = parameterGoTypes[1:]
+	}
+
+	if len(parameterNames) != len(parameterGoTypes) {
+		return StandardLibraryFunction{},
+			fmt.Errorf(
+				"%s: expected %d parameter names, got %d",
+				name,
+				len(parameterGoTypes),
+				len(parameterNames),
+			)
+	}
+
+	parameters := make([]*sema.Parameter, len(parameterGoTypes))
+	parameterTypes := make([]sema.Type, len(parameterGoTypes))
+
+	for i, parameterGoType := range parameterGoTypes {
+		parameterType, err := semaTypeForGoType(parameterGoType)
+		if err != nil {
+			return StandardLibraryFunction{},
+				fmt.Errorf("%s: parameter %s: %w", name, parameterNames[i], err)
+		}
+
+		parameterTypes[i] = parameterType
+		parameters[i] = &sema.Parameter{
+			Label:          sema.ArgumentLabelNotRequired,
+			Identifier:     parameterNames[i],
+			TypeAnnotation: sema.NewTypeAnnotation(parameterType),
+		}
+	}
+
+	// Results
+
+	resultCount := functionGoType.NumOut()
+
+	returnsError := resultCount > 0 &&
+		functionGoType.Out(resultCount-1) == errorGoType
+	if returnsError {
+		resultCount--
+	}
+
+	var returnType sema.Type
+
+	switch resultCount {
+	case 0:
+		returnType = sema.VoidType
+
+	case 1:
+		var err error
+		returnType, err = semaTypeForGoType(functionGoType.Out(0))
+		if err != nil {
+			return StandardLibraryFunction{},
+				fmt.Errorf("%s: result: %w", name, err)
+		}
+
+	default:
+		return StandardLibraryFunction{},
+			fmt.Errorf("%s: expected at most one result, got %d", name, resultCount)
+	}
+
+	functionType := &sema.FunctionType{
+		Parameters:           parameters,
+		ReturnTypeAnnotation: sema.NewTypeAnnotation(returnType),
+	}
+
+	return NewStandardLibraryFunction(
+		name,
+		functionType,
+		docString,
+		func(invocation interpreter.Invocation) interpreter.Value {
+
+			if len(invocation.Arguments) != len(parameterTypes) {
+				panic(errors.NewUnexpectedError(
+					"%s: expected %d arguments, got %d",
+					name,
+					len(parameterTypes),
+					len(invocation.Arguments),
+				))
+			}
+
+			arguments := make([]reflect.Value, 0, functionGoType.NumIn())
+
+			if passInvocation {
+				arguments = append(arguments, reflect.ValueOf(invocation))
+			}
+
+			for i, argument := range invocation.Arguments {
+				arguments = append(
+					arguments,
+					goValueFromValue(argument, parameterGoTypes[i], parameterTypes[i]),
+				)
+			}
+
+			results := functionValue.Call(arguments)
+
+			if returnsError {
+				errorResult := results[len(results)-1]
+				if !errorResult.IsNil() {
+					panic(errors.DefaultUserError{
+						Err: errorResult.Interface().(error),
+					})
+				}
+			}
+
+			if resultCount == 0 {
+				return interpreter.NewVoidValue(invocation.Interpreter)
+			}
+
+			return valueFromGoValue(
+				invocation.Interpreter,
+				invocation.GetLocationRange,
+				results[0],
+				returnType,
+			)
+		},
+	), nil
+}
+
+func semaTypeForGoType(goType reflect.Type) (sema.Type, error) {
+	switch goType {
+	case bigIntGoType:
+		return sema.IntType, nil
+	case addressGoType:
+		return &sema.AddressType{}, nil
+	case valueGoType:
+		return sema.AnyStructType, nil
+	}
+
+	switch goType.Kind() {
+	case reflect.Bool:
+		return sema.BoolType, nil
+	case reflect.String:
+		return sema.StringType, nil
+	case reflect.Int8:
+		return sema.Int8Type, nil
+	case reflect.Int16:
+		return sema.Int16Type, nil
+	case reflect.Int32:
+		return sema.Int32Type, nil
+	case reflect.Int64:
+		return sema.Int64Type, nil
+	case reflect.Uint8:
+		return sema.UInt8Type, nil
+	case reflect.Uint16:
+		return sema.UInt16Type, nil
+	case reflect.Uint32:
+		return sema.UInt32Type, nil
+	case reflect.Uint64:
+		return sema.UInt64Type, nil
+	case reflect.Slice:
+		elementType, err := semaTypeForGoType(goType.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &sema.VariableSizedType{
+			Type: elementType,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported Go type %s", goType)
+}
+
+func goValueFromValue(value interpreter.Value, goType reflect.Type, semaType sema.Type) reflect.Value {
+	switch semaType {
+	case sema.AnyStructType:
+		goValue := reflect.New(goType).Elem()
+		goValue.Set(reflect.ValueOf(value))
+		return goValue
+
+	case sema.IntType:
+		return reflect.ValueOf(value.(interpreter.IntValue).BigInt)
+
+	case sema.BoolType:
+		return reflect.ValueOf(bool(value.(interpreter.BoolValue))).Convert(goType)
+
+	case sema.StringType:
+		return reflect.ValueOf(value.(*interpreter.StringValue).Str).Convert(goType)
+
+	case sema.Int8Type, sema.Int16Type, sema.Int32Type, sema.Int64Type,
+		sema.UInt8Type, sema.UInt16Type, sema.UInt32Type, sema.UInt64Type:
+
+		return reflect.ValueOf(value).Convert(goType)
+	}
+
+	switch semaType := semaType.(type) {
+	case *sema.AddressType:
+		return reflect.ValueOf(common.Address(value.(interpreter.AddressValue)))
+
+	case *sema.VariableSizedType:
+		array := value.(*interpreter.ArrayValue)
+		goValue := reflect.MakeSlice(goType, 0, array.Count())
+		elementGoType := goType.Elem()
+
+		array.Iterate(nil, func(element interpreter.Value) (resume bool) {
+			goValue = reflect.Append(
+				goValue,
+				goValueFromValue(element, elementGoType, semaType.Type),
+			)
+			return true
+		})
+
+		return goValue
+	}
+
+	panic(errors.NewUnreachableError())
+}
+
+func valueFromGoValue(
+	inter *interpreter.Interpreter,
+	getLocationRange func() interpreter.LocationRange,
+	goValue reflect.Value,
+	semaType sema.Type,
+) interpreter.Value {
+
+	switch semaType {
+	case sema.AnyStructType:
+		return goValue.Interface().(interpreter.Value)
+
+	case sema.IntType:
+		bigInt := goValue.Interface().(*big.Int)
+		return interpreter.NewIntValueFromBigInt(
+			inter,
+			common.NewBigIntMemoryUsage(common.BigIntByteLength(bigInt)),
+			func() *big.Int {
+				return bigInt
+			},
+		)
+
+	case sema.BoolType:
+		return interpreter.NewBoolValue(inter, goValue.Bool())
+
+	case sema.StringType:
+		str := goValue.String()
+		return interpreter.NewStringValue(
+			inter,
+			common.NewStringMemoryUsage(len(str)),
+			func() string {
+				return str
+			},
+		)
+
+	case sema.Int8Type:
+		return interpreter.NewInt8Value(inter, func() int8 {
+			return int8(goValue.Int())
+		})
+
+	case sema.Int16Type:
+		return interpreter.NewInt16Value(inter, func() int16 {
+			return int16(goValue.Int())
+		})
+
+	case sema.Int32Type:
+		return interpreter.NewInt32Value(inter, func() int32 {
+			return int32(goValue.Int())
+		})
+
+	case sema.Int64Type:
+		return interpreter.NewInt64Value(inter, func() int64 {
+			return goValue.Int()
+		})
+
+	case sema.UInt8Type:
+		return interpreter.NewUInt8Value(inter, func() uint8 {
+			return uint8(goValue.Uint())
+		})
+
+	case sema.UInt16Type:
+		return interpreter.NewUInt16Value(inter, func() uint16 {
+			return uint16(goValue.Uint())
+		})
+
+	case sema.UInt32Type:
+		return interpreter.NewUInt32Value(inter, func() uint32 {
+			return uint32(goValue.Uint())
+		})
+
+	case sema.UInt64Type:
+		return interpreter.NewUInt64Value(inter, func() uint64 {
+			return goValue.Uint()
+		})
+	}
+
+	switch semaType := semaType.(type) {
+	case *sema.AddressType:
+		return interpreter.NewAddressValue(
+			inter,
+			goValue.Convert(addressGoType).Interface().(common.Address),
+		)
+
+	case *sema.VariableSizedType:
+		count := goValue.Len()
+		values := make([]interpreter.Value, count)
+		for i := 0; i < count; i++ {
+			values[i] = valueFromGoValue(inter, getLocationRange, goValue.Index(i), semaType.Type)
+		}
+
+		return interpreter.NewArrayValue(
+			inter,
+			getLocationRange,
+			interpreter.NewVariableSizedStaticType(
+				inter,
+				interpreter.ConvertSemaToStaticType(inter, semaType.Type),
+			),
+			common.Address{},
+			values...,
+		)
+	}
+
+	panic(errors.NewUnreachableError())
+}
@@ -0,0 +1,241 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stdlib
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+	runtimeErrors "github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/parser"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func newTypedFunctionTestInterpreter(
+	t *testing.T,
+	code string,
+	function StandardLibraryFunction,
+) *interpreter.Interpreter {
+
+	functions := StandardLibraryFunctions{function}
+
+	program, err := parser.ParseProgram(code, nil)
+	require.NoError(t, err)
+
+	checker, err := sema.NewChecker(
+		program,
+		utils.TestLocation,
+		nil,
+		false,
+		sema.WithPredeclaredValues(functions.ToSemaValueDeclarations()),
+	)
+	require.NoError(t, err)
+
+	err = checker.Check()
+	require.NoError(t, err)
+
+	inter, err := interpreter.NewInterpreter(
+		interpreter.ProgramFromChecker(checker),
+		checker.Location,
+		interpreter.WithStorage(newUnmeteredInMemoryStorage()),
+		interpreter.WithPredeclaredValues(functions.ToInterpreterValueDeclarations()),
+	)
+	require.NoError(t, err)
+
+	err = inter.Interpret()
+	require.NoError(t, err)
+
+	return inter
+}
+
+func TestNewTypedStandardLibraryFunction(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("function type", func(t *testing.T) {
+
+		t.Parallel()
+
+		function, err := NewTypedStandardLibraryFunction(
+			"test",
+			"",
+			[]string{"a", "b", "c", "d"},
+			func(
+				_ interpreter.Invocation,
+				_ bool,
+				_ []string,
+				_ *big.Int,
+				_ common.Address,
+			) (uint8, error) {
+				return 0, nil
+			},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			"((_ a: Bool, _ b: [String], _ c: Int, _ d: Address): UInt8)",
+			function.Type.QualifiedString(),
+		)
+		assert.Equal(t,
+			[]string{
+				sema.ArgumentLabelNotRequired,
+				sema.ArgumentLabelNotRequired,
+				sema.ArgumentLabelNotRequired,
+				sema.ArgumentLabelNotRequired,
+			},
+			function.ArgumentLabels,
+		)
+	})
+
+	t.Run("invocation", func(t *testing.T) {
+
+		t.Parallel()
+
+		function, err := NewTypedStandardLibraryFunction(
+			"join",
+			"",
+			[]string{"strings", "count"},
+			func(strings []string, count int64) []string {
+				joined := make([]string, 0, count)
+				for i := int64(0); i < count; i++ {
+					joined = append(joined, strings...)
+				}
+				return joined
+			},
+		)
+		require.NoError(t, err)
+
+		inter := newTypedFunctionTestInterpreter(t,
+			`
+              pub fun test(): [String] {
+                  return join(["a", "b"], 2)
+              }
+            `,
+			function,
+		)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		utils.RequireValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.ReturnEmptyLocationRange,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeString,
+				},
+				common.Address{},
+				interpreter.NewUnmeteredStringValue("a"),
+				interpreter.NewUnmeteredStringValue("b"),
+				interpreter.NewUnmeteredStringValue("a"),
+				interpreter.NewUnmeteredStringValue("b"),
+			),
+			result,
+		)
+	})
+
+	t.Run("error", func(t *testing.T) {
+
+		t.Parallel()
+
+		function, err := NewTypedStandardLibraryFunction(
+			"fail",
+			"",
+			[]string{"value"},
+			func(value interpreter.Value) error {
+				return errors.New(value.String())
+			},
+		)
+		require.NoError(t, err)
+
+		inter := newTypedFunctionTestInterpreter(t,
+			`
+              pub fun test() {
+                  fail(42)
+              }
+            `,
+			function,
+		)
+
+		_, err = inter.Invoke("test")
+		require.Error(t, err)
+
+		var userError runtimeErrors.DefaultUserError
+		require.ErrorAs(t, err, &userError)
+		assert.EqualError(t, userError, "42")
+	})
+
+	t.Run("parameter name count mismatch", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := NewTypedStandardLibraryFunction(
+			"test",
+			"",
+			[]string{"a"},
+			func(a, b bool) {},
+		)
+		require.EqualError(t, err, "test: expected 2 parameter names, got 1")
+	})
+
+	t.Run("unsupported parameter type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := NewTypedStandardLibraryFunction(
+			"test",
+			"",
+			[]string{"a"},
+			func(a float64) {},
+		)
+		require.EqualError(t, err, "test: parameter a: unsupported Go type float64")
+	})
+
+	t.Run("too many results", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := NewTypedStandardLibraryFunction(
+			"test",
+			"",
+			nil,
+			func() (bool, bool) {
+				return false, false
+			},
+		)
+		require.EqualError(t, err, "test: expected at most one result, got 2")
+	})
+
+	t.Run("not a function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := NewTypedStandardLibraryFunction("test", "", nil, 1)
+		require.EqualError(t, err, "test: expected Go function, got int")
+	})
+}
@@ -32,6 +32,21 @@ import (
 
 const StorageDomainContract = "contract"
 
+const StorageDomainInbox = "inbox"
+
+// BatchLedger is a ledger which supports reading
+// multiple values owned by the same account at once.
+//
+// A runtime interface may optionally implement it
+//
+type BatchLedger interface {
+	atree.Ledger
+	// GetValues gets the values for the given keys in the storage, owned by the given account.
+	// The values are returned in the order of the keys,
+	// and are empty if no value exists for the key
+	GetValues(owner []byte, keys [][]byte) (values [][]byte, err error)
+}
+
 type Storage struct {
 	*atree.PersistentSlabStorage
 	writes          map[interpreter.StorageKey]atree.StorageIndex
@@ -39,10 +54,19 @@ type Storage struct {
 	contractUpdates map[interpreter.StorageKey]*interpreter.CompositeValue
 	Ledger          atree.Ledger
 	memoryGauge     common.MemoryGauge
+	effectTracker   *interpreter.EffectTracker
+	// domainRegisters are the domain registers which were read in a batched read,
+	// but which storage maps are not loaded yet
+	domainRegisters map[interpreter.StorageKey][]byte
+	// hashedContractKeysEnabled configures if contract values are stored
+	// under the hash of the contract's type ID, instead of the contract's name
+	hashedContractKeysEnabled bool
 }
 
 var _ atree.SlabStorage = &Storage{}
 var _ interpreter.Storage = &Storage{}
+var _ interpreter.EffectTrackingStorage = &Storage{}
+var _ interpreter.StorageMapPreloadingStorage = &Storage{}
 
 func NewStorage(ledger atree.Ledger, memoryGauge common.MemoryGauge) *Storage {
 	decodeStorable := func(
@@ -52,7 +76,7 @@ func NewStorage(ledger atree.Ledger, memoryGauge common.MemoryGauge) *Storage {
 		atree.Storable,
 		error,
 	) {
-		return interpreter.DecodeStorable(
+		return interpreter.DecodeStorableLazily(
 			decoder,
 			slabStorageID,
 			memoryGauge,
@@ -77,7 +101,9 @@ func NewStorage(ledger atree.Ledger, memoryGauge common.MemoryGauge) *Storage {
 		writes:                map[interpreter.StorageKey]atree.StorageIndex{},
 		storageMaps:           map[interpreter.StorageKey]*interpreter.StorageMap{},
 		contractUpdates:       map[interpreter.StorageKey]*interpreter.CompositeValue{},
+		domainRegisters:       map[interpreter.StorageKey][]byte{},
 		memoryGauge:           memoryGauge,
+		effectTracker:         &interpreter.EffectTracker{},
 	}
 }
 
@@ -97,14 +123,7 @@ func (s *Storage) GetStorageMap(
 
 		// Load data through the runtime interface
 
-		var data []byte
-		var err error
-		wrapPanic(func() {
-			data, err = s.Ledger.GetValue(key.Address[:], []byte(key.Key))
-		})
-		if err != nil {
-			panic(err)
-		}
+		data := s.readDomainRegister(key)
 
 		dataLength := len(data)
 		isStorageIndex := dataLength == storageIndexLength
@@ -136,6 +155,85 @@ func (s *Storage) GetStorageMap(
 	return storageMap
 }
 
+// PreloadStorageMaps reads the registers of the storage maps of the given domains of the account,
+// which are not loaded yet, in a single batched read.
+//
+// If the ledger does not support batched reads,
+// the registers are instead read when the storage maps are loaded
+//
+func (s *Storage) PreloadStorageMaps(address common.Address, domains []string) {
+
+	batchLedger, ok := s.Ledger.(BatchLedger)
+	if !ok {
+		return
+	}
+
+	keys := make([]interpreter.StorageKey, 0, len(domains))
+	for _, domain := range domains {
+		key := interpreter.NewStorageKey(s.memoryGauge, address, domain)
+		if s.storageMaps[key] != nil {
+			continue
+		}
+		if _, ok := s.domainRegisters[key]; ok {
+			continue
+		}
+		keys = append(keys, key)
+	}
+
+	if len(keys) == 0 {
+		return
+	}
+
+	registerKeys := make([][]byte, len(keys))
+	for i, key := range keys {
+		registerKeys[i] = []byte(key.Key)
+	}
+
+	var values [][]byte
+	var err error
+	wrapPanic(func() {
+		values, err = batchLedger.GetValues(address[:], registerKeys)
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	if len(values) != len(keys) {
+		panic(errors.NewUnexpectedError(
+			"invalid batched register read: expected %d values, got %d",
+			len(keys), len(values),
+		))
+	}
+
+	for i, key := range keys {
+		s.domainRegisters[key] = values[i]
+	}
+}
+
+// readDomainRegister reads the register of the given storage domain.
+//
+// If the register was already read in a batched read,
+// the value of the batched read is used
+//
+func (s *Storage) readDomainRegister(key interpreter.StorageKey) []byte {
+
+	data, ok := s.domainRegisters[key]
+	if ok {
+		delete(s.domainRegisters, key)
+		return data
+	}
+
+	var err error
+	wrapPanic(func() {
+		data, err = s.Ledger.GetValue(key.Address[:], []byte(key.Key))
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return data
+}
+
 func (s *Storage) loadExistingStorageMap(address atree.Address, storageIndex atree.StorageIndex) *interpreter.StorageMap {
 
 	storageID := atree.StorageID{
@@ -155,6 +253,9 @@ func (s *Storage) storeNewStorageMap(address atree.Address, domain string) *inte
 
 	s.writes[storageKey] = storageIndex
 
+	// The write of the new storage map is committed even if the current recoverable call fails
+	s.effectTracker.RecordEffect()
+
 	return storageMap
 }
 
@@ -169,6 +270,8 @@ func (s *Storage) recordContractUpdate(
 	// otherwise the removal write is lost
 
 	s.contractUpdates[key] = contractValue
+
+	s.effectTracker.RecordEffect()
 }
 
 type ContractUpdate struct {
@@ -234,14 +337,175 @@ func (s *Storage) writeContractUpdate(
 	contractValue *interpreter.CompositeValue,
 ) {
 	storageMap := s.GetStorageMap(key.Address, StorageDomainContract, true)
+
+	storageMapKey := key.Key
+	if s.hashedContractKeysEnabled {
+		hashedKey := contractStorageMapKey(key.Address, key.Key)
+		if hashedKey != key.Key {
+			storageMapKey = s.hashedContractStorageMapKey(inter, storageMap, key, hashedKey, contractValue)
+		}
+	}
+
 	// NOTE: pass nil instead of allocating a Value-typed  interface that points to nil
 	if contractValue == nil {
-		storageMap.WriteValue(inter, key.Key, nil)
+		storageMap.WriteValue(inter, storageMapKey, nil)
 	} else {
-		storageMap.WriteValue(inter, key.Key, contractValue)
+		storageMap.WriteValue(inter, storageMapKey, contractValue)
 	}
 }
 
+// hashedContractStorageMapKey returns the key under which the given contract update is written,
+// if hashed contract keys are enabled.
+//
+// The hashed key is not collision-resistant, so it might already store the value of another contract.
+// In that case, a contract which is still stored under its name stays stored under its name,
+// and storing any other contract is rejected.
+//
+func (s *Storage) hashedContractStorageMapKey(
+	inter *interpreter.Interpreter,
+	storageMap *interpreter.StorageMap,
+	key interpreter.StorageKey,
+	hashedKey string,
+	contractValue *interpreter.CompositeValue,
+) string {
+
+	existingValue := storageMap.ReadValue(inter, hashedKey)
+	if existingValue != nil &&
+		!isContractValue(existingValue, key.Address, key.Key) {
+
+		if contractValue == nil || storageMap.ValueExists(key.Key) {
+			return key.Key
+		}
+
+		panic(&ContractStorageKeyCollisionError{
+			Address:        key.Address,
+			Name:           key.Key,
+			ExistingTypeID: existingValue.(*interpreter.CompositeValue).TypeID(),
+		})
+	}
+
+	// Remove the value stored under the contract name, if any,
+	// as it is superseded by the value stored under the hashed key
+	storageMap.WriteValue(inter, key.Key, nil)
+
+	return hashedKey
+}
+
+// contractStorageMapKey returns the hashed key of the contract with the given name
+// in the contract storage domain of the given address.
+//
+// Names which are not longer than a hashed key are not hashed,
+// as hashing them would not shorten the key.
+//
+func contractStorageMapKey(address common.Address, name string) string {
+	if len(name) <= interpreter.HashedStorageKeyLength {
+		return name
+	}
+
+	return interpreter.HashedStorageKey(contractTypeID(address, name))
+}
+
+func contractTypeID(address common.Address, name string) common.TypeID {
+	location := common.AddressLocation{
+		Address: address,
+		Name:    name,
+	}
+	return location.TypeID(nil, name)
+}
+
+// isContractValue returns true if the given stored value
+// is the value of the contract with the given name, stored in the given address.
+//
+func isContractValue(value interpreter.Value, address common.Address, name string) bool {
+	compositeValue, ok := value.(*interpreter.CompositeValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	return compositeValue.TypeID() == contractTypeID(address, name)
+}
+
+// readContractValue returns the value of the contract with the given name,
+// stored in the given address. Returns nil if there is no such contract.
+//
+// If hashed contract keys are enabled, the value stored under the hashed key is preferred,
+// but the value stored under the contract name is still found if it was not migrated yet.
+// The value stored under the hashed key is only used if it is the value of the contract,
+// as the hashed key of another contract might collide with the contract's hashed key.
+//
+func (s *Storage) readContractValue(
+	inter *interpreter.Interpreter,
+	address common.Address,
+	name string,
+) interpreter.Value {
+	storageMap := s.GetStorageMap(address, StorageDomainContract, false)
+	if storageMap == nil {
+		return nil
+	}
+
+	if s.hashedContractKeysEnabled {
+		hashedKey := contractStorageMapKey(address, name)
+		if hashedKey != name {
+			value := storageMap.ReadValue(inter, hashedKey)
+			if value != nil && isContractValue(value, address, name) {
+				return value
+			}
+		}
+	}
+
+	return storageMap.ReadValue(inter, name)
+}
+
+// MigrateContractStorageKeys moves all contract values of the given address,
+// which are stored under the contract's name, to the hashed key of the contract's type ID.
+// Returns the number of migrated contract values.
+//
+// The migration is idempotent: contract values which are already stored
+// under the hashed key are left unchanged.
+//
+// Contract values with names which are not hashed, and contract values whose hashed key
+// already stores the value of another contract, are left stored under the contract's name.
+//
+func (s *Storage) MigrateContractStorageKeys(inter *interpreter.Interpreter, address common.Address) int {
+	storageMap := s.GetStorageMap(address, StorageDomainContract, false)
+	if storageMap == nil {
+		return 0
+	}
+
+	// NOTE: collect the names before moving the values,
+	// as the storage map must not be mutated while it is iterated
+
+	var names []string
+
+	iterator := storageMap.Iterator(inter)
+	for {
+		key := iterator.NextKey()
+		if key == "" {
+			break
+		}
+
+		if interpreter.IsHashedStorageKey(key) {
+			continue
+		}
+
+		names = append(names, key)
+	}
+
+	migrated := 0
+
+	for _, name := range names {
+		hashedKey := contractStorageMapKey(address, name)
+		if hashedKey == name || storageMap.ValueExists(hashedKey) {
+			continue
+		}
+
+		storageMap.MoveValue(inter, name, hashedKey)
+		migrated++
+	}
+
+	return migrated
+}
+
 type write struct {
 	storageKey   interpreter.StorageKey
 	storageIndex atree.StorageIndex
@@ -257,6 +521,12 @@ func sortWrites(writes []write) {
 
 // Commit serializes/saves all values in the readCache in storage (through the runtime interface).
 //
+// Writes are deferred until the commit and buffered per register,
+// so only the final value of each register is written.
+// The writes are performed in a deterministic order:
+// first the storage map registers, sorted by storage key,
+// then the slabs, sorted by storage ID.
+//
 func (s *Storage) Commit(inter *interpreter.Interpreter, commitContractUpdates bool) error {
 
 	if commitContractUpdates {
@@ -320,6 +590,31 @@ func (s *Storage) Commit(inter *interpreter.Interpreter, commitContractUpdates b
 	return s.PersistentSlabStorage.FastCommit(runtime.NumCPU())
 }
 
+func (s *Storage) EffectTracker() *interpreter.EffectTracker {
+	return s.effectTracker
+}
+
+func (s *Storage) GenerateStorageID(address atree.Address) (atree.StorageID, error) {
+	storageID, err := s.PersistentSlabStorage.GenerateStorageID(address)
+	if err != nil {
+		return atree.StorageID{}, err
+	}
+
+	s.effectTracker.RecordStorageIDGeneration(storageID)
+
+	return storageID, nil
+}
+
+func (s *Storage) Store(storageID atree.StorageID, slab atree.Slab) error {
+	s.effectTracker.RecordStorageWrite(storageID)
+	return s.PersistentSlabStorage.Store(storageID, slab)
+}
+
+func (s *Storage) Remove(storageID atree.StorageID) error {
+	s.effectTracker.RecordStorageWrite(storageID)
+	return s.PersistentSlabStorage.Remove(storageID)
+}
+
 func (s *Storage) CheckHealth() error {
 	// Check slab storage health
 	rootSlabIDs, err := atree.CheckStorageHealth(s, -1)
@@ -206,6 +206,165 @@ func TestRuntimeStorageWrite(t *testing.T) {
 	)
 }
 
+// testBatchRuntimeInterface is a test runtime interface which supports batched reads
+//
+type testBatchRuntimeInterface struct {
+	*testRuntimeInterface
+	getValues func(owner []byte, keys [][]byte) (values [][]byte, err error)
+}
+
+var _ BatchLedger = &testBatchRuntimeInterface{}
+
+func (i *testBatchRuntimeInterface) GetValues(owner []byte, keys [][]byte) (values [][]byte, err error) {
+	return i.getValues(owner, keys)
+}
+
+func TestRuntimeStorageBatchedDomainReads(t *testing.T) {
+
+	t.Parallel()
+
+	address := common.MustBytesToAddress([]byte{0x1})
+
+	tx := []byte(`
+      transaction {
+          prepare(signer: AuthAccount) {
+              signer.save(1, to: /storage/one)
+              signer.capabilities.storage.issue<&Int>(/storage/one)
+              signer.capabilities.storage.issue<&Int>(/storage/one)
+          }
+       }
+    `)
+
+	type reads struct {
+		single  []string
+		batched [][]string
+	}
+
+	executeTransaction := func(t *testing.T, batched bool) reads {
+
+		runtime := newTestInterpreterRuntime()
+
+		var result reads
+		var inBatch bool
+
+		onRead := func(_, key, _ []byte) {
+			if !inBatch {
+				result.single = append(result.single, string(key))
+			}
+		}
+
+		ledger := newTestLedger(onRead, nil)
+
+		var runtimeInterface Interface = &testRuntimeInterface{
+			storage: ledger,
+			getSigningAccounts: func() ([]Address, error) {
+				return []Address{address}, nil
+			},
+		}
+
+		if batched {
+			runtimeInterface = &testBatchRuntimeInterface{
+				testRuntimeInterface: runtimeInterface.(*testRuntimeInterface),
+				getValues: func(owner []byte, keys [][]byte) ([][]byte, error) {
+					inBatch = true
+					defer func() {
+						inBatch = false
+					}()
+
+					batch := make([]string, 0, len(keys))
+					values := make([][]byte, 0, len(keys))
+					for _, key := range keys {
+						batch = append(batch, string(key))
+						value, err := ledger.GetValue(owner, key)
+						if err != nil {
+							return nil, err
+						}
+						values = append(values, value)
+					}
+					result.batched = append(result.batched, batch)
+					return values, nil
+				},
+			}
+		}
+
+		nextTransactionLocation := newTransactionLocationGenerator()
+
+		err := runtime.ExecuteTransaction(
+			Script{
+				Source: tx,
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  nextTransactionLocation(),
+			},
+		)
+		require.NoError(t, err)
+
+		return result
+	}
+
+	t.Run("batched reads supported", func(t *testing.T) {
+
+		t.Parallel()
+
+		result := executeTransaction(t, true)
+
+		// Only the registers of the domains used for issuing a capability are read in a batch,
+		// when the first capability is issued
+
+		domains := []string{
+			interpreter.CapabilityIDStorageDomain,
+			interpreter.CapabilityControllerStorageDomain,
+		}
+
+		assert.Equal(t, [][]string{domains}, result.batched)
+
+		// The preloaded domain registers are not read again individually
+
+		for _, domain := range domains {
+			assert.NotContains(t, result.single, domain)
+		}
+
+		// Registers of other domains are not read in a batch
+
+		assert.Contains(t, result.single, "storage")
+	})
+
+	t.Run("batched reads not supported", func(t *testing.T) {
+
+		t.Parallel()
+
+		result := executeTransaction(t, false)
+
+		assert.Empty(t, result.batched)
+
+		assert.Contains(t, result.single, interpreter.CapabilityIDStorageDomain)
+		assert.Contains(t, result.single, interpreter.CapabilityControllerStorageDomain)
+	})
+
+	t.Run("same registers read", func(t *testing.T) {
+
+		t.Parallel()
+
+		unbatchedResult := executeTransaction(t, false)
+		batchedResult := executeTransaction(t, true)
+
+		readRegisters := func(result reads) []string {
+			registers := append([]string{}, result.single...)
+			for _, batch := range result.batched {
+				registers = append(registers, batch...)
+			}
+			sort.Strings(registers)
+			return registers
+		}
+
+		assert.Equal(t,
+			readRegisters(unbatchedResult),
+			readRegisters(batchedResult),
+		)
+	})
+}
+
 func TestRuntimeAccountStorage(t *testing.T) {
 
 	t.Parallel()
@@ -2316,22 +2475,24 @@ func TestRuntimeReferenceOwnerAccess(t *testing.T) {
 
                   accountA.save(<-testResource, to: /storage/test)
 
-                  // At this point the resource is in storage A
-                  log(ref.owner?.address)
+                  // At this point the resource is in storage A.
+                  // The reference was invalidated when the resource was moved,
+                  // so borrow a new one
+                  let refA = accountA.borrow<&TestContract.TestResource>(from: /storage/test)!
+                  log(refA.owner?.address)
 
                   let testResource2 <- accountA.load<@TestContract.TestResource>(from: /storage/test)!
 
                   let ref2 = &testResource2 as &TestContract.TestResource
 
                    // At this point the resource is not in storage
-                  log(ref.owner?.address)
                   log(ref2.owner?.address)
 
                   accountB.save(<-testResource2, to: /storage/test)
 
                   // At this point the resource is in storage B
-                  log(ref.owner?.address)
-                  log(ref2.owner?.address)
+                  let refB = accountB.borrow<&TestContract.TestResource>(from: /storage/test)!
+                  log(refB.owner?.address)
               }
           }
         `
@@ -2428,8 +2589,6 @@ func TestRuntimeReferenceOwnerAccess(t *testing.T) {
 				"nil",
 				"0x0000000000000001",
 				"nil",
-				"nil",
-				"0x0000000000000002",
 				"0x0000000000000002",
 			},
 			loggedMessages,
@@ -2466,7 +2625,8 @@ func TestRuntimeReferenceOwnerAccess(t *testing.T) {
                   account.save(<-testResources, to: /storage/test)
 
                   // At this point the resource is in storage
-                  log(ref.owner?.address)
+                  let storedRef = account.borrow<&[TestContract.TestResource]>(from: /storage/test)!
+                  log(storedRef[0].owner?.address)
               }
           }
         `
@@ -2606,8 +2766,10 @@ func TestRuntimeReferenceOwnerAccess(t *testing.T) {
                   account.save(<-nestingResource, to: /storage/test)
 
                   // At this point the nesting and nested resources are both in storage
-                  log(nestingResourceRef.owner?.address)
-                  log(nestedElementResourceRef.owner?.address)
+                  let storedNestingResourceRef = account.borrow<&TestContract.TestNestingResource>(from: /storage/test)!
+                  let storedNestedElementResourceRef = &storedNestingResourceRef.nestedResources[0] as &TestContract.TestNestedResource
+                  log(storedNestingResourceRef.owner?.address)
+                  log(storedNestedElementResourceRef.owner?.address)
               }
           }
         `
@@ -2735,7 +2897,8 @@ func TestRuntimeReferenceOwnerAccess(t *testing.T) {
                   account.save(<-testResources, to: /storage/test)
 
                   // At this point the resource is in storage
-                  log(ref[0].owner?.address)
+                  let storedRef = account.borrow<&[[TestContract.TestResource]]>(from: /storage/test)!
+                  log(storedRef[0][0].owner?.address)
               }
           }
         `
@@ -2861,7 +3024,8 @@ func TestRuntimeReferenceOwnerAccess(t *testing.T) {
                   account.save(<-testResources, to: /storage/test)
 
                   // At this point the resource is in storage
-                  log(ref[0]?.owner?.address)
+                  let storedRef = account.borrow<&[{Int: TestContract.TestResource}]>(from: /storage/test)!
+                  log(storedRef[0][0]?.owner?.address)
               }
           }
         `
@@ -3179,6 +3343,163 @@ func TestRuntimeStorageEnumCase(t *testing.T) {
 	)
 }
 
+func TestRuntimeStorageGenericComposite(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := newTestInterpreterRuntime()
+
+	address := common.MustBytesToAddress([]byte{0x1})
+
+	accountCodes := map[common.Location][]byte{}
+	var loggedMessages []string
+
+	runtimeInterface := &testRuntimeInterface{
+		storage: newTestLedger(nil, nil),
+		getSigningAccounts: func() ([]Address, error) {
+			return []Address{address}, nil
+		},
+		resolveLocation: singleIdentifierLocationResolver(t),
+		updateAccountContractCode: func(address Address, name string, code []byte) error {
+			location := common.AddressLocation{
+				Address: address,
+				Name:    name,
+			}
+			accountCodes[location] = code
+			return nil
+		},
+		getAccountContractCode: func(address Address, name string) (code []byte, err error) {
+			location := common.AddressLocation{
+				Address: address,
+				Name:    name,
+			}
+			code = accountCodes[location]
+			return code, nil
+		},
+		emitEvent: func(event cadence.Event) error {
+			return nil
+		},
+		log: func(message string) {
+			loggedMessages = append(loggedMessages, message)
+		},
+	}
+
+	nextTransactionLocation := newTransactionLocationGenerator()
+
+	// Deploy contract
+
+	err := runtime.ExecuteTransaction(
+		Script{
+			Source: utils.DeploymentTransaction(
+				"C",
+				[]byte(`
+                  pub contract C {
+
+                    pub resource Vault<T: AnyStruct> {
+                        pub var value: T
+
+                        init(value: T) {
+                            self.value = value
+                        }
+
+                        pub fun set(_ value: AnyStruct) {
+                            self.value = value as! T
+                        }
+                    }
+
+                    pub fun createVault<T: AnyStruct>(value: T): @Vault<T> {
+                        return <- create Vault<T>(value: value)
+                    }
+                  }
+                `),
+			),
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	// Store the generic resource
+
+	err = runtime.ExecuteTransaction(
+		Script{
+			Source: []byte(`
+              import C from 0x1
+
+              transaction {
+                  prepare(signer: AuthAccount) {
+                      signer.save(<-C.createVault(value: 42), to: /storage/vault)
+                  }
+               }
+            `),
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	// Load the generic resource
+
+	err = runtime.ExecuteTransaction(
+		Script{
+			Source: []byte(`
+              import C from 0x1
+
+              transaction {
+                  prepare(signer: AuthAccount) {
+                      let vault <- signer.load<@C.Vault<Int>>(from: /storage/vault)!
+                      log(vault.getType().identifier)
+                      vault.set(43)
+                      log(vault.value)
+                      signer.save(<-vault, to: /storage/vault)
+                  }
+               }
+            `),
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	require.Equal(t,
+		[]string{
+			`"A.0000000000000001.C.Vault<Int>"`,
+			"43",
+		},
+		loggedMessages,
+	)
+
+	// The type arguments of the stored resource are enforced
+
+	err = runtime.ExecuteTransaction(
+		Script{
+			Source: []byte(`
+              import C from 0x1
+
+              transaction {
+                  prepare(signer: AuthAccount) {
+                      let vault = signer.borrow<&C.Vault<Int>>(from: /storage/vault)!
+                      vault.set("44")
+                  }
+               }
+            `),
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.Error(t, err)
+
+	require.ErrorAs(t, err, &interpreter.ForceCastTypeMismatchError{})
+}
+
 func TestStorageReadNoImplicitWrite(t *testing.T) {
 
 	t.Parallel()
@@ -3217,3 +3538,301 @@ func TestStorageReadNoImplicitWrite(t *testing.T) {
 	)
 	require.NoError(t, err)
 }
+
+type hashedContractStorageKeysTest struct {
+	ledger           testLedger
+	runtimeInterface *testRuntimeInterface
+	nextLocation     func() common.TransactionLocation
+}
+
+func newHashedContractStorageKeysTest(t *testing.T) *hashedContractStorageKeysTest {
+
+	address := common.MustBytesToAddress([]byte{0x1})
+
+	accountCodes := map[common.Location][]byte{}
+
+	ledger := newTestLedger(nil, nil)
+
+	runtimeInterface := &testRuntimeInterface{
+		storage: ledger,
+		getSigningAccounts: func() ([]Address, error) {
+			return []Address{address}, nil
+		},
+		resolveLocation: singleIdentifierLocationResolver(t),
+		updateAccountContractCode: func(address Address, name string, code []byte) error {
+			location := common.AddressLocation{
+				Address: address,
+				Name:    name,
+			}
+			accountCodes[location] = code
+			return nil
+		},
+		getAccountContractCode: func(address Address, name string) (code []byte, err error) {
+			location := common.AddressLocation{
+				Address: address,
+				Name:    name,
+			}
+			code = accountCodes[location]
+			return code, nil
+		},
+		emitEvent: func(event cadence.Event) error {
+			return nil
+		},
+	}
+
+	return &hashedContractStorageKeysTest{
+		ledger:           ledger,
+		runtimeInterface: runtimeInterface,
+		nextLocation:     newTransactionLocationGenerator(),
+	}
+}
+
+var hashedContractStorageKeysTestAddress = common.MustBytesToAddress([]byte{0x1})
+
+func (test *hashedContractStorageKeysTest) hashedKey(name string) string {
+	location := common.AddressLocation{
+		Address: hashedContractStorageKeysTestAddress,
+		Name:    name,
+	}
+	return interpreter.HashedStorageKey(location.TypeID(nil, name))
+}
+
+// NOTE: always create a new storage to get the latest view of the ledger
+
+func (test *hashedContractStorageKeysTest) contractKeyExists(key string) bool {
+	storageMap := NewStorage(test.ledger, nil).
+		GetStorageMap(hashedContractStorageKeysTestAddress, StorageDomainContract, false)
+	if storageMap == nil {
+		return false
+	}
+	return storageMap.ValueExists(key)
+}
+
+func (test *hashedContractStorageKeysTest) deploy(runtime Runtime, name string, answer int) error {
+	return runtime.ExecuteTransaction(
+		Script{
+			Source: utils.DeploymentTransaction(
+				name,
+				[]byte(fmt.Sprintf(
+					`
+                      pub contract %s {
+                          pub let answer: Int
+
+                          init() {
+                              self.answer = %d
+                          }
+                      }
+                    `,
+					name,
+					answer,
+				)),
+			),
+		},
+		Context{
+			Interface: test.runtimeInterface,
+			Location:  test.nextLocation(),
+		},
+	)
+}
+
+func (test *hashedContractStorageKeysTest) readAnswer(runtime Runtime, name string) (cadence.Value, error) {
+	return runtime.ExecuteScript(
+		Script{
+			Source: []byte(fmt.Sprintf(
+				`
+                  import %[1]s from 0x1
+
+                  pub fun main(): Int {
+                      return %[1]s.answer
+                  }
+                `,
+				name,
+			)),
+		},
+		Context{
+			Interface: test.runtimeInterface,
+			Location:  common.ScriptLocation{},
+		},
+	)
+}
+
+func (test *hashedContractStorageKeysTest) migrate(t *testing.T) int {
+	storage := NewStorage(test.ledger, nil)
+
+	inter, err := interpreter.NewInterpreter(
+		nil,
+		utils.TestLocation,
+		interpreter.WithStorage(storage),
+		interpreter.WithAtreeValueValidationEnabled(true),
+		interpreter.WithAtreeStorageValidationEnabled(true),
+	)
+	require.NoError(t, err)
+
+	migrated := storage.MigrateContractStorageKeys(inter, hashedContractStorageKeysTestAddress)
+
+	const commitContractUpdates = false
+	err = storage.Commit(inter, commitContractUpdates)
+	require.NoError(t, err)
+
+	return migrated
+}
+
+func TestRuntimeHashedContractStorageKeys(t *testing.T) {
+
+	t.Parallel()
+
+	legacyRuntime := newTestInterpreterRuntime()
+	hashedRuntime := newTestInterpreterRuntime(
+		WithHashedContractStorageKeysEnabled(true),
+	)
+
+	// NOTE: Contract6986 and Contract603330 in account 0x1 have the same hashed key
+
+	const collidingName1 = "Contract6986"
+	const collidingName2 = "Contract603330"
+
+	t.Run("migration", func(t *testing.T) {
+
+		t.Parallel()
+
+		test := newHashedContractStorageKeysTest(t)
+
+		// Deploy a contract without hashed keys:
+		// the contract value is stored under the contract name
+
+		const legacyName = "LegacyContract"
+
+		err := test.deploy(legacyRuntime, legacyName, 42)
+		require.NoError(t, err)
+
+		require.True(t, test.contractKeyExists(legacyName))
+		require.False(t, test.contractKeyExists(test.hashedKey(legacyName)))
+
+		// The contract value stored under the contract name
+		// can still be loaded when hashed keys are enabled
+
+		result, err := test.readAnswer(hashedRuntime, legacyName)
+		require.NoError(t, err)
+		require.Equal(t, cadence.NewInt(42), result)
+
+		// Migrate the contract storage keys
+
+		require.Equal(t, 1, test.migrate(t))
+
+		require.False(t, test.contractKeyExists(legacyName))
+		require.True(t, test.contractKeyExists(test.hashedKey(legacyName)))
+
+		result, err = test.readAnswer(hashedRuntime, legacyName)
+		require.NoError(t, err)
+		require.Equal(t, cadence.NewInt(42), result)
+
+		// The migration is idempotent
+
+		require.Equal(t, 0, test.migrate(t))
+
+		require.True(t, test.contractKeyExists(test.hashedKey(legacyName)))
+
+		// Deploy a contract with hashed keys:
+		// the contract value is stored under the hashed key
+
+		const hashedName = "HashedContract"
+
+		err = test.deploy(hashedRuntime, hashedName, 43)
+		require.NoError(t, err)
+
+		require.False(t, test.contractKeyExists(hashedName))
+		require.True(t, test.contractKeyExists(test.hashedKey(hashedName)))
+
+		result, err = test.readAnswer(hashedRuntime, hashedName)
+		require.NoError(t, err)
+		require.Equal(t, cadence.NewInt(43), result)
+	})
+
+	t.Run("short names", func(t *testing.T) {
+
+		t.Parallel()
+
+		test := newHashedContractStorageKeysTest(t)
+
+		// Names which are not longer than a hashed key are not hashed
+
+		const name = "Short"
+
+		err := test.deploy(hashedRuntime, name, 42)
+		require.NoError(t, err)
+
+		require.True(t, test.contractKeyExists(name))
+		require.False(t, test.contractKeyExists(test.hashedKey(name)))
+
+		result, err := test.readAnswer(hashedRuntime, name)
+		require.NoError(t, err)
+		require.Equal(t, cadence.NewInt(42), result)
+
+		require.Equal(t, 0, test.migrate(t))
+
+		require.True(t, test.contractKeyExists(name))
+	})
+
+	t.Run("collision on deployment", func(t *testing.T) {
+
+		t.Parallel()
+
+		test := newHashedContractStorageKeysTest(t)
+
+		require.Equal(t,
+			test.hashedKey(collidingName1),
+			test.hashedKey(collidingName2),
+		)
+
+		err := test.deploy(hashedRuntime, collidingName1, 42)
+		require.NoError(t, err)
+
+		// Deploying a contract with a colliding hashed key is rejected
+
+		err = test.deploy(hashedRuntime, collidingName2, 43)
+		require.Error(t, err)
+
+		var collisionErr *ContractStorageKeyCollisionError
+		require.ErrorAs(t, err, &collisionErr)
+
+		// The existing contract is not affected
+
+		result, err := test.readAnswer(hashedRuntime, collidingName1)
+		require.NoError(t, err)
+		require.Equal(t, cadence.NewInt(42), result)
+	})
+
+	t.Run("collision on migration", func(t *testing.T) {
+
+		t.Parallel()
+
+		test := newHashedContractStorageKeysTest(t)
+
+		err := test.deploy(legacyRuntime, collidingName1, 42)
+		require.NoError(t, err)
+
+		err = test.deploy(legacyRuntime, collidingName2, 43)
+		require.NoError(t, err)
+
+		// Only one of the contracts can be migrated,
+		// the other contract stays stored under its name
+
+		require.Equal(t, 1, test.migrate(t))
+
+		require.True(t, test.contractKeyExists(test.hashedKey(collidingName1)))
+		require.NotEqual(t,
+			test.contractKeyExists(collidingName1),
+			test.contractKeyExists(collidingName2),
+		)
+
+		// Reading a contract never returns the value of the colliding contract
+
+		result, err := test.readAnswer(hashedRuntime, collidingName1)
+		require.NoError(t, err)
+		require.Equal(t, cadence.NewInt(42), result)
+
+		result, err = test.readAnswer(hashedRuntime, collidingName2)
+		require.NoError(t, err)
+		require.Equal(t, cadence.NewInt(43), result)
+	})
+}
@@ -1200,6 +1200,84 @@ func TestCheckAccount_getLinkTarget(t *testing.T) {
 	}
 }
 
+func TestCheckAccount_forEach(t *testing.T) {
+
+	t.Parallel()
+
+	functionNames := map[common.PathDomain]string{
+		common.PathDomainStorage: "forEachStored",
+		common.PathDomainPublic:  "forEachPublic",
+		common.PathDomainPrivate: "forEachPrivate",
+	}
+
+	pathTypes := map[common.PathDomain]string{
+		common.PathDomainStorage: "StoragePath",
+		common.PathDomainPublic:  "PublicPath",
+		common.PathDomainPrivate: "PrivatePath",
+	}
+
+	test := func(
+		accountType string,
+		accountVariable string,
+		functionDomain common.PathDomain,
+		pathDomain common.PathDomain,
+	) {
+
+		testName := fmt.Sprintf(
+			"%s.%s: %s",
+			accountType,
+			functionNames[functionDomain],
+			pathTypes[pathDomain],
+		)
+
+		t.Run(testName, func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := ParseAndCheckAccount(t,
+				fmt.Sprintf(
+					`
+                      fun test() {
+                          %s.%s(fun (path: %s, type: Type): Bool {
+                              return true
+                          })
+                      }
+                    `,
+					accountVariable,
+					functionNames[functionDomain],
+					pathTypes[pathDomain],
+				),
+			)
+
+			switch {
+			case accountType == "PublicAccount" && functionDomain != common.PathDomainPublic:
+				errs := ExpectCheckerErrors(t, err, 1)
+
+				require.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+
+			case functionDomain != pathDomain:
+				errs := ExpectCheckerErrors(t, err, 1)
+
+				require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+
+			default:
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	for _, functionDomain := range common.AllPathDomainsByIdentifier {
+		for _, pathDomain := range common.AllPathDomainsByIdentifier {
+			for accountType, accountVariable := range map[string]string{
+				"AuthAccount":   "authAccount",
+				"PublicAccount": "publicAccount",
+			} {
+				test(accountType, accountVariable, functionDomain, pathDomain)
+			}
+		}
+	}
+}
+
 func TestCheckAccount_getCapability(t *testing.T) {
 
 	t.Parallel()
@@ -1508,3 +1586,178 @@ func TestPublicAccountContracts(t *testing.T) {
 	})
 
 }
+
+func TestCheckAccountInbox(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckAccount(t, `
+          resource R {}
+
+          fun test() {
+              let inbox: AuthAccount.Inbox = authAccount.inbox
+
+              let cap = authAccount.getCapability<&R>(/private/r)
+              inbox.publish(cap, name: "r", recipient: 0x1)
+
+              let unpublished: Capability<&R>? = inbox.unpublish<&R>("r")
+              let claimed: Capability<&R>? = inbox.claim<&R>("r", provider: 0x1)
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("publish non-capability", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckAccount(t, `
+          fun test() {
+              authAccount.inbox.publish(1, name: "r", recipient: 0x1)
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("claim non-reference type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckAccount(t, `
+          resource R {}
+
+          fun test() {
+              authAccount.inbox.claim<@R>("r", provider: 0x1)
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("unpublish without type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckAccount(t, `
+          fun test() {
+              authAccount.inbox.unpublish("r")
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeParameterTypeInferenceError{}, errs[0])
+	})
+
+	t.Run("public account", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckAccount(t, `
+          fun test() {
+              publicAccount.inbox
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+	})
+}
+
+func TestCheckReadOnlyProgram(t *testing.T) {
+
+	t.Parallel()
+
+	parseAndCheck := func(t *testing.T, code string, readOnly bool) error {
+		_, err := ParseAndCheckWithOptions(t,
+			code,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithPredeclaredValues([]sema.ValueDeclaration{
+						stdlib.StandardLibraryValue{
+							Name: "authAccount",
+							Type: sema.AuthAccountType,
+							Kind: common.DeclarationKindConstant,
+						},
+					}),
+					sema.WithReadOnlyProgramCheckEnabled(readOnly),
+				},
+			},
+		)
+		return err
+	}
+
+	mutations := map[string]string{
+		"save":                 `authAccount.save(1, to: /storage/one)`,
+		"load":                 `authAccount.load<Int>(from: /storage/one)`,
+		"link":                 `authAccount.link<&Int>(/public/one, target: /storage/one)`,
+		"unlink":               `authAccount.unlink(/public/one)`,
+		"add":                  `authAccount.contracts.add(name: "C", code: [])`,
+		"update__experimental": `authAccount.contracts.update__experimental(name: "C", code: [])`,
+		"remove":               `authAccount.contracts.remove(name: "C")`,
+	}
+
+	for name, statement := range mutations {
+
+		name := name
+
+		code := fmt.Sprintf(
+			`
+              pub fun main() {
+                  %s
+              }
+            `,
+			statement,
+		)
+
+		t.Run(name, func(t *testing.T) {
+
+			t.Parallel()
+
+			err := parseAndCheck(t, code, true)
+
+			errs := ExpectCheckerErrors(t, err, 1)
+
+			var mutationErr *sema.ReadOnlyProgramMutationError
+			require.ErrorAs(t, errs[0], &mutationErr)
+			assert.Equal(t, name, mutationErr.Name)
+		})
+
+		t.Run(fmt.Sprintf("%s, disabled", name), func(t *testing.T) {
+
+			t.Parallel()
+
+			err := parseAndCheck(t, code, false)
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("reads", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := parseAndCheck(t,
+			`
+              pub fun main() {
+                  let a = authAccount.copy<Int>(from: /storage/one)
+                  let b = authAccount.borrow<&Int>(from: /storage/one)
+                  let c = authAccount.getCapability<&Int>(/public/one)
+                  let d = authAccount.contracts.get(name: "C")
+              }
+            `,
+			true,
+		)
+		require.NoError(t, err)
+	})
+}
@@ -864,6 +864,118 @@ func TestCheckInvalidArrayContainsNotEquatable(t *testing.T) {
 	assert.IsType(t, &sema.NotEquatableTypeError{}, errs[0])
 }
 
+func TestCheckArraySort(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      fun test(): [String] {
+          let xs = ["c", "a", "b"]
+          xs.sort(by: fun (a: String, b: String): Bool {
+              return a.length < b.length
+          })
+          return xs
+      }
+    `)
+
+	require.NoError(t, err)
+}
+
+func TestCheckInvalidArraySort(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("wrong comparison function type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let xs = [3, 1, 2]
+              xs.sort(by: fun (a: String, b: String): Bool {
+                  return true
+              })
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("resource elements", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(xs: &[R]) {
+              xs.sort(by: fun (a: @R, b: @R): Bool {
+                  destroy a
+                  destroy b
+                  return true
+              })
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidResourceArrayMemberError{}, errs[0])
+	})
+}
+
+func TestCheckArrayBinarySearch(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      fun test(): Int? {
+          let xs: [UInt8] = [1, 2, 3]
+          return xs.binarySearch(2)
+      }
+    `)
+
+	require.NoError(t, err)
+}
+
+func TestCheckInvalidArrayBinarySearch(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("not comparable", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(): Int? {
+              let xs = ["a", "b"]
+              return xs.binarySearch("a")
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotComparableTypeError{}, errs[0])
+	})
+
+	t.Run("numeric super-type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(): Int? {
+              let xs: [Integer] = [1, 2]
+              return xs.binarySearch(1)
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotComparableTypeError{}, errs[0])
+	})
+}
+
 func TestCheckEmptyArray(t *testing.T) {
 
 	t.Parallel()
@@ -918,6 +1030,103 @@ func TestCheckInvalidDictionaryContainsKey(t *testing.T) {
 	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
 }
 
+func TestCheckDictionaryForEachKey(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      fun test(): Int {
+          let x = {1: "One", 2: "Two", 3: "Three"}
+          var sum = 0
+          x.forEachKey(fun (key: Int): Bool {
+              sum = sum + key
+              return true
+          })
+          return sum
+      }
+    `)
+
+	require.NoError(t, err)
+}
+
+func TestCheckDictionaryForEachValue(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      fun test(): String {
+          let x = {1: "One", 2: "Two", 3: "Three"}
+          var joined = ""
+          x.forEachValue(fun (value: String): Bool {
+              joined = joined.concat(value)
+              return true
+          })
+          return joined
+      }
+    `)
+
+	require.NoError(t, err)
+}
+
+func TestCheckInvalidDictionaryForEachKey(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("invalid key type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let x = {1: "One", 2: "Two", 3: "Three"}
+              x.forEachKey(fun (key: String): Bool {
+                  return true
+              })
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("missing result", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let x = {1: "One", 2: "Two", 3: "Three"}
+              x.forEachKey(fun (key: Int) {})
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
+
+func TestCheckInvalidResourceDictionaryForEachValue(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      resource R {}
+
+      fun test(x: &{Int: R}) {
+          x.forEachValue(fun (value: @R): Bool {
+              destroy value
+              return true
+          })
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.InvalidResourceDictionaryMemberError{}, errs[0])
+}
+
 func TestCheckEmptyDictionary(t *testing.T) {
 
 	t.Parallel()
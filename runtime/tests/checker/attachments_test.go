@@ -0,0 +1,634 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func TestCheckAttachmentDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("struct base", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct S {}
+
+          attachment A for S {
+              let x: Int
+
+              init(x: Int) {
+                  self.x = x
+              }
+
+              fun getX(): Int {
+                  return self.x
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+
+		attachmentType := RequireGlobalType(t, checker.Elaboration, "A")
+		require.IsType(t, &sema.CompositeType{}, attachmentType)
+
+		compositeType := attachmentType.(*sema.CompositeType)
+		assert.Equal(t, common.CompositeKindAttachment, compositeType.Kind)
+		assert.Equal(t,
+			RequireGlobalType(t, checker.Elaboration, "S"),
+			compositeType.GetBaseType(),
+		)
+	})
+
+	t.Run("resource base", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          attachment A for R {}
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid base", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          attachment A for Int {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidBaseTypeError{}, errs[0])
+	})
+
+	t.Run("undeclared base", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          attachment A for S {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+
+	t.Run("nested in contract", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract C {
+              resource R {}
+
+              attachment A for R {}
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("nested in contract interface", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract interface CI {
+              resource R {}
+
+              attachment A for R {}
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidNestedDeclarationError{}, errs[0])
+	})
+
+	t.Run("resource field", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          attachment A for R {
+              let r: @R
+
+              init(r: @R) {
+                  self.r <- r
+              }
+
+              destroy() {
+                  destroy self.r
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.InvalidResourceFieldError{}, errs[0])
+		assert.IsType(t, &sema.InvalidDestructorError{}, errs[1])
+	})
+}
+
+func TestCheckAttachmentBase(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {
+              let x: Int
+
+              init() {
+                  self.x = 1
+              }
+          }
+
+          attachment A for R {
+              fun getBase(): &R {
+                  return base
+              }
+
+              fun getX(): Int {
+                  return base.x
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("initializer", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              let x: Int
+
+              init() {
+                  self.x = 1
+              }
+          }
+
+          attachment A for S {
+              let y: Int
+
+              init() {
+                  self.y = base.x
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("not available in base", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              fun test() {
+                  base
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+}
+
+func TestCheckAttachExpression(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("struct", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct S {}
+
+          attachment A for S {
+              let x: Int
+
+              init(x: Int) {
+                  self.x = x
+              }
+          }
+
+          let s = attach A(x: 1) to S()
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			RequireGlobalType(t, checker.Elaboration, "S"),
+			RequireGlobalValue(t, checker.Elaboration, "s"),
+		)
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          attachment A for R {}
+
+          fun test(): @R {
+              let r <- create R()
+              let r2 <- attach A() to <-r
+              return <-r2
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("resource, missing move", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          attachment A for R {}
+
+          fun test(): @R {
+              let r <- create R()
+              return <-attach A() to r
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 3)
+
+		assert.IsType(t, &sema.MissingMoveOperationError{}, errs[0])
+		assert.IsType(t, &sema.ResourceLossError{}, errs[1])
+		assert.IsType(t, &sema.ResourceLossError{}, errs[2])
+	})
+
+	t.Run("resource, use after move", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          attachment A for R {}
+
+          fun test(): @R {
+              let r <- create R()
+              let r2 <- attach A() to <-r
+              destroy r
+              return <-r2
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ResourceUseAfterInvalidationError{}, errs[0])
+	})
+
+	t.Run("resource loss", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          attachment A for R {}
+
+          fun test() {
+              attach A() to <-create R()
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ResourceLossError{}, errs[0])
+	})
+
+	t.Run("wrong base", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {}
+          struct T {}
+
+          attachment A for S {}
+
+          let t = attach A() to T()
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.AttachToInvalidTypeError{}, errs[0])
+	})
+
+	t.Run("reference base", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {}
+
+          attachment A for S {}
+
+          let s = S()
+          let ref = attach A() to &s as &S
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.AttachToInvalidTypeError{}, errs[0])
+	})
+
+	t.Run("non-attachment", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {}
+
+          let s = attach S() to S()
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.AttachNonAttachmentError{}, errs[0])
+	})
+
+	t.Run("construction outside attach", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {}
+
+          attachment A for S {}
+
+          let a = A()
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidAttachmentUsageError{}, errs[0])
+	})
+}
+
+func TestCheckAttachmentAccess(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("value", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct S {}
+
+          attachment A for S {
+              let x: Int
+
+              init() {
+                  self.x = 1
+              }
+          }
+
+          let s = attach A() to S()
+          let a = s[A]
+          let x = s[A]?.x
+        `)
+
+		require.NoError(t, err)
+
+		attachmentType := RequireGlobalType(t, checker.Elaboration, "A")
+
+		assert.Equal(t,
+			&sema.OptionalType{
+				Type: &sema.ReferenceType{
+					Type: attachmentType,
+				},
+			},
+			RequireGlobalValue(t, checker.Elaboration, "a"),
+		)
+
+		assert.Equal(t,
+			&sema.OptionalType{
+				Type: sema.IntType,
+			},
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+	})
+
+	t.Run("reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          attachment A for R {}
+
+          fun test(ref: &R): &A? {
+              return ref[A]
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("wrong base", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {}
+          struct T {}
+
+          attachment A for S {}
+
+          let a = T()[A]
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidTypeIndexingError{}, errs[0])
+	})
+
+	t.Run("non-attachment", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {}
+
+          let a = S()[S]
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidTypeIndexingError{}, errs[0])
+	})
+
+	t.Run("non-type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {}
+
+          let a = S()[1]
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotIndexableTypeError{}, errs[0])
+	})
+
+	t.Run("assignment", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {}
+
+          attachment A for S {}
+
+          fun test() {
+              let s = S()
+              s[A] = nil
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotIndexingAssignableTypeError{}, errs[0])
+	})
+}
+
+func TestCheckRemoveStatement(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          attachment A for R {}
+
+          fun test(): @R {
+              let r <- attach A() to <-create R()
+              remove A from r
+              return <-r
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("wrong base", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {}
+          struct T {}
+
+          attachment A for S {}
+
+          fun test() {
+              let t = T()
+              remove A from t
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidAttachmentRemoveError{}, errs[0])
+	})
+
+	t.Run("reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          attachment A for R {}
+
+          fun test(ref: &R) {
+              remove A from ref
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidAttachmentRemoveError{}, errs[0])
+	})
+
+	t.Run("resource loss", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          attachment A for R {}
+
+          fun test() {
+              remove A from <-create R()
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ResourceLossError{}, errs[0])
+	})
+}
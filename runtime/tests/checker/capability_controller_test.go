@@ -0,0 +1,154 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func TestCheckStorageCapabilityControllers(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckAccount(t, `
+          resource R {}
+
+          fun test() {
+              let capabilities: AuthAccount.Capabilities = authAccount.capabilities
+              let storageCapabilities: AuthAccount.StorageCapabilities = capabilities.storage
+
+              let cap: Capability<&R> = storageCapabilities.issue<&R>(/storage/r)
+
+              let controller: StorageCapabilityController? =
+                  storageCapabilities.getController(byCapabilityID: 1)
+
+              let controllers: [StorageCapabilityController] =
+                  storageCapabilities.getControllers(forPath: /storage/r)
+
+              storageCapabilities.forEachController(
+                  forPath: /storage/r,
+                  fun (controller: StorageCapabilityController): Bool {
+                      let id: UInt64 = controller.capabilityID
+                      let borrowType: Type = controller.borrowType
+                      let target: StoragePath = controller.target()
+                      controller.retarget(/storage/r2)
+                      controller.delete()
+                      return true
+                  }
+              )
+
+              capabilities.publish(cap, at: /public/r)
+              let unpublished: Capability? = capabilities.unpublish(/public/r)
+              let id: UInt64? = capabilities.migrateLink(/public/r)
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("issue non-reference type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckAccount(t, `
+          resource R {}
+
+          fun test() {
+              authAccount.capabilities.storage.issue<@R>(/storage/r)
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("issue non-storage path", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckAccount(t, `
+          resource R {}
+
+          fun test() {
+              authAccount.capabilities.storage.issue<&R>(/public/r)
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("publish at private path", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckAccount(t, `
+          resource R {}
+
+          fun test() {
+              let cap = authAccount.capabilities.storage.issue<&R>(/storage/r)
+              authAccount.capabilities.publish(cap, at: /private/r)
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("public account", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckAccount(t, `
+          let capabilities = publicAccount.capabilities
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+	})
+
+	t.Run("controller not storable", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckAccount(t, `
+          fun test() {
+              let controller = authAccount.capabilities.storage.getController(byCapabilityID: 1)!
+              authAccount.save(controller, to: /storage/controller)
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
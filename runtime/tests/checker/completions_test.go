@@ -0,0 +1,238 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func TestCheckCompletionsAtPosition(t *testing.T) {
+
+	t.Parallel()
+
+	completionIdentifiers := func(completions []sema.Completion, prefix string) []string {
+		var identifiers []string
+		for _, completion := range completions {
+			if !strings.HasPrefix(completion.Identifier, prefix) {
+				continue
+			}
+			identifiers = append(identifiers, completion.Identifier)
+		}
+		return identifiers
+	}
+
+	findCompletion := func(completions []sema.Completion, identifier string) *sema.Completion {
+		for _, completion := range completions {
+			if completion.Identifier == identifier {
+				return &completion
+			}
+		}
+		return nil
+	}
+
+	t.Run("variables", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheckWithOptions(t,
+			`
+              let _TEST_x = "global"
+
+              fun _TEST_foo(_TEST_a: Int) {
+                  let _TEST_x = 1
+                  if true {
+                      let _TEST_b = 2
+                  }
+                  let _TEST_c = 3
+              }
+            `,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithPositionInfoEnabled(true),
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		// At the end of the if-statement, after the declaration of `_TEST_b`
+
+		completions := checker.CompletionsAtPosition(sema.Position{Line: 8, Column: 18})
+
+		assert.Equal(t,
+			[]string{"_TEST_a", "_TEST_b", "_TEST_foo", "_TEST_x"},
+			completionIdentifiers(completions, "_TEST_"),
+		)
+
+		// The local variable shadows the global variable
+
+		x := findCompletion(completions, "_TEST_x")
+		require.NotNil(t, x)
+		assert.Equal(t, sema.CompletionKindVariable, x.Kind)
+		assert.Equal(t, common.DeclarationKindConstant, x.DeclarationKind)
+		assert.Equal(t, sema.IntType, x.Type)
+
+		a := findCompletion(completions, "_TEST_a")
+		require.NotNil(t, a)
+		assert.Equal(t, common.DeclarationKindParameter, a.DeclarationKind)
+
+		// Built-in declarations are in scope, too
+
+		assert.NotNil(t, findCompletion(completions, "Int"))
+
+		// Outside of the function, only the global declarations are in scope
+
+		completions = checker.CompletionsAtPosition(sema.Position{Line: 11, Column: 0})
+
+		assert.Equal(t,
+			[]string{"_TEST_foo", "_TEST_x"},
+			completionIdentifiers(completions, "_TEST_"),
+		)
+
+		x = findCompletion(completions, "_TEST_x")
+		require.NotNil(t, x)
+		assert.Equal(t, sema.StringType, x.Type)
+	})
+
+	t.Run("members", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheckWithOptions(t,
+			`
+              pub struct S {
+                  pub let _TEST_a: Int
+                  priv let _TEST_b: Int
+
+                  init() {
+                      self._TEST_a = 1
+                      self._TEST_b = 2
+                  }
+
+                  pub fun _TEST_sum(): Int {
+                      return self._TEST_a + self._TEST_b
+                  }
+              }
+
+              pub fun test(s: S): Int {
+                  return s._TEST_a
+              }
+            `,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithPositionInfoEnabled(true),
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		// Outside of the structure, the private field is not accessible
+
+		completions := checker.CompletionsAtPosition(sema.Position{Line: 17, Column: 28})
+
+		assert.Equal(t,
+			[]string{"_TEST_a", "_TEST_sum"},
+			completionIdentifiers(completions, "_TEST_"),
+		)
+
+		sum := findCompletion(completions, "_TEST_sum")
+		require.NotNil(t, sum)
+		assert.Equal(t, sema.CompletionKindMember, sum.Kind)
+		assert.Equal(t, common.DeclarationKindFunction, sum.DeclarationKind)
+		assert.IsType(t, &sema.FunctionType{}, sum.Type)
+
+		// Inside of the structure, the private field is accessible
+
+		completions = checker.CompletionsAtPosition(sema.Position{Line: 12, Column: 33})
+
+		assert.Equal(t,
+			[]string{"_TEST_a", "_TEST_b", "_TEST_sum"},
+			completionIdentifiers(completions, "_TEST_"),
+		)
+	})
+
+	t.Run("importable declarations", func(t *testing.T) {
+
+		t.Parallel()
+
+		importedChecker, err := ParseAndCheckWithOptions(t,
+			`
+              pub let _TEST_x = 1
+              pub fun _TEST_y(): Int { return 2 }
+              priv let _TEST_z = 3
+            `,
+			ParseAndCheckOptions{
+				Location: utils.ImportedLocation,
+			},
+		)
+		require.NoError(t, err)
+
+		checker, err := ParseAndCheckWithOptions(t,
+			`
+              import _TEST_x from "imported"
+
+              let _TEST_a = _TEST_x
+            `,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithPositionInfoEnabled(true),
+					sema.WithImportHandler(
+						func(_ *sema.Checker, _ common.Location, _ ast.Range) (sema.Import, error) {
+							return sema.ElaborationImport{
+								Elaboration: importedChecker.Elaboration,
+							}, nil
+						},
+					),
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		completions := checker.CompletionsAtPosition(sema.Position{Line: 5, Column: 0})
+
+		assert.Equal(t,
+			[]string{"_TEST_a", "_TEST_x", "_TEST_y"},
+			completionIdentifiers(completions, "_TEST_"),
+		)
+
+		y := findCompletion(completions, "_TEST_y")
+		require.NotNil(t, y)
+		assert.Equal(t, sema.CompletionKindImportable, y.Kind)
+		assert.Equal(t, common.DeclarationKindFunction, y.DeclarationKind)
+		assert.Equal(t, utils.ImportedLocation, y.Location)
+	})
+
+	t.Run("position info disabled", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `let x = 1`)
+		require.NoError(t, err)
+
+		assert.Nil(t, checker.CompletionsAtPosition(sema.Position{Line: 1, Column: 0}))
+	})
+}
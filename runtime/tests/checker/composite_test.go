@@ -2329,6 +2329,125 @@ func TestCheckInvalidMissingMember(t *testing.T) {
 		)
 	})
 
+	t.Run("optional: suggested fix", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+          struct S {
+              fun a() {}
+          }
+
+          fun test() {
+              let s: S? = S()
+              s.a
+          }
+        `
+
+		_, err := ParseAndCheck(t, code)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t,
+			&sema.NotDeclaredMemberError{},
+			errs[0],
+		)
+
+		notDeclaredMemberErr := errs[0].(*sema.NotDeclaredMemberError)
+
+		fixes := notDeclaredMemberErr.SuggestedFixes(code)
+		require.Len(t, fixes, 1)
+		require.Len(t, fixes[0].TextEdits, 1)
+
+		fixedCode := fixes[0].TextEdits[0].ApplyTo(code)
+		assert.Contains(t, fixedCode, "s?.a")
+
+		_, err = ParseAndCheck(t, fixedCode)
+		require.NoError(t, err)
+	})
+
+	t.Run("closest member", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+          struct S {
+              let balance: Int
+
+              init() {
+                  self.balance = 0
+              }
+          }
+
+          fun test() {
+              let s = S()
+              s.balanse
+          }
+        `
+
+		_, err := ParseAndCheck(t, code)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t,
+			&sema.NotDeclaredMemberError{},
+			errs[0],
+		)
+
+		notDeclaredMemberErr := errs[0].(*sema.NotDeclaredMemberError)
+		assert.Equal(t,
+			"unknown member, did you mean `balance`?",
+			notDeclaredMemberErr.SecondaryError(),
+		)
+
+		fixes := notDeclaredMemberErr.SuggestedFixes(code)
+		require.Len(t, fixes, 1)
+		assert.Equal(t, "rename to `balance`", fixes[0].Message)
+		require.Len(t, fixes[0].TextEdits, 1)
+
+		fixedCode := fixes[0].TextEdits[0].ApplyTo(code)
+		assert.Contains(t, fixedCode, "s.balance\n")
+
+		_, err = ParseAndCheck(t, fixedCode)
+		require.NoError(t, err)
+	})
+
+	t.Run("no close member", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+          struct S {
+              let balance: Int
+
+              init() {
+                  self.balance = 0
+              }
+          }
+
+          fun test() {
+              let s = S()
+              s.owner
+          }
+        `
+
+		_, err := ParseAndCheck(t, code)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t,
+			&sema.NotDeclaredMemberError{},
+			errs[0],
+		)
+
+		notDeclaredMemberErr := errs[0].(*sema.NotDeclaredMemberError)
+		assert.Equal(t,
+			"unknown member",
+			notDeclaredMemberErr.SecondaryError(),
+		)
+		assert.Empty(t, notDeclaredMemberErr.SuggestedFixes(code))
+	})
+
 	t.Run("optional: non-optional non-existent", func(t *testing.T) {
 
 		t.Parallel()
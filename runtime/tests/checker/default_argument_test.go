@@ -0,0 +1,312 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func TestCheckFunctionDefaultArgument(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("all arguments", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(a: Int, b: Int = 2): Int {
+              return a + b
+          }
+
+          let x = test(a: 1, b: 3)
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("omitted argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          fun test(a: Int, b: Int = 2): Int {
+              return a + b
+          }
+
+          let x = test(a: 1)
+        `)
+		require.NoError(t, err)
+
+		functionType := RequireGlobalValue(t, checker.Elaboration, "test").(*sema.FunctionType)
+		require.NotNil(t, functionType.RequiredArgumentCount)
+		assert.Equal(t, 1, *functionType.RequiredArgumentCount)
+	})
+
+	t.Run("omitted all arguments", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(a: Int = 1, b: String = "b") {}
+
+          let x = test()
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("missing required argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(a: Int, b: Int = 2) {}
+
+          let x = test()
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ArgumentCountError{}, errs[0])
+	})
+
+	t.Run("type mismatch", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(a: Int = "a") {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("expected type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(a: UInt8 = 1, b: [Int8]? = [-1]) {}
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("missing default argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(a: Int = 1, b: Int) {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingDefaultArgumentError{}, errs[0])
+	})
+
+	t.Run("reference to other parameter", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(a: Int, b: Int = a) {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+
+	t.Run("reference to global", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let y = 2
+
+          fun test(a: Int = y * 2) {}
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(r: @R? = nil) {
+              destroy r
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidDefaultArgumentError{}, errs[0])
+	})
+
+	t.Run("function expression", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let test = fun (a: Int = 1): Int {
+              return a
+          }
+
+          let x = test()
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("composite function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              fun test(a: Int, b: Bool = false) {}
+          }
+
+          let x = S().test(a: 1)
+        `)
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckCompositeInitializerDefaultArgument(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("struct", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct S {
+              let a: Int
+              let b: String
+
+              init(a: Int, b: String = "b") {
+                  self.a = a
+                  self.b = b
+              }
+          }
+
+          let s1 = S(a: 1)
+          let s2 = S(a: 1, b: "c")
+        `)
+		require.NoError(t, err)
+
+		constructorType := RequireGlobalValue(t, checker.Elaboration, "S").(*sema.FunctionType)
+		require.NotNil(t, constructorType.RequiredArgumentCount)
+		assert.Equal(t, 1, *constructorType.RequiredArgumentCount)
+	})
+
+	t.Run("resource, missing argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {
+              let a: Int
+              let b: Int
+
+              init(a: Int, b: Int = 2) {
+                  self.a = a
+                  self.b = b
+              }
+          }
+
+          fun test(): @R {
+              return <- create R()
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ArgumentCountError{}, errs[0])
+	})
+
+	t.Run("nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract C {
+
+              struct S {
+                  let a: Int
+
+                  init(a: Int = 1) {
+                      self.a = a
+                  }
+              }
+
+              fun test(): S {
+                  return S()
+              }
+          }
+
+          let s = C.S()
+        `)
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckTransactionDefaultArgument(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("parameter", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          transaction(a: Int = 1) {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidDefaultArgumentError{}, errs[0])
+	})
+
+	t.Run("prepare", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          transaction {
+              prepare(signer: AuthAccount, x: Int = 1) {}
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.InvalidTransactionPrepareParameterTypeError{}, errs[0])
+		assert.IsType(t, &sema.InvalidDefaultArgumentError{}, errs[1])
+	})
+}
@@ -0,0 +1,230 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/parser"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func TestCheckElaborationNodeIDs(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("keyed by node ID", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let x = 1 + 2
+        `)
+		require.NoError(t, err)
+
+		variableDeclaration := checker.Program.VariableDeclarations()[0]
+		require.NotEqual(t, ast.NodeIDUnassigned, variableDeclaration.ID())
+
+		binaryExpression := variableDeclaration.Value.(*ast.BinaryExpression)
+		require.NotEqual(t, ast.NodeIDUnassigned, binaryExpression.ID())
+
+		assert.Equal(t,
+			sema.IntType,
+			checker.Elaboration.VariableDeclarationValueTypes[variableDeclaration.ID()],
+		)
+
+		assert.Equal(t,
+			sema.IntType,
+			checker.Elaboration.BinaryExpressionResultTypes[binaryExpression.ID()],
+		)
+	})
+
+	t.Run("rewritten post-conditions", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          fun test(x: Int): Int {
+              post {
+                  result > before(x)
+              }
+              return x + 1
+          }
+        `)
+		require.NoError(t, err)
+
+		functionBlock := checker.Program.FunctionDeclarations()[0].FunctionBlock
+
+		rewrite, ok := checker.Elaboration.PostConditionsRewrite[functionBlock.PostConditions]
+		require.True(t, ok)
+
+		require.Len(t, rewrite.BeforeStatements, 1)
+
+		beforeStatement := rewrite.BeforeStatements[0].(*ast.VariableDeclaration)
+		require.NotEqual(t, ast.NodeIDUnassigned, beforeStatement.ID())
+
+		assert.Equal(t,
+			sema.IntType,
+			checker.Elaboration.VariableDeclarationValueTypes[beforeStatement.ID()],
+		)
+
+		require.Len(t, rewrite.RewrittenPostConditions, 1)
+
+		rewrittenTest := rewrite.RewrittenPostConditions[0].Test.(*ast.BinaryExpression)
+		require.NotEqual(t, ast.NodeIDUnassigned, rewrittenTest.ID())
+		require.NotEqual(t, ast.NodeIDUnassigned, rewrittenTest.Right.ID())
+
+		assert.Equal(t,
+			sema.BoolType,
+			checker.Elaboration.BinaryExpressionResultTypes[rewrittenTest.ID()],
+		)
+	})
+
+	t.Run("node of other program", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let x = 1
+        `)
+		require.NoError(t, err)
+
+		otherChecker, err := ParseAndCheck(t, `
+          let x = true
+        `)
+		require.NoError(t, err)
+
+		variableDeclaration := checker.Program.VariableDeclarations()[0]
+		otherVariableDeclaration := otherChecker.Program.VariableDeclarations()[0]
+
+		// The programs have the same structure, but the IDs of their nodes differ
+
+		require.Equal(t,
+			variableDeclaration.ID().Index(),
+			otherVariableDeclaration.ID().Index(),
+		)
+		require.NotEqual(t,
+			variableDeclaration.ID(),
+			otherVariableDeclaration.ID(),
+		)
+
+		assert.Equal(t,
+			sema.IntType,
+			checker.Elaboration.VariableDeclarationValueTypes[variableDeclaration.ID()],
+		)
+
+		_, ok := checker.Elaboration.VariableDeclarationValueTypes[otherVariableDeclaration.ID()]
+		assert.False(t, ok)
+	})
+
+	t.Run("program is not modified", func(t *testing.T) {
+
+		t.Parallel()
+
+		program, err := parser.ParseProgram(`
+          fun test(x: Int): Int {
+              post {
+                  result > before(x)
+              }
+              return x + 1
+          }
+        `, nil)
+		require.NoError(t, err)
+
+		elementIDs := func() []ast.NodeID {
+			var ids []ast.NodeID
+			ast.Inspect(program, func(element ast.Element) bool {
+				if element != nil {
+					ids = append(ids, element.ID())
+				}
+				return true
+			})
+			return ids
+		}
+
+		ids := elementIDs()
+
+		// Check the shared program concurrently
+
+		const checkerCount = 4
+
+		checkers := make([]*sema.Checker, checkerCount)
+		errs := make([]error, checkerCount)
+
+		var wg sync.WaitGroup
+		wg.Add(checkerCount)
+
+		for i := 0; i < checkerCount; i++ {
+			i := i
+			go func() {
+				defer wg.Done()
+
+				checker, err := sema.NewChecker(
+					program,
+					utils.TestLocation,
+					nil,
+					false,
+					sema.WithAccessCheckMode(sema.AccessCheckModeNotSpecifiedUnrestricted),
+				)
+				if err != nil {
+					errs[i] = err
+					return
+				}
+
+				checkers[i] = checker
+				errs[i] = checker.Check()
+			}()
+		}
+
+		wg.Wait()
+
+		for _, err := range errs {
+			require.NoError(t, err)
+		}
+
+		assert.Equal(t, ids, elementIDs())
+
+		// The elements synthesized by the checkers have IDs,
+		// which do not collide with the IDs of the program
+
+		functionBlock := program.FunctionDeclarations()[0].FunctionBlock
+
+		for _, checker := range checkers {
+			rewrite := checker.Elaboration.PostConditionsRewrite[functionBlock.PostConditions]
+			require.Len(t, rewrite.BeforeStatements, 1)
+
+			beforeStatement := rewrite.BeforeStatements[0].(*ast.VariableDeclaration)
+
+			assert.Equal(t,
+				program.ID().ProgramTag(),
+				beforeStatement.ID().ProgramTag(),
+			)
+			assert.GreaterOrEqual(t,
+				beforeStatement.ID().Index(),
+				ast.FirstSynthesizedNodeIndex,
+			)
+		}
+	})
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/sema"
 )
 
@@ -194,3 +195,104 @@ func TestEntryPointParameters(t *testing.T) {
 		require.Empty(t, parameters)
 	})
 }
+
+func TestEntryPointConditions(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("script", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+            pub fun main(a: Int): Int {
+                pre {
+                    a > 0: "a must be positive"
+                }
+                post {
+                    result == a
+                }
+                return a
+            }
+        `)
+
+		require.NoError(t, err)
+
+		entryPoint := checker.EntryPoint()
+		require.NotNil(t, entryPoint)
+
+		require.Len(t, entryPoint.Parameters, 1)
+		require.Equal(t, "a", entryPoint.Parameters[0].Identifier)
+
+		preConditions := entryPoint.PreConditions
+		require.NotNil(t, preConditions)
+		require.Len(t, *preConditions, 1)
+		require.Equal(t, ast.ConditionKindPre, (*preConditions)[0].Kind)
+		require.Equal(t, "a > 0", (*preConditions)[0].Test.String())
+		require.Equal(t, `"a must be positive"`, (*preConditions)[0].Message.String())
+
+		postConditions := entryPoint.PostConditions
+		require.NotNil(t, postConditions)
+		require.Len(t, *postConditions, 1)
+		require.Equal(t, ast.ConditionKindPost, (*postConditions)[0].Kind)
+		require.Equal(t, "result == a", (*postConditions)[0].Test.String())
+		require.Nil(t, (*postConditions)[0].Message)
+	})
+
+	t.Run("transaction", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+            transaction(a: Int) {
+                prepare(signer: AuthAccount) {}
+
+                pre {
+                    a > 0
+                }
+
+                execute {}
+            }
+        `)
+
+		require.NoError(t, err)
+
+		entryPoint := checker.EntryPoint()
+		require.NotNil(t, entryPoint)
+
+		require.Len(t, entryPoint.Parameters, 1)
+		require.Equal(t, "a", entryPoint.Parameters[0].Identifier)
+
+		preConditions := entryPoint.PreConditions
+		require.NotNil(t, preConditions)
+		require.Len(t, *preConditions, 1)
+		require.Equal(t, "a > 0", (*preConditions)[0].Test.String())
+
+		require.Nil(t, entryPoint.PostConditions)
+	})
+
+	t.Run("transaction and script", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+            pub fun main(a: Int) {
+                pre {
+                    a > 0
+                }
+            }
+
+            transaction(a: Int) {
+                prepare(signer: AuthAccount) {}
+
+                pre {
+                    a > 0
+                }
+            }
+        `)
+
+		require.NoError(t, err)
+
+		require.Nil(t, checker.EntryPoint())
+	})
+}
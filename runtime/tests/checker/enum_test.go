@@ -240,3 +240,151 @@ func TestCheckEnumInContract(t *testing.T) {
 
 	require.NoError(t, err)
 }
+
+func TestCheckEnumAllCases(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          enum E: UInt8 {
+              case a
+              case b
+          }
+
+          let cases: [E] = E.allCases
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("mutation", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          enum E: UInt8 {
+              case a
+              case b
+          }
+
+          fun test() {
+              E.allCases.append(E.a)
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.ExternalMutationError{}, errs[0])
+	})
+
+	t.Run("assignment", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          enum E: UInt8 {
+              case a
+          }
+
+          fun test() {
+              E.allCases = []
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		require.IsType(t, &sema.InvalidAssignmentAccessError{}, errs[0])
+		require.IsType(t, &sema.AssignmentToConstantMemberError{}, errs[1])
+	})
+
+	t.Run("shadowed by case", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          enum E: UInt8 {
+              case allCases
+          }
+
+          let e: E = E.allCases
+        `)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckEnumLookup(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          enum E: UInt8 {
+              case a
+              case b
+          }
+
+          let e: E? = E.lookup(rawValue: 1)
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid raw value type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          enum E: UInt8 {
+              case a
+          }
+
+          let e = E.lookup(rawValue: "a")
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("missing argument label", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          enum E: UInt8 {
+              case a
+          }
+
+          let e = E.lookup(0)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.MissingArgumentLabelError{}, errs[0])
+	})
+
+	t.Run("non-optional result", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          enum E: UInt8 {
+              case a
+          }
+
+          let e: E = E.lookup(rawValue: 0)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
@@ -282,3 +282,219 @@ func TestCheckEmitEvent(t *testing.T) {
 		assert.IsType(t, &sema.EmitImportedEventError{}, errs[0])
 	})
 }
+
+func TestCheckResourceDestroyedEvent(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("fields", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+            struct S {}
+
+            resource Inner {}
+
+            resource R {
+                let id: Int
+                var name: String?
+                let s: S
+                let inner: @Inner
+                let numbers: [UInt8]
+
+                init() {
+                    self.id = 1
+                    self.name = nil
+                    self.s = S()
+                    self.inner <- create Inner()
+                    self.numbers = []
+                }
+
+                fun test() {}
+
+                destroy() {
+                    destroy self.inner
+                }
+            }
+        `)
+		require.NoError(t, err)
+
+		resourceType := RequireGlobalType(t, checker.Elaboration, "R").(*sema.CompositeType)
+
+		eventType := resourceType.ResourceDestroyedEventType()
+		require.NotNil(t, eventType)
+
+		assert.Equal(t, common.CompositeKindEvent, eventType.Kind)
+		assert.Equal(t, "R.ResourceDestroyed", eventType.QualifiedIdentifier())
+		assert.Equal(t,
+			[]string{"uuid", "id", "name", "s", "numbers"},
+			eventType.Fields,
+		)
+		require.Len(t, eventType.ConstructorParameters, 5)
+		assert.Equal(t,
+			&sema.OptionalType{Type: sema.StringType},
+			eventType.ConstructorParameters[2].TypeAnnotation.Type,
+		)
+
+		assert.Same(t, eventType, checker.Elaboration.CompositeTypes[eventType.ID()])
+	})
+
+	t.Run("struct", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+            struct S {}
+        `)
+		require.NoError(t, err)
+
+		structType := RequireGlobalType(t, checker.Elaboration, "S").(*sema.CompositeType)
+
+		assert.Nil(t, structType.ResourceDestroyedEventType())
+	})
+
+	t.Run("nested in contract", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheckWithOptions(t,
+			`
+              contract C {
+                  resource R {
+                      let id: UInt64
+
+                      init(id: UInt64) {
+                          self.id = id
+                      }
+                  }
+              }
+            `,
+			ParseAndCheckOptions{
+				Location: utils.ImportedLocation,
+			},
+		)
+		require.NoError(t, err)
+
+		contractType := RequireGlobalType(t, checker.Elaboration, "C").(*sema.CompositeType)
+		resourceType, ok := contractType.GetNestedTypes().Get("R")
+		require.True(t, ok)
+
+		eventType := resourceType.(*sema.CompositeType).ResourceDestroyedEventType()
+		require.NotNil(t, eventType)
+
+		assert.Equal(t,
+			common.TypeID("S.imported.C.R.ResourceDestroyed"),
+			eventType.ID(),
+		)
+	})
+
+	t.Run("redeclaration", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            resource R {
+                event ResourceDestroyed()
+            }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidNestedDeclarationError{}, errs[0])
+	})
+
+	t.Run("explicit emit", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            resource R {
+                destroy() {
+                    emit ResourceDestroyed(uuid: self.uuid)
+                }
+            }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+}
+
+func TestCheckElaborationEventTypes(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+        pub contract C {
+
+            pub event Deposit(id: UInt64, amount: UFix64)
+
+            pub resource R {}
+
+            pub fun deposit() {
+                emit Deposit(id: 1, amount: 2.0)
+            }
+        }
+
+        event Withdraw(id: UInt64)
+    `)
+	require.NoError(t, err)
+
+	eventTypes := checker.Elaboration.EventTypes()
+
+	typeIDs := make([]common.TypeID, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		assert.Equal(t, common.CompositeKindEvent, eventType.Kind)
+		typeIDs = append(typeIDs, eventType.ID())
+	}
+
+	assert.Equal(t,
+		[]common.TypeID{
+			"S.test.C.Deposit",
+			"S.test.C.R.ResourceDestroyed",
+			"S.test.Withdraw",
+		},
+		typeIDs,
+	)
+
+	depositParameters := eventTypes[0].ConstructorParameters
+	require.Len(t, depositParameters, 2)
+
+	assert.Equal(t, "id", depositParameters[0].Identifier)
+	assert.Equal(t, sema.UInt64Type, depositParameters[0].TypeAnnotation.Type)
+
+	assert.Equal(t, "amount", depositParameters[1].Identifier)
+	assert.Equal(t, sema.UFix64Type, depositParameters[1].TypeAnnotation.Type)
+}
+
+func TestCheckIndexedEventFields(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+        event Transfer(indexed from: Address, to: Address, indexed into amount: UFix64)
+
+        fun test() {
+            emit Transfer(from: 0x1, to: 0x2, into: 1.0)
+        }
+    `)
+	require.NoError(t, err)
+
+	transferType := RequireGlobalType(t, checker.Elaboration, "Transfer").(*sema.CompositeType)
+
+	indexed := map[string]bool{}
+	transferType.Members.Foreach(func(name string, member *sema.Member) {
+		indexed[name] = member.Indexed
+	})
+
+	assert.Equal(t,
+		map[string]bool{
+			"from":   true,
+			"to":     false,
+			"amount": true,
+		},
+		indexed,
+	)
+}
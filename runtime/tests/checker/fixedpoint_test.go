@@ -658,13 +658,25 @@ func TestCheckFixedPointLiteralScales(t *testing.T) {
 					),
 				)
 
-				if i <= scale {
+				// The literal without a type annotation is inferred to be UFix64,
+				// which might have a different scale than the tested type
+
+				expectedErrorCount := 0
+				if i > scale {
+					expectedErrorCount++
+				}
+				if i > sema.Fix64Scale {
+					expectedErrorCount++
+				}
+
+				if expectedErrorCount == 0 {
 					assert.NoError(t, err)
 				} else {
-					errs := ExpectCheckerErrors(t, err, 2)
+					errs := ExpectCheckerErrors(t, err, expectedErrorCount)
 
-					assert.IsType(t, &sema.InvalidFixedPointLiteralScaleError{}, errs[0])
-					assert.IsType(t, &sema.InvalidFixedPointLiteralScaleError{}, errs[1])
+					for _, err := range errs {
+						assert.IsType(t, &sema.InvalidFixedPointLiteralScaleError{}, err)
+					}
 				}
 			}
 		})
@@ -710,3 +722,38 @@ func TestCheckFixedPointMinMax(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckFixedPointFromString(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(t *testing.T, ty sema.Type) {
+
+		checker, err := ParseAndCheck(t,
+			fmt.Sprintf(
+				`
+				  let x = %s.fromString("1.0")
+				`,
+				ty,
+			),
+		)
+		require.NoError(t, err)
+
+		require.Equal(t,
+			&sema.OptionalType{Type: ty},
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+	}
+
+	for _, ty := range sema.AllFixedPointTypes {
+		// Only test leaf types
+		switch ty {
+		case sema.FixedPointType, sema.SignedFixedPointType:
+			continue
+		}
+
+		t.Run(ty.String(), func(t *testing.T) {
+			test(t, ty)
+		})
+	}
+}
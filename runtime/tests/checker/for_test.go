@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/cadence/runtime/sema"
 )
@@ -71,6 +72,29 @@ func TestCheckForEmpty(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCheckForRange(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      let xs: [UInt8] = []
+
+      fun test() {
+          for x in (1 as UInt8)..<10 {
+              xs.append(x)
+          }
+          for i, x in (1 as UInt8)...10 {
+              xs.append(x)
+          }
+      }
+    `)
+
+	require.NoError(t, err)
+
+	forStatements := checker.Program.FunctionDeclarations()[0].FunctionBlock.Block.Statements
+	require.Len(t, forStatements, 2)
+}
+
 func TestCheckInvalidForValueNonArray(t *testing.T) {
 
 	t.Parallel()
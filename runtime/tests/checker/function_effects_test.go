@@ -0,0 +1,271 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/stdlib"
+	"github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func functionDeclarationEffects(t *testing.T, checker *sema.Checker, name string) sema.FunctionEffects {
+	for _, declaration := range checker.Program.FunctionDeclarations() {
+		if declaration.Identifier.Identifier == name {
+			return checker.Elaboration.FunctionDeclarationEffects(declaration)
+		}
+	}
+
+	require.FailNow(t, "missing function declaration", name)
+	return sema.FunctionEffectsNone
+}
+
+func TestCheckFunctionEffects(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("none", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+            fun test(): Int {
+                let xs = [1]
+                xs.append(2)
+                return xs.length
+            }
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.FunctionEffectsNone,
+			functionDeclarationEffects(t, checker, "test"),
+		)
+	})
+
+	t.Run("emit", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+            event Foo()
+
+            fun test() {
+                emit Foo()
+            }
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.FunctionEffectEmitEvent,
+			functionDeclarationEffects(t, checker, "test"),
+		)
+	})
+
+	t.Run("log", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheckWithOptions(t,
+			`
+              fun test() {
+                  log(1)
+              }
+            `,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithPredeclaredValues(
+						stdlib.StandardLibraryFunctions{
+							stdlib.LogFunction,
+						}.ToSemaValueDeclarations(),
+					),
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.FunctionEffectLog,
+			functionDeclarationEffects(t, checker, "test"),
+		)
+	})
+
+	t.Run("storage write", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheckAccount(t, `
+            fun write() {
+                authAccount.save(1, to: /storage/one)
+            }
+
+            fun read(): Int? {
+                return authAccount.copy<Int>(from: /storage/one)
+            }
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.FunctionEffectWriteStorage,
+			functionDeclarationEffects(t, checker, "write"),
+		)
+		assert.Equal(t,
+			sema.FunctionEffectsNone,
+			functionDeclarationEffects(t, checker, "read"),
+		)
+	})
+
+	t.Run("transitive", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+            event Foo()
+
+            struct S {
+                fun foo() {
+                    emit Foo()
+                }
+            }
+
+            fun bar() {
+                S().foo()
+            }
+
+            fun baz() {
+                bar()
+                baz()
+            }
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.FunctionEffectEmitEvent,
+			functionDeclarationEffects(t, checker, "baz"),
+		)
+	})
+
+	t.Run("initializer", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+            event Foo()
+
+            struct S {
+                init() {
+                    emit Foo()
+                }
+            }
+
+            fun test() {
+                S()
+            }
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.FunctionEffectEmitEvent,
+			functionDeclarationEffects(t, checker, "test"),
+		)
+
+		compositeDeclaration := checker.Program.CompositeDeclarations()[1]
+		initializer := compositeDeclaration.Members.Initializers()[0]
+
+		assert.Equal(t,
+			sema.FunctionEffectEmitEvent,
+			checker.Elaboration.SpecialFunctionDeclarationEffects(initializer),
+		)
+	})
+
+	t.Run("imported contract", func(t *testing.T) {
+
+		t.Parallel()
+
+		importedChecker, err := ParseAndCheckWithOptions(t,
+			`
+              pub contract C {
+                  pub fun foo() {}
+              }
+            `,
+			ParseAndCheckOptions{
+				Location: utils.ImportedLocation,
+			},
+		)
+		require.NoError(t, err)
+
+		checker, err := ParseAndCheckWithOptions(t,
+			`
+              import C from "imported"
+
+              pub contract D {
+                  pub fun bar() {}
+              }
+
+              fun test() {
+                  C.foo()
+              }
+
+              fun local() {
+                  D.bar()
+              }
+            `,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithImportHandler(
+						func(_ *sema.Checker, _ common.Location, _ ast.Range) (sema.Import, error) {
+							return sema.ElaborationImport{
+								Elaboration: importedChecker.Elaboration,
+							}, nil
+						},
+					),
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.FunctionEffectCallImportedContract,
+			functionDeclarationEffects(t, checker, "test"),
+		)
+		assert.Equal(t,
+			sema.FunctionEffectsNone,
+			functionDeclarationEffects(t, checker, "local"),
+		)
+	})
+}
+
+func TestFunctionEffects_Has(t *testing.T) {
+
+	t.Parallel()
+
+	effects := sema.FunctionEffectEmitEvent | sema.FunctionEffectLog
+
+	assert.True(t, effects.Has(sema.FunctionEffectEmitEvent))
+	assert.True(t, effects.Has(sema.FunctionEffectLog))
+	assert.True(t, effects.Has(sema.FunctionEffectEmitEvent|sema.FunctionEffectLog))
+	assert.False(t, effects.Has(sema.FunctionEffectWriteStorage))
+	assert.True(t, effects.Has(sema.FunctionEffectsNone))
+}
@@ -0,0 +1,398 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func TestCheckGenericCompositeDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("struct", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct Box<T: AnyStruct> {
+              let value: T
+
+              init(value: T) {
+                  self.value = value
+              }
+
+              fun get(): T {
+                  return self.value
+              }
+          }
+
+          let box: Box<Int> = Box<Int>(value: 1)
+          let value = box.value
+          let value2 = box.get()
+        `)
+		require.NoError(t, err)
+
+		boxType := RequireGlobalValue(t, checker.Elaboration, "box")
+		require.IsType(t, &sema.CompositeType{}, boxType)
+		compositeType := boxType.(*sema.CompositeType)
+
+		assert.Equal(t, "Box<Int>", compositeType.String())
+		assert.Equal(t, common.TypeID("S.test.Box<Int>"), compositeType.ID())
+		assert.Equal(t, []sema.Type{sema.IntType}, compositeType.TypeArguments())
+
+		assert.Equal(t,
+			sema.IntType,
+			RequireGlobalValue(t, checker.Elaboration, "value"),
+		)
+		assert.Equal(t,
+			sema.IntType,
+			RequireGlobalValue(t, checker.Elaboration, "value2"),
+		)
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource Vault<T: AnyStruct> {
+              let data: T
+
+              init(data: T) {
+                  self.data = data
+              }
+          }
+
+          fun test(): @Vault<String> {
+              return <-create Vault<String>(data: "hello")
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("resource type parameter", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          resource Holder<T: AnyResource> {
+              let inner: @T
+
+              init(inner: @T) {
+                  self.inner <- inner
+              }
+
+              destroy() {
+                  destroy self.inner
+              }
+          }
+
+          fun test(): @Holder<@R> {
+              return <-create Holder(inner: <-create R())
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("multiple type parameters", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct Pair<A, B> {
+              let first: A
+              let second: B
+
+              init(first: A, second: B) {
+                  self.first = first
+                  self.second = second
+              }
+
+              fun swap(): Pair<B, A> {
+                  return Pair(first: self.second, second: self.first)
+              }
+          }
+
+          let pair = Pair(first: 1, second: "one").swap()
+          let first = pair.first
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			common.TypeID("S.test.Pair<String,Int>"),
+			RequireGlobalValue(t, checker.Elaboration, "pair").ID(),
+		)
+		assert.Equal(t,
+			sema.StringType,
+			RequireGlobalValue(t, checker.Elaboration, "first"),
+		)
+	})
+
+	t.Run("self", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Box<T> {
+              let value: T
+
+              init(value: T) {
+                  self.value = value
+              }
+
+              fun same(): Box<T> {
+                  return self
+              }
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("type argument inference", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct Box<T> {
+              let value: T
+
+              init(value: T) {
+                  self.value = value
+              }
+          }
+
+          let box = Box(value: "hello")
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			common.TypeID("S.test.Box<String>"),
+			RequireGlobalValue(t, checker.Elaboration, "box").ID(),
+		)
+	})
+
+	t.Run("used before declaration", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Holder {
+              let box: Box<Int>
+
+              init() {
+                  self.box = Box(value: 1)
+              }
+
+              fun get(): Int {
+                  return self.box.value
+              }
+          }
+
+          struct Box<T> {
+              let value: T
+
+              init(value: T) {
+                  self.value = value
+              }
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("nested in contract", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          contract C {
+              struct Box<T> {
+                  let value: T
+
+                  init(value: T) {
+                      self.value = value
+                  }
+              }
+          }
+
+          let box = C.Box<Bool>(value: true)
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			common.TypeID("S.test.C.Box<Bool>"),
+			RequireGlobalValue(t, checker.Elaboration, "box").ID(),
+		)
+	})
+}
+
+func TestCheckInvalidGenericCompositeDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("invalid kind", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract C<T> {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidCompositeTypeParametersError{}, errs[0])
+	})
+
+	t.Run("type argument mismatch", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Box<T> {
+              let value: T
+
+              init(value: T) {
+                  self.value = value
+              }
+          }
+
+          let box: Box<Int> = Box<String>(value: "hello")
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("argument mismatch", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Box<T> {
+              let value: T
+
+              init(value: T) {
+                  self.value = value
+              }
+          }
+
+          let box = Box<Int>(value: "hello")
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.TypeParameterTypeMismatchError{}, errs[0])
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[1])
+	})
+
+	t.Run("type bound", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Box<T: Integer> {}
+
+          let box: Box<String> = Box<String>()
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[1])
+	})
+
+	t.Run("type argument count", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Box<T> {}
+
+          let box: Box<Int, String>? = nil
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidTypeArgumentCountError{}, errs[0])
+	})
+
+	t.Run("non-generic", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {}
+
+          let s: S<Int>? = nil
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.UnparameterizedTypeInstantiationError{}, errs[0])
+	})
+
+	t.Run("invariant", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Box<T> {
+              let value: T
+
+              init(value: T) {
+                  self.value = value
+              }
+          }
+
+          let box: Box<AnyStruct> = Box<Int>(value: 1)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("infinitely expanding", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Box<T> {
+              let next: Box<[T]>?
+
+              init() {
+                  self.next = nil
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InfinitelyExpandingTypeError{}, errs[0])
+	})
+}
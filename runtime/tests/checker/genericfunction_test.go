@@ -162,7 +162,7 @@ func TestCheckGenericFunction(t *testing.T) {
 		require.IsType(t, &ast.InvocationExpression{}, variableDeclaration.Value)
 		invocationExpression := variableDeclaration.Value.(*ast.InvocationExpression)
 
-		typeArguments := checker.Elaboration.InvocationExpressionTypeArguments[invocationExpression]
+		typeArguments := checker.Elaboration.InvocationExpressionTypeArguments[invocationExpression.ID()]
 
 		ty, present := typeArguments.Get(typeParameter)
 		require.True(t, present, "could not find type argument for parameter %#+v", typeParameter)
@@ -212,7 +212,7 @@ func TestCheckGenericFunction(t *testing.T) {
 		require.IsType(t, &ast.InvocationExpression{}, variableDeclaration.Value)
 		invocationExpression := variableDeclaration.Value.(*ast.InvocationExpression)
 
-		typeArguments := checker.Elaboration.InvocationExpressionTypeArguments[invocationExpression]
+		typeArguments := checker.Elaboration.InvocationExpressionTypeArguments[invocationExpression.ID()]
 
 		ty, present := typeArguments.Get(typeParameter)
 		require.True(t, present, "could not find type argument for type parameter %#+v", typeParameter)
@@ -385,7 +385,7 @@ func TestCheckGenericFunction(t *testing.T) {
 		require.IsType(t, &ast.InvocationExpression{}, variableDeclaration.Value)
 		invocationExpression := variableDeclaration.Value.(*ast.InvocationExpression)
 
-		typeParameterTypes := checker.Elaboration.InvocationExpressionTypeArguments[invocationExpression]
+		typeParameterTypes := checker.Elaboration.InvocationExpressionTypeArguments[invocationExpression.ID()]
 
 		ty, present := typeParameterTypes.Get(typeParameter)
 		require.True(t, present, "could not find type argument for type parameter %#+v", typeParameter)
@@ -509,7 +509,7 @@ func TestCheckGenericFunction(t *testing.T) {
 		require.IsType(t, &ast.InvocationExpression{}, variableDeclaration.Value)
 		invocationExpression := variableDeclaration.Value.(*ast.InvocationExpression)
 
-		typeArguments := checker.Elaboration.InvocationExpressionTypeArguments[invocationExpression]
+		typeArguments := checker.Elaboration.InvocationExpressionTypeArguments[invocationExpression.ID()]
 
 		ty, present := typeArguments.Get(typeParameter)
 		require.True(t, present, "could not find type argument for type parameter %#+v", typeParameter)
@@ -568,7 +568,7 @@ func TestCheckGenericFunction(t *testing.T) {
 		require.IsType(t, &ast.InvocationExpression{}, variableDeclaration.Value)
 		invocationExpression := variableDeclaration.Value.(*ast.InvocationExpression)
 
-		typeArguments := checker.Elaboration.InvocationExpressionTypeArguments[invocationExpression]
+		typeArguments := checker.Elaboration.InvocationExpressionTypeArguments[invocationExpression.ID()]
 
 		ty, present := typeArguments.Get(typeParameter)
 		require.True(t, present, "could not find type argument for type parameter %#+v", typeParameter)
@@ -613,7 +613,7 @@ func TestCheckGenericFunction(t *testing.T) {
 		require.IsType(t, &ast.InvocationExpression{}, variableDeclaration.Value)
 		invocationExpression := variableDeclaration.Value.(*ast.InvocationExpression)
 
-		typeArguments := checker.Elaboration.InvocationExpressionTypeArguments[invocationExpression]
+		typeArguments := checker.Elaboration.InvocationExpressionTypeArguments[invocationExpression.ID()]
 
 		ty, present := typeArguments.Get(typeParameter)
 		require.True(t, present, "could not find type argument for type parameter %#+v", typeParameter)
@@ -937,3 +937,383 @@ func TestCheckUnparameterizedTypeInstantiationE(t *testing.T) {
 
 	assert.IsType(t, &sema.UnparameterizedTypeInstantiationError{}, errs[0])
 }
+
+func TestCheckGenericFunctionTypeParameterConstraints(t *testing.T) {
+
+	t.Parallel()
+
+	typeParameter := &sema.TypeParameter{
+		Name:      "T",
+		TypeBound: sema.AnyStructType,
+		Constraints: []sema.Type{
+			sema.IntegerType,
+		},
+	}
+
+	functionType := &sema.FunctionType{
+		TypeParameters: []*sema.TypeParameter{
+			typeParameter,
+		},
+		Parameters: []*sema.Parameter{
+			{
+				Label:      sema.ArgumentLabelNotRequired,
+				Identifier: "value",
+				TypeAnnotation: sema.NewTypeAnnotation(
+					&sema.GenericType{
+						TypeParameter: typeParameter,
+					},
+				),
+			},
+		},
+		ReturnTypeAnnotation: sema.NewTypeAnnotation(sema.VoidType),
+	}
+
+	t.Run("valid: inferred", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckWithTestValue(t,
+			`
+              let res = test(1)
+            `,
+			functionType,
+		)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("valid: explicit", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckWithTestValue(t,
+			`
+              let res = test<Int>(1)
+            `,
+			functionType,
+		)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid: inferred, constraint not satisfied", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckWithTestValue(t,
+			`
+              let res = test("1")
+            `,
+			functionType,
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		var typeMismatchError *sema.TypeMismatchError
+		require.ErrorAs(t, errs[0], &typeMismatchError)
+		assert.Equal(t, sema.IntegerType, typeMismatchError.ExpectedType)
+	})
+
+	t.Run("invalid: explicit, constraint not satisfied", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckWithTestValue(t,
+			`
+              let res = test<Bool>(true)
+            `,
+			functionType,
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("string", func(t *testing.T) {
+
+		t.Parallel()
+
+		assert.Equal(t,
+			"(<T: AnyStruct & Integer>(_ value: T): Void)",
+			functionType.String(),
+		)
+	})
+}
+
+func TestCheckGenericFunctionDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("identity, inferred type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          fun identity<T>(_ value: T): T {
+              return value
+          }
+
+          let x = identity(1)
+          let y = identity("1")
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.IntType,
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+
+		assert.Equal(t,
+			sema.StringType,
+			RequireGlobalValue(t, checker.Elaboration, "y"),
+		)
+
+		functionType := RequireGlobalValue(t, checker.Elaboration, "identity")
+
+		assert.Equal(t,
+			"(<T: AnyStruct>(_ value: T): T)",
+			functionType.String(),
+		)
+	})
+
+	t.Run("explicit type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          fun identity<T>(_ value: T): T {
+              return value
+          }
+
+          let x = identity<String>("1")
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.StringType,
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+	})
+
+	t.Run("type bound not satisfied", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun double<T: Integer>(_ value: T): [T] {
+              return [value, value]
+          }
+
+          let x = double("1")
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("conformance constraint", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct interface Comparable {
+              fun compare(_ other: AnyStruct): Int
+          }
+
+          struct Version: Comparable {
+              let number: Int
+
+              init(_ number: Int) {
+                  self.number = number
+              }
+
+              fun compare(_ other: AnyStruct): Int {
+                  return self.number - (other as! Version).number
+              }
+          }
+
+          fun max<T: AnyStruct & Comparable>(_ a: T, _ b: T): T {
+              if a.compare(b) >= 0 {
+                  return a
+              }
+              return b
+          }
+
+          let x = max(Version(1), Version(2))
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			"Version",
+			RequireGlobalValue(t, checker.Elaboration, "x").String(),
+		)
+
+		functionType := RequireGlobalValue(t, checker.Elaboration, "max")
+
+		assert.Equal(t,
+			"(<T: AnyStruct & Comparable>(_ a: T, _ b: T): T)",
+			functionType.String(),
+		)
+	})
+
+	t.Run("conformance constraint not satisfied", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface Comparable {
+              fun compare(_ other: AnyStruct): Int
+          }
+
+          fun max<T: AnyStruct & Comparable>(_ a: T, _ b: T): T {
+              if a.compare(b) >= 0 {
+                  return a
+              }
+              return b
+          }
+
+          let x = max(1, 2)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		var typeMismatchError *sema.TypeMismatchError
+		require.ErrorAs(t, errs[0], &typeMismatchError)
+		assert.Equal(t, "Comparable", typeMismatchError.ExpectedType.String())
+	})
+
+	t.Run("member not declared by type bounds", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test<T: AnyStruct>(_ value: T) {
+              value.compare(value)
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+	})
+
+	t.Run("generic value is subtype of type bound", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test<T: Integer>(_ value: T): Integer {
+              let integer: Integer = value
+              return integer
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("type bound is not subtype of generic type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test<T: Integer>(_ value: T): T {
+              return 1
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun identity<T: AnyResource>(_ value: @T): @T {
+              return <-value
+          }
+
+          fun test() {
+              let r <- identity(<-create R())
+              destroy r
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("resource loss", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test<T: AnyResource>(_ value: @T) {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ResourceLossError{}, errs[0])
+	})
+
+	t.Run("mixed resource and non-resource type bounds", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test<T: AnyStruct & AnyResource>() {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidTypeParameterBoundError{}, errs[0])
+	})
+
+	t.Run("type parameter not available outside of function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test<T>() {}
+
+          let x: T = 1
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+
+	t.Run("composite function", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct Box {
+              fun first<T: AnyStruct>(_ values: [T]): T? {
+                  if values.length == 0 {
+                      return nil
+                  }
+                  return values[0]
+              }
+          }
+
+          let x = Box().first([1, 2])
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.OptionalType{Type: sema.IntType},
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+	})
+}
@@ -201,7 +201,7 @@ func TestCheckInvalidRepeatedImport(t *testing.T) {
 
 	errs := ExpectCheckerErrors(t, err, 1)
 
-	assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+	assert.IsType(t, &sema.ImportCollisionError{}, errs[0])
 }
 
 func TestCheckImportResolutionSplit(t *testing.T) {
@@ -288,6 +288,74 @@ func TestCheckImportResolutionSplit(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestCheckInvalidImportResolvedIdentifier(t *testing.T) {
+
+	t.Parallel()
+
+	importedAddress := common.MustBytesToAddress([]byte{0x1})
+
+	importedChecker, err := ParseAndCheckWithOptions(t,
+		`
+          pub let y = 1
+        `,
+		ParseAndCheckOptions{
+			Location: common.AddressLocation{
+				Address: importedAddress,
+				Name:    "x",
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	// The location handler resolves the identifier location to an address location
+	// and synthesizes an identifier, which has no position in the program
+
+	_, err = ParseAndCheckWithOptions(t,
+		`
+
+           import x
+        `,
+		ParseAndCheckOptions{
+			Options: []sema.Option{
+				sema.WithLocationHandler(
+					func(_ []ast.Identifier, location common.Location) ([]sema.ResolvedLocation, error) {
+						name := string(location.(common.IdentifierLocation))
+						return []sema.ResolvedLocation{
+							{
+								Location: common.AddressLocation{
+									Address: importedAddress,
+									Name:    name,
+								},
+								Identifiers: []ast.Identifier{
+									{Identifier: name},
+								},
+							},
+						}, nil
+					},
+				),
+				sema.WithImportHandler(
+					func(_ *sema.Checker, _ common.Location, _ ast.Range) (sema.Import, error) {
+						return sema.ElaborationImport{
+							Elaboration: importedChecker.Elaboration,
+						}, nil
+					},
+				),
+			},
+		},
+	)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	require.IsType(t, &sema.NotExportedError{}, errs[0])
+
+	notExportedErr := errs[0].(*sema.NotExportedError)
+	assert.Equal(t, "x", notExportedErr.Name)
+	assert.Equal(t,
+		ast.Position{Offset: 20, Line: 3, Column: 18},
+		notExportedErr.Pos,
+	)
+}
+
 func TestCheckImportAll(t *testing.T) {
 
 	t.Parallel()
@@ -581,6 +649,14 @@ func TestCheckInvalidImportCycleSelf(t *testing.T) {
 	errs = ExpectCheckerErrors(t, importedProgramError, 1)
 
 	require.IsType(t, &sema.CyclicImportsError{}, errs[0])
+
+	assert.Equal(t,
+		[]common.Location{
+			utils.TestLocation,
+			utils.TestLocation,
+		},
+		errs[0].(*sema.CyclicImportsError).Cycle,
+	)
 }
 
 func TestCheckInvalidImportCycleTwoLocations(t *testing.T) {
@@ -679,6 +755,15 @@ func TestCheckInvalidImportCycleTwoLocations(t *testing.T) {
 	errs = ExpectCheckerErrors(t, importedProgramError, 2)
 	require.IsType(t, &sema.CyclicImportsError{}, errs[0])
 	require.IsType(t, &sema.NotDeclaredError{}, errs[1])
+
+	assert.Equal(t,
+		[]common.Location{
+			common.StringLocation("odd"),
+			common.StringLocation("even"),
+			common.StringLocation("odd"),
+		},
+		errs[0].(*sema.CyclicImportsError).Cycle,
+	)
 }
 
 func TestCheckImportVirtual(t *testing.T) {
@@ -736,3 +821,287 @@ func TestCheckImportVirtual(t *testing.T) {
 
 	require.NoError(t, err)
 }
+
+func TestCheckImportAlias(t *testing.T) {
+
+	t.Parallel()
+
+	importedChecker, err := ParseAndCheckWithOptions(t,
+		`
+          pub let x = 1
+
+          pub fun y(): Int {
+              return 2
+          }
+
+          pub contract C {
+
+              pub resource R {}
+
+              pub fun createR(): @R {
+                  return <-create R()
+              }
+          }
+        `,
+		ParseAndCheckOptions{
+			Location: utils.ImportedLocation,
+		},
+	)
+
+	require.NoError(t, err)
+
+	check := func(code string) (*sema.Checker, error) {
+		return ParseAndCheckWithOptions(t,
+			code,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithImportHandler(
+						func(_ *sema.Checker, _ common.Location, _ ast.Range) (sema.Import, error) {
+							return sema.ElaborationImport{
+								Elaboration: importedChecker.Elaboration,
+							}, nil
+						},
+					),
+				},
+			},
+		)
+	}
+
+	t.Run("value", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := check(`
+          import x as a, y as b from "imported"
+
+          pub let c: Int = a + b()
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.IntType,
+			RequireGlobalValue(t, checker.Elaboration, "c"),
+		)
+	})
+
+	t.Run("type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := check(`
+          import C as D from "imported"
+
+          pub fun test(): @D.R {
+              return <-D.createR()
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("original name is not declared", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := check(`
+          import x as a from "imported"
+
+          pub let c = x
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+
+	t.Run("same name imported under different aliases", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := check(`
+          import x as a from "imported"
+          import x as b from "imported"
+
+          pub let c = a + b
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("alias of missing declaration", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := check(`
+          import z as a from "imported"
+
+          pub let c = a
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotExportedError{}, errs[0])
+	})
+
+	t.Run("collision of aliases", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := check(`
+          import x as a from "imported"
+          import y as a from "imported"
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		var collisionErr *sema.ImportCollisionError
+		require.ErrorAs(t, errs[0], &collisionErr)
+
+		assert.Equal(t, "a", collisionErr.Name)
+		assert.Equal(t, "y", collisionErr.ImportedName)
+		assert.Equal(t,
+			ast.Position{Offset: 58, Line: 3, Column: 17},
+			collisionErr.StartPos,
+		)
+		require.Len(t, collisionErr.ErrorNotes(), 1)
+	})
+
+	t.Run("collision of type and value", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := check(`
+          import C from "imported"
+          import C as D from "imported"
+          import C as D from "imported"
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ImportCollisionError{}, errs[0])
+	})
+
+	t.Run("collision with local declaration", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := check(`
+          import x as C from "imported"
+
+          pub struct C {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+	})
+}
+
+func TestCheckImportedCompositeConstructorArgumentLabels(t *testing.T) {
+
+	t.Parallel()
+
+	importedChecker, err := ParseAndCheckWithOptions(t,
+		`
+          pub struct S {
+              pub let a: Int
+              pub let b: Int
+
+              init(a: Int, with b: Int = 2) {
+                  self.a = a
+                  self.b = b
+              }
+          }
+
+          pub contract C {
+
+              pub struct N {
+                  pub let x: Int
+
+                  init(_ x: Int) {
+                      self.x = x
+                  }
+              }
+          }
+        `,
+		ParseAndCheckOptions{
+			Location: utils.ImportedLocation,
+		},
+	)
+	require.NoError(t, err)
+
+	check := func(code string) error {
+		_, err := ParseAndCheckWithOptions(t,
+			code,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithImportHandler(
+						func(_ *sema.Checker, _ common.Location, _ ast.Range) (sema.Import, error) {
+							return sema.ElaborationImport{
+								Elaboration: importedChecker.Elaboration,
+							}, nil
+						},
+					),
+				},
+			},
+		)
+		return err
+	}
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := check(`
+          import S, C from "imported"
+
+          let s1 = S(a: 1, with: 2)
+          let s2 = S(a: 1)
+          let n = C.N(3)
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("missing label", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := check(`
+          import S from "imported"
+
+          let s = S(1)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingArgumentLabelError{}, errs[0])
+	})
+
+	t.Run("incorrect label", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := check(`
+          import S from "imported"
+
+          let s = S(a: 1, b: 2)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.IncorrectArgumentLabelError{}, errs[0])
+	})
+
+	t.Run("nested, superfluous label", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := check(`
+          import C from "imported"
+
+          let n = C.N(x: 3)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.IncorrectArgumentLabelError{}, errs[0])
+	})
+}
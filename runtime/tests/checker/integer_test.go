@@ -19,6 +19,7 @@
 package checker
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 	"testing"
@@ -26,6 +27,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/sema"
 )
 
@@ -403,6 +405,87 @@ func TestCheckInvalidTooLongAddress(t *testing.T) {
 	assert.IsType(t, &sema.InvalidAddressLiteralError{}, errs[1])
 }
 
+func TestCheckAddressValidator(t *testing.T) {
+
+	t.Parallel()
+
+	validator := func(address common.Address) error {
+		if address[0] != 0 {
+			return errors.New("address not in network")
+		}
+		return nil
+	}
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckWithOptions(t,
+			`
+              let a: Address = 0x1
+              let b = Address(0x2)
+            `,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithAddressValidator(validator),
+				},
+			},
+		)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckWithOptions(t,
+			`
+              let a: Address = 0x1000000000000001
+              let b = Address(0x1000000000000002)
+            `,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithAddressValidator(validator),
+				},
+			},
+		)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		require.IsType(t, &sema.InvalidAddressError{}, errs[0])
+		assert.Equal(t,
+			common.Address{0x10, 0, 0, 0, 0, 0, 0, 0x1},
+			errs[0].(*sema.InvalidAddressError).Address,
+		)
+		assert.IsType(t, &sema.InvalidAddressError{}, errs[1])
+	})
+}
+
+func TestCheckAddressParse(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+        let a = Address.parse("0x1")
+        let b = a!.toString()
+    `)
+
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		&sema.OptionalType{
+			Type: &sema.AddressType{},
+		},
+		RequireGlobalValue(t, checker.Elaboration, "a"),
+	)
+
+	assert.Equal(t,
+		sema.StringType,
+		RequireGlobalValue(t, checker.Elaboration, "b"),
+	)
+}
+
 func TestCheckSignedIntegerNegate(t *testing.T) {
 
 	t.Parallel()
@@ -581,3 +664,38 @@ func TestCheckIntegerMinMax(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckIntegerFromString(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(t *testing.T, ty sema.Type) {
+
+		checker, err := ParseAndCheck(t,
+			fmt.Sprintf(
+				`
+				  let x = %s.fromString("1")
+				`,
+				ty,
+			),
+		)
+		require.NoError(t, err)
+
+		require.Equal(t,
+			&sema.OptionalType{Type: ty},
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+	}
+
+	for _, ty := range sema.AllIntegerTypes {
+		// Only test leaf types
+		switch ty {
+		case sema.IntegerType, sema.SignedIntegerType:
+			continue
+		}
+
+		t.Run(ty.String(), func(t *testing.T) {
+			test(t, ty)
+		})
+	}
+}
@@ -20,6 +20,7 @@ package checker
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -1918,6 +1919,93 @@ func BenchmarkCheckContractInterfaceFungibleTokenConformance(b *testing.B) {
 	}
 }
 
+// manyInterfaceConformancesCode returns a program with the given number of interfaces,
+// and the given number of structures, which each conform to all interfaces
+//
+func manyInterfaceConformancesCode(interfaceCount int, compositeCount int) string {
+	var builder strings.Builder
+
+	interfaceNames := make([]string, interfaceCount)
+
+	for i := 0; i < interfaceCount; i++ {
+		interfaceNames[i] = fmt.Sprintf("I%d", i)
+
+		_, _ = fmt.Fprintf(&builder,
+			`
+              pub struct interface I%[1]d {
+                  pub let field%[1]d: Int
+                  pub fun get%[1]d(): Int
+                  pub fun set%[1]d(_ value: Int)
+              }
+            `,
+			i,
+		)
+	}
+
+	for i := 0; i < compositeCount; i++ {
+		_, _ = fmt.Fprintf(&builder,
+			"\npub struct S%d: %s {\n",
+			i,
+			strings.Join(interfaceNames, ", "),
+		)
+
+		for j := 0; j < interfaceCount; j++ {
+			_, _ = fmt.Fprintf(&builder,
+				`
+                  pub let field%[1]d: Int
+                  pub fun get%[1]d(): Int { return self.field%[1]d }
+                  pub fun set%[1]d(_ value: Int) {}
+                `,
+				j,
+			)
+		}
+
+		builder.WriteString("\ninit() {\n")
+		for j := 0; j < interfaceCount; j++ {
+			_, _ = fmt.Fprintf(&builder, "self.field%d = %d\n", j, j)
+		}
+		builder.WriteString("}\n}\n")
+	}
+
+	return builder.String()
+}
+
+func TestCheckManyInterfaceConformances(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, manyInterfaceConformancesCode(10, 10))
+	require.NoError(t, err)
+}
+
+func BenchmarkCheckManyInterfaceConformances(b *testing.B) {
+
+	program, err := parser.ParseProgram(manyInterfaceConformancesCode(20, 20), nil)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		checker, err := sema.NewChecker(
+			program,
+			TestLocation,
+			nil,
+			false,
+			sema.WithAccessCheckMode(sema.AccessCheckModeNotSpecifiedUnrestricted),
+		)
+		if err != nil {
+			b.Fatal(err)
+		}
+		err = checker.Check()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 func TestCheckContractInterfaceFungibleTokenUse(t *testing.T) {
 
 	t.Parallel()
@@ -0,0 +1,226 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func TestCheckTypeNestingDepthLimit(t *testing.T) {
+
+	t.Parallel()
+
+	check := func(t *testing.T, code string) error {
+		_, err := ParseAndCheckWithOptions(t,
+			code,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithMaxTypeNestingDepth(3),
+				},
+			},
+		)
+		return err
+	}
+
+	t.Run("within limit", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := check(t, `
+          let xs: [[Int]] = []
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("limit reached", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := check(t, `
+          let xs: [{String: [Int?]}] = []
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeNestingDepthLimitReachedError{}, errs[0])
+		assert.Equal(t, 3, errs[0].(*sema.TypeNestingDepthLimitReachedError).Limit)
+	})
+}
+
+func TestCheckRestrictionCountLimit(t *testing.T) {
+
+	t.Parallel()
+
+	check := func(t *testing.T, restrictions string) error {
+		_, err := ParseAndCheckWithOptions(t,
+			`
+              struct interface I1 {}
+              struct interface I2 {}
+              struct interface I3 {}
+
+              struct S: I1, I2, I3 {}
+
+              let s: S{`+restrictions+`} = S()
+            `,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithMaxRestrictionCount(2),
+				},
+			},
+		)
+		return err
+	}
+
+	t.Run("within limit", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := check(t, "I1, I2")
+		require.NoError(t, err)
+	})
+
+	t.Run("limit reached", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := check(t, "I1, I2, I3")
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.RestrictionCountLimitReachedError{}, errs[0])
+
+		limitErr := errs[0].(*sema.RestrictionCountLimitReachedError)
+		assert.Equal(t, 3, limitErr.Count)
+		assert.Equal(t, 2, limitErr.Limit)
+	})
+}
+
+func TestCheckParameterCountLimit(t *testing.T) {
+
+	t.Parallel()
+
+	check := func(t *testing.T, code string) error {
+		_, err := ParseAndCheckWithOptions(t,
+			code,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithMaxParameterCount(2),
+				},
+			},
+		)
+		return err
+	}
+
+	t.Run("within limit", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := check(t, `
+          fun test(a: Int, b: Int): ((Int, Int): Int) {
+              return fun (c: Int, d: Int): Int {
+                  return a + b + c + d
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("function declaration", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := check(t, `
+          fun test(a: Int, b: Int, c: Int) {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.ParameterCountLimitReachedError{}, errs[0])
+
+		limitErr := errs[0].(*sema.ParameterCountLimitReachedError)
+		assert.Equal(t, 3, limitErr.Count)
+		assert.Equal(t, 2, limitErr.Limit)
+	})
+
+	t.Run("function type", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := check(t, `
+          let f: ((Int, Int, Int): Void)? = nil
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.ParameterCountLimitReachedError{}, errs[0])
+	})
+}
+
+func TestCheckRecursionDepthLimit(t *testing.T) {
+
+	t.Parallel()
+
+	check := func(t *testing.T, code string) error {
+		_, err := ParseAndCheckWithOptions(t,
+			code,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithMaxRecursionDepth(4),
+				},
+			},
+		)
+		return err
+	}
+
+	t.Run("within limit", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := check(t, `
+          fun test(): Int {
+              return 1 + 2
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("limit reached", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := check(t, `
+          fun test(): Int {
+              return ((1 + 2) + 3) + ((4 + 5) + 6)
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.RecursionDepthLimitReachedError{}, errs[0])
+		assert.Equal(t, 4, errs[0].(*sema.RecursionDepthLimitReachedError).Limit)
+	})
+}
@@ -426,6 +426,116 @@ func TestCheckSaturatedArithmeticFunctions(t *testing.T) {
 	}
 }
 
+func TestCheckCheckedArithmeticFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	type testCase struct {
+		ty                              sema.Type
+		add, subtract, multiply, divide bool
+	}
+
+	testCases := []testCase{
+		{
+			ty:       sema.IntType,
+			add:      false,
+			subtract: false,
+			multiply: false,
+			divide:   true,
+		},
+		{
+			ty:       sema.UIntType,
+			add:      false,
+			subtract: true,
+			multiply: false,
+			divide:   true,
+		},
+		{
+			ty:       sema.IntegerType,
+			add:      false,
+			subtract: false,
+			multiply: false,
+			divide:   false,
+		},
+	}
+
+	for _, ty := range append(
+		sema.AllSignedIntegerTypes[:],
+		sema.AllSignedFixedPointTypes...,
+	) {
+
+		if ty == sema.IntType {
+			continue
+		}
+
+		testCases = append(testCases, testCase{
+			ty:       ty,
+			add:      true,
+			subtract: true,
+			multiply: true,
+			divide:   true,
+		})
+	}
+
+	for _, ty := range append(
+		sema.AllUnsignedIntegerTypes[:],
+		sema.AllUnsignedFixedPointTypes...,
+	) {
+
+		if ty == sema.UIntType {
+			continue
+		}
+
+		// Word types wrap around instead of overflowing,
+		// so only division may fail
+
+		isWord := strings.HasPrefix(ty.String(), "Word")
+
+		testCases = append(testCases, testCase{
+			ty:       ty,
+			add:      !isWord,
+			subtract: !isWord,
+			multiply: !isWord,
+			divide:   true,
+		})
+	}
+
+	test := func(ty sema.Type, method string, expected bool) {
+
+		method = fmt.Sprintf("checked%s", method)
+
+		t.Run(fmt.Sprintf("%s %s", ty, method), func(t *testing.T) {
+
+			_, err := ParseAndCheckWithPanic(t,
+				fmt.Sprintf(
+					`
+                      fun test(a: %[1]s, b: %[1]s): %[1]s? {
+                          return a.%[2]s(b)
+                      }
+                    `,
+					ty,
+					method,
+				),
+			)
+
+			if expected {
+				require.NoError(t, err)
+			} else {
+				errs := ExpectCheckerErrors(t, err, 1)
+
+				assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+			}
+		})
+	}
+
+	for _, testCase := range testCases {
+		test(testCase.ty, "Add", testCase.add)
+		test(testCase.ty, "Subtract", testCase.subtract)
+		test(testCase.ty, "Multiply", testCase.multiply)
+		test(testCase.ty, "Divide", testCase.divide)
+	}
+}
+
 func TestCheckInvalidCompositeEquality(t *testing.T) {
 
 	t.Parallel()
@@ -537,6 +647,8 @@ func TestCheckNumericSuperTypeBinaryOperations(t *testing.T) {
 			ast.OperationLessEqual,
 			ast.OperationGreater,
 			ast.OperationGreaterEqual,
+			ast.OperationRange,
+			ast.OperationInclusiveRange,
 		}
 
 		for _, supertype := range supertypes {
@@ -588,3 +700,141 @@ func TestCheckNumericSuperTypeBinaryOperations(t *testing.T) {
 		}
 	})
 }
+
+func TestCheckRangeOperations(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("half-open", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let r = 1..<10
+          let start = r.start
+          let end = r.end
+          let contains = r.contains(5)
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.TypeID("Range<Int>"),
+			RequireGlobalValue(t, checker.Elaboration, "r").ID(),
+		)
+		assert.Equal(t, sema.IntType, RequireGlobalValue(t, checker.Elaboration, "start"))
+		assert.Equal(t, sema.IntType, RequireGlobalValue(t, checker.Elaboration, "end"))
+		assert.Equal(t, sema.BoolType, RequireGlobalValue(t, checker.Elaboration, "contains"))
+	})
+
+	t.Run("inclusive", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let r = 1...10
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.TypeID("InclusiveRange<Int>"),
+			RequireGlobalValue(t, checker.Elaboration, "r").ID(),
+		)
+	})
+
+	t.Run("precedence", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let a: Int? = nil
+          let r: Range<Int> = a ?? 0..<1 + 2
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("type annotation", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let r: InclusiveRange<UInt8> = 1...10
+          let start = r.start
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t, sema.UInt8Type, RequireGlobalValue(t, checker.Elaboration, "start"))
+	})
+
+	t.Run("half-open and inclusive are different types", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let r: Range<Int> = 1...10
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("invalid element type bound", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let r: Range<String>? = nil
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("invalid operands", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let r = "a"..<"z"
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidBinaryOperandsError{}, errs[0])
+	})
+
+	t.Run("mismatched operands", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let a: Int = 1
+          let b: UInt8 = 10
+          let r = a..<b
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidBinaryOperandsError{}, errs[0])
+	})
+
+	t.Run("not storable", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract C {
+              let r: Range<Int>
+
+              init() {
+                  self.r = 0..<1
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.FieldTypeNotStorableError{}, errs[0])
+	})
+}
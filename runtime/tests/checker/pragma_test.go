@@ -97,3 +97,265 @@ func TestCheckPragmaInvalidInvocationExprTypeArgs(t *testing.T) {
 	errs := ExpectCheckerErrors(t, err, 1)
 	assert.IsType(t, &sema.InvalidPragmaError{Message: "type arguments not supported"}, errs[0])
 }
+
+func TestCheckPragmaInMembers(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("deprecated", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          pub contract C {
+
+              #deprecated("use bar")
+              pub fun foo() {}
+
+              #deprecated
+              pub let x: Int
+
+              pub fun bar() {}
+
+              #deprecated("use T")
+              pub struct S {}
+
+              init() {
+                  self.x = 1
+              }
+          }
+		`)
+		require.NoError(t, err)
+
+		contractType := RequireGlobalType(t, checker.Elaboration, "C").(*sema.CompositeType)
+
+		foo, ok := contractType.Members.Get("foo")
+		require.True(t, ok)
+		assert.True(t, foo.Deprecated)
+		assert.Equal(t, "use bar", foo.DeprecationMessage)
+
+		x, ok := contractType.Members.Get("x")
+		require.True(t, ok)
+		assert.True(t, x.Deprecated)
+		assert.Equal(t, "", x.DeprecationMessage)
+
+		bar, ok := contractType.Members.Get("bar")
+		require.True(t, ok)
+		assert.False(t, bar.Deprecated)
+
+		s, ok := contractType.Members.Get("S")
+		require.True(t, ok)
+		assert.True(t, s.Deprecated)
+		assert.Equal(t, "use T", s.DeprecationMessage)
+	})
+
+	t.Run("deprecated in interface", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          pub struct interface I {
+
+              #deprecated("use bar")
+              pub fun foo()
+          }
+		`)
+		require.NoError(t, err)
+
+		interfaceType := RequireGlobalType(t, checker.Elaboration, "I").(*sema.InterfaceType)
+
+		foo, ok := interfaceType.Members.Get("foo")
+		require.True(t, ok)
+		assert.True(t, foo.Deprecated)
+		assert.Equal(t, "use bar", foo.DeprecationMessage)
+	})
+
+	t.Run("unsupported pragma", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          pub struct S {
+
+              #version("1.0")
+              pub fun foo() {}
+          }
+		`)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+		assert.IsType(t, &sema.InvalidPragmaError{}, errs[0])
+	})
+
+	t.Run("deprecated without declaration", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          pub struct S {
+              pub fun foo() {}
+
+              #deprecated
+          }
+		`)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+		assert.IsType(t, &sema.InvalidPragmaError{}, errs[0])
+	})
+
+	t.Run("deprecated with too many arguments", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          pub struct S {
+
+              #deprecated("a", "b")
+              pub fun foo() {}
+          }
+		`)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+		assert.IsType(t, &sema.InvalidPragmaError{}, errs[0])
+	})
+}
+
+func TestCheckPragmaCadenceVersion(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("no version", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          pub let x = 1
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t, sema.DefaultLanguageVersion, checker.LanguageVersion())
+	})
+
+	t.Run("valid version", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          #cadence(version: "0.25")
+
+          pub let x = 1
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.LanguageVersion{Major: 0, Minor: 25},
+			checker.LanguageVersion(),
+		)
+	})
+
+	t.Run("newer than latest version", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          #cadence(version: "0.99")
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		var versionErr *sema.UnsupportedLanguageVersionError
+		require.ErrorAs(t, errs[0], &versionErr)
+		assert.Equal(t, sema.LanguageVersion{Major: 0, Minor: 99}, versionErr.Version)
+		assert.Equal(t, sema.LatestLanguageVersion, versionErr.MaxVersion)
+
+		assert.Equal(t, sema.DefaultLanguageVersion, checker.LanguageVersion())
+	})
+
+	t.Run("newer than max version", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckWithOptions(t,
+			`
+              #cadence(version: "0.25")
+            `,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithMaxLanguageVersion(sema.LanguageVersion{Major: 0, Minor: 24}),
+				},
+			},
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		var versionErr *sema.UnsupportedLanguageVersionError
+		require.ErrorAs(t, errs[0], &versionErr)
+		assert.Equal(t, sema.LanguageVersion{Major: 0, Minor: 24}, versionErr.MaxVersion)
+	})
+
+	t.Run("invalid version", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          #cadence(version: "latest")
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+		assert.IsType(t, &sema.InvalidPragmaError{}, errs[0])
+	})
+
+	t.Run("missing label", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          #cadence("0.25")
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+		assert.IsType(t, &sema.InvalidPragmaError{}, errs[0])
+	})
+
+	t.Run("duplicate", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          #cadence(version: "0.24")
+          #cadence(version: "0.25")
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+		assert.IsType(t, &sema.InvalidPragmaError{}, errs[0])
+	})
+
+	t.Run("feature gating", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+          pub typealias A = [B]
+
+          pub typealias B = Int
+        `
+
+		// Lazy type alias resolution is not enabled by default
+
+		_, err := ParseAndCheck(t, code)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+
+		// Lazy type alias resolution is enabled by declaring a version which supports it
+
+		checker, err := ParseAndCheck(t, `#cadence(version: "0.25")`+code)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.VariableSizedType{
+				Type: sema.IntType,
+			},
+			RequireGlobalType(t, checker.Elaboration, "A"),
+		)
+	})
+}
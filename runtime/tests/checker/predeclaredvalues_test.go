@@ -217,3 +217,108 @@ func TestCheckPredeclaredValues(t *testing.T) {
 		require.IsType(t, &sema.NotDeclaredError{}, errs[1])
 	})
 }
+
+func TestCheckPredeclaredTypes(t *testing.T) {
+
+	t.Parallel()
+
+	location1 := common.AddressLocation{
+		Address: common.MustBytesToAddress([]byte{0x1}),
+	}
+
+	location2 := common.AddressLocation{
+		Address: common.MustBytesToAddress([]byte{0x2}),
+	}
+
+	// Only predeclare a type 'Foo' for 0x2
+
+	predeclaredTypesOption := sema.WithPredeclaredTypes(
+		[]sema.TypeDeclaration{
+			stdlib.StandardLibraryType{
+				Name: "Foo",
+				Type: sema.IntType,
+				Kind: common.DeclarationKindType,
+				Available: func(location common.Location) bool {
+					addressLocation, ok := location.(common.AddressLocation)
+					return ok && addressLocation == location2
+				},
+			},
+		},
+	)
+
+	t.Run("available", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckWithOptions(t,
+			`let x: Foo = 1`,
+			ParseAndCheckOptions{
+				Location: location2,
+				Options: []sema.Option{
+					predeclaredTypesOption,
+				},
+			},
+		)
+		require.NoError(t, err)
+	})
+
+	t.Run("not available", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckWithOptions(t,
+			`let x: Foo = 1`,
+			ParseAndCheckOptions{
+				Location: location1,
+				Options: []sema.Option{
+					predeclaredTypesOption,
+				},
+			},
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+
+	t.Run("import", func(t *testing.T) {
+
+		t.Parallel()
+
+		importedChecker, err := ParseAndCheckWithOptions(t,
+			`let x: Foo = 1`,
+			ParseAndCheckOptions{
+				Location: location2,
+				Options: []sema.Option{
+					predeclaredTypesOption,
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		// The type is not exported by 0x2
+
+		_, err = ParseAndCheckWithOptions(t,
+			`
+              import Foo from 0x2
+            `,
+			ParseAndCheckOptions{
+				Location: location1,
+				Options: []sema.Option{
+					predeclaredTypesOption,
+					sema.WithImportHandler(
+						func(_ *sema.Checker, _ common.Location, _ ast.Range) (sema.Import, error) {
+							return sema.ElaborationImport{
+								Elaboration: importedChecker.Elaboration,
+							}, nil
+						},
+					),
+				},
+			},
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.NotExportedError{}, errs[0])
+	})
+}
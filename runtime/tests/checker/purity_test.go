@@ -0,0 +1,542 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func TestCheckPurityInvocation(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("view calling view", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            view fun foo(): Int { return 1 }
+
+            view fun bar(): Int { return foo() }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("view calling impure", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            fun foo(): Int { return 1 }
+
+            view fun bar(): Int { return foo() }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		var purityErr *sema.PurityError
+		require.ErrorAs(t, errs[0], &purityErr)
+		assert.Equal(t, sema.ImpureOperationInvocation, purityErr.Operation)
+	})
+
+	t.Run("impure calling impure", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            fun foo(): Int { return 1 }
+
+            fun bar(): Int { return foo() }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("view calling view builtin", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            view fun foo(_ s: String): String {
+                return s.concat("!").toLower()
+            }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("view calling impure builtin", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            view fun foo(_ xs: [Int]) {
+                xs.append(1)
+            }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.PurityError{}, errs[0])
+	})
+
+	t.Run("view calling impure function expression", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            view fun foo() {
+                let f = fun () {}
+                f()
+            }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.PurityError{}, errs[0])
+	})
+
+	t.Run("view calling view function expression", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            view fun foo() {
+                let f = view fun () {}
+                f()
+            }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("view calling constructor without initializer", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            struct S {}
+
+            view fun foo(): S {
+                return S()
+            }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("view calling view initializer", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            struct S {
+                let x: Int
+
+                view init(x: Int) {
+                    self.x = x
+                }
+            }
+
+            view fun foo(): S {
+                return S(x: 1)
+            }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("view calling impure initializer", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            struct S {
+                let x: Int
+
+                init(x: Int) {
+                    self.x = x
+                }
+            }
+
+            view fun foo(): S {
+                return S(x: 1)
+            }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.PurityError{}, errs[0])
+	})
+
+	t.Run("view calling storage write", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckAccount(t, `
+            view fun foo() {
+                authAccount.save(1, to: /storage/one)
+            }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.PurityError{}, errs[0])
+	})
+
+	t.Run("view calling storage read", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckAccount(t, `
+            view fun foo(): &Int? {
+                return authAccount.borrow<&Int>(from: /storage/one)
+            }
+        `)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckPurityWrite(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("local variable", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            view fun foo(): Int {
+                var x = 1
+                x = 2
+                return x
+            }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("local array", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            view fun foo(): [Int] {
+                let xs = [1, 2]
+                xs[0] = 3
+                return xs
+            }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("global variable", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            var x = 1
+
+            view fun foo() {
+                x = 2
+            }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		var purityErr *sema.PurityError
+		require.ErrorAs(t, errs[0], &purityErr)
+		assert.Equal(t, sema.ImpureOperationWrite, purityErr.Operation)
+	})
+
+	t.Run("captured variable", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            fun foo() {
+                var x = 1
+                let f = view fun () {
+                    x = 2
+                }
+            }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.PurityError{}, errs[0])
+	})
+
+	t.Run("self field", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            struct S {
+                var x: Int
+
+                init() {
+                    self.x = 1
+                }
+
+                view fun setX() {
+                    self.x = 2
+                }
+            }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.PurityError{}, errs[0])
+	})
+
+	t.Run("self field in view initializer", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            struct S {
+                var x: Int
+
+                view init() {
+                    self.x = 1
+                }
+            }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("through reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            struct S {
+                var x: Int
+
+                init() {
+                    self.x = 1
+                }
+            }
+
+            view fun foo(_ s: &S) {
+                let r = s
+                r.x = 2
+            }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.PurityError{}, errs[0])
+	})
+
+	t.Run("array element through reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            view fun foo(_ xs: &[Int]) {
+                xs[0] = 1
+            }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.PurityError{}, errs[0])
+	})
+
+	t.Run("swap", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            var x = 1
+
+            view fun foo() {
+                var y = 2
+                x <-> y
+            }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.PurityError{}, errs[0])
+	})
+}
+
+func TestCheckPurityEmit(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+        event Foo()
+
+        view fun foo() {
+            emit Foo()
+        }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	var purityErr *sema.PurityError
+	require.ErrorAs(t, errs[0], &purityErr)
+	assert.Equal(t, sema.ImpureOperationEmit, purityErr.Operation)
+}
+
+func TestCheckPurityDestroy(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+        resource R {}
+
+        view fun foo(_ r: @R) {
+            destroy r
+        }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	var purityErr *sema.PurityError
+	require.ErrorAs(t, errs[0], &purityErr)
+	assert.Equal(t, sema.ImpureOperationDestroy, purityErr.Operation)
+}
+
+func TestCheckPurityConformance(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("view implementation of view requirement", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            struct interface I {
+                view fun foo()
+            }
+
+            struct S: I {
+                view fun foo() {}
+            }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("view implementation of impure requirement", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            struct interface I {
+                fun foo()
+            }
+
+            struct S: I {
+                view fun foo() {}
+            }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("impure implementation of view requirement", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            struct interface I {
+                view fun foo()
+            }
+
+            struct S: I {
+                fun foo() {}
+            }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.ConformanceError{}, errs[0])
+	})
+}
+
+func TestCheckPuritySubtyping(t *testing.T) {
+
+	t.Parallel()
+
+	viewFunctionType := &sema.FunctionType{
+		Purity:               sema.FunctionPurityView,
+		ReturnTypeAnnotation: sema.NewTypeAnnotation(sema.VoidType),
+	}
+
+	impureFunctionType := &sema.FunctionType{
+		ReturnTypeAnnotation: sema.NewTypeAnnotation(sema.VoidType),
+	}
+
+	assert.True(t, sema.IsSubType(viewFunctionType, impureFunctionType))
+	assert.False(t, sema.IsSubType(impureFunctionType, viewFunctionType))
+	assert.False(t, viewFunctionType.Equal(impureFunctionType))
+	assert.Equal(t, "view ((): Void)", viewFunctionType.String())
+}
+
+func TestCheckViewFunctionEntryPoint(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("view", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+            pub view fun main(): Int {
+                return 1
+            }
+        `)
+		require.NoError(t, err)
+
+		isView, err := checker.Elaboration.IsViewFunctionEntryPoint()
+		require.NoError(t, err)
+		assert.True(t, isView)
+	})
+
+	t.Run("impure", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+            pub fun main(): Int {
+                return 1
+            }
+        `)
+		require.NoError(t, err)
+
+		isView, err := checker.Elaboration.IsViewFunctionEntryPoint()
+		require.NoError(t, err)
+		assert.False(t, isView)
+	})
+}
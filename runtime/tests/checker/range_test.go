@@ -139,12 +139,12 @@ func TestCheckRange(t *testing.T) {
 			},
 			{
 				Identifier:      "_TEST_Baz",
-				Type:            bazValueVariable.Type,
+				Type:            bazTypeVariable.Type,
 				DeclarationKind: common.DeclarationKindResource,
 			},
 			{
 				Identifier:      "_TEST_Baz",
-				Type:            bazTypeVariable.Type,
+				Type:            bazValueVariable.Type,
 				DeclarationKind: common.DeclarationKindResource,
 			},
 			{
@@ -197,12 +197,12 @@ func TestCheckRange(t *testing.T) {
 			},
 			{
 				Identifier:      "_TEST_Baz",
-				Type:            bazValueVariable.Type,
+				Type:            bazTypeVariable.Type,
 				DeclarationKind: common.DeclarationKindResource,
 			},
 			{
 				Identifier:      "_TEST_Baz",
-				Type:            bazTypeVariable.Type,
+				Type:            bazValueVariable.Type,
 				DeclarationKind: common.DeclarationKindResource,
 			},
 			{
@@ -245,12 +245,12 @@ func TestCheckRange(t *testing.T) {
 			},
 			{
 				Identifier:      "_TEST_Baz",
-				Type:            bazValueVariable.Type,
+				Type:            bazTypeVariable.Type,
 				DeclarationKind: common.DeclarationKindResource,
 			},
 			{
 				Identifier:      "_TEST_Baz",
-				Type:            bazTypeVariable.Type,
+				Type:            bazValueVariable.Type,
 				DeclarationKind: common.DeclarationKindResource,
 			},
 			{
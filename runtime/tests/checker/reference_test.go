@@ -1392,3 +1392,408 @@ func TestCheckReferenceTypeImplicitConformance(t *testing.T) {
 		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
 	})
 }
+
+func TestCheckReferenceEquality(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("same type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(a: &R, b: &R): Bool {
+              return a == b
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("different authorization", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(a: auth &R, b: &R): Bool {
+              return a == b
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("subtype", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource interface I {}
+
+          resource R: I {}
+
+          fun test(a: &R, b: &{I}): Bool {
+              return a != b
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("optional", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {}
+
+          fun test(a: &S?, b: &AnyStruct): Bool {
+              return a == b
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("unrelated types", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          resource S {}
+
+          fun test(a: &R, b: &S): Bool {
+              return a == b
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.InvalidBinaryOperandsError{}, errs[0])
+	})
+
+	t.Run("reference and referenced value", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {}
+
+          fun test(a: &S, b: S): Bool {
+              return a == b
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.InvalidBinaryOperandsError{}, errs[0])
+	})
+}
+
+func TestCheckDereference(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("struct", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct S {}
+
+          let s = S()
+          let ref = &s as &S
+          let copy = *ref
+        `)
+
+		require.NoError(t, err)
+
+		sType := RequireGlobalType(t, checker.Elaboration, "S")
+
+		assert.Equal(t,
+			sType,
+			RequireGlobalValue(t, checker.Elaboration, "copy"),
+		)
+	})
+
+	t.Run("array", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let xs = [1, 2, 3]
+          let ref = &xs as &[Int]
+          let copy = *ref
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.VariableSizedType{
+				Type: sema.IntType,
+			},
+			RequireGlobalValue(t, checker.Elaboration, "copy"),
+		)
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test() {
+              let r <- create R()
+              let ref = &r as &R
+              let copy = *ref
+              destroy r
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.ResourceDereferenceError{}, errs[0])
+	})
+
+	t.Run("non-reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let x = 1
+          let y = *x
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.NonReferenceTypeDereferenceError{}, errs[0])
+	})
+
+	t.Run("optional reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let xs = {"a": 1}
+          let ref: &Int? = &xs["a"] as &Int?
+          let y = *ref
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.NonReferenceTypeDereferenceError{}, errs[0])
+	})
+}
+
+func TestCheckInvalidatedReferenceUse(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("use before move", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {
+              let id: Int
+
+              init() {
+                  self.id = 1
+              }
+          }
+
+          fun test(): @[R] {
+              let r <- create R()
+              let ref = &r as &R
+              ref.id
+              return <-[<-r]
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("use after move", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {
+              let id: Int
+
+              init() {
+                  self.id = 1
+              }
+          }
+
+          fun test(): @[R] {
+              let r <- create R()
+              let ref = &r as &R
+              let rs <- [<-r]
+              ref.id
+              return <-rs
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.InvalidatedReferenceError{}, errs[0])
+	})
+
+	t.Run("use after destruction", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {
+              let id: Int
+
+              init() {
+                  self.id = 1
+              }
+          }
+
+          fun test() {
+              let r <- create R()
+              let ref = &r as &R
+              destroy r
+              ref.id
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.InvalidatedReferenceError{}, errs[0])
+	})
+
+	t.Run("use after potential move", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(_ rs: &[R], _ b: Bool) {
+              let r <- create R()
+              let ref = &r as &R
+              if b {
+                  rs.append(<-r)
+              } else {
+                  destroy r
+              }
+              ref.uuid
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.InvalidatedReferenceError{}, errs[0])
+	})
+
+	t.Run("nested reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test() {
+              let rs <- [<-create R()]
+              let ref = &rs[0] as &R
+              destroy rs
+              ref.uuid
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.InvalidatedReferenceError{}, errs[0])
+	})
+
+	t.Run("optional reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test() {
+              let rs <- {1: <-create R()}
+              let ref = &rs[1] as &R?
+              destroy rs
+              ref!.uuid
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.InvalidatedReferenceError{}, errs[0])
+	})
+
+	t.Run("reference copy", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test() {
+              let r <- create R()
+              let ref = &r as &R
+              let ref2 = ref
+              destroy r
+              ref2.uuid
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.InvalidatedReferenceError{}, errs[0])
+	})
+
+	t.Run("reassigned reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test() {
+              let r1 <- create R()
+              let r2 <- create R()
+              var ref = &r1 as &R
+              ref = &r2 as &R
+              destroy r1
+              ref.uuid
+              destroy r2
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("struct", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {}
+
+          fun test() {
+              let s = S()
+              let ref = &s as &S
+              let ss = [s]
+              ref.getType()
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+}
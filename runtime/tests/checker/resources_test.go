@@ -1705,17 +1705,31 @@ func TestCheckInvalidResourceReturnMissingMove(t *testing.T) {
 
 	t.Parallel()
 
-	_, err := ParseAndCheck(t, `
+	const code = `
       resource X {}
 
       fun test(): @X {
           return create X()
       }
-    `)
+    `
+
+	_, err := ParseAndCheck(t, code)
 
 	errs := ExpectCheckerErrors(t, err, 1)
 
-	assert.IsType(t, &sema.MissingMoveOperationError{}, errs[0])
+	var missingMoveErr *sema.MissingMoveOperationError
+	require.ErrorAs(t, errs[0], &missingMoveErr)
+
+	fixes := missingMoveErr.SuggestedFixes(code)
+	require.Len(t, fixes, 1)
+	require.Len(t, fixes[0].TextEdits, 1)
+
+	fixedCode := fixes[0].TextEdits[0].ApplyTo(code)
+
+	assert.Contains(t, fixedCode, "return <-create X()")
+
+	_, err = ParseAndCheck(t, fixedCode)
+	require.NoError(t, err)
 }
 
 func TestCheckInvalidResourceReturnMissingMoveInvalidReturnType(t *testing.T) {
@@ -1850,17 +1864,34 @@ func TestCheckInvalidResourceVariableDeclarationIncorrectTransfer(t *testing.T)
 
 	t.Parallel()
 
-	_, err := ParseAndCheck(t, `
+	const code = `
       resource X {}
 
       let x = create X()
       let y = x
-    `)
+    `
+
+	_, err := ParseAndCheck(t, code)
 
 	errs := ExpectCheckerErrors(t, err, 2)
 
 	assert.IsType(t, &sema.IncorrectTransferOperationError{}, errs[0])
 	assert.IsType(t, &sema.IncorrectTransferOperationError{}, errs[1])
+
+	// Apply the suggested fixes in reverse order,
+	// so the offsets of the earlier edits stay valid
+
+	fixedCode := code
+	for i := len(errs) - 1; i >= 0; i-- {
+		fixes := errs[i].(*sema.IncorrectTransferOperationError).SuggestedFixes(code)
+		require.Len(t, fixes, 1)
+		require.Len(t, fixes[0].TextEdits, 1)
+
+		fixedCode = fixes[0].TextEdits[0].ApplyTo(fixedCode)
+	}
+
+	_, err = ParseAndCheck(t, fixedCode)
+	require.NoError(t, err)
 }
 
 func TestCheckInvalidNonResourceVariableDeclarationMoveTransfer(t *testing.T) {
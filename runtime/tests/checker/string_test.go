@@ -292,6 +292,113 @@ func TestCheckStringEncodeHex(t *testing.T) {
 	)
 }
 
+func TestCheckStringTryDecodeHex(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+        let x = "01CADE".tryDecodeHex()
+	`)
+
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		&sema.OptionalType{Type: sema.ByteArrayType},
+		RequireGlobalValue(t, checker.Elaboration, "x"),
+	)
+}
+
+func TestCheckByteArrayToHexString(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("variable-sized", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+            let bytes: [UInt8] = [1, 2, 3]
+            let x = bytes.toHexString()
+	    `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.StringType,
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+	})
+
+	t.Run("constant-sized", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+            let bytes: [UInt8; 3] = [1, 2, 3]
+            let x = bytes.toHexString()
+	    `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.StringType,
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+	})
+
+	t.Run("invalid element type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            let ints: [Int] = [1, 2, 3]
+            let x = ints.toHexString()
+	    `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+	})
+}
+
+func TestCheckStringBase64(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+        let x = String.encodeBase64([1, 2, 3])
+        let y = x.decodeBase64()
+	`)
+
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		sema.StringType,
+		RequireGlobalValue(t, checker.Elaboration, "x"),
+	)
+
+	assert.Equal(t,
+		&sema.OptionalType{Type: sema.ByteArrayType},
+		RequireGlobalValue(t, checker.Elaboration, "y"),
+	)
+}
+
+func TestCheckStringFromUTF8(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+        let x = String.fromUTF8("abc".utf8)
+	`)
+
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		&sema.OptionalType{Type: sema.StringType},
+		RequireGlobalValue(t, checker.Elaboration, "x"),
+	)
+}
+
 func TestCheckStringUtf8Field(t *testing.T) {
 
 	t.Parallel()
@@ -324,3 +431,111 @@ func TestCheckStringToLower(t *testing.T) {
 		RequireGlobalValue(t, checker.Elaboration, "x"),
 	)
 }
+
+func TestCheckStringTemplate(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let a = 1
+          let b: UFix64 = 2.5
+          let c = true
+          let d: Character = "d"
+          let e: Address = 0x1
+          let f = /storage/f
+          let g = "g"
+
+          let x = "\(a), \(b), \(c), \(d), \(e), \(f), \(g), \(a + 1)"
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.StringType,
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let x = "a \("b \(1)")"
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.StringType,
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+	})
+
+	t.Run("invalid, not string-convertible", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {}
+
+          let x = "\(S())"
+          let y = "\([1])"
+          let z = "\(nil)"
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 3)
+
+		assert.IsType(t, &sema.TypeMismatchWithDescriptionError{}, errs[0])
+		assert.IsType(t, &sema.TypeMismatchWithDescriptionError{}, errs[1])
+		assert.IsType(t, &sema.TypeMismatchWithDescriptionError{}, errs[2])
+	})
+
+	t.Run("invalid, resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test() {
+              let r <- create R()
+              let x = "\(<-r)"
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchWithDescriptionError{}, errs[0])
+	})
+
+	t.Run("invalid, not character", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let x: Character = "\(1)"
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("invalid, undeclared", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let x = "\(y)"
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+}
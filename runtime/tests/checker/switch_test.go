@@ -401,3 +401,290 @@ func TestCheckSwitchStatementWithUnreachableReturn(t *testing.T) {
 	assert.IsType(t, &sema.UnreachableStatementError{}, errs[0])
 	assert.IsType(t, &sema.MissingReturnStatementError{}, errs[1])
 }
+
+func TestCheckSwitchStatementEnumExhaustiveness(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("all cases", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          enum E: UInt8 {
+              case a
+              case b
+              case c
+          }
+
+          fun test(_ e: E): String {
+              switch e {
+              case E.a:
+                  return "a"
+              case E.b:
+                  return "b"
+              case E.c:
+                  return "c"
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("default", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          enum E: UInt8 {
+              case a
+              case b
+          }
+
+          fun test(_ e: E): String {
+              switch e {
+              case E.a:
+                  return "a"
+              default:
+                  return "other"
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("missing cases", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          enum E: UInt8 {
+              case a
+              case b
+              case c
+          }
+
+          fun test(_ e: E) {
+              switch e {
+              case E.b:
+                  return
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		var missingCasesErr *sema.MissingSwitchCasesError
+		require.ErrorAs(t, errs[0], &missingCasesErr)
+
+		assert.Equal(t,
+			[]string{"a", "c"},
+			missingCasesErr.MissingCases,
+		)
+		assert.Equal(t,
+			"missing `a` and `c`; add the missing cases or a `default` case",
+			missingCasesErr.SecondaryError(),
+		)
+	})
+
+	t.Run("non-constant case expression", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          enum E: UInt8 {
+              case a
+              case b
+          }
+
+          fun test(_ e: E, _ other: E) {
+              switch e {
+              case E.a:
+                  return
+              case other:
+                  return
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		var missingCasesErr *sema.MissingSwitchCasesError
+		require.ErrorAs(t, errs[0], &missingCasesErr)
+
+		assert.Equal(t,
+			[]string{"b"},
+			missingCasesErr.MissingCases,
+		)
+	})
+
+	t.Run("nested enum", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract C {
+              enum E: UInt8 {
+                  pub case a
+                  pub case b
+              }
+          }
+
+          fun test(_ e: C.E): Int {
+              switch e {
+              case C.E.a:
+                  return 1
+              case C.E.b:
+                  return 2
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("non-enum", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(_ x: Int) {
+              switch x {
+              case 1:
+                  return
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckSwitchStatementOptionalBindingPattern(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(_ x: Int?): Int {
+              switch x {
+              case let y?:
+                  return y
+              case nil:
+                  return 0
+              }
+              return -1
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("non-equatable", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              let x: Int
+
+              init(x: Int) {
+                  self.x = x
+              }
+          }
+
+          fun test(_ s: S?): Int {
+              switch s {
+              case let t?:
+                  return t.x
+              default:
+                  return 0
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("binding is scoped to case", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(_ x: Int?) {
+              switch x {
+              case let y?:
+                  break
+              default:
+                  let z = y
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+
+	t.Run("binding is constant", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(_ x: Int?) {
+              switch x {
+              case let y?:
+                  y = 1
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.AssignmentToConstantError{}, errs[0])
+	})
+
+	t.Run("invalid, non-optional", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(_ x: Int) {
+              switch x {
+              case let y?:
+                  break
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchWithDescriptionError{}, errs[0])
+	})
+
+	t.Run("invalid, resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(_ r: @R?) {
+              switch r {
+              case let s?:
+                  break
+              }
+              destroy r
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchWithDescriptionError{}, errs[0])
+	})
+}
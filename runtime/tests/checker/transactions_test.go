@@ -477,3 +477,286 @@ func TestCheckInvalidTransactionSelfMoveIntoDictionaryLiteral(t *testing.T) {
 
 	assert.IsType(t, &sema.InvalidMoveError{}, errs[0])
 }
+
+func TestCheckTransactionExecuteAuthAccountAccess(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(t *testing.T, code string, expectedErrors []error) {
+		t.Parallel()
+
+		_, err := ParseAndCheckWithOptions(t,
+			code,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithTransactionExecuteAuthAccountCheckEnabled(true),
+				},
+			},
+		)
+
+		errs := ExpectCheckerErrors(t, err, len(expectedErrors))
+
+		for i, err := range errs {
+			if !assert.IsType(t, expectedErrors[i], err) {
+				t.Log(err)
+			}
+		}
+	}
+
+	t.Run("AuthAccountFieldUseOutsideExecute", func(t *testing.T) {
+		test(t,
+			`
+              transaction {
+
+                  let signer: AuthAccount
+                  let address: Address
+
+                  prepare(signer: AuthAccount) {
+                      self.signer = signer
+                      self.address = self.signer.address
+                  }
+
+                  execute {
+                      let address = self.address
+                  }
+
+                  post {
+                      self.signer.address == self.address
+                  }
+              }
+            `,
+			nil,
+		)
+	})
+
+	t.Run("InvalidAuthAccountFieldUseInExecute", func(t *testing.T) {
+		test(t,
+			`
+              transaction {
+
+                  let signer: AuthAccount
+
+                  prepare(signer: AuthAccount) {
+                      self.signer = signer
+                  }
+
+                  execute {
+                      self.signer.save(1, to: /storage/one)
+                  }
+              }
+            `,
+			[]error{
+				&sema.InvalidTransactionExecuteAuthAccountAccessError{},
+			},
+		)
+	})
+
+	t.Run("InvalidNestedAuthAccountFieldUseInExecute", func(t *testing.T) {
+		test(t,
+			`
+              transaction {
+
+                  let signers: [AuthAccount]
+                  let contracts: &AuthAccount.Contracts?
+
+                  prepare(signer: AuthAccount) {
+                      self.signers = [signer]
+                      self.contracts = &signer.contracts as &AuthAccount.Contracts
+                  }
+
+                  execute {
+                      let signers = self.signers
+                      fun names(): [String] {
+                          return self.contracts!.names
+                      }
+                  }
+              }
+            `,
+			[]error{
+				&sema.InvalidTransactionExecuteAuthAccountAccessError{},
+				&sema.InvalidTransactionExecuteAuthAccountAccessError{},
+			},
+		)
+	})
+
+	t.Run("InvalidAnyStructFieldUseInExecute", func(t *testing.T) {
+		test(t,
+			`
+              transaction {
+
+                  let signer: AnyStruct
+                  let signers: [AnyStruct]
+
+                  prepare(signer: AuthAccount) {
+                      self.signer = signer
+                      self.signers = [signer]
+                  }
+
+                  execute {
+                      (self.signer as! AuthAccount).save(1, to: /storage/one)
+                      (self.signers[0] as! AuthAccount).save(2, to: /storage/two)
+                  }
+              }
+            `,
+			[]error{
+				&sema.InvalidTransactionExecuteAuthAccountAccessError{},
+				&sema.InvalidTransactionExecuteAuthAccountAccessError{},
+			},
+		)
+	})
+
+	t.Run("InvalidWrappedAuthAccountFieldUseInExecute", func(t *testing.T) {
+		test(t,
+			`
+              pub struct Wrapper {
+                  pub let account: AuthAccount
+
+                  init(account: AuthAccount) {
+                      self.account = account
+                  }
+              }
+
+              pub struct Outer {
+                  pub let wrappers: {String: Wrapper}
+
+                  init(wrappers: {String: Wrapper}) {
+                      self.wrappers = wrappers
+                  }
+              }
+
+              transaction {
+
+                  let wrapper: Wrapper
+                  let outer: Outer
+
+                  prepare(signer: AuthAccount) {
+                      self.wrapper = Wrapper(account: signer)
+                      self.outer = Outer(wrappers: {"signer": self.wrapper})
+                  }
+
+                  execute {
+                      self.wrapper.account.save(1, to: /storage/one)
+                      self.outer.wrappers["signer"]!.account.save(2, to: /storage/two)
+                  }
+              }
+            `,
+			[]error{
+				&sema.InvalidTransactionExecuteAuthAccountAccessError{},
+				&sema.InvalidTransactionExecuteAuthAccountAccessError{},
+			},
+		)
+	})
+
+	t.Run("InvalidResourceFieldUseInExecute", func(t *testing.T) {
+		test(t,
+			`
+              pub resource R {
+                  pub let account: AuthAccount
+
+                  init(account: AuthAccount) {
+                      self.account = account
+                  }
+              }
+
+              transaction {
+
+                  let r: @AnyResource
+
+                  prepare(signer: AuthAccount) {
+                      self.r <- create R(account: signer)
+                  }
+
+                  execute {
+                      let r <- self.r as! @R
+                      r.account.save(1, to: /storage/one)
+                      destroy r
+                  }
+              }
+            `,
+			[]error{
+				&sema.InvalidTransactionExecuteAuthAccountAccessError{},
+			},
+		)
+	})
+
+	t.Run("InvalidFunctionFieldUseInExecute", func(t *testing.T) {
+		test(t,
+			`
+              transaction {
+
+                  let save: ((Int): Void)
+
+                  prepare(signer: AuthAccount) {
+                      self.save = fun (value: Int) {
+                          signer.save(value, to: /storage/one)
+                      }
+                  }
+
+                  execute {
+                      self.save(1)
+                  }
+              }
+            `,
+			[]error{
+				&sema.InvalidTransactionExecuteAuthAccountAccessError{},
+			},
+		)
+	})
+
+	t.Run("FieldsWithoutAuthAccountUseInExecute", func(t *testing.T) {
+		test(t,
+			`
+              pub struct Node {
+                  pub let value: Int
+                  pub let next: Node?
+
+                  init(value: Int, next: Node?) {
+                      self.value = value
+                      self.next = next
+                  }
+              }
+
+              transaction {
+
+                  let address: Address
+                  let node: Node
+                  let capability: Capability<&Int>
+
+                  prepare(signer: AuthAccount) {
+                      self.address = signer.address
+                      self.node = Node(value: 1, next: nil)
+                      self.capability = signer.getCapability<&Int>(/public/one)
+                  }
+
+                  execute {
+                      let address = self.address
+                      let value = self.node.value
+                      let reference = self.capability.borrow()
+                  }
+              }
+            `,
+			nil,
+		)
+	})
+}
+
+func TestCheckTransactionExecuteAuthAccountAccessDisabled(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      transaction {
+
+          let signer: AuthAccount
+
+          prepare(signer: AuthAccount) {
+              self.signer = signer
+          }
+
+          execute {
+              self.signer.save(1, to: /storage/one)
+          }
+      }
+    `)
+	require.NoError(t, err)
+}
@@ -0,0 +1,205 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func TestCheckTry(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("invocation", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          fun test(_ x: Int): Int {
+              return x
+          }
+
+          let x = try? test(1)
+        `)
+		require.NoError(t, err)
+
+		xType := RequireGlobalValue(t, checker.Elaboration, "x")
+
+		assert.Equal(t,
+			&sema.OptionalType{
+				Type: sema.IntType,
+			},
+			xType,
+		)
+	})
+
+	t.Run("optional result", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          fun test(): String? {
+              return nil
+          }
+
+          let x = try? test()
+        `)
+		require.NoError(t, err)
+
+		xType := RequireGlobalValue(t, checker.Elaboration, "x")
+
+		assert.Equal(t,
+			&sema.OptionalType{
+				Type: &sema.OptionalType{
+					Type: sema.StringType,
+				},
+			},
+			xType,
+		)
+	})
+
+	t.Run("nil-coalescing", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          fun test(): Int {
+              return 1
+          }
+
+          let x = try? test() ?? 2
+        `)
+		require.NoError(t, err)
+
+		xType := RequireGlobalValue(t, checker.Elaboration, "x")
+
+		assert.Equal(t, sema.IntType, xType)
+	})
+
+	t.Run("expected type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test<T>(): [T] {
+              return []
+          }
+
+          let x: [Int]? = try? test<Int>()
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("function member", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              fun test(): Int {
+                  return 1
+              }
+          }
+
+          let s = S()
+          let x: Int? = try? s.test()
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("non-invocation", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let x = try? 1
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidTryExpressionError{}, errs[0])
+	})
+
+	t.Run("resource argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(_ r: @R) {
+              destroy r
+          }
+
+          fun main() {
+              let r <- create R()
+              try? test(<-r)
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidTryResourceError{}, errs[0])
+	})
+
+	t.Run("resource result", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(): @R {
+              return <-create R()
+          }
+
+          fun main() {
+              let r <- try? test()
+              destroy r
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidTryResourceError{}, errs[0])
+	})
+
+	t.Run("resource reference argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(_ r: &R) {}
+
+          fun main() {
+              let r <- create R()
+              try? test(&r as &R)
+              destroy r
+          }
+        `)
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,430 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func TestCheckTupleExpression(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("inferred", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let t = (1, "two", true)
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.NewTupleType(nil, []sema.Type{
+				sema.IntType,
+				sema.StringType,
+				sema.BoolType,
+			}),
+			RequireGlobalValue(t, checker.Elaboration, "t"),
+		)
+	})
+
+	t.Run("expected", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let t: (UInt8, String?) = (1, "two")
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.NewTupleType(nil, []sema.Type{
+				sema.UInt8Type,
+				&sema.OptionalType{Type: sema.StringType},
+			}),
+			RequireGlobalValue(t, checker.Elaboration, "t"),
+		)
+	})
+
+	t.Run("mismatch", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let t: (Int, String) = (1, 2)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("length mismatch", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let t: (Int, Int) = (1, 2, 3)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
+
+func TestCheckTupleSubtyping(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("covariant", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let t1: (Int, String) = (1, "two")
+          let t2: (AnyStruct, String?) = t1
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let t1: (Int, String) = (1, "two")
+          let t2: (String, Int) = t1
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("common supertype", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let ts = [(1, "one"), (2, nil)]
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.VariableSizedType{
+				Type: sema.NewTupleType(nil, []sema.Type{
+					sema.IntType,
+					&sema.OptionalType{Type: sema.StringType},
+				}),
+			},
+			RequireGlobalValue(t, checker.Elaboration, "ts"),
+		)
+	})
+}
+
+func TestCheckTupleElementAccess(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let t = (1, "two")
+          let a = t[0]
+          let b = t[1]
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t, sema.IntType, RequireGlobalValue(t, checker.Elaboration, "a"))
+		assert.Equal(t, sema.StringType, RequireGlobalValue(t, checker.Elaboration, "b"))
+	})
+
+	t.Run("out of bounds", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let t = (1, "two")
+          let c = t[2]
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TupleIndexOutOfBoundsError{}, errs[0])
+	})
+
+	t.Run("non-literal index", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let t = (1, "two")
+          let i = 0
+          let c = t[i]
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.InvalidTupleIndexError{}, errs[0])
+	})
+
+	t.Run("assignment", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              var t = (1, "two")
+              t[0] = 2
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.NotIndexingAssignableTypeError{}, errs[0])
+	})
+}
+
+func TestCheckTupleVariableDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          fun test(): String {
+              let (a, b) = (1, "two")
+              return b
+          }
+        `)
+		require.NoError(t, err)
+
+		assert.NotNil(t, checker)
+	})
+
+	t.Run("type annotation", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(): Int? {
+              let (a, b): (Int?, String) = (1, "two")
+              return a
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("count mismatch", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let (a, b, c) = (1, "two")
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TupleDestructuringCountMismatchError{}, errs[0])
+	})
+
+	t.Run("not a tuple", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let (a, b) = [1, 2]
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeMismatchWithDescriptionError{}, errs[0])
+	})
+
+	t.Run("constant", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let (a, b) = (1, 2)
+              a = 3
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.AssignmentToConstantError{}, errs[0])
+	})
+
+	t.Run("variable", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              var (a, b) = (1, 2)
+              a = 3
+          }
+        `)
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckTupleResources(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("destructuring", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test() {
+              let t <- (<-create R(), 1)
+              let (r, n) <- t
+              destroy r
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("missing move", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test() {
+              let t = (<-create R(), 1)
+              let (r, n) <- t
+              destroy r
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.IncorrectTransferOperationError{}, errs[0])
+	})
+
+	t.Run("use after destructuring", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test() {
+              let t <- (<-create R(), 1)
+              let (r, n) <- t
+              destroy r
+              destroy t
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.ResourceUseAfterInvalidationError{}, errs[0])
+	})
+
+	t.Run("resource loss", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test() {
+              let t <- (<-create R(), 1)
+              let (r, n) <- t
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.ResourceLossError{}, errs[0])
+	})
+
+	t.Run("resource annotation", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(t: @(R, Int)) {
+              let (r, n) <- t
+              destroy r
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("missing resource annotation", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(t: (R, Int)) {
+              let (r, n) <- t
+              destroy r
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.MissingResourceAnnotationError{}, errs[0])
+	})
+}
+
+func TestCheckTupleNotStorable(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      fun test(account: AuthAccount) {
+          let t = (1, 2)
+          account.save(t, to: /storage/t)
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+}
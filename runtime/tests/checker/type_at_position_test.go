@@ -0,0 +1,164 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func TestCheckTypeAtPosition(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheckWithOptions(t,
+		`
+          pub struct S {
+              /// The number of things
+              pub let count: Int
+
+              init() {
+                  self.count = 1
+              }
+          }
+
+          /// Returns a number
+          pub fun test(s: S): Int {
+              let x = [s.count]
+              return x.length + 2
+          }
+        `,
+		ParseAndCheckOptions{
+			Options: []sema.Option{
+				sema.WithPositionInfoEnabled(true),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	elaboration := checker.Elaboration
+
+	t.Run("function declaration", func(t *testing.T) {
+
+		t.Parallel()
+
+		positionType := elaboration.TypeAtPosition(sema.Position{Line: 12, Column: 20})
+		require.NotNil(t, positionType)
+
+		assert.Equal(t, common.DeclarationKindFunction, positionType.DeclarationKind)
+		assert.Equal(t, " Returns a number", positionType.DocString)
+		require.IsType(t, &sema.FunctionType{}, positionType.Type)
+		assert.Equal(t, sema.IntType, positionType.Type.(*sema.FunctionType).ReturnTypeAnnotation.Type)
+	})
+
+	t.Run("field declaration", func(t *testing.T) {
+
+		t.Parallel()
+
+		positionType := elaboration.TypeAtPosition(sema.Position{Line: 4, Column: 24})
+		require.NotNil(t, positionType)
+
+		assert.Equal(t,
+			&sema.PositionType{
+				StartPos:        sema.Position{Line: 4, Column: 22},
+				EndPos:          sema.Position{Line: 4, Column: 26},
+				Type:            sema.IntType,
+				DeclarationKind: common.DeclarationKindField,
+				DocString:       " The number of things",
+			},
+			positionType,
+		)
+	})
+
+	t.Run("variable reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		// `s` in `s.count`
+
+		positionType := elaboration.TypeAtPosition(sema.Position{Line: 13, Column: 23})
+		require.NotNil(t, positionType)
+
+		assert.Equal(t, common.DeclarationKindParameter, positionType.DeclarationKind)
+		assert.Equal(t, "S", positionType.Type.QualifiedString())
+	})
+
+	t.Run("member", func(t *testing.T) {
+
+		t.Parallel()
+
+		// `count` in `s.count`
+
+		positionType := elaboration.TypeAtPosition(sema.Position{Line: 13, Column: 27})
+		require.NotNil(t, positionType)
+
+		assert.Equal(t, sema.IntType, positionType.Type)
+		assert.Equal(t, common.DeclarationKindField, positionType.DeclarationKind)
+		assert.Equal(t, " The number of things", positionType.DocString)
+	})
+
+	t.Run("expression", func(t *testing.T) {
+
+		t.Parallel()
+
+		// `[` of the array literal
+
+		positionType := elaboration.TypeAtPosition(sema.Position{Line: 13, Column: 22})
+		require.NotNil(t, positionType)
+
+		assert.Equal(t, sema.Position{Line: 13, Column: 22}, positionType.StartPos)
+		assert.Equal(t, sema.Position{Line: 13, Column: 30}, positionType.EndPos)
+		assert.Equal(t, common.DeclarationKindUnknown, positionType.DeclarationKind)
+		assert.Equal(t,
+			(&sema.VariableSizedType{Type: sema.IntType}).ID(),
+			positionType.Type.ID(),
+		)
+
+		// `+` of the binary expression
+
+		positionType = elaboration.TypeAtPosition(sema.Position{Line: 14, Column: 30})
+		require.NotNil(t, positionType)
+
+		assert.Equal(t, sema.Position{Line: 14, Column: 21}, positionType.StartPos)
+		assert.Equal(t, sema.Position{Line: 14, Column: 32}, positionType.EndPos)
+		assert.Equal(t, sema.IntType, positionType.Type)
+	})
+
+	t.Run("no expression", func(t *testing.T) {
+
+		t.Parallel()
+
+		assert.Nil(t, elaboration.TypeAtPosition(sema.Position{Line: 1, Column: 0}))
+	})
+
+	t.Run("position info disabled", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `let x = 1`)
+		require.NoError(t, err)
+
+		assert.Nil(t, checker.Elaboration.TypeAtPosition(sema.Position{Line: 1, Column: 4}))
+	})
+}
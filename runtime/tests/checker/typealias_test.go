@@ -0,0 +1,566 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func TestCheckTypeAlias(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("top-level", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          typealias Numbers = [Int]
+
+          let xs: Numbers = [1, 2, 3]
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.VariableSizedType{
+				Type: sema.IntType,
+			},
+			RequireGlobalValue(t, checker.Elaboration, "xs"),
+		)
+	})
+
+	t.Run("transparent", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct S {}
+
+          typealias A = S
+
+          let a: A = S()
+          let s: S = a
+          let t = Type<A>()
+          let same = Type<A>() == Type<S>()
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			RequireGlobalType(t, checker.Elaboration, "S"),
+			RequireGlobalType(t, checker.Elaboration, "A"),
+		)
+		assert.Equal(t,
+			RequireGlobalType(t, checker.Elaboration, "S"),
+			RequireGlobalValue(t, checker.Elaboration, "a"),
+		)
+	})
+
+	t.Run("elaboration", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          typealias A = {String: Int}
+        `)
+		require.NoError(t, err)
+
+		declaration := checker.Program.TypeAliasDeclarations()[0]
+
+		assert.Equal(t,
+			&sema.DictionaryType{
+				KeyType:   sema.StringType,
+				ValueType: sema.IntType,
+			},
+			checker.Elaboration.TypeAliasDeclarationTypes[declaration.ID()],
+		)
+	})
+
+	t.Run("alias of alias", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          typealias A = Int
+          typealias B = A?
+
+          let b: B = 1
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.OptionalType{
+				Type: sema.IntType,
+			},
+			RequireGlobalValue(t, checker.Elaboration, "b"),
+		)
+	})
+
+	t.Run("self-referential", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          typealias A = [A]
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+
+	t.Run("forward reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          typealias A = B
+          typealias B = Int
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+
+	t.Run("used in composite", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          typealias Names = [String]
+
+          struct S {
+              let names: Names
+
+              init(names: Names) {
+                  self.names = names
+              }
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          typealias A = R
+
+          fun test() {
+              let r: @A <- create R()
+              destroy r
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("resource, missing resource annotation", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          typealias A = R
+
+          fun test(r: A) {
+              destroy r
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingResourceAnnotationError{}, errs[0])
+	})
+
+	t.Run("redeclaration", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {}
+
+          typealias S = Int
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+	})
+
+	t.Run("local", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              typealias A = Int
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidDeclarationError{}, errs[0])
+	})
+
+	t.Run("invalid access modifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          priv typealias A = Int
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidAccessModifierError{}, errs[0])
+	})
+}
+
+func TestCheckNestedTypeAlias(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("contract", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          pub contract C {
+
+              pub resource interface Receiver {}
+
+              pub resource Vault: Receiver {}
+
+              pub typealias ReceiverCapability = Capability<&Vault{Receiver}>
+
+              pub fun receive(capability: ReceiverCapability): ReceiverCapability {
+                  return capability
+              }
+          }
+
+          pub fun test(capability: C.ReceiverCapability): Capability<&C.Vault{C.Receiver}> {
+              return C.receive(capability: capability)
+          }
+        `)
+		require.NoError(t, err)
+
+		contractType := RequireGlobalType(t, checker.Elaboration, "C").(*sema.CompositeType)
+
+		aliasedType, ok := contractType.TypeAlias("ReceiverCapability")
+		require.True(t, ok)
+
+		vaultType, ok := contractType.GetNestedTypes().Get("Vault")
+		require.True(t, ok)
+
+		receiverType, ok := contractType.GetNestedTypes().Get("Receiver")
+		require.True(t, ok)
+
+		expectedType := &sema.CapabilityType{
+			BorrowType: &sema.ReferenceType{
+				Type: &sema.RestrictedType{
+					Type: vaultType,
+					Restrictions: []*sema.InterfaceType{
+						receiverType.(*sema.InterfaceType),
+					},
+				},
+			},
+		}
+
+		assert.True(t, expectedType.Equal(aliasedType))
+		assert.Equal(t, expectedType.ID(), aliasedType.ID())
+	})
+
+	t.Run("imported", func(t *testing.T) {
+
+		t.Parallel()
+
+		importedChecker, err := ParseAndCheckWithOptions(t,
+			`
+              pub contract C {
+
+                  pub struct S {}
+
+                  pub typealias Ss = [S]
+              }
+            `,
+			ParseAndCheckOptions{
+				Location: utils.ImportedLocation,
+			},
+		)
+		require.NoError(t, err)
+
+		checker, err := ParseAndCheckWithOptions(t,
+			`
+              import C from "imported"
+
+              let ss: C.Ss = [C.S()]
+            `,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithImportHandler(
+						func(_ *sema.Checker, _ common.Location, _ ast.Range) (sema.Import, error) {
+							return sema.ElaborationImport{
+								Elaboration: importedChecker.Elaboration,
+							}, nil
+						},
+					),
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		contractType := RequireGlobalType(t, importedChecker.Elaboration, "C").(*sema.CompositeType)
+
+		structType, ok := contractType.GetNestedTypes().Get("S")
+		require.True(t, ok)
+
+		assert.Equal(t,
+			&sema.VariableSizedType{
+				Type: structType,
+			},
+			RequireGlobalValue(t, checker.Elaboration, "ss"),
+		)
+	})
+
+	t.Run("not declared", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          pub contract C {}
+
+          let x: C.A? = nil
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+
+	t.Run("duplicate", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          pub contract C {
+
+              pub struct S {}
+
+              pub typealias S = Int
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+	})
+
+	t.Run("duplicate type aliases", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          pub contract C {
+
+              pub typealias A = Int
+
+              pub typealias A = String
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+	})
+
+	for _, kind := range []common.CompositeKind{
+		common.CompositeKindStructure,
+		common.CompositeKindResource,
+	} {
+		kind := kind
+
+		t.Run(kind.Keyword(), func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := ParseAndCheck(t, `
+              pub `+kind.Keyword()+` X {
+                  pub typealias A = Int
+              }
+            `)
+
+			errs := ExpectCheckerErrors(t, err, 1)
+
+			assert.IsType(t, &sema.InvalidNestedDeclarationError{}, errs[0])
+		})
+	}
+
+	t.Run("contract interface", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          pub contract interface CI {
+              pub typealias A = Int
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidNestedDeclarationError{}, errs[0])
+	})
+}
+
+func TestCheckTypeAliasLazyResolution(t *testing.T) {
+
+	t.Parallel()
+
+	parseAndCheck := func(t *testing.T, code string, enabled bool) (*sema.Checker, error) {
+		return ParseAndCheckWithOptions(t,
+			code,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithLazyTypeAliasResolutionEnabled(enabled),
+				},
+			},
+		)
+	}
+
+	const forwardReferenceCode = `
+      pub typealias A = [B]
+
+      pub typealias B = {String: C}
+
+      pub typealias C = Int
+    `
+
+	t.Run("forward reference, disabled", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheck(t, forwardReferenceCode, false)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[1])
+	})
+
+	t.Run("forward reference, enabled", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := parseAndCheck(t, forwardReferenceCode, true)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.VariableSizedType{
+				Type: &sema.DictionaryType{
+					KeyType:   sema.StringType,
+					ValueType: sema.IntType,
+				},
+			},
+			RequireGlobalType(t, checker.Elaboration, "A"),
+		)
+	})
+
+	t.Run("forward reference in contract, enabled", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := parseAndCheck(t, `
+          pub contract C {
+
+              pub typealias A = B?
+
+              pub typealias B = String
+          }
+
+          pub let a: C.A = nil
+        `,
+			true,
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.OptionalType{
+				Type: sema.StringType,
+			},
+			RequireGlobalValue(t, checker.Elaboration, "a"),
+		)
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheck(t, `
+          pub typealias A = [B]
+
+          pub typealias B = ((C): Void)
+
+          pub typealias C = &A
+        `,
+			true,
+		)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		require.IsType(t, &sema.CyclicTypeAliasError{}, errs[0])
+		assert.Equal(t,
+			[]string{"A", "B", "C", "A"},
+			errs[0].(*sema.CyclicTypeAliasError).Cycle,
+		)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[1])
+	})
+
+	t.Run("self-reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheck(t, `
+          pub typealias A = {String: A}
+        `,
+			true,
+		)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		require.IsType(t, &sema.CyclicTypeAliasError{}, errs[0])
+		assert.Equal(t,
+			[]string{"A", "A"},
+			errs[0].(*sema.CyclicTypeAliasError).Cycle,
+		)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[1])
+	})
+}
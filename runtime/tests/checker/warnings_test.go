@@ -0,0 +1,282 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func parseAndCheckWithWarnings(t *testing.T, code string) []sema.Warning {
+	checker, err := ParseAndCheckWithOptions(t,
+		code,
+		ParseAndCheckOptions{
+			Options: []sema.Option{
+				sema.WithWarningsEnabled(true),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	return checker.Warnings()
+}
+
+func TestCheckWarningsDisabled(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      fun test(): Int {
+          return 1
+      }
+
+      fun main() {
+          test()
+      }
+	`)
+	require.NoError(t, err)
+
+	assert.Empty(t, checker.Warnings())
+}
+
+func TestCheckUnusedResultWarning(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("non-void", func(t *testing.T) {
+
+		t.Parallel()
+
+		warnings := parseAndCheckWithWarnings(t, `
+          fun test(): Int {
+              return 1
+          }
+
+          fun main() {
+              test()
+          }
+		`)
+
+		require.Len(t, warnings, 1)
+		require.IsType(t, &sema.UnusedResultWarning{}, warnings[0])
+
+		warning := warnings[0].(*sema.UnusedResultWarning)
+		assert.Equal(t, sema.IntType, warning.Type)
+		assert.Equal(t,
+			ast.Position{Offset: 102, Line: 7, Column: 14},
+			warning.StartPosition(),
+		)
+	})
+
+	t.Run("void", func(t *testing.T) {
+
+		t.Parallel()
+
+		warnings := parseAndCheckWithWarnings(t, `
+          fun test() {}
+
+          fun main() {
+              test()
+          }
+		`)
+
+		assert.Empty(t, warnings)
+	})
+
+	t.Run("optional chaining of void", func(t *testing.T) {
+
+		t.Parallel()
+
+		warnings := parseAndCheckWithWarnings(t, `
+          struct S {
+              fun test() {}
+          }
+
+          fun main() {
+              let s: S? = S()
+              s?.test()
+          }
+		`)
+
+		assert.Empty(t, warnings)
+	})
+
+	t.Run("used", func(t *testing.T) {
+
+		t.Parallel()
+
+		warnings := parseAndCheckWithWarnings(t, `
+          fun test(): Int {
+              return 1
+          }
+
+          fun main() {
+              let x = test()
+          }
+		`)
+
+		assert.Empty(t, warnings)
+	})
+}
+
+func TestCheckDeprecatedMemberWarning(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("deprecated", func(t *testing.T) {
+
+		t.Parallel()
+
+		warnings := parseAndCheckWithWarnings(t, `
+          struct S {
+
+              /// Returns one.
+              ///
+              /// Deprecated: Use `+"`bar`"+` instead
+              fun foo() {}
+
+              fun bar() {}
+          }
+
+          fun main() {
+              S().foo()
+          }
+		`)
+
+		require.Len(t, warnings, 1)
+		require.IsType(t, &sema.DeprecatedMemberWarning{}, warnings[0])
+
+		warning := warnings[0].(*sema.DeprecatedMemberWarning)
+		assert.Equal(t, "foo", warning.Name)
+		assert.Equal(t, "Use `bar` instead", warning.DeprecationNotes)
+		assert.Equal(t,
+			"function `foo` is deprecated: Use `bar` instead",
+			warning.Error(),
+		)
+	})
+
+	t.Run("deprecated pragma", func(t *testing.T) {
+
+		t.Parallel()
+
+		warnings := parseAndCheckWithWarnings(t, `
+          pub contract C {
+
+              #deprecated("use bar")
+              pub fun foo() {}
+
+              pub fun bar() {}
+
+              #deprecated
+              pub struct S {}
+          }
+
+          fun main() {
+              C.foo()
+              let s = C.S()
+          }
+		`)
+
+		require.Len(t, warnings, 2)
+
+		require.IsType(t, &sema.DeprecatedMemberWarning{}, warnings[0])
+		assert.Equal(t,
+			"function `foo` is deprecated: use bar",
+			warnings[0].Error(),
+		)
+
+		require.IsType(t, &sema.DeprecatedMemberWarning{}, warnings[1])
+		assert.Equal(t,
+			"structure `S` is deprecated",
+			warnings[1].Error(),
+		)
+	})
+
+	t.Run("not deprecated", func(t *testing.T) {
+
+		t.Parallel()
+
+		warnings := parseAndCheckWithWarnings(t, `
+          struct S {
+
+              /// Returns one.
+              fun bar() {}
+          }
+
+          fun main() {
+              S().bar()
+          }
+		`)
+
+		assert.Empty(t, warnings)
+	})
+}
+
+func TestCheckAlwaysSucceedingCastWarning(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("failable cast to supertype", func(t *testing.T) {
+
+		t.Parallel()
+
+		warnings := parseAndCheckWithWarnings(t, `
+          let x: Int = 1
+          let y = x as? Integer
+		`)
+
+		require.Len(t, warnings, 1)
+		require.IsType(t, &sema.AlwaysSucceedingCastWarning{}, warnings[0])
+
+		warning := warnings[0].(*sema.AlwaysSucceedingCastWarning)
+		assert.Equal(t, ast.OperationFailableCast, warning.Operation)
+		assert.Equal(t, sema.IntType, warning.ValueType)
+		assert.Equal(t, sema.IntegerType, warning.TargetType)
+	})
+
+	t.Run("force cast to same type", func(t *testing.T) {
+
+		t.Parallel()
+
+		warnings := parseAndCheckWithWarnings(t, `
+          let x: Int = 1
+          let y = x as! Int
+		`)
+
+		require.Len(t, warnings, 1)
+		require.IsType(t, &sema.AlwaysSucceedingCastWarning{}, warnings[0])
+	})
+
+	t.Run("downcast", func(t *testing.T) {
+
+		t.Parallel()
+
+		warnings := parseAndCheckWithWarnings(t, `
+          let x: Integer = 1
+          let y = x as? Int
+		`)
+
+		assert.Empty(t, warnings)
+	})
+}
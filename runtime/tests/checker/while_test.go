@@ -22,7 +22,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/sema"
 )
 
@@ -164,3 +166,166 @@ func TestCheckInvalidContinueStatement(t *testing.T) {
 	errs := ExpectCheckerErrors(t, err, 1)
 	assert.IsType(t, &sema.ControlStatementError{}, errs[0])
 }
+
+func TestCheckLabeledLoops(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("labeled break and continue", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              outer: while true {
+                  inner: for x in [1, 2, 3] {
+                      if x == 1 {
+                          continue outer
+                      }
+                      if x == 2 {
+                          continue inner
+                      }
+                      break outer
+                  }
+              }
+          }
+        `)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("labeled break in switch", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(x: Int) {
+              outer: while true {
+                  switch x {
+                  case 1:
+                      break outer
+                  default:
+                      break
+                  }
+              }
+          }
+        `)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("sibling loops with same label", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              loop: while true {
+                  break loop
+              }
+              loop: while true {
+                  break loop
+              }
+          }
+        `)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("undeclared label", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              outer: while true {
+                  break inner
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		var notDeclaredErr *sema.NotDeclaredError
+		require.ErrorAs(t, errs[0], &notDeclaredErr)
+		assert.Equal(t, common.DeclarationKindLabel, notDeclaredErr.ExpectedKind)
+		assert.Equal(t, "inner", notDeclaredErr.Name)
+	})
+
+	t.Run("label of sibling loop", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              first: while true {
+                  break
+              }
+              while true {
+                  continue first
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+
+	t.Run("label outside of loop", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              break outer
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+
+	t.Run("label not available in nested function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              outer: while true {
+                  fun () {
+                      while true {
+                          break outer
+                      }
+                  }
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+
+	t.Run("redeclared label", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              outer: while true {
+                  outer: while true {
+                      break outer
+                  }
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		var redeclarationErr *sema.RedeclarationError
+		require.ErrorAs(t, errs[0], &redeclarationErr)
+		assert.Equal(t, common.DeclarationKindLabel, redeclarationErr.Kind)
+	})
+}
@@ -0,0 +1,23 @@
+package checker
+
+import (
+	"fmt"
+	"github.com/onflow/cadence/runtime/ast"
+	"testing"
+)
+
+func TestZZScratch(t *testing.T) {
+	_, err := ParseAndCheck(t, `
+pub fun wrap<T>(value: T): [T] {
+    return [value]
+}
+
+pub fun map<U>(_ f: ((Int): U)): [U] {
+    return wrap<U>(value: f(1))
+}
+`)
+	for _, e := range ExpectCheckerErrors(t, err, 1) {
+		t.Log(e, fmt.Sprintf("%#v", e.(ast.HasPosition).StartPosition()))
+		t.Logf("%+v", e)
+	}
+}
@@ -1902,3 +1902,203 @@ func TestInterpretAccount_StorageFields(t *testing.T) {
 		}
 	}
 }
+
+func TestInterpretAccount_forEach(t *testing.T) {
+
+	t.Parallel()
+
+	const code = `
+      resource R {}
+
+      struct S {}
+
+      fun setup() {
+          account.save(<-create R(), to: /storage/r)
+          account.save(S(), to: /storage/s)
+          account.link<&R>(/public/r, target: /storage/r)
+          account.link<&S>(/private/s, target: /storage/s)
+      }
+
+      fun storedPaths(): [String] {
+          let paths: [String] = []
+          account.forEachStored(fun (path: StoragePath, type: Type): Bool {
+              paths.append(path.toString().concat(": ").concat(type.identifier))
+              return true
+          })
+          return paths
+      }
+
+      fun publicPaths(): [String] {
+          let paths: [String] = []
+          account.forEachPublic(fun (path: PublicPath, type: Type): Bool {
+              paths.append(path.toString().concat(": ").concat(type.identifier))
+              return true
+          })
+          return paths
+      }
+
+      fun privatePaths(): [String] {
+          let paths: [String] = []
+          account.forEachPrivate(fun (path: PrivatePath, type: Type): Bool {
+              paths.append(path.toString().concat(": ").concat(type.identifier))
+              return true
+          })
+          return paths
+      }
+
+      fun first(): Int {
+          var count = 0
+          account.forEachStored(fun (path: StoragePath, type: Type): Bool {
+              count = count + 1
+              return false
+          })
+          return count
+      }
+
+      fun mutate() {
+          account.forEachStored(fun (path: StoragePath, type: Type): Bool {
+              account.save(S(), to: /storage/s2)
+              return true
+          })
+      }
+    `
+
+	stringElements := func(t *testing.T, inter *interpreter.Interpreter, value interpreter.Value) []string {
+		require.IsType(t, &interpreter.ArrayValue{}, value)
+
+		var result []string
+		for _, element := range arrayElements(inter, value.(*interpreter.ArrayValue)) {
+			require.IsType(t, &interpreter.StringValue{}, element)
+			result = append(result, element.(*interpreter.StringValue).Str)
+		}
+		return result
+	}
+
+	t.Run("empty", func(t *testing.T) {
+
+		t.Parallel()
+
+		address := interpreter.NewUnmeteredAddressValueFromBytes([]byte{42})
+
+		inter, _ := testAccount(t, address, true, code)
+
+		value, err := inter.Invoke("storedPaths")
+		require.NoError(t, err)
+
+		assert.Empty(t, stringElements(t, inter, value))
+	})
+
+	t.Run("all domains", func(t *testing.T) {
+
+		t.Parallel()
+
+		address := interpreter.NewUnmeteredAddressValueFromBytes([]byte{42})
+
+		inter, _ := testAccount(t, address, true, code)
+
+		_, err := inter.Invoke("setup")
+		require.NoError(t, err)
+
+		value, err := inter.Invoke("storedPaths")
+		require.NoError(t, err)
+
+		assert.ElementsMatch(t,
+			[]string{
+				"/storage/r: S.test.R",
+				"/storage/s: S.test.S",
+			},
+			stringElements(t, inter, value),
+		)
+
+		value, err = inter.Invoke("publicPaths")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			[]string{"/public/r: Capability<&S.test.R>"},
+			stringElements(t, inter, value),
+		)
+
+		value, err = inter.Invoke("privatePaths")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			[]string{"/private/s: Capability<&S.test.S>"},
+			stringElements(t, inter, value),
+		)
+	})
+
+	t.Run("stop early", func(t *testing.T) {
+
+		t.Parallel()
+
+		address := interpreter.NewUnmeteredAddressValueFromBytes([]byte{42})
+
+		inter, _ := testAccount(t, address, true, code)
+
+		_, err := inter.Invoke("setup")
+		require.NoError(t, err)
+
+		value, err := inter.Invoke("first")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(1),
+			value,
+		)
+	})
+
+	t.Run("mutation", func(t *testing.T) {
+
+		t.Parallel()
+
+		address := interpreter.NewUnmeteredAddressValueFromBytes([]byte{42})
+
+		inter, _ := testAccount(t, address, true, code)
+
+		_, err := inter.Invoke("setup")
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("mutate")
+		require.Error(t, err)
+
+		require.ErrorAs(t, err, &interpreter.ContainerMutatedDuringIterationError{})
+	})
+
+	t.Run("public account", func(t *testing.T) {
+
+		t.Parallel()
+
+		address := interpreter.NewUnmeteredAddressValueFromBytes([]byte{42})
+
+		inter, _ := testAccount(
+			t,
+			address,
+			true,
+			`
+              resource R {}
+
+              fun test(): [String] {
+                  authAccount.save(<-create R(), to: /storage/r)
+                  authAccount.link<&R>(/public/r, target: /storage/r)
+
+                  let paths: [String] = []
+                  pubAccount.forEachPublic(fun (path: PublicPath, type: Type): Bool {
+                      paths.append(path.toString())
+                      return true
+                  })
+                  return paths
+              }
+            `,
+		)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			[]string{"/public/r"},
+			stringElements(t, inter, value),
+		)
+	})
+}
@@ -21,6 +21,7 @@ package interpreter_test
 import (
 	"fmt"
 	"math"
+	"math/big"
 	"strings"
 	"testing"
 
@@ -717,6 +718,74 @@ func TestInterpretSaturatedArithmeticFunctions(t *testing.T) {
 				},
 			},
 		},
+		sema.Fix128Type: {
+			add: testCalls{
+				overflow: testCall{
+					interpreter.NewUnmeteredFix128ValueFromBigInt(sema.Fix128TypeMaxBig),
+					interpreter.NewUnmeteredFix128ValueWithInteger(big.NewInt(2)),
+					interpreter.NewUnmeteredFix128ValueFromBigInt(sema.Fix128TypeMaxBig),
+				},
+				underflow: testCall{
+					interpreter.NewUnmeteredFix128ValueFromBigInt(sema.Fix128TypeMinBig),
+					interpreter.NewUnmeteredFix128ValueWithInteger(big.NewInt(-2)),
+					interpreter.NewUnmeteredFix128ValueFromBigInt(sema.Fix128TypeMinBig),
+				},
+			},
+			subtract: testCalls{
+				overflow: testCall{
+					interpreter.NewUnmeteredFix128ValueFromBigInt(sema.Fix128TypeMaxBig),
+					interpreter.NewUnmeteredFix128ValueWithInteger(big.NewInt(-2)),
+					interpreter.NewUnmeteredFix128ValueFromBigInt(sema.Fix128TypeMaxBig),
+				},
+				underflow: testCall{
+					interpreter.NewUnmeteredFix128ValueFromBigInt(sema.Fix128TypeMinBig),
+					interpreter.NewUnmeteredFix128ValueWithInteger(big.NewInt(2)),
+					interpreter.NewUnmeteredFix128ValueFromBigInt(sema.Fix128TypeMinBig),
+				},
+			},
+			multiply: testCalls{
+				overflow: testCall{
+					interpreter.NewUnmeteredFix128ValueFromBigInt(sema.Fix128TypeMaxBig),
+					interpreter.NewUnmeteredFix128ValueWithInteger(big.NewInt(2)),
+					interpreter.NewUnmeteredFix128ValueFromBigInt(sema.Fix128TypeMaxBig),
+				},
+				underflow: testCall{
+					interpreter.NewUnmeteredFix128ValueFromBigInt(sema.Fix128TypeMinBig),
+					interpreter.NewUnmeteredFix128ValueWithInteger(big.NewInt(2)),
+					interpreter.NewUnmeteredFix128ValueFromBigInt(sema.Fix128TypeMinBig),
+				},
+			},
+			divide: testCalls{
+				overflow: testCall{
+					interpreter.NewUnmeteredFix128ValueFromBigInt(sema.Fix128TypeMinBig),
+					interpreter.NewUnmeteredFix128ValueWithInteger(big.NewInt(-1)),
+					interpreter.NewUnmeteredFix128ValueFromBigInt(sema.Fix128TypeMaxBig),
+				},
+			},
+		},
+		sema.UFix128Type: {
+			add: testCalls{
+				overflow: testCall{
+					interpreter.NewUnmeteredUFix128ValueFromBigInt(sema.UFix128TypeMaxBig),
+					interpreter.NewUnmeteredUFix128ValueWithInteger(big.NewInt(2)),
+					interpreter.NewUnmeteredUFix128ValueFromBigInt(sema.UFix128TypeMaxBig),
+				},
+			},
+			subtract: testCalls{
+				underflow: testCall{
+					interpreter.NewUnmeteredUFix128ValueFromBigInt(sema.UFix128TypeMinBig),
+					interpreter.NewUnmeteredUFix128ValueWithInteger(big.NewInt(2)),
+					interpreter.NewUnmeteredUFix128ValueFromBigInt(sema.UFix128TypeMinBig),
+				},
+			},
+			multiply: testCalls{
+				overflow: testCall{
+					interpreter.NewUnmeteredUFix128ValueFromBigInt(sema.UFix128TypeMaxBig),
+					interpreter.NewUnmeteredUFix128ValueWithInteger(big.NewInt(2)),
+					interpreter.NewUnmeteredUFix128ValueFromBigInt(sema.UFix128TypeMaxBig),
+				},
+			},
+		},
 	}
 
 	// Verify all test cases exist
@@ -835,3 +904,128 @@ func TestInterpretSaturatedArithmeticFunctions(t *testing.T) {
 		test(ty, "Divide", testCase.divide)
 	}
 }
+
+func TestInterpretCheckedArithmeticFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	for _, ty := range append(
+		sema.AllSignedIntegerTypes[:],
+		sema.AllUnsignedIntegerTypes...,
+	) {
+
+		rangedType, ok := ty.(sema.IntegerRangedType)
+		require.True(t, ok)
+
+		if rangedType.IsSuperType() ||
+			rangedType.MinInt() == nil ||
+			rangedType.MaxInt() == nil ||
+			strings.HasPrefix(ty.String(), "Word") {
+
+			continue
+		}
+
+		ty := ty
+
+		t.Run(ty.String(), func(t *testing.T) {
+
+			t.Parallel()
+
+			inter := parseCheckAndInterpret(t,
+				fmt.Sprintf(
+					`
+                      let min: %[1]s = %[2]s
+                      let max: %[1]s = %[3]s
+
+                      fun add(): %[1]s? {
+                          return max.checkedAdd(1)
+                      }
+
+                      fun subtract(): %[1]s? {
+                          return min.checkedSubtract(1)
+                      }
+
+                      fun multiply(): %[1]s? {
+                          return max.checkedMultiply(2)
+                      }
+
+                      fun divide(): %[1]s? {
+                          return max.checkedDivide(0)
+                      }
+
+                      fun valid(): %[1]s? {
+                          return max.checkedSubtract(1)
+                      }
+                    `,
+					ty,
+					rangedType.MinInt(),
+					rangedType.MaxInt(),
+				),
+			)
+
+			for _, name := range []string{"add", "subtract", "multiply", "divide"} {
+				result, err := inter.Invoke(name)
+				require.NoError(t, err)
+
+				require.IsType(t, interpreter.NilValue{}, result, name)
+			}
+
+			result, err := inter.Invoke("valid")
+			require.NoError(t, err)
+
+			require.IsType(t, &interpreter.SomeValue{}, result)
+		})
+	}
+}
+
+func TestInterpretCheckedDivideOnlyArithmeticFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	// Arithmetic of these types cannot overflow or underflow,
+	// but division may still fail, as the divisor may be zero
+
+	for _, ty := range []sema.Type{
+		sema.IntType,
+		sema.UIntType,
+		sema.Word8Type,
+		sema.Word16Type,
+		sema.Word32Type,
+		sema.Word64Type,
+	} {
+
+		ty := ty
+
+		t.Run(ty.String(), func(t *testing.T) {
+
+			t.Parallel()
+
+			inter := parseCheckAndInterpret(t,
+				fmt.Sprintf(
+					`
+                      let a: %[1]s = 6
+
+                      fun divideByZero(): %[1]s? {
+                          return a.checkedDivide(0)
+                      }
+
+                      fun divide(): %[1]s? {
+                          return a.checkedDivide(2)
+                      }
+                    `,
+					ty,
+				),
+			)
+
+			result, err := inter.Invoke("divideByZero")
+			require.NoError(t, err)
+
+			require.IsType(t, interpreter.NilValue{}, result)
+
+			result, err = inter.Invoke("divide")
+			require.NoError(t, err)
+
+			require.IsType(t, &interpreter.SomeValue{}, result)
+		})
+	}
+}
@@ -0,0 +1,298 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	. "github.com/onflow/cadence/runtime/tests/utils"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+func TestInterpretAttachExpression(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("struct", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct S {}
+
+          attachment A for S {
+              let x: Int
+
+              init(x: Int) {
+                  self.x = x
+              }
+
+              fun double(): Int {
+                  return self.x * 2
+              }
+          }
+
+          fun test(): [Int?] {
+              let s = attach A(x: 21) to S()
+              return [s[A]?.x, s[A]?.double()]
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.ReturnEmptyLocationRange,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.OptionalStaticType{
+						Type: interpreter.PrimitiveStaticTypeInt,
+					},
+				},
+				common.Address{},
+				interpreter.NewUnmeteredSomeValueNonCopying(interpreter.NewUnmeteredIntValueFromInt64(21)),
+				interpreter.NewUnmeteredSomeValueNonCopying(interpreter.NewUnmeteredIntValueFromInt64(42)),
+			),
+			value,
+		)
+	})
+
+	t.Run("struct is copied", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct S {}
+
+          attachment A for S {}
+
+          fun test(): [Bool] {
+              let s = S()
+              let s2 = attach A() to s
+              return [s[A] == nil, s2[A] != nil]
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.ReturnEmptyLocationRange,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeBool,
+				},
+				common.Address{},
+				interpreter.BoolValue(true),
+				interpreter.BoolValue(true),
+			),
+			value,
+		)
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          resource R {
+              let x: Int
+
+              init(x: Int) {
+                  self.x = x
+              }
+          }
+
+          attachment A for R {
+              let y: Int
+
+              init(y: Int) {
+                  self.y = y
+              }
+
+              fun sum(): Int {
+                  return base.x + self.y
+              }
+          }
+
+          fun test(): Int {
+              let r <- attach A(y: 2) to <-create R(x: 1)
+              let sum = r[A]!.sum()
+              destroy r
+              return sum
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(3),
+			value,
+		)
+	})
+
+	t.Run("base in initializer", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct S {
+              let x: Int
+
+              init() {
+                  self.x = 3
+              }
+          }
+
+          attachment A for S {
+              let y: Int
+
+              init() {
+                  self.y = base.x * 2
+              }
+          }
+
+          fun test(): Int {
+              let s = attach A() to S()
+              return s[A]!.y
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(6),
+			value,
+		)
+	})
+
+	t.Run("access through reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          resource R {}
+
+          attachment A for R {
+              fun foo(): Int {
+                  return 42
+              }
+          }
+
+          fun get(_ ref: &R): Int? {
+              return ref[A]?.foo()
+          }
+
+          fun test(): Int? {
+              let r <- attach A() to <-create R()
+              let value = get(&r as &R)
+              destroy r
+              return value
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredSomeValueNonCopying(
+				interpreter.NewUnmeteredIntValueFromInt64(42),
+			),
+			value,
+		)
+	})
+
+	t.Run("duplicate", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct S {}
+
+          attachment A for S {}
+
+          fun test() {
+              let s = attach A() to S()
+              let s2 = attach A() to s
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.Error(t, err)
+
+		require.ErrorAs(t, err, &interpreter.DuplicateAttachmentError{})
+	})
+}
+
+func TestInterpretRemoveStatement(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      resource R {}
+
+      attachment A for R {}
+
+      fun test(): [Bool] {
+          let r <- attach A() to <-create R()
+          let before = r[A] != nil
+          remove A from r
+          let after = r[A] == nil
+          destroy r
+          return [before, after]
+      }
+    `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewArrayValue(
+			inter,
+			interpreter.ReturnEmptyLocationRange,
+			interpreter.VariableSizedStaticType{
+				Type: interpreter.PrimitiveStaticTypeBool,
+			},
+			common.Address{},
+			interpreter.BoolValue(true),
+			interpreter.BoolValue(true),
+		),
+		value,
+	)
+}
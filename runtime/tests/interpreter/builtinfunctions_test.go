@@ -20,6 +20,7 @@ package interpreter_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/onflow/cadence/runtime/common"
@@ -81,12 +82,20 @@ func TestInterpretToString(t *testing.T) {
 
 			isSigned := sema.IsSubType(ty, sema.SignedFixedPointType)
 
+			// Super-types are inferred to be Fix64 or UFix64
+			var scale uint = sema.Fix64Scale
+			if fractionalRangedType, ok := ty.(sema.FractionalRangedType); ok {
+				scale = fractionalRangedType.Scale()
+			}
+
+			fractional := "34" + strings.Repeat("0", int(scale)-2)
+
 			if isSigned {
 				literal = "-12.34"
-				expected = interpreter.NewUnmeteredStringValue("-12.34000000")
+				expected = interpreter.NewUnmeteredStringValue("-12." + fractional)
 			} else {
 				literal = "12.34"
-				expected = interpreter.NewUnmeteredStringValue("12.34000000")
+				expected = interpreter.NewUnmeteredStringValue("12." + fractional)
 			}
 
 			inter := parseCheckAndInterpret(t,
@@ -301,12 +310,22 @@ func TestInterpretToBigEndianBytes(t *testing.T) {
 			"42.24": {0, 0, 0, 0, 251, 197, 32, 0},
 			"-1.0":  {255, 255, 255, 255, 250, 10, 31, 0},
 		},
+		"Fix128": {
+			"0.0":   {0},
+			"42.0":  {34, 189, 216, 143, 237, 158, 252, 106, 0, 0, 0},
+			"42.24": {34, 240, 170, 253, 0, 136, 125, 32, 0, 0, 0},
+			"-1.0":  {255, 44, 61, 228, 49, 51, 18, 95, 0, 0, 0},
+		},
 		// UFix*
 		"UFix64": {
 			"0.0":   {0, 0, 0, 0, 0, 0, 0, 0},
 			"42.0":  {0, 0, 0, 0, 250, 86, 234, 0},
 			"42.24": {0, 0, 0, 0, 251, 197, 32, 0},
 		},
+		"UFix128": {
+			"0.0":  {0},
+			"42.0": {34, 189, 216, 143, 237, 158, 252, 106, 0, 0, 0},
+		},
 	}
 
 	// Ensure the test cases are complete
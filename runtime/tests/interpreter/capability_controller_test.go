@@ -0,0 +1,561 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	. "github.com/onflow/cadence/runtime/tests/utils"
+
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+func TestInterpretStorageCapabilityControllers(t *testing.T) {
+
+	t.Parallel()
+
+	const code = `
+      resource R {
+          let value: Int
+
+          init(value: Int) {
+              self.value = value
+          }
+      }
+
+      fun assert(_ condition: Bool) {
+          pre { condition }
+      }
+
+      fun setup() {
+          account.save(<-create R(value: 1), to: /storage/r)
+          account.save(<-create R(value: 2), to: /storage/r2)
+      }
+
+      fun issue(): UInt64 {
+          let cap = account.capabilities.storage.issue<&R>(/storage/r)
+          return account.capabilities.storage.getControllers(forPath: /storage/r)[0].capabilityID
+      }
+
+      fun borrowIssued(): Int {
+          let cap = account.capabilities.storage.issue<&R>(/storage/r)
+          assert(cap.check())
+          return cap.borrow()!.value
+      }
+
+      fun borrowWithWrongType(): Bool {
+          let cap: Capability = account.capabilities.storage.issue<&AnyResource>(/storage/r)
+          return cap.check<&R>() || cap.borrow<&R>() != nil
+      }
+
+      fun controllers(): [UInt64] {
+          let cap1 = account.capabilities.storage.issue<&R>(/storage/r)
+          let cap2 = account.capabilities.storage.issue<&AnyResource>(/storage/r)
+          let cap3 = account.capabilities.storage.issue<&R>(/storage/r2)
+
+          let controller = account.capabilities.storage.getController(byCapabilityID: 2)!
+          assert(controller.capabilityID == 2)
+          assert(controller.borrowType == Type<&AnyResource>())
+          assert(controller.target().toString() == "/storage/r")
+
+          assert(account.capabilities.storage.getController(byCapabilityID: 4) == nil)
+
+          let ids: [UInt64] = []
+          for pathController in account.capabilities.storage.getControllers(forPath: /storage/r) {
+              ids.append(pathController.capabilityID)
+          }
+          return ids
+      }
+
+      fun revoke(): Bool {
+          let cap = account.capabilities.storage.issue<&R>(/storage/r)
+          let controller = account.capabilities.storage.getController(byCapabilityID: 1)!
+          controller.delete()
+          assert(account.capabilities.storage.getController(byCapabilityID: 1) == nil)
+          assert(account.capabilities.storage.getControllers(forPath: /storage/r).length == 0)
+          return cap.check() || cap.borrow() != nil
+      }
+
+      fun useDeleted() {
+          account.capabilities.storage.issue<&R>(/storage/r)
+          let controller = account.capabilities.storage.getController(byCapabilityID: 1)!
+          controller.delete()
+          controller.target()
+      }
+
+      fun retarget(): Int {
+          let cap = account.capabilities.storage.issue<&R>(/storage/r)
+          let controller = account.capabilities.storage.getController(byCapabilityID: 1)!
+          controller.retarget(/storage/r2)
+          assert(controller.target().toString() == "/storage/r2")
+          return cap.borrow()!.value
+      }
+
+      fun forEach(): [UInt64] {
+          account.capabilities.storage.issue<&R>(/storage/r)
+          account.capabilities.storage.issue<&R>(/storage/r2)
+          account.capabilities.storage.issue<&R>(/storage/r)
+          account.capabilities.storage.issue<&R>(/storage/r)
+
+          let ids: [UInt64] = []
+          account.capabilities.storage.forEachController(
+              forPath: /storage/r,
+              fun (controller: StorageCapabilityController): Bool {
+                  ids.append(controller.capabilityID)
+                  return ids.length < 2
+              }
+          )
+          return ids
+      }
+
+      fun issueDuringIteration() {
+          account.capabilities.storage.issue<&R>(/storage/r)
+          account.capabilities.storage.forEachController(
+              forPath: /storage/r,
+              fun (controller: StorageCapabilityController): Bool {
+                  account.capabilities.storage.issue<&R>(/storage/r)
+                  return true
+              }
+          )
+      }
+    `
+
+	newInterpreter := func(t *testing.T) *interpreter.Interpreter {
+		address := interpreter.NewUnmeteredAddressValueFromBytes([]byte{42})
+
+		inter, _ := testAccount(t, address, true, code)
+
+		_, err := inter.Invoke("setup")
+		require.NoError(t, err)
+
+		return inter
+	}
+
+	t.Run("issue", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newInterpreter(t)
+
+		value, err := inter.Invoke("issue")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredUInt64Value(1),
+			value,
+		)
+	})
+
+	t.Run("borrow", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newInterpreter(t)
+
+		value, err := inter.Invoke("borrowIssued")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(1),
+			value,
+		)
+	})
+
+	t.Run("borrow with wrong type", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newInterpreter(t)
+
+		value, err := inter.Invoke("borrowWithWrongType")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.BoolValue(false),
+			value,
+		)
+	})
+
+	t.Run("controllers", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newInterpreter(t)
+
+		value, err := inter.Invoke("controllers")
+		require.NoError(t, err)
+
+		require.IsType(t, &interpreter.ArrayValue{}, value)
+
+		require.Equal(t,
+			[]interpreter.Value{
+				interpreter.NewUnmeteredUInt64Value(1),
+				interpreter.NewUnmeteredUInt64Value(2),
+			},
+			arrayElements(inter, value.(*interpreter.ArrayValue)),
+		)
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newInterpreter(t)
+
+		value, err := inter.Invoke("revoke")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.BoolValue(false),
+			value,
+		)
+	})
+
+	t.Run("use deleted controller", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newInterpreter(t)
+
+		_, err := inter.Invoke("useDeleted")
+		require.Error(t, err)
+
+		require.ErrorAs(t, err, &interpreter.DeletedCapabilityControllerError{})
+	})
+
+	t.Run("retarget", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newInterpreter(t)
+
+		value, err := inter.Invoke("retarget")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(2),
+			value,
+		)
+	})
+
+	t.Run("forEachController", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newInterpreter(t)
+
+		value, err := inter.Invoke("forEach")
+		require.NoError(t, err)
+
+		require.IsType(t, &interpreter.ArrayValue{}, value)
+
+		require.Equal(t,
+			[]interpreter.Value{
+				interpreter.NewUnmeteredUInt64Value(1),
+				interpreter.NewUnmeteredUInt64Value(3),
+			},
+			arrayElements(inter, value.(*interpreter.ArrayValue)),
+		)
+	})
+
+	t.Run("issue during iteration", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newInterpreter(t)
+
+		_, err := inter.Invoke("issueDuringIteration")
+		require.Error(t, err)
+
+		require.ErrorAs(t, err, &interpreter.ContainerMutatedDuringIterationError{})
+	})
+}
+
+func TestInterpretAccountCapabilitiesPublishing(t *testing.T) {
+
+	t.Parallel()
+
+	const code = `
+      resource R {
+          let value: Int
+
+          init(value: Int) {
+              self.value = value
+          }
+      }
+
+      fun assert(_ condition: Bool) {
+          pre { condition }
+      }
+
+      fun setup() {
+          account.save(<-create R(value: 1), to: /storage/r)
+      }
+
+      fun publish(): Int {
+          let cap = account.capabilities.storage.issue<&R>(/storage/r)
+          account.capabilities.publish(cap, at: /public/r)
+          return pubAccount.getCapability<&R>(/public/r).borrow()!.value
+      }
+
+      fun unpublish(): Bool {
+          let cap = account.capabilities.storage.issue<&R>(/storage/r)
+          account.capabilities.publish(cap, at: /public/r)
+          let unpublished = account.capabilities.unpublish(/public/r)!
+          assert(unpublished.borrow<&R>()!.value == 1)
+          assert(account.capabilities.unpublish(/public/r) == nil)
+          return pubAccount.getCapability<&R>(/public/r).check()
+      }
+
+      fun publishRevoked(): Bool {
+          let cap = account.capabilities.storage.issue<&R>(/storage/r)
+          account.capabilities.publish(cap, at: /public/r)
+          account.capabilities.storage.getController(byCapabilityID: 1)!.delete()
+          return pubAccount.getCapability<&R>(/public/r).check()
+      }
+
+      fun publishTwice() {
+          let cap = account.capabilities.storage.issue<&R>(/storage/r)
+          account.capabilities.publish(cap, at: /public/r)
+          account.capabilities.publish(cap, at: /public/r)
+      }
+
+      fun publishPathCapability() {
+          let cap = account.link<&R>(/private/r, target: /storage/r)!
+          account.capabilities.publish(cap, at: /public/r)
+      }
+    `
+
+	newInterpreter := func(t *testing.T) *interpreter.Interpreter {
+		address := interpreter.NewUnmeteredAddressValueFromBytes([]byte{42})
+
+		inter, _ := testAccount(t, address, true, code)
+
+		_, err := inter.Invoke("setup")
+		require.NoError(t, err)
+
+		return inter
+	}
+
+	t.Run("publish", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newInterpreter(t)
+
+		value, err := inter.Invoke("publish")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(1),
+			value,
+		)
+	})
+
+	t.Run("unpublish", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newInterpreter(t)
+
+		value, err := inter.Invoke("unpublish")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.BoolValue(false),
+			value,
+		)
+	})
+
+	t.Run("revoked", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newInterpreter(t)
+
+		value, err := inter.Invoke("publishRevoked")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.BoolValue(false),
+			value,
+		)
+	})
+
+	t.Run("publish twice", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newInterpreter(t)
+
+		_, err := inter.Invoke("publishTwice")
+		require.Error(t, err)
+
+		require.ErrorAs(t, err, &interpreter.CapabilityPublishingOverwriteError{})
+	})
+
+	t.Run("publish path capability", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newInterpreter(t)
+
+		_, err := inter.Invoke("publishPathCapability")
+		require.Error(t, err)
+
+		require.ErrorAs(t, err, &interpreter.PathCapabilityPublishingError{})
+	})
+}
+
+func TestInterpretAccountCapabilitiesMigrateLink(t *testing.T) {
+
+	t.Parallel()
+
+	const code = `
+      resource interface I {}
+
+      resource R: I {
+          let value: Int
+
+          init(value: Int) {
+              self.value = value
+          }
+      }
+
+      fun assert(_ condition: Bool) {
+          pre { condition }
+      }
+
+      fun setup() {
+          account.save(<-create R(value: 1), to: /storage/r)
+      }
+
+      fun migrate(): Int {
+          let linkedCap = account.link<&R>(/private/r, target: /storage/r)!
+          account.link<&R>(/public/r, target: /private/r)
+
+          let id = account.capabilities.migrateLink(/public/r)!
+
+          let controller = account.capabilities.storage.getController(byCapabilityID: id)!
+          assert(controller.target().toString() == "/storage/r")
+          assert(controller.borrowType == Type<&R>())
+
+          // The link was replaced, but existing capabilities keep working
+          assert(account.getLinkTarget(/public/r) == nil)
+
+          let publicCap = pubAccount.getCapability<&R>(/public/r)
+          let value = publicCap.borrow()!.value
+
+          // Revoking the controller revokes the migrated public capability,
+          // but not other capabilities for the private link
+          controller.delete()
+          assert(!publicCap.check())
+          assert(linkedCap.check())
+
+          return value
+      }
+
+      fun migrateMissing(): UInt64? {
+          return account.capabilities.migrateLink(/public/missing)
+      }
+
+      fun migrateBroken(): UInt64? {
+          account.link<&R>(/public/r, target: /private/missing)
+          return account.capabilities.migrateLink(/public/r)
+      }
+
+      fun migrateRestricted(): UInt64? {
+          account.link<&R>(/private/r, target: /storage/r)
+          account.link<&R{I}>(/public/r, target: /private/r)
+          return account.capabilities.migrateLink(/public/r)
+      }
+    `
+
+	newInterpreter := func(t *testing.T) *interpreter.Interpreter {
+		address := interpreter.NewUnmeteredAddressValueFromBytes([]byte{42})
+
+		inter, _ := testAccount(t, address, true, code)
+
+		_, err := inter.Invoke("setup")
+		require.NoError(t, err)
+
+		return inter
+	}
+
+	t.Run("migrate", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newInterpreter(t)
+
+		value, err := inter.Invoke("migrate")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(1),
+			value,
+		)
+	})
+
+	for _, name := range []string{
+		"migrateMissing",
+		"migrateBroken",
+		"migrateRestricted",
+	} {
+		name := name
+
+		t.Run(name, func(t *testing.T) {
+
+			t.Parallel()
+
+			inter := newInterpreter(t)
+
+			value, err := inter.Invoke(name)
+			require.NoError(t, err)
+
+			AssertValuesEqual(
+				t,
+				inter,
+				interpreter.NilValue{},
+				value,
+			)
+		})
+	}
+}
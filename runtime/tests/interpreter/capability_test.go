@@ -246,7 +246,18 @@ func TestInterpretCapability_borrow(t *testing.T) {
 		t.Run("r2", func(t *testing.T) {
 
 			_, err := inter.Invoke("r2")
-			require.ErrorAs(t, err, &interpreter.ForceCastTypeMismatchError{})
+
+			var forceCastErr interpreter.ForceCastTypeMismatchError
+			require.ErrorAs(t, err, &forceCastErr)
+
+			require.Equal(t,
+				"S.test.R",
+				string(forceCastErr.ActualType.ID()),
+			)
+			require.Equal(t,
+				"unexpectedly found non-`R2` while force-casting value: got `R`",
+				forceCastErr.Error(),
+			)
 		})
 
 		t.Run("single change after borrow", func(t *testing.T) {
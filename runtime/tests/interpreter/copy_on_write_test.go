@@ -0,0 +1,414 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/onflow/atree"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+func TestInterpretCopyOnWrite(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(t *testing.T, code string, expected string) {
+		inter := parseCheckAndInterpret(t, code)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		require.Equal(t, expected, result.String())
+	}
+
+	t.Run("mutate copy of array", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(
+			t,
+			`
+          fun test(): [[Int]] {
+              let a = [1, 2]
+              let b = a
+              b.append(3)
+              b[0] = 4
+              return [a, b]
+          }
+        `,
+			"[[1, 2], [4, 2, 3]]",
+		)
+	})
+
+	t.Run("mutate original of array", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(
+			t,
+			`
+          fun test(): [[Int]] {
+              let a = [1, 2]
+              let b = a
+              let c = b
+              a.remove(at: 0)
+              a.insert(at: 0, 3)
+              return [a, b, c]
+          }
+        `,
+			"[[3, 2], [1, 2], [1, 2]]",
+		)
+	})
+
+	t.Run("mutate array argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(
+			t,
+			`
+          fun fill(_ values: [String]): [String] {
+              values.append("c")
+              return values
+          }
+
+          fun test(): [[String]] {
+              let a = ["a", "b"]
+              let b = fill(a)
+              return [a, b]
+          }
+        `,
+			`[["a", "b"], ["a", "b", "c"]]`,
+		)
+	})
+
+	t.Run("sort copy of array", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(
+			t,
+			`
+          fun test(): [[Int]] {
+              let a = [3, 1, 2]
+              let b = a
+              b.sort(by: fun (x: Int, y: Int): Bool {
+                  return x < y
+              })
+              return [a, b]
+          }
+        `,
+			"[[3, 1, 2], [1, 2, 3]]",
+		)
+	})
+
+	t.Run("move copy of array into container", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(
+			t,
+			`
+          fun test(): [AnyStruct] {
+              let a = [1, 2]
+              let b = a
+              let c: [[Int]] = []
+              c.append(b)
+              c.append(a)
+              a.append(3)
+              c[0].append(4)
+              return [a, b, c]
+          }
+        `,
+			"[[1, 2, 3], [1, 2], [[1, 2, 4], [1, 2]]]",
+		)
+	})
+
+	t.Run("mutate nested array of copied element", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(
+			t,
+			`
+          fun test(): [AnyStruct] {
+              let a = [[1], [2]]
+              let b = a[0]
+              a[0].append(3)
+              a[1] = [4]
+              return [a, b]
+          }
+        `,
+			"[[[1, 3], [4]], [1]]",
+		)
+	})
+
+	t.Run("mutate copy of dictionary", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(
+			t,
+			`
+          fun test(): [Int?] {
+              let a = {"a": 1, "b": 2}
+              let b = a
+              b.remove(key: "a")
+              b["c"] = 3
+              return [a["a"], a["b"], a["c"], b["a"], b["b"], b["c"]]
+          }
+        `,
+			"[1, 2, nil, nil, 2, 3]",
+		)
+	})
+
+	t.Run("mutate original of dictionary", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(
+			t,
+			`
+          fun test(): [Int?] {
+              let a = {"a": 1, "b": 2}
+              let b = a
+              a.insert(key: "a", 3)
+              return [a["a"], a["b"], b["a"], b["b"]]
+          }
+        `,
+			"[3, 2, 1, 2]",
+		)
+	})
+
+	t.Run("mutate original of dictionary while iterating copy", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(
+			t,
+			`
+          fun test(): [Int] {
+              let a = {"a": 1, "b": 2}
+              let b = a
+              var count = 0
+              b.forEachKey(fun (key: String): Bool {
+                  a.remove(key: key)
+                  count = count + 1
+                  return true
+              })
+              return [count, a.length, b.length]
+          }
+        `,
+			"[2, 0, 2]",
+		)
+	})
+
+	t.Run("storage IDs", func(t *testing.T) {
+
+		t.Parallel()
+
+		// Sharing generates the same storage IDs as eager copies,
+		// as e.g. the seeds of new dictionaries are derived from them.
+		// Arrays of structs are copied eagerly
+
+		storageID := func(t *testing.T, elementType string) atree.StorageID {
+			inter := parseCheckAndInterpret(
+				t,
+				fmt.Sprintf(
+					`
+                      fun test(): {Int: Int} {
+                          let a: [%s] = [1, 2]
+                          let b = a
+                          b.append(3)
+                          let c = a
+                          return {1: 1}
+                      }
+                    `,
+					elementType,
+				),
+			)
+
+			result, err := inter.Invoke("test")
+			require.NoError(t, err)
+
+			require.IsType(t, &interpreter.DictionaryValue{}, result)
+			return result.(*interpreter.DictionaryValue).StorageID()
+		}
+
+		require.Equal(t,
+			storageID(t, "AnyStruct"),
+			storageID(t, "Int"),
+		)
+	})
+
+	t.Run("mutate copy of large array", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(
+			t,
+			`
+          fun test(): [Int] {
+              let a: [Int] = []
+              var i = 0
+              while i < 1000 {
+                  a.append(i)
+                  i = i + 1
+              }
+              let b = a
+              b[500] = -1
+              b.append(1000)
+              return [a.length, a[500], b.length, b[500], b[999]]
+          }
+        `,
+			"[1000, 500, 1001, -1, 999]",
+		)
+	})
+
+	t.Run("storage IDs of large containers", func(t *testing.T) {
+
+		t.Parallel()
+
+		// Copies of containers which are stored in multiple slabs, and which have elements
+		// which are stored in separate slabs, generate the same storage IDs as eager copies.
+		// Containers with non-sharable element types are copied eagerly
+
+		// Strings larger than the maximum inline size of array elements
+		// are stored in separate slabs
+
+		largeString := fmt.Sprintf(`"%s"`, strings.Repeat("0123456789", 60))
+
+		storageID := func(t *testing.T, containerType string, insertion string) atree.StorageID {
+			inter := parseCheckAndInterpret(
+				t,
+				fmt.Sprintf(
+					`
+                      fun element(_ i: Int): String {
+                          if i %% 10 == 0 {
+                              return %[3]s.concat(i.toString())
+                          }
+                          return i.toString()
+                      }
+
+                      fun test(): {Int: Int} {
+                          let a: %[1]s = %[2]s
+                          var i = 0
+                          while i < 1000 {
+                              %[4]s
+                              i = i + 1
+                          }
+                          let b = a
+                          let c = a
+                          %[4]s
+                          let d = a
+                          return {1: 1}
+                      }
+                    `,
+					containerType,
+					containerType[0:1]+containerType[len(containerType)-1:],
+					largeString,
+					insertion,
+				),
+			)
+
+			result, err := inter.Invoke("test")
+			require.NoError(t, err)
+
+			require.IsType(t, &interpreter.DictionaryValue{}, result)
+			return result.(*interpreter.DictionaryValue).StorageID()
+		}
+
+		t.Run("array", func(t *testing.T) {
+
+			t.Parallel()
+
+			const insertion = "a.append(element(i))"
+
+			require.Equal(t,
+				storageID(t, "[AnyStruct]", insertion),
+				storageID(t, "[String]", insertion),
+			)
+		})
+
+		t.Run("dictionary", func(t *testing.T) {
+
+			t.Parallel()
+
+			const insertion = "a[element(i)] = element(i + 1)"
+
+			require.Equal(t,
+				storageID(t, "{String: AnyStruct}", insertion),
+				storageID(t, "{String: String}", insertion),
+			)
+		})
+	})
+}
+
+func BenchmarkInterpretCopyOnWrite(b *testing.B) {
+
+	// Arrays of AnyStruct are copied eagerly,
+	// arrays of Int are shared until they are mutated
+
+	for _, elementType := range []string{"AnyStruct", "Int"} {
+
+		b.Run(elementType, func(b *testing.B) {
+
+			inter := parseCheckAndInterpret(
+				b,
+				fmt.Sprintf(
+					`
+                      fun makeValues(): [%[1]s] {
+                          let values: [%[1]s] = []
+                          var i = 0
+                          while i < 10000 {
+                              values.append(i)
+                              i = i + 1
+                          }
+                          return values
+                      }
+
+                      let values = makeValues()
+
+                      fun count(_ values: [%[1]s]): Int {
+                          return values.length
+                      }
+
+                      fun test(): Int {
+                          return count(values)
+                      }
+                    `,
+					elementType,
+				),
+			)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				_, err := inter.Invoke("test")
+				require.NoError(b, err)
+			}
+		})
+	}
+}
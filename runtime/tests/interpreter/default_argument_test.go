@@ -0,0 +1,272 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	. "github.com/onflow/cadence/runtime/tests/utils"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+func TestInterpretFunctionDefaultArgument(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("omitted and provided", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun add(_ a: Int, _ b: Int = 10): Int {
+              return a + b
+          }
+
+          let x = add(1)
+          let y = add(1, 2)
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(11),
+			inter.Globals["x"].GetValue(),
+		)
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(3),
+			inter.Globals["y"].GetValue(),
+		)
+	})
+
+	t.Run("conversion to parameter type", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(a: Int8? = 1): Int8? {
+              return a
+          }
+
+          let x = test()
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredSomeValueNonCopying(
+				interpreter.Int8Value(1),
+			),
+			inter.Globals["x"].GetValue(),
+		)
+	})
+
+	t.Run("evaluated on each invocation", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          var counter = 0
+
+          fun next(): Int {
+              counter = counter + 1
+              return counter
+          }
+
+          fun test(a: Int = next()): Int {
+              return a
+          }
+
+          fun main(): [Int] {
+              return [test(), test(a: 10), test()]
+          }
+        `)
+
+		result, err := inter.Invoke("main")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.ReturnEmptyLocationRange,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+				},
+				common.Address{},
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+				interpreter.NewUnmeteredIntValueFromInt64(10),
+				interpreter.NewUnmeteredIntValueFromInt64(2),
+			),
+			result,
+		)
+	})
+
+	t.Run("declaration scope", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let x = 1
+
+          fun test(a: Int = x): Int {
+              return a
+          }
+
+          fun main(): Int {
+              let x = 2
+              return test()
+          }
+        `)
+
+		result, err := inter.Invoke("main")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(1),
+			result,
+		)
+	})
+
+	t.Run("function expression", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let test = fun (a: String = "default"): String {
+              return a
+          }
+
+          let x = test()
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredStringValue("default"),
+			inter.Globals["x"].GetValue(),
+		)
+	})
+
+	t.Run("composite function", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct S {
+              let a: Int
+
+              init() {
+                  self.a = 1
+              }
+
+              fun add(b: Int = 2): Int {
+                  return self.a + b
+              }
+          }
+
+          let x = S().add()
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(3),
+			inter.Globals["x"].GetValue(),
+		)
+	})
+}
+
+func TestInterpretCompositeInitializerDefaultArgument(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("struct", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct S {
+              let a: Int
+              let b: String
+
+              init(a: Int, b: String = "b") {
+                  self.a = a
+                  self.b = b
+              }
+          }
+
+          let x = S(a: 1).b
+          let y = S(a: 1, b: "c").b
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredStringValue("b"),
+			inter.Globals["x"].GetValue(),
+		)
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredStringValue("c"),
+			inter.Globals["y"].GetValue(),
+		)
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          resource R {
+              let a: UInt8
+
+              init(a: UInt8 = 42) {
+                  self.a = a
+              }
+          }
+
+          fun test(): UInt8 {
+              let r <- create R()
+              let a = r.a
+              destroy r
+              return a
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.UInt8Value(42),
+			result,
+		)
+	})
+}
@@ -185,6 +185,91 @@ func TestInterpretEnumConstructor(t *testing.T) {
 	)
 }
 
+func TestInterpretEnumAllCases(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      enum E: UInt8 {
+          case a
+          case b
+          case c
+      }
+
+      fun test(): [E] {
+          let cases = E.allCases
+          cases.append(E.a)
+          return cases
+      }
+
+      let res = [
+          E.allCases.length == 3,
+          E.allCases[0] == E.a,
+          E.allCases[1] == E.b,
+          E.allCases[2] == E.c,
+          test().length == 4,
+          E.allCases.length == 3
+      ]
+    `)
+
+	RequireValuesEqual(
+		t,
+		inter,
+		interpreter.NewArrayValue(
+			inter,
+			interpreter.ReturnEmptyLocationRange,
+			interpreter.VariableSizedStaticType{
+				Type: interpreter.PrimitiveStaticTypeBool,
+			},
+			common.Address{},
+			interpreter.BoolValue(true),
+			interpreter.BoolValue(true),
+			interpreter.BoolValue(true),
+			interpreter.BoolValue(true),
+			interpreter.BoolValue(true),
+			interpreter.BoolValue(true),
+		),
+		inter.Globals["res"].GetValue(),
+	)
+}
+
+func TestInterpretEnumLookup(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      enum E: Int64 {
+          case a
+          case b
+      }
+
+      let res = [
+          E.lookup(rawValue: 0)! == E.a,
+          E.lookup(rawValue: 1)! == E.b,
+          E.lookup(rawValue: -1) == nil,
+          E.lookup(rawValue: 2) == nil
+      ]
+    `)
+
+	RequireValuesEqual(
+		t,
+		inter,
+		interpreter.NewArrayValue(
+			inter,
+			interpreter.ReturnEmptyLocationRange,
+			interpreter.VariableSizedStaticType{
+				Type: interpreter.PrimitiveStaticTypeBool,
+			},
+			common.Address{},
+			interpreter.BoolValue(true),
+			interpreter.BoolValue(true),
+			interpreter.BoolValue(true),
+			interpreter.BoolValue(true),
+		),
+		inter.Globals["res"].GetValue(),
+	)
+}
+
 func TestInterpretEnumInstance(t *testing.T) {
 
 	t.Parallel()
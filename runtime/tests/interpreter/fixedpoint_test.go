@@ -28,6 +28,7 @@ import (
 
 	. "github.com/onflow/cadence/runtime/tests/utils"
 
+	"github.com/onflow/cadence/fixedpoint"
 	"github.com/onflow/cadence/runtime/interpreter"
 	"github.com/onflow/cadence/runtime/sema"
 )
@@ -54,9 +55,11 @@ func TestInterpretFixedPointConversionAndAddition(t *testing.T) {
 
 	tests := map[string]interpreter.Value{
 		// Fix*
-		"Fix64": interpreter.NewUnmeteredFix64Value(123000000),
+		"Fix64":  interpreter.NewUnmeteredFix64Value(123000000),
+		"Fix128": interpreter.NewUnmeteredFix128ValueFromBigInt(mustParseFix128("1.23")),
 		// UFix*
-		"UFix64": interpreter.NewUnmeteredUFix64Value(123000000),
+		"UFix64":  interpreter.NewUnmeteredUFix64Value(123000000),
+		"UFix128": interpreter.NewUnmeteredUFix128ValueFromBigInt(mustParseFix128("1.23")),
 	}
 
 	for _, fixedPointType := range sema.AllFixedPointTypes {
@@ -111,9 +114,19 @@ func TestInterpretFixedPointConversionAndAddition(t *testing.T) {
 	}
 }
 
+func mustParseFix128(s string) *big.Int {
+	value, err := fixedpoint.ParseFix128(s)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
 var testFixedPointValues = map[string]interpreter.Value{
-	"Fix64":  interpreter.NewUnmeteredFix64Value(50 * sema.Fix64Factor),
-	"UFix64": interpreter.NewUnmeteredUFix64Value(50 * sema.Fix64Factor),
+	"Fix64":   interpreter.NewUnmeteredFix64Value(50 * sema.Fix64Factor),
+	"UFix64":  interpreter.NewUnmeteredUFix64Value(50 * sema.Fix64Factor),
+	"Fix128":  interpreter.NewUnmeteredFix128ValueFromBigInt(mustParseFix128("50.0")),
+	"UFix128": interpreter.NewUnmeteredUFix128ValueFromBigInt(mustParseFix128("50.0")),
 }
 
 func init() {
@@ -571,6 +584,14 @@ func TestInterpretFixedPointMinMax(t *testing.T) {
 			min: interpreter.NewUnmeteredUFix64Value(0),
 			max: interpreter.NewUnmeteredUFix64Value(math.MaxUint64),
 		},
+		sema.Fix128Type: {
+			min: interpreter.NewUnmeteredFix128ValueFromBigInt(sema.Fix128TypeMinBig),
+			max: interpreter.NewUnmeteredFix128ValueFromBigInt(sema.Fix128TypeMaxBig),
+		},
+		sema.UFix128Type: {
+			min: interpreter.NewUnmeteredUFix128ValueFromBigInt(sema.UFix128TypeMinBig),
+			max: interpreter.NewUnmeteredUFix128ValueFromBigInt(sema.UFix128TypeMaxBig),
+		},
 	}
 
 	for _, ty := range sema.AllFixedPointTypes {
@@ -592,3 +613,203 @@ func TestInterpretFixedPointMinMax(t *testing.T) {
 		})
 	}
 }
+
+func TestInterpretFix128(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("precision", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let a: Fix128 = 0.000000000000000000000001
+          let b: Fix128 = -1.5
+          let sum = a + b
+          let product = b * b
+          let quotient = Fix128(1.0) / Fix128(3.0)
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredFix128ValueFromBigInt(mustParseFix128("-1.499999999999999999999999")),
+			inter.Globals["sum"].GetValue(),
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredFix128ValueFromBigInt(mustParseFix128("2.25")),
+			inter.Globals["product"].GetValue(),
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredFix128ValueFromBigInt(mustParseFix128("0.333333333333333333333333")),
+			inter.Globals["quotient"].GetValue(),
+		)
+	})
+
+	t.Run("conversion from and to Fix64", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let x: Fix64 = -1.23456789
+          let y = Fix128(x)
+          let z: UFix128 = 1.234567891234
+          let w = UFix64(z)
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredFix128ValueFromBigInt(mustParseFix128("-1.23456789")),
+			inter.Globals["y"].GetValue(),
+		)
+
+		// Additional fractional digits are truncated
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredUFix64Value(123456789),
+			inter.Globals["w"].GetValue(),
+		)
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Fix128 {
+              return Fix128.max + 0.000000000000000000000001
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.ErrorAs(t, err, &interpreter.OverflowError{})
+	})
+
+	t.Run("underflow", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): UFix128 {
+              let x: UFix128 = 1.0
+              return x - 2.0
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.ErrorAs(t, err, &interpreter.UnderflowError{})
+	})
+
+	t.Run("division by zero", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Fix128 {
+              let x: Fix128 = 1.0
+              return x / 0.0
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.ErrorAs(t, err, &interpreter.DivisionByZeroError{})
+	})
+
+	t.Run("saturating", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let x = Fix128.max.saturatingAdd(1.0)
+          let y = UFix128(1.0).saturatingSubtract(2.0)
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredFix128ValueFromBigInt(sema.Fix128TypeMaxBig),
+			inter.Globals["x"].GetValue(),
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredUFix128ValueFromBigInt(big.NewInt(0)),
+			inter.Globals["y"].GetValue(),
+		)
+	})
+}
+
+func TestInterpretFixedPointFromString(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(t *testing.T, typeName string, input string, expected interpreter.Value) {
+
+		inter := parseCheckAndInterpret(t,
+			fmt.Sprintf(
+				`
+				  fun test(_ input: String): %[1]s? {
+				      return %[1]s.fromString(input)
+				  }
+				`,
+				typeName,
+			),
+		)
+
+		result, err := inter.Invoke("test", interpreter.NewUnmeteredStringValue(input))
+		require.NoError(t, err)
+
+		if expected == nil {
+			expected = interpreter.NilValue{}
+		} else {
+			expected = interpreter.NewUnmeteredSomeValueNonCopying(expected)
+		}
+
+		RequireValuesEqual(t, inter, expected, result)
+	}
+
+	for typeName, value := range testFixedPointValues {
+		t.Run(typeName, func(t *testing.T) {
+			test(t, typeName, "50.0", value)
+		})
+	}
+
+	type testCase struct {
+		typeName string
+		input    string
+		expected interpreter.Value
+	}
+
+	for _, testCase := range []testCase{
+		{"Fix64", "-1.5", interpreter.NewUnmeteredFix64Value(-150_000_000)},
+		{"Fix64", "-0.0", nil},
+		{"Fix64", "1", nil},
+		{"Fix64", "1.", nil},
+		{"Fix64", ".5", nil},
+		{"Fix64", "+1.5", nil},
+		{"Fix64", "01.5", nil},
+		{"Fix64", "1.-5", nil},
+		{"Fix64", "1.5.0", nil},
+		{"UFix64", "0.00000001", interpreter.NewUnmeteredUFix64Value(1)},
+		{"UFix64", "0.000000001", nil},
+		{"UFix64", "-1.0", nil},
+		{"UFix64", "184467440737.09551615", interpreter.NewUnmeteredUFix64Value(math.MaxUint64)},
+		{"UFix64", "184467440737.09551616", nil},
+		{"Fix128", "-1.5", interpreter.NewUnmeteredFix128ValueFromBigInt(mustParseFix128("-1.5"))},
+		{"UFix128", "-1.5", nil},
+	} {
+		t.Run(fmt.Sprintf("%s %q", testCase.typeName, testCase.input), func(t *testing.T) {
+			test(t, testCase.typeName, testCase.input, testCase.expected)
+		})
+	}
+}
@@ -219,3 +219,319 @@ func TestInterpretForStatementEmpty(t *testing.T) {
 		value,
 	)
 }
+
+func TestInterpretForStatementOverRange(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(t *testing.T, code string, expected interpreter.Value) {
+		inter := parseCheckAndInterpret(t, code)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, expected, value)
+	}
+
+	t.Run("half-open", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(t,
+			`
+              fun test(): Int {
+                  var sum = 0
+                  for x in 1..<5 {
+                      sum = sum + x
+                  }
+                  return sum
+              }
+            `,
+			interpreter.NewUnmeteredIntValueFromInt64(10),
+		)
+	})
+
+	t.Run("inclusive", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(t,
+			`
+              fun test(): Int {
+                  var sum = 0
+                  for x in 1...5 {
+                      sum = sum + x
+                  }
+                  return sum
+              }
+            `,
+			interpreter.NewUnmeteredIntValueFromInt64(15),
+		)
+	})
+
+	t.Run("index", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(t,
+			`
+              fun test(): Int {
+                  var sum = 0
+                  for i, x in 10..<13 {
+                      sum = sum + i * x
+                  }
+                  return sum
+              }
+            `,
+			// 0*10 + 1*11 + 2*12
+			interpreter.NewUnmeteredIntValueFromInt64(35),
+		)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(t,
+			`
+              fun test(): Int {
+                  var count = 0
+                  for x in 3..<3 {
+                      count = count + 1
+                  }
+                  for x in 5...4 {
+                      count = count + 1
+                  }
+                  return count
+              }
+            `,
+			interpreter.NewUnmeteredIntValueFromInt64(0),
+		)
+	})
+
+	t.Run("inclusive up to maximum", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(t,
+			`
+              fun test(): Int {
+                  var count = 0
+                  for x in (250 as UInt8)...255 {
+                      count = count + 1
+                  }
+                  return count
+              }
+            `,
+			interpreter.NewUnmeteredIntValueFromInt64(6),
+		)
+	})
+
+	t.Run("break", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(t,
+			`
+              fun test(): Int {
+                  var sum = 0
+                  for x in 0..<100 {
+                      if x == 3 {
+                          break
+                      }
+                      sum = sum + x
+                  }
+                  return sum
+              }
+            `,
+			interpreter.NewUnmeteredIntValueFromInt64(3),
+		)
+	})
+}
+
+func TestInterpretRange(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let r = 1..<5
+      let start = r.start
+      let end = r.end
+      let containsStart = r.contains(1)
+      let containsEnd = r.contains(5)
+      let inclusiveContainsEnd = (1...5).contains(5)
+      let typeIdentifier = r.getType().identifier
+    `)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredIntValueFromInt64(1),
+		inter.Globals["start"].GetValue(),
+	)
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredIntValueFromInt64(5),
+		inter.Globals["end"].GetValue(),
+	)
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.BoolValue(true),
+		inter.Globals["containsStart"].GetValue(),
+	)
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.BoolValue(false),
+		inter.Globals["containsEnd"].GetValue(),
+	)
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.BoolValue(true),
+		inter.Globals["inclusiveContainsEnd"].GetValue(),
+	)
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredStringValue("Range<Int>"),
+		inter.Globals["typeIdentifier"].GetValue(),
+	)
+}
+
+func TestInterpretLabeledLoops(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(t *testing.T, code string, expected interpreter.Value) {
+		inter := parseCheckAndInterpret(t, code)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, expected, value)
+	}
+
+	t.Run("labeled break", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(t,
+			`
+              fun test(): Int {
+                  var count = 0
+                  outer: for x in [1, 2, 3] {
+                      for y in [1, 2, 3] {
+                          if x == 2 && y == 2 {
+                              break outer
+                          }
+                          count = count + 1
+                      }
+                  }
+                  return count
+              }
+            `,
+			// (1,1), (1,2), (1,3), (2,1)
+			interpreter.NewUnmeteredIntValueFromInt64(4),
+		)
+	})
+
+	t.Run("labeled continue", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(t,
+			`
+              fun test(): Int {
+                  var count = 0
+                  outer: for x in [1, 2, 3] {
+                      var y = 0
+                      while y < 3 {
+                          y = y + 1
+                          if y == 2 {
+                              continue outer
+                          }
+                          count = count + 1
+                      }
+                      count = count + 100
+                  }
+                  return count
+              }
+            `,
+			interpreter.NewUnmeteredIntValueFromInt64(3),
+		)
+	})
+
+	t.Run("labeled while", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(t,
+			`
+              fun test(): Int {
+                  var i = 0
+                  outer: while true {
+                      i = i + 1
+                      while true {
+                          if i < 5 {
+                              continue outer
+                          }
+                          break outer
+                      }
+                  }
+                  return i
+              }
+            `,
+			interpreter.NewUnmeteredIntValueFromInt64(5),
+		)
+	})
+
+	t.Run("labeled break in switch", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(t,
+			`
+              fun test(): Int {
+                  var count = 0
+                  outer: for x in [1, 2, 3] {
+                      switch x {
+                      case 1:
+                          break
+                      case 2:
+                          break outer
+                      }
+                      count = count + 1
+                  }
+                  return count
+              }
+            `,
+			interpreter.NewUnmeteredIntValueFromInt64(1),
+		)
+	})
+
+	t.Run("labeled range loops", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(t,
+			`
+              fun test(): Int {
+                  var count = 0
+                  outer: for x in 0..<3 {
+                      inner: for y in 0..<3 {
+                          if y == 1 {
+                              continue outer
+                          }
+                          count = count + 1
+                      }
+                  }
+                  return count
+              }
+            `,
+			interpreter.NewUnmeteredIntValueFromInt64(3),
+		)
+	})
+}
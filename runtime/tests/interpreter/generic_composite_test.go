@@ -0,0 +1,218 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/onflow/cadence/runtime/tests/utils"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+func TestInterpretGenericCompositeDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("struct", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct Box<T> {
+              let value: T
+
+              init(value: T) {
+                  self.value = value
+              }
+
+              fun get(): T {
+                  return self.value
+              }
+          }
+
+          let box = Box(value: 42)
+
+          fun test(): Int {
+              return box.get()
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(42),
+			value,
+		)
+
+		variable, ok := inter.Globals.Get("box")
+		require.True(t, ok)
+
+		box := variable.GetValue()
+		require.IsType(t, &interpreter.CompositeValue{}, box)
+
+		staticType := box.StaticType(inter)
+		require.IsType(t, interpreter.CompositeStaticType{}, staticType)
+
+		assert.Equal(t,
+			[]interpreter.StaticType{
+				interpreter.PrimitiveStaticTypeInt,
+			},
+			staticType.(interpreter.CompositeStaticType).TypeArguments(),
+		)
+		assert.Equal(t, "S.test.Box<Int>", staticType.String())
+	})
+
+	t.Run("run-time type", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct Box<T> {
+              let value: T
+
+              init(value: T) {
+                  self.value = value
+              }
+          }
+
+          fun test(): [Bool] {
+              let box = Box<String>(value: "hello")
+              let anyBox: AnyStruct = box
+              return [
+                  box.getType() == Type<Box<String>>(),
+                  box.getType() == Type<Box<Int>>(),
+                  (anyBox as? Box<String>) != nil,
+                  (anyBox as? Box<Int>) != nil,
+                  Type<Box<String>>().identifier == "S.test.Box<String>"
+              ]
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.ReturnEmptyLocationRange,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeBool,
+				},
+				common.Address{},
+				interpreter.BoolValue(true),
+				interpreter.BoolValue(false),
+				interpreter.BoolValue(true),
+				interpreter.BoolValue(false),
+				interpreter.BoolValue(true),
+			),
+			value,
+		)
+	})
+
+	t.Run("type arguments in functions", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct Box<T> {
+              var value: T
+
+              init(value: T) {
+                  self.value = value
+              }
+
+              fun set(_ value: AnyStruct) {
+                  self.value = value as! T
+              }
+          }
+
+          fun test() {
+              let box = Box(value: 1)
+              box.set(2)
+              box.set("three")
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.Error(t, err)
+
+		require.ErrorAs(t, err, &interpreter.ForceCastTypeMismatchError{})
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          resource R {
+              let id: Int
+
+              init(id: Int) {
+                  self.id = id
+              }
+          }
+
+          resource Holder<T: AnyResource> {
+              var inner: @T?
+
+              init(inner: @T) {
+                  self.inner <- inner
+              }
+
+              fun take(): @T {
+                  let inner <- self.inner <- nil
+                  return <-inner!
+              }
+
+              destroy() {
+                  destroy self.inner
+              }
+          }
+
+          fun test(): Int {
+              let holder <- create Holder<@R>(inner: <-create R(id: 42))
+              let r <- holder.take()
+              let id = r.id
+              destroy r
+              destroy holder
+              return id
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(42),
+			value,
+		)
+	})
+}
@@ -0,0 +1,258 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	. "github.com/onflow/cadence/runtime/tests/utils"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+func TestInterpretGenericFunctionDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("identity", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun identity<T>(_ value: T): T {
+              return value
+          }
+
+          fun test(): Int {
+              return identity(42)
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(42),
+			value,
+		)
+	})
+
+	t.Run("array of type parameter", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun pair<T>(_ first: T, _ second: T): [T] {
+              return [first, second]
+          }
+
+          fun test(): Bool {
+              let values = pair(1, 2)
+              return values.getType() == Type<[Int]>()
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.BoolValue(true),
+			value,
+		)
+	})
+
+	t.Run("nested invocation", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun singleton<T>(_ value: T): [T] {
+              return [value]
+          }
+
+          fun nested<U>(_ value: U): [[U]] {
+              return [singleton(value)]
+          }
+
+          fun test(): Bool {
+              return nested("a").getType() == Type<[[String]]>()
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.BoolValue(true),
+			value,
+		)
+	})
+
+	t.Run("cast to type parameter", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun cast<T>(_ value: AnyStruct): T? {
+              return value as? T
+          }
+
+          fun test(): [Int?] {
+              return [cast<Int>(1), cast<Int>("1")]
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.ReturnEmptyLocationRange,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.OptionalStaticType{
+						Type: interpreter.PrimitiveStaticTypeInt,
+					},
+				},
+				common.Address{},
+				interpreter.NewUnmeteredSomeValueNonCopying(interpreter.NewUnmeteredIntValueFromInt64(1)),
+				interpreter.NilValue{},
+			),
+			value,
+		)
+	})
+
+	t.Run("conformance constraint", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct interface Comparable {
+              fun compare(_ other: AnyStruct): Int
+          }
+
+          struct Version: Comparable {
+              let number: Int
+
+              init(_ number: Int) {
+                  self.number = number
+              }
+
+              fun compare(_ other: AnyStruct): Int {
+                  return self.number - (other as! Version).number
+              }
+          }
+
+          fun max<T: AnyStruct & Comparable>(_ a: T, _ b: T): T {
+              if a.compare(b) >= 0 {
+                  return a
+              }
+              return b
+          }
+
+          fun test(): Int {
+              return max(Version(1), Version(2)).number
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(2),
+			value,
+		)
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          resource R {
+              let id: Int
+
+              init(id: Int) {
+                  self.id = id
+              }
+          }
+
+          fun identity<T: AnyResource>(_ value: @T): @T {
+              return <-value
+          }
+
+          fun test(): Int {
+              let r <- identity(<-create R(id: 1))
+              let id = r.id
+              destroy r
+              return id
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(1),
+			value,
+		)
+	})
+
+	t.Run("composite function", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct Box {
+              fun wrap<T>(_ value: T): [T] {
+                  return [value]
+              }
+          }
+
+          fun test(): Bool {
+              return Box().wrap(true).getType() == Type<[Bool]>()
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.BoolValue(true),
+			value,
+		)
+	})
+}
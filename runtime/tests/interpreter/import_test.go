@@ -411,3 +411,82 @@ func TestInterpretResourceConstructionThroughIndirectImport(t *testing.T) {
 		resourceConstructionError.CompositeType,
 	)
 }
+
+func TestInterpretImportAlias(t *testing.T) {
+
+	t.Parallel()
+
+	address := common.MustBytesToAddress([]byte{0x1})
+
+	importedChecker, err := checker.ParseAndCheckWithOptions(t,
+		`
+          pub fun a(): Int {
+              return 1
+          }
+
+          pub fun b(): Int {
+              return 2
+          }
+        `,
+		checker.ParseAndCheckOptions{
+			Location: common.AddressLocation{
+				Address: address,
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	importingChecker, err := checker.ParseAndCheckWithOptions(t,
+		`
+          import a as b, b as c from 0x1
+
+          pub fun test(): Int {
+              return b() * 10 + c()
+          }
+        `,
+		checker.ParseAndCheckOptions{
+			Options: []sema.Option{
+				sema.WithImportHandler(
+					func(checker *sema.Checker, importedLocation common.Location, _ ast.Range) (sema.Import, error) {
+						return sema.ElaborationImport{
+							Elaboration: importedChecker.Elaboration,
+						}, nil
+					},
+				),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	inter, err := interpreter.NewInterpreter(
+		interpreter.ProgramFromChecker(importingChecker),
+		importingChecker.Location,
+		interpreter.WithImportLocationHandler(
+			func(inter *interpreter.Interpreter, location common.Location) interpreter.Import {
+				program := interpreter.ProgramFromChecker(importedChecker)
+				subInterpreter, err := inter.NewSubInterpreter(program, location)
+				if err != nil {
+					panic(err)
+				}
+
+				return interpreter.InterpreterImport{
+					Interpreter: subInterpreter,
+				}
+			},
+		),
+	)
+	require.NoError(t, err)
+
+	err = inter.Interpret()
+	require.NoError(t, err)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredIntValueFromInt64(12),
+		value,
+	)
+}
@@ -19,6 +19,7 @@
 package interpreter_test
 
 import (
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
@@ -29,6 +30,7 @@ import (
 
 	. "github.com/onflow/cadence/runtime/tests/utils"
 
+	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/interpreter"
 	"github.com/onflow/cadence/runtime/sema"
 )
@@ -260,6 +262,103 @@ func TestInterpretAddressConversion(t *testing.T) {
 	})
 }
 
+func TestInterpretAddressParse(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun test(_ string: String): Address? {
+          return Address.parse(string)
+      }
+    `)
+
+	someAddress := func(address ...byte) interpreter.Value {
+		return interpreter.NewUnmeteredSomeValueNonCopying(
+			interpreter.NewUnmeteredAddressValueFromBytes(address),
+		)
+	}
+
+	for input, expected := range map[string]interpreter.Value{
+		"0x1":                  someAddress(0x1),
+		"1":                    someAddress(0x1),
+		"0x0000000000000001":   someAddress(0x1),
+		"0x0102030405060708":   someAddress(0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8),
+		"0x010203040506070809": interpreter.NilValue{},
+		"0x":                   interpreter.NilValue{},
+		"":                     interpreter.NilValue{},
+		"0xq":                  interpreter.NilValue{},
+		"-1":                   interpreter.NilValue{},
+	} {
+		result, err := inter.Invoke("test", interpreter.NewUnmeteredStringValue(input))
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, expected, result)
+	}
+}
+
+func TestInterpretAddressParseValidator(t *testing.T) {
+
+	t.Parallel()
+
+	inter, err := parseCheckAndInterpretWithOptions(t,
+		`
+          let a = Address.parse("0x1")
+          let b = Address.parse("0x1000000000000001")
+        `,
+		ParseCheckAndInterpretOptions{
+			Options: []interpreter.Option{
+				interpreter.WithAddressValidator(func(address common.Address) error {
+					if address[0] != 0 {
+						return errors.New("address not in network")
+					}
+					return nil
+				}),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredSomeValueNonCopying(
+			interpreter.NewUnmeteredAddressValueFromBytes([]byte{0x1}),
+		),
+		inter.Globals["a"].GetValue(),
+	)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NilValue{},
+		inter.Globals["b"].GetValue(),
+	)
+}
+
+func TestInterpretAddressToString(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let a = Address(0x1).toString()
+      let b = Address.parse(a)!.toString()
+    `)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredStringValue("0x0000000000000001"),
+		inter.Globals["a"].GetValue(),
+	)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredStringValue("0x0000000000000001"),
+		inter.Globals["b"].GetValue(),
+	)
+}
+
 func TestInterpretIntegerLiteralTypeConversionInVariableDeclaration(t *testing.T) {
 
 	t.Parallel()
@@ -882,3 +981,71 @@ func TestInterpretIntegerMinMax(t *testing.T) {
 		})
 	}
 }
+
+func TestInterpretIntegerFromString(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(t *testing.T, typeName string, input string, expected interpreter.Value) {
+
+		inter := parseCheckAndInterpret(t,
+			fmt.Sprintf(
+				`
+				  fun test(_ input: String): %[1]s? {
+				      return %[1]s.fromString(input)
+				  }
+				`,
+				typeName,
+			),
+		)
+
+		result, err := inter.Invoke("test", interpreter.NewUnmeteredStringValue(input))
+		require.NoError(t, err)
+
+		if expected == nil {
+			expected = interpreter.NilValue{}
+		} else {
+			expected = interpreter.NewUnmeteredSomeValueNonCopying(expected)
+		}
+
+		RequireValuesEqual(t, inter, expected, result)
+	}
+
+	for typeName, value := range testIntegerTypesAndValues {
+		t.Run(typeName, func(t *testing.T) {
+			test(t, typeName, "50", value)
+		})
+	}
+
+	type testCase struct {
+		typeName string
+		input    string
+		expected interpreter.Value
+	}
+
+	for _, testCase := range []testCase{
+		{"Int", "0", interpreter.NewUnmeteredIntValueFromInt64(0)},
+		{"Int", "-123", interpreter.NewUnmeteredIntValueFromInt64(-123)},
+		{"Int", "-0", nil},
+		{"Int", "+1", nil},
+		{"Int", "01", nil},
+		{"Int", "1_000", nil},
+		{"Int", " 1", nil},
+		{"Int", "0x1", nil},
+		{"Int", "", nil},
+		{"Int", "-", nil},
+		{"Int8", "-128", interpreter.NewUnmeteredInt8Value(math.MinInt8)},
+		{"Int8", "-129", nil},
+		{"Int8", "128", nil},
+		{"UInt", "-1", nil},
+		{"UInt8", "255", interpreter.NewUnmeteredUInt8Value(math.MaxUint8)},
+		{"UInt8", "256", nil},
+		{"UInt64", "18446744073709551615", interpreter.NewUnmeteredUInt64Value(math.MaxUint64)},
+		{"UInt64", "18446744073709551616", nil},
+		{"Word8", "-1", nil},
+	} {
+		t.Run(fmt.Sprintf("%s %q", testCase.typeName, testCase.input), func(t *testing.T) {
+			test(t, testCase.typeName, testCase.input, testCase.expected)
+		})
+	}
+}
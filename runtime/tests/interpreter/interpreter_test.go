@@ -5623,6 +5623,125 @@ func TestInterpretDictionaryContainsKey(t *testing.T) {
 	)
 }
 
+func TestInterpretDictionaryForEachKey(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun sumKeys(): Int {
+          let x = {1: "one", 2: "two", 3: "three"}
+          var sum = 0
+          x.forEachKey(fun (key: Int): Bool {
+              sum = sum + key
+              return true
+          })
+          return sum
+      }
+
+      fun countUntilFalse(): Int {
+          let x = {1: "one", 2: "two", 3: "three"}
+          var count = 0
+          x.forEachKey(fun (key: Int): Bool {
+              count = count + 1
+              return false
+          })
+          return count
+      }
+
+      let x = {1: "one", 2: "two", 3: "three", 4: "four"}
+
+      fun iteratedKeys(): [Int] {
+          let keys: [Int] = []
+          x.forEachKey(fun (key: Int): Bool {
+              keys.append(key)
+              return true
+          })
+          return keys
+      }
+
+      fun keys(): [Int] {
+          return x.keys
+      }
+    `)
+
+	value, err := inter.Invoke("sumKeys")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredIntValueFromInt64(6),
+		value,
+	)
+
+	value, err = inter.Invoke("countUntilFalse")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredIntValueFromInt64(1),
+		value,
+	)
+
+	value, err = inter.Invoke("iteratedKeys")
+	require.NoError(t, err)
+
+	expected, err := inter.Invoke("keys")
+	require.NoError(t, err)
+
+	AssertValuesEqual(t, inter, expected, value)
+}
+
+func TestInterpretDictionaryForEachValue(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let x = {1: "one", 2: "two", 3: "three", 4: "four"}
+
+      fun iteratedValues(): [String] {
+          let values: [String] = []
+          x.forEachValue(fun (value: String): Bool {
+              values.append(value)
+              return true
+          })
+          return values
+      }
+
+      fun values(): [String] {
+          return x.values
+      }
+    `)
+
+	value, err := inter.Invoke("iteratedValues")
+	require.NoError(t, err)
+
+	expected, err := inter.Invoke("values")
+	require.NoError(t, err)
+
+	AssertValuesEqual(t, inter, expected, value)
+}
+
+func TestInterpretDictionaryForEachKeyMutation(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun test() {
+          let x = {1: "one", 2: "two"}
+          x.forEachKey(fun (key: Int): Bool {
+              x.remove(key: key)
+              return true
+          })
+      }
+    `)
+
+	_, err := inter.Invoke("test")
+	require.Error(t, err)
+	require.ErrorAs(t, err, &interpreter.ContainerMutatedDuringIterationError{})
+}
+
 func TestInterpretStringConcat(t *testing.T) {
 
 	t.Parallel()
@@ -6859,6 +6978,14 @@ func TestInterpretEmitEventParameterTypes(t *testing.T) {
 			value: interpreter.NewUnmeteredUFix64Value(123000000),
 			ty:    sema.UFix64Type,
 		},
+		"Fix128": {
+			value: interpreter.NewUnmeteredFix128ValueFromBigInt(mustParseFix128("1.23")),
+			ty:    sema.Fix128Type,
+		},
+		"UFix128": {
+			value: interpreter.NewUnmeteredUFix128ValueFromBigInt(mustParseFix128("1.23")),
+			ty:    sema.UFix128Type,
+		},
 		// TODO:
 		//// Struct
 		//"S": {
@@ -7151,16 +7278,22 @@ func TestInterpretReferenceExpression(t *testing.T) {
 
 	t.Parallel()
 
-	inter := parseCheckAndInterpret(t, `
-      resource R {}
+	inter, err := parseCheckAndInterpretWithOptions(t,
+		`
+          resource R {}
 
-      fun test(): &R {
-          let r <- create R()
-          let ref = &r as &R
-          destroy r
-          return ref
-      }
-    `)
+          fun test(): &R {
+              let r <- create R()
+              let ref = &r as &R
+              destroy r
+              return ref
+          }
+	    `,
+		ParseCheckAndInterpretOptions{
+			HandleCheckerError: expectInvalidatedReferenceErrors(t, 1),
+		},
+	)
+	require.NoError(t, err)
 
 	value, err := inter.Invoke("test")
 	require.NoError(t, err)
@@ -7283,20 +7416,26 @@ func TestInterpretReferenceDereferenceFailure(t *testing.T) {
 
 	t.Parallel()
 
-	inter := parseCheckAndInterpret(t, `
-      pub resource R {
-          pub fun foo() {}
-      }
+	inter, err := parseCheckAndInterpretWithOptions(t,
+		`
+          pub resource R {
+              pub fun foo() {}
+          }
 
-      pub fun test() {
-          let r <- create R()
-          let ref = &r as &R
-          destroy r
-          ref.foo()
-      }
-    `)
+          pub fun test() {
+              let r <- create R()
+              let ref = &r as &R
+              destroy r
+              ref.foo()
+          }
+	    `,
+		ParseCheckAndInterpretOptions{
+			HandleCheckerError: expectInvalidatedReferenceErrors(t, 1),
+		},
+	)
+	require.NoError(t, err)
 
-	_, err := inter.Invoke("test")
+	_, err = inter.Invoke("test")
 	require.Error(t, err)
 
 	require.ErrorAs(t, err, &interpreter.DestroyedResourceError{})
@@ -7412,13 +7551,14 @@ func TestInterpretResourceMovingAndBorrowing(t *testing.T) {
                     destroy self.r2
                 }
 
-                fun moveToStack_Borrow_AndMoveBack(): &R2 {
+                fun moveToStack_Borrow_AndMoveBack(): String {
                     // The second assignment should not lead to the resource being cleared
                     let optR2 <- self.r2 <- nil
                     let r2 <- optR2!
                     let ref = &r2 as &R2
+                    let value = ref.value
                     self.r2 <-! r2
-                    return ref
+                    return value
                 }
             }
 
@@ -7426,9 +7566,8 @@ func TestInterpretResourceMovingAndBorrowing(t *testing.T) {
                 let r2 <- create R2()
                 let r1 <- create R1()
                 r1.r2 <-! r2
-                let ref = r1.moveToStack_Borrow_AndMoveBack()
+                let refValue = r1.moveToStack_Borrow_AndMoveBack()
                 let value = r1.r2?.value
-                let refValue = ref.value
                 destroy r1
                 return [value, refValue]
             }
@@ -7485,13 +7624,14 @@ func TestInterpretResourceMovingAndBorrowing(t *testing.T) {
                     destroy self.r2
                 }
 
-                fun moveToStack_Borrow_AndMoveBack(): &R2 {
+                fun moveToStack_Borrow_AndMoveBack(): String {
                     // The second assignment should not lead to the resource being cleared
                     let optR2 <- self.r2 <- nil
                     let r2 <- optR2!
                     let ref = &r2 as &R2
+                    let value = ref.value
                     self.r2 <-! r2
-                    return ref
+                    return value
                 }
             }
 
@@ -7502,9 +7642,8 @@ func TestInterpretResourceMovingAndBorrowing(t *testing.T) {
             fun test(r1: &R1): [String?] {
                 let r2 <- create R2()
                 r1.r2 <-! r2
-                let ref = r1.moveToStack_Borrow_AndMoveBack()
+                let refValue = r1.moveToStack_Borrow_AndMoveBack()
                 let value = r1.r2?.value
-                let refValue = ref.value
                 return [value, refValue]
             }
         `)
@@ -8258,7 +8397,7 @@ func TestInterpretNonStorageReferenceAfterDestruction(t *testing.T) {
 
 	t.Parallel()
 
-	inter := parseCheckAndInterpret(t,
+	inter, err := parseCheckAndInterpretWithOptions(t,
 		`
           resource NFT {
               var id: Int
@@ -8275,9 +8414,13 @@ func TestInterpretNonStorageReferenceAfterDestruction(t *testing.T) {
               return nftRef.id
           }
         `,
+		ParseCheckAndInterpretOptions{
+			HandleCheckerError: expectInvalidatedReferenceErrors(t, 1),
+		},
 	)
+	require.NoError(t, err)
 
-	_, err := inter.Invoke("test")
+	_, err = inter.Invoke("test")
 	require.Error(t, err)
 
 	require.ErrorAs(t, err, &interpreter.DestroyedResourceError{})
@@ -8551,176 +8694,224 @@ func TestInterpretReferenceUseAfterCopy(t *testing.T) {
 
 		t.Parallel()
 
-		inter := parseCheckAndInterpret(t, `
-          resource R {
-              var name: String
-              init(name: String) {
-                  self.name = name
+		inter, err := parseCheckAndInterpretWithOptions(t,
+			`
+              resource R {
+                  var name: String
+                  init(name: String) {
+                      self.name = name
+                  }
               }
-          }
 
-          fun test() {
-              let r <- create R(name: "1")
-              let ref = &r as &R
-              let container <- [<-r]
-              ref.name = "2"
-              destroy container
-          }
-        `)
-
-		_, err := inter.Invoke("test")
+              fun test() {
+                  let r <- create R(name: "1")
+                  let ref = &r as &R
+                  let container <- [<-r]
+                  ref.name = "2"
+                  destroy container
+              }
+		    `,
+			ParseCheckAndInterpretOptions{
+				HandleCheckerError: expectInvalidatedReferenceErrors(t, 1),
+			},
+		)
 		require.NoError(t, err)
 
+		_, err = inter.Invoke("test")
+		require.ErrorAs(t, err, &interpreter.InvalidatedReferenceError{})
+
 	})
 
 	t.Run("resource, field read", func(t *testing.T) {
 
 		t.Parallel()
 
-		inter := parseCheckAndInterpret(t, `
-          resource R {
-              var name: String
-              init(name: String) {
-                  self.name = name
+		inter, err := parseCheckAndInterpretWithOptions(t,
+			`
+              resource R {
+                  var name: String
+                  init(name: String) {
+                      self.name = name
+                  }
               }
-          }
-
-          fun test(): String {
-              let r <- create R(name: "1")
-              let ref = &r as &R
-              let container <- [<-r]
-              let name = ref.name
-              destroy container
-              return name
-          }
-        `)
 
-		_, err := inter.Invoke("test")
+              fun test(): String {
+                  let r <- create R(name: "1")
+                  let ref = &r as &R
+                  let container <- [<-r]
+                  let name = ref.name
+                  destroy container
+                  return name
+              }
+		    `,
+			ParseCheckAndInterpretOptions{
+				HandleCheckerError: expectInvalidatedReferenceErrors(t, 1),
+			},
+		)
 		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.ErrorAs(t, err, &interpreter.InvalidatedReferenceError{})
 	})
 
 	t.Run("resource array, insert", func(t *testing.T) {
 
 		t.Parallel()
 
-		inter := parseCheckAndInterpret(t, `
-          resource R {}
-
-          fun test() {
-              let rs <- [<-create R()]
-              let ref = &rs as &[R]
-              let container <- [<-rs]
-              ref.insert(at: 1, <-create R())
-              destroy container
-          }
-        `)
-
-		_, err := inter.Invoke("test")
+		inter, err := parseCheckAndInterpretWithOptions(t,
+			`
+              resource R {}
+
+              fun test() {
+                  let rs <- [<-create R()]
+                  let ref = &rs as &[R]
+                  let container <- [<-rs]
+                  ref.insert(at: 1, <-create R())
+                  destroy container
+              }
+		    `,
+			ParseCheckAndInterpretOptions{
+				HandleCheckerError: expectInvalidatedReferenceErrors(t, 1),
+			},
+		)
 		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.ErrorAs(t, err, &interpreter.InvalidatedReferenceError{})
 	})
 
 	t.Run("resource array, append", func(t *testing.T) {
 
 		t.Parallel()
 
-		inter := parseCheckAndInterpret(t, `
-          resource R {}
-
-          fun test() {
-              let rs <- [<-create R()]
-              let ref = &rs as &[R]
-              let container <- [<-rs]
-              ref.append(<-create R())
-              destroy container
-          }
-        `)
-
-		_, err := inter.Invoke("test")
+		inter, err := parseCheckAndInterpretWithOptions(t,
+			`
+              resource R {}
+
+              fun test() {
+                  let rs <- [<-create R()]
+                  let ref = &rs as &[R]
+                  let container <- [<-rs]
+                  ref.append(<-create R())
+                  destroy container
+              }
+		    `,
+			ParseCheckAndInterpretOptions{
+				HandleCheckerError: expectInvalidatedReferenceErrors(t, 1),
+			},
+		)
 		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.ErrorAs(t, err, &interpreter.InvalidatedReferenceError{})
 	})
 
 	t.Run("resource array, get/set", func(t *testing.T) {
 
 		t.Parallel()
 
-		inter := parseCheckAndInterpret(t, `
-          resource R {}
-
-          fun test() {
-              let rs <- [<-create R()]
-              let ref = &rs as &[R]
-              let container <- [<-rs]
-              var r <- create R()
-              ref[0] <-> r
-              destroy container
-              destroy r
-          }
-        `)
-
-		_, err := inter.Invoke("test")
+		inter, err := parseCheckAndInterpretWithOptions(t,
+			`
+              resource R {}
+
+              fun test() {
+                  let rs <- [<-create R()]
+                  let ref = &rs as &[R]
+                  let container <- [<-rs]
+                  var r <- create R()
+                  ref[0] <-> r
+                  destroy container
+                  destroy r
+              }
+		    `,
+			ParseCheckAndInterpretOptions{
+				HandleCheckerError: expectInvalidatedReferenceErrors(t, 1),
+			},
+		)
 		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.ErrorAs(t, err, &interpreter.InvalidatedReferenceError{})
 	})
 
 	t.Run("resource array, remove", func(t *testing.T) {
 
 		t.Parallel()
 
-		inter := parseCheckAndInterpret(t, `
-          resource R {}
-
-          fun test() {
-              let rs <- [<-create R()]
-              let ref = &rs as &[R]
-              let container <- [<-rs]
-              let r <- ref.remove(at: 0)
-              destroy container
-              destroy r
-          }
-        `)
-
-		_, err := inter.Invoke("test")
+		inter, err := parseCheckAndInterpretWithOptions(t,
+			`
+              resource R {}
+
+              fun test() {
+                  let rs <- [<-create R()]
+                  let ref = &rs as &[R]
+                  let container <- [<-rs]
+                  let r <- ref.remove(at: 0)
+                  destroy container
+                  destroy r
+              }
+		    `,
+			ParseCheckAndInterpretOptions{
+				HandleCheckerError: expectInvalidatedReferenceErrors(t, 1),
+			},
+		)
 		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.ErrorAs(t, err, &interpreter.InvalidatedReferenceError{})
 	})
 
 	t.Run("resource dictionary, insert", func(t *testing.T) {
 
 		t.Parallel()
 
-		inter := parseCheckAndInterpret(t, `
-          resource R {}
-
-          fun test() {
-              let rs <- {0: <-create R()}
-              let ref = &rs as &{Int: R}
-              let container <- [<-rs]
-              ref[1] <-! create R()
-              destroy container
-          }
-        `)
-
-		_, err := inter.Invoke("test")
+		inter, err := parseCheckAndInterpretWithOptions(t,
+			`
+              resource R {}
+
+              fun test() {
+                  let rs <- {0: <-create R()}
+                  let ref = &rs as &{Int: R}
+                  let container <- [<-rs]
+                  ref[1] <-! create R()
+                  destroy container
+              }
+		    `,
+			ParseCheckAndInterpretOptions{
+				HandleCheckerError: expectInvalidatedReferenceErrors(t, 1),
+			},
+		)
 		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.ErrorAs(t, err, &interpreter.InvalidatedReferenceError{})
 	})
 
 	t.Run("resource dictionary, remove", func(t *testing.T) {
 
 		t.Parallel()
 
-		inter := parseCheckAndInterpret(t, `
-          resource R {}
-
-          fun test() {
-              let rs <- {0: <-create R()}
-              let ref = &rs as &{Int: R}
-              let container <- [<-rs]
-              let r <- ref.remove(key: 0)
-              destroy container
-              destroy r
-          }
-        `)
-
-		_, err := inter.Invoke("test")
+		inter, err := parseCheckAndInterpretWithOptions(t,
+			`
+              resource R {}
+
+              fun test() {
+                  let rs <- {0: <-create R()}
+                  let ref = &rs as &{Int: R}
+                  let container <- [<-rs]
+                  let r <- ref.remove(key: 0)
+                  destroy container
+                  destroy r
+              }
+		    `,
+			ParseCheckAndInterpretOptions{
+				HandleCheckerError: expectInvalidatedReferenceErrors(t, 1),
+			},
+		)
 		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.ErrorAs(t, err, &interpreter.InvalidatedReferenceError{})
 	})
 
 	t.Run("struct, field write and read", func(t *testing.T) {
@@ -8891,6 +9082,15 @@ func newTestAuthAccountValue(
 				panicFunction,
 			)
 		},
+		func() interpreter.Value {
+			return interpreter.NewAuthAccountInboxValue(
+				inter,
+				addressValue,
+				panicFunction,
+				panicFunction,
+				panicFunction,
+			)
+		},
 	)
 }
 
@@ -9838,6 +10038,219 @@ func TestInterpretArrayFirstIndexDoesNotExist(t *testing.T) {
 	)
 }
 
+func TestInterpretArraySort(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("integers", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): [Int] {
+              let xs = [3, 1, 4, 1, 5, 9, 2, 6]
+              xs.sort(by: fun (a: Int, b: Int): Bool {
+                  return a < b
+              })
+              return xs
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.ReturnEmptyLocationRange,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+				},
+				common.Address{},
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+				interpreter.NewUnmeteredIntValueFromInt64(2),
+				interpreter.NewUnmeteredIntValueFromInt64(3),
+				interpreter.NewUnmeteredIntValueFromInt64(4),
+				interpreter.NewUnmeteredIntValueFromInt64(5),
+				interpreter.NewUnmeteredIntValueFromInt64(6),
+				interpreter.NewUnmeteredIntValueFromInt64(9),
+			),
+			value,
+		)
+	})
+
+	t.Run("stable, nested arrays", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): [[Int]] {
+              let xs = [[2, 1], [1, 2], [2, 2], [1, 1]]
+              xs.sort(by: fun (a: [Int], b: [Int]): Bool {
+                  return a[0] < b[0]
+              })
+              return xs
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		newArray := func(values ...int64) *interpreter.ArrayValue {
+			elements := make([]interpreter.Value, len(values))
+			for i, value := range values {
+				elements[i] = interpreter.NewUnmeteredIntValueFromInt64(value)
+			}
+			return interpreter.NewArrayValue(
+				inter,
+				interpreter.ReturnEmptyLocationRange,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+				},
+				common.Address{},
+				elements...,
+			)
+		}
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.ReturnEmptyLocationRange,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.VariableSizedStaticType{
+						Type: interpreter.PrimitiveStaticTypeInt,
+					},
+				},
+				common.Address{},
+				newArray(1, 2),
+				newArray(1, 1),
+				newArray(2, 1),
+				newArray(2, 2),
+			),
+			value,
+		)
+	})
+
+	t.Run("mutation in comparison function", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let xs = [3, 1, 2]
+
+          fun test() {
+              xs.sort(by: fun (a: Int, b: Int): Bool {
+                  xs.append(a)
+                  return a < b
+              })
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.Error(t, err)
+		require.ErrorAs(t, err, &interpreter.ContainerMutatedDuringIterationError{})
+	})
+
+	t.Run("elements are not transferred", func(t *testing.T) {
+
+		t.Parallel()
+
+		var sorting bool
+		var transferredArrays uint
+
+		inter, err := parseCheckAndInterpretWithOptions(t,
+			`
+              let xs = [[3], [1], [2]]
+
+              fun test() {
+                  xs.sort(by: fun (a: [Int], b: [Int]): Bool {
+                      return a[0] < b[0]
+                  })
+              }
+            `,
+			ParseCheckAndInterpretOptions{
+				Options: []interpreter.Option{
+					interpreter.WithOnMeterComputationFuncHandler(
+						func(compKind common.ComputationKind, intensity uint) {
+							if sorting && compKind == common.ComputationKindTransferArrayValue {
+								transferredArrays++
+							}
+						},
+					),
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		xs := inter.Globals["xs"].GetValue().(*interpreter.ArrayValue)
+
+		storageIDs := map[atree.StorageID]int{}
+		for index := 0; index < xs.Count(); index++ {
+			element := xs.Get(inter, interpreter.ReturnEmptyLocationRange, index).(*interpreter.ArrayValue)
+			storageIDs[element.StorageID()] = index
+		}
+
+		sorting = true
+		_, err = inter.Invoke("test")
+		require.NoError(t, err)
+		sorting = false
+
+		assert.Equal(t, uint(0), transferredArrays)
+
+		var sortedIndices []int
+		for index := 0; index < xs.Count(); index++ {
+			element := xs.Get(inter, interpreter.ReturnEmptyLocationRange, index).(*interpreter.ArrayValue)
+			sortedIndices = append(sortedIndices, storageIDs[element.StorageID()])
+		}
+
+		assert.Equal(t, []int{1, 2, 0}, sortedIndices)
+	})
+}
+
+func TestInterpretArrayBinarySearch(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let xs: [UInt64] = [1, 3, 5, 7, 9, 11]
+
+      fun find(_ x: UInt64): Int? {
+          return xs.binarySearch(x)
+      }
+    `)
+
+	for index, element := range []uint64{1, 3, 5, 7, 9, 11} {
+		value, err := inter.Invoke("find", interpreter.NewUnmeteredUInt64Value(element))
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredSomeValueNonCopying(
+				interpreter.NewUnmeteredIntValueFromInt64(int64(index)),
+			),
+			value,
+		)
+	}
+
+	for _, element := range []uint64{0, 4, 12} {
+		value, err := inter.Invoke("find", interpreter.NewUnmeteredUInt64Value(element))
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NilValue{},
+			value,
+		)
+	}
+}
+
 func TestInterpretOptionalReference(t *testing.T) {
 
 	t.Parallel()
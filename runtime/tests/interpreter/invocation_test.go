@@ -21,9 +21,11 @@ package interpreter_test
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/cadence/runtime/interpreter"
+	. "github.com/onflow/cadence/runtime/tests/utils"
 )
 
 func TestInterpretFunctionInvocationCheckArgumentTypes(t *testing.T) {
@@ -41,3 +43,192 @@ func TestInterpretFunctionInvocationCheckArgumentTypes(t *testing.T) {
 
 	require.ErrorAs(t, err, &interpreter.ValueTransferTypeError{})
 }
+
+func TestInterpretViewFunctionInvocation(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+       struct S {
+           let x: Int
+
+           view init(x: Int) {
+               self.x = x
+           }
+
+           view fun double(): Int {
+               return self.x * 2
+           }
+       }
+
+       fun apply(_ f: ((Int): Int), _ x: Int): Int {
+           return f(x)
+       }
+
+       view fun test(): Int {
+           let values = [1, 2]
+           values[0] = S(x: values[1]).double()
+           let add = view fun (_ y: Int): Int {
+               return values[0] + y
+           }
+           return add(1)
+       }
+
+       fun testApply(): Int {
+           return apply(view fun (_ x: Int): Int { return x + 1 }, 41)
+       }
+   `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredIntValueFromInt64(5),
+		value,
+	)
+
+	value, err = inter.Invoke("testApply")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredIntValueFromInt64(42),
+		value,
+	)
+}
+
+func TestInterpretCallStackDepthLimit(t *testing.T) {
+
+	t.Parallel()
+
+	inter, err := parseCheckAndInterpretWithOptions(t,
+		`
+          fun recurse(_ n: Int): Int {
+              if n == 0 {
+                  return 0
+              }
+              return recurse(n - 1)
+          }
+        `,
+		ParseCheckAndInterpretOptions{
+			Options: []interpreter.Option{
+				interpreter.WithCallStackDepthLimit(10),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	value, err := inter.Invoke("recurse", interpreter.NewUnmeteredIntValueFromInt64(9))
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredIntValueFromInt64(0),
+		value,
+	)
+
+	_, err = inter.Invoke("recurse", interpreter.NewUnmeteredIntValueFromInt64(10))
+	require.Error(t, err)
+
+	var limitErr interpreter.CallStackDepthLimitExceededError
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, uint64(10), limitErr.Limit)
+}
+
+func TestInterpretDefaultCallStackDepthLimit(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun recurse(_ n: Int): Int {
+          if n == 0 {
+              return 0
+          }
+          let f = fun (_ n: Int): Int {
+              return recurse(n - 1)
+          }
+          return f(n)
+      }
+    `)
+
+	// Each level of the recursion invokes two functions
+
+	value, err := inter.Invoke(
+		"recurse",
+		interpreter.NewUnmeteredIntValueFromInt64(interpreter.DefaultCallStackDepthLimit/2-1),
+	)
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredIntValueFromInt64(0),
+		value,
+	)
+
+	// Infinite recursion must result in an error, not a Go stack overflow
+
+	_, err = inter.Invoke("recurse", interpreter.NewUnmeteredIntValueFromInt64(-1))
+	require.Error(t, err)
+
+	var limitErr interpreter.CallStackDepthLimitExceededError
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, uint64(interpreter.DefaultCallStackDepthLimit), limitErr.Limit)
+}
+
+func TestInterpretErrorCallStack(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      struct S {
+          fun fail(): Int {
+              let x: Int? = nil
+              return x!
+          }
+      }
+
+      fun test(): Int {
+          let apply = fun (_ s: S): Int {
+              return s.fail()
+          }
+          return apply(S())
+      }
+    `)
+
+	_, err := inter.Invoke("test")
+	require.Error(t, err)
+
+	var interpreterErr interpreter.Error
+	require.ErrorAs(t, err, &interpreterErr)
+
+	assert.Equal(t,
+		[]interpreter.StackFrame{
+			{
+				FunctionName: "S.fail",
+				Location:     TestLocation,
+				Line:         5,
+			},
+			{
+				FunctionName: "",
+				Location:     TestLocation,
+				Line:         11,
+			},
+			{
+				FunctionName: "test",
+				Location:     TestLocation,
+				Line:         13,
+			},
+		},
+		interpreterErr.CallStack(),
+	)
+
+	assert.Equal(t,
+		"<anonymous> (test:11)",
+		interpreterErr.CallStack()[1].String(),
+	)
+}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/onflow/cadence/runtime/interpreter"
 	"github.com/onflow/cadence/runtime/tests/checker"
+	. "github.com/onflow/cadence/runtime/tests/utils"
 )
 
 func TestInterpretMemberAccessType(t *testing.T) {
@@ -556,3 +557,65 @@ func TestInterpretMemberAccessType(t *testing.T) {
 		})
 	})
 }
+
+func TestInterpretMemberInlineCache(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      struct interface Shape {
+          fun area(): Int
+      }
+
+      struct Square: Shape {
+          let side: Int
+
+          init(side: Int) {
+              self.side = side
+          }
+
+          fun area(): Int {
+              return self.side * self.side
+          }
+      }
+
+      struct Rectangle: Shape {
+          let width: Int
+          let height: Int
+
+          init(width: Int, height: Int) {
+              self.width = width
+              self.height = height
+          }
+
+          fun area(): Int {
+              return self.width * self.height
+          }
+      }
+
+      fun test(): Int {
+          let shapes: [{Shape}] = [
+              Square(side: 2),
+              Square(side: 3),
+              Rectangle(width: 2, height: 5),
+              Square(side: 4)
+          ]
+          var total = 0
+          for shape in shapes {
+              // The call site is invoked with values of different types
+              total = total + shape.area()
+          }
+          return total
+      }
+    `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredIntValueFromInt64(39),
+		value,
+	)
+}
@@ -703,8 +703,8 @@ func TestInterpretSimpleCompositeMetering(t *testing.T) {
 		require.NoError(t, err)
 
 		assert.Equal(t, uint64(1), meter.getMemory(common.MemoryKindSimpleCompositeValueBase))
-		// AuthAccount has 18 fields
-		assert.Equal(t, uint64(18), meter.getMemory(common.MemoryKindSimpleCompositeValue))
+		// AuthAccount has 23 fields
+		assert.Equal(t, uint64(23), meter.getMemory(common.MemoryKindSimpleCompositeValue))
 	})
 
 	t.Run("public account", func(t *testing.T) {
@@ -723,8 +723,8 @@ func TestInterpretSimpleCompositeMetering(t *testing.T) {
 		require.NoError(t, err)
 
 		assert.Equal(t, uint64(1), meter.getMemory(common.MemoryKindSimpleCompositeValueBase))
-		// PublicAccount has 9 fields
-		assert.Equal(t, uint64(9), meter.getMemory(common.MemoryKindSimpleCompositeValue))
+		// PublicAccount has 10 fields
+		assert.Equal(t, uint64(10), meter.getMemory(common.MemoryKindSimpleCompositeValue))
 	})
 }
 
@@ -9721,6 +9721,9 @@ func TestInterpretStaticTypeStringConversion(t *testing.T) {
 				interpreter.PrimitiveStaticTypeAuthAccountKeys,
 				interpreter.PrimitiveStaticTypePublicAccountKeys,
 				interpreter.PrimitiveStaticTypeAccountKey,
+				interpreter.PrimitiveStaticTypeAuthAccountCapabilities,
+				interpreter.PrimitiveStaticTypeAuthAccountStorageCapabilities,
+				interpreter.PrimitiveStaticTypeAuthAccountInbox,
 				interpreter.PrimitiveStaticType_Count:
 				continue
 			case interpreter.PrimitiveStaticTypeAnyResource:
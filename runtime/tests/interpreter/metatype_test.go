@@ -524,6 +524,47 @@ func TestInterpretIsSubtype(t *testing.T) {
             `,
 			result: false,
 		},
+		{
+			name: "missing type is not a subtype of AnyStruct",
+			code: `
+              let result = missingType.isSubtype(of: Type<AnyStruct>())
+            `,
+			result: false,
+		},
+		{
+			name: "AnyStruct is not a subtype of missing type",
+			code: `
+              let result = Type<AnyStruct>().isSubtype(of: missingType)
+            `,
+			result: false,
+		},
+		{
+			name: "resource is a subtype of restricted type",
+			code: `
+              resource interface I {}
+              resource R: I {}
+              let result = Type<@R>().isSubtype(of: Type<@AnyResource{I}>())
+            `,
+			result: true,
+		},
+		{
+			name: "resource is not a subtype of unconformed restricted type",
+			code: `
+              resource interface I {}
+              resource R {}
+              let result = Type<@R>().isSubtype(of: Type<@AnyResource{I}>())
+            `,
+			result: false,
+		},
+		{
+			name: "composite type from identifier is a subtype of interface type from identifier",
+			code: `
+              resource interface I {}
+              resource R: I {}
+              let result = CompositeType("S.test.R")!.isSubtype(of: InterfaceType("S.test.I")!)
+            `,
+			result: true,
+		},
 	}
 
 	valueDeclarations := stdlib.StandardLibraryValues{
@@ -537,6 +578,20 @@ func TestInterpretIsSubtype(t *testing.T) {
 			},
 			Kind: common.DeclarationKindConstant,
 		},
+		{
+			Name: "missingType",
+			Type: sema.MetaType,
+			ValueFactory: func(_ *interpreter.Interpreter) interpreter.Value {
+				return interpreter.TypeValue{
+					Type: interpreter.CompositeStaticType{
+						Location:            TestLocation,
+						QualifiedIdentifier: "Missing",
+						TypeID:              "S.test.Missing",
+					},
+				}
+			},
+			Kind: common.DeclarationKindConstant,
+		},
 	}
 
 	semaValueDeclarations := valueDeclarations.ToSemaValueDeclarations()
@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/onflow/atree"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/cadence/runtime/common"
@@ -434,6 +435,18 @@ func TestInterpretContainerVariance(t *testing.T) {
 	})
 }
 
+// expectInvalidatedReferenceErrors returns a checker error handler
+// which expects the given number of invalidated reference errors
+func expectInvalidatedReferenceErrors(t *testing.T, count int) func(error) {
+	return func(err error) {
+		errs := checker.ExpectCheckerErrors(t, err, count)
+
+		for _, err := range errs {
+			require.IsType(t, &sema.InvalidatedReferenceError{}, err)
+		}
+	}
+}
+
 func TestInterpretResourceReferenceAfterMove(t *testing.T) {
 
 	t.Parallel()
@@ -442,22 +455,28 @@ func TestInterpretResourceReferenceAfterMove(t *testing.T) {
 
 		t.Parallel()
 
-		inter := parseCheckAndInterpret(t, `
-            resource R {
-                let value: String
+		inter, err := parseCheckAndInterpretWithOptions(t,
+			`
+                resource R {
+                    let value: String
 
-                init(value: String) {
-                    self.value = value
+                    init(value: String) {
+                        self.value = value
+                    }
                 }
-            }
 
-            fun test(target: &[R]): String {
-                let r <- create R(value: "testValue")
-                let ref = &r as &R
-                target.append(<-r)
-                return ref.value
-            }
-        `)
+                fun test(target: &[R]): String {
+                    let r <- create R(value: "testValue")
+                    let ref = &r as &R
+                    target.append(<-r)
+                    return ref.value
+                }
+            `,
+			ParseCheckAndInterpretOptions{
+				HandleCheckerError: expectInvalidatedReferenceErrors(t, 1),
+			},
+		)
+		require.NoError(t, err)
 
 		address := common.Address{0x1}
 
@@ -480,37 +499,36 @@ func TestInterpretResourceReferenceAfterMove(t *testing.T) {
 			},
 		}
 
-		value, err := inter.Invoke("test", arrayRef)
-		require.NoError(t, err)
-
-		AssertValuesEqual(
-			t,
-			inter,
-			interpreter.NewUnmeteredStringValue("testValue"),
-			value,
-		)
+		_, err = inter.Invoke("test", arrayRef)
+		require.ErrorAs(t, err, &interpreter.InvalidatedReferenceError{})
 	})
 
 	t.Run("array", func(t *testing.T) {
 
 		t.Parallel()
 
-		inter := parseCheckAndInterpret(t, `
-            resource R {
-                let value: String
+		inter, err := parseCheckAndInterpretWithOptions(t,
+			`
+                resource R {
+                    let value: String
 
-                init(value: String) {
-                    self.value = value
+                    init(value: String) {
+                        self.value = value
+                    }
                 }
-            }
 
-            fun test(target: &[[R]]): String {
-                let rs <- [<-create R(value: "testValue")]
-                let ref = &rs as &[R]
-                target.append(<-rs)
-                return ref[0].value
-            }
-        `)
+                fun test(target: &[[R]]): String {
+                    let rs <- [<-create R(value: "testValue")]
+                    let ref = &rs as &[R]
+                    target.append(<-rs)
+                    return ref[0].value
+                }
+            `,
+			ParseCheckAndInterpretOptions{
+				HandleCheckerError: expectInvalidatedReferenceErrors(t, 1),
+			},
+		)
+		require.NoError(t, err)
 
 		address := common.Address{0x1}
 
@@ -537,37 +555,36 @@ func TestInterpretResourceReferenceAfterMove(t *testing.T) {
 			},
 		}
 
-		value, err := inter.Invoke("test", arrayRef)
-		require.NoError(t, err)
-
-		AssertValuesEqual(
-			t,
-			inter,
-			interpreter.NewUnmeteredStringValue("testValue"),
-			value,
-		)
+		_, err = inter.Invoke("test", arrayRef)
+		require.ErrorAs(t, err, &interpreter.InvalidatedReferenceError{})
 	})
 
 	t.Run("dictionary", func(t *testing.T) {
 
 		t.Parallel()
 
-		inter := parseCheckAndInterpret(t, `
-            resource R {
-                let value: String
+		inter, err := parseCheckAndInterpretWithOptions(t,
+			`
+                resource R {
+                    let value: String
 
-                init(value: String) {
-                    self.value = value
+                    init(value: String) {
+                        self.value = value
+                    }
                 }
-            }
 
-            fun test(target: &[{Int: R}]): String? {
-                let rs <- {1: <-create R(value: "testValue")}
-                let ref = &rs as &{Int: R}
-                target.append(<-rs)
-                return ref[1]?.value
-            }
-        `)
+                fun test(target: &[{Int: R}]): String? {
+                    let rs <- {1: <-create R(value: "testValue")}
+                    let ref = &rs as &{Int: R}
+                    target.append(<-rs)
+                    return ref[1]?.value
+                }
+            `,
+			ParseCheckAndInterpretOptions{
+				HandleCheckerError: expectInvalidatedReferenceErrors(t, 1),
+			},
+		)
+		require.NoError(t, err)
 
 		address := common.Address{0x1}
 
@@ -596,17 +613,8 @@ func TestInterpretResourceReferenceAfterMove(t *testing.T) {
 			},
 		}
 
-		value, err := inter.Invoke("test", arrayRef)
-		require.NoError(t, err)
-
-		AssertValuesEqual(
-			t,
-			inter,
-			interpreter.NewUnmeteredSomeValueNonCopying(
-				interpreter.NewUnmeteredStringValue("testValue"),
-			),
-			value,
-		)
+		_, err = inter.Invoke("test", arrayRef)
+		require.ErrorAs(t, err, &interpreter.InvalidatedReferenceError{})
 	})
 }
 
@@ -618,56 +626,54 @@ func TestInterpretReferenceUseAfterShiftStatementMove(t *testing.T) {
 
 		t.Parallel()
 
-		inter := parseCheckAndInterpret(t, `
-          resource R2 {
-              let value: String
+		inter, err := parseCheckAndInterpretWithOptions(t,
+			`
+              resource R2 {
+                  let value: String
 
-              init() {
-                  self.value = "test"
+                  init() {
+                      self.value = "test"
+                  }
               }
-          }
 
-          resource R1 {
-              var r2: @R2?
+              resource R1 {
+                  var r2: @R2?
+
+                  init() {
+                      self.r2 <- nil
+                  }
 
-              init() {
-                  self.r2 <- nil
-              }
+                  destroy() {
+                      destroy self.r2
+                  }
 
-              destroy() {
-                  destroy self.r2
+                  fun borrowR2(): &R2? {
+                      let optR2 <- self.r2 <- nil
+                      let r2 <- optR2!
+                      let ref = &r2 as &R2
+                      self.r2 <-! r2
+                      return ref
+                  }
               }
 
-              fun borrowR2(): &R2? {
-                  let optR2 <- self.r2 <- nil
-                  let r2 <- optR2!
-                  let ref = &r2 as &R2
-                  self.r2 <-! r2
-                  return ref
+              fun test(): String {
+                  let r2 <- create R2()
+                  let r1 <- create R1()
+                  r1.r2 <-! r2
+                  let optRef = r1.borrowR2()
+                  let value = optRef!.value
+                  destroy r1
+                  return value
               }
-          }
-
-          fun test(): String {
-              let r2 <- create R2()
-              let r1 <- create R1()
-              r1.r2 <-! r2
-              let optRef = r1.borrowR2()
-              let value = optRef!.value
-              destroy r1
-              return value
-          }
-        `)
-
-		value, err := inter.Invoke("test")
-		require.NoError(t, err)
-
-		AssertValuesEqual(
-			t,
-			inter,
-			interpreter.NewUnmeteredStringValue("test"),
-			value,
+            `,
+			ParseCheckAndInterpretOptions{
+				HandleCheckerError: expectInvalidatedReferenceErrors(t, 1),
+			},
 		)
+		require.NoError(t, err)
 
+		_, err = inter.Invoke("test")
+		require.ErrorAs(t, err, &interpreter.InvalidatedReferenceError{})
 	})
 
 	t.Run("container in account", func(t *testing.T) {
@@ -725,6 +731,7 @@ func TestInterpretReferenceUseAfterShiftStatementMove(t *testing.T) {
               }
             `,
 			ParseCheckAndInterpretOptions{
+				HandleCheckerError: expectInvalidatedReferenceErrors(t, 1),
 				Options: []interpreter.Option{
 					interpreter.WithPublicAccountHandler(
 						newTestPublicAccountValue,
@@ -748,15 +755,8 @@ func TestInterpretReferenceUseAfterShiftStatementMove(t *testing.T) {
 
 		// Test
 
-		value, err := inter.Invoke("test", ref)
-		require.NoError(t, err)
-
-		AssertValuesEqual(
-			t,
-			inter,
-			interpreter.NewUnmeteredStringValue("test"),
-			value,
-		)
+		_, err = inter.Invoke("test", ref)
+		require.ErrorAs(t, err, &interpreter.InvalidatedReferenceError{})
 
 		// Check R1 owner
 
@@ -894,3 +894,295 @@ func TestInterpretReferenceExpressionOfOptional(t *testing.T) {
 		require.IsType(t, &interpreter.EphemeralReferenceValue{}, innerValue)
 	})
 }
+
+func TestInterpretReferenceEquality(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("same value, different borrow types", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          resource interface I {}
+
+          resource R: I {}
+
+          fun test(): Bool {
+              let r <- create R()
+              let ref1 = &r as &R
+              let ref2 = &r as &{I}
+              let ref3 = &r as auth &AnyResource
+              let equal = ref1 == ref2 && ref2 == ref3
+              destroy r
+              return equal
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		require.Equal(t, interpreter.BoolValue(true), value)
+	})
+
+	t.Run("different values", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct S {}
+
+          fun test(): Bool {
+              let s1 = S()
+              let s2 = S()
+              return &s1 as &S != &s2 as &S
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		require.Equal(t, interpreter.BoolValue(true), value)
+	})
+}
+
+func TestInterpretDereference(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("copy", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): [Int] {
+              let xs = [1, 2, 3]
+              let ref = &xs as &[Int]
+              let copy = *ref
+              copy.append(4)
+              return xs
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.ReturnEmptyLocationRange,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+				},
+				common.Address{},
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+				interpreter.NewUnmeteredIntValueFromInt64(2),
+				interpreter.NewUnmeteredIntValueFromInt64(3),
+			),
+			value,
+		)
+	})
+
+	t.Run("primitive", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let x = 42
+          let ref = &x as &Int
+          let y = *ref
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(42),
+			inter.Globals["y"].GetValue(),
+		)
+	})
+
+	t.Run("storage reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		address := interpreter.NewUnmeteredAddressValueFromBytes([]byte{42})
+
+		inter, _ := testAccount(
+			t,
+			address,
+			true,
+			`
+              struct S {
+                  var foo: Int
+
+                  init() {
+                      self.foo = 42
+                  }
+              }
+
+              fun test(): Int {
+                  account.save(S(), to: /storage/s)
+                  let ref = account.borrow<&S>(from: /storage/s)!
+                  let copy = *ref
+                  copy.foo = 1
+                  return ref.foo
+              }
+
+              fun dangling(): S {
+                  let ref = account.borrow<&S>(from: /storage/s)!
+                  account.load<S>(from: /storage/s)
+                  return *ref
+              }
+            `,
+		)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(42),
+			value,
+		)
+
+		_, err = inter.Invoke("dangling")
+		require.Error(t, err)
+
+		require.ErrorAs(t, err, &interpreter.DereferenceError{})
+	})
+}
+
+func TestInterpretInvalidatedReferenceUse(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("use before move", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          resource R {
+              let id: Int
+
+              init() {
+                  self.id = 1
+              }
+          }
+
+          fun identity(_ ref: &R): &R {
+              return ref
+          }
+
+          fun test(): Int {
+              let r <- create R()
+              let ref = identity(&r as &R)
+              let id = ref.id
+              let r2 <- r
+              destroy r2
+              return id
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(1),
+			value,
+		)
+	})
+
+	t.Run("use after move", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          resource R {
+              let id: Int
+
+              init() {
+                  self.id = 1
+              }
+          }
+
+          fun identity(_ ref: &R): &R {
+              return ref
+          }
+
+          fun test(): Int {
+              let r <- create R()
+              let ref = identity(&r as &R)
+              let r2 <- r
+              let id = ref.id
+              destroy r2
+              return id
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.Error(t, err)
+
+		var invalidatedReferenceErr interpreter.InvalidatedReferenceError
+		require.ErrorAs(t, err, &invalidatedReferenceErr)
+
+		assert.Equal(t, 18, invalidatedReferenceErr.StartPosition().Line)
+	})
+
+	t.Run("use after move of optional", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          resource R {}
+
+          fun identity(_ ref: &R?): &R? {
+              return ref
+          }
+
+          fun test() {
+              let r: @R? <- create R()
+              let ref = identity(&r as &R?)
+              let r2 <- r
+              ref!.uuid
+              destroy r2
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.Error(t, err)
+
+		require.ErrorAs(t, err, &interpreter.InvalidatedReferenceError{})
+	})
+
+	t.Run("array, use after move", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          resource R {}
+
+          fun identity(_ ref: &[R]): &[R] {
+              return ref
+          }
+
+          fun test(): Int {
+              let rs: @[R] <- []
+              let ref = identity(&rs as &[R])
+              let rs2 <- [<-rs]
+              let length = ref.length
+              destroy rs2
+              return length
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.Error(t, err)
+
+		require.ErrorAs(t, err, &interpreter.InvalidatedReferenceError{})
+	})
+}
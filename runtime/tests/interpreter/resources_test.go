@@ -19,6 +19,7 @@
 package interpreter_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -26,6 +27,7 @@ import (
 
 	"github.com/onflow/atree"
 
+	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/sema"
 	"github.com/onflow/cadence/runtime/tests/checker"
 	. "github.com/onflow/cadence/runtime/tests/utils"
@@ -2369,7 +2371,7 @@ func TestInterpretOptionalResourceReference(t *testing.T) {
 	)
 
 	_, err := inter.Invoke("test")
-	require.NoError(t, err)
+	require.ErrorAs(t, err, &interpreter.InvalidatedReferenceError{})
 }
 
 func TestInterpretArrayOptionalResourceReference(t *testing.T) {
@@ -2405,7 +2407,7 @@ func TestInterpretArrayOptionalResourceReference(t *testing.T) {
 	)
 
 	_, err := inter.Invoke("test")
-	require.NoError(t, err)
+	require.ErrorAs(t, err, &interpreter.InvalidatedReferenceError{})
 }
 
 func TestInterpretReferenceUseAfterTransferAndDestruction(t *testing.T) {
@@ -2430,119 +2432,143 @@ func TestInterpretReferenceUseAfterTransferAndDestruction(t *testing.T) {
 
 		t.Parallel()
 
-		inter := parseCheckAndInterpret(t, resourceCode+`
+		inter, err := parseCheckAndInterpretWithOptions(t,
+			resourceCode+`
 
-          fun test(): Int {
+              fun test(): Int {
 
-              let resources <- {
-                  "r": <-create R()
-              }
+                  let resources <- {
+                      "r": <-create R()
+                  }
 
-              let ref = &resources["r"] as &R?
-              let r <-resources.remove(key: "r")
-	          destroy r
-              destroy resources
+                  let ref = &resources["r"] as &R?
+                  let r <-resources.remove(key: "r")
+    	          destroy r
+                  destroy resources
 
-              ref!.increment()
-              return ref!.value
-          }
-        `)
+                  ref!.increment()
+                  return ref!.value
+              }
+            `,
+			ParseCheckAndInterpretOptions{
+				HandleCheckerError: expectInvalidatedReferenceErrors(t, 2),
+			},
+		)
+		require.NoError(t, err)
 
-		_, err := inter.Invoke("test")
+		_, err = inter.Invoke("test")
 
-		var invalidatedResourceErr interpreter.DestroyedResourceError
-		require.ErrorAs(t, err, &invalidatedResourceErr)
+		var invalidatedReferenceErr interpreter.InvalidatedReferenceError
+		require.ErrorAs(t, err, &invalidatedReferenceErr)
 
-		assert.Equal(t, 26, invalidatedResourceErr.StartPosition().Line)
+		assert.Equal(t, 26, invalidatedReferenceErr.StartPosition().Line)
 	})
 
 	t.Run("dictionary", func(t *testing.T) {
 
 		t.Parallel()
 
-		inter := parseCheckAndInterpret(t, resourceCode+`
+		inter, err := parseCheckAndInterpretWithOptions(t,
+			resourceCode+`
 
-          fun test(): Int {
+              fun test(): Int {
 
-              let resources <- {
-                  "nested": <-{"r": <-create R()}
-              }
+                  let resources <- {
+                      "nested": <-{"r": <-create R()}
+                  }
 
-              let ref = &resources["nested"] as &{String: R}?
-              let nested <-resources.remove(key: "nested")
-	          destroy nested
-              destroy resources
+                  let ref = &resources["nested"] as &{String: R}?
+                  let nested <-resources.remove(key: "nested")
+    	          destroy nested
+                  destroy resources
 
-              return ref!.length
-          }
-        `)
+                  return ref!.length
+              }
+            `,
+			ParseCheckAndInterpretOptions{
+				HandleCheckerError: expectInvalidatedReferenceErrors(t, 1),
+			},
+		)
+		require.NoError(t, err)
 
-		_, err := inter.Invoke("test")
+		_, err = inter.Invoke("test")
 		require.Error(t, err)
 
-		var invalidatedResourceErr interpreter.DestroyedResourceError
-		require.ErrorAs(t, err, &invalidatedResourceErr)
+		var invalidatedReferenceErr interpreter.InvalidatedReferenceError
+		require.ErrorAs(t, err, &invalidatedReferenceErr)
 
-		assert.Equal(t, 26, invalidatedResourceErr.StartPosition().Line)
+		assert.Equal(t, 26, invalidatedReferenceErr.StartPosition().Line)
 	})
 
 	t.Run("array", func(t *testing.T) {
 
 		t.Parallel()
 
-		inter := parseCheckAndInterpret(t, resourceCode+`
+		inter, err := parseCheckAndInterpretWithOptions(t,
+			resourceCode+`
 
-          fun test(): Int {
+              fun test(): Int {
 
-              let resources <- {
-                  "nested": <-[<-create R()]
-              }
+                  let resources <- {
+                      "nested": <-[<-create R()]
+                  }
 
-              let ref = &resources["nested"] as &[R]?
-              let nested <-resources.remove(key: "nested")
-	          destroy nested
-              destroy resources
+                  let ref = &resources["nested"] as &[R]?
+                  let nested <-resources.remove(key: "nested")
+    	          destroy nested
+                  destroy resources
 
-              return ref!.length
-          }
-        `)
+                  return ref!.length
+              }
+            `,
+			ParseCheckAndInterpretOptions{
+				HandleCheckerError: expectInvalidatedReferenceErrors(t, 1),
+			},
+		)
+		require.NoError(t, err)
 
-		_, err := inter.Invoke("test")
+		_, err = inter.Invoke("test")
 		require.Error(t, err)
 
-		var invalidatedResourceErr interpreter.DestroyedResourceError
-		require.ErrorAs(t, err, &invalidatedResourceErr)
+		var invalidatedReferenceErr interpreter.InvalidatedReferenceError
+		require.ErrorAs(t, err, &invalidatedReferenceErr)
 
-		assert.Equal(t, 26, invalidatedResourceErr.StartPosition().Line)
+		assert.Equal(t, 26, invalidatedReferenceErr.StartPosition().Line)
 	})
 
 	t.Run("optional", func(t *testing.T) {
 
 		t.Parallel()
 
-		inter := parseCheckAndInterpret(t, resourceCode+`
+		inter, err := parseCheckAndInterpretWithOptions(t,
+			resourceCode+`
 
-          fun test(): Int {
+              fun test(): Int {
 
-              let resources: @[R?] <- [<-create R()]
+                  let resources: @[R?] <- [<-create R()]
 
-              let ref = &resources[0] as &R?
-              let r <-resources.remove(at: 0)
-		      destroy r
-              destroy resources
+                  let ref = &resources[0] as &R?
+                  let r <-resources.remove(at: 0)
+    		      destroy r
+                  destroy resources
 
-              ref!.increment()
-              return ref!.value
-          }
-        `)
+                  ref!.increment()
+                  return ref!.value
+              }
+            `,
+			ParseCheckAndInterpretOptions{
+				HandleCheckerError: expectInvalidatedReferenceErrors(t, 2),
+			},
+		)
+		require.NoError(t, err)
 
-		_, err := inter.Invoke("test")
+		_, err = inter.Invoke("test")
 		require.Error(t, err)
 
-		var invalidatedResourceErr interpreter.DestroyedResourceError
-		require.ErrorAs(t, err, &invalidatedResourceErr)
+		var invalidatedReferenceErr interpreter.InvalidatedReferenceError
+		require.ErrorAs(t, err, &invalidatedReferenceErr)
 
-		assert.Equal(t, 24, invalidatedResourceErr.StartPosition().Line)
+		assert.Equal(t, 24, invalidatedReferenceErr.StartPosition().Line)
 	})
 }
 
@@ -2590,3 +2616,220 @@ func TestInterpretResourceDestroyedInPreCondition(t *testing.T) {
 	require.Error(t, err)
 	require.ErrorAs(t, err, &interpreter.InvalidatedResourceError{})
 }
+
+func TestInterpretResourceDestroyedEvent(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      resource Inner {
+          let name: String
+
+          init(name: String) {
+              self.name = name
+          }
+      }
+
+      resource R {
+          let id: Int
+          let inner: @Inner
+
+          init(id: Int) {
+              self.id = id
+              self.inner <- create Inner(name: "inner")
+          }
+
+          destroy() {
+              destroy self.inner
+          }
+      }
+
+      fun test() {
+          let r <- create R(id: 42)
+          destroy r
+      }
+    `)
+
+	type event struct {
+		typeID sema.TypeID
+		fields map[string]interpreter.Value
+	}
+
+	var events []event
+
+	inter.SetOnEventEmittedHandler(
+		func(
+			inter *interpreter.Interpreter,
+			getLocationRange func() interpreter.LocationRange,
+			eventValue *interpreter.CompositeValue,
+			eventType *sema.CompositeType,
+		) error {
+			fields := map[string]interpreter.Value{}
+			for _, fieldName := range eventType.Fields {
+				fields[fieldName] = eventValue.GetField(inter, getLocationRange, fieldName)
+			}
+
+			events = append(events, event{
+				typeID: eventType.ID(),
+				fields: fields,
+			})
+			return nil
+		},
+	)
+
+	_, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	require.Len(t, events, 2)
+
+	// The event for the outer resource is emitted before its destructor is run
+
+	assert.Equal(t, sema.TypeID("S.test.R.ResourceDestroyed"), events[0].typeID)
+	assert.Len(t, events[0].fields, 2)
+	assert.Contains(t, events[0].fields, sema.ResourceUUIDFieldName)
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredIntValueFromInt64(42),
+		events[0].fields["id"],
+	)
+
+	assert.Equal(t, sema.TypeID("S.test.Inner.ResourceDestroyed"), events[1].typeID)
+	assert.Len(t, events[1].fields, 2)
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredStringValue("inner"),
+		events[1].fields["name"],
+	)
+}
+
+func TestInterpretResourceLoss(t *testing.T) {
+
+	t.Parallel()
+
+	const code = `
+      resource R {}
+
+      fun fail(): @R {
+          let r: @R? <- nil
+          return <-r!
+      }
+
+      fun consume(_ r1: @R, _ r2: @R) {
+          destroy r1
+          destroy r2
+      }
+
+      fun testArguments() {
+          consume(
+              <-create R(),
+              <-fail()
+          )
+      }
+
+      fun testArray() {
+          let rs <- [
+              <-create R(),
+              <-fail()
+          ]
+          destroy rs
+      }
+
+      fun testDictionary() {
+          let rs <- {
+              "a": <-create R(),
+              "b": <-fail()
+          }
+          destroy rs
+      }
+
+      fun testNoTemporary() {
+          consume(
+              <-fail(),
+              <-create R()
+          )
+      }
+    `
+
+	t.Run("diagnostics disabled", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, code)
+
+		_, err := inter.Invoke("testArguments")
+		require.Error(t, err)
+
+		require.ErrorAs(t, err, &interpreter.ForceNilError{})
+		require.False(t, errors.As(err, &interpreter.ResourceLossError{}))
+	})
+
+	newInterpreter := func(t *testing.T) *interpreter.Interpreter {
+		inter, err := parseCheckAndInterpretWithOptions(t,
+			code,
+			ParseCheckAndInterpretOptions{
+				Options: []interpreter.Option{
+					interpreter.WithResourceLossDiagnosticsEnabled(true),
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		return inter
+	}
+
+	for _, functionName := range []string{
+		"testArguments",
+		"testArray",
+		"testDictionary",
+	} {
+
+		functionName := functionName
+
+		t.Run(functionName, func(t *testing.T) {
+
+			t.Parallel()
+
+			inter := newInterpreter(t)
+
+			_, err := inter.Invoke(functionName)
+			require.Error(t, err)
+
+			// The error which caused the loss is still available
+
+			require.ErrorAs(t, err, &interpreter.ForceNilError{})
+
+			var resourceLossErr interpreter.ResourceLossError
+			require.ErrorAs(t, err, &resourceLossErr)
+
+			// The lost resource was created in the first element
+
+			assert.Equal(t, common.TypeID("S.test.R"), resourceLossErr.TypeID)
+			assert.True(t, resourceLossErr.HasUUID)
+			assert.Equal(t, uint64(1), resourceLossErr.UUID)
+			assert.Contains(t,
+				resourceLossErr.Error(),
+				"loss of resource of type `S.test.R` with UUID 1: ",
+			)
+
+			startPosition := resourceLossErr.StartPosition()
+			assert.Equal(t, "<-create R()", code[startPosition.Offset:resourceLossErr.EndPosition(nil).Offset+1])
+		})
+	}
+
+	t.Run("no temporary", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newInterpreter(t)
+
+		// No resource is held in a temporary when the evaluation fails
+
+		_, err := inter.Invoke("testNoTemporary")
+		require.Error(t, err)
+
+		require.ErrorAs(t, err, &interpreter.ForceNilError{})
+		require.False(t, errors.As(err, &interpreter.ResourceLossError{}))
+	})
+}
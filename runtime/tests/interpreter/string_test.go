@@ -131,6 +131,170 @@ func TestInterpretStringEncodeHex(t *testing.T) {
 	)
 }
 
+func TestInterpretStringTryDecodeHex(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun test(_ string: String): [UInt8]? {
+          return string.tryDecodeHex()
+      }
+    `)
+
+	result, err := inter.Invoke("test", interpreter.NewUnmeteredStringValue("01CADE"))
+	require.NoError(t, err)
+
+	RequireValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredSomeValueNonCopying(
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.ReturnEmptyLocationRange,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeUInt8,
+				},
+				common.Address{},
+				interpreter.NewUnmeteredUInt8Value(1),
+				interpreter.NewUnmeteredUInt8Value(0xCA),
+				interpreter.NewUnmeteredUInt8Value(0xDE),
+			),
+		),
+		result,
+	)
+
+	for _, invalid := range []string{"0", "0x01", "zz"} {
+		result, err := inter.Invoke("test", interpreter.NewUnmeteredStringValue(invalid))
+		require.NoError(t, err)
+
+		RequireValuesEqual(t, inter, interpreter.NilValue{}, result)
+	}
+}
+
+func TestInterpretByteArrayToHexString(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let bytes: [UInt8] = [1, 2, 3, 0xCA, 0xDE]
+      let x = bytes.toHexString()
+      let fixed: [UInt8; 2] = [0xCA, 0xDE]
+      let y = fixed.toHexString()
+    `)
+
+	RequireValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredStringValue("010203cade"),
+		inter.Globals["x"].GetValue(),
+	)
+
+	RequireValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredStringValue("cade"),
+		inter.Globals["y"].GetValue(),
+	)
+}
+
+func TestInterpretStringBase64(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun encode(_ bytes: [UInt8]): String {
+          return String.encodeBase64(bytes)
+      }
+
+      fun decode(_ string: String): [UInt8]? {
+          return string.decodeBase64()
+      }
+    `)
+
+	bytes := interpreter.NewArrayValue(
+		inter,
+		interpreter.ReturnEmptyLocationRange,
+		interpreter.VariableSizedStaticType{
+			Type: interpreter.PrimitiveStaticTypeUInt8,
+		},
+		common.Address{},
+		interpreter.NewUnmeteredUInt8Value(0xFB),
+		interpreter.NewUnmeteredUInt8Value(0xFF),
+		interpreter.NewUnmeteredUInt8Value(1),
+		interpreter.NewUnmeteredUInt8Value(2),
+	)
+
+	result, err := inter.Invoke("encode", bytes)
+	require.NoError(t, err)
+
+	RequireValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredStringValue("+/8BAg=="),
+		result,
+	)
+
+	result, err = inter.Invoke("decode", interpreter.NewUnmeteredStringValue("+/8BAg=="))
+	require.NoError(t, err)
+
+	RequireValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredSomeValueNonCopying(bytes),
+		result,
+	)
+
+	// Missing padding, URL encoding, non-canonical padding bits, invalid characters
+
+	for _, invalid := range []string{"+/8BAg", "-_8BAg==", "+/8BAh==", "+/8B Ag=="} {
+		result, err := inter.Invoke("decode", interpreter.NewUnmeteredStringValue(invalid))
+		require.NoError(t, err)
+
+		RequireValuesEqual(t, inter, interpreter.NilValue{}, result)
+	}
+}
+
+func TestInterpretStringFromUTF8(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun test(_ bytes: [UInt8]): String? {
+          return String.fromUTF8(bytes)
+      }
+
+      let roundTrip = String.fromUTF8("Flowers \u{1F490} are beautiful".utf8)
+    `)
+
+	RequireValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredSomeValueNonCopying(
+			interpreter.NewUnmeteredStringValue("Flowers \U0001F490 are beautiful"),
+		),
+		inter.Globals["roundTrip"].GetValue(),
+	)
+
+	// Truncated multi-byte sequence
+
+	result, err := inter.Invoke(
+		"test",
+		interpreter.NewArrayValue(
+			inter,
+			interpreter.ReturnEmptyLocationRange,
+			interpreter.VariableSizedStaticType{
+				Type: interpreter.PrimitiveStaticTypeUInt8,
+			},
+			common.Address{},
+			interpreter.NewUnmeteredUInt8Value(240),
+			interpreter.NewUnmeteredUInt8Value(159),
+		),
+	)
+	require.NoError(t, err)
+
+	RequireValuesEqual(t, inter, interpreter.NilValue{}, result)
+}
+
 func TestInterpretStringUtf8Field(t *testing.T) {
 
 	t.Parallel()
@@ -343,3 +507,89 @@ func TestInterpretCompareCharacters(t *testing.T) {
 		inter.Globals["z"].GetValue(),
 	)
 }
+
+func TestInterpretStringTemplate(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("values", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): String {
+              let a = 1
+              let b: UFix64 = 2.5
+              let c = true
+              let d: Character = "d"
+              let e: Address = 0x1
+              let f = /storage/f
+              let g = "g\n"
+
+              return "\(a), \(b), \(c), \(d), \(e), \(f), \(g)\(a + 1)"
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredStringValue(
+				"1, 2.50000000, true, d, 0x0000000000000001, /storage/f, g\n2",
+			),
+			value,
+		)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): String {
+              let x: Int8 = -3
+              return "a \("b \(x) c") d"
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredStringValue("a b -3 c d"),
+			value,
+		)
+	})
+
+	t.Run("evaluation order", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          var counter = 0
+
+          fun next(): Int {
+              counter = counter + 1
+              return counter
+          }
+
+          fun test(): String {
+              return "\(next())-\(next())-\(next())"
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredStringValue("1-2-3"),
+			value,
+		)
+	})
+}
@@ -26,6 +26,7 @@ import (
 
 	. "github.com/onflow/cadence/runtime/tests/utils"
 
+	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/interpreter"
 	"github.com/onflow/cadence/runtime/sema"
 	"github.com/onflow/cadence/runtime/tests/checker"
@@ -271,4 +272,119 @@ func TestInterpretSwitchStatement(t *testing.T) {
 			AssertValuesEqual(t, inter, testCase.expected, actual)
 		}
 	})
+
+	t.Run("optional binding pattern", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(_ x: Int?): Int {
+              switch x {
+              case let y?:
+                  return y + 1
+              default:
+                  return 0
+              }
+          }
+        `)
+
+		for _, testCase := range []struct {
+			argument interpreter.Value
+			expected interpreter.Value
+		}{
+			{
+				interpreter.NewUnmeteredSomeValueNonCopying(
+					interpreter.NewUnmeteredIntValueFromInt64(1),
+				),
+				interpreter.NewUnmeteredIntValueFromInt64(2),
+			},
+			{
+				interpreter.NilValue{},
+				interpreter.NewUnmeteredIntValueFromInt64(0),
+			},
+		} {
+			actual, err := inter.Invoke("test", testCase.argument)
+			require.NoError(t, err)
+
+			AssertValuesEqual(t, inter, testCase.expected, actual)
+		}
+	})
+
+	t.Run("optional binding pattern, struct copy", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          struct S {
+              var value: Int
+
+              init() {
+                  self.value = 1
+              }
+          }
+
+          fun test(): [Int] {
+              let s: S? = S()
+              switch s {
+              case let t?:
+                  t.value = 2
+                  return [s!.value, t.value]
+              default:
+                  return []
+              }
+          }
+        `)
+
+		actual, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.ReturnEmptyLocationRange,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+				},
+				common.Address{},
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+				interpreter.NewUnmeteredIntValueFromInt64(2),
+			),
+			actual,
+		)
+	})
+
+	t.Run("exhaustive enum", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          enum E: UInt8 {
+              case a
+              case b
+          }
+
+          fun test(_ e: E): String {
+              switch e {
+              case E.a:
+                  return "a"
+              case E.b:
+                  return "b"
+              }
+          }
+
+          fun testA(): String {
+              return test(E.a)
+          }
+
+          fun testB(): String {
+              return test(E.b)
+          }
+        `)
+
+		for name, expected := range map[string]interpreter.Value{
+			"testA": interpreter.NewUnmeteredStringValue("a"),
+			"testB": interpreter.NewUnmeteredStringValue("b"),
+		} {
+			actual, err := inter.Invoke(name)
+			require.NoError(t, err)
+
+			AssertValuesEqual(t, inter, expected, actual)
+		}
+	})
 }
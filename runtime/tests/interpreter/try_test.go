@@ -0,0 +1,393 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/stdlib"
+	. "github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func TestInterpretTry(t *testing.T) {
+
+	t.Parallel()
+
+	standardLibraryFunctions :=
+		stdlib.StandardLibraryFunctions{
+			stdlib.PanicFunction,
+		}
+
+	valueDeclarations := standardLibraryFunctions.ToSemaValueDeclarations()
+	values := standardLibraryFunctions.ToInterpreterValueDeclarations()
+
+	parseCheckAndInterpretWithPanic := func(t *testing.T, code string) *interpreter.Interpreter {
+		inter, err := parseCheckAndInterpretWithOptions(t,
+			code,
+			ParseCheckAndInterpretOptions{
+				CheckerOptions: []sema.Option{
+					sema.WithPredeclaredValues(valueDeclarations),
+				},
+				Options: []interpreter.Option{
+					interpreter.WithPredeclaredValues(values),
+				},
+			},
+		)
+		require.NoError(t, err)
+		return inter
+	}
+
+	t.Run("success", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithPanic(t, `
+          fun answer(): Int {
+              return 42
+          }
+
+          fun test(): Int? {
+              return try? answer()
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredSomeValueNonCopying(
+				interpreter.NewUnmeteredIntValueFromInt64(42),
+			),
+			value,
+		)
+	})
+
+	t.Run("panic", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithPanic(t, `
+          fun answer(): Int {
+              return panic("no answer")
+          }
+
+          fun test(): Int? {
+              return try? answer()
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NilValue{},
+			value,
+		)
+	})
+
+	t.Run("overflow in nested call", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithPanic(t, `
+          fun add(_ a: UInt8, _ b: UInt8): UInt8 {
+              return a + b
+          }
+
+          fun sum(_ values: [UInt8]): UInt8 {
+              var total: UInt8 = 0
+              for value in values {
+                  total = add(total, value)
+              }
+              return total
+          }
+
+          fun test(): [UInt8?] {
+              return [
+                  try? sum([1, 2]),
+                  try? sum([200, 100]),
+                  try? sum([3, 4])
+              ]
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.ReturnEmptyLocationRange,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.OptionalStaticType{
+						Type: interpreter.PrimitiveStaticTypeUInt8,
+					},
+				},
+				common.Address{},
+				interpreter.NewUnmeteredSomeValueNonCopying(
+					interpreter.NewUnmeteredUInt8Value(3),
+				),
+				interpreter.NilValue{},
+				interpreter.NewUnmeteredSomeValueNonCopying(
+					interpreter.NewUnmeteredUInt8Value(7),
+				),
+			),
+			value,
+		)
+
+		require.Empty(t, inter.CallStack.Invocations)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithPanic(t, `
+          fun fail(): Int {
+              return panic("fail")
+          }
+
+          fun inner(): Int {
+              return (try? fail()) ?? 1
+          }
+
+          fun outer(): Int {
+              return inner() + fail()
+          }
+
+          fun test(): [Int?] {
+              return [try? inner(), try? outer()]
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.ReturnEmptyLocationRange,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.OptionalStaticType{
+						Type: interpreter.PrimitiveStaticTypeInt,
+					},
+				},
+				common.Address{},
+				interpreter.NewUnmeteredSomeValueNonCopying(
+					interpreter.NewUnmeteredIntValueFromInt64(1),
+				),
+				interpreter.NilValue{},
+			),
+			value,
+		)
+	})
+
+	t.Run("new values", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithPanic(t, `
+          fun fill(): [Int] {
+              let values: [Int] = []
+              var i = 0
+              while i < 100 {
+                  values.append(i)
+                  i = i + 1
+              }
+              return panic("fail")
+          }
+
+          fun test(): [Int]? {
+              return try? fill()
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NilValue{},
+			value,
+		)
+	})
+
+	t.Run("mutation of existing value", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithPanic(t, `
+          fun fill(_ values: &[Int]): Int {
+              values.append(1)
+              return panic("fail")
+          }
+
+          fun test(): Int? {
+              let values: [Int] = []
+              return try? fill(&values as &[Int])
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.Error(t, err)
+
+		require.ErrorAs(t, err, &stdlib.PanicError{})
+	})
+
+	t.Run("event", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithPanic(t, `
+          event Failed()
+
+          fun fail(): Int {
+              emit Failed()
+              return panic("fail")
+          }
+
+          fun test(): Int? {
+              return try? fail()
+          }
+        `)
+
+		inter.SetOnEventEmittedHandler(
+			func(
+				_ *interpreter.Interpreter,
+				_ func() interpreter.LocationRange,
+				_ *interpreter.CompositeValue,
+				_ *sema.CompositeType,
+			) error {
+				return nil
+			},
+		)
+
+		_, err := inter.Invoke("test")
+		require.Error(t, err)
+
+		require.ErrorAs(t, err, &stdlib.PanicError{})
+	})
+
+	t.Run("assignment to existing variable", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithPanic(t, `
+          var count = 0
+
+          fun increment(): Int {
+              count = count + 1
+              let n: Int? = nil
+              return n!
+          }
+
+          fun test(): Int? {
+              return try? increment()
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NilValue{},
+			value,
+		)
+
+		variable, ok := inter.Globals.Get("count")
+		require.True(t, ok)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(0),
+			variable.GetValue(),
+		)
+	})
+
+	t.Run("assignment in nested call", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithPanic(t, `
+          fun test(): [Int] {
+              var x = 1
+              var y = 1
+
+              fun increment(): Int {
+                  y = y + 1
+                  return y
+              }
+
+              fun fail(): Int {
+                  x = 2
+                  x = 3
+                  try? increment()
+                  return panic("fail")
+              }
+
+              fun failInner(): Int {
+                  x = 4
+                  return panic("fail")
+              }
+
+              try? fail()
+              try? increment()
+              try? failInner()
+
+              return [x, y]
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.ReturnEmptyLocationRange,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+				},
+				common.Address{},
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+				interpreter.NewUnmeteredIntValueFromInt64(2),
+			),
+			value,
+		)
+	})
+}
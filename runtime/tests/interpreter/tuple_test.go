@@ -0,0 +1,168 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	. "github.com/onflow/cadence/runtime/tests/utils"
+
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+func TestInterpretTupleExpression(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let t: (Int8, String?) = (1, "two")
+      let a = t[0]
+      let b = t[1]
+      let typeIdentifier = t.getType().identifier
+    `)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.Int8Value(1),
+		inter.Globals["a"].GetValue(),
+	)
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredSomeValueNonCopying(
+			interpreter.NewUnmeteredStringValue("two"),
+		),
+		inter.Globals["b"].GetValue(),
+	)
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredStringValue("(Int8,String?)"),
+		inter.Globals["typeIdentifier"].GetValue(),
+	)
+}
+
+func TestInterpretTupleVariableDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun divMod(_ a: Int, _ b: Int): (Int, Int) {
+          return (a / b, a % b)
+      }
+
+      fun test(): Int {
+          var (quotient, remainder) = divMod(17, 5)
+          quotient = quotient * 10
+          return quotient + remainder
+      }
+    `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredIntValueFromInt64(32),
+		value,
+	)
+}
+
+func TestInterpretTupleEquality(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let equal = (1, "two") == (1, "two")
+      let notEqual = (1, "two") == (1, "three")
+    `)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.BoolValue(true),
+		inter.Globals["equal"].GetValue(),
+	)
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.BoolValue(false),
+		inter.Globals["notEqual"].GetValue(),
+	)
+}
+
+func TestInterpretTupleString(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let t = (1, "two", [true])
+    `)
+
+	require.Equal(t,
+		`(1, "two", [true])`,
+		inter.Globals["t"].GetValue().String(),
+	)
+}
+
+func TestInterpretTupleResources(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      resource R {
+          let id: Int
+
+          init(id: Int) {
+              self.id = id
+          }
+      }
+
+      fun test(): Int {
+          let t <- (<-create R(id: 1), <-create R(id: 2))
+          let (r1, r2) <- t
+          let sum = r1.id * 10 + r2.id
+          destroy r1
+          destroy r2
+          return sum
+      }
+
+      fun testDestroy() {
+          let t <- (<-create R(id: 1), 2)
+          destroy t
+      }
+    `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredIntValueFromInt64(12),
+		value,
+	)
+
+	_, err = inter.Invoke("testDestroy")
+	require.NoError(t, err)
+}
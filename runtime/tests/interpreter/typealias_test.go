@@ -0,0 +1,106 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	. "github.com/onflow/cadence/runtime/tests/utils"
+
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+func TestInterpretTypeAlias(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("runtime type", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct S {}
+
+          typealias Ss = [S]
+
+          fun test(): Bool {
+              let ss: Ss = [S()]
+              let any: AnyStruct = ss
+              return (any as? Ss) != nil
+                  && any.getType() == Type<Ss>()
+                  && Type<Ss>() == Type<[S]>()
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.BoolValue(true),
+			value,
+		)
+	})
+
+	t.Run("nested in contract", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter, err := parseCheckAndInterpretWithOptions(t,
+			`
+              pub contract C {
+
+                  pub resource R {}
+
+                  pub typealias Rs = [R]
+
+                  pub fun make(): @Rs {
+                      return <-[<-create R()]
+                  }
+              }
+
+              pub fun test(): Int {
+                  let rs: @C.Rs <- C.make()
+                  let count = rs.length
+                  destroy rs
+                  return count
+              }
+            `,
+			ParseCheckAndInterpretOptions{
+				Options: []interpreter.Option{
+					makeContractValueHandler(nil, nil, nil),
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(1),
+			value,
+		)
+	})
+}
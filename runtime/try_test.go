@@ -0,0 +1,168 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/stdlib"
+)
+
+func TestRuntimeTryStorage(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := newTestInterpreterRuntime()
+
+	numbers := []byte(`
+      pub fun add(_ number: Int, to account: AuthAccount): Int {
+        let numbers = account.borrow<&[Int]>(from: /storage/numbers)!
+        numbers.append(number)
+        if number < 0 {
+          panic("negative number")
+        }
+        return numbers.length
+      }
+
+      pub fun sum(of account: AuthAccount, limit: Int): Int {
+        let numbers = account.borrow<&[Int]>(from: /storage/numbers)!
+        var sum = 0
+        var i = 0
+        while i < numbers.length {
+          sum = sum + numbers[i]
+          i = i + 1
+        }
+        if sum > limit {
+          panic("limit exceeded")
+        }
+        return sum
+      }
+    `)
+
+	setupTx := []byte(`
+      transaction {
+        prepare(signer: AuthAccount) {
+          signer.save([1, 2], to: /storage/numbers)
+        }
+      }
+    `)
+
+	// The failed call only read from storage, so it can be recovered from
+
+	readTx := []byte(`
+      import "numbers"
+
+      transaction {
+        prepare(signer: AuthAccount) {
+          log(try? sum(of: signer, limit: 10))
+          log(try? sum(of: signer, limit: 1))
+        }
+      }
+    `)
+
+	// The successful call wrote to storage
+
+	writeTx := []byte(`
+      import "numbers"
+
+      transaction {
+        prepare(signer: AuthAccount) {
+          log(try? add(3, to: signer))
+        }
+      }
+    `)
+
+	// The failed call wrote to storage, and the write cannot be undone,
+	// so the transaction must abort
+
+	failedWriteTx := []byte(`
+      import "numbers"
+
+      transaction {
+        prepare(signer: AuthAccount) {
+          log(try? add(-1, to: signer))
+        }
+      }
+    `)
+
+	var loggedMessages []string
+
+	runtimeInterface := &testRuntimeInterface{
+		getCode: func(location Location) (bytes []byte, err error) {
+			switch location {
+			case common.StringLocation("numbers"):
+				return numbers, nil
+			default:
+				return nil, fmt.Errorf("unknown import location: %s", location)
+			}
+		},
+		storage: newTestLedger(nil, nil),
+		getSigningAccounts: func() ([]Address, error) {
+			return []Address{{42}}, nil
+		},
+		log: func(message string) {
+			loggedMessages = append(loggedMessages, message)
+		},
+	}
+
+	nextTransactionLocation := newTransactionLocationGenerator()
+
+	executeTransaction := func(tx []byte) error {
+		return runtime.ExecuteTransaction(
+			Script{
+				Source: tx,
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  nextTransactionLocation(),
+			},
+		)
+	}
+
+	for _, tx := range [][]byte{setupTx, readTx, writeTx} {
+		err := executeTransaction(tx)
+		require.NoError(t, err)
+	}
+
+	err := executeTransaction(failedWriteTx)
+	require.Error(t, err)
+
+	require.ErrorAs(t, err, &stdlib.PanicError{})
+
+	// The aborted transaction had no effect
+
+	err = executeTransaction(readTx)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		[]string{
+			"3",
+			"nil",
+			"3",
+			"6",
+			"nil",
+		},
+		loggedMessages,
+	)
+}
@@ -153,6 +153,23 @@ func (c *TypeComparator) CheckInstantiationTypeEquality(expected *ast.Instantiat
 	return nil
 }
 
+func (c *TypeComparator) CheckTupleTypeEquality(expected *ast.TupleType, found ast.Type) error {
+	foundTupleType, ok := found.(*ast.TupleType)
+	if !ok || len(expected.Types) != len(foundTupleType.Types) {
+		return getTypeMismatchError(expected, found)
+	}
+
+	for index, expectedElementType := range expected.Types {
+		foundElementType := foundTupleType.Types[index]
+		err := expectedElementType.CheckEqual(foundElementType, c)
+		if err != nil {
+			return getTypeMismatchError(expected, found)
+		}
+	}
+
+	return nil
+}
+
 func (c *TypeComparator) CheckFunctionTypeEquality(expected *ast.FunctionType, found ast.Type) error {
 	foundFuncType, ok := found.(*ast.FunctionType)
 	if !ok || len(expected.ParameterTypeAnnotations) != len(foundFuncType.ParameterTypeAnnotations) {
@@ -29,10 +29,22 @@ const BlockHashLength = 32
 type BlockHash [BlockHashLength]byte
 
 type Block struct {
-	Height    uint64
-	View      uint64
-	Hash      BlockHash
-	Timestamp int64
+	Height     uint64
+	View       uint64
+	Hash       BlockHash
+	Timestamp  int64
+	ParentHash BlockHash
+	// CollectionIndex is the index of the collection which contains the executed transaction.
+	// It is only set for the current block
+	CollectionIndex uint64
+}
+
+type BlockHeader struct {
+	Height     uint64
+	View       uint64
+	Hash       BlockHash
+	Timestamp  int64
+	ParentHash BlockHash
 }
 
 type ResolvedLocation = sema.ResolvedLocation
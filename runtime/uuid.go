@@ -0,0 +1,86 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"github.com/onflow/cadence/runtime/errors"
+)
+
+// uuidAllocator provides the UUIDs for new resources.
+//
+// If the batch size is greater than 1 and the runtime interface is a UUIDBatchAllocator,
+// the UUIDs are allocated from the runtime interface in batches,
+// so that not every resource creation requires a call of the runtime interface.
+// UUIDs of a batch which are not used by the end of the execution are discarded.
+//
+type uuidAllocator struct {
+	runtimeInterface Interface
+	batchAllocator   UUIDBatchAllocator
+	batchSize        uint64
+	uuids            []uint64
+}
+
+func newUUIDAllocator(runtimeInterface Interface, batchSize uint64) *uuidAllocator {
+	allocator := &uuidAllocator{
+		runtimeInterface: runtimeInterface,
+	}
+
+	if batchSize > 1 {
+		batchAllocator, ok := runtimeInterface.(UUIDBatchAllocator)
+		if ok {
+			allocator.batchAllocator = batchAllocator
+			allocator.batchSize = batchSize
+		}
+	}
+
+	return allocator
+}
+
+func (a *uuidAllocator) nextUUID() (uuid uint64, err error) {
+	if a.batchAllocator == nil {
+		wrapPanic(func() {
+			uuid, err = a.runtimeInterface.GenerateUUID()
+		})
+		return
+	}
+
+	if len(a.uuids) == 0 {
+		var uuids []uint64
+		wrapPanic(func() {
+			uuids, err = a.batchAllocator.AllocateUUIDs(a.batchSize)
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		if len(uuids) == 0 {
+			return 0, errors.NewUnexpectedError(
+				"failed to allocate UUIDs: expected %d, got none",
+				a.batchSize,
+			)
+		}
+
+		a.uuids = uuids
+	}
+
+	uuid = a.uuids[0]
+	a.uuids = a.uuids[1:]
+
+	return uuid, nil
+}
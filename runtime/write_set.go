@@ -0,0 +1,142 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"time"
+
+	"github.com/onflow/cadence/runtime/common"
+)
+
+// WriteSet is the state written by the execution of a transaction,
+// recorded by Runtime.ExecuteTransactionWithWriteSet.
+//
+// Storage writes are deferred until the end of the execution,
+// so overwritten intermediate values are never written,
+// and each register is written at most once, with its final value.
+// The writes are ordered deterministically, in the order they were flushed.
+//
+type WriteSet struct {
+	// Registers are the registers which were written through the runtime interface
+	Registers []RegisterWrite
+}
+
+// RegisterWrite is a write of a register, i.e. a key in the storage owned by an account.
+// An empty value indicates the removal of the register.
+//
+type RegisterWrite struct {
+	Owner []byte
+	Key   []byte
+	Value []byte
+}
+
+type writeSetRecorder struct {
+	writeSet  WriteSet
+	registers map[registerKey]int
+}
+
+func newWriteSetRecorder() *writeSetRecorder {
+	return &writeSetRecorder{
+		registers: map[registerKey]int{},
+	}
+}
+
+func (r *writeSetRecorder) recordRegisterWrite(owner, key, value []byte) {
+	// Copy the value, as the caller may reuse the underlying array
+	valueCopy := make([]byte, len(value))
+	copy(valueCopy, value)
+
+	registerKey := registerKey{
+		owner: string(owner),
+		key:   string(key),
+	}
+
+	// If the register was already written, e.g. in an earlier commit,
+	// only keep the final value
+
+	if index, ok := r.registers[registerKey]; ok {
+		r.writeSet.Registers[index].Value = valueCopy
+		return
+	}
+
+	r.registers[registerKey] = len(r.writeSet.Registers)
+
+	r.writeSet.Registers = append(r.writeSet.Registers, RegisterWrite{
+		Owner: []byte(registerKey.owner),
+		Key:   []byte(registerKey.key),
+		Value: valueCopy,
+	})
+}
+
+// writeSetRecordingInterface is a runtime interface which records all writes of registers
+// into a write set, and delegates them to the wrapped interface.
+//
+// Memory metering and metrics reporting are delegated to the wrapped interface,
+// if it supports them.
+//
+type writeSetRecordingInterface struct {
+	Interface
+	recorder *writeSetRecorder
+}
+
+var _ Interface = &writeSetRecordingInterface{}
+var _ common.MemoryGauge = &writeSetRecordingInterface{}
+var _ Metrics = &writeSetRecordingInterface{}
+
+func newWriteSetRecordingInterface(runtimeInterface Interface, recorder *writeSetRecorder) *writeSetRecordingInterface {
+	return &writeSetRecordingInterface{
+		Interface: runtimeInterface,
+		recorder:  recorder,
+	}
+}
+
+func (i *writeSetRecordingInterface) SetValue(owner, key, value []byte) error {
+	err := i.Interface.SetValue(owner, key, value)
+	if err != nil {
+		return err
+	}
+	i.recorder.recordRegisterWrite(owner, key, value)
+	return nil
+}
+
+func (i *writeSetRecordingInterface) MeterMemory(usage common.MemoryUsage) error {
+	memoryGauge, ok := i.Interface.(common.MemoryGauge)
+	if !ok {
+		return nil
+	}
+	return memoryGauge.MeterMemory(usage)
+}
+
+func (i *writeSetRecordingInterface) ProgramParsed(location common.Location, duration time.Duration) {
+	if metrics, ok := i.Interface.(Metrics); ok {
+		metrics.ProgramParsed(location, duration)
+	}
+}
+
+func (i *writeSetRecordingInterface) ProgramChecked(location common.Location, duration time.Duration) {
+	if metrics, ok := i.Interface.(Metrics); ok {
+		metrics.ProgramChecked(location, duration)
+	}
+}
+
+func (i *writeSetRecordingInterface) ProgramInterpreted(location common.Location, duration time.Duration) {
+	if metrics, ok := i.Interface.(Metrics); ok {
+		metrics.ProgramInterpreted(location, duration)
+	}
+}
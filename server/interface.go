@@ -0,0 +1,74 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+// programCache is a cache of parsed and checked programs,
+// which is shared by all requests of a server
+//
+type programCache map[common.LocationID]*interpreter.Program
+
+// requestInterface is the runtime interface used for a single request.
+//
+// It delegates to the interface of the server,
+// but caches programs in the server's program cache,
+// records the emitted events and logs,
+// and optionally provides the authorizers of the request as the signing accounts.
+//
+type requestInterface struct {
+	runtime.Interface
+	programs    programCache
+	authorizers []runtime.Address
+	events      []cadence.Event
+	logs        []string
+}
+
+var _ runtime.Interface = &requestInterface{}
+
+func (i *requestInterface) GetProgram(location runtime.Location) (*interpreter.Program, error) {
+	return i.programs[location.ID()], nil
+}
+
+func (i *requestInterface) SetProgram(location runtime.Location, program *interpreter.Program) error {
+	i.programs[location.ID()] = program
+	return nil
+}
+
+func (i *requestInterface) GetSigningAccounts() ([]runtime.Address, error) {
+	if i.authorizers != nil {
+		return i.authorizers, nil
+	}
+	return i.Interface.GetSigningAccounts()
+}
+
+func (i *requestInterface) EmitEvent(event cadence.Event) error {
+	i.events = append(i.events, event)
+	return i.Interface.EmitEvent(event)
+}
+
+func (i *requestInterface) ProgramLog(message string) error {
+	i.logs = append(i.logs, message)
+	return i.Interface.ProgramLog(message)
+}
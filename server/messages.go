@@ -0,0 +1,111 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"encoding/json"
+
+	"github.com/onflow/cadence/runtime/cmd"
+)
+
+// ExecuteScriptRequest is the request of the ExecuteScript method.
+//
+// The arguments are JSON-CDC encoded values.
+//
+type ExecuteScriptRequest struct {
+	Source    string            `json:"source"`
+	Arguments []json.RawMessage `json:"arguments,omitempty"`
+}
+
+// ExecuteScriptResponse is the response of the ExecuteScript method.
+//
+// The value is the JSON-CDC encoded result of the script, if it succeeded.
+// If the script failed, the error is the pretty-printed error,
+// and the diagnostics describe the individual errors.
+//
+type ExecuteScriptResponse struct {
+	Value       json.RawMessage  `json:"value,omitempty"`
+	Logs        []string         `json:"logs,omitempty"`
+	Diagnostics []cmd.Diagnostic `json:"diagnostics,omitempty"`
+	Error       string           `json:"error,omitempty"`
+}
+
+// ExecuteTransactionRequest is the request of the ExecuteTransaction method.
+//
+// The arguments are JSON-CDC encoded values.
+// The authorizers are hex-encoded addresses. If no authorizers are given,
+// the signing accounts are provided by the interface of the server.
+//
+type ExecuteTransactionRequest struct {
+	Source      string            `json:"source"`
+	Arguments   []json.RawMessage `json:"arguments,omitempty"`
+	Authorizers []string          `json:"authorizers,omitempty"`
+}
+
+// ExecuteTransactionResponse is the response of the ExecuteTransaction method.
+//
+// The events are the JSON-CDC encoded events emitted by the transaction.
+// If the transaction failed, the error is the pretty-printed error,
+// and the diagnostics describe the individual errors.
+//
+type ExecuteTransactionResponse struct {
+	Events      []json.RawMessage `json:"events,omitempty"`
+	Logs        []string          `json:"logs,omitempty"`
+	Diagnostics []cmd.Diagnostic  `json:"diagnostics,omitempty"`
+	Error       string            `json:"error,omitempty"`
+}
+
+// ParseAndCheckRequest is the request of the ParseAndCheck method.
+//
+type ParseAndCheckRequest struct {
+	Source string `json:"source"`
+}
+
+// ParseAndCheckResponse is the response of the ParseAndCheck method.
+//
+// The diagnostics describe the syntax and semantic errors of the program, if any.
+//
+type ParseAndCheckResponse struct {
+	Diagnostics []cmd.Diagnostic `json:"diagnostics"`
+}
+
+// DecodeEventRequest is the request of the DecodeEvent method.
+//
+// The payload is a JSON-CDC encoded event.
+//
+type DecodeEventRequest struct {
+	Payload json.RawMessage `json:"payload"`
+}
+
+// DecodeEventResponse is the response of the DecodeEvent method.
+//
+type DecodeEventResponse struct {
+	Type   string       `json:"type"`
+	Fields []EventField `json:"fields"`
+}
+
+// EventField is a field of a decoded event.
+//
+// The value is the Cadence representation of the field's value.
+//
+type EventField struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
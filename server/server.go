@@ -0,0 +1,305 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package server provides a gRPC service which exposes script and transaction execution,
+// parsing and checking, and event decoding.
+//
+// The service is backed by a pluggable runtime interface,
+// and keeps one checked-program cache for the lifetime of the server,
+// so long-running tools like emulators and editors do not repeatedly parse and check imported programs.
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/cmd"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+)
+
+// Server implements the execution service.
+//
+// Requests are handled one at a time, as runtime interfaces are generally not safe for concurrent use.
+type Server struct {
+	mu                sync.Mutex
+	runtime           runtime.Runtime
+	inter             runtime.Interface
+	programs          programCache
+	predeclaredValues []runtime.ValueDeclaration
+}
+
+var _ ExecutionServer = &Server{}
+
+type Option func(*Server)
+
+// WithRuntime returns a server option which sets the runtime used to execute programs.
+// By default, a new interpreter runtime is used.
+func WithRuntime(runtime runtime.Runtime) Option {
+	return func(server *Server) {
+		server.runtime = runtime
+	}
+}
+
+// WithPredeclaredValues returns a server option which sets
+// the values which are predeclared in all programs.
+func WithPredeclaredValues(predeclaredValues []runtime.ValueDeclaration) Option {
+	return func(server *Server) {
+		server.predeclaredValues = predeclaredValues
+	}
+}
+
+// NewServer returns a new execution server which is backed by the given runtime interface
+func NewServer(runtimeInterface runtime.Interface, options ...Option) *Server {
+	server := &Server{
+		inter:    runtimeInterface,
+		programs: programCache{},
+	}
+
+	for _, option := range options {
+		option(server)
+	}
+
+	if server.runtime == nil {
+		server.runtime = runtime.NewInterpreterRuntime()
+	}
+
+	return server
+}
+
+// ClearProgramCache removes all parsed and checked programs from the program cache,
+// e.g. after contracts were updated outside of the server
+func (s *Server) ClearProgramCache() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.programs = programCache{}
+}
+
+func (s *Server) ExecuteScript(
+	_ context.Context,
+	req *ExecuteScriptRequest,
+) (*ExecuteScriptResponse, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	source := []byte(req.Source)
+
+	requestInterface := s.newRequestInterface(nil)
+
+	value, err := s.runtime.ExecuteScript(
+		runtime.Script{
+			Source:    source,
+			Arguments: encodedArguments(req.Arguments),
+		},
+		s.newContext(
+			requestInterface,
+			common.NewScriptLocation(nil, sourceHash(source)),
+		),
+	)
+
+	res := &ExecuteScriptResponse{
+		Logs: requestInterface.logs,
+	}
+
+	if err != nil {
+		if errors.IsInternalError(err) {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		res.Diagnostics = diagnostics(err)
+		res.Error = err.Error()
+		return res, nil
+	}
+
+	res.Value, err = jsoncdc.Encode(value)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res, nil
+}
+
+func (s *Server) ExecuteTransaction(
+	_ context.Context,
+	req *ExecuteTransactionRequest,
+) (*ExecuteTransactionResponse, error) {
+
+	var authorizers []runtime.Address
+	if req.Authorizers != nil {
+		authorizers = make([]runtime.Address, 0, len(req.Authorizers))
+		for _, authorizer := range req.Authorizers {
+			address, err := common.HexToAddress(authorizer)
+			if err != nil {
+				return nil, status.Error(
+					codes.InvalidArgument,
+					fmt.Sprintf("invalid authorizer address %q: %s", authorizer, err),
+				)
+			}
+			authorizers = append(authorizers, address)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	source := []byte(req.Source)
+
+	requestInterface := s.newRequestInterface(authorizers)
+
+	err := s.runtime.ExecuteTransaction(
+		runtime.Script{
+			Source:    source,
+			Arguments: encodedArguments(req.Arguments),
+		},
+		s.newContext(
+			requestInterface,
+			common.NewTransactionLocation(nil, sourceHash(source)),
+		),
+	)
+
+	res := &ExecuteTransactionResponse{
+		Logs: requestInterface.logs,
+	}
+
+	if err != nil {
+		if errors.IsInternalError(err) {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		res.Diagnostics = diagnostics(err)
+		res.Error = err.Error()
+		return res, nil
+	}
+
+	res.Events = make([]json.RawMessage, 0, len(requestInterface.events))
+	for _, event := range requestInterface.events {
+		encoded, err := jsoncdc.Encode(event)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		res.Events = append(res.Events, encoded)
+	}
+
+	return res, nil
+}
+
+func (s *Server) ParseAndCheck(
+	_ context.Context,
+	req *ParseAndCheckRequest,
+) (*ParseAndCheckResponse, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	source := []byte(req.Source)
+
+	_, err := s.runtime.ParseAndCheckProgram(
+		source,
+		s.newContext(
+			s.newRequestInterface(nil),
+			common.NewScriptLocation(nil, sourceHash(source)),
+		),
+	)
+	if err != nil && errors.IsInternalError(err) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &ParseAndCheckResponse{
+		Diagnostics: diagnostics(err),
+	}, nil
+}
+
+func (s *Server) DecodeEvent(
+	_ context.Context,
+	req *DecodeEventRequest,
+) (*DecodeEventResponse, error) {
+
+	value, err := jsoncdc.Decode(nil, req.Payload)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	event, ok := value.(cadence.Event)
+	if !ok {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			fmt.Sprintf("payload is not an event, got %s", value.Type().ID()),
+		)
+	}
+
+	fields := make([]EventField, 0, len(event.Fields))
+	for i, field := range event.EventType.Fields {
+		fieldValue := event.Fields[i]
+		fields = append(fields, EventField{
+			Name:  field.Identifier,
+			Type:  field.Type.ID(),
+			Value: fieldValue.String(),
+		})
+	}
+
+	return &DecodeEventResponse{
+		Type:   event.EventType.ID(),
+		Fields: fields,
+	}, nil
+}
+
+func (s *Server) newRequestInterface(authorizers []runtime.Address) *requestInterface {
+	return &requestInterface{
+		Interface:   s.inter,
+		programs:    s.programs,
+		authorizers: authorizers,
+	}
+}
+
+func (s *Server) newContext(runtimeInterface runtime.Interface, location common.Location) runtime.Context {
+	return runtime.Context{
+		Interface:         runtimeInterface,
+		Location:          location,
+		PredeclaredValues: s.predeclaredValues,
+	}
+}
+
+func encodedArguments(arguments []json.RawMessage) [][]byte {
+	result := make([][]byte, len(arguments))
+	for i, argument := range arguments {
+		result[i] = argument
+	}
+	return result
+}
+
+func sourceHash(source []byte) []byte {
+	hash := sha256.Sum256(source)
+	return hash[:]
+}
+
+// diagnostics returns the diagnostics for the given runtime error
+func diagnostics(err error) []cmd.Diagnostic {
+	if runtimeErr, ok := err.(runtime.Error); ok {
+		err = runtimeErr.Err
+	}
+	return cmd.Diagnostics(err)
+}
@@ -0,0 +1,355 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/common"
+)
+
+type testInterface struct {
+	runtime.Interface
+	code       map[common.LocationID][]byte
+	logs       []string
+	events     []cadence.Event
+	uuid       uint64
+	signers    []runtime.Address
+	codeLoaded int
+}
+
+func (i *testInterface) ResolveLocation(
+	identifiers []runtime.Identifier,
+	location runtime.Location,
+) ([]runtime.ResolvedLocation, error) {
+	addressLocation, ok := location.(common.AddressLocation)
+	if !ok {
+		return []runtime.ResolvedLocation{
+			{
+				Location:    location,
+				Identifiers: identifiers,
+			},
+		}, nil
+	}
+
+	resolvedLocations := make([]runtime.ResolvedLocation, 0, len(identifiers))
+	for _, identifier := range identifiers {
+		resolvedLocations = append(resolvedLocations, runtime.ResolvedLocation{
+			Location: common.AddressLocation{
+				Address: addressLocation.Address,
+				Name:    identifier.Identifier,
+			},
+			Identifiers: []runtime.Identifier{identifier},
+		})
+	}
+	return resolvedLocations, nil
+}
+
+func (i *testInterface) GetCode(location runtime.Location) ([]byte, error) {
+	i.codeLoaded++
+	return i.code[location.ID()], nil
+}
+
+func (i *testInterface) GetAccountContractCode(address runtime.Address, name string) ([]byte, error) {
+	location := common.NewAddressLocation(nil, address, name)
+	i.codeLoaded++
+	return i.code[location.ID()], nil
+}
+
+func (i *testInterface) GetSigningAccounts() ([]runtime.Address, error) {
+	return i.signers, nil
+}
+
+func (i *testInterface) ProgramLog(message string) error {
+	i.logs = append(i.logs, message)
+	return nil
+}
+
+func (i *testInterface) EmitEvent(event cadence.Event) error {
+	i.events = append(i.events, event)
+	return nil
+}
+
+func (i *testInterface) GenerateUUID() (uint64, error) {
+	i.uuid++
+	return i.uuid, nil
+}
+
+func (i *testInterface) MeterComputation(_ common.ComputationKind, _ uint) error {
+	return nil
+}
+
+func (i *testInterface) MeterMemory(_ common.MemoryUsage) error {
+	return nil
+}
+
+func (i *testInterface) DecodeArgument(argument []byte, _ cadence.Type) (cadence.Value, error) {
+	return jsoncdc.Decode(nil, argument)
+}
+
+func newTestClient(t *testing.T, runtimeInterface runtime.Interface) *ExecutionClient {
+	listener := bufconn.Listen(1024 * 1024)
+
+	grpcServer := grpc.NewServer()
+	RegisterExecutionServer(grpcServer, NewServer(runtimeInterface))
+
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.Dial(
+		"bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return listener.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return NewExecutionClient(conn)
+}
+
+func encodeArgument(t *testing.T, value cadence.Value) json.RawMessage {
+	encoded, err := jsoncdc.Encode(value)
+	require.NoError(t, err)
+	return encoded
+}
+
+func TestServerExecuteScript(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("result and logs", func(t *testing.T) {
+
+		t.Parallel()
+
+		runtimeInterface := &testInterface{}
+		client := newTestClient(t, runtimeInterface)
+
+		res, err := client.ExecuteScript(
+			context.Background(),
+			&ExecuteScriptRequest{
+				Source: `
+                  pub fun main(a: Int): Int {
+                      log(a)
+                      return a * 2
+                  }
+                `,
+				Arguments: []json.RawMessage{
+					encodeArgument(t, cadence.NewInt(21)),
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		assert.Empty(t, res.Error)
+		assert.Empty(t, res.Diagnostics)
+		assert.JSONEq(t,
+			`{"type":"Int","value":"42"}`,
+			string(res.Value),
+		)
+		assert.Equal(t, []string{"21"}, res.Logs)
+		assert.Equal(t, []string{"21"}, runtimeInterface.logs)
+	})
+
+	t.Run("error", func(t *testing.T) {
+
+		t.Parallel()
+
+		client := newTestClient(t, &testInterface{})
+
+		res, err := client.ExecuteScript(
+			context.Background(),
+			&ExecuteScriptRequest{
+				Source: `
+                  pub fun main(): Int {
+                      return true
+                  }
+                `,
+			},
+		)
+		require.NoError(t, err)
+
+		assert.Nil(t, res.Value)
+		assert.NotEmpty(t, res.Error)
+		require.Len(t, res.Diagnostics, 1)
+		assert.Equal(t, "mismatched types", res.Diagnostics[0].Message)
+	})
+}
+
+func TestServerExecuteTransaction(t *testing.T) {
+
+	t.Parallel()
+
+	runtimeInterface := &testInterface{}
+	client := newTestClient(t, runtimeInterface)
+
+	res, err := client.ExecuteTransaction(
+		context.Background(),
+		&ExecuteTransactionRequest{
+			Source: `
+              transaction {
+                  prepare(signer: AuthAccount) {
+                      log(signer.address)
+                  }
+              }
+            `,
+			Authorizers: []string{"0x42"},
+		},
+	)
+	require.NoError(t, err)
+
+	assert.Empty(t, res.Error)
+	assert.Equal(t, []string{"0x0000000000000042"}, res.Logs)
+}
+
+func TestServerParseAndCheck(t *testing.T) {
+
+	t.Parallel()
+
+	client := newTestClient(t, &testInterface{})
+
+	res, err := client.ParseAndCheck(
+		context.Background(),
+		&ParseAndCheckRequest{
+			Source: `
+              pub fun main() {
+                  let x: Int = "1"
+                  y
+              }
+            `,
+		},
+	)
+	require.NoError(t, err)
+
+	require.Len(t, res.Diagnostics, 2)
+	assert.Equal(t, "mismatched types", res.Diagnostics[0].Message)
+	assert.Equal(t, "cannot find variable in this scope: `y`", res.Diagnostics[1].Message)
+	require.NotNil(t, res.Diagnostics[0].StartPos)
+	assert.Equal(t, 3, res.Diagnostics[0].StartPos.Line)
+}
+
+func TestServerProgramCache(t *testing.T) {
+
+	t.Parallel()
+
+	address := common.MustBytesToAddress([]byte{0x1})
+
+	runtimeInterface := &testInterface{
+		code: map[common.LocationID][]byte{
+			common.NewAddressLocation(nil, address, "Test").ID(): []byte(`
+              pub contract Test {
+                  pub fun answer(): Int {
+                      return 42
+                  }
+              }
+            `),
+		},
+	}
+	client := newTestClient(t, runtimeInterface)
+
+	req := &ParseAndCheckRequest{
+		Source: `
+          import Test from 0x1
+
+          pub fun main(): Int {
+              return Test.answer()
+          }
+        `,
+	}
+
+	for i := 0; i < 2; i++ {
+		res, err := client.ParseAndCheck(context.Background(), req)
+		require.NoError(t, err)
+		assert.Empty(t, res.Diagnostics)
+	}
+
+	assert.Equal(t, 1, runtimeInterface.codeLoaded)
+}
+
+func TestServerDecodeEvent(t *testing.T) {
+
+	t.Parallel()
+
+	client := newTestClient(t, &testInterface{})
+
+	event := cadence.NewEvent([]cadence.Value{
+		cadence.NewInt(1),
+		cadence.String("foo"),
+	}).WithType(&cadence.EventType{
+		Location:            common.IdentifierLocation("test"),
+		QualifiedIdentifier: "Test",
+		Fields: []cadence.Field{
+			{
+				Identifier: "a",
+				Type:       cadence.IntType{},
+			},
+			{
+				Identifier: "b",
+				Type:       cadence.StringType{},
+			},
+		},
+	})
+
+	payload, err := jsoncdc.Encode(event)
+	require.NoError(t, err)
+
+	res, err := client.DecodeEvent(
+		context.Background(),
+		&DecodeEventRequest{
+			Payload: payload,
+		},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		&DecodeEventResponse{
+			Type: "I.test.Test",
+			Fields: []EventField{
+				{Name: "a", Type: "Int", Value: "1"},
+				{Name: "b", Type: "String", Value: `"foo"`},
+			},
+		},
+		res,
+	)
+
+	_, err = client.DecodeEvent(
+		context.Background(),
+		&DecodeEventRequest{
+			Payload: encodeArgument(t, cadence.NewInt(1)),
+		},
+	)
+	require.Error(t, err)
+}
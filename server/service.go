@@ -0,0 +1,233 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+)
+
+// The service is described manually instead of generated from a protocol buffer definition:
+// requests and responses are plain Go types, which are encoded as JSON.
+// Clients must use the content-subtype "json", e.g. using grpc.CallContentSubtype.
+
+const serviceName = "cadence.Execution"
+
+const (
+	executeScriptMethod      = "/" + serviceName + "/ExecuteScript"
+	executeTransactionMethod = "/" + serviceName + "/ExecuteTransaction"
+	parseAndCheckMethod      = "/" + serviceName + "/ParseAndCheck"
+	decodeEventMethod        = "/" + serviceName + "/DecodeEvent"
+)
+
+// CodecName is the name of the codec used by the execution service
+//
+const CodecName = "json"
+
+type jsonCodec struct{}
+
+var _ encoding.Codec = jsonCodec{}
+
+func (jsonCodec) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
+
+func (jsonCodec) Name() string {
+	return CodecName
+}
+
+func init() {
+	encoding.RegisterCodec(jsonCodec{})
+}
+
+// ExecutionServer is the server API of the execution service
+//
+type ExecutionServer interface {
+	ExecuteScript(context.Context, *ExecuteScriptRequest) (*ExecuteScriptResponse, error)
+	ExecuteTransaction(context.Context, *ExecuteTransactionRequest) (*ExecuteTransactionResponse, error)
+	ParseAndCheck(context.Context, *ParseAndCheckRequest) (*ParseAndCheckResponse, error)
+	DecodeEvent(context.Context, *DecodeEventRequest) (*DecodeEventResponse, error)
+}
+
+// RegisterExecutionServer registers the given execution server with the given gRPC server
+//
+func RegisterExecutionServer(registrar grpc.ServiceRegistrar, server ExecutionServer) {
+	registrar.RegisterService(&executionServiceDesc, server)
+}
+
+var executionServiceDesc = grpc.ServiceDesc{
+	ServiceName: serviceName,
+	HandlerType: (*ExecutionServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExecuteScript",
+			Handler: newUnaryHandler(
+				executeScriptMethod,
+				ExecutionServer.ExecuteScript,
+			),
+		},
+		{
+			MethodName: "ExecuteTransaction",
+			Handler: newUnaryHandler(
+				executeTransactionMethod,
+				ExecutionServer.ExecuteTransaction,
+			),
+		},
+		{
+			MethodName: "ParseAndCheck",
+			Handler: newUnaryHandler(
+				parseAndCheckMethod,
+				ExecutionServer.ParseAndCheck,
+			),
+		},
+		{
+			MethodName: "DecodeEvent",
+			Handler: newUnaryHandler(
+				decodeEventMethod,
+				ExecutionServer.DecodeEvent,
+			),
+		},
+	},
+	Streams: []grpc.StreamDesc{},
+}
+
+// methodHandler is the type of the handler of a method in a grpc.MethodDesc
+//
+type methodHandler = func(
+	server any,
+	ctx context.Context,
+	decode func(any) error,
+	interceptor grpc.UnaryServerInterceptor,
+) (any, error)
+
+// newUnaryHandler returns a gRPC method handler which decodes the request
+// and calls the given method of the execution server
+//
+func newUnaryHandler[Req any, Res any](
+	fullMethod string,
+	method func(ExecutionServer, context.Context, *Req) (*Res, error),
+) methodHandler {
+	return func(
+		server any,
+		ctx context.Context,
+		decode func(any) error,
+		interceptor grpc.UnaryServerInterceptor,
+	) (any, error) {
+		req := new(Req)
+		if err := decode(req); err != nil {
+			return nil, err
+		}
+
+		executionServer := server.(ExecutionServer)
+
+		if interceptor == nil {
+			return method(executionServer, ctx, req)
+		}
+
+		info := &grpc.UnaryServerInfo{
+			Server:     server,
+			FullMethod: fullMethod,
+		}
+
+		handler := func(ctx context.Context, req any) (any, error) {
+			return method(executionServer, ctx, req.(*Req))
+		}
+
+		return interceptor(ctx, req, info, handler)
+	}
+}
+
+// ExecutionClient is a client of the execution service
+//
+type ExecutionClient struct {
+	conn grpc.ClientConnInterface
+}
+
+var _ ExecutionServer = &ExecutionClient{}
+
+func NewExecutionClient(conn grpc.ClientConnInterface) *ExecutionClient {
+	return &ExecutionClient{
+		conn: conn,
+	}
+}
+
+func (c *ExecutionClient) ExecuteScript(
+	ctx context.Context,
+	req *ExecuteScriptRequest,
+) (*ExecuteScriptResponse, error) {
+	res := &ExecuteScriptResponse{}
+	err := c.invoke(ctx, executeScriptMethod, req, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *ExecutionClient) ExecuteTransaction(
+	ctx context.Context,
+	req *ExecuteTransactionRequest,
+) (*ExecuteTransactionResponse, error) {
+	res := &ExecuteTransactionResponse{}
+	err := c.invoke(ctx, executeTransactionMethod, req, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *ExecutionClient) ParseAndCheck(
+	ctx context.Context,
+	req *ParseAndCheckRequest,
+) (*ParseAndCheckResponse, error) {
+	res := &ParseAndCheckResponse{}
+	err := c.invoke(ctx, parseAndCheckMethod, req, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *ExecutionClient) DecodeEvent(
+	ctx context.Context,
+	req *DecodeEventRequest,
+) (*DecodeEventResponse, error) {
+	res := &DecodeEventResponse{}
+	err := c.invoke(ctx, decodeEventMethod, req, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *ExecutionClient) invoke(ctx context.Context, method string, req any, res any) error {
+	return c.conn.Invoke(
+		ctx,
+		method,
+		req,
+		res,
+		grpc.CallContentSubtype(CodecName),
+	)
+}
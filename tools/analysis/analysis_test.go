@@ -112,8 +112,8 @@ func TestNeedSyntaxAndImport(t *testing.T) {
 				return true
 			}
 
-			leftHandType := program.Elaboration.CastingStaticValueTypes[castingExpression]
-			rightHandType := program.Elaboration.CastingTargetTypes[castingExpression]
+			leftHandType := program.Elaboration.CastingStaticValueTypes[castingExpression.ID()]
+			rightHandType := program.Elaboration.CastingTargetTypes[castingExpression.ID()]
 
 			if !sema.IsSubType(leftHandType, rightHandType) {
 				return true
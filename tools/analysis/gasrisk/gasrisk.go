@@ -0,0 +1,351 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package gasrisk implements an analyzer which detects code
+// that may consume an unbounded or excessive amount of computation:
+// loops whose number of iterations depends on a storage collection or external input,
+// and storage reads inside of loops.
+//
+package gasrisk
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/tools/analysis"
+)
+
+const Category = "gas-risk"
+
+// Analyzer reports the gas risks of a program as diagnostics,
+// and returns them as a Report.
+//
+// The program must be loaded with type information (analysis.NeedTypes),
+// otherwise only loops depending on external input are detected
+//
+var Analyzer = &analysis.Analyzer{
+	Description: "Detects unbounded loops and storage reads inside of loops",
+	Run: func(pass *analysis.Pass) interface{} {
+		program := pass.Program
+
+		var report Report
+
+		w := &walker{
+			analyzer: &analyzer{
+				elaboration: program.Elaboration,
+				report: func(risk Risk) {
+					risk.Location = program.Location
+					report = append(report, risk)
+
+					pass.Report(analysis.Diagnostic{
+						Location:         program.Location,
+						Category:         Category,
+						Range:            risk.Range,
+						Message:          risk.Message,
+						SecondaryMessage: risk.Kind.secondaryMessage(),
+					})
+				},
+			},
+			unbounded: map[string]string{},
+		}
+
+		ast.Walk(w, program.Program)
+
+		return report
+	},
+}
+
+// Analyze returns the gas risks of the given program
+//
+func Analyze(program *analysis.Program) Report {
+	pass := &analysis.Pass{
+		Program: program,
+		Report:  func(analysis.Diagnostic) {},
+	}
+	return Analyzer.Run(pass).(Report)
+}
+
+func (k RiskKind) secondaryMessage() string {
+	switch k {
+	case RiskKindUnboundedLoop:
+		return "the number of iterations is not bounded and may exceed the computation limit"
+	case RiskKindStorageReadInLoop:
+		return "consider reading from storage once, outside of the loop"
+	}
+
+	return ""
+}
+
+type analyzer struct {
+	elaboration *sema.Elaboration
+	report      func(Risk)
+}
+
+// walker walks the program and keeps track of the state of the current scope
+//
+type walker struct {
+	*analyzer
+	// unbounded maps the names of the variables and parameters
+	// which hold a storage collection or external input
+	// to a description of their origin
+	unbounded map[string]string
+	inLoop    bool
+}
+
+var _ ast.Walker = &walker{}
+
+func (w *walker) Walk(element ast.Element) ast.Walker {
+	switch element := element.(type) {
+	case *ast.TransactionDeclaration:
+		// Transaction parameters are provided by the submitter
+		return w.function(element.ParameterList, true)
+
+	case *ast.FunctionDeclaration:
+		// The parameters of public functions may be provided by anyone
+		isPublic := element.Access == ast.AccessPublic ||
+			element.Access == ast.AccessPublicSettable
+		return w.function(element.ParameterList, isPublic)
+
+	case *ast.FunctionExpression:
+		return w.function(element.ParameterList, false)
+
+	case *ast.VariableDeclaration:
+		if origin := w.origin(element.Value); origin != "" {
+			w.unbounded[element.Identifier.Identifier] = origin
+		} else {
+			delete(w.unbounded, element.Identifier.Identifier)
+		}
+
+	case *ast.ForStatement:
+		w.checkLoopBound(element, element.Value)
+
+		// The iterated value is only evaluated once, before the loop
+		ast.Walk(w, element.Value)
+		ast.Walk(w.loop(), element.Block)
+		return nil
+
+	case *ast.WhileStatement:
+		w.checkLoopBound(element, element.Test)
+		return w.loop()
+
+	case *ast.InvocationExpression:
+		if !w.inLoop {
+			break
+		}
+
+		if name, ok := w.storageRead(element); ok {
+			w.report(Risk{
+				Kind:    RiskKindStorageReadInLoop,
+				Range:   ast.NewRangeFromPositioned(nil, element),
+				Message: fmt.Sprintf("storage read `%s` inside of loop", name),
+			})
+		}
+	}
+
+	return w
+}
+
+// function returns a walker for the body of a function with the given parameters.
+// The parameters shadow outer variables, unless they receive external input
+//
+func (w *walker) function(parameterList *ast.ParameterList, isExternal bool) *walker {
+	unbounded := make(map[string]string, len(w.unbounded))
+	for name, origin := range w.unbounded {
+		unbounded[name] = origin
+	}
+
+	if parameterList != nil {
+		for _, parameter := range parameterList.Parameters {
+			name := parameter.Identifier.Identifier
+			if isExternal {
+				unbounded[name] = fmt.Sprintf("external input `%s`", name)
+			} else {
+				delete(unbounded, name)
+			}
+		}
+	}
+
+	return &walker{
+		analyzer:  w.analyzer,
+		unbounded: unbounded,
+	}
+}
+
+// loop returns a walker for code which is evaluated on each iteration of a loop
+//
+func (w *walker) loop() *walker {
+	return &walker{
+		analyzer:  w.analyzer,
+		unbounded: w.unbounded,
+		inLoop:    true,
+	}
+}
+
+func (w *walker) checkLoopBound(loop ast.Statement, bound ast.Expression) {
+	origin := w.origin(bound)
+	if origin == "" {
+		return
+	}
+
+	w.report(Risk{
+		Kind:    RiskKindUnboundedLoop,
+		Range:   ast.NewRangeFromPositioned(nil, loop),
+		Message: fmt.Sprintf("loop bound depends on %s", origin),
+	})
+}
+
+// origin returns a description of the storage collection or external input
+// the given expression depends on, if any
+//
+func (w *walker) origin(expression ast.Expression) (origin string) {
+	if expression == nil {
+		return ""
+	}
+
+	ast.Inspect(expression, func(element ast.Element) bool {
+		if origin != "" {
+			return false
+		}
+
+		switch element := element.(type) {
+		case *ast.FunctionExpression:
+			// The body of a function expression is not evaluated
+			return false
+
+		case *ast.IdentifierExpression:
+			origin = w.unbounded[element.Identifier.Identifier]
+
+		case *ast.MemberExpression:
+			if w.isStoredCollectionField(element) {
+				origin = fmt.Sprintf("storage collection `%s`", element.Identifier.Identifier)
+			}
+
+		case *ast.InvocationExpression:
+			if name, ok := w.storageRead(element); ok {
+				origin = fmt.Sprintf("storage read `%s`", name)
+			}
+		}
+
+		return true
+	})
+
+	return
+}
+
+func (a *analyzer) memberInfo(expression ast.Expression) (sema.MemberInfo, bool) {
+	memberExpression, ok := expression.(*ast.MemberExpression)
+	if !ok || a.elaboration == nil {
+		return sema.MemberInfo{}, false
+	}
+
+	memberInfo, ok := a.elaboration.MemberExpressionMemberInfos[memberExpression.ID()]
+	if !ok || memberInfo.Member == nil {
+		return sema.MemberInfo{}, false
+	}
+
+	return memberInfo, true
+}
+
+// isStoredCollectionField returns true if the given member expression
+// accesses an array or dictionary field of a contract or resource,
+// i.e. a collection which is kept in storage and may grow without bounds
+//
+func (a *analyzer) isStoredCollectionField(expression *ast.MemberExpression) bool {
+	memberInfo, ok := a.memberInfo(expression)
+	if !ok {
+		return false
+	}
+
+	member := memberInfo.Member
+	if member.DeclarationKind != common.DeclarationKindField {
+		return false
+	}
+
+	compositeType, ok := member.ContainerType.(*sema.CompositeType)
+	if !ok {
+		return false
+	}
+
+	switch compositeType.Kind {
+	case common.CompositeKindContract,
+		common.CompositeKindResource:
+	default:
+		return false
+	}
+
+	switch member.TypeAnnotation.Type.(type) {
+	case *sema.VariableSizedType, *sema.DictionaryType:
+		return true
+	}
+
+	return false
+}
+
+// storageRead returns the name of the invoked function
+// if the given invocation reads from account storage
+//
+func (a *analyzer) storageRead(invocation *ast.InvocationExpression) (string, bool) {
+	memberInfo, ok := a.memberInfo(invocation.InvokedExpression)
+	if !ok {
+		return "", false
+	}
+
+	name := memberInfo.Member.Identifier.Identifier
+
+	switch accessedType := unwrapType(memberInfo.AccessedType).(type) {
+	case *sema.CompositeType:
+		if accessedType != sema.AuthAccountType {
+			break
+		}
+
+		switch name {
+		case sema.AuthAccountLoadField,
+			sema.AuthAccountCopyField,
+			sema.AuthAccountBorrowField,
+			sema.AuthAccountTypeField,
+			sema.AuthAccountGetLinkTargetField:
+
+			return name, true
+		}
+
+	case *sema.CapabilityType:
+		switch name {
+		case sema.CapabilityTypeBorrowField,
+			sema.CapabilityTypeCheckField:
+
+			return name, true
+		}
+	}
+
+	return "", false
+}
+
+func unwrapType(ty sema.Type) sema.Type {
+	for {
+		switch typ := ty.(type) {
+		case *sema.OptionalType:
+			ty = typ.Type
+		case *sema.ReferenceType:
+			ty = typ.Type
+		default:
+			return ty
+		}
+	}
+}
@@ -0,0 +1,261 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gasrisk_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/tools/analysis"
+	"github.com/onflow/cadence/tools/analysis/gasrisk"
+)
+
+func analyze(t *testing.T, location common.Location, code string) (gasrisk.Report, []analysis.Diagnostic) {
+
+	config := &analysis.Config{
+		Mode: analysis.NeedTypes,
+		ResolveCode: func(
+			resolvedLocation common.Location,
+			_ common.Location,
+			_ ast.Range,
+		) (string, error) {
+			require.Equal(t, location, resolvedLocation)
+			return code, nil
+		},
+	}
+
+	programs, err := analysis.Load(config, location)
+	require.NoError(t, err)
+
+	var diagnostics []analysis.Diagnostic
+
+	programs[location].Run(
+		[]*analysis.Analyzer{gasrisk.Analyzer},
+		func(diagnostic analysis.Diagnostic) {
+			diagnostics = append(diagnostics, diagnostic)
+		},
+	)
+
+	return gasrisk.Analyze(programs[location]), diagnostics
+}
+
+func kindsAndMessages(report gasrisk.Report) [][2]string {
+	var result [][2]string
+	for _, risk := range report {
+		result = append(result, [2]string{risk.Kind.String(), risk.Message})
+	}
+	return result
+}
+
+func TestAnalyzeUnboundedLoops(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("storage collection", func(t *testing.T) {
+
+		t.Parallel()
+
+		location := common.StringLocation("test")
+
+		report, diagnostics := analyze(t,
+			location,
+			`
+              pub contract C {
+
+                  pub let items: [Int]
+                  pub let names: {String: Int}
+
+                  init() {
+                      self.items = []
+                      self.names = {}
+                  }
+
+                  pub fun sum(): Int {
+                      var sum = 0
+                      for item in self.items {
+                          sum = sum + item
+                      }
+                      return sum
+                  }
+
+                  pub fun count(): Int {
+                      var i = 0
+                      while i < self.names.length {
+                          i = i + 1
+                      }
+                      return i
+                  }
+
+                  pub fun keys(): Int {
+                      let keys = self.names.keys
+                      var count = 0
+                      for key in keys {
+                          count = count + 1
+                      }
+                      return count
+                  }
+
+                  pub fun bounded(): Int {
+                      var i = 0
+                      while i < 10 {
+                          i = i + 1
+                      }
+                      return i
+                  }
+              }
+            `,
+		)
+
+		assert.Equal(t,
+			[][2]string{
+				{"UnboundedLoop", "loop bound depends on storage collection `items`"},
+				{"UnboundedLoop", "loop bound depends on storage collection `names`"},
+				{"UnboundedLoop", "loop bound depends on storage collection `names`"},
+			},
+			kindsAndMessages(report),
+		)
+
+		require.Len(t, diagnostics, 3)
+		assert.Equal(t, gasrisk.Category, diagnostics[0].Category)
+		assert.Equal(t, location, diagnostics[0].Location)
+		assert.Equal(t, report[0].Range, diagnostics[0].Range)
+		assert.Equal(t,
+			ast.Position{Offset: 339, Line: 14, Column: 22},
+			report[0].StartPos,
+		)
+	})
+
+	t.Run("external input", func(t *testing.T) {
+
+		t.Parallel()
+
+		report, _ := analyze(t,
+			common.StringLocation("test"),
+			`
+              pub fun main(values: [Int], count: Int): Int {
+                  var sum = 0
+                  for value in values {
+                      sum = sum + value
+                  }
+
+                  var i = 0
+                  while i < count {
+                      i = i + 1
+                  }
+
+                  return sum + helper(values)
+              }
+
+              priv fun helper(_ values: [Int]): Int {
+                  var sum = 0
+                  for value in values {
+                      sum = sum + value
+                  }
+                  return sum
+              }
+            `,
+		)
+
+		assert.Equal(t,
+			[][2]string{
+				{"UnboundedLoop", "loop bound depends on external input `values`"},
+				{"UnboundedLoop", "loop bound depends on external input `count`"},
+			},
+			kindsAndMessages(report),
+		)
+	})
+}
+
+func TestAnalyzeStorageReadsInLoops(t *testing.T) {
+
+	t.Parallel()
+
+	report, _ := analyze(t,
+		common.TransactionLocation{0x1},
+		`
+          transaction(paths: [StoragePath]) {
+
+              prepare(signer: AuthAccount) {
+                  for path in paths {
+                      let value = signer.copy<Int>(from: path)
+                  }
+
+                  let capability = signer.getCapability<&Int>(/public/number)
+                  var i = 0
+                  while i < 3 {
+                      capability.borrow()
+                      i = i + 1
+                  }
+
+                  let number = signer.borrow<&Int>(from: /storage/number)
+              }
+          }
+        `,
+	)
+
+	assert.Equal(t,
+		[][2]string{
+			{"UnboundedLoop", "loop bound depends on external input `paths`"},
+			{"StorageReadInLoop", "storage read `copy` inside of loop"},
+			{"StorageReadInLoop", "storage read `borrow` inside of loop"},
+		},
+		kindsAndMessages(report),
+	)
+}
+
+func TestReportJSON(t *testing.T) {
+
+	t.Parallel()
+
+	report := gasrisk.Report{
+		{
+			Kind:     gasrisk.RiskKindStorageReadInLoop,
+			Location: common.StringLocation("test"),
+			Range: ast.Range{
+				StartPos: ast.Position{Offset: 1, Line: 2, Column: 3},
+				EndPos:   ast.Position{Offset: 4, Line: 5, Column: 6},
+			},
+			Message: "storage read `borrow` inside of loop",
+		},
+	}
+
+	encoded, err := json.Marshal(report)
+	require.NoError(t, err)
+
+	assert.JSONEq(t,
+		// language=json
+		`
+          [
+            {
+              "kind": "StorageReadInLoop",
+              "location": "S.test",
+              "startPos": {"Offset": 1, "Line": 2, "Column": 3},
+              "endPos": {"Offset": 4, "Line": 5, "Column": 6},
+              "message": "storage read `+"`borrow`"+` inside of loop"
+            }
+          ]
+        `,
+		string(encoded),
+	)
+}
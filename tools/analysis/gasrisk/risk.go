@@ -0,0 +1,63 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gasrisk
+
+import (
+	"encoding/json"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+)
+
+// Risk is a finding of the gas risk analysis
+//
+type Risk struct {
+	Kind     RiskKind
+	Location common.Location
+	ast.Range
+	Message string
+}
+
+type jsonRisk struct {
+	Kind     RiskKind     `json:"kind"`
+	Location string       `json:"location,omitempty"`
+	StartPos ast.Position `json:"startPos"`
+	EndPos   ast.Position `json:"endPos"`
+	Message  string       `json:"message"`
+}
+
+func (r Risk) MarshalJSON() ([]byte, error) {
+	var location string
+	if r.Location != nil {
+		location = string(r.Location.ID())
+	}
+
+	return json.Marshal(jsonRisk{
+		Kind:     r.Kind,
+		Location: location,
+		StartPos: r.StartPos,
+		EndPos:   r.EndPos,
+		Message:  r.Message,
+	})
+}
+
+// Report is the result of the gas risk analysis of a program.
+// It can be encoded to JSON, e.g. for consumption by audit tooling
+//
+type Report []Risk
@@ -0,0 +1,40 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gasrisk
+
+//go:generate go run golang.org/x/tools/cmd/stringer -type=RiskKind -trimprefix=RiskKind
+
+// RiskKind is the kind of gas risk
+//
+type RiskKind uint
+
+const (
+	RiskKindUnknown RiskKind = iota
+
+	// RiskKindUnboundedLoop is the kind of loops
+	// whose number of iterations depends on a storage collection or external input
+	RiskKindUnboundedLoop
+
+	// RiskKindStorageReadInLoop is the kind of storage reads inside of loops
+	RiskKindStorageReadInLoop
+)
+
+func (k RiskKind) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
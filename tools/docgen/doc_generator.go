@@ -54,6 +54,8 @@ var templateFiles = []string{
 	"enum-case-template",
 	"initializer-template",
 	"event-template",
+	"type-alias-template",
+	"deprecation-template",
 }
 
 type DocGenerator struct {
@@ -62,6 +64,7 @@ type DocGenerator struct {
 	typeNames        []string
 	outputDir        string
 	files            InMemoryFiles
+	deprecations     map[ast.Declaration]string
 }
 
 type InMemoryFiles map[string][]byte
@@ -102,6 +105,15 @@ func NewDocGenerator() *DocGenerator {
 		return fmt.Sprint(fileNamePrefix, nameSeparator, decl.DeclarationIdentifier().String(), mdFileExt)
 	}
 
+	functions["isDeprecated"] = func(decl ast.Declaration) bool {
+		_, ok := gen.deprecations[decl]
+		return ok
+	}
+
+	functions["deprecationMessage"] = func(decl ast.Declaration) string {
+		return gen.deprecations[decl]
+	}
+
 	templateProvider := templates.NewMarkdownTemplateProvider()
 
 	gen.entryPageGen = newTemplate(baseTemplate, templateProvider)
@@ -166,6 +178,9 @@ func (gen *DocGenerator) GenerateInMemory(source string) (InMemoryFiles, error)
 
 func (gen *DocGenerator) genProgram(program *ast.Program) error {
 
+	gen.deprecations = map[ast.Declaration]string{}
+	gen.collectDeprecations(program.Declarations())
+
 	// If its not a sole-declaration, i.e: has multiple top level declarations,
 	// then generated an entry page.
 	if program.SoleContractDeclaration() == nil &&
@@ -190,6 +205,24 @@ func (gen *DocGenerator) genProgram(program *ast.Program) error {
 	return gen.genDeclarations(program.Declarations())
 }
 
+// collectDeprecations records the declarations which are annotated
+// with a deprecated pragma, including nested declarations.
+func (gen *DocGenerator) collectDeprecations(decls []ast.Declaration) {
+	members := ast.NewUnmeteredMembers(decls)
+	for decl, message := range members.Deprecations() {
+		gen.deprecations[decl] = message
+	}
+
+	for _, decl := range decls {
+		declMembers := decl.DeclarationMembers()
+		if declMembers == nil {
+			continue
+		}
+
+		gen.collectDeprecations(declMembers.Declarations())
+	}
+}
+
 func (gen *DocGenerator) genDeclarations(decls []ast.Declaration) error {
 	var err error
 	for _, decl := range decls {
@@ -133,3 +133,33 @@ func TestFunctionDocFormatting(t *testing.T) {
 
 	assert.Equal(t, string(expectedContent), string(docFiles["index.md"]))
 }
+
+func TestDeprecationDocFormatting(t *testing.T) {
+
+	t.Parallel()
+
+	code := `
+        #deprecated("use bar")
+        pub fun foo() {}
+
+        #deprecated
+        pub fun bar() {}
+    `
+
+	docGen := docgen.NewDocGenerator()
+
+	docFiles, err := docGen.GenerateInMemory(code)
+	require.NoError(t, err)
+	require.Len(t, docFiles, 1)
+
+	assert.Equal(t,
+		"## Functions\n\n"+
+			"### fun `foo()`\n\n"+
+			"```cadence\nfunc foo()\n```\n\n"+
+			"**Deprecated**: use bar\n\n---\n\n"+
+			"### fun `bar()`\n\n"+
+			"```cadence\nfunc bar()\n```\n\n"+
+			"**Deprecated**\n\n---\n",
+		string(docFiles["index.md"]),
+	)
+}
@@ -0,0 +1,463 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+// expression returns a random expression of the given type.
+//
+// Expressions which might overflow, divide by zero, or grow unboundedly
+// when evaluated repeatedly in loops are never generated
+//
+func (g *Generator) expression(ty sema.Type, depth int) string {
+	if depth >= g.config.MaxDepth {
+		return g.literal(ty, depth)
+	}
+
+	var result string
+	var ok bool
+
+	switch g.random.Intn(7) {
+	case 0:
+		result, ok = g.variable(ty)
+	case 1:
+		result, ok = g.functionInvocation(ty, depth)
+	case 2:
+		result, ok = g.conditional(ty, depth), true
+	case 3:
+		result, ok = g.nilCoalescing(ty, depth), true
+	case 4, 5:
+		result, ok = g.compoundExpression(ty, depth)
+	case 6:
+		result, ok = g.fieldAccess(ty, depth)
+	}
+
+	if ok {
+		return result
+	}
+
+	return g.literal(ty, depth)
+}
+
+// typed returns the given expression statically cast to the given type,
+// for positions in which the checker has no expected type,
+// so literals have the given type, instead of their default type
+//
+func (g *Generator) typed(expression string, ty sema.Type) string {
+	return fmt.Sprintf("(%s as %s)", expression, ty)
+}
+
+func (g *Generator) typedExpression(ty sema.Type, depth int) string {
+	return g.typed(g.expression(ty, depth), ty)
+}
+
+func (g *Generator) variable(ty sema.Type) (string, bool) {
+	var candidates []string
+	for _, variable := range g.scope {
+		if variable.typ.Equal(ty) {
+			candidates = append(candidates, variable.name)
+		}
+	}
+	if len(candidates) == 0 {
+		return "", false
+	}
+	return candidates[g.random.Intn(len(candidates))], true
+}
+
+func (g *Generator) functionInvocation(returnType sema.Type, depth int) (string, bool) {
+	var candidates []*function
+	for _, function := range g.functions {
+		if function.returnType.Equal(returnType) {
+			candidates = append(candidates, function)
+		}
+	}
+	if len(candidates) == 0 {
+		return "", false
+	}
+	function := candidates[g.random.Intn(len(candidates))]
+	return g.invocation(function, depth), true
+}
+
+func (g *Generator) invocation(function *function, depth int) string {
+	arguments := make([]string, 0, len(function.parameters))
+	for _, parameter := range function.parameters {
+		arguments = append(arguments, fmt.Sprintf(
+			"%s: %s",
+			parameter.name,
+			g.expression(parameter.typ, depth+1),
+		))
+	}
+	return fmt.Sprintf("%s(%s)", function.name, strings.Join(arguments, ", "))
+}
+
+func (g *Generator) construction(composite *composite, depth int) string {
+	arguments := make([]string, 0, len(composite.fields))
+	for _, field := range composite.fields {
+		arguments = append(arguments, fmt.Sprintf(
+			"%s: %s",
+			field.name,
+			g.expression(field.typ, depth+1),
+		))
+	}
+	return fmt.Sprintf("%s(%s)", composite.name, strings.Join(arguments, ", "))
+}
+
+// fieldAccess returns an access of a field of a newly constructed structure.
+// Resources are not accessed, as they would have to be destroyed
+//
+func (g *Generator) fieldAccess(ty sema.Type, depth int) (string, bool) {
+	type candidate struct {
+		composite *composite
+		field     variable
+	}
+
+	var candidates []candidate
+	for _, composite := range g.composites {
+		if composite.isResource {
+			continue
+		}
+		for _, field := range composite.fields {
+			if field.typ.Equal(ty) {
+				candidates = append(candidates, candidate{
+					composite: composite,
+					field:     field,
+				})
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		return "", false
+	}
+
+	chosen := candidates[g.random.Intn(len(candidates))]
+	return fmt.Sprintf(
+		"%s.%s",
+		g.construction(chosen.composite, depth),
+		chosen.field.name,
+	), true
+}
+
+func (g *Generator) conditional(ty sema.Type, depth int) string {
+	return fmt.Sprintf(
+		"(%s ? %s : %s)",
+		g.expression(sema.BoolType, depth+1),
+		g.expression(ty, depth+1),
+		g.expression(ty, depth+1),
+	)
+}
+
+func (g *Generator) nilCoalescing(ty sema.Type, depth int) string {
+	return fmt.Sprintf(
+		"(%s ?? %s)",
+		g.typedExpression(&sema.OptionalType{Type: ty}, depth+1),
+		g.expression(ty, depth+1),
+	)
+}
+
+func (g *Generator) binary(operators []string, left, right string) string {
+	return fmt.Sprintf(
+		"(%s %s %s)",
+		left,
+		operators[g.random.Intn(len(operators))],
+		right,
+	)
+}
+
+// nonZeroIntegerLiteral returns a positive integer literal,
+// which fits all integer types
+//
+func (g *Generator) nonZeroIntegerLiteral() string {
+	return fmt.Sprint(1 + g.random.Intn(127))
+}
+
+func (g *Generator) compoundExpression(ty sema.Type, depth int) (string, bool) {
+	depth++
+
+	switch ty := ty.(type) {
+	case *sema.OptionalType:
+		// Dictionary access
+		dictionaryType := &sema.DictionaryType{
+			KeyType:   g.chooseType(dictionaryKeyTypes),
+			ValueType: ty.Type,
+		}
+		return fmt.Sprintf(
+			"%s[%s]",
+			g.typedExpression(dictionaryType, depth),
+			g.expression(dictionaryType.KeyType, depth),
+		), true
+
+	case *sema.VariableSizedType:
+		// Concatenation with a literal, so the array does not grow exponentially in loops
+		return fmt.Sprintf(
+			"%s.concat(%s)",
+			g.typedExpression(ty, depth),
+			g.literal(ty, depth),
+		), true
+	}
+
+	switch {
+	case ty.Equal(sema.IntType):
+		return g.intExpression(depth), true
+
+	case containsType(wordTypes, ty):
+		// Arithmetic on word types wraps around, so it never overflows
+		return g.binary(
+			[]string{"+", "-", "*", "&", "|", "^"},
+			g.expression(ty, depth),
+			g.expression(ty, depth),
+		), true
+
+	case containsType(integerTypes, ty):
+		return g.binary(
+			[]string{"&", "|", "^"},
+			g.expression(ty, depth),
+			g.expression(ty, depth),
+		), true
+
+	case ty.Equal(sema.BoolType):
+		return g.boolExpression(depth), true
+
+	case ty.Equal(sema.StringType):
+		return g.stringExpression(depth), true
+	}
+
+	return "", false
+}
+
+func (g *Generator) intExpression(depth int) string {
+	switch g.random.Intn(6) {
+	case 0:
+		return g.binary(
+			[]string{"+", "-"},
+			g.expression(sema.IntType, depth),
+			g.expression(sema.IntType, depth),
+		)
+
+	case 1:
+		// Multiplication by and remainder of a non-zero literal,
+		// so the value does not grow exponentially in loops,
+		// and there is no division by zero
+		return g.binary(
+			[]string{"*", "%"},
+			g.expression(sema.IntType, depth),
+			g.nonZeroIntegerLiteral(),
+		)
+
+	case 2:
+		arrayType := &sema.VariableSizedType{
+			Type: g.randomType(depth),
+		}
+		return g.typedExpression(arrayType, depth) + ".length"
+
+	case 3:
+		return g.typedExpression(sema.StringType, depth) + ".length"
+
+	case 4:
+		return fmt.Sprintf("Int(%s)", g.typedExpression(g.chooseType(integerTypes), depth))
+
+	default:
+		return g.binary(
+			[]string{"&", "|", "^"},
+			g.expression(sema.IntType, depth),
+			g.expression(sema.IntType, depth),
+		)
+	}
+}
+
+func (g *Generator) boolExpression(depth int) string {
+	switch g.random.Intn(6) {
+	case 0:
+		return fmt.Sprintf("!(%s)", g.expression(sema.BoolType, depth))
+
+	case 1:
+		return g.binary(
+			[]string{"&&", "||"},
+			g.expression(sema.BoolType, depth),
+			g.expression(sema.BoolType, depth),
+		)
+
+	case 2:
+		operandType := g.randomEquatableType(depth)
+		return g.binary(
+			[]string{"==", "!="},
+			g.typedExpression(operandType, depth),
+			g.typedExpression(operandType, depth),
+		)
+
+	case 3:
+		operandType := g.chooseType(numberTypes)
+		return g.binary(
+			[]string{"<", "<=", ">", ">="},
+			g.typedExpression(operandType, depth),
+			g.typedExpression(operandType, depth),
+		)
+
+	case 4:
+		arrayType := &sema.VariableSizedType{
+			Type: g.randomEquatableType(depth),
+		}
+		return fmt.Sprintf(
+			"%s.contains(%s)",
+			g.typedExpression(arrayType, depth),
+			g.expression(arrayType.Type, depth),
+		)
+
+	default:
+		dictionaryType := &sema.DictionaryType{
+			KeyType:   g.chooseType(dictionaryKeyTypes),
+			ValueType: g.randomType(depth),
+		}
+		return fmt.Sprintf(
+			"%s.containsKey(%s)",
+			g.typedExpression(dictionaryType, depth),
+			g.expression(dictionaryType.KeyType, depth),
+		)
+	}
+}
+
+func (g *Generator) stringExpression(depth int) string {
+	switch g.random.Intn(3) {
+	case 0:
+		// Concatenation with a literal, so the string does not grow exponentially in loops
+		return fmt.Sprintf(
+			"%s.concat(%s)",
+			g.typedExpression(sema.StringType, depth),
+			g.stringLiteral(),
+		)
+
+	case 1:
+		return fmt.Sprintf(
+			"%s.toString()",
+			g.typedExpression(g.chooseType(numberTypes), depth),
+		)
+
+	default:
+		return fmt.Sprintf(
+			"%s.toLower()",
+			g.typedExpression(sema.StringType, depth),
+		)
+	}
+}
+
+// Literals
+
+func (g *Generator) literal(ty sema.Type, depth int) string {
+	switch ty := ty.(type) {
+	case *sema.OptionalType:
+		if depth >= g.config.MaxDepth || g.chance(2) {
+			return "nil"
+		}
+		return g.expression(ty.Type, depth+1)
+
+	case *sema.VariableSizedType:
+		count := g.elementCount(depth)
+		elements := make([]string, 0, count)
+		for i := 0; i < count; i++ {
+			elements = append(elements, g.expression(ty.Type, depth+1))
+		}
+		return fmt.Sprintf("[%s]", strings.Join(elements, ", "))
+
+	case *sema.DictionaryType:
+		count := g.elementCount(depth)
+		entries := make([]string, 0, count)
+		for i := 0; i < count; i++ {
+			entries = append(entries, fmt.Sprintf(
+				"%s: %s",
+				g.literal(ty.KeyType, depth+1),
+				g.expression(ty.ValueType, depth+1),
+			))
+		}
+		return fmt.Sprintf("{%s}", strings.Join(entries, ", "))
+	}
+
+	switch {
+	case containsType(integerTypes, ty):
+		// Small literals, so they fit all integer types.
+		// NOTE: Negative zero is not negated, as it is not inferred
+		if isSignedType(ty) && g.chance(3) {
+			return fmt.Sprintf("(-%s)", g.nonZeroIntegerLiteral())
+		}
+		value := g.random.Intn(128)
+		switch g.random.Intn(4) {
+		case 0:
+			return fmt.Sprintf("0x%x", value)
+		case 1:
+			return fmt.Sprintf("0b%b", value)
+		default:
+			return fmt.Sprint(value)
+		}
+
+	case containsType(fixedPointTypes, ty):
+		literal := fmt.Sprintf("%d.%d", g.random.Intn(128), g.random.Intn(100))
+		if isSignedType(ty) && g.chance(3) {
+			return fmt.Sprintf("(-%s)", literal)
+		}
+		return literal
+
+	case ty.Equal(sema.BoolType):
+		if g.chance(2) {
+			return "true"
+		}
+		return "false"
+
+	case ty.Equal(sema.StringType):
+		return g.stringLiteral()
+	}
+
+	panic(fmt.Errorf("unsupported type: %s", ty))
+}
+
+// elementCount returns the number of elements of a container literal.
+// Deeply nested containers are empty
+//
+func (g *Generator) elementCount(depth int) int {
+	if depth >= g.config.MaxDepth {
+		return 0
+	}
+	return g.random.Intn(g.config.MaxElements + 1)
+}
+
+func (g *Generator) stringLiteral() string {
+	var builder strings.Builder
+	builder.WriteByte('"')
+
+	length := g.random.Intn(6)
+	for i := 0; i < length; i++ {
+		switch g.random.Intn(8) {
+		case 0:
+			escapes := []string{`\0`, `\\`, `\t`, `\n`, `\r`, `\"`, `\'`}
+			builder.WriteString(escapes[g.random.Intn(len(escapes))])
+		case 1:
+			fmt.Fprintf(&builder, `\u{%x}`, g.random.Intn(0xD800))
+		case 2:
+			// Upper case letters, so lower-casing has an effect
+			builder.WriteByte(byte('A' + g.random.Intn(26)))
+		default:
+			builder.WriteByte(byte('a' + g.random.Intn(26)))
+		}
+	}
+
+	builder.WriteByte('"')
+	return builder.String()
+}
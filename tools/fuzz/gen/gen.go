@@ -0,0 +1,545 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package gen generates random, well-typed Cadence programs.
+//
+// The types of the generated declarations and expressions are sema types,
+// and each program has a main function which returns a value of a random type.
+//
+// The generated programs always type-check, and terminate without a run-time error,
+// so they are suitable for differential testing, e.g. comparing the results
+// of the tree-walking interpreter with the results of another backend,
+// and as a seed corpus for fuzzing, see the fuzz package.
+// Given the same seed and configuration, the generator produces the same programs.
+//
+package gen
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+// MainFunctionName is the name of the function which is declared in each generated program.
+// It is the entry point of the program, has no parameters,
+// and returns a value of the program's result type.
+//
+const MainFunctionName = sema.FunctionEntryPointName
+
+// Config is the configuration of a generator
+//
+type Config struct {
+	// MaxDepth is the maximum nesting depth of types, expressions, and statements
+	MaxDepth int
+	// MaxStatements is the maximum number of statements in a block
+	MaxStatements int
+	// MaxComposites is the maximum number of structures and resources declared
+	MaxComposites int
+	// MaxFunctions is the maximum number of functions declared in addition to the main function
+	MaxFunctions int
+	// MaxParameters is the maximum number of parameters of a function
+	MaxParameters int
+	// MaxElements is the maximum number of elements in array and dictionary literals
+	MaxElements int
+	// MaxLoopIterations is the maximum number of iterations of a loop
+	MaxLoopIterations int
+}
+
+// DefaultConfig is the default configuration of a generator
+//
+var DefaultConfig = Config{
+	MaxDepth:          3,
+	MaxStatements:     4,
+	MaxComposites:     2,
+	MaxFunctions:      3,
+	MaxParameters:     3,
+	MaxElements:       3,
+	MaxLoopIterations: 3,
+}
+
+// Program is a generated program
+//
+type Program struct {
+	Code string
+	// ResultType is the return type of the main function
+	ResultType sema.Type
+}
+
+// Generator generates random, well-typed Cadence programs
+//
+type Generator struct {
+	config     Config
+	random     *rand.Rand
+	builder    strings.Builder
+	indent     int
+	nextName   int
+	composites []*composite
+	functions  []*function
+	scope      []variable
+}
+
+type composite struct {
+	name       string
+	isResource bool
+	// fields are the fields of the composite.
+	// Fields which are assignable are declared with `var`
+	fields []variable
+}
+
+type function struct {
+	name       string
+	parameters []variable
+	returnType sema.Type
+}
+
+type variable struct {
+	name string
+	typ  sema.Type
+	// assignable is true if the variable may be assigned or mutated,
+	// i.e. it was declared with `var`, and it is not a loop variable or counter
+	assignable bool
+}
+
+func NewGenerator(seed int64, config Config) *Generator {
+	return &Generator{
+		config: config,
+		random: rand.New(rand.NewSource(seed)),
+	}
+}
+
+// Program generates a new random program
+//
+func (g *Generator) Program() Program {
+	g.builder.Reset()
+	g.indent = 0
+	g.nextName = 0
+	g.composites = nil
+	g.functions = nil
+	g.scope = nil
+
+	compositeCount := g.random.Intn(g.config.MaxComposites + 1)
+	for i := 0; i < compositeCount; i++ {
+		g.composite()
+		g.writeLine("")
+	}
+
+	functionCount := g.random.Intn(g.config.MaxFunctions + 1)
+	for i := 0; i < functionCount; i++ {
+		g.function(g.newName("f"), g.parameters())
+		g.writeLine("")
+	}
+
+	main := g.function(MainFunctionName, nil)
+
+	return Program{
+		Code:       g.builder.String(),
+		ResultType: main.returnType,
+	}
+}
+
+func (g *Generator) newName(prefix string) string {
+	name := fmt.Sprintf("%s%d", prefix, g.nextName)
+	g.nextName++
+	return name
+}
+
+func (g *Generator) writeLine(line string) {
+	if line != "" {
+		g.builder.WriteString(strings.Repeat("    ", g.indent))
+		g.builder.WriteString(line)
+	}
+	g.builder.WriteByte('\n')
+}
+
+func (g *Generator) chance(n int) bool {
+	return g.random.Intn(n) == 0
+}
+
+// Declarations
+
+func (g *Generator) parameters() []variable {
+	count := g.random.Intn(g.config.MaxParameters + 1)
+	parameters := make([]variable, 0, count)
+	for i := 0; i < count; i++ {
+		parameters = append(parameters, variable{
+			name: g.newName("p"),
+			typ:  g.randomType(0),
+		})
+	}
+	return parameters
+}
+
+func (g *Generator) composite() {
+	composite := &composite{
+		isResource: g.chance(2),
+	}
+
+	kind := "struct"
+	if composite.isResource {
+		kind = "resource"
+		composite.name = g.newName("R")
+	} else {
+		composite.name = g.newName("S")
+	}
+
+	fieldCount := 1 + g.random.Intn(3)
+	for i := 0; i < fieldCount; i++ {
+		composite.fields = append(composite.fields, variable{
+			name:       g.newName("f"),
+			typ:        g.randomType(0),
+			assignable: g.chance(2),
+		})
+	}
+
+	g.writeLine(fmt.Sprintf("pub %s %s {", kind, composite.name))
+	g.indent++
+
+	parameters := make([]string, 0, len(composite.fields))
+
+	for _, field := range composite.fields {
+		variableKind := "let"
+		if field.assignable {
+			variableKind = "var"
+		}
+		g.writeLine(fmt.Sprintf("pub %s %s: %s", variableKind, field.name, field.typ))
+
+		parameters = append(parameters, fmt.Sprintf("%s: %s", field.name, field.typ))
+	}
+
+	g.writeLine("")
+	g.writeLine(fmt.Sprintf("init(%s) {", strings.Join(parameters, ", ")))
+	g.indent++
+	for _, field := range composite.fields {
+		g.writeLine(fmt.Sprintf("self.%[1]s = %[1]s", field.name))
+	}
+	g.indent--
+	g.writeLine("}")
+
+	g.indent--
+	g.writeLine("}")
+
+	g.composites = append(g.composites, composite)
+}
+
+func (g *Generator) function(name string, parameters []variable) *function {
+	function := &function{
+		name:       name,
+		parameters: parameters,
+		returnType: g.randomType(0),
+	}
+
+	parameterDeclarations := make([]string, 0, len(parameters))
+	for _, parameter := range parameters {
+		parameterDeclarations = append(
+			parameterDeclarations,
+			fmt.Sprintf("%s: %s", parameter.name, parameter.typ),
+		)
+	}
+
+	g.writeLine(fmt.Sprintf(
+		"pub fun %s(%s): %s {",
+		name,
+		strings.Join(parameterDeclarations, ", "),
+		function.returnType,
+	))
+	g.indent++
+
+	g.scope = append(g.scope[:0], parameters...)
+	g.statements(0)
+	g.writeLine("return " + g.expression(function.returnType, 0))
+
+	g.indent--
+	g.writeLine("}")
+
+	// Only declare the function after its body was generated,
+	// so functions are never recursive, and programs always terminate
+
+	g.functions = append(g.functions, function)
+
+	return function
+}
+
+// Statements
+
+func (g *Generator) statements(depth int) {
+	scopeLength := len(g.scope)
+
+	count := g.random.Intn(g.config.MaxStatements + 1)
+	for i := 0; i < count; i++ {
+		g.statement(depth)
+	}
+
+	// Variables declared in the block are not accessible after it
+
+	g.scope = g.scope[:scopeLength]
+}
+
+func (g *Generator) block(depth int) {
+	g.indent++
+	g.statements(depth + 1)
+	g.indent--
+}
+
+func (g *Generator) statement(depth int) {
+	canNest := depth < g.config.MaxDepth
+
+	switch g.random.Intn(8) {
+	case 0:
+		if g.assignment() {
+			return
+		}
+
+	case 1:
+		if g.mutation() {
+			return
+		}
+
+	case 2:
+		if canNest {
+			g.ifStatement(depth)
+			return
+		}
+
+	case 3:
+		if canNest {
+			g.whileStatement(depth)
+			return
+		}
+
+	case 4:
+		if canNest {
+			g.forStatement(depth)
+			return
+		}
+
+	case 5:
+		if len(g.functions) > 0 {
+			function := g.functions[g.random.Intn(len(g.functions))]
+			g.writeLine(g.invocation(function, depth))
+			return
+		}
+
+	case 6:
+		if g.compositeUse() {
+			return
+		}
+	}
+
+	g.variableDeclaration()
+}
+
+func (g *Generator) declare(variable variable) {
+	g.scope = append(g.scope, variable)
+}
+
+func (g *Generator) variableDeclaration() {
+	v := variable{
+		name:       g.newName("v"),
+		typ:        g.randomType(0),
+		assignable: g.chance(2),
+	}
+
+	kind := "let"
+	if v.assignable {
+		kind = "var"
+	}
+
+	g.writeLine(fmt.Sprintf(
+		"%s %s: %s = %s",
+		kind,
+		v.name,
+		v.typ,
+		g.expression(v.typ, 0),
+	))
+
+	g.declare(v)
+}
+
+func (g *Generator) assignableVariables(filter func(sema.Type) bool) []variable {
+	var candidates []variable
+	for _, variable := range g.scope {
+		if variable.assignable && filter(variable.typ) {
+			candidates = append(candidates, variable)
+		}
+	}
+	return candidates
+}
+
+func (g *Generator) assignment() bool {
+	candidates := g.assignableVariables(func(sema.Type) bool {
+		return true
+	})
+	if len(candidates) == 0 {
+		return false
+	}
+
+	variable := candidates[g.random.Intn(len(candidates))]
+	g.writeLine(fmt.Sprintf("%s = %s", variable.name, g.expression(variable.typ, 0)))
+	return true
+}
+
+// mutation appends to an array, or inserts into a dictionary
+//
+func (g *Generator) mutation() bool {
+	candidates := g.assignableVariables(func(ty sema.Type) bool {
+		switch ty.(type) {
+		case *sema.VariableSizedType, *sema.DictionaryType:
+			return true
+		default:
+			return false
+		}
+	})
+	if len(candidates) == 0 {
+		return false
+	}
+
+	variable := candidates[g.random.Intn(len(candidates))]
+
+	switch ty := variable.typ.(type) {
+	case *sema.VariableSizedType:
+		g.writeLine(fmt.Sprintf(
+			"%s.append(%s)",
+			variable.name,
+			g.expression(ty.Type, 0),
+		))
+
+	case *sema.DictionaryType:
+		g.writeLine(fmt.Sprintf(
+			"%s[%s] = %s",
+			variable.name,
+			g.expression(ty.KeyType, 0),
+			g.expression(ty.ValueType, 0),
+		))
+	}
+
+	return true
+}
+
+// compositeUse constructs a composite value, reads one of its fields,
+// and destroys the value if it is a resource
+//
+func (g *Generator) compositeUse() bool {
+	if len(g.composites) == 0 {
+		return false
+	}
+
+	composite := g.composites[g.random.Intn(len(g.composites))]
+
+	name := g.newName("c")
+	construction := g.construction(composite, 0)
+
+	if composite.isResource {
+		g.writeLine(fmt.Sprintf("let %s <- create %s", name, construction))
+	} else {
+		g.writeLine(fmt.Sprintf("let %s = %s", name, construction))
+	}
+
+	field := composite.fields[g.random.Intn(len(composite.fields))]
+	fieldVariable := variable{
+		name: g.newName("v"),
+		typ:  field.typ,
+	}
+	g.writeLine(fmt.Sprintf(
+		"let %s: %s = %s.%s",
+		fieldVariable.name,
+		fieldVariable.typ,
+		name,
+		field.name,
+	))
+
+	if composite.isResource {
+		g.writeLine(fmt.Sprintf("destroy %s", name))
+	}
+
+	g.declare(fieldVariable)
+
+	return true
+}
+
+func (g *Generator) ifStatement(depth int) {
+	g.writeLine(fmt.Sprintf("if %s {", g.expression(sema.BoolType, 0)))
+	g.block(depth)
+	if g.chance(2) {
+		g.writeLine("} else {")
+		g.block(depth)
+	}
+	g.writeLine("}")
+}
+
+// whileStatement generates a loop with a counter,
+// so the loop always terminates
+//
+func (g *Generator) whileStatement(depth int) {
+	counter := g.newName("i")
+	iterations := 1 + g.random.Intn(g.config.MaxLoopIterations)
+
+	g.writeLine(fmt.Sprintf("var %s = 0", counter))
+	g.writeLine(fmt.Sprintf("while %s < %d {", counter, iterations))
+
+	g.indent++
+	scopeLength := len(g.scope)
+	g.declare(variable{
+		name: counter,
+		typ:  sema.IntType,
+	})
+	g.statements(depth + 1)
+	g.writeLine(fmt.Sprintf("%[1]s = %[1]s + 1", counter))
+	g.scope = g.scope[:scopeLength]
+	g.indent--
+
+	g.writeLine("}")
+
+	g.declare(variable{
+		name: counter,
+		typ:  sema.IntType,
+	})
+}
+
+// forStatement generates a loop over an array literal,
+// so the number of iterations is bounded
+//
+func (g *Generator) forStatement(depth int) {
+	elementType := g.randomType(g.config.MaxDepth - 1)
+	element := g.newName("e")
+
+	count := 1 + g.random.Intn(g.config.MaxLoopIterations)
+	elements := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		elements = append(elements, g.expression(elementType, depth+1))
+	}
+
+	g.writeLine(fmt.Sprintf(
+		"for %s in %s {",
+		element,
+		g.typed(fmt.Sprintf("[%s]", strings.Join(elements, ", ")), &sema.VariableSizedType{Type: elementType}),
+	))
+
+	g.indent++
+	scopeLength := len(g.scope)
+	g.declare(variable{
+		name: element,
+		typ:  elementType,
+	})
+	g.statements(depth + 1)
+	g.scope = g.scope[:scopeLength]
+	g.indent--
+
+	g.writeLine("}")
+}
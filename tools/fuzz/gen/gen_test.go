@@ -0,0 +1,125 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/parser"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+const testSeed = 42
+const testProgramCount = 200
+
+func generatePrograms(seed int64, count int) []Program {
+	generator := NewGenerator(seed, DefaultConfig)
+	programs := make([]Program, 0, count)
+	for i := 0; i < count; i++ {
+		programs = append(programs, generator.Program())
+	}
+	return programs
+}
+
+func parseAndCheck(t *testing.T, code string) *sema.Checker {
+	program, err := parser.ParseProgram(code, nil)
+	require.NoError(t, err, code)
+
+	checker, err := sema.NewChecker(
+		program,
+		common.StringLocation("test"),
+		nil,
+		false,
+		sema.WithAccessCheckMode(sema.AccessCheckModeNotSpecifiedUnrestricted),
+	)
+	require.NoError(t, err)
+
+	err = checker.Check()
+	require.NoError(t, err, code)
+
+	return checker
+}
+
+func invokeMain(t *testing.T, checker *sema.Checker) interpreter.Value {
+	var uuid uint64
+
+	inter, err := interpreter.NewInterpreter(
+		interpreter.ProgramFromChecker(checker),
+		checker.Location,
+		interpreter.WithStorage(interpreter.NewInMemoryStorage(nil)),
+		interpreter.WithUUIDHandler(func() (uint64, error) {
+			uuid++
+			return uuid, nil
+		}),
+	)
+	require.NoError(t, err)
+
+	err = inter.Interpret()
+	require.NoError(t, err)
+
+	result, err := inter.Invoke(MainFunctionName)
+	require.NoError(t, err)
+
+	return result
+}
+
+func TestGenerator(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("deterministic", func(t *testing.T) {
+
+		t.Parallel()
+
+		assert.Equal(t,
+			generatePrograms(testSeed, testProgramCount),
+			generatePrograms(testSeed, testProgramCount),
+		)
+	})
+
+	t.Run("well-typed and terminating", func(t *testing.T) {
+
+		t.Parallel()
+
+		for _, program := range generatePrograms(testSeed, testProgramCount) {
+
+			checker := parseAndCheck(t, program.Code)
+
+			mainType, err := checker.Elaboration.FunctionEntryPointType()
+			require.NoError(t, err)
+
+			assert.True(t,
+				mainType.ReturnTypeAnnotation.Type.Equal(program.ResultType),
+				program.Code,
+			)
+
+			// Evaluating the same program twice must produce the same result
+
+			first := invokeMain(t, checker)
+			second := invokeMain(t, checker)
+
+			assert.Equal(t, first.String(), second.String(), program.Code)
+		}
+	})
+}
@@ -0,0 +1,139 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gen
+
+import (
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+var signedFixedSizeIntegerTypes = []sema.Type{
+	sema.Int8Type,
+	sema.Int16Type,
+	sema.Int32Type,
+	sema.Int64Type,
+}
+
+var unsignedFixedSizeIntegerTypes = []sema.Type{
+	sema.UInt8Type,
+	sema.UInt16Type,
+	sema.UInt32Type,
+	sema.UInt64Type,
+}
+
+var wordTypes = []sema.Type{
+	sema.Word8Type,
+	sema.Word16Type,
+	sema.Word32Type,
+	sema.Word64Type,
+}
+
+var fixedPointTypes = []sema.Type{
+	sema.Fix64Type,
+	sema.UFix64Type,
+}
+
+var integerTypes = concatTypes(
+	[]sema.Type{sema.IntType},
+	signedFixedSizeIntegerTypes,
+	unsignedFixedSizeIntegerTypes,
+	wordTypes,
+)
+
+var numberTypes = concatTypes(
+	integerTypes,
+	fixedPointTypes,
+)
+
+var simpleTypes = concatTypes(
+	numberTypes,
+	[]sema.Type{
+		sema.BoolType,
+		sema.StringType,
+	},
+)
+
+var dictionaryKeyTypes = []sema.Type{
+	sema.IntType,
+	sema.StringType,
+}
+
+func concatTypes(typeLists ...[]sema.Type) []sema.Type {
+	var result []sema.Type
+	for _, types := range typeLists {
+		result = append(result, types...)
+	}
+	return result
+}
+
+func containsType(types []sema.Type, ty sema.Type) bool {
+	for _, otherType := range types {
+		if ty.Equal(otherType) {
+			return true
+		}
+	}
+	return false
+}
+
+func isSignedType(ty sema.Type) bool {
+	return ty.Equal(sema.IntType) ||
+		ty.Equal(sema.Fix64Type) ||
+		containsType(signedFixedSizeIntegerTypes, ty)
+}
+
+func (g *Generator) chooseType(types []sema.Type) sema.Type {
+	return types[g.random.Intn(len(types))]
+}
+
+// randomType returns a random type.
+// The deeper the type is nested, the more likely it is a simple type
+//
+func (g *Generator) randomType(depth int) sema.Type {
+	if depth >= g.config.MaxDepth-1 || !g.chance(3) {
+		return g.chooseType(simpleTypes)
+	}
+
+	switch g.random.Intn(3) {
+	case 0:
+		return &sema.OptionalType{
+			Type: g.randomType(depth + 1),
+		}
+
+	case 1:
+		return &sema.VariableSizedType{
+			Type: g.randomType(depth + 1),
+		}
+
+	default:
+		return &sema.DictionaryType{
+			KeyType:   g.chooseType(dictionaryKeyTypes),
+			ValueType: g.randomType(depth + 1),
+		}
+	}
+}
+
+// randomEquatableType returns a random type which can be compared for equality
+//
+func (g *Generator) randomEquatableType(depth int) sema.Type {
+	for {
+		ty := g.randomType(depth)
+		if ty.IsEquatable() {
+			return ty
+		}
+	}
+}
@@ -0,0 +1,222 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package refactor
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+// Parameter is a parameter which is added to a function
+//
+type Parameter struct {
+	// Label is the argument label of the parameter.
+	// If it is empty, the identifier is used as the argument label.
+	// If it is `_`, the parameter has no argument label
+	Label string
+	// Identifier is the name of the parameter
+	Identifier string
+	// TypeAnnotation is the type annotation of the parameter, e.g. `Int`
+	TypeAnnotation string
+	// DefaultArgument is the expression which is passed
+	// as the argument for the parameter in the existing invocations of the function
+	DefaultArgument string
+}
+
+// AddParameter returns the text edits which add the given parameter
+// to the function which is declared or referenced at the given position,
+// i.e. the edits add the parameter to the end of the parameter list of the function declaration,
+// and the default argument to the end of the argument list of all invocations of the function.
+//
+func AddParameter(checker *sema.Checker, pos ast.Position, parameter Parameter) ([]ast.TextEdit, error) {
+
+	if !isValidIdentifier(parameter.Identifier) {
+		return nil, &InvalidIdentifierError{
+			Identifier: parameter.Identifier,
+		}
+	}
+
+	if parameter.Label != "" &&
+		parameter.Label != sema.ArgumentLabelNotRequired &&
+		!isValidIdentifier(parameter.Label) {
+
+		return nil, &InvalidIdentifierError{
+			Identifier: parameter.Label,
+		}
+	}
+
+	origin, err := originAt(checker, pos)
+	if err != nil {
+		return nil, err
+	}
+
+	if origin.DeclarationKind != common.DeclarationKindFunction {
+		return nil, &NotAFunctionError{
+			Pos: pos,
+		}
+	}
+
+	declaration := functionDeclarationAt(checker.Program, *origin.StartPos)
+	if declaration == nil {
+		return nil, &NotAFunctionError{
+			Pos: pos,
+		}
+	}
+
+	parameterList := declaration.ParameterList
+
+	for _, existingParameter := range parameterList.Parameters {
+		if existingParameter.Identifier.Identifier == parameter.Identifier {
+			return nil, &UnsupportedRefactoringError{
+				Reason: fmt.Sprintf(
+					"function already has a parameter `%s`",
+					parameter.Identifier,
+				),
+				Range: existingParameter.Range,
+			}
+		}
+	}
+
+	// Parameter
+
+	parameterSeparator := ""
+	if len(parameterList.Parameters) > 0 {
+		parameterSeparator = ", "
+	}
+
+	parameterLabel := ""
+	if parameter.Label != "" {
+		parameterLabel = parameter.Label + " "
+	}
+
+	edits := []ast.TextEdit{
+		{
+			Insertion: fmt.Sprintf(
+				"%s%s%s: %s",
+				parameterSeparator,
+				parameterLabel,
+				parameter.Identifier,
+				parameter.TypeAnnotation,
+			),
+			Range: ast.Range{
+				StartPos: parameterList.EndPos,
+				EndPos:   parameterList.EndPos,
+			},
+		},
+	}
+
+	// Arguments
+
+	argumentLabel := parameter.Label
+	if argumentLabel == "" {
+		argumentLabel = parameter.Identifier
+	}
+
+	argumentLabelPrefix := ""
+	if argumentLabel != sema.ArgumentLabelNotRequired {
+		argumentLabelPrefix = argumentLabel + ": "
+	}
+
+	invocations := invocationsByInvokedIdentifierOffset(checker.Program)
+
+	for _, occurrenceRange := range occurrenceRanges(checker, origin) {
+
+		if isSamePosition(occurrenceRange.StartPos, declaration.Identifier.Pos) {
+			continue
+		}
+
+		invocation, ok := invocations[occurrenceRange.StartPos.Offset]
+		if !ok {
+			return nil, &UnsupportedRefactoringError{
+				Reason: "function is referenced without being invoked",
+				Range:  occurrenceRange,
+			}
+		}
+
+		argumentSeparator := ""
+		if len(invocation.Arguments) > 0 {
+			argumentSeparator = ", "
+		}
+
+		edits = append(edits, ast.TextEdit{
+			Insertion: fmt.Sprintf(
+				"%s%s%s",
+				argumentSeparator,
+				argumentLabelPrefix,
+				parameter.DefaultArgument,
+			),
+			Range: ast.Range{
+				StartPos: invocation.EndPos,
+				EndPos:   invocation.EndPos,
+			},
+		})
+	}
+
+	return edits, nil
+}
+
+// functionDeclarationAt returns the function declaration
+// which has its identifier at the given position, if any
+//
+func functionDeclarationAt(program *ast.Program, pos ast.Position) (result *ast.FunctionDeclaration) {
+	ast.Inspect(program, func(element ast.Element) bool {
+		if result != nil {
+			return false
+		}
+
+		if declaration, ok := element.(*ast.FunctionDeclaration); ok &&
+			isSamePosition(declaration.Identifier.Pos, pos) {
+
+			result = declaration
+			return false
+		}
+
+		return true
+	})
+
+	return
+}
+
+// invocationsByInvokedIdentifierOffset returns all invocations in the given program
+// which invoke an identifier or member, keyed by the offset of the identifier
+//
+func invocationsByInvokedIdentifierOffset(program *ast.Program) map[int]*ast.InvocationExpression {
+	invocations := map[int]*ast.InvocationExpression{}
+
+	ast.Inspect(program, func(element ast.Element) bool {
+		invocation, ok := element.(*ast.InvocationExpression)
+		if !ok {
+			return true
+		}
+
+		switch invokedExpression := invocation.InvokedExpression.(type) {
+		case *ast.IdentifierExpression:
+			invocations[invokedExpression.Identifier.Pos.Offset] = invocation
+		case *ast.MemberExpression:
+			invocations[invokedExpression.Identifier.Pos.Offset] = invocation
+		}
+
+		return true
+	})
+
+	return invocations
+}
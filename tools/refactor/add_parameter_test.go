@@ -0,0 +1,246 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package refactor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddParameter(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("function", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+          fun add(_ a: Int, _ b: Int): Int {
+              return a + b
+          }
+
+          fun test(): Int {
+              return add(1, add(2, 3))
+          }
+        `
+
+		checker := parseAndCheck(t, code)
+
+		edits, err := AddParameter(
+			checker,
+			position(t, code, "add", 1),
+			Parameter{
+				Label:           "_",
+				Identifier:      "c",
+				TypeAnnotation:  "Int",
+				DefaultArgument: "0",
+			},
+		)
+		require.NoError(t, err)
+		require.Len(t, edits, 3)
+
+		fixedCode := ApplyEdits(code, edits)
+
+		assert.Equal(t,
+			`
+          fun add(_ a: Int, _ b: Int, _ c: Int): Int {
+              return a + b
+          }
+
+          fun test(): Int {
+              return add(1, add(2, 3, 0), 0)
+          }
+        `,
+			fixedCode,
+		)
+
+		parseAndCheck(t, fixedCode)
+	})
+
+	t.Run("member function without parameters", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+          struct S {
+              fun double(): Int {
+                  return 2
+              }
+          }
+
+          fun test(): Int {
+              let s = S()
+              return s.double()
+          }
+        `
+
+		checker := parseAndCheck(t, code)
+
+		edits, err := AddParameter(
+			checker,
+			position(t, code, "double", 0),
+			Parameter{
+				Identifier:      "value",
+				TypeAnnotation:  "Int",
+				DefaultArgument: "1",
+			},
+		)
+		require.NoError(t, err)
+
+		fixedCode := ApplyEdits(code, edits)
+
+		assert.Equal(t,
+			`
+          struct S {
+              fun double(value: Int): Int {
+                  return 2
+              }
+          }
+
+          fun test(): Int {
+              let s = S()
+              return s.double(value: 1)
+          }
+        `,
+			fixedCode,
+		)
+
+		parseAndCheck(t, fixedCode)
+	})
+
+	t.Run("label", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+          fun greet(): String {
+              return "hello"
+          }
+
+          let greeting = greet()
+        `
+
+		checker := parseAndCheck(t, code)
+
+		edits, err := AddParameter(
+			checker,
+			position(t, code, "greet", 0),
+			Parameter{
+				Label:           "to",
+				Identifier:      "name",
+				TypeAnnotation:  "String",
+				DefaultArgument: `"world"`,
+			},
+		)
+		require.NoError(t, err)
+
+		fixedCode := ApplyEdits(code, edits)
+
+		assert.Equal(t,
+			`
+          fun greet(to name: String): String {
+              return "hello"
+          }
+
+          let greeting = greet(to: "world")
+        `,
+			fixedCode,
+		)
+
+		parseAndCheck(t, fixedCode)
+	})
+
+	t.Run("function reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+          fun one(): Int {
+              return 1
+          }
+
+          let f = one
+        `
+
+		checker := parseAndCheck(t, code)
+
+		_, err := AddParameter(
+			checker,
+			position(t, code, "one", 0),
+			Parameter{
+				Identifier:      "x",
+				TypeAnnotation:  "Int",
+				DefaultArgument: "0",
+			},
+		)
+		require.Error(t, err)
+		assert.IsType(t, &UnsupportedRefactoringError{}, err)
+	})
+
+	t.Run("duplicate parameter", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+          fun id(x: Int): Int {
+              return x
+          }
+        `
+
+		checker := parseAndCheck(t, code)
+
+		_, err := AddParameter(
+			checker,
+			position(t, code, "id", 0),
+			Parameter{
+				Identifier:      "x",
+				TypeAnnotation:  "Int",
+				DefaultArgument: "0",
+			},
+		)
+		require.Error(t, err)
+		assert.IsType(t, &UnsupportedRefactoringError{}, err)
+	})
+
+	t.Run("not a function", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+          let x = 1
+        `
+
+		checker := parseAndCheck(t, code)
+
+		_, err := AddParameter(
+			checker,
+			position(t, code, "x", 0),
+			Parameter{
+				Identifier:      "y",
+				TypeAnnotation:  "Int",
+				DefaultArgument: "0",
+			},
+		)
+		require.Error(t, err)
+		assert.IsType(t, &NotAFunctionError{}, err)
+	})
+}
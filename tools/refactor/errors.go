@@ -0,0 +1,91 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package refactor
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence/runtime/ast"
+)
+
+// MissingPositionInfoError is returned when a refactoring requires
+// the position information of the checker, but it was not enabled
+//
+type MissingPositionInfoError struct{}
+
+func (*MissingPositionInfoError) Error() string {
+	return "position information is not available: checker must have position information enabled"
+}
+
+// NoDeclarationError is returned when there is no declaration
+// declared or referenced at the given position
+//
+type NoDeclarationError struct {
+	Pos ast.Position
+}
+
+func (e *NoDeclarationError) Error() string {
+	return fmt.Sprintf(
+		"no declaration at %d:%d",
+		e.Pos.Line,
+		e.Pos.Column,
+	)
+}
+
+// InvalidIdentifierError is returned when a new name is not a valid identifier
+//
+type InvalidIdentifierError struct {
+	Identifier string
+}
+
+func (e *InvalidIdentifierError) Error() string {
+	return fmt.Sprintf("invalid identifier: `%s`", e.Identifier)
+}
+
+// NotAFunctionError is returned when the declaration at the given position
+// is not a function declaration
+//
+type NotAFunctionError struct {
+	Pos ast.Position
+}
+
+func (e *NotAFunctionError) Error() string {
+	return fmt.Sprintf(
+		"no function declaration at %d:%d",
+		e.Pos.Line,
+		e.Pos.Column,
+	)
+}
+
+// UnsupportedRefactoringError is returned when a refactoring cannot be applied
+// without changing the behaviour of the program
+//
+type UnsupportedRefactoringError struct {
+	Reason string
+	ast.Range
+}
+
+func (e *UnsupportedRefactoringError) Error() string {
+	return fmt.Sprintf(
+		"cannot refactor code at %d:%d: %s",
+		e.StartPos.Line,
+		e.StartPos.Column,
+		e.Reason,
+	)
+}
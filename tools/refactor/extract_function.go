@@ -0,0 +1,593 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package refactor
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+// ExtractFunction returns the text edits which extract the statements
+// in the given selection into a new function with the given name,
+// and replace the statements with an invocation of the new function.
+//
+// The selection must cover one or more complete statements of the same block.
+// Variables which are declared outside of the selection in the enclosing function
+// become parameters of the new function.
+//
+// The new function is declared after the enclosing function:
+// If the enclosing function is a member of a composite,
+// the new function becomes a private member function of the composite,
+// otherwise it becomes a global function.
+//
+// Selections which cannot be extracted without changing the behaviour of the program
+// are rejected, e.g. selections which return, or which assign to or mutate outer variables.
+//
+func ExtractFunction(
+	checker *sema.Checker,
+	code string,
+	selection ast.Range,
+	name string,
+) (
+	[]ast.TextEdit,
+	error,
+) {
+	if !isValidIdentifier(name) {
+		return nil, &InvalidIdentifierError{
+			Identifier: name,
+		}
+	}
+
+	if checker.Occurrences == nil {
+		return nil, &MissingPositionInfoError{}
+	}
+
+	extraction, err := newFunctionExtraction(checker, selection)
+	if err != nil {
+		return nil, err
+	}
+
+	err = extraction.analyze()
+	if err != nil {
+		return nil, err
+	}
+
+	return extraction.edits(code, name), nil
+}
+
+type extractedParameter struct {
+	name string
+	typ  sema.Type
+}
+
+type functionExtraction struct {
+	checker   *sema.Checker
+	selection ast.Range
+	// statements are the selected statements
+	statements []ast.Statement
+	// function is the outermost function which contains the selection.
+	// Variables declared in it are not accessible from the new function
+	function ast.Element
+	// isInitializer is true if the selection is in an initializer
+	isInitializer bool
+	// composite is the innermost composite which contains the selection, if any
+	composite *ast.CompositeDeclaration
+	// declaration is the declaration after which the new function is declared
+	declaration ast.Declaration
+	parameters  []extractedParameter
+	usesSelf    bool
+}
+
+func newFunctionExtraction(checker *sema.Checker, selection ast.Range) (*functionExtraction, error) {
+
+	extraction := &functionExtraction{
+		checker:   checker,
+		selection: selection,
+	}
+
+	var block *ast.Block
+	var blockStack []ast.Element
+
+	walkWithStack(checker.Program, func(element ast.Element, stack []ast.Element) bool {
+		if !containsRange(element, selection) {
+			return false
+		}
+
+		if currentBlock, ok := element.(*ast.Block); ok {
+			block = currentBlock
+			blockStack = append(blockStack[:0], stack...)
+		}
+
+		return true
+	})
+
+	if block == nil {
+		return nil, &UnsupportedRefactoringError{
+			Reason: "selection is not in a block",
+			Range:  selection,
+		}
+	}
+
+	for _, statement := range block.Statements {
+		statementRange := ast.NewUnmeteredRangeFromPositioned(statement)
+
+		statementStart := statementRange.StartPos.Offset
+		statementEnd := statementRange.EndPos.Offset
+
+		if statementEnd < selection.StartPos.Offset ||
+			statementStart > selection.EndPos.Offset {
+
+			continue
+		}
+
+		if statementStart < selection.StartPos.Offset ||
+			statementEnd > selection.EndPos.Offset {
+
+			return nil, &UnsupportedRefactoringError{
+				Reason: "selection does not cover complete statements",
+				Range:  selection,
+			}
+		}
+
+		extraction.statements = append(extraction.statements, statement)
+	}
+
+	if len(extraction.statements) == 0 {
+		return nil, &UnsupportedRefactoringError{
+			Reason: "selection does not contain statements",
+			Range:  selection,
+		}
+	}
+
+	// Determine the enclosing declarations
+
+	for i, element := range blockStack {
+		switch element := element.(type) {
+		case *ast.CompositeDeclaration:
+			extraction.composite = element
+			extraction.function = nil
+			extraction.declaration = nil
+
+		case *ast.FunctionDeclaration:
+			if extraction.function == nil {
+				extraction.function = element
+				extraction.declaration = element
+			}
+
+		case *ast.SpecialFunctionDeclaration:
+			if extraction.function == nil {
+				extraction.function = element
+				extraction.declaration = element
+				extraction.isInitializer = element.Kind == common.DeclarationKindInitializer
+			}
+
+		case *ast.FunctionExpression:
+			if extraction.function == nil {
+				extraction.function = element
+			}
+
+		case *ast.TransactionDeclaration:
+			extraction.function = element
+			extraction.declaration = element
+		}
+
+		// Top-level declarations are children of the program
+
+		if extraction.declaration == nil && i == 1 {
+			if declaration, ok := element.(ast.Declaration); ok {
+				extraction.declaration = declaration
+			}
+		}
+	}
+
+	if extraction.function == nil || extraction.declaration == nil {
+		return nil, &UnsupportedRefactoringError{
+			Reason: "selection is not in a function",
+			Range:  selection,
+		}
+	}
+
+	return extraction, nil
+}
+
+func (e *functionExtraction) isInSelection(pos ast.Position) bool {
+	return pos.Offset >= e.selection.StartPos.Offset &&
+		pos.Offset <= e.selection.EndPos.Offset
+}
+
+func (e *functionExtraction) isInFunction(pos ast.Position) bool {
+	return pos.Offset >= e.function.StartPosition().Offset &&
+		pos.Offset <= e.function.EndPosition(nil).Offset
+}
+
+// outerVariable returns the origin of the variable referenced by the given identifier,
+// if the variable is declared in the enclosing function, but outside of the selection
+//
+func (e *functionExtraction) outerVariable(identifier ast.Identifier) *sema.Origin {
+	occurrence := e.checker.Occurrences.Find(sema.ASTToSemaPosition(identifier.Pos))
+	if occurrence == nil {
+		return nil
+	}
+
+	origin := occurrence.Origin
+	if origin == nil || origin.StartPos == nil {
+		return nil
+	}
+
+	if e.isInSelection(*origin.StartPos) || !e.isInFunction(*origin.StartPos) {
+		return nil
+	}
+
+	return origin
+}
+
+func (e *functionExtraction) unsupported(reason string, positioned ast.HasPosition) error {
+	return &UnsupportedRefactoringError{
+		Reason: reason,
+		Range:  ast.NewUnmeteredRangeFromPositioned(positioned),
+	}
+}
+
+func (e *functionExtraction) analyze() (err error) {
+
+	seenParameters := map[string]struct{}{}
+
+	for _, statement := range e.statements {
+
+		walkWithStack(statement, func(element ast.Element, stack []ast.Element) bool {
+			if err != nil {
+				return false
+			}
+
+			switch element := element.(type) {
+			case *ast.ReturnStatement:
+				err = e.unsupported("selection contains a return statement", element)
+
+			case *ast.BreakStatement:
+				if !isInLoop(stack) {
+					err = e.unsupported("selection contains a break statement outside of a loop", element)
+				}
+
+			case *ast.ContinueStatement:
+				if !isInLoop(stack) {
+					err = e.unsupported("selection contains a continue statement outside of a loop", element)
+				}
+
+			case *ast.AssignmentStatement:
+				err = e.checkNotOuterTarget(element.Target)
+
+			case *ast.SwapStatement:
+				err = e.checkNotOuterTarget(element.Left)
+				if err == nil {
+					err = e.checkNotOuterTarget(element.Right)
+				}
+
+			case *ast.InvocationExpression:
+				// Member functions might mutate the receiver,
+				// and the new function only receives a copy of outer values
+				if memberExpression, ok := element.InvokedExpression.(*ast.MemberExpression); ok {
+					origin := e.outerTargetVariable(memberExpression.Expression)
+					if origin != nil && isCopiedContainerType(origin.Type) {
+						err = e.unsupported("selection calls a function on a variable declared outside of it", element)
+					}
+				}
+
+			case *ast.IdentifierExpression:
+				identifier := element.Identifier
+
+				if identifier.Identifier == "self" {
+					e.usesSelf = true
+					return true
+				}
+
+				origin := e.outerVariable(identifier)
+				if origin == nil {
+					return true
+				}
+
+				if origin.Type != nil && origin.Type.IsResourceType() {
+					err = e.unsupported("selection uses a resource declared outside of it", element)
+					return false
+				}
+
+				if _, ok := seenParameters[identifier.Identifier]; !ok {
+					seenParameters[identifier.Identifier] = struct{}{}
+					e.parameters = append(e.parameters, extractedParameter{
+						name: identifier.Identifier,
+						typ:  origin.Type,
+					})
+				}
+			}
+
+			return err == nil
+		})
+
+		if err != nil {
+			return err
+		}
+
+		err = e.checkDeclarationNotUsedAfterSelection(statement)
+		if err != nil {
+			return err
+		}
+	}
+
+	if e.usesSelf {
+		if e.composite == nil {
+			return &UnsupportedRefactoringError{
+				Reason: "selection uses `self` outside of a composite",
+				Range:  e.selection,
+			}
+		}
+		if e.isInitializer {
+			return &UnsupportedRefactoringError{
+				Reason: "selection uses `self` in an initializer",
+				Range:  e.selection,
+			}
+		}
+	}
+
+	return nil
+}
+
+// outerTargetVariable returns the origin of the variable which is the base
+// of the given target expression, e.g. `x` in `x.y[z]`,
+// if the variable is declared in the enclosing function, but outside of the selection
+//
+func (e *functionExtraction) outerTargetVariable(target ast.Expression) *sema.Origin {
+	for {
+		switch expression := target.(type) {
+		case *ast.MemberExpression:
+			target = expression.Expression
+		case *ast.IndexExpression:
+			target = expression.TargetExpression
+		case *ast.IdentifierExpression:
+			return e.outerVariable(expression.Identifier)
+		default:
+			return nil
+		}
+	}
+}
+
+func (e *functionExtraction) checkNotOuterTarget(target ast.Expression) error {
+	if e.outerTargetVariable(target) != nil {
+		return e.unsupported("selection assigns to a variable declared outside of it", target)
+	}
+	return nil
+}
+
+// checkDeclarationNotUsedAfterSelection checks that the declaration of the given statement, if any,
+// is not referenced after the selection, as it is not accessible outside of the new function
+//
+func (e *functionExtraction) checkDeclarationNotUsedAfterSelection(statement ast.Statement) error {
+	declaration, ok := statement.(ast.Declaration)
+	if !ok {
+		return nil
+	}
+
+	identifier := declaration.DeclarationIdentifier()
+	if identifier == nil {
+		return nil
+	}
+
+	occurrence := e.checker.Occurrences.Find(sema.ASTToSemaPosition(identifier.Pos))
+	if occurrence == nil || occurrence.Origin == nil || occurrence.Origin.StartPos == nil {
+		return nil
+	}
+
+	for _, occurrenceRange := range occurrenceRanges(e.checker, occurrence.Origin) {
+		if occurrenceRange.StartPos.Offset > e.selection.EndPos.Offset {
+			return e.unsupported(
+				fmt.Sprintf("`%s` is declared in the selection, but used after it", identifier.Identifier),
+				occurrenceRange,
+			)
+		}
+	}
+
+	return nil
+}
+
+func (e *functionExtraction) edits(code string, name string) []ast.TextEdit {
+
+	firstStatement := e.statements[0]
+	lastStatement := e.statements[len(e.statements)-1]
+
+	statementsStartPos := firstStatement.StartPosition()
+	statementsEndPos := lastStatement.EndPosition(nil)
+
+	// Invocation
+
+	arguments := make([]string, 0, len(e.parameters))
+	for _, parameter := range e.parameters {
+		arguments = append(arguments, parameter.name)
+	}
+
+	invokedExpression := name
+	if e.composite != nil {
+		invokedExpression = "self." + name
+	}
+
+	invocation := fmt.Sprintf(
+		"%s(%s)",
+		invokedExpression,
+		strings.Join(arguments, ", "),
+	)
+
+	// Function declaration
+
+	declarationStartPos := e.declaration.StartPosition()
+	declarationEndPos := e.declaration.EndPosition(nil)
+
+	indentation := strings.Repeat(" ", declarationStartPos.Column)
+
+	access := "priv "
+	if e.composite == nil {
+		access = ""
+		if functionDeclaration, ok := e.declaration.(*ast.FunctionDeclaration); ok {
+			keyword := functionDeclaration.Access.Keyword()
+			if keyword != "" {
+				access = keyword + " "
+			}
+		}
+	}
+
+	parameters := make([]string, 0, len(e.parameters))
+	for _, parameter := range e.parameters {
+		parameters = append(parameters, fmt.Sprintf(
+			"_ %s: %s",
+			parameter.name,
+			parameter.typ.QualifiedString(),
+		))
+	}
+
+	body := reindent(
+		code[statementsStartPos.Offset:statementsEndPos.Offset+1],
+		statementsStartPos.Column,
+		indentation+"    ",
+	)
+
+	var builder strings.Builder
+	builder.WriteString("\n\n")
+	builder.WriteString(indentation)
+	builder.WriteString(access)
+	builder.WriteString("fun ")
+	builder.WriteString(name)
+	builder.WriteString("(")
+	builder.WriteString(strings.Join(parameters, ", "))
+	builder.WriteString(") {\n")
+	builder.WriteString(body)
+	builder.WriteString("\n")
+	builder.WriteString(indentation)
+	builder.WriteString("}")
+
+	insertionPos := declarationEndPos.Shifted(nil, 1)
+
+	return []ast.TextEdit{
+		{
+			Replacement: invocation,
+			Range: ast.Range{
+				StartPos: statementsStartPos,
+				EndPos:   statementsEndPos,
+			},
+		},
+		{
+			Insertion: builder.String(),
+			Range: ast.Range{
+				StartPos: insertionPos,
+				EndPos:   insertionPos,
+			},
+		},
+	}
+}
+
+// reindent returns the given code, which starts at the given column,
+// with all lines indented with the given indentation
+//
+func reindent(code string, column int, indentation string) string {
+	lines := strings.Split(code, "\n")
+	for i, line := range lines {
+		if i > 0 {
+			// Remove the original indentation, at most up to the start column
+			removed := 0
+			for removed < column &&
+				removed < len(line) &&
+				(line[removed] == ' ' || line[removed] == '\t') {
+
+				removed++
+			}
+			line = line[removed:]
+		}
+
+		if line != "" {
+			line = indentation + line
+		}
+		lines[i] = line
+	}
+	return strings.Join(lines, "\n")
+}
+
+// isCopiedContainerType returns true if values of the given type are copied
+// when passed to a function, and they might be mutated by member functions
+//
+func isCopiedContainerType(ty sema.Type) bool {
+	switch ty := ty.(type) {
+	case *sema.VariableSizedType, *sema.ConstantSizedType, *sema.DictionaryType:
+		return true
+	case *sema.CompositeType:
+		return !ty.IsResourceType()
+	case *sema.OptionalType:
+		return isCopiedContainerType(ty.Type)
+	default:
+		return false
+	}
+}
+
+func isInLoop(stack []ast.Element) bool {
+	for i := len(stack) - 1; i >= 0; i-- {
+		switch stack[i].(type) {
+		case *ast.WhileStatement, *ast.ForStatement:
+			return true
+		case *ast.FunctionExpression, *ast.FunctionDeclaration:
+			return false
+		}
+	}
+	return false
+}
+
+func containsRange(element ast.Element, r ast.Range) bool {
+	if _, ok := element.(*ast.Program); ok {
+		return true
+	}
+	return element.StartPosition().Offset <= r.StartPos.Offset &&
+		element.EndPosition(nil).Offset >= r.EndPos.Offset
+}
+
+// walkWithStack walks the given element and its children in depth-first order.
+// The visit function is called with the element and its ancestors.
+// If it returns false, the children of the element are not walked
+//
+func walkWithStack(element ast.Element, visit func(element ast.Element, stack []ast.Element) bool) {
+	walker := &stackWalker{
+		visit: visit,
+	}
+	ast.Walk(walker, element)
+}
+
+type stackWalker struct {
+	stack []ast.Element
+	visit func(element ast.Element, stack []ast.Element) bool
+}
+
+func (w *stackWalker) Walk(element ast.Element) ast.Walker {
+	if element == nil {
+		w.stack = w.stack[:len(w.stack)-1]
+		return nil
+	}
+
+	if !w.visit(element, w.stack) {
+		return nil
+	}
+
+	w.stack = append(w.stack, element)
+	return w
+}
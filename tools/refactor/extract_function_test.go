@@ -0,0 +1,310 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package refactor
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/ast"
+)
+
+// selection returns the range of the given text in the code
+//
+func selection(t *testing.T, code string, text string) ast.Range {
+	startPos := position(t, code, text, 0)
+
+	// The end position is the position of the last character of the text
+
+	endOffset := startPos.Offset + len(text) - 1
+
+	return ast.Range{
+		StartPos: startPos,
+		EndPos: ast.Position{
+			Offset: endOffset,
+			Line:   1 + strings.Count(code[:endOffset], "\n"),
+			Column: endOffset - (strings.LastIndex(code[:endOffset], "\n") + 1),
+		},
+	}
+}
+
+func TestExtractFunction(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("global function", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+          pub fun log(_ value: AnyStruct) {}
+
+          pub fun test(a: Int): Int {
+              let b = a * 2
+              log(a + b)
+              log(b)
+              return b
+          }
+        `
+
+		checker := parseAndCheck(t, code)
+
+		edits, err := ExtractFunction(
+			checker,
+			code,
+			selection(t, code, "log(a + b)\n              log(b)"),
+			"logValues",
+		)
+		require.NoError(t, err)
+
+		fixedCode := ApplyEdits(code, edits)
+
+		assert.Equal(t,
+			`
+          pub fun log(_ value: AnyStruct) {}
+
+          pub fun test(a: Int): Int {
+              let b = a * 2
+              logValues(a, b)
+              return b
+          }
+
+          pub fun logValues(_ a: Int, _ b: Int) {
+              log(a + b)
+              log(b)
+          }
+        `,
+			fixedCode,
+		)
+
+		parseAndCheck(t, fixedCode)
+	})
+
+	t.Run("member function", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+          pub struct S {
+              pub var count: Int
+
+              init() {
+                  self.count = 0
+              }
+
+              pub fun increment(by amount: Int) {
+                  if amount > 0 {
+                      self.count = self.count + amount
+                  }
+              }
+          }
+        `
+
+		checker := parseAndCheck(t, code)
+
+		edits, err := ExtractFunction(
+			checker,
+			code,
+			selection(t, code, "self.count = self.count + amount"),
+			"add",
+		)
+		require.NoError(t, err)
+
+		fixedCode := ApplyEdits(code, edits)
+
+		assert.Equal(t,
+			`
+          pub struct S {
+              pub var count: Int
+
+              init() {
+                  self.count = 0
+              }
+
+              pub fun increment(by amount: Int) {
+                  if amount > 0 {
+                      self.add(amount)
+                  }
+              }
+
+              priv fun add(_ amount: Int) {
+                  self.count = self.count + amount
+              }
+          }
+        `,
+			fixedCode,
+		)
+
+		parseAndCheck(t, fixedCode)
+	})
+
+	t.Run("nested statements", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+          pub fun log(_ value: AnyStruct) {}
+
+          pub fun test(values: [Int]) {
+              var i = 0
+              while i < values.length {
+                  let value = values[i]
+                  if value > 0 {
+                      log(value)
+                      i = i + 1
+                      continue
+                  }
+                  break
+              }
+          }
+        `
+
+		checker := parseAndCheck(t, code)
+
+		// Break and continue inside of a loop in the selection are allowed
+
+		edits, err := ExtractFunction(
+			checker,
+			code,
+			selection(t, code, "var i = 0\n              while i < values.length {\n                  let value = values[i]\n                  if value > 0 {\n                      log(value)\n                      i = i + 1\n                      continue\n                  }\n                  break\n              }"),
+			"logPositive",
+		)
+		require.NoError(t, err)
+
+		fixedCode := ApplyEdits(code, edits)
+
+		assert.Equal(t,
+			`
+          pub fun log(_ value: AnyStruct) {}
+
+          pub fun test(values: [Int]) {
+              logPositive(values)
+          }
+
+          pub fun logPositive(_ values: [Int]) {
+              var i = 0
+              while i < values.length {
+                  let value = values[i]
+                  if value > 0 {
+                      log(value)
+                      i = i + 1
+                      continue
+                  }
+                  break
+              }
+          }
+        `,
+			fixedCode,
+		)
+
+		parseAndCheck(t, fixedCode)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+          pub fun log(_ value: AnyStruct) {}
+
+          pub resource R {}
+
+          pub struct S {
+              pub var count: Int
+
+              init() {
+                  self.count = 0
+              }
+          }
+
+          pub fun test(values: [Int]): Int {
+              var count = 0
+              let s = S()
+              let r <- create R()
+              while count < 10 {
+                  count = count + 1
+                  values.append(count)
+                  if count > 5 {
+                      break
+                  }
+              }
+              let total = count * 2
+              destroy r
+              log(total)
+              log(s)
+              return total
+          }
+        `
+
+		checker := parseAndCheck(t, code)
+
+		for _, text := range []string{
+			// assignment to outer variable
+			"count = count + 1",
+			// mutation of outer variable
+			"values.append(count)",
+			// break outside of a loop
+			"break",
+			// return
+			"return total",
+			// declaration used after the selection
+			"let total = count * 2",
+			// outer resource
+			"destroy r",
+			// partial statement
+			"count * 2",
+		} {
+			_, err := ExtractFunction(checker, code, selection(t, code, text), "extracted")
+			require.Error(t, err, text)
+			require.IsType(t, &UnsupportedRefactoringError{}, err, text)
+		}
+
+		_, err := ExtractFunction(checker, code, selection(t, code, "log(s)"), "extracted")
+		require.NoError(t, err)
+	})
+
+	t.Run("self in initializer", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+          pub struct S {
+              pub var count: Int
+
+              init() {
+                  self.count = 0
+              }
+          }
+        `
+
+		checker := parseAndCheck(t, code)
+
+		_, err := ExtractFunction(
+			checker,
+			code,
+			selection(t, code, "self.count = 0"),
+			"reset",
+		)
+		require.Error(t, err)
+		require.IsType(t, &UnsupportedRefactoringError{}, err)
+	})
+}
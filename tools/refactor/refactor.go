@@ -0,0 +1,152 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package refactor provides refactoring operations for Cadence programs,
+// e.g. renaming a declaration, extracting statements into a function,
+// and adding a parameter to a function.
+//
+// The operations return textual edits of the source code,
+// instead of re-printing the program,
+// so the formatting and comments of the program are preserved.
+//
+// Operations which need to resolve references require a checker
+// which checked the program with position information enabled,
+// see sema.WithPositionInfoEnabled.
+//
+package refactor
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/parser"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+// ApplyEdits applies the given text edits to the given code,
+// and returns the resulting code.
+//
+// The edits may be given in any order, but they must not overlap.
+//
+func ApplyEdits(code string, edits []ast.TextEdit) string {
+	sortedEdits := make([]ast.TextEdit, len(edits))
+	copy(sortedEdits, edits)
+
+	// Apply the edits from the end of the code to the start,
+	// so the offsets of the remaining edits stay valid
+
+	sort.SliceStable(sortedEdits, func(i, j int) bool {
+		return sortedEdits[i].StartPos.Offset > sortedEdits[j].StartPos.Offset
+	})
+
+	for _, edit := range sortedEdits {
+		code = edit.ApplyTo(code)
+	}
+
+	return code
+}
+
+// originAt returns the origin of the declaration which is declared or referenced
+// at the given position, if any
+//
+func originAt(checker *sema.Checker, pos ast.Position) (*sema.Origin, error) {
+	if checker.Occurrences == nil {
+		return nil, &MissingPositionInfoError{}
+	}
+
+	position := sema.ASTToSemaPosition(pos)
+
+	for _, occurrence := range checker.Occurrences.FindAll(position) {
+		origin := occurrence.Origin
+		if origin == nil || origin.StartPos == nil || origin.EndPos == nil {
+			continue
+		}
+		return origin, nil
+	}
+
+	return nil, &NoDeclarationError{
+		Pos: pos,
+	}
+}
+
+// occurrenceRanges returns the ranges of the declaration and all references
+// of the declaration of the given origin, ordered by offset.
+//
+// NOTE: The checker might record multiple origins for the same declaration,
+// e.g. for global functions, so the occurrences of all origins
+// with the same declaration position are returned
+//
+func occurrenceRanges(checker *sema.Checker, origin *sema.Origin) []ast.Range {
+	var ranges []ast.Range
+
+	seenOffsets := map[int]struct{}{}
+
+	addRanges := func(origin *sema.Origin) {
+		for _, occurrenceRange := range origin.Occurrences {
+			offset := occurrenceRange.StartPos.Offset
+			if _, ok := seenOffsets[offset]; ok {
+				continue
+			}
+			seenOffsets[offset] = struct{}{}
+			ranges = append(ranges, occurrenceRange)
+		}
+	}
+
+	addRanges(origin)
+
+	for _, occurrence := range checker.Occurrences.All() {
+		otherOrigin := occurrence.Origin
+		if otherOrigin == nil ||
+			otherOrigin == origin ||
+			otherOrigin.StartPos == nil ||
+			!isSamePosition(*otherOrigin.StartPos, *origin.StartPos) {
+
+			continue
+		}
+		addRanges(otherOrigin)
+	}
+
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].StartPos.Offset < ranges[j].StartPos.Offset
+	})
+
+	return ranges
+}
+
+// isValidIdentifier returns true if the given name can be used as an identifier,
+// i.e. it can be both declared and referenced
+//
+func isValidIdentifier(name string) bool {
+	expression, errs := parser.ParseExpression(name, nil)
+	if len(errs) > 0 {
+		return false
+	}
+
+	identifierExpression, ok := expression.(*ast.IdentifierExpression)
+	if !ok || identifierExpression.Identifier.Identifier != name {
+		return false
+	}
+
+	_, err := parser.ParseProgram(fmt.Sprintf("let %s = 0", name), nil)
+	return err == nil
+}
+
+func isSamePosition(a, b ast.Position) bool {
+	return a.Line == b.Line && a.Column == b.Column
+}
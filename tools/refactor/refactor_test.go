@@ -0,0 +1,108 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package refactor
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/parser"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func parseAndCheck(t *testing.T, code string) *sema.Checker {
+	program, err := parser.ParseProgram(code, nil)
+	require.NoError(t, err)
+
+	checker, err := sema.NewChecker(
+		program,
+		common.StringLocation("test"),
+		nil,
+		false,
+		sema.WithPositionInfoEnabled(true),
+		sema.WithAccessCheckMode(sema.AccessCheckModeNotSpecifiedUnrestricted),
+	)
+	require.NoError(t, err)
+
+	err = checker.Check()
+	require.NoError(t, err)
+
+	return checker
+}
+
+// position returns the position of the given occurrence of the given text in the code
+//
+func position(t *testing.T, code string, text string, occurrence int) ast.Position {
+	offset := -1
+	for i := 0; i <= occurrence; i++ {
+		next := strings.Index(code[offset+1:], text)
+		require.GreaterOrEqual(t, next, 0, "missing occurrence %d of %s", i, text)
+		offset += next + 1
+	}
+
+	line := 1 + strings.Count(code[:offset], "\n")
+	column := offset - (strings.LastIndex(code[:offset], "\n") + 1)
+
+	return ast.Position{
+		Offset: offset,
+		Line:   line,
+		Column: column,
+	}
+}
+
+func TestApplyEdits(t *testing.T) {
+
+	t.Parallel()
+
+	const code = "let x = 1\nlet y = x"
+
+	edits := []ast.TextEdit{
+		{
+			Replacement: "z",
+			Range: ast.Range{
+				StartPos: ast.Position{Offset: 4, Line: 1, Column: 4},
+				EndPos:   ast.Position{Offset: 4, Line: 1, Column: 4},
+			},
+		},
+		{
+			Replacement: "z",
+			Range: ast.Range{
+				StartPos: ast.Position{Offset: 18, Line: 2, Column: 8},
+				EndPos:   ast.Position{Offset: 18, Line: 2, Column: 8},
+			},
+		},
+		{
+			Insertion: "pub ",
+			Range: ast.Range{
+				StartPos: ast.Position{Offset: 0, Line: 1, Column: 0},
+				EndPos:   ast.Position{Offset: 0, Line: 1, Column: 0},
+			},
+		},
+	}
+
+	assert.Equal(t,
+		"pub let z = 1\nlet y = z",
+		ApplyEdits(code, edits),
+	)
+}
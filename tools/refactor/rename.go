@@ -0,0 +1,109 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package refactor
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+// Rename returns the text edits which rename the declaration
+// which is declared or referenced at the given position,
+// i.e. the edits replace the identifier of the declaration and of all references.
+//
+// When a parameter without an argument label is renamed,
+// the old name is kept as the argument label,
+// so the invocations of the function stay valid.
+//
+func Rename(checker *sema.Checker, pos ast.Position, newName string) ([]ast.TextEdit, error) {
+
+	if !isValidIdentifier(newName) {
+		return nil, &InvalidIdentifierError{
+			Identifier: newName,
+		}
+	}
+
+	origin, err := originAt(checker, pos)
+	if err != nil {
+		return nil, err
+	}
+
+	var unlabeledParameter *ast.Parameter
+	if origin.DeclarationKind == common.DeclarationKindParameter {
+		parameter := parameterAt(checker.Program, *origin.StartPos)
+		if parameter != nil && parameter.Label == "" {
+			unlabeledParameter = parameter
+		}
+	}
+
+	ranges := occurrenceRanges(checker, origin)
+
+	edits := make([]ast.TextEdit, 0, len(ranges))
+
+	for _, occurrenceRange := range ranges {
+		replacement := newName
+		if unlabeledParameter != nil &&
+			isSamePosition(occurrenceRange.StartPos, unlabeledParameter.Identifier.Pos) {
+
+			replacement = unlabeledParameter.Identifier.Identifier + " " + newName
+		}
+
+		edits = append(edits, ast.TextEdit{
+			Replacement: replacement,
+			Range:       occurrenceRange,
+		})
+	}
+
+	return edits, nil
+}
+
+// parameterAt returns the parameter declared at the given position, if any
+//
+func parameterAt(program *ast.Program, pos ast.Position) (result *ast.Parameter) {
+	ast.Inspect(program, func(element ast.Element) bool {
+		if result != nil {
+			return false
+		}
+
+		var parameterList *ast.ParameterList
+
+		switch element := element.(type) {
+		case *ast.FunctionDeclaration:
+			parameterList = element.ParameterList
+		case *ast.SpecialFunctionDeclaration:
+			parameterList = element.FunctionDeclaration.ParameterList
+		case *ast.FunctionExpression:
+			parameterList = element.ParameterList
+		}
+
+		if parameterList != nil {
+			for _, parameter := range parameterList.Parameters {
+				if isSamePosition(parameter.Identifier.Pos, pos) {
+					result = parameter
+					return false
+				}
+			}
+		}
+
+		return true
+	})
+
+	return
+}
@@ -0,0 +1,232 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package refactor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRename(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("local variable", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+          fun test(): Int {
+              let x = 1
+              let y = x + 2
+              return x * y
+          }
+        `
+
+		checker := parseAndCheck(t, code)
+
+		edits, err := Rename(checker, position(t, code, "x", 1), "count")
+		require.NoError(t, err)
+		require.Len(t, edits, 3)
+
+		fixedCode := ApplyEdits(code, edits)
+
+		assert.Equal(t,
+			`
+          fun test(): Int {
+              let count = 1
+              let y = count + 2
+              return count * y
+          }
+        `,
+			fixedCode,
+		)
+
+		parseAndCheck(t, fixedCode)
+	})
+
+	t.Run("function", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+          fun add(_ a: Int, _ b: Int): Int {
+              return a + b
+          }
+
+          fun test(): Int {
+              return add(1, add(2, 3))
+          }
+        `
+
+		checker := parseAndCheck(t, code)
+
+		edits, err := Rename(checker, position(t, code, "add", 0), "sum")
+		require.NoError(t, err)
+		require.Len(t, edits, 3)
+
+		fixedCode := ApplyEdits(code, edits)
+
+		assert.Equal(t,
+			`
+          fun sum(_ a: Int, _ b: Int): Int {
+              return a + b
+          }
+
+          fun test(): Int {
+              return sum(1, sum(2, 3))
+          }
+        `,
+			fixedCode,
+		)
+
+		parseAndCheck(t, fixedCode)
+	})
+
+	t.Run("field and function members", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+          struct S {
+              let count: Int
+
+              init() {
+                  self.count = 0
+              }
+
+              fun double(): Int {
+                  return self.count * 2
+              }
+          }
+
+          fun test(): Int {
+              let s = S()
+              return s.count + s.double()
+          }
+        `
+
+		checker := parseAndCheck(t, code)
+
+		edits, err := Rename(checker, position(t, code, "count", 3), "total")
+		require.NoError(t, err)
+
+		fixedCode := ApplyEdits(code, edits)
+
+		assert.Equal(t,
+			`
+          struct S {
+              let total: Int
+
+              init() {
+                  self.total = 0
+              }
+
+              fun double(): Int {
+                  return self.total * 2
+              }
+          }
+
+          fun test(): Int {
+              let s = S()
+              return s.total + s.double()
+          }
+        `,
+			fixedCode,
+		)
+
+		parseAndCheck(t, fixedCode)
+
+		edits, err = Rename(checker, position(t, code, "double", 1), "twice")
+		require.NoError(t, err)
+
+		parseAndCheck(t, ApplyEdits(code, edits))
+	})
+
+	t.Run("unlabeled parameter", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+          fun inc(value: Int): Int {
+              return value + 1
+          }
+
+          fun test(): Int {
+              return inc(value: 1)
+          }
+        `
+
+		checker := parseAndCheck(t, code)
+
+		edits, err := Rename(checker, position(t, code, "value", 1), "x")
+		require.NoError(t, err)
+
+		fixedCode := ApplyEdits(code, edits)
+
+		assert.Equal(t,
+			`
+          fun inc(value x: Int): Int {
+              return x + 1
+          }
+
+          fun test(): Int {
+              return inc(value: 1)
+          }
+        `,
+			fixedCode,
+		)
+
+		parseAndCheck(t, fixedCode)
+	})
+
+	t.Run("invalid identifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+          let x = 1
+        `
+
+		checker := parseAndCheck(t, code)
+
+		for _, name := range []string{"", "create", "true", "nil", "1x", "a b"} {
+			_, err := Rename(checker, position(t, code, "x", 0), name)
+			require.Error(t, err)
+			assert.IsType(t, &InvalidIdentifierError{}, err)
+		}
+	})
+
+	t.Run("no declaration", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+          let x = 1
+        `
+
+		checker := parseAndCheck(t, code)
+
+		_, err := Rename(checker, position(t, code, "1", 0), "y")
+		require.Error(t, err)
+		assert.IsType(t, &NoDeclarationError{}, err)
+	})
+}
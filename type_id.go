@@ -0,0 +1,88 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cadence
+
+import (
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+// TypeDescriptor is the structured representation of a type ID,
+// as returned by DecodeTypeID.
+type TypeDescriptor struct {
+	// Location is the location of the type,
+	// or nil if the type is a built-in type, e.g. `Int` or `AnyStruct`
+	Location common.Location
+	// QualifiedIdentifier is the qualified identifier of the type, e.g. `Foo.Bar`
+	QualifiedIdentifier string
+	// Restrictions are the restrictions of a restricted type,
+	// or nil if the type is not a restricted type
+	Restrictions []TypeDescriptor
+}
+
+// DecodeTypeID decodes the given type ID of a nominal type, e.g. `A.0000000000000001.Foo.Bar`,
+// or a restricted type, e.g. `A.0000000000000001.Foo.Bar{A.0000000000000002.I}`,
+// into its location, qualified identifier, and restrictions.
+//
+// See sema.DecodeTypeID for details.
+func DecodeTypeID(typeID string) (TypeDescriptor, error) {
+	descriptor, err := sema.DecodeTypeID(sema.TypeID(typeID))
+	if err != nil {
+		return TypeDescriptor{}, err
+	}
+
+	return newTypeDescriptor(descriptor), nil
+}
+
+func newTypeDescriptor(descriptor sema.TypeDescriptor) TypeDescriptor {
+	var restrictions []TypeDescriptor
+	if descriptor.Restrictions != nil {
+		restrictions = make([]TypeDescriptor, 0, len(descriptor.Restrictions))
+		for _, restriction := range descriptor.Restrictions {
+			restrictions = append(restrictions, newTypeDescriptor(restriction))
+		}
+	}
+
+	return TypeDescriptor{
+		Location:            descriptor.Location,
+		QualifiedIdentifier: descriptor.QualifiedIdentifier,
+		Restrictions:        restrictions,
+	}
+}
+
+// TypeID returns the canonical type ID for the type described by the descriptor.
+func (d TypeDescriptor) TypeID() string {
+	return string(d.semaTypeDescriptor().TypeID())
+}
+
+func (d TypeDescriptor) semaTypeDescriptor() sema.TypeDescriptor {
+	var restrictions []sema.TypeDescriptor
+	if d.Restrictions != nil {
+		restrictions = make([]sema.TypeDescriptor, 0, len(d.Restrictions))
+		for _, restriction := range d.Restrictions {
+			restrictions = append(restrictions, restriction.semaTypeDescriptor())
+		}
+	}
+
+	return sema.TypeDescriptor{
+		Location:            d.Location,
+		QualifiedIdentifier: d.QualifiedIdentifier,
+		Restrictions:        restrictions,
+	}
+}
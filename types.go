@@ -20,6 +20,7 @@ package cadence
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/onflow/cadence/runtime/common"
 )
@@ -766,6 +767,44 @@ func (UFix64Type) ID() string {
 	return "UFix64"
 }
 
+// Fix128Type
+
+type Fix128Type struct{}
+
+func NewFix128Type() Fix128Type {
+	return Fix128Type{}
+}
+
+func NewMeteredFix128Type(gauge common.MemoryGauge) Fix128Type {
+	common.UseMemory(gauge, common.CadenceSimpleTypeMemoryUsage)
+	return NewFix128Type()
+}
+
+func (Fix128Type) isType() {}
+
+func (Fix128Type) ID() string {
+	return "Fix128"
+}
+
+// UFix128Type
+
+type UFix128Type struct{}
+
+func NewUFix128Type() UFix128Type {
+	return UFix128Type{}
+}
+
+func NewMeteredUFix128Type(gauge common.MemoryGauge) UFix128Type {
+	common.UseMemory(gauge, common.CadenceSimpleTypeMemoryUsage)
+	return NewUFix128Type()
+}
+
+func (UFix128Type) isType() {}
+
+func (UFix128Type) ID() string {
+	return "UFix128"
+}
+
 type ArrayType interface {
 	Type
 	Element() Type
@@ -873,11 +912,46 @@ func (t DictionaryType) ID() string {
 	)
 }
 
+// TupleType
+
+type TupleType struct {
+	ElementTypes []Type
+}
+
+func NewTupleType(
+	elementTypes []Type,
+) *TupleType {
+	return &TupleType{
+		ElementTypes: elementTypes,
+	}
+}
+
+func NewMeteredTupleType(
+	gauge common.MemoryGauge,
+	elementTypes []Type,
+) *TupleType {
+	common.UseMemory(gauge, common.CadenceTupleTypeMemoryUsage)
+	return NewTupleType(elementTypes)
+}
+
+func (*TupleType) isType() {}
+
+func (t *TupleType) ID() string {
+	elementTypeIDs := make([]string, len(t.ElementTypes))
+	for i, elementType := range t.ElementTypes {
+		elementTypeIDs[i] = elementType.ID()
+	}
+	return fmt.Sprintf("(%s)", strings.Join(elementTypeIDs, ","))
+}
+
 // Field
 
 type Field struct {
 	Identifier string
 	Type       Type
+	// Indexed indicates if the field is an event field
+	// which is marked with the `indexed` modifier
+	Indexed bool
 }
 
 // Fields are always created in an array, which must be metered ahead of time.
@@ -1894,3 +1968,83 @@ func (AccountKeyType) isType() {}
 func (AccountKeyType) ID() string {
 	return "AccountKey"
 }
+
+// AuthAccountCapabilitiesType
+type AuthAccountCapabilitiesType struct{}
+
+func NewAuthAccountCapabilitiesType() AuthAccountCapabilitiesType {
+	return AuthAccountCapabilitiesType{}
+}
+
+func NewMeteredAuthAccountCapabilitiesType(
+	gauge common.MemoryGauge,
+) AuthAccountCapabilitiesType {
+	common.UseMemory(gauge, common.CadenceSimpleTypeMemoryUsage)
+	return NewAuthAccountCapabilitiesType()
+}
+
+func (AuthAccountCapabilitiesType) isType() {}
+
+func (AuthAccountCapabilitiesType) ID() string {
+	return "AuthAccount.Capabilities"
+}
+
+// AuthAccountInboxType
+type AuthAccountInboxType struct{}
+
+func NewAuthAccountInboxType() AuthAccountInboxType {
+	return AuthAccountInboxType{}
+}
+
+func NewMeteredAuthAccountInboxType(
+	gauge common.MemoryGauge,
+) AuthAccountInboxType {
+	common.UseMemory(gauge, common.CadenceSimpleTypeMemoryUsage)
+	return NewAuthAccountInboxType()
+}
+
+func (AuthAccountInboxType) isType() {}
+
+func (AuthAccountInboxType) ID() string {
+	return "AuthAccount.Inbox"
+}
+
+// AuthAccountStorageCapabilitiesType
+type AuthAccountStorageCapabilitiesType struct{}
+
+func NewAuthAccountStorageCapabilitiesType() AuthAccountStorageCapabilitiesType {
+	return AuthAccountStorageCapabilitiesType{}
+}
+
+func NewMeteredAuthAccountStorageCapabilitiesType(
+	gauge common.MemoryGauge,
+) AuthAccountStorageCapabilitiesType {
+	common.UseMemory(gauge, common.CadenceSimpleTypeMemoryUsage)
+	return NewAuthAccountStorageCapabilitiesType()
+}
+
+func (AuthAccountStorageCapabilitiesType) isType() {}
+
+func (AuthAccountStorageCapabilitiesType) ID() string {
+	return "AuthAccount.StorageCapabilities"
+}
+
+// StorageCapabilityControllerType
+type StorageCapabilityControllerType struct{}
+
+func NewStorageCapabilityControllerType() StorageCapabilityControllerType {
+	return StorageCapabilityControllerType{}
+}
+
+func NewMeteredStorageCapabilityControllerType(
+	gauge common.MemoryGauge,
+) StorageCapabilityControllerType {
+	common.UseMemory(gauge, common.CadenceSimpleTypeMemoryUsage)
+	return NewStorageCapabilityControllerType()
+}
+
+func (StorageCapabilityControllerType) isType() {}
+
+func (StorageCapabilityControllerType) ID() string {
+	return "StorageCapabilityController"
+}
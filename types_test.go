@@ -22,7 +22,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/tests/utils"
 )
 
@@ -163,3 +165,41 @@ func TestType_ID(t *testing.T) {
 		test(testCase.ty, testCase.expected)
 	}
 }
+
+func TestDecodeTypeID(t *testing.T) {
+
+	t.Parallel()
+
+	descriptor, err := DecodeTypeID("A.0x1.Foo.Bar{A.0x2.I}")
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		TypeDescriptor{
+			Location: common.NewAddressLocation(
+				nil,
+				common.MustBytesToAddress([]byte{0x1}),
+				"Foo",
+			),
+			QualifiedIdentifier: "Foo.Bar",
+			Restrictions: []TypeDescriptor{
+				{
+					Location: common.NewAddressLocation(
+						nil,
+						common.MustBytesToAddress([]byte{0x2}),
+						"I",
+					),
+					QualifiedIdentifier: "I",
+				},
+			},
+		},
+		descriptor,
+	)
+
+	assert.Equal(t,
+		"A.0000000000000001.Foo.Bar{A.0000000000000002.I}",
+		descriptor.TypeID(),
+	)
+
+	_, err = DecodeTypeID("[Int]")
+	require.Error(t, err)
+}
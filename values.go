@@ -1333,6 +1333,142 @@ func (v UFix64) String() string {
 	return format.UFix64(uint64(v))
 }
 
+// Fix128
+
+type Fix128 struct {
+	Value *big.Int
+}
+
+var _ Value = Fix128{}
+
+var fix128MemoryUsage = common.NewCadenceBigIntMemoryUsage(16)
+
+func NewFix128(s string) (Fix128, error) {
+	v, err := fixedpoint.ParseFix128(s)
+	if err != nil {
+		return Fix128{}, err
+	}
+	return Fix128{v}, nil
+}
+
+func NewFix128FromBig(i *big.Int) (Fix128, error) {
+	if i.Cmp(sema.Fix128TypeMinBig) < 0 {
+		return Fix128{}, errors.NewDefaultUserError("value exceeds min of Fix128: %s", i.String())
+	}
+	if i.Cmp(sema.Fix128TypeMaxBig) > 0 {
+		return Fix128{}, errors.NewDefaultUserError("value exceeds max of Fix128: %s", i.String())
+	}
+	return Fix128{i}, nil
+}
+
+func NewMeteredFix128(gauge common.MemoryGauge, constructor func() (string, error)) (Fix128, error) {
+	common.UseMemory(gauge, fix128MemoryUsage)
+	value, err := constructor()
+	if err != nil {
+		return Fix128{}, err
+	}
+	return NewFix128(value)
+}
+
+func NewMeteredFix128FromBig(
+	memoryGauge common.MemoryGauge,
+	bigIntConstructor func() *big.Int,
+) (Fix128, error) {
+	common.UseMemory(memoryGauge, fix128MemoryUsage)
+	value := bigIntConstructor()
+	return NewFix128FromBig(value)
+}
+
+func (Fix128) isValue() {}
+
+func (Fix128) Type() Type {
+	return NewFix128Type()
+}
+
+func (Fix128) MeteredType(gauge common.MemoryGauge) Type {
+	return NewMeteredFix128Type(gauge)
+}
+
+func (v Fix128) ToGoValue() any {
+	return v.Value
+}
+
+func (v Fix128) ToBigEndianBytes() []byte {
+	return interpreter.SignedBigIntToBigEndianBytes(v.Value)
+}
+
+func (v Fix128) String() string {
+	return format.Fix128(v.Value)
+}
+
+// UFix128
+
+type UFix128 struct {
+	Value *big.Int
+}
+
+var _ Value = UFix128{}
+
+var ufix128MemoryUsage = common.NewCadenceBigIntMemoryUsage(16)
+
+func NewUFix128(s string) (UFix128, error) {
+	v, err := fixedpoint.ParseUFix128(s)
+	if err != nil {
+		return UFix128{}, err
+	}
+	return UFix128{v}, nil
+}
+
+func NewUFix128FromBig(i *big.Int) (UFix128, error) {
+	if i.Sign() < 0 {
+		return UFix128{}, errors.NewDefaultUserError("invalid negative value for UFix128: %s", i.String())
+	}
+	if i.Cmp(sema.UFix128TypeMaxBig) > 0 {
+		return UFix128{}, errors.NewDefaultUserError("value exceeds max of UFix128: %s", i.String())
+	}
+	return UFix128{i}, nil
+}
+
+func NewMeteredUFix128(gauge common.MemoryGauge, constructor func() (string, error)) (UFix128, error) {
+	common.UseMemory(gauge, ufix128MemoryUsage)
+	value, err := constructor()
+	if err != nil {
+		return UFix128{}, err
+	}
+	return NewUFix128(value)
+}
+
+func NewMeteredUFix128FromBig(
+	memoryGauge common.MemoryGauge,
+	bigIntConstructor func() *big.Int,
+) (UFix128, error) {
+	common.UseMemory(memoryGauge, ufix128MemoryUsage)
+	value := bigIntConstructor()
+	return NewUFix128FromBig(value)
+}
+
+func (UFix128) isValue() {}
+
+func (UFix128) Type() Type {
+	return NewUFix128Type()
+}
+
+func (UFix128) MeteredType(gauge common.MemoryGauge) Type {
+	return NewMeteredUFix128Type(gauge)
+}
+
+func (v UFix128) ToGoValue() any {
+	return v.Value
+}
+
+func (v UFix128) ToBigEndianBytes() []byte {
+	return interpreter.UnsignedBigIntToBigEndianBytes(v.Value)
+}
+
+func (v UFix128) String() string {
+	return format.UFix128(v.Value)
+}
+
 // Array
 
 type Array struct {
@@ -1467,6 +1603,66 @@ func (v Dictionary) String() string {
 	return format.Dictionary(pairs)
 }
 
+// Tuple
+
+type Tuple struct {
+	TupleType Type
+	Values    []Value
+}
+
+var _ Value = Tuple{}
+
+func NewTuple(values []Value) Tuple {
+	return Tuple{Values: values}
+}
+
+func NewMeteredTuple(
+	gauge common.MemoryGauge,
+	constructor func() ([]Value, error),
+) (Tuple, error) {
+	common.UseMemory(gauge, common.CadenceTupleValueBaseMemoryUsage)
+
+	values, err := constructor()
+	if err != nil {
+		return Tuple{}, err
+	}
+
+	return NewTuple(values), nil
+}
+
+func (Tuple) isValue() {}
+
+func (v Tuple) Type() Type {
+	return v.TupleType
+}
+
+func (v Tuple) MeteredType(_ common.MemoryGauge) Type {
+	return v.Type()
+}
+
+func (v Tuple) WithType(tupleType *TupleType) Tuple {
+	v.TupleType = tupleType
+	return v
+}
+
+func (v Tuple) ToGoValue() any {
+	ret := make([]any, len(v.Values))
+
+	for i, e := range v.Values {
+		ret[i] = e.ToGoValue()
+	}
+
+	return ret
+}
+
+func (v Tuple) String() string {
+	values := make([]string, len(v.Values))
+	for i, value := range v.Values {
+		values[i] = value.String()
+	}
+	return format.Tuple(values)
+}
+
 // KeyValuePair
 
 type KeyValuePair struct {
@@ -1482,6 +1678,59 @@ func NewMeteredKeyValuePair(gauge common.MemoryGauge, key, value Value) KeyValue
 	}
 }
 
+// Composite
+
+// Composite is a composite value, i.e. a struct, resource, event, contract, or enum.
+//
+// The field values of a composite are guaranteed to be in the declaration order
+// of the fields of the composite type, i.e. the value of the field at index i
+// of CompositeFields is the value at index i of FieldValues.
+// Prefer FieldByName over positional access to avoid depending on the order.
+type Composite interface {
+	Value
+	isComposite()
+	// CompositeFields returns the fields of the composite type, in declaration order,
+	// or nil if the value has no type
+	CompositeFields() []Field
+	// FieldValues returns the field values, in declaration order
+	FieldValues() []Value
+	// FieldByName returns the value of the field with the given name,
+	// or nil if the composite has no such field
+	FieldByName(name string) Value
+	// FieldsMappedByName returns the field values mapped by the names of the fields
+	FieldsMappedByName() map[string]Value
+}
+
+var _ Composite = Struct{}
+var _ Composite = Resource{}
+var _ Composite = Event{}
+var _ Composite = Contract{}
+var _ Composite = Enum{}
+
+func compositeFieldByName(fields []Field, values []Value, name string) Value {
+	for i, field := range fields {
+		if field.Identifier != name {
+			continue
+		}
+		if i >= len(values) {
+			return nil
+		}
+		return values[i]
+	}
+	return nil
+}
+
+func compositeFieldsMappedByName(fields []Field, values []Value) map[string]Value {
+	result := make(map[string]Value, len(fields))
+	for i, field := range fields {
+		if i >= len(values) {
+			break
+		}
+		result[field.Identifier] = values[i]
+	}
+	return result
+}
+
 // Struct
 
 type Struct struct {
@@ -1540,6 +1789,27 @@ func (v Struct) String() string {
 	return formatComposite(v.StructType.ID(), v.StructType.Fields, v.Fields)
 }
 
+func (Struct) isComposite() {}
+
+func (v Struct) CompositeFields() []Field {
+	if v.StructType == nil {
+		return nil
+	}
+	return v.StructType.Fields
+}
+
+func (v Struct) FieldValues() []Value {
+	return v.Fields
+}
+
+func (v Struct) FieldByName(name string) Value {
+	return compositeFieldByName(v.CompositeFields(), v.Fields, name)
+}
+
+func (v Struct) FieldsMappedByName() map[string]Value {
+	return compositeFieldsMappedByName(v.CompositeFields(), v.Fields)
+}
+
 func formatComposite(typeID string, fields []Field, values []Value) string {
 	preparedFields := make([]struct {
 		Name  string
@@ -1618,6 +1888,27 @@ func (v Resource) String() string {
 	return formatComposite(v.ResourceType.ID(), v.ResourceType.Fields, v.Fields)
 }
 
+func (Resource) isComposite() {}
+
+func (v Resource) CompositeFields() []Field {
+	if v.ResourceType == nil {
+		return nil
+	}
+	return v.ResourceType.Fields
+}
+
+func (v Resource) FieldValues() []Value {
+	return v.Fields
+}
+
+func (v Resource) FieldByName(name string) Value {
+	return compositeFieldByName(v.CompositeFields(), v.Fields, name)
+}
+
+func (v Resource) FieldsMappedByName() map[string]Value {
+	return compositeFieldsMappedByName(v.CompositeFields(), v.Fields)
+}
+
 // Event
 
 type Event struct {
@@ -1674,6 +1965,27 @@ func (v Event) String() string {
 	return formatComposite(v.EventType.ID(), v.EventType.Fields, v.Fields)
 }
 
+func (Event) isComposite() {}
+
+func (v Event) CompositeFields() []Field {
+	if v.EventType == nil {
+		return nil
+	}
+	return v.EventType.Fields
+}
+
+func (v Event) FieldValues() []Value {
+	return v.Fields
+}
+
+func (v Event) FieldByName(name string) Value {
+	return compositeFieldByName(v.CompositeFields(), v.Fields, name)
+}
+
+func (v Event) FieldsMappedByName() map[string]Value {
+	return compositeFieldsMappedByName(v.CompositeFields(), v.Fields)
+}
+
 // Contract
 
 type Contract struct {
@@ -1731,6 +2043,27 @@ func (v Contract) String() string {
 	return formatComposite(v.ContractType.ID(), v.ContractType.Fields, v.Fields)
 }
 
+func (Contract) isComposite() {}
+
+func (v Contract) CompositeFields() []Field {
+	if v.ContractType == nil {
+		return nil
+	}
+	return v.ContractType.Fields
+}
+
+func (v Contract) FieldValues() []Value {
+	return v.Fields
+}
+
+func (v Contract) FieldByName(name string) Value {
+	return compositeFieldByName(v.CompositeFields(), v.Fields, name)
+}
+
+func (v Contract) FieldsMappedByName() map[string]Value {
+	return compositeFieldsMappedByName(v.CompositeFields(), v.Fields)
+}
+
 // Link
 
 type Link struct {
@@ -1855,10 +2188,14 @@ func (v TypeValue) String() string {
 
 // Capability
 
+// Capability is either a path capability, which has a path,
+// or an ID capability, which was issued through a capability controller and has an ID.
+//
 type Capability struct {
 	Path       Path
 	Address    Address
 	BorrowType Type
+	ID         UInt64
 }
 
 var _ Value = Capability{}
@@ -1876,6 +2213,19 @@ func NewMeteredCapability(gauge common.MemoryGauge, path Path, address Address,
 	return NewCapability(path, address, borrowType)
 }
 
+func NewIDCapability(id UInt64, address Address, borrowType Type) Capability {
+	return Capability{
+		ID:         id,
+		Address:    address,
+		BorrowType: borrowType,
+	}
+}
+
+func NewMeteredIDCapability(gauge common.MemoryGauge, id UInt64, address Address, borrowType Type) Capability {
+	common.UseMemory(gauge, common.CadenceCapabilityValueMemoryUsage)
+	return NewIDCapability(id, address, borrowType)
+}
+
 func (Capability) isValue() {}
 
 func (v Capability) Type() Type {
@@ -1891,6 +2241,13 @@ func (Capability) ToGoValue() any {
 }
 
 func (v Capability) String() string {
+	if v.ID != 0 {
+		return format.IDCapability(
+			v.BorrowType.ID(),
+			v.Address.String(),
+			v.ID.String(),
+		)
+	}
 	return format.Capability(
 		v.BorrowType.ID(),
 		v.Address.String(),
@@ -1953,3 +2310,24 @@ func (v Enum) ToGoValue() any {
 func (v Enum) String() string {
 	return formatComposite(v.EnumType.ID(), v.EnumType.Fields, v.Fields)
 }
+
+func (Enum) isComposite() {}
+
+func (v Enum) CompositeFields() []Field {
+	if v.EnumType == nil {
+		return nil
+	}
+	return v.EnumType.Fields
+}
+
+func (v Enum) FieldValues() []Value {
+	return v.Fields
+}
+
+func (v Enum) FieldByName(name string) Value {
+	return compositeFieldByName(v.CompositeFields(), v.Fields, name)
+}
+
+func (v Enum) FieldsMappedByName() map[string]Value {
+	return compositeFieldsMappedByName(v.CompositeFields(), v.Fields)
+}
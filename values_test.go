@@ -42,6 +42,8 @@ func TestStringer(t *testing.T) {
 
 	ufix64, _ := NewUFix64("64.01")
 	fix64, _ := NewFix64("-32.11")
+	ufix128, _ := NewUFix128("128.01")
+	fix128, _ := NewFix128("-0.5")
 
 	stringerTests := map[string]testCase{
 		"UInt": {
@@ -124,6 +126,14 @@ func TestStringer(t *testing.T) {
 			value:    fix64,
 			expected: "-32.11000000",
 		},
+		"UFix128": {
+			value:    ufix128,
+			expected: "128.010000000000000000000000",
+		},
+		"Fix128": {
+			value:    fix128,
+			expected: "-0.500000000000000000000000",
+		},
 		"Void": {
 			value:    NewVoid(),
 			expected: "()",
@@ -440,6 +450,17 @@ func TestToBigEndianBytes(t *testing.T) {
 			Fix64(42_00000000): {0, 0, 0, 0, 250, 86, 234, 0},
 			Fix64(42_24000000): {0, 0, 0, 0, 251, 197, 32, 0},
 		},
+		"Fix128": {
+			Fix128{Value: big.NewInt(0)}:    {0},
+			Fix128{Value: big.NewInt(42)}:   {42},
+			Fix128{Value: big.NewInt(-1)}:   {255},
+			Fix128{Value: big.NewInt(-256)}: {255, 0},
+		},
+		"UFix128": {
+			UFix128{Value: big.NewInt(0)}:   {0},
+			UFix128{Value: big.NewInt(42)}:  {42},
+			UFix128{Value: big.NewInt(255)}: {255},
+		},
 	}
 
 	// Ensure the test cases are complete
@@ -599,3 +620,52 @@ func TestNewUInt256FromBig(t *testing.T) {
 	_, err = NewUInt256FromBig(aboveMax)
 	require.Error(t, err)
 }
+
+func TestCompositeFieldByName(t *testing.T) {
+
+	t.Parallel()
+
+	fields := []Field{
+		{Identifier: "b", Type: IntType{}},
+		{Identifier: "a", Type: StringType{}},
+	}
+
+	values := []Value{
+		NewInt(1),
+		String("foo"),
+	}
+
+	for _, composite := range []Composite{
+		NewStruct(values).WithType(&StructType{Fields: fields}),
+		NewResource(values).WithType(&ResourceType{Fields: fields}),
+		NewEvent(values).WithType(&EventType{Fields: fields}),
+		NewContract(values).WithType(&ContractType{Fields: fields}),
+		NewEnum(values).WithType(&EnumType{Fields: fields}),
+	} {
+		assert.Equal(t, fields, composite.CompositeFields())
+		assert.Equal(t, values, composite.FieldValues())
+
+		assert.Equal(t, NewInt(1), composite.FieldByName("b"))
+		assert.Equal(t, String("foo"), composite.FieldByName("a"))
+		assert.Nil(t, composite.FieldByName("c"))
+
+		assert.Equal(t,
+			map[string]Value{
+				"b": NewInt(1),
+				"a": String("foo"),
+			},
+			composite.FieldsMappedByName(),
+		)
+	}
+
+	t.Run("without type", func(t *testing.T) {
+
+		t.Parallel()
+
+		composite := NewStruct(values)
+
+		assert.Nil(t, composite.CompositeFields())
+		assert.Nil(t, composite.FieldByName("a"))
+		assert.Empty(t, composite.FieldsMappedByName())
+	})
+}